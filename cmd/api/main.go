@@ -8,13 +8,20 @@ import (
 	"github.com/demola234/defifundr/cmd/api/docs"
 	"github.com/demola234/defifundr/config"
 	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/infrastructure/blockchain"
 	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/infrastructure/events"
+	"github.com/demola234/defifundr/infrastructure/fx"
 	"github.com/demola234/defifundr/infrastructure/mail"
 	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/infrastructure/pdf"
+	"github.com/demola234/defifundr/infrastructure/tax"
 	"github.com/demola234/defifundr/internal/adapters/handlers"
 	"github.com/demola234/defifundr/internal/adapters/repositories"
 	"github.com/demola234/defifundr/internal/adapters/routers"
+	"github.com/demola234/defifundr/internal/core/ports"
 	"github.com/demola234/defifundr/internal/core/services"
+	signedToken "github.com/demola234/defifundr/pkg/signed_token"
 	tokenMaker "github.com/demola234/defifundr/pkg/token_maker"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -67,6 +74,7 @@ func main() {
 
 	// Initialize repository
 	dbQueries := db.New(conn)
+	store := db.NewStore(conn)
 
 	defer conn.Close()
 
@@ -78,6 +86,24 @@ func main() {
 	walletRepo := repositories.NewWalletRepository(*dbQueries)
 	securityRepo := repositories.NewSecurityRepository(*dbQueries)
 	otpRepo := repositories.NewOtpRepository(*dbQueries)
+	payoutAddressRepo := repositories.NewPayoutAddressRepository(store)
+	transactionRepo := repositories.NewTransactionRepository(*dbQueries)
+	transactionPINRepo := repositories.NewTransactionPINRepository(*dbQueries)
+	indexerCheckpointRepo := repositories.NewIndexerCheckpointRepository(*dbQueries)
+	supportedAssetRepo := repositories.NewSupportedAssetRepository(*dbQueries)
+	fxRateRepo := repositories.NewFXRateRepository(*dbQueries)
+	organizationRepo := repositories.NewOrganizationRepository(store)
+	invitationRepo := repositories.NewInvitationRepository(store)
+	payrollRepo := repositories.NewPayrollRepository(store)
+	approvalRepo := repositories.NewApprovalRepository(store)
+	invoiceRepo := repositories.NewInvoiceRepository(store)
+	recurringInvoiceRepo := repositories.NewRecurringInvoiceRepository(store)
+	invoiceShareRepo := repositories.NewInvoiceShareRepository(store)
+	invoiceReminderRepo := repositories.NewInvoiceReminderRepository(store)
+	creditNoteRepo := repositories.NewCreditNoteRepository(store)
+	clientRepo := repositories.NewClientRepository(store)
+	timesheetRepo := repositories.NewTimesheetRepository(store)
+	ledgerRepo := repositories.NewLedgerRepository(store)
 
 	tokenMaker, err := tokenMaker.NewTokenMaker(configs.TokenSymmetricKey)
 	if err != nil {
@@ -111,15 +137,127 @@ func main() {
 	emailService := services.NewEmailService(configs, logger, emailSender)
 
 	userService := services.NewUserService(userRepo)
+	payoutAddressService := services.NewPayoutAddressService(payoutAddressRepo, userRepo, securityRepo, emailService, configs, logger)
+	organizationService := services.NewOrganizationService(organizationRepo, logger)
 
 	// Create services
-	authService := services.NewAuthService(userRepo, sessionRepo, oAuthRepo, walletRepo, securityRepo, emailService, tokenMaker, configs, logger, otpRepo, userService)
+	authService := services.NewAuthService(userRepo, sessionRepo, oAuthRepo, walletRepo, securityRepo, emailService, tokenMaker, configs, logger, otpRepo, userService, payoutAddressService, organizationService)
 	waitlistService := services.NewWaitlistService(waitlistRepo, emailService)
+	assetService := services.NewAssetService(supportedAssetRepo, logger)
+	transactionService := services.NewTransactionService(transactionRepo, assetService, logger)
+	transactionPINService := services.NewTransactionPINService(transactionPINRepo, userRepo, otpRepo, securityRepo, emailService, configs, logger)
+	ledgerService := services.NewLedgerService(ledgerRepo, logger)
+
+	invitationSigner, err := signedToken.NewSigner(configs.InvitationSecret, "organization_invitation")
+	if err != nil {
+		logger.Fatal("Failed to create invitation signer", err, nil)
+	}
+	invitationService := services.NewInvitationService(invitationRepo, organizationService, authService, emailService, invitationSigner, configs, logger)
+
+	// Exchange rates come from a fixture unless an HTTP provider is configured
+	var fxProvider ports.FXRateProvider
+	switch configs.FXProvider {
+	case "http":
+		fxProvider, err = fx.NewHTTPProvider(configs.FXHTTPURL, configs.FXHTTPAPIKey)
+	default:
+		fxProvider, err = fx.LoadStaticProvider(configs.FXStaticRatesFile)
+	}
+	if err != nil {
+		logger.Fatal("Failed to create FX rate provider", err, nil)
+	}
+	fxService := services.NewFXService(fxRateRepo, fxProvider, configs, logger)
+
+	// VAT rates come from the built-in table unless a newer rates file is configured
+	taxRates, err := tax.LoadRateTable(configs.TaxRatesFile)
+	if err != nil {
+		logger.Fatal("Failed to load tax rates", err, nil)
+	}
+	taxService := services.NewTaxService(taxRates, logger)
+
+	// The payroll contract is only reachable when a node and a signing key are configured
+	var payrollContract ports.PayrollContractClient
+
+	// Track on-chain transaction status when a node is configured
+	if configs.CryptDeployURL != "" {
+		evmClient, err := blockchain.NewEVMClient(ctx, configs.CryptDeployURL)
+		if err != nil {
+			logger.Fatal("Failed to connect to EVM node", err, nil)
+		}
+		defer evmClient.Close()
+
+		transactionEventBus := events.NewTransactionEventBus(logger)
+		transactionTracker := services.NewTransactionTracker(transactionRepo, transactionService, evmClient, transactionEventBus, configs, logger)
+		transactionTracker.Start()
+		defer transactionTracker.Stop()
+
+		// Index incoming ERC-20 payments when tokens to watch are configured
+		if len(configs.IndexerTokens) > 0 {
+			transferIndexer := services.NewTransferIndexer(evmClient, walletRepo, transactionRepo, indexerCheckpointRepo, assetService, configs, logger)
+			transferIndexer.Start()
+			defer transferIndexer.Stop()
+		}
+
+		// Match payments into invoice deposit addresses to their invoices
+		invoicePaymentWatcher := services.NewInvoicePaymentWatcher(evmClient, invoiceRepo, indexerCheckpointRepo, assetService, ledgerService, configs, logger)
+		invoicePaymentWatcher.Start()
+		defer invoicePaymentWatcher.Stop()
+
+		if configs.ContractAddress != "" && configs.ContractPrivateKey != "" {
+			contract, err := blockchain.NewPayrollContract(ctx, evmClient.ContractBackend(), configs.ContractAddress, configs.ContractPrivateKey, logger)
+			if err != nil {
+				logger.Fatal("Failed to create payroll contract client", err, nil)
+			}
+			payrollContract = contract
+		}
+	}
+
+	payrollService := services.NewPayrollService(payrollRepo, organizationService, assetService, payoutAddressService, fxService, taxService, payrollContract, configs, logger)
+	approvalService := services.NewApprovalService(approvalRepo, payrollRepo, organizationService, payoutAddressService, assetService, fxService, securityRepo, logger)
+
+	// Generate draft pay runs as pay dates arrive and expire stale approvals
+	payrollScheduler := services.NewPayrollScheduler(payrollService, approvalService, configs, logger)
+	payrollScheduler.Start()
+	defer payrollScheduler.Stop()
+
+	invoiceRenderer := pdf.NewInvoiceRenderer(logger)
+	invoiceShareSigner, err := signedToken.NewSigner(configs.InvoiceShareSecret, "invoice_share_link")
+	if err != nil {
+		logger.Fatal("Failed to create invoice share link signer", err, nil)
+	}
+	clientService := services.NewClientService(clientRepo, organizationService, assetService, logger)
+	invoiceService := services.NewInvoiceService(invoiceRepo, clientRepo, organizationService, assetService, emailService, invoiceRenderer, securityRepo, ledgerService, taxService, configs, logger)
+	recurringInvoiceService := services.NewRecurringInvoiceService(recurringInvoiceRepo, invoiceService, organizationService, assetService, logger)
+	invoiceShareService := services.NewInvoiceShareService(invoiceShareRepo, invoiceRepo, organizationService, assetService, invoiceRenderer, securityRepo, invoiceShareSigner, configs, logger)
+	invoiceReminderService := services.NewInvoiceReminderService(invoiceReminderRepo, invoiceRepo, organizationService, emailService, logger)
+	creditNoteService := services.NewCreditNoteService(creditNoteRepo, invoiceRepo, organizationService, assetService, securityRepo, ledgerService, logger)
+	timesheetService := services.NewTimesheetService(timesheetRepo, clientRepo, organizationService, invoiceService, logger)
+
+	// Generate recurring invoices, move invoices past their due date to overdue
+	// and send the reminders that have come due
+	invoiceScheduler := services.NewInvoiceScheduler(invoiceService, recurringInvoiceService, invoiceReminderService, configs, logger)
+	invoiceScheduler.Start()
+	defer invoiceScheduler.Stop()
 
 	// Create handlers
 	authHandler := handlers.NewAuthHandler(authService, logger)
 	userHandler := handlers.NewUserHandler(userService)
 	waitlistHandler := handlers.NewWaitlistHandler(waitlistService, logger)
+	payoutAddressHandler := handlers.NewPayoutAddressHandler(payoutAddressService, logger)
+	transactionHandler := handlers.NewTransactionHandler(transactionService, logger)
+	transactionPINHandler := handlers.NewTransactionPINHandler(transactionPINService, logger)
+	assetHandler := handlers.NewAssetHandler(assetService, logger)
+	fxHandler := handlers.NewFXHandler(fxService, logger)
+	organizationHandler := handlers.NewOrganizationHandler(organizationService, logger)
+	invitationHandler := handlers.NewInvitationHandler(invitationService, authService, logger)
+	payrollHandler := handlers.NewPayrollHandler(payrollService, logger)
+	approvalHandler := handlers.NewApprovalHandler(approvalService, logger)
+	invoiceHandler := handlers.NewInvoiceHandler(invoiceService, logger)
+	recurringInvoiceHandler := handlers.NewRecurringInvoiceHandler(recurringInvoiceService, logger)
+	invoiceShareHandler := handlers.NewInvoiceShareHandler(invoiceShareService, logger)
+	invoiceReminderHandler := handlers.NewInvoiceReminderHandler(invoiceReminderService, logger)
+	creditNoteHandler := handlers.NewCreditNoteHandler(creditNoteService, logger)
+	clientHandler := handlers.NewClientHandler(clientService, logger)
+	timesheetHandler := handlers.NewTimesheetHandler(timesheetService, logger)
 
 	// Initialize the router
 	router := gin.New()
@@ -132,14 +270,14 @@ func main() {
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-MFA-Token", middleware.TransactionPINHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
 	// Set up API routes
-	setupRoutes(router, authHandler, userHandler, waitlistHandler, configs, logger)
+	setupRoutes(router, authHandler, userHandler, waitlistHandler, payoutAddressHandler, transactionHandler, transactionPINHandler, assetHandler, fxHandler, organizationHandler, invitationHandler, payrollHandler, approvalHandler, invoiceHandler, recurringInvoiceHandler, invoiceShareHandler, invoiceReminderHandler, creditNoteHandler, clientHandler, timesheetHandler, configs, logger)
 
 	// Explicitly set host based on environment without protocol
 	var swaggerHost string
@@ -174,7 +312,7 @@ func main() {
 }
 
 // setupRoutes configures all the API routes
-func setupRoutes(router *gin.Engine, authHandler *handlers.AuthHandler, userHandler *handlers.UserHandler, waitlistHandler *handlers.WaitlistHandler, configs config.Config, logger logging.Logger) {
+func setupRoutes(router *gin.Engine, authHandler *handlers.AuthHandler, userHandler *handlers.UserHandler, waitlistHandler *handlers.WaitlistHandler, payoutAddressHandler *handlers.PayoutAddressHandler, transactionHandler *handlers.TransactionHandler, transactionPINHandler *handlers.TransactionPINHandler, assetHandler *handlers.AssetHandler, fxHandler *handlers.FXHandler, organizationHandler *handlers.OrganizationHandler, invitationHandler *handlers.InvitationHandler, payrollHandler *handlers.PayrollHandler, approvalHandler *handlers.ApprovalHandler, invoiceHandler *handlers.InvoiceHandler, recurringInvoiceHandler *handlers.RecurringInvoiceHandler, invoiceShareHandler *handlers.InvoiceShareHandler, invoiceReminderHandler *handlers.InvoiceReminderHandler, creditNoteHandler *handlers.CreditNoteHandler, clientHandler *handlers.ClientHandler, timesheetHandler *handlers.TimesheetHandler, configs config.Config, logger logging.Logger) {
 	// Health check endpoint
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -191,9 +329,28 @@ func setupRoutes(router *gin.Engine, authHandler *handlers.AuthHandler, userHand
 
 	// Middleware to check if the user is authenticated
 	authMiddleware := middleware.AuthMiddleware(tokenMaker, logger)
+	adminMiddleware := middleware.AdminMiddleware(configs.AdminEmails)
+	mfaMiddleware := middleware.MFARequiredMiddleware(authHandler.GetUserRepository())
+	transactionPINMiddleware := middleware.TransactionPINRequiredMiddleware(transactionPINHandler.GetPINService())
 
 	// Register routes
 	routers.RegisterAuthRoutes(router, authHandler, tokenMaker, logger)
 	routers.RegisterUserRoutes(v1, userHandler, authMiddleware)
 	routers.RegisterWaitlistRoutes(v1, waitlistHandler, authMiddleware)
+	routers.RegisterPayoutAddressRoutes(v1, payoutAddressHandler, authMiddleware)
+	routers.RegisterTransactionRoutes(v1, transactionHandler, authMiddleware)
+	routers.RegisterTransactionPINRoutes(v1, transactionPINHandler, authMiddleware)
+	routers.RegisterAssetRoutes(v1, assetHandler, authMiddleware, adminMiddleware)
+	routers.RegisterFXRoutes(v1, fxHandler, authMiddleware)
+	routers.RegisterOrganizationRoutes(v1, organizationHandler, authMiddleware)
+	routers.RegisterInvitationRoutes(v1, invitationHandler, authMiddleware)
+	routers.RegisterPayrollRoutes(v1, payrollHandler, authMiddleware)
+	routers.RegisterApprovalRoutes(v1, approvalHandler, authMiddleware, mfaMiddleware, transactionPINMiddleware)
+	routers.RegisterInvoiceRoutes(v1, invoiceHandler, authMiddleware)
+	routers.RegisterRecurringInvoiceRoutes(v1, recurringInvoiceHandler, authMiddleware)
+	routers.RegisterInvoiceShareRoutes(v1, invoiceShareHandler, authMiddleware)
+	routers.RegisterInvoiceReminderRoutes(v1, invoiceReminderHandler, authMiddleware)
+	routers.RegisterCreditNoteRoutes(v1, creditNoteHandler, authMiddleware)
+	routers.RegisterClientRoutes(v1, clientHandler, authMiddleware)
+	routers.RegisterTimesheetRoutes(v1, timesheetHandler, authMiddleware)
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/demola234/defifundr/internal/core/domain"
@@ -54,6 +56,58 @@ type Config struct {
 	OTPExpiryDuration time.Duration `mapstructure:"OTP_EXPIRY_DURATION"`
 	MaxOTPAttempts    int           `mapstructure:"MAX_OTP_ATTEMPTS"`
 
+	// Payout Address Allowlist Configuration
+	PayoutAddressCoolingOff time.Duration `mapstructure:"PAYOUT_ADDRESS_COOLING_OFF"`
+	PayoutAddressCancelURL  string        `mapstructure:"PAYOUT_ADDRESS_CANCEL_URL"`
+
+	// Transaction PIN Configuration
+	TransactionPINMaxAttempts     int           `mapstructure:"TRANSACTION_PIN_MAX_ATTEMPTS"`
+	TransactionPINLockoutDuration time.Duration `mapstructure:"TRANSACTION_PIN_LOCKOUT_DURATION"`
+
+	// On-chain Transaction Tracker Configuration
+	TxTrackerPollInterval    time.Duration `mapstructure:"TX_TRACKER_POLL_INTERVAL"`
+	TxTrackerConfirmations   uint64        `mapstructure:"TX_TRACKER_CONFIRMATIONS"`
+	TxTrackerNotFoundTimeout time.Duration `mapstructure:"TX_TRACKER_NOT_FOUND_TIMEOUT"`
+
+	// ERC-20 Transfer Indexer Configuration
+	IndexerChain        string        `mapstructure:"INDEXER_CHAIN"`
+	IndexerTokens       []string      `mapstructure:"INDEXER_TOKENS"`
+	IndexerStartBlock   uint64        `mapstructure:"INDEXER_START_BLOCK"`
+	IndexerBatchSize    uint64        `mapstructure:"INDEXER_BATCH_SIZE"`
+	IndexerReorgDepth   uint64        `mapstructure:"INDEXER_REORG_DEPTH"`
+	IndexerPollInterval time.Duration `mapstructure:"INDEXER_POLL_INTERVAL"`
+
+	// Foreign Exchange Configuration
+	FXProvider          string            `mapstructure:"FX_PROVIDER"`
+	FXStaticRatesFile   string            `mapstructure:"FX_STATIC_RATES_FILE"`
+	FXHTTPURL           string            `mapstructure:"FX_HTTP_URL"`
+	FXHTTPAPIKey        string            `mapstructure:"FX_HTTP_API_KEY"`
+	FXRateMaxAge        time.Duration     `mapstructure:"FX_RATE_MAX_AGE"`
+	FXQuoteLockDuration time.Duration     `mapstructure:"FX_QUOTE_LOCK_DURATION"`
+	FXPegs              map[string]string `mapstructure:"-"`
+
+	// Team Invitation Configuration
+	InvitationSecret    string        `mapstructure:"INVITATION_SECRET"`
+	InvitationTTL       time.Duration `mapstructure:"INVITATION_TTL"`
+	InvitationAcceptURL string        `mapstructure:"INVITATION_ACCEPT_URL"`
+
+	// Payroll Configuration
+	PayrollPollInterval   time.Duration `mapstructure:"PAYROLL_POLL_INTERVAL"`
+	PayrollFeeBasisPoints int64         `mapstructure:"PAYROLL_FEE_BPS"`
+	PayrollAnomalyPercent int64         `mapstructure:"PAYROLL_ANOMALY_PERCENT"`
+
+	// Invoice Configuration
+	InvoicePollInterval time.Duration `mapstructure:"INVOICE_POLL_INTERVAL"`
+	InvoiceShareSecret  string        `mapstructure:"INVOICE_SHARE_SECRET"`
+	InvoiceShareTTL     time.Duration `mapstructure:"INVOICE_SHARE_TTL"`
+	InvoiceShareURL     string        `mapstructure:"INVOICE_SHARE_URL"`
+
+	// Tax Configuration
+	TaxRatesFile string `mapstructure:"TAX_RATES_FILE"`
+
+	// Platform administrators, identified by account email
+	AdminEmails []string `mapstructure:"ADMIN_EMAILS"`
+
 	// Logging configuration
 	LogLevel       string `mapstructure:"LOG_LEVEL"`
 	LogFormat      string `mapstructure:"LOG_FORMAT"`
@@ -78,6 +132,38 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetDefault("MAX_LOGIN_ATTEMPTS", 5)
 	viper.SetDefault("OTP_EXPIRY_DURATION", "5m")
 	viper.SetDefault("MAX_OTP_ATTEMPTS", 3)
+	viper.SetDefault("PAYOUT_ADDRESS_COOLING_OFF", "24h")
+	viper.SetDefault("PAYOUT_ADDRESS_CANCEL_URL", "http://localhost:8080/api/v1/payout-addresses/cancel")
+	viper.SetDefault("TRANSACTION_PIN_MAX_ATTEMPTS", 5)
+	viper.SetDefault("TRANSACTION_PIN_LOCKOUT_DURATION", "30m")
+	viper.SetDefault("TX_TRACKER_POLL_INTERVAL", "15s")
+	viper.SetDefault("TX_TRACKER_CONFIRMATIONS", 12)
+	viper.SetDefault("TX_TRACKER_NOT_FOUND_TIMEOUT", "30m")
+	viper.SetDefault("INDEXER_CHAIN", "ethereum")
+	viper.SetDefault("INDEXER_TOKENS", "")
+	viper.SetDefault("INDEXER_START_BLOCK", 0)
+	viper.SetDefault("INDEXER_BATCH_SIZE", 1000)
+	viper.SetDefault("INDEXER_REORG_DEPTH", 12)
+	viper.SetDefault("INDEXER_POLL_INTERVAL", "15s")
+	viper.SetDefault("FX_PROVIDER", "static")
+	viper.SetDefault("FX_STATIC_RATES_FILE", "")
+	viper.SetDefault("FX_HTTP_URL", "")
+	viper.SetDefault("FX_HTTP_API_KEY", "")
+	viper.SetDefault("FX_RATE_MAX_AGE", "1h")
+	viper.SetDefault("FX_QUOTE_LOCK_DURATION", "15m")
+	viper.SetDefault("FX_PEGS", "USDC:USD,USDT:USD")
+	viper.SetDefault("INVITATION_SECRET", "")
+	viper.SetDefault("INVITATION_TTL", "168h")
+	viper.SetDefault("INVITATION_ACCEPT_URL", "http://localhost:3000/invitations/accept")
+	viper.SetDefault("PAYROLL_POLL_INTERVAL", "1m")
+	viper.SetDefault("PAYROLL_FEE_BPS", 0)
+	viper.SetDefault("PAYROLL_ANOMALY_PERCENT", 25)
+	viper.SetDefault("INVOICE_POLL_INTERVAL", "5m")
+	viper.SetDefault("INVOICE_SHARE_SECRET", "")
+	viper.SetDefault("INVOICE_SHARE_TTL", "720h")
+	viper.SetDefault("INVOICE_SHARE_URL", "http://localhost:3000/invoices/shared")
+	viper.SetDefault("TAX_RATES_FILE", "")
+	viper.SetDefault("ADMIN_EMAILS", "")
 
 	// Set default values for logging
 	viper.SetDefault("LOG_LEVEL", "info")
@@ -118,6 +204,102 @@ func LoadConfig(path string) (config Config, err error) {
 		return
 	}
 
+	config.PayoutAddressCoolingOff, err = time.ParseDuration(viper.GetString("PAYOUT_ADDRESS_COOLING_OFF"))
+	if err != nil {
+		return
+	}
+
+	config.TransactionPINLockoutDuration, err = time.ParseDuration(viper.GetString("TRANSACTION_PIN_LOCKOUT_DURATION"))
+	if err != nil {
+		return
+	}
+
+	config.TxTrackerPollInterval, err = time.ParseDuration(viper.GetString("TX_TRACKER_POLL_INTERVAL"))
+	if err != nil {
+		return
+	}
+
+	config.TxTrackerNotFoundTimeout, err = time.ParseDuration(viper.GetString("TX_TRACKER_NOT_FOUND_TIMEOUT"))
+	if err != nil {
+		return
+	}
+
+	config.IndexerPollInterval, err = time.ParseDuration(viper.GetString("INDEXER_POLL_INTERVAL"))
+	if err != nil {
+		return
+	}
+
+	config.FXRateMaxAge, err = time.ParseDuration(viper.GetString("FX_RATE_MAX_AGE"))
+	if err != nil {
+		return
+	}
+
+	config.FXQuoteLockDuration, err = time.ParseDuration(viper.GetString("FX_QUOTE_LOCK_DURATION"))
+	if err != nil {
+		return
+	}
+
+	config.InvitationTTL, err = time.ParseDuration(viper.GetString("INVITATION_TTL"))
+	if err != nil {
+		return
+	}
+
+	config.PayrollPollInterval, err = time.ParseDuration(viper.GetString("PAYROLL_POLL_INTERVAL"))
+	if err != nil {
+		return
+	}
+
+	config.InvoicePollInterval, err = time.ParseDuration(viper.GetString("INVOICE_POLL_INTERVAL"))
+	if err != nil {
+		return
+	}
+
+	config.InvoiceShareTTL, err = time.ParseDuration(viper.GetString("INVOICE_SHARE_TTL"))
+	if err != nil {
+		return
+	}
+
+	// Invitation links are signed with their own secret, never the token key
+	if config.InvitationSecret == "" || config.InvitationSecret == config.TokenSymmetricKey {
+		err = errors.New("INVITATION_SECRET must be set and differ from TOKEN_SYMMETRIC_KEY")
+		return
+	}
+
+	// Invoice share links are signed with their own secret, never the token key
+	if config.InvoiceShareSecret == "" || config.InvoiceShareSecret == config.TokenSymmetricKey {
+		err = errors.New("INVOICE_SHARE_SECRET must be set and differ from TOKEN_SYMMETRIC_KEY")
+		return
+	}
+
+	// Pegs are given as a comma separated list of CODE:PEGGED_TO pairs
+	config.FXPegs = make(map[string]string)
+	for _, peg := range strings.Split(viper.GetString("FX_PEGS"), ",") {
+		code, target, ok := strings.Cut(peg, ":")
+		if !ok {
+			continue
+		}
+		code, target = strings.ToUpper(strings.TrimSpace(code)), strings.ToUpper(strings.TrimSpace(target))
+		if code != "" && target != "" {
+			config.FXPegs[code] = target
+		}
+	}
+
+	// Token addresses are given as a comma separated list
+	config.IndexerTokens = nil
+	for _, token := range strings.Split(viper.GetString("INDEXER_TOKENS"), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			config.IndexerTokens = append(config.IndexerTokens, token)
+		}
+	}
+
+	// Admin emails are given as a comma separated list
+	config.AdminEmails = nil
+	for _, email := range strings.Split(viper.GetString("ADMIN_EMAILS"), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			config.AdminEmails = append(config.AdminEmails, email)
+		}
+	}
+
 	return
 }
 
@@ -0,0 +1,27 @@
+-- +goose Up
+-- Payout address allowlist: every newly linked or changed payout address
+-- is quarantined for a cooling-off period before funds can be sent to it.
+CREATE TABLE payout_address_allowlist (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    wallet_id UUID NOT NULL REFERENCES user_wallets(id) ON DELETE CASCADE,
+    address TEXT NOT NULL,
+    chain TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    cancel_token_hash VARCHAR(255) NOT NULL UNIQUE,
+    available_at TIMESTAMPTZ NOT NULL,
+    activated_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_payout_address_allowlist_user_id ON payout_address_allowlist(user_id);
+CREATE INDEX idx_payout_address_allowlist_address ON payout_address_allowlist(address);
+CREATE INDEX idx_payout_address_allowlist_status_available_at ON payout_address_allowlist(status, available_at);
+
+COMMENT ON COLUMN payout_address_allowlist.status IS 'pending, active, cancelled';
+COMMENT ON COLUMN payout_address_allowlist.cancel_token_hash IS 'sha256 of the one-click cancel token';
+
+-- +goose Down
+DROP TABLE IF EXISTS payout_address_allowlist;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE INDEX idx_transactions_user_id_created_at ON transactions(user_id, created_at DESC);
+CREATE INDEX idx_transactions_status ON transactions(status);
+
+COMMENT ON COLUMN transactions.status IS 'created, pending, confirmed, failed, not_found';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+COMMENT ON COLUMN transactions.status IS 'created, pending, not_found, failed';
+
+DROP INDEX IF EXISTS idx_transactions_status;
+DROP INDEX IF EXISTS idx_transactions_user_id_created_at;
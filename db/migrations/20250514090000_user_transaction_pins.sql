@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TYPE otp_purpose ADD VALUE IF NOT EXISTS 'transaction_pin_reset';
+
+CREATE TABLE user_transaction_pins (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  pin_hash VARCHAR(255) NOT NULL,
+  failed_attempts INTEGER NOT NULL DEFAULT 0,
+  locked_until TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN user_transaction_pins.pin_hash IS 'argon2id hash of the transaction PIN';
+COMMENT ON COLUMN user_transaction_pins.failed_attempts IS 'consecutive wrong PIN entries since the last success or lockout';
+COMMENT ON COLUMN user_transaction_pins.locked_until IS 'PIN verification is refused until this time';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+-- Postgres cannot drop an enum value, transaction_pin_reset stays on otp_purpose
+DROP TABLE IF EXISTS user_transaction_pins;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE transactions
+  ADD COLUMN block_number BIGINT,
+  ADD COLUMN block_hash VARCHAR(66);
+
+COMMENT ON COLUMN transactions.block_number IS 'block the receipt was last seen in, cleared when the block is reorged out';
+COMMENT ON COLUMN transactions.block_hash IS 'hash of the block the receipt was last seen in';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS block_hash,
+  DROP COLUMN IF EXISTS block_number;
@@ -0,0 +1,47 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE transactions
+  ADD COLUMN direction VARCHAR(10) NOT NULL DEFAULT 'outbound',
+  ADD COLUMN log_index INTEGER,
+  ADD COLUMN token_address VARCHAR(42),
+  ADD COLUMN from_address VARCHAR(42),
+  ADD COLUMN to_address VARCHAR(42),
+  ADD COLUMN amount NUMERIC(78, 0);
+
+-- One transaction can carry several inbound transfers, so inbound rows are
+-- unique per log rather than per hash
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_tx_hash_key;
+DROP INDEX IF EXISTS idx_transactions_tx_hash;
+CREATE UNIQUE INDEX idx_transactions_tx_hash ON transactions(tx_hash) WHERE log_index IS NULL;
+CREATE UNIQUE INDEX idx_transactions_tx_hash_log_index ON transactions(tx_hash, log_index) WHERE log_index IS NOT NULL;
+
+COMMENT ON COLUMN transactions.direction IS 'outbound (user initiated) or inbound (indexed ERC-20 transfer)';
+COMMENT ON COLUMN transactions.log_index IS 'position of the Transfer log in its block, inbound transfers only';
+COMMENT ON COLUMN transactions.amount IS 'transfer amount in token base units, inbound transfers only';
+
+CREATE TABLE indexer_checkpoints (
+  name VARCHAR(100) PRIMARY KEY,
+  block_number BIGINT NOT NULL,
+  block_hash VARCHAR(66) NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE indexer_checkpoints IS 'last block each chain indexer has fully processed';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS indexer_checkpoints;
+
+DELETE FROM transactions WHERE direction = 'inbound';
+
+DROP INDEX IF EXISTS idx_transactions_tx_hash_log_index;
+DROP INDEX IF EXISTS idx_transactions_tx_hash;
+CREATE UNIQUE INDEX idx_transactions_tx_hash ON transactions(tx_hash);
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS amount,
+  DROP COLUMN IF EXISTS to_address,
+  DROP COLUMN IF EXISTS from_address,
+  DROP COLUMN IF EXISTS token_address,
+  DROP COLUMN IF EXISTS log_index,
+  DROP COLUMN IF EXISTS direction;
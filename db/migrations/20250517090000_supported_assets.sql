@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE supported_assets (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  chain VARCHAR(50) NOT NULL,
+  symbol VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  contract_address VARCHAR(100),
+  decimals SMALLINT NOT NULL CHECK (decimals BETWEEN 0 AND 36),
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_supported_assets_chain_symbol ON supported_assets(chain, symbol);
+CREATE UNIQUE INDEX idx_supported_assets_chain_contract ON supported_assets(chain, lower(contract_address)) WHERE contract_address IS NOT NULL;
+
+COMMENT ON TABLE supported_assets IS 'tokens accepted for payouts, invoices and incoming payments';
+COMMENT ON COLUMN supported_assets.chain IS 'lower-case chain slug matching user_wallets.chain, e.g. ethereum, base, tron';
+COMMENT ON COLUMN supported_assets.contract_address IS 'token contract or mint address, NULL for the chain''s native asset';
+COMMENT ON COLUMN supported_assets.decimals IS 'number of decimal places between display units and base units';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS supported_assets;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE fx_rates (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  base_currency VARCHAR(20) NOT NULL,
+  quote_currency VARCHAR(20) NOT NULL,
+  rate NUMERIC(36,18) NOT NULL CHECK (rate > 0),
+  source VARCHAR(50) NOT NULL,
+  fetched_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_fx_rates_pair_fetched_at ON fx_rates(base_currency, quote_currency, fetched_at DESC);
+
+COMMENT ON TABLE fx_rates IS 'history of exchange rates fetched from the configured FX provider';
+COMMENT ON COLUMN fx_rates.rate IS 'units of quote_currency per one unit of base_currency';
+
+CREATE TABLE fx_quotes (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  base_currency VARCHAR(20) NOT NULL,
+  quote_currency VARCHAR(20) NOT NULL,
+  rate NUMERIC(36,18) NOT NULL CHECK (rate > 0),
+  source VARCHAR(50) NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE fx_quotes IS 'exchange rates locked for a window so conversions at pay time are deterministic';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS fx_quotes;
+DROP TABLE IF EXISTS fx_rates;
@@ -0,0 +1,77 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE ledger_accounts (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  code VARCHAR(150) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  type VARCHAR(20) NOT NULL CHECK (type IN ('asset', 'liability', 'equity', 'revenue', 'expense')),
+  owner_id UUID,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_ledger_accounts_code ON ledger_accounts(code);
+CREATE INDEX idx_ledger_accounts_owner_id ON ledger_accounts(owner_id) WHERE owner_id IS NOT NULL;
+
+COMMENT ON TABLE ledger_accounts IS 'chart of accounts for the double-entry ledger';
+COMMENT ON COLUMN ledger_accounts.code IS 'stable unique key, e.g. payables:contractor:<user id>';
+COMMENT ON COLUMN ledger_accounts.owner_id IS 'user or organization the account belongs to, NULL for platform accounts';
+
+CREATE TABLE ledger_entries (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  external_reference VARCHAR(255) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  occurred_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_ledger_entries_external_reference ON ledger_entries(external_reference);
+
+COMMENT ON TABLE ledger_entries IS 'journal entries; each groups postings that sum to zero per asset';
+COMMENT ON COLUMN ledger_entries.external_reference IS 'idempotency key of the business event that produced the entry';
+
+CREATE TABLE ledger_postings (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  entry_id UUID NOT NULL REFERENCES ledger_entries(id) ON DELETE RESTRICT,
+  account_id UUID NOT NULL REFERENCES ledger_accounts(id) ON DELETE RESTRICT,
+  asset VARCHAR(20) NOT NULL,
+  amount NUMERIC(78,18) NOT NULL CHECK (amount <> 0),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_ledger_postings_entry_id ON ledger_postings(entry_id);
+CREATE INDEX idx_ledger_postings_account_asset ON ledger_postings(account_id, asset);
+
+COMMENT ON TABLE ledger_postings IS 'immutable debits and credits; corrections are made with reversing entries';
+COMMENT ON COLUMN ledger_postings.amount IS 'positive for a debit, negative for a credit, in display units of asset';
+
+-- Reject any transaction that leaves an entry unbalanced. The check is deferred
+-- to commit so all postings of an entry can be inserted one by one.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION check_ledger_entry_balanced() RETURNS trigger AS $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM ledger_postings
+    WHERE entry_id = NEW.entry_id
+    GROUP BY asset
+    HAVING SUM(amount) <> 0
+  ) THEN
+    RAISE EXCEPTION 'ledger entry % does not balance', NEW.entry_id;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE CONSTRAINT TRIGGER trg_ledger_postings_balanced
+AFTER INSERT OR UPDATE ON ledger_postings
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_ledger_entry_balanced();
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TRIGGER IF EXISTS trg_ledger_postings_balanced ON ledger_postings;
+DROP FUNCTION IF EXISTS check_ledger_entry_balanced();
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_accounts;
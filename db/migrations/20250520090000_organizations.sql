@@ -0,0 +1,63 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE organizations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL DEFAULT '',
+  city VARCHAR(255) NOT NULL DEFAULT '',
+  postal_code VARCHAR(255) NOT NULL DEFAULT '',
+  country VARCHAR(255) NOT NULL DEFAULT '',
+  website VARCHAR(255),
+  size VARCHAR(50),
+  industry VARCHAR(255),
+  description TEXT,
+  headquarters VARCHAR(255),
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_organizations_created_by ON organizations(created_by) WHERE created_by IS NOT NULL;
+
+COMMENT ON TABLE organizations IS 'business accounts; users act for them through organization_members';
+COMMENT ON COLUMN organizations.created_by IS 'user who set the organization up during business onboarding';
+
+CREATE TABLE organization_members (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'finance', 'viewer')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_organization_members_org_user ON organization_members(organization_id, user_id);
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
+
+COMMENT ON COLUMN organization_members.role IS 'owner, admin, finance, viewer';
+
+-- Every business user with company details becomes the owner of an
+-- organization built from them
+INSERT INTO organizations (name, address, city, postal_code, country, website, created_by, created_at, updated_at)
+SELECT
+  company_name,
+  COALESCE(company_address, ''),
+  COALESCE(company_city, ''),
+  COALESCE(company_postal_code, ''),
+  COALESCE(company_country, ''),
+  NULLIF(company_website, ''),
+  id,
+  created_at,
+  updated_at
+FROM users
+WHERE account_type = 'business' AND COALESCE(company_name, '') <> '';
+
+INSERT INTO organization_members (organization_id, user_id, role, created_at, updated_at)
+SELECT id, created_by, 'owner', created_at, created_at
+FROM organizations
+WHERE created_by IS NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
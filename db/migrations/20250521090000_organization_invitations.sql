@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE organization_invitations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  email VARCHAR(255) NOT NULL,
+  role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'finance', 'viewer')),
+  invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'revoked')),
+  token_hash VARCHAR(64) NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  send_count INTEGER NOT NULL DEFAULT 1,
+  last_sent_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  accepted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  accepted_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_organization_invitations_pending_email ON organization_invitations(organization_id, lower(email)) WHERE status = 'pending';
+CREATE INDEX idx_organization_invitations_organization_id ON organization_invitations(organization_id);
+
+COMMENT ON TABLE organization_invitations IS 'emailed invitations to join an organization';
+COMMENT ON COLUMN organization_invitations.token_hash IS 'SHA-256 of the latest signed token; resending replaces it so older links stop working';
+COMMENT ON COLUMN organization_invitations.status IS 'pending, accepted, revoked; a pending invitation past expires_at is expired';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS organization_invitations;
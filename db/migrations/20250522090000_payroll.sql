@@ -0,0 +1,84 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE payroll_schedules (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('weekly', 'biweekly', 'monthly', 'custom')),
+  cron_expression VARCHAR(100),
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  first_pay_date TIMESTAMPTZ NOT NULL,
+  next_run_at TIMESTAMPTZ NOT NULL,
+  last_run_at TIMESTAMPTZ,
+  active BOOLEAN NOT NULL DEFAULT true,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK ((frequency = 'custom') = (cron_expression IS NOT NULL))
+);
+
+CREATE INDEX idx_payroll_schedules_organization_id ON payroll_schedules(organization_id);
+CREATE INDEX idx_payroll_schedules_due ON payroll_schedules(next_run_at) WHERE active;
+
+COMMENT ON TABLE payroll_schedules IS 'recurring pay dates of an organization; each date produces a draft pay run';
+COMMENT ON COLUMN payroll_schedules.cron_expression IS 'five-field cron expression, only for custom schedules';
+COMMENT ON COLUMN payroll_schedules.first_pay_date IS 'anchor that weekly, bi-weekly and monthly pay dates are counted from';
+
+CREATE TABLE employee_compensations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  schedule_id UUID NOT NULL REFERENCES payroll_schedules(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  amount NUMERIC(78,18) NOT NULL CHECK (amount > 0),
+  currency VARCHAR(20) NOT NULL,
+  payout_asset_id UUID NOT NULL REFERENCES supported_assets(id) ON DELETE RESTRICT,
+  wallet_address VARCHAR(255) NOT NULL,
+  active BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_employee_compensations_active ON employee_compensations(schedule_id, user_id) WHERE active;
+CREATE INDEX idx_employee_compensations_organization_id ON employee_compensations(organization_id);
+
+COMMENT ON TABLE employee_compensations IS 'what each employee is paid per pay date of a schedule';
+COMMENT ON COLUMN employee_compensations.currency IS 'currency the amount is agreed in; may differ from the payout asset';
+
+CREATE TABLE pay_runs (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  schedule_id UUID NOT NULL REFERENCES payroll_schedules(id) ON DELETE CASCADE,
+  pay_date TIMESTAMPTZ NOT NULL,
+  period_start TIMESTAMPTZ NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft' CONSTRAINT pay_runs_status_check CHECK (status IN ('draft')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_pay_runs_schedule_pay_date ON pay_runs(schedule_id, pay_date);
+CREATE INDEX idx_pay_runs_organization_id ON pay_runs(organization_id, pay_date DESC);
+
+COMMENT ON COLUMN pay_runs.period_start IS 'previous pay date of the schedule, or when it was created for the first run';
+
+CREATE TABLE pay_run_line_items (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  pay_run_id UUID NOT NULL REFERENCES pay_runs(id) ON DELETE CASCADE,
+  compensation_id UUID REFERENCES employee_compensations(id) ON DELETE SET NULL,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  amount NUMERIC(78,18) NOT NULL,
+  currency VARCHAR(20) NOT NULL,
+  payout_asset_id UUID NOT NULL REFERENCES supported_assets(id) ON DELETE RESTRICT,
+  wallet_address VARCHAR(255) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_pay_run_line_items_pay_run_id ON pay_run_line_items(pay_run_id);
+
+COMMENT ON TABLE pay_run_line_items IS 'compensation copied onto a pay run, so later changes do not alter it';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS pay_run_line_items;
+DROP TABLE IF EXISTS pay_runs;
+DROP TABLE IF EXISTS employee_compensations;
+DROP TABLE IF EXISTS payroll_schedules;
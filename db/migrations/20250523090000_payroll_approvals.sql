@@ -0,0 +1,71 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE approval_policies (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  currency VARCHAR(20) NOT NULL,
+  min_total_amount NUMERIC(78,18) CHECK (min_total_amount >= 0),
+  min_payout_amount NUMERIC(78,18) CHECK (min_payout_amount >= 0),
+  required_approvals INTEGER NOT NULL CHECK (required_approvals > 0),
+  approver_ids UUID[] NOT NULL,
+  approval_window_hours INTEGER NOT NULL DEFAULT 72 CHECK (approval_window_hours > 0),
+  active BOOLEAN NOT NULL DEFAULT true,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (required_approvals <= cardinality(approver_ids))
+);
+
+CREATE INDEX idx_approval_policies_organization_id ON approval_policies(organization_id);
+
+COMMENT ON TABLE approval_policies IS 'N of M sign-off rules a pay run must pass before it can be paid';
+COMMENT ON COLUMN approval_policies.currency IS 'currency the thresholds are in; pay run amounts are converted into it';
+COMMENT ON COLUMN approval_policies.min_total_amount IS 'policy applies when the pay run total reaches this amount';
+COMMENT ON COLUMN approval_policies.min_payout_amount IS 'policy applies when any single payout reaches this amount';
+COMMENT ON COLUMN approval_policies.approval_window_hours IS 'how long a request stays open before it expires';
+
+CREATE TABLE approval_requests (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  pay_run_id UUID NOT NULL REFERENCES pay_runs(id) ON DELETE CASCADE,
+  policy_id UUID REFERENCES approval_policies(id) ON DELETE SET NULL,
+  required_approvals INTEGER NOT NULL CHECK (required_approvals > 0),
+  approver_ids UUID[] NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'expired')),
+  requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  expires_at TIMESTAMPTZ NOT NULL,
+  decided_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_approval_requests_pending_pay_run ON approval_requests(pay_run_id) WHERE status = 'pending';
+CREATE INDEX idx_approval_requests_organization_id ON approval_requests(organization_id, created_at DESC);
+CREATE INDEX idx_approval_requests_expiry ON approval_requests(expires_at) WHERE status = 'pending';
+
+COMMENT ON COLUMN approval_requests.required_approvals IS 'copied from the policy when the pay run was submitted';
+COMMENT ON COLUMN approval_requests.approver_ids IS 'copied from the policy when the pay run was submitted';
+
+CREATE TABLE approval_decisions (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  request_id UUID NOT NULL REFERENCES approval_requests(id) ON DELETE CASCADE,
+  approver_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  decision VARCHAR(20) NOT NULL CHECK (decision IN ('approved', 'rejected')),
+  comment TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (request_id, approver_id)
+);
+
+ALTER TABLE pay_runs DROP CONSTRAINT pay_runs_status_check;
+ALTER TABLE pay_runs ADD CONSTRAINT pay_runs_status_check CHECK (status IN ('draft', 'pending_approval', 'approved'));
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+UPDATE pay_runs SET status = 'draft' WHERE status <> 'draft';
+ALTER TABLE pay_runs DROP CONSTRAINT pay_runs_status_check;
+ALTER TABLE pay_runs ADD CONSTRAINT pay_runs_status_check CHECK (status IN ('draft'));
+
+DROP TABLE IF EXISTS approval_decisions;
+DROP TABLE IF EXISTS approval_requests;
+DROP TABLE IF EXISTS approval_policies;
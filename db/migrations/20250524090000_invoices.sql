@@ -0,0 +1,73 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE invoice_sequences (
+  organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+  last_number BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE invoice_sequences IS 'last invoice number handed out per organization; numbering an invoice locks the row until the invoice is committed, so numbers have no gaps';
+
+CREATE TABLE invoices (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  number BIGINT NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft' CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'sent', 'viewed', 'paid', 'overdue', 'void')),
+  currency VARCHAR(20) NOT NULL,
+  customer_name VARCHAR(255) NOT NULL,
+  customer_email VARCHAR(255) NOT NULL,
+  customer_address TEXT NOT NULL DEFAULT '',
+  issue_date TIMESTAMPTZ NOT NULL,
+  due_date TIMESTAMPTZ NOT NULL,
+  notes TEXT NOT NULL DEFAULT '',
+  subtotal NUMERIC(78,18) NOT NULL DEFAULT 0,
+  discount_total NUMERIC(78,18) NOT NULL DEFAULT 0,
+  tax_total NUMERIC(78,18) NOT NULL DEFAULT 0,
+  total NUMERIC(78,18) NOT NULL DEFAULT 0,
+  sent_at TIMESTAMPTZ,
+  viewed_at TIMESTAMPTZ,
+  paid_at TIMESTAMPTZ,
+  payment_reference VARCHAR(255),
+  voided_at TIMESTAMPTZ,
+  void_reason TEXT,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (due_date >= issue_date)
+);
+
+CREATE UNIQUE INDEX idx_invoices_organization_number ON invoices(organization_id, number);
+CREATE INDEX idx_invoices_organization_status ON invoices(organization_id, status);
+CREATE INDEX idx_invoices_open_due_date ON invoices(due_date) WHERE status IN ('sent', 'viewed');
+
+COMMENT ON TABLE invoices IS 'invoices an organization bills its customers with; numbered per organization and never deleted, only voided';
+COMMENT ON COLUMN invoices.number IS 'sequential per organization, from invoice_sequences';
+COMMENT ON COLUMN invoices.currency IS 'fiat currency or asset symbol every amount of the invoice is in';
+COMMENT ON COLUMN invoices.viewed_at IS 'first time the customer opened the invoice';
+
+CREATE TABLE invoice_line_items (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  description TEXT NOT NULL,
+  quantity NUMERIC(38,6) NOT NULL CHECK (quantity > 0),
+  unit_price NUMERIC(78,18) NOT NULL CHECK (unit_price >= 0),
+  discount_percent NUMERIC(7,4) NOT NULL DEFAULT 0 CHECK (discount_percent >= 0 AND discount_percent <= 100),
+  tax_rate NUMERIC(7,4) NOT NULL DEFAULT 0 CHECK (tax_rate >= 0 AND tax_rate <= 100),
+  amount NUMERIC(78,18) NOT NULL,
+  discount_amount NUMERIC(78,18) NOT NULL,
+  tax_amount NUMERIC(78,18) NOT NULL,
+  total NUMERIC(78,18) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_invoice_line_items_position ON invoice_line_items(invoice_id, position);
+
+COMMENT ON COLUMN invoice_line_items.amount IS 'quantity times unit price, before discount and tax';
+COMMENT ON COLUMN invoice_line_items.tax_rate IS 'percentage applied to the amount after discount';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS invoice_line_items;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequences;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE organizations ADD COLUMN logo_url TEXT;
+
+COMMENT ON COLUMN organizations.logo_url IS 'PNG or JPEG logo printed on invoices';
+
+ALTER TABLE invoices
+  ADD COLUMN payment_asset_id UUID REFERENCES supported_assets(id) ON DELETE RESTRICT,
+  ADD COLUMN payment_address VARCHAR(255);
+
+COMMENT ON COLUMN invoices.payment_asset_id IS 'asset the customer is asked to pay in, printed on the invoice with payment_address';
+COMMENT ON COLUMN invoices.payment_address IS 'wallet the customer pays into';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE invoices
+  DROP COLUMN IF EXISTS payment_address,
+  DROP COLUMN IF EXISTS payment_asset_id;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS logo_url;
@@ -0,0 +1,67 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE recurring_invoices (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'active' CONSTRAINT recurring_invoices_status_check CHECK (status IN ('active', 'paused', 'completed')),
+  frequency VARCHAR(20) NOT NULL CONSTRAINT recurring_invoices_frequency_check CHECK (frequency IN ('weekly', 'biweekly', 'monthly', 'quarterly', 'yearly')),
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  start_date TIMESTAMPTZ NOT NULL,
+  end_date TIMESTAMPTZ,
+  max_occurrences INTEGER CHECK (max_occurrences > 0),
+  occurrences INTEGER NOT NULL DEFAULT 0,
+  next_issue_at TIMESTAMPTZ NOT NULL,
+  last_issued_at TIMESTAMPTZ,
+  days_until_due INTEGER NOT NULL DEFAULT 30 CHECK (days_until_due >= 0),
+  auto_send BOOLEAN NOT NULL DEFAULT false,
+  currency VARCHAR(20) NOT NULL,
+  customer_name VARCHAR(255) NOT NULL,
+  customer_email VARCHAR(255) NOT NULL,
+  customer_address TEXT NOT NULL DEFAULT '',
+  notes TEXT NOT NULL DEFAULT '',
+  payment_asset_id UUID REFERENCES supported_assets(id) ON DELETE RESTRICT,
+  payment_address VARCHAR(255),
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_recurring_invoices_organization ON recurring_invoices(organization_id);
+CREATE INDEX idx_recurring_invoices_next_issue_at ON recurring_invoices(next_issue_at) WHERE status = 'active';
+
+COMMENT ON TABLE recurring_invoices IS 'invoice templates issued on a schedule, such as monthly retainers';
+COMMENT ON COLUMN recurring_invoices.start_date IS 'first issue date; later ones follow from it by frequency in timezone';
+COMMENT ON COLUMN recurring_invoices.end_date IS 'no invoice is issued after this date';
+COMMENT ON COLUMN recurring_invoices.max_occurrences IS 'the template completes once this many invoices have been issued';
+COMMENT ON COLUMN recurring_invoices.next_issue_at IS 'issue date of the next invoice to generate';
+COMMENT ON COLUMN recurring_invoices.days_until_due IS 'each invoice is due this many days after its issue date';
+COMMENT ON COLUMN recurring_invoices.auto_send IS 'generated invoices are sent to the customer straight away instead of staying drafts';
+
+CREATE TABLE recurring_invoice_line_items (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  recurring_invoice_id UUID NOT NULL REFERENCES recurring_invoices(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  description TEXT NOT NULL,
+  quantity NUMERIC(38,6) NOT NULL CHECK (quantity > 0),
+  unit_price NUMERIC(78,18) NOT NULL CHECK (unit_price >= 0),
+  discount_percent NUMERIC(7,4) NOT NULL DEFAULT 0 CHECK (discount_percent >= 0 AND discount_percent <= 100),
+  tax_rate NUMERIC(7,4) NOT NULL DEFAULT 0 CHECK (tax_rate >= 0 AND tax_rate <= 100),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_recurring_invoice_line_items_position ON recurring_invoice_line_items(recurring_invoice_id, position);
+
+ALTER TABLE invoices ADD COLUMN recurring_invoice_id UUID REFERENCES recurring_invoices(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX idx_invoices_recurring_issue_date ON invoices(recurring_invoice_id, issue_date) WHERE recurring_invoice_id IS NOT NULL;
+
+COMMENT ON COLUMN invoices.recurring_invoice_id IS 'template the invoice was generated from; at most one invoice per template and issue date';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_invoices_recurring_issue_date;
+ALTER TABLE invoices DROP COLUMN IF EXISTS recurring_invoice_id;
+DROP TABLE IF EXISTS recurring_invoice_line_items;
+DROP TABLE IF EXISTS recurring_invoices;
@@ -0,0 +1,72 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE organization_deposit_keys (
+  organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+  extended_public_key VARCHAR(255) NOT NULL,
+  next_index BIGINT NOT NULL DEFAULT 0 CHECK (next_index >= 0 AND next_index < 2147483648),
+  tolerance_bps INTEGER NOT NULL DEFAULT 0 CHECK (tolerance_bps >= 0 AND tolerance_bps <= 1000),
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_organization_deposit_keys_key ON organization_deposit_keys(extended_public_key);
+
+COMMENT ON TABLE organization_deposit_keys IS 'account-level xpub every invoice deposit address of an organization is derived from; no private key is stored';
+COMMENT ON COLUMN organization_deposit_keys.next_index IS 'next unused receiving address index, handed out one per invoice';
+COMMENT ON COLUMN organization_deposit_keys.tolerance_bps IS 'underpayment, in basis points of the total, still accepted as paid to allow for fees deducted in transit';
+
+ALTER TABLE invoices DROP CONSTRAINT invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'sent', 'viewed', 'partially_paid', 'paid', 'overdue', 'void'));
+
+ALTER TABLE invoices
+  ADD COLUMN deposit_index BIGINT,
+  ADD COLUMN amount_paid NUMERIC(78,18) NOT NULL DEFAULT 0;
+
+CREATE UNIQUE INDEX idx_invoices_deposit_address ON invoices(payment_address) WHERE deposit_index IS NOT NULL;
+CREATE INDEX idx_invoices_awaiting_deposit ON invoices(status) WHERE deposit_index IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_invoices_open_due_date;
+CREATE INDEX idx_invoices_open_due_date ON invoices(due_date) WHERE status IN ('sent', 'viewed', 'partially_paid');
+
+COMMENT ON COLUMN invoices.deposit_index IS 'receiving address index payment_address was derived at from the organization deposit key; null for an address entered by hand';
+COMMENT ON COLUMN invoices.amount_paid IS 'sum of the matched on-chain payments, in the invoice currency';
+
+CREATE TABLE invoice_payments (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+  tx_hash VARCHAR(66) NOT NULL,
+  log_index INTEGER NOT NULL,
+  token_address VARCHAR(42) NOT NULL,
+  from_address VARCHAR(42) NOT NULL,
+  to_address VARCHAR(42) NOT NULL,
+  amount NUMERIC(78,18) NOT NULL CHECK (amount > 0),
+  block_number BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_invoice_payments_transfer ON invoice_payments(tx_hash, log_index);
+CREATE INDEX idx_invoice_payments_invoice ON invoice_payments(invoice_id);
+
+COMMENT ON TABLE invoice_payments IS 'token transfers into an invoice deposit address; each transfer counts once';
+COMMENT ON COLUMN invoice_payments.amount IS 'transferred amount in the invoice currency';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS invoice_payments;
+
+DROP INDEX IF EXISTS idx_invoices_open_due_date;
+CREATE INDEX idx_invoices_open_due_date ON invoices(due_date) WHERE status IN ('sent', 'viewed');
+
+DROP INDEX IF EXISTS idx_invoices_awaiting_deposit;
+DROP INDEX IF EXISTS idx_invoices_deposit_address;
+
+ALTER TABLE invoices
+  DROP COLUMN IF EXISTS amount_paid,
+  DROP COLUMN IF EXISTS deposit_index;
+
+UPDATE invoices SET status = 'sent' WHERE status = 'partially_paid';
+ALTER TABLE invoices DROP CONSTRAINT invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'sent', 'viewed', 'paid', 'overdue', 'void'));
+
+DROP TABLE IF EXISTS organization_deposit_keys;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE invoice_share_links (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+  token_hash VARCHAR(64) NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  revoked_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_invoice_share_links_token_hash ON invoice_share_links(token_hash);
+CREATE INDEX idx_invoice_share_links_invoice ON invoice_share_links(invoice_id);
+
+COMMENT ON TABLE invoice_share_links IS 'public read-only links to an invoice for customers without an account; only a hash of the signed token is stored';
+COMMENT ON COLUMN invoice_share_links.view_count IS 'times the invoice was opened through the link';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS invoice_share_links;
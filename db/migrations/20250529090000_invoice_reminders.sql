@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE invoice_reminder_settings (
+  organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+  enabled BOOLEAN NOT NULL DEFAULT false,
+  offset_days INTEGER[] NOT NULL,
+  updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE invoice_reminder_settings IS 'when an organization''s customers are reminded of invoices awaiting payment';
+COMMENT ON COLUMN invoice_reminder_settings.offset_days IS 'days relative to the due date a reminder is sent on; negative before it, positive after';
+
+CREATE TABLE invoice_reminders (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+  offset_days INTEGER NOT NULL,
+  recipient VARCHAR(255) NOT NULL,
+  sent_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_invoice_reminders_step ON invoice_reminders(invoice_id, offset_days);
+
+COMMENT ON TABLE invoice_reminders IS 'reminders sent for an invoice; a row is written before the email is queued so a step is never sent twice';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS invoice_reminders;
+DROP TABLE IF EXISTS invoice_reminder_settings;
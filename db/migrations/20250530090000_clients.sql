@@ -0,0 +1,46 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE clients (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  cc_emails TEXT[] NOT NULL DEFAULT '{}',
+  tax_id VARCHAR(64) NOT NULL DEFAULT '',
+  address_line1 VARCHAR(255) NOT NULL DEFAULT '',
+  address_line2 VARCHAR(255) NOT NULL DEFAULT '',
+  city VARCHAR(100) NOT NULL DEFAULT '',
+  region VARCHAR(100) NOT NULL DEFAULT '',
+  postal_code VARCHAR(20) NOT NULL DEFAULT '',
+  country VARCHAR(2) NOT NULL DEFAULT '',
+  preferred_currency VARCHAR(20) NOT NULL DEFAULT '',
+  preferred_asset_id UUID REFERENCES supported_assets(id) ON DELETE SET NULL,
+  payment_terms_days INTEGER NOT NULL DEFAULT 30 CHECK (payment_terms_days >= 0 AND payment_terms_days <= 365),
+  notes TEXT NOT NULL DEFAULT '',
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_clients_organization_email ON clients(organization_id, email);
+CREATE UNIQUE INDEX idx_clients_organization_tax_id ON clients(organization_id, tax_id) WHERE tax_id <> '';
+CREATE INDEX idx_clients_organization_name ON clients(organization_id, lower(name));
+
+COMMENT ON TABLE clients IS 'the customers an organization bills; one per billing email and per tax ID';
+COMMENT ON COLUMN clients.email IS 'lowercased billing email invoices are sent to';
+COMMENT ON COLUMN clients.tax_id IS 'uppercased without spaces or punctuation, so the same ID written differently is one client';
+COMMENT ON COLUMN clients.country IS 'ISO 3166-1 alpha-2 code of the billing address';
+COMMENT ON COLUMN clients.payment_terms_days IS 'days from issue to due date on invoices that do not set one';
+
+ALTER TABLE invoices
+  ADD COLUMN client_id UUID REFERENCES clients(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_invoices_client ON invoices(client_id) WHERE client_id IS NOT NULL;
+
+COMMENT ON COLUMN invoices.client_id IS 'directory entry the customer details were taken from; the invoice keeps its own copy of them';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_invoices_client;
+ALTER TABLE invoices DROP COLUMN IF EXISTS client_id;
+DROP TABLE IF EXISTS clients;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE credit_note_sequences (
+  organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+  last_number BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE credit_note_sequences IS 'last credit note number handed out per organization, numbered apart from invoices and without gaps';
+
+CREATE TABLE credit_notes (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE RESTRICT,
+  number BIGINT NOT NULL,
+  currency VARCHAR(20) NOT NULL,
+  amount NUMERIC(78,18) NOT NULL CHECK (amount > 0),
+  tax_amount NUMERIC(78,18) NOT NULL DEFAULT 0 CHECK (tax_amount >= 0 AND tax_amount <= amount),
+  reason TEXT NOT NULL,
+  ledger_entry_id UUID NOT NULL REFERENCES ledger_entries(id) ON DELETE RESTRICT,
+  issued_at TIMESTAMPTZ NOT NULL,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_credit_notes_organization_number ON credit_notes(organization_id, number);
+CREATE INDEX idx_credit_notes_invoice ON credit_notes(invoice_id);
+
+COMMENT ON TABLE credit_notes IS 'amounts taken off the balance of an issued invoice; never edited or deleted';
+COMMENT ON COLUMN credit_notes.amount IS 'amount credited including tax, in the invoice currency';
+COMMENT ON COLUMN credit_notes.tax_amount IS 'part of amount that reverses tax charged on the invoice';
+COMMENT ON COLUMN credit_notes.ledger_entry_id IS 'reversing entry posted with the credit note in the same transaction';
+
+ALTER TABLE invoices DROP CONSTRAINT invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'sent', 'viewed', 'partially_paid', 'paid', 'overdue', 'credited', 'void'));
+
+ALTER TABLE invoices
+  ADD COLUMN amount_credited NUMERIC(78,18) NOT NULL DEFAULT 0 CHECK (amount_credited >= 0);
+
+COMMENT ON COLUMN invoices.amount_credited IS 'sum of the credit notes issued against the invoice, in the invoice currency';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE invoices DROP COLUMN IF EXISTS amount_credited;
+
+UPDATE invoices SET status = 'void', voided_at = COALESCE(voided_at, now()), void_reason = COALESCE(void_reason, 'credited') WHERE status = 'credited';
+ALTER TABLE invoices DROP CONSTRAINT invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'sent', 'viewed', 'partially_paid', 'paid', 'overdue', 'void'));
+
+DROP TABLE IF EXISTS credit_notes;
+DROP TABLE IF EXISTS credit_note_sequences;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE organizations
+  ADD COLUMN tax_id VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN organizations.tax_id IS 'VAT or sales tax registration number, upper case without spaces or punctuation; empty if not registered';
+
+ALTER TABLE invoices
+  ADD COLUMN customer_country VARCHAR(2) NOT NULL DEFAULT '',
+  ADD COLUMN customer_tax_id VARCHAR(64) NOT NULL DEFAULT '',
+  ADD COLUMN automatic_tax BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN tax_treatment VARCHAR(20) NOT NULL DEFAULT '' CHECK (tax_treatment IN ('', 'standard', 'reverse_charge', 'outside_scope', 'not_registered')),
+  ADD COLUMN tax_name VARCHAR(20) NOT NULL DEFAULT '',
+  ADD COLUMN tax_note TEXT NOT NULL DEFAULT '',
+  ADD COLUMN tax_rates_version VARCHAR(50) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN invoices.automatic_tax IS 'line tax rates are set by the tax engine rather than entered by hand';
+COMMENT ON COLUMN invoices.tax_treatment IS 'how the tax engine taxed the invoice; empty for hand-entered rates';
+COMMENT ON COLUMN invoices.tax_rates_version IS 'version of the tax rate table the rates came from';
+
+ALTER TABLE employee_compensations
+  ADD COLUMN tax_country VARCHAR(2) NOT NULL DEFAULT '',
+  ADD COLUMN tax_id VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN employee_compensations.tax_country IS 'country the payee is taxed in, for the tax breakdown on payslips; empty to leave tax out';
+COMMENT ON COLUMN employee_compensations.tax_id IS 'VAT registration number of a contractor who charges tax on their pay';
+
+ALTER TABLE pay_run_line_items
+  ADD COLUMN tax_country VARCHAR(2) NOT NULL DEFAULT '',
+  ADD COLUMN tax_id VARCHAR(64) NOT NULL DEFAULT '';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE pay_run_line_items
+  DROP COLUMN IF EXISTS tax_id,
+  DROP COLUMN IF EXISTS tax_country;
+
+ALTER TABLE employee_compensations
+  DROP COLUMN IF EXISTS tax_id,
+  DROP COLUMN IF EXISTS tax_country;
+
+ALTER TABLE invoices
+  DROP COLUMN IF EXISTS tax_rates_version,
+  DROP COLUMN IF EXISTS tax_note,
+  DROP COLUMN IF EXISTS tax_name,
+  DROP COLUMN IF EXISTS tax_treatment,
+  DROP COLUMN IF EXISTS automatic_tax,
+  DROP COLUMN IF EXISTS customer_tax_id,
+  DROP COLUMN IF EXISTS customer_country;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS tax_id;
@@ -0,0 +1,67 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE contractor_contracts (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  contractor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  client_id UUID REFERENCES clients(id) ON DELETE SET NULL,
+  project VARCHAR(255) NOT NULL,
+  hourly_rate NUMERIC(36, 18) NOT NULL CHECK (hourly_rate > 0),
+  currency VARCHAR(20) NOT NULL,
+  active BOOLEAN NOT NULL DEFAULT true,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_contractor_contracts_active_project
+  ON contractor_contracts(organization_id, contractor_id, lower(project)) WHERE active;
+CREATE INDEX idx_contractor_contracts_contractor ON contractor_contracts(contractor_id);
+
+COMMENT ON TABLE contractor_contracts IS 'hourly work a contractor does for the organization on a client project';
+COMMENT ON COLUMN contractor_contracts.client_id IS 'client the approved hours are invoiced to';
+COMMENT ON COLUMN contractor_contracts.hourly_rate IS 'what the client is billed per approved hour, in currency';
+
+CREATE TABLE timesheets (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  contract_id UUID NOT NULL REFERENCES contractor_contracts(id) ON DELETE CASCADE,
+  contractor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  week_start TIMESTAMPTZ NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'submitted', 'approved', 'rejected', 'invoiced')),
+  total_hours NUMERIC(7, 2) NOT NULL DEFAULT 0,
+  submitted_at TIMESTAMPTZ,
+  reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  reviewed_at TIMESTAMPTZ,
+  rejection_reason TEXT NOT NULL DEFAULT '',
+  invoice_id UUID REFERENCES invoices(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_timesheets_contract_week ON timesheets(contract_id, week_start);
+CREATE INDEX idx_timesheets_organization_status ON timesheets(organization_id, status, week_start);
+CREATE INDEX idx_timesheets_invoice ON timesheets(invoice_id) WHERE invoice_id IS NOT NULL;
+
+COMMENT ON TABLE timesheets IS 'one contract''s hours for one week, submitted by the contractor and approved by the organization';
+COMMENT ON COLUMN timesheets.week_start IS 'midnight UTC on the Monday the week starts';
+COMMENT ON COLUMN timesheets.total_hours IS 'sum of the entries'' hours, kept with the entries';
+COMMENT ON COLUMN timesheets.invoice_id IS 'invoice the approved hours were billed on; cleared if that invoice is voided';
+
+CREATE TABLE timesheet_entries (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  timesheet_id UUID NOT NULL REFERENCES timesheets(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  work_date TIMESTAMPTZ NOT NULL,
+  hours NUMERIC(5, 2) NOT NULL CHECK (hours > 0 AND hours <= 24),
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_timesheet_entries_timesheet ON timesheet_entries(timesheet_id, position);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS timesheet_entries;
+DROP TABLE IF EXISTS timesheets;
+DROP TABLE IF EXISTS contractor_contracts;
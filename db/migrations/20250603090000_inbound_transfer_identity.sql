@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- A log's index is its position in the block, so it changes when a reorg
+-- re-mines the transaction in another block. Inbound transfers are identified
+-- instead by what survives a reorg: the transaction, the token, the recipient
+-- and the transfer's position among the transaction's transfers of that token
+-- to that recipient.
+ALTER TABLE transactions ADD COLUMN transfer_ordinal INTEGER;
+
+UPDATE transactions t
+SET transfer_ordinal = ranked.ordinal
+FROM (
+  SELECT
+    id,
+    ROW_NUMBER() OVER (PARTITION BY tx_hash, token_address, to_address ORDER BY log_index) - 1 AS ordinal
+  FROM transactions
+  WHERE direction = 'inbound'
+) ranked
+WHERE t.id = ranked.id;
+
+DROP INDEX IF EXISTS idx_transactions_tx_hash_log_index;
+CREATE UNIQUE INDEX idx_transactions_inbound_transfer
+  ON transactions(tx_hash, token_address, to_address, transfer_ordinal)
+  WHERE direction = 'inbound';
+
+COMMENT ON COLUMN transactions.log_index IS 'position of the Transfer log in its block, inbound transfers only; changes when a reorg re-mines the transaction';
+COMMENT ON COLUMN transactions.transfer_ordinal IS 'position among the transaction''s transfers of the same token to the same recipient, inbound transfers only; stable across reorgs';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_transactions_inbound_transfer;
+CREATE UNIQUE INDEX idx_transactions_tx_hash_log_index ON transactions(tx_hash, log_index) WHERE log_index IS NOT NULL;
+
+COMMENT ON COLUMN transactions.log_index IS 'position of the Transfer log in its block, inbound transfers only';
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_ordinal;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- User-initiated transactions now record the transfer the user declared,
+-- validated against the asset registry, so the amount is no longer inbound only.
+COMMENT ON COLUMN transactions.amount IS 'transfer amount in token base units; declared by the user for outbound transactions';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+COMMENT ON COLUMN transactions.amount IS 'transfer amount in token base units, inbound transfers only';
@@ -0,0 +1,83 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Company details live on the organization a business user sets up. The
+-- organizations migration only built one for business users with a company
+-- name, so any other user with a company name who has not set one up since
+-- becomes the owner of an organization built from their details.
+WITH created AS (
+  INSERT INTO organizations (name, address, city, postal_code, country, website, created_by, created_at, updated_at)
+  SELECT
+    u.company_name,
+    COALESCE(u.company_address, ''),
+    COALESCE(u.company_city, ''),
+    COALESCE(u.company_postal_code, ''),
+    COALESCE(u.company_country, ''),
+    NULLIF(u.company_website, ''),
+    u.id,
+    u.created_at,
+    u.updated_at
+  FROM users u
+  WHERE COALESCE(u.company_name, '') <> ''
+    AND NOT EXISTS (SELECT 1 FROM organizations o WHERE o.created_by = u.id)
+  RETURNING id, created_by
+)
+INSERT INTO organization_members (organization_id, user_id, role, created_at, updated_at)
+SELECT id, created_by, 'owner', now(), now()
+FROM created;
+
+-- The details as they were on users are kept, including those without a
+-- company name that no organization could be built from, so rolling back
+-- restores them exactly
+CREATE TABLE user_company_details (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  company_name VARCHAR(255),
+  company_address VARCHAR(255),
+  company_city VARCHAR(255),
+  company_postal_code VARCHAR(255),
+  company_country VARCHAR(255),
+  company_website VARCHAR(255)
+);
+
+COMMENT ON TABLE user_company_details IS 'company details users entered before organizations held them; kept to roll back the drop of the users columns';
+
+INSERT INTO user_company_details (user_id, company_name, company_address, company_city, company_postal_code, company_country, company_website)
+SELECT id, company_name, company_address, company_city, company_postal_code, company_country, company_website
+FROM users
+WHERE COALESCE(company_name, '') <> ''
+  OR COALESCE(company_address, '') <> ''
+  OR COALESCE(company_city, '') <> ''
+  OR COALESCE(company_postal_code, '') <> ''
+  OR COALESCE(company_country, '') <> ''
+  OR COALESCE(company_website, '') <> '';
+
+ALTER TABLE users
+  DROP COLUMN IF EXISTS company_name,
+  DROP COLUMN IF EXISTS company_address,
+  DROP COLUMN IF EXISTS company_city,
+  DROP COLUMN IF EXISTS company_postal_code,
+  DROP COLUMN IF EXISTS company_country,
+  DROP COLUMN IF EXISTS company_website;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE users
+  ADD COLUMN company_name VARCHAR(255) DEFAULT '',
+  ADD COLUMN company_address VARCHAR(255) DEFAULT '',
+  ADD COLUMN company_city VARCHAR(255) DEFAULT '',
+  ADD COLUMN company_postal_code VARCHAR(255) DEFAULT '',
+  ADD COLUMN company_country VARCHAR(255) DEFAULT '',
+  ADD COLUMN company_website VARCHAR(255);
+
+UPDATE users u
+SET
+  company_name = d.company_name,
+  company_address = d.company_address,
+  company_city = d.company_city,
+  company_postal_code = d.company_postal_code,
+  company_country = d.company_country,
+  company_website = d.company_website
+FROM user_company_details d
+WHERE d.user_id = u.id;
+
+DROP TABLE IF EXISTS user_company_details;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE invoice_reminder_failures (
+  invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+  offset_days INTEGER NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 1 CHECK (attempts > 0),
+  failed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (invoice_id, offset_days)
+);
+
+COMMENT ON TABLE invoice_reminder_failures IS 'reminder steps whose email could not be queued; retried with backoff and given up after too many attempts';
+COMMENT ON COLUMN invoice_reminder_failures.failed_at IS 'when the latest attempt failed';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS invoice_reminder_failures;
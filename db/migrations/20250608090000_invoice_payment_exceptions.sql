@@ -0,0 +1,57 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Like inbound transactions, invoice payments are identified by what survives
+-- a reorg: the transaction, the token, the deposit address and the transfer's
+-- position among the transaction's transfers of that token to that address.
+ALTER TABLE invoice_payments ADD COLUMN transfer_ordinal INTEGER;
+
+UPDATE invoice_payments p
+SET transfer_ordinal = ranked.ordinal
+FROM (
+  SELECT
+    id,
+    ROW_NUMBER() OVER (PARTITION BY tx_hash, token_address, to_address ORDER BY log_index) - 1 AS ordinal
+  FROM invoice_payments
+) ranked
+WHERE p.id = ranked.id;
+
+ALTER TABLE invoice_payments ALTER COLUMN transfer_ordinal SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_invoice_payments_transfer;
+CREATE UNIQUE INDEX idx_invoice_payments_transfer
+  ON invoice_payments(tx_hash, token_address, to_address, transfer_ordinal);
+
+-- Transfers into the address of a voided invoice are kept so they can be
+-- refunded, but do not count towards it
+ALTER TABLE invoice_payments ADD COLUMN applied BOOLEAN NOT NULL DEFAULT true;
+
+COMMENT ON COLUMN invoice_payments.log_index IS 'position of the Transfer log in its block; changes when a reorg re-mines the transaction';
+COMMENT ON COLUMN invoice_payments.transfer_ordinal IS 'position among the transaction''s transfers of the same token to the same address; stable across reorgs';
+COMMENT ON COLUMN invoice_payments.applied IS 'false for a transfer received after the invoice was voided, which is not counted in amount_paid';
+
+ALTER TABLE invoices ADD COLUMN payment_exception VARCHAR(20)
+  CHECK (payment_exception IN ('overpaid', 'paid_after_void'));
+
+UPDATE invoices
+SET payment_exception = 'overpaid'
+WHERE amount_paid > total - amount_credited;
+
+CREATE INDEX idx_invoices_payment_exception ON invoices(organization_id, payment_exception) WHERE payment_exception IS NOT NULL;
+
+COMMENT ON COLUMN invoices.payment_exception IS 'overpaid, or paid_after_void when a transfer arrived after the invoice was voided; needs a refund or follow-up';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_invoices_payment_exception;
+ALTER TABLE invoices DROP COLUMN IF EXISTS payment_exception;
+
+DELETE FROM invoice_payments WHERE NOT applied;
+ALTER TABLE invoice_payments DROP COLUMN IF EXISTS applied;
+
+DROP INDEX IF EXISTS idx_invoice_payments_transfer;
+CREATE UNIQUE INDEX idx_invoice_payments_transfer ON invoice_payments(tx_hash, log_index);
+
+COMMENT ON COLUMN invoice_payments.log_index IS NULL;
+
+ALTER TABLE invoice_payments DROP COLUMN IF EXISTS transfer_ordinal;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- A pay run's payouts are converted into their payout assets with a locked
+-- quote when it is submitted, so approvers sign off on the amounts that are paid
+ALTER TABLE pay_run_line_items
+  ADD COLUMN fx_quote_id UUID REFERENCES fx_quotes(id) ON DELETE RESTRICT,
+  ADD COLUMN locked_payout NUMERIC(78,18) CHECK (locked_payout >= 0);
+
+COMMENT ON COLUMN pay_run_line_items.fx_quote_id IS 'quote the amount was converted into the payout asset with; empty when no conversion was needed';
+COMMENT ON COLUMN pay_run_line_items.locked_payout IS 'amount of the payout asset paid, locked when the pay run was submitted';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE pay_run_line_items
+  DROP COLUMN IF EXISTS locked_payout,
+  DROP COLUMN IF EXISTS fx_quote_id;
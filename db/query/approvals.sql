@@ -0,0 +1,131 @@
+-- name: CreateApprovalPolicy :one
+INSERT INTO approval_policies (
+  id,
+  organization_id,
+  name,
+  currency,
+  min_total_amount,
+  min_payout_amount,
+  required_approvals,
+  approver_ids,
+  approval_window_hours,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now(), now()
+) RETURNING *;
+
+-- name: GetApprovalPolicyByID :one
+SELECT * FROM approval_policies
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListApprovalPoliciesByOrganization :many
+SELECT * FROM approval_policies
+WHERE organization_id = $1
+ORDER BY active DESC, required_approvals DESC, created_at;
+
+-- name: UpdateApprovalPolicy :one
+UPDATE approval_policies
+SET
+  name = $2,
+  currency = $3,
+  min_total_amount = $4,
+  min_payout_amount = $5,
+  required_approvals = $6,
+  approver_ids = $7,
+  approval_window_hours = $8,
+  active = $9,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateApprovalRequest :one
+INSERT INTO approval_requests (
+  id,
+  organization_id,
+  pay_run_id,
+  policy_id,
+  required_approvals,
+  approver_ids,
+  status,
+  requested_by,
+  expires_at,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, 'pending', $7, $8, now(), now()
+) RETURNING *;
+
+-- name: GetApprovalRequestByID :one
+SELECT * FROM approval_requests
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetApprovalRequestForUpdate :one
+-- Locks a request while a decision on it is recorded
+SELECT * FROM approval_requests
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListApprovalRequestsByOrganization :many
+-- Lists an organization's approval requests, optionally in one status
+SELECT * FROM approval_requests
+WHERE organization_id = @organization_id
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at DESC
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountApprovalRequestsByOrganization :one
+SELECT COUNT(*) FROM approval_requests
+WHERE organization_id = @organization_id
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
+
+-- name: ResolveApprovalRequest :one
+-- Closes a pending request with its outcome
+UPDATE approval_requests
+SET
+  status = $2,
+  decided_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: ExpireApprovalRequests :many
+-- Expires pending requests whose approval window has passed
+UPDATE approval_requests
+SET
+  status = 'expired',
+  decided_at = now(),
+  updated_at = now()
+WHERE status = 'pending' AND expires_at <= $1
+RETURNING *;
+
+-- name: CreateApprovalDecision :one
+-- Records an approver's decision unless they already decided, in which case
+-- no row is returned
+INSERT INTO approval_decisions (
+  id,
+  request_id,
+  approver_id,
+  decision,
+  comment,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+)
+ON CONFLICT (request_id, approver_id) DO NOTHING
+RETURNING *;
+
+-- name: ListApprovalDecisions :many
+SELECT sqlc.embed(d), u.email, u.first_name, u.last_name
+FROM approval_decisions d
+JOIN users u ON u.id = d.approver_id
+WHERE d.request_id = $1
+ORDER BY d.created_at;
+
+-- name: CountApprovalDecisions :one
+SELECT COUNT(*) FROM approval_decisions
+WHERE request_id = $1 AND decision = $2;
@@ -0,0 +1,92 @@
+-- name: CreateClient :one
+-- Adds a client to the directory; no row is returned if the organization
+-- already has a client with the same email or tax ID
+INSERT INTO clients (
+  id,
+  organization_id,
+  name,
+  email,
+  cc_emails,
+  tax_id,
+  address_line1,
+  address_line2,
+  city,
+  region,
+  postal_code,
+  country,
+  preferred_currency,
+  preferred_asset_id,
+  payment_terms_days,
+  notes,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, now(), now()
+)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: GetClient :one
+SELECT * FROM clients
+WHERE organization_id = @organization_id AND id = @id
+LIMIT 1;
+
+-- name: FindDuplicateClient :one
+-- Finds another of the organization's clients with the same email or tax ID
+SELECT * FROM clients
+WHERE organization_id = @organization_id
+  AND id <> @exclude_id
+  AND (email = @email OR (@tax_id::text <> '' AND tax_id = @tax_id::text))
+ORDER BY created_at
+LIMIT 1;
+
+-- name: ListClients :many
+-- Lists an organization's clients by name, optionally only those whose name,
+-- email or tax ID contains the search text
+SELECT * FROM clients
+WHERE organization_id = @organization_id
+  AND (
+    sqlc.narg(search)::text IS NULL
+    OR position(lower(sqlc.narg(search)::text) IN lower(name)) > 0
+    OR position(lower(sqlc.narg(search)::text) IN email) > 0
+    OR position(upper(sqlc.narg(search)::text) IN tax_id) > 0
+  )
+ORDER BY lower(name), created_at
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountClients :one
+SELECT COUNT(*) FROM clients
+WHERE organization_id = @organization_id
+  AND (
+    sqlc.narg(search)::text IS NULL
+    OR position(lower(sqlc.narg(search)::text) IN lower(name)) > 0
+    OR position(lower(sqlc.narg(search)::text) IN email) > 0
+    OR position(upper(sqlc.narg(search)::text) IN tax_id) > 0
+  );
+
+-- name: UpdateClient :one
+UPDATE clients
+SET
+  name = $3,
+  email = $4,
+  cc_emails = $5,
+  tax_id = $6,
+  address_line1 = $7,
+  address_line2 = $8,
+  city = $9,
+  region = $10,
+  postal_code = $11,
+  country = $12,
+  preferred_currency = $13,
+  preferred_asset_id = $14,
+  payment_terms_days = $15,
+  notes = $16,
+  updated_at = now()
+WHERE organization_id = $1 AND id = $2
+RETURNING *;
+
+-- name: DeleteClient :execrows
+-- Removes a client from the directory; its invoices keep their copy of its details
+DELETE FROM clients
+WHERE organization_id = @organization_id AND id = @id;
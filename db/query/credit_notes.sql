@@ -0,0 +1,60 @@
+-- name: NextCreditNoteNumber :one
+-- Hands out the organization's next credit note number. Like invoice numbers,
+-- the sequence row stays locked until the calling transaction ends.
+INSERT INTO credit_note_sequences (organization_id, last_number, updated_at)
+VALUES (@organization_id, 1, now())
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  last_number = credit_note_sequences.last_number + 1,
+  updated_at = now()
+RETURNING last_number;
+
+-- name: CreateCreditNote :one
+INSERT INTO credit_notes (
+  id,
+  organization_id,
+  invoice_id,
+  number,
+  currency,
+  amount,
+  tax_amount,
+  reason,
+  ledger_entry_id,
+  issued_at,
+  created_by,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+) RETURNING *;
+
+-- name: GetCreditNote :one
+SELECT * FROM credit_notes
+WHERE organization_id = @organization_id AND id = @id
+LIMIT 1;
+
+-- name: ListCreditNotesByInvoice :many
+SELECT * FROM credit_notes
+WHERE invoice_id = $1
+ORDER BY number;
+
+-- name: ListCreditNotesByOrganization :many
+-- Lists an organization's credit notes, latest number first
+SELECT * FROM credit_notes
+WHERE organization_id = @organization_id
+ORDER BY number DESC
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountCreditNotesByOrganization :one
+SELECT COUNT(*) FROM credit_notes
+WHERE organization_id = @organization_id;
+
+-- name: ApplyInvoiceCredit :one
+-- Adds a credit note to what has been credited on an invoice, moving it to
+-- the status the remaining balance puts it in
+UPDATE invoices
+SET
+  amount_credited = amount_credited + @amount,
+  status = @status,
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
@@ -0,0 +1,56 @@
+-- name: CreateFXRate :one
+-- Records a fetched exchange rate
+INSERT INTO fx_rates (
+  id,
+  base_currency,
+  quote_currency,
+  rate,
+  source,
+  fetched_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+) RETURNING *;
+
+-- name: GetLatestFXRate :one
+-- Retrieves the most recently fetched rate for a currency pair
+SELECT * FROM fx_rates
+WHERE base_currency = $1 AND quote_currency = $2
+ORDER BY fetched_at DESC
+LIMIT 1;
+
+-- name: ListLatestFXRates :many
+-- Lists the most recently fetched rate of every pair with the given base
+SELECT DISTINCT ON (quote_currency) * FROM fx_rates
+WHERE base_currency = $1
+ORDER BY quote_currency, fetched_at DESC;
+
+-- name: ListFXRateHistory :many
+-- Lists the rates of a pair fetched in a time range, newest first
+SELECT * FROM fx_rates
+WHERE base_currency = @base_currency
+  AND quote_currency = @quote_currency
+  AND fetched_at >= @fetched_from
+  AND fetched_at < @fetched_to
+ORDER BY fetched_at DESC
+LIMIT @row_limit;
+
+-- name: CreateFXQuote :one
+-- Locks an exchange rate until expires_at
+INSERT INTO fx_quotes (
+  id,
+  base_currency,
+  quote_currency,
+  rate,
+  source,
+  expires_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+) RETURNING *;
+
+-- name: GetFXQuoteByID :one
+-- Retrieves a locked quote by ID
+SELECT * FROM fx_quotes
+WHERE id = $1
+LIMIT 1;
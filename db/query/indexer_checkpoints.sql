@@ -0,0 +1,22 @@
+-- name: GetIndexerCheckpoint :one
+-- Retrieves the last processed block of an indexer
+SELECT * FROM indexer_checkpoints
+WHERE name = $1
+LIMIT 1;
+
+-- name: UpsertIndexerCheckpoint :one
+-- Records the last processed block of an indexer
+INSERT INTO indexer_checkpoints (
+  name,
+  block_number,
+  block_hash,
+  updated_at
+) VALUES (
+  $1, $2, $3, now()
+)
+ON CONFLICT (name) DO UPDATE
+SET
+  block_number = EXCLUDED.block_number,
+  block_hash = EXCLUDED.block_hash,
+  updated_at = now()
+RETURNING *;
@@ -0,0 +1,76 @@
+-- name: GetOrganizationDepositKey :one
+SELECT * FROM organization_deposit_keys
+WHERE organization_id = $1
+LIMIT 1;
+
+-- name: UpsertOrganizationDepositKey :one
+-- Sets an organization's deposit key. A different key starts again at the
+-- first receiving address; saving the same key only changes the tolerance.
+INSERT INTO organization_deposit_keys (
+  organization_id,
+  extended_public_key,
+  next_index,
+  tolerance_bps,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  @organization_id, @extended_public_key, 0, @tolerance_bps, @created_by, now(), now()
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  extended_public_key = EXCLUDED.extended_public_key,
+  next_index = CASE
+    WHEN organization_deposit_keys.extended_public_key = EXCLUDED.extended_public_key THEN organization_deposit_keys.next_index
+    ELSE 0
+  END,
+  tolerance_bps = EXCLUDED.tolerance_bps,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteOrganizationDepositKey :exec
+DELETE FROM organization_deposit_keys
+WHERE organization_id = $1;
+
+-- name: AllocateDepositIndex :one
+-- Hands out the organization's next receiving address index together with the
+-- key to derive it from
+UPDATE organization_deposit_keys
+SET
+  next_index = next_index + 1,
+  updated_at = now()
+WHERE organization_id = $1
+RETURNING extended_public_key, (next_index - 1)::bigint AS deposit_index;
+
+-- name: CreateInvoicePayment :one
+-- Records a transfer into an invoice deposit address; a transfer that was
+-- already recorded, even in another block after a reorg re-mined its
+-- transaction, returns no row
+INSERT INTO invoice_payments (
+  id,
+  invoice_id,
+  tx_hash,
+  log_index,
+  transfer_ordinal,
+  token_address,
+  from_address,
+  to_address,
+  amount,
+  block_number,
+  applied,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+)
+ON CONFLICT (tx_hash, token_address, to_address, transfer_ordinal) DO NOTHING
+RETURNING *;
+
+-- name: ListInvoicePayments :many
+SELECT * FROM invoice_payments
+WHERE invoice_id = $1
+ORDER BY block_number, log_index;
+
+-- name: SumInvoicePayments :one
+SELECT COALESCE(SUM(amount), 0)::numeric AS total
+FROM invoice_payments
+WHERE invoice_id = $1 AND applied;
@@ -0,0 +1,106 @@
+-- name: GetInvoiceReminderSettings :one
+SELECT * FROM invoice_reminder_settings
+WHERE organization_id = $1
+LIMIT 1;
+
+-- name: UpsertInvoiceReminderSettings :one
+INSERT INTO invoice_reminder_settings (
+  organization_id,
+  enabled,
+  offset_days,
+  updated_by,
+  created_at,
+  updated_at
+) VALUES (
+  @organization_id, @enabled, @offset_days, @updated_by, now(), now()
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  enabled = EXCLUDED.enabled,
+  offset_days = EXCLUDED.offset_days,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: ListDueInvoiceReminders :many
+-- Finds, per invoice awaiting payment, the latest reminder step that has come
+-- due and has not been sent. Steps that came due before the invoice was sent
+-- are skipped, as are steps earlier than one already sent or given up, so
+-- catching up after downtime sends one reminder rather than every missed one.
+-- A step whose email failed waits out a backoff that doubles with each
+-- attempt, is given up after max_attempts, and is listed after steps that
+-- have not failed so retries never crowd them out of a batch.
+WITH p AS (
+  SELECT
+    @now::timestamptz AS now,
+    @max_attempts::integer AS max_attempts,
+    @retry_backoff_seconds::integer AS retry_backoff_seconds
+)
+SELECT d.invoice_id, d.offset_days
+FROM p, (
+  SELECT DISTINCT ON (i.id)
+    i.id AS invoice_id,
+    o.offset_days::integer AS offset_days
+  FROM invoices i
+  JOIN invoice_reminder_settings s ON s.organization_id = i.organization_id AND s.enabled
+  CROSS JOIN p
+  CROSS JOIN LATERAL unnest(s.offset_days) AS o(offset_days)
+  WHERE i.status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+    AND i.sent_at IS NOT NULL
+    AND i.due_date + o.offset_days * interval '1 day' <= p.now
+    AND i.due_date + o.offset_days * interval '1 day' > i.sent_at
+    AND NOT EXISTS (
+      SELECT 1 FROM invoice_reminders r
+      WHERE r.invoice_id = i.id AND r.offset_days >= o.offset_days
+    )
+    AND NOT EXISTS (
+      SELECT 1 FROM invoice_reminder_failures g
+      WHERE g.invoice_id = i.id AND g.offset_days >= o.offset_days AND g.attempts >= p.max_attempts
+    )
+  ORDER BY i.id, o.offset_days DESC
+) d
+LEFT JOIN invoice_reminder_failures f ON f.invoice_id = d.invoice_id AND f.offset_days = d.offset_days
+WHERE f.invoice_id IS NULL
+  OR f.failed_at + make_interval(secs => p.retry_backoff_seconds * power(2, f.attempts - 1)) <= p.now
+ORDER BY COALESCE(f.attempts, 0), d.invoice_id
+LIMIT @batch_size;
+
+-- name: CreateInvoiceReminder :one
+-- Records a reminder step before it is sent; a step that was already
+-- recorded returns no row
+INSERT INTO invoice_reminders (
+  id,
+  invoice_id,
+  offset_days,
+  recipient,
+  sent_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (invoice_id, offset_days) DO NOTHING
+RETURNING *;
+
+-- name: DeleteInvoiceReminder :exec
+DELETE FROM invoice_reminders
+WHERE id = $1;
+
+-- name: RecordInvoiceReminderFailure :one
+-- Counts a failed attempt at a reminder step and returns how many there have been
+INSERT INTO invoice_reminder_failures (
+  invoice_id,
+  offset_days,
+  attempts,
+  failed_at
+) VALUES (
+  $1, $2, 1, $3
+)
+ON CONFLICT (invoice_id, offset_days) DO UPDATE
+SET
+  attempts = invoice_reminder_failures.attempts + 1,
+  failed_at = EXCLUDED.failed_at
+RETURNING attempts;
+
+-- name: ListInvoiceReminders :many
+SELECT * FROM invoice_reminders
+WHERE invoice_id = $1
+ORDER BY sent_at;
@@ -0,0 +1,37 @@
+-- name: CreateInvoiceShareLink :one
+INSERT INTO invoice_share_links (
+  id,
+  invoice_id,
+  token_hash,
+  expires_at,
+  created_by,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+)
+RETURNING *;
+
+-- name: GetInvoiceShareLink :one
+SELECT * FROM invoice_share_links
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListInvoiceShareLinks :many
+SELECT * FROM invoice_share_links
+WHERE invoice_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeInvoiceShareLink :one
+UPDATE invoice_share_links
+SET
+  revoked_at = @revoked_at,
+  revoked_by = @revoked_by
+WHERE id = @id AND invoice_id = @invoice_id AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RecordInvoiceShareLinkView :exec
+UPDATE invoice_share_links
+SET
+  view_count = view_count + 1,
+  last_viewed_at = @viewed_at
+WHERE id = @id;
@@ -0,0 +1,217 @@
+-- name: NextInvoiceNumber :one
+-- Hands out the organization's next invoice number. The sequence row stays
+-- locked until the calling transaction ends, so a rolled back invoice gives
+-- its number back and concurrent invoices are numbered one after the other.
+INSERT INTO invoice_sequences (organization_id, last_number, updated_at)
+VALUES (@organization_id, 1, now())
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  last_number = invoice_sequences.last_number + 1,
+  updated_at = now()
+RETURNING last_number;
+
+-- name: CreateInvoice :one
+INSERT INTO invoices (
+  id,
+  organization_id,
+  number,
+  status,
+  currency,
+  customer_name,
+  customer_email,
+  customer_address,
+  issue_date,
+  due_date,
+  notes,
+  subtotal,
+  discount_total,
+  tax_total,
+  total,
+  payment_asset_id,
+  payment_address,
+  recurring_invoice_id,
+  deposit_index,
+  created_by,
+  client_id,
+  customer_country,
+  customer_tax_id,
+  automatic_tax,
+  tax_treatment,
+  tax_name,
+  tax_note,
+  tax_rates_version,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, 'draft', $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+  $21, $22, $23, $24, $25, $26, $27, now(), now()
+) RETURNING *;
+
+-- name: GetInvoiceByID :one
+SELECT * FROM invoices
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListInvoicesByOrganization :many
+-- Lists an organization's invoices, optionally in one status, latest number first
+SELECT * FROM invoices
+WHERE organization_id = @organization_id
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY number DESC
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountInvoicesByOrganization :one
+SELECT COUNT(*) FROM invoices
+WHERE organization_id = @organization_id
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
+
+-- name: UpdateDraftInvoice :one
+-- Replaces the details of a draft invoice; no row is returned once it has been sent
+UPDATE invoices
+SET
+  currency = $2,
+  customer_name = $3,
+  customer_email = $4,
+  customer_address = $5,
+  issue_date = $6,
+  due_date = $7,
+  notes = $8,
+  subtotal = $9,
+  discount_total = $10,
+  tax_total = $11,
+  total = $12,
+  payment_asset_id = $13,
+  payment_address = $14,
+  deposit_index = $15,
+  client_id = $16,
+  customer_country = $17,
+  customer_tax_id = $18,
+  automatic_tax = $19,
+  tax_treatment = $20,
+  tax_name = $21,
+  tax_note = $22,
+  tax_rates_version = $23,
+  updated_at = now()
+WHERE id = $1 AND status = 'draft'
+RETURNING *;
+
+-- name: MarkInvoiceSent :one
+UPDATE invoices
+SET
+  status = 'sent',
+  sent_at = @sent_at,
+  updated_at = now()
+WHERE id = @id AND status = 'draft'
+RETURNING *;
+
+-- name: MarkInvoiceViewed :one
+-- Records the first time the customer opened an invoice awaiting payment. Only
+-- a sent invoice becomes viewed; a partially paid or overdue one keeps its status.
+UPDATE invoices
+SET
+  status = CASE WHEN status = 'sent' THEN 'viewed' ELSE status END,
+  viewed_at = COALESCE(viewed_at, @viewed_at),
+  updated_at = now()
+WHERE id = @id AND status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+RETURNING *;
+
+-- name: MarkInvoicePaid :one
+UPDATE invoices
+SET
+  status = 'paid',
+  paid_at = @paid_at,
+  payment_reference = sqlc.narg(payment_reference),
+  updated_at = now()
+WHERE id = @id AND status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+RETURNING *;
+
+-- name: VoidInvoice :one
+UPDATE invoices
+SET
+  status = 'void',
+  voided_at = @voided_at,
+  void_reason = @void_reason,
+  updated_at = now()
+WHERE id = @id AND status IN ('draft', 'sent', 'viewed', 'overdue') AND amount_credited = 0
+RETURNING *;
+
+-- name: MarkInvoicesOverdue :many
+-- Moves sent, viewed and partially paid invoices whose due date has passed to
+-- overdue, a batch at a time; rows another instance is updating are skipped
+UPDATE invoices
+SET
+  status = 'overdue',
+  updated_at = now()
+WHERE id IN (
+  SELECT i.id FROM invoices i
+  WHERE i.status IN ('sent', 'viewed', 'partially_paid') AND i.due_date < @now
+  ORDER BY i.due_date
+  LIMIT @limit_count
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetInvoiceForUpdate :one
+-- Locks an invoice until the calling transaction ends
+SELECT * FROM invoices
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListInvoicesAwaitingDeposit :many
+-- Lists the invoices whose deposit address is watched for payments: those
+-- not yet paid or voided, and paid or voided ones for a while longer so late
+-- transfers are still flagged as overpayments or payments after the void
+SELECT * FROM invoices
+WHERE deposit_index IS NOT NULL
+  AND (status IN ('draft', 'sent', 'viewed', 'partially_paid', 'overdue')
+    OR (status = 'paid' AND paid_at >= @closed_since)
+    OR (status = 'void' AND voided_at >= @closed_since))
+ORDER BY created_at;
+
+-- name: UpdateInvoicePaymentStatus :one
+-- Stores the amount received, the status it puts an invoice in and any
+-- payment exception it raises
+UPDATE invoices
+SET
+  status = @status,
+  amount_paid = @amount_paid,
+  paid_at = sqlc.narg(paid_at),
+  payment_reference = sqlc.narg(payment_reference),
+  payment_exception = sqlc.narg(payment_exception),
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: InvoiceDepositAddressExists :one
+SELECT EXISTS (
+  SELECT 1 FROM invoices
+  WHERE payment_address = $1 AND deposit_index IS NOT NULL
+);
+
+-- name: CreateInvoiceLineItem :one
+INSERT INTO invoice_line_items (
+  id,
+  invoice_id,
+  position,
+  description,
+  quantity,
+  unit_price,
+  discount_percent,
+  tax_rate,
+  amount,
+  discount_amount,
+  tax_amount,
+  total,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, now()
+) RETURNING *;
+
+-- name: ListInvoiceLineItems :many
+SELECT * FROM invoice_line_items
+WHERE invoice_id = $1
+ORDER BY position;
+
+-- name: DeleteInvoiceLineItems :exec
+DELETE FROM invoice_line_items
+WHERE invoice_id = $1;
@@ -0,0 +1,91 @@
+-- name: CreateLedgerAccount :one
+-- Opens a ledger account
+INSERT INTO ledger_accounts (
+  id,
+  code,
+  name,
+  type,
+  owner_id,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, now(), now()
+) RETURNING *;
+
+-- name: GetLedgerAccountByID :one
+-- Retrieves a ledger account by ID
+SELECT * FROM ledger_accounts
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetLedgerAccountByCode :one
+-- Retrieves a ledger account by its unique code
+SELECT * FROM ledger_accounts
+WHERE code = $1
+LIMIT 1;
+
+-- name: ListLedgerAccountsByOwner :many
+-- Lists the ledger accounts belonging to a user or organization
+SELECT * FROM ledger_accounts
+WHERE owner_id = $1
+ORDER BY code;
+
+-- name: CreateLedgerEntry :one
+-- Records a journal entry unless one with the same external reference exists,
+-- in which case no row is returned
+INSERT INTO ledger_entries (
+  id,
+  external_reference,
+  description,
+  occurred_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, now()
+)
+ON CONFLICT (external_reference) DO NOTHING
+RETURNING *;
+
+-- name: GetLedgerEntryByID :one
+-- Retrieves a journal entry by ID
+SELECT * FROM ledger_entries
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetLedgerEntryByReference :one
+-- Retrieves a journal entry by its external reference
+SELECT * FROM ledger_entries
+WHERE external_reference = $1
+LIMIT 1;
+
+-- name: CreateLedgerPosting :one
+-- Records one debit or credit of a journal entry
+INSERT INTO ledger_postings (
+  id,
+  entry_id,
+  account_id,
+  asset,
+  amount,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+) RETURNING *;
+
+-- name: ListLedgerPostingsByEntry :many
+-- Lists the postings of a journal entry
+SELECT * FROM ledger_postings
+WHERE entry_id = $1
+ORDER BY created_at, id;
+
+-- name: GetLedgerAccountBalances :many
+-- Sums the postings of an account per asset
+SELECT asset, SUM(amount)::NUMERIC AS balance
+FROM ledger_postings
+WHERE account_id = $1
+GROUP BY asset
+ORDER BY asset;
+
+-- name: GetLedgerAccountBalance :one
+-- Sums the postings of an account in one asset
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS balance
+FROM ledger_postings
+WHERE account_id = $1 AND asset = $2;
@@ -0,0 +1,72 @@
+-- name: CreateOrganizationInvitation :one
+-- Records a new pending invitation
+INSERT INTO organization_invitations (
+  id,
+  organization_id,
+  email,
+  role,
+  invited_by,
+  status,
+  token_hash,
+  expires_at,
+  send_count,
+  last_sent_at,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'pending', $6, $7, 1, now(), now(), now()
+) RETURNING *;
+
+-- name: GetOrganizationInvitationByID :one
+SELECT sqlc.embed(i), o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.id = $1
+LIMIT 1;
+
+-- name: GetPendingOrganizationInvitationByEmail :one
+-- Retrieves the pending invitation for an email address in an organization, if any
+SELECT sqlc.embed(i), o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND lower(i.email) = lower(@email::text) AND i.status = 'pending'
+LIMIT 1;
+
+-- name: ListPendingOrganizationInvitations :many
+-- Lists an organization's pending invitations, including expired ones, newest first
+SELECT sqlc.embed(i), o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND i.status = 'pending'
+ORDER BY i.created_at DESC;
+
+-- name: RefreshOrganizationInvitationToken :one
+-- Replaces the token of a pending invitation when it is resent
+UPDATE organization_invitations
+SET
+  token_hash = $2,
+  expires_at = $3,
+  send_count = send_count + 1,
+  last_sent_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: MarkOrganizationInvitationAccepted :one
+UPDATE organization_invitations
+SET
+  status = 'accepted',
+  accepted_by = $2,
+  accepted_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: MarkOrganizationInvitationRevoked :one
+UPDATE organization_invitations
+SET
+  status = 'revoked',
+  revoked_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
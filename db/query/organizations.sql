@@ -0,0 +1,98 @@
+-- name: CreateOrganization :one
+-- Creates an organization profile
+INSERT INTO organizations (
+  id,
+  name,
+  address,
+  city,
+  postal_code,
+  country,
+  website,
+  size,
+  industry,
+  description,
+  headquarters,
+  logo_url,
+  created_by,
+  tax_id,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, now(), now()
+) RETURNING *;
+
+-- name: GetOrganizationByID :one
+SELECT * FROM organizations WHERE id = $1 LIMIT 1;
+
+-- name: UpdateOrganization :one
+-- Replaces an organization's profile
+UPDATE organizations
+SET
+  name = $2,
+  address = $3,
+  city = $4,
+  postal_code = $5,
+  country = $6,
+  website = $7,
+  size = $8,
+  industry = $9,
+  description = $10,
+  headquarters = $11,
+  logo_url = $12,
+  tax_id = $13,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListOrganizationsByUser :many
+-- Lists the organizations a user belongs to with their role in each
+SELECT sqlc.embed(o), m.role
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name ASC;
+
+-- name: CreateOrganizationMember :one
+-- Adds a user to an organization
+INSERT INTO organization_members (
+  id,
+  organization_id,
+  user_id,
+  role,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, now(), now()
+) RETURNING *;
+
+-- name: GetOrganizationMember :one
+-- Retrieves a user's membership of an organization with their contact details
+SELECT sqlc.embed(m), u.email, u.first_name, u.last_name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1 AND m.user_id = $2
+LIMIT 1;
+
+-- name: ListOrganizationMembers :many
+-- Lists an organization's members with their contact details
+SELECT sqlc.embed(m), u.email, u.first_name, u.last_name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.created_at ASC;
+
+-- name: UpdateOrganizationMemberRole :one
+UPDATE organization_members
+SET
+  role = $3,
+  updated_at = now()
+WHERE organization_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteOrganizationMember :exec
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: CountOrganizationMembersByRole :one
+SELECT COUNT(*) FROM organization_members
+WHERE organization_id = $1 AND role = $2;
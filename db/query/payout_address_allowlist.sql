@@ -0,0 +1,51 @@
+-- name: CreatePayoutAddress :one
+-- Adds a payout address to the allowlist in the pending (quarantined) state
+INSERT INTO payout_address_allowlist (
+    id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetPayoutAddressByID :one
+SELECT * FROM payout_address_allowlist
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPayoutAddressByCancelTokenHash :one
+SELECT * FROM payout_address_allowlist
+WHERE cancel_token_hash = $1
+LIMIT 1;
+
+-- name: GetLatestPayoutAddressByUserAndAddress :one
+-- Retrieves the most recent allowlist entry for a user's address. Hex (EVM)
+-- addresses match whatever their checksum casing.
+SELECT * FROM payout_address_allowlist
+WHERE user_id = $1
+  AND (address = $2 OR (address ILIKE '0x%' AND lower(address) = lower($2)))
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListPayoutAddressesByUserID :many
+SELECT * FROM payout_address_allowlist
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: ActivateDuePayoutAddresses :exec
+-- Releases pending addresses whose cooling-off period has elapsed
+UPDATE payout_address_allowlist
+SET
+    status = 'active',
+    activated_at = available_at,
+    updated_at = now()
+WHERE status = 'pending' AND available_at <= $1;
+
+-- name: CancelPayoutAddress :one
+-- Blocks a pending address so it can never receive funds
+UPDATE payout_address_allowlist
+SET
+    status = 'cancelled',
+    cancelled_at = now(),
+    updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
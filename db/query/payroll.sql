@@ -0,0 +1,204 @@
+-- name: CreatePayrollSchedule :one
+-- Creates a payroll schedule
+INSERT INTO payroll_schedules (
+  id,
+  organization_id,
+  name,
+  frequency,
+  cron_expression,
+  timezone,
+  first_pay_date,
+  next_run_at,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now()
+) RETURNING *;
+
+-- name: GetPayrollScheduleByID :one
+SELECT * FROM payroll_schedules
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPayrollScheduleForUpdate :one
+-- Locks a schedule while its next pay run is generated
+SELECT * FROM payroll_schedules
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListPayrollSchedulesByOrganization :many
+SELECT * FROM payroll_schedules
+WHERE organization_id = $1
+ORDER BY created_at;
+
+-- name: ListDuePayrollSchedules :many
+-- Lists active schedules whose next pay date has arrived
+SELECT * FROM payroll_schedules
+WHERE active AND next_run_at <= $1
+ORDER BY next_run_at
+LIMIT $2;
+
+-- name: UpdatePayrollSchedule :one
+UPDATE payroll_schedules
+SET
+  name = $2,
+  frequency = $3,
+  cron_expression = $4,
+  timezone = $5,
+  first_pay_date = $6,
+  next_run_at = $7,
+  active = $8,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: AdvancePayrollSchedule :one
+-- Moves a schedule on to its next pay date after a run was generated
+UPDATE payroll_schedules
+SET
+  last_run_at = $2,
+  next_run_at = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateEmployeeCompensation :one
+INSERT INTO employee_compensations (
+  id,
+  organization_id,
+  schedule_id,
+  user_id,
+  amount,
+  currency,
+  payout_asset_id,
+  wallet_address,
+  tax_country,
+  tax_id,
+  active,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, true, now(), now()
+) RETURNING *;
+
+-- name: GetEmployeeCompensationByID :one
+SELECT sqlc.embed(c), u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM employee_compensations c
+JOIN users u ON u.id = c.user_id
+JOIN supported_assets a ON a.id = c.payout_asset_id
+WHERE c.id = $1
+LIMIT 1;
+
+-- name: ListEmployeeCompensations :many
+-- Lists an organization's compensation records, optionally for one schedule
+SELECT sqlc.embed(c), u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM employee_compensations c
+JOIN users u ON u.id = c.user_id
+JOIN supported_assets a ON a.id = c.payout_asset_id
+WHERE c.organization_id = @organization_id
+  AND (sqlc.narg(schedule_id)::uuid IS NULL OR c.schedule_id = sqlc.narg(schedule_id))
+ORDER BY c.active DESC, u.first_name, u.last_name;
+
+-- name: ListActiveCompensationsBySchedule :many
+SELECT * FROM employee_compensations
+WHERE schedule_id = $1 AND active
+ORDER BY created_at;
+
+-- name: UpdateEmployeeCompensation :one
+UPDATE employee_compensations
+SET
+  amount = $2,
+  currency = $3,
+  payout_asset_id = $4,
+  wallet_address = $5,
+  active = $6,
+  tax_country = $7,
+  tax_id = $8,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePayRun :one
+-- Creates a draft pay run unless the schedule already has one for the pay
+-- date, in which case no row is returned
+INSERT INTO pay_runs (
+  id,
+  organization_id,
+  schedule_id,
+  pay_date,
+  period_start,
+  status,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'draft', now(), now()
+)
+ON CONFLICT (schedule_id, pay_date) DO NOTHING
+RETURNING *;
+
+-- name: GetPayRunByID :one
+SELECT * FROM pay_runs
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListPayRunsByOrganization :many
+SELECT * FROM pay_runs
+WHERE organization_id = $1
+ORDER BY pay_date DESC, created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetPreviousPayRun :one
+-- Returns the schedule's latest pay run before the given pay date
+SELECT * FROM pay_runs
+WHERE schedule_id = @schedule_id AND pay_date < @before
+ORDER BY pay_date DESC
+LIMIT 1;
+
+-- name: CountPayRunsByOrganization :one
+SELECT COUNT(*) FROM pay_runs
+WHERE organization_id = $1;
+
+-- name: CreatePayRunLineItem :one
+INSERT INTO pay_run_line_items (
+  id,
+  pay_run_id,
+  compensation_id,
+  user_id,
+  amount,
+  currency,
+  payout_asset_id,
+  wallet_address,
+  tax_country,
+  tax_id,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now()
+) RETURNING *;
+
+-- name: ListPayRunLineItems :many
+SELECT sqlc.embed(li), u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM pay_run_line_items li
+JOIN users u ON u.id = li.user_id
+JOIN supported_assets a ON a.id = li.payout_asset_id
+WHERE li.pay_run_id = $1
+ORDER BY u.first_name, u.last_name, li.created_at;
+
+-- name: LockPayRunLineItemPayout :exec
+-- Stores the payout a line item was converted to when its pay run was submitted
+UPDATE pay_run_line_items
+SET
+  fx_quote_id = sqlc.narg(fx_quote_id),
+  locked_payout = @locked_payout
+WHERE id = @id;
+
+-- name: UpdatePayRunStatus :one
+-- Moves a pay run from one status to another; no row is returned if it is no
+-- longer in the expected status
+UPDATE pay_runs
+SET
+  status = @to_status,
+  updated_at = now()
+WHERE id = @id AND status = @from_status
+RETURNING *;
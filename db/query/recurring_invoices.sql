@@ -0,0 +1,111 @@
+-- name: CreateRecurringInvoice :one
+INSERT INTO recurring_invoices (
+  id,
+  organization_id,
+  name,
+  status,
+  frequency,
+  timezone,
+  start_date,
+  end_date,
+  max_occurrences,
+  next_issue_at,
+  days_until_due,
+  auto_send,
+  currency,
+  customer_name,
+  customer_email,
+  customer_address,
+  notes,
+  payment_asset_id,
+  payment_address,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, 'active', $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, now(), now()
+) RETURNING *;
+
+-- name: GetRecurringInvoiceByID :one
+SELECT * FROM recurring_invoices
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetRecurringInvoiceForUpdate :one
+-- Locks a template while its next invoice is generated
+SELECT * FROM recurring_invoices
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListRecurringInvoicesByOrganization :many
+SELECT * FROM recurring_invoices
+WHERE organization_id = $1
+ORDER BY created_at;
+
+-- name: ListDueRecurringInvoices :many
+-- Lists active templates whose next issue date has arrived
+SELECT * FROM recurring_invoices
+WHERE status = 'active' AND next_issue_at <= $1
+ORDER BY next_issue_at
+LIMIT $2;
+
+-- name: UpdateRecurringInvoice :one
+UPDATE recurring_invoices
+SET
+  name = $2,
+  status = $3,
+  frequency = $4,
+  timezone = $5,
+  start_date = $6,
+  end_date = $7,
+  max_occurrences = $8,
+  next_issue_at = $9,
+  days_until_due = $10,
+  auto_send = $11,
+  currency = $12,
+  customer_name = $13,
+  customer_email = $14,
+  customer_address = $15,
+  notes = $16,
+  payment_asset_id = $17,
+  payment_address = $18,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: AdvanceRecurringInvoice :one
+-- Counts an invoice generated from a template and moves it on to its next
+-- issue date, completing it when there is none
+UPDATE recurring_invoices
+SET
+  occurrences = occurrences + 1,
+  last_issued_at = @last_issued_at,
+  next_issue_at = @next_issue_at,
+  status = CASE WHEN @completed::boolean THEN 'completed' ELSE status END,
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: CreateRecurringInvoiceLineItem :one
+INSERT INTO recurring_invoice_line_items (
+  id,
+  recurring_invoice_id,
+  position,
+  description,
+  quantity,
+  unit_price,
+  discount_percent,
+  tax_rate,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, now()
+) RETURNING *;
+
+-- name: ListRecurringInvoiceLineItems :many
+SELECT * FROM recurring_invoice_line_items
+WHERE recurring_invoice_id = $1
+ORDER BY position;
+
+-- name: DeleteRecurringInvoiceLineItems :exec
+DELETE FROM recurring_invoice_line_items
+WHERE recurring_invoice_id = $1;
@@ -0,0 +1,73 @@
+-- name: CreateSupportedAsset :one
+-- Registers a new asset
+INSERT INTO supported_assets (
+  id,
+  chain,
+  symbol,
+  name,
+  contract_address,
+  decimals,
+  enabled,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, now(), now()
+) RETURNING *;
+
+-- name: UpsertSupportedAsset :one
+-- Registers an asset or refreshes its details, keyed by chain and symbol. Used by the seeder.
+INSERT INTO supported_assets (
+  id,
+  chain,
+  symbol,
+  name,
+  contract_address,
+  decimals,
+  enabled,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, now(), now()
+)
+ON CONFLICT (chain, symbol) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  contract_address = EXCLUDED.contract_address,
+  decimals = EXCLUDED.decimals,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetSupportedAssetByID :one
+-- Retrieves an asset by its ID
+SELECT * FROM supported_assets
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetSupportedAssetBySymbol :one
+-- Retrieves an asset by chain and symbol
+SELECT * FROM supported_assets
+WHERE chain = @chain AND upper(symbol) = upper(@symbol::text)
+LIMIT 1;
+
+-- name: GetSupportedAssetByContract :one
+-- Retrieves an asset by chain and contract address, ignoring address case
+SELECT * FROM supported_assets
+WHERE chain = @chain AND lower(contract_address) = lower(@contract_address::text)
+LIMIT 1;
+
+-- name: ListSupportedAssets :many
+-- Lists assets, optionally only those on one chain or only enabled ones
+SELECT * FROM supported_assets
+WHERE (sqlc.narg('chain')::text IS NULL OR chain = sqlc.narg('chain'))
+  AND (NOT @enabled_only::boolean OR enabled)
+ORDER BY chain, symbol;
+
+-- name: UpdateSupportedAsset :one
+-- Updates the display name and enabled flag of an asset
+UPDATE supported_assets
+SET
+  name = @name,
+  enabled = @enabled,
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
@@ -0,0 +1,173 @@
+-- name: CreateContractorContract :one
+-- Adds a contract unless the contractor already has an active one for the
+-- project, in which case no row is returned
+INSERT INTO contractor_contracts (
+  id,
+  organization_id,
+  contractor_id,
+  client_id,
+  project,
+  hourly_rate,
+  currency,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, true, $8, now(), now()
+)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: GetContractorContract :one
+SELECT sqlc.embed(c), u.email, u.first_name, u.last_name, COALESCE(cl.name, '')::text AS client_name
+FROM contractor_contracts c
+JOIN users u ON u.id = c.contractor_id
+LEFT JOIN clients cl ON cl.id = c.client_id
+WHERE c.organization_id = @organization_id AND c.id = @id
+LIMIT 1;
+
+-- name: ListContractorContracts :many
+-- Lists an organization's contracts, active ones first, optionally only one
+-- contractor's
+SELECT sqlc.embed(c), u.email, u.first_name, u.last_name, COALESCE(cl.name, '')::text AS client_name
+FROM contractor_contracts c
+JOIN users u ON u.id = c.contractor_id
+LEFT JOIN clients cl ON cl.id = c.client_id
+WHERE c.organization_id = @organization_id
+  AND (sqlc.narg(contractor_id)::uuid IS NULL OR c.contractor_id = sqlc.narg(contractor_id))
+ORDER BY c.active DESC, u.first_name, u.last_name, lower(c.project);
+
+-- name: UpdateContractorContract :one
+UPDATE contractor_contracts
+SET
+  client_id = $3,
+  project = $4,
+  hourly_rate = $5,
+  currency = $6,
+  active = $7,
+  updated_at = now()
+WHERE organization_id = $1 AND id = $2
+RETURNING *;
+
+-- name: SaveTimesheet :one
+-- Starts a contract's timesheet for the week, or reopens an existing draft or
+-- rejected one for editing. No row is returned once the timesheet has been
+-- submitted.
+INSERT INTO timesheets (
+  id,
+  organization_id,
+  contract_id,
+  contractor_id,
+  week_start,
+  status,
+  total_hours,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'draft', $6, now(), now()
+)
+ON CONFLICT (contract_id, week_start) DO UPDATE
+SET
+  status = 'draft',
+  total_hours = EXCLUDED.total_hours,
+  updated_at = now()
+WHERE timesheets.status IN ('draft', 'rejected')
+RETURNING *;
+
+-- name: DeleteTimesheetEntries :exec
+DELETE FROM timesheet_entries
+WHERE timesheet_id = $1;
+
+-- name: CreateTimesheetEntry :exec
+INSERT INTO timesheet_entries (
+  id,
+  timesheet_id,
+  position,
+  work_date,
+  hours,
+  description,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+);
+
+-- name: GetTimesheet :one
+SELECT sqlc.embed(t), c.project, u.email, u.first_name, u.last_name
+FROM timesheets t
+JOIN contractor_contracts c ON c.id = t.contract_id
+JOIN users u ON u.id = t.contractor_id
+WHERE t.organization_id = @organization_id AND t.id = @id
+LIMIT 1;
+
+-- name: ListTimesheetEntries :many
+SELECT * FROM timesheet_entries
+WHERE timesheet_id = $1
+ORDER BY position;
+
+-- name: ListTimesheets :many
+-- Lists an organization's timesheets, latest week first, optionally only one
+-- contractor's, one contract's or those in one status
+SELECT sqlc.embed(t), c.project, u.email, u.first_name, u.last_name
+FROM timesheets t
+JOIN contractor_contracts c ON c.id = t.contract_id
+JOIN users u ON u.id = t.contractor_id
+WHERE t.organization_id = @organization_id
+  AND (sqlc.narg(contractor_id)::uuid IS NULL OR t.contractor_id = sqlc.narg(contractor_id))
+  AND (sqlc.narg(contract_id)::uuid IS NULL OR t.contract_id = sqlc.narg(contract_id))
+  AND (sqlc.narg(status)::text IS NULL OR t.status = sqlc.narg(status))
+ORDER BY t.week_start DESC, u.first_name, u.last_name, lower(c.project)
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountTimesheets :one
+SELECT COUNT(*) FROM timesheets t
+WHERE t.organization_id = @organization_id
+  AND (sqlc.narg(contractor_id)::uuid IS NULL OR t.contractor_id = sqlc.narg(contractor_id))
+  AND (sqlc.narg(contract_id)::uuid IS NULL OR t.contract_id = sqlc.narg(contract_id))
+  AND (sqlc.narg(status)::text IS NULL OR t.status = sqlc.narg(status));
+
+-- name: SubmitTimesheet :one
+-- Submits a draft timesheet with hours on it for approval
+UPDATE timesheets
+SET
+  status = 'submitted',
+  submitted_at = @submitted_at,
+  rejection_reason = '',
+  updated_at = now()
+WHERE id = @id AND status = 'draft' AND total_hours > 0
+RETURNING *;
+
+-- name: ReviewTimesheet :one
+-- Approves or rejects a submitted timesheet
+UPDATE timesheets
+SET
+  status = @status,
+  reviewed_by = @reviewed_by,
+  reviewed_at = @reviewed_at,
+  rejection_reason = @rejection_reason,
+  updated_at = now()
+WHERE id = @id AND status = 'submitted'
+RETURNING *;
+
+-- name: InvoiceTimesheets :many
+-- Marks the organization's approved timesheets among ids as billed on an
+-- invoice, returning those that were
+UPDATE timesheets
+SET
+  status = 'invoiced',
+  invoice_id = @invoice_id,
+  updated_at = now()
+WHERE organization_id = @organization_id
+  AND id = ANY(@ids::uuid[])
+  AND status = 'approved'
+RETURNING id;
+
+-- name: ReleaseInvoicedTimesheets :exec
+-- Returns the timesheets billed on a voided invoice to approved, so their
+-- hours can be invoiced again
+UPDATE timesheets
+SET
+  status = 'approved',
+  invoice_id = NULL,
+  updated_at = now()
+WHERE invoice_id = $1 AND status = 'invoiced';
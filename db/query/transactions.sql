@@ -1,3 +1,20 @@
+-- name: CreateTransaction :one
+-- Creates a new transaction and returns the created transaction
+INSERT INTO transactions (
+  id,
+  user_id,
+  tx_hash,
+  transaction_pin_hash,
+  status,
+  token_address,
+  to_address,
+  amount,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, now(), now()
+) RETURNING *;
+
 -- name: GetTransactionByID :one
 -- Retrieves a single transaction by its ID
 SELECT * FROM transactions
@@ -5,9 +22,10 @@ WHERE id = $1
 LIMIT 1;
 
 -- name: GetTransactionByTxHash :one
--- Retrieves a single transaction by its transaction hash
+-- Retrieves the user-initiated transaction with the hash. Inbound transfers are
+-- left out, as one transaction can carry several of them.
 SELECT * FROM transactions
-WHERE tx_hash = $1
+WHERE tx_hash = $1 AND direction = 'outbound'
 LIMIT 1;
 
 -- name: GetTransactionsByUserID :many
@@ -39,6 +57,25 @@ SET
 WHERE id = $1
 RETURNING *;
 
+-- name: TransitionTransactionStatus :one
+-- Moves a transaction to a new status only if it is still in the expected status
+UPDATE transactions
+SET
+  status = @to_status,
+  updated_at = now()
+WHERE id = @id AND status = @from_status
+RETURNING *;
+
+-- name: UpdateTransactionBlock :one
+-- Records the block a transaction's receipt was seen in, or clears it after a reorg
+UPDATE transactions
+SET
+  block_number = sqlc.narg('block_number'),
+  block_hash = sqlc.narg('block_hash'),
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
+
 -- name: UpdateTransaction :one
 -- Updates transaction details and returns the updated transaction
 UPDATE transactions
@@ -57,4 +94,58 @@ WHERE id = $1;
 -- name: DeleteTransactionsByUserID :exec
 -- Deletes all transactions for a specific user
 DELETE FROM transactions
-WHERE user_id = $1;
\ No newline at end of file
+WHERE user_id = $1;
+
+-- name: ListTransactionsByUserID :many
+-- Lists a user's transactions with pagination and optional status and date range filters
+SELECT * FROM transactions
+WHERE user_id = @user_id
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+ORDER BY created_at DESC
+LIMIT @limit_count
+OFFSET @offset_count;
+
+-- name: CountTransactionsByUserID :one
+-- Counts a user's transactions matching the same filters as ListTransactionsByUserID
+SELECT COUNT(*) FROM transactions
+WHERE user_id = @user_id
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'));
+
+-- name: UpsertInboundTransfer :one
+-- Records an indexed inbound transfer. A transfer seen again, including in
+-- another block after a reorg re-mined its transaction, only refreshes where
+-- it was seen, so it is never recorded twice.
+INSERT INTO transactions (
+  id,
+  user_id,
+  tx_hash,
+  transaction_pin_hash,
+  status,
+  direction,
+  log_index,
+  transfer_ordinal,
+  token_address,
+  from_address,
+  to_address,
+  amount,
+  block_number,
+  block_hash,
+  created_at,
+  updated_at
+) VALUES (
+  @id, @user_id, @tx_hash, '', @status, 'inbound', @log_index, @transfer_ordinal, @token_address,
+  @from_address, @to_address, @amount, @block_number, @block_hash, now(), now()
+)
+ON CONFLICT (tx_hash, token_address, to_address, transfer_ordinal) WHERE direction = 'inbound' DO UPDATE
+SET
+  log_index = EXCLUDED.log_index,
+  from_address = EXCLUDED.from_address,
+  amount = EXCLUDED.amount,
+  block_number = EXCLUDED.block_number,
+  block_hash = EXCLUDED.block_hash,
+  updated_at = now()
+RETURNING *;
@@ -0,0 +1,44 @@
+-- name: GetTransactionPINByUserID :one
+-- Retrieves the transaction PIN record for a user
+SELECT * FROM user_transaction_pins
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: UpsertTransactionPIN :one
+-- Sets a user's transaction PIN and clears any failed attempts or lockout
+INSERT INTO user_transaction_pins (
+  user_id,
+  pin_hash,
+  failed_attempts,
+  locked_until,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, 0, NULL, now(), now()
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  pin_hash = EXCLUDED.pin_hash,
+  failed_attempts = 0,
+  locked_until = NULL,
+  updated_at = now()
+RETURNING *;
+
+-- name: RecordFailedTransactionPINAttempt :one
+-- Counts a wrong PIN entry and locks the PIN once max_attempts is reached
+UPDATE user_transaction_pins
+SET
+  failed_attempts = CASE WHEN failed_attempts + 1 >= @max_attempts::int THEN 0 ELSE failed_attempts + 1 END,
+  locked_until = CASE WHEN failed_attempts + 1 >= @max_attempts::int THEN @lock_until::timestamptz ELSE locked_until END,
+  updated_at = now()
+WHERE user_id = @user_id
+RETURNING *;
+
+-- name: ResetTransactionPINAttempts :exec
+-- Clears failed attempts and any lockout after a correct PIN entry
+UPDATE user_transaction_pins
+SET
+  failed_attempts = 0,
+  locked_until = NULL,
+  updated_at = now()
+WHERE user_id = $1;
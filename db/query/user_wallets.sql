@@ -20,3 +20,6 @@ RETURNING *;
 
 -- name: DeleteUserWallet :exec
 DELETE FROM user_wallets WHERE id = $1;
+
+-- name: ListUserWallets :many
+SELECT * FROM user_wallets ORDER BY created_at;
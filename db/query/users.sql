@@ -13,15 +13,9 @@ INSERT INTO users (
   nationality,
   residential_country,
   job_role,
-  company_name,
-  company_address,
-  company_city,
-  company_postal_code,
-  company_country,
   auth_provider,
   provider_id,
   employee_type,
-  company_website,
   employment_type,
   user_address,
   user_city,
@@ -41,15 +35,9 @@ INSERT INTO users (
   @nationality,
   @residential_country,
   @job_role,
-  COALESCE(@company_name, ''),
-  COALESCE(@company_address, ''),
-  COALESCE(@company_city, ''),
-  COALESCE(@company_postal_code, ''),
-  COALESCE(@company_country, ''),
   @auth_provider,
   @provider_id,
   @employee_type,
-  COALESCE(@company_website, ''),
   COALESCE(@employment_type, ''),
   COALESCE(@user_address, ''),
   COALESCE(@user_city, ''),
@@ -120,18 +108,12 @@ SET
   nationality = COALESCE($9, nationality),
   residential_country = $10,
   job_role = $11,
-  company_website = $12,
-  employment_type = $13,
-  company_name = $14,
-  company_address = $15,
-  company_city = $16,
-  company_postal_code = $17,
-  company_country = $18,
-  auth_provider = COALESCE($19, auth_provider),
-  provider_id = COALESCE($20, provider_id),
-  user_address = COALESCE($21, user_address),
-  user_city = COALESCE($22, user_city),
-  user_postal_code = COALESCE($23, user_postal_code),
+  employment_type = $12,
+  auth_provider = COALESCE($13, auth_provider),
+  provider_id = COALESCE($14, provider_id),
+  user_address = COALESCE($15, user_address),
+  user_city = COALESCE($16, user_city),
+  user_postal_code = COALESCE($17, user_postal_code),
   updated_at = now()
 WHERE id = $1
 RETURNING *;
@@ -171,17 +153,11 @@ SET
 WHERE id = $1
 RETURNING *;
 
--- name: UpdateUserCompanyDetails :one
--- Updates a user's company details
+-- name: UpdateUserEmploymentType :one
+-- Updates a user's employment type
 UPDATE users
 SET
-  company_name = COALESCE($2, company_name),
-  company_address = COALESCE($3, company_address),
-  company_city = COALESCE($4, company_city),
-  company_postal_code = COALESCE($5, company_postal_code),
-  company_country = COALESCE($6, company_country),
-  company_website = COALESCE($7, company_website),
-  employment_type = COALESCE($8, employment_type),
+  employment_type = COALESCE($2, employment_type),
   updated_at = now()
 WHERE id = $1
 RETURNING *;
@@ -0,0 +1,577 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: approvals.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countApprovalDecisions = `-- name: CountApprovalDecisions :one
+SELECT COUNT(*) FROM approval_decisions
+WHERE request_id = $1 AND decision = $2
+`
+
+type CountApprovalDecisionsParams struct {
+	RequestID uuid.UUID `json:"request_id"`
+	Decision  string    `json:"decision"`
+}
+
+func (q *Queries) CountApprovalDecisions(ctx context.Context, arg CountApprovalDecisionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countApprovalDecisions, arg.RequestID, arg.Decision)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countApprovalRequestsByOrganization = `-- name: CountApprovalRequestsByOrganization :one
+SELECT COUNT(*) FROM approval_requests
+WHERE organization_id = $1
+  AND ($2::text IS NULL OR status = $2)
+`
+
+type CountApprovalRequestsByOrganizationParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Status         pgtype.Text `json:"status"`
+}
+
+func (q *Queries) CountApprovalRequestsByOrganization(ctx context.Context, arg CountApprovalRequestsByOrganizationParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countApprovalRequestsByOrganization, arg.OrganizationID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createApprovalDecision = `-- name: CreateApprovalDecision :one
+INSERT INTO approval_decisions (
+  id,
+  request_id,
+  approver_id,
+  decision,
+  comment,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+)
+ON CONFLICT (request_id, approver_id) DO NOTHING
+RETURNING id, request_id, approver_id, decision, comment, created_at
+`
+
+type CreateApprovalDecisionParams struct {
+	ID         uuid.UUID   `json:"id"`
+	RequestID  uuid.UUID   `json:"request_id"`
+	ApproverID uuid.UUID   `json:"approver_id"`
+	Decision   string      `json:"decision"`
+	Comment    pgtype.Text `json:"comment"`
+}
+
+// Records an approver's decision unless they already decided, in which case
+// no row is returned
+func (q *Queries) CreateApprovalDecision(ctx context.Context, arg CreateApprovalDecisionParams) (ApprovalDecisions, error) {
+	row := q.db.QueryRow(ctx, createApprovalDecision,
+		arg.ID,
+		arg.RequestID,
+		arg.ApproverID,
+		arg.Decision,
+		arg.Comment,
+	)
+	var i ApprovalDecisions
+	err := row.Scan(
+		&i.ID,
+		&i.RequestID,
+		&i.ApproverID,
+		&i.Decision,
+		&i.Comment,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createApprovalPolicy = `-- name: CreateApprovalPolicy :one
+INSERT INTO approval_policies (
+  id,
+  organization_id,
+  name,
+  currency,
+  min_total_amount,
+  min_payout_amount,
+  required_approvals,
+  approver_ids,
+  approval_window_hours,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now(), now()
+) RETURNING id, organization_id, name, currency, min_total_amount, min_payout_amount, required_approvals, approver_ids, approval_window_hours, active, created_by, created_at, updated_at
+`
+
+type CreateApprovalPolicyParams struct {
+	ID                  uuid.UUID      `json:"id"`
+	OrganizationID      uuid.UUID      `json:"organization_id"`
+	Name                string         `json:"name"`
+	Currency            string         `json:"currency"`
+	MinTotalAmount      pgtype.Numeric `json:"min_total_amount"`
+	MinPayoutAmount     pgtype.Numeric `json:"min_payout_amount"`
+	RequiredApprovals   int32          `json:"required_approvals"`
+	ApproverIds         []uuid.UUID    `json:"approver_ids"`
+	ApprovalWindowHours int32          `json:"approval_window_hours"`
+	Active              bool           `json:"active"`
+	CreatedBy           pgtype.UUID    `json:"created_by"`
+}
+
+func (q *Queries) CreateApprovalPolicy(ctx context.Context, arg CreateApprovalPolicyParams) (ApprovalPolicies, error) {
+	row := q.db.QueryRow(ctx, createApprovalPolicy,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Currency,
+		arg.MinTotalAmount,
+		arg.MinPayoutAmount,
+		arg.RequiredApprovals,
+		arg.ApproverIds,
+		arg.ApprovalWindowHours,
+		arg.Active,
+		arg.CreatedBy,
+	)
+	var i ApprovalPolicies
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Currency,
+		&i.MinTotalAmount,
+		&i.MinPayoutAmount,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.ApprovalWindowHours,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createApprovalRequest = `-- name: CreateApprovalRequest :one
+INSERT INTO approval_requests (
+  id,
+  organization_id,
+  pay_run_id,
+  policy_id,
+  required_approvals,
+  approver_ids,
+  status,
+  requested_by,
+  expires_at,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, 'pending', $7, $8, now(), now()
+) RETURNING id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at
+`
+
+type CreateApprovalRequestParams struct {
+	ID                uuid.UUID   `json:"id"`
+	OrganizationID    uuid.UUID   `json:"organization_id"`
+	PayRunID          uuid.UUID   `json:"pay_run_id"`
+	PolicyID          pgtype.UUID `json:"policy_id"`
+	RequiredApprovals int32       `json:"required_approvals"`
+	ApproverIds       []uuid.UUID `json:"approver_ids"`
+	RequestedBy       uuid.UUID   `json:"requested_by"`
+	ExpiresAt         time.Time   `json:"expires_at"`
+}
+
+func (q *Queries) CreateApprovalRequest(ctx context.Context, arg CreateApprovalRequestParams) (ApprovalRequests, error) {
+	row := q.db.QueryRow(ctx, createApprovalRequest,
+		arg.ID,
+		arg.OrganizationID,
+		arg.PayRunID,
+		arg.PolicyID,
+		arg.RequiredApprovals,
+		arg.ApproverIds,
+		arg.RequestedBy,
+		arg.ExpiresAt,
+	)
+	var i ApprovalRequests
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.PayRunID,
+		&i.PolicyID,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const expireApprovalRequests = `-- name: ExpireApprovalRequests :many
+UPDATE approval_requests
+SET
+  status = 'expired',
+  decided_at = now(),
+  updated_at = now()
+WHERE status = 'pending' AND expires_at <= $1
+RETURNING id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at
+`
+
+// Expires pending requests whose approval window has passed
+func (q *Queries) ExpireApprovalRequests(ctx context.Context, expiresAt time.Time) ([]ApprovalRequests, error) {
+	rows, err := q.db.Query(ctx, expireApprovalRequests, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApprovalRequests{}
+	for rows.Next() {
+		var i ApprovalRequests
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.PayRunID,
+			&i.PolicyID,
+			&i.RequiredApprovals,
+			&i.ApproverIds,
+			&i.Status,
+			&i.RequestedBy,
+			&i.ExpiresAt,
+			&i.DecidedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getApprovalPolicyByID = `-- name: GetApprovalPolicyByID :one
+SELECT id, organization_id, name, currency, min_total_amount, min_payout_amount, required_approvals, approver_ids, approval_window_hours, active, created_by, created_at, updated_at FROM approval_policies
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetApprovalPolicyByID(ctx context.Context, id uuid.UUID) (ApprovalPolicies, error) {
+	row := q.db.QueryRow(ctx, getApprovalPolicyByID, id)
+	var i ApprovalPolicies
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Currency,
+		&i.MinTotalAmount,
+		&i.MinPayoutAmount,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.ApprovalWindowHours,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getApprovalRequestByID = `-- name: GetApprovalRequestByID :one
+SELECT id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at FROM approval_requests
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetApprovalRequestByID(ctx context.Context, id uuid.UUID) (ApprovalRequests, error) {
+	row := q.db.QueryRow(ctx, getApprovalRequestByID, id)
+	var i ApprovalRequests
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.PayRunID,
+		&i.PolicyID,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getApprovalRequestForUpdate = `-- name: GetApprovalRequestForUpdate :one
+SELECT id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at FROM approval_requests
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks a request while a decision on it is recorded
+func (q *Queries) GetApprovalRequestForUpdate(ctx context.Context, id uuid.UUID) (ApprovalRequests, error) {
+	row := q.db.QueryRow(ctx, getApprovalRequestForUpdate, id)
+	var i ApprovalRequests
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.PayRunID,
+		&i.PolicyID,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listApprovalDecisions = `-- name: ListApprovalDecisions :many
+SELECT d.id, d.request_id, d.approver_id, d.decision, d.comment, d.created_at, u.email, u.first_name, u.last_name
+FROM approval_decisions d
+JOIN users u ON u.id = d.approver_id
+WHERE d.request_id = $1
+ORDER BY d.created_at
+`
+
+type ListApprovalDecisionsRow struct {
+	ApprovalDecisions ApprovalDecisions `json:"approval_decisions"`
+	Email             string            `json:"email"`
+	FirstName         string            `json:"first_name"`
+	LastName          string            `json:"last_name"`
+}
+
+func (q *Queries) ListApprovalDecisions(ctx context.Context, requestID uuid.UUID) ([]ListApprovalDecisionsRow, error) {
+	rows, err := q.db.Query(ctx, listApprovalDecisions, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListApprovalDecisionsRow{}
+	for rows.Next() {
+		var i ListApprovalDecisionsRow
+		if err := rows.Scan(
+			&i.ApprovalDecisions.ID,
+			&i.ApprovalDecisions.RequestID,
+			&i.ApprovalDecisions.ApproverID,
+			&i.ApprovalDecisions.Decision,
+			&i.ApprovalDecisions.Comment,
+			&i.ApprovalDecisions.CreatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listApprovalPoliciesByOrganization = `-- name: ListApprovalPoliciesByOrganization :many
+SELECT id, organization_id, name, currency, min_total_amount, min_payout_amount, required_approvals, approver_ids, approval_window_hours, active, created_by, created_at, updated_at FROM approval_policies
+WHERE organization_id = $1
+ORDER BY active DESC, required_approvals DESC, created_at
+`
+
+func (q *Queries) ListApprovalPoliciesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ApprovalPolicies, error) {
+	rows, err := q.db.Query(ctx, listApprovalPoliciesByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApprovalPolicies{}
+	for rows.Next() {
+		var i ApprovalPolicies
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Currency,
+			&i.MinTotalAmount,
+			&i.MinPayoutAmount,
+			&i.RequiredApprovals,
+			&i.ApproverIds,
+			&i.ApprovalWindowHours,
+			&i.Active,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listApprovalRequestsByOrganization = `-- name: ListApprovalRequestsByOrganization :many
+SELECT id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at FROM approval_requests
+WHERE organization_id = $1
+  AND ($2::text IS NULL OR status = $2)
+ORDER BY created_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListApprovalRequestsByOrganizationParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Status         pgtype.Text `json:"status"`
+	OffsetCount    int32       `json:"offset_count"`
+	LimitCount     int32       `json:"limit_count"`
+}
+
+// Lists an organization's approval requests, optionally in one status
+func (q *Queries) ListApprovalRequestsByOrganization(ctx context.Context, arg ListApprovalRequestsByOrganizationParams) ([]ApprovalRequests, error) {
+	rows, err := q.db.Query(ctx, listApprovalRequestsByOrganization,
+		arg.OrganizationID,
+		arg.Status,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApprovalRequests{}
+	for rows.Next() {
+		var i ApprovalRequests
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.PayRunID,
+			&i.PolicyID,
+			&i.RequiredApprovals,
+			&i.ApproverIds,
+			&i.Status,
+			&i.RequestedBy,
+			&i.ExpiresAt,
+			&i.DecidedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveApprovalRequest = `-- name: ResolveApprovalRequest :one
+UPDATE approval_requests
+SET
+  status = $2,
+  decided_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, organization_id, pay_run_id, policy_id, required_approvals, approver_ids, status, requested_by, expires_at, decided_at, created_at, updated_at
+`
+
+type ResolveApprovalRequestParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+// Closes a pending request with its outcome
+func (q *Queries) ResolveApprovalRequest(ctx context.Context, arg ResolveApprovalRequestParams) (ApprovalRequests, error) {
+	row := q.db.QueryRow(ctx, resolveApprovalRequest, arg.ID, arg.Status)
+	var i ApprovalRequests
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.PayRunID,
+		&i.PolicyID,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateApprovalPolicy = `-- name: UpdateApprovalPolicy :one
+UPDATE approval_policies
+SET
+  name = $2,
+  currency = $3,
+  min_total_amount = $4,
+  min_payout_amount = $5,
+  required_approvals = $6,
+  approver_ids = $7,
+  approval_window_hours = $8,
+  active = $9,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, organization_id, name, currency, min_total_amount, min_payout_amount, required_approvals, approver_ids, approval_window_hours, active, created_by, created_at, updated_at
+`
+
+type UpdateApprovalPolicyParams struct {
+	ID                  uuid.UUID      `json:"id"`
+	Name                string         `json:"name"`
+	Currency            string         `json:"currency"`
+	MinTotalAmount      pgtype.Numeric `json:"min_total_amount"`
+	MinPayoutAmount     pgtype.Numeric `json:"min_payout_amount"`
+	RequiredApprovals   int32          `json:"required_approvals"`
+	ApproverIds         []uuid.UUID    `json:"approver_ids"`
+	ApprovalWindowHours int32          `json:"approval_window_hours"`
+	Active              bool           `json:"active"`
+}
+
+func (q *Queries) UpdateApprovalPolicy(ctx context.Context, arg UpdateApprovalPolicyParams) (ApprovalPolicies, error) {
+	row := q.db.QueryRow(ctx, updateApprovalPolicy,
+		arg.ID,
+		arg.Name,
+		arg.Currency,
+		arg.MinTotalAmount,
+		arg.MinPayoutAmount,
+		arg.RequiredApprovals,
+		arg.ApproverIds,
+		arg.ApprovalWindowHours,
+		arg.Active,
+	)
+	var i ApprovalPolicies
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Currency,
+		&i.MinTotalAmount,
+		&i.MinPayoutAmount,
+		&i.RequiredApprovals,
+		&i.ApproverIds,
+		&i.ApprovalWindowHours,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,389 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: clients.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countClients = `-- name: CountClients :one
+SELECT COUNT(*) FROM clients
+WHERE organization_id = $1
+  AND (
+    $2::text IS NULL
+    OR position(lower($2::text) IN lower(name)) > 0
+    OR position(lower($2::text) IN email) > 0
+    OR position(upper($2::text) IN tax_id) > 0
+  )
+`
+
+type CountClientsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Search         pgtype.Text `json:"search"`
+}
+
+func (q *Queries) CountClients(ctx context.Context, arg CountClientsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countClients, arg.OrganizationID, arg.Search)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createClient = `-- name: CreateClient :one
+INSERT INTO clients (
+  id,
+  organization_id,
+  name,
+  email,
+  cc_emails,
+  tax_id,
+  address_line1,
+  address_line2,
+  city,
+  region,
+  postal_code,
+  country,
+  preferred_currency,
+  preferred_asset_id,
+  payment_terms_days,
+  notes,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, now(), now()
+)
+ON CONFLICT DO NOTHING
+RETURNING id, organization_id, name, email, cc_emails, tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, preferred_asset_id, payment_terms_days, notes, created_by, created_at, updated_at
+`
+
+type CreateClientParams struct {
+	ID                uuid.UUID   `json:"id"`
+	OrganizationID    uuid.UUID   `json:"organization_id"`
+	Name              string      `json:"name"`
+	Email             string      `json:"email"`
+	CcEmails          []string    `json:"cc_emails"`
+	TaxID             string      `json:"tax_id"`
+	AddressLine1      string      `json:"address_line1"`
+	AddressLine2      string      `json:"address_line2"`
+	City              string      `json:"city"`
+	Region            string      `json:"region"`
+	PostalCode        string      `json:"postal_code"`
+	Country           string      `json:"country"`
+	PreferredCurrency string      `json:"preferred_currency"`
+	PreferredAssetID  pgtype.UUID `json:"preferred_asset_id"`
+	PaymentTermsDays  int32       `json:"payment_terms_days"`
+	Notes             string      `json:"notes"`
+	CreatedBy         pgtype.UUID `json:"created_by"`
+}
+
+// Adds a client to the directory; no row is returned if the organization
+// already has a client with the same email or tax ID
+func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Clients, error) {
+	row := q.db.QueryRow(ctx, createClient,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Email,
+		arg.CcEmails,
+		arg.TaxID,
+		arg.AddressLine1,
+		arg.AddressLine2,
+		arg.City,
+		arg.Region,
+		arg.PostalCode,
+		arg.Country,
+		arg.PreferredCurrency,
+		arg.PreferredAssetID,
+		arg.PaymentTermsDays,
+		arg.Notes,
+		arg.CreatedBy,
+	)
+	var i Clients
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Email,
+		&i.CcEmails,
+		&i.TaxID,
+		&i.AddressLine1,
+		&i.AddressLine2,
+		&i.City,
+		&i.Region,
+		&i.PostalCode,
+		&i.Country,
+		&i.PreferredCurrency,
+		&i.PreferredAssetID,
+		&i.PaymentTermsDays,
+		&i.Notes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteClient = `-- name: DeleteClient :execrows
+DELETE FROM clients
+WHERE organization_id = $1 AND id = $2
+`
+
+type DeleteClientParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ID             uuid.UUID `json:"id"`
+}
+
+// Removes a client from the directory; its invoices keep their copy of its details
+func (q *Queries) DeleteClient(ctx context.Context, arg DeleteClientParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClient, arg.OrganizationID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findDuplicateClient = `-- name: FindDuplicateClient :one
+SELECT id, organization_id, name, email, cc_emails, tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, preferred_asset_id, payment_terms_days, notes, created_by, created_at, updated_at FROM clients
+WHERE organization_id = $1
+  AND id <> $2
+  AND (email = $3 OR ($4::text <> '' AND tax_id = $4::text))
+ORDER BY created_at
+LIMIT 1
+`
+
+type FindDuplicateClientParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ExcludeID      uuid.UUID `json:"exclude_id"`
+	Email          string    `json:"email"`
+	TaxID          string    `json:"tax_id"`
+}
+
+// Finds another of the organization's clients with the same email or tax ID
+func (q *Queries) FindDuplicateClient(ctx context.Context, arg FindDuplicateClientParams) (Clients, error) {
+	row := q.db.QueryRow(ctx, findDuplicateClient,
+		arg.OrganizationID,
+		arg.ExcludeID,
+		arg.Email,
+		arg.TaxID,
+	)
+	var i Clients
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Email,
+		&i.CcEmails,
+		&i.TaxID,
+		&i.AddressLine1,
+		&i.AddressLine2,
+		&i.City,
+		&i.Region,
+		&i.PostalCode,
+		&i.Country,
+		&i.PreferredCurrency,
+		&i.PreferredAssetID,
+		&i.PaymentTermsDays,
+		&i.Notes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getClient = `-- name: GetClient :one
+SELECT id, organization_id, name, email, cc_emails, tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, preferred_asset_id, payment_terms_days, notes, created_by, created_at, updated_at FROM clients
+WHERE organization_id = $1 AND id = $2
+LIMIT 1
+`
+
+type GetClientParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ID             uuid.UUID `json:"id"`
+}
+
+func (q *Queries) GetClient(ctx context.Context, arg GetClientParams) (Clients, error) {
+	row := q.db.QueryRow(ctx, getClient, arg.OrganizationID, arg.ID)
+	var i Clients
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Email,
+		&i.CcEmails,
+		&i.TaxID,
+		&i.AddressLine1,
+		&i.AddressLine2,
+		&i.City,
+		&i.Region,
+		&i.PostalCode,
+		&i.Country,
+		&i.PreferredCurrency,
+		&i.PreferredAssetID,
+		&i.PaymentTermsDays,
+		&i.Notes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listClients = `-- name: ListClients :many
+SELECT id, organization_id, name, email, cc_emails, tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, preferred_asset_id, payment_terms_days, notes, created_by, created_at, updated_at FROM clients
+WHERE organization_id = $1
+  AND (
+    $2::text IS NULL
+    OR position(lower($2::text) IN lower(name)) > 0
+    OR position(lower($2::text) IN email) > 0
+    OR position(upper($2::text) IN tax_id) > 0
+  )
+ORDER BY lower(name), created_at
+LIMIT $4 OFFSET $3
+`
+
+type ListClientsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Search         pgtype.Text `json:"search"`
+	OffsetCount    int32       `json:"offset_count"`
+	LimitCount     int32       `json:"limit_count"`
+}
+
+// Lists an organization's clients by name, optionally only those whose name,
+// email or tax ID contains the search text
+func (q *Queries) ListClients(ctx context.Context, arg ListClientsParams) ([]Clients, error) {
+	rows, err := q.db.Query(ctx, listClients,
+		arg.OrganizationID,
+		arg.Search,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Clients{}
+	for rows.Next() {
+		var i Clients
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Email,
+			&i.CcEmails,
+			&i.TaxID,
+			&i.AddressLine1,
+			&i.AddressLine2,
+			&i.City,
+			&i.Region,
+			&i.PostalCode,
+			&i.Country,
+			&i.PreferredCurrency,
+			&i.PreferredAssetID,
+			&i.PaymentTermsDays,
+			&i.Notes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateClient = `-- name: UpdateClient :one
+UPDATE clients
+SET
+  name = $3,
+  email = $4,
+  cc_emails = $5,
+  tax_id = $6,
+  address_line1 = $7,
+  address_line2 = $8,
+  city = $9,
+  region = $10,
+  postal_code = $11,
+  country = $12,
+  preferred_currency = $13,
+  preferred_asset_id = $14,
+  payment_terms_days = $15,
+  notes = $16,
+  updated_at = now()
+WHERE organization_id = $1 AND id = $2
+RETURNING id, organization_id, name, email, cc_emails, tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, preferred_asset_id, payment_terms_days, notes, created_by, created_at, updated_at
+`
+
+type UpdateClientParams struct {
+	OrganizationID    uuid.UUID   `json:"organization_id"`
+	ID                uuid.UUID   `json:"id"`
+	Name              string      `json:"name"`
+	Email             string      `json:"email"`
+	CcEmails          []string    `json:"cc_emails"`
+	TaxID             string      `json:"tax_id"`
+	AddressLine1      string      `json:"address_line1"`
+	AddressLine2      string      `json:"address_line2"`
+	City              string      `json:"city"`
+	Region            string      `json:"region"`
+	PostalCode        string      `json:"postal_code"`
+	Country           string      `json:"country"`
+	PreferredCurrency string      `json:"preferred_currency"`
+	PreferredAssetID  pgtype.UUID `json:"preferred_asset_id"`
+	PaymentTermsDays  int32       `json:"payment_terms_days"`
+	Notes             string      `json:"notes"`
+}
+
+func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (Clients, error) {
+	row := q.db.QueryRow(ctx, updateClient,
+		arg.OrganizationID,
+		arg.ID,
+		arg.Name,
+		arg.Email,
+		arg.CcEmails,
+		arg.TaxID,
+		arg.AddressLine1,
+		arg.AddressLine2,
+		arg.City,
+		arg.Region,
+		arg.PostalCode,
+		arg.Country,
+		arg.PreferredCurrency,
+		arg.PreferredAssetID,
+		arg.PaymentTermsDays,
+		arg.Notes,
+	)
+	var i Clients
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Email,
+		&i.CcEmails,
+		&i.TaxID,
+		&i.AddressLine1,
+		&i.AddressLine2,
+		&i.City,
+		&i.Region,
+		&i.PostalCode,
+		&i.Country,
+		&i.PreferredCurrency,
+		&i.PreferredAssetID,
+		&i.PaymentTermsDays,
+		&i.Notes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,293 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: credit_notes.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const applyInvoiceCredit = `-- name: ApplyInvoiceCredit :one
+UPDATE invoices
+SET
+  amount_credited = amount_credited + $1,
+  status = $2,
+  updated_at = now()
+WHERE id = $3
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type ApplyInvoiceCreditParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	Status string          `json:"status"`
+	ID     uuid.UUID       `json:"id"`
+}
+
+// Adds a credit note to what has been credited on an invoice, moving it to
+// the status the remaining balance puts it in
+func (q *Queries) ApplyInvoiceCredit(ctx context.Context, arg ApplyInvoiceCreditParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, applyInvoiceCredit, arg.Amount, arg.Status, arg.ID)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const countCreditNotesByOrganization = `-- name: CountCreditNotesByOrganization :one
+SELECT COUNT(*) FROM credit_notes
+WHERE organization_id = $1
+`
+
+func (q *Queries) CountCreditNotesByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCreditNotesByOrganization, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCreditNote = `-- name: CreateCreditNote :one
+INSERT INTO credit_notes (
+  id,
+  organization_id,
+  invoice_id,
+  number,
+  currency,
+  amount,
+  tax_amount,
+  reason,
+  ledger_entry_id,
+  issued_at,
+  created_by,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+) RETURNING id, organization_id, invoice_id, number, currency, amount, tax_amount, reason, ledger_entry_id, issued_at, created_by, created_at
+`
+
+type CreateCreditNoteParams struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	InvoiceID      uuid.UUID       `json:"invoice_id"`
+	Number         int64           `json:"number"`
+	Currency       string          `json:"currency"`
+	Amount         decimal.Decimal `json:"amount"`
+	TaxAmount      decimal.Decimal `json:"tax_amount"`
+	Reason         string          `json:"reason"`
+	LedgerEntryID  uuid.UUID       `json:"ledger_entry_id"`
+	IssuedAt       time.Time       `json:"issued_at"`
+	CreatedBy      pgtype.UUID     `json:"created_by"`
+}
+
+func (q *Queries) CreateCreditNote(ctx context.Context, arg CreateCreditNoteParams) (CreditNotes, error) {
+	row := q.db.QueryRow(ctx, createCreditNote,
+		arg.ID,
+		arg.OrganizationID,
+		arg.InvoiceID,
+		arg.Number,
+		arg.Currency,
+		arg.Amount,
+		arg.TaxAmount,
+		arg.Reason,
+		arg.LedgerEntryID,
+		arg.IssuedAt,
+		arg.CreatedBy,
+	)
+	var i CreditNotes
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.InvoiceID,
+		&i.Number,
+		&i.Currency,
+		&i.Amount,
+		&i.TaxAmount,
+		&i.Reason,
+		&i.LedgerEntryID,
+		&i.IssuedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCreditNote = `-- name: GetCreditNote :one
+SELECT id, organization_id, invoice_id, number, currency, amount, tax_amount, reason, ledger_entry_id, issued_at, created_by, created_at FROM credit_notes
+WHERE organization_id = $1 AND id = $2
+LIMIT 1
+`
+
+type GetCreditNoteParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ID             uuid.UUID `json:"id"`
+}
+
+func (q *Queries) GetCreditNote(ctx context.Context, arg GetCreditNoteParams) (CreditNotes, error) {
+	row := q.db.QueryRow(ctx, getCreditNote, arg.OrganizationID, arg.ID)
+	var i CreditNotes
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.InvoiceID,
+		&i.Number,
+		&i.Currency,
+		&i.Amount,
+		&i.TaxAmount,
+		&i.Reason,
+		&i.LedgerEntryID,
+		&i.IssuedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCreditNotesByInvoice = `-- name: ListCreditNotesByInvoice :many
+SELECT id, organization_id, invoice_id, number, currency, amount, tax_amount, reason, ledger_entry_id, issued_at, created_by, created_at FROM credit_notes
+WHERE invoice_id = $1
+ORDER BY number
+`
+
+func (q *Queries) ListCreditNotesByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]CreditNotes, error) {
+	rows, err := q.db.Query(ctx, listCreditNotesByInvoice, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CreditNotes{}
+	for rows.Next() {
+		var i CreditNotes
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.InvoiceID,
+			&i.Number,
+			&i.Currency,
+			&i.Amount,
+			&i.TaxAmount,
+			&i.Reason,
+			&i.LedgerEntryID,
+			&i.IssuedAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCreditNotesByOrganization = `-- name: ListCreditNotesByOrganization :many
+SELECT id, organization_id, invoice_id, number, currency, amount, tax_amount, reason, ledger_entry_id, issued_at, created_by, created_at FROM credit_notes
+WHERE organization_id = $1
+ORDER BY number DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListCreditNotesByOrganizationParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	OffsetCount    int32     `json:"offset_count"`
+	LimitCount     int32     `json:"limit_count"`
+}
+
+// Lists an organization's credit notes, latest number first
+func (q *Queries) ListCreditNotesByOrganization(ctx context.Context, arg ListCreditNotesByOrganizationParams) ([]CreditNotes, error) {
+	rows, err := q.db.Query(ctx, listCreditNotesByOrganization, arg.OrganizationID, arg.OffsetCount, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CreditNotes{}
+	for rows.Next() {
+		var i CreditNotes
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.InvoiceID,
+			&i.Number,
+			&i.Currency,
+			&i.Amount,
+			&i.TaxAmount,
+			&i.Reason,
+			&i.LedgerEntryID,
+			&i.IssuedAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextCreditNoteNumber = `-- name: NextCreditNoteNumber :one
+INSERT INTO credit_note_sequences (organization_id, last_number, updated_at)
+VALUES ($1, 1, now())
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  last_number = credit_note_sequences.last_number + 1,
+  updated_at = now()
+RETURNING last_number
+`
+
+// Hands out the organization's next credit note number. Like invoice numbers,
+// the sequence row stays locked until the calling transaction ends.
+func (q *Queries) NextCreditNoteNumber(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, nextCreditNoteNumber, organizationID)
+	var last_number int64
+	err := row.Scan(&last_number)
+	return last_number, err
+}
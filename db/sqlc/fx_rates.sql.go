@@ -0,0 +1,244 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: fx_rates.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createFXQuote = `-- name: CreateFXQuote :one
+INSERT INTO fx_quotes (
+  id,
+  base_currency,
+  quote_currency,
+  rate,
+  source,
+  expires_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+) RETURNING id, base_currency, quote_currency, rate, source, expires_at, created_at
+`
+
+type CreateFXQuoteParams struct {
+	ID            uuid.UUID       `json:"id"`
+	BaseCurrency  string          `json:"base_currency"`
+	QuoteCurrency string          `json:"quote_currency"`
+	Rate          decimal.Decimal `json:"rate"`
+	Source        string          `json:"source"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+}
+
+// Locks an exchange rate until expires_at
+func (q *Queries) CreateFXQuote(ctx context.Context, arg CreateFXQuoteParams) (FxQuotes, error) {
+	row := q.db.QueryRow(ctx, createFXQuote,
+		arg.ID,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.Rate,
+		arg.Source,
+		arg.ExpiresAt,
+	)
+	var i FxQuotes
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.Rate,
+		&i.Source,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createFXRate = `-- name: CreateFXRate :one
+INSERT INTO fx_rates (
+  id,
+  base_currency,
+  quote_currency,
+  rate,
+  source,
+  fetched_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+) RETURNING id, base_currency, quote_currency, rate, source, fetched_at, created_at
+`
+
+type CreateFXRateParams struct {
+	ID            uuid.UUID       `json:"id"`
+	BaseCurrency  string          `json:"base_currency"`
+	QuoteCurrency string          `json:"quote_currency"`
+	Rate          decimal.Decimal `json:"rate"`
+	Source        string          `json:"source"`
+	FetchedAt     time.Time       `json:"fetched_at"`
+}
+
+// Records a fetched exchange rate
+func (q *Queries) CreateFXRate(ctx context.Context, arg CreateFXRateParams) (FxRates, error) {
+	row := q.db.QueryRow(ctx, createFXRate,
+		arg.ID,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.Rate,
+		arg.Source,
+		arg.FetchedAt,
+	)
+	var i FxRates
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.Rate,
+		&i.Source,
+		&i.FetchedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFXQuoteByID = `-- name: GetFXQuoteByID :one
+SELECT id, base_currency, quote_currency, rate, source, expires_at, created_at FROM fx_quotes
+WHERE id = $1
+LIMIT 1
+`
+
+// Retrieves a locked quote by ID
+func (q *Queries) GetFXQuoteByID(ctx context.Context, id uuid.UUID) (FxQuotes, error) {
+	row := q.db.QueryRow(ctx, getFXQuoteByID, id)
+	var i FxQuotes
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.Rate,
+		&i.Source,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestFXRate = `-- name: GetLatestFXRate :one
+SELECT id, base_currency, quote_currency, rate, source, fetched_at, created_at FROM fx_rates
+WHERE base_currency = $1 AND quote_currency = $2
+ORDER BY fetched_at DESC
+LIMIT 1
+`
+
+type GetLatestFXRateParams struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+}
+
+// Retrieves the most recently fetched rate for a currency pair
+func (q *Queries) GetLatestFXRate(ctx context.Context, arg GetLatestFXRateParams) (FxRates, error) {
+	row := q.db.QueryRow(ctx, getLatestFXRate, arg.BaseCurrency, arg.QuoteCurrency)
+	var i FxRates
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.Rate,
+		&i.Source,
+		&i.FetchedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listFXRateHistory = `-- name: ListFXRateHistory :many
+SELECT id, base_currency, quote_currency, rate, source, fetched_at, created_at FROM fx_rates
+WHERE base_currency = $1
+  AND quote_currency = $2
+  AND fetched_at >= $3
+  AND fetched_at < $4
+ORDER BY fetched_at DESC
+LIMIT $5
+`
+
+type ListFXRateHistoryParams struct {
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	FetchedFrom   time.Time `json:"fetched_from"`
+	FetchedTo     time.Time `json:"fetched_to"`
+	RowLimit      int32     `json:"row_limit"`
+}
+
+// Lists the rates of a pair fetched in a time range, newest first
+func (q *Queries) ListFXRateHistory(ctx context.Context, arg ListFXRateHistoryParams) ([]FxRates, error) {
+	rows, err := q.db.Query(ctx, listFXRateHistory,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.FetchedFrom,
+		arg.FetchedTo,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FxRates{}
+	for rows.Next() {
+		var i FxRates
+		if err := rows.Scan(
+			&i.ID,
+			&i.BaseCurrency,
+			&i.QuoteCurrency,
+			&i.Rate,
+			&i.Source,
+			&i.FetchedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestFXRates = `-- name: ListLatestFXRates :many
+SELECT DISTINCT ON (quote_currency) id, base_currency, quote_currency, rate, source, fetched_at, created_at FROM fx_rates
+WHERE base_currency = $1
+ORDER BY quote_currency, fetched_at DESC
+`
+
+// Lists the most recently fetched rate of every pair with the given base
+func (q *Queries) ListLatestFXRates(ctx context.Context, baseCurrency string) ([]FxRates, error) {
+	rows, err := q.db.Query(ctx, listLatestFXRates, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FxRates{}
+	for rows.Next() {
+		var i FxRates
+		if err := rows.Scan(
+			&i.ID,
+			&i.BaseCurrency,
+			&i.QuoteCurrency,
+			&i.Rate,
+			&i.Source,
+			&i.FetchedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
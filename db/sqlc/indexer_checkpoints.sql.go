@@ -0,0 +1,65 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: indexer_checkpoints.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const getIndexerCheckpoint = `-- name: GetIndexerCheckpoint :one
+SELECT name, block_number, block_hash, updated_at FROM indexer_checkpoints
+WHERE name = $1
+LIMIT 1
+`
+
+// Retrieves the last processed block of an indexer
+func (q *Queries) GetIndexerCheckpoint(ctx context.Context, name string) (IndexerCheckpoints, error) {
+	row := q.db.QueryRow(ctx, getIndexerCheckpoint, name)
+	var i IndexerCheckpoints
+	err := row.Scan(
+		&i.Name,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertIndexerCheckpoint = `-- name: UpsertIndexerCheckpoint :one
+INSERT INTO indexer_checkpoints (
+  name,
+  block_number,
+  block_hash,
+  updated_at
+) VALUES (
+  $1, $2, $3, now()
+)
+ON CONFLICT (name) DO UPDATE
+SET
+  block_number = EXCLUDED.block_number,
+  block_hash = EXCLUDED.block_hash,
+  updated_at = now()
+RETURNING name, block_number, block_hash, updated_at
+`
+
+type UpsertIndexerCheckpointParams struct {
+	Name        string `json:"name"`
+	BlockNumber int64  `json:"block_number"`
+	BlockHash   string `json:"block_hash"`
+}
+
+// Records the last processed block of an indexer
+func (q *Queries) UpsertIndexerCheckpoint(ctx context.Context, arg UpsertIndexerCheckpointParams) (IndexerCheckpoints, error) {
+	row := q.db.QueryRow(ctx, upsertIndexerCheckpoint, arg.Name, arg.BlockNumber, arg.BlockHash)
+	var i IndexerCheckpoints
+	err := row.Scan(
+		&i.Name,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
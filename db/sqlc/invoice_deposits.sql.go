@@ -0,0 +1,243 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: invoice_deposits.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const allocateDepositIndex = `-- name: AllocateDepositIndex :one
+UPDATE organization_deposit_keys
+SET
+  next_index = next_index + 1,
+  updated_at = now()
+WHERE organization_id = $1
+RETURNING extended_public_key, (next_index - 1)::bigint AS deposit_index
+`
+
+type AllocateDepositIndexRow struct {
+	ExtendedPublicKey string `json:"extended_public_key"`
+	DepositIndex      int64  `json:"deposit_index"`
+}
+
+// Hands out the organization's next receiving address index together with the
+// key to derive it from
+func (q *Queries) AllocateDepositIndex(ctx context.Context, organizationID uuid.UUID) (AllocateDepositIndexRow, error) {
+	row := q.db.QueryRow(ctx, allocateDepositIndex, organizationID)
+	var i AllocateDepositIndexRow
+	err := row.Scan(&i.ExtendedPublicKey, &i.DepositIndex)
+	return i, err
+}
+
+const createInvoicePayment = `-- name: CreateInvoicePayment :one
+INSERT INTO invoice_payments (
+  id,
+  invoice_id,
+  tx_hash,
+  log_index,
+  transfer_ordinal,
+  token_address,
+  from_address,
+  to_address,
+  amount,
+  block_number,
+  applied,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+)
+ON CONFLICT (tx_hash, token_address, to_address, transfer_ordinal) DO NOTHING
+RETURNING id, invoice_id, tx_hash, log_index, token_address, from_address, to_address, amount, block_number, created_at, transfer_ordinal, applied
+`
+
+type CreateInvoicePaymentParams struct {
+	ID              uuid.UUID       `json:"id"`
+	InvoiceID       uuid.UUID       `json:"invoice_id"`
+	TxHash          string          `json:"tx_hash"`
+	LogIndex        int32           `json:"log_index"`
+	TransferOrdinal int32           `json:"transfer_ordinal"`
+	TokenAddress    string          `json:"token_address"`
+	FromAddress     string          `json:"from_address"`
+	ToAddress       string          `json:"to_address"`
+	Amount          decimal.Decimal `json:"amount"`
+	BlockNumber     int64           `json:"block_number"`
+	Applied         bool            `json:"applied"`
+}
+
+// Records a transfer into an invoice deposit address; a transfer that was
+// already recorded, even in another block after a reorg re-mined its
+// transaction, returns no row
+func (q *Queries) CreateInvoicePayment(ctx context.Context, arg CreateInvoicePaymentParams) (InvoicePayments, error) {
+	row := q.db.QueryRow(ctx, createInvoicePayment,
+		arg.ID,
+		arg.InvoiceID,
+		arg.TxHash,
+		arg.LogIndex,
+		arg.TransferOrdinal,
+		arg.TokenAddress,
+		arg.FromAddress,
+		arg.ToAddress,
+		arg.Amount,
+		arg.BlockNumber,
+		arg.Applied,
+	)
+	var i InvoicePayments
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.TxHash,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.BlockNumber,
+		&i.CreatedAt,
+		&i.TransferOrdinal,
+		&i.Applied,
+	)
+	return i, err
+}
+
+const deleteOrganizationDepositKey = `-- name: DeleteOrganizationDepositKey :exec
+DELETE FROM organization_deposit_keys
+WHERE organization_id = $1
+`
+
+func (q *Queries) DeleteOrganizationDepositKey(ctx context.Context, organizationID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationDepositKey, organizationID)
+	return err
+}
+
+const getOrganizationDepositKey = `-- name: GetOrganizationDepositKey :one
+SELECT organization_id, extended_public_key, next_index, tolerance_bps, created_by, created_at, updated_at FROM organization_deposit_keys
+WHERE organization_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationDepositKey(ctx context.Context, organizationID uuid.UUID) (OrganizationDepositKeys, error) {
+	row := q.db.QueryRow(ctx, getOrganizationDepositKey, organizationID)
+	var i OrganizationDepositKeys
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.ExtendedPublicKey,
+		&i.NextIndex,
+		&i.ToleranceBps,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listInvoicePayments = `-- name: ListInvoicePayments :many
+SELECT id, invoice_id, tx_hash, log_index, token_address, from_address, to_address, amount, block_number, created_at, transfer_ordinal, applied FROM invoice_payments
+WHERE invoice_id = $1
+ORDER BY block_number, log_index
+`
+
+func (q *Queries) ListInvoicePayments(ctx context.Context, invoiceID uuid.UUID) ([]InvoicePayments, error) {
+	rows, err := q.db.Query(ctx, listInvoicePayments, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InvoicePayments{}
+	for rows.Next() {
+		var i InvoicePayments
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.TxHash,
+			&i.LogIndex,
+			&i.TokenAddress,
+			&i.FromAddress,
+			&i.ToAddress,
+			&i.Amount,
+			&i.BlockNumber,
+			&i.CreatedAt,
+			&i.TransferOrdinal,
+			&i.Applied,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumInvoicePayments = `-- name: SumInvoicePayments :one
+SELECT COALESCE(SUM(amount), 0)::numeric AS total
+FROM invoice_payments
+WHERE invoice_id = $1 AND applied
+`
+
+func (q *Queries) SumInvoicePayments(ctx context.Context, invoiceID uuid.UUID) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, sumInvoicePayments, invoiceID)
+	var total decimal.Decimal
+	err := row.Scan(&total)
+	return total, err
+}
+
+const upsertOrganizationDepositKey = `-- name: UpsertOrganizationDepositKey :one
+INSERT INTO organization_deposit_keys (
+  organization_id,
+  extended_public_key,
+  next_index,
+  tolerance_bps,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, 0, $3, $4, now(), now()
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  extended_public_key = EXCLUDED.extended_public_key,
+  next_index = CASE
+    WHEN organization_deposit_keys.extended_public_key = EXCLUDED.extended_public_key THEN organization_deposit_keys.next_index
+    ELSE 0
+  END,
+  tolerance_bps = EXCLUDED.tolerance_bps,
+  updated_at = now()
+RETURNING organization_id, extended_public_key, next_index, tolerance_bps, created_by, created_at, updated_at
+`
+
+type UpsertOrganizationDepositKeyParams struct {
+	OrganizationID    uuid.UUID   `json:"organization_id"`
+	ExtendedPublicKey string      `json:"extended_public_key"`
+	ToleranceBps      int32       `json:"tolerance_bps"`
+	CreatedBy         pgtype.UUID `json:"created_by"`
+}
+
+// Sets an organization's deposit key. A different key starts again at the
+// first receiving address; saving the same key only changes the tolerance.
+func (q *Queries) UpsertOrganizationDepositKey(ctx context.Context, arg UpsertOrganizationDepositKeyParams) (OrganizationDepositKeys, error) {
+	row := q.db.QueryRow(ctx, upsertOrganizationDepositKey,
+		arg.OrganizationID,
+		arg.ExtendedPublicKey,
+		arg.ToleranceBps,
+		arg.CreatedBy,
+	)
+	var i OrganizationDepositKeys
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.ExtendedPublicKey,
+		&i.NextIndex,
+		&i.ToleranceBps,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
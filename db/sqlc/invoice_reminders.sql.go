@@ -0,0 +1,276 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: invoice_reminders.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createInvoiceReminder = `-- name: CreateInvoiceReminder :one
+INSERT INTO invoice_reminders (
+  id,
+  invoice_id,
+  offset_days,
+  recipient,
+  sent_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (invoice_id, offset_days) DO NOTHING
+RETURNING id, invoice_id, offset_days, recipient, sent_at
+`
+
+type CreateInvoiceReminderParams struct {
+	ID         uuid.UUID `json:"id"`
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int32     `json:"offset_days"`
+	Recipient  string    `json:"recipient"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// Records a reminder step before it is sent; a step that was already
+// recorded returns no row
+func (q *Queries) CreateInvoiceReminder(ctx context.Context, arg CreateInvoiceReminderParams) (InvoiceReminders, error) {
+	row := q.db.QueryRow(ctx, createInvoiceReminder,
+		arg.ID,
+		arg.InvoiceID,
+		arg.OffsetDays,
+		arg.Recipient,
+		arg.SentAt,
+	)
+	var i InvoiceReminders
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.OffsetDays,
+		&i.Recipient,
+		&i.SentAt,
+	)
+	return i, err
+}
+
+const deleteInvoiceReminder = `-- name: DeleteInvoiceReminder :exec
+DELETE FROM invoice_reminders
+WHERE id = $1
+`
+
+func (q *Queries) DeleteInvoiceReminder(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInvoiceReminder, id)
+	return err
+}
+
+const getInvoiceReminderSettings = `-- name: GetInvoiceReminderSettings :one
+SELECT organization_id, enabled, offset_days, updated_by, created_at, updated_at FROM invoice_reminder_settings
+WHERE organization_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetInvoiceReminderSettings(ctx context.Context, organizationID uuid.UUID) (InvoiceReminderSettings, error) {
+	row := q.db.QueryRow(ctx, getInvoiceReminderSettings, organizationID)
+	var i InvoiceReminderSettings
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Enabled,
+		&i.OffsetDays,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDueInvoiceReminders = `-- name: ListDueInvoiceReminders :many
+WITH p AS (
+  SELECT
+    $2::timestamptz AS now,
+    $3::integer AS max_attempts,
+    $4::integer AS retry_backoff_seconds
+)
+SELECT d.invoice_id, d.offset_days
+FROM p, (
+  SELECT DISTINCT ON (i.id)
+    i.id AS invoice_id,
+    o.offset_days::integer AS offset_days
+  FROM invoices i
+  JOIN invoice_reminder_settings s ON s.organization_id = i.organization_id AND s.enabled
+  CROSS JOIN p
+  CROSS JOIN LATERAL unnest(s.offset_days) AS o(offset_days)
+  WHERE i.status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+    AND i.sent_at IS NOT NULL
+    AND i.due_date + o.offset_days * interval '1 day' <= p.now
+    AND i.due_date + o.offset_days * interval '1 day' > i.sent_at
+    AND NOT EXISTS (
+      SELECT 1 FROM invoice_reminders r
+      WHERE r.invoice_id = i.id AND r.offset_days >= o.offset_days
+    )
+    AND NOT EXISTS (
+      SELECT 1 FROM invoice_reminder_failures g
+      WHERE g.invoice_id = i.id AND g.offset_days >= o.offset_days AND g.attempts >= p.max_attempts
+    )
+  ORDER BY i.id, o.offset_days DESC
+) d
+LEFT JOIN invoice_reminder_failures f ON f.invoice_id = d.invoice_id AND f.offset_days = d.offset_days
+WHERE f.invoice_id IS NULL
+  OR f.failed_at + make_interval(secs => p.retry_backoff_seconds * power(2, f.attempts - 1)) <= p.now
+ORDER BY COALESCE(f.attempts, 0), d.invoice_id
+LIMIT $1
+`
+
+type ListDueInvoiceRemindersParams struct {
+	BatchSize           int32     `json:"batch_size"`
+	Now                 time.Time `json:"now"`
+	MaxAttempts         int32     `json:"max_attempts"`
+	RetryBackoffSeconds int32     `json:"retry_backoff_seconds"`
+}
+
+type ListDueInvoiceRemindersRow struct {
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int32     `json:"offset_days"`
+}
+
+// Finds, per invoice awaiting payment, the latest reminder step that has come
+// due and has not been sent. Steps that came due before the invoice was sent
+// are skipped, as are steps earlier than one already sent or given up, so
+// catching up after downtime sends one reminder rather than every missed one.
+// A step whose email failed waits out a backoff that doubles with each
+// attempt, is given up after max_attempts, and is listed after steps that
+// have not failed so retries never crowd them out of a batch.
+func (q *Queries) ListDueInvoiceReminders(ctx context.Context, arg ListDueInvoiceRemindersParams) ([]ListDueInvoiceRemindersRow, error) {
+	rows, err := q.db.Query(ctx, listDueInvoiceReminders,
+		arg.BatchSize,
+		arg.Now,
+		arg.MaxAttempts,
+		arg.RetryBackoffSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDueInvoiceRemindersRow{}
+	for rows.Next() {
+		var i ListDueInvoiceRemindersRow
+		if err := rows.Scan(&i.InvoiceID, &i.OffsetDays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoiceReminders = `-- name: ListInvoiceReminders :many
+SELECT id, invoice_id, offset_days, recipient, sent_at FROM invoice_reminders
+WHERE invoice_id = $1
+ORDER BY sent_at
+`
+
+func (q *Queries) ListInvoiceReminders(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceReminders, error) {
+	rows, err := q.db.Query(ctx, listInvoiceReminders, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InvoiceReminders{}
+	for rows.Next() {
+		var i InvoiceReminders
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.OffsetDays,
+			&i.Recipient,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordInvoiceReminderFailure = `-- name: RecordInvoiceReminderFailure :one
+INSERT INTO invoice_reminder_failures (
+  invoice_id,
+  offset_days,
+  attempts,
+  failed_at
+) VALUES (
+  $1, $2, 1, $3
+)
+ON CONFLICT (invoice_id, offset_days) DO UPDATE
+SET
+  attempts = invoice_reminder_failures.attempts + 1,
+  failed_at = EXCLUDED.failed_at
+RETURNING attempts
+`
+
+type RecordInvoiceReminderFailureParams struct {
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int32     `json:"offset_days"`
+	FailedAt   time.Time `json:"failed_at"`
+}
+
+// Counts a failed attempt at a reminder step and returns how many there have been
+func (q *Queries) RecordInvoiceReminderFailure(ctx context.Context, arg RecordInvoiceReminderFailureParams) (int32, error) {
+	row := q.db.QueryRow(ctx, recordInvoiceReminderFailure, arg.InvoiceID, arg.OffsetDays, arg.FailedAt)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const upsertInvoiceReminderSettings = `-- name: UpsertInvoiceReminderSettings :one
+INSERT INTO invoice_reminder_settings (
+  organization_id,
+  enabled,
+  offset_days,
+  updated_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, now(), now()
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  enabled = EXCLUDED.enabled,
+  offset_days = EXCLUDED.offset_days,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING organization_id, enabled, offset_days, updated_by, created_at, updated_at
+`
+
+type UpsertInvoiceReminderSettingsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Enabled        bool        `json:"enabled"`
+	OffsetDays     []int32     `json:"offset_days"`
+	UpdatedBy      pgtype.UUID `json:"updated_by"`
+}
+
+func (q *Queries) UpsertInvoiceReminderSettings(ctx context.Context, arg UpsertInvoiceReminderSettingsParams) (InvoiceReminderSettings, error) {
+	row := q.db.QueryRow(ctx, upsertInvoiceReminderSettings,
+		arg.OrganizationID,
+		arg.Enabled,
+		arg.OffsetDays,
+		arg.UpdatedBy,
+	)
+	var i InvoiceReminderSettings
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Enabled,
+		&i.OffsetDays,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,178 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: invoice_share_links.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createInvoiceShareLink = `-- name: CreateInvoiceShareLink :one
+INSERT INTO invoice_share_links (
+  id,
+  invoice_id,
+  token_hash,
+  expires_at,
+  created_by,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+)
+RETURNING id, invoice_id, token_hash, expires_at, view_count, last_viewed_at, revoked_at, revoked_by, created_by, created_at
+`
+
+type CreateInvoiceShareLinkParams struct {
+	ID        uuid.UUID   `json:"id"`
+	InvoiceID uuid.UUID   `json:"invoice_id"`
+	TokenHash string      `json:"token_hash"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	CreatedBy pgtype.UUID `json:"created_by"`
+}
+
+func (q *Queries) CreateInvoiceShareLink(ctx context.Context, arg CreateInvoiceShareLinkParams) (InvoiceShareLinks, error) {
+	row := q.db.QueryRow(ctx, createInvoiceShareLink,
+		arg.ID,
+		arg.InvoiceID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.CreatedBy,
+	)
+	var i InvoiceShareLinks
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ViewCount,
+		&i.LastViewedAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getInvoiceShareLink = `-- name: GetInvoiceShareLink :one
+SELECT id, invoice_id, token_hash, expires_at, view_count, last_viewed_at, revoked_at, revoked_by, created_by, created_at FROM invoice_share_links
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetInvoiceShareLink(ctx context.Context, id uuid.UUID) (InvoiceShareLinks, error) {
+	row := q.db.QueryRow(ctx, getInvoiceShareLink, id)
+	var i InvoiceShareLinks
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ViewCount,
+		&i.LastViewedAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listInvoiceShareLinks = `-- name: ListInvoiceShareLinks :many
+SELECT id, invoice_id, token_hash, expires_at, view_count, last_viewed_at, revoked_at, revoked_by, created_by, created_at FROM invoice_share_links
+WHERE invoice_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListInvoiceShareLinks(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceShareLinks, error) {
+	rows, err := q.db.Query(ctx, listInvoiceShareLinks, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InvoiceShareLinks{}
+	for rows.Next() {
+		var i InvoiceShareLinks
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.TokenHash,
+			&i.ExpiresAt,
+			&i.ViewCount,
+			&i.LastViewedAt,
+			&i.RevokedAt,
+			&i.RevokedBy,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordInvoiceShareLinkView = `-- name: RecordInvoiceShareLinkView :exec
+UPDATE invoice_share_links
+SET
+  view_count = view_count + 1,
+  last_viewed_at = $1
+WHERE id = $2
+`
+
+type RecordInvoiceShareLinkViewParams struct {
+	ViewedAt pgtype.Timestamptz `json:"viewed_at"`
+	ID       uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) RecordInvoiceShareLinkView(ctx context.Context, arg RecordInvoiceShareLinkViewParams) error {
+	_, err := q.db.Exec(ctx, recordInvoiceShareLinkView, arg.ViewedAt, arg.ID)
+	return err
+}
+
+const revokeInvoiceShareLink = `-- name: RevokeInvoiceShareLink :one
+UPDATE invoice_share_links
+SET
+  revoked_at = $1,
+  revoked_by = $2
+WHERE id = $3 AND invoice_id = $4 AND revoked_at IS NULL
+RETURNING id, invoice_id, token_hash, expires_at, view_count, last_viewed_at, revoked_at, revoked_by, created_by, created_at
+`
+
+type RevokeInvoiceShareLinkParams struct {
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+	RevokedBy pgtype.UUID        `json:"revoked_by"`
+	ID        uuid.UUID          `json:"id"`
+	InvoiceID uuid.UUID          `json:"invoice_id"`
+}
+
+func (q *Queries) RevokeInvoiceShareLink(ctx context.Context, arg RevokeInvoiceShareLinkParams) (InvoiceShareLinks, error) {
+	row := q.db.QueryRow(ctx, revokeInvoiceShareLink,
+		arg.RevokedAt,
+		arg.RevokedBy,
+		arg.ID,
+		arg.InvoiceID,
+	)
+	var i InvoiceShareLinks
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ViewCount,
+		&i.LastViewedAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
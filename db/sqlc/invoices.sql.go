@@ -0,0 +1,1131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: invoices.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const countInvoicesByOrganization = `-- name: CountInvoicesByOrganization :one
+SELECT COUNT(*) FROM invoices
+WHERE organization_id = $1
+  AND ($2::text IS NULL OR status = $2)
+`
+
+type CountInvoicesByOrganizationParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Status         pgtype.Text `json:"status"`
+}
+
+func (q *Queries) CountInvoicesByOrganization(ctx context.Context, arg CountInvoicesByOrganizationParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countInvoicesByOrganization, arg.OrganizationID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createInvoice = `-- name: CreateInvoice :one
+INSERT INTO invoices (
+  id,
+  organization_id,
+  number,
+  status,
+  currency,
+  customer_name,
+  customer_email,
+  customer_address,
+  issue_date,
+  due_date,
+  notes,
+  subtotal,
+  discount_total,
+  tax_total,
+  total,
+  payment_asset_id,
+  payment_address,
+  recurring_invoice_id,
+  deposit_index,
+  created_by,
+  client_id,
+  customer_country,
+  customer_tax_id,
+  automatic_tax,
+  tax_treatment,
+  tax_name,
+  tax_note,
+  tax_rates_version,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, 'draft', $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+  $21, $22, $23, $24, $25, $26, $27, now(), now()
+) RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type CreateInvoiceParams struct {
+	ID                 uuid.UUID       `json:"id"`
+	OrganizationID     uuid.UUID       `json:"organization_id"`
+	Number             int64           `json:"number"`
+	Currency           string          `json:"currency"`
+	CustomerName       string          `json:"customer_name"`
+	CustomerEmail      string          `json:"customer_email"`
+	CustomerAddress    string          `json:"customer_address"`
+	IssueDate          time.Time       `json:"issue_date"`
+	DueDate            time.Time       `json:"due_date"`
+	Notes              string          `json:"notes"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	DiscountTotal      decimal.Decimal `json:"discount_total"`
+	TaxTotal           decimal.Decimal `json:"tax_total"`
+	Total              decimal.Decimal `json:"total"`
+	PaymentAssetID     pgtype.UUID     `json:"payment_asset_id"`
+	PaymentAddress     pgtype.Text     `json:"payment_address"`
+	RecurringInvoiceID pgtype.UUID     `json:"recurring_invoice_id"`
+	DepositIndex       pgtype.Int8     `json:"deposit_index"`
+	CreatedBy          pgtype.UUID     `json:"created_by"`
+	ClientID           pgtype.UUID     `json:"client_id"`
+	CustomerCountry    string          `json:"customer_country"`
+	CustomerTaxID      string          `json:"customer_tax_id"`
+	AutomaticTax       bool            `json:"automatic_tax"`
+	TaxTreatment       string          `json:"tax_treatment"`
+	TaxName            string          `json:"tax_name"`
+	TaxNote            string          `json:"tax_note"`
+	TaxRatesVersion    string          `json:"tax_rates_version"`
+}
+
+func (q *Queries) CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, createInvoice,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Number,
+		arg.Currency,
+		arg.CustomerName,
+		arg.CustomerEmail,
+		arg.CustomerAddress,
+		arg.IssueDate,
+		arg.DueDate,
+		arg.Notes,
+		arg.Subtotal,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.Total,
+		arg.PaymentAssetID,
+		arg.PaymentAddress,
+		arg.RecurringInvoiceID,
+		arg.DepositIndex,
+		arg.CreatedBy,
+		arg.ClientID,
+		arg.CustomerCountry,
+		arg.CustomerTaxID,
+		arg.AutomaticTax,
+		arg.TaxTreatment,
+		arg.TaxName,
+		arg.TaxNote,
+		arg.TaxRatesVersion,
+	)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const createInvoiceLineItem = `-- name: CreateInvoiceLineItem :one
+INSERT INTO invoice_line_items (
+  id,
+  invoice_id,
+  position,
+  description,
+  quantity,
+  unit_price,
+  discount_percent,
+  tax_rate,
+  amount,
+  discount_amount,
+  tax_amount,
+  total,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, now()
+) RETURNING id, invoice_id, position, description, quantity, unit_price, discount_percent, tax_rate, amount, discount_amount, tax_amount, total, created_at
+`
+
+type CreateInvoiceLineItemParams struct {
+	ID              uuid.UUID       `json:"id"`
+	InvoiceID       uuid.UUID       `json:"invoice_id"`
+	Position        int32           `json:"position"`
+	Description     string          `json:"description"`
+	Quantity        decimal.Decimal `json:"quantity"`
+	UnitPrice       decimal.Decimal `json:"unit_price"`
+	DiscountPercent decimal.Decimal `json:"discount_percent"`
+	TaxRate         decimal.Decimal `json:"tax_rate"`
+	Amount          decimal.Decimal `json:"amount"`
+	DiscountAmount  decimal.Decimal `json:"discount_amount"`
+	TaxAmount       decimal.Decimal `json:"tax_amount"`
+	Total           decimal.Decimal `json:"total"`
+}
+
+func (q *Queries) CreateInvoiceLineItem(ctx context.Context, arg CreateInvoiceLineItemParams) (InvoiceLineItems, error) {
+	row := q.db.QueryRow(ctx, createInvoiceLineItem,
+		arg.ID,
+		arg.InvoiceID,
+		arg.Position,
+		arg.Description,
+		arg.Quantity,
+		arg.UnitPrice,
+		arg.DiscountPercent,
+		arg.TaxRate,
+		arg.Amount,
+		arg.DiscountAmount,
+		arg.TaxAmount,
+		arg.Total,
+	)
+	var i InvoiceLineItems
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.Position,
+		&i.Description,
+		&i.Quantity,
+		&i.UnitPrice,
+		&i.DiscountPercent,
+		&i.TaxRate,
+		&i.Amount,
+		&i.DiscountAmount,
+		&i.TaxAmount,
+		&i.Total,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteInvoiceLineItems = `-- name: DeleteInvoiceLineItems :exec
+DELETE FROM invoice_line_items
+WHERE invoice_id = $1
+`
+
+func (q *Queries) DeleteInvoiceLineItems(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInvoiceLineItems, invoiceID)
+	return err
+}
+
+const getInvoiceByID = `-- name: GetInvoiceByID :one
+SELECT id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception FROM invoices
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetInvoiceByID(ctx context.Context, id uuid.UUID) (Invoices, error) {
+	row := q.db.QueryRow(ctx, getInvoiceByID, id)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const getInvoiceForUpdate = `-- name: GetInvoiceForUpdate :one
+SELECT id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception FROM invoices
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks an invoice until the calling transaction ends
+func (q *Queries) GetInvoiceForUpdate(ctx context.Context, id uuid.UUID) (Invoices, error) {
+	row := q.db.QueryRow(ctx, getInvoiceForUpdate, id)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const invoiceDepositAddressExists = `-- name: InvoiceDepositAddressExists :one
+SELECT EXISTS (
+  SELECT 1 FROM invoices
+  WHERE payment_address = $1 AND deposit_index IS NOT NULL
+)
+`
+
+func (q *Queries) InvoiceDepositAddressExists(ctx context.Context, paymentAddress pgtype.Text) (bool, error) {
+	row := q.db.QueryRow(ctx, invoiceDepositAddressExists, paymentAddress)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listInvoiceLineItems = `-- name: ListInvoiceLineItems :many
+SELECT id, invoice_id, position, description, quantity, unit_price, discount_percent, tax_rate, amount, discount_amount, tax_amount, total, created_at FROM invoice_line_items
+WHERE invoice_id = $1
+ORDER BY position
+`
+
+func (q *Queries) ListInvoiceLineItems(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceLineItems, error) {
+	rows, err := q.db.Query(ctx, listInvoiceLineItems, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InvoiceLineItems{}
+	for rows.Next() {
+		var i InvoiceLineItems
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.Position,
+			&i.Description,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.DiscountPercent,
+			&i.TaxRate,
+			&i.Amount,
+			&i.DiscountAmount,
+			&i.TaxAmount,
+			&i.Total,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoicesAwaitingDeposit = `-- name: ListInvoicesAwaitingDeposit :many
+SELECT id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception FROM invoices
+WHERE deposit_index IS NOT NULL
+  AND (status IN ('draft', 'sent', 'viewed', 'partially_paid', 'overdue')
+    OR (status = 'paid' AND paid_at >= $1)
+    OR (status = 'void' AND voided_at >= $1))
+ORDER BY created_at
+`
+
+// Lists the invoices whose deposit address is watched for payments: those
+// not yet paid or voided, and paid or voided ones for a while longer so late
+// transfers are still flagged as overpayments or payments after the void
+func (q *Queries) ListInvoicesAwaitingDeposit(ctx context.Context, closedSince pgtype.Timestamptz) ([]Invoices, error) {
+	rows, err := q.db.Query(ctx, listInvoicesAwaitingDeposit, closedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Invoices{}
+	for rows.Next() {
+		var i Invoices
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Number,
+			&i.Status,
+			&i.Currency,
+			&i.CustomerName,
+			&i.CustomerEmail,
+			&i.CustomerAddress,
+			&i.IssueDate,
+			&i.DueDate,
+			&i.Notes,
+			&i.Subtotal,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.Total,
+			&i.SentAt,
+			&i.ViewedAt,
+			&i.PaidAt,
+			&i.PaymentReference,
+			&i.VoidedAt,
+			&i.VoidReason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PaymentAssetID,
+			&i.PaymentAddress,
+			&i.RecurringInvoiceID,
+			&i.DepositIndex,
+			&i.AmountPaid,
+			&i.ClientID,
+			&i.AmountCredited,
+			&i.CustomerCountry,
+			&i.CustomerTaxID,
+			&i.AutomaticTax,
+			&i.TaxTreatment,
+			&i.TaxName,
+			&i.TaxNote,
+			&i.TaxRatesVersion,
+			&i.PaymentException,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoicesByOrganization = `-- name: ListInvoicesByOrganization :many
+SELECT id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception FROM invoices
+WHERE organization_id = $1
+  AND ($2::text IS NULL OR status = $2)
+ORDER BY number DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListInvoicesByOrganizationParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Status         pgtype.Text `json:"status"`
+	OffsetCount    int32       `json:"offset_count"`
+	LimitCount     int32       `json:"limit_count"`
+}
+
+// Lists an organization's invoices, optionally in one status, latest number first
+func (q *Queries) ListInvoicesByOrganization(ctx context.Context, arg ListInvoicesByOrganizationParams) ([]Invoices, error) {
+	rows, err := q.db.Query(ctx, listInvoicesByOrganization,
+		arg.OrganizationID,
+		arg.Status,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Invoices{}
+	for rows.Next() {
+		var i Invoices
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Number,
+			&i.Status,
+			&i.Currency,
+			&i.CustomerName,
+			&i.CustomerEmail,
+			&i.CustomerAddress,
+			&i.IssueDate,
+			&i.DueDate,
+			&i.Notes,
+			&i.Subtotal,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.Total,
+			&i.SentAt,
+			&i.ViewedAt,
+			&i.PaidAt,
+			&i.PaymentReference,
+			&i.VoidedAt,
+			&i.VoidReason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PaymentAssetID,
+			&i.PaymentAddress,
+			&i.RecurringInvoiceID,
+			&i.DepositIndex,
+			&i.AmountPaid,
+			&i.ClientID,
+			&i.AmountCredited,
+			&i.CustomerCountry,
+			&i.CustomerTaxID,
+			&i.AutomaticTax,
+			&i.TaxTreatment,
+			&i.TaxName,
+			&i.TaxNote,
+			&i.TaxRatesVersion,
+			&i.PaymentException,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markInvoicePaid = `-- name: MarkInvoicePaid :one
+UPDATE invoices
+SET
+  status = 'paid',
+  paid_at = $1,
+  payment_reference = $2,
+  updated_at = now()
+WHERE id = $3 AND status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type MarkInvoicePaidParams struct {
+	PaidAt           pgtype.Timestamptz `json:"paid_at"`
+	PaymentReference pgtype.Text        `json:"payment_reference"`
+	ID               uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) MarkInvoicePaid(ctx context.Context, arg MarkInvoicePaidParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, markInvoicePaid, arg.PaidAt, arg.PaymentReference, arg.ID)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const markInvoiceSent = `-- name: MarkInvoiceSent :one
+UPDATE invoices
+SET
+  status = 'sent',
+  sent_at = $1,
+  updated_at = now()
+WHERE id = $2 AND status = 'draft'
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type MarkInvoiceSentParams struct {
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+	ID     uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) MarkInvoiceSent(ctx context.Context, arg MarkInvoiceSentParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, markInvoiceSent, arg.SentAt, arg.ID)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const markInvoiceViewed = `-- name: MarkInvoiceViewed :one
+UPDATE invoices
+SET
+  status = CASE WHEN status = 'sent' THEN 'viewed' ELSE status END,
+  viewed_at = COALESCE(viewed_at, $1),
+  updated_at = now()
+WHERE id = $2 AND status IN ('sent', 'viewed', 'partially_paid', 'overdue')
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type MarkInvoiceViewedParams struct {
+	ViewedAt pgtype.Timestamptz `json:"viewed_at"`
+	ID       uuid.UUID          `json:"id"`
+}
+
+// Records the first time the customer opened an invoice awaiting payment. Only
+// a sent invoice becomes viewed; a partially paid or overdue one keeps its status.
+func (q *Queries) MarkInvoiceViewed(ctx context.Context, arg MarkInvoiceViewedParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, markInvoiceViewed, arg.ViewedAt, arg.ID)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const markInvoicesOverdue = `-- name: MarkInvoicesOverdue :many
+UPDATE invoices
+SET
+  status = 'overdue',
+  updated_at = now()
+WHERE id IN (
+  SELECT i.id FROM invoices i
+  WHERE i.status IN ('sent', 'viewed', 'partially_paid') AND i.due_date < $1
+  ORDER BY i.due_date
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type MarkInvoicesOverdueParams struct {
+	Now        time.Time `json:"now"`
+	LimitCount int32     `json:"limit_count"`
+}
+
+// Moves sent, viewed and partially paid invoices whose due date has passed to
+// overdue, a batch at a time; rows another instance is updating are skipped
+func (q *Queries) MarkInvoicesOverdue(ctx context.Context, arg MarkInvoicesOverdueParams) ([]Invoices, error) {
+	rows, err := q.db.Query(ctx, markInvoicesOverdue, arg.Now, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Invoices{}
+	for rows.Next() {
+		var i Invoices
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Number,
+			&i.Status,
+			&i.Currency,
+			&i.CustomerName,
+			&i.CustomerEmail,
+			&i.CustomerAddress,
+			&i.IssueDate,
+			&i.DueDate,
+			&i.Notes,
+			&i.Subtotal,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.Total,
+			&i.SentAt,
+			&i.ViewedAt,
+			&i.PaidAt,
+			&i.PaymentReference,
+			&i.VoidedAt,
+			&i.VoidReason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PaymentAssetID,
+			&i.PaymentAddress,
+			&i.RecurringInvoiceID,
+			&i.DepositIndex,
+			&i.AmountPaid,
+			&i.ClientID,
+			&i.AmountCredited,
+			&i.CustomerCountry,
+			&i.CustomerTaxID,
+			&i.AutomaticTax,
+			&i.TaxTreatment,
+			&i.TaxName,
+			&i.TaxNote,
+			&i.TaxRatesVersion,
+			&i.PaymentException,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextInvoiceNumber = `-- name: NextInvoiceNumber :one
+INSERT INTO invoice_sequences (organization_id, last_number, updated_at)
+VALUES ($1, 1, now())
+ON CONFLICT (organization_id) DO UPDATE
+SET
+  last_number = invoice_sequences.last_number + 1,
+  updated_at = now()
+RETURNING last_number
+`
+
+// Hands out the organization's next invoice number. The sequence row stays
+// locked until the calling transaction ends, so a rolled back invoice gives
+// its number back and concurrent invoices are numbered one after the other.
+func (q *Queries) NextInvoiceNumber(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, nextInvoiceNumber, organizationID)
+	var last_number int64
+	err := row.Scan(&last_number)
+	return last_number, err
+}
+
+const updateDraftInvoice = `-- name: UpdateDraftInvoice :one
+UPDATE invoices
+SET
+  currency = $2,
+  customer_name = $3,
+  customer_email = $4,
+  customer_address = $5,
+  issue_date = $6,
+  due_date = $7,
+  notes = $8,
+  subtotal = $9,
+  discount_total = $10,
+  tax_total = $11,
+  total = $12,
+  payment_asset_id = $13,
+  payment_address = $14,
+  deposit_index = $15,
+  client_id = $16,
+  customer_country = $17,
+  customer_tax_id = $18,
+  automatic_tax = $19,
+  tax_treatment = $20,
+  tax_name = $21,
+  tax_note = $22,
+  tax_rates_version = $23,
+  updated_at = now()
+WHERE id = $1 AND status = 'draft'
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type UpdateDraftInvoiceParams struct {
+	ID              uuid.UUID       `json:"id"`
+	Currency        string          `json:"currency"`
+	CustomerName    string          `json:"customer_name"`
+	CustomerEmail   string          `json:"customer_email"`
+	CustomerAddress string          `json:"customer_address"`
+	IssueDate       time.Time       `json:"issue_date"`
+	DueDate         time.Time       `json:"due_date"`
+	Notes           string          `json:"notes"`
+	Subtotal        decimal.Decimal `json:"subtotal"`
+	DiscountTotal   decimal.Decimal `json:"discount_total"`
+	TaxTotal        decimal.Decimal `json:"tax_total"`
+	Total           decimal.Decimal `json:"total"`
+	PaymentAssetID  pgtype.UUID     `json:"payment_asset_id"`
+	PaymentAddress  pgtype.Text     `json:"payment_address"`
+	DepositIndex    pgtype.Int8     `json:"deposit_index"`
+	ClientID        pgtype.UUID     `json:"client_id"`
+	CustomerCountry string          `json:"customer_country"`
+	CustomerTaxID   string          `json:"customer_tax_id"`
+	AutomaticTax    bool            `json:"automatic_tax"`
+	TaxTreatment    string          `json:"tax_treatment"`
+	TaxName         string          `json:"tax_name"`
+	TaxNote         string          `json:"tax_note"`
+	TaxRatesVersion string          `json:"tax_rates_version"`
+}
+
+// Replaces the details of a draft invoice; no row is returned once it has been sent
+func (q *Queries) UpdateDraftInvoice(ctx context.Context, arg UpdateDraftInvoiceParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, updateDraftInvoice,
+		arg.ID,
+		arg.Currency,
+		arg.CustomerName,
+		arg.CustomerEmail,
+		arg.CustomerAddress,
+		arg.IssueDate,
+		arg.DueDate,
+		arg.Notes,
+		arg.Subtotal,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.Total,
+		arg.PaymentAssetID,
+		arg.PaymentAddress,
+		arg.DepositIndex,
+		arg.ClientID,
+		arg.CustomerCountry,
+		arg.CustomerTaxID,
+		arg.AutomaticTax,
+		arg.TaxTreatment,
+		arg.TaxName,
+		arg.TaxNote,
+		arg.TaxRatesVersion,
+	)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const updateInvoicePaymentStatus = `-- name: UpdateInvoicePaymentStatus :one
+UPDATE invoices
+SET
+  status = $1,
+  amount_paid = $2,
+  paid_at = $3,
+  payment_reference = $4,
+  payment_exception = $5,
+  updated_at = now()
+WHERE id = $6
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type UpdateInvoicePaymentStatusParams struct {
+	Status           string             `json:"status"`
+	AmountPaid       decimal.Decimal    `json:"amount_paid"`
+	PaidAt           pgtype.Timestamptz `json:"paid_at"`
+	PaymentReference pgtype.Text        `json:"payment_reference"`
+	PaymentException pgtype.Text        `json:"payment_exception"`
+	ID               uuid.UUID          `json:"id"`
+}
+
+// Stores the amount received, the status it puts an invoice in and any
+// payment exception it raises
+func (q *Queries) UpdateInvoicePaymentStatus(ctx context.Context, arg UpdateInvoicePaymentStatusParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, updateInvoicePaymentStatus,
+		arg.Status,
+		arg.AmountPaid,
+		arg.PaidAt,
+		arg.PaymentReference,
+		arg.PaymentException,
+		arg.ID,
+	)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
+
+const voidInvoice = `-- name: VoidInvoice :one
+UPDATE invoices
+SET
+  status = 'void',
+  voided_at = $1,
+  void_reason = $2,
+  updated_at = now()
+WHERE id = $3 AND status IN ('draft', 'sent', 'viewed', 'overdue') AND amount_credited = 0
+RETURNING id, organization_id, number, status, currency, customer_name, customer_email, customer_address, issue_date, due_date, notes, subtotal, discount_total, tax_total, total, sent_at, viewed_at, paid_at, payment_reference, voided_at, void_reason, created_by, created_at, updated_at, payment_asset_id, payment_address, recurring_invoice_id, deposit_index, amount_paid, client_id, amount_credited, customer_country, customer_tax_id, automatic_tax, tax_treatment, tax_name, tax_note, tax_rates_version, payment_exception
+`
+
+type VoidInvoiceParams struct {
+	VoidedAt   pgtype.Timestamptz `json:"voided_at"`
+	VoidReason pgtype.Text        `json:"void_reason"`
+	ID         uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) VoidInvoice(ctx context.Context, arg VoidInvoiceParams) (Invoices, error) {
+	row := q.db.QueryRow(ctx, voidInvoice, arg.VoidedAt, arg.VoidReason, arg.ID)
+	var i Invoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Number,
+		&i.Status,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.Notes,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.Total,
+		&i.SentAt,
+		&i.ViewedAt,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.VoidedAt,
+		&i.VoidReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.RecurringInvoiceID,
+		&i.DepositIndex,
+		&i.AmountPaid,
+		&i.ClientID,
+		&i.AmountCredited,
+		&i.CustomerCountry,
+		&i.CustomerTaxID,
+		&i.AutomaticTax,
+		&i.TaxTreatment,
+		&i.TaxName,
+		&i.TaxNote,
+		&i.TaxRatesVersion,
+		&i.PaymentException,
+	)
+	return i, err
+}
@@ -0,0 +1,348 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: ledger.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createLedgerAccount = `-- name: CreateLedgerAccount :one
+INSERT INTO ledger_accounts (
+  id,
+  code,
+  name,
+  type,
+  owner_id,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, now(), now()
+) RETURNING id, code, name, type, owner_id, created_at, updated_at
+`
+
+type CreateLedgerAccountParams struct {
+	ID      uuid.UUID   `json:"id"`
+	Code    string      `json:"code"`
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+// Opens a ledger account
+func (q *Queries) CreateLedgerAccount(ctx context.Context, arg CreateLedgerAccountParams) (LedgerAccounts, error) {
+	row := q.db.QueryRow(ctx, createLedgerAccount,
+		arg.ID,
+		arg.Code,
+		arg.Name,
+		arg.Type,
+		arg.OwnerID,
+	)
+	var i LedgerAccounts
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Type,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createLedgerEntry = `-- name: CreateLedgerEntry :one
+INSERT INTO ledger_entries (
+  id,
+  external_reference,
+  description,
+  occurred_at,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, now()
+)
+ON CONFLICT (external_reference) DO NOTHING
+RETURNING id, external_reference, description, occurred_at, created_at
+`
+
+type CreateLedgerEntryParams struct {
+	ID                uuid.UUID `json:"id"`
+	ExternalReference string    `json:"external_reference"`
+	Description       string    `json:"description"`
+	OccurredAt        time.Time `json:"occurred_at"`
+}
+
+// Records a journal entry unless one with the same external reference exists,
+// in which case no row is returned
+func (q *Queries) CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) (LedgerEntries, error) {
+	row := q.db.QueryRow(ctx, createLedgerEntry,
+		arg.ID,
+		arg.ExternalReference,
+		arg.Description,
+		arg.OccurredAt,
+	)
+	var i LedgerEntries
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalReference,
+		&i.Description,
+		&i.OccurredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createLedgerPosting = `-- name: CreateLedgerPosting :one
+INSERT INTO ledger_postings (
+  id,
+  entry_id,
+  account_id,
+  asset,
+  amount,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, now()
+) RETURNING id, entry_id, account_id, asset, amount, created_at
+`
+
+type CreateLedgerPostingParams struct {
+	ID        uuid.UUID       `json:"id"`
+	EntryID   uuid.UUID       `json:"entry_id"`
+	AccountID uuid.UUID       `json:"account_id"`
+	Asset     string          `json:"asset"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// Records one debit or credit of a journal entry
+func (q *Queries) CreateLedgerPosting(ctx context.Context, arg CreateLedgerPostingParams) (LedgerPostings, error) {
+	row := q.db.QueryRow(ctx, createLedgerPosting,
+		arg.ID,
+		arg.EntryID,
+		arg.AccountID,
+		arg.Asset,
+		arg.Amount,
+	)
+	var i LedgerPostings
+	err := row.Scan(
+		&i.ID,
+		&i.EntryID,
+		&i.AccountID,
+		&i.Asset,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLedgerAccountBalance = `-- name: GetLedgerAccountBalance :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS balance
+FROM ledger_postings
+WHERE account_id = $1 AND asset = $2
+`
+
+type GetLedgerAccountBalanceParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Asset     string    `json:"asset"`
+}
+
+// Sums the postings of an account in one asset
+func (q *Queries) GetLedgerAccountBalance(ctx context.Context, arg GetLedgerAccountBalanceParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getLedgerAccountBalance, arg.AccountID, arg.Asset)
+	var balance decimal.Decimal
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getLedgerAccountBalances = `-- name: GetLedgerAccountBalances :many
+SELECT asset, SUM(amount)::NUMERIC AS balance
+FROM ledger_postings
+WHERE account_id = $1
+GROUP BY asset
+ORDER BY asset
+`
+
+type GetLedgerAccountBalancesRow struct {
+	Asset   string          `json:"asset"`
+	Balance decimal.Decimal `json:"balance"`
+}
+
+// Sums the postings of an account per asset
+func (q *Queries) GetLedgerAccountBalances(ctx context.Context, accountID uuid.UUID) ([]GetLedgerAccountBalancesRow, error) {
+	rows, err := q.db.Query(ctx, getLedgerAccountBalances, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLedgerAccountBalancesRow{}
+	for rows.Next() {
+		var i GetLedgerAccountBalancesRow
+		if err := rows.Scan(&i.Asset, &i.Balance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLedgerAccountByCode = `-- name: GetLedgerAccountByCode :one
+SELECT id, code, name, type, owner_id, created_at, updated_at FROM ledger_accounts
+WHERE code = $1
+LIMIT 1
+`
+
+// Retrieves a ledger account by its unique code
+func (q *Queries) GetLedgerAccountByCode(ctx context.Context, code string) (LedgerAccounts, error) {
+	row := q.db.QueryRow(ctx, getLedgerAccountByCode, code)
+	var i LedgerAccounts
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Type,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLedgerAccountByID = `-- name: GetLedgerAccountByID :one
+SELECT id, code, name, type, owner_id, created_at, updated_at FROM ledger_accounts
+WHERE id = $1
+LIMIT 1
+`
+
+// Retrieves a ledger account by ID
+func (q *Queries) GetLedgerAccountByID(ctx context.Context, id uuid.UUID) (LedgerAccounts, error) {
+	row := q.db.QueryRow(ctx, getLedgerAccountByID, id)
+	var i LedgerAccounts
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Type,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLedgerEntryByID = `-- name: GetLedgerEntryByID :one
+SELECT id, external_reference, description, occurred_at, created_at FROM ledger_entries
+WHERE id = $1
+LIMIT 1
+`
+
+// Retrieves a journal entry by ID
+func (q *Queries) GetLedgerEntryByID(ctx context.Context, id uuid.UUID) (LedgerEntries, error) {
+	row := q.db.QueryRow(ctx, getLedgerEntryByID, id)
+	var i LedgerEntries
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalReference,
+		&i.Description,
+		&i.OccurredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLedgerEntryByReference = `-- name: GetLedgerEntryByReference :one
+SELECT id, external_reference, description, occurred_at, created_at FROM ledger_entries
+WHERE external_reference = $1
+LIMIT 1
+`
+
+// Retrieves a journal entry by its external reference
+func (q *Queries) GetLedgerEntryByReference(ctx context.Context, externalReference string) (LedgerEntries, error) {
+	row := q.db.QueryRow(ctx, getLedgerEntryByReference, externalReference)
+	var i LedgerEntries
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalReference,
+		&i.Description,
+		&i.OccurredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listLedgerAccountsByOwner = `-- name: ListLedgerAccountsByOwner :many
+SELECT id, code, name, type, owner_id, created_at, updated_at FROM ledger_accounts
+WHERE owner_id = $1
+ORDER BY code
+`
+
+// Lists the ledger accounts belonging to a user or organization
+func (q *Queries) ListLedgerAccountsByOwner(ctx context.Context, ownerID pgtype.UUID) ([]LedgerAccounts, error) {
+	rows, err := q.db.Query(ctx, listLedgerAccountsByOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LedgerAccounts{}
+	for rows.Next() {
+		var i LedgerAccounts
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Type,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLedgerPostingsByEntry = `-- name: ListLedgerPostingsByEntry :many
+SELECT id, entry_id, account_id, asset, amount, created_at FROM ledger_postings
+WHERE entry_id = $1
+ORDER BY created_at, id
+`
+
+// Lists the postings of a journal entry
+func (q *Queries) ListLedgerPostingsByEntry(ctx context.Context, entryID uuid.UUID) ([]LedgerPostings, error) {
+	rows, err := q.db.Query(ctx, listLedgerPostingsByEntry, entryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LedgerPostings{}
+	for rows.Next() {
+		var i LedgerPostings
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntryID,
+			&i.AccountID,
+			&i.Asset,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
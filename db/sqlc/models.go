@@ -12,17 +12,19 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 )
 
 type OtpPurpose string
 
 const (
-	OtpPurposeEmailVerification OtpPurpose = "email_verification"
-	OtpPurposePasswordReset     OtpPurpose = "password_reset"
-	OtpPurposePhoneVerification OtpPurpose = "phone_verification"
-	OtpPurposeAccountRecovery   OtpPurpose = "account_recovery"
-	OtpPurposeTwoFactorAuth     OtpPurpose = "two_factor_auth"
-	OtpPurposeLoginConfirmation OtpPurpose = "login_confirmation"
+	OtpPurposeEmailVerification   OtpPurpose = "email_verification"
+	OtpPurposePasswordReset       OtpPurpose = "password_reset"
+	OtpPurposePhoneVerification   OtpPurpose = "phone_verification"
+	OtpPurposeAccountRecovery     OtpPurpose = "account_recovery"
+	OtpPurposeTwoFactorAuth       OtpPurpose = "two_factor_auth"
+	OtpPurposeLoginConfirmation   OtpPurpose = "login_confirmation"
+	OtpPurposeTransactionPinReset OtpPurpose = "transaction_pin_reset"
 )
 
 func (e *OtpPurpose) Scan(src interface{}) error {
@@ -60,6 +62,320 @@ func (ns NullOtpPurpose) Value() (driver.Value, error) {
 	return string(ns.OtpPurpose), nil
 }
 
+type ApprovalDecisions struct {
+	ID         uuid.UUID   `json:"id"`
+	RequestID  uuid.UUID   `json:"request_id"`
+	ApproverID uuid.UUID   `json:"approver_id"`
+	Decision   string      `json:"decision"`
+	Comment    pgtype.Text `json:"comment"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// N of M sign-off rules a pay run must pass before it can be paid
+type ApprovalPolicies struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	// currency the thresholds are in; pay run amounts are converted into it
+	Currency string `json:"currency"`
+	// policy applies when the pay run total reaches this amount
+	MinTotalAmount pgtype.Numeric `json:"min_total_amount"`
+	// policy applies when any single payout reaches this amount
+	MinPayoutAmount   pgtype.Numeric `json:"min_payout_amount"`
+	RequiredApprovals int32          `json:"required_approvals"`
+	ApproverIds       []uuid.UUID    `json:"approver_ids"`
+	// how long a request stays open before it expires
+	ApprovalWindowHours int32       `json:"approval_window_hours"`
+	Active              bool        `json:"active"`
+	CreatedBy           pgtype.UUID `json:"created_by"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+}
+
+type ApprovalRequests struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	PayRunID       uuid.UUID   `json:"pay_run_id"`
+	PolicyID       pgtype.UUID `json:"policy_id"`
+	// copied from the policy when the pay run was submitted
+	RequiredApprovals int32 `json:"required_approvals"`
+	// copied from the policy when the pay run was submitted
+	ApproverIds []uuid.UUID        `json:"approver_ids"`
+	Status      string             `json:"status"`
+	RequestedBy uuid.UUID          `json:"requested_by"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	DecidedAt   pgtype.Timestamptz `json:"decided_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// the customers an organization bills; one per billing email and per tax ID
+type Clients struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	// lowercased billing email invoices are sent to
+	Email    string   `json:"email"`
+	CcEmails []string `json:"cc_emails"`
+	// uppercased without spaces or punctuation, so the same ID written differently is one client
+	TaxID        string `json:"tax_id"`
+	AddressLine1 string `json:"address_line1"`
+	AddressLine2 string `json:"address_line2"`
+	City         string `json:"city"`
+	Region       string `json:"region"`
+	PostalCode   string `json:"postal_code"`
+	// ISO 3166-1 alpha-2 code of the billing address
+	Country           string      `json:"country"`
+	PreferredCurrency string      `json:"preferred_currency"`
+	PreferredAssetID  pgtype.UUID `json:"preferred_asset_id"`
+	// days from issue to due date on invoices that do not set one
+	PaymentTermsDays int32       `json:"payment_terms_days"`
+	Notes            string      `json:"notes"`
+	CreatedBy        pgtype.UUID `json:"created_by"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+}
+
+// hourly work a contractor does for the organization on a client project
+type ContractorContracts struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ContractorID   uuid.UUID `json:"contractor_id"`
+	// client the approved hours are invoiced to
+	ClientID pgtype.UUID `json:"client_id"`
+	Project  string      `json:"project"`
+	// what the client is billed per approved hour, in currency
+	HourlyRate decimal.Decimal `json:"hourly_rate"`
+	Currency   string          `json:"currency"`
+	Active     bool            `json:"active"`
+	CreatedBy  pgtype.UUID     `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// last credit note number handed out per organization, numbered apart from invoices and without gaps
+type CreditNoteSequences struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	LastNumber     int64     `json:"last_number"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// amounts taken off the balance of an issued invoice; never edited or deleted
+type CreditNotes struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	InvoiceID      uuid.UUID `json:"invoice_id"`
+	Number         int64     `json:"number"`
+	Currency       string    `json:"currency"`
+	// amount credited including tax, in the invoice currency
+	Amount decimal.Decimal `json:"amount"`
+	// part of amount that reverses tax charged on the invoice
+	TaxAmount decimal.Decimal `json:"tax_amount"`
+	Reason    string          `json:"reason"`
+	// reversing entry posted with the credit note in the same transaction
+	LedgerEntryID uuid.UUID   `json:"ledger_entry_id"`
+	IssuedAt      time.Time   `json:"issued_at"`
+	CreatedBy     pgtype.UUID `json:"created_by"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// what each employee is paid per pay date of a schedule
+type EmployeeCompensations struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	ScheduleID     uuid.UUID       `json:"schedule_id"`
+	UserID         uuid.UUID       `json:"user_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	// currency the amount is agreed in; may differ from the payout asset
+	Currency      string    `json:"currency"`
+	PayoutAssetID uuid.UUID `json:"payout_asset_id"`
+	WalletAddress string    `json:"wallet_address"`
+	Active        bool      `json:"active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	// country the payee is taxed in, for the tax breakdown on payslips; empty to leave tax out
+	TaxCountry string `json:"tax_country"`
+	// VAT registration number of a contractor who charges tax on their pay
+	TaxID string `json:"tax_id"`
+}
+
+// exchange rates locked for a window so conversions at pay time are deterministic
+type FxQuotes struct {
+	ID            uuid.UUID       `json:"id"`
+	BaseCurrency  string          `json:"base_currency"`
+	QuoteCurrency string          `json:"quote_currency"`
+	Rate          decimal.Decimal `json:"rate"`
+	Source        string          `json:"source"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// history of exchange rates fetched from the configured FX provider
+type FxRates struct {
+	ID            uuid.UUID `json:"id"`
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	// units of quote_currency per one unit of base_currency
+	Rate      decimal.Decimal `json:"rate"`
+	Source    string          `json:"source"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// last block each chain indexer has fully processed
+type IndexerCheckpoints struct {
+	Name        string    `json:"name"`
+	BlockNumber int64     `json:"block_number"`
+	BlockHash   string    `json:"block_hash"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type InvoiceLineItems struct {
+	ID              uuid.UUID       `json:"id"`
+	InvoiceID       uuid.UUID       `json:"invoice_id"`
+	Position        int32           `json:"position"`
+	Description     string          `json:"description"`
+	Quantity        decimal.Decimal `json:"quantity"`
+	UnitPrice       decimal.Decimal `json:"unit_price"`
+	DiscountPercent decimal.Decimal `json:"discount_percent"`
+	// percentage applied to the amount after discount
+	TaxRate decimal.Decimal `json:"tax_rate"`
+	// quantity times unit price, before discount and tax
+	Amount         decimal.Decimal `json:"amount"`
+	DiscountAmount decimal.Decimal `json:"discount_amount"`
+	TaxAmount      decimal.Decimal `json:"tax_amount"`
+	Total          decimal.Decimal `json:"total"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// token transfers into an invoice deposit address; each transfer counts once
+type InvoicePayments struct {
+	ID        uuid.UUID `json:"id"`
+	InvoiceID uuid.UUID `json:"invoice_id"`
+	TxHash    string    `json:"tx_hash"`
+	// position of the Transfer log in its block; changes when a reorg re-mines the transaction
+	LogIndex     int32  `json:"log_index"`
+	TokenAddress string `json:"token_address"`
+	FromAddress  string `json:"from_address"`
+	ToAddress    string `json:"to_address"`
+	// transferred amount in the invoice currency
+	Amount      decimal.Decimal `json:"amount"`
+	BlockNumber int64           `json:"block_number"`
+	CreatedAt   time.Time       `json:"created_at"`
+	// position among the transaction's transfers of the same token to the same address; stable across reorgs
+	TransferOrdinal int32 `json:"transfer_ordinal"`
+	// false for a transfer received after the invoice was voided, which is not counted in amount_paid
+	Applied bool `json:"applied"`
+}
+
+// reminder steps whose email could not be queued; retried with backoff and given up after too many attempts
+type InvoiceReminderFailures struct {
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int32     `json:"offset_days"`
+	Attempts   int32     `json:"attempts"`
+	// when the latest attempt failed
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// when an organization's customers are reminded of invoices awaiting payment
+type InvoiceReminderSettings struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Enabled        bool      `json:"enabled"`
+	// days relative to the due date a reminder is sent on; negative before it, positive after
+	OffsetDays []int32     `json:"offset_days"`
+	UpdatedBy  pgtype.UUID `json:"updated_by"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// reminders sent for an invoice; a row is written before the email is queued so a step is never sent twice
+type InvoiceReminders struct {
+	ID         uuid.UUID `json:"id"`
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int32     `json:"offset_days"`
+	Recipient  string    `json:"recipient"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// last invoice number handed out per organization; numbering an invoice locks the row until the invoice is committed, so numbers have no gaps
+type InvoiceSequences struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	LastNumber     int64     `json:"last_number"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// public read-only links to an invoice for customers without an account; only a hash of the signed token is stored
+type InvoiceShareLinks struct {
+	ID        uuid.UUID `json:"id"`
+	InvoiceID uuid.UUID `json:"invoice_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// times the invoice was opened through the link
+	ViewCount    int32              `json:"view_count"`
+	LastViewedAt pgtype.Timestamptz `json:"last_viewed_at"`
+	RevokedAt    pgtype.Timestamptz `json:"revoked_at"`
+	RevokedBy    pgtype.UUID        `json:"revoked_by"`
+	CreatedBy    pgtype.UUID        `json:"created_by"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
+
+// invoices an organization bills its customers with; numbered per organization and never deleted, only voided
+type Invoices struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	// sequential per organization, from invoice_sequences
+	Number int64  `json:"number"`
+	Status string `json:"status"`
+	// fiat currency or asset symbol every amount of the invoice is in
+	Currency        string             `json:"currency"`
+	CustomerName    string             `json:"customer_name"`
+	CustomerEmail   string             `json:"customer_email"`
+	CustomerAddress string             `json:"customer_address"`
+	IssueDate       time.Time          `json:"issue_date"`
+	DueDate         time.Time          `json:"due_date"`
+	Notes           string             `json:"notes"`
+	Subtotal        decimal.Decimal    `json:"subtotal"`
+	DiscountTotal   decimal.Decimal    `json:"discount_total"`
+	TaxTotal        decimal.Decimal    `json:"tax_total"`
+	Total           decimal.Decimal    `json:"total"`
+	SentAt          pgtype.Timestamptz `json:"sent_at"`
+	// first time the customer opened the invoice
+	ViewedAt         pgtype.Timestamptz `json:"viewed_at"`
+	PaidAt           pgtype.Timestamptz `json:"paid_at"`
+	PaymentReference pgtype.Text        `json:"payment_reference"`
+	VoidedAt         pgtype.Timestamptz `json:"voided_at"`
+	VoidReason       pgtype.Text        `json:"void_reason"`
+	CreatedBy        pgtype.UUID        `json:"created_by"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+	// asset the customer is asked to pay in, printed on the invoice with payment_address
+	PaymentAssetID pgtype.UUID `json:"payment_asset_id"`
+	// wallet the customer pays into
+	PaymentAddress pgtype.Text `json:"payment_address"`
+	// template the invoice was generated from; at most one invoice per template and issue date
+	RecurringInvoiceID pgtype.UUID `json:"recurring_invoice_id"`
+	// receiving address index payment_address was derived at from the organization deposit key; null for an address entered by hand
+	DepositIndex pgtype.Int8 `json:"deposit_index"`
+	// sum of the matched on-chain payments, in the invoice currency
+	AmountPaid decimal.Decimal `json:"amount_paid"`
+	// directory entry the customer details were taken from; the invoice keeps its own copy of them
+	ClientID pgtype.UUID `json:"client_id"`
+	// sum of the credit notes issued against the invoice, in the invoice currency
+	AmountCredited  decimal.Decimal `json:"amount_credited"`
+	CustomerCountry string          `json:"customer_country"`
+	CustomerTaxID   string          `json:"customer_tax_id"`
+	// line tax rates are set by the tax engine rather than entered by hand
+	AutomaticTax bool `json:"automatic_tax"`
+	// how the tax engine taxed the invoice; empty for hand-entered rates
+	TaxTreatment string `json:"tax_treatment"`
+	TaxName      string `json:"tax_name"`
+	TaxNote      string `json:"tax_note"`
+	// version of the tax rate table the rates came from
+	TaxRatesVersion string `json:"tax_rates_version"`
+	// overpaid, or paid_after_void when a transfer arrived after the invoice was voided; needs a refund or follow-up
+	PaymentException pgtype.Text `json:"payment_exception"`
+}
+
 type Kyc struct {
 	ID                   uuid.UUID `json:"id"`
 	UserID               uuid.UUID `json:"user_id"`
@@ -72,6 +388,107 @@ type Kyc struct {
 	CreatedAt            time.Time `json:"created_at"`
 }
 
+// chart of accounts for the double-entry ledger
+type LedgerAccounts struct {
+	ID uuid.UUID `json:"id"`
+	// stable unique key, e.g. payables:contractor:<user id>
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// user or organization the account belongs to, NULL for platform accounts
+	OwnerID   pgtype.UUID `json:"owner_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// journal entries; each groups postings that sum to zero per asset
+type LedgerEntries struct {
+	ID uuid.UUID `json:"id"`
+	// idempotency key of the business event that produced the entry
+	ExternalReference string    `json:"external_reference"`
+	Description       string    `json:"description"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// immutable debits and credits; corrections are made with reversing entries
+type LedgerPostings struct {
+	ID        uuid.UUID `json:"id"`
+	EntryID   uuid.UUID `json:"entry_id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Asset     string    `json:"asset"`
+	// positive for a debit, negative for a credit, in display units of asset
+	Amount    decimal.Decimal `json:"amount"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// account-level xpub every invoice deposit address of an organization is derived from; no private key is stored
+type OrganizationDepositKeys struct {
+	OrganizationID    uuid.UUID `json:"organization_id"`
+	ExtendedPublicKey string    `json:"extended_public_key"`
+	// next unused receiving address index, handed out one per invoice
+	NextIndex int64 `json:"next_index"`
+	// underpayment, in basis points of the total, still accepted as paid to allow for fees deducted in transit
+	ToleranceBps int32       `json:"tolerance_bps"`
+	CreatedBy    pgtype.UUID `json:"created_by"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// emailed invitations to join an organization
+type OrganizationInvitations struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Email          string      `json:"email"`
+	Role           string      `json:"role"`
+	InvitedBy      pgtype.UUID `json:"invited_by"`
+	// pending, accepted, revoked; a pending invitation past expires_at is expired
+	Status string `json:"status"`
+	// SHA-256 of the latest signed token; resending replaces it so older links stop working
+	TokenHash  string             `json:"token_hash"`
+	ExpiresAt  time.Time          `json:"expires_at"`
+	SendCount  int32              `json:"send_count"`
+	LastSentAt time.Time          `json:"last_sent_at"`
+	AcceptedBy pgtype.UUID        `json:"accepted_by"`
+	AcceptedAt pgtype.Timestamptz `json:"accepted_at"`
+	RevokedAt  pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type OrganizationMembers struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	// owner, admin, finance, viewer
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// business accounts; users act for them through organization_members
+type Organizations struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Address      string      `json:"address"`
+	City         string      `json:"city"`
+	PostalCode   string      `json:"postal_code"`
+	Country      string      `json:"country"`
+	Website      pgtype.Text `json:"website"`
+	Size         pgtype.Text `json:"size"`
+	Industry     pgtype.Text `json:"industry"`
+	Description  pgtype.Text `json:"description"`
+	Headquarters pgtype.Text `json:"headquarters"`
+	// user who set the organization up during business onboarding
+	CreatedBy pgtype.UUID `json:"created_by"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	// PNG or JPEG logo printed on invoices
+	LogoUrl pgtype.Text `json:"logo_url"`
+	// VAT or sales tax registration number, upper case without spaces or punctuation; empty if not registered
+	TaxID string `json:"tax_id"`
+}
+
 type OtpVerifications struct {
 	ID            uuid.UUID          `json:"id"`
 	UserID        pgtype.UUID        `json:"user_id"`
@@ -90,6 +507,119 @@ type OtpVerifications struct {
 	DeviceID      pgtype.UUID        `json:"device_id"`
 }
 
+// compensation copied onto a pay run, so later changes do not alter it
+type PayRunLineItems struct {
+	ID             uuid.UUID       `json:"id"`
+	PayRunID       uuid.UUID       `json:"pay_run_id"`
+	CompensationID pgtype.UUID     `json:"compensation_id"`
+	UserID         uuid.UUID       `json:"user_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency"`
+	PayoutAssetID  uuid.UUID       `json:"payout_asset_id"`
+	WalletAddress  string          `json:"wallet_address"`
+	CreatedAt      time.Time       `json:"created_at"`
+	TaxCountry     string          `json:"tax_country"`
+	TaxID          string          `json:"tax_id"`
+	// quote the amount was converted into the payout asset with; empty when no conversion was needed
+	FxQuoteID pgtype.UUID `json:"fx_quote_id"`
+	// amount of the payout asset paid, locked when the pay run was submitted
+	LockedPayout pgtype.Numeric `json:"locked_payout"`
+}
+
+type PayRuns struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ScheduleID     uuid.UUID `json:"schedule_id"`
+	PayDate        time.Time `json:"pay_date"`
+	// previous pay date of the schedule, or when it was created for the first run
+	PeriodStart time.Time `json:"period_start"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PayoutAddressAllowlist struct {
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	WalletID uuid.UUID `json:"wallet_id"`
+	Address  string    `json:"address"`
+	Chain    string    `json:"chain"`
+	// pending, active, cancelled
+	Status string `json:"status"`
+	// sha256 of the one-click cancel token
+	CancelTokenHash string             `json:"cancel_token_hash"`
+	AvailableAt     time.Time          `json:"available_at"`
+	ActivatedAt     pgtype.Timestamptz `json:"activated_at"`
+	CancelledAt     pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// recurring pay dates of an organization; each date produces a draft pay run
+type PayrollSchedules struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Frequency      string    `json:"frequency"`
+	// five-field cron expression, only for custom schedules
+	CronExpression pgtype.Text `json:"cron_expression"`
+	Timezone       string      `json:"timezone"`
+	// anchor that weekly, bi-weekly and monthly pay dates are counted from
+	FirstPayDate time.Time          `json:"first_pay_date"`
+	NextRunAt    time.Time          `json:"next_run_at"`
+	LastRunAt    pgtype.Timestamptz `json:"last_run_at"`
+	Active       bool               `json:"active"`
+	CreatedBy    pgtype.UUID        `json:"created_by"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
+type RecurringInvoiceLineItems struct {
+	ID                 uuid.UUID       `json:"id"`
+	RecurringInvoiceID uuid.UUID       `json:"recurring_invoice_id"`
+	Position           int32           `json:"position"`
+	Description        string          `json:"description"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	UnitPrice          decimal.Decimal `json:"unit_price"`
+	DiscountPercent    decimal.Decimal `json:"discount_percent"`
+	TaxRate            decimal.Decimal `json:"tax_rate"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// invoice templates issued on a schedule, such as monthly retainers
+type RecurringInvoices struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Status         string    `json:"status"`
+	Frequency      string    `json:"frequency"`
+	Timezone       string    `json:"timezone"`
+	// first issue date; later ones follow from it by frequency in timezone
+	StartDate time.Time `json:"start_date"`
+	// no invoice is issued after this date
+	EndDate pgtype.Timestamptz `json:"end_date"`
+	// the template completes once this many invoices have been issued
+	MaxOccurrences pgtype.Int4 `json:"max_occurrences"`
+	Occurrences    int32       `json:"occurrences"`
+	// issue date of the next invoice to generate
+	NextIssueAt  time.Time          `json:"next_issue_at"`
+	LastIssuedAt pgtype.Timestamptz `json:"last_issued_at"`
+	// each invoice is due this many days after its issue date
+	DaysUntilDue int32 `json:"days_until_due"`
+	// generated invoices are sent to the customer straight away instead of staying drafts
+	AutoSend        bool        `json:"auto_send"`
+	Currency        string      `json:"currency"`
+	CustomerName    string      `json:"customer_name"`
+	CustomerEmail   string      `json:"customer_email"`
+	CustomerAddress string      `json:"customer_address"`
+	Notes           string      `json:"notes"`
+	PaymentAssetID  pgtype.UUID `json:"payment_asset_id"`
+	PaymentAddress  pgtype.Text `json:"payment_address"`
+	CreatedBy       pgtype.UUID `json:"created_by"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
 type SecurityEvents struct {
 	ID        uuid.UUID        `json:"id"`
 	UserID    uuid.UUID        `json:"user_id"`
@@ -134,16 +664,89 @@ type SessionsV2 struct {
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
 }
 
+// tokens accepted for payouts, invoices and incoming payments
+type SupportedAssets struct {
+	ID uuid.UUID `json:"id"`
+	// lower-case chain slug matching user_wallets.chain, e.g. ethereum, base, tron
+	Chain  string `json:"chain"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+	// token contract or mint address, NULL for the chain's native asset
+	ContractAddress pgtype.Text `json:"contract_address"`
+	// number of decimal places between display units and base units
+	Decimals  int16     `json:"decimals"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TimesheetEntries struct {
+	ID          uuid.UUID       `json:"id"`
+	TimesheetID uuid.UUID       `json:"timesheet_id"`
+	Position    int32           `json:"position"`
+	WorkDate    time.Time       `json:"work_date"`
+	Hours       decimal.Decimal `json:"hours"`
+	Description string          `json:"description"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// one contract's hours for one week, submitted by the contractor and approved by the organization
+type Timesheets struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ContractID     uuid.UUID `json:"contract_id"`
+	ContractorID   uuid.UUID `json:"contractor_id"`
+	// midnight UTC on the Monday the week starts
+	WeekStart time.Time `json:"week_start"`
+	Status    string    `json:"status"`
+	// sum of the entries' hours, kept with the entries
+	TotalHours      decimal.Decimal    `json:"total_hours"`
+	SubmittedAt     pgtype.Timestamptz `json:"submitted_at"`
+	ReviewedBy      pgtype.UUID        `json:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+	RejectionReason string             `json:"rejection_reason"`
+	// invoice the approved hours were billed on; empty while the invoice is being raised and cleared if that invoice is voided
+	InvoiceID pgtype.UUID `json:"invoice_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
 type Transactions struct {
 	ID     uuid.UUID `json:"id"`
 	UserID uuid.UUID `json:"user_id"`
 	TxHash string    `json:"tx_hash"`
 	// hashed transaction pin
 	TransactionPinHash string `json:"transaction_pin_hash"`
-	// created, pending, not_found, failed
+	// created, pending, confirmed, failed, not_found
 	Status    string    `json:"status"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// block the receipt was last seen in, cleared when the block is reorged out
+	BlockNumber pgtype.Int8 `json:"block_number"`
+	// hash of the block the receipt was last seen in
+	BlockHash pgtype.Text `json:"block_hash"`
+	// outbound (user initiated) or inbound (indexed ERC-20 transfer)
+	Direction string `json:"direction"`
+	// position of the Transfer log in its block, inbound transfers only; changes when a reorg re-mines the transaction
+	LogIndex     pgtype.Int4 `json:"log_index"`
+	TokenAddress pgtype.Text `json:"token_address"`
+	FromAddress  pgtype.Text `json:"from_address"`
+	ToAddress    pgtype.Text `json:"to_address"`
+	// transfer amount in token base units; declared by the user for outbound transactions
+	Amount pgtype.Numeric `json:"amount"`
+	// position among the transaction's transfers of the same token to the same recipient, inbound transfers only; stable across reorgs
+	TransferOrdinal pgtype.Int4 `json:"transfer_ordinal"`
+}
+
+// company details users entered before organizations held them; kept to roll back the drop of the users columns
+type UserCompanyDetails struct {
+	UserID            uuid.UUID   `json:"user_id"`
+	CompanyName       pgtype.Text `json:"company_name"`
+	CompanyAddress    pgtype.Text `json:"company_address"`
+	CompanyCity       pgtype.Text `json:"company_city"`
+	CompanyPostalCode pgtype.Text `json:"company_postal_code"`
+	CompanyCountry    pgtype.Text `json:"company_country"`
+	CompanyWebsite    pgtype.Text `json:"company_website"`
 }
 
 type UserDeviceTokens struct {
@@ -166,6 +769,18 @@ type UserDeviceTokens struct {
 	IsRevoked             bool               `json:"is_revoked"`
 }
 
+type UserTransactionPins struct {
+	UserID uuid.UUID `json:"user_id"`
+	// argon2id hash of the transaction PIN
+	PinHash string `json:"pin_hash"`
+	// consecutive wrong PIN entries since the last success or lockout
+	FailedAttempts int32 `json:"failed_attempts"`
+	// PIN verification is refused until this time
+	LockedUntil pgtype.Timestamptz `json:"locked_until"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
 type UserWallets struct {
 	ID        uuid.UUID        `json:"id"`
 	UserID    uuid.UUID        `json:"user_id"`
@@ -195,18 +810,12 @@ type Users struct {
 	Nationality           string             `json:"nationality"`
 	ResidentialCountry    pgtype.Text        `json:"residential_country"`
 	JobRole               pgtype.Text        `json:"job_role"`
-	CompanyName           pgtype.Text        `json:"company_name"`
-	CompanyAddress        pgtype.Text        `json:"company_address"`
-	CompanyCity           pgtype.Text        `json:"company_city"`
-	CompanyPostalCode     pgtype.Text        `json:"company_postal_code"`
-	CompanyCountry        pgtype.Text        `json:"company_country"`
 	UserAddress           pgtype.Text        `json:"user_address"`
 	UserCity              pgtype.Text        `json:"user_city"`
 	UserPostalCode        pgtype.Text        `json:"user_postal_code"`
 	EmployeeType          pgtype.Text        `json:"employee_type"`
 	AuthProvider          pgtype.Text        `json:"auth_provider"`
 	ProviderID            string             `json:"provider_id"`
-	CompanyWebsite        pgtype.Text        `json:"company_website"`
 	EmploymentType        pgtype.Text        `json:"employment_type"`
 	CreatedAt             time.Time          `json:"created_at"`
 	UpdatedAt             time.Time          `json:"updated_at"`
@@ -0,0 +1,320 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization_invitations.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createOrganizationInvitation = `-- name: CreateOrganizationInvitation :one
+INSERT INTO organization_invitations (
+  id,
+  organization_id,
+  email,
+  role,
+  invited_by,
+  status,
+  token_hash,
+  expires_at,
+  send_count,
+  last_sent_at,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'pending', $6, $7, 1, now(), now(), now()
+) RETURNING id, organization_id, email, role, invited_by, status, token_hash, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+`
+
+type CreateOrganizationInvitationParams struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Email          string      `json:"email"`
+	Role           string      `json:"role"`
+	InvitedBy      pgtype.UUID `json:"invited_by"`
+	TokenHash      string      `json:"token_hash"`
+	ExpiresAt      time.Time   `json:"expires_at"`
+}
+
+// Records a new pending invitation
+func (q *Queries) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitations, error) {
+	row := q.db.QueryRow(ctx, createOrganizationInvitation,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Role,
+		arg.InvitedBy,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var i OrganizationInvitations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.Status,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationInvitationByID = `-- name: GetOrganizationInvitationByID :one
+SELECT i.id, i.organization_id, i.email, i.role, i.invited_by, i.status, i.token_hash, i.expires_at, i.send_count, i.last_sent_at, i.accepted_by, i.accepted_at, i.revoked_at, i.created_at, i.updated_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.id = $1
+LIMIT 1
+`
+
+type GetOrganizationInvitationByIDRow struct {
+	OrganizationInvitations OrganizationInvitations `json:"organization_invitations"`
+	OrganizationName        string                  `json:"organization_name"`
+}
+
+func (q *Queries) GetOrganizationInvitationByID(ctx context.Context, id uuid.UUID) (GetOrganizationInvitationByIDRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationInvitationByID, id)
+	var i GetOrganizationInvitationByIDRow
+	err := row.Scan(
+		&i.OrganizationInvitations.ID,
+		&i.OrganizationInvitations.OrganizationID,
+		&i.OrganizationInvitations.Email,
+		&i.OrganizationInvitations.Role,
+		&i.OrganizationInvitations.InvitedBy,
+		&i.OrganizationInvitations.Status,
+		&i.OrganizationInvitations.TokenHash,
+		&i.OrganizationInvitations.ExpiresAt,
+		&i.OrganizationInvitations.SendCount,
+		&i.OrganizationInvitations.LastSentAt,
+		&i.OrganizationInvitations.AcceptedBy,
+		&i.OrganizationInvitations.AcceptedAt,
+		&i.OrganizationInvitations.RevokedAt,
+		&i.OrganizationInvitations.CreatedAt,
+		&i.OrganizationInvitations.UpdatedAt,
+		&i.OrganizationName,
+	)
+	return i, err
+}
+
+const getPendingOrganizationInvitationByEmail = `-- name: GetPendingOrganizationInvitationByEmail :one
+SELECT i.id, i.organization_id, i.email, i.role, i.invited_by, i.status, i.token_hash, i.expires_at, i.send_count, i.last_sent_at, i.accepted_by, i.accepted_at, i.revoked_at, i.created_at, i.updated_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND lower(i.email) = lower($2::text) AND i.status = 'pending'
+LIMIT 1
+`
+
+type GetPendingOrganizationInvitationByEmailParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Email          string    `json:"email"`
+}
+
+type GetPendingOrganizationInvitationByEmailRow struct {
+	OrganizationInvitations OrganizationInvitations `json:"organization_invitations"`
+	OrganizationName        string                  `json:"organization_name"`
+}
+
+// Retrieves the pending invitation for an email address in an organization, if any
+func (q *Queries) GetPendingOrganizationInvitationByEmail(ctx context.Context, arg GetPendingOrganizationInvitationByEmailParams) (GetPendingOrganizationInvitationByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getPendingOrganizationInvitationByEmail, arg.OrganizationID, arg.Email)
+	var i GetPendingOrganizationInvitationByEmailRow
+	err := row.Scan(
+		&i.OrganizationInvitations.ID,
+		&i.OrganizationInvitations.OrganizationID,
+		&i.OrganizationInvitations.Email,
+		&i.OrganizationInvitations.Role,
+		&i.OrganizationInvitations.InvitedBy,
+		&i.OrganizationInvitations.Status,
+		&i.OrganizationInvitations.TokenHash,
+		&i.OrganizationInvitations.ExpiresAt,
+		&i.OrganizationInvitations.SendCount,
+		&i.OrganizationInvitations.LastSentAt,
+		&i.OrganizationInvitations.AcceptedBy,
+		&i.OrganizationInvitations.AcceptedAt,
+		&i.OrganizationInvitations.RevokedAt,
+		&i.OrganizationInvitations.CreatedAt,
+		&i.OrganizationInvitations.UpdatedAt,
+		&i.OrganizationName,
+	)
+	return i, err
+}
+
+const listPendingOrganizationInvitations = `-- name: ListPendingOrganizationInvitations :many
+SELECT i.id, i.organization_id, i.email, i.role, i.invited_by, i.status, i.token_hash, i.expires_at, i.send_count, i.last_sent_at, i.accepted_by, i.accepted_at, i.revoked_at, i.created_at, i.updated_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND i.status = 'pending'
+ORDER BY i.created_at DESC
+`
+
+type ListPendingOrganizationInvitationsRow struct {
+	OrganizationInvitations OrganizationInvitations `json:"organization_invitations"`
+	OrganizationName        string                  `json:"organization_name"`
+}
+
+// Lists an organization's pending invitations, including expired ones, newest first
+func (q *Queries) ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]ListPendingOrganizationInvitationsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingOrganizationInvitations, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingOrganizationInvitationsRow{}
+	for rows.Next() {
+		var i ListPendingOrganizationInvitationsRow
+		if err := rows.Scan(
+			&i.OrganizationInvitations.ID,
+			&i.OrganizationInvitations.OrganizationID,
+			&i.OrganizationInvitations.Email,
+			&i.OrganizationInvitations.Role,
+			&i.OrganizationInvitations.InvitedBy,
+			&i.OrganizationInvitations.Status,
+			&i.OrganizationInvitations.TokenHash,
+			&i.OrganizationInvitations.ExpiresAt,
+			&i.OrganizationInvitations.SendCount,
+			&i.OrganizationInvitations.LastSentAt,
+			&i.OrganizationInvitations.AcceptedBy,
+			&i.OrganizationInvitations.AcceptedAt,
+			&i.OrganizationInvitations.RevokedAt,
+			&i.OrganizationInvitations.CreatedAt,
+			&i.OrganizationInvitations.UpdatedAt,
+			&i.OrganizationName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOrganizationInvitationAccepted = `-- name: MarkOrganizationInvitationAccepted :one
+UPDATE organization_invitations
+SET
+  status = 'accepted',
+  accepted_by = $2,
+  accepted_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, organization_id, email, role, invited_by, status, token_hash, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+`
+
+type MarkOrganizationInvitationAcceptedParams struct {
+	ID         uuid.UUID   `json:"id"`
+	AcceptedBy pgtype.UUID `json:"accepted_by"`
+}
+
+func (q *Queries) MarkOrganizationInvitationAccepted(ctx context.Context, arg MarkOrganizationInvitationAcceptedParams) (OrganizationInvitations, error) {
+	row := q.db.QueryRow(ctx, markOrganizationInvitationAccepted, arg.ID, arg.AcceptedBy)
+	var i OrganizationInvitations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.Status,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const markOrganizationInvitationRevoked = `-- name: MarkOrganizationInvitationRevoked :one
+UPDATE organization_invitations
+SET
+  status = 'revoked',
+  revoked_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, organization_id, email, role, invited_by, status, token_hash, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+`
+
+func (q *Queries) MarkOrganizationInvitationRevoked(ctx context.Context, id uuid.UUID) (OrganizationInvitations, error) {
+	row := q.db.QueryRow(ctx, markOrganizationInvitationRevoked, id)
+	var i OrganizationInvitations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.Status,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const refreshOrganizationInvitationToken = `-- name: RefreshOrganizationInvitationToken :one
+UPDATE organization_invitations
+SET
+  token_hash = $2,
+  expires_at = $3,
+  send_count = send_count + 1,
+  last_sent_at = now(),
+  updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, organization_id, email, role, invited_by, status, token_hash, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+`
+
+type RefreshOrganizationInvitationTokenParams struct {
+	ID        uuid.UUID `json:"id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Replaces the token of a pending invitation when it is resent
+func (q *Queries) RefreshOrganizationInvitationToken(ctx context.Context, arg RefreshOrganizationInvitationTokenParams) (OrganizationInvitations, error) {
+	row := q.db.QueryRow(ctx, refreshOrganizationInvitationToken, arg.ID, arg.TokenHash, arg.ExpiresAt)
+	var i OrganizationInvitations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.Status,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
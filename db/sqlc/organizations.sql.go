@@ -0,0 +1,433 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organizations.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countOrganizationMembersByRole = `-- name: CountOrganizationMembersByRole :one
+SELECT COUNT(*) FROM organization_members
+WHERE organization_id = $1 AND role = $2
+`
+
+type CountOrganizationMembersByRoleParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) CountOrganizationMembersByRole(ctx context.Context, arg CountOrganizationMembersByRoleParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationMembersByRole, arg.OrganizationID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganization = `-- name: CreateOrganization :one
+INSERT INTO organizations (
+  id,
+  name,
+  address,
+  city,
+  postal_code,
+  country,
+  website,
+  size,
+  industry,
+  description,
+  headquarters,
+  logo_url,
+  created_by,
+  tax_id,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, now(), now()
+) RETURNING id, name, address, city, postal_code, country, website, size, industry, description, headquarters, created_by, created_at, updated_at, logo_url, tax_id
+`
+
+type CreateOrganizationParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Address      string      `json:"address"`
+	City         string      `json:"city"`
+	PostalCode   string      `json:"postal_code"`
+	Country      string      `json:"country"`
+	Website      pgtype.Text `json:"website"`
+	Size         pgtype.Text `json:"size"`
+	Industry     pgtype.Text `json:"industry"`
+	Description  pgtype.Text `json:"description"`
+	Headquarters pgtype.Text `json:"headquarters"`
+	LogoUrl      pgtype.Text `json:"logo_url"`
+	CreatedBy    pgtype.UUID `json:"created_by"`
+	TaxID        string      `json:"tax_id"`
+}
+
+// Creates an organization profile
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organizations, error) {
+	row := q.db.QueryRow(ctx, createOrganization,
+		arg.ID,
+		arg.Name,
+		arg.Address,
+		arg.City,
+		arg.PostalCode,
+		arg.Country,
+		arg.Website,
+		arg.Size,
+		arg.Industry,
+		arg.Description,
+		arg.Headquarters,
+		arg.LogoUrl,
+		arg.CreatedBy,
+		arg.TaxID,
+	)
+	var i Organizations
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Address,
+		&i.City,
+		&i.PostalCode,
+		&i.Country,
+		&i.Website,
+		&i.Size,
+		&i.Industry,
+		&i.Description,
+		&i.Headquarters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LogoUrl,
+		&i.TaxID,
+	)
+	return i, err
+}
+
+const createOrganizationMember = `-- name: CreateOrganizationMember :one
+INSERT INTO organization_members (
+  id,
+  organization_id,
+  user_id,
+  role,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, now(), now()
+) RETURNING id, organization_id, user_id, role, created_at, updated_at
+`
+
+type CreateOrganizationMemberParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+}
+
+// Adds a user to an organization
+func (q *Queries) CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) (OrganizationMembers, error) {
+	row := q.db.QueryRow(ctx, createOrganizationMember,
+		arg.ID,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Role,
+	)
+	var i OrganizationMembers
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :exec
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	return err
+}
+
+const getOrganizationByID = `-- name: GetOrganizationByID :one
+SELECT id, name, address, city, postal_code, country, website, size, industry, description, headquarters, created_by, created_at, updated_at, logo_url, tax_id FROM organizations WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOrganizationByID(ctx context.Context, id uuid.UUID) (Organizations, error) {
+	row := q.db.QueryRow(ctx, getOrganizationByID, id)
+	var i Organizations
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Address,
+		&i.City,
+		&i.PostalCode,
+		&i.Country,
+		&i.Website,
+		&i.Size,
+		&i.Industry,
+		&i.Description,
+		&i.Headquarters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LogoUrl,
+		&i.TaxID,
+	)
+	return i, err
+}
+
+const getOrganizationMember = `-- name: GetOrganizationMember :one
+SELECT m.id, m.organization_id, m.user_id, m.role, m.created_at, m.updated_at, u.email, u.first_name, u.last_name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1 AND m.user_id = $2
+LIMIT 1
+`
+
+type GetOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+type GetOrganizationMemberRow struct {
+	OrganizationMembers OrganizationMembers `json:"organization_members"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"first_name"`
+	LastName            string              `json:"last_name"`
+}
+
+// Retrieves a user's membership of an organization with their contact details
+func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i GetOrganizationMemberRow
+	err := row.Scan(
+		&i.OrganizationMembers.ID,
+		&i.OrganizationMembers.OrganizationID,
+		&i.OrganizationMembers.UserID,
+		&i.OrganizationMembers.Role,
+		&i.OrganizationMembers.CreatedAt,
+		&i.OrganizationMembers.UpdatedAt,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+	)
+	return i, err
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT m.id, m.organization_id, m.user_id, m.role, m.created_at, m.updated_at, u.email, u.first_name, u.last_name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.created_at ASC
+`
+
+type ListOrganizationMembersRow struct {
+	OrganizationMembers OrganizationMembers `json:"organization_members"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"first_name"`
+	LastName            string              `json:"last_name"`
+}
+
+// Lists an organization's members with their contact details
+func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationMembersRow{}
+	for rows.Next() {
+		var i ListOrganizationMembersRow
+		if err := rows.Scan(
+			&i.OrganizationMembers.ID,
+			&i.OrganizationMembers.OrganizationID,
+			&i.OrganizationMembers.UserID,
+			&i.OrganizationMembers.Role,
+			&i.OrganizationMembers.CreatedAt,
+			&i.OrganizationMembers.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationsByUser = `-- name: ListOrganizationsByUser :many
+SELECT o.id, o.name, o.address, o.city, o.postal_code, o.country, o.website, o.size, o.industry, o.description, o.headquarters, o.created_by, o.created_at, o.updated_at, o.logo_url, o.tax_id, m.role
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name ASC
+`
+
+type ListOrganizationsByUserRow struct {
+	Organizations Organizations `json:"organizations"`
+	Role          string        `json:"role"`
+}
+
+// Lists the organizations a user belongs to with their role in each
+func (q *Queries) ListOrganizationsByUser(ctx context.Context, userID uuid.UUID) ([]ListOrganizationsByUserRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizationsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationsByUserRow{}
+	for rows.Next() {
+		var i ListOrganizationsByUserRow
+		if err := rows.Scan(
+			&i.Organizations.ID,
+			&i.Organizations.Name,
+			&i.Organizations.Address,
+			&i.Organizations.City,
+			&i.Organizations.PostalCode,
+			&i.Organizations.Country,
+			&i.Organizations.Website,
+			&i.Organizations.Size,
+			&i.Organizations.Industry,
+			&i.Organizations.Description,
+			&i.Organizations.Headquarters,
+			&i.Organizations.CreatedBy,
+			&i.Organizations.CreatedAt,
+			&i.Organizations.UpdatedAt,
+			&i.Organizations.LogoUrl,
+			&i.Organizations.TaxID,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOrganization = `-- name: UpdateOrganization :one
+UPDATE organizations
+SET
+  name = $2,
+  address = $3,
+  city = $4,
+  postal_code = $5,
+  country = $6,
+  website = $7,
+  size = $8,
+  industry = $9,
+  description = $10,
+  headquarters = $11,
+  logo_url = $12,
+  tax_id = $13,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, name, address, city, postal_code, country, website, size, industry, description, headquarters, created_by, created_at, updated_at, logo_url, tax_id
+`
+
+type UpdateOrganizationParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Address      string      `json:"address"`
+	City         string      `json:"city"`
+	PostalCode   string      `json:"postal_code"`
+	Country      string      `json:"country"`
+	Website      pgtype.Text `json:"website"`
+	Size         pgtype.Text `json:"size"`
+	Industry     pgtype.Text `json:"industry"`
+	Description  pgtype.Text `json:"description"`
+	Headquarters pgtype.Text `json:"headquarters"`
+	LogoUrl      pgtype.Text `json:"logo_url"`
+	TaxID        string      `json:"tax_id"`
+}
+
+// Replaces an organization's profile
+func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organizations, error) {
+	row := q.db.QueryRow(ctx, updateOrganization,
+		arg.ID,
+		arg.Name,
+		arg.Address,
+		arg.City,
+		arg.PostalCode,
+		arg.Country,
+		arg.Website,
+		arg.Size,
+		arg.Industry,
+		arg.Description,
+		arg.Headquarters,
+		arg.LogoUrl,
+		arg.TaxID,
+	)
+	var i Organizations
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Address,
+		&i.City,
+		&i.PostalCode,
+		&i.Country,
+		&i.Website,
+		&i.Size,
+		&i.Industry,
+		&i.Description,
+		&i.Headquarters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LogoUrl,
+		&i.TaxID,
+	)
+	return i, err
+}
+
+const updateOrganizationMemberRole = `-- name: UpdateOrganizationMemberRole :one
+UPDATE organization_members
+SET
+  role = $3,
+  updated_at = now()
+WHERE organization_id = $1 AND user_id = $2
+RETURNING id, organization_id, user_id, role, created_at, updated_at
+`
+
+type UpdateOrganizationMemberRoleParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMembers, error) {
+	row := q.db.QueryRow(ctx, updateOrganizationMemberRole, arg.OrganizationID, arg.UserID, arg.Role)
+	var i OrganizationMembers
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: payout_address_allowlist.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const activateDuePayoutAddresses = `-- name: ActivateDuePayoutAddresses :exec
+UPDATE payout_address_allowlist
+SET
+    status = 'active',
+    activated_at = available_at,
+    updated_at = now()
+WHERE status = 'pending' AND available_at <= $1
+`
+
+// Releases pending addresses whose cooling-off period has elapsed
+func (q *Queries) ActivateDuePayoutAddresses(ctx context.Context, availableAt time.Time) error {
+	_, err := q.db.Exec(ctx, activateDuePayoutAddresses, availableAt)
+	return err
+}
+
+const cancelPayoutAddress = `-- name: CancelPayoutAddress :one
+UPDATE payout_address_allowlist
+SET
+    status = 'cancelled',
+    cancelled_at = now(),
+    updated_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at
+`
+
+// Blocks a pending address so it can never receive funds
+func (q *Queries) CancelPayoutAddress(ctx context.Context, id uuid.UUID) (PayoutAddressAllowlist, error) {
+	row := q.db.QueryRow(ctx, cancelPayoutAddress, id)
+	var i PayoutAddressAllowlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.Address,
+		&i.Chain,
+		&i.Status,
+		&i.CancelTokenHash,
+		&i.AvailableAt,
+		&i.ActivatedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createPayoutAddress = `-- name: CreatePayoutAddress :one
+INSERT INTO payout_address_allowlist (
+    id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at
+`
+
+type CreatePayoutAddressParams struct {
+	ID              uuid.UUID `json:"id"`
+	UserID          uuid.UUID `json:"user_id"`
+	WalletID        uuid.UUID `json:"wallet_id"`
+	Address         string    `json:"address"`
+	Chain           string    `json:"chain"`
+	Status          string    `json:"status"`
+	CancelTokenHash string    `json:"cancel_token_hash"`
+	AvailableAt     time.Time `json:"available_at"`
+}
+
+// Adds a payout address to the allowlist in the pending (quarantined) state
+func (q *Queries) CreatePayoutAddress(ctx context.Context, arg CreatePayoutAddressParams) (PayoutAddressAllowlist, error) {
+	row := q.db.QueryRow(ctx, createPayoutAddress,
+		arg.ID,
+		arg.UserID,
+		arg.WalletID,
+		arg.Address,
+		arg.Chain,
+		arg.Status,
+		arg.CancelTokenHash,
+		arg.AvailableAt,
+	)
+	var i PayoutAddressAllowlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.Address,
+		&i.Chain,
+		&i.Status,
+		&i.CancelTokenHash,
+		&i.AvailableAt,
+		&i.ActivatedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLatestPayoutAddressByUserAndAddress = `-- name: GetLatestPayoutAddressByUserAndAddress :one
+SELECT id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at FROM payout_address_allowlist
+WHERE user_id = $1
+  AND (address = $2 OR (address ILIKE '0x%' AND lower(address) = lower($2)))
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestPayoutAddressByUserAndAddressParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Address string    `json:"address"`
+}
+
+// Retrieves the most recent allowlist entry for a user's address. Hex (EVM)
+// addresses match whatever their checksum casing.
+func (q *Queries) GetLatestPayoutAddressByUserAndAddress(ctx context.Context, arg GetLatestPayoutAddressByUserAndAddressParams) (PayoutAddressAllowlist, error) {
+	row := q.db.QueryRow(ctx, getLatestPayoutAddressByUserAndAddress, arg.UserID, arg.Address)
+	var i PayoutAddressAllowlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.Address,
+		&i.Chain,
+		&i.Status,
+		&i.CancelTokenHash,
+		&i.AvailableAt,
+		&i.ActivatedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPayoutAddressByCancelTokenHash = `-- name: GetPayoutAddressByCancelTokenHash :one
+SELECT id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at FROM payout_address_allowlist
+WHERE cancel_token_hash = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPayoutAddressByCancelTokenHash(ctx context.Context, cancelTokenHash string) (PayoutAddressAllowlist, error) {
+	row := q.db.QueryRow(ctx, getPayoutAddressByCancelTokenHash, cancelTokenHash)
+	var i PayoutAddressAllowlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.Address,
+		&i.Chain,
+		&i.Status,
+		&i.CancelTokenHash,
+		&i.AvailableAt,
+		&i.ActivatedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPayoutAddressByID = `-- name: GetPayoutAddressByID :one
+SELECT id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at FROM payout_address_allowlist
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPayoutAddressByID(ctx context.Context, id uuid.UUID) (PayoutAddressAllowlist, error) {
+	row := q.db.QueryRow(ctx, getPayoutAddressByID, id)
+	var i PayoutAddressAllowlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.Address,
+		&i.Chain,
+		&i.Status,
+		&i.CancelTokenHash,
+		&i.AvailableAt,
+		&i.ActivatedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listPayoutAddressesByUserID = `-- name: ListPayoutAddressesByUserID :many
+SELECT id, user_id, wallet_id, address, chain, status, cancel_token_hash, available_at, activated_at, cancelled_at, created_at, updated_at FROM payout_address_allowlist
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListPayoutAddressesByUserID(ctx context.Context, userID uuid.UUID) ([]PayoutAddressAllowlist, error) {
+	rows, err := q.db.Query(ctx, listPayoutAddressesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PayoutAddressAllowlist{}
+	for rows.Next() {
+		var i PayoutAddressAllowlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WalletID,
+			&i.Address,
+			&i.Chain,
+			&i.Status,
+			&i.CancelTokenHash,
+			&i.AvailableAt,
+			&i.ActivatedAt,
+			&i.CancelledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,906 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: payroll.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const advancePayrollSchedule = `-- name: AdvancePayrollSchedule :one
+UPDATE payroll_schedules
+SET
+  last_run_at = $2,
+  next_run_at = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at
+`
+
+type AdvancePayrollScheduleParams struct {
+	ID        uuid.UUID          `json:"id"`
+	LastRunAt pgtype.Timestamptz `json:"last_run_at"`
+	NextRunAt time.Time          `json:"next_run_at"`
+}
+
+// Moves a schedule on to its next pay date after a run was generated
+func (q *Queries) AdvancePayrollSchedule(ctx context.Context, arg AdvancePayrollScheduleParams) (PayrollSchedules, error) {
+	row := q.db.QueryRow(ctx, advancePayrollSchedule, arg.ID, arg.LastRunAt, arg.NextRunAt)
+	var i PayrollSchedules
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Frequency,
+		&i.CronExpression,
+		&i.Timezone,
+		&i.FirstPayDate,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const countPayRunsByOrganization = `-- name: CountPayRunsByOrganization :one
+SELECT COUNT(*) FROM pay_runs
+WHERE organization_id = $1
+`
+
+func (q *Queries) CountPayRunsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countPayRunsByOrganization, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createEmployeeCompensation = `-- name: CreateEmployeeCompensation :one
+INSERT INTO employee_compensations (
+  id,
+  organization_id,
+  schedule_id,
+  user_id,
+  amount,
+  currency,
+  payout_asset_id,
+  wallet_address,
+  tax_country,
+  tax_id,
+  active,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, true, now(), now()
+) RETURNING id, organization_id, schedule_id, user_id, amount, currency, payout_asset_id, wallet_address, active, created_at, updated_at, tax_country, tax_id
+`
+
+type CreateEmployeeCompensationParams struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	ScheduleID     uuid.UUID       `json:"schedule_id"`
+	UserID         uuid.UUID       `json:"user_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency"`
+	PayoutAssetID  uuid.UUID       `json:"payout_asset_id"`
+	WalletAddress  string          `json:"wallet_address"`
+	TaxCountry     string          `json:"tax_country"`
+	TaxID          string          `json:"tax_id"`
+}
+
+func (q *Queries) CreateEmployeeCompensation(ctx context.Context, arg CreateEmployeeCompensationParams) (EmployeeCompensations, error) {
+	row := q.db.QueryRow(ctx, createEmployeeCompensation,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ScheduleID,
+		arg.UserID,
+		arg.Amount,
+		arg.Currency,
+		arg.PayoutAssetID,
+		arg.WalletAddress,
+		arg.TaxCountry,
+		arg.TaxID,
+	)
+	var i EmployeeCompensations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.UserID,
+		&i.Amount,
+		&i.Currency,
+		&i.PayoutAssetID,
+		&i.WalletAddress,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TaxCountry,
+		&i.TaxID,
+	)
+	return i, err
+}
+
+const createPayRun = `-- name: CreatePayRun :one
+INSERT INTO pay_runs (
+  id,
+  organization_id,
+  schedule_id,
+  pay_date,
+  period_start,
+  status,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'draft', now(), now()
+)
+ON CONFLICT (schedule_id, pay_date) DO NOTHING
+RETURNING id, organization_id, schedule_id, pay_date, period_start, status, created_at, updated_at
+`
+
+type CreatePayRunParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ScheduleID     uuid.UUID `json:"schedule_id"`
+	PayDate        time.Time `json:"pay_date"`
+	PeriodStart    time.Time `json:"period_start"`
+}
+
+// Creates a draft pay run unless the schedule already has one for the pay
+// date, in which case no row is returned
+func (q *Queries) CreatePayRun(ctx context.Context, arg CreatePayRunParams) (PayRuns, error) {
+	row := q.db.QueryRow(ctx, createPayRun,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ScheduleID,
+		arg.PayDate,
+		arg.PeriodStart,
+	)
+	var i PayRuns
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.PayDate,
+		&i.PeriodStart,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createPayRunLineItem = `-- name: CreatePayRunLineItem :one
+INSERT INTO pay_run_line_items (
+  id,
+  pay_run_id,
+  compensation_id,
+  user_id,
+  amount,
+  currency,
+  payout_asset_id,
+  wallet_address,
+  tax_country,
+  tax_id,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now()
+) RETURNING id, pay_run_id, compensation_id, user_id, amount, currency, payout_asset_id, wallet_address, created_at, tax_country, tax_id, fx_quote_id, locked_payout
+`
+
+type CreatePayRunLineItemParams struct {
+	ID             uuid.UUID       `json:"id"`
+	PayRunID       uuid.UUID       `json:"pay_run_id"`
+	CompensationID pgtype.UUID     `json:"compensation_id"`
+	UserID         uuid.UUID       `json:"user_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency"`
+	PayoutAssetID  uuid.UUID       `json:"payout_asset_id"`
+	WalletAddress  string          `json:"wallet_address"`
+	TaxCountry     string          `json:"tax_country"`
+	TaxID          string          `json:"tax_id"`
+}
+
+func (q *Queries) CreatePayRunLineItem(ctx context.Context, arg CreatePayRunLineItemParams) (PayRunLineItems, error) {
+	row := q.db.QueryRow(ctx, createPayRunLineItem,
+		arg.ID,
+		arg.PayRunID,
+		arg.CompensationID,
+		arg.UserID,
+		arg.Amount,
+		arg.Currency,
+		arg.PayoutAssetID,
+		arg.WalletAddress,
+		arg.TaxCountry,
+		arg.TaxID,
+	)
+	var i PayRunLineItems
+	err := row.Scan(
+		&i.ID,
+		&i.PayRunID,
+		&i.CompensationID,
+		&i.UserID,
+		&i.Amount,
+		&i.Currency,
+		&i.PayoutAssetID,
+		&i.WalletAddress,
+		&i.CreatedAt,
+		&i.TaxCountry,
+		&i.TaxID,
+		&i.FxQuoteID,
+		&i.LockedPayout,
+	)
+	return i, err
+}
+
+const createPayrollSchedule = `-- name: CreatePayrollSchedule :one
+INSERT INTO payroll_schedules (
+  id,
+  organization_id,
+  name,
+  frequency,
+  cron_expression,
+  timezone,
+  first_pay_date,
+  next_run_at,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now()
+) RETURNING id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at
+`
+
+type CreatePayrollScheduleParams struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Name           string      `json:"name"`
+	Frequency      string      `json:"frequency"`
+	CronExpression pgtype.Text `json:"cron_expression"`
+	Timezone       string      `json:"timezone"`
+	FirstPayDate   time.Time   `json:"first_pay_date"`
+	NextRunAt      time.Time   `json:"next_run_at"`
+	Active         bool        `json:"active"`
+	CreatedBy      pgtype.UUID `json:"created_by"`
+}
+
+// Creates a payroll schedule
+func (q *Queries) CreatePayrollSchedule(ctx context.Context, arg CreatePayrollScheduleParams) (PayrollSchedules, error) {
+	row := q.db.QueryRow(ctx, createPayrollSchedule,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Frequency,
+		arg.CronExpression,
+		arg.Timezone,
+		arg.FirstPayDate,
+		arg.NextRunAt,
+		arg.Active,
+		arg.CreatedBy,
+	)
+	var i PayrollSchedules
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Frequency,
+		&i.CronExpression,
+		&i.Timezone,
+		&i.FirstPayDate,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEmployeeCompensationByID = `-- name: GetEmployeeCompensationByID :one
+SELECT c.id, c.organization_id, c.schedule_id, c.user_id, c.amount, c.currency, c.payout_asset_id, c.wallet_address, c.active, c.created_at, c.updated_at, c.tax_country, c.tax_id, u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM employee_compensations c
+JOIN users u ON u.id = c.user_id
+JOIN supported_assets a ON a.id = c.payout_asset_id
+WHERE c.id = $1
+LIMIT 1
+`
+
+type GetEmployeeCompensationByIDRow struct {
+	EmployeeCompensations EmployeeCompensations `json:"employee_compensations"`
+	Email                 string                `json:"email"`
+	FirstName             string                `json:"first_name"`
+	LastName              string                `json:"last_name"`
+	PayoutAssetSymbol     string                `json:"payout_asset_symbol"`
+}
+
+func (q *Queries) GetEmployeeCompensationByID(ctx context.Context, id uuid.UUID) (GetEmployeeCompensationByIDRow, error) {
+	row := q.db.QueryRow(ctx, getEmployeeCompensationByID, id)
+	var i GetEmployeeCompensationByIDRow
+	err := row.Scan(
+		&i.EmployeeCompensations.ID,
+		&i.EmployeeCompensations.OrganizationID,
+		&i.EmployeeCompensations.ScheduleID,
+		&i.EmployeeCompensations.UserID,
+		&i.EmployeeCompensations.Amount,
+		&i.EmployeeCompensations.Currency,
+		&i.EmployeeCompensations.PayoutAssetID,
+		&i.EmployeeCompensations.WalletAddress,
+		&i.EmployeeCompensations.Active,
+		&i.EmployeeCompensations.CreatedAt,
+		&i.EmployeeCompensations.UpdatedAt,
+		&i.EmployeeCompensations.TaxCountry,
+		&i.EmployeeCompensations.TaxID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PayoutAssetSymbol,
+	)
+	return i, err
+}
+
+const getPayRunByID = `-- name: GetPayRunByID :one
+SELECT id, organization_id, schedule_id, pay_date, period_start, status, created_at, updated_at FROM pay_runs
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPayRunByID(ctx context.Context, id uuid.UUID) (PayRuns, error) {
+	row := q.db.QueryRow(ctx, getPayRunByID, id)
+	var i PayRuns
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.PayDate,
+		&i.PeriodStart,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPayrollScheduleByID = `-- name: GetPayrollScheduleByID :one
+SELECT id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at FROM payroll_schedules
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPayrollScheduleByID(ctx context.Context, id uuid.UUID) (PayrollSchedules, error) {
+	row := q.db.QueryRow(ctx, getPayrollScheduleByID, id)
+	var i PayrollSchedules
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Frequency,
+		&i.CronExpression,
+		&i.Timezone,
+		&i.FirstPayDate,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPayrollScheduleForUpdate = `-- name: GetPayrollScheduleForUpdate :one
+SELECT id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at FROM payroll_schedules
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks a schedule while its next pay run is generated
+func (q *Queries) GetPayrollScheduleForUpdate(ctx context.Context, id uuid.UUID) (PayrollSchedules, error) {
+	row := q.db.QueryRow(ctx, getPayrollScheduleForUpdate, id)
+	var i PayrollSchedules
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Frequency,
+		&i.CronExpression,
+		&i.Timezone,
+		&i.FirstPayDate,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPreviousPayRun = `-- name: GetPreviousPayRun :one
+SELECT id, organization_id, schedule_id, pay_date, period_start, status, created_at, updated_at FROM pay_runs
+WHERE schedule_id = $1 AND pay_date < $2
+ORDER BY pay_date DESC
+LIMIT 1
+`
+
+type GetPreviousPayRunParams struct {
+	ScheduleID uuid.UUID `json:"schedule_id"`
+	Before     time.Time `json:"before"`
+}
+
+// Returns the schedule's latest pay run before the given pay date
+func (q *Queries) GetPreviousPayRun(ctx context.Context, arg GetPreviousPayRunParams) (PayRuns, error) {
+	row := q.db.QueryRow(ctx, getPreviousPayRun, arg.ScheduleID, arg.Before)
+	var i PayRuns
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.PayDate,
+		&i.PeriodStart,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveCompensationsBySchedule = `-- name: ListActiveCompensationsBySchedule :many
+SELECT id, organization_id, schedule_id, user_id, amount, currency, payout_asset_id, wallet_address, active, created_at, updated_at, tax_country, tax_id FROM employee_compensations
+WHERE schedule_id = $1 AND active
+ORDER BY created_at
+`
+
+func (q *Queries) ListActiveCompensationsBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]EmployeeCompensations, error) {
+	rows, err := q.db.Query(ctx, listActiveCompensationsBySchedule, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EmployeeCompensations{}
+	for rows.Next() {
+		var i EmployeeCompensations
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.ScheduleID,
+			&i.UserID,
+			&i.Amount,
+			&i.Currency,
+			&i.PayoutAssetID,
+			&i.WalletAddress,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TaxCountry,
+			&i.TaxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDuePayrollSchedules = `-- name: ListDuePayrollSchedules :many
+SELECT id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at FROM payroll_schedules
+WHERE active AND next_run_at <= $1
+ORDER BY next_run_at
+LIMIT $2
+`
+
+type ListDuePayrollSchedulesParams struct {
+	NextRunAt time.Time `json:"next_run_at"`
+	Limit     int32     `json:"limit"`
+}
+
+// Lists active schedules whose next pay date has arrived
+func (q *Queries) ListDuePayrollSchedules(ctx context.Context, arg ListDuePayrollSchedulesParams) ([]PayrollSchedules, error) {
+	rows, err := q.db.Query(ctx, listDuePayrollSchedules, arg.NextRunAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PayrollSchedules{}
+	for rows.Next() {
+		var i PayrollSchedules
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Frequency,
+			&i.CronExpression,
+			&i.Timezone,
+			&i.FirstPayDate,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.Active,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEmployeeCompensations = `-- name: ListEmployeeCompensations :many
+SELECT c.id, c.organization_id, c.schedule_id, c.user_id, c.amount, c.currency, c.payout_asset_id, c.wallet_address, c.active, c.created_at, c.updated_at, c.tax_country, c.tax_id, u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM employee_compensations c
+JOIN users u ON u.id = c.user_id
+JOIN supported_assets a ON a.id = c.payout_asset_id
+WHERE c.organization_id = $1
+  AND ($2::uuid IS NULL OR c.schedule_id = $2)
+ORDER BY c.active DESC, u.first_name, u.last_name
+`
+
+type ListEmployeeCompensationsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	ScheduleID     pgtype.UUID `json:"schedule_id"`
+}
+
+type ListEmployeeCompensationsRow struct {
+	EmployeeCompensations EmployeeCompensations `json:"employee_compensations"`
+	Email                 string                `json:"email"`
+	FirstName             string                `json:"first_name"`
+	LastName              string                `json:"last_name"`
+	PayoutAssetSymbol     string                `json:"payout_asset_symbol"`
+}
+
+// Lists an organization's compensation records, optionally for one schedule
+func (q *Queries) ListEmployeeCompensations(ctx context.Context, arg ListEmployeeCompensationsParams) ([]ListEmployeeCompensationsRow, error) {
+	rows, err := q.db.Query(ctx, listEmployeeCompensations, arg.OrganizationID, arg.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEmployeeCompensationsRow{}
+	for rows.Next() {
+		var i ListEmployeeCompensationsRow
+		if err := rows.Scan(
+			&i.EmployeeCompensations.ID,
+			&i.EmployeeCompensations.OrganizationID,
+			&i.EmployeeCompensations.ScheduleID,
+			&i.EmployeeCompensations.UserID,
+			&i.EmployeeCompensations.Amount,
+			&i.EmployeeCompensations.Currency,
+			&i.EmployeeCompensations.PayoutAssetID,
+			&i.EmployeeCompensations.WalletAddress,
+			&i.EmployeeCompensations.Active,
+			&i.EmployeeCompensations.CreatedAt,
+			&i.EmployeeCompensations.UpdatedAt,
+			&i.EmployeeCompensations.TaxCountry,
+			&i.EmployeeCompensations.TaxID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.PayoutAssetSymbol,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPayRunLineItems = `-- name: ListPayRunLineItems :many
+SELECT li.id, li.pay_run_id, li.compensation_id, li.user_id, li.amount, li.currency, li.payout_asset_id, li.wallet_address, li.created_at, li.tax_country, li.tax_id, li.fx_quote_id, li.locked_payout, u.email, u.first_name, u.last_name, a.symbol AS payout_asset_symbol
+FROM pay_run_line_items li
+JOIN users u ON u.id = li.user_id
+JOIN supported_assets a ON a.id = li.payout_asset_id
+WHERE li.pay_run_id = $1
+ORDER BY u.first_name, u.last_name, li.created_at
+`
+
+type ListPayRunLineItemsRow struct {
+	PayRunLineItems   PayRunLineItems `json:"pay_run_line_items"`
+	Email             string          `json:"email"`
+	FirstName         string          `json:"first_name"`
+	LastName          string          `json:"last_name"`
+	PayoutAssetSymbol string          `json:"payout_asset_symbol"`
+}
+
+func (q *Queries) ListPayRunLineItems(ctx context.Context, payRunID uuid.UUID) ([]ListPayRunLineItemsRow, error) {
+	rows, err := q.db.Query(ctx, listPayRunLineItems, payRunID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPayRunLineItemsRow{}
+	for rows.Next() {
+		var i ListPayRunLineItemsRow
+		if err := rows.Scan(
+			&i.PayRunLineItems.ID,
+			&i.PayRunLineItems.PayRunID,
+			&i.PayRunLineItems.CompensationID,
+			&i.PayRunLineItems.UserID,
+			&i.PayRunLineItems.Amount,
+			&i.PayRunLineItems.Currency,
+			&i.PayRunLineItems.PayoutAssetID,
+			&i.PayRunLineItems.WalletAddress,
+			&i.PayRunLineItems.CreatedAt,
+			&i.PayRunLineItems.TaxCountry,
+			&i.PayRunLineItems.TaxID,
+			&i.PayRunLineItems.FxQuoteID,
+			&i.PayRunLineItems.LockedPayout,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.PayoutAssetSymbol,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPayRunsByOrganization = `-- name: ListPayRunsByOrganization :many
+SELECT id, organization_id, schedule_id, pay_date, period_start, status, created_at, updated_at FROM pay_runs
+WHERE organization_id = $1
+ORDER BY pay_date DESC, created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListPayRunsByOrganizationParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Limit          int32     `json:"limit"`
+	Offset         int32     `json:"offset"`
+}
+
+func (q *Queries) ListPayRunsByOrganization(ctx context.Context, arg ListPayRunsByOrganizationParams) ([]PayRuns, error) {
+	rows, err := q.db.Query(ctx, listPayRunsByOrganization, arg.OrganizationID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PayRuns{}
+	for rows.Next() {
+		var i PayRuns
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.ScheduleID,
+			&i.PayDate,
+			&i.PeriodStart,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPayrollSchedulesByOrganization = `-- name: ListPayrollSchedulesByOrganization :many
+SELECT id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at FROM payroll_schedules
+WHERE organization_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListPayrollSchedulesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]PayrollSchedules, error) {
+	rows, err := q.db.Query(ctx, listPayrollSchedulesByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PayrollSchedules{}
+	for rows.Next() {
+		var i PayrollSchedules
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Frequency,
+			&i.CronExpression,
+			&i.Timezone,
+			&i.FirstPayDate,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.Active,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPayRunLineItemPayout = `-- name: LockPayRunLineItemPayout :exec
+UPDATE pay_run_line_items
+SET
+  fx_quote_id = $1,
+  locked_payout = $2
+WHERE id = $3
+`
+
+type LockPayRunLineItemPayoutParams struct {
+	FxQuoteID    pgtype.UUID    `json:"fx_quote_id"`
+	LockedPayout pgtype.Numeric `json:"locked_payout"`
+	ID           uuid.UUID      `json:"id"`
+}
+
+// Stores the payout a line item was converted to when its pay run was submitted
+func (q *Queries) LockPayRunLineItemPayout(ctx context.Context, arg LockPayRunLineItemPayoutParams) error {
+	_, err := q.db.Exec(ctx, lockPayRunLineItemPayout, arg.FxQuoteID, arg.LockedPayout, arg.ID)
+	return err
+}
+
+const updateEmployeeCompensation = `-- name: UpdateEmployeeCompensation :one
+UPDATE employee_compensations
+SET
+  amount = $2,
+  currency = $3,
+  payout_asset_id = $4,
+  wallet_address = $5,
+  active = $6,
+  tax_country = $7,
+  tax_id = $8,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, organization_id, schedule_id, user_id, amount, currency, payout_asset_id, wallet_address, active, created_at, updated_at, tax_country, tax_id
+`
+
+type UpdateEmployeeCompensationParams struct {
+	ID            uuid.UUID       `json:"id"`
+	Amount        decimal.Decimal `json:"amount"`
+	Currency      string          `json:"currency"`
+	PayoutAssetID uuid.UUID       `json:"payout_asset_id"`
+	WalletAddress string          `json:"wallet_address"`
+	Active        bool            `json:"active"`
+	TaxCountry    string          `json:"tax_country"`
+	TaxID         string          `json:"tax_id"`
+}
+
+func (q *Queries) UpdateEmployeeCompensation(ctx context.Context, arg UpdateEmployeeCompensationParams) (EmployeeCompensations, error) {
+	row := q.db.QueryRow(ctx, updateEmployeeCompensation,
+		arg.ID,
+		arg.Amount,
+		arg.Currency,
+		arg.PayoutAssetID,
+		arg.WalletAddress,
+		arg.Active,
+		arg.TaxCountry,
+		arg.TaxID,
+	)
+	var i EmployeeCompensations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.UserID,
+		&i.Amount,
+		&i.Currency,
+		&i.PayoutAssetID,
+		&i.WalletAddress,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TaxCountry,
+		&i.TaxID,
+	)
+	return i, err
+}
+
+const updatePayRunStatus = `-- name: UpdatePayRunStatus :one
+UPDATE pay_runs
+SET
+  status = $1,
+  updated_at = now()
+WHERE id = $2 AND status = $3
+RETURNING id, organization_id, schedule_id, pay_date, period_start, status, created_at, updated_at
+`
+
+type UpdatePayRunStatusParams struct {
+	ToStatus   string    `json:"to_status"`
+	ID         uuid.UUID `json:"id"`
+	FromStatus string    `json:"from_status"`
+}
+
+// Moves a pay run from one status to another; no row is returned if it is no
+// longer in the expected status
+func (q *Queries) UpdatePayRunStatus(ctx context.Context, arg UpdatePayRunStatusParams) (PayRuns, error) {
+	row := q.db.QueryRow(ctx, updatePayRunStatus, arg.ToStatus, arg.ID, arg.FromStatus)
+	var i PayRuns
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ScheduleID,
+		&i.PayDate,
+		&i.PeriodStart,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updatePayrollSchedule = `-- name: UpdatePayrollSchedule :one
+UPDATE payroll_schedules
+SET
+  name = $2,
+  frequency = $3,
+  cron_expression = $4,
+  timezone = $5,
+  first_pay_date = $6,
+  next_run_at = $7,
+  active = $8,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, organization_id, name, frequency, cron_expression, timezone, first_pay_date, next_run_at, last_run_at, active, created_by, created_at, updated_at
+`
+
+type UpdatePayrollScheduleParams struct {
+	ID             uuid.UUID   `json:"id"`
+	Name           string      `json:"name"`
+	Frequency      string      `json:"frequency"`
+	CronExpression pgtype.Text `json:"cron_expression"`
+	Timezone       string      `json:"timezone"`
+	FirstPayDate   time.Time   `json:"first_pay_date"`
+	NextRunAt      time.Time   `json:"next_run_at"`
+	Active         bool        `json:"active"`
+}
+
+func (q *Queries) UpdatePayrollSchedule(ctx context.Context, arg UpdatePayrollScheduleParams) (PayrollSchedules, error) {
+	row := q.db.QueryRow(ctx, updatePayrollSchedule,
+		arg.ID,
+		arg.Name,
+		arg.Frequency,
+		arg.CronExpression,
+		arg.Timezone,
+		arg.FirstPayDate,
+		arg.NextRunAt,
+		arg.Active,
+	)
+	var i PayrollSchedules
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Frequency,
+		&i.CronExpression,
+		&i.Timezone,
+		&i.FirstPayDate,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -6,18 +6,35 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
+	// Releases pending addresses whose cooling-off period has elapsed
+	ActivateDuePayoutAddresses(ctx context.Context, availableAt time.Time) error
+	// Moves a schedule on to its next pay date after a run was generated
+	AdvancePayrollSchedule(ctx context.Context, arg AdvancePayrollScheduleParams) (PayrollSchedules, error)
+	// Counts an invoice generated from a template and moves it on to its next
+	// issue date, completing it when there is none
+	AdvanceRecurringInvoice(ctx context.Context, arg AdvanceRecurringInvoiceParams) (RecurringInvoices, error)
+	// Hands out the organization's next receiving address index together with the
+	// key to derive it from
+	AllocateDepositIndex(ctx context.Context, organizationID uuid.UUID) (AllocateDepositIndexRow, error)
+	// Adds a credit note to what has been credited on an invoice, moving it to
+	// the status the remaining balance puts it in
+	ApplyInvoiceCredit(ctx context.Context, arg ApplyInvoiceCreditParams) (Invoices, error)
 	// Blocks all sessions for a specific user
 	BlockAllUserSessions(ctx context.Context, userID uuid.UUID) error
 	// Blocks all expired sessions
 	BlockExpiredSessions(ctx context.Context) error
 	// Blocks a session (marks it as invalid)
 	BlockSession(ctx context.Context, id uuid.UUID) error
+	// Blocks a pending address so it can never receive funds
+	CancelPayoutAddress(ctx context.Context, id uuid.UUID) (PayoutAddressAllowlist, error)
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 	CountActiveDeviceTokensForUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	CountActiveOTPsForUser(ctx context.Context, arg CountActiveOTPsForUserParams) (int64, error)
@@ -25,34 +42,107 @@ type Querier interface {
 	CountActiveSessions(ctx context.Context) (int64, error)
 	// Counts the number of active sessions for a specific user
 	CountActiveSessionsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountApprovalDecisions(ctx context.Context, arg CountApprovalDecisionsParams) (int64, error)
+	CountApprovalRequestsByOrganization(ctx context.Context, arg CountApprovalRequestsByOrganizationParams) (int64, error)
+	CountClients(ctx context.Context, arg CountClientsParams) (int64, error)
+	CountCreditNotesByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	CountInvoicesByOrganization(ctx context.Context, arg CountInvoicesByOrganizationParams) (int64, error)
+	CountOrganizationMembersByRole(ctx context.Context, arg CountOrganizationMembersByRoleParams) (int64, error)
+	CountPayRunsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error)
 	// Counts the number of users matching a search query
 	CountSearchUsers(ctx context.Context, dollar_1 pgtype.Text) (int64, error)
 	// Counts the number of waitlist entries matching a search query
 	CountSearchWaitlist(ctx context.Context, dollar_1 pgtype.Text) (int64, error)
+	CountTimesheets(ctx context.Context, arg CountTimesheetsParams) (int64, error)
+	// Counts a user's transactions matching the same filters as ListTransactionsByUserID
+	CountTransactionsByUserID(ctx context.Context, arg CountTransactionsByUserIDParams) (int64, error)
 	// Counts the total number of users (useful for pagination)
 	CountUsers(ctx context.Context) (int64, error)
 	// Counts users filtered by account type
 	CountUsersByAccountType(ctx context.Context, accountType string) (int64, error)
 	// Counts the total number of waitlist entries matching filters
 	CountWaitlistEntries(ctx context.Context, arg CountWaitlistEntriesParams) (int64, error)
+	// Records an approver's decision unless they already decided, in which case
+	// no row is returned
+	CreateApprovalDecision(ctx context.Context, arg CreateApprovalDecisionParams) (ApprovalDecisions, error)
+	CreateApprovalPolicy(ctx context.Context, arg CreateApprovalPolicyParams) (ApprovalPolicies, error)
+	CreateApprovalRequest(ctx context.Context, arg CreateApprovalRequestParams) (ApprovalRequests, error)
+	// Adds a client to the directory; no row is returned if the organization
+	// already has a client with the same email or tax ID
+	CreateClient(ctx context.Context, arg CreateClientParams) (Clients, error)
+	// Adds a contract unless the contractor already has an active one for the
+	// project, in which case no row is returned
+	CreateContractorContract(ctx context.Context, arg CreateContractorContractParams) (ContractorContracts, error)
+	CreateCreditNote(ctx context.Context, arg CreateCreditNoteParams) (CreditNotes, error)
+	CreateEmployeeCompensation(ctx context.Context, arg CreateEmployeeCompensationParams) (EmployeeCompensations, error)
+	// Locks an exchange rate until expires_at
+	CreateFXQuote(ctx context.Context, arg CreateFXQuoteParams) (FxQuotes, error)
+	// Records a fetched exchange rate
+	CreateFXRate(ctx context.Context, arg CreateFXRateParams) (FxRates, error)
+	CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (Invoices, error)
+	CreateInvoiceLineItem(ctx context.Context, arg CreateInvoiceLineItemParams) (InvoiceLineItems, error)
+	// Records a transfer into an invoice deposit address; a transfer that was
+	// already recorded, even in another block after a reorg re-mined its
+	// transaction, returns no row
+	CreateInvoicePayment(ctx context.Context, arg CreateInvoicePaymentParams) (InvoicePayments, error)
+	// Records a reminder step before it is sent; a step that was already
+	// recorded returns no row
+	CreateInvoiceReminder(ctx context.Context, arg CreateInvoiceReminderParams) (InvoiceReminders, error)
+	CreateInvoiceShareLink(ctx context.Context, arg CreateInvoiceShareLinkParams) (InvoiceShareLinks, error)
+	// Opens a ledger account
+	CreateLedgerAccount(ctx context.Context, arg CreateLedgerAccountParams) (LedgerAccounts, error)
+	// Records a journal entry unless one with the same external reference exists,
+	// in which case no row is returned
+	CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) (LedgerEntries, error)
+	// Records one debit or credit of a journal entry
+	CreateLedgerPosting(ctx context.Context, arg CreateLedgerPostingParams) (LedgerPostings, error)
 	CreateOTPVerification(ctx context.Context, arg CreateOTPVerificationParams) (OtpVerifications, error)
+	// Creates an organization profile
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organizations, error)
+	// Records a new pending invitation
+	CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitations, error)
+	// Adds a user to an organization
+	CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) (OrganizationMembers, error)
+	// Creates a draft pay run unless the schedule already has one for the pay
+	// date, in which case no row is returned
+	CreatePayRun(ctx context.Context, arg CreatePayRunParams) (PayRuns, error)
+	CreatePayRunLineItem(ctx context.Context, arg CreatePayRunLineItemParams) (PayRunLineItems, error)
+	// Adds a payout address to the allowlist in the pending (quarantined) state
+	CreatePayoutAddress(ctx context.Context, arg CreatePayoutAddressParams) (PayoutAddressAllowlist, error)
+	// Creates a payroll schedule
+	CreatePayrollSchedule(ctx context.Context, arg CreatePayrollScheduleParams) (PayrollSchedules, error)
+	CreateRecurringInvoice(ctx context.Context, arg CreateRecurringInvoiceParams) (RecurringInvoices, error)
+	CreateRecurringInvoiceLineItem(ctx context.Context, arg CreateRecurringInvoiceLineItemParams) (RecurringInvoiceLineItems, error)
 	CreateSecurityEvent(ctx context.Context, arg CreateSecurityEventParams) (SecurityEvents, error)
 	// Creates a new session and returns the created session record
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Sessions, error)
+	// Registers a new asset
+	CreateSupportedAsset(ctx context.Context, arg CreateSupportedAssetParams) (SupportedAssets, error)
+	CreateTimesheetEntry(ctx context.Context, arg CreateTimesheetEntryParams) error
+	// Creates a new transaction and returns the created transaction
+	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transactions, error)
 	// Creates a new user record and returns the created user
 	CreateUser(ctx context.Context, arg CreateUserParams) (Users, error)
 	CreateUserDeviceToken(ctx context.Context, arg CreateUserDeviceTokenParams) (UserDeviceTokens, error)
 	CreateUserWallet(ctx context.Context, arg CreateUserWalletParams) (UserWallets, error)
 	// Creates a new waitlist entry and returns the created entry
 	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (Waitlist, error)
+	// Removes a client from the directory; its invoices keep their copy of its details
+	DeleteClient(ctx context.Context, arg DeleteClientParams) (int64, error)
 	DeleteExpiredDeviceTokens(ctx context.Context) error
 	DeleteExpiredOTPs(ctx context.Context) error
 	// Cleans up expired sessions that are older than the specified date
 	DeleteExpiredSessions(ctx context.Context, expiresAt pgtype.Timestamp) error
+	DeleteInvoiceLineItems(ctx context.Context, invoiceID uuid.UUID) error
+	DeleteInvoiceReminder(ctx context.Context, id uuid.UUID) error
+	DeleteOrganizationDepositKey(ctx context.Context, organizationID uuid.UUID) error
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	DeleteRecurringInvoiceLineItems(ctx context.Context, recurringInvoiceID uuid.UUID) error
 	// Deletes a session by its ID
 	DeleteSession(ctx context.Context, id uuid.UUID) error
 	// Deletes all sessions for a specific user
 	DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error
+	DeleteTimesheetEntries(ctx context.Context, timesheetID uuid.UUID) error
 	// Permanently deletes a transaction record
 	DeleteTransaction(ctx context.Context, id uuid.UUID) error
 	// Deletes all transactions for a specific user
@@ -62,17 +152,73 @@ type Querier interface {
 	DeleteUserWallet(ctx context.Context, id uuid.UUID) error
 	// Permanently deletes a waitlist entry
 	DeleteWaitlistEntry(ctx context.Context, id uuid.UUID) error
+	// Expires pending requests whose approval window has passed
+	ExpireApprovalRequests(ctx context.Context, expiresAt time.Time) ([]ApprovalRequests, error)
 	// Retrieves all waitlist entries for export
 	ExportWaitlistEntries(ctx context.Context) ([]Waitlist, error)
+	// Finds another of the organization's clients with the same email or tax ID
+	FindDuplicateClient(ctx context.Context, arg FindDuplicateClientParams) (Clients, error)
 	GetActiveDeviceTokensForUser(ctx context.Context, userID uuid.UUID) ([]UserDeviceTokens, error)
 	// Retrieves active (non-expired, non-blocked) sessions with pagination
 	GetActiveSessions(ctx context.Context, arg GetActiveSessionsParams) ([]Sessions, error)
 	// Retrieves active sessions for a specific user
 	GetActiveSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Sessions, error)
+	GetApprovalPolicyByID(ctx context.Context, id uuid.UUID) (ApprovalPolicies, error)
+	GetApprovalRequestByID(ctx context.Context, id uuid.UUID) (ApprovalRequests, error)
+	// Locks a request while a decision on it is recorded
+	GetApprovalRequestForUpdate(ctx context.Context, id uuid.UUID) (ApprovalRequests, error)
+	GetClient(ctx context.Context, arg GetClientParams) (Clients, error)
+	GetContractorContract(ctx context.Context, arg GetContractorContractParams) (GetContractorContractRow, error)
+	GetCreditNote(ctx context.Context, arg GetCreditNoteParams) (CreditNotes, error)
 	GetDeviceTokensByPlatform(ctx context.Context, arg GetDeviceTokensByPlatformParams) ([]UserDeviceTokens, error)
+	GetEmployeeCompensationByID(ctx context.Context, id uuid.UUID) (GetEmployeeCompensationByIDRow, error)
+	// Retrieves a locked quote by ID
+	GetFXQuoteByID(ctx context.Context, id uuid.UUID) (FxQuotes, error)
+	// Retrieves the last processed block of an indexer
+	GetIndexerCheckpoint(ctx context.Context, name string) (IndexerCheckpoints, error)
+	GetInvoiceByID(ctx context.Context, id uuid.UUID) (Invoices, error)
+	// Locks an invoice until the calling transaction ends
+	GetInvoiceForUpdate(ctx context.Context, id uuid.UUID) (Invoices, error)
+	GetInvoiceReminderSettings(ctx context.Context, organizationID uuid.UUID) (InvoiceReminderSettings, error)
+	GetInvoiceShareLink(ctx context.Context, id uuid.UUID) (InvoiceShareLinks, error)
+	// Retrieves the most recently fetched rate for a currency pair
+	GetLatestFXRate(ctx context.Context, arg GetLatestFXRateParams) (FxRates, error)
+	// Retrieves the most recent allowlist entry for a user's address. Hex (EVM)
+	// addresses match whatever their checksum casing.
+	GetLatestPayoutAddressByUserAndAddress(ctx context.Context, arg GetLatestPayoutAddressByUserAndAddressParams) (PayoutAddressAllowlist, error)
+	// Sums the postings of an account in one asset
+	GetLedgerAccountBalance(ctx context.Context, arg GetLedgerAccountBalanceParams) (decimal.Decimal, error)
+	// Sums the postings of an account per asset
+	GetLedgerAccountBalances(ctx context.Context, accountID uuid.UUID) ([]GetLedgerAccountBalancesRow, error)
+	// Retrieves a ledger account by its unique code
+	GetLedgerAccountByCode(ctx context.Context, code string) (LedgerAccounts, error)
+	// Retrieves a ledger account by ID
+	GetLedgerAccountByID(ctx context.Context, id uuid.UUID) (LedgerAccounts, error)
+	// Retrieves a journal entry by ID
+	GetLedgerEntryByID(ctx context.Context, id uuid.UUID) (LedgerEntries, error)
+	// Retrieves a journal entry by its external reference
+	GetLedgerEntryByReference(ctx context.Context, externalReference string) (LedgerEntries, error)
 	GetOTPVerificationByID(ctx context.Context, id uuid.UUID) (OtpVerifications, error)
 	GetOTPVerificationByUserAndPurpose(ctx context.Context, arg GetOTPVerificationByUserAndPurposeParams) (OtpVerifications, error)
+	GetOrganizationByID(ctx context.Context, id uuid.UUID) (Organizations, error)
+	GetOrganizationDepositKey(ctx context.Context, organizationID uuid.UUID) (OrganizationDepositKeys, error)
+	GetOrganizationInvitationByID(ctx context.Context, id uuid.UUID) (GetOrganizationInvitationByIDRow, error)
+	// Retrieves a user's membership of an organization with their contact details
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error)
+	GetPayRunByID(ctx context.Context, id uuid.UUID) (PayRuns, error)
+	GetPayoutAddressByCancelTokenHash(ctx context.Context, cancelTokenHash string) (PayoutAddressAllowlist, error)
+	GetPayoutAddressByID(ctx context.Context, id uuid.UUID) (PayoutAddressAllowlist, error)
+	GetPayrollScheduleByID(ctx context.Context, id uuid.UUID) (PayrollSchedules, error)
+	// Locks a schedule while its next pay run is generated
+	GetPayrollScheduleForUpdate(ctx context.Context, id uuid.UUID) (PayrollSchedules, error)
+	// Retrieves the pending invitation for an email address in an organization, if any
+	GetPendingOrganizationInvitationByEmail(ctx context.Context, arg GetPendingOrganizationInvitationByEmailParams) (GetPendingOrganizationInvitationByEmailRow, error)
+	// Returns the schedule's latest pay run before the given pay date
+	GetPreviousPayRun(ctx context.Context, arg GetPreviousPayRunParams) (PayRuns, error)
 	GetRecentLoginEventsByUserID(ctx context.Context, arg GetRecentLoginEventsByUserIDParams) ([]SecurityEvents, error)
+	GetRecurringInvoiceByID(ctx context.Context, id uuid.UUID) (RecurringInvoices, error)
+	// Locks a template while its next invoice is generated
+	GetRecurringInvoiceForUpdate(ctx context.Context, id uuid.UUID) (RecurringInvoices, error)
 	GetSecurityEventsByUserIDAndType(ctx context.Context, arg GetSecurityEventsByUserIDAndTypeParams) ([]SecurityEvents, error)
 	// Retrieves a session by its ID
 	GetSessionByID(ctx context.Context, id uuid.UUID) (Sessions, error)
@@ -80,10 +226,20 @@ type Querier interface {
 	GetSessionByRefreshToken(ctx context.Context, refreshToken string) (Sessions, error)
 	// Retrieves all sessions for a specific user
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Sessions, error)
+	// Retrieves an asset by chain and contract address, ignoring address case
+	GetSupportedAssetByContract(ctx context.Context, arg GetSupportedAssetByContractParams) (SupportedAssets, error)
+	// Retrieves an asset by its ID
+	GetSupportedAssetByID(ctx context.Context, id uuid.UUID) (SupportedAssets, error)
+	// Retrieves an asset by chain and symbol
+	GetSupportedAssetBySymbol(ctx context.Context, arg GetSupportedAssetBySymbolParams) (SupportedAssets, error)
+	GetTimesheet(ctx context.Context, arg GetTimesheetParams) (GetTimesheetRow, error)
 	// Retrieves a single transaction by its ID
 	GetTransactionByID(ctx context.Context, id uuid.UUID) (Transactions, error)
-	// Retrieves a single transaction by its transaction hash
+	// Retrieves the user-initiated transaction with the hash. Inbound transfers are
+	// left out, as one transaction can carry several of them.
 	GetTransactionByTxHash(ctx context.Context, txHash string) (Transactions, error)
+	// Retrieves the transaction PIN record for a user
+	GetTransactionPINByUserID(ctx context.Context, userID uuid.UUID) (UserTransactionPins, error)
 	// Retrieves transactions by status
 	GetTransactionsByStatus(ctx context.Context, arg GetTransactionsByStatusParams) ([]Transactions, error)
 	// Retrieves all transactions for a specific user
@@ -111,39 +267,176 @@ type Querier interface {
 	GetWalletByAddress(ctx context.Context, address string) (UserWallets, error)
 	GetWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]UserWallets, error)
 	InValidateOTP(ctx context.Context, id uuid.UUID) error
+	InvoiceDepositAddressExists(ctx context.Context, paymentAddress pgtype.Text) (bool, error)
+	// Marks the organization's approved timesheets among ids as billed on an
+	// invoice, returning those that were
+	InvoiceTimesheets(ctx context.Context, arg InvoiceTimesheetsParams) ([]uuid.UUID, error)
+	ListActiveCompensationsBySchedule(ctx context.Context, scheduleID uuid.UUID) ([]EmployeeCompensations, error)
+	ListApprovalDecisions(ctx context.Context, requestID uuid.UUID) ([]ListApprovalDecisionsRow, error)
+	ListApprovalPoliciesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ApprovalPolicies, error)
+	// Lists an organization's approval requests, optionally in one status
+	ListApprovalRequestsByOrganization(ctx context.Context, arg ListApprovalRequestsByOrganizationParams) ([]ApprovalRequests, error)
+	// Lists an organization's clients by name, optionally only those whose name,
+	// email or tax ID contains the search text
+	ListClients(ctx context.Context, arg ListClientsParams) ([]Clients, error)
+	// Lists an organization's contracts, active ones first, optionally only one
+	// contractor's
+	ListContractorContracts(ctx context.Context, arg ListContractorContractsParams) ([]ListContractorContractsRow, error)
+	ListCreditNotesByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]CreditNotes, error)
+	// Lists an organization's credit notes, latest number first
+	ListCreditNotesByOrganization(ctx context.Context, arg ListCreditNotesByOrganizationParams) ([]CreditNotes, error)
+	// Finds, per invoice awaiting payment, the latest reminder step that has come
+	// due and has not been sent. Steps that came due before the invoice was sent
+	// are skipped, as are steps earlier than one already sent or given up, so
+	// catching up after downtime sends one reminder rather than every missed one.
+	// A step whose email failed waits out a backoff that doubles with each
+	// attempt, is given up after max_attempts, and is listed after steps that
+	// have not failed so retries never crowd them out of a batch.
+	ListDueInvoiceReminders(ctx context.Context, arg ListDueInvoiceRemindersParams) ([]ListDueInvoiceRemindersRow, error)
+	// Lists active schedules whose next pay date has arrived
+	ListDuePayrollSchedules(ctx context.Context, arg ListDuePayrollSchedulesParams) ([]PayrollSchedules, error)
+	// Lists active templates whose next issue date has arrived
+	ListDueRecurringInvoices(ctx context.Context, arg ListDueRecurringInvoicesParams) ([]RecurringInvoices, error)
+	// Lists an organization's compensation records, optionally for one schedule
+	ListEmployeeCompensations(ctx context.Context, arg ListEmployeeCompensationsParams) ([]ListEmployeeCompensationsRow, error)
+	// Lists the rates of a pair fetched in a time range, newest first
+	ListFXRateHistory(ctx context.Context, arg ListFXRateHistoryParams) ([]FxRates, error)
+	ListInvoiceLineItems(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceLineItems, error)
+	ListInvoicePayments(ctx context.Context, invoiceID uuid.UUID) ([]InvoicePayments, error)
+	ListInvoiceReminders(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceReminders, error)
+	ListInvoiceShareLinks(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceShareLinks, error)
+	// Lists the invoices whose deposit address is watched for payments: those
+	// not yet paid or voided, and paid or voided ones for a while longer so late
+	// transfers are still flagged as overpayments or payments after the void
+	ListInvoicesAwaitingDeposit(ctx context.Context, closedSince pgtype.Timestamptz) ([]Invoices, error)
+	// Lists an organization's invoices, optionally in one status, latest number first
+	ListInvoicesByOrganization(ctx context.Context, arg ListInvoicesByOrganizationParams) ([]Invoices, error)
+	// Lists the most recently fetched rate of every pair with the given base
+	ListLatestFXRates(ctx context.Context, baseCurrency string) ([]FxRates, error)
+	// Lists the ledger accounts belonging to a user or organization
+	ListLedgerAccountsByOwner(ctx context.Context, ownerID pgtype.UUID) ([]LedgerAccounts, error)
+	// Lists the postings of a journal entry
+	ListLedgerPostingsByEntry(ctx context.Context, entryID uuid.UUID) ([]LedgerPostings, error)
+	// Lists an organization's members with their contact details
+	ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error)
+	// Lists the organizations a user belongs to with their role in each
+	ListOrganizationsByUser(ctx context.Context, userID uuid.UUID) ([]ListOrganizationsByUserRow, error)
+	ListPayRunLineItems(ctx context.Context, payRunID uuid.UUID) ([]ListPayRunLineItemsRow, error)
+	ListPayRunsByOrganization(ctx context.Context, arg ListPayRunsByOrganizationParams) ([]PayRuns, error)
+	ListPayoutAddressesByUserID(ctx context.Context, userID uuid.UUID) ([]PayoutAddressAllowlist, error)
+	ListPayrollSchedulesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]PayrollSchedules, error)
+	// Lists an organization's pending invitations, including expired ones, newest first
+	ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]ListPendingOrganizationInvitationsRow, error)
+	ListRecurringInvoiceLineItems(ctx context.Context, recurringInvoiceID uuid.UUID) ([]RecurringInvoiceLineItems, error)
+	ListRecurringInvoicesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]RecurringInvoices, error)
+	// Lists assets, optionally only those on one chain or only enabled ones
+	ListSupportedAssets(ctx context.Context, arg ListSupportedAssetsParams) ([]SupportedAssets, error)
+	ListTimesheetEntries(ctx context.Context, timesheetID uuid.UUID) ([]TimesheetEntries, error)
+	// Lists an organization's timesheets, latest week first, optionally only one
+	// contractor's, one contract's or those in one status
+	ListTimesheets(ctx context.Context, arg ListTimesheetsParams) ([]ListTimesheetsRow, error)
+	// Lists a user's transactions with pagination and optional status and date range filters
+	ListTransactionsByUserID(ctx context.Context, arg ListTransactionsByUserIDParams) ([]Transactions, error)
+	ListUserWallets(ctx context.Context) ([]UserWallets, error)
 	// Lists users with pagination support
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]Users, error)
 	// Lists users filtered by account type with pagination
 	ListUsersByAccountType(ctx context.Context, arg ListUsersByAccountTypeParams) ([]Users, error)
 	// Lists waitlist entries with pagination and filtering support
 	ListWaitlistEntries(ctx context.Context, arg ListWaitlistEntriesParams) ([]Waitlist, error)
+	// Stores the payout a line item was converted to when its pay run was submitted
+	LockPayRunLineItemPayout(ctx context.Context, arg LockPayRunLineItemPayoutParams) error
+	MarkInvoicePaid(ctx context.Context, arg MarkInvoicePaidParams) (Invoices, error)
+	MarkInvoiceSent(ctx context.Context, arg MarkInvoiceSentParams) (Invoices, error)
+	// Records the first time the customer opened an invoice awaiting payment. Only
+	// a sent invoice becomes viewed; a partially paid or overdue one keeps its status.
+	MarkInvoiceViewed(ctx context.Context, arg MarkInvoiceViewedParams) (Invoices, error)
+	// Moves sent, viewed and partially paid invoices whose due date has passed to
+	// overdue, a batch at a time; rows another instance is updating are skipped
+	MarkInvoicesOverdue(ctx context.Context, arg MarkInvoicesOverdueParams) ([]Invoices, error)
+	MarkOrganizationInvitationAccepted(ctx context.Context, arg MarkOrganizationInvitationAcceptedParams) (OrganizationInvitations, error)
+	MarkOrganizationInvitationRevoked(ctx context.Context, id uuid.UUID) (OrganizationInvitations, error)
+	// Hands out the organization's next credit note number. Like invoice numbers,
+	// the sequence row stays locked until the calling transaction ends.
+	NextCreditNoteNumber(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	// Hands out the organization's next invoice number. The sequence row stays
+	// locked until the calling transaction ends, so a rolled back invoice gives
+	// its number back and concurrent invoices are numbered one after the other.
+	NextInvoiceNumber(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	// Counts a wrong PIN entry and locks the PIN once max_attempts is reached
+	RecordFailedTransactionPINAttempt(ctx context.Context, arg RecordFailedTransactionPINAttemptParams) (UserTransactionPins, error)
+	// Counts a failed attempt at a reminder step and returns how many there have been
+	RecordInvoiceReminderFailure(ctx context.Context, arg RecordInvoiceReminderFailureParams) (int32, error)
+	RecordInvoiceShareLinkView(ctx context.Context, arg RecordInvoiceShareLinkViewParams) error
+	// Replaces the token of a pending invitation when it is resent
+	RefreshOrganizationInvitationToken(ctx context.Context, arg RefreshOrganizationInvitationTokenParams) (OrganizationInvitations, error)
+	// Returns the timesheets billed on a voided invoice to approved, so their
+	// hours can be invoiced again
+	ReleaseInvoicedTimesheets(ctx context.Context, invoiceID pgtype.UUID) error
+	// Clears failed attempts and any lockout after a correct PIN entry
+	ResetTransactionPINAttempts(ctx context.Context, userID uuid.UUID) error
+	// Closes a pending request with its outcome
+	ResolveApprovalRequest(ctx context.Context, arg ResolveApprovalRequestParams) (ApprovalRequests, error)
+	// Approves or rejects a submitted timesheet
+	ReviewTimesheet(ctx context.Context, arg ReviewTimesheetParams) (Timesheets, error)
 	RevokeDeviceToken(ctx context.Context, id uuid.UUID) (UserDeviceTokens, error)
+	RevokeInvoiceShareLink(ctx context.Context, arg RevokeInvoiceShareLinkParams) (InvoiceShareLinks, error)
+	// Starts a contract's timesheet for the week, or reopens an existing draft or
+	// rejected one for editing. No row is returned once the timesheet has been
+	// submitted.
+	SaveTimesheet(ctx context.Context, arg SaveTimesheetParams) (Timesheets, error)
 	SearchDeviceTokens(ctx context.Context, arg SearchDeviceTokensParams) ([]UserDeviceTokens, error)
 	// Searches for users by name, email, or nationality with pagination
 	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]Users, error)
 	// Searches for waitlist entries by email or name with pagination
 	SearchWaitlist(ctx context.Context, arg SearchWaitlistParams) ([]Waitlist, error)
+	// Submits a draft timesheet with hours on it for approval
+	SubmitTimesheet(ctx context.Context, arg SubmitTimesheetParams) (Timesheets, error)
+	SumInvoicePayments(ctx context.Context, invoiceID uuid.UUID) (decimal.Decimal, error)
+	// Moves a transaction to a new status only if it is still in the expected status
+	TransitionTransactionStatus(ctx context.Context, arg TransitionTransactionStatusParams) (Transactions, error)
+	UpdateApprovalPolicy(ctx context.Context, arg UpdateApprovalPolicyParams) (ApprovalPolicies, error)
+	UpdateClient(ctx context.Context, arg UpdateClientParams) (Clients, error)
+	UpdateContractorContract(ctx context.Context, arg UpdateContractorContractParams) (ContractorContracts, error)
 	UpdateDeviceTokenDetails(ctx context.Context, arg UpdateDeviceTokenDetailsParams) (UserDeviceTokens, error)
 	UpdateDeviceTokenLastUsed(ctx context.Context, arg UpdateDeviceTokenLastUsedParams) (UserDeviceTokens, error)
 	UpdateDeviceTokenPushNotificationToken(ctx context.Context, arg UpdateDeviceTokenPushNotificationTokenParams) (UserDeviceTokens, error)
+	// Replaces the details of a draft invoice; no row is returned once it has been sent
+	UpdateDraftInvoice(ctx context.Context, arg UpdateDraftInvoiceParams) (Invoices, error)
+	UpdateEmployeeCompensation(ctx context.Context, arg UpdateEmployeeCompensationParams) (EmployeeCompensations, error)
+	// Stores the amount received, the status it puts an invoice in and any
+	// payment exception it raises
+	UpdateInvoicePaymentStatus(ctx context.Context, arg UpdateInvoicePaymentStatusParams) (Invoices, error)
 	UpdateOTPAttempts(ctx context.Context, id uuid.UUID) (OtpVerifications, error)
+	// Replaces an organization's profile
+	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organizations, error)
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (OrganizationMembers, error)
+	// Moves a pay run from one status to another; no row is returned if it is no
+	// longer in the expected status
+	UpdatePayRunStatus(ctx context.Context, arg UpdatePayRunStatusParams) (PayRuns, error)
+	UpdatePayrollSchedule(ctx context.Context, arg UpdatePayrollScheduleParams) (PayrollSchedules, error)
+	UpdateRecurringInvoice(ctx context.Context, arg UpdateRecurringInvoiceParams) (RecurringInvoices, error)
 	// Updates just the refresh token of a session
 	UpdateRefreshToken(ctx context.Context, arg UpdateRefreshTokenParams) (Sessions, error)
 	// Updates session details
 	UpdateSession(ctx context.Context, arg UpdateSessionParams) (Sessions, error)
 	UpdateSessionRefreshToken(ctx context.Context, arg UpdateSessionRefreshTokenParams) (Sessions, error)
+	// Updates the display name and enabled flag of an asset
+	UpdateSupportedAsset(ctx context.Context, arg UpdateSupportedAssetParams) (SupportedAssets, error)
 	// Updates transaction details and returns the updated transaction
 	UpdateTransaction(ctx context.Context, arg UpdateTransactionParams) (Transactions, error)
+	// Records the block a transaction's receipt was seen in, or clears it after a reorg
+	UpdateTransactionBlock(ctx context.Context, arg UpdateTransactionBlockParams) (Transactions, error)
 	// Updates the status of a transaction and returns the updated transaction
 	UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (Transactions, error)
 	// Updates user details and returns the updated user
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (Users, error)
 	// Updates a user's address
 	UpdateUserAddress(ctx context.Context, arg UpdateUserAddressParams) (Users, error)
-	// Updates a user's company details
-	UpdateUserCompanyDetails(ctx context.Context, arg UpdateUserCompanyDetailsParams) (Users, error)
 	// Updates a user's email address with validation that the new email is unique
 	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (Users, error)
+	// Updates a user's employment type
+	UpdateUserEmploymentType(ctx context.Context, arg UpdateUserEmploymentTypeParams) (Users, error)
 	// Updates a user's job role
 	UpdateUserJobRole(ctx context.Context, arg UpdateUserJobRoleParams) (Users, error)
 	// Updates a user's password
@@ -153,8 +446,23 @@ type Querier interface {
 	// Updates a user's profile information
 	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (Users, error)
 	UpdateUserWallet(ctx context.Context, arg UpdateUserWalletParams) (UserWallets, error)
+	// Records an indexed inbound transfer. A transfer seen again, including in
+	// another block after a reorg re-mined its transaction, only refreshes where
+	// it was seen, so it is never recorded twice.
+	UpsertInboundTransfer(ctx context.Context, arg UpsertInboundTransferParams) (Transactions, error)
+	// Records the last processed block of an indexer
+	UpsertIndexerCheckpoint(ctx context.Context, arg UpsertIndexerCheckpointParams) (IndexerCheckpoints, error)
+	UpsertInvoiceReminderSettings(ctx context.Context, arg UpsertInvoiceReminderSettingsParams) (InvoiceReminderSettings, error)
+	// Sets an organization's deposit key. A different key starts again at the
+	// first receiving address; saving the same key only changes the tolerance.
+	UpsertOrganizationDepositKey(ctx context.Context, arg UpsertOrganizationDepositKeyParams) (OrganizationDepositKeys, error)
+	// Registers an asset or refreshes its details, keyed by chain and symbol. Used by the seeder.
+	UpsertSupportedAsset(ctx context.Context, arg UpsertSupportedAssetParams) (SupportedAssets, error)
+	// Sets a user's transaction PIN and clears any failed attempts or lockout
+	UpsertTransactionPIN(ctx context.Context, arg UpsertTransactionPINParams) (UserTransactionPins, error)
 	UpsertUserDeviceToken(ctx context.Context, arg UpsertUserDeviceTokenParams) (UserDeviceTokens, error)
 	VerifyOTP(ctx context.Context, arg VerifyOTPParams) (OtpVerifications, error)
+	VoidInvoice(ctx context.Context, arg VoidInvoiceParams) (Invoices, error)
 }
 
 var _ Querier = (*Queries)(nil)
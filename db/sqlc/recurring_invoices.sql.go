@@ -0,0 +1,558 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: recurring_invoices.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const advanceRecurringInvoice = `-- name: AdvanceRecurringInvoice :one
+UPDATE recurring_invoices
+SET
+  occurrences = occurrences + 1,
+  last_issued_at = $1,
+  next_issue_at = $2,
+  status = CASE WHEN $3::boolean THEN 'completed' ELSE status END,
+  updated_at = now()
+WHERE id = $4
+RETURNING id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at
+`
+
+type AdvanceRecurringInvoiceParams struct {
+	LastIssuedAt pgtype.Timestamptz `json:"last_issued_at"`
+	NextIssueAt  time.Time          `json:"next_issue_at"`
+	Completed    bool               `json:"completed"`
+	ID           uuid.UUID          `json:"id"`
+}
+
+// Counts an invoice generated from a template and moves it on to its next
+// issue date, completing it when there is none
+func (q *Queries) AdvanceRecurringInvoice(ctx context.Context, arg AdvanceRecurringInvoiceParams) (RecurringInvoices, error) {
+	row := q.db.QueryRow(ctx, advanceRecurringInvoice,
+		arg.LastIssuedAt,
+		arg.NextIssueAt,
+		arg.Completed,
+		arg.ID,
+	)
+	var i RecurringInvoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Status,
+		&i.Frequency,
+		&i.Timezone,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.NextIssueAt,
+		&i.LastIssuedAt,
+		&i.DaysUntilDue,
+		&i.AutoSend,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.Notes,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createRecurringInvoice = `-- name: CreateRecurringInvoice :one
+INSERT INTO recurring_invoices (
+  id,
+  organization_id,
+  name,
+  status,
+  frequency,
+  timezone,
+  start_date,
+  end_date,
+  max_occurrences,
+  next_issue_at,
+  days_until_due,
+  auto_send,
+  currency,
+  customer_name,
+  customer_email,
+  customer_address,
+  notes,
+  payment_asset_id,
+  payment_address,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, 'active', $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, now(), now()
+) RETURNING id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at
+`
+
+type CreateRecurringInvoiceParams struct {
+	ID              uuid.UUID          `json:"id"`
+	OrganizationID  uuid.UUID          `json:"organization_id"`
+	Name            string             `json:"name"`
+	Frequency       string             `json:"frequency"`
+	Timezone        string             `json:"timezone"`
+	StartDate       time.Time          `json:"start_date"`
+	EndDate         pgtype.Timestamptz `json:"end_date"`
+	MaxOccurrences  pgtype.Int4        `json:"max_occurrences"`
+	NextIssueAt     time.Time          `json:"next_issue_at"`
+	DaysUntilDue    int32              `json:"days_until_due"`
+	AutoSend        bool               `json:"auto_send"`
+	Currency        string             `json:"currency"`
+	CustomerName    string             `json:"customer_name"`
+	CustomerEmail   string             `json:"customer_email"`
+	CustomerAddress string             `json:"customer_address"`
+	Notes           string             `json:"notes"`
+	PaymentAssetID  pgtype.UUID        `json:"payment_asset_id"`
+	PaymentAddress  pgtype.Text        `json:"payment_address"`
+	CreatedBy       pgtype.UUID        `json:"created_by"`
+}
+
+func (q *Queries) CreateRecurringInvoice(ctx context.Context, arg CreateRecurringInvoiceParams) (RecurringInvoices, error) {
+	row := q.db.QueryRow(ctx, createRecurringInvoice,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Frequency,
+		arg.Timezone,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MaxOccurrences,
+		arg.NextIssueAt,
+		arg.DaysUntilDue,
+		arg.AutoSend,
+		arg.Currency,
+		arg.CustomerName,
+		arg.CustomerEmail,
+		arg.CustomerAddress,
+		arg.Notes,
+		arg.PaymentAssetID,
+		arg.PaymentAddress,
+		arg.CreatedBy,
+	)
+	var i RecurringInvoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Status,
+		&i.Frequency,
+		&i.Timezone,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.NextIssueAt,
+		&i.LastIssuedAt,
+		&i.DaysUntilDue,
+		&i.AutoSend,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.Notes,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createRecurringInvoiceLineItem = `-- name: CreateRecurringInvoiceLineItem :one
+INSERT INTO recurring_invoice_line_items (
+  id,
+  recurring_invoice_id,
+  position,
+  description,
+  quantity,
+  unit_price,
+  discount_percent,
+  tax_rate,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, now()
+) RETURNING id, recurring_invoice_id, position, description, quantity, unit_price, discount_percent, tax_rate, created_at
+`
+
+type CreateRecurringInvoiceLineItemParams struct {
+	ID                 uuid.UUID       `json:"id"`
+	RecurringInvoiceID uuid.UUID       `json:"recurring_invoice_id"`
+	Position           int32           `json:"position"`
+	Description        string          `json:"description"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	UnitPrice          decimal.Decimal `json:"unit_price"`
+	DiscountPercent    decimal.Decimal `json:"discount_percent"`
+	TaxRate            decimal.Decimal `json:"tax_rate"`
+}
+
+func (q *Queries) CreateRecurringInvoiceLineItem(ctx context.Context, arg CreateRecurringInvoiceLineItemParams) (RecurringInvoiceLineItems, error) {
+	row := q.db.QueryRow(ctx, createRecurringInvoiceLineItem,
+		arg.ID,
+		arg.RecurringInvoiceID,
+		arg.Position,
+		arg.Description,
+		arg.Quantity,
+		arg.UnitPrice,
+		arg.DiscountPercent,
+		arg.TaxRate,
+	)
+	var i RecurringInvoiceLineItems
+	err := row.Scan(
+		&i.ID,
+		&i.RecurringInvoiceID,
+		&i.Position,
+		&i.Description,
+		&i.Quantity,
+		&i.UnitPrice,
+		&i.DiscountPercent,
+		&i.TaxRate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteRecurringInvoiceLineItems = `-- name: DeleteRecurringInvoiceLineItems :exec
+DELETE FROM recurring_invoice_line_items
+WHERE recurring_invoice_id = $1
+`
+
+func (q *Queries) DeleteRecurringInvoiceLineItems(ctx context.Context, recurringInvoiceID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRecurringInvoiceLineItems, recurringInvoiceID)
+	return err
+}
+
+const getRecurringInvoiceByID = `-- name: GetRecurringInvoiceByID :one
+SELECT id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at FROM recurring_invoices
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetRecurringInvoiceByID(ctx context.Context, id uuid.UUID) (RecurringInvoices, error) {
+	row := q.db.QueryRow(ctx, getRecurringInvoiceByID, id)
+	var i RecurringInvoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Status,
+		&i.Frequency,
+		&i.Timezone,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.NextIssueAt,
+		&i.LastIssuedAt,
+		&i.DaysUntilDue,
+		&i.AutoSend,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.Notes,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRecurringInvoiceForUpdate = `-- name: GetRecurringInvoiceForUpdate :one
+SELECT id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at FROM recurring_invoices
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks a template while its next invoice is generated
+func (q *Queries) GetRecurringInvoiceForUpdate(ctx context.Context, id uuid.UUID) (RecurringInvoices, error) {
+	row := q.db.QueryRow(ctx, getRecurringInvoiceForUpdate, id)
+	var i RecurringInvoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Status,
+		&i.Frequency,
+		&i.Timezone,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.NextIssueAt,
+		&i.LastIssuedAt,
+		&i.DaysUntilDue,
+		&i.AutoSend,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.Notes,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDueRecurringInvoices = `-- name: ListDueRecurringInvoices :many
+SELECT id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at FROM recurring_invoices
+WHERE status = 'active' AND next_issue_at <= $1
+ORDER BY next_issue_at
+LIMIT $2
+`
+
+type ListDueRecurringInvoicesParams struct {
+	NextIssueAt time.Time `json:"next_issue_at"`
+	Limit       int32     `json:"limit"`
+}
+
+// Lists active templates whose next issue date has arrived
+func (q *Queries) ListDueRecurringInvoices(ctx context.Context, arg ListDueRecurringInvoicesParams) ([]RecurringInvoices, error) {
+	rows, err := q.db.Query(ctx, listDueRecurringInvoices, arg.NextIssueAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RecurringInvoices{}
+	for rows.Next() {
+		var i RecurringInvoices
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Status,
+			&i.Frequency,
+			&i.Timezone,
+			&i.StartDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.Occurrences,
+			&i.NextIssueAt,
+			&i.LastIssuedAt,
+			&i.DaysUntilDue,
+			&i.AutoSend,
+			&i.Currency,
+			&i.CustomerName,
+			&i.CustomerEmail,
+			&i.CustomerAddress,
+			&i.Notes,
+			&i.PaymentAssetID,
+			&i.PaymentAddress,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringInvoiceLineItems = `-- name: ListRecurringInvoiceLineItems :many
+SELECT id, recurring_invoice_id, position, description, quantity, unit_price, discount_percent, tax_rate, created_at FROM recurring_invoice_line_items
+WHERE recurring_invoice_id = $1
+ORDER BY position
+`
+
+func (q *Queries) ListRecurringInvoiceLineItems(ctx context.Context, recurringInvoiceID uuid.UUID) ([]RecurringInvoiceLineItems, error) {
+	rows, err := q.db.Query(ctx, listRecurringInvoiceLineItems, recurringInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RecurringInvoiceLineItems{}
+	for rows.Next() {
+		var i RecurringInvoiceLineItems
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecurringInvoiceID,
+			&i.Position,
+			&i.Description,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.DiscountPercent,
+			&i.TaxRate,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringInvoicesByOrganization = `-- name: ListRecurringInvoicesByOrganization :many
+SELECT id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at FROM recurring_invoices
+WHERE organization_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListRecurringInvoicesByOrganization(ctx context.Context, organizationID uuid.UUID) ([]RecurringInvoices, error) {
+	rows, err := q.db.Query(ctx, listRecurringInvoicesByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RecurringInvoices{}
+	for rows.Next() {
+		var i RecurringInvoices
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Status,
+			&i.Frequency,
+			&i.Timezone,
+			&i.StartDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.Occurrences,
+			&i.NextIssueAt,
+			&i.LastIssuedAt,
+			&i.DaysUntilDue,
+			&i.AutoSend,
+			&i.Currency,
+			&i.CustomerName,
+			&i.CustomerEmail,
+			&i.CustomerAddress,
+			&i.Notes,
+			&i.PaymentAssetID,
+			&i.PaymentAddress,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRecurringInvoice = `-- name: UpdateRecurringInvoice :one
+UPDATE recurring_invoices
+SET
+  name = $2,
+  status = $3,
+  frequency = $4,
+  timezone = $5,
+  start_date = $6,
+  end_date = $7,
+  max_occurrences = $8,
+  next_issue_at = $9,
+  days_until_due = $10,
+  auto_send = $11,
+  currency = $12,
+  customer_name = $13,
+  customer_email = $14,
+  customer_address = $15,
+  notes = $16,
+  payment_asset_id = $17,
+  payment_address = $18,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, organization_id, name, status, frequency, timezone, start_date, end_date, max_occurrences, occurrences, next_issue_at, last_issued_at, days_until_due, auto_send, currency, customer_name, customer_email, customer_address, notes, payment_asset_id, payment_address, created_by, created_at, updated_at
+`
+
+type UpdateRecurringInvoiceParams struct {
+	ID              uuid.UUID          `json:"id"`
+	Name            string             `json:"name"`
+	Status          string             `json:"status"`
+	Frequency       string             `json:"frequency"`
+	Timezone        string             `json:"timezone"`
+	StartDate       time.Time          `json:"start_date"`
+	EndDate         pgtype.Timestamptz `json:"end_date"`
+	MaxOccurrences  pgtype.Int4        `json:"max_occurrences"`
+	NextIssueAt     time.Time          `json:"next_issue_at"`
+	DaysUntilDue    int32              `json:"days_until_due"`
+	AutoSend        bool               `json:"auto_send"`
+	Currency        string             `json:"currency"`
+	CustomerName    string             `json:"customer_name"`
+	CustomerEmail   string             `json:"customer_email"`
+	CustomerAddress string             `json:"customer_address"`
+	Notes           string             `json:"notes"`
+	PaymentAssetID  pgtype.UUID        `json:"payment_asset_id"`
+	PaymentAddress  pgtype.Text        `json:"payment_address"`
+}
+
+func (q *Queries) UpdateRecurringInvoice(ctx context.Context, arg UpdateRecurringInvoiceParams) (RecurringInvoices, error) {
+	row := q.db.QueryRow(ctx, updateRecurringInvoice,
+		arg.ID,
+		arg.Name,
+		arg.Status,
+		arg.Frequency,
+		arg.Timezone,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MaxOccurrences,
+		arg.NextIssueAt,
+		arg.DaysUntilDue,
+		arg.AutoSend,
+		arg.Currency,
+		arg.CustomerName,
+		arg.CustomerEmail,
+		arg.CustomerAddress,
+		arg.Notes,
+		arg.PaymentAssetID,
+		arg.PaymentAddress,
+	)
+	var i RecurringInvoices
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Status,
+		&i.Frequency,
+		&i.Timezone,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.NextIssueAt,
+		&i.LastIssuedAt,
+		&i.DaysUntilDue,
+		&i.AutoSend,
+		&i.Currency,
+		&i.CustomerName,
+		&i.CustomerEmail,
+		&i.CustomerAddress,
+		&i.Notes,
+		&i.PaymentAssetID,
+		&i.PaymentAddress,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -109,6 +109,12 @@ func (s *Seeder) SeedDB(ctx context.Context) error {
 
 	// Seed tables in the correct order to maintain referential integrity
 	var err error
+	if contains(tables, "supported_assets") {
+		if err = s.seedSupportedAssets(ctx); err != nil {
+			return fmt.Errorf("failed to seed supported assets: %w", err)
+		}
+	}
+
 	if contains(tables, "users") {
 		if err = s.seedUsers(ctx); err != nil {
 			return fmt.Errorf("failed to seed users: %w", err)
@@ -155,6 +161,7 @@ func (s *Seeder) getTablesToSeed() []string {
 	if len(s.options.Tables) == 0 {
 		// If no specific tables are requested, seed all tables
 		return []string{
+			"supported_assets",
 			"users",
 			"sessions",
 			"otp_verifications",
@@ -214,6 +221,33 @@ func (s *Seeder) cleanTables(ctx context.Context) error {
 	return nil
 }
 
+// seedSupportedAssets registers the stablecoins and native coins accepted for payments.
+// Assets are upserted by chain and symbol, so reseeding never duplicates them.
+func (s *Seeder) seedSupportedAssets(ctx context.Context) error {
+	log.Println("Seeding supported assets...")
+
+	assets := []UpsertSupportedAssetParams{
+		{Chain: "ethereum", Symbol: "ETH", Name: "Ether", Decimals: 18},
+		{Chain: "ethereum", Symbol: "USDC", Name: "USD Coin", ContractAddress: pgtype.Text{String: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Valid: true}, Decimals: 6},
+		{Chain: "ethereum", Symbol: "USDT", Name: "Tether USD", ContractAddress: pgtype.Text{String: "0xdAC17F958D2ee523a2206206994597C13D831ec7", Valid: true}, Decimals: 6},
+		{Chain: "base", Symbol: "USDC", Name: "USD Coin", ContractAddress: pgtype.Text{String: "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913", Valid: true}, Decimals: 6},
+		{Chain: "polygon", Symbol: "USDC", Name: "USD Coin", ContractAddress: pgtype.Text{String: "0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359", Valid: true}, Decimals: 6},
+		{Chain: "tron", Symbol: "USDT", Name: "Tether USD", ContractAddress: pgtype.Text{String: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", Valid: true}, Decimals: 6},
+		{Chain: "solana", Symbol: "USDC", Name: "USD Coin", ContractAddress: pgtype.Text{String: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Valid: true}, Decimals: 6},
+	}
+
+	for _, asset := range assets {
+		asset.ID = uuid.New()
+		asset.Enabled = true
+		if _, err := s.queries.UpsertSupportedAsset(ctx, asset); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Seeded %d supported assets", len(assets))
+	return nil
+}
+
 // seedUsers creates user records
 func (s *Seeder) seedUsers(ctx context.Context) error {
 	log.Printf("Seeding %d users...", s.options.UserCount)
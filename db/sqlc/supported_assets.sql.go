@@ -0,0 +1,283 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: supported_assets.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSupportedAsset = `-- name: CreateSupportedAsset :one
+INSERT INTO supported_assets (
+  id,
+  chain,
+  symbol,
+  name,
+  contract_address,
+  decimals,
+  enabled,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, now(), now()
+) RETURNING id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at
+`
+
+type CreateSupportedAssetParams struct {
+	ID              uuid.UUID   `json:"id"`
+	Chain           string      `json:"chain"`
+	Symbol          string      `json:"symbol"`
+	Name            string      `json:"name"`
+	ContractAddress pgtype.Text `json:"contract_address"`
+	Decimals        int16       `json:"decimals"`
+	Enabled         bool        `json:"enabled"`
+}
+
+// Registers a new asset
+func (q *Queries) CreateSupportedAsset(ctx context.Context, arg CreateSupportedAssetParams) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, createSupportedAsset,
+		arg.ID,
+		arg.Chain,
+		arg.Symbol,
+		arg.Name,
+		arg.ContractAddress,
+		arg.Decimals,
+		arg.Enabled,
+	)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSupportedAssetByContract = `-- name: GetSupportedAssetByContract :one
+SELECT id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at FROM supported_assets
+WHERE chain = $1 AND lower(contract_address) = lower($2::text)
+LIMIT 1
+`
+
+type GetSupportedAssetByContractParams struct {
+	Chain           string `json:"chain"`
+	ContractAddress string `json:"contract_address"`
+}
+
+// Retrieves an asset by chain and contract address, ignoring address case
+func (q *Queries) GetSupportedAssetByContract(ctx context.Context, arg GetSupportedAssetByContractParams) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, getSupportedAssetByContract, arg.Chain, arg.ContractAddress)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSupportedAssetByID = `-- name: GetSupportedAssetByID :one
+SELECT id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at FROM supported_assets
+WHERE id = $1
+LIMIT 1
+`
+
+// Retrieves an asset by its ID
+func (q *Queries) GetSupportedAssetByID(ctx context.Context, id uuid.UUID) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, getSupportedAssetByID, id)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSupportedAssetBySymbol = `-- name: GetSupportedAssetBySymbol :one
+SELECT id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at FROM supported_assets
+WHERE chain = $1 AND upper(symbol) = upper($2::text)
+LIMIT 1
+`
+
+type GetSupportedAssetBySymbolParams struct {
+	Chain  string `json:"chain"`
+	Symbol string `json:"symbol"`
+}
+
+// Retrieves an asset by chain and symbol
+func (q *Queries) GetSupportedAssetBySymbol(ctx context.Context, arg GetSupportedAssetBySymbolParams) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, getSupportedAssetBySymbol, arg.Chain, arg.Symbol)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSupportedAssets = `-- name: ListSupportedAssets :many
+SELECT id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at FROM supported_assets
+WHERE ($1::text IS NULL OR chain = $1)
+  AND (NOT $2::boolean OR enabled)
+ORDER BY chain, symbol
+`
+
+type ListSupportedAssetsParams struct {
+	Chain       pgtype.Text `json:"chain"`
+	EnabledOnly bool        `json:"enabled_only"`
+}
+
+// Lists assets, optionally only those on one chain or only enabled ones
+func (q *Queries) ListSupportedAssets(ctx context.Context, arg ListSupportedAssetsParams) ([]SupportedAssets, error) {
+	rows, err := q.db.Query(ctx, listSupportedAssets, arg.Chain, arg.EnabledOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SupportedAssets{}
+	for rows.Next() {
+		var i SupportedAssets
+		if err := rows.Scan(
+			&i.ID,
+			&i.Chain,
+			&i.Symbol,
+			&i.Name,
+			&i.ContractAddress,
+			&i.Decimals,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSupportedAsset = `-- name: UpdateSupportedAsset :one
+UPDATE supported_assets
+SET
+  name = $1,
+  enabled = $2,
+  updated_at = now()
+WHERE id = $3
+RETURNING id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at
+`
+
+type UpdateSupportedAssetParams struct {
+	Name    string    `json:"name"`
+	Enabled bool      `json:"enabled"`
+	ID      uuid.UUID `json:"id"`
+}
+
+// Updates the display name and enabled flag of an asset
+func (q *Queries) UpdateSupportedAsset(ctx context.Context, arg UpdateSupportedAssetParams) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, updateSupportedAsset, arg.Name, arg.Enabled, arg.ID)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertSupportedAsset = `-- name: UpsertSupportedAsset :one
+INSERT INTO supported_assets (
+  id,
+  chain,
+  symbol,
+  name,
+  contract_address,
+  decimals,
+  enabled,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, now(), now()
+)
+ON CONFLICT (chain, symbol) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  contract_address = EXCLUDED.contract_address,
+  decimals = EXCLUDED.decimals,
+  updated_at = now()
+RETURNING id, chain, symbol, name, contract_address, decimals, enabled, created_at, updated_at
+`
+
+type UpsertSupportedAssetParams struct {
+	ID              uuid.UUID   `json:"id"`
+	Chain           string      `json:"chain"`
+	Symbol          string      `json:"symbol"`
+	Name            string      `json:"name"`
+	ContractAddress pgtype.Text `json:"contract_address"`
+	Decimals        int16       `json:"decimals"`
+	Enabled         bool        `json:"enabled"`
+}
+
+// Registers an asset or refreshes its details, keyed by chain and symbol. Used by the seeder.
+func (q *Queries) UpsertSupportedAsset(ctx context.Context, arg UpsertSupportedAssetParams) (SupportedAssets, error) {
+	row := q.db.QueryRow(ctx, upsertSupportedAsset,
+		arg.ID,
+		arg.Chain,
+		arg.Symbol,
+		arg.Name,
+		arg.ContractAddress,
+		arg.Decimals,
+		arg.Enabled,
+	)
+	var i SupportedAssets
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.Symbol,
+		&i.Name,
+		&i.ContractAddress,
+		&i.Decimals,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,672 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: timesheets.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const countTimesheets = `-- name: CountTimesheets :one
+SELECT COUNT(*) FROM timesheets t
+WHERE t.organization_id = $1
+  AND ($2::uuid IS NULL OR t.contractor_id = $2)
+  AND ($3::uuid IS NULL OR t.contract_id = $3)
+  AND ($4::text IS NULL OR t.status = $4)
+`
+
+type CountTimesheetsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	ContractorID   pgtype.UUID `json:"contractor_id"`
+	ContractID     pgtype.UUID `json:"contract_id"`
+	Status         pgtype.Text `json:"status"`
+}
+
+func (q *Queries) CountTimesheets(ctx context.Context, arg CountTimesheetsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTimesheets,
+		arg.OrganizationID,
+		arg.ContractorID,
+		arg.ContractID,
+		arg.Status,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createContractorContract = `-- name: CreateContractorContract :one
+INSERT INTO contractor_contracts (
+  id,
+  organization_id,
+  contractor_id,
+  client_id,
+  project,
+  hourly_rate,
+  currency,
+  active,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, true, $8, now(), now()
+)
+ON CONFLICT DO NOTHING
+RETURNING id, organization_id, contractor_id, client_id, project, hourly_rate, currency, active, created_by, created_at, updated_at
+`
+
+type CreateContractorContractParams struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	ContractorID   uuid.UUID       `json:"contractor_id"`
+	ClientID       pgtype.UUID     `json:"client_id"`
+	Project        string          `json:"project"`
+	HourlyRate     decimal.Decimal `json:"hourly_rate"`
+	Currency       string          `json:"currency"`
+	CreatedBy      pgtype.UUID     `json:"created_by"`
+}
+
+// Adds a contract unless the contractor already has an active one for the
+// project, in which case no row is returned
+func (q *Queries) CreateContractorContract(ctx context.Context, arg CreateContractorContractParams) (ContractorContracts, error) {
+	row := q.db.QueryRow(ctx, createContractorContract,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ContractorID,
+		arg.ClientID,
+		arg.Project,
+		arg.HourlyRate,
+		arg.Currency,
+		arg.CreatedBy,
+	)
+	var i ContractorContracts
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ContractorID,
+		&i.ClientID,
+		&i.Project,
+		&i.HourlyRate,
+		&i.Currency,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createTimesheetEntry = `-- name: CreateTimesheetEntry :exec
+INSERT INTO timesheet_entries (
+  id,
+  timesheet_id,
+  position,
+  work_date,
+  hours,
+  description,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, now()
+)
+`
+
+type CreateTimesheetEntryParams struct {
+	ID          uuid.UUID       `json:"id"`
+	TimesheetID uuid.UUID       `json:"timesheet_id"`
+	Position    int32           `json:"position"`
+	WorkDate    time.Time       `json:"work_date"`
+	Hours       decimal.Decimal `json:"hours"`
+	Description string          `json:"description"`
+}
+
+func (q *Queries) CreateTimesheetEntry(ctx context.Context, arg CreateTimesheetEntryParams) error {
+	_, err := q.db.Exec(ctx, createTimesheetEntry,
+		arg.ID,
+		arg.TimesheetID,
+		arg.Position,
+		arg.WorkDate,
+		arg.Hours,
+		arg.Description,
+	)
+	return err
+}
+
+const deleteTimesheetEntries = `-- name: DeleteTimesheetEntries :exec
+DELETE FROM timesheet_entries
+WHERE timesheet_id = $1
+`
+
+func (q *Queries) DeleteTimesheetEntries(ctx context.Context, timesheetID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTimesheetEntries, timesheetID)
+	return err
+}
+
+const getContractorContract = `-- name: GetContractorContract :one
+SELECT c.id, c.organization_id, c.contractor_id, c.client_id, c.project, c.hourly_rate, c.currency, c.active, c.created_by, c.created_at, c.updated_at, u.email, u.first_name, u.last_name, COALESCE(cl.name, '')::text AS client_name
+FROM contractor_contracts c
+JOIN users u ON u.id = c.contractor_id
+LEFT JOIN clients cl ON cl.id = c.client_id
+WHERE c.organization_id = $1 AND c.id = $2
+LIMIT 1
+`
+
+type GetContractorContractParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ID             uuid.UUID `json:"id"`
+}
+
+type GetContractorContractRow struct {
+	ContractorContracts ContractorContracts `json:"contractor_contracts"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"first_name"`
+	LastName            string              `json:"last_name"`
+	ClientName          string              `json:"client_name"`
+}
+
+func (q *Queries) GetContractorContract(ctx context.Context, arg GetContractorContractParams) (GetContractorContractRow, error) {
+	row := q.db.QueryRow(ctx, getContractorContract, arg.OrganizationID, arg.ID)
+	var i GetContractorContractRow
+	err := row.Scan(
+		&i.ContractorContracts.ID,
+		&i.ContractorContracts.OrganizationID,
+		&i.ContractorContracts.ContractorID,
+		&i.ContractorContracts.ClientID,
+		&i.ContractorContracts.Project,
+		&i.ContractorContracts.HourlyRate,
+		&i.ContractorContracts.Currency,
+		&i.ContractorContracts.Active,
+		&i.ContractorContracts.CreatedBy,
+		&i.ContractorContracts.CreatedAt,
+		&i.ContractorContracts.UpdatedAt,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.ClientName,
+	)
+	return i, err
+}
+
+const getTimesheet = `-- name: GetTimesheet :one
+SELECT t.id, t.organization_id, t.contract_id, t.contractor_id, t.week_start, t.status, t.total_hours, t.submitted_at, t.reviewed_by, t.reviewed_at, t.rejection_reason, t.invoice_id, t.created_at, t.updated_at, c.project, u.email, u.first_name, u.last_name
+FROM timesheets t
+JOIN contractor_contracts c ON c.id = t.contract_id
+JOIN users u ON u.id = t.contractor_id
+WHERE t.organization_id = $1 AND t.id = $2
+LIMIT 1
+`
+
+type GetTimesheetParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ID             uuid.UUID `json:"id"`
+}
+
+type GetTimesheetRow struct {
+	Timesheets Timesheets `json:"timesheets"`
+	Project    string     `json:"project"`
+	Email      string     `json:"email"`
+	FirstName  string     `json:"first_name"`
+	LastName   string     `json:"last_name"`
+}
+
+func (q *Queries) GetTimesheet(ctx context.Context, arg GetTimesheetParams) (GetTimesheetRow, error) {
+	row := q.db.QueryRow(ctx, getTimesheet, arg.OrganizationID, arg.ID)
+	var i GetTimesheetRow
+	err := row.Scan(
+		&i.Timesheets.ID,
+		&i.Timesheets.OrganizationID,
+		&i.Timesheets.ContractID,
+		&i.Timesheets.ContractorID,
+		&i.Timesheets.WeekStart,
+		&i.Timesheets.Status,
+		&i.Timesheets.TotalHours,
+		&i.Timesheets.SubmittedAt,
+		&i.Timesheets.ReviewedBy,
+		&i.Timesheets.ReviewedAt,
+		&i.Timesheets.RejectionReason,
+		&i.Timesheets.InvoiceID,
+		&i.Timesheets.CreatedAt,
+		&i.Timesheets.UpdatedAt,
+		&i.Project,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+	)
+	return i, err
+}
+
+const invoiceTimesheets = `-- name: InvoiceTimesheets :many
+UPDATE timesheets
+SET
+  status = 'invoiced',
+  invoice_id = $1,
+  updated_at = now()
+WHERE organization_id = $2
+  AND id = ANY($3::uuid[])
+  AND status = 'approved'
+RETURNING id
+`
+
+type InvoiceTimesheetsParams struct {
+	InvoiceID      pgtype.UUID `json:"invoice_id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	Ids            []uuid.UUID `json:"ids"`
+}
+
+// Marks the organization's approved timesheets among ids as billed on an
+// invoice, returning those that were
+func (q *Queries) InvoiceTimesheets(ctx context.Context, arg InvoiceTimesheetsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, invoiceTimesheets, arg.InvoiceID, arg.OrganizationID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContractorContracts = `-- name: ListContractorContracts :many
+SELECT c.id, c.organization_id, c.contractor_id, c.client_id, c.project, c.hourly_rate, c.currency, c.active, c.created_by, c.created_at, c.updated_at, u.email, u.first_name, u.last_name, COALESCE(cl.name, '')::text AS client_name
+FROM contractor_contracts c
+JOIN users u ON u.id = c.contractor_id
+LEFT JOIN clients cl ON cl.id = c.client_id
+WHERE c.organization_id = $1
+  AND ($2::uuid IS NULL OR c.contractor_id = $2)
+ORDER BY c.active DESC, u.first_name, u.last_name, lower(c.project)
+`
+
+type ListContractorContractsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	ContractorID   pgtype.UUID `json:"contractor_id"`
+}
+
+type ListContractorContractsRow struct {
+	ContractorContracts ContractorContracts `json:"contractor_contracts"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"first_name"`
+	LastName            string              `json:"last_name"`
+	ClientName          string              `json:"client_name"`
+}
+
+// Lists an organization's contracts, active ones first, optionally only one
+// contractor's
+func (q *Queries) ListContractorContracts(ctx context.Context, arg ListContractorContractsParams) ([]ListContractorContractsRow, error) {
+	rows, err := q.db.Query(ctx, listContractorContracts, arg.OrganizationID, arg.ContractorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListContractorContractsRow{}
+	for rows.Next() {
+		var i ListContractorContractsRow
+		if err := rows.Scan(
+			&i.ContractorContracts.ID,
+			&i.ContractorContracts.OrganizationID,
+			&i.ContractorContracts.ContractorID,
+			&i.ContractorContracts.ClientID,
+			&i.ContractorContracts.Project,
+			&i.ContractorContracts.HourlyRate,
+			&i.ContractorContracts.Currency,
+			&i.ContractorContracts.Active,
+			&i.ContractorContracts.CreatedBy,
+			&i.ContractorContracts.CreatedAt,
+			&i.ContractorContracts.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.ClientName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimesheetEntries = `-- name: ListTimesheetEntries :many
+SELECT id, timesheet_id, position, work_date, hours, description, created_at FROM timesheet_entries
+WHERE timesheet_id = $1
+ORDER BY position
+`
+
+func (q *Queries) ListTimesheetEntries(ctx context.Context, timesheetID uuid.UUID) ([]TimesheetEntries, error) {
+	rows, err := q.db.Query(ctx, listTimesheetEntries, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TimesheetEntries{}
+	for rows.Next() {
+		var i TimesheetEntries
+		if err := rows.Scan(
+			&i.ID,
+			&i.TimesheetID,
+			&i.Position,
+			&i.WorkDate,
+			&i.Hours,
+			&i.Description,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimesheets = `-- name: ListTimesheets :many
+SELECT t.id, t.organization_id, t.contract_id, t.contractor_id, t.week_start, t.status, t.total_hours, t.submitted_at, t.reviewed_by, t.reviewed_at, t.rejection_reason, t.invoice_id, t.created_at, t.updated_at, c.project, u.email, u.first_name, u.last_name
+FROM timesheets t
+JOIN contractor_contracts c ON c.id = t.contract_id
+JOIN users u ON u.id = t.contractor_id
+WHERE t.organization_id = $1
+  AND ($2::uuid IS NULL OR t.contractor_id = $2)
+  AND ($3::uuid IS NULL OR t.contract_id = $3)
+  AND ($4::text IS NULL OR t.status = $4)
+ORDER BY t.week_start DESC, u.first_name, u.last_name, lower(c.project)
+LIMIT $6 OFFSET $5
+`
+
+type ListTimesheetsParams struct {
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	ContractorID   pgtype.UUID `json:"contractor_id"`
+	ContractID     pgtype.UUID `json:"contract_id"`
+	Status         pgtype.Text `json:"status"`
+	OffsetCount    int32       `json:"offset_count"`
+	LimitCount     int32       `json:"limit_count"`
+}
+
+type ListTimesheetsRow struct {
+	Timesheets Timesheets `json:"timesheets"`
+	Project    string     `json:"project"`
+	Email      string     `json:"email"`
+	FirstName  string     `json:"first_name"`
+	LastName   string     `json:"last_name"`
+}
+
+// Lists an organization's timesheets, latest week first, optionally only one
+// contractor's, one contract's or those in one status
+func (q *Queries) ListTimesheets(ctx context.Context, arg ListTimesheetsParams) ([]ListTimesheetsRow, error) {
+	rows, err := q.db.Query(ctx, listTimesheets,
+		arg.OrganizationID,
+		arg.ContractorID,
+		arg.ContractID,
+		arg.Status,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimesheetsRow{}
+	for rows.Next() {
+		var i ListTimesheetsRow
+		if err := rows.Scan(
+			&i.Timesheets.ID,
+			&i.Timesheets.OrganizationID,
+			&i.Timesheets.ContractID,
+			&i.Timesheets.ContractorID,
+			&i.Timesheets.WeekStart,
+			&i.Timesheets.Status,
+			&i.Timesheets.TotalHours,
+			&i.Timesheets.SubmittedAt,
+			&i.Timesheets.ReviewedBy,
+			&i.Timesheets.ReviewedAt,
+			&i.Timesheets.RejectionReason,
+			&i.Timesheets.InvoiceID,
+			&i.Timesheets.CreatedAt,
+			&i.Timesheets.UpdatedAt,
+			&i.Project,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseInvoicedTimesheets = `-- name: ReleaseInvoicedTimesheets :exec
+UPDATE timesheets
+SET
+  status = 'approved',
+  invoice_id = NULL,
+  updated_at = now()
+WHERE invoice_id = $1 AND status = 'invoiced'
+`
+
+// Returns the timesheets billed on a voided invoice to approved, so their
+// hours can be invoiced again
+func (q *Queries) ReleaseInvoicedTimesheets(ctx context.Context, invoiceID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, releaseInvoicedTimesheets, invoiceID)
+	return err
+}
+
+const reviewTimesheet = `-- name: ReviewTimesheet :one
+UPDATE timesheets
+SET
+  status = $1,
+  reviewed_by = $2,
+  reviewed_at = $3,
+  rejection_reason = $4,
+  updated_at = now()
+WHERE id = $5 AND status = 'submitted'
+RETURNING id, organization_id, contract_id, contractor_id, week_start, status, total_hours, submitted_at, reviewed_by, reviewed_at, rejection_reason, invoice_id, created_at, updated_at
+`
+
+type ReviewTimesheetParams struct {
+	Status          string             `json:"status"`
+	ReviewedBy      pgtype.UUID        `json:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+	RejectionReason string             `json:"rejection_reason"`
+	ID              uuid.UUID          `json:"id"`
+}
+
+// Approves or rejects a submitted timesheet
+func (q *Queries) ReviewTimesheet(ctx context.Context, arg ReviewTimesheetParams) (Timesheets, error) {
+	row := q.db.QueryRow(ctx, reviewTimesheet,
+		arg.Status,
+		arg.ReviewedBy,
+		arg.ReviewedAt,
+		arg.RejectionReason,
+		arg.ID,
+	)
+	var i Timesheets
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ContractID,
+		&i.ContractorID,
+		&i.WeekStart,
+		&i.Status,
+		&i.TotalHours,
+		&i.SubmittedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.RejectionReason,
+		&i.InvoiceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const saveTimesheet = `-- name: SaveTimesheet :one
+INSERT INTO timesheets (
+  id,
+  organization_id,
+  contract_id,
+  contractor_id,
+  week_start,
+  status,
+  total_hours,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'draft', $6, now(), now()
+)
+ON CONFLICT (contract_id, week_start) DO UPDATE
+SET
+  status = 'draft',
+  total_hours = EXCLUDED.total_hours,
+  updated_at = now()
+WHERE timesheets.status IN ('draft', 'rejected')
+RETURNING id, organization_id, contract_id, contractor_id, week_start, status, total_hours, submitted_at, reviewed_by, reviewed_at, rejection_reason, invoice_id, created_at, updated_at
+`
+
+type SaveTimesheetParams struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	ContractID     uuid.UUID       `json:"contract_id"`
+	ContractorID   uuid.UUID       `json:"contractor_id"`
+	WeekStart      time.Time       `json:"week_start"`
+	TotalHours     decimal.Decimal `json:"total_hours"`
+}
+
+// Starts a contract's timesheet for the week, or reopens an existing draft or
+// rejected one for editing. No row is returned once the timesheet has been
+// submitted.
+func (q *Queries) SaveTimesheet(ctx context.Context, arg SaveTimesheetParams) (Timesheets, error) {
+	row := q.db.QueryRow(ctx, saveTimesheet,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ContractID,
+		arg.ContractorID,
+		arg.WeekStart,
+		arg.TotalHours,
+	)
+	var i Timesheets
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ContractID,
+		&i.ContractorID,
+		&i.WeekStart,
+		&i.Status,
+		&i.TotalHours,
+		&i.SubmittedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.RejectionReason,
+		&i.InvoiceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const submitTimesheet = `-- name: SubmitTimesheet :one
+UPDATE timesheets
+SET
+  status = 'submitted',
+  submitted_at = $1,
+  rejection_reason = '',
+  updated_at = now()
+WHERE id = $2 AND status = 'draft' AND total_hours > 0
+RETURNING id, organization_id, contract_id, contractor_id, week_start, status, total_hours, submitted_at, reviewed_by, reviewed_at, rejection_reason, invoice_id, created_at, updated_at
+`
+
+type SubmitTimesheetParams struct {
+	SubmittedAt pgtype.Timestamptz `json:"submitted_at"`
+	ID          uuid.UUID          `json:"id"`
+}
+
+// Submits a draft timesheet with hours on it for approval
+func (q *Queries) SubmitTimesheet(ctx context.Context, arg SubmitTimesheetParams) (Timesheets, error) {
+	row := q.db.QueryRow(ctx, submitTimesheet, arg.SubmittedAt, arg.ID)
+	var i Timesheets
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ContractID,
+		&i.ContractorID,
+		&i.WeekStart,
+		&i.Status,
+		&i.TotalHours,
+		&i.SubmittedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.RejectionReason,
+		&i.InvoiceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateContractorContract = `-- name: UpdateContractorContract :one
+UPDATE contractor_contracts
+SET
+  client_id = $3,
+  project = $4,
+  hourly_rate = $5,
+  currency = $6,
+  active = $7,
+  updated_at = now()
+WHERE organization_id = $1 AND id = $2
+RETURNING id, organization_id, contractor_id, client_id, project, hourly_rate, currency, active, created_by, created_at, updated_at
+`
+
+type UpdateContractorContractParams struct {
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	ID             uuid.UUID       `json:"id"`
+	ClientID       pgtype.UUID     `json:"client_id"`
+	Project        string          `json:"project"`
+	HourlyRate     decimal.Decimal `json:"hourly_rate"`
+	Currency       string          `json:"currency"`
+	Active         bool            `json:"active"`
+}
+
+func (q *Queries) UpdateContractorContract(ctx context.Context, arg UpdateContractorContractParams) (ContractorContracts, error) {
+	row := q.db.QueryRow(ctx, updateContractorContract,
+		arg.OrganizationID,
+		arg.ID,
+		arg.ClientID,
+		arg.Project,
+		arg.HourlyRate,
+		arg.Currency,
+		arg.Active,
+	)
+	var i ContractorContracts
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.ContractorID,
+		&i.ClientID,
+		&i.Project,
+		&i.HourlyRate,
+		&i.Currency,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
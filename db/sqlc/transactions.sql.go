@@ -9,8 +9,99 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countTransactionsByUserID = `-- name: CountTransactionsByUserID :one
+SELECT COUNT(*) FROM transactions
+WHERE user_id = $1
+  AND ($2::text IS NULL OR status = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+`
+
+type CountTransactionsByUserIDParams struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Status      pgtype.Text        `json:"status"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+}
+
+// Counts a user's transactions matching the same filters as ListTransactionsByUserID
+func (q *Queries) CountTransactionsByUserID(ctx context.Context, arg CountTransactionsByUserIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTransactionsByUserID,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createTransaction = `-- name: CreateTransaction :one
+INSERT INTO transactions (
+  id,
+  user_id,
+  tx_hash,
+  transaction_pin_hash,
+  status,
+  token_address,
+  to_address,
+  amount,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, now(), now()
+) RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
+`
+
+type CreateTransactionParams struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	TxHash             string         `json:"tx_hash"`
+	TransactionPinHash string         `json:"transaction_pin_hash"`
+	Status             string         `json:"status"`
+	TokenAddress       pgtype.Text    `json:"token_address"`
+	ToAddress          pgtype.Text    `json:"to_address"`
+	Amount             pgtype.Numeric `json:"amount"`
+}
+
+// Creates a new transaction and returns the created transaction
+func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transactions, error) {
+	row := q.db.QueryRow(ctx, createTransaction,
+		arg.ID,
+		arg.UserID,
+		arg.TxHash,
+		arg.TransactionPinHash,
+		arg.Status,
+		arg.TokenAddress,
+		arg.ToAddress,
+		arg.Amount,
+	)
+	var i Transactions
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TxHash,
+		&i.TransactionPinHash,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
+	)
+	return i, err
+}
+
 const deleteTransaction = `-- name: DeleteTransaction :exec
 DELETE FROM transactions
 WHERE id = $1
@@ -34,7 +125,7 @@ func (q *Queries) DeleteTransactionsByUserID(ctx context.Context, userID uuid.UU
 }
 
 const getTransactionByID = `-- name: GetTransactionByID :one
-SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at FROM transactions
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
 WHERE id = $1
 LIMIT 1
 `
@@ -51,17 +142,27 @@ func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (Transac
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
 	)
 	return i, err
 }
 
 const getTransactionByTxHash = `-- name: GetTransactionByTxHash :one
-SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at FROM transactions
-WHERE tx_hash = $1
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
+WHERE tx_hash = $1 AND direction = 'outbound'
 LIMIT 1
 `
 
-// Retrieves a single transaction by its transaction hash
+// Retrieves the user-initiated transaction with the hash. Inbound transfers are
+// left out, as one transaction can carry several of them.
 func (q *Queries) GetTransactionByTxHash(ctx context.Context, txHash string) (Transactions, error) {
 	row := q.db.QueryRow(ctx, getTransactionByTxHash, txHash)
 	var i Transactions
@@ -73,12 +174,21 @@ func (q *Queries) GetTransactionByTxHash(ctx context.Context, txHash string) (Tr
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
 	)
 	return i, err
 }
 
 const getTransactionsByStatus = `-- name: GetTransactionsByStatus :many
-SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at FROM transactions
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
 WHERE status = $1
 ORDER BY created_at DESC
 LIMIT $2
@@ -109,6 +219,15 @@ func (q *Queries) GetTransactionsByStatus(ctx context.Context, arg GetTransactio
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.BlockNumber,
+			&i.BlockHash,
+			&i.Direction,
+			&i.LogIndex,
+			&i.TokenAddress,
+			&i.FromAddress,
+			&i.ToAddress,
+			&i.Amount,
+			&i.TransferOrdinal,
 		); err != nil {
 			return nil, err
 		}
@@ -121,7 +240,7 @@ func (q *Queries) GetTransactionsByStatus(ctx context.Context, arg GetTransactio
 }
 
 const getTransactionsByUserID = `-- name: GetTransactionsByUserID :many
-SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at FROM transactions
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
 WHERE user_id = $1
 ORDER BY created_at DESC
 `
@@ -144,6 +263,15 @@ func (q *Queries) GetTransactionsByUserID(ctx context.Context, userID uuid.UUID)
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.BlockNumber,
+			&i.BlockHash,
+			&i.Direction,
+			&i.LogIndex,
+			&i.TokenAddress,
+			&i.FromAddress,
+			&i.ToAddress,
+			&i.Amount,
+			&i.TransferOrdinal,
 		); err != nil {
 			return nil, err
 		}
@@ -156,7 +284,7 @@ func (q *Queries) GetTransactionsByUserID(ctx context.Context, userID uuid.UUID)
 }
 
 const getTransactionsByUserIDAndStatus = `-- name: GetTransactionsByUserIDAndStatus :many
-SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at FROM transactions
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
 WHERE user_id = $1 AND status = $2
 ORDER BY created_at DESC
 `
@@ -184,6 +312,80 @@ func (q *Queries) GetTransactionsByUserIDAndStatus(ctx context.Context, arg GetT
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.BlockNumber,
+			&i.BlockHash,
+			&i.Direction,
+			&i.LogIndex,
+			&i.TokenAddress,
+			&i.FromAddress,
+			&i.ToAddress,
+			&i.Amount,
+			&i.TransferOrdinal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionsByUserID = `-- name: ListTransactionsByUserID :many
+SELECT id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal FROM transactions
+WHERE user_id = $1
+  AND ($2::text IS NULL OR status = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+ORDER BY created_at DESC
+LIMIT $6
+OFFSET $5
+`
+
+type ListTransactionsByUserIDParams struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Status      pgtype.Text        `json:"status"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+	OffsetCount int32              `json:"offset_count"`
+	LimitCount  int32              `json:"limit_count"`
+}
+
+// Lists a user's transactions with pagination and optional status and date range filters
+func (q *Queries) ListTransactionsByUserID(ctx context.Context, arg ListTransactionsByUserIDParams) ([]Transactions, error) {
+	rows, err := q.db.Query(ctx, listTransactionsByUserID,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transactions{}
+	for rows.Next() {
+		var i Transactions
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TxHash,
+			&i.TransactionPinHash,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.BlockNumber,
+			&i.BlockHash,
+			&i.Direction,
+			&i.LogIndex,
+			&i.TokenAddress,
+			&i.FromAddress,
+			&i.ToAddress,
+			&i.Amount,
+			&i.TransferOrdinal,
 		); err != nil {
 			return nil, err
 		}
@@ -195,6 +397,46 @@ func (q *Queries) GetTransactionsByUserIDAndStatus(ctx context.Context, arg GetT
 	return items, nil
 }
 
+const transitionTransactionStatus = `-- name: TransitionTransactionStatus :one
+UPDATE transactions
+SET
+  status = $1,
+  updated_at = now()
+WHERE id = $2 AND status = $3
+RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
+`
+
+type TransitionTransactionStatusParams struct {
+	ToStatus   string    `json:"to_status"`
+	ID         uuid.UUID `json:"id"`
+	FromStatus string    `json:"from_status"`
+}
+
+// Moves a transaction to a new status only if it is still in the expected status
+func (q *Queries) TransitionTransactionStatus(ctx context.Context, arg TransitionTransactionStatusParams) (Transactions, error) {
+	row := q.db.QueryRow(ctx, transitionTransactionStatus, arg.ToStatus, arg.ID, arg.FromStatus)
+	var i Transactions
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TxHash,
+		&i.TransactionPinHash,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
+	)
+	return i, err
+}
+
 const updateTransaction = `-- name: UpdateTransaction :one
 UPDATE transactions
 SET
@@ -202,7 +444,7 @@ SET
   transaction_pin_hash = COALESCE($3, transaction_pin_hash),
   updated_at = now()
 WHERE id = $1
-RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at
+RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
 `
 
 type UpdateTransactionParams struct {
@@ -223,6 +465,56 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
+	)
+	return i, err
+}
+
+const updateTransactionBlock = `-- name: UpdateTransactionBlock :one
+UPDATE transactions
+SET
+  block_number = $1,
+  block_hash = $2,
+  updated_at = now()
+WHERE id = $3
+RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
+`
+
+type UpdateTransactionBlockParams struct {
+	BlockNumber pgtype.Int8 `json:"block_number"`
+	BlockHash   pgtype.Text `json:"block_hash"`
+	ID          uuid.UUID   `json:"id"`
+}
+
+// Records the block a transaction's receipt was seen in, or clears it after a reorg
+func (q *Queries) UpdateTransactionBlock(ctx context.Context, arg UpdateTransactionBlockParams) (Transactions, error) {
+	row := q.db.QueryRow(ctx, updateTransactionBlock, arg.BlockNumber, arg.BlockHash, arg.ID)
+	var i Transactions
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TxHash,
+		&i.TransactionPinHash,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
 	)
 	return i, err
 }
@@ -233,7 +525,7 @@ SET
   status = $2,
   updated_at = now()
 WHERE id = $1
-RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at
+RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
 `
 
 type UpdateTransactionStatusParams struct {
@@ -253,6 +545,103 @@ func (q *Queries) UpdateTransactionStatus(ctx context.Context, arg UpdateTransac
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
+	)
+	return i, err
+}
+
+const upsertInboundTransfer = `-- name: UpsertInboundTransfer :one
+INSERT INTO transactions (
+  id,
+  user_id,
+  tx_hash,
+  transaction_pin_hash,
+  status,
+  direction,
+  log_index,
+  transfer_ordinal,
+  token_address,
+  from_address,
+  to_address,
+  amount,
+  block_number,
+  block_hash,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, '', $4, 'inbound', $5, $6, $7,
+  $8, $9, $10, $11, $12, now(), now()
+)
+ON CONFLICT (tx_hash, token_address, to_address, transfer_ordinal) WHERE direction = 'inbound' DO UPDATE
+SET
+  log_index = EXCLUDED.log_index,
+  from_address = EXCLUDED.from_address,
+  amount = EXCLUDED.amount,
+  block_number = EXCLUDED.block_number,
+  block_hash = EXCLUDED.block_hash,
+  updated_at = now()
+RETURNING id, user_id, tx_hash, transaction_pin_hash, status, created_at, updated_at, block_number, block_hash, direction, log_index, token_address, from_address, to_address, amount, transfer_ordinal
+`
+
+type UpsertInboundTransferParams struct {
+	ID              uuid.UUID      `json:"id"`
+	UserID          uuid.UUID      `json:"user_id"`
+	TxHash          string         `json:"tx_hash"`
+	Status          string         `json:"status"`
+	LogIndex        pgtype.Int4    `json:"log_index"`
+	TransferOrdinal pgtype.Int4    `json:"transfer_ordinal"`
+	TokenAddress    pgtype.Text    `json:"token_address"`
+	FromAddress     pgtype.Text    `json:"from_address"`
+	ToAddress       pgtype.Text    `json:"to_address"`
+	Amount          pgtype.Numeric `json:"amount"`
+	BlockNumber     pgtype.Int8    `json:"block_number"`
+	BlockHash       pgtype.Text    `json:"block_hash"`
+}
+
+// Records an indexed inbound transfer. A transfer seen again, including in
+// another block after a reorg re-mined its transaction, only refreshes where
+// it was seen, so it is never recorded twice.
+func (q *Queries) UpsertInboundTransfer(ctx context.Context, arg UpsertInboundTransferParams) (Transactions, error) {
+	row := q.db.QueryRow(ctx, upsertInboundTransfer,
+		arg.ID,
+		arg.UserID,
+		arg.TxHash,
+		arg.Status,
+		arg.LogIndex,
+		arg.TransferOrdinal,
+		arg.TokenAddress,
+		arg.FromAddress,
+		arg.ToAddress,
+		arg.Amount,
+		arg.BlockNumber,
+		arg.BlockHash,
+	)
+	var i Transactions
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TxHash,
+		&i.TransactionPinHash,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.Direction,
+		&i.LogIndex,
+		&i.TokenAddress,
+		&i.FromAddress,
+		&i.ToAddress,
+		&i.Amount,
+		&i.TransferOrdinal,
 	)
 	return i, err
 }
@@ -0,0 +1,120 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: user_transaction_pins.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const getTransactionPINByUserID = `-- name: GetTransactionPINByUserID :one
+SELECT user_id, pin_hash, failed_attempts, locked_until, created_at, updated_at FROM user_transaction_pins
+WHERE user_id = $1
+LIMIT 1
+`
+
+// Retrieves the transaction PIN record for a user
+func (q *Queries) GetTransactionPINByUserID(ctx context.Context, userID uuid.UUID) (UserTransactionPins, error) {
+	row := q.db.QueryRow(ctx, getTransactionPINByUserID, userID)
+	var i UserTransactionPins
+	err := row.Scan(
+		&i.UserID,
+		&i.PinHash,
+		&i.FailedAttempts,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const recordFailedTransactionPINAttempt = `-- name: RecordFailedTransactionPINAttempt :one
+UPDATE user_transaction_pins
+SET
+  failed_attempts = CASE WHEN failed_attempts + 1 >= $1::int THEN 0 ELSE failed_attempts + 1 END,
+  locked_until = CASE WHEN failed_attempts + 1 >= $1::int THEN $2::timestamptz ELSE locked_until END,
+  updated_at = now()
+WHERE user_id = $3
+RETURNING user_id, pin_hash, failed_attempts, locked_until, created_at, updated_at
+`
+
+type RecordFailedTransactionPINAttemptParams struct {
+	MaxAttempts int32     `json:"max_attempts"`
+	LockUntil   time.Time `json:"lock_until"`
+	UserID      uuid.UUID `json:"user_id"`
+}
+
+// Counts a wrong PIN entry and locks the PIN once max_attempts is reached
+func (q *Queries) RecordFailedTransactionPINAttempt(ctx context.Context, arg RecordFailedTransactionPINAttemptParams) (UserTransactionPins, error) {
+	row := q.db.QueryRow(ctx, recordFailedTransactionPINAttempt, arg.MaxAttempts, arg.LockUntil, arg.UserID)
+	var i UserTransactionPins
+	err := row.Scan(
+		&i.UserID,
+		&i.PinHash,
+		&i.FailedAttempts,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const resetTransactionPINAttempts = `-- name: ResetTransactionPINAttempts :exec
+UPDATE user_transaction_pins
+SET
+  failed_attempts = 0,
+  locked_until = NULL,
+  updated_at = now()
+WHERE user_id = $1
+`
+
+// Clears failed attempts and any lockout after a correct PIN entry
+func (q *Queries) ResetTransactionPINAttempts(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, resetTransactionPINAttempts, userID)
+	return err
+}
+
+const upsertTransactionPIN = `-- name: UpsertTransactionPIN :one
+INSERT INTO user_transaction_pins (
+  user_id,
+  pin_hash,
+  failed_attempts,
+  locked_until,
+  created_at,
+  updated_at
+) VALUES (
+  $1, $2, 0, NULL, now(), now()
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  pin_hash = EXCLUDED.pin_hash,
+  failed_attempts = 0,
+  locked_until = NULL,
+  updated_at = now()
+RETURNING user_id, pin_hash, failed_attempts, locked_until, created_at, updated_at
+`
+
+type UpsertTransactionPINParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	PinHash string    `json:"pin_hash"`
+}
+
+// Sets a user's transaction PIN and clears any failed attempts or lockout
+func (q *Queries) UpsertTransactionPIN(ctx context.Context, arg UpsertTransactionPINParams) (UserTransactionPins, error) {
+	row := q.db.QueryRow(ctx, upsertTransactionPIN, arg.UserID, arg.PinHash)
+	var i UserTransactionPins
+	err := row.Scan(
+		&i.UserID,
+		&i.PinHash,
+		&i.FailedAttempts,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
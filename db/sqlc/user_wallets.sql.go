@@ -119,6 +119,39 @@ func (q *Queries) GetWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]U
 	return items, nil
 }
 
+const listUserWallets = `-- name: ListUserWallets :many
+SELECT id, user_id, address, type, chain, is_default, created_at, updated_at FROM user_wallets ORDER BY created_at
+`
+
+func (q *Queries) ListUserWallets(ctx context.Context) ([]UserWallets, error) {
+	rows, err := q.db.Query(ctx, listUserWallets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserWallets{}
+	for rows.Next() {
+		var i UserWallets
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Address,
+			&i.Type,
+			&i.Chain,
+			&i.IsDefault,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUserWallet = `-- name: UpdateUserWallet :one
 UPDATE user_wallets
 SET is_default = $2, updated_at = $3
@@ -88,15 +88,9 @@ INSERT INTO users (
   nationality,
   residential_country,
   job_role,
-  company_name,
-  company_address,
-  company_city,
-  company_postal_code,
-  company_country,
   auth_provider,
   provider_id,
   employee_type,
-  company_website,
   employment_type,
   user_address,
   user_city,
@@ -116,22 +110,16 @@ INSERT INTO users (
   $10,
   $11,
   $12,
-  COALESCE($13, ''),
-  COALESCE($14, ''),
-  COALESCE($15, ''),
+  $13,
+  $14,
+  $15,
   COALESCE($16, ''),
   COALESCE($17, ''),
-  $18,
-  $19,
-  $20,
-  COALESCE($21, ''),
-  COALESCE($22, ''),
-  COALESCE($23, ''),
-  COALESCE($24, ''),
-  COALESCE($25, ''),
-  COALESCE($26, now()),
-  COALESCE($27, now())
-) RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+  COALESCE($18, ''),
+  COALESCE($19, ''),
+  COALESCE($20, now()),
+  COALESCE($21, now())
+) RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type CreateUserParams struct {
@@ -147,15 +135,9 @@ type CreateUserParams struct {
 	Nationality         string      `json:"nationality"`
 	ResidentialCountry  pgtype.Text `json:"residential_country"`
 	JobRole             pgtype.Text `json:"job_role"`
-	CompanyName         interface{} `json:"company_name"`
-	CompanyAddress      interface{} `json:"company_address"`
-	CompanyCity         interface{} `json:"company_city"`
-	CompanyPostalCode   interface{} `json:"company_postal_code"`
-	CompanyCountry      interface{} `json:"company_country"`
 	AuthProvider        pgtype.Text `json:"auth_provider"`
 	ProviderID          string      `json:"provider_id"`
 	EmployeeType        pgtype.Text `json:"employee_type"`
-	CompanyWebsite      interface{} `json:"company_website"`
 	EmploymentType      interface{} `json:"employment_type"`
 	UserAddress         interface{} `json:"user_address"`
 	UserCity            interface{} `json:"user_city"`
@@ -179,15 +161,9 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Users,
 		arg.Nationality,
 		arg.ResidentialCountry,
 		arg.JobRole,
-		arg.CompanyName,
-		arg.CompanyAddress,
-		arg.CompanyCity,
-		arg.CompanyPostalCode,
-		arg.CompanyCountry,
 		arg.AuthProvider,
 		arg.ProviderID,
 		arg.EmployeeType,
-		arg.CompanyWebsite,
 		arg.EmploymentType,
 		arg.UserAddress,
 		arg.UserCity,
@@ -212,18 +188,12 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Users,
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -243,7 +213,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at FROM users WHERE id = $1::uuid LIMIT 1
+SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at FROM users WHERE id = $1::uuid LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, dollar_1 uuid.UUID) (Users, error) {
@@ -265,18 +235,12 @@ func (q *Queries) GetUser(ctx context.Context, dollar_1 uuid.UUID) (Users, error
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -285,7 +249,7 @@ func (q *Queries) GetUser(ctx context.Context, dollar_1 uuid.UUID) (Users, error
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at FROM users
+SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at FROM users
 WHERE email = $1
 LIMIT 1
 `
@@ -310,18 +274,12 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (Users, erro
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -330,7 +288,7 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (Users, erro
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 FROM users
 ORDER BY 
   CASE WHEN $3::text = 'ASC' THEN created_at END ASC,
@@ -371,18 +329,12 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]Users,
 			&i.Nationality,
 			&i.ResidentialCountry,
 			&i.JobRole,
-			&i.CompanyName,
-			&i.CompanyAddress,
-			&i.CompanyCity,
-			&i.CompanyPostalCode,
-			&i.CompanyCountry,
 			&i.UserAddress,
 			&i.UserCity,
 			&i.UserPostalCode,
 			&i.EmployeeType,
 			&i.AuthProvider,
 			&i.ProviderID,
-			&i.CompanyWebsite,
 			&i.EmploymentType,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -398,7 +350,7 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]Users,
 }
 
 const listUsersByAccountType = `-- name: ListUsersByAccountType :many
-SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 FROM users
 WHERE account_type = $3
 ORDER BY 
@@ -446,18 +398,12 @@ func (q *Queries) ListUsersByAccountType(ctx context.Context, arg ListUsersByAcc
 			&i.Nationality,
 			&i.ResidentialCountry,
 			&i.JobRole,
-			&i.CompanyName,
-			&i.CompanyAddress,
-			&i.CompanyCity,
-			&i.CompanyPostalCode,
-			&i.CompanyCountry,
 			&i.UserAddress,
 			&i.UserCity,
 			&i.UserPostalCode,
 			&i.EmployeeType,
 			&i.AuthProvider,
 			&i.ProviderID,
-			&i.CompanyWebsite,
 			&i.EmploymentType,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -473,7 +419,7 @@ func (q *Queries) ListUsersByAccountType(ctx context.Context, arg ListUsersByAcc
 }
 
 const searchUsers = `-- name: SearchUsers :many
-SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+SELECT id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 FROM users
 WHERE 
   (
@@ -527,18 +473,12 @@ func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]Use
 			&i.Nationality,
 			&i.ResidentialCountry,
 			&i.JobRole,
-			&i.CompanyName,
-			&i.CompanyAddress,
-			&i.CompanyCity,
-			&i.CompanyPostalCode,
-			&i.CompanyCountry,
 			&i.UserAddress,
 			&i.UserCity,
 			&i.UserPostalCode,
 			&i.EmployeeType,
 			&i.AuthProvider,
 			&i.ProviderID,
-			&i.CompanyWebsite,
 			&i.EmploymentType,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -566,21 +506,15 @@ SET
   nationality = COALESCE($9, nationality),
   residential_country = $10,
   job_role = $11,
-  company_website = $12,
-  employment_type = $13,
-  company_name = $14,
-  company_address = $15,
-  company_city = $16,
-  company_postal_code = $17,
-  company_country = $18,
-  auth_provider = COALESCE($19, auth_provider),
-  provider_id = COALESCE($20, provider_id),
-  user_address = COALESCE($21, user_address),
-  user_city = COALESCE($22, user_city),
-  user_postal_code = COALESCE($23, user_postal_code),
+  employment_type = $12,
+  auth_provider = COALESCE($13, auth_provider),
+  provider_id = COALESCE($14, provider_id),
+  user_address = COALESCE($15, user_address),
+  user_city = COALESCE($16, user_city),
+  user_postal_code = COALESCE($17, user_postal_code),
   updated_at = now()
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type UpdateUserParams struct {
@@ -595,13 +529,7 @@ type UpdateUserParams struct {
 	Nationality         string      `json:"nationality"`
 	ResidentialCountry  pgtype.Text `json:"residential_country"`
 	JobRole             pgtype.Text `json:"job_role"`
-	CompanyWebsite      pgtype.Text `json:"company_website"`
 	EmploymentType      pgtype.Text `json:"employment_type"`
-	CompanyName         pgtype.Text `json:"company_name"`
-	CompanyAddress      pgtype.Text `json:"company_address"`
-	CompanyCity         pgtype.Text `json:"company_city"`
-	CompanyPostalCode   pgtype.Text `json:"company_postal_code"`
-	CompanyCountry      pgtype.Text `json:"company_country"`
 	AuthProvider        pgtype.Text `json:"auth_provider"`
 	ProviderID          string      `json:"provider_id"`
 	UserAddress         pgtype.Text `json:"user_address"`
@@ -623,13 +551,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Users,
 		arg.Nationality,
 		arg.ResidentialCountry,
 		arg.JobRole,
-		arg.CompanyWebsite,
 		arg.EmploymentType,
-		arg.CompanyName,
-		arg.CompanyAddress,
-		arg.CompanyCity,
-		arg.CompanyPostalCode,
-		arg.CompanyCountry,
 		arg.AuthProvider,
 		arg.ProviderID,
 		arg.UserAddress,
@@ -653,18 +575,12 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Users,
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -680,7 +596,7 @@ SET
   user_postal_code = COALESCE($4, user_postal_code),
   updated_at = now()
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type UpdateUserAddressParams struct {
@@ -715,18 +631,12 @@ func (q *Queries) UpdateUserAddress(ctx context.Context, arg UpdateUserAddressPa
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -734,44 +644,23 @@ func (q *Queries) UpdateUserAddress(ctx context.Context, arg UpdateUserAddressPa
 	return i, err
 }
 
-const updateUserCompanyDetails = `-- name: UpdateUserCompanyDetails :one
+const updateUserEmail = `-- name: UpdateUserEmail :one
 UPDATE users
 SET
-  company_name = COALESCE($2, company_name),
-  company_address = COALESCE($3, company_address),
-  company_city = COALESCE($4, company_city),
-  company_postal_code = COALESCE($5, company_postal_code),
-  company_country = COALESCE($6, company_country),
-  company_website = COALESCE($7, company_website),
-  employment_type = COALESCE($8, employment_type),
+  email = $2,
   updated_at = now()
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
-type UpdateUserCompanyDetailsParams struct {
-	ID                uuid.UUID   `json:"id"`
-	CompanyName       pgtype.Text `json:"company_name"`
-	CompanyAddress    pgtype.Text `json:"company_address"`
-	CompanyCity       pgtype.Text `json:"company_city"`
-	CompanyPostalCode pgtype.Text `json:"company_postal_code"`
-	CompanyCountry    pgtype.Text `json:"company_country"`
-	CompanyWebsite    pgtype.Text `json:"company_website"`
-	EmploymentType    pgtype.Text `json:"employment_type"`
+type UpdateUserEmailParams struct {
+	ID    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
 }
 
-// Updates a user's company details
-func (q *Queries) UpdateUserCompanyDetails(ctx context.Context, arg UpdateUserCompanyDetailsParams) (Users, error) {
-	row := q.db.QueryRow(ctx, updateUserCompanyDetails,
-		arg.ID,
-		arg.CompanyName,
-		arg.CompanyAddress,
-		arg.CompanyCity,
-		arg.CompanyPostalCode,
-		arg.CompanyCountry,
-		arg.CompanyWebsite,
-		arg.EmploymentType,
-	)
+// Updates a user's email address with validation that the new email is unique
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (Users, error) {
+	row := q.db.QueryRow(ctx, updateUserEmail, arg.ID, arg.Email)
 	var i Users
 	err := row.Scan(
 		&i.ID,
@@ -789,18 +678,12 @@ func (q *Queries) UpdateUserCompanyDetails(ctx context.Context, arg UpdateUserCo
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -808,23 +691,23 @@ func (q *Queries) UpdateUserCompanyDetails(ctx context.Context, arg UpdateUserCo
 	return i, err
 }
 
-const updateUserEmail = `-- name: UpdateUserEmail :one
+const updateUserEmploymentType = `-- name: UpdateUserEmploymentType :one
 UPDATE users
 SET
-  email = $2,
+  employment_type = COALESCE($2, employment_type),
   updated_at = now()
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
-type UpdateUserEmailParams struct {
-	ID    uuid.UUID `json:"id"`
-	Email string    `json:"email"`
+type UpdateUserEmploymentTypeParams struct {
+	ID             uuid.UUID   `json:"id"`
+	EmploymentType pgtype.Text `json:"employment_type"`
 }
 
-// Updates a user's email address with validation that the new email is unique
-func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (Users, error) {
-	row := q.db.QueryRow(ctx, updateUserEmail, arg.ID, arg.Email)
+// Updates a user's employment type
+func (q *Queries) UpdateUserEmploymentType(ctx context.Context, arg UpdateUserEmploymentTypeParams) (Users, error) {
+	row := q.db.QueryRow(ctx, updateUserEmploymentType, arg.ID, arg.EmploymentType)
 	var i Users
 	err := row.Scan(
 		&i.ID,
@@ -842,18 +725,12 @@ func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -867,7 +744,7 @@ SET
   job_role = COALESCE($2, job_role),
   updated_at = now()
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type UpdateUserJobRoleParams struct {
@@ -895,18 +772,12 @@ func (q *Queries) UpdateUserJobRole(ctx context.Context, arg UpdateUserJobRolePa
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -943,7 +814,7 @@ SET
   personal_account_type = COALESCE($6, personal_account_type),
   updated_at = now()
   WHERE id = $1
-  RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+  RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type UpdateUserPersonalDetailsParams struct {
@@ -982,18 +853,12 @@ func (q *Queries) UpdateUserPersonalDetails(ctx context.Context, arg UpdateUserP
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -1008,7 +873,7 @@ SET
   first_name = COALESCE($3, first_name),
   last_name = COALESCE($4, last_name)
 WHERE id = $1
-RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, company_name, company_address, company_city, company_postal_code, company_country, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, company_website, employment_type, created_at, updated_at
+RETURNING id, email, password_hash, profile_picture, account_type, gender, personal_account_type, phone_number, phone_number_verified, phone_number_verified_at, first_name, last_name, nationality, residential_country, job_role, user_address, user_city, user_postal_code, employee_type, auth_provider, provider_id, employment_type, created_at, updated_at
 `
 
 type UpdateUserProfileParams struct {
@@ -1043,18 +908,12 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 		&i.Nationality,
 		&i.ResidentialCountry,
 		&i.JobRole,
-		&i.CompanyName,
-		&i.CompanyAddress,
-		&i.CompanyCity,
-		&i.CompanyPostalCode,
-		&i.CompanyCountry,
 		&i.UserAddress,
 		&i.UserCity,
 		&i.UserPostalCode,
 		&i.EmployeeType,
 		&i.AuthProvider,
 		&i.ProviderID,
-		&i.CompanyWebsite,
 		&i.EmploymentType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -0,0 +1,154 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// TransferEventTopic is the keccak256 hash of Transfer(address,address,uint256)
+var TransferEventTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// EVMClient reads chain state from an EVM JSON-RPC endpoint
+type EVMClient struct {
+	rpc *rpc.Client
+}
+
+// receiptResult holds the eth_getTransactionReceipt fields the tracker needs
+type receiptResult struct {
+	TransactionHash common.Hash    `json:"transactionHash"`
+	BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+	BlockHash       common.Hash    `json:"blockHash"`
+	Status          hexutil.Uint64 `json:"status"`
+}
+
+// NewEVMClient connects to the JSON-RPC endpoint at rpcURL
+func NewEVMClient(ctx context.Context, rpcURL string) (*EVMClient, error) {
+	client, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to EVM node: %w", err)
+	}
+
+	return &EVMClient{rpc: client}, nil
+}
+
+// Close closes the connection to the node
+func (c *EVMClient) Close() {
+	c.rpc.Close()
+}
+
+// ContractBackend returns a transacting client that shares this client's connection
+func (c *EVMClient) ContractBackend() ContractBackend {
+	return ethclient.NewClient(c.rpc)
+}
+
+// BlockNumber returns the latest block height
+func (c *EVMClient) BlockNumber(ctx context.Context) (uint64, error) {
+	var head hexutil.Uint64
+	if err := c.rpc.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	return uint64(head), nil
+}
+
+// TransactionReceipt returns the receipt of a mined transaction, or nil if it is not mined
+func (c *EVMClient) TransactionReceipt(ctx context.Context, txHash string) (*domain.TransactionReceipt, error) {
+	var result *receiptResult
+	if err := c.rpc.CallContext(ctx, &result, "eth_getTransactionReceipt", common.HexToHash(txHash)); err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	// Nodes return null for unknown and pending transactions
+	if result == nil || result.BlockHash == (common.Hash{}) {
+		return nil, nil
+	}
+
+	return &domain.TransactionReceipt{
+		TxHash:      result.TransactionHash.Hex(),
+		BlockNumber: uint64(result.BlockNumber),
+		BlockHash:   result.BlockHash.Hex(),
+		Success:     result.Status == 1,
+	}, nil
+}
+
+// TransactionKnown reports whether the node has the transaction, either mined or in the mempool
+func (c *EVMClient) TransactionKnown(ctx context.Context, txHash string) (bool, error) {
+	var result json.RawMessage
+	if err := c.rpc.CallContext(ctx, &result, "eth_getTransactionByHash", common.HexToHash(txHash)); err != nil {
+		return false, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return len(result) > 0 && string(result) != "null", nil
+}
+
+// BlockHash returns the hash of the canonical block at the given height
+func (c *EVMClient) BlockHash(ctx context.Context, number uint64) (string, error) {
+	var block *struct {
+		Hash common.Hash `json:"hash"`
+	}
+	if err := c.rpc.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.Uint64(number), false); err != nil {
+		return "", fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+
+	if block == nil {
+		return "", fmt.Errorf("block %d not found", number)
+	}
+
+	return block.Hash.Hex(), nil
+}
+
+// TransferLogs returns the ERC-20 Transfer events matching the filter.
+// Logs that do not have the ERC-20 shape, such as ERC-721 transfers which index
+// the token ID, are skipped.
+func (c *EVMClient) TransferLogs(ctx context.Context, filter domain.TransferLogFilter) ([]domain.TransferLog, error) {
+	tokens := make([]common.Address, len(filter.Tokens))
+	for i, token := range filter.Tokens {
+		tokens[i] = common.HexToAddress(token)
+	}
+
+	recipients := make([]common.Hash, len(filter.Recipients))
+	for i, recipient := range filter.Recipients {
+		recipients[i] = common.BytesToHash(common.HexToAddress(recipient).Bytes())
+	}
+
+	arg := map[string]interface{}{
+		"fromBlock": hexutil.Uint64(filter.FromBlock),
+		"toBlock":   hexutil.Uint64(filter.ToBlock),
+		"address":   tokens,
+		"topics":    [][]common.Hash{{TransferEventTopic}, nil, recipients},
+	}
+
+	var logs []types.Log
+	if err := c.rpc.CallContext(ctx, &logs, "eth_getLogs", arg); err != nil {
+		return nil, fmt.Errorf("failed to get transfer logs: %w", err)
+	}
+
+	result := make([]domain.TransferLog, 0, len(logs))
+	for _, log := range logs {
+		if len(log.Topics) != 3 || log.Topics[0] != TransferEventTopic || len(log.Data) != 32 {
+			continue
+		}
+
+		result = append(result, domain.TransferLog{
+			TxHash:      log.TxHash.Hex(),
+			LogIndex:    log.Index,
+			BlockNumber: log.BlockNumber,
+			BlockHash:   log.BlockHash.Hex(),
+			Token:       log.Address.Hex(),
+			From:        common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			To:          common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+			Amount:      new(big.Int).SetBytes(log.Data),
+		})
+	}
+
+	return result, nil
+}
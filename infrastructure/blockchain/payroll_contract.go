@@ -0,0 +1,289 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PayrollContractABI is the part of the payroll contract ABI used by the
+// backend. disburse pulls each amount of token from the treasury (the signing
+// account) and sends it to the matching recipient, so the treasury must have
+// approved the contract for at least the batch total.
+const PayrollContractABI = `[{
+	"type": "function",
+	"name": "disburse",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "token", "type": "address"},
+		{"name": "recipients", "type": "address[]"},
+		{"name": "amounts", "type": "uint256[]"}
+	],
+	"outputs": []
+}]`
+
+// gasLimitMarginPercent is added on top of the node's gas estimate, since
+// token balances can change between estimation and inclusion
+const gasLimitMarginPercent = 20
+
+// ContractBackend is the subset of an Ethereum client needed to submit
+// contract transactions. Both ethclient.Client and the simulated backend
+// client satisfy it.
+type ContractBackend interface {
+	ethereum.ChainIDReader
+	ethereum.GasEstimator
+	ethereum.GasPricer1559
+	ethereum.PendingStateReader
+	ethereum.TransactionSender
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// PayrollContract signs and submits batch disbursements to the payroll contract.
+// Nonces are tracked locally so several batches can be submitted before the
+// first one is mined, and resynchronised from the node after a failed send.
+type PayrollContract struct {
+	backend  ContractBackend
+	abi      abi.ABI
+	contract common.Address
+	key      *ecdsa.PrivateKey
+	from     common.Address
+	chainID  *big.Int
+	logger   logging.Logger
+
+	mu          sync.Mutex
+	nonce       uint64
+	nonceSynced bool
+}
+
+// NewPayrollContract creates a client for the payroll contract at contractAddress,
+// signing with the hex-encoded privateKey
+func NewPayrollContract(ctx context.Context, backend ContractBackend, contractAddress, privateKey string, logger logging.Logger) (*PayrollContract, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid payroll contract address %q", contractAddress)
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payroll signing key: %w", err)
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(PayrollContractABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payroll contract ABI: %w", err)
+	}
+
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	return &PayrollContract{
+		backend:  backend,
+		abi:      parsedABI,
+		contract: common.HexToAddress(contractAddress),
+		key:      key,
+		from:     crypto.PubkeyToAddress(key.PublicKey),
+		chainID:  chainID,
+		logger:   logger,
+	}, nil
+}
+
+// From returns the treasury address that signs disbursements
+func (c *PayrollContract) From() string {
+	return c.from.Hex()
+}
+
+// EstimateDisbursementGas returns the gas the batch is expected to use
+func (c *PayrollContract) EstimateDisbursementGas(ctx context.Context, batch domain.DisbursementBatch) (uint64, error) {
+	data, err := c.pack(batch)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.estimateGas(ctx, data)
+}
+
+// SuggestGasFeeCap returns the fee cap a disbursement sent now would use
+func (c *PayrollContract) SuggestGasFeeCap(ctx context.Context) (*big.Int, error) {
+	_, feeCap, err := c.suggestFees(ctx)
+	return feeCap, err
+}
+
+// Disburse signs and submits the batch with the next nonce
+func (c *PayrollContract) Disburse(ctx context.Context, batch domain.DisbursementBatch) (*domain.DisbursementResult, error) {
+	data, err := c.pack(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := c.estimateGas(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := gas + gas*gasLimitMarginPercent/100
+
+	tipCap, feeCap, err := c.suggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the lock across signing and sending so nonces are handed out in submission order
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tx, err := c.send(ctx, data, gasLimit, tipCap, feeCap)
+	if err != nil && isNonceError(err) {
+		// Another sender used our nonce, refresh from the node and try once more
+		c.logger.Warn("Payroll disbursement nonce out of sync, retrying", map[string]interface{}{
+			"from":  c.from.Hex(),
+			"nonce": c.nonce,
+			"error": err.Error(),
+		})
+		tx, err = c.send(ctx, data, gasLimit, tipCap, feeCap)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Payroll disbursement submitted", map[string]interface{}{
+		"tx_hash":    tx.Hash().Hex(),
+		"nonce":      tx.Nonce(),
+		"token":      batch.Token,
+		"recipients": len(batch.Transfers),
+		"total":      batch.Total().String(),
+	})
+
+	return &domain.DisbursementResult{
+		TxHash:    tx.Hash().Hex(),
+		Nonce:     tx.Nonce(),
+		GasLimit:  gasLimit,
+		GasFeeCap: feeCap,
+		GasTipCap: tipCap,
+	}, nil
+}
+
+// send signs and submits a transaction with the next local nonce. Must be called with c.mu held.
+func (c *PayrollContract) send(ctx context.Context, data []byte, gasLimit uint64, tipCap, feeCap *big.Int) (*types.Transaction, error) {
+	if !c.nonceSynced {
+		nonce, err := c.backend.PendingNonceAt(ctx, c.from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pending nonce: %w", err)
+		}
+		c.nonce = nonce
+		c.nonceSynced = true
+	}
+
+	tx, err := types.SignNewTx(c.key, types.LatestSignerForChainID(c.chainID), &types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     c.nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gasLimit,
+		To:        &c.contract,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign disbursement transaction: %w", err)
+	}
+
+	if err := c.backend.SendTransaction(ctx, tx); err != nil {
+		// The node's view of our nonce is now unknown, fetch it again next time
+		c.nonceSynced = false
+		return nil, fmt.Errorf("failed to send disbursement transaction: %w", err)
+	}
+
+	c.nonce++
+	return tx, nil
+}
+
+func (c *PayrollContract) estimateGas(ctx context.Context, data []byte) (uint64, error) {
+	gas, err := c.backend.EstimateGas(ctx, ethereum.CallMsg{
+		From: c.from,
+		To:   &c.contract,
+		Data: data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate disbursement gas: %w", err)
+	}
+
+	return gas, nil
+}
+
+// suggestFees returns EIP-1559 fees that stay valid if the base fee doubles
+func (c *PayrollContract) suggestFees(ctx context.Context) (tipCap, feeCap *big.Int, err error) {
+	tipCap, err = c.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to suggest gas tip: %w", err)
+	}
+
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest header: %w", err)
+	}
+
+	feeCap = new(big.Int).Set(tipCap)
+	if head.BaseFee != nil {
+		feeCap.Add(feeCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	}
+
+	return tipCap, feeCap, nil
+}
+
+// pack validates the batch and encodes the disburse call
+func (c *PayrollContract) pack(batch domain.DisbursementBatch) ([]byte, error) {
+	if !common.IsHexAddress(batch.Token) {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("invalid token address %q", batch.Token))
+	}
+
+	if len(batch.Transfers) == 0 {
+		return nil, appErrors.NewValidationError("disbursement batch has no transfers")
+	}
+
+	if len(batch.Transfers) > domain.MaxDisbursementBatchSize {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("disbursement batch exceeds %d transfers", domain.MaxDisbursementBatchSize))
+	}
+
+	recipients := make([]common.Address, len(batch.Transfers))
+	amounts := make([]*big.Int, len(batch.Transfers))
+	for i, transfer := range batch.Transfers {
+		if !common.IsHexAddress(transfer.Recipient) || common.HexToAddress(transfer.Recipient) == (common.Address{}) {
+			return nil, appErrors.NewValidationError(fmt.Sprintf("invalid recipient address %q", transfer.Recipient))
+		}
+		if transfer.Amount == nil || transfer.Amount.Sign() <= 0 {
+			return nil, appErrors.NewValidationError(fmt.Sprintf("transfer to %s must have a positive amount", transfer.Recipient))
+		}
+
+		recipients[i] = common.HexToAddress(transfer.Recipient)
+		amounts[i] = transfer.Amount
+	}
+
+	data, err := c.abi.Pack("disburse", common.HexToAddress(batch.Token), recipients, amounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode disbursement: %w", err)
+	}
+
+	return data, nil
+}
+
+// isNonceError reports whether a send failed because another transaction from
+// the treasury already holds the nonce. "already known" is deliberately not
+// matched: it means this exact disbursement is already in the pool, and
+// retrying with a new nonce would pay twice. Errors arrive over JSON-RPC as
+// plain strings, so they are matched by message.
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") ||
+		strings.Contains(msg, "replacement transaction underpriced")
+}
@@ -0,0 +1,213 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/blockchain/simbackend"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPayrollContract = "0x1111111111111111111111111111111111111111"
+	testToken           = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	testRecipientA      = "0x2222222222222222222222222222222222222222"
+	testRecipientB      = "0x3333333333333333333333333333333333333333"
+)
+
+func newTestPayrollContract(t *testing.T) (*simbackend.Backend, *PayrollContract) {
+	t.Helper()
+
+	sim, err := simbackend.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sim.Close() })
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	client, err := NewPayrollContract(context.Background(), sim.Client(), testPayrollContract, sim.TreasuryKeyHex(), logging.New(&cfg))
+	require.NoError(t, err)
+
+	return sim, client
+}
+
+func testBatch() domain.DisbursementBatch {
+	return domain.DisbursementBatch{
+		Token: testToken,
+		Transfers: []domain.DisbursementTransfer{
+			{Recipient: testRecipientA, Amount: big.NewInt(1_500_000)},
+			{Recipient: testRecipientB, Amount: big.NewInt(2_250_000)},
+		},
+	}
+}
+
+func TestPayrollContract_Disburse(t *testing.T) {
+	sim, client := newTestPayrollContract(t)
+	ctx := context.Background()
+
+	result, err := client.Disburse(ctx, testBatch())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), result.Nonce)
+	sim.Commit()
+
+	receipt, err := sim.Client().TransactionReceipt(ctx, common.HexToHash(result.TxHash))
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	assert.LessOrEqual(t, receipt.GasUsed, result.GasLimit)
+
+	tx, _, err := sim.Client().TransactionByHash(ctx, common.HexToHash(result.TxHash))
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress(testPayrollContract), *tx.To())
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	require.NoError(t, err)
+	assert.Equal(t, sim.TreasuryAddress, sender)
+	assert.Equal(t, sim.TreasuryAddress.Hex(), client.From())
+
+	// The calldata decodes back to the submitted batch
+	method, err := client.abi.MethodById(tx.Data()[:4])
+	require.NoError(t, err)
+	assert.Equal(t, "disburse", method.Name)
+
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress(testToken), args[0])
+	assert.Equal(t, []common.Address{common.HexToAddress(testRecipientA), common.HexToAddress(testRecipientB)}, args[1])
+	assert.Equal(t, []*big.Int{big.NewInt(1_500_000), big.NewInt(2_250_000)}, args[2])
+}
+
+func TestPayrollContract_Disburse_SequentialNonces(t *testing.T) {
+	sim, client := newTestPayrollContract(t)
+	ctx := context.Background()
+
+	// Several batches can be in flight before any of them is mined
+	first, err := client.Disburse(ctx, testBatch())
+	require.NoError(t, err)
+	second, err := client.Disburse(ctx, testBatch())
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(0), first.Nonce)
+	assert.Equal(t, uint64(1), second.Nonce)
+
+	sim.Commit()
+	for _, result := range []*domain.DisbursementResult{first, second} {
+		receipt, err := sim.Client().TransactionReceipt(ctx, common.HexToHash(result.TxHash))
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	}
+}
+
+func TestPayrollContract_Disburse_ResyncsNonceAfterExternalSend(t *testing.T) {
+	sim, client := newTestPayrollContract(t)
+	ctx := context.Background()
+
+	_, err := client.Disburse(ctx, testBatch())
+	require.NoError(t, err)
+
+	// Someone else spends the treasury's next nonce behind the client's back
+	external, err := types.SignNewTx(sim.TreasuryKey, types.LatestSignerForChainID(client.chainID), &types.DynamicFeeTx{
+		ChainID:   client.chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(100_000_000_000),
+		Gas:       21_000,
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sim.Client().SendTransaction(ctx, external))
+
+	result, err := client.Disburse(ctx, testBatch())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), result.Nonce)
+
+	sim.Commit()
+	receipt, err := sim.Client().TransactionReceipt(ctx, common.HexToHash(result.TxHash))
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+func TestPayrollContract_EstimateDisbursementGas(t *testing.T) {
+	_, client := newTestPayrollContract(t)
+
+	gas, err := client.EstimateDisbursementGas(context.Background(), testBatch())
+
+	require.NoError(t, err)
+	assert.Greater(t, gas, uint64(21_000))
+}
+
+func TestPayrollContract_RejectsInvalidBatch(t *testing.T) {
+	_, client := newTestPayrollContract(t)
+
+	tooMany := domain.DisbursementBatch{Token: testToken}
+	for i := 0; i <= domain.MaxDisbursementBatchSize; i++ {
+		tooMany.Transfers = append(tooMany.Transfers, domain.DisbursementTransfer{Recipient: testRecipientA, Amount: big.NewInt(1)})
+	}
+
+	testCases := []struct {
+		name  string
+		batch domain.DisbursementBatch
+	}{
+		{
+			name:  "invalid token",
+			batch: domain.DisbursementBatch{Token: "usdc", Transfers: testBatch().Transfers},
+		},
+		{
+			name:  "no transfers",
+			batch: domain.DisbursementBatch{Token: testToken},
+		},
+		{
+			name:  "too many transfers",
+			batch: tooMany,
+		},
+		{
+			name: "zero address recipient",
+			batch: domain.DisbursementBatch{Token: testToken, Transfers: []domain.DisbursementTransfer{
+				{Recipient: "0x0000000000000000000000000000000000000000", Amount: big.NewInt(1)},
+			}},
+		},
+		{
+			name: "zero amount",
+			batch: domain.DisbursementBatch{Token: testToken, Transfers: []domain.DisbursementTransfer{
+				{Recipient: testRecipientA, Amount: big.NewInt(0)},
+			}},
+		},
+		{
+			name: "missing amount",
+			batch: domain.DisbursementBatch{Token: testToken, Transfers: []domain.DisbursementTransfer{
+				{Recipient: testRecipientA},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.Disburse(context.Background(), tc.batch)
+
+			var appErr *appErrors.AppError
+			require.True(t, errors.As(err, &appErr), "expected an AppError, got %v", err)
+			assert.Equal(t, appErrors.ErrorTypeValidation, appErr.ErrorType)
+		})
+	}
+}
+
+func TestNewPayrollContract_InvalidConfig(t *testing.T) {
+	sim, err := simbackend.New()
+	require.NoError(t, err)
+	defer sim.Close()
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+
+	_, err = NewPayrollContract(context.Background(), sim.Client(), "key", sim.TreasuryKeyHex(), logger)
+	assert.Error(t, err)
+
+	_, err = NewPayrollContract(context.Background(), sim.Client(), testPayrollContract, "key", logger)
+	assert.Error(t, err)
+}
@@ -0,0 +1,345 @@
+// Package rpctest provides an in-process fake EVM JSON-RPC node for tests.
+package rpctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// transferTopic is the keccak256 hash of Transfer(address,address,uint256)
+const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// Receipt is the subset of a transaction receipt served by the fake node
+type Receipt struct {
+	BlockNumber uint64
+	BlockHash   string
+	Success     bool
+}
+
+// TransferLog is an ERC-20 Transfer event served by eth_getLogs. Its block hash
+// is whatever the block's hash is when the logs are requested.
+type TransferLog struct {
+	TxHash      string
+	LogIndex    uint
+	BlockNumber uint64
+	Token       string
+	From        string
+	To          string
+	Amount      *big.Int
+}
+
+// Server is a fake EVM node. Tests mutate its chain state between polls to
+// simulate mining, mempool drops and reorgs.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	head     uint64
+	receipts map[string]Receipt
+	mempool  map[string]bool
+	blocks   map[uint64]string
+	logs     []TransferLog
+	calls    map[string]int
+}
+
+type request struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// NewServer starts a fake node at block 0. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		receipts: make(map[string]Receipt),
+		mempool:  make(map[string]bool),
+		blocks:   make(map[uint64]string),
+		calls:    make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// SetHead sets the latest block height
+func (s *Server) SetHead(head uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.head = head
+}
+
+// AddPending puts a transaction in the mempool
+func (s *Server) AddPending(txHash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mempool[normalize(txHash)] = true
+}
+
+// Drop removes a transaction from the mempool and the chain
+func (s *Server) Drop(txHash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.mempool, normalize(txHash))
+	delete(s.receipts, normalize(txHash))
+}
+
+// Mine includes a transaction in a block, replacing any previous receipt
+func (s *Server) Mine(txHash string, receipt Receipt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.mempool, normalize(txHash))
+	s.receipts[normalize(txHash)] = receipt
+}
+
+// Reorg removes a mined transaction's receipt and returns it to the mempool
+func (s *Server) Reorg(txHash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.receipts, normalize(txHash))
+	s.mempool[normalize(txHash)] = true
+}
+
+// BlockHashAt returns the current hash of a block. Unless replaced with
+// SetBlockHash, every block has a hash derived from its number.
+func (s *Server) BlockHashAt(number uint64) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.blockHash(number)
+}
+
+// SetBlockHash replaces the hash of a block, as a reorg would
+func (s *Server) SetBlockHash(number uint64, hash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocks[number] = hash
+}
+
+// AddLog adds a Transfer event to the chain
+func (s *Server) AddLog(log TransferLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs = append(s.logs, log)
+}
+
+// RemoveLogs removes every Transfer event emitted by a transaction, as when its block is orphaned
+func (s *Server) RemoveLogs(txHash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.logs[:0]
+	for _, log := range s.logs {
+		if normalize(log.TxHash) != normalize(txHash) {
+			kept = append(kept, log)
+		}
+	}
+	s.logs = kept
+}
+
+// Calls returns how many times a JSON-RPC method was called
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, rpcErr := s.dispatch(req)
+	resp := response{JSONRPC: "2.0", ID: req.ID, Result: result, Error: rpcErr}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) dispatch(req request) (interface{}, *rpcError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[req.Method]++
+
+	switch req.Method {
+	case "eth_blockNumber":
+		return hexUint(s.head), nil
+
+	case "eth_getTransactionReceipt":
+		txHash, err := stringParam(req.Params, 0)
+		if err != nil {
+			return nil, &rpcError{Code: -32602, Message: err.Error()}
+		}
+		receipt, ok := s.receipts[normalize(txHash)]
+		if !ok {
+			return nil, nil
+		}
+		status := "0x0"
+		if receipt.Success {
+			status = "0x1"
+		}
+		return map[string]interface{}{
+			"transactionHash": txHash,
+			"blockNumber":     hexUint(receipt.BlockNumber),
+			"blockHash":       receipt.BlockHash,
+			"status":          status,
+		}, nil
+
+	case "eth_getTransactionByHash":
+		txHash, err := stringParam(req.Params, 0)
+		if err != nil {
+			return nil, &rpcError{Code: -32602, Message: err.Error()}
+		}
+		if receipt, ok := s.receipts[normalize(txHash)]; ok {
+			return map[string]interface{}{"hash": txHash, "blockNumber": hexUint(receipt.BlockNumber), "blockHash": receipt.BlockHash}, nil
+		}
+		if s.mempool[normalize(txHash)] {
+			return map[string]interface{}{"hash": txHash, "blockNumber": nil, "blockHash": nil}, nil
+		}
+		return nil, nil
+
+	case "eth_getBlockByNumber":
+		number, err := uintParam(req.Params, 0)
+		if err != nil {
+			return nil, &rpcError{Code: -32602, Message: err.Error()}
+		}
+		if number > s.head {
+			return nil, nil
+		}
+		return map[string]interface{}{"number": hexUint(number), "hash": s.blockHash(number)}, nil
+
+	case "eth_getLogs":
+		if len(req.Params) == 0 {
+			return nil, &rpcError{Code: -32602, Message: "missing value for required argument 0"}
+		}
+		var filter logFilter
+		if err := json.Unmarshal(req.Params[0], &filter); err != nil {
+			return nil, &rpcError{Code: -32602, Message: err.Error()}
+		}
+		return s.filterLogs(filter)
+
+	default:
+		return nil, &rpcError{Code: -32601, Message: fmt.Sprintf("the method %s does not exist/is not available", req.Method)}
+	}
+}
+
+// logFilter is the eth_getLogs filter object. Topics are either null or a list of alternatives.
+type logFilter struct {
+	FromBlock string     `json:"fromBlock"`
+	ToBlock   string     `json:"toBlock"`
+	Address   []string   `json:"address"`
+	Topics    [][]string `json:"topics"`
+}
+
+func (s *Server) filterLogs(filter logFilter) (interface{}, *rpcError) {
+	from, err := strconv.ParseUint(strings.TrimPrefix(filter.FromBlock, "0x"), 16, 64)
+	if err != nil {
+		return nil, &rpcError{Code: -32602, Message: "invalid fromBlock"}
+	}
+	to, err := strconv.ParseUint(strings.TrimPrefix(filter.ToBlock, "0x"), 16, 64)
+	if err != nil {
+		return nil, &rpcError{Code: -32602, Message: "invalid toBlock"}
+	}
+
+	result := []map[string]interface{}{}
+	for _, log := range s.logs {
+		topics := []string{transferTopic, addressTopic(log.From), addressTopic(log.To)}
+		if log.BlockNumber < from || log.BlockNumber > to || !matchesAny(filter.Address, log.Token) || !matchesTopics(filter.Topics, topics) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"address":          log.Token,
+			"topics":           topics,
+			"data":             fmt.Sprintf("0x%064x", log.Amount),
+			"blockNumber":      hexUint(log.BlockNumber),
+			"blockHash":        s.blockHash(log.BlockNumber),
+			"transactionHash":  log.TxHash,
+			"transactionIndex": "0x0",
+			"logIndex":         hexUint(uint64(log.LogIndex)),
+			"removed":          false,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *Server) blockHash(number uint64) string {
+	if hash, ok := s.blocks[number]; ok {
+		return hash
+	}
+	return fmt.Sprintf("0x%064x", number)
+}
+
+func matchesTopics(filter [][]string, topics []string) bool {
+	for i, alternatives := range filter {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if i >= len(topics) || !matchesAny(alternatives, topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAny reports whether value is one of the alternatives. No alternatives matches everything.
+func matchesAny(alternatives []string, value string) bool {
+	if len(alternatives) == 0 {
+		return true
+	}
+	for _, alternative := range alternatives {
+		if normalize(alternative) == normalize(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func addressTopic(address string) string {
+	return "0x000000000000000000000000" + strings.TrimPrefix(normalize(address), "0x")
+}
+
+func uintParam(params []json.RawMessage, i int) (uint64, error) {
+	value, err := stringParam(params, i)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+}
+
+func stringParam(params []json.RawMessage, i int) (string, error) {
+	if len(params) <= i {
+		return "", fmt.Errorf("missing value for required argument %d", i)
+	}
+	var value string
+	if err := json.Unmarshal(params[i], &value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+func hexUint(v uint64) string {
+	return fmt.Sprintf("0x%x", v)
+}
+
+func normalize(txHash string) string {
+	return strings.ToLower(txHash)
+}
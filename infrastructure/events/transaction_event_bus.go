@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+)
+
+// TransactionEventHandler reacts to a transaction reaching a final status
+type TransactionEventHandler func(ctx context.Context, event domain.TransactionEvent) error
+
+// TransactionEventBus is an in-process publisher that fans transaction events
+// out to every subscribed handler
+type TransactionEventBus struct {
+	mu       sync.RWMutex
+	handlers []TransactionEventHandler
+	logger   logging.Logger
+}
+
+// NewTransactionEventBus creates an event bus with no subscribers
+func NewTransactionEventBus(logger logging.Logger) *TransactionEventBus {
+	return &TransactionEventBus{logger: logger}
+}
+
+// Subscribe registers a handler for all future events
+func (b *TransactionEventBus) Subscribe(handler TransactionEventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// PublishTransactionEvent delivers the event to every handler. A failing handler
+// does not stop delivery to the others; all handler errors are returned joined.
+func (b *TransactionEventBus) PublishTransactionEvent(ctx context.Context, event domain.TransactionEvent) error {
+	b.mu.RLock()
+	handlers := make([]TransactionEventHandler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mu.RUnlock()
+
+	b.logger.Info("Transaction reached final status", map[string]interface{}{
+		"transaction_id": event.Transaction.ID,
+		"tx_hash":        event.Transaction.TxHash,
+		"status":         event.Transaction.Status,
+		"confirmations":  event.Confirmations,
+	})
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			b.logger.Error("Transaction event handler failed", err, map[string]interface{}{
+				"transaction_id": event.Transaction.ID,
+			})
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
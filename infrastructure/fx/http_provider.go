@@ -0,0 +1,117 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// HTTPProvider fetches rates from an exchangerate.host style API:
+//
+//	GET {url}?base=EUR&symbols=USD,NGN[&access_key=...]
+//	{"base": "EUR", "rates": {"USD": 1.08, "NGN": 1675.2}}
+type HTTPProvider struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// NewHTTPProvider creates a provider calling the given endpoint. The API key is
+// sent as the access_key query parameter when set.
+func NewHTTPProvider(endpoint, apiKey string) (*HTTPProvider, error) {
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid fx provider url: %w", err)
+	}
+
+	return &HTTPProvider{
+		url:    endpoint,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Name identifies the provider in stored rates
+func (p *HTTPProvider) Name() string {
+	return "http"
+}
+
+type httpRatesResponse struct {
+	Success *bool                      `json:"success"`
+	Base    string                     `json:"base"`
+	Rates   map[string]decimal.Decimal `json:"rates"`
+	Error   json.RawMessage            `json:"error"`
+}
+
+// GetRates fetches the rate from base to each quote in one request
+func (p *HTTPProvider) GetRates(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error) {
+	endpoint, err := url.Parse(p.url)
+	if err != nil {
+		return nil, err
+	}
+
+	query := endpoint.Query()
+	query.Set("base", strings.ToUpper(base))
+	query.Set("symbols", strings.ToUpper(strings.Join(quotes, ",")))
+	if p.apiKey != "" {
+		query.Set("access_key", p.apiKey)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fx provider request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fx provider response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fx provider returned status %d", resp.StatusCode)
+	}
+
+	var payload httpRatesResponse
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode fx provider response: %w", err)
+	}
+
+	if payload.Success != nil && !*payload.Success {
+		return nil, fmt.Errorf("fx provider returned an error: %s", string(payload.Error))
+	}
+
+	if payload.Base != "" && !strings.EqualFold(payload.Base, base) {
+		return nil, fmt.Errorf("fx provider returned rates for %s instead of %s", payload.Base, base)
+	}
+
+	// Stamp rates with when we fetched them rather than the provider's publication
+	// time, since daily reference rates would otherwise always look stale
+	fetchedAt := time.Now()
+	rates := make([]domain.FXRate, 0, len(payload.Rates))
+	for quote, rate := range payload.Rates {
+		rates = append(rates, domain.FXRate{
+			Base:      strings.ToUpper(base),
+			Quote:     strings.ToUpper(quote),
+			Rate:      rate,
+			Source:    p.Name(),
+			FetchedAt: fetchedAt,
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,115 @@
+package fx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticProvider_GetRates(t *testing.T) {
+	provider := NewStaticProvider(map[string]map[string]decimal.Decimal{
+		"usd": {"eur": decimal.RequireFromString("0.8"), "ngn": decimal.RequireFromString("1600")},
+	})
+
+	rates, err := provider.GetRates(context.Background(), "EUR", []string{"USD", "NGN", "JPY"})
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+
+	// Inverse of USD/EUR
+	assert.Equal(t, "USD", rates[0].Quote)
+	assert.True(t, rates[0].Rate.Equal(decimal.RequireFromString("1.25")))
+
+	// Cross rate through USD
+	assert.Equal(t, "NGN", rates[1].Quote)
+	assert.True(t, rates[1].Rate.Equal(decimal.NewFromInt(2000)))
+	assert.Equal(t, "static", rates[1].Source)
+}
+
+func TestLoadStaticProvider(t *testing.T) {
+	provider, err := LoadStaticProvider("")
+	require.NoError(t, err)
+	rates, err := provider.GetRates(context.Background(), "USD", []string{"EUR"})
+	require.NoError(t, err)
+	assert.Len(t, rates, 1)
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"EUR": {"USD": "1.1"}}`), 0o600))
+	provider, err = LoadStaticProvider(path)
+	require.NoError(t, err)
+	rates, err = provider.GetRates(context.Background(), "EUR", []string{"USD"})
+	require.NoError(t, err)
+	assert.True(t, rates[0].Rate.Equal(decimal.RequireFromString("1.1")))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"EUR": {"USD": "0"}}`), 0o600))
+	_, err = LoadStaticProvider(path)
+	assert.Error(t, err)
+
+	_, err = LoadStaticProvider(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestHTTPProvider_GetRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "EUR", r.URL.Query().Get("base"))
+		assert.Equal(t, "USD,NGN", r.URL.Query().Get("symbols"))
+		assert.Equal(t, "secret", r.URL.Query().Get("access_key"))
+		_, _ = w.Write([]byte(`{"success": true, "base": "EUR", "rates": {"USD": 1.0837, "NGN": 1675.123456789012345}}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewHTTPProvider(server.URL+"/latest", "secret")
+	require.NoError(t, err)
+
+	rates, err := provider.GetRates(context.Background(), "eur", []string{"USD", "NGN"})
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+
+	byQuote := map[string]decimal.Decimal{}
+	for _, rate := range rates {
+		assert.Equal(t, "EUR", rate.Base)
+		assert.Equal(t, "http", rate.Source)
+		byQuote[rate.Quote] = rate.Rate
+	}
+	assert.True(t, byQuote["USD"].Equal(decimal.RequireFromString("1.0837")))
+	// Rates are decoded without passing through a float
+	assert.True(t, byQuote["NGN"].Equal(decimal.RequireFromString("1675.123456789012345")))
+}
+
+func TestHTTPProvider_Errors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "server_error", status: http.StatusBadGateway, body: `{}`},
+		{name: "api_error", status: http.StatusOK, body: `{"success": false, "error": {"code": 101}}`},
+		{name: "wrong_base", status: http.StatusOK, body: `{"base": "USD", "rates": {"EUR": 0.92}}`},
+		{name: "malformed", status: http.StatusOK, body: `<html>`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			provider, err := NewHTTPProvider(server.URL, "")
+			require.NoError(t, err)
+
+			_, err = provider.GetRates(context.Background(), "EUR", []string{"USD"})
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := NewHTTPProvider("not a url", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package fx
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+//go:embed default_rates.json
+var defaultRates []byte
+
+// StaticProvider serves exchange rates from a fixed table, for development,
+// tests and deployments that set rates by hand. Rates are keyed by base and
+// quote currency; missing pairs are derived from the inverse or through a
+// shared base, so a table of USD rates also answers EUR/NGN.
+type StaticProvider struct {
+	rates map[string]map[string]decimal.Decimal
+	now   func() time.Time
+}
+
+// NewStaticProvider creates a provider serving the given rates
+func NewStaticProvider(rates map[string]map[string]decimal.Decimal) *StaticProvider {
+	normalized := make(map[string]map[string]decimal.Decimal, len(rates))
+	for base, quotes := range rates {
+		base = strings.ToUpper(base)
+		if normalized[base] == nil {
+			normalized[base] = make(map[string]decimal.Decimal, len(quotes))
+		}
+		for quote, rate := range quotes {
+			normalized[base][strings.ToUpper(quote)] = rate
+		}
+	}
+
+	return &StaticProvider{rates: normalized, now: time.Now}
+}
+
+// LoadStaticProvider reads rates from a JSON file shaped {"USD": {"EUR": "0.92"}}.
+// An empty path loads the built-in fixture.
+func LoadStaticProvider(path string) (*StaticProvider, error) {
+	data := defaultRates
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read static fx rates: %w", err)
+		}
+	}
+
+	var rates map[string]map[string]decimal.Decimal
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("failed to parse static fx rates: %w", err)
+	}
+
+	for base, quotes := range rates {
+		for quote, rate := range quotes {
+			if !rate.IsPositive() {
+				return nil, fmt.Errorf("static fx rate %s/%s must be positive", base, quote)
+			}
+		}
+	}
+
+	return NewStaticProvider(rates), nil
+}
+
+// Name identifies the provider in stored rates
+func (p *StaticProvider) Name() string {
+	return "static"
+}
+
+// GetRates returns the rate from base to each quote the table can answer
+func (p *StaticProvider) GetRates(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error) {
+	base = strings.ToUpper(base)
+	now := p.now()
+
+	rates := make([]domain.FXRate, 0, len(quotes))
+	for _, quote := range quotes {
+		quote = strings.ToUpper(quote)
+
+		rate, ok := p.lookup(base, quote)
+		if !ok {
+			continue
+		}
+
+		rates = append(rates, domain.FXRate{
+			Base:      base,
+			Quote:     quote,
+			Rate:      rate,
+			Source:    p.Name(),
+			FetchedAt: now,
+		})
+	}
+
+	return rates, nil
+}
+
+// lookup finds a direct rate, an inverse rate, or a cross rate through a common base
+func (p *StaticProvider) lookup(base, quote string) (decimal.Decimal, bool) {
+	if rate, ok := p.direct(base, quote); ok {
+		return rate, true
+	}
+
+	// Walk bases in a fixed order so the same table always yields the same cross rate
+	bases := make([]string, 0, len(p.rates))
+	for via := range p.rates {
+		bases = append(bases, via)
+	}
+	sort.Strings(bases)
+
+	for _, via := range bases {
+		toBase, ok := p.direct(via, base)
+		if !ok {
+			continue
+		}
+		toQuote, ok := p.direct(via, quote)
+		if !ok {
+			continue
+		}
+		return toQuote.DivRound(toBase, 18), true
+	}
+
+	return decimal.Decimal{}, false
+}
+
+func (p *StaticProvider) direct(base, quote string) (decimal.Decimal, bool) {
+	if rate, ok := p.rates[base][quote]; ok {
+		return rate, true
+	}
+	if inverse, ok := p.rates[quote][base]; ok {
+		return decimal.NewFromInt(1).DivRound(inverse, 18), true
+	}
+	return decimal.Decimal{}, false
+}
@@ -48,12 +48,17 @@ func (s *AsyncQEmailSender) SendEmail(ctx context.Context, recipient string, sub
 
 // SendEmailWithAttachment sends an email with attachments directly
 func (s *AsyncQEmailSender) SendEmailWithAttachment(ctx context.Context, recipient string, subject string, templateName string, data map[string]interface{}, attachments []emailEnums.EmailAttachment) error {
-	_, err := s.QueueEmail(ctx, recipient, subject, templateName, data, emailEnums.NormalPriority)
+	_, err := s.enqueue(ctx, recipient, subject, templateName, data, attachments, emailEnums.NormalPriority)
 	return err
 }
 
 // QueueEmail queues an email for asynchronous delivery
 func (s *AsyncQEmailSender) QueueEmail(ctx context.Context, recipient string, subject string, templateName string, data map[string]interface{}, priority emailEnums.EmailPriority) (string, error) {
+	return s.enqueue(ctx, recipient, subject, templateName, data, nil, priority)
+}
+
+// enqueue builds the email message and puts it on the queue
+func (s *AsyncQEmailSender) enqueue(ctx context.Context, recipient string, subject string, templateName string, data map[string]interface{}, attachments []emailEnums.EmailAttachment, priority emailEnums.EmailPriority) (string, error) {
 	// Create a unique ID for the email
 	id := uuid.New().String()
 
@@ -64,7 +69,7 @@ func (s *AsyncQEmailSender) QueueEmail(ctx context.Context, recipient string, su
 		Subject:      subject,
 		TemplateName: templateName,
 		Data:         data,
-		Attachments:  nil,
+		Attachments:  attachments,
 		Priority:     priority,
 		CreatedAt:    time.Now(),
 	}
@@ -76,10 +81,11 @@ func (s *AsyncQEmailSender) QueueEmail(ctx context.Context, recipient string, su
 	}
 
 	s.logger.Info("Email queued", map[string]interface{}{
-		"id":        id,
-		"recipient": recipient,
-		"template":  templateName,
-		"priority":  priority,
+		"id":          id,
+		"recipient":   recipient,
+		"template":    templateName,
+		"priority":    priority,
+		"attachments": len(attachments),
 	})
 
 	return id, nil
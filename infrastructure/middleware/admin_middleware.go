@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	response "github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware restricts a route to platform administrators, identified by
+// the authenticated account's email. It must run after AuthMiddleware.
+func AdminMiddleware(adminEmails []string) gin.HandlerFunc {
+	admins := make(map[string]struct{}, len(adminEmails))
+	for _, email := range adminEmails {
+		admins[strings.ToLower(strings.TrimSpace(email))] = struct{}{}
+	}
+
+	return func(ctx *gin.Context) {
+		email, _ := ctx.Get("email")
+		emailStr, _ := email.(string)
+
+		if _, ok := admins[strings.ToLower(emailStr)]; !ok || emailStr == "" {
+			ctx.JSON(http.StatusForbidden, response.ErrorResponse{
+				Success: false,
+				Message: "Access denied",
+			})
+			ctx.Abort()
+			return
+		}
+
+		ctx.Set("user_role", "admin")
+		ctx.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(email string) *gin.Engine {
+		router := gin.New()
+		router.GET("/admin", func(ctx *gin.Context) {
+			if email != "" {
+				ctx.Set("email", email)
+			}
+			ctx.Next()
+		}, AdminMiddleware([]string{"ops@defifundr.com"}), func(ctx *gin.Context) {
+			role, _ := ctx.Get("user_role")
+			assert.Equal(t, "admin", role)
+			ctx.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	testCases := []struct {
+		name     string
+		email    string
+		expected int
+	}{
+		{name: "admin", email: "ops@defifundr.com", expected: http.StatusOK},
+		{name: "admin_case_insensitive", email: "Ops@DefiFundr.com", expected: http.StatusOK},
+		{name: "regular_user", email: "user@example.com", expected: http.StatusForbidden},
+		{name: "unauthenticated", expected: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/admin", nil)
+			newRouter(tc.email).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
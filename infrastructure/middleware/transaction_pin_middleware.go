@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	response "github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TransactionPINHeader carries the transaction PIN on payout-initiating requests
+const TransactionPINHeader = "X-Transaction-PIN"
+
+// TransactionPINRequiredMiddleware verifies the user's transaction PIN before any
+// payout-initiating request. It must run after AuthMiddleware.
+func TransactionPINRequiredMiddleware(pinService ports.TransactionPINService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userID, exists := ctx.Get("user_id")
+		if !exists {
+			ctx.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Message: "Unauthorized",
+			})
+			ctx.Abort()
+			return
+		}
+
+		userUUID, ok := userID.(uuid.UUID)
+		if !ok {
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+				Success: false,
+				Message: "Invalid user ID",
+			})
+			ctx.Abort()
+			return
+		}
+
+		pin := ctx.GetHeader(TransactionPINHeader)
+		if pin == "" {
+			ctx.JSON(http.StatusForbidden, response.ErrorResponse{
+				Success: false,
+				Message: "Transaction PIN is required",
+			})
+			ctx.Abort()
+			return
+		}
+
+		if err := pinService.VerifyPIN(ctx, userUUID, pin); err != nil {
+			status := http.StatusInternalServerError
+			message := "Failed to verify transaction PIN"
+
+			var appErr *appErrors.AppError
+			if errors.As(err, &appErr) {
+				status = appErr.StatusCode()
+				message = appErr.Error()
+			}
+
+			ctx.JSON(status, response.ErrorResponse{
+				Success: false,
+				Message: message,
+			})
+			ctx.Abort()
+			return
+		}
+
+		ctx.Set("transaction_pin_verified", true)
+		ctx.Next()
+	}
+}
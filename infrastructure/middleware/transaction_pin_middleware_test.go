@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionPINRequiredMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID := uuid.New()
+
+	newRouter := func(pinService *mocks.FakeTransactionPINService, authenticated bool) *gin.Engine {
+		router := gin.New()
+		router.POST("/payout", func(ctx *gin.Context) {
+			if authenticated {
+				ctx.Set("user_id", userID)
+			}
+			ctx.Next()
+		}, TransactionPINRequiredMiddleware(pinService), func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	t.Run("missing_pin", func(t *testing.T) {
+		pinService := new(mocks.FakeTransactionPINService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/payout", nil)
+		newRouter(pinService, true).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, 0, pinService.VerifyPINCallCount())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		pinService := new(mocks.FakeTransactionPINService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/payout", nil)
+		req.Header.Set(TransactionPINHeader, "482915")
+		newRouter(pinService, false).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("wrong_pin", func(t *testing.T) {
+		pinService := new(mocks.FakeTransactionPINService)
+		pinService.VerifyPINReturns(appErrors.NewForbiddenError("invalid transaction PIN, 2 attempts remaining"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/payout", nil)
+		req.Header.Set(TransactionPINHeader, "000001")
+		newRouter(pinService, true).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "2 attempts remaining")
+	})
+
+	t.Run("valid_pin", func(t *testing.T) {
+		pinService := new(mocks.FakeTransactionPINService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/payout", nil)
+		req.Header.Set(TransactionPINHeader, "482915")
+		newRouter(pinService, true).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		_, gotUserID, pin := pinService.VerifyPINArgsForCall(0)
+		assert.Equal(t, userID, gotUserID)
+		assert.Equal(t, "482915", pin)
+	})
+}
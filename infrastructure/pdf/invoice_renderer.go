@@ -0,0 +1,601 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"  // registers GIF logos with image.Decode
+	_ "image/jpeg" // registers JPEG logos with image.Decode
+	"image/png"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Page layout in millimetres on A4
+	pageMargin   = 15.0
+	contentWidth = 180.0
+	footerHeight = 10.0
+	lineHeight   = 5.0
+
+	// logoHeight and logoMaxWidth bound the logo in the top left corner
+	logoHeight   = 18.0
+	logoMaxWidth = 70.0
+	// qrCodeSize is the printed size of the payment QR code
+	qrCodeSize = 35.0
+	// qrCodePixels is the resolution the QR code is drawn at
+	qrCodePixels = 256
+
+	// logoFetchTimeout bounds fetching the organization's logo
+	logoFetchTimeout = 5 * time.Second
+	// maxLogoBytes and maxLogoPixels reject logos too large to embed
+	maxLogoBytes  = 2 << 20
+	maxLogoPixels = 4096 * 4096
+)
+
+// errPrivateLogoHost is returned when a logo URL resolves to an address that is
+// not on the public internet
+var errPrivateLogoHost = errors.New("logo host is not a public address")
+
+// lineItemColumns are the widths of the line item table's columns, adding up
+// to the content width
+var lineItemColumns = []float64{72, 18, 26, 18, 18, 28}
+
+// InvoiceRenderer renders invoices as A4 PDFs. It only uses the core Helvetica
+// and Courier fonts, so no font files are needed; text is printed in the
+// Windows-1252 character set.
+type InvoiceRenderer struct {
+	client *http.Client
+	logger logging.Logger
+}
+
+// NewInvoiceRenderer creates a renderer. Organization logos are fetched from
+// their URL, but only from public addresses.
+func NewInvoiceRenderer(logger logging.Logger) *InvoiceRenderer {
+	return &InvoiceRenderer{
+		client: newLogoClient(false),
+		logger: logger,
+	}
+}
+
+// RenderInvoice renders the invoice with the organization's branding and, when
+// the invoice has a payment address, payment instructions and a QR code. A logo
+// that cannot be fetched is left out rather than failing the invoice.
+func (r *InvoiceRenderer) RenderInvoice(ctx context.Context, document domain.InvoiceDocument) ([]byte, error) {
+	var logo []byte
+	if document.Organization.LogoURL != nil {
+		fetched, err := r.fetchLogo(ctx, *document.Organization.LogoURL)
+		if err != nil {
+			r.logger.Warn("Failed to fetch organization logo for invoice", map[string]interface{}{
+				"organization_id": document.Organization.ID,
+				"invoice_id":      document.Invoice.ID,
+				"error":           err.Error(),
+			})
+		} else {
+			logo = fetched
+		}
+	}
+
+	var qrCode []byte
+	if document.Invoice.PaymentAddress != "" && document.PaymentAsset != nil {
+		code, err := qrCodePNG(document.Invoice.PaymentAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to draw payment QR code: %w", err)
+		}
+		qrCode = code
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	layout := &invoiceLayout{
+		pdf:      pdf,
+		tr:       pdf.UnicodeTranslatorFromDescriptor(""),
+		document: document,
+	}
+	layout.render(logo, qrCode)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render invoice pdf: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fetchLogo downloads the logo and re-encodes it as an 8-bit PNG, which every
+// PDF reader and gofpdf itself handle
+func (r *InvoiceRenderer) fetchLogo(ctx context.Context, logoURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "image/png, image/jpeg, image/gif")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("logo request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("logo request returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxLogoBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logo: %w", err)
+	}
+	if len(body) > maxLogoBytes {
+		return nil, fmt.Errorf("logo is larger than %d bytes", maxLogoBytes)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("logo is not a PNG, JPEG or GIF image: %w", err)
+	}
+	if config.Width*config.Height > maxLogoPixels {
+		return nil, fmt.Errorf("logo is %dx%d pixels, which is too large", config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode logo: %w", err)
+	}
+
+	img := image.NewNRGBA(src.Bounds())
+	draw.Draw(img, img.Bounds(), src, src.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode logo: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// newLogoClient creates the HTTP client logos are fetched with. Unless
+// allowPrivate is set it refuses to connect to loopback, private and
+// link-local addresses, so a logo URL cannot be used to reach internal
+// services; the check runs on every connection, redirects included.
+func newLogoClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: logoFetchTimeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+				return errPrivateLogoHost
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: logoFetchTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: logoFetchTimeout,
+		},
+	}
+}
+
+// qrCodePNG draws content as a QR code in an 8-bit grayscale PNG
+func qrCodePNG(content string) ([]byte, error) {
+	code, err := qr.Encode(content, qr.M, qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+
+	scaled, err := barcode.Scale(code, qrCodePixels, qrCodePixels)
+	if err != nil {
+		return nil, err
+	}
+
+	// The barcode is 16-bit grayscale, which gofpdf cannot embed
+	img := image.NewGray(scaled.Bounds())
+	draw.Draw(img, img.Bounds(), scaled, scaled.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// invoiceLayout draws one invoice onto a PDF, top to bottom
+type invoiceLayout struct {
+	pdf      *gofpdf.Fpdf
+	tr       func(string) string
+	document domain.InvoiceDocument
+}
+
+func (l *invoiceLayout) render(logo, qrCode []byte) {
+	invoice := l.document.Invoice
+	org := l.document.Organization
+
+	l.pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	l.pdf.SetAutoPageBreak(true, pageMargin+footerHeight)
+	l.pdf.SetTitle("Invoice "+invoice.DisplayNumber(), true)
+	l.pdf.SetAuthor(org.Name, true)
+	l.pdf.SetCreator("DefiFundr", true)
+	l.pdf.AliasNbPages("")
+	l.pdf.SetFooterFunc(func() {
+		l.pdf.SetY(-pageMargin - lineHeight)
+		l.pdf.SetFont("Helvetica", "", 8)
+		l.pdf.SetTextColor(120, 120, 120)
+		footer := fmt.Sprintf("%s  ·  %s  ·  Page %d of {nb}", org.Name, invoice.DisplayNumber(), l.pdf.PageNo())
+		l.pdf.CellFormat(0, lineHeight, l.tr(footer), "", 0, "C", false, 0, "")
+	})
+
+	l.pdf.AddPage()
+	l.header(logo)
+	l.parties()
+	l.lineItems()
+	l.totals()
+	l.taxNote()
+	l.paymentInstructions(qrCode)
+	l.notes()
+}
+
+// header draws the logo on the left and the invoice number and dates on the right
+func (l *invoiceLayout) header(logo []byte) {
+	invoice := l.document.Invoice
+	logoBottom := pageMargin
+
+	if logo != nil {
+		options := gofpdf.ImageOptions{ImageType: "PNG"}
+		info := l.pdf.RegisterImageOptionsReader("logo", options, bytes.NewReader(logo))
+		if info != nil && info.Height() > 0 {
+			width, height := logoHeight*info.Width()/info.Height(), logoHeight
+			if width > logoMaxWidth {
+				width, height = logoMaxWidth, logoMaxWidth*info.Height()/info.Width()
+			}
+			l.pdf.ImageOptions("logo", pageMargin, pageMargin, width, height, false, options, 0, "")
+			logoBottom += height
+		}
+	}
+
+	l.pdf.SetXY(pageMargin, pageMargin)
+	l.pdf.SetFont("Helvetica", "B", 22)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.CellFormat(contentWidth, 10, "INVOICE", "", 1, "R", false, 0, "")
+
+	rows := [][2]string{
+		{"Invoice number", invoice.DisplayNumber()},
+		{"Issue date", formatDate(invoice.IssueDate)},
+		{"Due date", formatDate(invoice.DueDate)},
+		{"Currency", invoice.Currency},
+	}
+	switch invoice.Status {
+	case domain.InvoiceStatusPaid:
+		rows = append(rows, [2]string{"Status", "Paid"})
+	case domain.InvoiceStatusPartiallyPaid:
+		rows = append(rows, [2]string{"Status", "Partially paid"})
+	case domain.InvoiceStatusCredited:
+		rows = append(rows, [2]string{"Status", "Credited"})
+	case domain.InvoiceStatusVoid:
+		rows = append(rows, [2]string{"Status", "Void"})
+	}
+
+	// Credit notes lower what an open invoice asks for, so show what is left
+	credited := invoice.Status.IsOpen() && invoice.AmountCredited.IsPositive()
+	if credited {
+		rows = append(rows, [2]string{"Credited", formatAmount(invoice.AmountCredited.Amount())})
+	}
+	if credited || invoice.Status == domain.InvoiceStatusPartiallyPaid {
+		rows = append(rows, [2]string{"Balance due", formatAmount(invoice.BalanceDue().Amount())})
+	}
+
+	for _, row := range rows {
+		l.pdf.SetX(pageMargin + contentWidth - 80)
+		l.pdf.SetFont("Helvetica", "", 9)
+		l.pdf.SetTextColor(120, 120, 120)
+		l.pdf.CellFormat(40, lineHeight, l.tr(row[0]), "", 0, "L", false, 0, "")
+		l.pdf.SetFont("Helvetica", "B", 9)
+		l.pdf.SetTextColor(30, 30, 30)
+		l.pdf.CellFormat(40, lineHeight, l.tr(row[1]), "", 1, "R", false, 0, "")
+	}
+
+	l.pdf.SetY(max(l.pdf.GetY(), logoBottom) + 8)
+}
+
+// parties draws the issuing organization on the left and the customer on the right
+func (l *invoiceLayout) parties() {
+	invoice := l.document.Invoice
+	org := l.document.Organization
+	top := l.pdf.GetY()
+
+	from := []string{org.Address, strings.TrimSpace(org.City + " " + org.PostalCode), org.Country}
+	if org.Website != nil {
+		from = append(from, *org.Website)
+	}
+	if org.TaxID != "" {
+		from = append(from, "Tax ID: "+org.TaxID)
+	}
+	fromBottom := l.party(pageMargin, top, "From", org.Name, from)
+
+	billTo := []string{invoice.CustomerEmail, invoice.CustomerAddress}
+	if invoice.CustomerTaxID != "" {
+		billTo = append(billTo, "Tax ID: "+invoice.CustomerTaxID)
+	}
+	billToBottom := l.party(pageMargin+95, top, "Bill to", invoice.CustomerName, billTo)
+
+	l.pdf.SetY(max(fromBottom, billToBottom) + 8)
+}
+
+// party draws one address block at x, y and returns where it ends
+func (l *invoiceLayout) party(x, y float64, title, name string, lines []string) float64 {
+	const width = 85.0
+
+	l.pdf.SetXY(x, y)
+	l.pdf.SetFont("Helvetica", "", 8)
+	l.pdf.SetTextColor(120, 120, 120)
+	l.pdf.CellFormat(width, lineHeight, strings.ToUpper(title), "", 2, "L", false, 0, "")
+
+	l.pdf.SetFont("Helvetica", "B", 10)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.MultiCell(width, lineHeight, l.tr(name), "", "L", false)
+
+	l.pdf.SetFont("Helvetica", "", 9)
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		l.pdf.SetX(x)
+		l.pdf.MultiCell(width, lineHeight, l.tr(line), "", "L", false)
+	}
+
+	return l.pdf.GetY()
+}
+
+// lineItems draws the line item table, repeating its heading on every page it spans
+func (l *invoiceLayout) lineItems() {
+	l.lineItemHeading()
+
+	_, pageHeight := l.pdf.GetPageSize()
+	bottom := pageHeight - pageMargin - footerHeight
+
+	for _, item := range l.document.Invoice.LineItems {
+		l.pdf.SetFont("Helvetica", "", 9)
+		l.pdf.SetTextColor(30, 30, 30)
+
+		description := l.pdf.SplitLines([]byte(l.tr(item.Description)), lineItemColumns[0])
+		height := float64(max(len(description), 1))*lineHeight + 2
+
+		if l.pdf.GetY()+height > bottom {
+			l.pdf.AddPage()
+			l.lineItemHeading()
+			l.pdf.SetFont("Helvetica", "", 9)
+			l.pdf.SetTextColor(30, 30, 30)
+		}
+
+		top := l.pdf.GetY() + 1
+		for n, line := range description {
+			l.pdf.SetXY(pageMargin, top+float64(n)*lineHeight)
+			l.pdf.CellFormat(lineItemColumns[0], lineHeight, string(line), "", 0, "L", false, 0, "")
+		}
+
+		values := []string{
+			item.Quantity.String(),
+			formatAmount(item.UnitPrice.Amount()),
+			formatPercent(item.DiscountPercent),
+			formatPercent(item.TaxRate),
+			formatAmount(item.Total.Amount()),
+		}
+		x := pageMargin + lineItemColumns[0]
+		for n, value := range values {
+			l.pdf.SetXY(x, top)
+			l.pdf.CellFormat(lineItemColumns[n+1], lineHeight, value, "", 0, "R", false, 0, "")
+			x += lineItemColumns[n+1]
+		}
+
+		rowBottom := top - 1 + height
+		l.pdf.SetDrawColor(225, 225, 225)
+		l.pdf.Line(pageMargin, rowBottom, pageMargin+contentWidth, rowBottom)
+		l.pdf.SetY(rowBottom)
+	}
+
+	l.pdf.Ln(4)
+}
+
+func (l *invoiceLayout) lineItemHeading() {
+	headings := []string{"Description", "Qty", "Unit price", "Discount", "Tax", "Total"}
+
+	l.pdf.SetFont("Helvetica", "B", 9)
+	l.pdf.SetFillColor(242, 242, 242)
+	l.pdf.SetTextColor(60, 60, 60)
+	for n, heading := range headings {
+		align := "R"
+		if n == 0 {
+			align = "L"
+		}
+		l.pdf.CellFormat(lineItemColumns[n], 7, heading, "", 0, align, true, 0, "")
+	}
+	l.pdf.Ln(7)
+}
+
+// totals draws the subtotal, discount, tax by rate and total under the table
+// on the right. Tax worked out by the tax engine is shown even when nothing
+// is charged, so a reverse charge is stated next to the amounts.
+func (l *invoiceLayout) totals() {
+	invoice := l.document.Invoice
+
+	rows := [][2]string{{"Subtotal", formatAmount(invoice.Subtotal.Amount())}}
+	if !invoice.DiscountTotal.IsZero() {
+		rows = append(rows, [2]string{"Discount", "-" + formatAmount(invoice.DiscountTotal.Amount())})
+	}
+	for _, line := range invoice.TaxBreakdown() {
+		if line.Tax.IsZero() && invoice.TaxTreatment == "" {
+			continue
+		}
+		rows = append(rows, [2]string{line.Label, formatAmount(line.Tax.Amount())})
+	}
+
+	x := pageMargin + contentWidth - 80
+	l.pdf.SetFont("Helvetica", "", 9)
+	l.pdf.SetTextColor(30, 30, 30)
+	for _, row := range rows {
+		l.pdf.SetX(x)
+		l.pdf.CellFormat(40, lineHeight+1, row[0], "", 0, "L", false, 0, "")
+		l.pdf.CellFormat(40, lineHeight+1, row[1], "", 1, "R", false, 0, "")
+	}
+
+	l.pdf.SetDrawColor(30, 30, 30)
+	l.pdf.Line(x, l.pdf.GetY()+1, pageMargin+contentWidth, l.pdf.GetY()+1)
+	l.pdf.Ln(2)
+
+	l.pdf.SetX(x)
+	l.pdf.SetFont("Helvetica", "B", 11)
+	l.pdf.CellFormat(40, 7, "Total due", "", 0, "L", false, 0, "")
+	l.pdf.CellFormat(40, 7, l.tr(formatAmount(invoice.Total.Amount())+" "+invoice.Currency), "", 1, "R", false, 0, "")
+	l.pdf.Ln(8)
+}
+
+// taxNote draws the statement the invoice's tax treatment requires, such as
+// the reverse charge wording
+func (l *invoiceLayout) taxNote() {
+	note := l.document.Invoice.TaxNote
+	if note == "" {
+		return
+	}
+
+	l.pdf.SetFont("Helvetica", "", 9)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.MultiCell(contentWidth, lineHeight, l.tr(note), "", "L", false)
+	l.pdf.Ln(6)
+}
+
+// paymentInstructions tells the customer where to send the payment, next to a
+// QR code of the payment address
+func (l *invoiceLayout) paymentInstructions(qrCode []byte) {
+	invoice := l.document.Invoice
+	asset := l.document.PaymentAsset
+	if invoice.PaymentAddress == "" || asset == nil {
+		return
+	}
+
+	// Keep the instructions and the QR code together on one page
+	_, pageHeight := l.pdf.GetPageSize()
+	if l.pdf.GetY()+qrCodeSize+12 > pageHeight-pageMargin-footerHeight {
+		l.pdf.AddPage()
+	}
+
+	top := l.pdf.GetY()
+	const textWidth = contentWidth - qrCodeSize - 10
+
+	l.pdf.SetFont("Helvetica", "B", 10)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.CellFormat(textWidth, 6, "Payment instructions", "", 1, "L", false, 0, "")
+
+	total := formatAmount(invoice.Total.Amount())
+	instruction := fmt.Sprintf("Send %s %s on the %s network to the address below.", total, asset.Symbol, asset.Chain)
+	if !strings.EqualFold(invoice.Currency, asset.Symbol) {
+		instruction = fmt.Sprintf("Send the equivalent of %s %s in %s on the %s network to the address below.",
+			total, invoice.Currency, asset.Symbol, asset.Chain)
+	}
+
+	l.pdf.SetFont("Helvetica", "", 9)
+	l.pdf.MultiCell(textWidth, lineHeight, l.tr(instruction), "", "L", false)
+	l.pdf.Ln(1)
+
+	l.addressLine(textWidth, "Wallet address", invoice.PaymentAddress)
+	if asset.ContractAddress != "" {
+		l.addressLine(textWidth, asset.Symbol+" token contract", asset.ContractAddress)
+	}
+
+	l.pdf.Ln(1)
+	l.pdf.SetFont("Helvetica", "", 8)
+	l.pdf.SetTextColor(120, 120, 120)
+	warning := fmt.Sprintf("Only send %s on %s to this address. Other assets or networks may be lost.", asset.Symbol, asset.Chain)
+	l.pdf.MultiCell(textWidth, 4, l.tr(warning), "", "L", false)
+	textBottom := l.pdf.GetY()
+
+	if qrCode != nil {
+		options := gofpdf.ImageOptions{ImageType: "PNG"}
+		l.pdf.RegisterImageOptionsReader("payment-qr", options, bytes.NewReader(qrCode))
+		l.pdf.ImageOptions("payment-qr", pageMargin+contentWidth-qrCodeSize, top, qrCodeSize, qrCodeSize, false, options, 0, "")
+	}
+
+	l.pdf.SetY(max(textBottom, top+qrCodeSize) + 8)
+}
+
+func (l *invoiceLayout) addressLine(width float64, label, address string) {
+	l.pdf.SetFont("Helvetica", "", 8)
+	l.pdf.SetTextColor(120, 120, 120)
+	l.pdf.CellFormat(width, 4, l.tr(label), "", 1, "L", false, 0, "")
+
+	l.pdf.SetFont("Courier", "", 9)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.MultiCell(width, lineHeight, address, "", "L", false)
+}
+
+// notes draws the invoice's free text notes at the end
+func (l *invoiceLayout) notes() {
+	notes := l.document.Invoice.Notes
+	if notes == "" {
+		return
+	}
+
+	l.pdf.SetFont("Helvetica", "B", 10)
+	l.pdf.SetTextColor(30, 30, 30)
+	l.pdf.CellFormat(contentWidth, 6, "Notes", "", 1, "L", false, 0, "")
+
+	l.pdf.SetFont("Helvetica", "", 9)
+	l.pdf.MultiCell(contentWidth, lineHeight, l.tr(notes), "", "L", false)
+}
+
+// formatAmount prints an amount with thousands separators and at least two
+// decimal places, keeping any further places the amount has
+func formatAmount(amount decimal.Decimal) string {
+	text := amount.Abs().String()
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	var grouped strings.Builder
+	for n, digit := range whole {
+		if n > 0 && (len(whole)-n)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	}
+
+	return sign + grouped.String() + "." + fraction
+}
+
+// formatPercent prints a percentage, or a dash when it is zero
+func formatPercent(percent decimal.Decimal) string {
+	if percent.IsZero() {
+		return "-"
+	}
+	return percent.String() + "%"
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format("2 Jan 2006")
+}
@@ -0,0 +1,194 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRenderer(allowPrivate bool) *InvoiceRenderer {
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	renderer := NewInvoiceRenderer(logging.New(&cfg))
+	renderer.client = newLogoClient(allowPrivate)
+	return renderer
+}
+
+func testDocument(lines int) domain.InvoiceDocument {
+	invoice := domain.Invoice{
+		ID:              uuid.New(),
+		Number:          42,
+		Status:          domain.InvoiceStatusSent,
+		Currency:        "USDC",
+		CustomerName:    "Société Générale",
+		CustomerEmail:   "billing@example.com",
+		CustomerAddress: "1 Rue de la Paix\n75002 Paris",
+		IssueDate:       time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:         time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC),
+		Notes:           "Thank you for your business.",
+		PaymentAddress:  "0x52908400098527886E0F7030069857D2E4169EE7",
+	}
+	for i := 0; i < lines; i++ {
+		invoice.LineItems = append(invoice.LineItems, domain.InvoiceLineItem{
+			Description: fmt.Sprintf("Line %d: consulting on the integration of the payroll contract with a long description that wraps", i+1),
+			Quantity:    decimal.NewFromInt(2),
+			UnitPrice:   money.MustParse("1250.5", "USDC"),
+			TaxRate:     decimal.RequireFromString("7.5"),
+		})
+	}
+	invoice.Calculate(6)
+
+	return domain.InvoiceDocument{
+		Invoice: invoice,
+		Organization: domain.Organization{
+			ID:      uuid.New(),
+			Name:    "Acme Ltd",
+			Address: "12 Marina Road",
+			City:    "Lagos",
+			Country: "Nigeria",
+		},
+		PaymentAsset: &domain.SupportedAsset{
+			Symbol:          "USDC",
+			Chain:           "base",
+			ContractAddress: "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913",
+			Decimals:        6,
+			Enabled:         true,
+		},
+	}
+}
+
+func logoServer(t *testing.T) *httptest.Server {
+	img := image.NewRGBA64(image.Rect(0, 0, 40, 20))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 20; y++ {
+			img.Set(x, y, color.RGBA64{R: 0xffff, A: 0x8000})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestInvoiceRenderer_RenderInvoice(t *testing.T) {
+	server := logoServer(t)
+	renderer := newTestRenderer(true)
+
+	document := testDocument(3)
+	logoURL := server.URL + "/logo.png"
+	document.Organization.LogoURL = &logoURL
+
+	pdf, err := renderer.RenderInvoice(context.Background(), document)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+	assert.Equal(t, 1, bytes.Count(pdf, []byte("/Type /Page\n")))
+	// Logo, the logo's alpha mask and the QR code
+	assert.Equal(t, 3, bytes.Count(pdf, []byte("/Subtype /Image")))
+}
+
+func TestInvoiceRenderer_RenderInvoice_SpansPages(t *testing.T) {
+	renderer := newTestRenderer(false)
+
+	pdf, err := renderer.RenderInvoice(context.Background(), testDocument(40))
+	require.NoError(t, err)
+	assert.Greater(t, bytes.Count(pdf, []byte("/Type /Page\n")), 1)
+}
+
+func TestInvoiceRenderer_RenderInvoice_WithoutPaymentDetails(t *testing.T) {
+	renderer := newTestRenderer(false)
+
+	document := testDocument(1)
+	document.PaymentAsset = nil
+
+	pdf, err := renderer.RenderInvoice(context.Background(), document)
+	require.NoError(t, err)
+	assert.Zero(t, bytes.Count(pdf, []byte("/Subtype /Image")))
+}
+
+func TestInvoiceRenderer_RenderInvoice_ReverseCharge(t *testing.T) {
+	renderer := newTestRenderer(false)
+
+	document := testDocument(2)
+	document.Organization.TaxID = "DE123456789"
+	document.Invoice.CustomerTaxID = "FR12345678901"
+	document.Invoice.TaxTreatment = domain.TaxTreatmentReverseCharge
+	document.Invoice.TaxName = "VAT"
+	document.Invoice.TaxNote = "Reverse charge: the customer accounts for VAT (Article 196, Council Directive 2006/112/EC)"
+	for i := range document.Invoice.LineItems {
+		document.Invoice.LineItems[i].TaxRate = decimal.Zero
+	}
+	document.Invoice.Calculate(6)
+
+	pdf, err := renderer.RenderInvoice(context.Background(), document)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+	assert.Equal(t, 1, bytes.Count(pdf, []byte("/Type /Page\n")))
+}
+
+func TestInvoiceRenderer_SkipsUnusableLogo(t *testing.T) {
+	server := logoServer(t)
+
+	t.Run("private host", func(t *testing.T) {
+		renderer := newTestRenderer(false)
+
+		_, err := renderer.fetchLogo(context.Background(), server.URL)
+		assert.True(t, errors.Is(err, errPrivateLogoHost), "got %v", err)
+
+		document := testDocument(1)
+		logoURL := server.URL
+		document.Organization.LogoURL = &logoURL
+
+		pdf, err := renderer.RenderInvoice(context.Background(), document)
+		require.NoError(t, err)
+		// Only the QR code
+		assert.Equal(t, 1, bytes.Count(pdf, []byte("/Subtype /Image")))
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(strings.Repeat("<html></html>", 10)))
+		}))
+		defer page.Close()
+
+		_, err := newTestRenderer(true).fetchLogo(context.Background(), page.URL)
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := map[string]string{
+		"0":            "0.00",
+		"5":            "5.00",
+		"1234.5":       "1,234.50",
+		"1234567.891":  "1,234,567.891",
+		"-987654.3":    "-987,654.30",
+		"0.123456":     "0.123456",
+		"100.00000000": "100.00",
+	}
+
+	for input, want := range tests {
+		assert.Equal(t, want, formatAmount(decimal.RequireFromString(input)), input)
+	}
+}
@@ -0,0 +1,124 @@
+package tax
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// The version and tax names are stored on every invoice taxed with the table
+const (
+	maxVersionLength = 50
+	maxTaxNameLength = 20
+)
+
+//go:embed default_rates.json
+var defaultRates []byte
+
+// rateTableFile is the JSON shape of a rate table:
+// {"version": "2025.1", "countries": {"GB": {"name": "United Kingdom", "tax_name": "VAT", "union": "", "rates": [{"from": "2011-01-04", "standard": "20"}]}}}
+type rateTableFile struct {
+	Version   string                     `json:"version"`
+	Countries map[string]countryRateFile `json:"countries"`
+}
+
+type countryRateFile struct {
+	Name    string           `json:"name"`
+	TaxName string           `json:"tax_name"`
+	Union   string           `json:"union"`
+	Rates   []ratePeriodFile `json:"rates"`
+}
+
+type ratePeriodFile struct {
+	From     string          `json:"from"`
+	Standard decimal.Decimal `json:"standard"`
+}
+
+// LoadRateTable reads a versioned tax rate table from a JSON file. An empty
+// path loads the built-in table. Every country needs a two-letter code, a
+// name and at least one rate; rates are percentages from 0 to 100 starting
+// on distinct dates.
+func LoadRateTable(path string) (*domain.TaxRateTable, error) {
+	data := defaultRates
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read tax rates: %w", err)
+		}
+	}
+
+	var file rateTableFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse tax rates: %w", err)
+	}
+
+	return parseRateTable(file)
+}
+
+func parseRateTable(file rateTableFile) (*domain.TaxRateTable, error) {
+	table := &domain.TaxRateTable{
+		Version:   strings.TrimSpace(file.Version),
+		Countries: make(map[string]domain.CountryTaxRates, len(file.Countries)),
+	}
+	if table.Version == "" || len(table.Version) > maxVersionLength {
+		return nil, fmt.Errorf("tax rates need a version of at most %d characters", maxVersionLength)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for code, country := range file.Countries {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if len(code) != 2 {
+			return nil, fmt.Errorf("tax rates country %q is not a two-letter code", code)
+		}
+		if strings.TrimSpace(country.Name) == "" {
+			return nil, fmt.Errorf("tax rates country %s needs a name", code)
+		}
+		if len(country.Rates) == 0 {
+			return nil, fmt.Errorf("tax rates country %s needs at least one rate", code)
+		}
+
+		rates := make([]domain.TaxRatePeriod, len(country.Rates))
+		for i, rate := range country.Rates {
+			from, err := time.Parse(time.DateOnly, rate.From)
+			if err != nil {
+				return nil, fmt.Errorf("tax rates country %s: invalid start date %q", code, rate.From)
+			}
+			if rate.Standard.IsNegative() || rate.Standard.GreaterThan(hundred) {
+				return nil, fmt.Errorf("tax rates country %s: rate %s must be between 0 and 100", code, rate.Standard)
+			}
+			rates[i] = domain.TaxRatePeriod{From: from, Standard: rate.Standard}
+		}
+
+		sort.Slice(rates, func(a, b int) bool { return rates[a].From.Before(rates[b].From) })
+		for i := 1; i < len(rates); i++ {
+			if rates[i].From.Equal(rates[i-1].From) {
+				return nil, fmt.Errorf("tax rates country %s has two rates from %s", code, rates[i].From.Format(time.DateOnly))
+			}
+		}
+
+		taxName := strings.TrimSpace(country.TaxName)
+		if taxName == "" {
+			taxName = "VAT"
+		}
+		if len(taxName) > maxTaxNameLength {
+			return nil, fmt.Errorf("tax rates country %s: tax name can be at most %d characters", code, maxTaxNameLength)
+		}
+
+		table.Countries[code] = domain.CountryTaxRates{
+			Code:    code,
+			Name:    strings.TrimSpace(country.Name),
+			TaxName: taxName,
+			Union:   strings.ToUpper(strings.TrimSpace(country.Union)),
+			Rates:   rates,
+		}
+	}
+
+	return table, nil
+}
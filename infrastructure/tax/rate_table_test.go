@@ -0,0 +1,79 @@
+package tax
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRateTable(t *testing.T) {
+	table, err := LoadRateTable("")
+	require.NoError(t, err)
+	assert.NotEmpty(t, table.Version)
+
+	gb, ok := table.Lookup("gb")
+	require.True(t, ok)
+	assert.Equal(t, "VAT", gb.TaxName)
+	assert.Empty(t, gb.Union)
+
+	// Countries are also found by name, and rates follow their start dates
+	sk, ok := table.Lookup("Slovakia")
+	require.True(t, ok)
+	assert.Equal(t, "EU", sk.Union)
+	rate, ok := sk.StandardRate(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, "20", rate.String())
+	rate, ok = sk.StandardRate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, "23", rate.String())
+
+	_, ok = sk.StandardRate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	_, ok = table.Lookup("US")
+	assert.False(t, ok)
+}
+
+func TestLoadRateTable_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": "test-1", "countries": {"no": {"name": "Norway", "tax_name": "MVA", "rates": [{"from": "2005-01-01", "standard": "25"}]}}}`), 0o600))
+
+	table, err := LoadRateTable(path)
+	require.NoError(t, err)
+	assert.Equal(t, "test-1", table.Version)
+	no, ok := table.Lookup("NO")
+	require.True(t, ok)
+	assert.Equal(t, "MVA", no.TaxName)
+
+	_, err = LoadRateTable(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestLoadRateTable_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "not_json", data: `{`},
+		{name: "no_version", data: `{"countries": {"GB": {"name": "United Kingdom", "rates": [{"from": "2011-01-04", "standard": "20"}]}}}`},
+		{name: "bad_code", data: `{"version": "1", "countries": {"GBR": {"name": "United Kingdom", "rates": [{"from": "2011-01-04", "standard": "20"}]}}}`},
+		{name: "no_rates", data: `{"version": "1", "countries": {"GB": {"name": "United Kingdom", "rates": []}}}`},
+		{name: "bad_date", data: `{"version": "1", "countries": {"GB": {"name": "United Kingdom", "rates": [{"from": "04/01/2011", "standard": "20"}]}}}`},
+		{name: "rate_above_100", data: `{"version": "1", "countries": {"GB": {"name": "United Kingdom", "rates": [{"from": "2011-01-04", "standard": "120"}]}}}`},
+		{name: "duplicate_date", data: `{"version": "1", "countries": {"GB": {"name": "United Kingdom", "rates": [{"from": "2011-01-04", "standard": "20"}, {"from": "2011-01-04", "standard": "17.5"}]}}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rates.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.data), 0o600))
+
+			_, err := LoadRateTable(path)
+			assert.Error(t, err)
+		})
+	}
+}
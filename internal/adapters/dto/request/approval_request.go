@@ -0,0 +1,34 @@
+package request
+
+import "github.com/google/uuid"
+
+// ApprovalPolicyRequest represents an approval policy's settings, used both to
+// create a policy and to replace them. Thresholds are optional decimal strings
+// in currency; a policy without thresholds applies to every pay run.
+// ApprovalWindowHours defaults to 72 and Active defaults to true.
+type ApprovalPolicyRequest struct {
+	Name                string      `json:"name" binding:"required"`
+	Currency            string      `json:"currency" binding:"required"`
+	MinTotalAmount      string      `json:"min_total_amount"`
+	MinPayoutAmount     string      `json:"min_payout_amount"`
+	RequiredApprovals   int         `json:"required_approvals" binding:"required,min=1"`
+	ApproverIDs         []uuid.UUID `json:"approver_ids" binding:"required,min=1"`
+	ApprovalWindowHours int         `json:"approval_window_hours" binding:"omitempty,min=1"`
+	Active              *bool       `json:"active"`
+}
+
+// SubmitPayRunRequest lists quotes locked with POST /fx/quotes to convert the
+// pay run's payouts with; pairs they do not cover are locked at the current rate
+type SubmitPayRunRequest struct {
+	FXQuoteIDs []uuid.UUID `json:"fx_quote_ids" binding:"omitempty,max=20"`
+}
+
+// ApprovePayRunRequest represents an approver's sign-off with an optional comment
+type ApprovePayRunRequest struct {
+	Comment string `json:"comment"`
+}
+
+// RejectPayRunRequest represents an approver's rejection and why
+type RejectPayRunRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
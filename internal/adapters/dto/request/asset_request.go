@@ -0,0 +1,18 @@
+package request
+
+// CreateAssetRequest represents the request to register a supported asset.
+// ContractAddress is left empty for a chain's native coin.
+type CreateAssetRequest struct {
+	Chain           string `json:"chain" binding:"required"`
+	Symbol          string `json:"symbol" binding:"required"`
+	Name            string `json:"name" binding:"required"`
+	ContractAddress string `json:"contract_address"`
+	Decimals        *int   `json:"decimals" binding:"required"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// UpdateAssetRequest represents the request to rename an asset or switch it on or off
+type UpdateAssetRequest struct {
+	Name    *string `json:"name"`
+	Enabled *bool   `json:"enabled"`
+}
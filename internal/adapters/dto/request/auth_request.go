@@ -229,7 +229,6 @@ type UpdateProfileRequest struct {
 	Gender             string `json:"gender"`
 	ResidentialCountry string `json:"residential_country"`
 	JobRole            string `json:"job_role"`
-	CompanyWebsite     string `json:"company_website"`
 	EmploymentType     string `json:"employment_type"`
 }
 
@@ -0,0 +1,7 @@
+package request
+
+// LockFXQuoteRequest represents the request to lock an exchange rate
+type LockFXQuoteRequest struct {
+	Base  string `json:"base" binding:"required"`
+	Quote string `json:"quote" binding:"required"`
+}
@@ -0,0 +1,21 @@
+package request
+
+// CreateInvitationRequest represents the request to invite someone to an organization
+type CreateInvitationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,oneof=owner admin finance viewer"`
+}
+
+// AcceptInvitationRequest represents a signed-in user accepting an invitation link
+type AcceptInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RegisterWithInvitationRequest represents signing up from an invitation link.
+// The email address is taken from the invitation.
+type RegisterWithInvitationRequest struct {
+	Token     string `json:"token" binding:"required"`
+	Password  string `json:"password" binding:"required,min=8"`
+	FirstName string `json:"first_name" binding:"required"`
+	LastName  string `json:"last_name" binding:"required"`
+}
@@ -0,0 +1,183 @@
+package request
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvoiceRequest represents an invoice's details, used both to create a draft
+// and to replace one. Every amount is in currency. IssueDate defaults to now.
+// PaymentAssetID and PaymentAddress are printed on the invoice as payment
+// instructions. An asset without an address asks for a deposit address derived
+// from the organization's deposit key, so payments are matched automatically.
+// With a ClientID, the customer details, currency, payment asset and due date
+// default to the directory client's; without one they are required. With
+// AutomaticTax, every line's tax rate is worked out from the organization's
+// and the customer's country and tax ID, and the lines' tax_rate is ignored.
+type InvoiceRequest struct {
+	ClientID        *uuid.UUID               `json:"client_id"`
+	Currency        string                   `json:"currency"`
+	CustomerName    string                   `json:"customer_name"`
+	CustomerEmail   string                   `json:"customer_email"`
+	CustomerAddress string                   `json:"customer_address"`
+	CustomerCountry string                   `json:"customer_country"`
+	CustomerTaxID   string                   `json:"customer_tax_id"`
+	AutomaticTax    bool                     `json:"automatic_tax"`
+	IssueDate       *time.Time               `json:"issue_date"`
+	DueDate         *time.Time               `json:"due_date"`
+	Notes           string                   `json:"notes"`
+	LineItems       []InvoiceLineItemRequest `json:"line_items" binding:"required,min=1,dive"`
+	PaymentAssetID  *uuid.UUID               `json:"payment_asset_id"`
+	PaymentAddress  string                   `json:"payment_address"`
+}
+
+// InvoiceLineItemRequest represents one line of an invoice. Quantity, unit
+// price and the percentages are decimal strings; the percentages default to 0.
+type InvoiceLineItemRequest struct {
+	Description     string `json:"description" binding:"required"`
+	Quantity        string `json:"quantity" binding:"required"`
+	UnitPrice       string `json:"unit_price" binding:"required"`
+	DiscountPercent string `json:"discount_percent"`
+	TaxRate         string `json:"tax_rate"`
+}
+
+// MarkInvoicePaidRequest represents a payment received outside the platform.
+// PaidAt defaults to now.
+type MarkInvoicePaidRequest struct {
+	PaidAt    *time.Time `json:"paid_at"`
+	Reference string     `json:"reference"`
+}
+
+// VoidInvoiceRequest represents the cancellation of an invoice and why
+type VoidInvoiceRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// CreditNoteRequest represents an amount to take off an invoice's balance and
+// why. Amount, tax included, defaults to the whole balance due.
+type CreditNoteRequest struct {
+	Amount string `json:"amount"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+// InvoiceDepositKeyRequest represents the account-level extended public key
+// (m/44'/60'/0') invoice deposit addresses are derived from, and the
+// underpayment in basis points still accepted as paid
+type InvoiceDepositKeyRequest struct {
+	ExtendedPublicKey string `json:"extended_public_key" binding:"required"`
+	ToleranceBPS      int    `json:"tolerance_bps" binding:"min=0,max=1000"`
+}
+
+// InvoiceShareLinkRequest represents when a new share link stops working.
+// Without an expiry the link is valid for the configured default.
+type InvoiceShareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// InvoiceReminderSettingsRequest represents whether customers are reminded of
+// unpaid invoices and on which days relative to the due date: negative before
+// it, zero on it, positive after it
+type InvoiceReminderSettingsRequest struct {
+	Enabled    bool  `json:"enabled"`
+	OffsetDays []int `json:"offset_days" binding:"max=10"`
+}
+
+// RecurringInvoiceRequest represents a recurring invoice's schedule and the
+// invoice it issues, used both to create one and to replace its settings.
+// EndDate and MaxOccurrences are optional limits; DaysUntilDue defaults to 30.
+type RecurringInvoiceRequest struct {
+	Name            string                   `json:"name" binding:"required"`
+	Frequency       string                   `json:"frequency" binding:"required,oneof=weekly biweekly monthly quarterly yearly"`
+	Timezone        string                   `json:"timezone"`
+	StartDate       time.Time                `json:"start_date" binding:"required"`
+	EndDate         *time.Time               `json:"end_date"`
+	MaxOccurrences  *int                     `json:"max_occurrences"`
+	DaysUntilDue    *int                     `json:"days_until_due"`
+	AutoSend        bool                     `json:"auto_send"`
+	Currency        string                   `json:"currency" binding:"required"`
+	CustomerName    string                   `json:"customer_name" binding:"required"`
+	CustomerEmail   string                   `json:"customer_email" binding:"required"`
+	CustomerAddress string                   `json:"customer_address"`
+	Notes           string                   `json:"notes"`
+	LineItems       []InvoiceLineItemRequest `json:"line_items" binding:"required,min=1,dive"`
+	PaymentAssetID  *uuid.UUID               `json:"payment_asset_id"`
+	PaymentAddress  string                   `json:"payment_address"`
+}
+
+// ClientRequest represents a client's details, used both to add one to the
+// directory and to replace them. Country is an ISO 3166-1 alpha-2 code.
+// PreferredCurrency and PreferredAssetID are the defaults of the client's
+// invoices; PaymentTermsDays, the days from issue to due date, defaults to 30.
+type ClientRequest struct {
+	Name              string               `json:"name" binding:"required"`
+	Email             string               `json:"email" binding:"required"`
+	CCEmails          []string             `json:"cc_emails" binding:"max=10"`
+	TaxID             string               `json:"tax_id"`
+	BillingAddress    ClientAddressRequest `json:"billing_address"`
+	PreferredCurrency string               `json:"preferred_currency"`
+	PreferredAssetID  *uuid.UUID           `json:"preferred_asset_id"`
+	PaymentTermsDays  *int                 `json:"payment_terms_days" binding:"omitempty,min=0,max=365"`
+	Notes             string               `json:"notes"`
+}
+
+// ClientAddressRequest represents where a client is billed
+type ClientAddressRequest struct {
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+// CreateContractorContractRequest represents the request to bill a
+// contractor's hours on a project at an hourly rate. HourlyRate is a decimal
+// string in currency. Hours can only be invoiced once the contract has a client.
+type CreateContractorContractRequest struct {
+	ContractorID uuid.UUID  `json:"contractor_id" binding:"required"`
+	ClientID     *uuid.UUID `json:"client_id"`
+	Project      string     `json:"project" binding:"required"`
+	HourlyRate   string     `json:"hourly_rate" binding:"required"`
+	Currency     string     `json:"currency" binding:"required"`
+}
+
+// UpdateContractorContractRequest represents the request to change a
+// contract. Active defaults to true; set it to false to stop the contractor
+// logging time on it.
+type UpdateContractorContractRequest struct {
+	ClientID   *uuid.UUID `json:"client_id"`
+	Project    string     `json:"project" binding:"required"`
+	HourlyRate string     `json:"hourly_rate" binding:"required"`
+	Currency   string     `json:"currency" binding:"required"`
+	Active     *bool      `json:"active"`
+}
+
+// TimesheetRequest represents the hours a contractor logged on a contract in
+// the week starting on WeekStart, a Monday. The entries replace those the
+// week's timesheet had; only their calendar date is used.
+type TimesheetRequest struct {
+	ContractID uuid.UUID               `json:"contract_id" binding:"required"`
+	WeekStart  time.Time               `json:"week_start" binding:"required"`
+	Entries    []TimesheetEntryRequest `json:"entries" binding:"max=50,dive"`
+}
+
+// TimesheetEntryRequest represents time worked on one day. Hours is a decimal
+// string with at most two decimal places.
+type TimesheetEntryRequest struct {
+	WorkDate    time.Time `json:"work_date" binding:"required"`
+	Hours       string    `json:"hours" binding:"required"`
+	Description string    `json:"description"`
+}
+
+// RejectTimesheetRequest represents sending a timesheet back to the
+// contractor and why
+type RejectTimesheetRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// InvoiceTimesheetsRequest represents the approved timesheets to bill on one
+// draft invoice. They must share a client and currency.
+type InvoiceTimesheetsRequest struct {
+	TimesheetIDs []uuid.UUID `json:"timesheet_ids" binding:"required,min=1,max=100"`
+}
@@ -0,0 +1,23 @@
+package request
+
+// OrganizationRequest represents an organization's profile, used both to create
+// an organization and to replace its profile
+type OrganizationRequest struct {
+	Name         string  `json:"name" binding:"required"`
+	Address      string  `json:"address"`
+	City         string  `json:"city"`
+	PostalCode   string  `json:"postal_code"`
+	Country      string  `json:"country"`
+	Website      *string `json:"website"`
+	Size         *string `json:"size"`
+	Industry     *string `json:"industry"`
+	Description  *string `json:"description"`
+	Headquarters *string `json:"headquarters"`
+	LogoURL      *string `json:"logo_url"`
+	TaxID        string  `json:"tax_id"`
+}
+
+// UpdateMemberRoleRequest represents the request to change a member's role
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner admin finance viewer"`
+}
@@ -0,0 +1,45 @@
+package request
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayrollScheduleRequest represents a payroll schedule's settings, used both to
+// create a schedule and to replace them. CronExpression is only used by custom
+// schedules; Active defaults to true.
+type PayrollScheduleRequest struct {
+	Name           string    `json:"name" binding:"required"`
+	Frequency      string    `json:"frequency" binding:"required,oneof=weekly biweekly monthly custom"`
+	CronExpression string    `json:"cron_expression"`
+	Timezone       string    `json:"timezone"`
+	FirstPayDate   time.Time `json:"first_pay_date" binding:"required"`
+	Active         *bool     `json:"active"`
+}
+
+// CreateCompensationRequest represents the request to set an employee's pay on a schedule.
+// Amount is a decimal string in currency. A contractor who charges VAT on their
+// pay sets the country they are registered in and their tax ID.
+type CreateCompensationRequest struct {
+	ScheduleID    uuid.UUID `json:"schedule_id" binding:"required"`
+	UserID        uuid.UUID `json:"user_id" binding:"required"`
+	Amount        string    `json:"amount" binding:"required"`
+	Currency      string    `json:"currency" binding:"required"`
+	PayoutAssetID uuid.UUID `json:"payout_asset_id" binding:"required"`
+	WalletAddress string    `json:"wallet_address" binding:"required"`
+	TaxCountry    string    `json:"tax_country"`
+	TaxID         string    `json:"tax_id"`
+}
+
+// UpdateCompensationRequest represents the request to change an employee's pay.
+// Active defaults to true; set it to false to stop paying the employee.
+type UpdateCompensationRequest struct {
+	Amount        string    `json:"amount" binding:"required"`
+	Currency      string    `json:"currency" binding:"required"`
+	PayoutAssetID uuid.UUID `json:"payout_asset_id" binding:"required"`
+	WalletAddress string    `json:"wallet_address" binding:"required"`
+	TaxCountry    string    `json:"tax_country"`
+	TaxID         string    `json:"tax_id"`
+	Active        *bool     `json:"active"`
+}
@@ -0,0 +1,18 @@
+package request
+
+// SetTransactionPINRequest represents the request to set a first transaction PIN
+type SetTransactionPINRequest struct {
+	PIN string `json:"pin" binding:"required"`
+}
+
+// ChangeTransactionPINRequest represents the request to change the transaction PIN
+type ChangeTransactionPINRequest struct {
+	CurrentPIN string `json:"current_pin" binding:"required"`
+	NewPIN     string `json:"new_pin" binding:"required"`
+}
+
+// ResetTransactionPINRequest represents the request to reset the transaction PIN with an emailed OTP
+type ResetTransactionPINRequest struct {
+	OTP    string `json:"otp" binding:"required"`
+	NewPIN string `json:"new_pin" binding:"required"`
+}
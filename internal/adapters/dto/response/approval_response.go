@@ -0,0 +1,58 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApprovalPolicyResponse represents an approval policy
+type ApprovalPolicyResponse struct {
+	ID                  uuid.UUID       `json:"id"`
+	Name                string          `json:"name"`
+	Currency            string          `json:"currency"`
+	MinTotalAmount      *AmountResponse `json:"min_total_amount,omitempty"`
+	MinPayoutAmount     *AmountResponse `json:"min_payout_amount,omitempty"`
+	RequiredApprovals   int             `json:"required_approvals"`
+	ApproverIDs         []uuid.UUID     `json:"approver_ids"`
+	ApprovalWindowHours int             `json:"approval_window_hours"`
+	Active              bool            `json:"active"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+// ApprovalRequestResponse represents the sign-off of a pay run. Decisions and
+// the approval count are only included when a single request is retrieved.
+type ApprovalRequestResponse struct {
+	ID                uuid.UUID                  `json:"id"`
+	PayRunID          uuid.UUID                  `json:"pay_run_id"`
+	PolicyID          *uuid.UUID                 `json:"policy_id,omitempty"`
+	Status            string                     `json:"status"`
+	RequiredApprovals int                        `json:"required_approvals"`
+	Approvals         *int                       `json:"approvals,omitempty"`
+	ApproverIDs       []uuid.UUID                `json:"approver_ids"`
+	RequestedBy       uuid.UUID                  `json:"requested_by"`
+	ExpiresAt         time.Time                  `json:"expires_at"`
+	DecidedAt         *time.Time                 `json:"decided_at,omitempty"`
+	Decisions         []ApprovalDecisionResponse `json:"decisions,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+}
+
+// ApprovalDecisionResponse represents one approver's decision
+type ApprovalDecisionResponse struct {
+	ApproverID uuid.UUID `json:"approver_id"`
+	Email      string    `json:"email"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	Decision   string    `json:"decision"`
+	Comment    string    `json:"comment,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// SubmitPayRunResponse represents a submitted pay run and the approval request
+// it is waiting on, which is absent when no policy applied and it was approved
+// straight away
+type SubmitPayRunResponse struct {
+	PayRun          PayRunResponse           `json:"pay_run"`
+	ApprovalRequest *ApprovalRequestResponse `json:"approval_request,omitempty"`
+}
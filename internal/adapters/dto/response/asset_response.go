@@ -0,0 +1,20 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetResponse represents a supported asset. Contract address is empty for native coins.
+type AssetResponse struct {
+	ID              uuid.UUID `json:"id"`
+	Chain           string    `json:"chain"`
+	Symbol          string    `json:"symbol"`
+	Name            string    `json:"name"`
+	ContractAddress string    `json:"contract_address,omitempty"`
+	Decimals        int       `json:"decimals"`
+	Enabled         bool      `json:"enabled"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
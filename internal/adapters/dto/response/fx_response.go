@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FXRateResponse represents an exchange rate: one unit of base is worth rate units of quote
+type FXRateResponse struct {
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Rate      string    `json:"rate"`
+	Source    string    `json:"source"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// FXQuoteResponse represents an exchange rate locked until expires_at
+type FXQuoteResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Rate      string    `json:"rate"`
+	Source    string    `json:"source"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationResponse represents an invitation to join an organization
+type InvitationResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	OrganizationID   uuid.UUID  `json:"organization_id"`
+	OrganizationName string     `json:"organization_name,omitempty"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	Status           string     `json:"status"`
+	InvitedBy        *uuid.UUID `json:"invited_by,omitempty"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	Expired          bool       `json:"expired"`
+	SendCount        int        `json:"send_count"`
+	LastSentAt       time.Time  `json:"last_sent_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// InvitationLookupResponse describes an invitation link to the invitee.
+// AccountExists tells the client whether to sign in or sign up to accept.
+type InvitationLookupResponse struct {
+	OrganizationName string    `json:"organization_name"`
+	Email            string    `json:"email"`
+	Role             string    `json:"role"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	AccountExists    bool      `json:"account_exists"`
+}
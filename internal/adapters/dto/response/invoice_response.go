@@ -0,0 +1,338 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvoiceResponse represents an invoice. Amounts are decimal strings in the
+// invoice currency. Line items are only included when a single invoice is
+// retrieved.
+type InvoiceResponse struct {
+	ID                 uuid.UUID                 `json:"id"`
+	Number             int64                     `json:"number"`
+	InvoiceNumber      string                    `json:"invoice_number"`
+	Status             string                    `json:"status"`
+	Currency           string                    `json:"currency"`
+	ClientID           *uuid.UUID                `json:"client_id,omitempty"`
+	CustomerName       string                    `json:"customer_name"`
+	CustomerEmail      string                    `json:"customer_email"`
+	CustomerAddress    string                    `json:"customer_address,omitempty"`
+	CustomerCountry    string                    `json:"customer_country,omitempty"`
+	CustomerTaxID      string                    `json:"customer_tax_id,omitempty"`
+	IssueDate          time.Time                 `json:"issue_date"`
+	DueDate            time.Time                 `json:"due_date"`
+	Notes              string                    `json:"notes,omitempty"`
+	Subtotal           string                    `json:"subtotal"`
+	DiscountTotal      string                    `json:"discount_total"`
+	TaxTotal           string                    `json:"tax_total"`
+	Total              string                    `json:"total"`
+	AutomaticTax       bool                      `json:"automatic_tax"`
+	TaxTreatment       string                    `json:"tax_treatment,omitempty"`
+	TaxName            string                    `json:"tax_name,omitempty"`
+	TaxNote            string                    `json:"tax_note,omitempty"`
+	TaxRatesVersion    string                    `json:"tax_rates_version,omitempty"`
+	TaxBreakdown       []TaxBreakdownResponse    `json:"tax_breakdown,omitempty"`
+	LineItems          []InvoiceLineItemResponse `json:"line_items,omitempty"`
+	PaymentAssetID     *uuid.UUID                `json:"payment_asset_id,omitempty"`
+	PaymentAddress     string                    `json:"payment_address,omitempty"`
+	DepositAddress     bool                      `json:"deposit_address"`
+	AmountPaid         string                    `json:"amount_paid"`
+	AmountCredited     string                    `json:"amount_credited"`
+	BalanceDue         string                    `json:"balance_due"`
+	Overpayment        string                    `json:"overpayment,omitempty"`
+	PaymentException   string                    `json:"payment_exception,omitempty"`
+	RecurringInvoiceID *uuid.UUID                `json:"recurring_invoice_id,omitempty"`
+	SentAt             *time.Time                `json:"sent_at,omitempty"`
+	ViewedAt           *time.Time                `json:"viewed_at,omitempty"`
+	PaidAt             *time.Time                `json:"paid_at,omitempty"`
+	PaymentReference   string                    `json:"payment_reference,omitempty"`
+	VoidedAt           *time.Time                `json:"voided_at,omitempty"`
+	VoidReason         string                    `json:"void_reason,omitempty"`
+	CreatedAt          time.Time                 `json:"created_at"`
+	UpdatedAt          time.Time                 `json:"updated_at"`
+}
+
+// TaxBreakdownResponse represents the tax at one rate on an invoice or payslip:
+// the amount it was charged on and the tax
+type TaxBreakdownResponse struct {
+	Label   string `json:"label"`
+	Rate    string `json:"rate"`
+	Taxable string `json:"taxable"`
+	Tax     string `json:"tax"`
+}
+
+// InvoiceLineItemResponse represents one line of an invoice
+type InvoiceLineItemResponse struct {
+	Position        int    `json:"position"`
+	Description     string `json:"description"`
+	Quantity        string `json:"quantity"`
+	UnitPrice       string `json:"unit_price"`
+	DiscountPercent string `json:"discount_percent"`
+	TaxRate         string `json:"tax_rate"`
+	Amount          string `json:"amount"`
+	DiscountAmount  string `json:"discount_amount"`
+	TaxAmount       string `json:"tax_amount"`
+	Total           string `json:"total"`
+}
+
+// InvoiceDepositKeyResponse represents an organization's deposit key and how
+// many addresses have been derived from it
+type InvoiceDepositKeyResponse struct {
+	ExtendedPublicKey string    `json:"extended_public_key"`
+	AddressesUsed     int64     `json:"addresses_used"`
+	ToleranceBPS      int       `json:"tolerance_bps"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// InvoicePaymentResponse represents a transfer matched to an invoice, with its
+// amount in the invoice currency. Applied is false for a transfer received
+// after the invoice was voided.
+type InvoicePaymentResponse struct {
+	ID           uuid.UUID `json:"id"`
+	TxHash       string    `json:"tx_hash"`
+	LogIndex     int       `json:"log_index"`
+	TokenAddress string    `json:"token_address"`
+	FromAddress  string    `json:"from_address"`
+	ToAddress    string    `json:"to_address"`
+	Amount       string    `json:"amount"`
+	BlockNumber  int64     `json:"block_number"`
+	Applied      bool      `json:"applied"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// InvoiceShareLinkResponse represents a public link to an invoice. The URL
+// is only included when the link is created.
+type InvoiceShareLinkResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	URL          string     `json:"url,omitempty"`
+	Active       bool       `json:"active"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	ViewCount    int        `json:"view_count"`
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// SharedInvoiceResponse represents the read-only view of an invoice opened
+// through a share link. Payment instructions are only included while the
+// invoice is awaiting payment to a known address.
+type SharedInvoiceResponse struct {
+	OrganizationName    string                       `json:"organization_name"`
+	InvoiceNumber       string                       `json:"invoice_number"`
+	Status              string                       `json:"status"`
+	Currency            string                       `json:"currency"`
+	CustomerName        string                       `json:"customer_name"`
+	CustomerAddress     string                       `json:"customer_address,omitempty"`
+	CustomerTaxID       string                       `json:"customer_tax_id,omitempty"`
+	SellerTaxID         string                       `json:"seller_tax_id,omitempty"`
+	IssueDate           time.Time                    `json:"issue_date"`
+	DueDate             time.Time                    `json:"due_date"`
+	Notes               string                       `json:"notes,omitempty"`
+	LineItems           []InvoiceLineItemResponse    `json:"line_items"`
+	Subtotal            string                       `json:"subtotal"`
+	DiscountTotal       string                       `json:"discount_total"`
+	TaxTotal            string                       `json:"tax_total"`
+	TaxBreakdown        []TaxBreakdownResponse       `json:"tax_breakdown,omitempty"`
+	TaxNote             string                       `json:"tax_note,omitempty"`
+	Total               string                       `json:"total"`
+	AmountPaid          string                       `json:"amount_paid"`
+	AmountCredited      string                       `json:"amount_credited"`
+	BalanceDue          string                       `json:"balance_due"`
+	PaidAt              *time.Time                   `json:"paid_at,omitempty"`
+	PaymentInstructions *PaymentInstructionsResponse `json:"payment_instructions,omitempty"`
+	LinkExpiresAt       time.Time                    `json:"link_expires_at"`
+}
+
+// PaymentInstructionsResponse represents how to pay what is left of an
+// invoice: the amount in the invoice currency, sent in the asset to the address
+type PaymentInstructionsResponse struct {
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	Address         string `json:"address"`
+	AssetSymbol     string `json:"asset_symbol,omitempty"`
+	Chain           string `json:"chain,omitempty"`
+	ContractAddress string `json:"contract_address,omitempty"`
+	Decimals        int    `json:"decimals,omitempty"`
+}
+
+// InvoiceReminderSettingsResponse represents when an organization's customers
+// are reminded of unpaid invoices, in days relative to the due date
+type InvoiceReminderSettingsResponse struct {
+	Enabled    bool       `json:"enabled"`
+	OffsetDays []int      `json:"offset_days"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// InvoiceReminderResponse represents a reminder sent for an invoice
+type InvoiceReminderResponse struct {
+	ID         uuid.UUID `json:"id"`
+	OffsetDays int       `json:"offset_days"`
+	Recipient  string    `json:"recipient"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// RecurringInvoiceResponse represents a recurring invoice. Unit prices are
+// decimal strings in currency. Line items are only included when a single
+// recurring invoice is retrieved.
+type RecurringInvoiceResponse struct {
+	ID              uuid.UUID                          `json:"id"`
+	Name            string                             `json:"name"`
+	Status          string                             `json:"status"`
+	Frequency       string                             `json:"frequency"`
+	Timezone        string                             `json:"timezone"`
+	StartDate       time.Time                          `json:"start_date"`
+	EndDate         *time.Time                         `json:"end_date,omitempty"`
+	MaxOccurrences  *int                               `json:"max_occurrences,omitempty"`
+	Occurrences     int                                `json:"occurrences"`
+	NextIssueAt     *time.Time                         `json:"next_issue_at,omitempty"`
+	LastIssuedAt    *time.Time                         `json:"last_issued_at,omitempty"`
+	DaysUntilDue    int                                `json:"days_until_due"`
+	AutoSend        bool                               `json:"auto_send"`
+	Currency        string                             `json:"currency"`
+	CustomerName    string                             `json:"customer_name"`
+	CustomerEmail   string                             `json:"customer_email"`
+	CustomerAddress string                             `json:"customer_address,omitempty"`
+	Notes           string                             `json:"notes,omitempty"`
+	LineItems       []RecurringInvoiceLineItemResponse `json:"line_items,omitempty"`
+	PaymentAssetID  *uuid.UUID                         `json:"payment_asset_id,omitempty"`
+	PaymentAddress  string                             `json:"payment_address,omitempty"`
+	CreatedAt       time.Time                          `json:"created_at"`
+	UpdatedAt       time.Time                          `json:"updated_at"`
+}
+
+// RecurringInvoiceLineItemResponse represents one line copied onto every generated invoice
+type RecurringInvoiceLineItemResponse struct {
+	Position        int    `json:"position"`
+	Description     string `json:"description"`
+	Quantity        string `json:"quantity"`
+	UnitPrice       string `json:"unit_price"`
+	DiscountPercent string `json:"discount_percent"`
+	TaxRate         string `json:"tax_rate"`
+}
+
+// UpcomingInvoiceResponse represents an invoice a recurring invoice will
+// generate. It is numbered only once it is generated.
+type UpcomingInvoiceResponse struct {
+	IssueDate     time.Time                 `json:"issue_date"`
+	DueDate       time.Time                 `json:"due_date"`
+	Subtotal      string                    `json:"subtotal"`
+	DiscountTotal string                    `json:"discount_total"`
+	TaxTotal      string                    `json:"tax_total"`
+	Total         string                    `json:"total"`
+	LineItems     []InvoiceLineItemResponse `json:"line_items"`
+}
+
+// ClientResponse represents a client in an organization's directory
+type ClientResponse struct {
+	ID                uuid.UUID             `json:"id"`
+	Name              string                `json:"name"`
+	Email             string                `json:"email"`
+	CCEmails          []string              `json:"cc_emails"`
+	TaxID             string                `json:"tax_id,omitempty"`
+	BillingAddress    ClientAddressResponse `json:"billing_address"`
+	PreferredCurrency string                `json:"preferred_currency,omitempty"`
+	PreferredAssetID  *uuid.UUID            `json:"preferred_asset_id,omitempty"`
+	PaymentTermsDays  int                   `json:"payment_terms_days"`
+	Notes             string                `json:"notes,omitempty"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+}
+
+// ClientAddressResponse represents where a client is billed
+type ClientAddressResponse struct {
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city,omitempty"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country,omitempty"`
+}
+
+// ClientImportResponse represents the outcome of a client CSV import
+type ClientImportResponse struct {
+	CreatedCount int                         `json:"created_count"`
+	SkippedCount int                         `json:"skipped_count"`
+	Created      []ClientResponse            `json:"created"`
+	Skipped      []ClientImportIssueResponse `json:"skipped"`
+}
+
+// ClientImportIssueResponse represents a CSV row that was not imported. Row
+// counts the header as row 1.
+type ClientImportIssueResponse struct {
+	Row              int        `json:"row"`
+	Reason           string     `json:"reason"`
+	ExistingClientID *uuid.UUID `json:"existing_client_id,omitempty"`
+}
+
+// CreditNoteResponse represents a credit note issued against an invoice
+type CreditNoteResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Number        string     `json:"number"`
+	InvoiceID     uuid.UUID  `json:"invoice_id"`
+	Currency      string     `json:"currency"`
+	Amount        string     `json:"amount"`
+	TaxAmount     string     `json:"tax_amount"`
+	Reason        string     `json:"reason"`
+	LedgerEntryID uuid.UUID  `json:"ledger_entry_id"`
+	IssuedAt      time.Time  `json:"issued_at"`
+	CreatedBy     *uuid.UUID `json:"created_by,omitempty"`
+}
+
+// IssuedCreditNoteResponse represents a new credit note and the invoice it
+// was applied to
+type IssuedCreditNoteResponse struct {
+	CreditNote CreditNoteResponse `json:"credit_note"`
+	Invoice    InvoiceResponse    `json:"invoice"`
+}
+
+// ContractorContractResponse represents the hourly rate a contractor's time
+// on a project is billed at
+type ContractorContractResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	ContractorID uuid.UUID  `json:"contractor_id"`
+	Email        string     `json:"email"`
+	FirstName    string     `json:"first_name"`
+	LastName     string     `json:"last_name"`
+	ClientID     *uuid.UUID `json:"client_id,omitempty"`
+	ClientName   string     `json:"client_name,omitempty"`
+	Project      string     `json:"project"`
+	HourlyRate   string     `json:"hourly_rate"`
+	Currency     string     `json:"currency"`
+	Active       bool       `json:"active"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TimesheetResponse represents a contractor's week on a contract. Entries are
+// only included when a single timesheet is returned.
+type TimesheetResponse struct {
+	ID              uuid.UUID                `json:"id"`
+	ContractID      uuid.UUID                `json:"contract_id"`
+	ContractorID    uuid.UUID                `json:"contractor_id"`
+	Email           string                   `json:"email"`
+	FirstName       string                   `json:"first_name"`
+	LastName        string                   `json:"last_name"`
+	Project         string                   `json:"project"`
+	WeekStart       time.Time                `json:"week_start"`
+	WeekEnd         time.Time                `json:"week_end"`
+	Status          string                   `json:"status"`
+	TotalHours      string                   `json:"total_hours"`
+	Entries         []TimesheetEntryResponse `json:"entries,omitempty"`
+	SubmittedAt     *time.Time               `json:"submitted_at,omitempty"`
+	ReviewedBy      *uuid.UUID               `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time               `json:"reviewed_at,omitempty"`
+	RejectionReason string                   `json:"rejection_reason,omitempty"`
+	InvoiceID       *uuid.UUID               `json:"invoice_id,omitempty"`
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+}
+
+// TimesheetEntryResponse represents time worked on one day
+type TimesheetEntryResponse struct {
+	WorkDate    time.Time `json:"work_date"`
+	Hours       string    `json:"hours"`
+	Description string    `json:"description,omitempty"`
+}
@@ -0,0 +1,38 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationResponse represents an organization's profile. Role is the
+// caller's role and is only set when listing the caller's organizations.
+type OrganizationResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Address      string    `json:"address"`
+	City         string    `json:"city"`
+	PostalCode   string    `json:"postal_code"`
+	Country      string    `json:"country"`
+	Website      *string   `json:"website,omitempty"`
+	Size         *string   `json:"size,omitempty"`
+	Industry     *string   `json:"industry,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Headquarters *string   `json:"headquarters,omitempty"`
+	LogoURL      *string   `json:"logo_url,omitempty"`
+	TaxID        string    `json:"tax_id,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// OrganizationMemberResponse represents a member of an organization
+type OrganizationMemberResponse struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
@@ -0,0 +1,21 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayoutAddressResponse represents a payout address allowlist entry
+type PayoutAddressResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	WalletID    uuid.UUID  `json:"wallet_id"`
+	Address     string     `json:"address"`
+	Chain       string     `json:"chain"`
+	Status      string     `json:"status"`
+	Usable      bool       `json:"usable"`
+	AvailableAt time.Time  `json:"available_at"`
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
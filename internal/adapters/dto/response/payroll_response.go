@@ -0,0 +1,169 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayrollScheduleResponse represents a payroll schedule
+type PayrollScheduleResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	Frequency      string     `json:"frequency"`
+	CronExpression string     `json:"cron_expression,omitempty"`
+	Timezone       string     `json:"timezone"`
+	FirstPayDate   time.Time  `json:"first_pay_date"`
+	NextRunAt      time.Time  `json:"next_run_at"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	Active         bool       `json:"active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// CompensationResponse represents what an employee is paid on each date of a schedule
+type CompensationResponse struct {
+	ID                uuid.UUID `json:"id"`
+	ScheduleID        uuid.UUID `json:"schedule_id"`
+	UserID            uuid.UUID `json:"user_id"`
+	Email             string    `json:"email"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	Amount            string    `json:"amount"`
+	Currency          string    `json:"currency"`
+	PayoutAssetID     uuid.UUID `json:"payout_asset_id"`
+	PayoutAssetSymbol string    `json:"payout_asset_symbol"`
+	WalletAddress     string    `json:"wallet_address"`
+	TaxCountry        string    `json:"tax_country,omitempty"`
+	TaxID             string    `json:"tax_id,omitempty"`
+	Active            bool      `json:"active"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// AmountResponse represents a decimal amount in a currency
+type AmountResponse struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// PayRunResponse represents a pay run. Line items and totals are only included
+// when a single pay run is retrieved.
+type PayRunResponse struct {
+	ID          uuid.UUID                `json:"id"`
+	ScheduleID  uuid.UUID                `json:"schedule_id"`
+	PayDate     time.Time                `json:"pay_date"`
+	PeriodStart time.Time                `json:"period_start"`
+	Status      string                   `json:"status"`
+	Totals      []AmountResponse         `json:"totals,omitempty"`
+	LineItems   []PayRunLineItemResponse `json:"line_items,omitempty"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// PayRunLineItemResponse represents one employee's pay in a pay run
+type PayRunLineItemResponse struct {
+	ID                uuid.UUID `json:"id"`
+	UserID            uuid.UUID `json:"user_id"`
+	Email             string    `json:"email"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	Amount            string    `json:"amount"`
+	Currency          string    `json:"currency"`
+	PayoutAssetID     uuid.UUID `json:"payout_asset_id"`
+	PayoutAssetSymbol string    `json:"payout_asset_symbol"`
+	WalletAddress     string    `json:"wallet_address"`
+	TaxCountry        string    `json:"tax_country,omitempty"`
+	TaxID             string    `json:"tax_id,omitempty"`
+	// LockedPayout is the amount of the payout asset paid, fixed when the pay run is submitted
+	LockedPayout string     `json:"locked_payout,omitempty"`
+	FXQuoteID    *uuid.UUID `json:"fx_quote_id,omitempty"`
+}
+
+// PayslipResponse represents one employee's pay in a pay run. Gross is what is
+// paid; any tax the payee charges is broken out of it, leaving net.
+type PayslipResponse struct {
+	PayRunID          uuid.UUID              `json:"pay_run_id"`
+	OrganizationName  string                 `json:"organization_name"`
+	UserID            uuid.UUID              `json:"user_id"`
+	Email             string                 `json:"email"`
+	FirstName         string                 `json:"first_name"`
+	LastName          string                 `json:"last_name"`
+	PayDate           time.Time              `json:"pay_date"`
+	PeriodStart       time.Time              `json:"period_start"`
+	Status            string                 `json:"status"`
+	Currency          string                 `json:"currency"`
+	Gross             string                 `json:"gross"`
+	Net               string                 `json:"net"`
+	TaxTreatment      string                 `json:"tax_treatment,omitempty"`
+	TaxNote           string                 `json:"tax_note,omitempty"`
+	TaxRatesVersion   string                 `json:"tax_rates_version,omitempty"`
+	TaxBreakdown      []TaxBreakdownResponse `json:"tax_breakdown,omitempty"`
+	PayoutAssetSymbol string                 `json:"payout_asset_symbol"`
+	WalletAddress     string                 `json:"wallet_address"`
+}
+
+// PayRunSimulationResponse represents what a pay run would pay out now, with
+// the changes against the previous pay run of the schedule
+type PayRunSimulationResponse struct {
+	ScheduleID       uuid.UUID                   `json:"schedule_id"`
+	PayRunID         *uuid.UUID                  `json:"pay_run_id,omitempty"`
+	PayDate          time.Time                   `json:"pay_date"`
+	PeriodStart      time.Time                   `json:"period_start"`
+	Totals           []AmountResponse            `json:"totals"`
+	LineItems        []SimulatedLineItemResponse `json:"line_items"`
+	Funding          []SimulatedFundingResponse  `json:"funding"`
+	PreviousPayRunID *uuid.UUID                  `json:"previous_pay_run_id,omitempty"`
+	PreviousTotals   []AmountResponse            `json:"previous_totals,omitempty"`
+	Changes          []PayRunLineChangeResponse  `json:"changes"`
+	HasAnomalies     bool                        `json:"has_anomalies"`
+	Warnings         []string                    `json:"warnings,omitempty"`
+	SimulatedAt      time.Time                   `json:"simulated_at"`
+}
+
+// SimulatedLineItemResponse represents one employee's pay converted into the
+// payout asset. The payout fields are left out when no rate was available.
+type SimulatedLineItemResponse struct {
+	UserID            uuid.UUID       `json:"user_id"`
+	Email             string          `json:"email"`
+	FirstName         string          `json:"first_name"`
+	LastName          string          `json:"last_name"`
+	Amount            AmountResponse  `json:"amount"`
+	PayoutAssetID     uuid.UUID       `json:"payout_asset_id"`
+	PayoutAssetSymbol string          `json:"payout_asset_symbol"`
+	WalletAddress     string          `json:"wallet_address"`
+	FXRate            string          `json:"fx_rate,omitempty"`
+	PayoutAmount      *AmountResponse `json:"payout_amount,omitempty"`
+	Fee               *AmountResponse `json:"fee,omitempty"`
+}
+
+// SimulatedFundingResponse represents what the treasury needs in one payout
+// asset and the estimated gas of disbursing it
+type SimulatedFundingResponse struct {
+	AssetID         uuid.UUID       `json:"asset_id"`
+	Symbol          string          `json:"symbol"`
+	Chain           string          `json:"chain"`
+	PayoutAmount    AmountResponse  `json:"payout_amount"`
+	FeeAmount       AmountResponse  `json:"fee_amount"`
+	TotalAmount     AmountResponse  `json:"total_amount"`
+	Transfers       int             `json:"transfers"`
+	Batches         int             `json:"batches"`
+	EstimatedGas    *uint64         `json:"estimated_gas,omitempty"`
+	EstimatedGasFee *AmountResponse `json:"estimated_gas_fee,omitempty"`
+}
+
+// PayRunLineChangeResponse represents an employee whose pay differs from the previous pay run
+type PayRunLineChangeResponse struct {
+	Kind               string          `json:"kind"`
+	UserID             uuid.UUID       `json:"user_id"`
+	Email              string          `json:"email"`
+	FirstName          string          `json:"first_name"`
+	LastName           string          `json:"last_name"`
+	PreviousAmount     *AmountResponse `json:"previous_amount,omitempty"`
+	Amount             *AmountResponse `json:"amount,omitempty"`
+	PercentChange      string          `json:"percent_change,omitempty"`
+	CurrencyChanged    bool            `json:"currency_changed"`
+	PayoutAssetChanged bool            `json:"payout_asset_changed"`
+	WalletChanged      bool            `json:"wallet_changed"`
+	Anomalous          bool            `json:"anomalous"`
+}
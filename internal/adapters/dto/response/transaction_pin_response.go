@@ -0,0 +1,10 @@
+package response
+
+import "time"
+
+// TransactionPINStatusResponse describes whether a transaction PIN is set and locked
+type TransactionPINStatusResponse struct {
+	IsSet       bool       `json:"is_set"`
+	Locked      bool       `json:"locked"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
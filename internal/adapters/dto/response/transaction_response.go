@@ -0,0 +1,23 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionResponse represents an on-chain transaction. Token, addresses and
+// amount are only set for inbound transfers; amount is in token base units.
+type TransactionResponse struct {
+	ID           uuid.UUID `json:"id"`
+	TxHash       string    `json:"tx_hash"`
+	Status       string    `json:"status"`
+	Direction    string    `json:"direction"`
+	TokenAddress string    `json:"token_address,omitempty"`
+	FromAddress  string    `json:"from_address,omitempty"`
+	ToAddress    string    `json:"to_address,omitempty"`
+	Amount       string    `json:"amount,omitempty"`
+	BlockNumber  *int64    `json:"block_number,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
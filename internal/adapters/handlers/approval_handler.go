@@ -0,0 +1,483 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/gin-gonic/gin"
+)
+
+type ApprovalHandler struct {
+	approvalService ports.ApprovalService
+	logger          logging.Logger
+}
+
+// NewApprovalHandler creates a new pay run approval handler
+func NewApprovalHandler(approvalService ports.ApprovalService, logger logging.Logger) *ApprovalHandler {
+	return &ApprovalHandler{
+		approvalService: approvalService,
+		logger:          logger,
+	}
+}
+
+// CreatePolicy godoc
+// @Summary Create an approval policy
+// @Description Require N of the listed approvers to sign off on pay runs whose total or largest payout reaches a threshold (owners and admins)
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.ApprovalPolicyRequest true "Policy settings"
+// @Success 201 {object} response.SuccessResponse{data=response.ApprovalPolicyResponse} "Policy created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/approval-policies [post]
+func (h *ApprovalHandler) CreatePolicy(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.ApprovalPolicyRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	policy, ok := mapApprovalPolicyRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	created, err := h.approvalService.CreatePolicy(ctx, userID, orgID, policy)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create approval policy")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Approval policy created",
+		Data:    mapApprovalPolicyToResponse(*created),
+	})
+}
+
+// ListPolicies godoc
+// @Summary List approval policies
+// @Description List the organization's pay run approval policies (owners, admins and finance)
+// @Tags approvals
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.ApprovalPolicyResponse} "Policies"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/approval-policies [get]
+func (h *ApprovalHandler) ListPolicies(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	policies, err := h.approvalService.ListPolicies(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve approval policies")
+		return
+	}
+
+	policyResponses := make([]response.ApprovalPolicyResponse, len(policies))
+	for i, policy := range policies {
+		policyResponses[i] = mapApprovalPolicyToResponse(policy)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Approval policies retrieved",
+		Data:    policyResponses,
+	})
+}
+
+// UpdatePolicy godoc
+// @Summary Update an approval policy
+// @Description Replace a policy's settings; pay runs already submitted keep the approvers they were submitted with (owners and admins)
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param policy_id path string true "Policy ID"
+// @Param request body request.ApprovalPolicyRequest true "Policy settings"
+// @Success 200 {object} response.SuccessResponse{data=response.ApprovalPolicyResponse} "Policy updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or policy not found"
+// @Router /organizations/{id}/approval-policies/{policy_id} [put]
+func (h *ApprovalHandler) UpdatePolicy(ctx *gin.Context) {
+	userID, orgID, policyID, ok := parseOrganizationResourcePath(ctx, "policy_id")
+	if !ok {
+		return
+	}
+
+	var req request.ApprovalPolicyRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	policy, ok := mapApprovalPolicyRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	updated, err := h.approvalService.UpdatePolicy(ctx, userID, orgID, policyID, policy)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update approval policy")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Approval policy updated",
+		Data:    mapApprovalPolicyToResponse(*updated),
+	})
+}
+
+// SubmitPayRun godoc
+// @Summary Submit a pay run for approval
+// @Description Send a draft pay run to the approvers of the strictest policy that applies to it; it is approved straight away when none applies (owners, admins and finance). Each payout is converted into its asset and locked on the run, with the quotes given or at the current rate for pairs they do not cover. Requires the transaction PIN.
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param X-Transaction-PIN header string true "Transaction PIN"
+// @Param id path string true "Organization ID"
+// @Param run_id path string true "Pay run ID"
+// @Param request body request.SubmitPayRunRequest false "Optional locked quotes"
+// @Success 200 {object} response.SuccessResponse{data=response.SubmitPayRunResponse} "Pay run submitted"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Transaction PIN required or role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or pay run not found"
+// @Failure 409 {object} response.ErrorResponse "Pay run is not a draft"
+// @Router /organizations/{id}/payroll/runs/{run_id}/submit [post]
+func (h *ApprovalHandler) SubmitPayRun(ctx *gin.Context) {
+	userID, orgID, runID, ok := parseOrganizationResourcePath(ctx, "run_id")
+	if !ok {
+		return
+	}
+
+	var req request.SubmitPayRunRequest
+	// Quotes are optional, so an empty body is fine
+	if ctx.Request.ContentLength > 0 && !bindJSON(ctx, &req) {
+		return
+	}
+
+	run, approvalRequest, err := h.approvalService.SubmitPayRun(ctx, userID, orgID, runID, req.FXQuoteIDs)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to submit pay run")
+		return
+	}
+
+	submitResponse := response.SubmitPayRunResponse{
+		PayRun: mapPayRunToResponse(*run),
+	}
+	message := "Pay run approved"
+	if approvalRequest != nil {
+		requestResponse := mapApprovalRequestToResponse(*approvalRequest)
+		submitResponse.ApprovalRequest = &requestResponse
+		message = "Pay run submitted for approval"
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: message,
+		Data:    submitResponse,
+	})
+}
+
+// ListRequests godoc
+// @Summary List approval requests
+// @Description List the organization's pay run approval requests, newest first (owners, admins and finance)
+// @Tags approvals
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param status query string false "Only requests in this status (pending, approved, rejected, expired)"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.ApprovalRequestResponse} "Paginated list of approval requests"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/approvals [get]
+func (h *ApprovalHandler) ListRequests(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var status *domain.ApprovalStatus
+	if value := ctx.Query("status"); value != "" {
+		s := domain.ApprovalStatus(value)
+		status = &s
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	requests, total, err := h.approvalService.ListRequests(ctx, userID, orgID, status, page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve approval requests")
+		return
+	}
+
+	requestResponses := make([]response.ApprovalRequestResponse, len(requests))
+	for i, approvalRequest := range requests {
+		requestResponses[i] = mapApprovalRequestToResponse(approvalRequest)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, requestResponses))
+}
+
+// GetRequest godoc
+// @Summary Get an approval request
+// @Description Get a pay run approval request with every decision on it (owners, admins and finance)
+// @Tags approvals
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param approval_id path string true "Approval request ID"
+// @Success 200 {object} response.SuccessResponse{data=response.ApprovalRequestResponse} "Approval request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or approval request not found"
+// @Router /organizations/{id}/approvals/{approval_id} [get]
+func (h *ApprovalHandler) GetRequest(ctx *gin.Context) {
+	userID, orgID, requestID, ok := parseOrganizationResourcePath(ctx, "approval_id")
+	if !ok {
+		return
+	}
+
+	approvalRequest, err := h.approvalService.GetRequest(ctx, userID, orgID, requestID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve approval request")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Approval request retrieved",
+		Data:    mapApprovalRequestToResponse(*approvalRequest),
+	})
+}
+
+// Approve godoc
+// @Summary Approve a pay run
+// @Description Sign off on a pending pay run as one of its approvers; it is approved once enough approvers have signed off. Requires MFA and the transaction PIN.
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param X-MFA-Token header string true "MFA token"
+// @Param X-Transaction-PIN header string true "Transaction PIN"
+// @Param id path string true "Organization ID"
+// @Param approval_id path string true "Approval request ID"
+// @Param request body request.ApprovePayRunRequest false "Optional comment"
+// @Success 200 {object} response.SuccessResponse{data=response.ApprovalRequestResponse} "Approval recorded"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "MFA or transaction PIN required, or not an approver"
+// @Failure 404 {object} response.ErrorResponse "Organization or approval request not found"
+// @Failure 409 {object} response.ErrorResponse "Request no longer pending or already decided"
+// @Router /organizations/{id}/approvals/{approval_id}/approve [post]
+func (h *ApprovalHandler) Approve(ctx *gin.Context) {
+	userID, orgID, requestID, ok := parseOrganizationResourcePath(ctx, "approval_id")
+	if !ok {
+		return
+	}
+
+	var req request.ApprovePayRunRequest
+	// The comment is optional, so an empty body is fine
+	if ctx.Request.ContentLength > 0 && !bindJSON(ctx, &req) {
+		return
+	}
+
+	approvalRequest, err := h.approvalService.Approve(ctx, userID, orgID, requestID, req.Comment)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to approve pay run")
+		return
+	}
+
+	message := "Approval recorded"
+	if approvalRequest.Status == domain.ApprovalStatusApproved {
+		message = "Pay run approved"
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: message,
+		Data:    mapApprovalRequestToResponse(*approvalRequest),
+	})
+}
+
+// Reject godoc
+// @Summary Reject a pay run
+// @Description Reject a pending pay run as one of its approvers; it returns to draft. Requires MFA.
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param X-MFA-Token header string true "MFA token"
+// @Param id path string true "Organization ID"
+// @Param approval_id path string true "Approval request ID"
+// @Param request body request.RejectPayRunRequest true "Reason"
+// @Success 200 {object} response.SuccessResponse{data=response.ApprovalRequestResponse} "Pay run rejected"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "MFA required or not an approver"
+// @Failure 404 {object} response.ErrorResponse "Organization or approval request not found"
+// @Failure 409 {object} response.ErrorResponse "Request no longer pending or already decided"
+// @Router /organizations/{id}/approvals/{approval_id}/reject [post]
+func (h *ApprovalHandler) Reject(ctx *gin.Context) {
+	userID, orgID, requestID, ok := parseOrganizationResourcePath(ctx, "approval_id")
+	if !ok {
+		return
+	}
+
+	var req request.RejectPayRunRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	approvalRequest, err := h.approvalService.Reject(ctx, userID, orgID, requestID, req.Reason)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to reject pay run")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Pay run rejected",
+		Data:    mapApprovalRequestToResponse(*approvalRequest),
+	})
+}
+
+// mapApprovalPolicyRequestToDomain maps policy settings to the domain model. It
+// writes a bad request response and returns false when a threshold is malformed.
+func mapApprovalPolicyRequestToDomain(ctx *gin.Context, req request.ApprovalPolicyRequest) (domain.ApprovalPolicy, bool) {
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	policy := domain.ApprovalPolicy{
+		Name:                req.Name,
+		Currency:            req.Currency,
+		RequiredApprovals:   req.RequiredApprovals,
+		ApproverIDs:         req.ApproverIDs,
+		ApprovalWindowHours: req.ApprovalWindowHours,
+		Active:              active,
+	}
+
+	if req.MinTotalAmount != "" {
+		amount, ok := parseAmount(ctx, req.MinTotalAmount, req.Currency)
+		if !ok {
+			return domain.ApprovalPolicy{}, false
+		}
+		policy.MinTotalAmount = &amount
+	}
+	if req.MinPayoutAmount != "" {
+		amount, ok := parseAmount(ctx, req.MinPayoutAmount, req.Currency)
+		if !ok {
+			return domain.ApprovalPolicy{}, false
+		}
+		policy.MinPayoutAmount = &amount
+	}
+
+	return policy, true
+}
+
+// mapApprovalPolicyToResponse maps a domain approval policy to its response DTO
+func mapApprovalPolicyToResponse(policy domain.ApprovalPolicy) response.ApprovalPolicyResponse {
+	return response.ApprovalPolicyResponse{
+		ID:                  policy.ID,
+		Name:                policy.Name,
+		Currency:            policy.Currency,
+		MinTotalAmount:      mapOptionalAmountToResponse(policy.MinTotalAmount),
+		MinPayoutAmount:     mapOptionalAmountToResponse(policy.MinPayoutAmount),
+		RequiredApprovals:   policy.RequiredApprovals,
+		ApproverIDs:         policy.ApproverIDs,
+		ApprovalWindowHours: policy.ApprovalWindowHours,
+		Active:              policy.Active,
+		CreatedAt:           policy.CreatedAt,
+		UpdatedAt:           policy.UpdatedAt,
+	}
+}
+
+// mapApprovalRequestToResponse maps a domain approval request, and its decisions when loaded, to its response DTO
+func mapApprovalRequestToResponse(approvalRequest domain.ApprovalRequest) response.ApprovalRequestResponse {
+	requestResponse := response.ApprovalRequestResponse{
+		ID:                approvalRequest.ID,
+		PayRunID:          approvalRequest.PayRunID,
+		PolicyID:          approvalRequest.PolicyID,
+		Status:            string(approvalRequest.Status),
+		RequiredApprovals: approvalRequest.RequiredApprovals,
+		ApproverIDs:       approvalRequest.ApproverIDs,
+		RequestedBy:       approvalRequest.RequestedBy,
+		ExpiresAt:         approvalRequest.ExpiresAt,
+		DecidedAt:         approvalRequest.DecidedAt,
+		CreatedAt:         approvalRequest.CreatedAt,
+	}
+
+	// Decisions are only loaded for a single request, so there is nothing to count in lists
+	if approvalRequest.Decisions != nil {
+		approvals := approvalRequest.Approvals()
+		requestResponse.Approvals = &approvals
+	}
+
+	for _, decision := range approvalRequest.Decisions {
+		requestResponse.Decisions = append(requestResponse.Decisions, response.ApprovalDecisionResponse{
+			ApproverID: decision.ApproverID,
+			Email:      decision.Email,
+			FirstName:  decision.FirstName,
+			LastName:   decision.LastName,
+			Decision:   string(decision.Decision),
+			Comment:    decision.Comment,
+			CreatedAt:  decision.CreatedAt,
+		})
+	}
+
+	return requestResponse
+}
+
+// mapOptionalAmountToResponse maps an optional amount to its response DTO
+func mapOptionalAmountToResponse(amount *money.Money) *response.AmountResponse {
+	if amount == nil {
+		return nil
+	}
+	amountResponse := mapAmountToResponse(*amount)
+	return &amountResponse
+}
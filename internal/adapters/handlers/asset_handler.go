@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type AssetHandler struct {
+	assetService ports.AssetService
+	logger       logging.Logger
+}
+
+// NewAssetHandler creates a new supported assets handler
+func NewAssetHandler(assetService ports.AssetService, logger logging.Logger) *AssetHandler {
+	return &AssetHandler{
+		assetService: assetService,
+		logger:       logger,
+	}
+}
+
+// ListAssets godoc
+// @Summary List supported assets
+// @Description List the assets currently enabled for payments, optionally on one chain
+// @Tags assets
+// @Produce json
+// @Security Bearer
+// @Param chain query string false "Filter by chain (e.g. ethereum, base, polygon)"
+// @Success 200 {object} response.SuccessResponse{data=[]response.AssetResponse} "Supported assets"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /assets [get]
+func (h *AssetHandler) ListAssets(ctx *gin.Context) {
+	h.listAssets(ctx, domain.SupportedAssetFilter{
+		Chain:       ctx.Query("chain"),
+		EnabledOnly: true,
+	})
+}
+
+// AdminListAssets godoc
+// @Summary List all registered assets
+// @Description List every registered asset, including disabled ones (admin only)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param chain query string false "Filter by chain"
+// @Success 200 {object} response.SuccessResponse{data=[]response.AssetResponse} "Registered assets"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Access denied"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /admin/assets [get]
+func (h *AssetHandler) AdminListAssets(ctx *gin.Context) {
+	h.listAssets(ctx, domain.SupportedAssetFilter{
+		Chain: ctx.Query("chain"),
+	})
+}
+
+func (h *AssetHandler) listAssets(ctx *gin.Context, filter domain.SupportedAssetFilter) {
+	assets, err := h.assetService.ListAssets(ctx, filter)
+	if err != nil {
+		h.logger.Error("Failed to list supported assets", err, map[string]interface{}{
+			"chain": filter.Chain,
+		})
+		respondWithError(ctx, err, "Failed to retrieve assets")
+		return
+	}
+
+	assetResponses := make([]response.AssetResponse, len(assets))
+	for i, asset := range assets {
+		assetResponses[i] = mapAssetToResponse(asset)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Assets retrieved",
+		Data:    assetResponses,
+	})
+}
+
+// CreateAsset godoc
+// @Summary Register an asset
+// @Description Register a token or native coin on a chain (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.CreateAssetRequest true "Asset details"
+// @Success 201 {object} response.SuccessResponse{data=response.AssetResponse} "Asset registered"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Access denied"
+// @Failure 409 {object} response.ErrorResponse "Asset already registered"
+// @Router /admin/assets [post]
+func (h *AssetHandler) CreateAsset(ctx *gin.Context) {
+	var req request.CreateAssetRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	asset, err := h.assetService.CreateAsset(ctx, domain.SupportedAsset{
+		Chain:           req.Chain,
+		Symbol:          req.Symbol,
+		Name:            req.Name,
+		ContractAddress: req.ContractAddress,
+		Decimals:        *req.Decimals,
+		Enabled:         req.Enabled,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to register asset")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Asset registered",
+		Data:    mapAssetToResponse(*asset),
+	})
+}
+
+// UpdateAsset godoc
+// @Summary Update an asset
+// @Description Rename a registered asset or enable/disable it (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Asset ID"
+// @Param request body request.UpdateAssetRequest true "Fields to update"
+// @Success 200 {object} response.SuccessResponse{data=response.AssetResponse} "Asset updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Access denied"
+// @Failure 404 {object} response.ErrorResponse "Asset not found"
+// @Router /admin/assets/{id} [patch]
+func (h *AssetHandler) UpdateAsset(ctx *gin.Context) {
+	id, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateAssetRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	asset, err := h.assetService.UpdateAsset(ctx, id, req.Name, req.Enabled)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update asset")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Asset updated",
+		Data:    mapAssetToResponse(*asset),
+	})
+}
+
+// mapAssetToResponse maps a domain supported asset to its response DTO
+func mapAssetToResponse(asset domain.SupportedAsset) response.AssetResponse {
+	return response.AssetResponse{
+		ID:              asset.ID,
+		Chain:           asset.Chain,
+		Symbol:          asset.Symbol,
+		Name:            asset.Name,
+		ContractAddress: asset.ContractAddress,
+		Decimals:        asset.Decimals,
+		Enabled:         asset.Enabled,
+		CreatedAt:       asset.CreatedAt,
+		UpdatedAt:       asset.UpdatedAt,
+	}
+}
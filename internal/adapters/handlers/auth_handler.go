@@ -832,27 +832,28 @@ func (h *AuthHandler) UpdateBusinessDetails(ctx *gin.Context) {
 		return
 	}
 
-	// Create user domain object
+	// Company details are saved to the organization the user sets up
 	companyWebsite := req.CompanyWebsite
 	employmentType := req.EmploymentType
-	userDetails := domain.User{
-		ID:                user.UserID,
-		CompanyName:       req.CompanyName,
-		CompanyAddress:    req.CompanyAddress,
-		CompanyCity:       req.CompanyCity,
-		CompanyPostalCode: req.CompanyPostalCode,
-		CompanyCountry:    req.CompanyCountry,
+	profile := domain.Organization{
+		Name:       req.CompanyName,
+		Address:    req.CompanyAddress,
+		City:       req.CompanyCity,
+		PostalCode: req.CompanyPostalCode,
+		Country:    req.CompanyCountry,
 	}
 
 	if companyWebsite != "" {
-		userDetails.CompanyWebsite = &companyWebsite
+		profile.Website = &companyWebsite
 	}
+
+	var employment *string
 	if employmentType != "" {
-		userDetails.EmploymentType = &employmentType
+		employment = &employmentType
 	}
 
 	// Update user
-	updatedUser, err := h.authService.RegisterBusinessDetails(ctx, userDetails)
+	updatedUser, err := h.authService.RegisterBusinessDetails(ctx, user.UserID, profile, employment)
 	if err != nil {
 		reqLogger.Error("Failed to update business details", err, map[string]interface{}{
 			"user_id": user.UserID,
@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// maxClientImportBytes caps the size of an uploaded client CSV file
+const maxClientImportBytes = 1 << 20
+
+type ClientHandler struct {
+	clientService ports.ClientService
+	logger        logging.Logger
+}
+
+// NewClientHandler creates a new client directory handler
+func NewClientHandler(clientService ports.ClientService, logger logging.Logger) *ClientHandler {
+	return &ClientHandler{
+		clientService: clientService,
+		logger:        logger,
+	}
+}
+
+// CreateClient godoc
+// @Summary Add a client
+// @Description Add a client to the organization's directory. Invoices raised for it take its details, currency, payment asset and payment terms as defaults. Emails are lowercased and tax IDs stripped of spaces and punctuation; one already used by another client is a conflict. (owners, admins and finance)
+// @Tags clients
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.ClientRequest true "Client details"
+// @Success 201 {object} response.SuccessResponse{data=response.ClientResponse} "Client added"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Failure 409 {object} response.ErrorResponse "A client with the same email or tax ID exists"
+// @Router /organizations/{id}/clients [post]
+func (h *ClientHandler) CreateClient(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.ClientRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	client, err := h.clientService.CreateClient(ctx, userID, orgID, mapClientRequestToDomain(req))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to add client")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Client added",
+		Data:    mapClientToResponse(*client),
+	})
+}
+
+// ListClients godoc
+// @Summary List clients
+// @Description List the organization's clients by name, optionally only those whose name, email or tax ID contains the search text (any member)
+// @Tags clients
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param search query string false "Text to look for in the name, email or tax ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.ClientResponse} "Paginated list of clients"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/clients [get]
+func (h *ClientHandler) ListClients(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	clients, total, err := h.clientService.ListClients(ctx, userID, orgID, ctx.Query("search"), page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve clients")
+		return
+	}
+
+	clientResponses := make([]response.ClientResponse, len(clients))
+	for i, client := range clients {
+		clientResponses[i] = mapClientToResponse(client)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, clientResponses))
+}
+
+// GetClient godoc
+// @Summary Get a client
+// @Description Get a client from the organization's directory (any member)
+// @Tags clients
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param client_id path string true "Client ID"
+// @Success 200 {object} response.SuccessResponse{data=response.ClientResponse} "Client"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or client not found"
+// @Router /organizations/{id}/clients/{client_id} [get]
+func (h *ClientHandler) GetClient(ctx *gin.Context) {
+	userID, orgID, clientID, ok := parseOrganizationResourcePath(ctx, "client_id")
+	if !ok {
+		return
+	}
+
+	client, err := h.clientService.GetClient(ctx, userID, orgID, clientID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to get client")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Client retrieved",
+		Data:    mapClientToResponse(*client),
+	})
+}
+
+// UpdateClient godoc
+// @Summary Update a client
+// @Description Replace a client's details. Invoices already raised for it keep the details they were issued with. (owners, admins and finance)
+// @Tags clients
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param client_id path string true "Client ID"
+// @Param request body request.ClientRequest true "Client details"
+// @Success 200 {object} response.SuccessResponse{data=response.ClientResponse} "Client updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or client not found"
+// @Failure 409 {object} response.ErrorResponse "Another client has the same email or tax ID"
+// @Router /organizations/{id}/clients/{client_id} [put]
+func (h *ClientHandler) UpdateClient(ctx *gin.Context) {
+	userID, orgID, clientID, ok := parseOrganizationResourcePath(ctx, "client_id")
+	if !ok {
+		return
+	}
+
+	var req request.ClientRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	client, err := h.clientService.UpdateClient(ctx, userID, orgID, clientID, mapClientRequestToDomain(req))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update client")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Client updated",
+		Data:    mapClientToResponse(*client),
+	})
+}
+
+// DeleteClient godoc
+// @Summary Remove a client
+// @Description Remove a client from the organization's directory. Its invoices keep the details they were issued with. (owners, admins and finance)
+// @Tags clients
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param client_id path string true "Client ID"
+// @Success 200 {object} response.SuccessResponse "Client removed"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or client not found"
+// @Router /organizations/{id}/clients/{client_id} [delete]
+func (h *ClientHandler) DeleteClient(ctx *gin.Context) {
+	userID, orgID, clientID, ok := parseOrganizationResourcePath(ctx, "client_id")
+	if !ok {
+		return
+	}
+
+	if err := h.clientService.DeleteClient(ctx, userID, orgID, clientID); err != nil {
+		respondWithError(ctx, err, "Failed to remove client")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Client removed",
+	})
+}
+
+// ImportClients godoc
+// @Summary Import clients from CSV
+// @Description Add the clients in a CSV file of at most 1 MB and 1000 rows. The header row names the columns: name and email are required; cc_emails (separated by semicolons), tax_id, address_line1, address_line2, city, region, postal_code, country, preferred_currency, payment_terms_days and notes are optional. Invalid rows and rows whose email or tax ID matches an existing client or an earlier row are skipped and reported; the rest are added. (owners, admins and finance)
+// @Tags clients
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} response.SuccessResponse{data=response.ClientImportResponse} "Clients imported"
+// @Failure 400 {object} response.ErrorResponse "Missing or malformed file"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/clients/import [post]
+func (h *ClientHandler) ImportClients(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxClientImportBytes+4096)
+	header, err := ctx.FormFile("file")
+	if err != nil || header.Size > maxClientImportBytes {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "A CSV file of at most 1 MB is required in the file field",
+		})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		respondWithError(ctx, err, "Failed to read the uploaded file")
+		return
+	}
+	defer file.Close()
+
+	result, err := h.clientService.ImportClients(ctx, userID, orgID, file)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to import clients")
+		return
+	}
+
+	importResponse := response.ClientImportResponse{
+		CreatedCount: len(result.Created),
+		SkippedCount: len(result.Skipped),
+		Created:      make([]response.ClientResponse, len(result.Created)),
+		Skipped:      make([]response.ClientImportIssueResponse, len(result.Skipped)),
+	}
+	for i, client := range result.Created {
+		importResponse.Created[i] = mapClientToResponse(client)
+	}
+	for i, issue := range result.Skipped {
+		importResponse.Skipped[i] = response.ClientImportIssueResponse{
+			Row:              issue.Row,
+			Reason:           issue.Reason,
+			ExistingClientID: issue.ExistingClientID,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Clients imported",
+		Data:    importResponse,
+	})
+}
+
+func mapClientRequestToDomain(req request.ClientRequest) domain.Client {
+	client := domain.Client{
+		Name:     req.Name,
+		Email:    req.Email,
+		CCEmails: req.CCEmails,
+		TaxID:    req.TaxID,
+		BillingAddress: domain.ClientAddress{
+			Line1:      req.BillingAddress.Line1,
+			Line2:      req.BillingAddress.Line2,
+			City:       req.BillingAddress.City,
+			Region:     req.BillingAddress.Region,
+			PostalCode: req.BillingAddress.PostalCode,
+			Country:    req.BillingAddress.Country,
+		},
+		PreferredCurrency: req.PreferredCurrency,
+		PreferredAssetID:  req.PreferredAssetID,
+		PaymentTermsDays:  domain.DefaultClientPaymentTermsDays,
+		Notes:             req.Notes,
+	}
+	if req.PaymentTermsDays != nil {
+		client.PaymentTermsDays = *req.PaymentTermsDays
+	}
+
+	return client
+}
+
+func mapClientToResponse(client domain.Client) response.ClientResponse {
+	ccEmails := client.CCEmails
+	if ccEmails == nil {
+		ccEmails = []string{}
+	}
+
+	return response.ClientResponse{
+		ID:       client.ID,
+		Name:     client.Name,
+		Email:    client.Email,
+		CCEmails: ccEmails,
+		TaxID:    client.TaxID,
+		BillingAddress: response.ClientAddressResponse{
+			Line1:      client.BillingAddress.Line1,
+			Line2:      client.BillingAddress.Line2,
+			City:       client.BillingAddress.City,
+			Region:     client.BillingAddress.Region,
+			PostalCode: client.BillingAddress.PostalCode,
+			Country:    client.BillingAddress.Country,
+		},
+		PreferredCurrency: client.PreferredCurrency,
+		PreferredAssetID:  client.PreferredAssetID,
+		PaymentTermsDays:  client.PaymentTermsDays,
+		Notes:             client.Notes,
+		CreatedAt:         client.CreatedAt,
+		UpdatedAt:         client.UpdatedAt,
+	}
+}
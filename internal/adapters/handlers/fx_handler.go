@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type FXHandler struct {
+	fxService ports.FXService
+	logger    logging.Logger
+}
+
+// NewFXHandler creates a new exchange rate handler
+func NewFXHandler(fxService ports.FXService, logger logging.Logger) *FXHandler {
+	return &FXHandler{
+		fxService: fxService,
+		logger:    logger,
+	}
+}
+
+// GetRates godoc
+// @Summary Get exchange rates
+// @Description Get the current rate from a base currency to one or more quote currencies or assets
+// @Tags fx
+// @Produce json
+// @Security Bearer
+// @Param base query string true "Base currency (e.g. USD, EUR)"
+// @Param quotes query string true "Comma separated quote currencies (e.g. NGN,USDC)"
+// @Success 200 {object} response.SuccessResponse{data=[]response.FXRateResponse} "Exchange rates"
+// @Failure 400 {object} response.ErrorResponse "Invalid request or unsupported pair"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /fx/rates [get]
+func (h *FXHandler) GetRates(ctx *gin.Context) {
+	var quotes []string
+	for _, quote := range strings.Split(ctx.Query("quotes"), ",") {
+		if quote = strings.TrimSpace(quote); quote != "" {
+			quotes = append(quotes, quote)
+		}
+	}
+
+	rates, err := h.fxService.GetRates(ctx, ctx.Query("base"), quotes)
+	if err != nil {
+		h.logger.Error("Failed to get exchange rates", err, map[string]interface{}{
+			"base":   ctx.Query("base"),
+			"quotes": quotes,
+		})
+		respondWithError(ctx, err, "Failed to retrieve exchange rates")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Exchange rates retrieved",
+		Data:    mapFXRatesToResponse(rates),
+	})
+}
+
+// GetRateHistory godoc
+// @Summary Get exchange rate history
+// @Description List the stored rates of a currency pair, newest first. Defaults to the last 30 days.
+// @Tags fx
+// @Produce json
+// @Security Bearer
+// @Param base query string true "Base currency"
+// @Param quote query string true "Quote currency"
+// @Param from query string false "Only rates fetched at or after this RFC3339 time"
+// @Param to query string false "Only rates fetched before this RFC3339 time"
+// @Success 200 {object} response.SuccessResponse{data=[]response.FXRateResponse} "Exchange rate history"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /fx/rates/history [get]
+func (h *FXHandler) GetRateHistory(ctx *gin.Context) {
+	from, ok := parseTimeQuery(ctx, "from")
+	if !ok {
+		return
+	}
+
+	to, ok := parseTimeQuery(ctx, "to")
+	if !ok {
+		return
+	}
+
+	rates, err := h.fxService.GetRateHistory(ctx, ctx.Query("base"), ctx.Query("quote"), from, to)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve exchange rate history")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Exchange rate history retrieved",
+		Data:    mapFXRatesToResponse(rates),
+	})
+}
+
+// LockQuote godoc
+// @Summary Lock an exchange rate
+// @Description Lock the current rate of a currency pair for a fixed window, so conversions made with the quote are deterministic
+// @Tags fx
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.LockFXQuoteRequest true "Currency pair"
+// @Success 201 {object} response.SuccessResponse{data=response.FXQuoteResponse} "Quote locked"
+// @Failure 400 {object} response.ErrorResponse "Invalid request or unsupported pair"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /fx/quotes [post]
+func (h *FXHandler) LockQuote(ctx *gin.Context) {
+	var req request.LockFXQuoteRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	quote, err := h.fxService.LockQuote(ctx, req.Base, req.Quote)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to lock exchange rate")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Quote locked",
+		Data:    mapFXQuoteToResponse(*quote),
+	})
+}
+
+// GetQuote godoc
+// @Summary Get a locked quote
+// @Description Get a previously locked exchange rate quote
+// @Tags fx
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Quote ID"
+// @Success 200 {object} response.SuccessResponse{data=response.FXQuoteResponse} "Quote retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Quote not found"
+// @Router /fx/quotes/{id} [get]
+func (h *FXHandler) GetQuote(ctx *gin.Context) {
+	id, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	quote, err := h.fxService.GetQuote(ctx, id)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve quote")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Quote retrieved",
+		Data:    mapFXQuoteToResponse(*quote),
+	})
+}
+
+// mapFXRatesToResponse maps domain exchange rates to their response DTOs
+func mapFXRatesToResponse(rates []domain.FXRate) []response.FXRateResponse {
+	result := make([]response.FXRateResponse, len(rates))
+	for i, rate := range rates {
+		result[i] = response.FXRateResponse{
+			Base:      rate.Base,
+			Quote:     rate.Quote,
+			Rate:      rate.Rate.String(),
+			Source:    rate.Source,
+			FetchedAt: rate.FetchedAt,
+		}
+	}
+	return result
+}
+
+// mapFXQuoteToResponse maps a domain quote to its response DTO
+func mapFXQuoteToResponse(quote domain.FXQuote) response.FXQuoteResponse {
+	return response.FXQuoteResponse{
+		ID:        quote.ID,
+		Base:      quote.Base,
+		Quote:     quote.Quote,
+		Rate:      quote.Rate.String(),
+		Source:    quote.Source,
+		ExpiresAt: quote.ExpiresAt,
+		CreatedAt: quote.CreatedAt,
+	}
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// getAuthenticatedUserID reads the user ID set by the auth middleware.
+// It writes the error response and returns false when the ID is missing or malformed.
+func getAuthenticatedUserID(ctx *gin.Context) (uuid.UUID, bool) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, response.ErrorResponse{
+			Success: false,
+			Message: "Unauthorized",
+		})
+		return uuid.Nil, false
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid user ID",
+		})
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// parseUUIDParam parses a UUID path parameter and writes a bad request response on failure
+func parseUUIDParam(ctx *gin.Context, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(ctx.Param(name))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid " + name,
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// respondWithError maps an application error to its HTTP status, falling back
+// to a 500 with the given message for unexpected errors
+func respondWithError(ctx *gin.Context, err error, fallbackMessage string) {
+	var appErr *appErrors.AppError
+	if errors.As(err, &appErr) {
+		ctx.JSON(appErr.StatusCode(), response.ErrorResponse{
+			Success: false,
+			Message: appErr.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		Success: false,
+		Message: fallbackMessage,
+	})
+}
+
+// parsePagination reads the page and page_size query parameters, defaulting to
+// page 1 of 10 and capping the page size at 100
+func parsePagination(ctx *gin.Context) (int, int) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	return page, pageSize
+}
+
+// newPageResponse builds a paginated response from a page of items and the total count
+func newPageResponse(page, pageSize int, total int64, items interface{}) response.PageResponse {
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return response.PageResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+		Items:      items,
+	}
+}
+
+// parseTimeQuery parses an optional RFC3339 query parameter. It writes a bad
+// request response and returns false when the value is malformed.
+func parseTimeQuery(ctx *gin.Context, name string) (*time.Time, bool) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid " + name + ", expected an RFC3339 time",
+		})
+		return nil, false
+	}
+
+	return &t, true
+}
+
+// parseUUIDQuery parses an optional UUID query parameter. It writes a bad
+// request response and returns false when the value is malformed.
+func parseUUIDQuery(ctx *gin.Context, name string) (*uuid.UUID, bool) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid " + name,
+		})
+		return nil, false
+	}
+
+	return &id, true
+}
+
+// bindJSON binds the request body and writes a bad request response on failure
+func bindJSON(ctx *gin.Context, req interface{}) bool {
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid request format: " + err.Error(),
+		})
+		return false
+	}
+
+	return true
+}
+
+// parseOrganizationResourcePath reads the caller, the organization ID and the
+// ID of a resource nested under it, writing the error response on failure
+func parseOrganizationResourcePath(ctx *gin.Context, resourceParam string) (uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	resourceID, ok := parseUUIDParam(ctx, resourceParam)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, orgID, resourceID, true
+}
+
+// parseAmount parses a decimal amount string and writes a bad request response on failure
+func parseAmount(ctx *gin.Context, amount, currency string) (money.Money, bool) {
+	parsed, err := money.Parse(amount, currency)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid amount: " + amount,
+		})
+		return money.Money{}, false
+	}
+
+	return parsed, true
+}
+
+// parseDecimal parses a decimal string field, treating an empty string as zero,
+// and writes a bad request response on failure
+func parseDecimal(ctx *gin.Context, field, value string) (decimal.Decimal, bool) {
+	if value == "" {
+		return decimal.Zero, true
+	}
+
+	parsed, err := decimal.NewFromString(value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Invalid " + field + ": " + value,
+		})
+		return decimal.Decimal{}, false
+	}
+
+	return parsed, true
+}
@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InvitationHandler struct {
+	invitationService ports.InvitationService
+	authService       ports.AuthService
+	logger            logging.Logger
+}
+
+// NewInvitationHandler creates a new team invitation handler
+func NewInvitationHandler(invitationService ports.InvitationService, authService ports.AuthService, logger logging.Logger) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+		authService:       authService,
+		logger:            logger,
+	}
+}
+
+// CreateInvitation godoc
+// @Summary Invite a team member
+// @Description Email a signed, expiring invitation to join the organization (owners and admins; only owners can invite owners)
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.CreateInvitationRequest true "Invitee and role"
+// @Success 201 {object} response.SuccessResponse{data=response.InvitationResponse} "Invitation sent"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Failure 409 {object} response.ErrorResponse "Already a member or already invited"
+// @Router /organizations/{id}/invitations [post]
+func (h *InvitationHandler) CreateInvitation(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.CreateInvitationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(ctx, userID, orgID, req.Email, domain.OrganizationRole(req.Role))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create invitation")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Invitation sent",
+		Data:    mapInvitationToResponse(*invitation, time.Now()),
+	})
+}
+
+// ListInvitations godoc
+// @Summary List pending invitations
+// @Description List the organization's pending invitations, including expired ones that can be resent (owners and admins)
+// @Tags invitations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.InvitationResponse} "Invitations"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/invitations [get]
+func (h *InvitationHandler) ListInvitations(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	invitations, err := h.invitationService.ListPendingInvitations(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve invitations")
+		return
+	}
+
+	now := time.Now()
+	invitationResponses := make([]response.InvitationResponse, len(invitations))
+	for i, invitation := range invitations {
+		invitationResponses[i] = mapInvitationToResponse(invitation, now)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invitations retrieved",
+		Data:    invitationResponses,
+	})
+}
+
+// ResendInvitation godoc
+// @Summary Resend an invitation
+// @Description Email a new link with a fresh expiry; earlier links stop working (owners and admins)
+// @Tags invitations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invitation_id path string true "Invitation ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvitationResponse} "Invitation resent"
+// @Failure 400 {object} response.ErrorResponse "Resent too recently"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invitation not found"
+// @Failure 409 {object} response.ErrorResponse "Invitation no longer pending"
+// @Router /organizations/{id}/invitations/{invitation_id}/resend [post]
+func (h *InvitationHandler) ResendInvitation(ctx *gin.Context) {
+	userID, orgID, invitationID, ok := parseOrganizationResourcePath(ctx, "invitation_id")
+	if !ok {
+		return
+	}
+
+	invitation, err := h.invitationService.ResendInvitation(ctx, userID, orgID, invitationID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to resend invitation")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invitation resent",
+		Data:    mapInvitationToResponse(*invitation, time.Now()),
+	})
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke an invitation
+// @Description Cancel a pending invitation so its link stops working (owners and admins)
+// @Tags invitations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invitation_id path string true "Invitation ID"
+// @Success 200 {object} response.SuccessResponse "Invitation revoked"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invitation not found"
+// @Failure 409 {object} response.ErrorResponse "Invitation no longer pending"
+// @Router /organizations/{id}/invitations/{invitation_id} [delete]
+func (h *InvitationHandler) RevokeInvitation(ctx *gin.Context) {
+	userID, orgID, invitationID, ok := parseOrganizationResourcePath(ctx, "invitation_id")
+	if !ok {
+		return
+	}
+
+	if err := h.invitationService.RevokeInvitation(ctx, userID, orgID, invitationID); err != nil {
+		respondWithError(ctx, err, "Failed to revoke invitation")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invitation revoked",
+	})
+}
+
+// LookupInvitation godoc
+// @Summary Look up an invitation link
+// @Description Describe the invitation behind a link and whether the invitee already has an account
+// @Tags invitations
+// @Produce json
+// @Param token query string true "Invitation token"
+// @Success 200 {object} response.SuccessResponse{data=response.InvitationLookupResponse} "Invitation"
+// @Failure 400 {object} response.ErrorResponse "Invalid, expired or revoked link"
+// @Failure 404 {object} response.ErrorResponse "Invitation not found"
+// @Failure 409 {object} response.ErrorResponse "Already accepted"
+// @Router /invitations/lookup [get]
+func (h *InvitationHandler) LookupInvitation(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "token is required",
+		})
+		return
+	}
+
+	invitation, accountExists, err := h.invitationService.GetInvitationByToken(ctx, token)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to look up invitation")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invitation retrieved",
+		Data: response.InvitationLookupResponse{
+			OrganizationName: invitation.OrganizationName,
+			Email:            invitation.Email,
+			Role:             string(invitation.Role),
+			ExpiresAt:        invitation.ExpiresAt,
+			AccountExists:    accountExists,
+		},
+	})
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Join the organization as the signed-in user; the account's email must match the invitation
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.AcceptInvitationRequest true "Invitation token"
+// @Success 200 {object} response.SuccessResponse{data=response.OrganizationMemberResponse} "Invitation accepted"
+// @Failure 400 {object} response.ErrorResponse "Invalid, expired or revoked link"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Invitation sent to another email"
+// @Failure 409 {object} response.ErrorResponse "Already accepted or already a member"
+// @Router /invitations/accept [post]
+func (h *InvitationHandler) AcceptInvitation(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.AcceptInvitationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	member, err := h.invitationService.AcceptInvitation(ctx, userID, req.Token)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to accept invitation")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invitation accepted",
+		Data:    mapOrganizationMemberToResponse(*member),
+	})
+}
+
+// RegisterWithInvitation godoc
+// @Summary Sign up from an invitation
+// @Description Create an account for the invited email and join the organization in one step
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param request body request.RegisterWithInvitationRequest true "Invitation token and account details"
+// @Success 201 {object} response.SuccessResponse "Account created and invitation accepted"
+// @Failure 400 {object} response.ErrorResponse "Invalid request or link"
+// @Failure 409 {object} response.ErrorResponse "Account already exists"
+// @Router /invitations/register [post]
+func (h *InvitationHandler) RegisterWithInvitation(ctx *gin.Context) {
+	var req request.RegisterWithInvitationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	user := domain.User{
+		ID:          uuid.New(),
+		FirstName:   req.FirstName,
+		LastName:    req.LastName,
+		AccountType: "personal",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	createdUser, member, err := h.invitationService.AcceptInvitationWithRegistration(ctx, req.Token, user, req.Password)
+	if err != nil {
+		h.logger.Error("Registration from invitation failed", err, nil)
+		respondWithError(ctx, err, "Failed to accept invitation")
+		return
+	}
+
+	session, err := h.authService.CreateSession(
+		ctx,
+		createdUser.ID,
+		ctx.Request.UserAgent(),
+		ctx.ClientIP(),
+		"",
+		createdUser.Email,
+		"email",
+	)
+	if err != nil {
+		h.logger.Error("Failed to create session", err, map[string]interface{}{
+			"user_id": createdUser.ID,
+		})
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Registration successful but failed to create session",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Account created and invitation accepted",
+		Data: map[string]interface{}{
+			"user": response.LoginUserResponse{
+				ID:           createdUser.ID.String(),
+				Email:        createdUser.Email,
+				FirstName:    createdUser.FirstName,
+				LastName:     createdUser.LastName,
+				AccountType:  createdUser.AccountType,
+				AuthProvider: createdUser.AuthProvider,
+				CreatedAt:    createdUser.CreatedAt,
+				UpdatedAt:    createdUser.UpdatedAt,
+			},
+			"session": response.SessionResponse{
+				ID:            session.ID,
+				UserID:        createdUser.ID,
+				AccessToken:   session.OAuthAccessToken,
+				UserLoginType: session.UserLoginType,
+				ExpiresAt:     session.ExpiresAt,
+				CreatedAt:     session.CreatedAt,
+			},
+			"membership": mapOrganizationMemberToResponse(*member),
+		},
+	})
+}
+
+// mapInvitationToResponse maps a domain invitation to its response DTO
+func mapInvitationToResponse(invitation domain.Invitation, now time.Time) response.InvitationResponse {
+	return response.InvitationResponse{
+		ID:               invitation.ID,
+		OrganizationID:   invitation.OrganizationID,
+		OrganizationName: invitation.OrganizationName,
+		Email:            invitation.Email,
+		Role:             string(invitation.Role),
+		Status:           string(invitation.Status),
+		InvitedBy:        invitation.InvitedBy,
+		ExpiresAt:        invitation.ExpiresAt,
+		Expired:          invitation.IsExpired(now),
+		SendCount:        invitation.SendCount,
+		LastSentAt:       invitation.LastSentAt,
+		CreatedAt:        invitation.CreatedAt,
+	}
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type CreditNoteHandler struct {
+	creditNoteService ports.CreditNoteService
+	logger            logging.Logger
+}
+
+// NewCreditNoteHandler creates a new credit note handler
+func NewCreditNoteHandler(creditNoteService ports.CreditNoteService, logger logging.Logger) *CreditNoteHandler {
+	return &CreditNoteHandler{
+		creditNoteService: creditNoteService,
+		logger:            logger,
+	}
+}
+
+// IssueCreditNote godoc
+// @Summary Issue a credit note
+// @Description Take part or all of an open invoice's balance off with a credit note, numbered in its own sequence. The credit reverses the invoice's revenue and tax in proportion in the ledger. An invoice left with nothing to pay becomes credited. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body request.CreditNoteRequest true "Amount and reason"
+// @Success 201 {object} response.SuccessResponse{data=response.IssuedCreditNoteResponse} "Credit note issued"
+// @Failure 400 {object} response.ErrorResponse "Invalid request or amount above the balance due"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice is not awaiting payment"
+// @Router /organizations/{id}/invoices/{invoice_id}/credit-notes [post]
+func (h *CreditNoteHandler) IssueCreditNote(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	var req request.CreditNoteRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	var amount *decimal.Decimal
+	if req.Amount != "" {
+		parsed, ok := parseDecimal(ctx, "amount", req.Amount)
+		if !ok {
+			return
+		}
+		amount = &parsed
+	}
+
+	note, invoice, err := h.creditNoteService.IssueCreditNote(ctx, userID, orgID, invoiceID, amount, req.Reason)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to issue credit note")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Credit note issued",
+		Data: response.IssuedCreditNoteResponse{
+			CreditNote: mapCreditNoteToResponse(*note),
+			Invoice:    mapInvoiceToResponse(*invoice),
+		},
+	})
+}
+
+// ListInvoiceCreditNotes godoc
+// @Summary List an invoice's credit notes
+// @Description List the credit notes issued against an invoice in number order (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.CreditNoteResponse} "Credit notes"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id}/credit-notes [get]
+func (h *CreditNoteHandler) ListInvoiceCreditNotes(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	notes, err := h.creditNoteService.ListInvoiceCreditNotes(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve credit notes")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Credit notes retrieved",
+		Data:    mapCreditNotesToResponse(notes),
+	})
+}
+
+// ListCreditNotes godoc
+// @Summary List credit notes
+// @Description List the organization's credit notes, latest first (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.CreditNoteResponse} "Paginated list of credit notes"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/credit-notes [get]
+func (h *CreditNoteHandler) ListCreditNotes(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	notes, total, err := h.creditNoteService.ListCreditNotes(ctx, userID, orgID, page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve credit notes")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, mapCreditNotesToResponse(notes)))
+}
+
+// GetCreditNote godoc
+// @Summary Get a credit note
+// @Description Get one of the organization's credit notes (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param credit_note_id path string true "Credit note ID"
+// @Success 200 {object} response.SuccessResponse{data=response.CreditNoteResponse} "Credit note"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or credit note not found"
+// @Router /organizations/{id}/credit-notes/{credit_note_id} [get]
+func (h *CreditNoteHandler) GetCreditNote(ctx *gin.Context) {
+	userID, orgID, creditNoteID, ok := parseOrganizationResourcePath(ctx, "credit_note_id")
+	if !ok {
+		return
+	}
+
+	note, err := h.creditNoteService.GetCreditNote(ctx, userID, orgID, creditNoteID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve credit note")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Credit note retrieved",
+		Data:    mapCreditNoteToResponse(*note),
+	})
+}
+
+func mapCreditNotesToResponse(notes []domain.CreditNote) []response.CreditNoteResponse {
+	result := make([]response.CreditNoteResponse, len(notes))
+	for i, note := range notes {
+		result[i] = mapCreditNoteToResponse(note)
+	}
+	return result
+}
+
+func mapCreditNoteToResponse(note domain.CreditNote) response.CreditNoteResponse {
+	return response.CreditNoteResponse{
+		ID:            note.ID,
+		Number:        note.DisplayNumber(),
+		InvoiceID:     note.InvoiceID,
+		Currency:      note.Amount.Currency(),
+		Amount:        note.Amount.Amount().String(),
+		TaxAmount:     note.TaxAmount.Amount().String(),
+		Reason:        note.Reason,
+		LedgerEntryID: note.LedgerEntryID,
+		IssuedAt:      note.IssuedAt,
+		CreatedBy:     note.CreatedBy,
+	}
+}
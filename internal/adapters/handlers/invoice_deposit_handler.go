@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// GetDepositKey godoc
+// @Summary Get the invoice deposit key
+// @Description Get the extended public key invoice deposit addresses are derived from (owners, admins and finance)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceDepositKeyResponse} "Deposit key"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or deposit key not found"
+// @Router /organizations/{id}/invoice-deposit-key [get]
+func (h *InvoiceHandler) GetDepositKey(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	key, err := h.invoiceService.GetDepositKey(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to get deposit key")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Deposit key retrieved",
+		Data:    mapDepositKeyToResponse(*key),
+	})
+}
+
+// SetDepositKey godoc
+// @Summary Set the invoice deposit key
+// @Description Set the account-level extended public key (m/44'/60'/0') each invoice gets its own deposit address from, and the underpayment in basis points still accepted as paid to allow for fees. Private keys are rejected. Addresses are derived in order, so import the account into a wallet with a gap limit above the number of unpaid invoices. (owners and admins)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.InvoiceDepositKeyRequest true "Deposit key"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceDepositKeyResponse} "Deposit key set"
+// @Failure 400 {object} response.ErrorResponse "Invalid key or tolerance"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Failure 409 {object} response.ErrorResponse "Key already used for deposit addresses"
+// @Router /organizations/{id}/invoice-deposit-key [put]
+func (h *InvoiceHandler) SetDepositKey(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceDepositKeyRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	key, err := h.invoiceService.SetDepositKey(ctx, userID, orgID, req.ExtendedPublicKey, req.ToleranceBPS)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to set deposit key")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Deposit key set",
+		Data:    mapDepositKeyToResponse(*key),
+	})
+}
+
+// DeleteDepositKey godoc
+// @Summary Remove the invoice deposit key
+// @Description Stop deriving deposit addresses for new invoices. Invoices that already have one keep it and are still matched. (owners and admins)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse "Deposit key removed"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or deposit key not found"
+// @Router /organizations/{id}/invoice-deposit-key [delete]
+func (h *InvoiceHandler) DeleteDepositKey(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.invoiceService.DeleteDepositKey(ctx, userID, orgID); err != nil {
+		respondWithError(ctx, err, "Failed to remove deposit key")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Deposit key removed",
+	})
+}
+
+// ListInvoicePayments godoc
+// @Summary List an invoice's payments
+// @Description List the on-chain transfers matched to the invoice's deposit address (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.InvoicePaymentResponse} "Invoice payments"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id}/payments [get]
+func (h *InvoiceHandler) ListInvoicePayments(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	payments, err := h.invoiceService.ListInvoicePayments(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to list invoice payments")
+		return
+	}
+
+	paymentResponses := make([]response.InvoicePaymentResponse, len(payments))
+	for i, payment := range payments {
+		paymentResponses[i] = response.InvoicePaymentResponse{
+			ID:           payment.ID,
+			TxHash:       payment.TxHash,
+			LogIndex:     payment.LogIndex,
+			TokenAddress: payment.TokenAddress,
+			FromAddress:  payment.FromAddress,
+			ToAddress:    payment.ToAddress,
+			Amount:       payment.Amount.Amount().String(),
+			BlockNumber:  payment.BlockNumber,
+			Applied:      payment.Applied,
+			CreatedAt:    payment.CreatedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice payments retrieved",
+		Data:    paymentResponses,
+	})
+}
+
+func mapDepositKeyToResponse(key domain.InvoiceDepositKey) response.InvoiceDepositKeyResponse {
+	return response.InvoiceDepositKeyResponse{
+		ExtendedPublicKey: key.ExtendedPublicKey,
+		AddressesUsed:     key.NextIndex,
+		ToleranceBPS:      key.ToleranceBPS,
+		CreatedAt:         key.CreatedAt,
+		UpdatedAt:         key.UpdatedAt,
+	}
+}
@@ -0,0 +1,497 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type InvoiceHandler struct {
+	invoiceService ports.InvoiceService
+	logger         logging.Logger
+}
+
+// NewInvoiceHandler creates a new invoice handler
+func NewInvoiceHandler(invoiceService ports.InvoiceService, logger logging.Logger) *InvoiceHandler {
+	return &InvoiceHandler{
+		invoiceService: invoiceService,
+		logger:         logger,
+	}
+}
+
+// CreateInvoice godoc
+// @Summary Create an invoice
+// @Description Create a draft invoice with line items, discounts and taxes. It is numbered with the organization's next invoice number. A payment asset without a payment address gets a deposit address derived from the organization's deposit key. With a client_id, whatever is left out is taken from the directory client, the due date from its payment terms. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.InvoiceRequest true "Invoice details"
+// @Success 201 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/invoices [post]
+func (h *InvoiceHandler) CreateInvoice(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invoice, ok := mapInvoiceRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	created, err := h.invoiceService.CreateInvoice(ctx, userID, orgID, invoice)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice created",
+		Data:    mapInvoiceToResponse(*created),
+	})
+}
+
+// ListInvoices godoc
+// @Summary List invoices
+// @Description List the organization's invoices, latest number first (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param status query string false "Only invoices in this status (draft, sent, viewed, partially_paid, paid, overdue, void)"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.InvoiceResponse} "Paginated list of invoices"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/invoices [get]
+func (h *InvoiceHandler) ListInvoices(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var status *domain.InvoiceStatus
+	if value := ctx.Query("status"); value != "" {
+		s := domain.InvoiceStatus(value)
+		status = &s
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	invoices, total, err := h.invoiceService.ListInvoices(ctx, userID, orgID, status, page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve invoices")
+		return
+	}
+
+	invoiceResponses := make([]response.InvoiceResponse, len(invoices))
+	for i, invoice := range invoices {
+		invoiceResponses[i] = mapInvoiceToResponse(invoice)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, invoiceResponses))
+}
+
+// GetInvoice godoc
+// @Summary Get an invoice
+// @Description Get an invoice with its line items (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id} [get]
+func (h *InvoiceHandler) GetInvoice(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	invoice, err := h.invoiceService.GetInvoice(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice retrieved",
+		Data:    mapInvoiceToResponse(*invoice),
+	})
+}
+
+// DownloadInvoicePDF godoc
+// @Summary Download an invoice as PDF
+// @Description Render an invoice as a PDF with the organization's branding and payment instructions (any member)
+// @Tags invoices
+// @Produce application/pdf
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {file} blob "Invoice PDF"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id}/pdf [get]
+func (h *InvoiceHandler) DownloadInvoicePDF(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.invoiceService.RenderInvoicePDF(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to render invoice")
+		return
+	}
+
+	ctx.Header("Content-Disposition", "attachment; filename="+filename)
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// UpdateInvoice godoc
+// @Summary Update a draft invoice
+// @Description Replace the details and line items of a draft invoice; sent invoices cannot be edited (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body request.InvoiceRequest true "Invoice details"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice is no longer a draft"
+// @Router /organizations/{id}/invoices/{invoice_id} [put]
+func (h *InvoiceHandler) UpdateInvoice(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invoice, ok := mapInvoiceRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	updated, err := h.invoiceService.UpdateInvoice(ctx, userID, orgID, invoiceID, invoice)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice updated",
+		Data:    mapInvoiceToResponse(*updated),
+	})
+}
+
+// SendInvoice godoc
+// @Summary Send an invoice
+// @Description Email the invoice to the customer. A draft is marked as sent; an invoice already awaiting payment is emailed again. (owners, admins and finance)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice sent"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice is paid or void"
+// @Router /organizations/{id}/invoices/{invoice_id}/send [post]
+func (h *InvoiceHandler) SendInvoice(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	invoice, err := h.invoiceService.SendInvoice(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to send invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice sent",
+		Data:    mapInvoiceToResponse(*invoice),
+	})
+}
+
+// MarkPaid godoc
+// @Summary Mark an invoice as paid
+// @Description Record a payment of a sent, viewed, partially paid or overdue invoice received outside the platform (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body request.MarkInvoicePaidRequest true "Payment details"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice marked as paid"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice is not awaiting payment"
+// @Router /organizations/{id}/invoices/{invoice_id}/mark-paid [post]
+func (h *InvoiceHandler) MarkPaid(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	var req request.MarkInvoicePaidRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invoice, err := h.invoiceService.MarkPaid(ctx, userID, orgID, invoiceID, req.PaidAt, req.Reference)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to mark invoice as paid")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice marked as paid",
+		Data:    mapInvoiceToResponse(*invoice),
+	})
+}
+
+// VoidInvoice godoc
+// @Summary Void an invoice
+// @Description Cancel an invoice that has not received any payment or credit note. Its number stays used, and a sent invoice is reversed in the ledger. Timesheets billed on it return to approved. To cancel what is left of a paid or credited invoice, issue a credit note instead. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body request.VoidInvoiceRequest true "Reason"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice voided"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice has payments or credit notes, or is already void"
+// @Router /organizations/{id}/invoices/{invoice_id}/void [post]
+func (h *InvoiceHandler) VoidInvoice(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	var req request.VoidInvoiceRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invoice, err := h.invoiceService.VoidInvoice(ctx, userID, orgID, invoiceID, req.Reason)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to void invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice voided",
+		Data:    mapInvoiceToResponse(*invoice),
+	})
+}
+
+// mapInvoiceRequestToDomain maps invoice details to the domain model, writing
+// a bad request response if an amount or percentage does not parse
+func mapInvoiceRequestToDomain(ctx *gin.Context, req request.InvoiceRequest) (domain.Invoice, bool) {
+	invoice := domain.Invoice{
+		ClientID:        req.ClientID,
+		Currency:        req.Currency,
+		CustomerName:    req.CustomerName,
+		CustomerEmail:   req.CustomerEmail,
+		CustomerAddress: req.CustomerAddress,
+		CustomerCountry: req.CustomerCountry,
+		CustomerTaxID:   req.CustomerTaxID,
+		AutomaticTax:    req.AutomaticTax,
+		Notes:           req.Notes,
+		PaymentAssetID:  req.PaymentAssetID,
+		PaymentAddress:  req.PaymentAddress,
+	}
+	if req.IssueDate != nil {
+		invoice.IssueDate = *req.IssueDate
+	}
+	if req.DueDate != nil {
+		invoice.DueDate = *req.DueDate
+	}
+
+	lineItems, ok := mapInvoiceLineItemRequests(ctx, req.LineItems, req.Currency)
+	if !ok {
+		return domain.Invoice{}, false
+	}
+	invoice.LineItems = lineItems
+
+	return invoice, true
+}
+
+// mapInvoiceLineItemRequests parses invoice lines priced in currency, writing a
+// bad request response if an amount or percentage does not parse
+func mapInvoiceLineItemRequests(ctx *gin.Context, lines []request.InvoiceLineItemRequest, currency string) ([]domain.InvoiceLineItem, bool) {
+	items := make([]domain.InvoiceLineItem, len(lines))
+
+	for i, line := range lines {
+		field := fmt.Sprintf("line_items[%d]", i)
+
+		quantity, ok := parseDecimal(ctx, field+".quantity", line.Quantity)
+		if !ok {
+			return nil, false
+		}
+		unitPrice, ok := parseAmount(ctx, line.UnitPrice, currency)
+		if !ok {
+			return nil, false
+		}
+		discount, ok := parseDecimal(ctx, field+".discount_percent", line.DiscountPercent)
+		if !ok {
+			return nil, false
+		}
+		taxRate, ok := parseDecimal(ctx, field+".tax_rate", line.TaxRate)
+		if !ok {
+			return nil, false
+		}
+
+		items[i] = domain.InvoiceLineItem{
+			Description:     line.Description,
+			Quantity:        quantity,
+			UnitPrice:       unitPrice,
+			DiscountPercent: discount,
+			TaxRate:         taxRate,
+		}
+	}
+
+	return items, true
+}
+
+// mapInvoiceToResponse maps a domain invoice, and its line items when loaded, to its response DTO
+func mapInvoiceToResponse(invoice domain.Invoice) response.InvoiceResponse {
+	invoiceResponse := response.InvoiceResponse{
+		ID:                 invoice.ID,
+		Number:             invoice.Number,
+		InvoiceNumber:      invoice.DisplayNumber(),
+		Status:             string(invoice.Status),
+		Currency:           invoice.Currency,
+		ClientID:           invoice.ClientID,
+		CustomerName:       invoice.CustomerName,
+		CustomerEmail:      invoice.CustomerEmail,
+		CustomerAddress:    invoice.CustomerAddress,
+		CustomerCountry:    invoice.CustomerCountry,
+		CustomerTaxID:      invoice.CustomerTaxID,
+		IssueDate:          invoice.IssueDate,
+		DueDate:            invoice.DueDate,
+		Notes:              invoice.Notes,
+		Subtotal:           invoice.Subtotal.Amount().String(),
+		DiscountTotal:      invoice.DiscountTotal.Amount().String(),
+		TaxTotal:           invoice.TaxTotal.Amount().String(),
+		Total:              invoice.Total.Amount().String(),
+		AutomaticTax:       invoice.AutomaticTax,
+		TaxTreatment:       string(invoice.TaxTreatment),
+		TaxName:            invoice.TaxName,
+		TaxNote:            invoice.TaxNote,
+		TaxRatesVersion:    invoice.TaxRatesVersion,
+		PaymentAssetID:     invoice.PaymentAssetID,
+		PaymentAddress:     invoice.PaymentAddress,
+		DepositAddress:     invoice.DepositIndex != nil,
+		AmountPaid:         invoice.AmountPaid.Amount().String(),
+		AmountCredited:     invoice.AmountCredited.Amount().String(),
+		BalanceDue:         invoice.BalanceDue().Amount().String(),
+		RecurringInvoiceID: invoice.RecurringInvoiceID,
+		SentAt:             invoice.SentAt,
+		ViewedAt:           invoice.ViewedAt,
+		PaidAt:             invoice.PaidAt,
+		PaymentReference:   invoice.PaymentReference,
+		PaymentException:   string(invoice.PaymentException),
+		VoidedAt:           invoice.VoidedAt,
+		VoidReason:         invoice.VoidReason,
+		CreatedAt:          invoice.CreatedAt,
+		UpdatedAt:          invoice.UpdatedAt,
+	}
+
+	if overpayment := invoice.Overpayment(); overpayment.IsPositive() {
+		invoiceResponse.Overpayment = overpayment.Amount().String()
+	}
+	invoiceResponse.LineItems = mapInvoiceLineItemsToResponse(invoice.LineItems)
+	invoiceResponse.TaxBreakdown = mapTaxBreakdownToResponse(invoice.TaxBreakdown())
+
+	return invoiceResponse
+}
+
+func mapTaxBreakdownToResponse(lines []domain.TaxBreakdownLine) []response.TaxBreakdownResponse {
+	var breakdown []response.TaxBreakdownResponse
+	for _, line := range lines {
+		breakdown = append(breakdown, response.TaxBreakdownResponse{
+			Label:   line.Label,
+			Rate:    line.Rate.String(),
+			Taxable: line.Taxable.Amount().String(),
+			Tax:     line.Tax.Amount().String(),
+		})
+	}
+	return breakdown
+}
+
+func mapInvoiceLineItemsToResponse(items []domain.InvoiceLineItem) []response.InvoiceLineItemResponse {
+	var lineItems []response.InvoiceLineItemResponse
+	for _, item := range items {
+		lineItems = append(lineItems, response.InvoiceLineItemResponse{
+			Position:        item.Position,
+			Description:     item.Description,
+			Quantity:        item.Quantity.String(),
+			UnitPrice:       item.UnitPrice.Amount().String(),
+			DiscountPercent: item.DiscountPercent.String(),
+			TaxRate:         item.TaxRate.String(),
+			Amount:          item.Amount.Amount().String(),
+			DiscountAmount:  item.DiscountAmount.Amount().String(),
+			TaxAmount:       item.TaxAmount.Amount().String(),
+			Total:           item.Total.Amount().String(),
+		})
+	}
+	return lineItems
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type InvoiceReminderHandler struct {
+	reminderService ports.InvoiceReminderService
+	logger          logging.Logger
+}
+
+// NewInvoiceReminderHandler creates a new invoice reminder handler
+func NewInvoiceReminderHandler(reminderService ports.InvoiceReminderService, logger logging.Logger) *InvoiceReminderHandler {
+	return &InvoiceReminderHandler{
+		reminderService: reminderService,
+		logger:          logger,
+	}
+}
+
+// GetReminderSettings godoc
+// @Summary Get invoice reminder settings
+// @Description Get whether customers are emailed reminders of unpaid invoices and on which days relative to the due date. Organizations that never set them get the default cadence, turned off. (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceReminderSettingsResponse} "Reminder settings"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/invoice-reminders [get]
+func (h *InvoiceReminderHandler) GetReminderSettings(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	settings, err := h.reminderService.GetReminderSettings(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to get reminder settings")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Reminder settings retrieved",
+		Data:    mapReminderSettingsToResponse(*settings),
+	})
+}
+
+// UpdateReminderSettings godoc
+// @Summary Update invoice reminder settings
+// @Description Turn reminders of unpaid invoices on or off and set the days relative to the due date they are emailed on: negative before it, zero on it, positive after it. Reminders stop once an invoice is paid or voided. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.InvoiceReminderSettingsRequest true "Reminder settings"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceReminderSettingsResponse} "Reminder settings updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid reminder days"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/invoice-reminders [put]
+func (h *InvoiceReminderHandler) UpdateReminderSettings(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceReminderSettingsRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	settings, err := h.reminderService.UpdateReminderSettings(ctx, userID, orgID, domain.InvoiceReminderSettings{
+		Enabled:    req.Enabled,
+		OffsetDays: req.OffsetDays,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update reminder settings")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Reminder settings updated",
+		Data:    mapReminderSettingsToResponse(*settings),
+	})
+}
+
+// ListInvoiceReminders godoc
+// @Summary List an invoice's reminders
+// @Description List the reminders emailed to the customer of an invoice (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.InvoiceReminderResponse} "Invoice reminders"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id}/reminders [get]
+func (h *InvoiceReminderHandler) ListInvoiceReminders(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	reminders, err := h.reminderService.ListInvoiceReminders(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to list invoice reminders")
+		return
+	}
+
+	reminderResponses := make([]response.InvoiceReminderResponse, len(reminders))
+	for i, reminder := range reminders {
+		reminderResponses[i] = response.InvoiceReminderResponse{
+			ID:         reminder.ID,
+			OffsetDays: reminder.OffsetDays,
+			Recipient:  reminder.Recipient,
+			SentAt:     reminder.SentAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice reminders retrieved",
+		Data:    reminderResponses,
+	})
+}
+
+func mapReminderSettingsToResponse(settings domain.InvoiceReminderSettings) response.InvoiceReminderSettingsResponse {
+	settingsResponse := response.InvoiceReminderSettingsResponse{
+		Enabled:    settings.Enabled,
+		OffsetDays: settings.OffsetDays,
+	}
+
+	if !settings.UpdatedAt.IsZero() {
+		settingsResponse.UpdatedAt = &settings.UpdatedAt
+	}
+
+	return settingsResponse
+}
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type InvoiceShareHandler struct {
+	shareService ports.InvoiceShareService
+	logger       logging.Logger
+}
+
+// NewInvoiceShareHandler creates a new invoice share handler
+func NewInvoiceShareHandler(shareService ports.InvoiceShareService, logger logging.Logger) *InvoiceShareHandler {
+	return &InvoiceShareHandler{
+		shareService: shareService,
+		logger:       logger,
+	}
+}
+
+// CreateShareLink godoc
+// @Summary Share an invoice
+// @Description Create an expiring public link that shows the invoice read-only with payment instructions, for customers without an account. The URL is only returned once. Opening it moves a sent invoice to viewed. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body request.InvoiceShareLinkRequest false "Link expiry"
+// @Success 201 {object} response.SuccessResponse{data=response.InvoiceShareLinkResponse} "Share link created"
+// @Failure 400 {object} response.ErrorResponse "Invalid expiry"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Invoice is a draft or void"
+// @Router /organizations/{id}/invoices/{invoice_id}/share-links [post]
+func (h *InvoiceShareHandler) CreateShareLink(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceShareLinkRequest
+	if ctx.Request.ContentLength > 0 && !bindJSON(ctx, &req) {
+		return
+	}
+
+	link, url, err := h.shareService.CreateShareLink(ctx, userID, orgID, invoiceID, req.ExpiresAt)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to share invoice")
+		return
+	}
+
+	linkResponse := mapShareLinkToResponse(*link)
+	linkResponse.URL = url
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Share link created",
+		Data:    linkResponse,
+	})
+}
+
+// ListShareLinks godoc
+// @Summary List an invoice's share links
+// @Description List the public links an invoice has been shared with and how often each was opened (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.InvoiceShareLinkResponse} "Share links"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or invoice not found"
+// @Router /organizations/{id}/invoices/{invoice_id}/share-links [get]
+func (h *InvoiceShareHandler) ListShareLinks(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	links, err := h.shareService.ListShareLinks(ctx, userID, orgID, invoiceID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to list share links")
+		return
+	}
+
+	linkResponses := make([]response.InvoiceShareLinkResponse, len(links))
+	for i, link := range links {
+		linkResponses[i] = mapShareLinkToResponse(link)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Share links retrieved",
+		Data:    linkResponses,
+	})
+}
+
+// RevokeShareLink godoc
+// @Summary Revoke a share link
+// @Description Stop a public link from opening the invoice (owners, admins and finance)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param invoice_id path string true "Invoice ID"
+// @Param link_id path string true "Share link ID"
+// @Success 200 {object} response.SuccessResponse{data=response.InvoiceShareLinkResponse} "Share link revoked"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization, invoice or share link not found"
+// @Failure 409 {object} response.ErrorResponse "Already revoked"
+// @Router /organizations/{id}/invoices/{invoice_id}/share-links/{link_id} [delete]
+func (h *InvoiceShareHandler) RevokeShareLink(ctx *gin.Context) {
+	userID, orgID, invoiceID, ok := parseOrganizationResourcePath(ctx, "invoice_id")
+	if !ok {
+		return
+	}
+
+	linkID, ok := parseUUIDParam(ctx, "link_id")
+	if !ok {
+		return
+	}
+
+	link, err := h.shareService.RevokeShareLink(ctx, userID, orgID, invoiceID, linkID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to revoke share link")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Share link revoked",
+		Data:    mapShareLinkToResponse(*link),
+	})
+}
+
+// GetSharedInvoice godoc
+// @Summary View a shared invoice
+// @Description Show the invoice behind a public share link, read-only, with what is left to pay and where to send it. No account is needed. Opening the link moves a sent invoice to viewed.
+// @Tags invoices
+// @Produce json
+// @Param token query string true "Share link token"
+// @Success 200 {object} response.SuccessResponse{data=response.SharedInvoiceResponse} "Invoice"
+// @Failure 400 {object} response.ErrorResponse "Invalid, expired or revoked link"
+// @Failure 404 {object} response.ErrorResponse "Invoice not found"
+// @Failure 429 {object} response.ErrorResponse "Rate limit exceeded"
+// @Router /shared-invoices [get]
+func (h *InvoiceShareHandler) GetSharedInvoice(ctx *gin.Context) {
+	token, ok := shareToken(ctx)
+	if !ok {
+		return
+	}
+
+	shared, err := h.shareService.GetSharedInvoice(ctx, token)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to open shared invoice")
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice retrieved",
+		Data:    mapSharedInvoiceToResponse(*shared),
+	})
+}
+
+// DownloadSharedInvoicePDF godoc
+// @Summary Download a shared invoice as PDF
+// @Description Render the invoice behind a public share link as a PDF. No account is needed.
+// @Tags invoices
+// @Produce application/pdf
+// @Param token query string true "Share link token"
+// @Success 200 {file} blob "Invoice PDF"
+// @Failure 400 {object} response.ErrorResponse "Invalid, expired or revoked link"
+// @Failure 404 {object} response.ErrorResponse "Invoice not found"
+// @Failure 429 {object} response.ErrorResponse "Rate limit exceeded"
+// @Router /shared-invoices/pdf [get]
+func (h *InvoiceShareHandler) DownloadSharedInvoicePDF(ctx *gin.Context) {
+	token, ok := shareToken(ctx)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.shareService.RenderSharedInvoicePDF(ctx, token)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to render invoice")
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Content-Disposition", "attachment; filename="+filename)
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// shareToken reads the share link token from the query, writing the error response if it is missing
+func shareToken(ctx *gin.Context) (string, bool) {
+	token := ctx.Query("token")
+	if token == "" {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "token is required",
+		})
+		return "", false
+	}
+
+	return token, true
+}
+
+func mapShareLinkToResponse(link domain.InvoiceShareLink) response.InvoiceShareLinkResponse {
+	return response.InvoiceShareLinkResponse{
+		ID:           link.ID,
+		Active:       link.IsActive(time.Now()),
+		ExpiresAt:    link.ExpiresAt,
+		ViewCount:    link.ViewCount,
+		LastViewedAt: link.LastViewedAt,
+		RevokedAt:    link.RevokedAt,
+		CreatedAt:    link.CreatedAt,
+	}
+}
+
+// mapSharedInvoiceToResponse maps what a customer may see of an invoice,
+// leaving out internal details such as who created it and how its address was derived
+func mapSharedInvoiceToResponse(shared domain.SharedInvoice) response.SharedInvoiceResponse {
+	invoice := shared.Invoice
+
+	sharedResponse := response.SharedInvoiceResponse{
+		OrganizationName: shared.OrganizationName,
+		InvoiceNumber:    invoice.DisplayNumber(),
+		Status:           string(invoice.Status),
+		Currency:         invoice.Currency,
+		CustomerName:     invoice.CustomerName,
+		CustomerAddress:  invoice.CustomerAddress,
+		CustomerTaxID:    invoice.CustomerTaxID,
+		SellerTaxID:      shared.OrganizationTaxID,
+		IssueDate:        invoice.IssueDate,
+		DueDate:          invoice.DueDate,
+		Notes:            invoice.Notes,
+		LineItems:        mapInvoiceLineItemsToResponse(invoice.LineItems),
+		Subtotal:         invoice.Subtotal.Amount().String(),
+		DiscountTotal:    invoice.DiscountTotal.Amount().String(),
+		TaxTotal:         invoice.TaxTotal.Amount().String(),
+		TaxBreakdown:     mapTaxBreakdownToResponse(invoice.TaxBreakdown()),
+		TaxNote:          invoice.TaxNote,
+		Total:            invoice.Total.Amount().String(),
+		AmountPaid:       invoice.AmountPaid.Amount().String(),
+		AmountCredited:   invoice.AmountCredited.Amount().String(),
+		BalanceDue:       invoice.BalanceDue().Amount().String(),
+		PaidAt:           invoice.PaidAt,
+		LinkExpiresAt:    shared.LinkExpiresAt,
+	}
+
+	if invoice.Status.IsOpen() && invoice.PaymentAddress != "" {
+		instructions := &response.PaymentInstructionsResponse{
+			Amount:   invoice.BalanceDue().Amount().String(),
+			Currency: invoice.Currency,
+			Address:  invoice.PaymentAddress,
+		}
+		if asset := shared.PaymentAsset; asset != nil {
+			instructions.AssetSymbol = asset.Symbol
+			instructions.Chain = asset.Chain
+			instructions.ContractAddress = asset.ContractAddress
+			instructions.Decimals = asset.Decimals
+		}
+		sharedResponse.PaymentInstructions = instructions
+	}
+
+	return sharedResponse
+}
@@ -0,0 +1,366 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationHandler struct {
+	organizationService ports.OrganizationService
+	logger              logging.Logger
+}
+
+// NewOrganizationHandler creates a new organization handler
+func NewOrganizationHandler(organizationService ports.OrganizationService, logger logging.Logger) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+		logger:              logger,
+	}
+}
+
+// CreateOrganization godoc
+// @Summary Create an organization
+// @Description Create a business organization with the caller as its owner
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.OrganizationRequest true "Organization profile"
+// @Success 201 {object} response.SuccessResponse{data=response.OrganizationResponse} "Organization created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /organizations [post]
+func (h *OrganizationHandler) CreateOrganization(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.OrganizationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	org, err := h.organizationService.CreateOrganization(ctx, userID, mapOrganizationRequestToDomain(req))
+	if err != nil {
+		h.logger.Error("Failed to create organization", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		respondWithError(ctx, err, "Failed to create organization")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Organization created",
+		Data:    mapOrganizationToResponse(*org, ""),
+	})
+}
+
+// ListOrganizations godoc
+// @Summary List my organizations
+// @Description List the organizations the caller belongs to, with their role in each
+// @Tags organizations
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} response.SuccessResponse{data=[]response.OrganizationResponse} "Organizations"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /organizations [get]
+func (h *OrganizationHandler) ListOrganizations(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	memberships, err := h.organizationService.ListOrganizations(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to list organizations", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		respondWithError(ctx, err, "Failed to retrieve organizations")
+		return
+	}
+
+	orgResponses := make([]response.OrganizationResponse, len(memberships))
+	for i, membership := range memberships {
+		orgResponses[i] = mapOrganizationToResponse(membership.Organization, membership.Role)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Organizations retrieved",
+		Data:    orgResponses,
+	})
+}
+
+// GetOrganization godoc
+// @Summary Get an organization
+// @Description Get the profile of an organization the caller belongs to
+// @Tags organizations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=response.OrganizationResponse} "Organization"
+// @Failure 400 {object} response.ErrorResponse "Invalid organization ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id} [get]
+func (h *OrganizationHandler) GetOrganization(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	org, err := h.organizationService.GetOrganization(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve organization")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Organization retrieved",
+		Data:    mapOrganizationToResponse(*org, ""),
+	})
+}
+
+// UpdateOrganization godoc
+// @Summary Update an organization
+// @Description Replace an organization's profile (owners and admins only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.OrganizationRequest true "Organization profile"
+// @Success 200 {object} response.SuccessResponse{data=response.OrganizationResponse} "Organization updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id} [put]
+func (h *OrganizationHandler) UpdateOrganization(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.OrganizationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	org, err := h.organizationService.UpdateOrganization(ctx, userID, orgID, mapOrganizationRequestToDomain(req))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update organization")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Organization updated",
+		Data:    mapOrganizationToResponse(*org, ""),
+	})
+}
+
+// ListMembers godoc
+// @Summary List organization members
+// @Description List the members of an organization the caller belongs to
+// @Tags organizations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.OrganizationMemberResponse} "Members"
+// @Failure 400 {object} response.ErrorResponse "Invalid organization ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/members [get]
+func (h *OrganizationHandler) ListMembers(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	members, err := h.organizationService.ListMembers(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve members")
+		return
+	}
+
+	memberResponses := make([]response.OrganizationMemberResponse, len(members))
+	for i, member := range members {
+		memberResponses[i] = mapOrganizationMemberToResponse(member)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Members retrieved",
+		Data:    memberResponses,
+	})
+}
+
+// UpdateMemberRole godoc
+// @Summary Change a member's role
+// @Description Change a member's role (owners and admins; only owners can grant or change the owner role)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "Member's user ID"
+// @Param request body request.UpdateMemberRoleRequest true "New role"
+// @Success 200 {object} response.SuccessResponse{data=response.OrganizationMemberResponse} "Role changed"
+// @Failure 400 {object} response.ErrorResponse "Invalid request or last owner"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or member not found"
+// @Router /organizations/{id}/members/{user_id} [patch]
+func (h *OrganizationHandler) UpdateMemberRole(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	memberID, ok := parseUUIDParam(ctx, "user_id")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateMemberRoleRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	member, err := h.organizationService.UpdateMemberRole(ctx, userID, orgID, memberID, domain.OrganizationRole(req.Role))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to change member role")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Member role changed",
+		Data:    mapOrganizationMemberToResponse(*member),
+	})
+}
+
+// RemoveMember godoc
+// @Summary Remove a member
+// @Description Remove a member from an organization, or leave it by passing your own user ID
+// @Tags organizations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "Member's user ID"
+// @Success 200 {object} response.SuccessResponse "Member removed"
+// @Failure 400 {object} response.ErrorResponse "Last owner"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or member not found"
+// @Router /organizations/{id}/members/{user_id} [delete]
+func (h *OrganizationHandler) RemoveMember(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	memberID, ok := parseUUIDParam(ctx, "user_id")
+	if !ok {
+		return
+	}
+
+	if err := h.organizationService.RemoveMember(ctx, userID, orgID, memberID); err != nil {
+		respondWithError(ctx, err, "Failed to remove member")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Member removed",
+	})
+}
+
+// mapOrganizationRequestToDomain maps an organization profile request to the domain model
+func mapOrganizationRequestToDomain(req request.OrganizationRequest) domain.Organization {
+	return domain.Organization{
+		Name:         req.Name,
+		Address:      req.Address,
+		City:         req.City,
+		PostalCode:   req.PostalCode,
+		Country:      req.Country,
+		Website:      req.Website,
+		Size:         req.Size,
+		Industry:     req.Industry,
+		Description:  req.Description,
+		Headquarters: req.Headquarters,
+		LogoURL:      req.LogoURL,
+		TaxID:        req.TaxID,
+	}
+}
+
+// mapOrganizationToResponse maps a domain organization to its response DTO
+func mapOrganizationToResponse(org domain.Organization, role domain.OrganizationRole) response.OrganizationResponse {
+	return response.OrganizationResponse{
+		ID:           org.ID,
+		Name:         org.Name,
+		Address:      org.Address,
+		City:         org.City,
+		PostalCode:   org.PostalCode,
+		Country:      org.Country,
+		Website:      org.Website,
+		Size:         org.Size,
+		Industry:     org.Industry,
+		Description:  org.Description,
+		Headquarters: org.Headquarters,
+		LogoURL:      org.LogoURL,
+		TaxID:        org.TaxID,
+		Role:         string(role),
+		CreatedAt:    org.CreatedAt,
+		UpdatedAt:    org.UpdatedAt,
+	}
+}
+
+// mapOrganizationMemberToResponse maps a domain organization member to its response DTO
+func mapOrganizationMemberToResponse(member domain.OrganizationMember) response.OrganizationMemberResponse {
+	return response.OrganizationMemberResponse{
+		UserID:    member.UserID,
+		Email:     member.Email,
+		FirstName: member.FirstName,
+		LastName:  member.LastName,
+		Role:      string(member.Role),
+		JoinedAt:  member.CreatedAt,
+	}
+}
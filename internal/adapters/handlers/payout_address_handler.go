@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type PayoutAddressHandler struct {
+	payoutService ports.PayoutAddressService
+	logger        logging.Logger
+}
+
+// NewPayoutAddressHandler creates a new payout address handler
+func NewPayoutAddressHandler(payoutService ports.PayoutAddressService, logger logging.Logger) *PayoutAddressHandler {
+	return &PayoutAddressHandler{
+		payoutService: payoutService,
+		logger:        logger,
+	}
+}
+
+// ListPayoutAddresses godoc
+// @Summary List payout addresses
+// @Description List the payout address allowlist of the authenticated user, including addresses still in their cooling-off period
+// @Tags payout-addresses
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} response.SuccessResponse{data=[]response.PayoutAddressResponse} "Payout addresses retrieved"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /payout-addresses [get]
+func (h *PayoutAddressHandler) ListPayoutAddresses(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	addresses, err := h.payoutService.ListPayoutAddresses(ctx, userUUID)
+	if err != nil {
+		h.logger.Error("Failed to list payout addresses", err, map[string]interface{}{
+			"user_id": userUUID,
+		})
+		respondWithError(ctx, err, "Failed to retrieve payout addresses")
+		return
+	}
+
+	now := time.Now()
+	addressResponses := make([]response.PayoutAddressResponse, len(addresses))
+	for i, address := range addresses {
+		addressResponses[i] = mapPayoutAddressToResponse(address, now)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payout addresses retrieved",
+		Data:    addressResponses,
+	})
+}
+
+// CancelPayoutAddress godoc
+// @Summary Cancel a pending payout address
+// @Description Block a payout address that is still in its cooling-off period
+// @Tags payout-addresses
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Payout address ID"
+// @Success 200 {object} response.SuccessResponse{data=response.PayoutAddressResponse} "Payout address cancelled"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Payout address not found"
+// @Failure 409 {object} response.ErrorResponse "Payout address can no longer be cancelled"
+// @Router /payout-addresses/{id}/cancel [post]
+func (h *PayoutAddressHandler) CancelPayoutAddress(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	id, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	address, err := h.payoutService.CancelPayoutAddress(ctx, userUUID, id)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to cancel payout address")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payout address cancelled",
+		Data:    mapPayoutAddressToResponse(*address, time.Now()),
+	})
+}
+
+// ConfirmCancelPayoutAddress godoc
+// @Summary Confirm cancelling a payout address change from email
+// @Description Landing page of the cancel link sent by email whenever a payout address is added. It only shows the address; the change is cancelled by submitting the page's form, so link scanners that follow the link cannot cancel it.
+// @Tags payout-addresses
+// @Produce html
+// @Produce json
+// @Param token query string true "Cancel token from the notification email"
+// @Success 200 {object} response.SuccessResponse{data=response.PayoutAddressResponse} "Payout address change to confirm"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 404 {object} response.ErrorResponse "Invalid or expired cancel link"
+// @Router /payout-addresses/cancel [get]
+func (h *PayoutAddressHandler) ConfirmCancelPayoutAddress(ctx *gin.Context) {
+	token := ctx.Query("token")
+
+	address, err := h.payoutService.GetPayoutAddressByCancelToken(ctx, token)
+	if err != nil {
+		h.respondToCancelLink(ctx, err, "Failed to load payout address", nil)
+		return
+	}
+
+	now := time.Now()
+	if ctx.NegotiateFormat(binding.MIMEHTML, binding.MIMEJSON) == binding.MIMEJSON {
+		ctx.JSON(http.StatusOK, response.SuccessResponse{
+			Success: true,
+			Message: "Confirm to cancel this payout address change",
+			Data:    mapPayoutAddressToResponse(*address, now),
+		})
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	_ = payoutAddressCancelPage.Execute(ctx.Writer, payoutAddressCancelView{
+		Address:     address.Address,
+		Chain:       address.Chain,
+		AvailableAt: address.AvailableAt.UTC().Format(time.RFC1123),
+		Token:       token,
+		Cancellable: address.Status == domain.PayoutAddressStatusPending && !address.IsUsable(now),
+	})
+}
+
+// CancelPayoutAddressByToken godoc
+// @Summary Cancel a payout address change from email
+// @Description Cancels the payout address change the emailed link was sent for. Submitted from the link's confirmation page.
+// @Tags payout-addresses
+// @Accept x-www-form-urlencoded
+// @Produce html
+// @Produce json
+// @Param token formData string true "Cancel token from the notification email"
+// @Success 200 {object} response.SuccessResponse "Payout address change cancelled"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 404 {object} response.ErrorResponse "Invalid or expired cancel link"
+// @Failure 409 {object} response.ErrorResponse "Payout address can no longer be cancelled"
+// @Router /payout-addresses/cancel [post]
+func (h *PayoutAddressHandler) CancelPayoutAddressByToken(ctx *gin.Context) {
+	token := ctx.PostForm("token")
+	if token == "" {
+		token = ctx.Query("token")
+	}
+
+	address, err := h.payoutService.CancelPayoutAddressByToken(ctx, token)
+	if err != nil {
+		h.respondToCancelLink(ctx, err, "Failed to cancel payout address", nil)
+		return
+	}
+
+	h.logger.Info("Payout address cancelled from email link", map[string]interface{}{
+		"payout_address_id": address.ID,
+		"user_id":           address.UserID,
+	})
+
+	h.respondToCancelLink(ctx, nil, "Payout address change cancelled. No funds will be sent to "+address.Address, address)
+}
+
+// respondToCancelLink answers the email cancel link as a page for browsers and
+// as JSON for API clients
+func (h *PayoutAddressHandler) respondToCancelLink(ctx *gin.Context, err error, message string, address *domain.PayoutAddress) {
+	if ctx.NegotiateFormat(binding.MIMEHTML, binding.MIMEJSON) == binding.MIMEJSON {
+		if err != nil {
+			respondWithError(ctx, err, message)
+			return
+		}
+		ctx.JSON(http.StatusOK, response.SuccessResponse{
+			Success: true,
+			Message: message,
+		})
+		return
+	}
+
+	status := http.StatusOK
+	if err != nil {
+		status = http.StatusInternalServerError
+		var appErr *appErrors.AppError
+		if errors.As(err, &appErr) {
+			status = appErr.StatusCode()
+			message = appErr.Error()
+		}
+	}
+
+	ctx.Status(status)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	_ = payoutAddressCancelPage.Execute(ctx.Writer, payoutAddressCancelView{Message: message})
+}
+
+// payoutAddressCancelView is what the email cancel link's page shows
+type payoutAddressCancelView struct {
+	Address     string
+	Chain       string
+	AvailableAt string
+	Token       string
+	Cancellable bool
+	Message     string
+}
+
+var payoutAddressCancelPage = template.Must(template.New("payout_address_cancel").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Cancel payout address change</title>
+</head>
+<body style="font-family: sans-serif; max-width: 32rem; margin: 3rem auto; padding: 0 1rem;">
+<h1>Payout address change</h1>
+{{if .Message}}
+<p>{{.Message}}</p>
+{{else if .Cancellable}}
+<p>The address <code>{{.Address}}</code> on {{.Chain}} was added to your account and can receive payouts from {{.AvailableAt}}.</p>
+<p>If you did not add it, cancel the change now. No funds will be sent to it.</p>
+<form method="post" action="">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit">Cancel this change</button>
+</form>
+{{else}}
+<p>The change to <code>{{.Address}}</code> can no longer be cancelled. If you did not make it, contact support.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// mapPayoutAddressToResponse maps a domain payout address to its response DTO
+func mapPayoutAddressToResponse(address domain.PayoutAddress, now time.Time) response.PayoutAddressResponse {
+	return response.PayoutAddressResponse{
+		ID:          address.ID,
+		WalletID:    address.WalletID,
+		Address:     address.Address,
+		Chain:       address.Chain,
+		Status:      string(address.Status),
+		Usable:      address.IsUsable(now),
+		AvailableAt: address.AvailableAt,
+		ActivatedAt: address.ActivatedAt,
+		CancelledAt: address.CancelledAt,
+		CreatedAt:   address.CreatedAt,
+	}
+}
@@ -0,0 +1,789 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PayrollHandler struct {
+	payrollService ports.PayrollService
+	logger         logging.Logger
+}
+
+// NewPayrollHandler creates a new payroll handler
+func NewPayrollHandler(payrollService ports.PayrollService, logger logging.Logger) *PayrollHandler {
+	return &PayrollHandler{
+		payrollService: payrollService,
+		logger:         logger,
+	}
+}
+
+// CreateSchedule godoc
+// @Summary Create a payroll schedule
+// @Description Create a weekly, bi-weekly, monthly or custom cron pay schedule; a draft pay run is generated on each pay date (owners, admins and finance)
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.PayrollScheduleRequest true "Schedule settings"
+// @Success 201 {object} response.SuccessResponse{data=response.PayrollScheduleResponse} "Schedule created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/payroll/schedules [post]
+func (h *PayrollHandler) CreateSchedule(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.PayrollScheduleRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	schedule, err := h.payrollService.CreateSchedule(ctx, userID, orgID, mapPayrollScheduleRequestToDomain(req))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create payroll schedule")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Payroll schedule created",
+		Data:    mapPayrollScheduleToResponse(*schedule),
+	})
+}
+
+// ListSchedules godoc
+// @Summary List payroll schedules
+// @Description List the organization's payroll schedules (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.PayrollScheduleResponse} "Schedules"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/payroll/schedules [get]
+func (h *PayrollHandler) ListSchedules(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	schedules, err := h.payrollService.ListSchedules(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve payroll schedules")
+		return
+	}
+
+	scheduleResponses := make([]response.PayrollScheduleResponse, len(schedules))
+	for i, schedule := range schedules {
+		scheduleResponses[i] = mapPayrollScheduleToResponse(schedule)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payroll schedules retrieved",
+		Data:    scheduleResponses,
+	})
+}
+
+// GetSchedule godoc
+// @Summary Get a payroll schedule
+// @Description Get one of the organization's payroll schedules (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param schedule_id path string true "Schedule ID"
+// @Success 200 {object} response.SuccessResponse{data=response.PayrollScheduleResponse} "Schedule"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or schedule not found"
+// @Router /organizations/{id}/payroll/schedules/{schedule_id} [get]
+func (h *PayrollHandler) GetSchedule(ctx *gin.Context) {
+	userID, orgID, scheduleID, ok := parseOrganizationResourcePath(ctx, "schedule_id")
+	if !ok {
+		return
+	}
+
+	schedule, err := h.payrollService.GetSchedule(ctx, userID, orgID, scheduleID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve payroll schedule")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payroll schedule retrieved",
+		Data:    mapPayrollScheduleToResponse(*schedule),
+	})
+}
+
+// UpdateSchedule godoc
+// @Summary Update a payroll schedule
+// @Description Replace a schedule's settings, or pause it with active=false; the next pay date is recomputed from now (owners, admins and finance)
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param schedule_id path string true "Schedule ID"
+// @Param request body request.PayrollScheduleRequest true "Schedule settings"
+// @Success 200 {object} response.SuccessResponse{data=response.PayrollScheduleResponse} "Schedule updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or schedule not found"
+// @Router /organizations/{id}/payroll/schedules/{schedule_id} [put]
+func (h *PayrollHandler) UpdateSchedule(ctx *gin.Context) {
+	userID, orgID, scheduleID, ok := parseOrganizationResourcePath(ctx, "schedule_id")
+	if !ok {
+		return
+	}
+
+	var req request.PayrollScheduleRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	schedule, err := h.payrollService.UpdateSchedule(ctx, userID, orgID, scheduleID, mapPayrollScheduleRequestToDomain(req))
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update payroll schedule")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payroll schedule updated",
+		Data:    mapPayrollScheduleToResponse(*schedule),
+	})
+}
+
+// CreateCompensation godoc
+// @Summary Add an employee to a schedule
+// @Description Record what an organization member is paid on each date of a schedule, in which asset and to which wallet (owners, admins and finance)
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.CreateCompensationRequest true "Compensation"
+// @Success 201 {object} response.SuccessResponse{data=response.CompensationResponse} "Compensation created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or schedule not found"
+// @Failure 409 {object} response.ErrorResponse "Employee already on the schedule"
+// @Router /organizations/{id}/payroll/compensations [post]
+func (h *PayrollHandler) CreateCompensation(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.CreateCompensationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	amount, ok := parseAmount(ctx, req.Amount, req.Currency)
+	if !ok {
+		return
+	}
+
+	compensation, err := h.payrollService.CreateCompensation(ctx, userID, orgID, domain.EmployeeCompensation{
+		ScheduleID:    req.ScheduleID,
+		UserID:        req.UserID,
+		Amount:        amount,
+		PayoutAssetID: req.PayoutAssetID,
+		WalletAddress: req.WalletAddress,
+		TaxCountry:    req.TaxCountry,
+		TaxID:         req.TaxID,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create compensation")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Compensation created",
+		Data:    mapCompensationToResponse(*compensation),
+	})
+}
+
+// ListCompensations godoc
+// @Summary List compensation records
+// @Description List the organization's employee compensation records (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param schedule_id query string false "Only records on this schedule"
+// @Success 200 {object} response.SuccessResponse{data=[]response.CompensationResponse} "Compensation records"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/payroll/compensations [get]
+func (h *PayrollHandler) ListCompensations(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var scheduleID *uuid.UUID
+	if value := ctx.Query("schedule_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Message: "Invalid schedule_id",
+			})
+			return
+		}
+		scheduleID = &id
+	}
+
+	compensations, err := h.payrollService.ListCompensations(ctx, userID, orgID, scheduleID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve compensation records")
+		return
+	}
+
+	compensationResponses := make([]response.CompensationResponse, len(compensations))
+	for i, compensation := range compensations {
+		compensationResponses[i] = mapCompensationToResponse(compensation)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Compensation records retrieved",
+		Data:    compensationResponses,
+	})
+}
+
+// UpdateCompensation godoc
+// @Summary Update a compensation record
+// @Description Change an employee's pay, or stop paying them with active=false; existing pay runs are not changed (owners, admins and finance)
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param compensation_id path string true "Compensation ID"
+// @Param request body request.UpdateCompensationRequest true "Compensation"
+// @Success 200 {object} response.SuccessResponse{data=response.CompensationResponse} "Compensation updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or compensation not found"
+// @Failure 409 {object} response.ErrorResponse "Employee already on the schedule"
+// @Router /organizations/{id}/payroll/compensations/{compensation_id} [put]
+func (h *PayrollHandler) UpdateCompensation(ctx *gin.Context) {
+	userID, orgID, compensationID, ok := parseOrganizationResourcePath(ctx, "compensation_id")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateCompensationRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	amount, ok := parseAmount(ctx, req.Amount, req.Currency)
+	if !ok {
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	compensation, err := h.payrollService.UpdateCompensation(ctx, userID, orgID, compensationID, domain.EmployeeCompensation{
+		Amount:        amount,
+		PayoutAssetID: req.PayoutAssetID,
+		WalletAddress: req.WalletAddress,
+		TaxCountry:    req.TaxCountry,
+		TaxID:         req.TaxID,
+		Active:        active,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update compensation")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Compensation updated",
+		Data:    mapCompensationToResponse(*compensation),
+	})
+}
+
+// ListPayRuns godoc
+// @Summary List pay runs
+// @Description List the organization's pay runs, latest pay date first (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.PayRunResponse} "Paginated list of pay runs"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/payroll/runs [get]
+func (h *PayrollHandler) ListPayRuns(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	runs, total, err := h.payrollService.ListPayRuns(ctx, userID, orgID, page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve pay runs")
+		return
+	}
+
+	runResponses := make([]response.PayRunResponse, len(runs))
+	for i, run := range runs {
+		runResponses[i] = mapPayRunToResponse(run)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, runResponses))
+}
+
+// GetPayRun godoc
+// @Summary Get a pay run
+// @Description Get a pay run with its line items and totals per currency (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param run_id path string true "Pay run ID"
+// @Success 200 {object} response.SuccessResponse{data=response.PayRunResponse} "Pay run"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or pay run not found"
+// @Router /organizations/{id}/payroll/runs/{run_id} [get]
+func (h *PayrollHandler) GetPayRun(ctx *gin.Context) {
+	userID, orgID, runID, ok := parseOrganizationResourcePath(ctx, "run_id")
+	if !ok {
+		return
+	}
+
+	run, err := h.payrollService.GetPayRun(ctx, userID, orgID, runID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve pay run")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Pay run retrieved",
+		Data:    mapPayRunToResponse(*run),
+	})
+}
+
+// SimulateSchedule godoc
+// @Summary Simulate a schedule's next pay run
+// @Description Compute the line items, FX conversion, fees and estimated gas of a schedule's next pay run, or the one on pay_date, from its current compensation records, and compare it with the previous pay run. Nothing is created or submitted. (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param schedule_id path string true "Schedule ID"
+// @Param pay_date query string false "Pay date to simulate (RFC3339), defaults to the next pay date"
+// @Success 200 {object} response.SuccessResponse{data=response.PayRunSimulationResponse} "Simulation"
+// @Failure 400 {object} response.ErrorResponse "Invalid pay date"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or schedule not found"
+// @Router /organizations/{id}/payroll/schedules/{schedule_id}/simulate [post]
+func (h *PayrollHandler) SimulateSchedule(ctx *gin.Context) {
+	userID, orgID, scheduleID, ok := parseOrganizationResourcePath(ctx, "schedule_id")
+	if !ok {
+		return
+	}
+
+	payDate, ok := parseTimeQuery(ctx, "pay_date")
+	if !ok {
+		return
+	}
+
+	simulation, err := h.payrollService.SimulateSchedule(ctx, userID, orgID, scheduleID, payDate)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to simulate pay run")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Pay run simulated",
+		Data:    mapPayRunSimulationToResponse(*simulation),
+	})
+}
+
+// SimulatePayRun godoc
+// @Summary Simulate a pay run
+// @Description Compute what a pay run would pay out at the current rates, with fees and estimated gas, and compare it with the previous pay run. Nothing is submitted. (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param run_id path string true "Pay run ID"
+// @Success 200 {object} response.SuccessResponse{data=response.PayRunSimulationResponse} "Simulation"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or pay run not found"
+// @Router /organizations/{id}/payroll/runs/{run_id}/simulate [post]
+func (h *PayrollHandler) SimulatePayRun(ctx *gin.Context) {
+	userID, orgID, runID, ok := parseOrganizationResourcePath(ctx, "run_id")
+	if !ok {
+		return
+	}
+
+	simulation, err := h.payrollService.SimulatePayRun(ctx, userID, orgID, runID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to simulate pay run")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Pay run simulated",
+		Data:    mapPayRunSimulationToResponse(*simulation),
+	})
+}
+
+// ListPayslips godoc
+// @Summary List a pay run's payslips
+// @Description List the payslip of every employee in a pay run. Tax a VAT-registered contractor charges is broken out of their pay. (owners, admins and finance)
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param run_id path string true "Pay run ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.PayslipResponse} "Payslips"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or pay run not found"
+// @Router /organizations/{id}/payroll/runs/{run_id}/payslips [get]
+func (h *PayrollHandler) ListPayslips(ctx *gin.Context) {
+	userID, orgID, runID, ok := parseOrganizationResourcePath(ctx, "run_id")
+	if !ok {
+		return
+	}
+
+	payslips, err := h.payrollService.ListPayslips(ctx, userID, orgID, runID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve payslips")
+		return
+	}
+
+	payslipResponses := make([]response.PayslipResponse, len(payslips))
+	for i, payslip := range payslips {
+		payslipResponses[i] = mapPayslipToResponse(payslip)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payslips retrieved",
+		Data:    payslipResponses,
+	})
+}
+
+// GetPayslip godoc
+// @Summary Get a payslip
+// @Description Get one employee's payslip in a pay run. Employees can view their own; anyone else needs to be an owner, admin or finance member.
+// @Tags payroll
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param run_id path string true "Pay run ID"
+// @Param employee_id path string true "Employee user ID"
+// @Success 200 {object} response.SuccessResponse{data=response.PayslipResponse} "Payslip"
+// @Failure 400 {object} response.ErrorResponse "Invalid employee ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Not your payslip"
+// @Failure 404 {object} response.ErrorResponse "Organization, pay run or payslip not found"
+// @Router /organizations/{id}/payroll/runs/{run_id}/payslips/{employee_id} [get]
+func (h *PayrollHandler) GetPayslip(ctx *gin.Context) {
+	userID, orgID, runID, ok := parseOrganizationResourcePath(ctx, "run_id")
+	if !ok {
+		return
+	}
+
+	employeeID, ok := parseUUIDParam(ctx, "employee_id")
+	if !ok {
+		return
+	}
+
+	payslip, err := h.payrollService.GetPayslip(ctx, userID, orgID, runID, employeeID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve payslip")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Payslip retrieved",
+		Data:    mapPayslipToResponse(*payslip),
+	})
+}
+
+// mapPayrollScheduleRequestToDomain maps schedule settings to the domain model
+func mapPayrollScheduleRequestToDomain(req request.PayrollScheduleRequest) domain.PayrollSchedule {
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	return domain.PayrollSchedule{
+		Name:           req.Name,
+		Frequency:      domain.PayrollFrequency(req.Frequency),
+		CronExpression: req.CronExpression,
+		Timezone:       req.Timezone,
+		FirstPayDate:   req.FirstPayDate,
+		Active:         active,
+	}
+}
+
+// mapPayrollScheduleToResponse maps a domain payroll schedule to its response DTO
+func mapPayrollScheduleToResponse(schedule domain.PayrollSchedule) response.PayrollScheduleResponse {
+	return response.PayrollScheduleResponse{
+		ID:             schedule.ID,
+		Name:           schedule.Name,
+		Frequency:      string(schedule.Frequency),
+		CronExpression: schedule.CronExpression,
+		Timezone:       schedule.Timezone,
+		FirstPayDate:   schedule.FirstPayDate,
+		NextRunAt:      schedule.NextRunAt,
+		LastRunAt:      schedule.LastRunAt,
+		Active:         schedule.Active,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+}
+
+// mapCompensationToResponse maps a domain compensation record to its response DTO
+func mapCompensationToResponse(compensation domain.EmployeeCompensation) response.CompensationResponse {
+	return response.CompensationResponse{
+		ID:                compensation.ID,
+		ScheduleID:        compensation.ScheduleID,
+		UserID:            compensation.UserID,
+		Email:             compensation.Email,
+		FirstName:         compensation.FirstName,
+		LastName:          compensation.LastName,
+		Amount:            compensation.Amount.Amount().String(),
+		Currency:          compensation.Amount.Currency(),
+		PayoutAssetID:     compensation.PayoutAssetID,
+		PayoutAssetSymbol: compensation.PayoutAssetSymbol,
+		WalletAddress:     compensation.WalletAddress,
+		TaxCountry:        compensation.TaxCountry,
+		TaxID:             compensation.TaxID,
+		Active:            compensation.Active,
+		CreatedAt:         compensation.CreatedAt,
+		UpdatedAt:         compensation.UpdatedAt,
+	}
+}
+
+// mapPayRunToResponse maps a domain pay run, and its line items when loaded, to its response DTO
+func mapPayRunToResponse(run domain.PayRun) response.PayRunResponse {
+	runResponse := response.PayRunResponse{
+		ID:          run.ID,
+		ScheduleID:  run.ScheduleID,
+		PayDate:     run.PayDate,
+		PeriodStart: run.PeriodStart,
+		Status:      string(run.Status),
+		CreatedAt:   run.CreatedAt,
+		UpdatedAt:   run.UpdatedAt,
+	}
+
+	for _, total := range run.Totals() {
+		runResponse.Totals = append(runResponse.Totals, mapAmountToResponse(total))
+	}
+
+	for _, item := range run.LineItems {
+		itemResponse := response.PayRunLineItemResponse{
+			ID:                item.ID,
+			UserID:            item.UserID,
+			Email:             item.Email,
+			FirstName:         item.FirstName,
+			LastName:          item.LastName,
+			Amount:            item.Amount.Amount().String(),
+			Currency:          item.Amount.Currency(),
+			PayoutAssetID:     item.PayoutAssetID,
+			PayoutAssetSymbol: item.PayoutAssetSymbol,
+			WalletAddress:     item.WalletAddress,
+			TaxCountry:        item.TaxCountry,
+			TaxID:             item.TaxID,
+			FXQuoteID:         item.FXQuoteID,
+		}
+		if item.LockedPayout != nil {
+			itemResponse.LockedPayout = item.LockedPayout.Amount().String()
+		}
+		runResponse.LineItems = append(runResponse.LineItems, itemResponse)
+	}
+
+	return runResponse
+}
+
+// mapPayslipToResponse maps a domain payslip to its response DTO
+func mapPayslipToResponse(payslip domain.Payslip) response.PayslipResponse {
+	return response.PayslipResponse{
+		PayRunID:          payslip.PayRunID,
+		OrganizationName:  payslip.OrganizationName,
+		UserID:            payslip.UserID,
+		Email:             payslip.Email,
+		FirstName:         payslip.FirstName,
+		LastName:          payslip.LastName,
+		PayDate:           payslip.PayDate,
+		PeriodStart:       payslip.PeriodStart,
+		Status:            string(payslip.Status),
+		Currency:          payslip.Gross.Currency(),
+		Gross:             payslip.Gross.Amount().String(),
+		Net:               payslip.Net.Amount().String(),
+		TaxTreatment:      string(payslip.TaxTreatment),
+		TaxNote:           payslip.TaxNote,
+		TaxRatesVersion:   payslip.TaxRatesVersion,
+		TaxBreakdown:      mapTaxBreakdownToResponse(payslip.TaxLines),
+		PayoutAssetSymbol: payslip.PayoutAssetSymbol,
+		WalletAddress:     payslip.WalletAddress,
+	}
+}
+
+// mapPayRunSimulationToResponse maps a pay run simulation to its response DTO
+func mapPayRunSimulationToResponse(simulation domain.PayRunSimulation) response.PayRunSimulationResponse {
+	simulationResponse := response.PayRunSimulationResponse{
+		ScheduleID:   simulation.ScheduleID,
+		PayRunID:     simulation.PayRunID,
+		PayDate:      simulation.PayDate,
+		PeriodStart:  simulation.PeriodStart,
+		Totals:       []response.AmountResponse{},
+		LineItems:    []response.SimulatedLineItemResponse{},
+		Funding:      []response.SimulatedFundingResponse{},
+		Changes:      []response.PayRunLineChangeResponse{},
+		HasAnomalies: simulation.HasAnomalies(),
+		Warnings:     simulation.Warnings,
+		SimulatedAt:  simulation.SimulatedAt,
+	}
+
+	for _, total := range simulation.Totals {
+		simulationResponse.Totals = append(simulationResponse.Totals, mapAmountToResponse(total))
+	}
+
+	for _, item := range simulation.LineItems {
+		itemResponse := response.SimulatedLineItemResponse{
+			UserID:            item.UserID,
+			Email:             item.Email,
+			FirstName:         item.FirstName,
+			LastName:          item.LastName,
+			Amount:            mapAmountToResponse(item.Amount),
+			PayoutAssetID:     item.PayoutAssetID,
+			PayoutAssetSymbol: item.PayoutAssetSymbol,
+			WalletAddress:     item.WalletAddress,
+			PayoutAmount:      mapOptionalAmountToResponse(item.PayoutAmount),
+			Fee:               mapOptionalAmountToResponse(item.Fee),
+		}
+		if item.FXRate != nil {
+			itemResponse.FXRate = item.FXRate.String()
+		}
+		simulationResponse.LineItems = append(simulationResponse.LineItems, itemResponse)
+	}
+
+	for _, funding := range simulation.Funding {
+		simulationResponse.Funding = append(simulationResponse.Funding, response.SimulatedFundingResponse{
+			AssetID:         funding.AssetID,
+			Symbol:          funding.Symbol,
+			Chain:           funding.Chain,
+			PayoutAmount:    mapAmountToResponse(funding.PayoutAmount),
+			FeeAmount:       mapAmountToResponse(funding.FeeAmount),
+			TotalAmount:     mapAmountToResponse(funding.TotalAmount),
+			Transfers:       funding.Transfers,
+			Batches:         funding.Batches,
+			EstimatedGas:    funding.EstimatedGas,
+			EstimatedGasFee: mapOptionalAmountToResponse(funding.EstimatedGasFee),
+		})
+	}
+
+	if simulation.Previous != nil {
+		simulationResponse.PreviousPayRunID = &simulation.Previous.ID
+		for _, total := range simulation.Previous.Totals() {
+			simulationResponse.PreviousTotals = append(simulationResponse.PreviousTotals, mapAmountToResponse(total))
+		}
+	}
+
+	for _, change := range simulation.Changes {
+		changeResponse := response.PayRunLineChangeResponse{
+			Kind:               string(change.Kind),
+			UserID:             change.UserID,
+			Email:              change.Email,
+			FirstName:          change.FirstName,
+			LastName:           change.LastName,
+			PreviousAmount:     mapOptionalAmountToResponse(change.PreviousAmount),
+			Amount:             mapOptionalAmountToResponse(change.Amount),
+			CurrencyChanged:    change.CurrencyChanged,
+			PayoutAssetChanged: change.PayoutAssetChanged,
+			WalletChanged:      change.WalletChanged,
+			Anomalous:          change.Anomalous,
+		}
+		if change.PercentChange != nil {
+			changeResponse.PercentChange = change.PercentChange.String()
+		}
+		simulationResponse.Changes = append(simulationResponse.Changes, changeResponse)
+	}
+
+	return simulationResponse
+}
+
+// mapAmountToResponse maps an amount to its response DTO
+func mapAmountToResponse(amount money.Money) response.AmountResponse {
+	return response.AmountResponse{
+		Amount:   amount.Amount().String(),
+		Currency: amount.Currency(),
+	}
+}
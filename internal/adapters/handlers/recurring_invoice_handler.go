@@ -0,0 +1,406 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type RecurringInvoiceHandler struct {
+	recurringService ports.RecurringInvoiceService
+	logger           logging.Logger
+}
+
+// NewRecurringInvoiceHandler creates a new recurring invoice handler
+func NewRecurringInvoiceHandler(recurringService ports.RecurringInvoiceService, logger logging.Logger) *RecurringInvoiceHandler {
+	return &RecurringInvoiceHandler{
+		recurringService: recurringService,
+		logger:           logger,
+	}
+}
+
+// defaultDaysUntilDue is the payment term of a recurring invoice that does not set one
+const defaultDaysUntilDue = 30
+
+// CreateRecurringInvoice godoc
+// @Summary Create a recurring invoice
+// @Description Create a template that generates an invoice on each issue date, for example a monthly retainer. The first invoice is issued on the start date, or on the first issue date from today if it has passed. Generated invoices are emailed straight away when auto_send is set and stay drafts otherwise. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.RecurringInvoiceRequest true "Recurring invoice details"
+// @Success 201 {object} response.SuccessResponse{data=response.RecurringInvoiceResponse} "Recurring invoice created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/recurring-invoices [post]
+func (h *RecurringInvoiceHandler) CreateRecurringInvoice(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.RecurringInvoiceRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	recurring, ok := mapRecurringInvoiceRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	created, err := h.recurringService.CreateRecurringInvoice(ctx, userID, orgID, recurring)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to create recurring invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoice created",
+		Data:    mapRecurringInvoiceToResponse(*created),
+	})
+}
+
+// ListRecurringInvoices godoc
+// @Summary List recurring invoices
+// @Description List the organization's recurring invoices (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.RecurringInvoiceResponse} "Recurring invoices"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/recurring-invoices [get]
+func (h *RecurringInvoiceHandler) ListRecurringInvoices(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	recurring, err := h.recurringService.ListRecurringInvoices(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve recurring invoices")
+		return
+	}
+
+	recurringResponses := make([]response.RecurringInvoiceResponse, len(recurring))
+	for i, r := range recurring {
+		recurringResponses[i] = mapRecurringInvoiceToResponse(r)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoices retrieved",
+		Data:    recurringResponses,
+	})
+}
+
+// GetRecurringInvoice godoc
+// @Summary Get a recurring invoice
+// @Description Get a recurring invoice with its line items (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param recurring_id path string true "Recurring invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=response.RecurringInvoiceResponse} "Recurring invoice"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or recurring invoice not found"
+// @Router /organizations/{id}/recurring-invoices/{recurring_id} [get]
+func (h *RecurringInvoiceHandler) GetRecurringInvoice(ctx *gin.Context) {
+	userID, orgID, recurringID, ok := parseOrganizationResourcePath(ctx, "recurring_id")
+	if !ok {
+		return
+	}
+
+	recurring, err := h.recurringService.GetRecurringInvoice(ctx, userID, orgID, recurringID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve recurring invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoice retrieved",
+		Data:    mapRecurringInvoiceToResponse(*recurring),
+	})
+}
+
+// UpdateRecurringInvoice godoc
+// @Summary Update a recurring invoice
+// @Description Replace a recurring invoice's schedule, limits and line items. Invoices already generated are unchanged. (owners, admins and finance)
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param recurring_id path string true "Recurring invoice ID"
+// @Param request body request.RecurringInvoiceRequest true "Recurring invoice details"
+// @Success 200 {object} response.SuccessResponse{data=response.RecurringInvoiceResponse} "Recurring invoice updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or recurring invoice not found"
+// @Router /organizations/{id}/recurring-invoices/{recurring_id} [put]
+func (h *RecurringInvoiceHandler) UpdateRecurringInvoice(ctx *gin.Context) {
+	userID, orgID, recurringID, ok := parseOrganizationResourcePath(ctx, "recurring_id")
+	if !ok {
+		return
+	}
+
+	var req request.RecurringInvoiceRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	recurring, ok := mapRecurringInvoiceRequestToDomain(ctx, req)
+	if !ok {
+		return
+	}
+
+	updated, err := h.recurringService.UpdateRecurringInvoice(ctx, userID, orgID, recurringID, recurring)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update recurring invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoice updated",
+		Data:    mapRecurringInvoiceToResponse(*updated),
+	})
+}
+
+// PreviewRecurringInvoice godoc
+// @Summary Preview upcoming invoices
+// @Description List the next invoices a recurring invoice will generate, up to its end date or occurrence limit, without creating them (any member)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param recurring_id path string true "Recurring invoice ID"
+// @Param count query int false "Number of upcoming invoices (default: 6, max: 24)"
+// @Success 200 {object} response.SuccessResponse{data=[]response.UpcomingInvoiceResponse} "Upcoming invoices"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization or recurring invoice not found"
+// @Router /organizations/{id}/recurring-invoices/{recurring_id}/preview [get]
+func (h *RecurringInvoiceHandler) PreviewRecurringInvoice(ctx *gin.Context) {
+	userID, orgID, recurringID, ok := parseOrganizationResourcePath(ctx, "recurring_id")
+	if !ok {
+		return
+	}
+
+	count := 0
+	if value := ctx.Query("count"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Message: "Invalid count: " + value,
+			})
+			return
+		}
+		count = parsed
+	}
+
+	invoices, err := h.recurringService.PreviewRecurringInvoice(ctx, userID, orgID, recurringID, count)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to preview recurring invoice")
+		return
+	}
+
+	upcoming := make([]response.UpcomingInvoiceResponse, len(invoices))
+	for i, invoice := range invoices {
+		upcoming[i] = response.UpcomingInvoiceResponse{
+			IssueDate:     invoice.IssueDate,
+			DueDate:       invoice.DueDate,
+			Subtotal:      invoice.Subtotal.Amount().String(),
+			DiscountTotal: invoice.DiscountTotal.Amount().String(),
+			TaxTotal:      invoice.TaxTotal.Amount().String(),
+			Total:         invoice.Total.Amount().String(),
+			LineItems:     mapInvoiceLineItemsToResponse(invoice.LineItems),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Upcoming invoices retrieved",
+		Data:    upcoming,
+	})
+}
+
+// PauseRecurringInvoice godoc
+// @Summary Pause a recurring invoice
+// @Description Stop an active recurring invoice from generating invoices until it is resumed (owners, admins and finance)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param recurring_id path string true "Recurring invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=response.RecurringInvoiceResponse} "Recurring invoice paused"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or recurring invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Recurring invoice is not active"
+// @Router /organizations/{id}/recurring-invoices/{recurring_id}/pause [post]
+func (h *RecurringInvoiceHandler) PauseRecurringInvoice(ctx *gin.Context) {
+	userID, orgID, recurringID, ok := parseOrganizationResourcePath(ctx, "recurring_id")
+	if !ok {
+		return
+	}
+
+	recurring, err := h.recurringService.PauseRecurringInvoice(ctx, userID, orgID, recurringID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to pause recurring invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoice paused",
+		Data:    mapRecurringInvoiceToResponse(*recurring),
+	})
+}
+
+// ResumeRecurringInvoice godoc
+// @Summary Resume a recurring invoice
+// @Description Restart a paused recurring invoice from its next issue date; dates missed while it was paused are skipped (owners, admins and finance)
+// @Tags invoices
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param recurring_id path string true "Recurring invoice ID"
+// @Success 200 {object} response.SuccessResponse{data=response.RecurringInvoiceResponse} "Recurring invoice resumed"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or recurring invoice not found"
+// @Failure 409 {object} response.ErrorResponse "Recurring invoice is not paused"
+// @Router /organizations/{id}/recurring-invoices/{recurring_id}/resume [post]
+func (h *RecurringInvoiceHandler) ResumeRecurringInvoice(ctx *gin.Context) {
+	userID, orgID, recurringID, ok := parseOrganizationResourcePath(ctx, "recurring_id")
+	if !ok {
+		return
+	}
+
+	recurring, err := h.recurringService.ResumeRecurringInvoice(ctx, userID, orgID, recurringID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to resume recurring invoice")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Recurring invoice resumed",
+		Data:    mapRecurringInvoiceToResponse(*recurring),
+	})
+}
+
+// mapRecurringInvoiceRequestToDomain maps recurring invoice details to the
+// domain model, writing a bad request response if an amount or percentage
+// does not parse
+func mapRecurringInvoiceRequestToDomain(ctx *gin.Context, req request.RecurringInvoiceRequest) (domain.RecurringInvoice, bool) {
+	recurring := domain.RecurringInvoice{
+		Name:            req.Name,
+		Frequency:       domain.InvoiceFrequency(req.Frequency),
+		Timezone:        req.Timezone,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		MaxOccurrences:  req.MaxOccurrences,
+		DaysUntilDue:    defaultDaysUntilDue,
+		AutoSend:        req.AutoSend,
+		Currency:        req.Currency,
+		CustomerName:    req.CustomerName,
+		CustomerEmail:   req.CustomerEmail,
+		CustomerAddress: req.CustomerAddress,
+		Notes:           req.Notes,
+		PaymentAssetID:  req.PaymentAssetID,
+		PaymentAddress:  req.PaymentAddress,
+	}
+	if req.DaysUntilDue != nil {
+		recurring.DaysUntilDue = *req.DaysUntilDue
+	}
+
+	lineItems, ok := mapInvoiceLineItemRequests(ctx, req.LineItems, req.Currency)
+	if !ok {
+		return domain.RecurringInvoice{}, false
+	}
+	for _, item := range lineItems {
+		recurring.LineItems = append(recurring.LineItems, domain.RecurringInvoiceLineItem{
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice,
+			DiscountPercent: item.DiscountPercent,
+			TaxRate:         item.TaxRate,
+		})
+	}
+
+	return recurring, true
+}
+
+// mapRecurringInvoiceToResponse maps a recurring invoice, and its line items when loaded, to its response DTO
+func mapRecurringInvoiceToResponse(recurring domain.RecurringInvoice) response.RecurringInvoiceResponse {
+	recurringResponse := response.RecurringInvoiceResponse{
+		ID:              recurring.ID,
+		Name:            recurring.Name,
+		Status:          string(recurring.Status),
+		Frequency:       string(recurring.Frequency),
+		Timezone:        recurring.Timezone,
+		StartDate:       recurring.StartDate,
+		EndDate:         recurring.EndDate,
+		MaxOccurrences:  recurring.MaxOccurrences,
+		Occurrences:     recurring.Occurrences,
+		LastIssuedAt:    recurring.LastIssuedAt,
+		DaysUntilDue:    recurring.DaysUntilDue,
+		AutoSend:        recurring.AutoSend,
+		Currency:        recurring.Currency,
+		CustomerName:    recurring.CustomerName,
+		CustomerEmail:   recurring.CustomerEmail,
+		CustomerAddress: recurring.CustomerAddress,
+		Notes:           recurring.Notes,
+		PaymentAssetID:  recurring.PaymentAssetID,
+		PaymentAddress:  recurring.PaymentAddress,
+		CreatedAt:       recurring.CreatedAt,
+		UpdatedAt:       recurring.UpdatedAt,
+	}
+
+	// A completed recurring invoice issues nothing more
+	if recurring.Status != domain.RecurringInvoiceStatusCompleted {
+		recurringResponse.NextIssueAt = &recurring.NextIssueAt
+	}
+
+	for _, item := range recurring.LineItems {
+		recurringResponse.LineItems = append(recurringResponse.LineItems, response.RecurringInvoiceLineItemResponse{
+			Position:        item.Position,
+			Description:     item.Description,
+			Quantity:        item.Quantity.String(),
+			UnitPrice:       item.UnitPrice.Amount().String(),
+			DiscountPercent: item.DiscountPercent.String(),
+			TaxRate:         item.TaxRate.String(),
+		})
+	}
+
+	return recurringResponse
+}
@@ -0,0 +1,529 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type TimesheetHandler struct {
+	timesheetService ports.TimesheetService
+	logger           logging.Logger
+}
+
+// NewTimesheetHandler creates a new contractor timesheet handler
+func NewTimesheetHandler(timesheetService ports.TimesheetService, logger logging.Logger) *TimesheetHandler {
+	return &TimesheetHandler{
+		timesheetService: timesheetService,
+		logger:           logger,
+	}
+}
+
+// CreateContractorContract godoc
+// @Summary Add a contractor contract
+// @Description Set the hourly rate a member's time on a project is billed to a client at. A contractor can have one active contract per project. (owners, admins and finance)
+// @Tags timesheets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.CreateContractorContractRequest true "Contract details"
+// @Success 201 {object} response.SuccessResponse{data=response.ContractorContractResponse} "Contract added"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Failure 409 {object} response.ErrorResponse "The contractor already has an active contract for the project"
+// @Router /organizations/{id}/contracts [post]
+func (h *TimesheetHandler) CreateContractorContract(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.CreateContractorContractRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	hourlyRate, ok := parseAmount(ctx, req.HourlyRate, req.Currency)
+	if !ok {
+		return
+	}
+
+	contract, err := h.timesheetService.CreateContractorContract(ctx, userID, orgID, domain.ContractorContract{
+		ContractorID: req.ContractorID,
+		ClientID:     req.ClientID,
+		Project:      req.Project,
+		HourlyRate:   hourlyRate,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to add contract")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Contract added",
+		Data:    mapContractorContractToResponse(*contract),
+	})
+}
+
+// ListContractorContracts godoc
+// @Summary List contractor contracts
+// @Description List the organization's contractor contracts, active ones first. Members who do not manage finances only see their own.
+// @Tags timesheets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.SuccessResponse{data=[]response.ContractorContractResponse} "Contracts"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/contracts [get]
+func (h *TimesheetHandler) ListContractorContracts(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	contracts, err := h.timesheetService.ListContractorContracts(ctx, userID, orgID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve contracts")
+		return
+	}
+
+	contractResponses := make([]response.ContractorContractResponse, len(contracts))
+	for i, contract := range contracts {
+		contractResponses[i] = mapContractorContractToResponse(contract)
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Contracts retrieved",
+		Data:    contractResponses,
+	})
+}
+
+// UpdateContractorContract godoc
+// @Summary Update a contractor contract
+// @Description Change a contract's client, project, hourly rate or active flag. Approved hours not yet invoiced are billed at the new rate. (owners, admins and finance)
+// @Tags timesheets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param contract_id path string true "Contract ID"
+// @Param request body request.UpdateContractorContractRequest true "Contract details"
+// @Success 200 {object} response.SuccessResponse{data=response.ContractorContractResponse} "Contract updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or contract not found"
+// @Failure 409 {object} response.ErrorResponse "The contractor already has an active contract for the project"
+// @Router /organizations/{id}/contracts/{contract_id} [put]
+func (h *TimesheetHandler) UpdateContractorContract(ctx *gin.Context) {
+	userID, orgID, contractID, ok := parseOrganizationResourcePath(ctx, "contract_id")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateContractorContractRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	hourlyRate, ok := parseAmount(ctx, req.HourlyRate, req.Currency)
+	if !ok {
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	contract, err := h.timesheetService.UpdateContractorContract(ctx, userID, orgID, contractID, domain.ContractorContract{
+		ClientID:   req.ClientID,
+		Project:    req.Project,
+		HourlyRate: hourlyRate,
+		Active:     active,
+	})
+	if err != nil {
+		respondWithError(ctx, err, "Failed to update contract")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Contract updated",
+		Data:    mapContractorContractToResponse(*contract),
+	})
+}
+
+// SaveTimesheet godoc
+// @Summary Log a week's hours
+// @Description Replace the caller's entries for a week on one of their active contracts. Days after today cannot be logged. A timesheet can be edited until it is submitted; editing a rejected one returns it to draft.
+// @Tags timesheets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.TimesheetRequest true "The week's hours"
+// @Success 200 {object} response.SuccessResponse{data=response.TimesheetResponse} "Timesheet saved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Not the caller's contract"
+// @Failure 404 {object} response.ErrorResponse "Organization or contract not found"
+// @Failure 409 {object} response.ErrorResponse "Contract inactive or timesheet already submitted"
+// @Router /organizations/{id}/timesheets [put]
+func (h *TimesheetHandler) SaveTimesheet(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.TimesheetRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	entries := make([]domain.TimesheetEntry, len(req.Entries))
+	for i, entry := range req.Entries {
+		hours, ok := parseDecimal(ctx, "hours", entry.Hours)
+		if !ok {
+			return
+		}
+		entries[i] = domain.TimesheetEntry{
+			WorkDate:    entry.WorkDate,
+			Hours:       hours,
+			Description: entry.Description,
+		}
+	}
+
+	timesheet, err := h.timesheetService.SaveTimesheet(ctx, userID, orgID, req.ContractID, req.WeekStart, entries)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to save timesheet")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Timesheet saved",
+		Data:    mapTimesheetToResponse(*timesheet),
+	})
+}
+
+// ListTimesheets godoc
+// @Summary List timesheets
+// @Description List the organization's timesheets, latest week first. Members who do not manage finances only see their own.
+// @Tags timesheets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param status query string false "Filter by status (draft, submitted, approved, rejected, invoiced)"
+// @Param contract_id query string false "Only this contract's timesheets"
+// @Param contractor_id query string false "Only this contractor's timesheets"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.PageResponse{items=[]response.TimesheetResponse} "Paginated list of timesheets"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Organization not found"
+// @Router /organizations/{id}/timesheets [get]
+func (h *TimesheetHandler) ListTimesheets(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var filter domain.TimesheetFilter
+	if value := ctx.Query("status"); value != "" {
+		status := domain.TimesheetStatus(value)
+		filter.Status = &status
+	}
+	if filter.ContractID, ok = parseUUIDQuery(ctx, "contract_id"); !ok {
+		return
+	}
+	if filter.ContractorID, ok = parseUUIDQuery(ctx, "contractor_id"); !ok {
+		return
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	timesheets, total, err := h.timesheetService.ListTimesheets(ctx, userID, orgID, filter, page, pageSize)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve timesheets")
+		return
+	}
+
+	timesheetResponses := make([]response.TimesheetResponse, len(timesheets))
+	for i, timesheet := range timesheets {
+		timesheetResponses[i] = mapTimesheetToResponse(timesheet)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, timesheetResponses))
+}
+
+// GetTimesheet godoc
+// @Summary Get a timesheet
+// @Description Get a timesheet with its entries. Contractors can see their own; anyone else needs a role that manages finances.
+// @Tags timesheets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param timesheet_id path string true "Timesheet ID"
+// @Success 200 {object} response.SuccessResponse{data=response.TimesheetResponse} "Timesheet"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Not the caller's timesheet"
+// @Failure 404 {object} response.ErrorResponse "Organization or timesheet not found"
+// @Router /organizations/{id}/timesheets/{timesheet_id} [get]
+func (h *TimesheetHandler) GetTimesheet(ctx *gin.Context) {
+	userID, orgID, timesheetID, ok := parseOrganizationResourcePath(ctx, "timesheet_id")
+	if !ok {
+		return
+	}
+
+	timesheet, err := h.timesheetService.GetTimesheet(ctx, userID, orgID, timesheetID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to get timesheet")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Timesheet retrieved",
+		Data:    mapTimesheetToResponse(*timesheet),
+	})
+}
+
+// SubmitTimesheet godoc
+// @Summary Submit a timesheet
+// @Description Send the caller's draft timesheet for approval. It can no longer be edited unless it is rejected.
+// @Tags timesheets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param timesheet_id path string true "Timesheet ID"
+// @Success 200 {object} response.SuccessResponse{data=response.TimesheetResponse} "Timesheet submitted"
+// @Failure 400 {object} response.ErrorResponse "No hours logged"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Not the caller's timesheet"
+// @Failure 404 {object} response.ErrorResponse "Organization or timesheet not found"
+// @Failure 409 {object} response.ErrorResponse "Timesheet is not a draft"
+// @Router /organizations/{id}/timesheets/{timesheet_id}/submit [post]
+func (h *TimesheetHandler) SubmitTimesheet(ctx *gin.Context) {
+	userID, orgID, timesheetID, ok := parseOrganizationResourcePath(ctx, "timesheet_id")
+	if !ok {
+		return
+	}
+
+	timesheet, err := h.timesheetService.SubmitTimesheet(ctx, userID, orgID, timesheetID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to submit timesheet")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Timesheet submitted",
+		Data:    mapTimesheetToResponse(*timesheet),
+	})
+}
+
+// ApproveTimesheet godoc
+// @Summary Approve a timesheet
+// @Description Approve a submitted timesheet so its hours can be invoiced. Nobody approves their own timesheet. (owners, admins and finance)
+// @Tags timesheets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param timesheet_id path string true "Timesheet ID"
+// @Success 200 {object} response.SuccessResponse{data=response.TimesheetResponse} "Timesheet approved"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed or the caller's own timesheet"
+// @Failure 404 {object} response.ErrorResponse "Organization or timesheet not found"
+// @Failure 409 {object} response.ErrorResponse "Timesheet is not awaiting review"
+// @Router /organizations/{id}/timesheets/{timesheet_id}/approve [post]
+func (h *TimesheetHandler) ApproveTimesheet(ctx *gin.Context) {
+	userID, orgID, timesheetID, ok := parseOrganizationResourcePath(ctx, "timesheet_id")
+	if !ok {
+		return
+	}
+
+	timesheet, err := h.timesheetService.ApproveTimesheet(ctx, userID, orgID, timesheetID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to approve timesheet")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Timesheet approved",
+		Data:    mapTimesheetToResponse(*timesheet),
+	})
+}
+
+// RejectTimesheet godoc
+// @Summary Reject a timesheet
+// @Description Send a submitted timesheet back to the contractor with the reason, so they can correct and resubmit it. Nobody rejects their own timesheet. (owners, admins and finance)
+// @Tags timesheets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param timesheet_id path string true "Timesheet ID"
+// @Param request body request.RejectTimesheetRequest true "Reason"
+// @Success 200 {object} response.SuccessResponse{data=response.TimesheetResponse} "Timesheet rejected"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed or the caller's own timesheet"
+// @Failure 404 {object} response.ErrorResponse "Organization or timesheet not found"
+// @Failure 409 {object} response.ErrorResponse "Timesheet is not awaiting review"
+// @Router /organizations/{id}/timesheets/{timesheet_id}/reject [post]
+func (h *TimesheetHandler) RejectTimesheet(ctx *gin.Context) {
+	userID, orgID, timesheetID, ok := parseOrganizationResourcePath(ctx, "timesheet_id")
+	if !ok {
+		return
+	}
+
+	var req request.RejectTimesheetRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	timesheet, err := h.timesheetService.RejectTimesheet(ctx, userID, orgID, timesheetID, req.Reason)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to reject timesheet")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Timesheet rejected",
+		Data:    mapTimesheetToResponse(*timesheet),
+	})
+}
+
+// InvoiceTimesheets godoc
+// @Summary Invoice approved timesheets
+// @Description Raise a draft invoice to the client of approved timesheets, one line per timesheet billing its hours at the contract's hourly rate. The timesheets must share a client and currency; the invoice takes the rest of its details from the client and can be edited before it is sent. Voiding it returns the timesheets to approved. (owners, admins and finance)
+// @Tags timesheets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Organization ID"
+// @Param request body request.InvoiceTimesheetsRequest true "Timesheets to invoice"
+// @Success 201 {object} response.SuccessResponse{data=response.InvoiceResponse} "Invoice created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Role not allowed"
+// @Failure 404 {object} response.ErrorResponse "Organization or timesheet not found"
+// @Failure 409 {object} response.ErrorResponse "A timesheet is not approved or was already invoiced"
+// @Router /organizations/{id}/timesheets/invoice [post]
+func (h *TimesheetHandler) InvoiceTimesheets(ctx *gin.Context) {
+	userID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	orgID, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var req request.InvoiceTimesheetsRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	invoice, err := h.timesheetService.InvoiceTimesheets(ctx, userID, orgID, req.TimesheetIDs)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to invoice timesheets")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Invoice created",
+		Data:    mapInvoiceToResponse(*invoice),
+	})
+}
+
+func mapContractorContractToResponse(contract domain.ContractorContract) response.ContractorContractResponse {
+	return response.ContractorContractResponse{
+		ID:           contract.ID,
+		ContractorID: contract.ContractorID,
+		Email:        contract.Email,
+		FirstName:    contract.FirstName,
+		LastName:     contract.LastName,
+		ClientID:     contract.ClientID,
+		ClientName:   contract.ClientName,
+		Project:      contract.Project,
+		HourlyRate:   contract.HourlyRate.Amount().String(),
+		Currency:     contract.HourlyRate.Currency(),
+		Active:       contract.Active,
+		CreatedAt:    contract.CreatedAt,
+		UpdatedAt:    contract.UpdatedAt,
+	}
+}
+
+func mapTimesheetToResponse(timesheet domain.Timesheet) response.TimesheetResponse {
+	timesheetResponse := response.TimesheetResponse{
+		ID:              timesheet.ID,
+		ContractID:      timesheet.ContractID,
+		ContractorID:    timesheet.ContractorID,
+		Email:           timesheet.Email,
+		FirstName:       timesheet.FirstName,
+		LastName:        timesheet.LastName,
+		Project:         timesheet.Project,
+		WeekStart:       timesheet.WeekStart,
+		WeekEnd:         timesheet.WeekEnd(),
+		Status:          string(timesheet.Status),
+		TotalHours:      timesheet.TotalHours.String(),
+		SubmittedAt:     timesheet.SubmittedAt,
+		ReviewedBy:      timesheet.ReviewedBy,
+		ReviewedAt:      timesheet.ReviewedAt,
+		RejectionReason: timesheet.RejectionReason,
+		InvoiceID:       timesheet.InvoiceID,
+		CreatedAt:       timesheet.CreatedAt,
+		UpdatedAt:       timesheet.UpdatedAt,
+	}
+
+	if timesheet.Entries != nil {
+		timesheetResponse.Entries = make([]response.TimesheetEntryResponse, len(timesheet.Entries))
+		for i, entry := range timesheet.Entries {
+			timesheetResponse.Entries[i] = response.TimesheetEntryResponse{
+				WorkDate:    entry.WorkDate,
+				Hours:       entry.Hours.String(),
+				Description: entry.Description,
+			}
+		}
+	}
+
+	return timesheetResponse
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionHandler struct {
+	txService ports.TransactionService
+	logger    logging.Logger
+}
+
+// NewTransactionHandler creates a new transaction handler
+func NewTransactionHandler(txService ports.TransactionService, logger logging.Logger) *TransactionHandler {
+	return &TransactionHandler{
+		txService: txService,
+		logger:    logger,
+	}
+}
+
+// ListTransactions godoc
+// @Summary List transactions
+// @Description List the authenticated user's transactions with pagination and filtering
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Param status query string false "Filter by status (created, pending, confirmed, failed, not_found)"
+// @Param from query string false "Only transactions created at or after this RFC3339 time"
+// @Param to query string false "Only transactions created before this RFC3339 time"
+// @Success 200 {object} response.PageResponse{items=[]response.TransactionResponse} "Paginated list of transactions"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /transactions [get]
+func (h *TransactionHandler) ListTransactions(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	page, pageSize := parsePagination(ctx)
+
+	createdFrom, ok := parseTimeQuery(ctx, "from")
+	if !ok {
+		return
+	}
+
+	createdTo, ok := parseTimeQuery(ctx, "to")
+	if !ok {
+		return
+	}
+
+	filter := domain.TransactionFilter{
+		Status:      domain.TransactionStatus(ctx.Query("status")),
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+	}
+
+	txs, total, err := h.txService.ListTransactions(ctx, userUUID, page, pageSize, filter)
+	if err != nil {
+		h.logger.Error("Failed to list transactions", err, map[string]interface{}{
+			"user_id": userUUID,
+		})
+		respondWithError(ctx, err, "Failed to retrieve transactions")
+		return
+	}
+
+	txResponses := make([]response.TransactionResponse, len(txs))
+	for i, tx := range txs {
+		txResponses[i] = mapTransactionToResponse(tx)
+	}
+
+	ctx.JSON(http.StatusOK, newPageResponse(page, pageSize, total, txResponses))
+}
+
+// GetTransaction godoc
+// @Summary Get a transaction
+// @Description Get a single transaction owned by the authenticated user
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} response.SuccessResponse{data=response.TransactionResponse} "Transaction retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Transaction not found"
+// @Router /transactions/{id} [get]
+func (h *TransactionHandler) GetTransaction(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	id, ok := parseUUIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	tx, err := h.txService.GetTransaction(ctx, userUUID, id)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve transaction")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Transaction retrieved",
+		Data:    mapTransactionToResponse(*tx),
+	})
+}
+
+// mapTransactionToResponse maps a domain transaction to its response DTO
+func mapTransactionToResponse(tx domain.Transaction) response.TransactionResponse {
+	resp := response.TransactionResponse{
+		ID:           tx.ID,
+		TxHash:       tx.TxHash,
+		Status:       string(tx.Status),
+		Direction:    string(tx.Direction),
+		TokenAddress: tx.TokenAddress,
+		FromAddress:  tx.FromAddress,
+		ToAddress:    tx.ToAddress,
+		BlockNumber:  tx.BlockNumber,
+		CreatedAt:    tx.CreatedAt,
+		UpdatedAt:    tx.UpdatedAt,
+	}
+
+	if tx.Amount != nil {
+		resp.Amount = tx.Amount.String()
+	}
+
+	return resp
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/adapters/dto/request"
+	"github.com/demola234/defifundr/internal/adapters/dto/response"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionPINHandler struct {
+	pinService ports.TransactionPINService
+	logger     logging.Logger
+}
+
+// NewTransactionPINHandler creates a new transaction PIN handler
+func NewTransactionPINHandler(pinService ports.TransactionPINService, logger logging.Logger) *TransactionPINHandler {
+	return &TransactionPINHandler{
+		pinService: pinService,
+		logger:     logger,
+	}
+}
+
+// GetPINService returns the service the transaction PIN middleware verifies against
+func (h *TransactionPINHandler) GetPINService() ports.TransactionPINService {
+	return h.pinService
+}
+
+// GetPINStatus godoc
+// @Summary Get transaction PIN status
+// @Description Report whether the authenticated user has set a transaction PIN and whether it is locked
+// @Tags transaction-pin
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} response.SuccessResponse{data=response.TransactionPINStatusResponse} "Transaction PIN status"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /transaction-pin [get]
+func (h *TransactionPINHandler) GetPINStatus(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	status, err := h.pinService.GetPINStatus(ctx, userUUID)
+	if err != nil {
+		respondWithError(ctx, err, "Failed to retrieve transaction PIN status")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Transaction PIN status retrieved",
+		Data: response.TransactionPINStatusResponse{
+			IsSet:       status.IsSet,
+			Locked:      status.LockedUntil != nil,
+			LockedUntil: status.LockedUntil,
+		},
+	})
+}
+
+// SetPIN godoc
+// @Summary Set transaction PIN
+// @Description Set the 6 digit PIN required before initiating payouts
+// @Tags transaction-pin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.SetTransactionPINRequest true "New PIN"
+// @Success 201 {object} response.SuccessResponse "Transaction PIN set"
+// @Failure 400 {object} response.ErrorResponse "Invalid PIN"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ErrorResponse "Transaction PIN already set"
+// @Router /transaction-pin [post]
+func (h *TransactionPINHandler) SetPIN(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.SetTransactionPINRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	if err := h.pinService.SetPIN(ctx, userUUID, req.PIN); err != nil {
+		respondWithError(ctx, err, "Failed to set transaction PIN")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Transaction PIN set",
+	})
+}
+
+// ChangePIN godoc
+// @Summary Change transaction PIN
+// @Description Replace the transaction PIN after confirming the current one
+// @Tags transaction-pin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.ChangeTransactionPINRequest true "Current and new PIN"
+// @Success 200 {object} response.SuccessResponse "Transaction PIN changed"
+// @Failure 400 {object} response.ErrorResponse "Invalid PIN"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Wrong or locked PIN"
+// @Router /transaction-pin [put]
+func (h *TransactionPINHandler) ChangePIN(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.ChangeTransactionPINRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	if err := h.pinService.ChangePIN(ctx, userUUID, req.CurrentPIN, req.NewPIN); err != nil {
+		respondWithError(ctx, err, "Failed to change transaction PIN")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Transaction PIN changed",
+	})
+}
+
+// RequestPINReset godoc
+// @Summary Request a transaction PIN reset
+// @Description Email a one-time code that authorises resetting the transaction PIN
+// @Tags transaction-pin
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} response.SuccessResponse "Reset code sent"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /transaction-pin/reset/request [post]
+func (h *TransactionPINHandler) RequestPINReset(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.pinService.RequestPINReset(ctx, userUUID); err != nil {
+		h.logger.Error("Failed to request transaction PIN reset", err, map[string]interface{}{
+			"user_id": userUUID,
+		})
+		respondWithError(ctx, err, "Failed to send transaction PIN reset code")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "A reset code has been sent to your email",
+	})
+}
+
+// ResetPIN godoc
+// @Summary Reset transaction PIN
+// @Description Set a new transaction PIN using the emailed one-time code. This also lifts any lockout.
+// @Tags transaction-pin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body request.ResetTransactionPINRequest true "OTP and new PIN"
+// @Success 200 {object} response.SuccessResponse "Transaction PIN reset"
+// @Failure 400 {object} response.ErrorResponse "Invalid OTP or PIN"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Router /transaction-pin/reset [post]
+func (h *TransactionPINHandler) ResetPIN(ctx *gin.Context) {
+	userUUID, ok := getAuthenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.ResetTransactionPINRequest
+	if !bindJSON(ctx, &req) {
+		return
+	}
+
+	if err := h.pinService.ResetPIN(ctx, userUUID, req.OTP, req.NewPIN); err != nil {
+		respondWithError(ctx, err, "Failed to reset transaction PIN")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Transaction PIN reset",
+	})
+}
@@ -0,0 +1,406 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ApprovalRepository persists approval policies, requests and decisions. It
+// needs a db.Store so a request and the status of its pay run change together.
+type ApprovalRepository struct {
+	store db.Store
+}
+
+func NewApprovalRepository(store db.Store) *ApprovalRepository {
+	return &ApprovalRepository{
+		store: store,
+	}
+}
+
+// CreatePolicy creates an approval policy
+func (r *ApprovalRepository) CreatePolicy(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	params := db.CreateApprovalPolicyParams{
+		ID:                  policy.ID,
+		OrganizationID:      policy.OrganizationID,
+		Name:                policy.Name,
+		Currency:            policy.Currency,
+		MinTotalAmount:      toPgNumeric(policy.MinTotalAmount),
+		MinPayoutAmount:     toPgNumeric(policy.MinPayoutAmount),
+		RequiredApprovals:   int32(policy.RequiredApprovals),
+		ApproverIds:         policy.ApproverIDs,
+		ApprovalWindowHours: int32(policy.ApprovalWindowHours),
+		Active:              policy.Active,
+	}
+	if policy.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *policy.CreatedBy, Valid: true}
+	}
+
+	dbPolicy, err := r.store.CreateApprovalPolicy(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create approval policy: %w", err)
+	}
+
+	return mapDBApprovalPolicyToDomain(dbPolicy)
+}
+
+// GetPolicy retrieves an approval policy, or nil if there is none
+func (r *ApprovalRepository) GetPolicy(ctx context.Context, id uuid.UUID) (*domain.ApprovalPolicy, error) {
+	dbPolicy, err := r.store.GetApprovalPolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get approval policy: %w", err)
+	}
+
+	return mapDBApprovalPolicyToDomain(dbPolicy)
+}
+
+// ListPolicies lists an organization's approval policies, active ones first
+func (r *ApprovalRepository) ListPolicies(ctx context.Context, orgID uuid.UUID) ([]domain.ApprovalPolicy, error) {
+	dbPolicies, err := r.store.ListApprovalPoliciesByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval policies: %w", err)
+	}
+
+	policies := make([]domain.ApprovalPolicy, len(dbPolicies))
+	for i, dbPolicy := range dbPolicies {
+		policy, err := mapDBApprovalPolicyToDomain(dbPolicy)
+		if err != nil {
+			return nil, err
+		}
+		policies[i] = *policy
+	}
+
+	return policies, nil
+}
+
+// UpdatePolicy replaces an approval policy's settings
+func (r *ApprovalRepository) UpdatePolicy(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	dbPolicy, err := r.store.UpdateApprovalPolicy(ctx, db.UpdateApprovalPolicyParams{
+		ID:                  policy.ID,
+		Name:                policy.Name,
+		Currency:            policy.Currency,
+		MinTotalAmount:      toPgNumeric(policy.MinTotalAmount),
+		MinPayoutAmount:     toPgNumeric(policy.MinPayoutAmount),
+		RequiredApprovals:   int32(policy.RequiredApprovals),
+		ApproverIds:         policy.ApproverIDs,
+		ApprovalWindowHours: int32(policy.ApprovalWindowHours),
+		Active:              policy.Active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update approval policy: %w", err)
+	}
+
+	return mapDBApprovalPolicyToDomain(dbPolicy)
+}
+
+// CreateRequest submits a draft pay run for approval
+func (r *ApprovalRepository) CreateRequest(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+	var result *domain.ApprovalRequest
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		_, err := q.UpdatePayRunStatus(ctx, db.UpdatePayRunStatusParams{
+			ID:         request.PayRunID,
+			FromStatus: string(domain.PayRunStatusDraft),
+			ToStatus:   string(domain.PayRunStatusPendingApproval),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Submitted by someone else in the meantime
+				return nil
+			}
+			return fmt.Errorf("failed to update pay run status: %w", err)
+		}
+
+		if err := lockPayRunPayouts(ctx, q, lineItems); err != nil {
+			return err
+		}
+
+		params := db.CreateApprovalRequestParams{
+			ID:                request.ID,
+			OrganizationID:    request.OrganizationID,
+			PayRunID:          request.PayRunID,
+			RequiredApprovals: int32(request.RequiredApprovals),
+			ApproverIds:       request.ApproverIDs,
+			RequestedBy:       request.RequestedBy,
+			ExpiresAt:         request.ExpiresAt,
+		}
+		if request.PolicyID != nil {
+			params.PolicyID = pgtype.UUID{Bytes: *request.PolicyID, Valid: true}
+		}
+
+		dbRequest, err := q.CreateApprovalRequest(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create approval request: %w", err)
+		}
+
+		result = mapDBApprovalRequestToDomain(dbRequest)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetRequest retrieves an approval request with its decisions, or nil if there is none
+func (r *ApprovalRepository) GetRequest(ctx context.Context, id uuid.UUID) (*domain.ApprovalRequest, error) {
+	dbRequest, err := r.store.GetApprovalRequestByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get approval request: %w", err)
+	}
+
+	rows, err := r.store.ListApprovalDecisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval decisions: %w", err)
+	}
+
+	request := mapDBApprovalRequestToDomain(dbRequest)
+	request.Decisions = make([]domain.ApprovalDecision, len(rows))
+	for i, row := range rows {
+		request.Decisions[i] = mapDBApprovalDecisionToDomain(row)
+	}
+
+	return request, nil
+}
+
+// ListRequests lists an organization's approval requests, newest first, without decisions
+func (r *ApprovalRepository) ListRequests(ctx context.Context, orgID uuid.UUID, status *domain.ApprovalStatus, limit, offset int) ([]domain.ApprovalRequest, int64, error) {
+	var statusFilter pgtype.Text
+	if status != nil {
+		statusFilter = pgtype.Text{String: string(*status), Valid: true}
+	}
+
+	dbRequests, err := r.store.ListApprovalRequestsByOrganization(ctx, db.ListApprovalRequestsByOrganizationParams{
+		OrganizationID: orgID,
+		Status:         statusFilter,
+		LimitCount:     int32(limit),
+		OffsetCount:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list approval requests: %w", err)
+	}
+
+	total, err := r.store.CountApprovalRequestsByOrganization(ctx, db.CountApprovalRequestsByOrganizationParams{
+		OrganizationID: orgID,
+		Status:         statusFilter,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count approval requests: %w", err)
+	}
+
+	requests := make([]domain.ApprovalRequest, len(dbRequests))
+	for i, dbRequest := range dbRequests {
+		requests[i] = *mapDBApprovalRequestToDomain(dbRequest)
+	}
+
+	return requests, total, nil
+}
+
+// RecordDecision records a decision on a pending request and resolves it when
+// the outcome is settled. The request row is locked, so concurrent approvals
+// are counted one at a time.
+func (r *ApprovalRepository) RecordDecision(ctx context.Context, decision domain.ApprovalDecision, now time.Time) (*domain.ApprovalRequest, error) {
+	recorded := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbRequest, err := q.GetApprovalRequestForUpdate(ctx, decision.RequestID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock approval request: %w", err)
+		}
+
+		if dbRequest.Status != string(domain.ApprovalStatusPending) || !now.Before(dbRequest.ExpiresAt) {
+			return nil
+		}
+
+		_, err = q.CreateApprovalDecision(ctx, db.CreateApprovalDecisionParams{
+			ID:         decision.ID,
+			RequestID:  decision.RequestID,
+			ApproverID: decision.ApproverID,
+			Decision:   string(decision.Decision),
+			Comment:    toPgText(decision.Comment),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// The approver already decided
+				return nil
+			}
+			return fmt.Errorf("failed to record approval decision: %w", err)
+		}
+		recorded = true
+
+		outcome := domain.ApprovalStatusRejected
+		runStatus := domain.PayRunStatusDraft
+		if decision.Decision == domain.ApprovalStatusApproved {
+			approvals, err := q.CountApprovalDecisions(ctx, db.CountApprovalDecisionsParams{
+				RequestID: decision.RequestID,
+				Decision:  string(domain.ApprovalStatusApproved),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to count approvals: %w", err)
+			}
+			if approvals < int64(dbRequest.RequiredApprovals) {
+				return nil
+			}
+			outcome = domain.ApprovalStatusApproved
+			runStatus = domain.PayRunStatusApproved
+		}
+
+		if _, err := q.ResolveApprovalRequest(ctx, db.ResolveApprovalRequestParams{
+			ID:     dbRequest.ID,
+			Status: string(outcome),
+		}); err != nil {
+			return fmt.Errorf("failed to resolve approval request: %w", err)
+		}
+
+		if _, err := q.UpdatePayRunStatus(ctx, db.UpdatePayRunStatusParams{
+			ID:         dbRequest.PayRunID,
+			FromStatus: string(domain.PayRunStatusPendingApproval),
+			ToStatus:   string(runStatus),
+		}); err != nil {
+			return fmt.Errorf("failed to update pay run status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !recorded {
+		return nil, nil
+	}
+
+	return r.GetRequest(ctx, decision.RequestID)
+}
+
+// ExpireRequests expires stale pending requests and returns their pay runs to draft
+func (r *ApprovalRepository) ExpireRequests(ctx context.Context, now time.Time) ([]domain.ApprovalRequest, error) {
+	var expired []domain.ApprovalRequest
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbRequests, err := q.ExpireApprovalRequests(ctx, now)
+		if err != nil {
+			return fmt.Errorf("failed to expire approval requests: %w", err)
+		}
+
+		expired = make([]domain.ApprovalRequest, len(dbRequests))
+		for i, dbRequest := range dbRequests {
+			_, err := q.UpdatePayRunStatus(ctx, db.UpdatePayRunStatusParams{
+				ID:         dbRequest.PayRunID,
+				FromStatus: string(domain.PayRunStatusPendingApproval),
+				ToStatus:   string(domain.PayRunStatusDraft),
+			})
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to update pay run status: %w", err)
+			}
+			expired[i] = *mapDBApprovalRequestToDomain(dbRequest)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
+func toPgNumeric(amount *money.Money) pgtype.Numeric {
+	if amount == nil {
+		return pgtype.Numeric{}
+	}
+	return amount.Numeric()
+}
+
+func mapDBApprovalPolicyToDomain(policy db.ApprovalPolicies) (*domain.ApprovalPolicy, error) {
+	result := &domain.ApprovalPolicy{
+		ID:                  policy.ID,
+		OrganizationID:      policy.OrganizationID,
+		Name:                policy.Name,
+		Currency:            policy.Currency,
+		RequiredApprovals:   int(policy.RequiredApprovals),
+		ApproverIDs:         policy.ApproverIds,
+		ApprovalWindowHours: int(policy.ApprovalWindowHours),
+		Active:              policy.Active,
+		CreatedAt:           policy.CreatedAt,
+		UpdatedAt:           policy.UpdatedAt,
+	}
+
+	if policy.MinTotalAmount.Valid {
+		amount, err := money.FromNumeric(policy.MinTotalAmount, policy.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read approval policy threshold: %w", err)
+		}
+		result.MinTotalAmount = &amount
+	}
+	if policy.MinPayoutAmount.Valid {
+		amount, err := money.FromNumeric(policy.MinPayoutAmount, policy.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read approval policy threshold: %w", err)
+		}
+		result.MinPayoutAmount = &amount
+	}
+	if policy.CreatedBy.Valid {
+		createdBy := uuid.UUID(policy.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result, nil
+}
+
+func mapDBApprovalRequestToDomain(request db.ApprovalRequests) *domain.ApprovalRequest {
+	result := &domain.ApprovalRequest{
+		ID:                request.ID,
+		OrganizationID:    request.OrganizationID,
+		PayRunID:          request.PayRunID,
+		RequiredApprovals: int(request.RequiredApprovals),
+		ApproverIDs:       request.ApproverIds,
+		Status:            domain.ApprovalStatus(request.Status),
+		RequestedBy:       request.RequestedBy,
+		ExpiresAt:         request.ExpiresAt,
+		CreatedAt:         request.CreatedAt,
+		UpdatedAt:         request.UpdatedAt,
+	}
+
+	if request.PolicyID.Valid {
+		policyID := uuid.UUID(request.PolicyID.Bytes)
+		result.PolicyID = &policyID
+	}
+	if request.DecidedAt.Valid {
+		result.DecidedAt = &request.DecidedAt.Time
+	}
+
+	return result
+}
+
+func mapDBApprovalDecisionToDomain(row db.ListApprovalDecisionsRow) domain.ApprovalDecision {
+	return domain.ApprovalDecision{
+		ID:         row.ApprovalDecisions.ID,
+		RequestID:  row.ApprovalDecisions.RequestID,
+		ApproverID: row.ApprovalDecisions.ApproverID,
+		Email:      row.Email,
+		FirstName:  row.FirstName,
+		LastName:   row.LastName,
+		Decision:   domain.ApprovalStatus(row.ApprovalDecisions.Decision),
+		Comment:    getTextString(row.ApprovalDecisions.Comment),
+		CreatedAt:  row.ApprovalDecisions.CreatedAt,
+	}
+}
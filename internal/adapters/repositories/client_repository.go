@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ClientRepository struct {
+	store db.Store
+}
+
+func NewClientRepository(store db.Store) *ClientRepository {
+	return &ClientRepository{
+		store: store,
+	}
+}
+
+// CreateClient adds a client to the organization's directory. It returns nil
+// if the organization already has a client with the same email or tax ID.
+func (r *ClientRepository) CreateClient(ctx context.Context, client domain.Client) (*domain.Client, error) {
+	params := db.CreateClientParams{
+		ID:                client.ID,
+		OrganizationID:    client.OrganizationID,
+		Name:              client.Name,
+		Email:             client.Email,
+		CcEmails:          clientCCEmails(client),
+		TaxID:             client.TaxID,
+		AddressLine1:      client.BillingAddress.Line1,
+		AddressLine2:      client.BillingAddress.Line2,
+		City:              client.BillingAddress.City,
+		Region:            client.BillingAddress.Region,
+		PostalCode:        client.BillingAddress.PostalCode,
+		Country:           client.BillingAddress.Country,
+		PreferredCurrency: client.PreferredCurrency,
+		PaymentTermsDays:  int32(client.PaymentTermsDays),
+		Notes:             client.Notes,
+	}
+	if client.PreferredAssetID != nil {
+		params.PreferredAssetID = pgtype.UUID{Bytes: *client.PreferredAssetID, Valid: true}
+	}
+	if client.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *client.CreatedBy, Valid: true}
+	}
+
+	dbClient, err := r.store.CreateClient(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return mapDBClientToDomain(dbClient), nil
+}
+
+// GetClient retrieves one of the organization's clients, or nil if there is none
+func (r *ClientRepository) GetClient(ctx context.Context, orgID, id uuid.UUID) (*domain.Client, error) {
+	dbClient, err := r.store.GetClient(ctx, db.GetClientParams{
+		OrganizationID: orgID,
+		ID:             id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	return mapDBClientToDomain(dbClient), nil
+}
+
+// FindDuplicateClient finds a client of the organization other than excludeID
+// with the given email or tax ID, or nil if there is none
+func (r *ClientRepository) FindDuplicateClient(ctx context.Context, orgID uuid.UUID, email, taxID string, excludeID uuid.UUID) (*domain.Client, error) {
+	dbClient, err := r.store.FindDuplicateClient(ctx, db.FindDuplicateClientParams{
+		OrganizationID: orgID,
+		ExcludeID:      excludeID,
+		Email:          email,
+		TaxID:          taxID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find duplicate client: %w", err)
+	}
+
+	return mapDBClientToDomain(dbClient), nil
+}
+
+// ListClients lists an organization's clients by name, optionally only those
+// whose name, email or tax ID contains search
+func (r *ClientRepository) ListClients(ctx context.Context, orgID uuid.UUID, search string, limit, offset int) ([]domain.Client, int64, error) {
+	searchFilter := toPgText(search)
+
+	dbClients, err := r.store.ListClients(ctx, db.ListClientsParams{
+		OrganizationID: orgID,
+		Search:         searchFilter,
+		LimitCount:     int32(limit),
+		OffsetCount:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list clients: %w", err)
+	}
+
+	total, err := r.store.CountClients(ctx, db.CountClientsParams{
+		OrganizationID: orgID,
+		Search:         searchFilter,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count clients: %w", err)
+	}
+
+	clients := make([]domain.Client, len(dbClients))
+	for i, dbClient := range dbClients {
+		clients[i] = *mapDBClientToDomain(dbClient)
+	}
+
+	return clients, total, nil
+}
+
+// UpdateClient replaces a client's details. It returns nil if the client is
+// not in the organization's directory.
+func (r *ClientRepository) UpdateClient(ctx context.Context, client domain.Client) (*domain.Client, error) {
+	params := db.UpdateClientParams{
+		OrganizationID:    client.OrganizationID,
+		ID:                client.ID,
+		Name:              client.Name,
+		Email:             client.Email,
+		CcEmails:          clientCCEmails(client),
+		TaxID:             client.TaxID,
+		AddressLine1:      client.BillingAddress.Line1,
+		AddressLine2:      client.BillingAddress.Line2,
+		City:              client.BillingAddress.City,
+		Region:            client.BillingAddress.Region,
+		PostalCode:        client.BillingAddress.PostalCode,
+		Country:           client.BillingAddress.Country,
+		PreferredCurrency: client.PreferredCurrency,
+		PaymentTermsDays:  int32(client.PaymentTermsDays),
+		Notes:             client.Notes,
+	}
+	if client.PreferredAssetID != nil {
+		params.PreferredAssetID = pgtype.UUID{Bytes: *client.PreferredAssetID, Valid: true}
+	}
+
+	dbClient, err := r.store.UpdateClient(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update client: %w", err)
+	}
+
+	return mapDBClientToDomain(dbClient), nil
+}
+
+// DeleteClient removes a client from the organization's directory, reporting
+// whether it was there
+func (r *ClientRepository) DeleteClient(ctx context.Context, orgID, id uuid.UUID) (bool, error) {
+	deleted, err := r.store.DeleteClient(ctx, db.DeleteClientParams{
+		OrganizationID: orgID,
+		ID:             id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete client: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+// clientCCEmails never hands the database a nil array, which the NOT NULL column rejects
+func clientCCEmails(client domain.Client) []string {
+	if client.CCEmails == nil {
+		return []string{}
+	}
+	return client.CCEmails
+}
+
+func mapDBClientToDomain(client db.Clients) *domain.Client {
+	result := &domain.Client{
+		ID:             client.ID,
+		OrganizationID: client.OrganizationID,
+		Name:           client.Name,
+		Email:          client.Email,
+		CCEmails:       client.CcEmails,
+		TaxID:          client.TaxID,
+		BillingAddress: domain.ClientAddress{
+			Line1:      client.AddressLine1,
+			Line2:      client.AddressLine2,
+			City:       client.City,
+			Region:     client.Region,
+			PostalCode: client.PostalCode,
+			Country:    client.Country,
+		},
+		PreferredCurrency: client.PreferredCurrency,
+		PaymentTermsDays:  int(client.PaymentTermsDays),
+		Notes:             client.Notes,
+		CreatedAt:         client.CreatedAt,
+		UpdatedAt:         client.UpdatedAt,
+	}
+
+	if client.PreferredAssetID.Valid {
+		assetID := uuid.UUID(client.PreferredAssetID.Bytes)
+		result.PreferredAssetID = &assetID
+	}
+	if client.CreatedBy.Valid {
+		createdBy := uuid.UUID(client.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type FXRateRepository struct {
+	store db.Queries
+}
+
+func NewFXRateRepository(store db.Queries) *FXRateRepository {
+	return &FXRateRepository{
+		store: store,
+	}
+}
+
+// SaveRate records a fetched exchange rate
+func (r *FXRateRepository) SaveRate(ctx context.Context, rate domain.FXRate) (*domain.FXRate, error) {
+	dbRate, err := r.store.CreateFXRate(ctx, db.CreateFXRateParams{
+		ID:            rate.ID,
+		BaseCurrency:  rate.Base,
+		QuoteCurrency: rate.Quote,
+		Rate:          rate.Rate,
+		Source:        rate.Source,
+		FetchedAt:     rate.FetchedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save fx rate: %w", err)
+	}
+
+	return mapDBFXRateToDomain(dbRate), nil
+}
+
+// GetLatestRate retrieves the most recent rate for a pair, or nil if none was ever fetched
+func (r *FXRateRepository) GetLatestRate(ctx context.Context, base, quote string) (*domain.FXRate, error) {
+	dbRate, err := r.store.GetLatestFXRate(ctx, db.GetLatestFXRateParams{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest fx rate: %w", err)
+	}
+
+	return mapDBFXRateToDomain(dbRate), nil
+}
+
+// ListLatestRates lists the most recent rate of every pair with the given base
+func (r *FXRateRepository) ListLatestRates(ctx context.Context, base string) ([]domain.FXRate, error) {
+	dbRates, err := r.store.ListLatestFXRates(ctx, base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list latest fx rates: %w", err)
+	}
+
+	rates := make([]domain.FXRate, len(dbRates))
+	for i, dbRate := range dbRates {
+		rates[i] = *mapDBFXRateToDomain(dbRate)
+	}
+
+	return rates, nil
+}
+
+// ListRateHistory lists the rates of a pair fetched in [from, to), newest first
+func (r *FXRateRepository) ListRateHistory(ctx context.Context, base, quote string, from, to time.Time, limit int) ([]domain.FXRate, error) {
+	dbRates, err := r.store.ListFXRateHistory(ctx, db.ListFXRateHistoryParams{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		FetchedFrom:   from,
+		FetchedTo:     to,
+		RowLimit:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fx rate history: %w", err)
+	}
+
+	rates := make([]domain.FXRate, len(dbRates))
+	for i, dbRate := range dbRates {
+		rates[i] = *mapDBFXRateToDomain(dbRate)
+	}
+
+	return rates, nil
+}
+
+// CreateQuote stores a locked quote
+func (r *FXRateRepository) CreateQuote(ctx context.Context, quote domain.FXQuote) (*domain.FXQuote, error) {
+	dbQuote, err := r.store.CreateFXQuote(ctx, db.CreateFXQuoteParams{
+		ID:            quote.ID,
+		BaseCurrency:  quote.Base,
+		QuoteCurrency: quote.Quote,
+		Rate:          quote.Rate,
+		Source:        quote.Source,
+		ExpiresAt:     quote.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fx quote: %w", err)
+	}
+
+	return mapDBFXQuoteToDomain(dbQuote), nil
+}
+
+// GetQuote retrieves a locked quote by ID, or nil if there is none
+func (r *FXRateRepository) GetQuote(ctx context.Context, id uuid.UUID) (*domain.FXQuote, error) {
+	dbQuote, err := r.store.GetFXQuoteByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get fx quote: %w", err)
+	}
+
+	return mapDBFXQuoteToDomain(dbQuote), nil
+}
+
+func mapDBFXRateToDomain(rate db.FxRates) *domain.FXRate {
+	return &domain.FXRate{
+		ID:        rate.ID,
+		Base:      rate.BaseCurrency,
+		Quote:     rate.QuoteCurrency,
+		Rate:      rate.Rate,
+		Source:    rate.Source,
+		FetchedAt: rate.FetchedAt,
+	}
+}
+
+func mapDBFXQuoteToDomain(quote db.FxQuotes) *domain.FXQuote {
+	return &domain.FXQuote{
+		ID:        quote.ID,
+		Base:      quote.BaseCurrency,
+		Quote:     quote.QuoteCurrency,
+		Rate:      quote.Rate,
+		Source:    quote.Source,
+		ExpiresAt: quote.ExpiresAt,
+		CreatedAt: quote.CreatedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+type IndexerCheckpointRepository struct {
+	store db.Queries
+}
+
+func NewIndexerCheckpointRepository(store db.Queries) *IndexerCheckpointRepository {
+	return &IndexerCheckpointRepository{
+		store: store,
+	}
+}
+
+// GetCheckpoint returns the named indexer's checkpoint, or nil if it has never run
+func (r *IndexerCheckpointRepository) GetCheckpoint(ctx context.Context, name string) (*domain.IndexerCheckpoint, error) {
+	checkpoint, err := r.store.GetIndexerCheckpoint(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get indexer checkpoint: %w", err)
+	}
+
+	return mapDBIndexerCheckpointToDomain(checkpoint), nil
+}
+
+// SaveCheckpoint records the last block the indexer has fully processed
+func (r *IndexerCheckpointRepository) SaveCheckpoint(ctx context.Context, checkpoint domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error) {
+	saved, err := r.store.UpsertIndexerCheckpoint(ctx, db.UpsertIndexerCheckpointParams{
+		Name:        checkpoint.Name,
+		BlockNumber: int64(checkpoint.BlockNumber),
+		BlockHash:   checkpoint.BlockHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save indexer checkpoint: %w", err)
+	}
+
+	return mapDBIndexerCheckpointToDomain(saved), nil
+}
+
+// Helper to map DB indexer checkpoint to domain
+func mapDBIndexerCheckpointToDomain(checkpoint db.IndexerCheckpoints) *domain.IndexerCheckpoint {
+	return &domain.IndexerCheckpoint{
+		Name:        checkpoint.Name,
+		BlockNumber: uint64(checkpoint.BlockNumber),
+		BlockHash:   checkpoint.BlockHash,
+		UpdatedAt:   checkpoint.UpdatedAt,
+	}
+}
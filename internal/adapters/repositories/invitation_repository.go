@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InvitationRepository persists organization invitations. It needs a db.Store
+// so accepting an invitation and adding the member happen together.
+type InvitationRepository struct {
+	store db.Store
+}
+
+func NewInvitationRepository(store db.Store) *InvitationRepository {
+	return &InvitationRepository{
+		store: store,
+	}
+}
+
+// CreateInvitation records a new pending invitation
+func (r *InvitationRepository) CreateInvitation(ctx context.Context, invitation domain.Invitation) (*domain.Invitation, error) {
+	params := db.CreateOrganizationInvitationParams{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           string(invitation.Role),
+		TokenHash:      invitation.TokenHash,
+		ExpiresAt:      invitation.ExpiresAt,
+	}
+	if invitation.InvitedBy != nil {
+		params.InvitedBy = pgtype.UUID{Bytes: *invitation.InvitedBy, Valid: true}
+	}
+
+	dbInvitation, err := r.store.CreateOrganizationInvitation(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return mapDBInvitationToDomain(dbInvitation, invitation.OrganizationName), nil
+}
+
+// GetInvitationByID retrieves an invitation by ID, or nil if there is none
+func (r *InvitationRepository) GetInvitationByID(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+	row, err := r.store.GetOrganizationInvitationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get invitation by ID: %w", err)
+	}
+
+	return mapDBInvitationToDomain(row.OrganizationInvitations, row.OrganizationName), nil
+}
+
+// GetPendingInvitationByEmail retrieves the pending invitation for an email in an organization, or nil if there is none
+func (r *InvitationRepository) GetPendingInvitationByEmail(ctx context.Context, orgID uuid.UUID, email string) (*domain.Invitation, error) {
+	row, err := r.store.GetPendingOrganizationInvitationByEmail(ctx, db.GetPendingOrganizationInvitationByEmailParams{
+		OrganizationID: orgID,
+		Email:          email,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pending invitation: %w", err)
+	}
+
+	return mapDBInvitationToDomain(row.OrganizationInvitations, row.OrganizationName), nil
+}
+
+// ListPendingInvitations lists an organization's pending invitations, newest first
+func (r *InvitationRepository) ListPendingInvitations(ctx context.Context, orgID uuid.UUID) ([]domain.Invitation, error) {
+	rows, err := r.store.ListPendingOrganizationInvitations(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	invitations := make([]domain.Invitation, len(rows))
+	for i, row := range rows {
+		invitations[i] = *mapDBInvitationToDomain(row.OrganizationInvitations, row.OrganizationName)
+	}
+
+	return invitations, nil
+}
+
+// RefreshInvitationToken replaces a pending invitation's token and expiry
+func (r *InvitationRepository) RefreshInvitationToken(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (*domain.Invitation, error) {
+	dbInvitation, err := r.store.RefreshOrganizationInvitationToken(ctx, db.RefreshOrganizationInvitationTokenParams{
+		ID:        id,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to refresh invitation token: %w", err)
+	}
+
+	return mapDBInvitationToDomain(dbInvitation, ""), nil
+}
+
+// AcceptInvitation marks the invitation accepted and adds the member in one transaction
+func (r *InvitationRepository) AcceptInvitation(ctx context.Context, id, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	var result *domain.OrganizationMember
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbInvitation, err := q.MarkOrganizationInvitationAccepted(ctx, db.MarkOrganizationInvitationAcceptedParams{
+			ID:         id,
+			AcceptedBy: pgtype.UUID{Bytes: userID, Valid: true},
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Accepted or revoked in the meantime
+				return nil
+			}
+			return fmt.Errorf("failed to accept invitation: %w", err)
+		}
+
+		dbMember, err := q.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			ID:             uuid.New(),
+			OrganizationID: dbInvitation.OrganizationID,
+			UserID:         userID,
+			Role:           dbInvitation.Role,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add organization member: %w", err)
+		}
+
+		result = mapDBOrganizationMemberToDomain(dbMember)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RevokeInvitation revokes a pending invitation
+func (r *InvitationRepository) RevokeInvitation(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+	dbInvitation, err := r.store.MarkOrganizationInvitationRevoked(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	return mapDBInvitationToDomain(dbInvitation, ""), nil
+}
+
+func mapDBInvitationToDomain(invitation db.OrganizationInvitations, organizationName string) *domain.Invitation {
+	result := &domain.Invitation{
+		ID:               invitation.ID,
+		OrganizationID:   invitation.OrganizationID,
+		OrganizationName: organizationName,
+		Email:            invitation.Email,
+		Role:             domain.OrganizationRole(invitation.Role),
+		Status:           domain.InvitationStatus(invitation.Status),
+		TokenHash:        invitation.TokenHash,
+		ExpiresAt:        invitation.ExpiresAt,
+		SendCount:        int(invitation.SendCount),
+		LastSentAt:       invitation.LastSentAt,
+		CreatedAt:        invitation.CreatedAt,
+		UpdatedAt:        invitation.UpdatedAt,
+	}
+
+	if invitation.InvitedBy.Valid {
+		invitedBy := uuid.UUID(invitation.InvitedBy.Bytes)
+		result.InvitedBy = &invitedBy
+	}
+	if invitation.AcceptedBy.Valid {
+		acceptedBy := uuid.UUID(invitation.AcceptedBy.Bytes)
+		result.AcceptedBy = &acceptedBy
+	}
+	if invitation.AcceptedAt.Valid {
+		result.AcceptedAt = &invitation.AcceptedAt.Time
+	}
+	if invitation.RevokedAt.Valid {
+		result.RevokedAt = &invitation.RevokedAt.Time
+	}
+
+	return result
+}
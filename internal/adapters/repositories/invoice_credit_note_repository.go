@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CreditNoteRepository persists credit notes. It needs a db.Store so a credit
+// note is numbered, posted and applied to its invoice in one transaction.
+type CreditNoteRepository struct {
+	store db.Store
+}
+
+func NewCreditNoteRepository(store db.Store) *CreditNoteRepository {
+	return &CreditNoteRepository{
+		store: store,
+	}
+}
+
+// IssueCreditNote numbers a credit note, posts its reversing ledger entry and
+// takes its amount off the invoice, all in one transaction. It returns nil if
+// the invoice is no longer open or its balance has fallen below the amount.
+func (r *CreditNoteRepository) IssueCreditNote(ctx context.Context, note domain.CreditNote, entry domain.LedgerEntry) (*domain.CreditNote, *domain.Invoice, error) {
+	var issued *domain.CreditNote
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbInvoice, err := q.GetInvoiceForUpdate(ctx, note.InvoiceID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock invoice: %w", err)
+		}
+
+		invoice := mapDBInvoiceToDomain(dbInvoice)
+		if !invoice.Status.IsOpen() || note.Amount.Amount().GreaterThan(invoice.BalanceDue().Amount()) {
+			return nil
+		}
+
+		number, err := q.NextCreditNoteNumber(ctx, note.OrganizationID)
+		if err != nil {
+			return fmt.Errorf("failed to number credit note: %w", err)
+		}
+
+		posted, _, err := insertLedgerEntry(ctx, q, entry)
+		if err != nil {
+			return err
+		}
+
+		params := db.CreateCreditNoteParams{
+			ID:             note.ID,
+			OrganizationID: note.OrganizationID,
+			InvoiceID:      note.InvoiceID,
+			Number:         number,
+			Currency:       invoice.Currency,
+			Amount:         note.Amount.Amount(),
+			TaxAmount:      note.TaxAmount.Amount(),
+			Reason:         note.Reason,
+			LedgerEntryID:  posted.ID,
+			IssuedAt:       note.IssuedAt,
+		}
+		if note.CreatedBy != nil {
+			params.CreatedBy = pgtype.UUID{Bytes: *note.CreatedBy, Valid: true}
+		}
+
+		dbNote, err := q.CreateCreditNote(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create credit note: %w", err)
+		}
+
+		invoice.ApplyCredit(note.Amount)
+		if _, err := q.ApplyInvoiceCredit(ctx, db.ApplyInvoiceCreditParams{
+			ID:     invoice.ID,
+			Amount: note.Amount.Amount(),
+			Status: string(invoice.Status),
+		}); err != nil {
+			return fmt.Errorf("failed to apply credit to invoice: %w", err)
+		}
+
+		issued = mapDBCreditNoteToDomain(dbNote)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if issued == nil {
+		return nil, nil, nil
+	}
+
+	invoice, err := getInvoice(ctx, r.store, note.InvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issued, invoice, nil
+}
+
+// GetCreditNote retrieves one of an organization's credit notes
+func (r *CreditNoteRepository) GetCreditNote(ctx context.Context, orgID, id uuid.UUID) (*domain.CreditNote, error) {
+	dbNote, err := r.store.GetCreditNote(ctx, db.GetCreditNoteParams{
+		OrganizationID: orgID,
+		ID:             id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get credit note: %w", err)
+	}
+
+	return mapDBCreditNoteToDomain(dbNote), nil
+}
+
+// ListInvoiceCreditNotes lists the credit notes issued against an invoice in number order
+func (r *CreditNoteRepository) ListInvoiceCreditNotes(ctx context.Context, invoiceID uuid.UUID) ([]domain.CreditNote, error) {
+	dbNotes, err := r.store.ListCreditNotesByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice credit notes: %w", err)
+	}
+
+	notes := make([]domain.CreditNote, len(dbNotes))
+	for i, dbNote := range dbNotes {
+		notes[i] = *mapDBCreditNoteToDomain(dbNote)
+	}
+
+	return notes, nil
+}
+
+// ListCreditNotes lists an organization's credit notes, latest first
+func (r *CreditNoteRepository) ListCreditNotes(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]domain.CreditNote, int64, error) {
+	dbNotes, err := r.store.ListCreditNotesByOrganization(ctx, db.ListCreditNotesByOrganizationParams{
+		OrganizationID: orgID,
+		LimitCount:     int32(limit),
+		OffsetCount:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list credit notes: %w", err)
+	}
+
+	total, err := r.store.CountCreditNotesByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count credit notes: %w", err)
+	}
+
+	notes := make([]domain.CreditNote, len(dbNotes))
+	for i, dbNote := range dbNotes {
+		notes[i] = *mapDBCreditNoteToDomain(dbNote)
+	}
+
+	return notes, total, nil
+}
+
+func mapDBCreditNoteToDomain(note db.CreditNotes) *domain.CreditNote {
+	result := &domain.CreditNote{
+		ID:             note.ID,
+		OrganizationID: note.OrganizationID,
+		InvoiceID:      note.InvoiceID,
+		Number:         note.Number,
+		Amount:         money.New(note.Amount, note.Currency),
+		TaxAmount:      money.New(note.TaxAmount, note.Currency),
+		Reason:         note.Reason,
+		LedgerEntryID:  note.LedgerEntryID,
+		IssuedAt:       note.IssuedAt,
+		CreatedAt:      note.CreatedAt,
+	}
+
+	if note.CreatedBy.Valid {
+		createdBy := uuid.UUID(note.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
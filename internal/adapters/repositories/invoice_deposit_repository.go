@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetDepositKey retrieves the organization's deposit key, or nil if it has none
+func (r *InvoiceRepository) GetDepositKey(ctx context.Context, orgID uuid.UUID) (*domain.InvoiceDepositKey, error) {
+	dbKey, err := r.store.GetOrganizationDepositKey(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get deposit key: %w", err)
+	}
+
+	return mapDBDepositKeyToDomain(dbKey), nil
+}
+
+// SaveDepositKey sets the organization's deposit key and tolerance. A new key
+// starts handing out addresses from index 0 again.
+func (r *InvoiceRepository) SaveDepositKey(ctx context.Context, key domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error) {
+	params := db.UpsertOrganizationDepositKeyParams{
+		OrganizationID:    key.OrganizationID,
+		ExtendedPublicKey: key.ExtendedPublicKey,
+		ToleranceBps:      int32(key.ToleranceBPS),
+	}
+	if key.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *key.CreatedBy, Valid: true}
+	}
+
+	dbKey, err := r.store.UpsertOrganizationDepositKey(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save deposit key: %w", err)
+	}
+
+	return mapDBDepositKeyToDomain(dbKey), nil
+}
+
+// DeleteDepositKey removes the organization's deposit key. Addresses already
+// on invoices stay watched.
+func (r *InvoiceRepository) DeleteDepositKey(ctx context.Context, orgID uuid.UUID) error {
+	if err := r.store.DeleteOrganizationDepositKey(ctx, orgID); err != nil {
+		return fmt.Errorf("failed to delete deposit key: %w", err)
+	}
+	return nil
+}
+
+// AllocateDepositIndex hands out the organization's next receiving address
+// index with the key to derive it from. The key is empty if the organization
+// has none.
+func (r *InvoiceRepository) AllocateDepositIndex(ctx context.Context, orgID uuid.UUID) (string, int64, error) {
+	row, err := r.store.AllocateDepositIndex(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", 0, nil
+		}
+		return "", 0, fmt.Errorf("failed to allocate deposit index: %w", err)
+	}
+
+	return row.ExtendedPublicKey, row.DepositIndex, nil
+}
+
+// DepositAddressUsed reports whether any invoice already has the address as its deposit address
+func (r *InvoiceRepository) DepositAddressUsed(ctx context.Context, address string) (bool, error) {
+	used, err := r.store.InvoiceDepositAddressExists(ctx, toPgText(address))
+	if err != nil {
+		return false, fmt.Errorf("failed to check deposit address: %w", err)
+	}
+	return used, nil
+}
+
+// ListInvoicesAwaitingDeposit lists the invoices with a deposit address that
+// are not yet paid or voided, or were paid since paidSince, without line items
+func (r *InvoiceRepository) ListInvoicesAwaitingDeposit(ctx context.Context, closedSince time.Time) ([]domain.Invoice, error) {
+	dbInvoices, err := r.store.ListInvoicesAwaitingDeposit(ctx, pgtype.Timestamptz{Time: closedSince, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices awaiting deposit: %w", err)
+	}
+
+	invoices := make([]domain.Invoice, len(dbInvoices))
+	for i, dbInvoice := range dbInvoices {
+		invoices[i] = *mapDBInvoiceToDomain(dbInvoice)
+	}
+
+	return invoices, nil
+}
+
+// RecordInvoicePayment stores a transfer into an invoice's deposit address and,
+// in the same transaction, settles the invoice against everything received so
+// far with the organization's tolerance and posts the ledger entry settlement
+// builds from the invoice before and after. A transfer into a voided invoice
+// is stored unapplied and flags the invoice instead, with nothing posted. It
+// returns nil if the transfer was already recorded, so rescanning blocks
+// counts nothing twice.
+func (r *InvoiceRepository) RecordInvoicePayment(ctx context.Context, payment domain.InvoicePayment, at time.Time, settlement func(before, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error) {
+	recorded := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbInvoice, err := q.GetInvoiceForUpdate(ctx, payment.InvoiceID)
+		if err != nil {
+			return fmt.Errorf("failed to lock invoice: %w", err)
+		}
+
+		invoice := mapDBInvoiceToDomain(dbInvoice)
+		before := *invoice
+		applied := invoice.Status != domain.InvoiceStatusVoid
+
+		if _, err := q.CreateInvoicePayment(ctx, db.CreateInvoicePaymentParams{
+			ID:              payment.ID,
+			InvoiceID:       payment.InvoiceID,
+			TxHash:          payment.TxHash,
+			LogIndex:        int32(payment.LogIndex),
+			TransferOrdinal: int32(payment.TransferOrdinal),
+			TokenAddress:    payment.TokenAddress,
+			FromAddress:     payment.FromAddress,
+			ToAddress:       payment.ToAddress,
+			Amount:          payment.Amount.Amount(),
+			BlockNumber:     payment.BlockNumber,
+			Applied:         applied,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to record invoice payment: %w", err)
+		}
+
+		if applied {
+			received, err := q.SumInvoicePayments(ctx, payment.InvoiceID)
+			if err != nil {
+				return fmt.Errorf("failed to sum invoice payments: %w", err)
+			}
+
+			toleranceBPS := 0
+			dbKey, err := q.GetOrganizationDepositKey(ctx, dbInvoice.OrganizationID)
+			if err == nil {
+				toleranceBPS = int(dbKey.ToleranceBps)
+			} else if !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to get deposit key: %w", err)
+			}
+
+			invoice.ApplyPayments(received, toleranceBPS, at, payment.TxHash)
+		} else {
+			invoice.PaymentException = domain.InvoicePaymentExceptionPaidAfterVoid
+		}
+
+		params := db.UpdateInvoicePaymentStatusParams{
+			ID:               invoice.ID,
+			Status:           string(invoice.Status),
+			AmountPaid:       invoice.AmountPaid.Amount(),
+			PaymentReference: toPgText(invoice.PaymentReference),
+			PaymentException: toPgText(string(invoice.PaymentException)),
+		}
+		if invoice.PaidAt != nil {
+			params.PaidAt = pgtype.Timestamptz{Time: *invoice.PaidAt, Valid: true}
+		}
+
+		if _, err := q.UpdateInvoicePaymentStatus(ctx, params); err != nil {
+			return fmt.Errorf("failed to update invoice payment status: %w", err)
+		}
+
+		if applied {
+			entry, err := settlement(before, *invoice)
+			if err != nil {
+				return err
+			}
+			if entry != nil {
+				if _, _, err := insertLedgerEntry(ctx, q, *entry); err != nil {
+					return err
+				}
+			}
+		}
+
+		recorded = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !recorded {
+		return nil, nil
+	}
+
+	return r.GetInvoice(ctx, payment.InvoiceID)
+}
+
+// ListInvoicePayments lists the transfers matched to an invoice in the order they were made
+func (r *InvoiceRepository) ListInvoicePayments(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoicePayment, error) {
+	dbInvoice, err := r.store.GetInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	dbPayments, err := r.store.ListInvoicePayments(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice payments: %w", err)
+	}
+
+	payments := make([]domain.InvoicePayment, len(dbPayments))
+	for i, dbPayment := range dbPayments {
+		payments[i] = domain.InvoicePayment{
+			ID:              dbPayment.ID,
+			InvoiceID:       dbPayment.InvoiceID,
+			TxHash:          dbPayment.TxHash,
+			LogIndex:        int(dbPayment.LogIndex),
+			TransferOrdinal: int(dbPayment.TransferOrdinal),
+			TokenAddress:    dbPayment.TokenAddress,
+			FromAddress:     dbPayment.FromAddress,
+			ToAddress:       dbPayment.ToAddress,
+			Amount:          money.New(dbPayment.Amount, dbInvoice.Currency),
+			BlockNumber:     dbPayment.BlockNumber,
+			Applied:         dbPayment.Applied,
+			CreatedAt:       dbPayment.CreatedAt,
+		}
+	}
+
+	return payments, nil
+}
+
+func mapDBDepositKeyToDomain(key db.OrganizationDepositKeys) *domain.InvoiceDepositKey {
+	result := &domain.InvoiceDepositKey{
+		OrganizationID:    key.OrganizationID,
+		ExtendedPublicKey: key.ExtendedPublicKey,
+		NextIndex:         key.NextIndex,
+		ToleranceBPS:      int(key.ToleranceBps),
+		CreatedAt:         key.CreatedAt,
+		UpdatedAt:         key.UpdatedAt,
+	}
+
+	if key.CreatedBy.Valid {
+		createdBy := uuid.UUID(key.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
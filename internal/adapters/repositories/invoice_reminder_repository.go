@@ -0,0 +1,209 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InvoiceReminderRepository persists organizations' reminder settings and the
+// reminders sent for unpaid invoices
+type InvoiceReminderRepository struct {
+	store db.Store
+}
+
+func NewInvoiceReminderRepository(store db.Store) *InvoiceReminderRepository {
+	return &InvoiceReminderRepository{
+		store: store,
+	}
+}
+
+// GetReminderSettings retrieves the organization's reminder settings, or nil if it has never set them
+func (r *InvoiceReminderRepository) GetReminderSettings(ctx context.Context, orgID uuid.UUID) (*domain.InvoiceReminderSettings, error) {
+	dbSettings, err := r.store.GetInvoiceReminderSettings(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get invoice reminder settings: %w", err)
+	}
+
+	return mapDBReminderSettingsToDomain(dbSettings), nil
+}
+
+// SaveReminderSettings sets the organization's reminder settings
+func (r *InvoiceReminderRepository) SaveReminderSettings(ctx context.Context, settings domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error) {
+	offsets := make([]int32, len(settings.OffsetDays))
+	for i, offset := range settings.OffsetDays {
+		offsets[i] = int32(offset)
+	}
+
+	params := db.UpsertInvoiceReminderSettingsParams{
+		OrganizationID: settings.OrganizationID,
+		Enabled:        settings.Enabled,
+		OffsetDays:     offsets,
+	}
+	if settings.UpdatedBy != nil {
+		params.UpdatedBy = pgtype.UUID{Bytes: *settings.UpdatedBy, Valid: true}
+	}
+
+	dbSettings, err := r.store.UpsertInvoiceReminderSettings(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save invoice reminder settings: %w", err)
+	}
+
+	return mapDBReminderSettingsToDomain(dbSettings), nil
+}
+
+// ListDueReminders lists up to limit invoices with a reminder step that has
+// come due at now, with the latest such step of each. Steps whose email
+// failed are listed once their backoff has passed, after the others, until
+// they are given up.
+func (r *InvoiceReminderRepository) ListDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.DueInvoiceReminder, error) {
+	rows, err := r.store.ListDueInvoiceReminders(ctx, db.ListDueInvoiceRemindersParams{
+		Now:                 now,
+		MaxAttempts:         domain.MaxInvoiceReminderAttempts,
+		RetryBackoffSeconds: int32(domain.InvoiceReminderRetryBackoff / time.Second),
+		BatchSize:           int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due invoice reminders: %w", err)
+	}
+
+	due := make([]domain.DueInvoiceReminder, len(rows))
+	for i, row := range rows {
+		due[i] = domain.DueInvoiceReminder{
+			InvoiceID:  row.InvoiceID,
+			OffsetDays: int(row.OffsetDays),
+		}
+	}
+
+	return due, nil
+}
+
+// ClaimReminder records a reminder step for an invoice before it is sent,
+// with the invoice locked so a payment matched at the same time is seen. It
+// returns nil if the invoice is no longer awaiting payment or another
+// instance already claimed the step.
+func (r *InvoiceReminderRepository) ClaimReminder(ctx context.Context, invoiceID uuid.UUID, offsetDays int, at time.Time) (*domain.InvoiceReminder, *domain.Invoice, error) {
+	var (
+		reminder *domain.InvoiceReminder
+		invoice  *domain.Invoice
+	)
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbInvoice, err := q.GetInvoiceForUpdate(ctx, invoiceID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock invoice: %w", err)
+		}
+		if !domain.InvoiceStatus(dbInvoice.Status).IsOpen() {
+			return nil
+		}
+
+		dbReminder, err := q.CreateInvoiceReminder(ctx, db.CreateInvoiceReminderParams{
+			ID:         uuid.New(),
+			InvoiceID:  invoiceID,
+			OffsetDays: int32(offsetDays),
+			Recipient:  dbInvoice.CustomerEmail,
+			SentAt:     at,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to record invoice reminder: %w", err)
+		}
+
+		reminder = mapDBReminderToDomain(dbReminder)
+		invoice = mapDBInvoiceToDomain(dbInvoice)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reminder, invoice, nil
+}
+
+// ReleaseReminder removes a claimed reminder whose email could not be queued
+// and counts the failed attempt, so the step is tried again after a backoff.
+// It returns how many attempts at the step have failed.
+func (r *InvoiceReminderRepository) ReleaseReminder(ctx context.Context, reminder domain.InvoiceReminder, failedAt time.Time) (int, error) {
+	var attempts int32
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		if err := q.DeleteInvoiceReminder(ctx, reminder.ID); err != nil {
+			return fmt.Errorf("failed to release invoice reminder: %w", err)
+		}
+
+		var err error
+		attempts, err = q.RecordInvoiceReminderFailure(ctx, db.RecordInvoiceReminderFailureParams{
+			InvoiceID:  reminder.InvoiceID,
+			OffsetDays: int32(reminder.OffsetDays),
+			FailedAt:   failedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record invoice reminder failure: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(attempts), nil
+}
+
+func (r *InvoiceReminderRepository) ListInvoiceReminders(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoiceReminder, error) {
+	dbReminders, err := r.store.ListInvoiceReminders(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice reminders: %w", err)
+	}
+
+	reminders := make([]domain.InvoiceReminder, len(dbReminders))
+	for i, dbReminder := range dbReminders {
+		reminders[i] = *mapDBReminderToDomain(dbReminder)
+	}
+
+	return reminders, nil
+}
+
+func mapDBReminderSettingsToDomain(settings db.InvoiceReminderSettings) *domain.InvoiceReminderSettings {
+	result := &domain.InvoiceReminderSettings{
+		OrganizationID: settings.OrganizationID,
+		Enabled:        settings.Enabled,
+		OffsetDays:     make([]int, len(settings.OffsetDays)),
+		CreatedAt:      settings.CreatedAt,
+		UpdatedAt:      settings.UpdatedAt,
+	}
+
+	for i, offset := range settings.OffsetDays {
+		result.OffsetDays[i] = int(offset)
+	}
+	if settings.UpdatedBy.Valid {
+		updatedBy := uuid.UUID(settings.UpdatedBy.Bytes)
+		result.UpdatedBy = &updatedBy
+	}
+
+	return result
+}
+
+func mapDBReminderToDomain(reminder db.InvoiceReminders) *domain.InvoiceReminder {
+	return &domain.InvoiceReminder{
+		ID:         reminder.ID,
+		InvoiceID:  reminder.InvoiceID,
+		OffsetDays: int(reminder.OffsetDays),
+		Recipient:  reminder.Recipient,
+		SentAt:     reminder.SentAt,
+	}
+}
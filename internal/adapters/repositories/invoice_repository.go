@@ -0,0 +1,515 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// errTimesheetsNotApproved rolls back an invoice for timesheets that were not
+// all still approved
+var errTimesheetsNotApproved = errors.New("timesheets are no longer approved")
+
+// InvoiceRepository persists invoices and their line items. It needs a
+// db.Store so an invoice is numbered and written in one transaction.
+type InvoiceRepository struct {
+	store db.Store
+}
+
+func NewInvoiceRepository(store db.Store) *InvoiceRepository {
+	return &InvoiceRepository{
+		store: store,
+	}
+}
+
+// CreateInvoice takes the organization's next invoice number and stores the
+// invoice with its line items. The number is only used up if the whole
+// transaction commits.
+func (r *InvoiceRepository) CreateInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		return createInvoice(ctx, q, invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetInvoice(ctx, invoice.ID)
+}
+
+// CreateTimesheetInvoice stores an invoice billing approved timesheets and
+// marks them invoiced on it in the same transaction, so their hours are
+// never billed twice or left on no invoice. It returns nil, storing nothing,
+// if any of the timesheets is no longer approved.
+func (r *InvoiceRepository) CreateTimesheetInvoice(ctx context.Context, invoice domain.Invoice, timesheetIDs []uuid.UUID) (*domain.Invoice, error) {
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		if err := createInvoice(ctx, q, invoice); err != nil {
+			return err
+		}
+
+		invoiced, err := q.InvoiceTimesheets(ctx, db.InvoiceTimesheetsParams{
+			InvoiceID:      pgtype.UUID{Bytes: invoice.ID, Valid: true},
+			OrganizationID: invoice.OrganizationID,
+			Ids:            timesheetIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to invoice timesheets: %w", err)
+		}
+		if len(invoiced) != len(timesheetIDs) {
+			return errTimesheetsNotApproved
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errTimesheetsNotApproved) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.GetInvoice(ctx, invoice.ID)
+}
+
+// GetInvoice retrieves an invoice with its line items, or nil if there is none
+func (r *InvoiceRepository) GetInvoice(ctx context.Context, id uuid.UUID) (*domain.Invoice, error) {
+	return getInvoice(ctx, r.store, id)
+}
+
+// ListInvoices lists an organization's invoices, latest number first, without line items
+func (r *InvoiceRepository) ListInvoices(ctx context.Context, orgID uuid.UUID, status *domain.InvoiceStatus, limit, offset int) ([]domain.Invoice, int64, error) {
+	var statusFilter pgtype.Text
+	if status != nil {
+		statusFilter = toPgText(string(*status))
+	}
+
+	dbInvoices, err := r.store.ListInvoicesByOrganization(ctx, db.ListInvoicesByOrganizationParams{
+		OrganizationID: orgID,
+		Status:         statusFilter,
+		LimitCount:     int32(limit),
+		OffsetCount:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	total, err := r.store.CountInvoicesByOrganization(ctx, db.CountInvoicesByOrganizationParams{
+		OrganizationID: orgID,
+		Status:         statusFilter,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count invoices: %w", err)
+	}
+
+	invoices := make([]domain.Invoice, len(dbInvoices))
+	for i, dbInvoice := range dbInvoices {
+		invoices[i] = *mapDBInvoiceToDomain(dbInvoice)
+	}
+
+	return invoices, total, nil
+}
+
+// UpdateDraftInvoice replaces a draft invoice's details and line items in one
+// transaction. It returns nil if the invoice is no longer a draft.
+func (r *InvoiceRepository) UpdateDraftInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+	updated := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		params := db.UpdateDraftInvoiceParams{
+			ID:              invoice.ID,
+			Currency:        invoice.Currency,
+			CustomerName:    invoice.CustomerName,
+			CustomerEmail:   invoice.CustomerEmail,
+			CustomerAddress: invoice.CustomerAddress,
+			CustomerCountry: invoice.CustomerCountry,
+			CustomerTaxID:   invoice.CustomerTaxID,
+			IssueDate:       invoice.IssueDate,
+			DueDate:         invoice.DueDate,
+			Notes:           invoice.Notes,
+			Subtotal:        invoice.Subtotal.Amount(),
+			DiscountTotal:   invoice.DiscountTotal.Amount(),
+			TaxTotal:        invoice.TaxTotal.Amount(),
+			Total:           invoice.Total.Amount(),
+			PaymentAddress:  toPgText(invoice.PaymentAddress),
+			AutomaticTax:    invoice.AutomaticTax,
+			TaxTreatment:    string(invoice.TaxTreatment),
+			TaxName:         invoice.TaxName,
+			TaxNote:         invoice.TaxNote,
+			TaxRatesVersion: invoice.TaxRatesVersion,
+		}
+		if invoice.PaymentAssetID != nil {
+			params.PaymentAssetID = pgtype.UUID{Bytes: *invoice.PaymentAssetID, Valid: true}
+		}
+		if invoice.DepositIndex != nil {
+			params.DepositIndex = pgtype.Int8{Int64: *invoice.DepositIndex, Valid: true}
+		}
+		if invoice.ClientID != nil {
+			params.ClientID = pgtype.UUID{Bytes: *invoice.ClientID, Valid: true}
+		}
+
+		_, err := q.UpdateDraftInvoice(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to update invoice: %w", err)
+		}
+
+		if err := q.DeleteInvoiceLineItems(ctx, invoice.ID); err != nil {
+			return fmt.Errorf("failed to delete invoice line items: %w", err)
+		}
+		if err := createInvoiceLineItems(ctx, q, invoice.ID, invoice.LineItems); err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated {
+		return nil, nil
+	}
+
+	return r.GetInvoice(ctx, invoice.ID)
+}
+
+// MarkSent moves a draft invoice to sent. The issuance entry, if given, is
+// posted to the ledger in the same transaction.
+func (r *InvoiceRepository) MarkSent(ctx context.Context, id uuid.UUID, at time.Time, issued *domain.LedgerEntry) (*domain.Invoice, error) {
+	var dbInvoice db.Invoices
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		var err error
+		dbInvoice, err = q.MarkInvoiceSent(ctx, db.MarkInvoiceSentParams{
+			ID:     id,
+			SentAt: pgtype.Timestamptz{Time: at, Valid: true},
+		})
+		if err != nil || issued == nil {
+			return err
+		}
+
+		_, _, err = insertLedgerEntry(ctx, q, *issued)
+		return err
+	})
+	return r.transitioned(ctx, dbInvoice, err, "mark invoice sent")
+}
+
+// MarkViewed records the first view of an open invoice, moving a sent invoice to viewed
+func (r *InvoiceRepository) MarkViewed(ctx context.Context, id uuid.UUID, at time.Time) (*domain.Invoice, error) {
+	dbInvoice, err := r.store.MarkInvoiceViewed(ctx, db.MarkInvoiceViewedParams{
+		ID:       id,
+		ViewedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	return r.transitioned(ctx, dbInvoice, err, "mark invoice viewed")
+}
+
+// MarkPaid moves an open invoice to paid and posts the settlement, if given,
+// in the same transaction. It returns nil if the invoice is no longer open or
+// a payment or credit note changed its balance from balanceDue.
+func (r *InvoiceRepository) MarkPaid(ctx context.Context, id uuid.UUID, balanceDue money.Money, at time.Time, reference string, settlement *domain.LedgerEntry) (*domain.Invoice, error) {
+	paid := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		dbInvoice, err := q.GetInvoiceForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock invoice: %w", err)
+		}
+
+		invoice := mapDBInvoiceToDomain(dbInvoice)
+		if !invoice.Status.IsOpen() || !invoice.BalanceDue().Equal(balanceDue) {
+			return nil
+		}
+
+		if _, err := q.MarkInvoicePaid(ctx, db.MarkInvoicePaidParams{
+			ID:               id,
+			PaidAt:           pgtype.Timestamptz{Time: at, Valid: true},
+			PaymentReference: toPgText(reference),
+		}); err != nil {
+			return fmt.Errorf("failed to mark invoice paid: %w", err)
+		}
+
+		if settlement != nil {
+			if _, _, err := insertLedgerEntry(ctx, q, *settlement); err != nil {
+				return err
+			}
+		}
+
+		paid = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !paid {
+		return nil, nil
+	}
+
+	return r.GetInvoice(ctx, id)
+}
+
+// Void voids an invoice that has not been paid or credited. A reversal, if
+// given, is posted to the ledger in the same transaction, and timesheets
+// billed on the invoice go back to approved.
+func (r *InvoiceRepository) Void(ctx context.Context, id uuid.UUID, at time.Time, reason string, reversal *domain.LedgerEntry) (*domain.Invoice, error) {
+	var dbInvoice db.Invoices
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		var err error
+		dbInvoice, err = q.VoidInvoice(ctx, db.VoidInvoiceParams{
+			ID:         id,
+			VoidedAt:   pgtype.Timestamptz{Time: at, Valid: true},
+			VoidReason: toPgText(reason),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := q.ReleaseInvoicedTimesheets(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+			return fmt.Errorf("failed to release invoiced timesheets: %w", err)
+		}
+		if reversal == nil {
+			return nil
+		}
+
+		_, _, err = insertLedgerEntry(ctx, q, *reversal)
+		return err
+	})
+	return r.transitioned(ctx, dbInvoice, err, "void invoice")
+}
+
+// MarkOverdue moves a batch of sent, viewed and partially paid invoices due before now to overdue
+func (r *InvoiceRepository) MarkOverdue(ctx context.Context, now time.Time, limit int) ([]domain.Invoice, error) {
+	dbInvoices, err := r.store.MarkInvoicesOverdue(ctx, db.MarkInvoicesOverdueParams{
+		Now:        now,
+		LimitCount: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark invoices overdue: %w", err)
+	}
+
+	invoices := make([]domain.Invoice, len(dbInvoices))
+	for i, dbInvoice := range dbInvoices {
+		invoices[i] = *mapDBInvoiceToDomain(dbInvoice)
+	}
+
+	return invoices, nil
+}
+
+// transitioned loads the invoice after a conditional status change, or returns
+// nil if the invoice was not in a status the change applies to
+func (r *InvoiceRepository) transitioned(ctx context.Context, dbInvoice db.Invoices, err error, action string) (*domain.Invoice, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	return r.GetInvoice(ctx, dbInvoice.ID)
+}
+
+// getInvoice loads an invoice with its line items, or nil if there is none.
+// The repositories that change invoices along with their own rows return the
+// invoice with it.
+func getInvoice(ctx context.Context, q db.Querier, id uuid.UUID) (*domain.Invoice, error) {
+	dbInvoice, err := q.GetInvoiceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	rows, err := q.ListInvoiceLineItems(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice line items: %w", err)
+	}
+
+	invoice := mapDBInvoiceToDomain(dbInvoice)
+	invoice.LineItems = make([]domain.InvoiceLineItem, len(rows))
+	for i, row := range rows {
+		invoice.LineItems[i] = mapDBInvoiceLineItemToDomain(row, invoice.Currency)
+	}
+
+	return invoice, nil
+}
+
+// createInvoice numbers an invoice and writes it with its line items, within
+// the caller's transaction
+func createInvoice(ctx context.Context, q *db.Queries, invoice domain.Invoice) error {
+	number, err := q.NextInvoiceNumber(ctx, invoice.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to number invoice: %w", err)
+	}
+
+	params := db.CreateInvoiceParams{
+		ID:              invoice.ID,
+		OrganizationID:  invoice.OrganizationID,
+		Number:          number,
+		Currency:        invoice.Currency,
+		CustomerName:    invoice.CustomerName,
+		CustomerEmail:   invoice.CustomerEmail,
+		CustomerAddress: invoice.CustomerAddress,
+		CustomerCountry: invoice.CustomerCountry,
+		CustomerTaxID:   invoice.CustomerTaxID,
+		IssueDate:       invoice.IssueDate,
+		DueDate:         invoice.DueDate,
+		Notes:           invoice.Notes,
+		Subtotal:        invoice.Subtotal.Amount(),
+		DiscountTotal:   invoice.DiscountTotal.Amount(),
+		TaxTotal:        invoice.TaxTotal.Amount(),
+		Total:           invoice.Total.Amount(),
+		PaymentAddress:  toPgText(invoice.PaymentAddress),
+		AutomaticTax:    invoice.AutomaticTax,
+		TaxTreatment:    string(invoice.TaxTreatment),
+		TaxName:         invoice.TaxName,
+		TaxNote:         invoice.TaxNote,
+		TaxRatesVersion: invoice.TaxRatesVersion,
+	}
+	if invoice.PaymentAssetID != nil {
+		params.PaymentAssetID = pgtype.UUID{Bytes: *invoice.PaymentAssetID, Valid: true}
+	}
+	if invoice.RecurringInvoiceID != nil {
+		params.RecurringInvoiceID = pgtype.UUID{Bytes: *invoice.RecurringInvoiceID, Valid: true}
+	}
+	if invoice.DepositIndex != nil {
+		params.DepositIndex = pgtype.Int8{Int64: *invoice.DepositIndex, Valid: true}
+	}
+	if invoice.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *invoice.CreatedBy, Valid: true}
+	}
+	if invoice.ClientID != nil {
+		params.ClientID = pgtype.UUID{Bytes: *invoice.ClientID, Valid: true}
+	}
+
+	if _, err := q.CreateInvoice(ctx, params); err != nil {
+		return fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	return createInvoiceLineItems(ctx, q, invoice.ID, invoice.LineItems)
+}
+
+// createInvoiceLineItems writes an invoice's line items in their order
+func createInvoiceLineItems(ctx context.Context, q *db.Queries, invoiceID uuid.UUID, items []domain.InvoiceLineItem) error {
+	for _, item := range items {
+		if _, err := q.CreateInvoiceLineItem(ctx, db.CreateInvoiceLineItemParams{
+			ID:              uuid.New(),
+			InvoiceID:       invoiceID,
+			Position:        int32(item.Position),
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice.Amount(),
+			DiscountPercent: item.DiscountPercent,
+			TaxRate:         item.TaxRate,
+			Amount:          item.Amount.Amount(),
+			DiscountAmount:  item.DiscountAmount.Amount(),
+			TaxAmount:       item.TaxAmount.Amount(),
+			Total:           item.Total.Amount(),
+		}); err != nil {
+			return fmt.Errorf("failed to create invoice line item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func mapDBInvoiceToDomain(invoice db.Invoices) *domain.Invoice {
+	result := &domain.Invoice{
+		ID:               invoice.ID,
+		OrganizationID:   invoice.OrganizationID,
+		Number:           invoice.Number,
+		Status:           domain.InvoiceStatus(invoice.Status),
+		Currency:         invoice.Currency,
+		CustomerName:     invoice.CustomerName,
+		CustomerEmail:    invoice.CustomerEmail,
+		CustomerAddress:  invoice.CustomerAddress,
+		CustomerCountry:  invoice.CustomerCountry,
+		CustomerTaxID:    invoice.CustomerTaxID,
+		IssueDate:        invoice.IssueDate,
+		DueDate:          invoice.DueDate,
+		Notes:            invoice.Notes,
+		AutomaticTax:     invoice.AutomaticTax,
+		TaxTreatment:     domain.TaxTreatment(invoice.TaxTreatment),
+		TaxName:          invoice.TaxName,
+		TaxNote:          invoice.TaxNote,
+		TaxRatesVersion:  invoice.TaxRatesVersion,
+		Subtotal:         money.New(invoice.Subtotal, invoice.Currency),
+		DiscountTotal:    money.New(invoice.DiscountTotal, invoice.Currency),
+		TaxTotal:         money.New(invoice.TaxTotal, invoice.Currency),
+		Total:            money.New(invoice.Total, invoice.Currency),
+		AmountPaid:       money.New(invoice.AmountPaid, invoice.Currency),
+		AmountCredited:   money.New(invoice.AmountCredited, invoice.Currency),
+		PaymentAddress:   getTextString(invoice.PaymentAddress),
+		PaymentReference: getTextString(invoice.PaymentReference),
+		PaymentException: domain.InvoicePaymentException(getTextString(invoice.PaymentException)),
+		VoidReason:       getTextString(invoice.VoidReason),
+		CreatedAt:        invoice.CreatedAt,
+		UpdatedAt:        invoice.UpdatedAt,
+	}
+
+	if invoice.SentAt.Valid {
+		result.SentAt = &invoice.SentAt.Time
+	}
+	if invoice.ViewedAt.Valid {
+		result.ViewedAt = &invoice.ViewedAt.Time
+	}
+	if invoice.PaidAt.Valid {
+		result.PaidAt = &invoice.PaidAt.Time
+	}
+	if invoice.VoidedAt.Valid {
+		result.VoidedAt = &invoice.VoidedAt.Time
+	}
+	if invoice.DepositIndex.Valid {
+		result.DepositIndex = &invoice.DepositIndex.Int64
+	}
+	if invoice.PaymentAssetID.Valid {
+		paymentAssetID := uuid.UUID(invoice.PaymentAssetID.Bytes)
+		result.PaymentAssetID = &paymentAssetID
+	}
+	if invoice.RecurringInvoiceID.Valid {
+		recurringInvoiceID := uuid.UUID(invoice.RecurringInvoiceID.Bytes)
+		result.RecurringInvoiceID = &recurringInvoiceID
+	}
+	if invoice.ClientID.Valid {
+		clientID := uuid.UUID(invoice.ClientID.Bytes)
+		result.ClientID = &clientID
+	}
+	if invoice.CreatedBy.Valid {
+		createdBy := uuid.UUID(invoice.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
+
+func mapDBInvoiceLineItemToDomain(item db.InvoiceLineItems, currency string) domain.InvoiceLineItem {
+	return domain.InvoiceLineItem{
+		ID:              item.ID,
+		InvoiceID:       item.InvoiceID,
+		Position:        int(item.Position),
+		Description:     item.Description,
+		Quantity:        item.Quantity,
+		UnitPrice:       money.New(item.UnitPrice, currency),
+		DiscountPercent: item.DiscountPercent,
+		TaxRate:         item.TaxRate,
+		Amount:          money.New(item.Amount, currency),
+		DiscountAmount:  money.New(item.DiscountAmount, currency),
+		TaxAmount:       money.New(item.TaxAmount, currency),
+		Total:           money.New(item.Total, currency),
+		CreatedAt:       item.CreatedAt,
+	}
+}
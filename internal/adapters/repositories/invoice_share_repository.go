@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InvoiceShareRepository persists the public links invoices are shared with
+type InvoiceShareRepository struct {
+	store db.Store
+}
+
+func NewInvoiceShareRepository(store db.Store) *InvoiceShareRepository {
+	return &InvoiceShareRepository{
+		store: store,
+	}
+}
+
+// CreateShareLink stores a new share link for an invoice
+func (r *InvoiceShareRepository) CreateShareLink(ctx context.Context, link domain.InvoiceShareLink) (*domain.InvoiceShareLink, error) {
+	params := db.CreateInvoiceShareLinkParams{
+		ID:        link.ID,
+		InvoiceID: link.InvoiceID,
+		TokenHash: link.TokenHash,
+		ExpiresAt: link.ExpiresAt,
+	}
+	if link.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *link.CreatedBy, Valid: true}
+	}
+
+	dbLink, err := r.store.CreateInvoiceShareLink(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoice share link: %w", err)
+	}
+
+	return mapDBShareLinkToDomain(dbLink), nil
+}
+
+// GetShareLink retrieves a share link by ID, or nil if it does not exist
+func (r *InvoiceShareRepository) GetShareLink(ctx context.Context, id uuid.UUID) (*domain.InvoiceShareLink, error) {
+	dbLink, err := r.store.GetInvoiceShareLink(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get invoice share link: %w", err)
+	}
+
+	return mapDBShareLinkToDomain(dbLink), nil
+}
+
+// ListShareLinks lists an invoice's share links, newest first
+func (r *InvoiceShareRepository) ListShareLinks(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoiceShareLink, error) {
+	dbLinks, err := r.store.ListInvoiceShareLinks(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice share links: %w", err)
+	}
+
+	links := make([]domain.InvoiceShareLink, len(dbLinks))
+	for i, dbLink := range dbLinks {
+		links[i] = *mapDBShareLinkToDomain(dbLink)
+	}
+
+	return links, nil
+}
+
+// RevokeShareLink revokes one of an invoice's share links, or returns nil if
+// it does not exist or was already revoked
+func (r *InvoiceShareRepository) RevokeShareLink(ctx context.Context, invoiceID, id, revokedBy uuid.UUID, at time.Time) (*domain.InvoiceShareLink, error) {
+	dbLink, err := r.store.RevokeInvoiceShareLink(ctx, db.RevokeInvoiceShareLinkParams{
+		ID:        id,
+		InvoiceID: invoiceID,
+		RevokedAt: pgtype.Timestamptz{Time: at, Valid: true},
+		RevokedBy: pgtype.UUID{Bytes: revokedBy, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to revoke invoice share link: %w", err)
+	}
+
+	return mapDBShareLinkToDomain(dbLink), nil
+}
+
+// RecordShareLinkView counts a view through the link and, in the same
+// transaction, records the customer's first view of the invoice, moving a
+// sent invoice to viewed. Invoices that are not awaiting payment are left as
+// they are.
+func (r *InvoiceShareRepository) RecordShareLinkView(ctx context.Context, link domain.InvoiceShareLink, at time.Time) error {
+	return r.store.ExecTx(ctx, func(q *db.Queries) error {
+		if err := q.RecordInvoiceShareLinkView(ctx, db.RecordInvoiceShareLinkViewParams{
+			ID:       link.ID,
+			ViewedAt: pgtype.Timestamptz{Time: at, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to record invoice share link view: %w", err)
+		}
+
+		if _, err := q.MarkInvoiceViewed(ctx, db.MarkInvoiceViewedParams{
+			ID:       link.InvoiceID,
+			ViewedAt: pgtype.Timestamptz{Time: at, Valid: true},
+		}); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to mark invoice viewed: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func mapDBShareLinkToDomain(link db.InvoiceShareLinks) *domain.InvoiceShareLink {
+	result := &domain.InvoiceShareLink{
+		ID:        link.ID,
+		InvoiceID: link.InvoiceID,
+		TokenHash: link.TokenHash,
+		ExpiresAt: link.ExpiresAt,
+		ViewCount: int(link.ViewCount),
+		CreatedAt: link.CreatedAt,
+	}
+
+	if link.LastViewedAt.Valid {
+		lastViewedAt := link.LastViewedAt.Time
+		result.LastViewedAt = &lastViewedAt
+	}
+	if link.RevokedAt.Valid {
+		revokedAt := link.RevokedAt.Time
+		result.RevokedAt = &revokedAt
+	}
+	if link.RevokedBy.Valid {
+		revokedBy := uuid.UUID(link.RevokedBy.Bytes)
+		result.RevokedBy = &revokedBy
+	}
+	if link.CreatedBy.Valid {
+		createdBy := uuid.UUID(link.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
@@ -0,0 +1,249 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LedgerRepository persists the double-entry ledger. It needs a db.Store rather
+// than plain queries so an entry and its postings are written in one transaction.
+type LedgerRepository struct {
+	store db.Store
+}
+
+func NewLedgerRepository(store db.Store) *LedgerRepository {
+	return &LedgerRepository{
+		store: store,
+	}
+}
+
+// CreateAccount opens a ledger account
+func (r *LedgerRepository) CreateAccount(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error) {
+	params := db.CreateLedgerAccountParams{
+		ID:   account.ID,
+		Code: account.Code,
+		Name: account.Name,
+		Type: string(account.Type),
+	}
+	if account.OwnerID != nil {
+		params.OwnerID = pgtype.UUID{Bytes: *account.OwnerID, Valid: true}
+	}
+
+	dbAccount, err := r.store.CreateLedgerAccount(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ledger account: %w", err)
+	}
+
+	return mapDBLedgerAccountToDomain(dbAccount), nil
+}
+
+// GetAccountByID retrieves a ledger account by ID, or nil if there is none
+func (r *LedgerRepository) GetAccountByID(ctx context.Context, id uuid.UUID) (*domain.LedgerAccount, error) {
+	dbAccount, err := r.store.GetLedgerAccountByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ledger account by ID: %w", err)
+	}
+
+	return mapDBLedgerAccountToDomain(dbAccount), nil
+}
+
+// GetAccountByCode retrieves a ledger account by code, or nil if there is none
+func (r *LedgerRepository) GetAccountByCode(ctx context.Context, code string) (*domain.LedgerAccount, error) {
+	dbAccount, err := r.store.GetLedgerAccountByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ledger account by code: %w", err)
+	}
+
+	return mapDBLedgerAccountToDomain(dbAccount), nil
+}
+
+// ListAccountsByOwner lists the accounts belonging to a user or organization
+func (r *LedgerRepository) ListAccountsByOwner(ctx context.Context, ownerID uuid.UUID) ([]domain.LedgerAccount, error) {
+	dbAccounts, err := r.store.ListLedgerAccountsByOwner(ctx, pgtype.UUID{Bytes: ownerID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger accounts: %w", err)
+	}
+
+	accounts := make([]domain.LedgerAccount, len(dbAccounts))
+	for i, dbAccount := range dbAccounts {
+		accounts[i] = *mapDBLedgerAccountToDomain(dbAccount)
+	}
+
+	return accounts, nil
+}
+
+// PostEntry records the entry and all its postings in one transaction. The
+// database rejects the commit if the postings do not balance. When the external
+// reference was already used, the stored entry is returned with created false.
+func (r *LedgerRepository) PostEntry(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, bool, error) {
+	var result *domain.LedgerEntry
+	created := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		var err error
+		result, created, err = insertLedgerEntry(ctx, q, entry)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, created, nil
+}
+
+// insertLedgerEntry records an entry and its postings with q, so other
+// repositories can post in the same transaction as the change the entry
+// accounts for. If the external reference was posted before, the stored entry
+// is returned instead and created is false.
+func insertLedgerEntry(ctx context.Context, q db.Querier, entry domain.LedgerEntry) (*domain.LedgerEntry, bool, error) {
+	dbEntry, err := q.CreateLedgerEntry(ctx, db.CreateLedgerEntryParams{
+		ID:                entry.ID,
+		ExternalReference: entry.ExternalReference,
+		Description:       entry.Description,
+		OccurredAt:        entry.OccurredAt,
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, fmt.Errorf("failed to create ledger entry: %w", err)
+		}
+
+		// Already posted: return what was stored the first time
+		existing, err := q.GetLedgerEntryByReference(ctx, entry.ExternalReference)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get existing ledger entry: %w", err)
+		}
+		result, err := loadLedgerEntry(ctx, q, existing)
+		return result, false, err
+	}
+
+	result := mapDBLedgerEntryToDomain(dbEntry)
+	for _, posting := range entry.Postings {
+		dbPosting, err := q.CreateLedgerPosting(ctx, db.CreateLedgerPostingParams{
+			ID:        posting.ID,
+			EntryID:   dbEntry.ID,
+			AccountID: posting.AccountID,
+			Asset:     posting.Amount.Currency(),
+			Amount:    posting.Amount.Amount(),
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create ledger posting: %w", err)
+		}
+		result.Postings = append(result.Postings, mapDBLedgerPostingToDomain(dbPosting))
+	}
+
+	return result, true, nil
+}
+
+// GetEntryByReference retrieves an entry with its postings, or nil if there is none
+func (r *LedgerRepository) GetEntryByReference(ctx context.Context, reference string) (*domain.LedgerEntry, error) {
+	dbEntry, err := r.store.GetLedgerEntryByReference(ctx, reference)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ledger entry by reference: %w", err)
+	}
+
+	return loadLedgerEntry(ctx, r.store, dbEntry)
+}
+
+// GetBalances sums an account's postings per asset
+func (r *LedgerRepository) GetBalances(ctx context.Context, accountID uuid.UUID) ([]domain.LedgerBalance, error) {
+	rows, err := r.store.GetLedgerAccountBalances(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger balances: %w", err)
+	}
+
+	balances := make([]domain.LedgerBalance, len(rows))
+	for i, row := range rows {
+		balances[i] = domain.LedgerBalance{
+			AccountID: accountID,
+			Balance:   money.New(row.Balance, row.Asset),
+		}
+	}
+
+	return balances, nil
+}
+
+// GetBalance sums an account's postings in one asset
+func (r *LedgerRepository) GetBalance(ctx context.Context, accountID uuid.UUID, asset string) (*domain.LedgerBalance, error) {
+	balance, err := r.store.GetLedgerAccountBalance(ctx, db.GetLedgerAccountBalanceParams{
+		AccountID: accountID,
+		Asset:     asset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger balance: %w", err)
+	}
+
+	return &domain.LedgerBalance{
+		AccountID: accountID,
+		Balance:   money.New(balance, asset),
+	}, nil
+}
+
+// loadLedgerEntry maps a stored entry and fetches its postings, inside or outside a transaction
+func loadLedgerEntry(ctx context.Context, q db.Querier, dbEntry db.LedgerEntries) (*domain.LedgerEntry, error) {
+	postings, err := q.ListLedgerPostingsByEntry(ctx, dbEntry.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger postings: %w", err)
+	}
+
+	entry := mapDBLedgerEntryToDomain(dbEntry)
+	for _, posting := range postings {
+		entry.Postings = append(entry.Postings, mapDBLedgerPostingToDomain(posting))
+	}
+
+	return entry, nil
+}
+
+func mapDBLedgerAccountToDomain(account db.LedgerAccounts) *domain.LedgerAccount {
+	result := &domain.LedgerAccount{
+		ID:        account.ID,
+		Code:      account.Code,
+		Name:      account.Name,
+		Type:      domain.LedgerAccountType(account.Type),
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+
+	if account.OwnerID.Valid {
+		ownerID := uuid.UUID(account.OwnerID.Bytes)
+		result.OwnerID = &ownerID
+	}
+
+	return result
+}
+
+func mapDBLedgerEntryToDomain(entry db.LedgerEntries) *domain.LedgerEntry {
+	return &domain.LedgerEntry{
+		ID:                entry.ID,
+		ExternalReference: entry.ExternalReference,
+		Description:       entry.Description,
+		OccurredAt:        entry.OccurredAt,
+		CreatedAt:         entry.CreatedAt,
+	}
+}
+
+func mapDBLedgerPostingToDomain(posting db.LedgerPostings) domain.LedgerPosting {
+	return domain.LedgerPosting{
+		ID:        posting.ID,
+		EntryID:   posting.EntryID,
+		AccountID: posting.AccountID,
+		Amount:    money.New(posting.Amount, posting.Asset),
+		CreatedAt: posting.CreatedAt,
+	}
+}
@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// OrganizationRepository persists organizations and their members. It needs a
+// db.Store so an organization and its first owner are created together.
+type OrganizationRepository struct {
+	store db.Store
+}
+
+func NewOrganizationRepository(store db.Store) *OrganizationRepository {
+	return &OrganizationRepository{
+		store: store,
+	}
+}
+
+// CreateOrganization creates the organization and its first owner in one transaction
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, org domain.Organization, ownerID uuid.UUID) (*domain.Organization, error) {
+	var result *domain.Organization
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		params := db.CreateOrganizationParams{
+			ID:           org.ID,
+			Name:         org.Name,
+			Address:      org.Address,
+			City:         org.City,
+			PostalCode:   org.PostalCode,
+			Country:      org.Country,
+			Website:      toPgTextPtr(org.Website),
+			Size:         toPgTextPtr(org.Size),
+			Industry:     toPgTextPtr(org.Industry),
+			Description:  toPgTextPtr(org.Description),
+			Headquarters: toPgTextPtr(org.Headquarters),
+			LogoUrl:      toPgTextPtr(org.LogoURL),
+			TaxID:        org.TaxID,
+		}
+		if org.CreatedBy != nil {
+			params.CreatedBy = pgtype.UUID{Bytes: *org.CreatedBy, Valid: true}
+		}
+
+		dbOrg, err := q.CreateOrganization(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to create organization: %w", err)
+		}
+
+		if _, err := q.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			ID:             uuid.New(),
+			OrganizationID: dbOrg.ID,
+			UserID:         ownerID,
+			Role:           string(domain.OrganizationRoleOwner),
+		}); err != nil {
+			return fmt.Errorf("failed to add organization owner: %w", err)
+		}
+
+		result = mapDBOrganizationToDomain(dbOrg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetOrganizationByID retrieves an organization by ID, or nil if there is none
+func (r *OrganizationRepository) GetOrganizationByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error) {
+	dbOrg, err := r.store.GetOrganizationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get organization by ID: %w", err)
+	}
+
+	return mapDBOrganizationToDomain(dbOrg), nil
+}
+
+// UpdateOrganization saves the organization's profile
+func (r *OrganizationRepository) UpdateOrganization(ctx context.Context, org domain.Organization) (*domain.Organization, error) {
+	dbOrg, err := r.store.UpdateOrganization(ctx, db.UpdateOrganizationParams{
+		ID:           org.ID,
+		Name:         org.Name,
+		Address:      org.Address,
+		City:         org.City,
+		PostalCode:   org.PostalCode,
+		Country:      org.Country,
+		Website:      toPgTextPtr(org.Website),
+		Size:         toPgTextPtr(org.Size),
+		Industry:     toPgTextPtr(org.Industry),
+		Description:  toPgTextPtr(org.Description),
+		Headquarters: toPgTextPtr(org.Headquarters),
+		LogoUrl:      toPgTextPtr(org.LogoURL),
+		TaxID:        org.TaxID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	return mapDBOrganizationToDomain(dbOrg), nil
+}
+
+// ListOrganizationsByUser lists the organizations a user belongs to with their role in each
+func (r *OrganizationRepository) ListOrganizationsByUser(ctx context.Context, userID uuid.UUID) ([]domain.OrganizationMembership, error) {
+	rows, err := r.store.ListOrganizationsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	memberships := make([]domain.OrganizationMembership, len(rows))
+	for i, row := range rows {
+		memberships[i] = domain.OrganizationMembership{
+			Organization: *mapDBOrganizationToDomain(row.Organizations),
+			Role:         domain.OrganizationRole(row.Role),
+		}
+	}
+
+	return memberships, nil
+}
+
+// AddMember adds a user to an organization
+func (r *OrganizationRepository) AddMember(ctx context.Context, member domain.OrganizationMember) (*domain.OrganizationMember, error) {
+	dbMember, err := r.store.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+		ID:             member.ID,
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           string(member.Role),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	return mapDBOrganizationMemberToDomain(dbMember), nil
+}
+
+// GetMember retrieves a user's membership of an organization, or nil if they are not a member
+func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	row, err := r.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+
+	member := mapDBOrganizationMemberToDomain(row.OrganizationMembers)
+	member.Email = row.Email
+	member.FirstName = row.FirstName
+	member.LastName = row.LastName
+
+	return member, nil
+}
+
+// ListMembers lists an organization's members in the order they joined
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.OrganizationMember, error) {
+	rows, err := r.store.ListOrganizationMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	members := make([]domain.OrganizationMember, len(rows))
+	for i, row := range rows {
+		member := mapDBOrganizationMemberToDomain(row.OrganizationMembers)
+		member.Email = row.Email
+		member.FirstName = row.FirstName
+		member.LastName = row.LastName
+		members[i] = *member
+	}
+
+	return members, nil
+}
+
+// UpdateMemberRole changes a member's role
+func (r *OrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	dbMember, err := r.store.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+		Role:           string(role),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization member role: %w", err)
+	}
+
+	return mapDBOrganizationMemberToDomain(dbMember), nil
+}
+
+// RemoveMember removes a user from an organization
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	err := r.store.DeleteOrganizationMember(ctx, db.DeleteOrganizationMemberParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	return nil
+}
+
+// CountMembersByRole counts an organization's members with the given role
+func (r *OrganizationRepository) CountMembersByRole(ctx context.Context, orgID uuid.UUID, role domain.OrganizationRole) (int64, error) {
+	count, err := r.store.CountOrganizationMembersByRole(ctx, db.CountOrganizationMembersByRoleParams{
+		OrganizationID: orgID,
+		Role:           string(role),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count organization members: %w", err)
+	}
+
+	return count, nil
+}
+
+func mapDBOrganizationToDomain(org db.Organizations) *domain.Organization {
+	result := &domain.Organization{
+		ID:           org.ID,
+		Name:         org.Name,
+		Address:      org.Address,
+		City:         org.City,
+		PostalCode:   org.PostalCode,
+		Country:      org.Country,
+		Website:      textPtr(org.Website),
+		Size:         textPtr(org.Size),
+		Industry:     textPtr(org.Industry),
+		Description:  textPtr(org.Description),
+		Headquarters: textPtr(org.Headquarters),
+		LogoURL:      textPtr(org.LogoUrl),
+		TaxID:        org.TaxID,
+		CreatedAt:    org.CreatedAt,
+		UpdatedAt:    org.UpdatedAt,
+	}
+
+	if org.CreatedBy.Valid {
+		createdBy := uuid.UUID(org.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
+
+func mapDBOrganizationMemberToDomain(member db.OrganizationMembers) *domain.OrganizationMember {
+	return &domain.OrganizationMember{
+		ID:             member.ID,
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           domain.OrganizationRole(member.Role),
+		CreatedAt:      member.CreatedAt,
+		UpdatedAt:      member.UpdatedAt,
+	}
+}
+
+// textPtr returns the value of a nullable text column, or nil when it is NULL
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
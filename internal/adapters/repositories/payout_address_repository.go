@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PayoutAddressRepository struct {
+	store db.Store
+}
+
+func NewPayoutAddressRepository(store db.Store) *PayoutAddressRepository {
+	return &PayoutAddressRepository{
+		store: store,
+	}
+}
+
+// CreateWalletWithPayoutAddress saves a newly linked wallet together with its
+// allowlist entry, so a wallet is never stored without its quarantine
+func (r *PayoutAddressRepository) CreateWalletWithPayoutAddress(ctx context.Context, wallet domain.UserWallet, address domain.PayoutAddress) (*domain.PayoutAddress, error) {
+	var created db.PayoutAddressAllowlist
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		now := time.Now()
+		if _, err := q.CreateUserWallet(ctx, db.CreateUserWalletParams{
+			ID:        wallet.ID,
+			UserID:    wallet.UserID,
+			Address:   wallet.Address,
+			Type:      wallet.Type,
+			Chain:     wallet.Chain,
+			IsDefault: wallet.IsDefault,
+			CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+			UpdatedAt: pgtype.Timestamp{Time: now, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to create user wallet: %w", err)
+		}
+
+		var err error
+		created, err = q.CreatePayoutAddress(ctx, db.CreatePayoutAddressParams{
+			ID:              address.ID,
+			UserID:          address.UserID,
+			WalletID:        address.WalletID,
+			Address:         address.Address,
+			Chain:           address.Chain,
+			Status:          string(address.Status),
+			CancelTokenHash: address.CancelTokenHash,
+			AvailableAt:     address.AvailableAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create payout address: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBPayoutAddressToDomain(created), nil
+}
+
+// GetPayoutAddressByID retrieves an allowlist entry by ID
+func (r *PayoutAddressRepository) GetPayoutAddressByID(ctx context.Context, id uuid.UUID) (*domain.PayoutAddress, error) {
+	dbAddress, err := r.store.GetPayoutAddressByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payout address by ID: %w", err)
+	}
+
+	return mapDBPayoutAddressToDomain(dbAddress), nil
+}
+
+// GetPayoutAddressByCancelTokenHash retrieves an allowlist entry by the hash of its cancel token
+func (r *PayoutAddressRepository) GetPayoutAddressByCancelTokenHash(ctx context.Context, tokenHash string) (*domain.PayoutAddress, error) {
+	dbAddress, err := r.store.GetPayoutAddressByCancelTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payout address by cancel token: %w", err)
+	}
+
+	return mapDBPayoutAddressToDomain(dbAddress), nil
+}
+
+// GetLatestPayoutAddress retrieves the most recent allowlist entry for a user's address.
+// It returns nil when the address has never been allowlisted.
+func (r *PayoutAddressRepository) GetLatestPayoutAddress(ctx context.Context, userID uuid.UUID, address string) (*domain.PayoutAddress, error) {
+	dbAddress, err := r.store.GetLatestPayoutAddressByUserAndAddress(ctx, db.GetLatestPayoutAddressByUserAndAddressParams{
+		UserID:  userID,
+		Address: address,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payout address: %w", err)
+	}
+
+	return mapDBPayoutAddressToDomain(dbAddress), nil
+}
+
+// ListPayoutAddressesByUserID lists all allowlist entries for a user
+func (r *PayoutAddressRepository) ListPayoutAddressesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.PayoutAddress, error) {
+	dbAddresses, err := r.store.ListPayoutAddressesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payout addresses: %w", err)
+	}
+
+	result := make([]domain.PayoutAddress, len(dbAddresses))
+	for i, dbAddress := range dbAddresses {
+		result[i] = *mapDBPayoutAddressToDomain(dbAddress)
+	}
+
+	return result, nil
+}
+
+// ActivateDuePayoutAddresses marks every pending address whose cooling-off period has elapsed as active
+func (r *PayoutAddressRepository) ActivateDuePayoutAddresses(ctx context.Context, now time.Time) error {
+	if err := r.store.ActivateDuePayoutAddresses(ctx, now); err != nil {
+		return fmt.Errorf("failed to activate payout addresses: %w", err)
+	}
+
+	return nil
+}
+
+// CancelPayoutAddress cancels a pending allowlist entry
+func (r *PayoutAddressRepository) CancelPayoutAddress(ctx context.Context, id uuid.UUID) (*domain.PayoutAddress, error) {
+	dbAddress, err := r.store.CancelPayoutAddress(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel payout address: %w", err)
+	}
+
+	return mapDBPayoutAddressToDomain(dbAddress), nil
+}
+
+// Helper to map DB payout address to domain
+func mapDBPayoutAddressToDomain(address db.PayoutAddressAllowlist) *domain.PayoutAddress {
+	result := &domain.PayoutAddress{
+		ID:              address.ID,
+		UserID:          address.UserID,
+		WalletID:        address.WalletID,
+		Address:         address.Address,
+		Chain:           address.Chain,
+		Status:          domain.PayoutAddressStatus(address.Status),
+		CancelTokenHash: address.CancelTokenHash,
+		AvailableAt:     address.AvailableAt,
+		CreatedAt:       address.CreatedAt,
+		UpdatedAt:       address.UpdatedAt,
+	}
+
+	if address.ActivatedAt.Valid {
+		result.ActivatedAt = &address.ActivatedAt.Time
+	}
+
+	if address.CancelledAt.Valid {
+		result.CancelledAt = &address.CancelledAt.Time
+	}
+
+	return result
+}
@@ -0,0 +1,508 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PayrollRepository persists payroll schedules, compensation records and pay
+// runs. It needs a db.Store so a pay run, its line items and the schedule's
+// next pay date are written together.
+type PayrollRepository struct {
+	store db.Store
+}
+
+func NewPayrollRepository(store db.Store) *PayrollRepository {
+	return &PayrollRepository{
+		store: store,
+	}
+}
+
+// CreateSchedule creates a payroll schedule
+func (r *PayrollRepository) CreateSchedule(ctx context.Context, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	params := db.CreatePayrollScheduleParams{
+		ID:             schedule.ID,
+		OrganizationID: schedule.OrganizationID,
+		Name:           schedule.Name,
+		Frequency:      string(schedule.Frequency),
+		CronExpression: toPgText(schedule.CronExpression),
+		Timezone:       schedule.Timezone,
+		FirstPayDate:   schedule.FirstPayDate,
+		NextRunAt:      schedule.NextRunAt,
+		Active:         schedule.Active,
+	}
+	if schedule.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *schedule.CreatedBy, Valid: true}
+	}
+
+	dbSchedule, err := r.store.CreatePayrollSchedule(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payroll schedule: %w", err)
+	}
+
+	return mapDBPayrollScheduleToDomain(dbSchedule), nil
+}
+
+// GetSchedule retrieves a payroll schedule by ID, or nil if there is none
+func (r *PayrollRepository) GetSchedule(ctx context.Context, id uuid.UUID) (*domain.PayrollSchedule, error) {
+	dbSchedule, err := r.store.GetPayrollScheduleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payroll schedule: %w", err)
+	}
+
+	return mapDBPayrollScheduleToDomain(dbSchedule), nil
+}
+
+// ListSchedules lists an organization's payroll schedules, oldest first
+func (r *PayrollRepository) ListSchedules(ctx context.Context, orgID uuid.UUID) ([]domain.PayrollSchedule, error) {
+	dbSchedules, err := r.store.ListPayrollSchedulesByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payroll schedules: %w", err)
+	}
+
+	return mapDBPayrollSchedulesToDomain(dbSchedules), nil
+}
+
+// UpdateSchedule replaces a payroll schedule's settings
+func (r *PayrollRepository) UpdateSchedule(ctx context.Context, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	dbSchedule, err := r.store.UpdatePayrollSchedule(ctx, db.UpdatePayrollScheduleParams{
+		ID:             schedule.ID,
+		Name:           schedule.Name,
+		Frequency:      string(schedule.Frequency),
+		CronExpression: toPgText(schedule.CronExpression),
+		Timezone:       schedule.Timezone,
+		FirstPayDate:   schedule.FirstPayDate,
+		NextRunAt:      schedule.NextRunAt,
+		Active:         schedule.Active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payroll schedule: %w", err)
+	}
+
+	return mapDBPayrollScheduleToDomain(dbSchedule), nil
+}
+
+// ListDueSchedules lists up to limit active schedules whose next pay date is at or before now
+func (r *PayrollRepository) ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]domain.PayrollSchedule, error) {
+	dbSchedules, err := r.store.ListDuePayrollSchedules(ctx, db.ListDuePayrollSchedulesParams{
+		NextRunAt: now,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due payroll schedules: %w", err)
+	}
+
+	return mapDBPayrollSchedulesToDomain(dbSchedules), nil
+}
+
+// CreateCompensation records an employee's compensation on a schedule
+func (r *PayrollRepository) CreateCompensation(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	dbCompensation, err := r.store.CreateEmployeeCompensation(ctx, db.CreateEmployeeCompensationParams{
+		ID:             compensation.ID,
+		OrganizationID: compensation.OrganizationID,
+		ScheduleID:     compensation.ScheduleID,
+		UserID:         compensation.UserID,
+		Amount:         compensation.Amount.Amount(),
+		Currency:       compensation.Amount.Currency(),
+		PayoutAssetID:  compensation.PayoutAssetID,
+		WalletAddress:  compensation.WalletAddress,
+		TaxCountry:     compensation.TaxCountry,
+		TaxID:          compensation.TaxID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compensation: %w", err)
+	}
+
+	// Reload to include the employee and asset details
+	return r.GetCompensation(ctx, dbCompensation.ID)
+}
+
+// GetCompensation retrieves a compensation record by ID, or nil if there is none
+func (r *PayrollRepository) GetCompensation(ctx context.Context, id uuid.UUID) (*domain.EmployeeCompensation, error) {
+	row, err := r.store.GetEmployeeCompensationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get compensation: %w", err)
+	}
+
+	compensation := mapDBCompensationToDomain(row.EmployeeCompensations)
+	compensation.Email = row.Email
+	compensation.FirstName = row.FirstName
+	compensation.LastName = row.LastName
+	compensation.PayoutAssetSymbol = row.PayoutAssetSymbol
+
+	return compensation, nil
+}
+
+// ListCompensations lists an organization's compensation records, active ones first
+func (r *PayrollRepository) ListCompensations(ctx context.Context, orgID uuid.UUID, scheduleID *uuid.UUID) ([]domain.EmployeeCompensation, error) {
+	params := db.ListEmployeeCompensationsParams{
+		OrganizationID: orgID,
+	}
+	if scheduleID != nil {
+		params.ScheduleID = pgtype.UUID{Bytes: *scheduleID, Valid: true}
+	}
+
+	rows, err := r.store.ListEmployeeCompensations(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compensations: %w", err)
+	}
+
+	compensations := make([]domain.EmployeeCompensation, len(rows))
+	for i, row := range rows {
+		compensation := mapDBCompensationToDomain(row.EmployeeCompensations)
+		compensation.Email = row.Email
+		compensation.FirstName = row.FirstName
+		compensation.LastName = row.LastName
+		compensation.PayoutAssetSymbol = row.PayoutAssetSymbol
+		compensations[i] = *compensation
+	}
+
+	return compensations, nil
+}
+
+// UpdateCompensation replaces a compensation record's pay details and active flag
+func (r *PayrollRepository) UpdateCompensation(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	dbCompensation, err := r.store.UpdateEmployeeCompensation(ctx, db.UpdateEmployeeCompensationParams{
+		ID:            compensation.ID,
+		Amount:        compensation.Amount.Amount(),
+		Currency:      compensation.Amount.Currency(),
+		PayoutAssetID: compensation.PayoutAssetID,
+		WalletAddress: compensation.WalletAddress,
+		Active:        compensation.Active,
+		TaxCountry:    compensation.TaxCountry,
+		TaxID:         compensation.TaxID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update compensation: %w", err)
+	}
+
+	return r.GetCompensation(ctx, dbCompensation.ID)
+}
+
+// GeneratePayRun creates the draft pay run for payDate and advances the schedule in one transaction
+func (r *PayrollRepository) GeneratePayRun(ctx context.Context, scheduleID uuid.UUID, payDate, nextRunAt time.Time) (*domain.PayRun, error) {
+	var runID uuid.UUID
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		schedule, err := q.GetPayrollScheduleForUpdate(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("failed to lock payroll schedule: %w", err)
+		}
+
+		// Another generator got here first, or the schedule was paused or moved
+		if !schedule.Active || !schedule.NextRunAt.Equal(payDate) {
+			return nil
+		}
+
+		periodStart := schedule.CreatedAt
+		if schedule.LastRunAt.Valid {
+			periodStart = schedule.LastRunAt.Time
+		}
+
+		dbRun, err := q.CreatePayRun(ctx, db.CreatePayRunParams{
+			ID:             uuid.New(),
+			OrganizationID: schedule.OrganizationID,
+			ScheduleID:     schedule.ID,
+			PayDate:        payDate,
+			PeriodStart:    periodStart,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			// A run already exists for the date, so only the schedule needs to move on
+		case err != nil:
+			return fmt.Errorf("failed to create pay run: %w", err)
+		default:
+			if err := createPayRunLineItems(ctx, q, dbRun); err != nil {
+				return err
+			}
+			runID = dbRun.ID
+		}
+
+		if _, err := q.AdvancePayrollSchedule(ctx, db.AdvancePayrollScheduleParams{
+			ID:        schedule.ID,
+			LastRunAt: pgtype.Timestamptz{Time: payDate, Valid: true},
+			NextRunAt: nextRunAt,
+		}); err != nil {
+			return fmt.Errorf("failed to advance payroll schedule: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if runID == uuid.Nil {
+		return nil, nil
+	}
+
+	return r.GetPayRun(ctx, runID)
+}
+
+// GetPayRun retrieves a pay run with its line items, or nil if there is none
+func (r *PayrollRepository) GetPayRun(ctx context.Context, id uuid.UUID) (*domain.PayRun, error) {
+	dbRun, err := r.store.GetPayRunByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pay run: %w", err)
+	}
+
+	return r.withLineItems(ctx, dbRun)
+}
+
+// GetPreviousPayRun retrieves the schedule's latest pay run before the given pay date, with its line items
+func (r *PayrollRepository) GetPreviousPayRun(ctx context.Context, scheduleID uuid.UUID, before time.Time) (*domain.PayRun, error) {
+	dbRun, err := r.store.GetPreviousPayRun(ctx, db.GetPreviousPayRunParams{
+		ScheduleID: scheduleID,
+		Before:     before,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get previous pay run: %w", err)
+	}
+
+	return r.withLineItems(ctx, dbRun)
+}
+
+// withLineItems maps a pay run and loads its line items
+func (r *PayrollRepository) withLineItems(ctx context.Context, dbRun db.PayRuns) (*domain.PayRun, error) {
+	rows, err := r.store.ListPayRunLineItems(ctx, dbRun.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pay run line items: %w", err)
+	}
+
+	run := mapDBPayRunToDomain(dbRun)
+	run.LineItems = make([]domain.PayRunLineItem, len(rows))
+	for i, row := range rows {
+		if run.LineItems[i], err = mapDBPayRunLineItemToDomain(row); err != nil {
+			return nil, err
+		}
+	}
+
+	return run, nil
+}
+
+// ListPayRuns lists an organization's pay runs, latest pay date first, without line items
+func (r *PayrollRepository) ListPayRuns(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]domain.PayRun, int64, error) {
+	dbRuns, err := r.store.ListPayRunsByOrganization(ctx, db.ListPayRunsByOrganizationParams{
+		OrganizationID: orgID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list pay runs: %w", err)
+	}
+
+	total, err := r.store.CountPayRunsByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count pay runs: %w", err)
+	}
+
+	runs := make([]domain.PayRun, len(dbRuns))
+	for i, dbRun := range dbRuns {
+		runs[i] = *mapDBPayRunToDomain(dbRun)
+	}
+
+	return runs, total, nil
+}
+
+// ApprovePayRun moves a draft pay run straight to approved and stores the
+// payouts locked on its line items in one transaction
+func (r *PayrollRepository) ApprovePayRun(ctx context.Context, run domain.PayRun) (bool, error) {
+	approved := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		_, err := q.UpdatePayRunStatus(ctx, db.UpdatePayRunStatusParams{
+			ID:         run.ID,
+			FromStatus: string(domain.PayRunStatusDraft),
+			ToStatus:   string(domain.PayRunStatusApproved),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Submitted by someone else in the meantime
+				return nil
+			}
+			return fmt.Errorf("failed to update pay run status: %w", err)
+		}
+
+		if err := lockPayRunPayouts(ctx, q, run.LineItems); err != nil {
+			return err
+		}
+
+		approved = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return approved, nil
+}
+
+// lockPayRunPayouts stores the payouts a submitted pay run's line items were converted to
+func lockPayRunPayouts(ctx context.Context, q *db.Queries, items []domain.PayRunLineItem) error {
+	for _, item := range items {
+		params := db.LockPayRunLineItemPayoutParams{
+			ID:           item.ID,
+			LockedPayout: toPgNumeric(item.LockedPayout),
+		}
+		if item.FXQuoteID != nil {
+			params.FxQuoteID = pgtype.UUID{Bytes: *item.FXQuoteID, Valid: true}
+		}
+
+		if err := q.LockPayRunLineItemPayout(ctx, params); err != nil {
+			return fmt.Errorf("failed to lock pay run payout: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// createPayRunLineItems copies the schedule's active compensation records onto the run
+func createPayRunLineItems(ctx context.Context, q *db.Queries, run db.PayRuns) error {
+	compensations, err := q.ListActiveCompensationsBySchedule(ctx, run.ScheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to list compensations: %w", err)
+	}
+
+	for _, compensation := range compensations {
+		_, err := q.CreatePayRunLineItem(ctx, db.CreatePayRunLineItemParams{
+			ID:             uuid.New(),
+			PayRunID:       run.ID,
+			CompensationID: pgtype.UUID{Bytes: compensation.ID, Valid: true},
+			UserID:         compensation.UserID,
+			Amount:         compensation.Amount,
+			Currency:       compensation.Currency,
+			PayoutAssetID:  compensation.PayoutAssetID,
+			WalletAddress:  compensation.WalletAddress,
+			TaxCountry:     compensation.TaxCountry,
+			TaxID:          compensation.TaxID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create pay run line item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func mapDBPayrollSchedulesToDomain(dbSchedules []db.PayrollSchedules) []domain.PayrollSchedule {
+	schedules := make([]domain.PayrollSchedule, len(dbSchedules))
+	for i, dbSchedule := range dbSchedules {
+		schedules[i] = *mapDBPayrollScheduleToDomain(dbSchedule)
+	}
+	return schedules
+}
+
+func mapDBPayrollScheduleToDomain(schedule db.PayrollSchedules) *domain.PayrollSchedule {
+	result := &domain.PayrollSchedule{
+		ID:             schedule.ID,
+		OrganizationID: schedule.OrganizationID,
+		Name:           schedule.Name,
+		Frequency:      domain.PayrollFrequency(schedule.Frequency),
+		CronExpression: getTextString(schedule.CronExpression),
+		Timezone:       schedule.Timezone,
+		FirstPayDate:   schedule.FirstPayDate,
+		NextRunAt:      schedule.NextRunAt,
+		Active:         schedule.Active,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+
+	if schedule.LastRunAt.Valid {
+		result.LastRunAt = &schedule.LastRunAt.Time
+	}
+	if schedule.CreatedBy.Valid {
+		createdBy := uuid.UUID(schedule.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
+
+func mapDBCompensationToDomain(compensation db.EmployeeCompensations) *domain.EmployeeCompensation {
+	return &domain.EmployeeCompensation{
+		ID:             compensation.ID,
+		OrganizationID: compensation.OrganizationID,
+		ScheduleID:     compensation.ScheduleID,
+		UserID:         compensation.UserID,
+		Amount:         money.New(compensation.Amount, compensation.Currency),
+		PayoutAssetID:  compensation.PayoutAssetID,
+		WalletAddress:  compensation.WalletAddress,
+		TaxCountry:     compensation.TaxCountry,
+		TaxID:          compensation.TaxID,
+		Active:         compensation.Active,
+		CreatedAt:      compensation.CreatedAt,
+		UpdatedAt:      compensation.UpdatedAt,
+	}
+}
+
+func mapDBPayRunToDomain(run db.PayRuns) *domain.PayRun {
+	return &domain.PayRun{
+		ID:             run.ID,
+		OrganizationID: run.OrganizationID,
+		ScheduleID:     run.ScheduleID,
+		PayDate:        run.PayDate,
+		PeriodStart:    run.PeriodStart,
+		Status:         domain.PayRunStatus(run.Status),
+		CreatedAt:      run.CreatedAt,
+		UpdatedAt:      run.UpdatedAt,
+	}
+}
+
+func mapDBPayRunLineItemToDomain(row db.ListPayRunLineItemsRow) (domain.PayRunLineItem, error) {
+	item := domain.PayRunLineItem{
+		ID:                row.PayRunLineItems.ID,
+		PayRunID:          row.PayRunLineItems.PayRunID,
+		UserID:            row.PayRunLineItems.UserID,
+		Email:             row.Email,
+		FirstName:         row.FirstName,
+		LastName:          row.LastName,
+		Amount:            money.New(row.PayRunLineItems.Amount, row.PayRunLineItems.Currency),
+		PayoutAssetID:     row.PayRunLineItems.PayoutAssetID,
+		PayoutAssetSymbol: row.PayoutAssetSymbol,
+		WalletAddress:     row.PayRunLineItems.WalletAddress,
+		TaxCountry:        row.PayRunLineItems.TaxCountry,
+		TaxID:             row.PayRunLineItems.TaxID,
+		CreatedAt:         row.PayRunLineItems.CreatedAt,
+	}
+
+	if row.PayRunLineItems.CompensationID.Valid {
+		compensationID := uuid.UUID(row.PayRunLineItems.CompensationID.Bytes)
+		item.CompensationID = &compensationID
+	}
+
+	if row.PayRunLineItems.FxQuoteID.Valid {
+		quoteID := uuid.UUID(row.PayRunLineItems.FxQuoteID.Bytes)
+		item.FXQuoteID = &quoteID
+	}
+
+	if row.PayRunLineItems.LockedPayout.Valid {
+		payout, err := money.FromNumeric(row.PayRunLineItems.LockedPayout, row.PayoutAssetSymbol)
+		if err != nil {
+			return item, fmt.Errorf("failed to read locked payout of pay run line item %s: %w", item.ID, err)
+		}
+		item.LockedPayout = &payout
+	}
+
+	return item, nil
+}
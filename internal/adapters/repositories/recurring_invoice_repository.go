@@ -0,0 +1,312 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RecurringInvoiceRepository persists recurring invoices and the invoices they
+// generate. It needs a db.Store so an invoice is generated and its recurring
+// invoice moved on in one transaction.
+type RecurringInvoiceRepository struct {
+	store db.Store
+}
+
+func NewRecurringInvoiceRepository(store db.Store) *RecurringInvoiceRepository {
+	return &RecurringInvoiceRepository{
+		store: store,
+	}
+}
+
+// CreateRecurringInvoice stores a recurring invoice with its line items in one transaction
+func (r *RecurringInvoiceRepository) CreateRecurringInvoice(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		params := db.CreateRecurringInvoiceParams{
+			ID:              recurring.ID,
+			OrganizationID:  recurring.OrganizationID,
+			Name:            recurring.Name,
+			Frequency:       string(recurring.Frequency),
+			Timezone:        recurring.Timezone,
+			StartDate:       recurring.StartDate,
+			EndDate:         toPgTimestamptzPtr(recurring.EndDate),
+			MaxOccurrences:  toPgInt4Ptr(recurring.MaxOccurrences),
+			NextIssueAt:     recurring.NextIssueAt,
+			DaysUntilDue:    int32(recurring.DaysUntilDue),
+			AutoSend:        recurring.AutoSend,
+			Currency:        recurring.Currency,
+			CustomerName:    recurring.CustomerName,
+			CustomerEmail:   recurring.CustomerEmail,
+			CustomerAddress: recurring.CustomerAddress,
+			Notes:           recurring.Notes,
+			PaymentAddress:  toPgText(recurring.PaymentAddress),
+		}
+		if recurring.PaymentAssetID != nil {
+			params.PaymentAssetID = pgtype.UUID{Bytes: *recurring.PaymentAssetID, Valid: true}
+		}
+		if recurring.CreatedBy != nil {
+			params.CreatedBy = pgtype.UUID{Bytes: *recurring.CreatedBy, Valid: true}
+		}
+
+		if _, err := q.CreateRecurringInvoice(ctx, params); err != nil {
+			return fmt.Errorf("failed to create recurring invoice: %w", err)
+		}
+
+		return createRecurringInvoiceLineItems(ctx, q, recurring.ID, recurring.LineItems)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetRecurringInvoice(ctx, recurring.ID)
+}
+
+// GetRecurringInvoice retrieves a recurring invoice with its line items, or nil if there is none
+func (r *RecurringInvoiceRepository) GetRecurringInvoice(ctx context.Context, id uuid.UUID) (*domain.RecurringInvoice, error) {
+	dbRecurring, err := r.store.GetRecurringInvoiceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get recurring invoice: %w", err)
+	}
+
+	return r.withRecurringLineItems(ctx, dbRecurring)
+}
+
+// ListRecurringInvoices lists an organization's recurring invoices, oldest first, without line items
+func (r *RecurringInvoiceRepository) ListRecurringInvoices(ctx context.Context, orgID uuid.UUID) ([]domain.RecurringInvoice, error) {
+	dbRecurring, err := r.store.ListRecurringInvoicesByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring invoices: %w", err)
+	}
+
+	recurring := make([]domain.RecurringInvoice, len(dbRecurring))
+	for i, row := range dbRecurring {
+		recurring[i] = *mapDBRecurringInvoiceToDomain(row)
+	}
+
+	return recurring, nil
+}
+
+// UpdateRecurringInvoice replaces a recurring invoice's settings and line items in one transaction
+func (r *RecurringInvoiceRepository) UpdateRecurringInvoice(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		params := db.UpdateRecurringInvoiceParams{
+			ID:              recurring.ID,
+			Name:            recurring.Name,
+			Status:          string(recurring.Status),
+			Frequency:       string(recurring.Frequency),
+			Timezone:        recurring.Timezone,
+			StartDate:       recurring.StartDate,
+			EndDate:         toPgTimestamptzPtr(recurring.EndDate),
+			MaxOccurrences:  toPgInt4Ptr(recurring.MaxOccurrences),
+			NextIssueAt:     recurring.NextIssueAt,
+			DaysUntilDue:    int32(recurring.DaysUntilDue),
+			AutoSend:        recurring.AutoSend,
+			Currency:        recurring.Currency,
+			CustomerName:    recurring.CustomerName,
+			CustomerEmail:   recurring.CustomerEmail,
+			CustomerAddress: recurring.CustomerAddress,
+			Notes:           recurring.Notes,
+			PaymentAddress:  toPgText(recurring.PaymentAddress),
+		}
+		if recurring.PaymentAssetID != nil {
+			params.PaymentAssetID = pgtype.UUID{Bytes: *recurring.PaymentAssetID, Valid: true}
+		}
+
+		if _, err := q.UpdateRecurringInvoice(ctx, params); err != nil {
+			return fmt.Errorf("failed to update recurring invoice: %w", err)
+		}
+
+		if err := q.DeleteRecurringInvoiceLineItems(ctx, recurring.ID); err != nil {
+			return fmt.Errorf("failed to delete recurring invoice line items: %w", err)
+		}
+		return createRecurringInvoiceLineItems(ctx, q, recurring.ID, recurring.LineItems)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetRecurringInvoice(ctx, recurring.ID)
+}
+
+// ListDueRecurringInvoices lists up to limit active recurring invoices, with
+// their line items, whose next issue date is at or before now
+func (r *RecurringInvoiceRepository) ListDueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]domain.RecurringInvoice, error) {
+	dbRecurring, err := r.store.ListDueRecurringInvoices(ctx, db.ListDueRecurringInvoicesParams{
+		NextIssueAt: now,
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due recurring invoices: %w", err)
+	}
+
+	recurring := make([]domain.RecurringInvoice, len(dbRecurring))
+	for i, row := range dbRecurring {
+		result, err := r.withRecurringLineItems(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		recurring[i] = *result
+	}
+
+	return recurring, nil
+}
+
+// GenerateRecurringInvoice creates the invoice a recurring invoice issues on
+// invoice.IssueDate and moves the template on to nextIssueAt, completing it if
+// completed is set, in one transaction. It returns nil if the template is no
+// longer due on that date.
+func (r *RecurringInvoiceRepository) GenerateRecurringInvoice(ctx context.Context, recurringID uuid.UUID, invoice domain.Invoice, nextIssueAt time.Time, completed bool) (*domain.Invoice, error) {
+	generated := false
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		recurring, err := q.GetRecurringInvoiceForUpdate(ctx, recurringID)
+		if err != nil {
+			return fmt.Errorf("failed to lock recurring invoice: %w", err)
+		}
+
+		// Another generator got here first, or the template was paused or rescheduled
+		if recurring.Status != string(domain.RecurringInvoiceStatusActive) || !recurring.NextIssueAt.Equal(invoice.IssueDate) {
+			return nil
+		}
+
+		if err := createInvoice(ctx, q, invoice); err != nil {
+			return err
+		}
+
+		if _, err := q.AdvanceRecurringInvoice(ctx, db.AdvanceRecurringInvoiceParams{
+			ID:           recurring.ID,
+			LastIssuedAt: pgtype.Timestamptz{Time: invoice.IssueDate, Valid: true},
+			NextIssueAt:  nextIssueAt,
+			Completed:    completed,
+		}); err != nil {
+			return fmt.Errorf("failed to advance recurring invoice: %w", err)
+		}
+
+		generated = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !generated {
+		return nil, nil
+	}
+
+	return getInvoice(ctx, r.store, invoice.ID)
+}
+
+func (r *RecurringInvoiceRepository) withRecurringLineItems(ctx context.Context, dbRecurring db.RecurringInvoices) (*domain.RecurringInvoice, error) {
+	rows, err := r.store.ListRecurringInvoiceLineItems(ctx, dbRecurring.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring invoice line items: %w", err)
+	}
+
+	recurring := mapDBRecurringInvoiceToDomain(dbRecurring)
+	recurring.LineItems = make([]domain.RecurringInvoiceLineItem, len(rows))
+	for i, row := range rows {
+		recurring.LineItems[i] = domain.RecurringInvoiceLineItem{
+			ID:                 row.ID,
+			RecurringInvoiceID: row.RecurringInvoiceID,
+			Position:           int(row.Position),
+			Description:        row.Description,
+			Quantity:           row.Quantity,
+			UnitPrice:          money.New(row.UnitPrice, recurring.Currency),
+			DiscountPercent:    row.DiscountPercent,
+			TaxRate:            row.TaxRate,
+			CreatedAt:          row.CreatedAt,
+		}
+	}
+
+	return recurring, nil
+}
+
+// createRecurringInvoiceLineItems writes a recurring invoice's line items in their order
+func createRecurringInvoiceLineItems(ctx context.Context, q *db.Queries, recurringID uuid.UUID, items []domain.RecurringInvoiceLineItem) error {
+	for _, item := range items {
+		if _, err := q.CreateRecurringInvoiceLineItem(ctx, db.CreateRecurringInvoiceLineItemParams{
+			ID:                 uuid.New(),
+			RecurringInvoiceID: recurringID,
+			Position:           int32(item.Position),
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          item.UnitPrice.Amount(),
+			DiscountPercent:    item.DiscountPercent,
+			TaxRate:            item.TaxRate,
+		}); err != nil {
+			return fmt.Errorf("failed to create recurring invoice line item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func mapDBRecurringInvoiceToDomain(recurring db.RecurringInvoices) *domain.RecurringInvoice {
+	result := &domain.RecurringInvoice{
+		ID:              recurring.ID,
+		OrganizationID:  recurring.OrganizationID,
+		Name:            recurring.Name,
+		Status:          domain.RecurringInvoiceStatus(recurring.Status),
+		Frequency:       domain.InvoiceFrequency(recurring.Frequency),
+		Timezone:        recurring.Timezone,
+		StartDate:       recurring.StartDate,
+		Occurrences:     int(recurring.Occurrences),
+		NextIssueAt:     recurring.NextIssueAt,
+		DaysUntilDue:    int(recurring.DaysUntilDue),
+		AutoSend:        recurring.AutoSend,
+		Currency:        recurring.Currency,
+		CustomerName:    recurring.CustomerName,
+		CustomerEmail:   recurring.CustomerEmail,
+		CustomerAddress: recurring.CustomerAddress,
+		Notes:           recurring.Notes,
+		PaymentAddress:  getTextString(recurring.PaymentAddress),
+		CreatedAt:       recurring.CreatedAt,
+		UpdatedAt:       recurring.UpdatedAt,
+	}
+
+	if recurring.EndDate.Valid {
+		result.EndDate = &recurring.EndDate.Time
+	}
+	if recurring.MaxOccurrences.Valid {
+		maxOccurrences := int(recurring.MaxOccurrences.Int32)
+		result.MaxOccurrences = &maxOccurrences
+	}
+	if recurring.LastIssuedAt.Valid {
+		result.LastIssuedAt = &recurring.LastIssuedAt.Time
+	}
+	if recurring.PaymentAssetID.Valid {
+		paymentAssetID := uuid.UUID(recurring.PaymentAssetID.Bytes)
+		result.PaymentAssetID = &paymentAssetID
+	}
+	if recurring.CreatedBy.Valid {
+		createdBy := uuid.UUID(recurring.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
+
+func toPgTimestamptzPtr(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func toPgInt4Ptr(n *int) pgtype.Int4 {
+	if n == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*n), Valid: true}
+}
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SupportedAssetRepository struct {
+	store db.Queries
+}
+
+func NewSupportedAssetRepository(store db.Queries) *SupportedAssetRepository {
+	return &SupportedAssetRepository{
+		store: store,
+	}
+}
+
+// CreateAsset registers a new asset
+func (r *SupportedAssetRepository) CreateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	dbAsset, err := r.store.CreateSupportedAsset(ctx, db.CreateSupportedAssetParams{
+		ID:              asset.ID,
+		Chain:           asset.Chain,
+		Symbol:          asset.Symbol,
+		Name:            asset.Name,
+		ContractAddress: pgtype.Text{String: asset.ContractAddress, Valid: asset.ContractAddress != ""},
+		Decimals:        int16(asset.Decimals),
+		Enabled:         asset.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create supported asset: %w", err)
+	}
+
+	return mapDBSupportedAssetToDomain(dbAsset), nil
+}
+
+// GetAssetByID retrieves an asset by ID, or nil if there is none
+func (r *SupportedAssetRepository) GetAssetByID(ctx context.Context, id uuid.UUID) (*domain.SupportedAsset, error) {
+	dbAsset, err := r.store.GetSupportedAssetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get supported asset by ID: %w", err)
+	}
+
+	return mapDBSupportedAssetToDomain(dbAsset), nil
+}
+
+// GetAssetBySymbol retrieves an asset by chain and symbol, or nil if there is none
+func (r *SupportedAssetRepository) GetAssetBySymbol(ctx context.Context, chain, symbol string) (*domain.SupportedAsset, error) {
+	dbAsset, err := r.store.GetSupportedAssetBySymbol(ctx, db.GetSupportedAssetBySymbolParams{
+		Chain:  chain,
+		Symbol: symbol,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get supported asset by symbol: %w", err)
+	}
+
+	return mapDBSupportedAssetToDomain(dbAsset), nil
+}
+
+// GetAssetByContract retrieves an asset by chain and contract address, or nil if there is none
+func (r *SupportedAssetRepository) GetAssetByContract(ctx context.Context, chain, contractAddress string) (*domain.SupportedAsset, error) {
+	dbAsset, err := r.store.GetSupportedAssetByContract(ctx, db.GetSupportedAssetByContractParams{
+		Chain:           chain,
+		ContractAddress: contractAddress,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get supported asset by contract: %w", err)
+	}
+
+	return mapDBSupportedAssetToDomain(dbAsset), nil
+}
+
+// ListAssets lists assets matching the filter, ordered by chain and symbol
+func (r *SupportedAssetRepository) ListAssets(ctx context.Context, filter domain.SupportedAssetFilter) ([]domain.SupportedAsset, error) {
+	dbAssets, err := r.store.ListSupportedAssets(ctx, db.ListSupportedAssetsParams{
+		Chain:       pgtype.Text{String: filter.Chain, Valid: filter.Chain != ""},
+		EnabledOnly: filter.EnabledOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list supported assets: %w", err)
+	}
+
+	result := make([]domain.SupportedAsset, len(dbAssets))
+	for i, dbAsset := range dbAssets {
+		result[i] = *mapDBSupportedAssetToDomain(dbAsset)
+	}
+
+	return result, nil
+}
+
+// UpdateAsset saves the asset's name and enabled flag
+func (r *SupportedAssetRepository) UpdateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	dbAsset, err := r.store.UpdateSupportedAsset(ctx, db.UpdateSupportedAssetParams{
+		ID:      asset.ID,
+		Name:    asset.Name,
+		Enabled: asset.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update supported asset: %w", err)
+	}
+
+	return mapDBSupportedAssetToDomain(dbAsset), nil
+}
+
+// Helper to map DB supported asset to domain
+func mapDBSupportedAssetToDomain(asset db.SupportedAssets) *domain.SupportedAsset {
+	return &domain.SupportedAsset{
+		ID:              asset.ID,
+		Chain:           asset.Chain,
+		Symbol:          asset.Symbol,
+		Name:            asset.Name,
+		ContractAddress: asset.ContractAddress.String,
+		Decimals:        int(asset.Decimals),
+		Enabled:         asset.Enabled,
+		CreatedAt:       asset.CreatedAt,
+		UpdatedAt:       asset.UpdatedAt,
+	}
+}
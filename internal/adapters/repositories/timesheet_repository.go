@@ -0,0 +1,366 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TimesheetRepository struct {
+	store db.Store
+}
+
+func NewTimesheetRepository(store db.Store) *TimesheetRepository {
+	return &TimesheetRepository{
+		store: store,
+	}
+}
+
+// CreateContractorContract adds a contract. It returns nil if the contractor
+// already has an active contract for the project.
+func (r *TimesheetRepository) CreateContractorContract(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+	params := db.CreateContractorContractParams{
+		ID:             contract.ID,
+		OrganizationID: contract.OrganizationID,
+		ContractorID:   contract.ContractorID,
+		Project:        contract.Project,
+		HourlyRate:     contract.HourlyRate.Amount(),
+		Currency:       contract.HourlyRate.Currency(),
+	}
+	if contract.ClientID != nil {
+		params.ClientID = pgtype.UUID{Bytes: *contract.ClientID, Valid: true}
+	}
+	if contract.CreatedBy != nil {
+		params.CreatedBy = pgtype.UUID{Bytes: *contract.CreatedBy, Valid: true}
+	}
+
+	dbContract, err := r.store.CreateContractorContract(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to create contractor contract: %w", err)
+	}
+
+	return r.GetContractorContract(ctx, dbContract.OrganizationID, dbContract.ID)
+}
+
+// GetContractorContract retrieves one of the organization's contracts with
+// the contractor's name and the client's, or nil if there is none
+func (r *TimesheetRepository) GetContractorContract(ctx context.Context, orgID, id uuid.UUID) (*domain.ContractorContract, error) {
+	row, err := r.store.GetContractorContract(ctx, db.GetContractorContractParams{
+		OrganizationID: orgID,
+		ID:             id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get contractor contract: %w", err)
+	}
+
+	contract := mapDBContractorContractToDomain(row.ContractorContracts)
+	contract.Email = row.Email
+	contract.FirstName = row.FirstName
+	contract.LastName = row.LastName
+	contract.ClientName = row.ClientName
+
+	return contract, nil
+}
+
+// ListContractorContracts lists an organization's contracts, active ones
+// first, optionally only one contractor's
+func (r *TimesheetRepository) ListContractorContracts(ctx context.Context, orgID uuid.UUID, contractorID *uuid.UUID) ([]domain.ContractorContract, error) {
+	params := db.ListContractorContractsParams{
+		OrganizationID: orgID,
+	}
+	if contractorID != nil {
+		params.ContractorID = pgtype.UUID{Bytes: *contractorID, Valid: true}
+	}
+
+	rows, err := r.store.ListContractorContracts(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contractor contracts: %w", err)
+	}
+
+	contracts := make([]domain.ContractorContract, len(rows))
+	for i, row := range rows {
+		contract := mapDBContractorContractToDomain(row.ContractorContracts)
+		contract.Email = row.Email
+		contract.FirstName = row.FirstName
+		contract.LastName = row.LastName
+		contract.ClientName = row.ClientName
+		contracts[i] = *contract
+	}
+
+	return contracts, nil
+}
+
+// UpdateContractorContract replaces a contract's client, project, rate and
+// active flag. It returns nil if the contract is not the organization's.
+func (r *TimesheetRepository) UpdateContractorContract(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+	params := db.UpdateContractorContractParams{
+		OrganizationID: contract.OrganizationID,
+		ID:             contract.ID,
+		Project:        contract.Project,
+		HourlyRate:     contract.HourlyRate.Amount(),
+		Currency:       contract.HourlyRate.Currency(),
+		Active:         contract.Active,
+	}
+	if contract.ClientID != nil {
+		params.ClientID = pgtype.UUID{Bytes: *contract.ClientID, Valid: true}
+	}
+
+	dbContract, err := r.store.UpdateContractorContract(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update contractor contract: %w", err)
+	}
+
+	return r.GetContractorContract(ctx, dbContract.OrganizationID, dbContract.ID)
+}
+
+// SaveTimesheet writes a contract's timesheet for a week as a draft, replacing
+// the entries it had. It returns nil if the timesheet has already been
+// submitted and can no longer be edited.
+func (r *TimesheetRepository) SaveTimesheet(ctx context.Context, timesheet domain.Timesheet) (*domain.Timesheet, error) {
+	var saved db.Timesheets
+	var locked bool
+
+	err := r.store.ExecTx(ctx, func(q *db.Queries) error {
+		var err error
+		saved, err = q.SaveTimesheet(ctx, db.SaveTimesheetParams{
+			ID:             timesheet.ID,
+			OrganizationID: timesheet.OrganizationID,
+			ContractID:     timesheet.ContractID,
+			ContractorID:   timesheet.ContractorID,
+			WeekStart:      timesheet.WeekStart,
+			TotalHours:     timesheet.TotalHours,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				locked = true
+				return nil
+			}
+			return fmt.Errorf("failed to save timesheet: %w", err)
+		}
+
+		if err := q.DeleteTimesheetEntries(ctx, saved.ID); err != nil {
+			return fmt.Errorf("failed to clear timesheet entries: %w", err)
+		}
+
+		for i, entry := range timesheet.Entries {
+			if err := q.CreateTimesheetEntry(ctx, db.CreateTimesheetEntryParams{
+				ID:          uuid.New(),
+				TimesheetID: saved.ID,
+				Position:    int32(i + 1),
+				WorkDate:    entry.WorkDate,
+				Hours:       entry.Hours,
+				Description: entry.Description,
+			}); err != nil {
+				return fmt.Errorf("failed to create timesheet entry: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return nil, nil
+	}
+
+	return r.GetTimesheet(ctx, saved.OrganizationID, saved.ID)
+}
+
+// GetTimesheet retrieves one of the organization's timesheets with its
+// entries, or nil if there is none
+func (r *TimesheetRepository) GetTimesheet(ctx context.Context, orgID, id uuid.UUID) (*domain.Timesheet, error) {
+	row, err := r.store.GetTimesheet(ctx, db.GetTimesheetParams{
+		OrganizationID: orgID,
+		ID:             id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get timesheet: %w", err)
+	}
+
+	entries, err := r.store.ListTimesheetEntries(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timesheet entries: %w", err)
+	}
+
+	timesheet := mapDBTimesheetToDomain(row.Timesheets)
+	timesheet.Project = row.Project
+	timesheet.Email = row.Email
+	timesheet.FirstName = row.FirstName
+	timesheet.LastName = row.LastName
+	timesheet.Entries = make([]domain.TimesheetEntry, len(entries))
+	for i, entry := range entries {
+		timesheet.Entries[i] = domain.TimesheetEntry{
+			ID:          entry.ID,
+			WorkDate:    entry.WorkDate.UTC(),
+			Hours:       entry.Hours,
+			Description: entry.Description,
+		}
+	}
+
+	return timesheet, nil
+}
+
+// ListTimesheets lists an organization's timesheets without their entries,
+// latest week first
+func (r *TimesheetRepository) ListTimesheets(ctx context.Context, orgID uuid.UUID, filter domain.TimesheetFilter, limit, offset int) ([]domain.Timesheet, int64, error) {
+	var contractorID, contractID pgtype.UUID
+	var status pgtype.Text
+	if filter.ContractorID != nil {
+		contractorID = pgtype.UUID{Bytes: *filter.ContractorID, Valid: true}
+	}
+	if filter.ContractID != nil {
+		contractID = pgtype.UUID{Bytes: *filter.ContractID, Valid: true}
+	}
+	if filter.Status != nil {
+		status = pgtype.Text{String: string(*filter.Status), Valid: true}
+	}
+
+	rows, err := r.store.ListTimesheets(ctx, db.ListTimesheetsParams{
+		OrganizationID: orgID,
+		ContractorID:   contractorID,
+		ContractID:     contractID,
+		Status:         status,
+		LimitCount:     int32(limit),
+		OffsetCount:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list timesheets: %w", err)
+	}
+
+	total, err := r.store.CountTimesheets(ctx, db.CountTimesheetsParams{
+		OrganizationID: orgID,
+		ContractorID:   contractorID,
+		ContractID:     contractID,
+		Status:         status,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count timesheets: %w", err)
+	}
+
+	timesheets := make([]domain.Timesheet, len(rows))
+	for i, row := range rows {
+		timesheet := mapDBTimesheetToDomain(row.Timesheets)
+		timesheet.Project = row.Project
+		timesheet.Email = row.Email
+		timesheet.FirstName = row.FirstName
+		timesheet.LastName = row.LastName
+		timesheets[i] = *timesheet
+	}
+
+	return timesheets, total, nil
+}
+
+// SubmitTimesheet submits a draft timesheet that has hours on it for
+// approval. It returns nil if the timesheet is not such a draft.
+func (r *TimesheetRepository) SubmitTimesheet(ctx context.Context, id uuid.UUID, at time.Time) (*domain.Timesheet, error) {
+	dbTimesheet, err := r.store.SubmitTimesheet(ctx, db.SubmitTimesheetParams{
+		ID:          id,
+		SubmittedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	return r.timesheetTransitioned(ctx, dbTimesheet, err, "submit timesheet")
+}
+
+// ReviewTimesheet approves or rejects a submitted timesheet. It returns nil
+// if the timesheet is no longer awaiting review.
+func (r *TimesheetRepository) ReviewTimesheet(ctx context.Context, id uuid.UUID, status domain.TimesheetStatus, reviewerID uuid.UUID, at time.Time, reason string) (*domain.Timesheet, error) {
+	dbTimesheet, err := r.store.ReviewTimesheet(ctx, db.ReviewTimesheetParams{
+		ID:              id,
+		Status:          string(status),
+		ReviewedBy:      pgtype.UUID{Bytes: reviewerID, Valid: true},
+		ReviewedAt:      pgtype.Timestamptz{Time: at, Valid: true},
+		RejectionReason: reason,
+	})
+	return r.timesheetTransitioned(ctx, dbTimesheet, err, "review timesheet")
+}
+
+// timesheetTransitioned loads the timesheet after a conditional status change,
+// or returns nil if the timesheet was not in a status the change applies to
+func (r *TimesheetRepository) timesheetTransitioned(ctx context.Context, dbTimesheet db.Timesheets, err error, action string) (*domain.Timesheet, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	return r.GetTimesheet(ctx, dbTimesheet.OrganizationID, dbTimesheet.ID)
+}
+
+func mapDBContractorContractToDomain(contract db.ContractorContracts) *domain.ContractorContract {
+	result := &domain.ContractorContract{
+		ID:             contract.ID,
+		OrganizationID: contract.OrganizationID,
+		ContractorID:   contract.ContractorID,
+		Project:        contract.Project,
+		HourlyRate:     money.New(contract.HourlyRate, contract.Currency),
+		Active:         contract.Active,
+		CreatedAt:      contract.CreatedAt,
+		UpdatedAt:      contract.UpdatedAt,
+	}
+
+	if contract.ClientID.Valid {
+		clientID := uuid.UUID(contract.ClientID.Bytes)
+		result.ClientID = &clientID
+	}
+	if contract.CreatedBy.Valid {
+		createdBy := uuid.UUID(contract.CreatedBy.Bytes)
+		result.CreatedBy = &createdBy
+	}
+
+	return result
+}
+
+func mapDBTimesheetToDomain(timesheet db.Timesheets) *domain.Timesheet {
+	result := &domain.Timesheet{
+		ID:              timesheet.ID,
+		OrganizationID:  timesheet.OrganizationID,
+		ContractID:      timesheet.ContractID,
+		ContractorID:    timesheet.ContractorID,
+		WeekStart:       timesheet.WeekStart.UTC(),
+		Status:          domain.TimesheetStatus(timesheet.Status),
+		TotalHours:      timesheet.TotalHours,
+		RejectionReason: timesheet.RejectionReason,
+		CreatedAt:       timesheet.CreatedAt,
+		UpdatedAt:       timesheet.UpdatedAt,
+	}
+
+	if timesheet.SubmittedAt.Valid {
+		submittedAt := timesheet.SubmittedAt.Time
+		result.SubmittedAt = &submittedAt
+	}
+	if timesheet.ReviewedBy.Valid {
+		reviewedBy := uuid.UUID(timesheet.ReviewedBy.Bytes)
+		result.ReviewedBy = &reviewedBy
+	}
+	if timesheet.ReviewedAt.Valid {
+		reviewedAt := timesheet.ReviewedAt.Time
+		result.ReviewedAt = &reviewedAt
+	}
+	if timesheet.InvoiceID.Valid {
+		invoiceID := uuid.UUID(timesheet.InvoiceID.Bytes)
+		result.InvoiceID = &invoiceID
+	}
+
+	return result
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type TransactionPINRepository struct {
+	store db.Queries
+}
+
+func NewTransactionPINRepository(store db.Queries) *TransactionPINRepository {
+	return &TransactionPINRepository{
+		store: store,
+	}
+}
+
+// GetTransactionPIN retrieves a user's transaction PIN record.
+// It returns nil when the user has not set a PIN.
+func (r *TransactionPINRepository) GetTransactionPIN(ctx context.Context, userID uuid.UUID) (*domain.TransactionPIN, error) {
+	dbPIN, err := r.store.GetTransactionPINByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get transaction PIN: %w", err)
+	}
+
+	return mapDBTransactionPINToDomain(dbPIN), nil
+}
+
+// SetTransactionPIN stores a new PIN hash and clears any lockout
+func (r *TransactionPINRepository) SetTransactionPIN(ctx context.Context, userID uuid.UUID, pinHash string) (*domain.TransactionPIN, error) {
+	dbPIN, err := r.store.UpsertTransactionPIN(ctx, db.UpsertTransactionPINParams{
+		UserID:  userID,
+		PinHash: pinHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set transaction PIN: %w", err)
+	}
+
+	return mapDBTransactionPINToDomain(dbPIN), nil
+}
+
+// RecordFailedAttempt counts a wrong PIN entry, locking the PIN until lockUntil
+// once maxAttempts consecutive failures are reached
+func (r *TransactionPINRepository) RecordFailedAttempt(ctx context.Context, userID uuid.UUID, maxAttempts int, lockUntil time.Time) (*domain.TransactionPIN, error) {
+	dbPIN, err := r.store.RecordFailedTransactionPINAttempt(ctx, db.RecordFailedTransactionPINAttemptParams{
+		UserID:      userID,
+		MaxAttempts: int32(maxAttempts),
+		LockUntil:   lockUntil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record failed PIN attempt: %w", err)
+	}
+
+	return mapDBTransactionPINToDomain(dbPIN), nil
+}
+
+// ResetFailedAttempts clears failed attempts and any lockout
+func (r *TransactionPINRepository) ResetFailedAttempts(ctx context.Context, userID uuid.UUID) error {
+	if err := r.store.ResetTransactionPINAttempts(ctx, userID); err != nil {
+		return fmt.Errorf("failed to reset PIN attempts: %w", err)
+	}
+
+	return nil
+}
+
+// Helper to map DB transaction PIN to domain
+func mapDBTransactionPINToDomain(pin db.UserTransactionPins) *domain.TransactionPIN {
+	result := &domain.TransactionPIN{
+		UserID:         pin.UserID,
+		PINHash:        pin.PinHash,
+		FailedAttempts: int(pin.FailedAttempts),
+		CreatedAt:      pin.CreatedAt,
+		UpdatedAt:      pin.UpdatedAt,
+	}
+
+	if pin.LockedUntil.Valid {
+		result.LockedUntil = &pin.LockedUntil.Time
+	}
+
+	return result
+}
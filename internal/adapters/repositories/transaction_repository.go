@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/defifundr/db/sqlc"
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TransactionRepository struct {
+	store db.Queries
+}
+
+func NewTransactionRepository(store db.Queries) *TransactionRepository {
+	return &TransactionRepository{
+		store: store,
+	}
+}
+
+// CreateTransaction records a new transaction
+func (r *TransactionRepository) CreateTransaction(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error) {
+	params := db.CreateTransactionParams{
+		ID:                 tx.ID,
+		UserID:             tx.UserID,
+		TxHash:             tx.TxHash,
+		TransactionPinHash: tx.TransactionPinHash,
+		Status:             string(tx.Status),
+		TokenAddress:       toPgText(tx.TokenAddress),
+		ToAddress:          toPgText(tx.ToAddress),
+	}
+	if tx.Amount != nil {
+		params.Amount = money.NumericFromBaseUnits(tx.Amount)
+	}
+
+	dbTx, err := r.store.CreateTransaction(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// GetTransactionByID retrieves a transaction by ID
+func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id uuid.UUID) (*domain.Transaction, error) {
+	dbTx, err := r.store.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction by ID: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// GetTransactionByTxHash retrieves the user-initiated transaction with the
+// on-chain hash. It returns nil when none was recorded.
+func (r *TransactionRepository) GetTransactionByTxHash(ctx context.Context, txHash string) (*domain.Transaction, error) {
+	dbTx, err := r.store.GetTransactionByTxHash(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get transaction by hash: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// ListTransactionsByUserID lists a user's transactions matching the filter, along with the total count
+func (r *TransactionRepository) ListTransactionsByUserID(ctx context.Context, userID uuid.UUID, limit, offset int, filter domain.TransactionFilter) ([]domain.Transaction, int64, error) {
+	status := pgtype.Text{String: string(filter.Status), Valid: filter.Status != ""}
+	var createdFrom, createdTo pgtype.Timestamptz
+	if filter.CreatedFrom != nil {
+		createdFrom = pgtype.Timestamptz{Time: *filter.CreatedFrom, Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		createdTo = pgtype.Timestamptz{Time: *filter.CreatedTo, Valid: true}
+	}
+
+	dbTxs, err := r.store.ListTransactionsByUserID(ctx, db.ListTransactionsByUserIDParams{
+		UserID:      userID,
+		Status:      status,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		LimitCount:  int32(limit),
+		OffsetCount: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	total, err := r.store.CountTransactionsByUserID(ctx, db.CountTransactionsByUserIDParams{
+		UserID:      userID,
+		Status:      status,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	result := make([]domain.Transaction, len(dbTxs))
+	for i, dbTx := range dbTxs {
+		tx, err := mapDBTransactionToDomain(dbTx)
+		if err != nil {
+			return nil, 0, err
+		}
+		result[i] = *tx
+	}
+
+	return result, total, nil
+}
+
+// GetTransactionsByStatus lists transactions in the given status, newest first
+func (r *TransactionRepository) GetTransactionsByStatus(ctx context.Context, status domain.TransactionStatus, limit, offset int) ([]domain.Transaction, error) {
+	dbTxs, err := r.store.GetTransactionsByStatus(ctx, db.GetTransactionsByStatusParams{
+		Status: string(status),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions by status: %w", err)
+	}
+
+	result := make([]domain.Transaction, len(dbTxs))
+	for i, dbTx := range dbTxs {
+		tx, err := mapDBTransactionToDomain(dbTx)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *tx
+	}
+
+	return result, nil
+}
+
+// TransitionTransactionStatus moves a transaction from one status to another.
+// It returns a conflict error if the transaction is no longer in the from status.
+func (r *TransactionRepository) TransitionTransactionStatus(ctx context.Context, id uuid.UUID, from, to domain.TransactionStatus) (*domain.Transaction, error) {
+	dbTx, err := r.store.TransitionTransactionStatus(ctx, db.TransitionTransactionStatusParams{
+		ID:         id,
+		FromStatus: string(from),
+		ToStatus:   string(to),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, appErrors.NewConflictError("transaction status changed concurrently")
+		}
+		return nil, fmt.Errorf("failed to update transaction status: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// UpdateTransactionBlock records the block a receipt was seen in. A nil blockNumber clears it.
+func (r *TransactionRepository) UpdateTransactionBlock(ctx context.Context, id uuid.UUID, blockNumber *int64, blockHash string) (*domain.Transaction, error) {
+	params := db.UpdateTransactionBlockParams{ID: id}
+	if blockNumber != nil {
+		params.BlockNumber = pgtype.Int8{Int64: *blockNumber, Valid: true}
+		params.BlockHash = pgtype.Text{String: blockHash, Valid: true}
+	}
+
+	dbTx, err := r.store.UpdateTransactionBlock(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update transaction block: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// UpsertInboundTransfer records an indexed inbound transfer. Seeing the same
+// transfer again only refreshes where it was seen, so rescanning a range after
+// a reorg is safe even when the transaction was re-mined in another block.
+func (r *TransactionRepository) UpsertInboundTransfer(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error) {
+	params := db.UpsertInboundTransferParams{
+		ID:           tx.ID,
+		UserID:       tx.UserID,
+		TxHash:       tx.TxHash,
+		Status:       string(tx.Status),
+		TokenAddress: pgtype.Text{String: tx.TokenAddress, Valid: true},
+		FromAddress:  pgtype.Text{String: tx.FromAddress, Valid: true},
+		ToAddress:    pgtype.Text{String: tx.ToAddress, Valid: true},
+	}
+	if tx.LogIndex != nil {
+		params.LogIndex = pgtype.Int4{Int32: int32(*tx.LogIndex), Valid: true}
+	}
+	if tx.TransferOrdinal != nil {
+		params.TransferOrdinal = pgtype.Int4{Int32: int32(*tx.TransferOrdinal), Valid: true}
+	}
+	if tx.Amount != nil {
+		params.Amount = money.NumericFromBaseUnits(tx.Amount)
+	}
+	if tx.BlockNumber != nil {
+		params.BlockNumber = pgtype.Int8{Int64: *tx.BlockNumber, Valid: true}
+		params.BlockHash = pgtype.Text{String: tx.BlockHash, Valid: true}
+	}
+
+	dbTx, err := r.store.UpsertInboundTransfer(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert inbound transfer: %w", err)
+	}
+
+	return mapDBTransactionToDomain(dbTx)
+}
+
+// Helper to map DB transaction to domain
+func mapDBTransactionToDomain(tx db.Transactions) (*domain.Transaction, error) {
+	result := &domain.Transaction{
+		ID:                 tx.ID,
+		UserID:             tx.UserID,
+		TxHash:             tx.TxHash,
+		TransactionPinHash: tx.TransactionPinHash,
+		Status:             domain.TransactionStatus(tx.Status),
+		Direction:          domain.TransactionDirection(tx.Direction),
+		TokenAddress:       tx.TokenAddress.String,
+		FromAddress:        tx.FromAddress.String,
+		ToAddress:          tx.ToAddress.String,
+		CreatedAt:          tx.CreatedAt,
+		UpdatedAt:          tx.UpdatedAt,
+	}
+
+	if tx.LogIndex.Valid {
+		logIndex := int(tx.LogIndex.Int32)
+		result.LogIndex = &logIndex
+	}
+
+	if tx.TransferOrdinal.Valid {
+		ordinal := int(tx.TransferOrdinal.Int32)
+		result.TransferOrdinal = &ordinal
+	}
+
+	// The amount column is NUMERIC(78,0), so it always holds whole base units
+	amount, err := money.BaseUnitsFromNumeric(tx.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read amount of transaction %s: %w", tx.ID, err)
+	}
+	result.Amount = amount
+
+	if tx.BlockNumber.Valid {
+		result.BlockNumber = &tx.BlockNumber.Int64
+		result.BlockHash = tx.BlockHash.String
+	}
+
+	return result, nil
+}
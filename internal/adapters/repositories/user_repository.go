@@ -38,15 +38,9 @@ func (r *UserRepository) CreateUser(ctx context.Context, user domain.User) (*dom
 		Nationality:         user.Nationality,
 		ResidentialCountry:  toPgTextPtr(user.ResidentialCountry),
 		JobRole:             toPgTextPtr(user.JobRole),
-		CompanyName:         toPgText(user.CompanyName),
-		CompanyAddress:      toPgText(user.CompanyAddress),
-		CompanyCity:         toPgText(user.CompanyCity),
-		CompanyPostalCode:   toPgText(user.CompanyPostalCode),
-		CompanyCountry:      toPgText(user.CompanyCountry),
 		AuthProvider:        toPgText(user.AuthProvider),
 		ProviderID:          user.ProviderID,
 		EmployeeType:        toPgText(user.EmployeeType),
-		CompanyWebsite:      toPgTextPtr(user.CompanyWebsite),
 		EmploymentType:      toPgTextPtr(user.EmploymentType),
 		CreatedAt:           pgtype.Timestamp{Time: time.Now(), Valid: true},
 		UpdatedAt:           pgtype.Timestamp{Time: time.Now(), Valid: true},
@@ -96,13 +90,7 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user domain.User) (*dom
 		Nationality:         user.Nationality,
 		ResidentialCountry:  toPgTextPtr(user.ResidentialCountry),
 		JobRole:             toPgTextPtr(user.JobRole),
-		CompanyWebsite:      toPgTextPtr(user.CompanyWebsite),
 		EmploymentType:      toPgTextPtr(user.EmploymentType),
-		CompanyName:         toPgText(user.CompanyName),
-		CompanyAddress:      toPgText(user.CompanyAddress),
-		CompanyCity:         toPgText(user.CompanyCity),
-		CompanyPostalCode:   toPgText(user.CompanyPostalCode),
-		CompanyCountry:      toPgText(user.CompanyCountry),
 		AuthProvider:        toPgText(user.AuthProvider),
 		ProviderID:          user.ProviderID,
 		UserAddress:         toPgTextPtr(user.UserAddress),
@@ -175,15 +163,9 @@ func mapDBUserToDomainUser(dbUser db.Users) *domain.User {
 		Nationality:         dbUser.Nationality,
 		ResidentialCountry:  strPtr(getTextString(dbUser.ResidentialCountry)),
 		JobRole:             strPtr(getTextString(dbUser.JobRole)),
-		CompanyName:         getTextString(dbUser.CompanyName),
-		CompanyAddress:      getTextString(dbUser.CompanyAddress),
-		CompanyCity:         getTextString(dbUser.CompanyCity),
-		CompanyPostalCode:   getTextString(dbUser.CompanyPostalCode),
-		CompanyCountry:      getTextString(dbUser.CompanyCountry),
 		AuthProvider:        getTextString(dbUser.AuthProvider),
 		ProviderID:          dbUser.ProviderID,
 		EmployeeType:        getTextString(dbUser.EmployeeType),
-		CompanyWebsite:      strPtr(getTextString(dbUser.CompanyWebsite)),
 		EmploymentType:      strPtr(getTextString(dbUser.EmploymentType)),
 		// Fill in missing fields with empty values
 		Address:      getTextString(dbUser.UserAddress),
@@ -212,21 +194,15 @@ func (r *UserRepository) UpdateUserPersonalDetails(ctx context.Context, user dom
 
 	return mapDBUserToDomainUser(dbUser), nil
 }
-func (r *UserRepository) UpdateUserBusinessDetails(ctx context.Context, user domain.User) (*domain.User, error) {
-	params := db.UpdateUserCompanyDetailsParams{
-		ID:                user.ID,
-		CompanyName:       toPgText(user.CompanyName),
-		CompanyAddress:    toPgText(user.CompanyAddress),
-		CompanyCity:       toPgText(user.CompanyCity),
-		CompanyPostalCode: toPgText(user.CompanyPostalCode),
-		CompanyCountry:    toPgText(user.CompanyCountry),
-		CompanyWebsite:    toPgTextPtr(user.CompanyWebsite),
-		EmploymentType:    toPgTextPtr(user.EmploymentType),
-	}
 
-	
+// UpdateUserEmploymentType updates the employment type a business user gave during onboarding
+func (r *UserRepository) UpdateUserEmploymentType(ctx context.Context, user domain.User) (*domain.User, error) {
+	params := db.UpdateUserEmploymentTypeParams{
+		ID:             user.ID,
+		EmploymentType: toPgTextPtr(user.EmploymentType),
+	}
 
-	dbUser, err := r.store.UpdateUserCompanyDetails(ctx, params)
+	dbUser, err := r.store.UpdateUserEmploymentType(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3,12 +3,14 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	db "github.com/demola234/defifundr/db/sqlc"
 	"github.com/demola234/defifundr/internal/core/domain"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -47,7 +49,7 @@ func (r *WalletRepository) CreateWallet(ctx context.Context, wallet domain.UserW
 func (r *WalletRepository) GetWalletByAddress(ctx context.Context, address string) (*domain.UserWallet, error) {
 	wallet, err := r.store.GetWalletByAddress(ctx, address)
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to get wallet by address: %w", err)
@@ -71,6 +73,21 @@ func (r *WalletRepository) GetWalletsByUserID(ctx context.Context, userID uuid.U
 	return result, nil
 }
 
+// ListWallets returns every linked wallet, oldest first
+func (r *WalletRepository) ListWallets(ctx context.Context) ([]domain.UserWallet, error) {
+	wallets, err := r.store.ListUserWallets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	result := make([]domain.UserWallet, len(wallets))
+	for i, wallet := range wallets {
+		result[i] = *mapDBWalletToDomain(wallet)
+	}
+
+	return result, nil
+}
+
 // UpdateWallet updates a wallet
 func (r *WalletRepository) UpdateWallet(ctx context.Context, wallet domain.UserWallet) error {
 	params := db.UpdateUserWalletParams{
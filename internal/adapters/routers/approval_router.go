@@ -0,0 +1,24 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterApprovalRoutes registers the pay run approval routes. Approving and
+// rejecting move money, so they also require an MFA step-up. Submitting and
+// approving can release a payout, so they also require the transaction PIN.
+func RegisterApprovalRoutes(rg *gin.RouterGroup, handler *handlers.ApprovalHandler, authMiddleware, mfaMiddleware, pinMiddleware gin.HandlerFunc) {
+	org := rg.Group("/organizations/:id")
+	org.Use(authMiddleware)
+	{
+		org.POST("/approval-policies", handler.CreatePolicy)
+		org.GET("/approval-policies", handler.ListPolicies)
+		org.PUT("/approval-policies/:policy_id", handler.UpdatePolicy)
+		org.POST("/payroll/runs/:run_id/submit", pinMiddleware, handler.SubmitPayRun)
+		org.GET("/approvals", handler.ListRequests)
+		org.GET("/approvals/:approval_id", handler.GetRequest)
+		org.POST("/approvals/:approval_id/approve", mfaMiddleware, pinMiddleware, handler.Approve)
+		org.POST("/approvals/:approval_id/reject", mfaMiddleware, handler.Reject)
+	}
+}
@@ -0,0 +1,22 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAssetRoutes(rg *gin.RouterGroup, handler *handlers.AssetHandler, authMiddleware, adminMiddleware gin.HandlerFunc) {
+	assets := rg.Group("/assets")
+	assets.Use(authMiddleware)
+	{
+		assets.GET("", handler.ListAssets)
+	}
+
+	admin := rg.Group("/admin/assets")
+	admin.Use(authMiddleware, adminMiddleware)
+	{
+		admin.GET("", handler.AdminListAssets)
+		admin.POST("", handler.CreateAsset)
+		admin.PATCH("/:id", handler.UpdateAsset)
+	}
+}
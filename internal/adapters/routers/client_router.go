@@ -0,0 +1,19 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterClientRoutes(rg *gin.RouterGroup, handler *handlers.ClientHandler, authMiddleware gin.HandlerFunc) {
+	clients := rg.Group("/organizations/:id/clients")
+	clients.Use(authMiddleware)
+	{
+		clients.POST("", handler.CreateClient)
+		clients.GET("", handler.ListClients)
+		clients.POST("/import", handler.ImportClients)
+		clients.GET("/:client_id", handler.GetClient)
+		clients.PUT("/:client_id", handler.UpdateClient)
+		clients.DELETE("/:client_id", handler.DeleteClient)
+	}
+}
@@ -0,0 +1,22 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterCreditNoteRoutes(rg *gin.RouterGroup, handler *handlers.CreditNoteHandler, authMiddleware gin.HandlerFunc) {
+	invoiceNotes := rg.Group("/organizations/:id/invoices/:invoice_id/credit-notes")
+	invoiceNotes.Use(authMiddleware)
+	{
+		invoiceNotes.POST("", handler.IssueCreditNote)
+		invoiceNotes.GET("", handler.ListInvoiceCreditNotes)
+	}
+
+	creditNotes := rg.Group("/organizations/:id/credit-notes")
+	creditNotes.Use(authMiddleware)
+	{
+		creditNotes.GET("", handler.ListCreditNotes)
+		creditNotes.GET("/:credit_note_id", handler.GetCreditNote)
+	}
+}
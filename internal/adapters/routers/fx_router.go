@@ -0,0 +1,21 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterFXRoutes(rg *gin.RouterGroup, handler *handlers.FXHandler, authMiddleware gin.HandlerFunc) {
+	fx := rg.Group("/fx")
+	fx.Use(authMiddleware)
+	{
+		// Stale rates are fetched from the provider, so bound how often a client can trigger that
+		fx.GET("/rates", middleware.RateLimitMiddleware(30, time.Minute), handler.GetRates)
+		fx.GET("/rates/history", handler.GetRateHistory)
+		fx.POST("/quotes", middleware.RateLimitMiddleware(30, time.Minute), handler.LockQuote)
+		fx.GET("/quotes/:id", handler.GetQuote)
+	}
+}
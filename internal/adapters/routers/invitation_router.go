@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterInvitationRoutes(rg *gin.RouterGroup, handler *handlers.InvitationHandler, authMiddleware gin.HandlerFunc) {
+	organizations := rg.Group("/organizations/:id/invitations")
+	organizations.Use(authMiddleware)
+	{
+		organizations.POST("", middleware.RateLimitMiddleware(20, time.Minute), handler.CreateInvitation)
+		organizations.GET("", handler.ListInvitations)
+		organizations.POST("/:invitation_id/resend", middleware.RateLimitMiddleware(20, time.Minute), handler.ResendInvitation)
+		organizations.DELETE("/:invitation_id", handler.RevokeInvitation)
+	}
+
+	// Lookup and sign-up are reached from the emailed link, before the invitee has a session
+	invitations := rg.Group("/invitations")
+	{
+		invitations.GET("/lookup", middleware.RateLimitMiddleware(30, time.Minute), handler.LookupInvitation)
+		invitations.POST("/register", middleware.RateLimitMiddleware(10, time.Minute), handler.RegisterWithInvitation)
+		invitations.POST("/accept", authMiddleware, handler.AcceptInvitation)
+	}
+}
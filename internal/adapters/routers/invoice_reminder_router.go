@@ -0,0 +1,21 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterInvoiceReminderRoutes(rg *gin.RouterGroup, handler *handlers.InvoiceReminderHandler, authMiddleware gin.HandlerFunc) {
+	reminders := rg.Group("/organizations/:id/invoice-reminders")
+	reminders.Use(authMiddleware)
+	{
+		reminders.GET("", handler.GetReminderSettings)
+		reminders.PUT("", handler.UpdateReminderSettings)
+	}
+
+	sent := rg.Group("/organizations/:id/invoices/:invoice_id/reminders")
+	sent.Use(authMiddleware)
+	{
+		sent.GET("", handler.ListInvoiceReminders)
+	}
+}
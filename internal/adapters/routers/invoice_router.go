@@ -0,0 +1,30 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterInvoiceRoutes(rg *gin.RouterGroup, handler *handlers.InvoiceHandler, authMiddleware gin.HandlerFunc) {
+	invoices := rg.Group("/organizations/:id/invoices")
+	invoices.Use(authMiddleware)
+	{
+		invoices.POST("", handler.CreateInvoice)
+		invoices.GET("", handler.ListInvoices)
+		invoices.GET("/:invoice_id", handler.GetInvoice)
+		invoices.PUT("/:invoice_id", handler.UpdateInvoice)
+		invoices.GET("/:invoice_id/pdf", handler.DownloadInvoicePDF)
+		invoices.POST("/:invoice_id/send", handler.SendInvoice)
+		invoices.POST("/:invoice_id/mark-paid", handler.MarkPaid)
+		invoices.POST("/:invoice_id/void", handler.VoidInvoice)
+		invoices.GET("/:invoice_id/payments", handler.ListInvoicePayments)
+	}
+
+	depositKey := rg.Group("/organizations/:id/invoice-deposit-key")
+	depositKey.Use(authMiddleware)
+	{
+		depositKey.GET("", handler.GetDepositKey)
+		depositKey.PUT("", handler.SetDepositKey)
+		depositKey.DELETE("", handler.DeleteDepositKey)
+	}
+}
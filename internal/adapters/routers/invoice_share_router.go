@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterInvoiceShareRoutes(rg *gin.RouterGroup, handler *handlers.InvoiceShareHandler, authMiddleware gin.HandlerFunc) {
+	links := rg.Group("/organizations/:id/invoices/:invoice_id/share-links")
+	links.Use(authMiddleware)
+	{
+		links.POST("", handler.CreateShareLink)
+		links.GET("", handler.ListShareLinks)
+		links.DELETE("/:link_id", handler.RevokeShareLink)
+	}
+
+	// Share links are opened by customers without an account, so the public
+	// routes have a limiter of their own rather than the authenticated ones'
+	shared := rg.Group("/shared-invoices")
+	shared.Use(middleware.RateLimitMiddleware(30, time.Minute))
+	{
+		shared.GET("", handler.GetSharedInvoice)
+		shared.GET("/pdf", handler.DownloadSharedInvoicePDF)
+	}
+}
@@ -0,0 +1,20 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterOrganizationRoutes(rg *gin.RouterGroup, handler *handlers.OrganizationHandler, authMiddleware gin.HandlerFunc) {
+	organizations := rg.Group("/organizations")
+	organizations.Use(authMiddleware)
+	{
+		organizations.POST("", handler.CreateOrganization)
+		organizations.GET("", handler.ListOrganizations)
+		organizations.GET("/:id", handler.GetOrganization)
+		organizations.PUT("/:id", handler.UpdateOrganization)
+		organizations.GET("/:id/members", handler.ListMembers)
+		organizations.PATCH("/:id/members/:user_id", handler.UpdateMemberRole)
+		organizations.DELETE("/:id/members/:user_id", handler.RemoveMember)
+	}
+}
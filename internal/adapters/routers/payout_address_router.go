@@ -0,0 +1,24 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPayoutAddressRoutes(rg *gin.RouterGroup, handler *handlers.PayoutAddressHandler, authMiddleware gin.HandlerFunc) {
+	// Public cancel link from the notification email. Following the link only
+	// shows a confirmation page; submitting it cancels the change.
+	cancelLinkRateLimit := middleware.RateLimitMiddleware(10, time.Minute)
+	rg.GET("/payout-addresses/cancel", cancelLinkRateLimit, handler.ConfirmCancelPayoutAddress)
+	rg.POST("/payout-addresses/cancel", cancelLinkRateLimit, handler.CancelPayoutAddressByToken)
+
+	payoutAddresses := rg.Group("/payout-addresses")
+	payoutAddresses.Use(authMiddleware)
+	{
+		payoutAddresses.GET("", handler.ListPayoutAddresses)
+		payoutAddresses.POST("/:id/cancel", handler.CancelPayoutAddress)
+	}
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPayrollRoutes(rg *gin.RouterGroup, handler *handlers.PayrollHandler, authMiddleware gin.HandlerFunc) {
+	payroll := rg.Group("/organizations/:id/payroll")
+	payroll.Use(authMiddleware)
+	{
+		payroll.POST("/schedules", handler.CreateSchedule)
+		payroll.GET("/schedules", handler.ListSchedules)
+		payroll.GET("/schedules/:schedule_id", handler.GetSchedule)
+		payroll.PUT("/schedules/:schedule_id", handler.UpdateSchedule)
+		payroll.POST("/schedules/:schedule_id/simulate", handler.SimulateSchedule)
+		payroll.POST("/compensations", handler.CreateCompensation)
+		payroll.GET("/compensations", handler.ListCompensations)
+		payroll.PUT("/compensations/:compensation_id", handler.UpdateCompensation)
+		payroll.GET("/runs", handler.ListPayRuns)
+		payroll.GET("/runs/:run_id", handler.GetPayRun)
+		payroll.POST("/runs/:run_id/simulate", handler.SimulatePayRun)
+		payroll.GET("/runs/:run_id/payslips", handler.ListPayslips)
+		payroll.GET("/runs/:run_id/payslips/:employee_id", handler.GetPayslip)
+	}
+}
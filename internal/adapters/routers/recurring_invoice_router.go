@@ -0,0 +1,20 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterRecurringInvoiceRoutes(rg *gin.RouterGroup, handler *handlers.RecurringInvoiceHandler, authMiddleware gin.HandlerFunc) {
+	recurring := rg.Group("/organizations/:id/recurring-invoices")
+	recurring.Use(authMiddleware)
+	{
+		recurring.POST("", handler.CreateRecurringInvoice)
+		recurring.GET("", handler.ListRecurringInvoices)
+		recurring.GET("/:recurring_id", handler.GetRecurringInvoice)
+		recurring.PUT("/:recurring_id", handler.UpdateRecurringInvoice)
+		recurring.GET("/:recurring_id/preview", handler.PreviewRecurringInvoice)
+		recurring.POST("/:recurring_id/pause", handler.PauseRecurringInvoice)
+		recurring.POST("/:recurring_id/resume", handler.ResumeRecurringInvoice)
+	}
+}
@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterTimesheetRoutes(rg *gin.RouterGroup, handler *handlers.TimesheetHandler, authMiddleware gin.HandlerFunc) {
+	contracts := rg.Group("/organizations/:id/contracts")
+	contracts.Use(authMiddleware)
+	{
+		contracts.POST("", handler.CreateContractorContract)
+		contracts.GET("", handler.ListContractorContracts)
+		contracts.PUT("/:contract_id", handler.UpdateContractorContract)
+	}
+
+	timesheets := rg.Group("/organizations/:id/timesheets")
+	timesheets.Use(authMiddleware)
+	{
+		timesheets.PUT("", handler.SaveTimesheet)
+		timesheets.GET("", handler.ListTimesheets)
+		timesheets.POST("/invoice", handler.InvoiceTimesheets)
+		timesheets.GET("/:timesheet_id", handler.GetTimesheet)
+		timesheets.POST("/:timesheet_id/submit", handler.SubmitTimesheet)
+		timesheets.POST("/:timesheet_id/approve", handler.ApproveTimesheet)
+		timesheets.POST("/:timesheet_id/reject", handler.RejectTimesheet)
+	}
+}
@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/middleware"
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterTransactionPINRoutes(rg *gin.RouterGroup, handler *handlers.TransactionPINHandler, authMiddleware gin.HandlerFunc) {
+	pin := rg.Group("/transaction-pin")
+	pin.Use(authMiddleware)
+	{
+		pin.GET("", handler.GetPINStatus)
+		pin.POST("", handler.SetPIN)
+		pin.PUT("", middleware.RateLimitMiddleware(5, time.Minute), handler.ChangePIN)
+
+		// Reset via emailed OTP
+		pin.POST("/reset/request", middleware.RateLimitMiddleware(3, time.Minute), handler.RequestPINReset)
+		pin.POST("/reset", middleware.RateLimitMiddleware(5, time.Minute), handler.ResetPIN)
+	}
+}
@@ -0,0 +1,15 @@
+package routers
+
+import (
+	"github.com/demola234/defifundr/internal/adapters/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterTransactionRoutes(rg *gin.RouterGroup, handler *handlers.TransactionHandler, authMiddleware gin.HandlerFunc) {
+	transactions := rg.Group("/transactions")
+	transactions.Use(authMiddleware)
+	{
+		transactions.GET("", handler.ListTransactions)
+		transactions.GET("/:id", handler.GetTransaction)
+	}
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// DefaultApprovalWindowHours is how long an approval request stays open when
+// the policy does not say otherwise
+const DefaultApprovalWindowHours = 72
+
+// ApprovalPolicy requires RequiredApprovals of the listed approvers to sign off
+// on a pay run before it can be paid. A policy applies when the pay run total
+// or any single payout reaches its threshold; a policy without thresholds
+// applies to every pay run.
+type ApprovalPolicy struct {
+	ID                  uuid.UUID    `json:"id"`
+	OrganizationID      uuid.UUID    `json:"organization_id"`
+	Name                string       `json:"name"`
+	Currency            string       `json:"currency"`
+	MinTotalAmount      *money.Money `json:"min_total_amount,omitempty"`
+	MinPayoutAmount     *money.Money `json:"min_payout_amount,omitempty"`
+	RequiredApprovals   int          `json:"required_approvals"`
+	ApproverIDs         []uuid.UUID  `json:"approver_ids"`
+	ApprovalWindowHours int          `json:"approval_window_hours"`
+	Active              bool         `json:"active"`
+	CreatedBy           *uuid.UUID   `json:"created_by,omitempty"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
+// Applies reports whether the policy covers a pay run with the given total and
+// largest single payout, both in the policy currency
+func (p ApprovalPolicy) Applies(total, largestPayout money.Money) bool {
+	if p.MinTotalAmount == nil && p.MinPayoutAmount == nil {
+		return true
+	}
+	if p.MinTotalAmount != nil && total.Amount().GreaterThanOrEqual(p.MinTotalAmount.Amount()) {
+		return true
+	}
+	if p.MinPayoutAmount != nil && largestPayout.Amount().GreaterThanOrEqual(p.MinPayoutAmount.Amount()) {
+		return true
+	}
+	return false
+}
+
+// ApprovalWindow returns how long a request under the policy stays open
+func (p ApprovalPolicy) ApprovalWindow() time.Duration {
+	return time.Duration(p.ApprovalWindowHours) * time.Hour
+}
+
+// ApprovalStatus is the state of an approval request, and also the decision an
+// approver records on one (approved or rejected)
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+	ApprovalStatusExpired  ApprovalStatus = "expired"
+)
+
+// IsValid reports whether the status is one of the known statuses
+func (s ApprovalStatus) IsValid() bool {
+	switch s {
+	case ApprovalStatusPending, ApprovalStatusApproved, ApprovalStatusRejected, ApprovalStatusExpired:
+		return true
+	}
+	return false
+}
+
+// ApprovalRequest asks the approvers of a policy to sign off on a pay run.
+// RequiredApprovals and ApproverIDs are copied from the policy on submission,
+// so later policy changes do not affect requests already in flight.
+type ApprovalRequest struct {
+	ID                uuid.UUID          `json:"id"`
+	OrganizationID    uuid.UUID          `json:"organization_id"`
+	PayRunID          uuid.UUID          `json:"pay_run_id"`
+	PolicyID          *uuid.UUID         `json:"policy_id,omitempty"`
+	RequiredApprovals int                `json:"required_approvals"`
+	ApproverIDs       []uuid.UUID        `json:"approver_ids"`
+	Status            ApprovalStatus     `json:"status"`
+	RequestedBy       uuid.UUID          `json:"requested_by"`
+	ExpiresAt         time.Time          `json:"expires_at"`
+	DecidedAt         *time.Time         `json:"decided_at,omitempty"`
+	Decisions         []ApprovalDecision `json:"decisions,omitempty"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// IsApprover reports whether the user is one of the request's approvers
+func (r ApprovalRequest) IsApprover(userID uuid.UUID) bool {
+	for _, id := range r.ApproverIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDecided reports whether the user already recorded a decision on the request
+func (r ApprovalRequest) HasDecided(userID uuid.UUID) bool {
+	for _, decision := range r.Decisions {
+		if decision.ApproverID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Approvals counts the approving decisions on the request
+func (r ApprovalRequest) Approvals() int {
+	count := 0
+	for _, decision := range r.Decisions {
+		if decision.Decision == ApprovalStatusApproved {
+			count++
+		}
+	}
+	return count
+}
+
+// ApprovalDecision is one approver's sign-off or rejection of a request
+type ApprovalDecision struct {
+	ID         uuid.UUID      `json:"id"`
+	RequestID  uuid.UUID      `json:"request_id"`
+	ApproverID uuid.UUID      `json:"approver_id"`
+	Email      string         `json:"email"`
+	FirstName  string         `json:"first_name"`
+	LastName   string         `json:"last_name"`
+	Decision   ApprovalStatus `json:"decision"`
+	Comment    string         `json:"comment,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
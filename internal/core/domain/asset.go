@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SupportedAsset is a token we accept for payouts, invoices and incoming payments
+type SupportedAsset struct {
+	ID              uuid.UUID `json:"id"`
+	Chain           string    `json:"chain"`
+	Symbol          string    `json:"symbol"`
+	Name            string    `json:"name"`
+	ContractAddress string    `json:"contract_address,omitempty"`
+	Decimals        int       `json:"decimals"`
+	Enabled         bool      `json:"enabled"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// SupportedAssetFilter narrows down an asset listing. Zero values are ignored.
+type SupportedAssetFilter struct {
+	Chain       string
+	EnabledOnly bool
+}
+
+// IsNative reports whether the asset is the chain's own coin rather than a token contract
+func (a SupportedAsset) IsNative() bool {
+	return a.ContractAddress == ""
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultClientPaymentTermsDays is how long a client has to pay unless it is given other terms
+	DefaultClientPaymentTermsDays = 30
+	// MaxClientPaymentTermsDays caps a client's payment terms
+	MaxClientPaymentTermsDays = 365
+	// MaxClientCCEmails caps the contacts copied on a client's invoices
+	MaxClientCCEmails = 10
+	// MaxClientImportRows caps the clients one CSV import can add
+	MaxClientImportRows = 1000
+)
+
+// Client is a customer in an organization's directory. Invoices raised for a
+// client take its details as defaults and keep their own copy, so editing or
+// removing a client never changes an invoice already issued. An organization
+// has at most one client per billing email and per tax ID.
+type Client struct {
+	ID                uuid.UUID     `json:"id"`
+	OrganizationID    uuid.UUID     `json:"organization_id"`
+	Name              string        `json:"name"`
+	Email             string        `json:"email"`
+	CCEmails          []string      `json:"cc_emails,omitempty"`
+	TaxID             string        `json:"tax_id,omitempty"`
+	BillingAddress    ClientAddress `json:"billing_address"`
+	PreferredCurrency string        `json:"preferred_currency,omitempty"`
+	PreferredAssetID  *uuid.UUID    `json:"preferred_asset_id,omitempty"`
+	PaymentTermsDays  int           `json:"payment_terms_days"`
+	Notes             string        `json:"notes,omitempty"`
+	CreatedBy         *uuid.UUID    `json:"created_by,omitempty"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// ClientAddress is where a client is billed. Country is an ISO 3166-1 alpha-2 code.
+type ClientAddress struct {
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city,omitempty"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country,omitempty"`
+}
+
+// String formats the address one part per line the way it is printed on an
+// invoice, leaving out the parts that are not set
+func (a ClientAddress) String() string {
+	locality := strings.TrimSpace(strings.Join(nonEmpty(a.City, strings.TrimSpace(a.Region+" "+a.PostalCode)), ", "))
+	return strings.Join(nonEmpty(a.Line1, a.Line2, locality, a.Country), "\n")
+}
+
+// NormalizeTaxID uppercases a tax ID and drops spaces and punctuation, so
+// "gb 123.456.789" and "GB123456789" are the same ID
+func NormalizeTaxID(taxID string) string {
+	var b strings.Builder
+	for _, r := range taxID {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// ClientImportResult is the outcome of a CSV import: the clients added and the
+// rows that were left out
+type ClientImportResult struct {
+	Created []Client            `json:"created"`
+	Skipped []ClientImportIssue `json:"skipped"`
+}
+
+// ClientImportIssue is a CSV row that was not imported and why. Row counts the
+// header as row 1. Duplicates name the client they match, when it was already
+// in the directory.
+type ClientImportIssue struct {
+	Row              int        `json:"row"`
+	Reason           string     `json:"reason"`
+	ExistingClientID *uuid.UUID `json:"existing_client_id,omitempty"`
+}
+
+func nonEmpty(values ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// CreditNote takes part or all of the balance off an issued invoice. Credit
+// notes are numbered per organization in their own sequence, apart from
+// invoices, and like invoices are never edited or deleted. Amount includes
+// tax; TaxAmount is the part of it that reverses tax charged on the invoice.
+type CreditNote struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	InvoiceID      uuid.UUID   `json:"invoice_id"`
+	Number         int64       `json:"number"`
+	Amount         money.Money `json:"amount"`
+	TaxAmount      money.Money `json:"tax_amount"`
+	Reason         string      `json:"reason"`
+	LedgerEntryID  uuid.UUID   `json:"ledger_entry_id"`
+	IssuedAt       time.Time   `json:"issued_at"`
+	CreatedBy      *uuid.UUID  `json:"created_by,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// DisplayNumber formats the credit note number the way it is shown to customers
+func (c CreditNote) DisplayNumber() string {
+	return fmt.Sprintf("CN-%06d", c.Number)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "math/big"
+
+// MaxDisbursementBatchSize caps the transfers in one payroll contract call so
+// a batch stays well under the block gas limit
+const MaxDisbursementBatchSize = 200
+
+// DisbursementTransfer is a single ERC-20 payment within a payroll batch.
+// Amount is in the token's base units.
+type DisbursementTransfer struct {
+	Recipient string   `json:"recipient"`
+	Amount    *big.Int `json:"amount"`
+}
+
+// DisbursementBatch pays several recipients in one token through the payroll contract
+type DisbursementBatch struct {
+	Token     string                 `json:"token"`
+	Transfers []DisbursementTransfer `json:"transfers"`
+}
+
+// DisbursementResult describes the submitted disbursement transaction
+type DisbursementResult struct {
+	TxHash    string   `json:"tx_hash"`
+	Nonce     uint64   `json:"nonce"`
+	GasLimit  uint64   `json:"gas_limit"`
+	GasFeeCap *big.Int `json:"gas_fee_cap"`
+	GasTipCap *big.Int `json:"gas_tip_cap"`
+}
+
+// Total returns the sum of all transfer amounts
+func (b DisbursementBatch) Total() *big.Int {
+	total := new(big.Int)
+	for _, transfer := range b.Transfers {
+		if transfer.Amount != nil {
+			total.Add(total, transfer.Amount)
+		}
+	}
+	return total
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FXRate is an exchange rate between two currency or asset codes: one unit of
+// Base is worth Rate units of Quote
+type FXRate struct {
+	ID        uuid.UUID       `json:"id"`
+	Base      string          `json:"base"`
+	Quote     string          `json:"quote"`
+	Rate      decimal.Decimal `json:"rate"`
+	Source    string          `json:"source"`
+	FetchedAt time.Time       `json:"fetched_at"`
+}
+
+// FXQuote is an exchange rate locked until ExpiresAt, so that amounts converted
+// with it are the same no matter when the conversion runs within the window
+type FXQuote struct {
+	ID        uuid.UUID       `json:"id"`
+	Base      string          `json:"base"`
+	Quote     string          `json:"quote"`
+	Rate      decimal.Decimal `json:"rate"`
+	Source    string          `json:"source"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// IsExpired reports whether the quote can no longer be used at the given time
+func (q FXQuote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+// Converts reports whether the quote converts between the two currencies, either way round
+func (q FXQuote) Converts(a, b string) bool {
+	return q.Base == a && q.Quote == b || q.Base == b && q.Quote == a
+}
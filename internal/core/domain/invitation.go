@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationStatus is the stored state of an organization invitation
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// Invitation is an emailed offer to join an organization with a role. Only a
+// hash of the latest signed token is kept; resending issues a new token.
+type Invitation struct {
+	ID               uuid.UUID        `json:"id"`
+	OrganizationID   uuid.UUID        `json:"organization_id"`
+	OrganizationName string           `json:"organization_name,omitempty"`
+	Email            string           `json:"email"`
+	Role             OrganizationRole `json:"role"`
+	InvitedBy        *uuid.UUID       `json:"invited_by,omitempty"`
+	Status           InvitationStatus `json:"status"`
+	TokenHash        string           `json:"-"`
+	ExpiresAt        time.Time        `json:"expires_at"`
+	SendCount        int              `json:"send_count"`
+	LastSentAt       time.Time        `json:"last_sent_at"`
+	AcceptedBy       *uuid.UUID       `json:"accepted_by,omitempty"`
+	AcceptedAt       *time.Time       `json:"accepted_at,omitempty"`
+	RevokedAt        *time.Time       `json:"revoked_at,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// IsExpired reports whether a pending invitation can no longer be accepted
+func (i Invitation) IsExpired(now time.Time) bool {
+	return i.Status == InvitationStatusPending && !now.Before(i.ExpiresAt)
+}
+
+// IsAcceptable reports whether the invitation is pending and not expired
+func (i Invitation) IsAcceptable(now time.Time) bool {
+	return i.Status == InvitationStatusPending && now.Before(i.ExpiresAt)
+}
@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceStatus represents the lifecycle state of an invoice
+type InvoiceStatus string
+
+const (
+	InvoiceStatusDraft         InvoiceStatus = "draft"
+	InvoiceStatusSent          InvoiceStatus = "sent"
+	InvoiceStatusViewed        InvoiceStatus = "viewed"
+	InvoiceStatusPartiallyPaid InvoiceStatus = "partially_paid"
+	InvoiceStatusPaid          InvoiceStatus = "paid"
+	InvoiceStatusOverdue       InvoiceStatus = "overdue"
+	InvoiceStatusCredited      InvoiceStatus = "credited"
+	InvoiceStatusVoid          InvoiceStatus = "void"
+)
+
+// IsValid reports whether the status is one of the known statuses
+func (s InvoiceStatus) IsValid() bool {
+	switch s {
+	case InvoiceStatusDraft, InvoiceStatusSent, InvoiceStatusViewed, InvoiceStatusPartiallyPaid,
+		InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusCredited, InvoiceStatusVoid:
+		return true
+	}
+	return false
+}
+
+// InvoicePaymentException flags an invoice whose payments need a refund or follow-up
+type InvoicePaymentException string
+
+const (
+	// InvoicePaymentExceptionOverpaid is an invoice that received more than it was owed
+	InvoicePaymentExceptionOverpaid InvoicePaymentException = "overpaid"
+	// InvoicePaymentExceptionPaidAfterVoid is an invoice that received a transfer after it was voided
+	InvoicePaymentExceptionPaidAfterVoid InvoicePaymentException = "paid_after_void"
+)
+
+// IsOpen reports whether an invoice in the status has been sent and is awaiting payment
+func (s InvoiceStatus) IsOpen() bool {
+	switch s {
+	case InvoiceStatusSent, InvoiceStatusViewed, InvoiceStatusPartiallyPaid, InvoiceStatusOverdue:
+		return true
+	}
+	return false
+}
+
+// Invoice is a bill an organization sends a customer. Every amount is in
+// Currency. Invoices are numbered per organization without gaps, so they are
+// never deleted, only voided or credited. With AutomaticTax, every line's tax
+// rate is worked out from where the organization and the customer are and
+// whether they have tax IDs; otherwise lines keep the rates they were given.
+type Invoice struct {
+	ID                 uuid.UUID               `json:"id"`
+	OrganizationID     uuid.UUID               `json:"organization_id"`
+	Number             int64                   `json:"number"`
+	Status             InvoiceStatus           `json:"status"`
+	Currency           string                  `json:"currency"`
+	ClientID           *uuid.UUID              `json:"client_id,omitempty"`
+	CustomerName       string                  `json:"customer_name"`
+	CustomerEmail      string                  `json:"customer_email"`
+	CustomerAddress    string                  `json:"customer_address,omitempty"`
+	CustomerCountry    string                  `json:"customer_country,omitempty"`
+	CustomerTaxID      string                  `json:"customer_tax_id,omitempty"`
+	IssueDate          time.Time               `json:"issue_date"`
+	DueDate            time.Time               `json:"due_date"`
+	Notes              string                  `json:"notes,omitempty"`
+	LineItems          []InvoiceLineItem       `json:"line_items,omitempty"`
+	Subtotal           money.Money             `json:"subtotal"`
+	DiscountTotal      money.Money             `json:"discount_total"`
+	AutomaticTax       bool                    `json:"automatic_tax"`
+	TaxTreatment       TaxTreatment            `json:"tax_treatment,omitempty"`
+	TaxName            string                  `json:"tax_name,omitempty"`
+	TaxNote            string                  `json:"tax_note,omitempty"`
+	TaxRatesVersion    string                  `json:"tax_rates_version,omitempty"`
+	TaxTotal           money.Money             `json:"tax_total"`
+	Total              money.Money             `json:"total"`
+	PaymentAssetID     *uuid.UUID              `json:"payment_asset_id,omitempty"`
+	PaymentAddress     string                  `json:"payment_address,omitempty"`
+	DepositIndex       *int64                  `json:"deposit_index,omitempty"`
+	AmountPaid         money.Money             `json:"amount_paid"`
+	AmountCredited     money.Money             `json:"amount_credited"`
+	RecurringInvoiceID *uuid.UUID              `json:"recurring_invoice_id,omitempty"`
+	SentAt             *time.Time              `json:"sent_at,omitempty"`
+	ViewedAt           *time.Time              `json:"viewed_at,omitempty"`
+	PaidAt             *time.Time              `json:"paid_at,omitempty"`
+	PaymentReference   string                  `json:"payment_reference,omitempty"`
+	PaymentException   InvoicePaymentException `json:"payment_exception,omitempty"`
+	VoidedAt           *time.Time              `json:"voided_at,omitempty"`
+	VoidReason         string                  `json:"void_reason,omitempty"`
+	CreatedBy          *uuid.UUID              `json:"created_by,omitempty"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+}
+
+// DisplayNumber formats the invoice number the way it is shown to customers
+func (i Invoice) DisplayNumber() string {
+	return fmt.Sprintf("INV-%06d", i.Number)
+}
+
+// PDFFilename is the name the rendered invoice is downloaded and attached as
+func (i Invoice) PDFFilename() string {
+	return i.DisplayNumber() + ".pdf"
+}
+
+// IsOverdue reports whether an open invoice is past its due date at the given time
+func (i Invoice) IsOverdue(at time.Time) bool {
+	return i.Status.IsOpen() && at.After(i.DueDate)
+}
+
+// BalanceDue is what is left to pay after the payments received and the
+// credit notes issued, zero once they cover the total
+func (i Invoice) BalanceDue() money.Money {
+	due := money.New(i.payable().Sub(i.AmountPaid.Amount()), i.Currency)
+	if !due.IsPositive() {
+		return money.Zero(i.Currency)
+	}
+	return due
+}
+
+// Overpayment is how much more than the total, less credit notes, has been received
+func (i Invoice) Overpayment() money.Money {
+	over := money.New(i.AmountPaid.Amount().Sub(i.payable()), i.Currency)
+	if !over.IsPositive() {
+		return money.Zero(i.Currency)
+	}
+	return over
+}
+
+// payable is the total less what credit notes have taken off it
+func (i Invoice) payable() decimal.Decimal {
+	return i.Total.Amount().Sub(i.AmountCredited.Amount())
+}
+
+// ApplyCredit takes a credit note's amount off the balance. An invoice the
+// credit leaves nothing to pay on is credited; otherwise its status is kept.
+func (i *Invoice) ApplyCredit(amount money.Money) {
+	i.AmountCredited = money.New(i.AmountCredited.Amount().Add(amount.Amount()), i.Currency)
+	if !i.BalanceDue().IsPositive() {
+		i.Status = InvoiceStatusCredited
+	}
+}
+
+// ApplyPayments records that received has been paid towards the invoice so
+// far and moves it to the status that puts it in. What is owed is the total
+// less any credit notes. An underpayment of at most
+// toleranceBPS basis points of the total, such as a fee taken off in transit,
+// still pays the invoice in full; reference and at are then kept as the
+// payment that settled it. Less than that leaves an open invoice partially
+// paid, or overdue if it already was. A paid invoice stays paid, so later
+// payments only add to its overpayment, which flags the invoice as overpaid.
+func (i *Invoice) ApplyPayments(received decimal.Decimal, toleranceBPS int, at time.Time, reference string) {
+	i.AmountPaid = money.New(received, i.Currency)
+	if i.Overpayment().IsPositive() {
+		i.PaymentException = InvoicePaymentExceptionOverpaid
+	}
+
+	if i.Status == InvoiceStatusPaid || !i.Status.IsOpen() || !received.IsPositive() {
+		return
+	}
+
+	tolerance := i.Total.Amount().Mul(decimal.NewFromInt(int64(toleranceBPS))).Div(decimal.NewFromInt(10000))
+	if received.GreaterThanOrEqual(i.payable().Sub(tolerance)) {
+		i.Status = InvoiceStatusPaid
+		i.PaidAt = &at
+		i.PaymentReference = reference
+		return
+	}
+
+	if i.Status != InvoiceStatusOverdue {
+		i.Status = InvoiceStatusPartiallyPaid
+	}
+}
+
+// Calculate works out every line item's amounts and the invoice totals. Line
+// amounts are rounded to places decimal places, so the totals are exact sums
+// of what each line shows.
+func (i *Invoice) Calculate(places int32) {
+	i.Subtotal = money.Zero(i.Currency)
+	i.DiscountTotal = money.Zero(i.Currency)
+	i.TaxTotal = money.Zero(i.Currency)
+	i.Total = money.Zero(i.Currency)
+
+	for n := range i.LineItems {
+		item := &i.LineItems[n]
+		item.Position = n + 1
+		item.Calculate(i.Currency, places)
+
+		// Every line is in the invoice currency, so Add cannot fail
+		i.Subtotal, _ = i.Subtotal.Add(item.Amount)
+		i.DiscountTotal, _ = i.DiscountTotal.Add(item.DiscountAmount)
+		i.TaxTotal, _ = i.TaxTotal.Add(item.TaxAmount)
+		i.Total, _ = i.Total.Add(item.Total)
+	}
+}
+
+// InvoiceLineItem is one line of an invoice. DiscountPercent comes off the
+// amount first and TaxRate, also a percentage, applies to what is left.
+type InvoiceLineItem struct {
+	ID              uuid.UUID       `json:"id"`
+	InvoiceID       uuid.UUID       `json:"invoice_id"`
+	Position        int             `json:"position"`
+	Description     string          `json:"description"`
+	Quantity        decimal.Decimal `json:"quantity"`
+	UnitPrice       money.Money     `json:"unit_price"`
+	DiscountPercent decimal.Decimal `json:"discount_percent"`
+	TaxRate         decimal.Decimal `json:"tax_rate"`
+	Amount          money.Money     `json:"amount"`
+	DiscountAmount  money.Money     `json:"discount_amount"`
+	TaxAmount       money.Money     `json:"tax_amount"`
+	Total           money.Money     `json:"total"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// Calculate works out the line's amount, discount, tax and total in currency,
+// each rounded half-even to places decimal places
+func (l *InvoiceLineItem) Calculate(currency string, places int32) {
+	hundred := decimal.NewFromInt(100)
+
+	l.UnitPrice = money.New(l.UnitPrice.Amount(), currency)
+	l.Amount = l.UnitPrice.Mul(l.Quantity).Round(places, money.RoundHalfEven)
+	l.DiscountAmount = l.Amount.Mul(l.DiscountPercent.Div(hundred)).Round(places, money.RoundHalfEven)
+
+	// Same currency throughout, so Sub and Add cannot fail
+	taxable, _ := l.Amount.Sub(l.DiscountAmount)
+	l.TaxAmount = taxable.Mul(l.TaxRate.Div(hundred)).Round(places, money.RoundHalfEven)
+	l.Total, _ = taxable.Add(l.TaxAmount)
+}
+
+// InvoiceDocument is everything printed on an invoice: the invoice itself, the
+// issuing organization and, when payment instructions are set, the asset the
+// customer pays in
+type InvoiceDocument struct {
+	Invoice      Invoice
+	Organization Organization
+	PaymentAsset *SupportedAsset
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// MaxInvoiceToleranceBPS caps the underpayment an organization can accept as paid
+const MaxInvoiceToleranceBPS = 1000
+
+// InvoiceDepositKey is the account-level extended public key an organization's
+// invoice deposit addresses are derived from, one receiving address per
+// invoice. Only the public key is stored, so the platform can hand out
+// addresses but never move what is paid into them.
+type InvoiceDepositKey struct {
+	OrganizationID    uuid.UUID  `json:"organization_id"`
+	ExtendedPublicKey string     `json:"extended_public_key"`
+	NextIndex         int64      `json:"next_index"`
+	ToleranceBPS      int        `json:"tolerance_bps"`
+	CreatedBy         *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// InvoicePayment is a token transfer observed into an invoice's deposit
+// address, with its amount in the invoice currency. A transfer received after
+// the invoice was voided is kept but not applied, so it does not count
+// towards the invoice.
+type InvoicePayment struct {
+	ID              uuid.UUID   `json:"id"`
+	InvoiceID       uuid.UUID   `json:"invoice_id"`
+	TxHash          string      `json:"tx_hash"`
+	LogIndex        int         `json:"log_index"`
+	TransferOrdinal int         `json:"-"`
+	TokenAddress    string      `json:"token_address"`
+	FromAddress     string      `json:"from_address"`
+	ToAddress       string      `json:"to_address"`
+	Amount          money.Money `json:"amount"`
+	BlockNumber     int64       `json:"block_number"`
+	Applied         bool        `json:"applied"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxInvoiceReminderSteps caps how many reminders one invoice can get
+	MaxInvoiceReminderSteps = 10
+	// MinInvoiceReminderOffset is the earliest a reminder can go out, in days before the due date
+	MinInvoiceReminderOffset = -30
+	// MaxInvoiceReminderOffset is the latest a reminder can go out, in days after the due date
+	MaxInvoiceReminderOffset = 90
+	// MaxInvoiceReminderAttempts is how many times a reminder step's email is tried before the step is given up
+	MaxInvoiceReminderAttempts = 5
+	// InvoiceReminderRetryBackoff is how long a failed reminder step waits before it is tried again; the wait doubles with each further failure
+	InvoiceReminderRetryBackoff = time.Hour
+)
+
+// DefaultInvoiceReminderOffsets is the cadence an organization starts with:
+// three days before the due date, on it, and one and two weeks after
+var DefaultInvoiceReminderOffsets = []int{-3, 0, 7, 14}
+
+// InvoiceReminderSettings is when an organization's customers are reminded of
+// invoices awaiting payment, in days relative to each invoice's due date.
+// Reminders are off until an organization turns them on.
+type InvoiceReminderSettings struct {
+	OrganizationID uuid.UUID  `json:"organization_id"`
+	Enabled        bool       `json:"enabled"`
+	OffsetDays     []int      `json:"offset_days"`
+	UpdatedBy      *uuid.UUID `json:"updated_by,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Validate checks the reminder steps are within range and distinct
+func (s InvoiceReminderSettings) Validate() error {
+	if s.Enabled && len(s.OffsetDays) == 0 {
+		return fmt.Errorf("at least one reminder is required")
+	}
+	if len(s.OffsetDays) > MaxInvoiceReminderSteps {
+		return fmt.Errorf("at most %d reminders are allowed", MaxInvoiceReminderSteps)
+	}
+
+	seen := make(map[int]bool, len(s.OffsetDays))
+	for _, offset := range s.OffsetDays {
+		if offset < MinInvoiceReminderOffset || offset > MaxInvoiceReminderOffset {
+			return fmt.Errorf("reminders must be between %d days before and %d days after the due date", -MinInvoiceReminderOffset, MaxInvoiceReminderOffset)
+		}
+		if seen[offset] {
+			return fmt.Errorf("reminder %d is listed more than once", offset)
+		}
+		seen[offset] = true
+	}
+
+	return nil
+}
+
+// InvoiceReminder is a reminder sent to the customer of an invoice at one step
+// of its organization's cadence
+type InvoiceReminder struct {
+	ID         uuid.UUID `json:"id"`
+	InvoiceID  uuid.UUID `json:"invoice_id"`
+	OffsetDays int       `json:"offset_days"`
+	Recipient  string    `json:"recipient"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// DueInvoiceReminder is a reminder step that has come due for an invoice
+type DueInvoiceReminder struct {
+	InvoiceID  uuid.UUID
+	OffsetDays int
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvoiceShareLink is a public, read-only link to an invoice for a customer
+// without an account. Only a hash of its signed token is kept, so a lost link
+// cannot be shown again, only revoked and replaced.
+type InvoiceShareLink struct {
+	ID           uuid.UUID  `json:"id"`
+	InvoiceID    uuid.UUID  `json:"invoice_id"`
+	TokenHash    string     `json:"-"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	ViewCount    int        `json:"view_count"`
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy    *uuid.UUID `json:"revoked_by,omitempty"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// IsActive reports whether the link still opens the invoice at the given time
+func (l InvoiceShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt == nil && now.Before(l.ExpiresAt)
+}
+
+// SharedInvoice is what a share link shows: the invoice, who issued it under
+// which tax ID, and the asset it is paid in, if any
+type SharedInvoice struct {
+	Invoice           Invoice         `json:"invoice"`
+	OrganizationName  string          `json:"organization_name"`
+	OrganizationTaxID string          `json:"organization_tax_id,omitempty"`
+	PaymentAsset      *SupportedAsset `json:"payment_asset,omitempty"`
+	LinkExpiresAt     time.Time       `json:"link_expires_at"`
+}
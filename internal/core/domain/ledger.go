@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// LedgerAccountType classifies a ledger account in the chart of accounts
+type LedgerAccountType string
+
+const (
+	LedgerAccountTypeAsset     LedgerAccountType = "asset"
+	LedgerAccountTypeLiability LedgerAccountType = "liability"
+	LedgerAccountTypeEquity    LedgerAccountType = "equity"
+	LedgerAccountTypeRevenue   LedgerAccountType = "revenue"
+	LedgerAccountTypeExpense   LedgerAccountType = "expense"
+)
+
+// IsValid reports whether the account type is one of the known types
+func (t LedgerAccountType) IsValid() bool {
+	switch t {
+	case LedgerAccountTypeAsset, LedgerAccountTypeLiability, LedgerAccountTypeEquity,
+		LedgerAccountTypeRevenue, LedgerAccountTypeExpense:
+		return true
+	}
+	return false
+}
+
+// IsDebitNormal reports whether the account's balance normally grows with
+// debits (assets, expenses) rather than credits (liabilities, equity, revenue)
+func (t LedgerAccountType) IsDebitNormal() bool {
+	return t == LedgerAccountTypeAsset || t == LedgerAccountTypeExpense
+}
+
+// LedgerAccount is an account in the double-entry ledger. Accounts are not tied
+// to one asset; balances are kept per asset.
+type LedgerAccount struct {
+	ID        uuid.UUID         `json:"id"`
+	Code      string            `json:"code"`
+	Name      string            `json:"name"`
+	Type      LedgerAccountType `json:"type"`
+	OwnerID   *uuid.UUID        `json:"owner_id,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// LedgerEntry is a journal entry: a set of postings that sum to zero in every
+// asset. ExternalReference identifies the business event that produced it, so
+// posting the same event twice records it once.
+type LedgerEntry struct {
+	ID                uuid.UUID       `json:"id"`
+	ExternalReference string          `json:"external_reference"`
+	Description       string          `json:"description"`
+	OccurredAt        time.Time       `json:"occurred_at"`
+	CreatedAt         time.Time       `json:"created_at"`
+	Postings          []LedgerPosting `json:"postings"`
+}
+
+// LedgerPosting is one debit or credit of an entry. Amount is positive for a
+// debit and negative for a credit; its currency is the asset.
+type LedgerPosting struct {
+	ID        uuid.UUID   `json:"id"`
+	EntryID   uuid.UUID   `json:"entry_id"`
+	AccountID uuid.UUID   `json:"account_id"`
+	Amount    money.Money `json:"amount"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// LedgerBalance is the sum of an account's postings in one asset, debits positive
+type LedgerBalance struct {
+	AccountID uuid.UUID   `json:"account_id"`
+	Balance   money.Money `json:"balance"`
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationRole is what a member is allowed to do in an organization
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner can do everything, including managing other owners
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleAdmin manages the profile and members other than owners
+	OrganizationRoleAdmin OrganizationRole = "admin"
+	// OrganizationRoleFinance prepares and pays payroll and invoices
+	OrganizationRoleFinance OrganizationRole = "finance"
+	// OrganizationRoleViewer has read-only access
+	OrganizationRoleViewer OrganizationRole = "viewer"
+)
+
+// IsValid reports whether the role is one of the known roles
+func (r OrganizationRole) IsValid() bool {
+	switch r {
+	case OrganizationRoleOwner, OrganizationRoleAdmin, OrganizationRoleFinance, OrganizationRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanManageOrganization reports whether the role may edit the profile and manage members
+func (r OrganizationRole) CanManageOrganization() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleAdmin
+}
+
+// CanManageFinances reports whether the role may prepare payments, payroll and invoices
+func (r OrganizationRole) CanManageFinances() bool {
+	return r.CanManageOrganization() || r == OrganizationRoleFinance
+}
+
+// Organization is a business account that several users can act for. The
+// size, industry, description and headquarters fields carry CompanyInfo.
+type Organization struct {
+	ID           uuid.UUID  `json:"id"`
+	Name         string     `json:"name"`
+	Address      string     `json:"address"`
+	City         string     `json:"city"`
+	PostalCode   string     `json:"postal_code"`
+	Country      string     `json:"country"`
+	Website      *string    `json:"website,omitempty"`
+	Size         *string    `json:"size,omitempty"`
+	Industry     *string    `json:"industry,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Headquarters *string    `json:"headquarters,omitempty"`
+	LogoURL      *string    `json:"logo_url,omitempty"`
+	TaxID        string     `json:"tax_id,omitempty"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// CompanyInfo returns the organization's profile as company info
+func (o Organization) CompanyInfo() CompanyInfo {
+	return CompanyInfo{
+		CompanyName:         &o.Name,
+		CompanySize:         o.Size,
+		CompanyIndustry:     o.Industry,
+		CompanyDescription:  o.Description,
+		CompanyHeadquarters: o.Headquarters,
+	}
+}
+
+// OrganizationMember is a user's membership of an organization
+type OrganizationMember struct {
+	ID             uuid.UUID        `json:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	UserID         uuid.UUID        `json:"user_id"`
+	Role           OrganizationRole `json:"role"`
+	Email          string           `json:"email,omitempty"`
+	FirstName      string           `json:"first_name,omitempty"`
+	LastName       string           `json:"last_name,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// OrganizationMembership is an organization together with the caller's role in it
+type OrganizationMembership struct {
+	Organization Organization     `json:"organization"`
+	Role         OrganizationRole `json:"role"`
+}
@@ -9,12 +9,13 @@ import (
 type OTPPurpose string
 
 const (
-	OTPPurposeEmailVerification OTPPurpose = "email_verification"
-	OTPPurposePasswordReset     OTPPurpose = "password_reset"
-	OTPPurposePhoneVerification OTPPurpose = "phone_verification"
-	OTPPurposeAccountRecovery   OTPPurpose = "account_recovery"
-	OTPPurposeTwoFactorAuth     OTPPurpose = "two_factor_auth"
-	OTPPurposeLoginConfirmation OTPPurpose = "login_confirmation"
+	OTPPurposeEmailVerification   OTPPurpose = "email_verification"
+	OTPPurposePasswordReset       OTPPurpose = "password_reset"
+	OTPPurposePhoneVerification   OTPPurpose = "phone_verification"
+	OTPPurposeAccountRecovery     OTPPurpose = "account_recovery"
+	OTPPurposeTwoFactorAuth       OTPPurpose = "two_factor_auth"
+	OTPPurposeLoginConfirmation   OTPPurpose = "login_confirmation"
+	OTPPurposeTransactionPINReset OTPPurpose = "transaction_pin_reset"
 )
 
 type OTPVerification struct {
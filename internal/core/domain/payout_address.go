@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayoutAddressStatus represents the allowlist state of a payout address
+type PayoutAddressStatus string
+
+const (
+	PayoutAddressStatusPending   PayoutAddressStatus = "pending"
+	PayoutAddressStatusActive    PayoutAddressStatus = "active"
+	PayoutAddressStatusCancelled PayoutAddressStatus = "cancelled"
+)
+
+// PayoutAddress is an allowlist entry for a wallet that may receive payouts.
+// New entries are quarantined until AvailableAt and can be cancelled by the
+// owner during that window.
+type PayoutAddress struct {
+	ID              uuid.UUID           `json:"id"`
+	UserID          uuid.UUID           `json:"user_id"`
+	WalletID        uuid.UUID           `json:"wallet_id"`
+	Address         string              `json:"address"`
+	Chain           string              `json:"chain"`
+	Status          PayoutAddressStatus `json:"status"`
+	CancelTokenHash string              `json:"-"`
+	AvailableAt     time.Time           `json:"available_at"`
+	ActivatedAt     *time.Time          `json:"activated_at,omitempty"`
+	CancelledAt     *time.Time          `json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// IsUsable reports whether funds may be sent to the address at the given time
+func (p PayoutAddress) IsUsable(now time.Time) bool {
+	switch p.Status {
+	case PayoutAddressStatusActive:
+		return true
+	case PayoutAddressStatusPending:
+		return !now.Before(p.AvailableAt)
+	default:
+		return false
+	}
+}
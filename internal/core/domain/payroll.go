@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+// PayrollFrequency is how often a payroll schedule pays out
+type PayrollFrequency string
+
+const (
+	PayrollFrequencyWeekly   PayrollFrequency = "weekly"
+	PayrollFrequencyBiweekly PayrollFrequency = "biweekly"
+	PayrollFrequencyMonthly  PayrollFrequency = "monthly"
+	PayrollFrequencyCustom   PayrollFrequency = "custom"
+)
+
+// IsValid reports whether the frequency is one of the known frequencies
+func (f PayrollFrequency) IsValid() bool {
+	switch f {
+	case PayrollFrequencyWeekly, PayrollFrequencyBiweekly, PayrollFrequencyMonthly, PayrollFrequencyCustom:
+		return true
+	}
+	return false
+}
+
+// PayrollSchedule is a recurring set of pay dates for an organization. Weekly,
+// bi-weekly and monthly dates are counted from FirstPayDate in Timezone; custom
+// schedules follow CronExpression from FirstPayDate onwards.
+type PayrollSchedule struct {
+	ID             uuid.UUID        `json:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	Name           string           `json:"name"`
+	Frequency      PayrollFrequency `json:"frequency"`
+	CronExpression string           `json:"cron_expression,omitempty"`
+	Timezone       string           `json:"timezone"`
+	FirstPayDate   time.Time        `json:"first_pay_date"`
+	NextRunAt      time.Time        `json:"next_run_at"`
+	LastRunAt      *time.Time       `json:"last_run_at,omitempty"`
+	Active         bool             `json:"active"`
+	CreatedBy      *uuid.UUID       `json:"created_by,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// NextPayDate returns the first pay date strictly after the given time. Monthly
+// dates that fall past the end of a shorter month move to its last day, so a
+// schedule anchored on the 31st pays on 28 or 29 February.
+func (s PayrollSchedule) NextPayDate(after time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+
+	anchor := s.FirstPayDate.In(loc)
+	after = after.In(loc)
+
+	if s.Frequency == PayrollFrequencyCustom {
+		schedule, err := ParsePayrollCron(s.CronExpression)
+		if err != nil {
+			return time.Time{}, err
+		}
+		// The first pay date is the earliest the expression may fire, not a pay date itself
+		if after.Before(anchor) {
+			after = anchor.Add(-time.Nanosecond)
+		}
+		next := schedule.Next(after)
+		if next.IsZero() {
+			return time.Time{}, fmt.Errorf("cron expression %q never fires", s.CronExpression)
+		}
+		return next, nil
+	}
+
+	if after.Before(anchor) {
+		return anchor, nil
+	}
+
+	switch s.Frequency {
+	case PayrollFrequencyWeekly:
+		return nextByDays(anchor, after, 7), nil
+	case PayrollFrequencyBiweekly:
+		return nextByDays(anchor, after, 14), nil
+	case PayrollFrequencyMonthly:
+		return nextByMonths(anchor, after, 1), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid payroll frequency %q", s.Frequency)
+}
+
+// ParsePayrollCron parses a standard five-field cron expression
+func ParsePayrollCron(expression string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+	return schedule, nil
+}
+
+// nextByDays steps from anchor in whole calendar days so the time of day holds across DST changes
+func nextByDays(anchor, after time.Time, days int) time.Time {
+	periods := int(after.Sub(anchor).Hours()/24) / days
+	next := anchor.AddDate(0, 0, periods*days)
+	for !next.After(after) {
+		periods++
+		next = anchor.AddDate(0, 0, periods*days)
+	}
+	return next
+}
+
+// nextByMonths steps from anchor every step calendar months, clamping to the end of shorter months
+func nextByMonths(anchor, after time.Time, step int) time.Time {
+	months := (after.Year()-anchor.Year())*12 + int(after.Month()-anchor.Month()) - 1
+	periods := max(months, 0) / step
+	next := addMonthsClamped(anchor, periods*step)
+	for !next.After(after) {
+		periods++
+		next = addMonthsClamped(anchor, periods*step)
+	}
+	return next
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// EmployeeCompensation is what an employee is paid on each pay date of a
+// schedule. Amount is in the agreed currency, which may differ from the asset
+// the employee is paid out in. A contractor who charges VAT on their pay sets
+// TaxCountry and TaxID so payslips can break the tax out of the amount.
+type EmployeeCompensation struct {
+	ID                uuid.UUID   `json:"id"`
+	OrganizationID    uuid.UUID   `json:"organization_id"`
+	ScheduleID        uuid.UUID   `json:"schedule_id"`
+	UserID            uuid.UUID   `json:"user_id"`
+	Email             string      `json:"email"`
+	FirstName         string      `json:"first_name"`
+	LastName          string      `json:"last_name"`
+	Amount            money.Money `json:"amount"`
+	PayoutAssetID     uuid.UUID   `json:"payout_asset_id"`
+	PayoutAssetSymbol string      `json:"payout_asset_symbol"`
+	WalletAddress     string      `json:"wallet_address"`
+	TaxCountry        string      `json:"tax_country,omitempty"`
+	TaxID             string      `json:"tax_id,omitempty"`
+	Active            bool        `json:"active"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// PayRunStatus represents the lifecycle state of a pay run
+type PayRunStatus string
+
+const (
+	PayRunStatusDraft           PayRunStatus = "draft"
+	PayRunStatusPendingApproval PayRunStatus = "pending_approval"
+	PayRunStatusApproved        PayRunStatus = "approved"
+)
+
+// PayRun is the payroll of one schedule for one pay date. Line items are
+// copied from the compensation records when the run is generated.
+type PayRun struct {
+	ID             uuid.UUID        `json:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	ScheduleID     uuid.UUID        `json:"schedule_id"`
+	PayDate        time.Time        `json:"pay_date"`
+	PeriodStart    time.Time        `json:"period_start"`
+	Status         PayRunStatus     `json:"status"`
+	LineItems      []PayRunLineItem `json:"line_items,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// Totals sums the line items per currency, in order of first appearance
+func (r PayRun) Totals() []money.Money {
+	var totals []money.Money
+	index := make(map[string]int)
+
+	for _, item := range r.LineItems {
+		i, ok := index[item.Amount.Currency()]
+		if !ok {
+			index[item.Amount.Currency()] = len(totals)
+			totals = append(totals, item.Amount)
+			continue
+		}
+		// Same currency by construction, so Add cannot fail
+		totals[i], _ = totals[i].Add(item.Amount)
+	}
+
+	return totals
+}
+
+// PayRunLineItem is one employee's pay in a pay run. LockedPayout is the
+// amount of the payout asset paid, converted with the FXQuoteID quote when
+// the pay run is submitted; both are nil on a draft, and FXQuoteID stays nil
+// when the amount is already in the payout asset.
+type PayRunLineItem struct {
+	ID                uuid.UUID    `json:"id"`
+	PayRunID          uuid.UUID    `json:"pay_run_id"`
+	CompensationID    *uuid.UUID   `json:"compensation_id,omitempty"`
+	UserID            uuid.UUID    `json:"user_id"`
+	Email             string       `json:"email"`
+	FirstName         string       `json:"first_name"`
+	LastName          string       `json:"last_name"`
+	Amount            money.Money  `json:"amount"`
+	PayoutAssetID     uuid.UUID    `json:"payout_asset_id"`
+	PayoutAssetSymbol string       `json:"payout_asset_symbol"`
+	WalletAddress     string       `json:"wallet_address"`
+	TaxCountry        string       `json:"tax_country,omitempty"`
+	TaxID             string       `json:"tax_id,omitempty"`
+	FXQuoteID         *uuid.UUID   `json:"fx_quote_id,omitempty"`
+	LockedPayout      *money.Money `json:"locked_payout,omitempty"`
+	CreatedAt         time.Time    `json:"created_at"`
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayRunSimulation is what a pay run would pay out if it were disbursed now.
+// It is computed on demand and never stored, so running one has no effect on
+// the schedule, its pay runs or the chain.
+type PayRunSimulation struct {
+	ScheduleID uuid.UUID `json:"schedule_id"`
+	// PayRunID is set when an existing pay run was simulated rather than the
+	// schedule's next pay date
+	PayRunID    *uuid.UUID          `json:"pay_run_id,omitempty"`
+	PayDate     time.Time           `json:"pay_date"`
+	PeriodStart time.Time           `json:"period_start"`
+	LineItems   []SimulatedLineItem `json:"line_items"`
+	// Totals sums the line items per agreed currency
+	Totals  []money.Money      `json:"totals"`
+	Funding []SimulatedFunding `json:"funding"`
+	// Previous is the schedule's pay run before this pay date, if there is one
+	Previous *PayRun            `json:"previous,omitempty"`
+	Changes  []PayRunLineChange `json:"changes"`
+	// Warnings lists what could not be computed, such as a missing exchange
+	// rate or a failed gas estimate; the rest of the simulation still holds
+	Warnings    []string  `json:"warnings,omitempty"`
+	SimulatedAt time.Time `json:"simulated_at"`
+}
+
+// HasAnomalies reports whether any change against the previous pay run was flagged
+func (s PayRunSimulation) HasAnomalies() bool {
+	for _, change := range s.Changes {
+		if change.Anomalous {
+			return true
+		}
+	}
+	return false
+}
+
+// SimulatedLineItem is one employee's pay converted into the payout asset at
+// the current rate, or at the rate locked when its pay run was submitted.
+// PayoutAmount and Fee are nil when no rate was available.
+type SimulatedLineItem struct {
+	PayRunLineItem
+	FXRate       *decimal.Decimal `json:"fx_rate,omitempty"`
+	PayoutAmount *money.Money     `json:"payout_amount,omitempty"`
+	Fee          *money.Money     `json:"fee,omitempty"`
+}
+
+// SimulatedFunding is what the treasury needs in one payout asset to cover
+// the pay run, and the estimated cost of the disbursement transactions.
+// EstimatedGasFee is in the chain's native coin.
+type SimulatedFunding struct {
+	AssetID         uuid.UUID    `json:"asset_id"`
+	Symbol          string       `json:"symbol"`
+	Chain           string       `json:"chain"`
+	PayoutAmount    money.Money  `json:"payout_amount"`
+	FeeAmount       money.Money  `json:"fee_amount"`
+	TotalAmount     money.Money  `json:"total_amount"`
+	Transfers       int          `json:"transfers"`
+	Batches         int          `json:"batches"`
+	EstimatedGas    *uint64      `json:"estimated_gas,omitempty"`
+	EstimatedGasFee *money.Money `json:"estimated_gas_fee,omitempty"`
+}
+
+// PayRunLineChangeKind says how an employee's pay differs from the previous pay run
+type PayRunLineChangeKind string
+
+const (
+	PayRunLineAdded   PayRunLineChangeKind = "added"
+	PayRunLineRemoved PayRunLineChangeKind = "removed"
+	PayRunLineChanged PayRunLineChangeKind = "changed"
+)
+
+// PayRunLineChange is an employee whose pay differs from the previous pay run.
+// A change is anomalous when the amount moved by at least the configured
+// percentage, or the currency, payout asset or wallet changed.
+type PayRunLineChange struct {
+	Kind               PayRunLineChangeKind `json:"kind"`
+	UserID             uuid.UUID            `json:"user_id"`
+	Email              string               `json:"email"`
+	FirstName          string               `json:"first_name"`
+	LastName           string               `json:"last_name"`
+	PreviousAmount     *money.Money         `json:"previous_amount,omitempty"`
+	Amount             *money.Money         `json:"amount,omitempty"`
+	PercentChange      *decimal.Decimal     `json:"percent_change,omitempty"`
+	CurrencyChanged    bool                 `json:"currency_changed"`
+	PayoutAssetChanged bool                 `json:"payout_asset_changed"`
+	WalletChanged      bool                 `json:"wallet_changed"`
+	Anomalous          bool                 `json:"anomalous"`
+}
+
+// DiffPayRunLineItems compares each employee's pay with the previous pay run
+// and returns the employees who were added, removed or whose pay changed, in
+// the order of current followed by the removed ones
+func DiffPayRunLineItems(previous, current []PayRunLineItem, anomalyPercent decimal.Decimal) []PayRunLineChange {
+	previousByUser := make(map[uuid.UUID]PayRunLineItem, len(previous))
+	for _, item := range previous {
+		previousByUser[item.UserID] = item
+	}
+
+	hundred := decimal.NewFromInt(100)
+	changes := []PayRunLineChange{}
+	seen := make(map[uuid.UUID]bool, len(current))
+
+	for _, item := range current {
+		seen[item.UserID] = true
+		amount := item.Amount
+
+		before, ok := previousByUser[item.UserID]
+		if !ok {
+			changes = append(changes, PayRunLineChange{
+				Kind:      PayRunLineAdded,
+				UserID:    item.UserID,
+				Email:     item.Email,
+				FirstName: item.FirstName,
+				LastName:  item.LastName,
+				Amount:    &amount,
+			})
+			continue
+		}
+
+		previousAmount := before.Amount
+		change := PayRunLineChange{
+			Kind:               PayRunLineChanged,
+			UserID:             item.UserID,
+			Email:              item.Email,
+			FirstName:          item.FirstName,
+			LastName:           item.LastName,
+			PreviousAmount:     &previousAmount,
+			Amount:             &amount,
+			CurrencyChanged:    !amount.SameCurrency(previousAmount),
+			PayoutAssetChanged: item.PayoutAssetID != before.PayoutAssetID,
+			WalletChanged:      item.WalletAddress != before.WalletAddress,
+		}
+
+		if !change.CurrencyChanged {
+			if amount.Equal(previousAmount) && !change.PayoutAssetChanged && !change.WalletChanged {
+				continue
+			}
+			if !previousAmount.IsZero() {
+				percent := amount.Amount().Sub(previousAmount.Amount()).Mul(hundred).Div(previousAmount.Amount()).Round(2)
+				change.PercentChange = &percent
+			}
+		}
+
+		change.Anomalous = change.CurrencyChanged || change.PayoutAssetChanged || change.WalletChanged ||
+			(change.PercentChange != nil && change.PercentChange.Abs().GreaterThanOrEqual(anomalyPercent))
+
+		changes = append(changes, change)
+	}
+
+	for _, item := range previous {
+		if seen[item.UserID] {
+			continue
+		}
+		previousAmount := item.Amount
+		changes = append(changes, PayRunLineChange{
+			Kind:           PayRunLineRemoved,
+			UserID:         item.UserID,
+			Email:          item.Email,
+			FirstName:      item.FirstName,
+			LastName:       item.LastName,
+			PreviousAmount: &previousAmount,
+		})
+	}
+
+	return changes
+}
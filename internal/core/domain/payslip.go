@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Payslip is one employee's pay in a pay run. Gross is the amount paid; when
+// the payee charges tax on it, TaxLines break the tax out of Gross and Net is
+// what is left.
+type Payslip struct {
+	PayRunID          uuid.UUID          `json:"pay_run_id"`
+	LineItemID        uuid.UUID          `json:"line_item_id"`
+	OrganizationID    uuid.UUID          `json:"organization_id"`
+	OrganizationName  string             `json:"organization_name"`
+	UserID            uuid.UUID          `json:"user_id"`
+	Email             string             `json:"email"`
+	FirstName         string             `json:"first_name"`
+	LastName          string             `json:"last_name"`
+	PayDate           time.Time          `json:"pay_date"`
+	PeriodStart       time.Time          `json:"period_start"`
+	Status            PayRunStatus       `json:"status"`
+	Gross             money.Money        `json:"gross"`
+	Net               money.Money        `json:"net"`
+	TaxTreatment      TaxTreatment       `json:"tax_treatment,omitempty"`
+	TaxName           string             `json:"tax_name,omitempty"`
+	TaxNote           string             `json:"tax_note,omitempty"`
+	TaxRatesVersion   string             `json:"tax_rates_version,omitempty"`
+	TaxLines          []TaxBreakdownLine `json:"tax_lines,omitempty"`
+	PayoutAssetSymbol string             `json:"payout_asset_symbol"`
+	WalletAddress     string             `json:"wallet_address"`
+}
+
+// NewPayslip builds the payslip of a line item in a pay run. The line item's
+// amount includes any tax, so with a determination the tax is taken out of it
+// at the determined rate, rounded to places. Without one there are no tax lines.
+func NewPayslip(run PayRun, item PayRunLineItem, organizationName string, tax *TaxDetermination, places int32) Payslip {
+	payslip := Payslip{
+		PayRunID:          run.ID,
+		LineItemID:        item.ID,
+		OrganizationID:    run.OrganizationID,
+		OrganizationName:  organizationName,
+		UserID:            item.UserID,
+		Email:             item.Email,
+		FirstName:         item.FirstName,
+		LastName:          item.LastName,
+		PayDate:           run.PayDate,
+		PeriodStart:       run.PeriodStart,
+		Status:            run.Status,
+		Gross:             item.Amount,
+		Net:               item.Amount,
+		PayoutAssetSymbol: item.PayoutAssetSymbol,
+		WalletAddress:     item.WalletAddress,
+	}
+	if tax == nil {
+		return payslip
+	}
+
+	currency := item.Amount.Currency()
+	hundred := decimal.NewFromInt(100)
+	taxAmount := money.New(item.Amount.Amount().Mul(tax.Rate).Div(hundred.Add(tax.Rate)), currency).Round(places, money.RoundHalfEven)
+	// Both amounts are in the line item's currency, so Sub cannot fail
+	payslip.Net, _ = item.Amount.Sub(taxAmount)
+
+	payslip.TaxTreatment = tax.Treatment
+	payslip.TaxName = tax.TaxName
+	payslip.TaxNote = tax.Note
+	payslip.TaxRatesVersion = tax.RatesVersion
+	payslip.TaxLines = []TaxBreakdownLine{{
+		Label:   TaxLabel(tax.TaxName, tax.Treatment, tax.Rate),
+		Rate:    tax.Rate,
+		Taxable: payslip.Net,
+		Tax:     taxAmount,
+	}}
+
+	return payslip
+}
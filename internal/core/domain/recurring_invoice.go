@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceFrequency is how often a recurring invoice is issued
+type InvoiceFrequency string
+
+const (
+	InvoiceFrequencyWeekly    InvoiceFrequency = "weekly"
+	InvoiceFrequencyBiweekly  InvoiceFrequency = "biweekly"
+	InvoiceFrequencyMonthly   InvoiceFrequency = "monthly"
+	InvoiceFrequencyQuarterly InvoiceFrequency = "quarterly"
+	InvoiceFrequencyYearly    InvoiceFrequency = "yearly"
+)
+
+// IsValid reports whether the frequency is one of the known frequencies
+func (f InvoiceFrequency) IsValid() bool {
+	switch f {
+	case InvoiceFrequencyWeekly, InvoiceFrequencyBiweekly, InvoiceFrequencyMonthly,
+		InvoiceFrequencyQuarterly, InvoiceFrequencyYearly:
+		return true
+	}
+	return false
+}
+
+// RecurringInvoiceStatus represents whether a recurring invoice still issues invoices
+type RecurringInvoiceStatus string
+
+const (
+	RecurringInvoiceStatusActive    RecurringInvoiceStatus = "active"
+	RecurringInvoiceStatusPaused    RecurringInvoiceStatus = "paused"
+	RecurringInvoiceStatusCompleted RecurringInvoiceStatus = "completed"
+)
+
+// IsValid reports whether the status is one of the known statuses
+func (s RecurringInvoiceStatus) IsValid() bool {
+	switch s {
+	case RecurringInvoiceStatusActive, RecurringInvoiceStatusPaused, RecurringInvoiceStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// RecurringInvoice is a template an invoice is generated from on every issue
+// date, such as a monthly retainer. Issue dates are counted from StartDate in
+// Timezone and stop after EndDate or once MaxOccurrences invoices have been
+// issued, whichever comes first.
+type RecurringInvoice struct {
+	ID              uuid.UUID                  `json:"id"`
+	OrganizationID  uuid.UUID                  `json:"organization_id"`
+	Name            string                     `json:"name"`
+	Status          RecurringInvoiceStatus     `json:"status"`
+	Frequency       InvoiceFrequency           `json:"frequency"`
+	Timezone        string                     `json:"timezone"`
+	StartDate       time.Time                  `json:"start_date"`
+	EndDate         *time.Time                 `json:"end_date,omitempty"`
+	MaxOccurrences  *int                       `json:"max_occurrences,omitempty"`
+	Occurrences     int                        `json:"occurrences"`
+	NextIssueAt     time.Time                  `json:"next_issue_at"`
+	LastIssuedAt    *time.Time                 `json:"last_issued_at,omitempty"`
+	DaysUntilDue    int                        `json:"days_until_due"`
+	AutoSend        bool                       `json:"auto_send"`
+	Currency        string                     `json:"currency"`
+	CustomerName    string                     `json:"customer_name"`
+	CustomerEmail   string                     `json:"customer_email"`
+	CustomerAddress string                     `json:"customer_address,omitempty"`
+	Notes           string                     `json:"notes,omitempty"`
+	LineItems       []RecurringInvoiceLineItem `json:"line_items,omitempty"`
+	PaymentAssetID  *uuid.UUID                 `json:"payment_asset_id,omitempty"`
+	PaymentAddress  string                     `json:"payment_address,omitempty"`
+	CreatedBy       *uuid.UUID                 `json:"created_by,omitempty"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+}
+
+// RecurringInvoiceLineItem is one line copied onto every generated invoice
+type RecurringInvoiceLineItem struct {
+	ID                 uuid.UUID       `json:"id"`
+	RecurringInvoiceID uuid.UUID       `json:"recurring_invoice_id"`
+	Position           int             `json:"position"`
+	Description        string          `json:"description"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	UnitPrice          money.Money     `json:"unit_price"`
+	DiscountPercent    decimal.Decimal `json:"discount_percent"`
+	TaxRate            decimal.Decimal `json:"tax_rate"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// NextIssueDate returns the first issue date strictly after the given time,
+// ignoring the end date and occurrence limit. Like monthly pay dates, dates
+// past the end of a shorter month move to its last day.
+func (r RecurringInvoice) NextIssueDate(after time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone %q: %w", r.Timezone, err)
+	}
+
+	anchor := r.StartDate.In(loc)
+	after = after.In(loc)
+
+	if after.Before(anchor) {
+		return anchor, nil
+	}
+
+	switch r.Frequency {
+	case InvoiceFrequencyWeekly:
+		return nextByDays(anchor, after, 7), nil
+	case InvoiceFrequencyBiweekly:
+		return nextByDays(anchor, after, 14), nil
+	case InvoiceFrequencyMonthly:
+		return nextByMonths(anchor, after, 1), nil
+	case InvoiceFrequencyQuarterly:
+		return nextByMonths(anchor, after, 3), nil
+	case InvoiceFrequencyYearly:
+		return nextByMonths(anchor, after, 12), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid invoice frequency %q", r.Frequency)
+}
+
+// Allows reports whether an invoice may be issued on issueDate once issued
+// invoices have already been, given the end date and occurrence limit
+func (r RecurringInvoice) Allows(issueDate time.Time, issued int) bool {
+	if r.MaxOccurrences != nil && issued >= *r.MaxOccurrences {
+		return false
+	}
+	if r.EndDate != nil && issueDate.After(*r.EndDate) {
+		return false
+	}
+	return true
+}
+
+// UpcomingIssueDates lists up to count issue dates still to come, starting at
+// NextIssueAt. A completed template has none.
+func (r RecurringInvoice) UpcomingIssueDates(count int) ([]time.Time, error) {
+	if r.Status == RecurringInvoiceStatusCompleted {
+		return nil, nil
+	}
+
+	dates := make([]time.Time, 0, count)
+	issueDate := r.NextIssueAt
+	for issued := r.Occurrences; len(dates) < count && r.Allows(issueDate, issued); issued++ {
+		dates = append(dates, issueDate)
+
+		next, err := r.NextIssueDate(issueDate)
+		if err != nil {
+			return nil, err
+		}
+		issueDate = next
+	}
+
+	return dates, nil
+}
+
+// InvoiceFor builds the draft invoice the template issues on issueDate, due
+// DaysUntilDue days later. Its amounts still have to be calculated.
+func (r RecurringInvoice) InvoiceFor(issueDate time.Time) Invoice {
+	invoice := Invoice{
+		OrganizationID:     r.OrganizationID,
+		Status:             InvoiceStatusDraft,
+		Currency:           r.Currency,
+		CustomerName:       r.CustomerName,
+		CustomerEmail:      r.CustomerEmail,
+		CustomerAddress:    r.CustomerAddress,
+		IssueDate:          issueDate,
+		DueDate:            issueDate.AddDate(0, 0, r.DaysUntilDue),
+		Notes:              r.Notes,
+		PaymentAssetID:     r.PaymentAssetID,
+		PaymentAddress:     r.PaymentAddress,
+		RecurringInvoiceID: &r.ID,
+		CreatedBy:          r.CreatedBy,
+	}
+
+	for _, item := range r.LineItems {
+		invoice.LineItems = append(invoice.LineItems, InvoiceLineItem{
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice,
+			DiscountPercent: item.DiscountPercent,
+			TaxRate:         item.TaxRate,
+		})
+	}
+
+	return invoice
+}
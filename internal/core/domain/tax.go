@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/shopspring/decimal"
+)
+
+// TaxTreatment is how tax applies to a sale between a seller and a buyer
+type TaxTreatment string
+
+const (
+	// TaxTreatmentStandard charges the seller's standard rate
+	TaxTreatmentStandard TaxTreatment = "standard"
+	// TaxTreatmentReverseCharge charges no tax; the business buyer accounts for it in its own country
+	TaxTreatmentReverseCharge TaxTreatment = "reverse_charge"
+	// TaxTreatmentOutsideScope charges no tax on a sale to a business outside the seller's tax area
+	TaxTreatmentOutsideScope TaxTreatment = "outside_scope"
+	// TaxTreatmentNotRegistered charges no tax because the seller has no tax ID
+	TaxTreatmentNotRegistered TaxTreatment = "not_registered"
+)
+
+// TaxRatePeriod is a country's standard rate, in percent, from a date until
+// the next period starts
+type TaxRatePeriod struct {
+	From     time.Time       `json:"from"`
+	Standard decimal.Decimal `json:"standard"`
+}
+
+// CountryTaxRates is one country's entry in the rate table. Union names the
+// customs and tax union the country belongs to, such as the EU.
+type CountryTaxRates struct {
+	Code    string          `json:"code"`
+	Name    string          `json:"name"`
+	TaxName string          `json:"tax_name"`
+	Union   string          `json:"union,omitempty"`
+	Rates   []TaxRatePeriod `json:"rates"`
+}
+
+// StandardRate returns the standard rate in force at the given time
+func (c CountryTaxRates) StandardRate(at time.Time) (decimal.Decimal, bool) {
+	// Periods are kept in date order, so the last one started is in force
+	for i := len(c.Rates) - 1; i >= 0; i-- {
+		if !c.Rates[i].From.After(at) {
+			return c.Rates[i].Standard, true
+		}
+	}
+	return decimal.Decimal{}, false
+}
+
+// TaxRateTable is a versioned set of per-country tax rates. The version is
+// kept on everything taxed with the table, so a figure can be traced back to
+// the rates that produced it.
+type TaxRateTable struct {
+	Version   string                     `json:"version"`
+	Countries map[string]CountryTaxRates `json:"countries"`
+}
+
+// Lookup finds a country by its ISO 3166-1 alpha-2 code or its name, ignoring case
+func (t TaxRateTable) Lookup(country string) (*CountryTaxRates, bool) {
+	country = strings.TrimSpace(country)
+	if rates, ok := t.Countries[strings.ToUpper(country)]; ok {
+		return &rates, true
+	}
+
+	for _, rates := range t.Countries {
+		if strings.EqualFold(rates.Name, country) {
+			return &rates, true
+		}
+	}
+
+	return nil, false
+}
+
+// TaxParty is the side of a sale tax is worked out for: where it is and the
+// tax ID it is registered under, if any
+type TaxParty struct {
+	Country string `json:"country"`
+	TaxID   string `json:"tax_id,omitempty"`
+}
+
+// TaxDetermination is the tax that applies to a sale: the treatment, the rate
+// in percent, and the note the treatment requires on the document
+type TaxDetermination struct {
+	Treatment    TaxTreatment    `json:"treatment"`
+	Country      string          `json:"country"`
+	TaxName      string          `json:"tax_name"`
+	Rate         decimal.Decimal `json:"rate"`
+	Note         string          `json:"note,omitempty"`
+	RatesVersion string          `json:"rates_version"`
+}
+
+// TaxBreakdownLine is the tax at one rate on a document: what it was charged
+// on and how much
+type TaxBreakdownLine struct {
+	Label   string          `json:"label"`
+	Rate    decimal.Decimal `json:"rate"`
+	Taxable money.Money     `json:"taxable"`
+	Tax     money.Money     `json:"tax"`
+}
+
+// TaxLabel names tax at a rate the way breakdown lines show it, e.g. "VAT 20%"
+// or "VAT reverse charge"
+func TaxLabel(taxName string, treatment TaxTreatment, rate decimal.Decimal) string {
+	if taxName == "" {
+		taxName = "Tax"
+	}
+
+	switch treatment {
+	case TaxTreatmentReverseCharge:
+		return taxName + " reverse charge"
+	case TaxTreatmentOutsideScope:
+		return "Outside the scope of " + taxName
+	case TaxTreatmentNotRegistered:
+		return "No " + taxName + " charged"
+	}
+
+	return fmt.Sprintf("%s %s%%", taxName, rate.String())
+}
+
+// TaxBreakdown groups the invoice's line items by tax rate, lowest rate first
+func (i Invoice) TaxBreakdown() []TaxBreakdownLine {
+	index := make(map[string]int)
+	var lines []TaxBreakdownLine
+
+	for _, item := range i.LineItems {
+		key := item.TaxRate.String()
+		taxable := money.New(item.Amount.Amount().Sub(item.DiscountAmount.Amount()), i.Currency)
+
+		n, ok := index[key]
+		if !ok {
+			index[key] = len(lines)
+			lines = append(lines, TaxBreakdownLine{
+				Label:   TaxLabel(i.TaxName, i.TaxTreatment, item.TaxRate),
+				Rate:    item.TaxRate,
+				Taxable: taxable,
+				Tax:     item.TaxAmount,
+			})
+			continue
+		}
+
+		// Every line is in the invoice currency, so Add cannot fail
+		lines[n].Taxable, _ = lines[n].Taxable.Add(taxable)
+		lines[n].Tax, _ = lines[n].Tax.Add(item.TaxAmount)
+	}
+
+	sort.SliceStable(lines, func(a, b int) bool {
+		return lines[a].Rate.LessThan(lines[b].Rate)
+	})
+
+	return lines
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MaxTimesheetEntries caps the entries on one week's timesheet
+	MaxTimesheetEntries = 50
+	// MaxTimesheetDayHours caps the hours logged against one day
+	MaxTimesheetDayHours = 24
+)
+
+// ContractorContract is hourly work a contractor does for the organization on
+// a project. Approved hours are invoiced to the client at the hourly rate. A
+// contractor has at most one active contract per project.
+type ContractorContract struct {
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	ContractorID   uuid.UUID   `json:"contractor_id"`
+	Email          string      `json:"email"`
+	FirstName      string      `json:"first_name"`
+	LastName       string      `json:"last_name"`
+	ClientID       *uuid.UUID  `json:"client_id,omitempty"`
+	ClientName     string      `json:"client_name,omitempty"`
+	Project        string      `json:"project"`
+	HourlyRate     money.Money `json:"hourly_rate"`
+	Active         bool        `json:"active"`
+	CreatedBy      *uuid.UUID  `json:"created_by,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+// TimesheetStatus represents where a week's timesheet is in its approval
+type TimesheetStatus string
+
+const (
+	TimesheetStatusDraft     TimesheetStatus = "draft"
+	TimesheetStatusSubmitted TimesheetStatus = "submitted"
+	TimesheetStatusApproved  TimesheetStatus = "approved"
+	TimesheetStatusRejected  TimesheetStatus = "rejected"
+	TimesheetStatusInvoiced  TimesheetStatus = "invoiced"
+)
+
+// IsValid reports whether the status is one of the known statuses
+func (s TimesheetStatus) IsValid() bool {
+	switch s {
+	case TimesheetStatusDraft, TimesheetStatusSubmitted, TimesheetStatusApproved,
+		TimesheetStatusRejected, TimesheetStatusInvoiced:
+		return true
+	}
+	return false
+}
+
+// Timesheet is the hours a contractor logged on one contract in one week,
+// which starts on WeekStart, a Monday at midnight UTC. The contractor submits
+// it, someone who manages the organization's finances approves or rejects it,
+// and approved hours are billed once on an invoice.
+type Timesheet struct {
+	ID              uuid.UUID        `json:"id"`
+	OrganizationID  uuid.UUID        `json:"organization_id"`
+	ContractID      uuid.UUID        `json:"contract_id"`
+	ContractorID    uuid.UUID        `json:"contractor_id"`
+	Email           string           `json:"email"`
+	FirstName       string           `json:"first_name"`
+	LastName        string           `json:"last_name"`
+	Project         string           `json:"project"`
+	WeekStart       time.Time        `json:"week_start"`
+	Status          TimesheetStatus  `json:"status"`
+	TotalHours      decimal.Decimal  `json:"total_hours"`
+	Entries         []TimesheetEntry `json:"entries,omitempty"`
+	SubmittedAt     *time.Time       `json:"submitted_at,omitempty"`
+	ReviewedBy      *uuid.UUID       `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time       `json:"reviewed_at,omitempty"`
+	RejectionReason string           `json:"rejection_reason,omitempty"`
+	InvoiceID       *uuid.UUID       `json:"invoice_id,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// TimesheetEntry is time worked on one day of a timesheet's week. WorkDate is
+// midnight UTC on that day.
+type TimesheetEntry struct {
+	ID          uuid.UUID       `json:"id"`
+	WorkDate    time.Time       `json:"work_date"`
+	Hours       decimal.Decimal `json:"hours"`
+	Description string          `json:"description,omitempty"`
+}
+
+// TimesheetFilter narrows the timesheets listed; nil fields match any
+type TimesheetFilter struct {
+	ContractorID *uuid.UUID
+	ContractID   *uuid.UUID
+	Status       *TimesheetStatus
+}
+
+// WeekEnd is the last day of the timesheet's week, the Sunday
+func (t Timesheet) WeekEnd() time.Time {
+	return t.WeekStart.AddDate(0, 0, 6)
+}
+
+// ContractorName is how the contractor is named on invoices: their name, or
+// their email when they have not set one
+func (t Timesheet) ContractorName() string {
+	if name := strings.TrimSpace(t.FirstName + " " + t.LastName); name != "" {
+		return name
+	}
+	return t.Email
+}
+
+// TimesheetWeekStart returns midnight UTC on the Monday of the week date falls in
+func TimesheetWeekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// TransactionStatus represents the lifecycle state of an on-chain transaction
+type TransactionStatus string
+
+const (
+	TransactionStatusCreated   TransactionStatus = "created"
+	TransactionStatusPending   TransactionStatus = "pending"
+	TransactionStatusConfirmed TransactionStatus = "confirmed"
+	TransactionStatusFailed    TransactionStatus = "failed"
+	TransactionStatusNotFound  TransactionStatus = "not_found"
+)
+
+// TransactionDirection tells user initiated transactions apart from indexed incoming payments
+type TransactionDirection string
+
+const (
+	TransactionDirectionOutbound TransactionDirection = "outbound"
+	TransactionDirectionInbound  TransactionDirection = "inbound"
+)
+
+// transactionTransitions lists the statuses each status may move to.
+// confirmed, failed and not_found are final. A created transaction that is
+// never seen by the node goes straight to not_found.
+var transactionTransitions = map[TransactionStatus][]TransactionStatus{
+	TransactionStatusCreated: {TransactionStatusPending, TransactionStatusNotFound},
+	TransactionStatusPending: {TransactionStatusConfirmed, TransactionStatusFailed, TransactionStatusNotFound},
+}
+
+// Transaction is an on-chain transaction initiated by a user, or an ERC-20
+// transfer into one of the user's wallets. Only inbound transfers carry the
+// log index and sender; a user-initiated transaction carries the token,
+// recipient and amount when the user declared the transfer it makes.
+type Transaction struct {
+	ID                 uuid.UUID            `json:"id"`
+	UserID             uuid.UUID            `json:"user_id"`
+	TxHash             string               `json:"tx_hash"`
+	TransactionPinHash string               `json:"-"`
+	Status             TransactionStatus    `json:"status"`
+	Direction          TransactionDirection `json:"direction"`
+	LogIndex           *int                 `json:"log_index,omitempty"`
+	TransferOrdinal    *int                 `json:"-"`
+	TokenAddress       string               `json:"token_address,omitempty"`
+	FromAddress        string               `json:"from_address,omitempty"`
+	ToAddress          string               `json:"to_address,omitempty"`
+	Amount             *big.Int             `json:"amount,omitempty"`
+	BlockNumber        *int64               `json:"block_number,omitempty"`
+	BlockHash          string               `json:"block_hash,omitempty"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+}
+
+// TransactionTransfer is the transfer a user declares when recording a
+// transaction they sent: an amount of a supported asset to a recipient on the chain
+type TransactionTransfer struct {
+	Chain  string      `json:"chain"`
+	Amount money.Money `json:"amount"`
+	To     string      `json:"to"`
+}
+
+// TransactionReceipt is the on-chain outcome of a mined transaction
+type TransactionReceipt struct {
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number"`
+	BlockHash   string `json:"block_hash"`
+	Success     bool   `json:"success"`
+}
+
+// TransactionEvent is emitted when a tracked transaction reaches a final status
+type TransactionEvent struct {
+	Transaction   Transaction `json:"transaction"`
+	Confirmations uint64      `json:"confirmations"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+}
+
+// TransactionFilter narrows down a transaction listing. Zero values are ignored.
+type TransactionFilter struct {
+	Status      TransactionStatus
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// IsValid reports whether the status is a known transaction status
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusCreated, TransactionStatusPending, TransactionStatusConfirmed,
+		TransactionStatusFailed, TransactionStatusNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinal reports whether no further status changes are allowed
+func (s TransactionStatus) IsFinal() bool {
+	return s.IsValid() && len(transactionTransitions[s]) == 0
+}
+
+// CanTransitionTo reports whether a transaction may move from s to next
+func (s TransactionStatus) CanTransitionTo(next TransactionStatus) bool {
+	for _, allowed := range transactionTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
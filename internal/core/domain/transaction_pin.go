@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionPIN is the PIN a user must enter before initiating a payout
+type TransactionPIN struct {
+	UserID         uuid.UUID  `json:"user_id"`
+	PINHash        string     `json:"-"`
+	FailedAttempts int        `json:"failed_attempts"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// IsLocked reports whether PIN verification is refused at the given time
+func (p TransactionPIN) IsLocked(now time.Time) bool {
+	return p.LockedUntil != nil && now.Before(*p.LockedUntil)
+}
+
+// TransactionPINStatus describes whether a user has a PIN and whether it is locked
+type TransactionPINStatus struct {
+	IsSet       bool       `json:"is_set"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// TransferLog is a decoded ERC-20 Transfer event
+type TransferLog struct {
+	TxHash      string   `json:"tx_hash"`
+	LogIndex    uint     `json:"log_index"`
+	BlockNumber uint64   `json:"block_number"`
+	BlockHash   string   `json:"block_hash"`
+	Token       string   `json:"token"`
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Amount      *big.Int `json:"amount"`
+}
+
+// TransferLogFilter selects Transfer events emitted by any of Tokens to any of
+// Recipients within an inclusive block range
+type TransferLogFilter struct {
+	FromBlock  uint64
+	ToBlock    uint64
+	Tokens     []string
+	Recipients []string
+}
+
+// IndexerCheckpoint is the last block a chain indexer has fully processed.
+// BlockHash is kept to detect when that block has been reorged out.
+type IndexerCheckpoint struct {
+	Name        string    `json:"name"`
+	BlockNumber uint64    `json:"block_number"`
+	BlockHash   string    `json:"block_hash"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+)
+
+// BlockchainClient defines the read operations needed against an EVM node
+type BlockchainClient interface {
+	// BlockNumber returns the latest block height
+	BlockNumber(ctx context.Context) (uint64, error)
+	// TransactionReceipt returns the receipt of a mined transaction, or nil if it is not mined
+	TransactionReceipt(ctx context.Context, txHash string) (*domain.TransactionReceipt, error)
+	// TransactionKnown reports whether the node has the transaction, either mined or in the mempool
+	TransactionKnown(ctx context.Context, txHash string) (bool, error)
+	// BlockHash returns the hash of the canonical block at the given height
+	BlockHash(ctx context.Context, number uint64) (string, error)
+	// TransferLogs returns the ERC-20 Transfer events matching the filter, in chain order
+	TransferLogs(ctx context.Context, filter domain.TransferLogFilter) ([]domain.TransferLog, error)
+}
+
+// TransactionEventPublisher delivers transaction lifecycle events to interested parties
+type TransactionEventPublisher interface {
+	PublishTransactionEvent(ctx context.Context, event domain.TransactionEvent) error
+}
+
+// PayrollContractClient submits batch ERC-20 disbursements through the payroll contract
+type PayrollContractClient interface {
+	// EstimateDisbursementGas returns the gas the batch is expected to use, before any safety margin
+	EstimateDisbursementGas(ctx context.Context, batch domain.DisbursementBatch) (uint64, error)
+	// SuggestGasFeeCap returns the most a disbursement sent now would pay per unit of gas, in wei
+	SuggestGasFeeCap(ctx context.Context) (*big.Int, error)
+	// Disburse signs and submits the batch. The returned transaction still has to be tracked until it is confirmed.
+	Disburse(ctx context.Context, batch domain.DisbursementBatch) (*domain.DisbursementResult, error)
+}
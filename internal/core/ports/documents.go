@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+)
+
+// InvoiceRenderer turns an invoice into the document sent to the customer
+type InvoiceRenderer interface {
+	// RenderInvoice renders the invoice as a PDF
+	RenderInvoice(ctx context.Context, document domain.InvoiceDocument) ([]byte, error)
+}
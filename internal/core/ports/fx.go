@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+)
+
+// FXRateProvider fetches current exchange rates from an external source
+type FXRateProvider interface {
+	// Name identifies the provider in stored rates
+	Name() string
+	// GetRates returns the rate from base to each quote currency it knows. Quotes
+	// it has no rate for are left out rather than failing the whole call.
+	GetRates(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error)
+}
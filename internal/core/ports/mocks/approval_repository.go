@@ -0,0 +1,772 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeApprovalRepository struct {
+	CreatePolicyStub        func(context.Context, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	createPolicyMutex       sync.RWMutex
+	createPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ApprovalPolicy
+	}
+	createPolicyReturns struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	createPolicyReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	CreateRequestStub        func(context.Context, domain.ApprovalRequest, []domain.PayRunLineItem) (*domain.ApprovalRequest, error)
+	createRequestMutex       sync.RWMutex
+	createRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ApprovalRequest
+		arg3 []domain.PayRunLineItem
+	}
+	createRequestReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	createRequestReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	ExpireRequestsStub        func(context.Context, time.Time) ([]domain.ApprovalRequest, error)
+	expireRequestsMutex       sync.RWMutex
+	expireRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	expireRequestsReturns struct {
+		result1 []domain.ApprovalRequest
+		result2 error
+	}
+	expireRequestsReturnsOnCall map[int]struct {
+		result1 []domain.ApprovalRequest
+		result2 error
+	}
+	GetPolicyStub        func(context.Context, uuid.UUID) (*domain.ApprovalPolicy, error)
+	getPolicyMutex       sync.RWMutex
+	getPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPolicyReturns struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	getPolicyReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	GetRequestStub        func(context.Context, uuid.UUID) (*domain.ApprovalRequest, error)
+	getRequestMutex       sync.RWMutex
+	getRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getRequestReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	getRequestReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	ListPoliciesStub        func(context.Context, uuid.UUID) ([]domain.ApprovalPolicy, error)
+	listPoliciesMutex       sync.RWMutex
+	listPoliciesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPoliciesReturns struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}
+	listPoliciesReturnsOnCall map[int]struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}
+	ListRequestsStub        func(context.Context, uuid.UUID, *domain.ApprovalStatus, int, int) ([]domain.ApprovalRequest, int64, error)
+	listRequestsMutex       sync.RWMutex
+	listRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *domain.ApprovalStatus
+		arg4 int
+		arg5 int
+	}
+	listRequestsReturns struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}
+	listRequestsReturnsOnCall map[int]struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}
+	RecordDecisionStub        func(context.Context, domain.ApprovalDecision, time.Time) (*domain.ApprovalRequest, error)
+	recordDecisionMutex       sync.RWMutex
+	recordDecisionArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ApprovalDecision
+		arg3 time.Time
+	}
+	recordDecisionReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	recordDecisionReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	UpdatePolicyStub        func(context.Context, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	updatePolicyMutex       sync.RWMutex
+	updatePolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ApprovalPolicy
+	}
+	updatePolicyReturns struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	updatePolicyReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApprovalRepository) CreatePolicy(arg1 context.Context, arg2 domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	fake.createPolicyMutex.Lock()
+	ret, specificReturn := fake.createPolicyReturnsOnCall[len(fake.createPolicyArgsForCall)]
+	fake.createPolicyArgsForCall = append(fake.createPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ApprovalPolicy
+	}{arg1, arg2})
+	stub := fake.CreatePolicyStub
+	fakeReturns := fake.createPolicyReturns
+	fake.recordInvocation("CreatePolicy", []interface{}{arg1, arg2})
+	fake.createPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) CreatePolicyCallCount() int {
+	fake.createPolicyMutex.RLock()
+	defer fake.createPolicyMutex.RUnlock()
+	return len(fake.createPolicyArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) CreatePolicyCalls(stub func(context.Context, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = stub
+}
+
+func (fake *FakeApprovalRepository) CreatePolicyArgsForCall(i int) (context.Context, domain.ApprovalPolicy) {
+	fake.createPolicyMutex.RLock()
+	defer fake.createPolicyMutex.RUnlock()
+	argsForCall := fake.createPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) CreatePolicyReturns(result1 *domain.ApprovalPolicy, result2 error) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = nil
+	fake.createPolicyReturns = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) CreatePolicyReturnsOnCall(i int, result1 *domain.ApprovalPolicy, result2 error) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = nil
+	if fake.createPolicyReturnsOnCall == nil {
+		fake.createPolicyReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.createPolicyReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) CreateRequest(arg1 context.Context, arg2 domain.ApprovalRequest, arg3 []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+	var arg3Copy []domain.PayRunLineItem
+	if arg3 != nil {
+		arg3Copy = make([]domain.PayRunLineItem, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createRequestMutex.Lock()
+	ret, specificReturn := fake.createRequestReturnsOnCall[len(fake.createRequestArgsForCall)]
+	fake.createRequestArgsForCall = append(fake.createRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ApprovalRequest
+		arg3 []domain.PayRunLineItem
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CreateRequestStub
+	fakeReturns := fake.createRequestReturns
+	fake.recordInvocation("CreateRequest", []interface{}{arg1, arg2, arg3Copy})
+	fake.createRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) CreateRequestCallCount() int {
+	fake.createRequestMutex.RLock()
+	defer fake.createRequestMutex.RUnlock()
+	return len(fake.createRequestArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) CreateRequestCalls(stub func(context.Context, domain.ApprovalRequest, []domain.PayRunLineItem) (*domain.ApprovalRequest, error)) {
+	fake.createRequestMutex.Lock()
+	defer fake.createRequestMutex.Unlock()
+	fake.CreateRequestStub = stub
+}
+
+func (fake *FakeApprovalRepository) CreateRequestArgsForCall(i int) (context.Context, domain.ApprovalRequest, []domain.PayRunLineItem) {
+	fake.createRequestMutex.RLock()
+	defer fake.createRequestMutex.RUnlock()
+	argsForCall := fake.createRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeApprovalRepository) CreateRequestReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.createRequestMutex.Lock()
+	defer fake.createRequestMutex.Unlock()
+	fake.CreateRequestStub = nil
+	fake.createRequestReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) CreateRequestReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.createRequestMutex.Lock()
+	defer fake.createRequestMutex.Unlock()
+	fake.CreateRequestStub = nil
+	if fake.createRequestReturnsOnCall == nil {
+		fake.createRequestReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.createRequestReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) ExpireRequests(arg1 context.Context, arg2 time.Time) ([]domain.ApprovalRequest, error) {
+	fake.expireRequestsMutex.Lock()
+	ret, specificReturn := fake.expireRequestsReturnsOnCall[len(fake.expireRequestsArgsForCall)]
+	fake.expireRequestsArgsForCall = append(fake.expireRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ExpireRequestsStub
+	fakeReturns := fake.expireRequestsReturns
+	fake.recordInvocation("ExpireRequests", []interface{}{arg1, arg2})
+	fake.expireRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) ExpireRequestsCallCount() int {
+	fake.expireRequestsMutex.RLock()
+	defer fake.expireRequestsMutex.RUnlock()
+	return len(fake.expireRequestsArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) ExpireRequestsCalls(stub func(context.Context, time.Time) ([]domain.ApprovalRequest, error)) {
+	fake.expireRequestsMutex.Lock()
+	defer fake.expireRequestsMutex.Unlock()
+	fake.ExpireRequestsStub = stub
+}
+
+func (fake *FakeApprovalRepository) ExpireRequestsArgsForCall(i int) (context.Context, time.Time) {
+	fake.expireRequestsMutex.RLock()
+	defer fake.expireRequestsMutex.RUnlock()
+	argsForCall := fake.expireRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) ExpireRequestsReturns(result1 []domain.ApprovalRequest, result2 error) {
+	fake.expireRequestsMutex.Lock()
+	defer fake.expireRequestsMutex.Unlock()
+	fake.ExpireRequestsStub = nil
+	fake.expireRequestsReturns = struct {
+		result1 []domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) ExpireRequestsReturnsOnCall(i int, result1 []domain.ApprovalRequest, result2 error) {
+	fake.expireRequestsMutex.Lock()
+	defer fake.expireRequestsMutex.Unlock()
+	fake.ExpireRequestsStub = nil
+	if fake.expireRequestsReturnsOnCall == nil {
+		fake.expireRequestsReturnsOnCall = make(map[int]struct {
+			result1 []domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.expireRequestsReturnsOnCall[i] = struct {
+		result1 []domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) GetPolicy(arg1 context.Context, arg2 uuid.UUID) (*domain.ApprovalPolicy, error) {
+	fake.getPolicyMutex.Lock()
+	ret, specificReturn := fake.getPolicyReturnsOnCall[len(fake.getPolicyArgsForCall)]
+	fake.getPolicyArgsForCall = append(fake.getPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPolicyStub
+	fakeReturns := fake.getPolicyReturns
+	fake.recordInvocation("GetPolicy", []interface{}{arg1, arg2})
+	fake.getPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) GetPolicyCallCount() int {
+	fake.getPolicyMutex.RLock()
+	defer fake.getPolicyMutex.RUnlock()
+	return len(fake.getPolicyArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) GetPolicyCalls(stub func(context.Context, uuid.UUID) (*domain.ApprovalPolicy, error)) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = stub
+}
+
+func (fake *FakeApprovalRepository) GetPolicyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPolicyMutex.RLock()
+	defer fake.getPolicyMutex.RUnlock()
+	argsForCall := fake.getPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) GetPolicyReturns(result1 *domain.ApprovalPolicy, result2 error) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = nil
+	fake.getPolicyReturns = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) GetPolicyReturnsOnCall(i int, result1 *domain.ApprovalPolicy, result2 error) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = nil
+	if fake.getPolicyReturnsOnCall == nil {
+		fake.getPolicyReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.getPolicyReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) GetRequest(arg1 context.Context, arg2 uuid.UUID) (*domain.ApprovalRequest, error) {
+	fake.getRequestMutex.Lock()
+	ret, specificReturn := fake.getRequestReturnsOnCall[len(fake.getRequestArgsForCall)]
+	fake.getRequestArgsForCall = append(fake.getRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetRequestStub
+	fakeReturns := fake.getRequestReturns
+	fake.recordInvocation("GetRequest", []interface{}{arg1, arg2})
+	fake.getRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) GetRequestCallCount() int {
+	fake.getRequestMutex.RLock()
+	defer fake.getRequestMutex.RUnlock()
+	return len(fake.getRequestArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) GetRequestCalls(stub func(context.Context, uuid.UUID) (*domain.ApprovalRequest, error)) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = stub
+}
+
+func (fake *FakeApprovalRepository) GetRequestArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getRequestMutex.RLock()
+	defer fake.getRequestMutex.RUnlock()
+	argsForCall := fake.getRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) GetRequestReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = nil
+	fake.getRequestReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) GetRequestReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = nil
+	if fake.getRequestReturnsOnCall == nil {
+		fake.getRequestReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.getRequestReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) ListPolicies(arg1 context.Context, arg2 uuid.UUID) ([]domain.ApprovalPolicy, error) {
+	fake.listPoliciesMutex.Lock()
+	ret, specificReturn := fake.listPoliciesReturnsOnCall[len(fake.listPoliciesArgsForCall)]
+	fake.listPoliciesArgsForCall = append(fake.listPoliciesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPoliciesStub
+	fakeReturns := fake.listPoliciesReturns
+	fake.recordInvocation("ListPolicies", []interface{}{arg1, arg2})
+	fake.listPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) ListPoliciesCallCount() int {
+	fake.listPoliciesMutex.RLock()
+	defer fake.listPoliciesMutex.RUnlock()
+	return len(fake.listPoliciesArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) ListPoliciesCalls(stub func(context.Context, uuid.UUID) ([]domain.ApprovalPolicy, error)) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = stub
+}
+
+func (fake *FakeApprovalRepository) ListPoliciesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPoliciesMutex.RLock()
+	defer fake.listPoliciesMutex.RUnlock()
+	argsForCall := fake.listPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) ListPoliciesReturns(result1 []domain.ApprovalPolicy, result2 error) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = nil
+	fake.listPoliciesReturns = struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) ListPoliciesReturnsOnCall(i int, result1 []domain.ApprovalPolicy, result2 error) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = nil
+	if fake.listPoliciesReturnsOnCall == nil {
+		fake.listPoliciesReturnsOnCall = make(map[int]struct {
+			result1 []domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.listPoliciesReturnsOnCall[i] = struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) ListRequests(arg1 context.Context, arg2 uuid.UUID, arg3 *domain.ApprovalStatus, arg4 int, arg5 int) ([]domain.ApprovalRequest, int64, error) {
+	fake.listRequestsMutex.Lock()
+	ret, specificReturn := fake.listRequestsReturnsOnCall[len(fake.listRequestsArgsForCall)]
+	fake.listRequestsArgsForCall = append(fake.listRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *domain.ApprovalStatus
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListRequestsStub
+	fakeReturns := fake.listRequestsReturns
+	fake.recordInvocation("ListRequests", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeApprovalRepository) ListRequestsCallCount() int {
+	fake.listRequestsMutex.RLock()
+	defer fake.listRequestsMutex.RUnlock()
+	return len(fake.listRequestsArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) ListRequestsCalls(stub func(context.Context, uuid.UUID, *domain.ApprovalStatus, int, int) ([]domain.ApprovalRequest, int64, error)) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = stub
+}
+
+func (fake *FakeApprovalRepository) ListRequestsArgsForCall(i int) (context.Context, uuid.UUID, *domain.ApprovalStatus, int, int) {
+	fake.listRequestsMutex.RLock()
+	defer fake.listRequestsMutex.RUnlock()
+	argsForCall := fake.listRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeApprovalRepository) ListRequestsReturns(result1 []domain.ApprovalRequest, result2 int64, result3 error) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = nil
+	fake.listRequestsReturns = struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalRepository) ListRequestsReturnsOnCall(i int, result1 []domain.ApprovalRequest, result2 int64, result3 error) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = nil
+	if fake.listRequestsReturnsOnCall == nil {
+		fake.listRequestsReturnsOnCall = make(map[int]struct {
+			result1 []domain.ApprovalRequest
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listRequestsReturnsOnCall[i] = struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalRepository) RecordDecision(arg1 context.Context, arg2 domain.ApprovalDecision, arg3 time.Time) (*domain.ApprovalRequest, error) {
+	fake.recordDecisionMutex.Lock()
+	ret, specificReturn := fake.recordDecisionReturnsOnCall[len(fake.recordDecisionArgsForCall)]
+	fake.recordDecisionArgsForCall = append(fake.recordDecisionArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ApprovalDecision
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RecordDecisionStub
+	fakeReturns := fake.recordDecisionReturns
+	fake.recordInvocation("RecordDecision", []interface{}{arg1, arg2, arg3})
+	fake.recordDecisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) RecordDecisionCallCount() int {
+	fake.recordDecisionMutex.RLock()
+	defer fake.recordDecisionMutex.RUnlock()
+	return len(fake.recordDecisionArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) RecordDecisionCalls(stub func(context.Context, domain.ApprovalDecision, time.Time) (*domain.ApprovalRequest, error)) {
+	fake.recordDecisionMutex.Lock()
+	defer fake.recordDecisionMutex.Unlock()
+	fake.RecordDecisionStub = stub
+}
+
+func (fake *FakeApprovalRepository) RecordDecisionArgsForCall(i int) (context.Context, domain.ApprovalDecision, time.Time) {
+	fake.recordDecisionMutex.RLock()
+	defer fake.recordDecisionMutex.RUnlock()
+	argsForCall := fake.recordDecisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeApprovalRepository) RecordDecisionReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.recordDecisionMutex.Lock()
+	defer fake.recordDecisionMutex.Unlock()
+	fake.RecordDecisionStub = nil
+	fake.recordDecisionReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) RecordDecisionReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.recordDecisionMutex.Lock()
+	defer fake.recordDecisionMutex.Unlock()
+	fake.RecordDecisionStub = nil
+	if fake.recordDecisionReturnsOnCall == nil {
+		fake.recordDecisionReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.recordDecisionReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicy(arg1 context.Context, arg2 domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	fake.updatePolicyMutex.Lock()
+	ret, specificReturn := fake.updatePolicyReturnsOnCall[len(fake.updatePolicyArgsForCall)]
+	fake.updatePolicyArgsForCall = append(fake.updatePolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ApprovalPolicy
+	}{arg1, arg2})
+	stub := fake.UpdatePolicyStub
+	fakeReturns := fake.updatePolicyReturns
+	fake.recordInvocation("UpdatePolicy", []interface{}{arg1, arg2})
+	fake.updatePolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicyCallCount() int {
+	fake.updatePolicyMutex.RLock()
+	defer fake.updatePolicyMutex.RUnlock()
+	return len(fake.updatePolicyArgsForCall)
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicyCalls(stub func(context.Context, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = stub
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicyArgsForCall(i int) (context.Context, domain.ApprovalPolicy) {
+	fake.updatePolicyMutex.RLock()
+	defer fake.updatePolicyMutex.RUnlock()
+	argsForCall := fake.updatePolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicyReturns(result1 *domain.ApprovalPolicy, result2 error) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = nil
+	fake.updatePolicyReturns = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) UpdatePolicyReturnsOnCall(i int, result1 *domain.ApprovalPolicy, result2 error) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = nil
+	if fake.updatePolicyReturnsOnCall == nil {
+		fake.updatePolicyReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.updatePolicyReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeApprovalRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.ApprovalRepository = new(FakeApprovalRepository)
@@ -0,0 +1,806 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeApprovalService struct {
+	ApproveStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.ApprovalRequest, error)
+	approveMutex       sync.RWMutex
+	approveArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	approveReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	approveReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	CreatePolicyStub        func(context.Context, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	createPolicyMutex       sync.RWMutex
+	createPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.ApprovalPolicy
+	}
+	createPolicyReturns struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	createPolicyReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	ExpireStaleApprovalsStub        func(context.Context) (int, error)
+	expireStaleApprovalsMutex       sync.RWMutex
+	expireStaleApprovalsArgsForCall []struct {
+		arg1 context.Context
+	}
+	expireStaleApprovalsReturns struct {
+		result1 int
+		result2 error
+	}
+	expireStaleApprovalsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	GetRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.ApprovalRequest, error)
+	getRequestMutex       sync.RWMutex
+	getRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getRequestReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	getRequestReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	ListPoliciesStub        func(context.Context, uuid.UUID, uuid.UUID) ([]domain.ApprovalPolicy, error)
+	listPoliciesMutex       sync.RWMutex
+	listPoliciesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listPoliciesReturns struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}
+	listPoliciesReturnsOnCall map[int]struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}
+	ListRequestsStub        func(context.Context, uuid.UUID, uuid.UUID, *domain.ApprovalStatus, int, int) ([]domain.ApprovalRequest, int64, error)
+	listRequestsMutex       sync.RWMutex
+	listRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *domain.ApprovalStatus
+		arg5 int
+		arg6 int
+	}
+	listRequestsReturns struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}
+	listRequestsReturnsOnCall map[int]struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}
+	RejectStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.ApprovalRequest, error)
+	rejectMutex       sync.RWMutex
+	rejectArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	rejectReturns struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	rejectReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}
+	SubmitPayRunStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, []uuid.UUID) (*domain.PayRun, *domain.ApprovalRequest, error)
+	submitPayRunMutex       sync.RWMutex
+	submitPayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 []uuid.UUID
+	}
+	submitPayRunReturns struct {
+		result1 *domain.PayRun
+		result2 *domain.ApprovalRequest
+		result3 error
+	}
+	submitPayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRun
+		result2 *domain.ApprovalRequest
+		result3 error
+	}
+	UpdatePolicyStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	updatePolicyMutex       sync.RWMutex
+	updatePolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.ApprovalPolicy
+	}
+	updatePolicyReturns struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	updatePolicyReturnsOnCall map[int]struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApprovalService) Approve(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) (*domain.ApprovalRequest, error) {
+	fake.approveMutex.Lock()
+	ret, specificReturn := fake.approveReturnsOnCall[len(fake.approveArgsForCall)]
+	fake.approveArgsForCall = append(fake.approveArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ApproveStub
+	fakeReturns := fake.approveReturns
+	fake.recordInvocation("Approve", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.approveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) ApproveCallCount() int {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	return len(fake.approveArgsForCall)
+}
+
+func (fake *FakeApprovalService) ApproveCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.ApprovalRequest, error)) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = stub
+}
+
+func (fake *FakeApprovalService) ApproveArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	argsForCall := fake.approveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeApprovalService) ApproveReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	fake.approveReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ApproveReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	if fake.approveReturnsOnCall == nil {
+		fake.approveReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.approveReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) CreatePolicy(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	fake.createPolicyMutex.Lock()
+	ret, specificReturn := fake.createPolicyReturnsOnCall[len(fake.createPolicyArgsForCall)]
+	fake.createPolicyArgsForCall = append(fake.createPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.ApprovalPolicy
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreatePolicyStub
+	fakeReturns := fake.createPolicyReturns
+	fake.recordInvocation("CreatePolicy", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) CreatePolicyCallCount() int {
+	fake.createPolicyMutex.RLock()
+	defer fake.createPolicyMutex.RUnlock()
+	return len(fake.createPolicyArgsForCall)
+}
+
+func (fake *FakeApprovalService) CreatePolicyCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = stub
+}
+
+func (fake *FakeApprovalService) CreatePolicyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) {
+	fake.createPolicyMutex.RLock()
+	defer fake.createPolicyMutex.RUnlock()
+	argsForCall := fake.createPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeApprovalService) CreatePolicyReturns(result1 *domain.ApprovalPolicy, result2 error) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = nil
+	fake.createPolicyReturns = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) CreatePolicyReturnsOnCall(i int, result1 *domain.ApprovalPolicy, result2 error) {
+	fake.createPolicyMutex.Lock()
+	defer fake.createPolicyMutex.Unlock()
+	fake.CreatePolicyStub = nil
+	if fake.createPolicyReturnsOnCall == nil {
+		fake.createPolicyReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.createPolicyReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovals(arg1 context.Context) (int, error) {
+	fake.expireStaleApprovalsMutex.Lock()
+	ret, specificReturn := fake.expireStaleApprovalsReturnsOnCall[len(fake.expireStaleApprovalsArgsForCall)]
+	fake.expireStaleApprovalsArgsForCall = append(fake.expireStaleApprovalsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExpireStaleApprovalsStub
+	fakeReturns := fake.expireStaleApprovalsReturns
+	fake.recordInvocation("ExpireStaleApprovals", []interface{}{arg1})
+	fake.expireStaleApprovalsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovalsCallCount() int {
+	fake.expireStaleApprovalsMutex.RLock()
+	defer fake.expireStaleApprovalsMutex.RUnlock()
+	return len(fake.expireStaleApprovalsArgsForCall)
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovalsCalls(stub func(context.Context) (int, error)) {
+	fake.expireStaleApprovalsMutex.Lock()
+	defer fake.expireStaleApprovalsMutex.Unlock()
+	fake.ExpireStaleApprovalsStub = stub
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovalsArgsForCall(i int) context.Context {
+	fake.expireStaleApprovalsMutex.RLock()
+	defer fake.expireStaleApprovalsMutex.RUnlock()
+	argsForCall := fake.expireStaleApprovalsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovalsReturns(result1 int, result2 error) {
+	fake.expireStaleApprovalsMutex.Lock()
+	defer fake.expireStaleApprovalsMutex.Unlock()
+	fake.ExpireStaleApprovalsStub = nil
+	fake.expireStaleApprovalsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ExpireStaleApprovalsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.expireStaleApprovalsMutex.Lock()
+	defer fake.expireStaleApprovalsMutex.Unlock()
+	fake.ExpireStaleApprovalsStub = nil
+	if fake.expireStaleApprovalsReturnsOnCall == nil {
+		fake.expireStaleApprovalsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.expireStaleApprovalsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) GetRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.ApprovalRequest, error) {
+	fake.getRequestMutex.Lock()
+	ret, specificReturn := fake.getRequestReturnsOnCall[len(fake.getRequestArgsForCall)]
+	fake.getRequestArgsForCall = append(fake.getRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetRequestStub
+	fakeReturns := fake.getRequestReturns
+	fake.recordInvocation("GetRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) GetRequestCallCount() int {
+	fake.getRequestMutex.RLock()
+	defer fake.getRequestMutex.RUnlock()
+	return len(fake.getRequestArgsForCall)
+}
+
+func (fake *FakeApprovalService) GetRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.ApprovalRequest, error)) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = stub
+}
+
+func (fake *FakeApprovalService) GetRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getRequestMutex.RLock()
+	defer fake.getRequestMutex.RUnlock()
+	argsForCall := fake.getRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeApprovalService) GetRequestReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = nil
+	fake.getRequestReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) GetRequestReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.getRequestMutex.Lock()
+	defer fake.getRequestMutex.Unlock()
+	fake.GetRequestStub = nil
+	if fake.getRequestReturnsOnCall == nil {
+		fake.getRequestReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.getRequestReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ListPolicies(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]domain.ApprovalPolicy, error) {
+	fake.listPoliciesMutex.Lock()
+	ret, specificReturn := fake.listPoliciesReturnsOnCall[len(fake.listPoliciesArgsForCall)]
+	fake.listPoliciesArgsForCall = append(fake.listPoliciesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListPoliciesStub
+	fakeReturns := fake.listPoliciesReturns
+	fake.recordInvocation("ListPolicies", []interface{}{arg1, arg2, arg3})
+	fake.listPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) ListPoliciesCallCount() int {
+	fake.listPoliciesMutex.RLock()
+	defer fake.listPoliciesMutex.RUnlock()
+	return len(fake.listPoliciesArgsForCall)
+}
+
+func (fake *FakeApprovalService) ListPoliciesCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]domain.ApprovalPolicy, error)) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = stub
+}
+
+func (fake *FakeApprovalService) ListPoliciesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listPoliciesMutex.RLock()
+	defer fake.listPoliciesMutex.RUnlock()
+	argsForCall := fake.listPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeApprovalService) ListPoliciesReturns(result1 []domain.ApprovalPolicy, result2 error) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = nil
+	fake.listPoliciesReturns = struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ListPoliciesReturnsOnCall(i int, result1 []domain.ApprovalPolicy, result2 error) {
+	fake.listPoliciesMutex.Lock()
+	defer fake.listPoliciesMutex.Unlock()
+	fake.ListPoliciesStub = nil
+	if fake.listPoliciesReturnsOnCall == nil {
+		fake.listPoliciesReturnsOnCall = make(map[int]struct {
+			result1 []domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.listPoliciesReturnsOnCall[i] = struct {
+		result1 []domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) ListRequests(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 *domain.ApprovalStatus, arg5 int, arg6 int) ([]domain.ApprovalRequest, int64, error) {
+	fake.listRequestsMutex.Lock()
+	ret, specificReturn := fake.listRequestsReturnsOnCall[len(fake.listRequestsArgsForCall)]
+	fake.listRequestsArgsForCall = append(fake.listRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *domain.ApprovalStatus
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListRequestsStub
+	fakeReturns := fake.listRequestsReturns
+	fake.recordInvocation("ListRequests", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeApprovalService) ListRequestsCallCount() int {
+	fake.listRequestsMutex.RLock()
+	defer fake.listRequestsMutex.RUnlock()
+	return len(fake.listRequestsArgsForCall)
+}
+
+func (fake *FakeApprovalService) ListRequestsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, *domain.ApprovalStatus, int, int) ([]domain.ApprovalRequest, int64, error)) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = stub
+}
+
+func (fake *FakeApprovalService) ListRequestsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, *domain.ApprovalStatus, int, int) {
+	fake.listRequestsMutex.RLock()
+	defer fake.listRequestsMutex.RUnlock()
+	argsForCall := fake.listRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeApprovalService) ListRequestsReturns(result1 []domain.ApprovalRequest, result2 int64, result3 error) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = nil
+	fake.listRequestsReturns = struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalService) ListRequestsReturnsOnCall(i int, result1 []domain.ApprovalRequest, result2 int64, result3 error) {
+	fake.listRequestsMutex.Lock()
+	defer fake.listRequestsMutex.Unlock()
+	fake.ListRequestsStub = nil
+	if fake.listRequestsReturnsOnCall == nil {
+		fake.listRequestsReturnsOnCall = make(map[int]struct {
+			result1 []domain.ApprovalRequest
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listRequestsReturnsOnCall[i] = struct {
+		result1 []domain.ApprovalRequest
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalService) Reject(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) (*domain.ApprovalRequest, error) {
+	fake.rejectMutex.Lock()
+	ret, specificReturn := fake.rejectReturnsOnCall[len(fake.rejectArgsForCall)]
+	fake.rejectArgsForCall = append(fake.rejectArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RejectStub
+	fakeReturns := fake.rejectReturns
+	fake.recordInvocation("Reject", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.rejectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) RejectCallCount() int {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	return len(fake.rejectArgsForCall)
+}
+
+func (fake *FakeApprovalService) RejectCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.ApprovalRequest, error)) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = stub
+}
+
+func (fake *FakeApprovalService) RejectArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	argsForCall := fake.rejectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeApprovalService) RejectReturns(result1 *domain.ApprovalRequest, result2 error) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = nil
+	fake.rejectReturns = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) RejectReturnsOnCall(i int, result1 *domain.ApprovalRequest, result2 error) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = nil
+	if fake.rejectReturnsOnCall == nil {
+		fake.rejectReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalRequest
+			result2 error
+		})
+	}
+	fake.rejectReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) SubmitPayRun(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 []uuid.UUID) (*domain.PayRun, *domain.ApprovalRequest, error) {
+	var arg5Copy []uuid.UUID
+	if arg5 != nil {
+		arg5Copy = make([]uuid.UUID, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.submitPayRunMutex.Lock()
+	ret, specificReturn := fake.submitPayRunReturnsOnCall[len(fake.submitPayRunArgsForCall)]
+	fake.submitPayRunArgsForCall = append(fake.submitPayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 []uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5Copy})
+	stub := fake.SubmitPayRunStub
+	fakeReturns := fake.submitPayRunReturns
+	fake.recordInvocation("SubmitPayRun", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+	fake.submitPayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeApprovalService) SubmitPayRunCallCount() int {
+	fake.submitPayRunMutex.RLock()
+	defer fake.submitPayRunMutex.RUnlock()
+	return len(fake.submitPayRunArgsForCall)
+}
+
+func (fake *FakeApprovalService) SubmitPayRunCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, []uuid.UUID) (*domain.PayRun, *domain.ApprovalRequest, error)) {
+	fake.submitPayRunMutex.Lock()
+	defer fake.submitPayRunMutex.Unlock()
+	fake.SubmitPayRunStub = stub
+}
+
+func (fake *FakeApprovalService) SubmitPayRunArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, []uuid.UUID) {
+	fake.submitPayRunMutex.RLock()
+	defer fake.submitPayRunMutex.RUnlock()
+	argsForCall := fake.submitPayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeApprovalService) SubmitPayRunReturns(result1 *domain.PayRun, result2 *domain.ApprovalRequest, result3 error) {
+	fake.submitPayRunMutex.Lock()
+	defer fake.submitPayRunMutex.Unlock()
+	fake.SubmitPayRunStub = nil
+	fake.submitPayRunReturns = struct {
+		result1 *domain.PayRun
+		result2 *domain.ApprovalRequest
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalService) SubmitPayRunReturnsOnCall(i int, result1 *domain.PayRun, result2 *domain.ApprovalRequest, result3 error) {
+	fake.submitPayRunMutex.Lock()
+	defer fake.submitPayRunMutex.Unlock()
+	fake.SubmitPayRunStub = nil
+	if fake.submitPayRunReturnsOnCall == nil {
+		fake.submitPayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRun
+			result2 *domain.ApprovalRequest
+			result3 error
+		})
+	}
+	fake.submitPayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRun
+		result2 *domain.ApprovalRequest
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApprovalService) UpdatePolicy(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	fake.updatePolicyMutex.Lock()
+	ret, specificReturn := fake.updatePolicyReturnsOnCall[len(fake.updatePolicyArgsForCall)]
+	fake.updatePolicyArgsForCall = append(fake.updatePolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.ApprovalPolicy
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdatePolicyStub
+	fakeReturns := fake.updatePolicyReturns
+	fake.recordInvocation("UpdatePolicy", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updatePolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeApprovalService) UpdatePolicyCallCount() int {
+	fake.updatePolicyMutex.RLock()
+	defer fake.updatePolicyMutex.RUnlock()
+	return len(fake.updatePolicyArgsForCall)
+}
+
+func (fake *FakeApprovalService) UpdatePolicyCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = stub
+}
+
+func (fake *FakeApprovalService) UpdatePolicyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ApprovalPolicy) {
+	fake.updatePolicyMutex.RLock()
+	defer fake.updatePolicyMutex.RUnlock()
+	argsForCall := fake.updatePolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeApprovalService) UpdatePolicyReturns(result1 *domain.ApprovalPolicy, result2 error) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = nil
+	fake.updatePolicyReturns = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) UpdatePolicyReturnsOnCall(i int, result1 *domain.ApprovalPolicy, result2 error) {
+	fake.updatePolicyMutex.Lock()
+	defer fake.updatePolicyMutex.Unlock()
+	fake.UpdatePolicyStub = nil
+	if fake.updatePolicyReturnsOnCall == nil {
+		fake.updatePolicyReturnsOnCall = make(map[int]struct {
+			result1 *domain.ApprovalPolicy
+			result2 error
+		})
+	}
+	fake.updatePolicyReturnsOnCall[i] = struct {
+		result1 *domain.ApprovalPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApprovalService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeApprovalService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.ApprovalService = new(FakeApprovalService)
@@ -0,0 +1,526 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+type FakeAssetService struct {
+	CreateAssetStub        func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)
+	createAssetMutex       sync.RWMutex
+	createAssetArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}
+	createAssetReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	createAssetReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	GetAssetStub        func(context.Context, uuid.UUID) (*domain.SupportedAsset, error)
+	getAssetMutex       sync.RWMutex
+	getAssetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getAssetReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	getAssetReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	ListAssetsStub        func(context.Context, domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)
+	listAssetsMutex       sync.RWMutex
+	listAssetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SupportedAssetFilter
+	}
+	listAssetsReturns struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}
+	listAssetsReturnsOnCall map[int]struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}
+	UpdateAssetStub        func(context.Context, uuid.UUID, *string, *bool) (*domain.SupportedAsset, error)
+	updateAssetMutex       sync.RWMutex
+	updateAssetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *string
+		arg4 *bool
+	}
+	updateAssetReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	updateAssetReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	ValidateAmountStub        func(context.Context, string, money.Money) (*domain.SupportedAsset, error)
+	validateAmountMutex       sync.RWMutex
+	validateAmountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 money.Money
+	}
+	validateAmountReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	validateAmountReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	ValidateBaseUnitAmountStub        func(context.Context, string, string, *big.Int) (*domain.SupportedAsset, error)
+	validateBaseUnitAmountMutex       sync.RWMutex
+	validateBaseUnitAmountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *big.Int
+	}
+	validateBaseUnitAmountReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	validateBaseUnitAmountReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAssetService) CreateAsset(arg1 context.Context, arg2 domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	fake.createAssetMutex.Lock()
+	ret, specificReturn := fake.createAssetReturnsOnCall[len(fake.createAssetArgsForCall)]
+	fake.createAssetArgsForCall = append(fake.createAssetArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}{arg1, arg2})
+	stub := fake.CreateAssetStub
+	fakeReturns := fake.createAssetReturns
+	fake.recordInvocation("CreateAsset", []interface{}{arg1, arg2})
+	fake.createAssetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) CreateAssetCallCount() int {
+	fake.createAssetMutex.RLock()
+	defer fake.createAssetMutex.RUnlock()
+	return len(fake.createAssetArgsForCall)
+}
+
+func (fake *FakeAssetService) CreateAssetCalls(stub func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = stub
+}
+
+func (fake *FakeAssetService) CreateAssetArgsForCall(i int) (context.Context, domain.SupportedAsset) {
+	fake.createAssetMutex.RLock()
+	defer fake.createAssetMutex.RUnlock()
+	argsForCall := fake.createAssetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAssetService) CreateAssetReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = nil
+	fake.createAssetReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) CreateAssetReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = nil
+	if fake.createAssetReturnsOnCall == nil {
+		fake.createAssetReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.createAssetReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) GetAsset(arg1 context.Context, arg2 uuid.UUID) (*domain.SupportedAsset, error) {
+	fake.getAssetMutex.Lock()
+	ret, specificReturn := fake.getAssetReturnsOnCall[len(fake.getAssetArgsForCall)]
+	fake.getAssetArgsForCall = append(fake.getAssetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetAssetStub
+	fakeReturns := fake.getAssetReturns
+	fake.recordInvocation("GetAsset", []interface{}{arg1, arg2})
+	fake.getAssetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) GetAssetCallCount() int {
+	fake.getAssetMutex.RLock()
+	defer fake.getAssetMutex.RUnlock()
+	return len(fake.getAssetArgsForCall)
+}
+
+func (fake *FakeAssetService) GetAssetCalls(stub func(context.Context, uuid.UUID) (*domain.SupportedAsset, error)) {
+	fake.getAssetMutex.Lock()
+	defer fake.getAssetMutex.Unlock()
+	fake.GetAssetStub = stub
+}
+
+func (fake *FakeAssetService) GetAssetArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getAssetMutex.RLock()
+	defer fake.getAssetMutex.RUnlock()
+	argsForCall := fake.getAssetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAssetService) GetAssetReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetMutex.Lock()
+	defer fake.getAssetMutex.Unlock()
+	fake.GetAssetStub = nil
+	fake.getAssetReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) GetAssetReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetMutex.Lock()
+	defer fake.getAssetMutex.Unlock()
+	fake.GetAssetStub = nil
+	if fake.getAssetReturnsOnCall == nil {
+		fake.getAssetReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.getAssetReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ListAssets(arg1 context.Context, arg2 domain.SupportedAssetFilter) ([]domain.SupportedAsset, error) {
+	fake.listAssetsMutex.Lock()
+	ret, specificReturn := fake.listAssetsReturnsOnCall[len(fake.listAssetsArgsForCall)]
+	fake.listAssetsArgsForCall = append(fake.listAssetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SupportedAssetFilter
+	}{arg1, arg2})
+	stub := fake.ListAssetsStub
+	fakeReturns := fake.listAssetsReturns
+	fake.recordInvocation("ListAssets", []interface{}{arg1, arg2})
+	fake.listAssetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) ListAssetsCallCount() int {
+	fake.listAssetsMutex.RLock()
+	defer fake.listAssetsMutex.RUnlock()
+	return len(fake.listAssetsArgsForCall)
+}
+
+func (fake *FakeAssetService) ListAssetsCalls(stub func(context.Context, domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = stub
+}
+
+func (fake *FakeAssetService) ListAssetsArgsForCall(i int) (context.Context, domain.SupportedAssetFilter) {
+	fake.listAssetsMutex.RLock()
+	defer fake.listAssetsMutex.RUnlock()
+	argsForCall := fake.listAssetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAssetService) ListAssetsReturns(result1 []domain.SupportedAsset, result2 error) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = nil
+	fake.listAssetsReturns = struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ListAssetsReturnsOnCall(i int, result1 []domain.SupportedAsset, result2 error) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = nil
+	if fake.listAssetsReturnsOnCall == nil {
+		fake.listAssetsReturnsOnCall = make(map[int]struct {
+			result1 []domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.listAssetsReturnsOnCall[i] = struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) UpdateAsset(arg1 context.Context, arg2 uuid.UUID, arg3 *string, arg4 *bool) (*domain.SupportedAsset, error) {
+	fake.updateAssetMutex.Lock()
+	ret, specificReturn := fake.updateAssetReturnsOnCall[len(fake.updateAssetArgsForCall)]
+	fake.updateAssetArgsForCall = append(fake.updateAssetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *string
+		arg4 *bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateAssetStub
+	fakeReturns := fake.updateAssetReturns
+	fake.recordInvocation("UpdateAsset", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateAssetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) UpdateAssetCallCount() int {
+	fake.updateAssetMutex.RLock()
+	defer fake.updateAssetMutex.RUnlock()
+	return len(fake.updateAssetArgsForCall)
+}
+
+func (fake *FakeAssetService) UpdateAssetCalls(stub func(context.Context, uuid.UUID, *string, *bool) (*domain.SupportedAsset, error)) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = stub
+}
+
+func (fake *FakeAssetService) UpdateAssetArgsForCall(i int) (context.Context, uuid.UUID, *string, *bool) {
+	fake.updateAssetMutex.RLock()
+	defer fake.updateAssetMutex.RUnlock()
+	argsForCall := fake.updateAssetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAssetService) UpdateAssetReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = nil
+	fake.updateAssetReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) UpdateAssetReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = nil
+	if fake.updateAssetReturnsOnCall == nil {
+		fake.updateAssetReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.updateAssetReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ValidateAmount(arg1 context.Context, arg2 string, arg3 money.Money) (*domain.SupportedAsset, error) {
+	fake.validateAmountMutex.Lock()
+	ret, specificReturn := fake.validateAmountReturnsOnCall[len(fake.validateAmountArgsForCall)]
+	fake.validateAmountArgsForCall = append(fake.validateAmountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 money.Money
+	}{arg1, arg2, arg3})
+	stub := fake.ValidateAmountStub
+	fakeReturns := fake.validateAmountReturns
+	fake.recordInvocation("ValidateAmount", []interface{}{arg1, arg2, arg3})
+	fake.validateAmountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) ValidateAmountCallCount() int {
+	fake.validateAmountMutex.RLock()
+	defer fake.validateAmountMutex.RUnlock()
+	return len(fake.validateAmountArgsForCall)
+}
+
+func (fake *FakeAssetService) ValidateAmountCalls(stub func(context.Context, string, money.Money) (*domain.SupportedAsset, error)) {
+	fake.validateAmountMutex.Lock()
+	defer fake.validateAmountMutex.Unlock()
+	fake.ValidateAmountStub = stub
+}
+
+func (fake *FakeAssetService) ValidateAmountArgsForCall(i int) (context.Context, string, money.Money) {
+	fake.validateAmountMutex.RLock()
+	defer fake.validateAmountMutex.RUnlock()
+	argsForCall := fake.validateAmountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAssetService) ValidateAmountReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.validateAmountMutex.Lock()
+	defer fake.validateAmountMutex.Unlock()
+	fake.ValidateAmountStub = nil
+	fake.validateAmountReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ValidateAmountReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.validateAmountMutex.Lock()
+	defer fake.validateAmountMutex.Unlock()
+	fake.ValidateAmountStub = nil
+	if fake.validateAmountReturnsOnCall == nil {
+		fake.validateAmountReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.validateAmountReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmount(arg1 context.Context, arg2 string, arg3 string, arg4 *big.Int) (*domain.SupportedAsset, error) {
+	fake.validateBaseUnitAmountMutex.Lock()
+	ret, specificReturn := fake.validateBaseUnitAmountReturnsOnCall[len(fake.validateBaseUnitAmountArgsForCall)]
+	fake.validateBaseUnitAmountArgsForCall = append(fake.validateBaseUnitAmountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *big.Int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ValidateBaseUnitAmountStub
+	fakeReturns := fake.validateBaseUnitAmountReturns
+	fake.recordInvocation("ValidateBaseUnitAmount", []interface{}{arg1, arg2, arg3, arg4})
+	fake.validateBaseUnitAmountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmountCallCount() int {
+	fake.validateBaseUnitAmountMutex.RLock()
+	defer fake.validateBaseUnitAmountMutex.RUnlock()
+	return len(fake.validateBaseUnitAmountArgsForCall)
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmountCalls(stub func(context.Context, string, string, *big.Int) (*domain.SupportedAsset, error)) {
+	fake.validateBaseUnitAmountMutex.Lock()
+	defer fake.validateBaseUnitAmountMutex.Unlock()
+	fake.ValidateBaseUnitAmountStub = stub
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmountArgsForCall(i int) (context.Context, string, string, *big.Int) {
+	fake.validateBaseUnitAmountMutex.RLock()
+	defer fake.validateBaseUnitAmountMutex.RUnlock()
+	argsForCall := fake.validateBaseUnitAmountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmountReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.validateBaseUnitAmountMutex.Lock()
+	defer fake.validateBaseUnitAmountMutex.Unlock()
+	fake.ValidateBaseUnitAmountStub = nil
+	fake.validateBaseUnitAmountReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) ValidateBaseUnitAmountReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.validateBaseUnitAmountMutex.Lock()
+	defer fake.validateBaseUnitAmountMutex.Unlock()
+	fake.ValidateBaseUnitAmountStub = nil
+	if fake.validateBaseUnitAmountReturnsOnCall == nil {
+		fake.validateBaseUnitAmountReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.validateBaseUnitAmountReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAssetService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAssetService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.AssetService = new(FakeAssetService)
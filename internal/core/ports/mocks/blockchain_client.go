@@ -0,0 +1,432 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeBlockchainClient struct {
+	BlockHashStub        func(context.Context, uint64) (string, error)
+	blockHashMutex       sync.RWMutex
+	blockHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 uint64
+	}
+	blockHashReturns struct {
+		result1 string
+		result2 error
+	}
+	blockHashReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	BlockNumberStub        func(context.Context) (uint64, error)
+	blockNumberMutex       sync.RWMutex
+	blockNumberArgsForCall []struct {
+		arg1 context.Context
+	}
+	blockNumberReturns struct {
+		result1 uint64
+		result2 error
+	}
+	blockNumberReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	TransactionKnownStub        func(context.Context, string) (bool, error)
+	transactionKnownMutex       sync.RWMutex
+	transactionKnownArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	transactionKnownReturns struct {
+		result1 bool
+		result2 error
+	}
+	transactionKnownReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	TransactionReceiptStub        func(context.Context, string) (*domain.TransactionReceipt, error)
+	transactionReceiptMutex       sync.RWMutex
+	transactionReceiptArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	transactionReceiptReturns struct {
+		result1 *domain.TransactionReceipt
+		result2 error
+	}
+	transactionReceiptReturnsOnCall map[int]struct {
+		result1 *domain.TransactionReceipt
+		result2 error
+	}
+	TransferLogsStub        func(context.Context, domain.TransferLogFilter) ([]domain.TransferLog, error)
+	transferLogsMutex       sync.RWMutex
+	transferLogsArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.TransferLogFilter
+	}
+	transferLogsReturns struct {
+		result1 []domain.TransferLog
+		result2 error
+	}
+	transferLogsReturnsOnCall map[int]struct {
+		result1 []domain.TransferLog
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBlockchainClient) BlockHash(arg1 context.Context, arg2 uint64) (string, error) {
+	fake.blockHashMutex.Lock()
+	ret, specificReturn := fake.blockHashReturnsOnCall[len(fake.blockHashArgsForCall)]
+	fake.blockHashArgsForCall = append(fake.blockHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.BlockHashStub
+	fakeReturns := fake.blockHashReturns
+	fake.recordInvocation("BlockHash", []interface{}{arg1, arg2})
+	fake.blockHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlockchainClient) BlockHashCallCount() int {
+	fake.blockHashMutex.RLock()
+	defer fake.blockHashMutex.RUnlock()
+	return len(fake.blockHashArgsForCall)
+}
+
+func (fake *FakeBlockchainClient) BlockHashCalls(stub func(context.Context, uint64) (string, error)) {
+	fake.blockHashMutex.Lock()
+	defer fake.blockHashMutex.Unlock()
+	fake.BlockHashStub = stub
+}
+
+func (fake *FakeBlockchainClient) BlockHashArgsForCall(i int) (context.Context, uint64) {
+	fake.blockHashMutex.RLock()
+	defer fake.blockHashMutex.RUnlock()
+	argsForCall := fake.blockHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlockchainClient) BlockHashReturns(result1 string, result2 error) {
+	fake.blockHashMutex.Lock()
+	defer fake.blockHashMutex.Unlock()
+	fake.BlockHashStub = nil
+	fake.blockHashReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) BlockHashReturnsOnCall(i int, result1 string, result2 error) {
+	fake.blockHashMutex.Lock()
+	defer fake.blockHashMutex.Unlock()
+	fake.BlockHashStub = nil
+	if fake.blockHashReturnsOnCall == nil {
+		fake.blockHashReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.blockHashReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) BlockNumber(arg1 context.Context) (uint64, error) {
+	fake.blockNumberMutex.Lock()
+	ret, specificReturn := fake.blockNumberReturnsOnCall[len(fake.blockNumberArgsForCall)]
+	fake.blockNumberArgsForCall = append(fake.blockNumberArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.BlockNumberStub
+	fakeReturns := fake.blockNumberReturns
+	fake.recordInvocation("BlockNumber", []interface{}{arg1})
+	fake.blockNumberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlockchainClient) BlockNumberCallCount() int {
+	fake.blockNumberMutex.RLock()
+	defer fake.blockNumberMutex.RUnlock()
+	return len(fake.blockNumberArgsForCall)
+}
+
+func (fake *FakeBlockchainClient) BlockNumberCalls(stub func(context.Context) (uint64, error)) {
+	fake.blockNumberMutex.Lock()
+	defer fake.blockNumberMutex.Unlock()
+	fake.BlockNumberStub = stub
+}
+
+func (fake *FakeBlockchainClient) BlockNumberArgsForCall(i int) context.Context {
+	fake.blockNumberMutex.RLock()
+	defer fake.blockNumberMutex.RUnlock()
+	argsForCall := fake.blockNumberArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBlockchainClient) BlockNumberReturns(result1 uint64, result2 error) {
+	fake.blockNumberMutex.Lock()
+	defer fake.blockNumberMutex.Unlock()
+	fake.BlockNumberStub = nil
+	fake.blockNumberReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) BlockNumberReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.blockNumberMutex.Lock()
+	defer fake.blockNumberMutex.Unlock()
+	fake.BlockNumberStub = nil
+	if fake.blockNumberReturnsOnCall == nil {
+		fake.blockNumberReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.blockNumberReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransactionKnown(arg1 context.Context, arg2 string) (bool, error) {
+	fake.transactionKnownMutex.Lock()
+	ret, specificReturn := fake.transactionKnownReturnsOnCall[len(fake.transactionKnownArgsForCall)]
+	fake.transactionKnownArgsForCall = append(fake.transactionKnownArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TransactionKnownStub
+	fakeReturns := fake.transactionKnownReturns
+	fake.recordInvocation("TransactionKnown", []interface{}{arg1, arg2})
+	fake.transactionKnownMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlockchainClient) TransactionKnownCallCount() int {
+	fake.transactionKnownMutex.RLock()
+	defer fake.transactionKnownMutex.RUnlock()
+	return len(fake.transactionKnownArgsForCall)
+}
+
+func (fake *FakeBlockchainClient) TransactionKnownCalls(stub func(context.Context, string) (bool, error)) {
+	fake.transactionKnownMutex.Lock()
+	defer fake.transactionKnownMutex.Unlock()
+	fake.TransactionKnownStub = stub
+}
+
+func (fake *FakeBlockchainClient) TransactionKnownArgsForCall(i int) (context.Context, string) {
+	fake.transactionKnownMutex.RLock()
+	defer fake.transactionKnownMutex.RUnlock()
+	argsForCall := fake.transactionKnownArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlockchainClient) TransactionKnownReturns(result1 bool, result2 error) {
+	fake.transactionKnownMutex.Lock()
+	defer fake.transactionKnownMutex.Unlock()
+	fake.TransactionKnownStub = nil
+	fake.transactionKnownReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransactionKnownReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.transactionKnownMutex.Lock()
+	defer fake.transactionKnownMutex.Unlock()
+	fake.TransactionKnownStub = nil
+	if fake.transactionKnownReturnsOnCall == nil {
+		fake.transactionKnownReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.transactionKnownReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransactionReceipt(arg1 context.Context, arg2 string) (*domain.TransactionReceipt, error) {
+	fake.transactionReceiptMutex.Lock()
+	ret, specificReturn := fake.transactionReceiptReturnsOnCall[len(fake.transactionReceiptArgsForCall)]
+	fake.transactionReceiptArgsForCall = append(fake.transactionReceiptArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TransactionReceiptStub
+	fakeReturns := fake.transactionReceiptReturns
+	fake.recordInvocation("TransactionReceipt", []interface{}{arg1, arg2})
+	fake.transactionReceiptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlockchainClient) TransactionReceiptCallCount() int {
+	fake.transactionReceiptMutex.RLock()
+	defer fake.transactionReceiptMutex.RUnlock()
+	return len(fake.transactionReceiptArgsForCall)
+}
+
+func (fake *FakeBlockchainClient) TransactionReceiptCalls(stub func(context.Context, string) (*domain.TransactionReceipt, error)) {
+	fake.transactionReceiptMutex.Lock()
+	defer fake.transactionReceiptMutex.Unlock()
+	fake.TransactionReceiptStub = stub
+}
+
+func (fake *FakeBlockchainClient) TransactionReceiptArgsForCall(i int) (context.Context, string) {
+	fake.transactionReceiptMutex.RLock()
+	defer fake.transactionReceiptMutex.RUnlock()
+	argsForCall := fake.transactionReceiptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlockchainClient) TransactionReceiptReturns(result1 *domain.TransactionReceipt, result2 error) {
+	fake.transactionReceiptMutex.Lock()
+	defer fake.transactionReceiptMutex.Unlock()
+	fake.TransactionReceiptStub = nil
+	fake.transactionReceiptReturns = struct {
+		result1 *domain.TransactionReceipt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransactionReceiptReturnsOnCall(i int, result1 *domain.TransactionReceipt, result2 error) {
+	fake.transactionReceiptMutex.Lock()
+	defer fake.transactionReceiptMutex.Unlock()
+	fake.TransactionReceiptStub = nil
+	if fake.transactionReceiptReturnsOnCall == nil {
+		fake.transactionReceiptReturnsOnCall = make(map[int]struct {
+			result1 *domain.TransactionReceipt
+			result2 error
+		})
+	}
+	fake.transactionReceiptReturnsOnCall[i] = struct {
+		result1 *domain.TransactionReceipt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransferLogs(arg1 context.Context, arg2 domain.TransferLogFilter) ([]domain.TransferLog, error) {
+	fake.transferLogsMutex.Lock()
+	ret, specificReturn := fake.transferLogsReturnsOnCall[len(fake.transferLogsArgsForCall)]
+	fake.transferLogsArgsForCall = append(fake.transferLogsArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.TransferLogFilter
+	}{arg1, arg2})
+	stub := fake.TransferLogsStub
+	fakeReturns := fake.transferLogsReturns
+	fake.recordInvocation("TransferLogs", []interface{}{arg1, arg2})
+	fake.transferLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlockchainClient) TransferLogsCallCount() int {
+	fake.transferLogsMutex.RLock()
+	defer fake.transferLogsMutex.RUnlock()
+	return len(fake.transferLogsArgsForCall)
+}
+
+func (fake *FakeBlockchainClient) TransferLogsCalls(stub func(context.Context, domain.TransferLogFilter) ([]domain.TransferLog, error)) {
+	fake.transferLogsMutex.Lock()
+	defer fake.transferLogsMutex.Unlock()
+	fake.TransferLogsStub = stub
+}
+
+func (fake *FakeBlockchainClient) TransferLogsArgsForCall(i int) (context.Context, domain.TransferLogFilter) {
+	fake.transferLogsMutex.RLock()
+	defer fake.transferLogsMutex.RUnlock()
+	argsForCall := fake.transferLogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlockchainClient) TransferLogsReturns(result1 []domain.TransferLog, result2 error) {
+	fake.transferLogsMutex.Lock()
+	defer fake.transferLogsMutex.Unlock()
+	fake.TransferLogsStub = nil
+	fake.transferLogsReturns = struct {
+		result1 []domain.TransferLog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) TransferLogsReturnsOnCall(i int, result1 []domain.TransferLog, result2 error) {
+	fake.transferLogsMutex.Lock()
+	defer fake.transferLogsMutex.Unlock()
+	fake.TransferLogsStub = nil
+	if fake.transferLogsReturnsOnCall == nil {
+		fake.transferLogsReturnsOnCall = make(map[int]struct {
+			result1 []domain.TransferLog
+			result2 error
+		})
+	}
+	fake.transferLogsReturnsOnCall[i] = struct {
+		result1 []domain.TransferLog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlockchainClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBlockchainClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.BlockchainClient = new(FakeBlockchainClient)
@@ -0,0 +1,535 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeClientRepository struct {
+	CreateClientStub        func(context.Context, domain.Client) (*domain.Client, error)
+	createClientMutex       sync.RWMutex
+	createClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Client
+	}
+	createClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	createClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	DeleteClientStub        func(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	deleteClientMutex       sync.RWMutex
+	deleteClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteClientReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteClientReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FindDuplicateClientStub        func(context.Context, uuid.UUID, string, string, uuid.UUID) (*domain.Client, error)
+	findDuplicateClientMutex       sync.RWMutex
+	findDuplicateClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+		arg5 uuid.UUID
+	}
+	findDuplicateClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	findDuplicateClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	GetClientStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.Client, error)
+	getClientMutex       sync.RWMutex
+	getClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	getClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	ListClientsStub        func(context.Context, uuid.UUID, string, int, int) ([]domain.Client, int64, error)
+	listClientsMutex       sync.RWMutex
+	listClientsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 int
+		arg5 int
+	}
+	listClientsReturns struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}
+	listClientsReturnsOnCall map[int]struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}
+	UpdateClientStub        func(context.Context, domain.Client) (*domain.Client, error)
+	updateClientMutex       sync.RWMutex
+	updateClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Client
+	}
+	updateClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	updateClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClientRepository) CreateClient(arg1 context.Context, arg2 domain.Client) (*domain.Client, error) {
+	fake.createClientMutex.Lock()
+	ret, specificReturn := fake.createClientReturnsOnCall[len(fake.createClientArgsForCall)]
+	fake.createClientArgsForCall = append(fake.createClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Client
+	}{arg1, arg2})
+	stub := fake.CreateClientStub
+	fakeReturns := fake.createClientReturns
+	fake.recordInvocation("CreateClient", []interface{}{arg1, arg2})
+	fake.createClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientRepository) CreateClientCallCount() int {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	return len(fake.createClientArgsForCall)
+}
+
+func (fake *FakeClientRepository) CreateClientCalls(stub func(context.Context, domain.Client) (*domain.Client, error)) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = stub
+}
+
+func (fake *FakeClientRepository) CreateClientArgsForCall(i int) (context.Context, domain.Client) {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	argsForCall := fake.createClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientRepository) CreateClientReturns(result1 *domain.Client, result2 error) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = nil
+	fake.createClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) CreateClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = nil
+	if fake.createClientReturnsOnCall == nil {
+		fake.createClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.createClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) DeleteClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (bool, error) {
+	fake.deleteClientMutex.Lock()
+	ret, specificReturn := fake.deleteClientReturnsOnCall[len(fake.deleteClientArgsForCall)]
+	fake.deleteClientArgsForCall = append(fake.deleteClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteClientStub
+	fakeReturns := fake.deleteClientReturns
+	fake.recordInvocation("DeleteClient", []interface{}{arg1, arg2, arg3})
+	fake.deleteClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientRepository) DeleteClientCallCount() int {
+	fake.deleteClientMutex.RLock()
+	defer fake.deleteClientMutex.RUnlock()
+	return len(fake.deleteClientArgsForCall)
+}
+
+func (fake *FakeClientRepository) DeleteClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = stub
+}
+
+func (fake *FakeClientRepository) DeleteClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteClientMutex.RLock()
+	defer fake.deleteClientMutex.RUnlock()
+	argsForCall := fake.deleteClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientRepository) DeleteClientReturns(result1 bool, result2 error) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = nil
+	fake.deleteClientReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) DeleteClientReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = nil
+	if fake.deleteClientReturnsOnCall == nil {
+		fake.deleteClientReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteClientReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) FindDuplicateClient(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 string, arg5 uuid.UUID) (*domain.Client, error) {
+	fake.findDuplicateClientMutex.Lock()
+	ret, specificReturn := fake.findDuplicateClientReturnsOnCall[len(fake.findDuplicateClientArgsForCall)]
+	fake.findDuplicateClientArgsForCall = append(fake.findDuplicateClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+		arg5 uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.FindDuplicateClientStub
+	fakeReturns := fake.findDuplicateClientReturns
+	fake.recordInvocation("FindDuplicateClient", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.findDuplicateClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientRepository) FindDuplicateClientCallCount() int {
+	fake.findDuplicateClientMutex.RLock()
+	defer fake.findDuplicateClientMutex.RUnlock()
+	return len(fake.findDuplicateClientArgsForCall)
+}
+
+func (fake *FakeClientRepository) FindDuplicateClientCalls(stub func(context.Context, uuid.UUID, string, string, uuid.UUID) (*domain.Client, error)) {
+	fake.findDuplicateClientMutex.Lock()
+	defer fake.findDuplicateClientMutex.Unlock()
+	fake.FindDuplicateClientStub = stub
+}
+
+func (fake *FakeClientRepository) FindDuplicateClientArgsForCall(i int) (context.Context, uuid.UUID, string, string, uuid.UUID) {
+	fake.findDuplicateClientMutex.RLock()
+	defer fake.findDuplicateClientMutex.RUnlock()
+	argsForCall := fake.findDuplicateClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClientRepository) FindDuplicateClientReturns(result1 *domain.Client, result2 error) {
+	fake.findDuplicateClientMutex.Lock()
+	defer fake.findDuplicateClientMutex.Unlock()
+	fake.FindDuplicateClientStub = nil
+	fake.findDuplicateClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) FindDuplicateClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.findDuplicateClientMutex.Lock()
+	defer fake.findDuplicateClientMutex.Unlock()
+	fake.FindDuplicateClientStub = nil
+	if fake.findDuplicateClientReturnsOnCall == nil {
+		fake.findDuplicateClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.findDuplicateClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) GetClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.Client, error) {
+	fake.getClientMutex.Lock()
+	ret, specificReturn := fake.getClientReturnsOnCall[len(fake.getClientArgsForCall)]
+	fake.getClientArgsForCall = append(fake.getClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetClientStub
+	fakeReturns := fake.getClientReturns
+	fake.recordInvocation("GetClient", []interface{}{arg1, arg2, arg3})
+	fake.getClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientRepository) GetClientCallCount() int {
+	fake.getClientMutex.RLock()
+	defer fake.getClientMutex.RUnlock()
+	return len(fake.getClientArgsForCall)
+}
+
+func (fake *FakeClientRepository) GetClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.Client, error)) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = stub
+}
+
+func (fake *FakeClientRepository) GetClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getClientMutex.RLock()
+	defer fake.getClientMutex.RUnlock()
+	argsForCall := fake.getClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientRepository) GetClientReturns(result1 *domain.Client, result2 error) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = nil
+	fake.getClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) GetClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = nil
+	if fake.getClientReturnsOnCall == nil {
+		fake.getClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.getClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) ListClients(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 int, arg5 int) ([]domain.Client, int64, error) {
+	fake.listClientsMutex.Lock()
+	ret, specificReturn := fake.listClientsReturnsOnCall[len(fake.listClientsArgsForCall)]
+	fake.listClientsArgsForCall = append(fake.listClientsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListClientsStub
+	fakeReturns := fake.listClientsReturns
+	fake.recordInvocation("ListClients", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listClientsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientRepository) ListClientsCallCount() int {
+	fake.listClientsMutex.RLock()
+	defer fake.listClientsMutex.RUnlock()
+	return len(fake.listClientsArgsForCall)
+}
+
+func (fake *FakeClientRepository) ListClientsCalls(stub func(context.Context, uuid.UUID, string, int, int) ([]domain.Client, int64, error)) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = stub
+}
+
+func (fake *FakeClientRepository) ListClientsArgsForCall(i int) (context.Context, uuid.UUID, string, int, int) {
+	fake.listClientsMutex.RLock()
+	defer fake.listClientsMutex.RUnlock()
+	argsForCall := fake.listClientsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClientRepository) ListClientsReturns(result1 []domain.Client, result2 int64, result3 error) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = nil
+	fake.listClientsReturns = struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientRepository) ListClientsReturnsOnCall(i int, result1 []domain.Client, result2 int64, result3 error) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = nil
+	if fake.listClientsReturnsOnCall == nil {
+		fake.listClientsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Client
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listClientsReturnsOnCall[i] = struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientRepository) UpdateClient(arg1 context.Context, arg2 domain.Client) (*domain.Client, error) {
+	fake.updateClientMutex.Lock()
+	ret, specificReturn := fake.updateClientReturnsOnCall[len(fake.updateClientArgsForCall)]
+	fake.updateClientArgsForCall = append(fake.updateClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Client
+	}{arg1, arg2})
+	stub := fake.UpdateClientStub
+	fakeReturns := fake.updateClientReturns
+	fake.recordInvocation("UpdateClient", []interface{}{arg1, arg2})
+	fake.updateClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientRepository) UpdateClientCallCount() int {
+	fake.updateClientMutex.RLock()
+	defer fake.updateClientMutex.RUnlock()
+	return len(fake.updateClientArgsForCall)
+}
+
+func (fake *FakeClientRepository) UpdateClientCalls(stub func(context.Context, domain.Client) (*domain.Client, error)) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = stub
+}
+
+func (fake *FakeClientRepository) UpdateClientArgsForCall(i int) (context.Context, domain.Client) {
+	fake.updateClientMutex.RLock()
+	defer fake.updateClientMutex.RUnlock()
+	argsForCall := fake.updateClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientRepository) UpdateClientReturns(result1 *domain.Client, result2 error) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = nil
+	fake.updateClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) UpdateClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = nil
+	if fake.updateClientReturnsOnCall == nil {
+		fake.updateClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.updateClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClientRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.ClientRepository = new(FakeClientRepository)
@@ -0,0 +1,545 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeClientService struct {
+	CreateClientStub        func(context.Context, uuid.UUID, uuid.UUID, domain.Client) (*domain.Client, error)
+	createClientMutex       sync.RWMutex
+	createClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Client
+	}
+	createClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	createClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	DeleteClientStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	deleteClientMutex       sync.RWMutex
+	deleteClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	deleteClientReturns struct {
+		result1 error
+	}
+	deleteClientReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetClientStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Client, error)
+	getClientMutex       sync.RWMutex
+	getClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	getClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	ImportClientsStub        func(context.Context, uuid.UUID, uuid.UUID, io.Reader) (*domain.ClientImportResult, error)
+	importClientsMutex       sync.RWMutex
+	importClientsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 io.Reader
+	}
+	importClientsReturns struct {
+		result1 *domain.ClientImportResult
+		result2 error
+	}
+	importClientsReturnsOnCall map[int]struct {
+		result1 *domain.ClientImportResult
+		result2 error
+	}
+	ListClientsStub        func(context.Context, uuid.UUID, uuid.UUID, string, int, int) ([]domain.Client, int64, error)
+	listClientsMutex       sync.RWMutex
+	listClientsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 int
+		arg6 int
+	}
+	listClientsReturns struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}
+	listClientsReturnsOnCall map[int]struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}
+	UpdateClientStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Client) (*domain.Client, error)
+	updateClientMutex       sync.RWMutex
+	updateClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.Client
+	}
+	updateClientReturns struct {
+		result1 *domain.Client
+		result2 error
+	}
+	updateClientReturnsOnCall map[int]struct {
+		result1 *domain.Client
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClientService) CreateClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.Client) (*domain.Client, error) {
+	fake.createClientMutex.Lock()
+	ret, specificReturn := fake.createClientReturnsOnCall[len(fake.createClientArgsForCall)]
+	fake.createClientArgsForCall = append(fake.createClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Client
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateClientStub
+	fakeReturns := fake.createClientReturns
+	fake.recordInvocation("CreateClient", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientService) CreateClientCallCount() int {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	return len(fake.createClientArgsForCall)
+}
+
+func (fake *FakeClientService) CreateClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.Client) (*domain.Client, error)) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = stub
+}
+
+func (fake *FakeClientService) CreateClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.Client) {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	argsForCall := fake.createClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClientService) CreateClientReturns(result1 *domain.Client, result2 error) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = nil
+	fake.createClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) CreateClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.createClientMutex.Lock()
+	defer fake.createClientMutex.Unlock()
+	fake.CreateClientStub = nil
+	if fake.createClientReturnsOnCall == nil {
+		fake.createClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.createClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) DeleteClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.deleteClientMutex.Lock()
+	ret, specificReturn := fake.deleteClientReturnsOnCall[len(fake.deleteClientArgsForCall)]
+	fake.deleteClientArgsForCall = append(fake.deleteClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteClientStub
+	fakeReturns := fake.deleteClientReturns
+	fake.recordInvocation("DeleteClient", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientService) DeleteClientCallCount() int {
+	fake.deleteClientMutex.RLock()
+	defer fake.deleteClientMutex.RUnlock()
+	return len(fake.deleteClientArgsForCall)
+}
+
+func (fake *FakeClientService) DeleteClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = stub
+}
+
+func (fake *FakeClientService) DeleteClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.deleteClientMutex.RLock()
+	defer fake.deleteClientMutex.RUnlock()
+	argsForCall := fake.deleteClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClientService) DeleteClientReturns(result1 error) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = nil
+	fake.deleteClientReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientService) DeleteClientReturnsOnCall(i int, result1 error) {
+	fake.deleteClientMutex.Lock()
+	defer fake.deleteClientMutex.Unlock()
+	fake.DeleteClientStub = nil
+	if fake.deleteClientReturnsOnCall == nil {
+		fake.deleteClientReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteClientReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClientService) GetClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Client, error) {
+	fake.getClientMutex.Lock()
+	ret, specificReturn := fake.getClientReturnsOnCall[len(fake.getClientArgsForCall)]
+	fake.getClientArgsForCall = append(fake.getClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetClientStub
+	fakeReturns := fake.getClientReturns
+	fake.recordInvocation("GetClient", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientService) GetClientCallCount() int {
+	fake.getClientMutex.RLock()
+	defer fake.getClientMutex.RUnlock()
+	return len(fake.getClientArgsForCall)
+}
+
+func (fake *FakeClientService) GetClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Client, error)) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = stub
+}
+
+func (fake *FakeClientService) GetClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getClientMutex.RLock()
+	defer fake.getClientMutex.RUnlock()
+	argsForCall := fake.getClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClientService) GetClientReturns(result1 *domain.Client, result2 error) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = nil
+	fake.getClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) GetClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.getClientMutex.Lock()
+	defer fake.getClientMutex.Unlock()
+	fake.GetClientStub = nil
+	if fake.getClientReturnsOnCall == nil {
+		fake.getClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.getClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) ImportClients(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 io.Reader) (*domain.ClientImportResult, error) {
+	fake.importClientsMutex.Lock()
+	ret, specificReturn := fake.importClientsReturnsOnCall[len(fake.importClientsArgsForCall)]
+	fake.importClientsArgsForCall = append(fake.importClientsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 io.Reader
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ImportClientsStub
+	fakeReturns := fake.importClientsReturns
+	fake.recordInvocation("ImportClients", []interface{}{arg1, arg2, arg3, arg4})
+	fake.importClientsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientService) ImportClientsCallCount() int {
+	fake.importClientsMutex.RLock()
+	defer fake.importClientsMutex.RUnlock()
+	return len(fake.importClientsArgsForCall)
+}
+
+func (fake *FakeClientService) ImportClientsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, io.Reader) (*domain.ClientImportResult, error)) {
+	fake.importClientsMutex.Lock()
+	defer fake.importClientsMutex.Unlock()
+	fake.ImportClientsStub = stub
+}
+
+func (fake *FakeClientService) ImportClientsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, io.Reader) {
+	fake.importClientsMutex.RLock()
+	defer fake.importClientsMutex.RUnlock()
+	argsForCall := fake.importClientsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClientService) ImportClientsReturns(result1 *domain.ClientImportResult, result2 error) {
+	fake.importClientsMutex.Lock()
+	defer fake.importClientsMutex.Unlock()
+	fake.ImportClientsStub = nil
+	fake.importClientsReturns = struct {
+		result1 *domain.ClientImportResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) ImportClientsReturnsOnCall(i int, result1 *domain.ClientImportResult, result2 error) {
+	fake.importClientsMutex.Lock()
+	defer fake.importClientsMutex.Unlock()
+	fake.ImportClientsStub = nil
+	if fake.importClientsReturnsOnCall == nil {
+		fake.importClientsReturnsOnCall = make(map[int]struct {
+			result1 *domain.ClientImportResult
+			result2 error
+		})
+	}
+	fake.importClientsReturnsOnCall[i] = struct {
+		result1 *domain.ClientImportResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) ListClients(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string, arg5 int, arg6 int) ([]domain.Client, int64, error) {
+	fake.listClientsMutex.Lock()
+	ret, specificReturn := fake.listClientsReturnsOnCall[len(fake.listClientsArgsForCall)]
+	fake.listClientsArgsForCall = append(fake.listClientsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListClientsStub
+	fakeReturns := fake.listClientsReturns
+	fake.recordInvocation("ListClients", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listClientsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientService) ListClientsCallCount() int {
+	fake.listClientsMutex.RLock()
+	defer fake.listClientsMutex.RUnlock()
+	return len(fake.listClientsArgsForCall)
+}
+
+func (fake *FakeClientService) ListClientsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string, int, int) ([]domain.Client, int64, error)) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = stub
+}
+
+func (fake *FakeClientService) ListClientsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string, int, int) {
+	fake.listClientsMutex.RLock()
+	defer fake.listClientsMutex.RUnlock()
+	argsForCall := fake.listClientsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeClientService) ListClientsReturns(result1 []domain.Client, result2 int64, result3 error) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = nil
+	fake.listClientsReturns = struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientService) ListClientsReturnsOnCall(i int, result1 []domain.Client, result2 int64, result3 error) {
+	fake.listClientsMutex.Lock()
+	defer fake.listClientsMutex.Unlock()
+	fake.ListClientsStub = nil
+	if fake.listClientsReturnsOnCall == nil {
+		fake.listClientsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Client
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listClientsReturnsOnCall[i] = struct {
+		result1 []domain.Client
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientService) UpdateClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.Client) (*domain.Client, error) {
+	fake.updateClientMutex.Lock()
+	ret, specificReturn := fake.updateClientReturnsOnCall[len(fake.updateClientArgsForCall)]
+	fake.updateClientArgsForCall = append(fake.updateClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.Client
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateClientStub
+	fakeReturns := fake.updateClientReturns
+	fake.recordInvocation("UpdateClient", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientService) UpdateClientCallCount() int {
+	fake.updateClientMutex.RLock()
+	defer fake.updateClientMutex.RUnlock()
+	return len(fake.updateClientArgsForCall)
+}
+
+func (fake *FakeClientService) UpdateClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Client) (*domain.Client, error)) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = stub
+}
+
+func (fake *FakeClientService) UpdateClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Client) {
+	fake.updateClientMutex.RLock()
+	defer fake.updateClientMutex.RUnlock()
+	argsForCall := fake.updateClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClientService) UpdateClientReturns(result1 *domain.Client, result2 error) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = nil
+	fake.updateClientReturns = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) UpdateClientReturnsOnCall(i int, result1 *domain.Client, result2 error) {
+	fake.updateClientMutex.Lock()
+	defer fake.updateClientMutex.Unlock()
+	fake.UpdateClientStub = nil
+	if fake.updateClientReturnsOnCall == nil {
+		fake.updateClientReturnsOnCall = make(map[int]struct {
+			result1 *domain.Client
+			result2 error
+		})
+	}
+	fake.updateClientReturnsOnCall[i] = struct {
+		result1 *domain.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClientService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.ClientService = new(FakeClientService)
@@ -0,0 +1,389 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type FakeCreditNoteService struct {
+	GetCreditNoteStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.CreditNote, error)
+	getCreditNoteMutex       sync.RWMutex
+	getCreditNoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getCreditNoteReturns struct {
+		result1 *domain.CreditNote
+		result2 error
+	}
+	getCreditNoteReturnsOnCall map[int]struct {
+		result1 *domain.CreditNote
+		result2 error
+	}
+	IssueCreditNoteStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *decimal.Decimal, string) (*domain.CreditNote, *domain.Invoice, error)
+	issueCreditNoteMutex       sync.RWMutex
+	issueCreditNoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *decimal.Decimal
+		arg6 string
+	}
+	issueCreditNoteReturns struct {
+		result1 *domain.CreditNote
+		result2 *domain.Invoice
+		result3 error
+	}
+	issueCreditNoteReturnsOnCall map[int]struct {
+		result1 *domain.CreditNote
+		result2 *domain.Invoice
+		result3 error
+	}
+	ListCreditNotesStub        func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]domain.CreditNote, int64, error)
+	listCreditNotesMutex       sync.RWMutex
+	listCreditNotesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 int
+		arg5 int
+	}
+	listCreditNotesReturns struct {
+		result1 []domain.CreditNote
+		result2 int64
+		result3 error
+	}
+	listCreditNotesReturnsOnCall map[int]struct {
+		result1 []domain.CreditNote
+		result2 int64
+		result3 error
+	}
+	ListInvoiceCreditNotesStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.CreditNote, error)
+	listInvoiceCreditNotesMutex       sync.RWMutex
+	listInvoiceCreditNotesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	listInvoiceCreditNotesReturns struct {
+		result1 []domain.CreditNote
+		result2 error
+	}
+	listInvoiceCreditNotesReturnsOnCall map[int]struct {
+		result1 []domain.CreditNote
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreditNoteService) GetCreditNote(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.CreditNote, error) {
+	fake.getCreditNoteMutex.Lock()
+	ret, specificReturn := fake.getCreditNoteReturnsOnCall[len(fake.getCreditNoteArgsForCall)]
+	fake.getCreditNoteArgsForCall = append(fake.getCreditNoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetCreditNoteStub
+	fakeReturns := fake.getCreditNoteReturns
+	fake.recordInvocation("GetCreditNote", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getCreditNoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreditNoteService) GetCreditNoteCallCount() int {
+	fake.getCreditNoteMutex.RLock()
+	defer fake.getCreditNoteMutex.RUnlock()
+	return len(fake.getCreditNoteArgsForCall)
+}
+
+func (fake *FakeCreditNoteService) GetCreditNoteCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.CreditNote, error)) {
+	fake.getCreditNoteMutex.Lock()
+	defer fake.getCreditNoteMutex.Unlock()
+	fake.GetCreditNoteStub = stub
+}
+
+func (fake *FakeCreditNoteService) GetCreditNoteArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getCreditNoteMutex.RLock()
+	defer fake.getCreditNoteMutex.RUnlock()
+	argsForCall := fake.getCreditNoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCreditNoteService) GetCreditNoteReturns(result1 *domain.CreditNote, result2 error) {
+	fake.getCreditNoteMutex.Lock()
+	defer fake.getCreditNoteMutex.Unlock()
+	fake.GetCreditNoteStub = nil
+	fake.getCreditNoteReturns = struct {
+		result1 *domain.CreditNote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreditNoteService) GetCreditNoteReturnsOnCall(i int, result1 *domain.CreditNote, result2 error) {
+	fake.getCreditNoteMutex.Lock()
+	defer fake.getCreditNoteMutex.Unlock()
+	fake.GetCreditNoteStub = nil
+	if fake.getCreditNoteReturnsOnCall == nil {
+		fake.getCreditNoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.CreditNote
+			result2 error
+		})
+	}
+	fake.getCreditNoteReturnsOnCall[i] = struct {
+		result1 *domain.CreditNote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNote(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 *decimal.Decimal, arg6 string) (*domain.CreditNote, *domain.Invoice, error) {
+	fake.issueCreditNoteMutex.Lock()
+	ret, specificReturn := fake.issueCreditNoteReturnsOnCall[len(fake.issueCreditNoteArgsForCall)]
+	fake.issueCreditNoteArgsForCall = append(fake.issueCreditNoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *decimal.Decimal
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.IssueCreditNoteStub
+	fakeReturns := fake.issueCreditNoteReturns
+	fake.recordInvocation("IssueCreditNote", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.issueCreditNoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNoteCallCount() int {
+	fake.issueCreditNoteMutex.RLock()
+	defer fake.issueCreditNoteMutex.RUnlock()
+	return len(fake.issueCreditNoteArgsForCall)
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNoteCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *decimal.Decimal, string) (*domain.CreditNote, *domain.Invoice, error)) {
+	fake.issueCreditNoteMutex.Lock()
+	defer fake.issueCreditNoteMutex.Unlock()
+	fake.IssueCreditNoteStub = stub
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNoteArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *decimal.Decimal, string) {
+	fake.issueCreditNoteMutex.RLock()
+	defer fake.issueCreditNoteMutex.RUnlock()
+	argsForCall := fake.issueCreditNoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNoteReturns(result1 *domain.CreditNote, result2 *domain.Invoice, result3 error) {
+	fake.issueCreditNoteMutex.Lock()
+	defer fake.issueCreditNoteMutex.Unlock()
+	fake.IssueCreditNoteStub = nil
+	fake.issueCreditNoteReturns = struct {
+		result1 *domain.CreditNote
+		result2 *domain.Invoice
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreditNoteService) IssueCreditNoteReturnsOnCall(i int, result1 *domain.CreditNote, result2 *domain.Invoice, result3 error) {
+	fake.issueCreditNoteMutex.Lock()
+	defer fake.issueCreditNoteMutex.Unlock()
+	fake.IssueCreditNoteStub = nil
+	if fake.issueCreditNoteReturnsOnCall == nil {
+		fake.issueCreditNoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.CreditNote
+			result2 *domain.Invoice
+			result3 error
+		})
+	}
+	fake.issueCreditNoteReturnsOnCall[i] = struct {
+		result1 *domain.CreditNote
+		result2 *domain.Invoice
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotes(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 int, arg5 int) ([]domain.CreditNote, int64, error) {
+	fake.listCreditNotesMutex.Lock()
+	ret, specificReturn := fake.listCreditNotesReturnsOnCall[len(fake.listCreditNotesArgsForCall)]
+	fake.listCreditNotesArgsForCall = append(fake.listCreditNotesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListCreditNotesStub
+	fakeReturns := fake.listCreditNotesReturns
+	fake.recordInvocation("ListCreditNotes", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listCreditNotesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotesCallCount() int {
+	fake.listCreditNotesMutex.RLock()
+	defer fake.listCreditNotesMutex.RUnlock()
+	return len(fake.listCreditNotesArgsForCall)
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotesCalls(stub func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]domain.CreditNote, int64, error)) {
+	fake.listCreditNotesMutex.Lock()
+	defer fake.listCreditNotesMutex.Unlock()
+	fake.ListCreditNotesStub = stub
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, int, int) {
+	fake.listCreditNotesMutex.RLock()
+	defer fake.listCreditNotesMutex.RUnlock()
+	argsForCall := fake.listCreditNotesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotesReturns(result1 []domain.CreditNote, result2 int64, result3 error) {
+	fake.listCreditNotesMutex.Lock()
+	defer fake.listCreditNotesMutex.Unlock()
+	fake.ListCreditNotesStub = nil
+	fake.listCreditNotesReturns = struct {
+		result1 []domain.CreditNote
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreditNoteService) ListCreditNotesReturnsOnCall(i int, result1 []domain.CreditNote, result2 int64, result3 error) {
+	fake.listCreditNotesMutex.Lock()
+	defer fake.listCreditNotesMutex.Unlock()
+	fake.ListCreditNotesStub = nil
+	if fake.listCreditNotesReturnsOnCall == nil {
+		fake.listCreditNotesReturnsOnCall = make(map[int]struct {
+			result1 []domain.CreditNote
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listCreditNotesReturnsOnCall[i] = struct {
+		result1 []domain.CreditNote
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotes(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]domain.CreditNote, error) {
+	fake.listInvoiceCreditNotesMutex.Lock()
+	ret, specificReturn := fake.listInvoiceCreditNotesReturnsOnCall[len(fake.listInvoiceCreditNotesArgsForCall)]
+	fake.listInvoiceCreditNotesArgsForCall = append(fake.listInvoiceCreditNotesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListInvoiceCreditNotesStub
+	fakeReturns := fake.listInvoiceCreditNotesReturns
+	fake.recordInvocation("ListInvoiceCreditNotes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listInvoiceCreditNotesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotesCallCount() int {
+	fake.listInvoiceCreditNotesMutex.RLock()
+	defer fake.listInvoiceCreditNotesMutex.RUnlock()
+	return len(fake.listInvoiceCreditNotesArgsForCall)
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotesCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.CreditNote, error)) {
+	fake.listInvoiceCreditNotesMutex.Lock()
+	defer fake.listInvoiceCreditNotesMutex.Unlock()
+	fake.ListInvoiceCreditNotesStub = stub
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.listInvoiceCreditNotesMutex.RLock()
+	defer fake.listInvoiceCreditNotesMutex.RUnlock()
+	argsForCall := fake.listInvoiceCreditNotesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotesReturns(result1 []domain.CreditNote, result2 error) {
+	fake.listInvoiceCreditNotesMutex.Lock()
+	defer fake.listInvoiceCreditNotesMutex.Unlock()
+	fake.ListInvoiceCreditNotesStub = nil
+	fake.listInvoiceCreditNotesReturns = struct {
+		result1 []domain.CreditNote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreditNoteService) ListInvoiceCreditNotesReturnsOnCall(i int, result1 []domain.CreditNote, result2 error) {
+	fake.listInvoiceCreditNotesMutex.Lock()
+	defer fake.listInvoiceCreditNotesMutex.Unlock()
+	fake.ListInvoiceCreditNotesStub = nil
+	if fake.listInvoiceCreditNotesReturnsOnCall == nil {
+		fake.listInvoiceCreditNotesReturnsOnCall = make(map[int]struct {
+			result1 []domain.CreditNote
+			result2 error
+		})
+	}
+	fake.listInvoiceCreditNotesReturnsOnCall[i] = struct {
+		result1 []domain.CreditNote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreditNoteService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCreditNoteService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.CreditNoteService = new(FakeCreditNoteService)
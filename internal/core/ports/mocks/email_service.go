@@ -0,0 +1,835 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeEmailService struct {
+	SendBatchUpdateStub        func(context.Context, []string, string, string) error
+	sendBatchUpdateMutex       sync.RWMutex
+	sendBatchUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 string
+		arg4 string
+	}
+	sendBatchUpdateReturns struct {
+		result1 error
+	}
+	sendBatchUpdateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendInvoiceStub        func(context.Context, domain.Invoice, string, []byte) error
+	sendInvoiceMutex       sync.RWMutex
+	sendInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 string
+		arg4 []byte
+	}
+	sendInvoiceReturns struct {
+		result1 error
+	}
+	sendInvoiceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendInvoiceReminderStub        func(context.Context, domain.Invoice, string, int) error
+	sendInvoiceReminderMutex       sync.RWMutex
+	sendInvoiceReminderArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 string
+		arg4 int
+	}
+	sendInvoiceReminderReturns struct {
+		result1 error
+	}
+	sendInvoiceReminderReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendPasswordResetEmailStub        func(context.Context, string, string, string) error
+	sendPasswordResetEmailMutex       sync.RWMutex
+	sendPasswordResetEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	sendPasswordResetEmailReturns struct {
+		result1 error
+	}
+	sendPasswordResetEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendPayoutAddressCancelledNotificationStub        func(context.Context, string, string, domain.PayoutAddress) error
+	sendPayoutAddressCancelledNotificationMutex       sync.RWMutex
+	sendPayoutAddressCancelledNotificationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.PayoutAddress
+	}
+	sendPayoutAddressCancelledNotificationReturns struct {
+		result1 error
+	}
+	sendPayoutAddressCancelledNotificationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendPayoutAddressChangeNotificationStub        func(context.Context, string, string, domain.PayoutAddress, string) error
+	sendPayoutAddressChangeNotificationMutex       sync.RWMutex
+	sendPayoutAddressChangeNotificationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.PayoutAddress
+		arg5 string
+	}
+	sendPayoutAddressChangeNotificationReturns struct {
+		result1 error
+	}
+	sendPayoutAddressChangeNotificationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendTeamInvitationStub        func(context.Context, string, string, domain.Invitation, string) error
+	sendTeamInvitationMutex       sync.RWMutex
+	sendTeamInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.Invitation
+		arg5 string
+	}
+	sendTeamInvitationReturns struct {
+		result1 error
+	}
+	sendTeamInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendTransactionPINResetEmailStub        func(context.Context, string, string, string) error
+	sendTransactionPINResetEmailMutex       sync.RWMutex
+	sendTransactionPINResetEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	sendTransactionPINResetEmailReturns struct {
+		result1 error
+	}
+	sendTransactionPINResetEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendWaitlistConfirmationStub        func(context.Context, string, string, string, int) error
+	sendWaitlistConfirmationMutex       sync.RWMutex
+	sendWaitlistConfirmationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}
+	sendWaitlistConfirmationReturns struct {
+		result1 error
+	}
+	sendWaitlistConfirmationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendWaitlistInvitationStub        func(context.Context, string, string, string) error
+	sendWaitlistInvitationMutex       sync.RWMutex
+	sendWaitlistInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	sendWaitlistInvitationReturns struct {
+		result1 error
+	}
+	sendWaitlistInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEmailService) SendBatchUpdate(arg1 context.Context, arg2 []string, arg3 string, arg4 string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.sendBatchUpdateMutex.Lock()
+	ret, specificReturn := fake.sendBatchUpdateReturnsOnCall[len(fake.sendBatchUpdateArgsForCall)]
+	fake.sendBatchUpdateArgsForCall = append(fake.sendBatchUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 string
+		arg4 string
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.SendBatchUpdateStub
+	fakeReturns := fake.sendBatchUpdateReturns
+	fake.recordInvocation("SendBatchUpdate", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.sendBatchUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendBatchUpdateCallCount() int {
+	fake.sendBatchUpdateMutex.RLock()
+	defer fake.sendBatchUpdateMutex.RUnlock()
+	return len(fake.sendBatchUpdateArgsForCall)
+}
+
+func (fake *FakeEmailService) SendBatchUpdateCalls(stub func(context.Context, []string, string, string) error) {
+	fake.sendBatchUpdateMutex.Lock()
+	defer fake.sendBatchUpdateMutex.Unlock()
+	fake.SendBatchUpdateStub = stub
+}
+
+func (fake *FakeEmailService) SendBatchUpdateArgsForCall(i int) (context.Context, []string, string, string) {
+	fake.sendBatchUpdateMutex.RLock()
+	defer fake.sendBatchUpdateMutex.RUnlock()
+	argsForCall := fake.sendBatchUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendBatchUpdateReturns(result1 error) {
+	fake.sendBatchUpdateMutex.Lock()
+	defer fake.sendBatchUpdateMutex.Unlock()
+	fake.SendBatchUpdateStub = nil
+	fake.sendBatchUpdateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendBatchUpdateReturnsOnCall(i int, result1 error) {
+	fake.sendBatchUpdateMutex.Lock()
+	defer fake.sendBatchUpdateMutex.Unlock()
+	fake.SendBatchUpdateStub = nil
+	if fake.sendBatchUpdateReturnsOnCall == nil {
+		fake.sendBatchUpdateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendBatchUpdateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendInvoice(arg1 context.Context, arg2 domain.Invoice, arg3 string, arg4 []byte) error {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.sendInvoiceMutex.Lock()
+	ret, specificReturn := fake.sendInvoiceReturnsOnCall[len(fake.sendInvoiceArgsForCall)]
+	fake.sendInvoiceArgsForCall = append(fake.sendInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 string
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.SendInvoiceStub
+	fakeReturns := fake.sendInvoiceReturns
+	fake.recordInvocation("SendInvoice", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.sendInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendInvoiceCallCount() int {
+	fake.sendInvoiceMutex.RLock()
+	defer fake.sendInvoiceMutex.RUnlock()
+	return len(fake.sendInvoiceArgsForCall)
+}
+
+func (fake *FakeEmailService) SendInvoiceCalls(stub func(context.Context, domain.Invoice, string, []byte) error) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = stub
+}
+
+func (fake *FakeEmailService) SendInvoiceArgsForCall(i int) (context.Context, domain.Invoice, string, []byte) {
+	fake.sendInvoiceMutex.RLock()
+	defer fake.sendInvoiceMutex.RUnlock()
+	argsForCall := fake.sendInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendInvoiceReturns(result1 error) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = nil
+	fake.sendInvoiceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendInvoiceReturnsOnCall(i int, result1 error) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = nil
+	if fake.sendInvoiceReturnsOnCall == nil {
+		fake.sendInvoiceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendInvoiceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendInvoiceReminder(arg1 context.Context, arg2 domain.Invoice, arg3 string, arg4 int) error {
+	fake.sendInvoiceReminderMutex.Lock()
+	ret, specificReturn := fake.sendInvoiceReminderReturnsOnCall[len(fake.sendInvoiceReminderArgsForCall)]
+	fake.sendInvoiceReminderArgsForCall = append(fake.sendInvoiceReminderArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendInvoiceReminderStub
+	fakeReturns := fake.sendInvoiceReminderReturns
+	fake.recordInvocation("SendInvoiceReminder", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendInvoiceReminderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendInvoiceReminderCallCount() int {
+	fake.sendInvoiceReminderMutex.RLock()
+	defer fake.sendInvoiceReminderMutex.RUnlock()
+	return len(fake.sendInvoiceReminderArgsForCall)
+}
+
+func (fake *FakeEmailService) SendInvoiceReminderCalls(stub func(context.Context, domain.Invoice, string, int) error) {
+	fake.sendInvoiceReminderMutex.Lock()
+	defer fake.sendInvoiceReminderMutex.Unlock()
+	fake.SendInvoiceReminderStub = stub
+}
+
+func (fake *FakeEmailService) SendInvoiceReminderArgsForCall(i int) (context.Context, domain.Invoice, string, int) {
+	fake.sendInvoiceReminderMutex.RLock()
+	defer fake.sendInvoiceReminderMutex.RUnlock()
+	argsForCall := fake.sendInvoiceReminderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendInvoiceReminderReturns(result1 error) {
+	fake.sendInvoiceReminderMutex.Lock()
+	defer fake.sendInvoiceReminderMutex.Unlock()
+	fake.SendInvoiceReminderStub = nil
+	fake.sendInvoiceReminderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendInvoiceReminderReturnsOnCall(i int, result1 error) {
+	fake.sendInvoiceReminderMutex.Lock()
+	defer fake.sendInvoiceReminderMutex.Unlock()
+	fake.SendInvoiceReminderStub = nil
+	if fake.sendInvoiceReminderReturnsOnCall == nil {
+		fake.sendInvoiceReminderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendInvoiceReminderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmail(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.sendPasswordResetEmailMutex.Lock()
+	ret, specificReturn := fake.sendPasswordResetEmailReturnsOnCall[len(fake.sendPasswordResetEmailArgsForCall)]
+	fake.sendPasswordResetEmailArgsForCall = append(fake.sendPasswordResetEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendPasswordResetEmailStub
+	fakeReturns := fake.sendPasswordResetEmailReturns
+	fake.recordInvocation("SendPasswordResetEmail", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendPasswordResetEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmailCallCount() int {
+	fake.sendPasswordResetEmailMutex.RLock()
+	defer fake.sendPasswordResetEmailMutex.RUnlock()
+	return len(fake.sendPasswordResetEmailArgsForCall)
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmailCalls(stub func(context.Context, string, string, string) error) {
+	fake.sendPasswordResetEmailMutex.Lock()
+	defer fake.sendPasswordResetEmailMutex.Unlock()
+	fake.SendPasswordResetEmailStub = stub
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmailArgsForCall(i int) (context.Context, string, string, string) {
+	fake.sendPasswordResetEmailMutex.RLock()
+	defer fake.sendPasswordResetEmailMutex.RUnlock()
+	argsForCall := fake.sendPasswordResetEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmailReturns(result1 error) {
+	fake.sendPasswordResetEmailMutex.Lock()
+	defer fake.sendPasswordResetEmailMutex.Unlock()
+	fake.SendPasswordResetEmailStub = nil
+	fake.sendPasswordResetEmailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPasswordResetEmailReturnsOnCall(i int, result1 error) {
+	fake.sendPasswordResetEmailMutex.Lock()
+	defer fake.sendPasswordResetEmailMutex.Unlock()
+	fake.SendPasswordResetEmailStub = nil
+	if fake.sendPasswordResetEmailReturnsOnCall == nil {
+		fake.sendPasswordResetEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendPasswordResetEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotification(arg1 context.Context, arg2 string, arg3 string, arg4 domain.PayoutAddress) error {
+	fake.sendPayoutAddressCancelledNotificationMutex.Lock()
+	ret, specificReturn := fake.sendPayoutAddressCancelledNotificationReturnsOnCall[len(fake.sendPayoutAddressCancelledNotificationArgsForCall)]
+	fake.sendPayoutAddressCancelledNotificationArgsForCall = append(fake.sendPayoutAddressCancelledNotificationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.PayoutAddress
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendPayoutAddressCancelledNotificationStub
+	fakeReturns := fake.sendPayoutAddressCancelledNotificationReturns
+	fake.recordInvocation("SendPayoutAddressCancelledNotification", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendPayoutAddressCancelledNotificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotificationCallCount() int {
+	fake.sendPayoutAddressCancelledNotificationMutex.RLock()
+	defer fake.sendPayoutAddressCancelledNotificationMutex.RUnlock()
+	return len(fake.sendPayoutAddressCancelledNotificationArgsForCall)
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotificationCalls(stub func(context.Context, string, string, domain.PayoutAddress) error) {
+	fake.sendPayoutAddressCancelledNotificationMutex.Lock()
+	defer fake.sendPayoutAddressCancelledNotificationMutex.Unlock()
+	fake.SendPayoutAddressCancelledNotificationStub = stub
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotificationArgsForCall(i int) (context.Context, string, string, domain.PayoutAddress) {
+	fake.sendPayoutAddressCancelledNotificationMutex.RLock()
+	defer fake.sendPayoutAddressCancelledNotificationMutex.RUnlock()
+	argsForCall := fake.sendPayoutAddressCancelledNotificationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotificationReturns(result1 error) {
+	fake.sendPayoutAddressCancelledNotificationMutex.Lock()
+	defer fake.sendPayoutAddressCancelledNotificationMutex.Unlock()
+	fake.SendPayoutAddressCancelledNotificationStub = nil
+	fake.sendPayoutAddressCancelledNotificationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPayoutAddressCancelledNotificationReturnsOnCall(i int, result1 error) {
+	fake.sendPayoutAddressCancelledNotificationMutex.Lock()
+	defer fake.sendPayoutAddressCancelledNotificationMutex.Unlock()
+	fake.SendPayoutAddressCancelledNotificationStub = nil
+	if fake.sendPayoutAddressCancelledNotificationReturnsOnCall == nil {
+		fake.sendPayoutAddressCancelledNotificationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendPayoutAddressCancelledNotificationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotification(arg1 context.Context, arg2 string, arg3 string, arg4 domain.PayoutAddress, arg5 string) error {
+	fake.sendPayoutAddressChangeNotificationMutex.Lock()
+	ret, specificReturn := fake.sendPayoutAddressChangeNotificationReturnsOnCall[len(fake.sendPayoutAddressChangeNotificationArgsForCall)]
+	fake.sendPayoutAddressChangeNotificationArgsForCall = append(fake.sendPayoutAddressChangeNotificationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.PayoutAddress
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SendPayoutAddressChangeNotificationStub
+	fakeReturns := fake.sendPayoutAddressChangeNotificationReturns
+	fake.recordInvocation("SendPayoutAddressChangeNotification", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.sendPayoutAddressChangeNotificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotificationCallCount() int {
+	fake.sendPayoutAddressChangeNotificationMutex.RLock()
+	defer fake.sendPayoutAddressChangeNotificationMutex.RUnlock()
+	return len(fake.sendPayoutAddressChangeNotificationArgsForCall)
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotificationCalls(stub func(context.Context, string, string, domain.PayoutAddress, string) error) {
+	fake.sendPayoutAddressChangeNotificationMutex.Lock()
+	defer fake.sendPayoutAddressChangeNotificationMutex.Unlock()
+	fake.SendPayoutAddressChangeNotificationStub = stub
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotificationArgsForCall(i int) (context.Context, string, string, domain.PayoutAddress, string) {
+	fake.sendPayoutAddressChangeNotificationMutex.RLock()
+	defer fake.sendPayoutAddressChangeNotificationMutex.RUnlock()
+	argsForCall := fake.sendPayoutAddressChangeNotificationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotificationReturns(result1 error) {
+	fake.sendPayoutAddressChangeNotificationMutex.Lock()
+	defer fake.sendPayoutAddressChangeNotificationMutex.Unlock()
+	fake.SendPayoutAddressChangeNotificationStub = nil
+	fake.sendPayoutAddressChangeNotificationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendPayoutAddressChangeNotificationReturnsOnCall(i int, result1 error) {
+	fake.sendPayoutAddressChangeNotificationMutex.Lock()
+	defer fake.sendPayoutAddressChangeNotificationMutex.Unlock()
+	fake.SendPayoutAddressChangeNotificationStub = nil
+	if fake.sendPayoutAddressChangeNotificationReturnsOnCall == nil {
+		fake.sendPayoutAddressChangeNotificationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendPayoutAddressChangeNotificationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendTeamInvitation(arg1 context.Context, arg2 string, arg3 string, arg4 domain.Invitation, arg5 string) error {
+	fake.sendTeamInvitationMutex.Lock()
+	ret, specificReturn := fake.sendTeamInvitationReturnsOnCall[len(fake.sendTeamInvitationArgsForCall)]
+	fake.sendTeamInvitationArgsForCall = append(fake.sendTeamInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 domain.Invitation
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SendTeamInvitationStub
+	fakeReturns := fake.sendTeamInvitationReturns
+	fake.recordInvocation("SendTeamInvitation", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.sendTeamInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendTeamInvitationCallCount() int {
+	fake.sendTeamInvitationMutex.RLock()
+	defer fake.sendTeamInvitationMutex.RUnlock()
+	return len(fake.sendTeamInvitationArgsForCall)
+}
+
+func (fake *FakeEmailService) SendTeamInvitationCalls(stub func(context.Context, string, string, domain.Invitation, string) error) {
+	fake.sendTeamInvitationMutex.Lock()
+	defer fake.sendTeamInvitationMutex.Unlock()
+	fake.SendTeamInvitationStub = stub
+}
+
+func (fake *FakeEmailService) SendTeamInvitationArgsForCall(i int) (context.Context, string, string, domain.Invitation, string) {
+	fake.sendTeamInvitationMutex.RLock()
+	defer fake.sendTeamInvitationMutex.RUnlock()
+	argsForCall := fake.sendTeamInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeEmailService) SendTeamInvitationReturns(result1 error) {
+	fake.sendTeamInvitationMutex.Lock()
+	defer fake.sendTeamInvitationMutex.Unlock()
+	fake.SendTeamInvitationStub = nil
+	fake.sendTeamInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendTeamInvitationReturnsOnCall(i int, result1 error) {
+	fake.sendTeamInvitationMutex.Lock()
+	defer fake.sendTeamInvitationMutex.Unlock()
+	fake.SendTeamInvitationStub = nil
+	if fake.sendTeamInvitationReturnsOnCall == nil {
+		fake.sendTeamInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendTeamInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmail(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.sendTransactionPINResetEmailMutex.Lock()
+	ret, specificReturn := fake.sendTransactionPINResetEmailReturnsOnCall[len(fake.sendTransactionPINResetEmailArgsForCall)]
+	fake.sendTransactionPINResetEmailArgsForCall = append(fake.sendTransactionPINResetEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendTransactionPINResetEmailStub
+	fakeReturns := fake.sendTransactionPINResetEmailReturns
+	fake.recordInvocation("SendTransactionPINResetEmail", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendTransactionPINResetEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmailCallCount() int {
+	fake.sendTransactionPINResetEmailMutex.RLock()
+	defer fake.sendTransactionPINResetEmailMutex.RUnlock()
+	return len(fake.sendTransactionPINResetEmailArgsForCall)
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmailCalls(stub func(context.Context, string, string, string) error) {
+	fake.sendTransactionPINResetEmailMutex.Lock()
+	defer fake.sendTransactionPINResetEmailMutex.Unlock()
+	fake.SendTransactionPINResetEmailStub = stub
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmailArgsForCall(i int) (context.Context, string, string, string) {
+	fake.sendTransactionPINResetEmailMutex.RLock()
+	defer fake.sendTransactionPINResetEmailMutex.RUnlock()
+	argsForCall := fake.sendTransactionPINResetEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmailReturns(result1 error) {
+	fake.sendTransactionPINResetEmailMutex.Lock()
+	defer fake.sendTransactionPINResetEmailMutex.Unlock()
+	fake.SendTransactionPINResetEmailStub = nil
+	fake.sendTransactionPINResetEmailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendTransactionPINResetEmailReturnsOnCall(i int, result1 error) {
+	fake.sendTransactionPINResetEmailMutex.Lock()
+	defer fake.sendTransactionPINResetEmailMutex.Unlock()
+	fake.SendTransactionPINResetEmailStub = nil
+	if fake.sendTransactionPINResetEmailReturnsOnCall == nil {
+		fake.sendTransactionPINResetEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendTransactionPINResetEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmation(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 int) error {
+	fake.sendWaitlistConfirmationMutex.Lock()
+	ret, specificReturn := fake.sendWaitlistConfirmationReturnsOnCall[len(fake.sendWaitlistConfirmationArgsForCall)]
+	fake.sendWaitlistConfirmationArgsForCall = append(fake.sendWaitlistConfirmationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SendWaitlistConfirmationStub
+	fakeReturns := fake.sendWaitlistConfirmationReturns
+	fake.recordInvocation("SendWaitlistConfirmation", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.sendWaitlistConfirmationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmationCallCount() int {
+	fake.sendWaitlistConfirmationMutex.RLock()
+	defer fake.sendWaitlistConfirmationMutex.RUnlock()
+	return len(fake.sendWaitlistConfirmationArgsForCall)
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmationCalls(stub func(context.Context, string, string, string, int) error) {
+	fake.sendWaitlistConfirmationMutex.Lock()
+	defer fake.sendWaitlistConfirmationMutex.Unlock()
+	fake.SendWaitlistConfirmationStub = stub
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmationArgsForCall(i int) (context.Context, string, string, string, int) {
+	fake.sendWaitlistConfirmationMutex.RLock()
+	defer fake.sendWaitlistConfirmationMutex.RUnlock()
+	argsForCall := fake.sendWaitlistConfirmationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmationReturns(result1 error) {
+	fake.sendWaitlistConfirmationMutex.Lock()
+	defer fake.sendWaitlistConfirmationMutex.Unlock()
+	fake.SendWaitlistConfirmationStub = nil
+	fake.sendWaitlistConfirmationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendWaitlistConfirmationReturnsOnCall(i int, result1 error) {
+	fake.sendWaitlistConfirmationMutex.Lock()
+	defer fake.sendWaitlistConfirmationMutex.Unlock()
+	fake.SendWaitlistConfirmationStub = nil
+	if fake.sendWaitlistConfirmationReturnsOnCall == nil {
+		fake.sendWaitlistConfirmationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendWaitlistConfirmationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitation(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.sendWaitlistInvitationMutex.Lock()
+	ret, specificReturn := fake.sendWaitlistInvitationReturnsOnCall[len(fake.sendWaitlistInvitationArgsForCall)]
+	fake.sendWaitlistInvitationArgsForCall = append(fake.sendWaitlistInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendWaitlistInvitationStub
+	fakeReturns := fake.sendWaitlistInvitationReturns
+	fake.recordInvocation("SendWaitlistInvitation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendWaitlistInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitationCallCount() int {
+	fake.sendWaitlistInvitationMutex.RLock()
+	defer fake.sendWaitlistInvitationMutex.RUnlock()
+	return len(fake.sendWaitlistInvitationArgsForCall)
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitationCalls(stub func(context.Context, string, string, string) error) {
+	fake.sendWaitlistInvitationMutex.Lock()
+	defer fake.sendWaitlistInvitationMutex.Unlock()
+	fake.SendWaitlistInvitationStub = stub
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitationArgsForCall(i int) (context.Context, string, string, string) {
+	fake.sendWaitlistInvitationMutex.RLock()
+	defer fake.sendWaitlistInvitationMutex.RUnlock()
+	argsForCall := fake.sendWaitlistInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitationReturns(result1 error) {
+	fake.sendWaitlistInvitationMutex.Lock()
+	defer fake.sendWaitlistInvitationMutex.Unlock()
+	fake.SendWaitlistInvitationStub = nil
+	fake.sendWaitlistInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) SendWaitlistInvitationReturnsOnCall(i int, result1 error) {
+	fake.sendWaitlistInvitationMutex.Lock()
+	defer fake.sendWaitlistInvitationMutex.Unlock()
+	fake.SendWaitlistInvitationStub = nil
+	if fake.sendWaitlistInvitationReturnsOnCall == nil {
+		fake.sendWaitlistInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendWaitlistInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEmailService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEmailService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.EmailService = new(FakeEmailService)
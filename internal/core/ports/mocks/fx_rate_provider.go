@@ -0,0 +1,188 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeFXRateProvider struct {
+	GetRatesStub        func(context.Context, string, []string) ([]domain.FXRate, error)
+	getRatesMutex       sync.RWMutex
+	getRatesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	getRatesReturns struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	getRatesReturnsOnCall map[int]struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct {
+	}
+	nameReturns struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFXRateProvider) GetRates(arg1 context.Context, arg2 string, arg3 []string) ([]domain.FXRate, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.getRatesMutex.Lock()
+	ret, specificReturn := fake.getRatesReturnsOnCall[len(fake.getRatesArgsForCall)]
+	fake.getRatesArgsForCall = append(fake.getRatesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.GetRatesStub
+	fakeReturns := fake.getRatesReturns
+	fake.recordInvocation("GetRates", []interface{}{arg1, arg2, arg3Copy})
+	fake.getRatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateProvider) GetRatesCallCount() int {
+	fake.getRatesMutex.RLock()
+	defer fake.getRatesMutex.RUnlock()
+	return len(fake.getRatesArgsForCall)
+}
+
+func (fake *FakeFXRateProvider) GetRatesCalls(stub func(context.Context, string, []string) ([]domain.FXRate, error)) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = stub
+}
+
+func (fake *FakeFXRateProvider) GetRatesArgsForCall(i int) (context.Context, string, []string) {
+	fake.getRatesMutex.RLock()
+	defer fake.getRatesMutex.RUnlock()
+	argsForCall := fake.getRatesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFXRateProvider) GetRatesReturns(result1 []domain.FXRate, result2 error) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = nil
+	fake.getRatesReturns = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateProvider) GetRatesReturnsOnCall(i int, result1 []domain.FXRate, result2 error) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = nil
+	if fake.getRatesReturnsOnCall == nil {
+		fake.getRatesReturnsOnCall = make(map[int]struct {
+			result1 []domain.FXRate
+			result2 error
+		})
+	}
+	fake.getRatesReturnsOnCall[i] = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateProvider) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
+	}{})
+	stub := fake.NameStub
+	fakeReturns := fake.nameReturns
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFXRateProvider) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakeFXRateProvider) NameCalls(stub func() string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = stub
+}
+
+func (fake *FakeFXRateProvider) NameReturns(result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeFXRateProvider) NameReturnsOnCall(i int, result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeFXRateProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFXRateProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.FXRateProvider = new(FakeFXRateProvider)
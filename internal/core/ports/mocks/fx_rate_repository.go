@@ -0,0 +1,525 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeFXRateRepository struct {
+	CreateQuoteStub        func(context.Context, domain.FXQuote) (*domain.FXQuote, error)
+	createQuoteMutex       sync.RWMutex
+	createQuoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.FXQuote
+	}
+	createQuoteReturns struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	createQuoteReturnsOnCall map[int]struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	GetLatestRateStub        func(context.Context, string, string) (*domain.FXRate, error)
+	getLatestRateMutex       sync.RWMutex
+	getLatestRateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getLatestRateReturns struct {
+		result1 *domain.FXRate
+		result2 error
+	}
+	getLatestRateReturnsOnCall map[int]struct {
+		result1 *domain.FXRate
+		result2 error
+	}
+	GetQuoteStub        func(context.Context, uuid.UUID) (*domain.FXQuote, error)
+	getQuoteMutex       sync.RWMutex
+	getQuoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getQuoteReturns struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	getQuoteReturnsOnCall map[int]struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	ListLatestRatesStub        func(context.Context, string) ([]domain.FXRate, error)
+	listLatestRatesMutex       sync.RWMutex
+	listLatestRatesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listLatestRatesReturns struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	listLatestRatesReturnsOnCall map[int]struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	ListRateHistoryStub        func(context.Context, string, string, time.Time, time.Time, int) ([]domain.FXRate, error)
+	listRateHistoryMutex       sync.RWMutex
+	listRateHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+		arg5 time.Time
+		arg6 int
+	}
+	listRateHistoryReturns struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	listRateHistoryReturnsOnCall map[int]struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	SaveRateStub        func(context.Context, domain.FXRate) (*domain.FXRate, error)
+	saveRateMutex       sync.RWMutex
+	saveRateArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.FXRate
+	}
+	saveRateReturns struct {
+		result1 *domain.FXRate
+		result2 error
+	}
+	saveRateReturnsOnCall map[int]struct {
+		result1 *domain.FXRate
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFXRateRepository) CreateQuote(arg1 context.Context, arg2 domain.FXQuote) (*domain.FXQuote, error) {
+	fake.createQuoteMutex.Lock()
+	ret, specificReturn := fake.createQuoteReturnsOnCall[len(fake.createQuoteArgsForCall)]
+	fake.createQuoteArgsForCall = append(fake.createQuoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.FXQuote
+	}{arg1, arg2})
+	stub := fake.CreateQuoteStub
+	fakeReturns := fake.createQuoteReturns
+	fake.recordInvocation("CreateQuote", []interface{}{arg1, arg2})
+	fake.createQuoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) CreateQuoteCallCount() int {
+	fake.createQuoteMutex.RLock()
+	defer fake.createQuoteMutex.RUnlock()
+	return len(fake.createQuoteArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) CreateQuoteCalls(stub func(context.Context, domain.FXQuote) (*domain.FXQuote, error)) {
+	fake.createQuoteMutex.Lock()
+	defer fake.createQuoteMutex.Unlock()
+	fake.CreateQuoteStub = stub
+}
+
+func (fake *FakeFXRateRepository) CreateQuoteArgsForCall(i int) (context.Context, domain.FXQuote) {
+	fake.createQuoteMutex.RLock()
+	defer fake.createQuoteMutex.RUnlock()
+	argsForCall := fake.createQuoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFXRateRepository) CreateQuoteReturns(result1 *domain.FXQuote, result2 error) {
+	fake.createQuoteMutex.Lock()
+	defer fake.createQuoteMutex.Unlock()
+	fake.CreateQuoteStub = nil
+	fake.createQuoteReturns = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) CreateQuoteReturnsOnCall(i int, result1 *domain.FXQuote, result2 error) {
+	fake.createQuoteMutex.Lock()
+	defer fake.createQuoteMutex.Unlock()
+	fake.CreateQuoteStub = nil
+	if fake.createQuoteReturnsOnCall == nil {
+		fake.createQuoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXQuote
+			result2 error
+		})
+	}
+	fake.createQuoteReturnsOnCall[i] = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) GetLatestRate(arg1 context.Context, arg2 string, arg3 string) (*domain.FXRate, error) {
+	fake.getLatestRateMutex.Lock()
+	ret, specificReturn := fake.getLatestRateReturnsOnCall[len(fake.getLatestRateArgsForCall)]
+	fake.getLatestRateArgsForCall = append(fake.getLatestRateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetLatestRateStub
+	fakeReturns := fake.getLatestRateReturns
+	fake.recordInvocation("GetLatestRate", []interface{}{arg1, arg2, arg3})
+	fake.getLatestRateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) GetLatestRateCallCount() int {
+	fake.getLatestRateMutex.RLock()
+	defer fake.getLatestRateMutex.RUnlock()
+	return len(fake.getLatestRateArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) GetLatestRateCalls(stub func(context.Context, string, string) (*domain.FXRate, error)) {
+	fake.getLatestRateMutex.Lock()
+	defer fake.getLatestRateMutex.Unlock()
+	fake.GetLatestRateStub = stub
+}
+
+func (fake *FakeFXRateRepository) GetLatestRateArgsForCall(i int) (context.Context, string, string) {
+	fake.getLatestRateMutex.RLock()
+	defer fake.getLatestRateMutex.RUnlock()
+	argsForCall := fake.getLatestRateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFXRateRepository) GetLatestRateReturns(result1 *domain.FXRate, result2 error) {
+	fake.getLatestRateMutex.Lock()
+	defer fake.getLatestRateMutex.Unlock()
+	fake.GetLatestRateStub = nil
+	fake.getLatestRateReturns = struct {
+		result1 *domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) GetLatestRateReturnsOnCall(i int, result1 *domain.FXRate, result2 error) {
+	fake.getLatestRateMutex.Lock()
+	defer fake.getLatestRateMutex.Unlock()
+	fake.GetLatestRateStub = nil
+	if fake.getLatestRateReturnsOnCall == nil {
+		fake.getLatestRateReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXRate
+			result2 error
+		})
+	}
+	fake.getLatestRateReturnsOnCall[i] = struct {
+		result1 *domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) GetQuote(arg1 context.Context, arg2 uuid.UUID) (*domain.FXQuote, error) {
+	fake.getQuoteMutex.Lock()
+	ret, specificReturn := fake.getQuoteReturnsOnCall[len(fake.getQuoteArgsForCall)]
+	fake.getQuoteArgsForCall = append(fake.getQuoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetQuoteStub
+	fakeReturns := fake.getQuoteReturns
+	fake.recordInvocation("GetQuote", []interface{}{arg1, arg2})
+	fake.getQuoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) GetQuoteCallCount() int {
+	fake.getQuoteMutex.RLock()
+	defer fake.getQuoteMutex.RUnlock()
+	return len(fake.getQuoteArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) GetQuoteCalls(stub func(context.Context, uuid.UUID) (*domain.FXQuote, error)) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = stub
+}
+
+func (fake *FakeFXRateRepository) GetQuoteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getQuoteMutex.RLock()
+	defer fake.getQuoteMutex.RUnlock()
+	argsForCall := fake.getQuoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFXRateRepository) GetQuoteReturns(result1 *domain.FXQuote, result2 error) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = nil
+	fake.getQuoteReturns = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) GetQuoteReturnsOnCall(i int, result1 *domain.FXQuote, result2 error) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = nil
+	if fake.getQuoteReturnsOnCall == nil {
+		fake.getQuoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXQuote
+			result2 error
+		})
+	}
+	fake.getQuoteReturnsOnCall[i] = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) ListLatestRates(arg1 context.Context, arg2 string) ([]domain.FXRate, error) {
+	fake.listLatestRatesMutex.Lock()
+	ret, specificReturn := fake.listLatestRatesReturnsOnCall[len(fake.listLatestRatesArgsForCall)]
+	fake.listLatestRatesArgsForCall = append(fake.listLatestRatesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListLatestRatesStub
+	fakeReturns := fake.listLatestRatesReturns
+	fake.recordInvocation("ListLatestRates", []interface{}{arg1, arg2})
+	fake.listLatestRatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) ListLatestRatesCallCount() int {
+	fake.listLatestRatesMutex.RLock()
+	defer fake.listLatestRatesMutex.RUnlock()
+	return len(fake.listLatestRatesArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) ListLatestRatesCalls(stub func(context.Context, string) ([]domain.FXRate, error)) {
+	fake.listLatestRatesMutex.Lock()
+	defer fake.listLatestRatesMutex.Unlock()
+	fake.ListLatestRatesStub = stub
+}
+
+func (fake *FakeFXRateRepository) ListLatestRatesArgsForCall(i int) (context.Context, string) {
+	fake.listLatestRatesMutex.RLock()
+	defer fake.listLatestRatesMutex.RUnlock()
+	argsForCall := fake.listLatestRatesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFXRateRepository) ListLatestRatesReturns(result1 []domain.FXRate, result2 error) {
+	fake.listLatestRatesMutex.Lock()
+	defer fake.listLatestRatesMutex.Unlock()
+	fake.ListLatestRatesStub = nil
+	fake.listLatestRatesReturns = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) ListLatestRatesReturnsOnCall(i int, result1 []domain.FXRate, result2 error) {
+	fake.listLatestRatesMutex.Lock()
+	defer fake.listLatestRatesMutex.Unlock()
+	fake.ListLatestRatesStub = nil
+	if fake.listLatestRatesReturnsOnCall == nil {
+		fake.listLatestRatesReturnsOnCall = make(map[int]struct {
+			result1 []domain.FXRate
+			result2 error
+		})
+	}
+	fake.listLatestRatesReturnsOnCall[i] = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) ListRateHistory(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time, arg5 time.Time, arg6 int) ([]domain.FXRate, error) {
+	fake.listRateHistoryMutex.Lock()
+	ret, specificReturn := fake.listRateHistoryReturnsOnCall[len(fake.listRateHistoryArgsForCall)]
+	fake.listRateHistoryArgsForCall = append(fake.listRateHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+		arg5 time.Time
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListRateHistoryStub
+	fakeReturns := fake.listRateHistoryReturns
+	fake.recordInvocation("ListRateHistory", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listRateHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) ListRateHistoryCallCount() int {
+	fake.listRateHistoryMutex.RLock()
+	defer fake.listRateHistoryMutex.RUnlock()
+	return len(fake.listRateHistoryArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) ListRateHistoryCalls(stub func(context.Context, string, string, time.Time, time.Time, int) ([]domain.FXRate, error)) {
+	fake.listRateHistoryMutex.Lock()
+	defer fake.listRateHistoryMutex.Unlock()
+	fake.ListRateHistoryStub = stub
+}
+
+func (fake *FakeFXRateRepository) ListRateHistoryArgsForCall(i int) (context.Context, string, string, time.Time, time.Time, int) {
+	fake.listRateHistoryMutex.RLock()
+	defer fake.listRateHistoryMutex.RUnlock()
+	argsForCall := fake.listRateHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeFXRateRepository) ListRateHistoryReturns(result1 []domain.FXRate, result2 error) {
+	fake.listRateHistoryMutex.Lock()
+	defer fake.listRateHistoryMutex.Unlock()
+	fake.ListRateHistoryStub = nil
+	fake.listRateHistoryReturns = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) ListRateHistoryReturnsOnCall(i int, result1 []domain.FXRate, result2 error) {
+	fake.listRateHistoryMutex.Lock()
+	defer fake.listRateHistoryMutex.Unlock()
+	fake.ListRateHistoryStub = nil
+	if fake.listRateHistoryReturnsOnCall == nil {
+		fake.listRateHistoryReturnsOnCall = make(map[int]struct {
+			result1 []domain.FXRate
+			result2 error
+		})
+	}
+	fake.listRateHistoryReturnsOnCall[i] = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) SaveRate(arg1 context.Context, arg2 domain.FXRate) (*domain.FXRate, error) {
+	fake.saveRateMutex.Lock()
+	ret, specificReturn := fake.saveRateReturnsOnCall[len(fake.saveRateArgsForCall)]
+	fake.saveRateArgsForCall = append(fake.saveRateArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.FXRate
+	}{arg1, arg2})
+	stub := fake.SaveRateStub
+	fakeReturns := fake.saveRateReturns
+	fake.recordInvocation("SaveRate", []interface{}{arg1, arg2})
+	fake.saveRateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXRateRepository) SaveRateCallCount() int {
+	fake.saveRateMutex.RLock()
+	defer fake.saveRateMutex.RUnlock()
+	return len(fake.saveRateArgsForCall)
+}
+
+func (fake *FakeFXRateRepository) SaveRateCalls(stub func(context.Context, domain.FXRate) (*domain.FXRate, error)) {
+	fake.saveRateMutex.Lock()
+	defer fake.saveRateMutex.Unlock()
+	fake.SaveRateStub = stub
+}
+
+func (fake *FakeFXRateRepository) SaveRateArgsForCall(i int) (context.Context, domain.FXRate) {
+	fake.saveRateMutex.RLock()
+	defer fake.saveRateMutex.RUnlock()
+	argsForCall := fake.saveRateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFXRateRepository) SaveRateReturns(result1 *domain.FXRate, result2 error) {
+	fake.saveRateMutex.Lock()
+	defer fake.saveRateMutex.Unlock()
+	fake.SaveRateStub = nil
+	fake.saveRateReturns = struct {
+		result1 *domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) SaveRateReturnsOnCall(i int, result1 *domain.FXRate, result2 error) {
+	fake.saveRateMutex.Lock()
+	defer fake.saveRateMutex.Unlock()
+	fake.SaveRateStub = nil
+	if fake.saveRateReturnsOnCall == nil {
+		fake.saveRateReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXRate
+			result2 error
+		})
+	}
+	fake.saveRateReturnsOnCall[i] = struct {
+		result1 *domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXRateRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFXRateRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.FXRateRepository = new(FakeFXRateRepository)
@@ -0,0 +1,456 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+type FakeFXService struct {
+	ConvertStub        func(context.Context, uuid.UUID, money.Money, int32) (money.Money, error)
+	convertMutex       sync.RWMutex
+	convertArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 money.Money
+		arg4 int32
+	}
+	convertReturns struct {
+		result1 money.Money
+		result2 error
+	}
+	convertReturnsOnCall map[int]struct {
+		result1 money.Money
+		result2 error
+	}
+	GetQuoteStub        func(context.Context, uuid.UUID) (*domain.FXQuote, error)
+	getQuoteMutex       sync.RWMutex
+	getQuoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getQuoteReturns struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	getQuoteReturnsOnCall map[int]struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	GetRateHistoryStub        func(context.Context, string, string, *time.Time, *time.Time) ([]domain.FXRate, error)
+	getRateHistoryMutex       sync.RWMutex
+	getRateHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *time.Time
+		arg5 *time.Time
+	}
+	getRateHistoryReturns struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	getRateHistoryReturnsOnCall map[int]struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	GetRatesStub        func(context.Context, string, []string) ([]domain.FXRate, error)
+	getRatesMutex       sync.RWMutex
+	getRatesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	getRatesReturns struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	getRatesReturnsOnCall map[int]struct {
+		result1 []domain.FXRate
+		result2 error
+	}
+	LockQuoteStub        func(context.Context, string, string) (*domain.FXQuote, error)
+	lockQuoteMutex       sync.RWMutex
+	lockQuoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	lockQuoteReturns struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	lockQuoteReturnsOnCall map[int]struct {
+		result1 *domain.FXQuote
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFXService) Convert(arg1 context.Context, arg2 uuid.UUID, arg3 money.Money, arg4 int32) (money.Money, error) {
+	fake.convertMutex.Lock()
+	ret, specificReturn := fake.convertReturnsOnCall[len(fake.convertArgsForCall)]
+	fake.convertArgsForCall = append(fake.convertArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 money.Money
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ConvertStub
+	fakeReturns := fake.convertReturns
+	fake.recordInvocation("Convert", []interface{}{arg1, arg2, arg3, arg4})
+	fake.convertMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXService) ConvertCallCount() int {
+	fake.convertMutex.RLock()
+	defer fake.convertMutex.RUnlock()
+	return len(fake.convertArgsForCall)
+}
+
+func (fake *FakeFXService) ConvertCalls(stub func(context.Context, uuid.UUID, money.Money, int32) (money.Money, error)) {
+	fake.convertMutex.Lock()
+	defer fake.convertMutex.Unlock()
+	fake.ConvertStub = stub
+}
+
+func (fake *FakeFXService) ConvertArgsForCall(i int) (context.Context, uuid.UUID, money.Money, int32) {
+	fake.convertMutex.RLock()
+	defer fake.convertMutex.RUnlock()
+	argsForCall := fake.convertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFXService) ConvertReturns(result1 money.Money, result2 error) {
+	fake.convertMutex.Lock()
+	defer fake.convertMutex.Unlock()
+	fake.ConvertStub = nil
+	fake.convertReturns = struct {
+		result1 money.Money
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) ConvertReturnsOnCall(i int, result1 money.Money, result2 error) {
+	fake.convertMutex.Lock()
+	defer fake.convertMutex.Unlock()
+	fake.ConvertStub = nil
+	if fake.convertReturnsOnCall == nil {
+		fake.convertReturnsOnCall = make(map[int]struct {
+			result1 money.Money
+			result2 error
+		})
+	}
+	fake.convertReturnsOnCall[i] = struct {
+		result1 money.Money
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetQuote(arg1 context.Context, arg2 uuid.UUID) (*domain.FXQuote, error) {
+	fake.getQuoteMutex.Lock()
+	ret, specificReturn := fake.getQuoteReturnsOnCall[len(fake.getQuoteArgsForCall)]
+	fake.getQuoteArgsForCall = append(fake.getQuoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetQuoteStub
+	fakeReturns := fake.getQuoteReturns
+	fake.recordInvocation("GetQuote", []interface{}{arg1, arg2})
+	fake.getQuoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXService) GetQuoteCallCount() int {
+	fake.getQuoteMutex.RLock()
+	defer fake.getQuoteMutex.RUnlock()
+	return len(fake.getQuoteArgsForCall)
+}
+
+func (fake *FakeFXService) GetQuoteCalls(stub func(context.Context, uuid.UUID) (*domain.FXQuote, error)) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = stub
+}
+
+func (fake *FakeFXService) GetQuoteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getQuoteMutex.RLock()
+	defer fake.getQuoteMutex.RUnlock()
+	argsForCall := fake.getQuoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFXService) GetQuoteReturns(result1 *domain.FXQuote, result2 error) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = nil
+	fake.getQuoteReturns = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetQuoteReturnsOnCall(i int, result1 *domain.FXQuote, result2 error) {
+	fake.getQuoteMutex.Lock()
+	defer fake.getQuoteMutex.Unlock()
+	fake.GetQuoteStub = nil
+	if fake.getQuoteReturnsOnCall == nil {
+		fake.getQuoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXQuote
+			result2 error
+		})
+	}
+	fake.getQuoteReturnsOnCall[i] = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetRateHistory(arg1 context.Context, arg2 string, arg3 string, arg4 *time.Time, arg5 *time.Time) ([]domain.FXRate, error) {
+	fake.getRateHistoryMutex.Lock()
+	ret, specificReturn := fake.getRateHistoryReturnsOnCall[len(fake.getRateHistoryArgsForCall)]
+	fake.getRateHistoryArgsForCall = append(fake.getRateHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *time.Time
+		arg5 *time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetRateHistoryStub
+	fakeReturns := fake.getRateHistoryReturns
+	fake.recordInvocation("GetRateHistory", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getRateHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXService) GetRateHistoryCallCount() int {
+	fake.getRateHistoryMutex.RLock()
+	defer fake.getRateHistoryMutex.RUnlock()
+	return len(fake.getRateHistoryArgsForCall)
+}
+
+func (fake *FakeFXService) GetRateHistoryCalls(stub func(context.Context, string, string, *time.Time, *time.Time) ([]domain.FXRate, error)) {
+	fake.getRateHistoryMutex.Lock()
+	defer fake.getRateHistoryMutex.Unlock()
+	fake.GetRateHistoryStub = stub
+}
+
+func (fake *FakeFXService) GetRateHistoryArgsForCall(i int) (context.Context, string, string, *time.Time, *time.Time) {
+	fake.getRateHistoryMutex.RLock()
+	defer fake.getRateHistoryMutex.RUnlock()
+	argsForCall := fake.getRateHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeFXService) GetRateHistoryReturns(result1 []domain.FXRate, result2 error) {
+	fake.getRateHistoryMutex.Lock()
+	defer fake.getRateHistoryMutex.Unlock()
+	fake.GetRateHistoryStub = nil
+	fake.getRateHistoryReturns = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetRateHistoryReturnsOnCall(i int, result1 []domain.FXRate, result2 error) {
+	fake.getRateHistoryMutex.Lock()
+	defer fake.getRateHistoryMutex.Unlock()
+	fake.GetRateHistoryStub = nil
+	if fake.getRateHistoryReturnsOnCall == nil {
+		fake.getRateHistoryReturnsOnCall = make(map[int]struct {
+			result1 []domain.FXRate
+			result2 error
+		})
+	}
+	fake.getRateHistoryReturnsOnCall[i] = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetRates(arg1 context.Context, arg2 string, arg3 []string) ([]domain.FXRate, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.getRatesMutex.Lock()
+	ret, specificReturn := fake.getRatesReturnsOnCall[len(fake.getRatesArgsForCall)]
+	fake.getRatesArgsForCall = append(fake.getRatesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.GetRatesStub
+	fakeReturns := fake.getRatesReturns
+	fake.recordInvocation("GetRates", []interface{}{arg1, arg2, arg3Copy})
+	fake.getRatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXService) GetRatesCallCount() int {
+	fake.getRatesMutex.RLock()
+	defer fake.getRatesMutex.RUnlock()
+	return len(fake.getRatesArgsForCall)
+}
+
+func (fake *FakeFXService) GetRatesCalls(stub func(context.Context, string, []string) ([]domain.FXRate, error)) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = stub
+}
+
+func (fake *FakeFXService) GetRatesArgsForCall(i int) (context.Context, string, []string) {
+	fake.getRatesMutex.RLock()
+	defer fake.getRatesMutex.RUnlock()
+	argsForCall := fake.getRatesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFXService) GetRatesReturns(result1 []domain.FXRate, result2 error) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = nil
+	fake.getRatesReturns = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) GetRatesReturnsOnCall(i int, result1 []domain.FXRate, result2 error) {
+	fake.getRatesMutex.Lock()
+	defer fake.getRatesMutex.Unlock()
+	fake.GetRatesStub = nil
+	if fake.getRatesReturnsOnCall == nil {
+		fake.getRatesReturnsOnCall = make(map[int]struct {
+			result1 []domain.FXRate
+			result2 error
+		})
+	}
+	fake.getRatesReturnsOnCall[i] = struct {
+		result1 []domain.FXRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) LockQuote(arg1 context.Context, arg2 string, arg3 string) (*domain.FXQuote, error) {
+	fake.lockQuoteMutex.Lock()
+	ret, specificReturn := fake.lockQuoteReturnsOnCall[len(fake.lockQuoteArgsForCall)]
+	fake.lockQuoteArgsForCall = append(fake.lockQuoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LockQuoteStub
+	fakeReturns := fake.lockQuoteReturns
+	fake.recordInvocation("LockQuote", []interface{}{arg1, arg2, arg3})
+	fake.lockQuoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFXService) LockQuoteCallCount() int {
+	fake.lockQuoteMutex.RLock()
+	defer fake.lockQuoteMutex.RUnlock()
+	return len(fake.lockQuoteArgsForCall)
+}
+
+func (fake *FakeFXService) LockQuoteCalls(stub func(context.Context, string, string) (*domain.FXQuote, error)) {
+	fake.lockQuoteMutex.Lock()
+	defer fake.lockQuoteMutex.Unlock()
+	fake.LockQuoteStub = stub
+}
+
+func (fake *FakeFXService) LockQuoteArgsForCall(i int) (context.Context, string, string) {
+	fake.lockQuoteMutex.RLock()
+	defer fake.lockQuoteMutex.RUnlock()
+	argsForCall := fake.lockQuoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFXService) LockQuoteReturns(result1 *domain.FXQuote, result2 error) {
+	fake.lockQuoteMutex.Lock()
+	defer fake.lockQuoteMutex.Unlock()
+	fake.LockQuoteStub = nil
+	fake.lockQuoteReturns = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) LockQuoteReturnsOnCall(i int, result1 *domain.FXQuote, result2 error) {
+	fake.lockQuoteMutex.Lock()
+	defer fake.lockQuoteMutex.Unlock()
+	fake.LockQuoteStub = nil
+	if fake.lockQuoteReturnsOnCall == nil {
+		fake.lockQuoteReturnsOnCall = make(map[int]struct {
+			result1 *domain.FXQuote
+			result2 error
+		})
+	}
+	fake.lockQuoteReturnsOnCall[i] = struct {
+		result1 *domain.FXQuote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFXService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFXService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.FXService = new(FakeFXService)
@@ -0,0 +1,197 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeIndexerCheckpointRepository struct {
+	GetCheckpointStub        func(context.Context, string) (*domain.IndexerCheckpoint, error)
+	getCheckpointMutex       sync.RWMutex
+	getCheckpointArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getCheckpointReturns struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}
+	getCheckpointReturnsOnCall map[int]struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}
+	SaveCheckpointStub        func(context.Context, domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error)
+	saveCheckpointMutex       sync.RWMutex
+	saveCheckpointArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.IndexerCheckpoint
+	}
+	saveCheckpointReturns struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}
+	saveCheckpointReturnsOnCall map[int]struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpoint(arg1 context.Context, arg2 string) (*domain.IndexerCheckpoint, error) {
+	fake.getCheckpointMutex.Lock()
+	ret, specificReturn := fake.getCheckpointReturnsOnCall[len(fake.getCheckpointArgsForCall)]
+	fake.getCheckpointArgsForCall = append(fake.getCheckpointArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCheckpointStub
+	fakeReturns := fake.getCheckpointReturns
+	fake.recordInvocation("GetCheckpoint", []interface{}{arg1, arg2})
+	fake.getCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpointCallCount() int {
+	fake.getCheckpointMutex.RLock()
+	defer fake.getCheckpointMutex.RUnlock()
+	return len(fake.getCheckpointArgsForCall)
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpointCalls(stub func(context.Context, string) (*domain.IndexerCheckpoint, error)) {
+	fake.getCheckpointMutex.Lock()
+	defer fake.getCheckpointMutex.Unlock()
+	fake.GetCheckpointStub = stub
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpointArgsForCall(i int) (context.Context, string) {
+	fake.getCheckpointMutex.RLock()
+	defer fake.getCheckpointMutex.RUnlock()
+	argsForCall := fake.getCheckpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpointReturns(result1 *domain.IndexerCheckpoint, result2 error) {
+	fake.getCheckpointMutex.Lock()
+	defer fake.getCheckpointMutex.Unlock()
+	fake.GetCheckpointStub = nil
+	fake.getCheckpointReturns = struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexerCheckpointRepository) GetCheckpointReturnsOnCall(i int, result1 *domain.IndexerCheckpoint, result2 error) {
+	fake.getCheckpointMutex.Lock()
+	defer fake.getCheckpointMutex.Unlock()
+	fake.GetCheckpointStub = nil
+	if fake.getCheckpointReturnsOnCall == nil {
+		fake.getCheckpointReturnsOnCall = make(map[int]struct {
+			result1 *domain.IndexerCheckpoint
+			result2 error
+		})
+	}
+	fake.getCheckpointReturnsOnCall[i] = struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpoint(arg1 context.Context, arg2 domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error) {
+	fake.saveCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveCheckpointReturnsOnCall[len(fake.saveCheckpointArgsForCall)]
+	fake.saveCheckpointArgsForCall = append(fake.saveCheckpointArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.IndexerCheckpoint
+	}{arg1, arg2})
+	stub := fake.SaveCheckpointStub
+	fakeReturns := fake.saveCheckpointReturns
+	fake.recordInvocation("SaveCheckpoint", []interface{}{arg1, arg2})
+	fake.saveCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpointCallCount() int {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	return len(fake.saveCheckpointArgsForCall)
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpointCalls(stub func(context.Context, domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error)) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = stub
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpointArgsForCall(i int) (context.Context, domain.IndexerCheckpoint) {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	argsForCall := fake.saveCheckpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpointReturns(result1 *domain.IndexerCheckpoint, result2 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	fake.saveCheckpointReturns = struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexerCheckpointRepository) SaveCheckpointReturnsOnCall(i int, result1 *domain.IndexerCheckpoint, result2 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	if fake.saveCheckpointReturnsOnCall == nil {
+		fake.saveCheckpointReturnsOnCall = make(map[int]struct {
+			result1 *domain.IndexerCheckpoint
+			result2 error
+		})
+	}
+	fake.saveCheckpointReturnsOnCall[i] = struct {
+		result1 *domain.IndexerCheckpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexerCheckpointRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIndexerCheckpointRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.IndexerCheckpointRepository = new(FakeIndexerCheckpointRepository)
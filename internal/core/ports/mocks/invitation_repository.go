@@ -0,0 +1,602 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvitationRepository struct {
+	AcceptInvitationStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.OrganizationMember, error)
+	acceptInvitationMutex       sync.RWMutex
+	acceptInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	acceptInvitationReturns struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	acceptInvitationReturnsOnCall map[int]struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	CreateInvitationStub        func(context.Context, domain.Invitation) (*domain.Invitation, error)
+	createInvitationMutex       sync.RWMutex
+	createInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invitation
+	}
+	createInvitationReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	createInvitationReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	GetInvitationByIDStub        func(context.Context, uuid.UUID) (*domain.Invitation, error)
+	getInvitationByIDMutex       sync.RWMutex
+	getInvitationByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getInvitationByIDReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	getInvitationByIDReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	GetPendingInvitationByEmailStub        func(context.Context, uuid.UUID, string) (*domain.Invitation, error)
+	getPendingInvitationByEmailMutex       sync.RWMutex
+	getPendingInvitationByEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getPendingInvitationByEmailReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	getPendingInvitationByEmailReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	ListPendingInvitationsStub        func(context.Context, uuid.UUID) ([]domain.Invitation, error)
+	listPendingInvitationsMutex       sync.RWMutex
+	listPendingInvitationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPendingInvitationsReturns struct {
+		result1 []domain.Invitation
+		result2 error
+	}
+	listPendingInvitationsReturnsOnCall map[int]struct {
+		result1 []domain.Invitation
+		result2 error
+	}
+	RefreshInvitationTokenStub        func(context.Context, uuid.UUID, string, time.Time) (*domain.Invitation, error)
+	refreshInvitationTokenMutex       sync.RWMutex
+	refreshInvitationTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 time.Time
+	}
+	refreshInvitationTokenReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	refreshInvitationTokenReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	RevokeInvitationStub        func(context.Context, uuid.UUID) (*domain.Invitation, error)
+	revokeInvitationMutex       sync.RWMutex
+	revokeInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	revokeInvitationReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	revokeInvitationReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.OrganizationMember, error) {
+	fake.acceptInvitationMutex.Lock()
+	ret, specificReturn := fake.acceptInvitationReturnsOnCall[len(fake.acceptInvitationArgsForCall)]
+	fake.acceptInvitationArgsForCall = append(fake.acceptInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.AcceptInvitationStub
+	fakeReturns := fake.acceptInvitationReturns
+	fake.recordInvocation("AcceptInvitation", []interface{}{arg1, arg2, arg3})
+	fake.acceptInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitationCallCount() int {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	return len(fake.acceptInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.OrganizationMember, error)) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = stub
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	argsForCall := fake.acceptInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitationReturns(result1 *domain.OrganizationMember, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	fake.acceptInvitationReturns = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) AcceptInvitationReturnsOnCall(i int, result1 *domain.OrganizationMember, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	if fake.acceptInvitationReturnsOnCall == nil {
+		fake.acceptInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.acceptInvitationReturnsOnCall[i] = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) CreateInvitation(arg1 context.Context, arg2 domain.Invitation) (*domain.Invitation, error) {
+	fake.createInvitationMutex.Lock()
+	ret, specificReturn := fake.createInvitationReturnsOnCall[len(fake.createInvitationArgsForCall)]
+	fake.createInvitationArgsForCall = append(fake.createInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invitation
+	}{arg1, arg2})
+	stub := fake.CreateInvitationStub
+	fakeReturns := fake.createInvitationReturns
+	fake.recordInvocation("CreateInvitation", []interface{}{arg1, arg2})
+	fake.createInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) CreateInvitationCallCount() int {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	return len(fake.createInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) CreateInvitationCalls(stub func(context.Context, domain.Invitation) (*domain.Invitation, error)) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = stub
+}
+
+func (fake *FakeInvitationRepository) CreateInvitationArgsForCall(i int) (context.Context, domain.Invitation) {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	argsForCall := fake.createInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) CreateInvitationReturns(result1 *domain.Invitation, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	fake.createInvitationReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) CreateInvitationReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	if fake.createInvitationReturnsOnCall == nil {
+		fake.createInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.createInvitationReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByID(arg1 context.Context, arg2 uuid.UUID) (*domain.Invitation, error) {
+	fake.getInvitationByIDMutex.Lock()
+	ret, specificReturn := fake.getInvitationByIDReturnsOnCall[len(fake.getInvitationByIDArgsForCall)]
+	fake.getInvitationByIDArgsForCall = append(fake.getInvitationByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetInvitationByIDStub
+	fakeReturns := fake.getInvitationByIDReturns
+	fake.recordInvocation("GetInvitationByID", []interface{}{arg1, arg2})
+	fake.getInvitationByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByIDCallCount() int {
+	fake.getInvitationByIDMutex.RLock()
+	defer fake.getInvitationByIDMutex.RUnlock()
+	return len(fake.getInvitationByIDArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByIDCalls(stub func(context.Context, uuid.UUID) (*domain.Invitation, error)) {
+	fake.getInvitationByIDMutex.Lock()
+	defer fake.getInvitationByIDMutex.Unlock()
+	fake.GetInvitationByIDStub = stub
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getInvitationByIDMutex.RLock()
+	defer fake.getInvitationByIDMutex.RUnlock()
+	argsForCall := fake.getInvitationByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByIDReturns(result1 *domain.Invitation, result2 error) {
+	fake.getInvitationByIDMutex.Lock()
+	defer fake.getInvitationByIDMutex.Unlock()
+	fake.GetInvitationByIDStub = nil
+	fake.getInvitationByIDReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) GetInvitationByIDReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.getInvitationByIDMutex.Lock()
+	defer fake.getInvitationByIDMutex.Unlock()
+	fake.GetInvitationByIDStub = nil
+	if fake.getInvitationByIDReturnsOnCall == nil {
+		fake.getInvitationByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.getInvitationByIDReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmail(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.Invitation, error) {
+	fake.getPendingInvitationByEmailMutex.Lock()
+	ret, specificReturn := fake.getPendingInvitationByEmailReturnsOnCall[len(fake.getPendingInvitationByEmailArgsForCall)]
+	fake.getPendingInvitationByEmailArgsForCall = append(fake.getPendingInvitationByEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetPendingInvitationByEmailStub
+	fakeReturns := fake.getPendingInvitationByEmailReturns
+	fake.recordInvocation("GetPendingInvitationByEmail", []interface{}{arg1, arg2, arg3})
+	fake.getPendingInvitationByEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmailCallCount() int {
+	fake.getPendingInvitationByEmailMutex.RLock()
+	defer fake.getPendingInvitationByEmailMutex.RUnlock()
+	return len(fake.getPendingInvitationByEmailArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmailCalls(stub func(context.Context, uuid.UUID, string) (*domain.Invitation, error)) {
+	fake.getPendingInvitationByEmailMutex.Lock()
+	defer fake.getPendingInvitationByEmailMutex.Unlock()
+	fake.GetPendingInvitationByEmailStub = stub
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmailArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getPendingInvitationByEmailMutex.RLock()
+	defer fake.getPendingInvitationByEmailMutex.RUnlock()
+	argsForCall := fake.getPendingInvitationByEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmailReturns(result1 *domain.Invitation, result2 error) {
+	fake.getPendingInvitationByEmailMutex.Lock()
+	defer fake.getPendingInvitationByEmailMutex.Unlock()
+	fake.GetPendingInvitationByEmailStub = nil
+	fake.getPendingInvitationByEmailReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) GetPendingInvitationByEmailReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.getPendingInvitationByEmailMutex.Lock()
+	defer fake.getPendingInvitationByEmailMutex.Unlock()
+	fake.GetPendingInvitationByEmailStub = nil
+	if fake.getPendingInvitationByEmailReturnsOnCall == nil {
+		fake.getPendingInvitationByEmailReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.getPendingInvitationByEmailReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitations(arg1 context.Context, arg2 uuid.UUID) ([]domain.Invitation, error) {
+	fake.listPendingInvitationsMutex.Lock()
+	ret, specificReturn := fake.listPendingInvitationsReturnsOnCall[len(fake.listPendingInvitationsArgsForCall)]
+	fake.listPendingInvitationsArgsForCall = append(fake.listPendingInvitationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPendingInvitationsStub
+	fakeReturns := fake.listPendingInvitationsReturns
+	fake.recordInvocation("ListPendingInvitations", []interface{}{arg1, arg2})
+	fake.listPendingInvitationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitationsCallCount() int {
+	fake.listPendingInvitationsMutex.RLock()
+	defer fake.listPendingInvitationsMutex.RUnlock()
+	return len(fake.listPendingInvitationsArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitationsCalls(stub func(context.Context, uuid.UUID) ([]domain.Invitation, error)) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = stub
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPendingInvitationsMutex.RLock()
+	defer fake.listPendingInvitationsMutex.RUnlock()
+	argsForCall := fake.listPendingInvitationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitationsReturns(result1 []domain.Invitation, result2 error) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = nil
+	fake.listPendingInvitationsReturns = struct {
+		result1 []domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) ListPendingInvitationsReturnsOnCall(i int, result1 []domain.Invitation, result2 error) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = nil
+	if fake.listPendingInvitationsReturnsOnCall == nil {
+		fake.listPendingInvitationsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invitation
+			result2 error
+		})
+	}
+	fake.listPendingInvitationsReturnsOnCall[i] = struct {
+		result1 []domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationToken(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 time.Time) (*domain.Invitation, error) {
+	fake.refreshInvitationTokenMutex.Lock()
+	ret, specificReturn := fake.refreshInvitationTokenReturnsOnCall[len(fake.refreshInvitationTokenArgsForCall)]
+	fake.refreshInvitationTokenArgsForCall = append(fake.refreshInvitationTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RefreshInvitationTokenStub
+	fakeReturns := fake.refreshInvitationTokenReturns
+	fake.recordInvocation("RefreshInvitationToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.refreshInvitationTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationTokenCallCount() int {
+	fake.refreshInvitationTokenMutex.RLock()
+	defer fake.refreshInvitationTokenMutex.RUnlock()
+	return len(fake.refreshInvitationTokenArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationTokenCalls(stub func(context.Context, uuid.UUID, string, time.Time) (*domain.Invitation, error)) {
+	fake.refreshInvitationTokenMutex.Lock()
+	defer fake.refreshInvitationTokenMutex.Unlock()
+	fake.RefreshInvitationTokenStub = stub
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationTokenArgsForCall(i int) (context.Context, uuid.UUID, string, time.Time) {
+	fake.refreshInvitationTokenMutex.RLock()
+	defer fake.refreshInvitationTokenMutex.RUnlock()
+	argsForCall := fake.refreshInvitationTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationTokenReturns(result1 *domain.Invitation, result2 error) {
+	fake.refreshInvitationTokenMutex.Lock()
+	defer fake.refreshInvitationTokenMutex.Unlock()
+	fake.RefreshInvitationTokenStub = nil
+	fake.refreshInvitationTokenReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) RefreshInvitationTokenReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.refreshInvitationTokenMutex.Lock()
+	defer fake.refreshInvitationTokenMutex.Unlock()
+	fake.RefreshInvitationTokenStub = nil
+	if fake.refreshInvitationTokenReturnsOnCall == nil {
+		fake.refreshInvitationTokenReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.refreshInvitationTokenReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitation(arg1 context.Context, arg2 uuid.UUID) (*domain.Invitation, error) {
+	fake.revokeInvitationMutex.Lock()
+	ret, specificReturn := fake.revokeInvitationReturnsOnCall[len(fake.revokeInvitationArgsForCall)]
+	fake.revokeInvitationArgsForCall = append(fake.revokeInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RevokeInvitationStub
+	fakeReturns := fake.revokeInvitationReturns
+	fake.recordInvocation("RevokeInvitation", []interface{}{arg1, arg2})
+	fake.revokeInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitationCallCount() int {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	return len(fake.revokeInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitationCalls(stub func(context.Context, uuid.UUID) (*domain.Invitation, error)) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = stub
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitationArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	argsForCall := fake.revokeInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitationReturns(result1 *domain.Invitation, result2 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	fake.revokeInvitationReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) RevokeInvitationReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	if fake.revokeInvitationReturnsOnCall == nil {
+		fake.revokeInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.revokeInvitationReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvitationRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvitationRepository = new(FakeInvitationRepository)
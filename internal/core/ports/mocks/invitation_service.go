@@ -0,0 +1,620 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvitationService struct {
+	AcceptInvitationStub        func(context.Context, uuid.UUID, string) (*domain.OrganizationMember, error)
+	acceptInvitationMutex       sync.RWMutex
+	acceptInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	acceptInvitationReturns struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	acceptInvitationReturnsOnCall map[int]struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	AcceptInvitationWithRegistrationStub        func(context.Context, string, domain.User, string) (*domain.User, *domain.OrganizationMember, error)
+	acceptInvitationWithRegistrationMutex       sync.RWMutex
+	acceptInvitationWithRegistrationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 domain.User
+		arg4 string
+	}
+	acceptInvitationWithRegistrationReturns struct {
+		result1 *domain.User
+		result2 *domain.OrganizationMember
+		result3 error
+	}
+	acceptInvitationWithRegistrationReturnsOnCall map[int]struct {
+		result1 *domain.User
+		result2 *domain.OrganizationMember
+		result3 error
+	}
+	CreateInvitationStub        func(context.Context, uuid.UUID, uuid.UUID, string, domain.OrganizationRole) (*domain.Invitation, error)
+	createInvitationMutex       sync.RWMutex
+	createInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 domain.OrganizationRole
+	}
+	createInvitationReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	createInvitationReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	GetInvitationByTokenStub        func(context.Context, string) (*domain.Invitation, bool, error)
+	getInvitationByTokenMutex       sync.RWMutex
+	getInvitationByTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getInvitationByTokenReturns struct {
+		result1 *domain.Invitation
+		result2 bool
+		result3 error
+	}
+	getInvitationByTokenReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 bool
+		result3 error
+	}
+	ListPendingInvitationsStub        func(context.Context, uuid.UUID, uuid.UUID) ([]domain.Invitation, error)
+	listPendingInvitationsMutex       sync.RWMutex
+	listPendingInvitationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listPendingInvitationsReturns struct {
+		result1 []domain.Invitation
+		result2 error
+	}
+	listPendingInvitationsReturnsOnCall map[int]struct {
+		result1 []domain.Invitation
+		result2 error
+	}
+	ResendInvitationStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invitation, error)
+	resendInvitationMutex       sync.RWMutex
+	resendInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	resendInvitationReturns struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	resendInvitationReturnsOnCall map[int]struct {
+		result1 *domain.Invitation
+		result2 error
+	}
+	RevokeInvitationStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	revokeInvitationMutex       sync.RWMutex
+	revokeInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	revokeInvitationReturns struct {
+		result1 error
+	}
+	revokeInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvitationService) AcceptInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.OrganizationMember, error) {
+	fake.acceptInvitationMutex.Lock()
+	ret, specificReturn := fake.acceptInvitationReturnsOnCall[len(fake.acceptInvitationArgsForCall)]
+	fake.acceptInvitationArgsForCall = append(fake.acceptInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AcceptInvitationStub
+	fakeReturns := fake.acceptInvitationReturns
+	fake.recordInvocation("AcceptInvitation", []interface{}{arg1, arg2, arg3})
+	fake.acceptInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) AcceptInvitationCallCount() int {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	return len(fake.acceptInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) AcceptInvitationCalls(stub func(context.Context, uuid.UUID, string) (*domain.OrganizationMember, error)) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) AcceptInvitationArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	argsForCall := fake.acceptInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationService) AcceptInvitationReturns(result1 *domain.OrganizationMember, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	fake.acceptInvitationReturns = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) AcceptInvitationReturnsOnCall(i int, result1 *domain.OrganizationMember, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	if fake.acceptInvitationReturnsOnCall == nil {
+		fake.acceptInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.acceptInvitationReturnsOnCall[i] = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistration(arg1 context.Context, arg2 string, arg3 domain.User, arg4 string) (*domain.User, *domain.OrganizationMember, error) {
+	fake.acceptInvitationWithRegistrationMutex.Lock()
+	ret, specificReturn := fake.acceptInvitationWithRegistrationReturnsOnCall[len(fake.acceptInvitationWithRegistrationArgsForCall)]
+	fake.acceptInvitationWithRegistrationArgsForCall = append(fake.acceptInvitationWithRegistrationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 domain.User
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AcceptInvitationWithRegistrationStub
+	fakeReturns := fake.acceptInvitationWithRegistrationReturns
+	fake.recordInvocation("AcceptInvitationWithRegistration", []interface{}{arg1, arg2, arg3, arg4})
+	fake.acceptInvitationWithRegistrationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistrationCallCount() int {
+	fake.acceptInvitationWithRegistrationMutex.RLock()
+	defer fake.acceptInvitationWithRegistrationMutex.RUnlock()
+	return len(fake.acceptInvitationWithRegistrationArgsForCall)
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistrationCalls(stub func(context.Context, string, domain.User, string) (*domain.User, *domain.OrganizationMember, error)) {
+	fake.acceptInvitationWithRegistrationMutex.Lock()
+	defer fake.acceptInvitationWithRegistrationMutex.Unlock()
+	fake.AcceptInvitationWithRegistrationStub = stub
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistrationArgsForCall(i int) (context.Context, string, domain.User, string) {
+	fake.acceptInvitationWithRegistrationMutex.RLock()
+	defer fake.acceptInvitationWithRegistrationMutex.RUnlock()
+	argsForCall := fake.acceptInvitationWithRegistrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistrationReturns(result1 *domain.User, result2 *domain.OrganizationMember, result3 error) {
+	fake.acceptInvitationWithRegistrationMutex.Lock()
+	defer fake.acceptInvitationWithRegistrationMutex.Unlock()
+	fake.AcceptInvitationWithRegistrationStub = nil
+	fake.acceptInvitationWithRegistrationReturns = struct {
+		result1 *domain.User
+		result2 *domain.OrganizationMember
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) AcceptInvitationWithRegistrationReturnsOnCall(i int, result1 *domain.User, result2 *domain.OrganizationMember, result3 error) {
+	fake.acceptInvitationWithRegistrationMutex.Lock()
+	defer fake.acceptInvitationWithRegistrationMutex.Unlock()
+	fake.AcceptInvitationWithRegistrationStub = nil
+	if fake.acceptInvitationWithRegistrationReturnsOnCall == nil {
+		fake.acceptInvitationWithRegistrationReturnsOnCall = make(map[int]struct {
+			result1 *domain.User
+			result2 *domain.OrganizationMember
+			result3 error
+		})
+	}
+	fake.acceptInvitationWithRegistrationReturnsOnCall[i] = struct {
+		result1 *domain.User
+		result2 *domain.OrganizationMember
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) CreateInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string, arg5 domain.OrganizationRole) (*domain.Invitation, error) {
+	fake.createInvitationMutex.Lock()
+	ret, specificReturn := fake.createInvitationReturnsOnCall[len(fake.createInvitationArgsForCall)]
+	fake.createInvitationArgsForCall = append(fake.createInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 domain.OrganizationRole
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateInvitationStub
+	fakeReturns := fake.createInvitationReturns
+	fake.recordInvocation("CreateInvitation", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) CreateInvitationCallCount() int {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	return len(fake.createInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) CreateInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string, domain.OrganizationRole) (*domain.Invitation, error)) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) CreateInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string, domain.OrganizationRole) {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	argsForCall := fake.createInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvitationService) CreateInvitationReturns(result1 *domain.Invitation, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	fake.createInvitationReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) CreateInvitationReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	if fake.createInvitationReturnsOnCall == nil {
+		fake.createInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.createInvitationReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) GetInvitationByToken(arg1 context.Context, arg2 string) (*domain.Invitation, bool, error) {
+	fake.getInvitationByTokenMutex.Lock()
+	ret, specificReturn := fake.getInvitationByTokenReturnsOnCall[len(fake.getInvitationByTokenArgsForCall)]
+	fake.getInvitationByTokenArgsForCall = append(fake.getInvitationByTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetInvitationByTokenStub
+	fakeReturns := fake.getInvitationByTokenReturns
+	fake.recordInvocation("GetInvitationByToken", []interface{}{arg1, arg2})
+	fake.getInvitationByTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvitationService) GetInvitationByTokenCallCount() int {
+	fake.getInvitationByTokenMutex.RLock()
+	defer fake.getInvitationByTokenMutex.RUnlock()
+	return len(fake.getInvitationByTokenArgsForCall)
+}
+
+func (fake *FakeInvitationService) GetInvitationByTokenCalls(stub func(context.Context, string) (*domain.Invitation, bool, error)) {
+	fake.getInvitationByTokenMutex.Lock()
+	defer fake.getInvitationByTokenMutex.Unlock()
+	fake.GetInvitationByTokenStub = stub
+}
+
+func (fake *FakeInvitationService) GetInvitationByTokenArgsForCall(i int) (context.Context, string) {
+	fake.getInvitationByTokenMutex.RLock()
+	defer fake.getInvitationByTokenMutex.RUnlock()
+	argsForCall := fake.getInvitationByTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationService) GetInvitationByTokenReturns(result1 *domain.Invitation, result2 bool, result3 error) {
+	fake.getInvitationByTokenMutex.Lock()
+	defer fake.getInvitationByTokenMutex.Unlock()
+	fake.GetInvitationByTokenStub = nil
+	fake.getInvitationByTokenReturns = struct {
+		result1 *domain.Invitation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) GetInvitationByTokenReturnsOnCall(i int, result1 *domain.Invitation, result2 bool, result3 error) {
+	fake.getInvitationByTokenMutex.Lock()
+	defer fake.getInvitationByTokenMutex.Unlock()
+	fake.GetInvitationByTokenStub = nil
+	if fake.getInvitationByTokenReturnsOnCall == nil {
+		fake.getInvitationByTokenReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getInvitationByTokenReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) ListPendingInvitations(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]domain.Invitation, error) {
+	fake.listPendingInvitationsMutex.Lock()
+	ret, specificReturn := fake.listPendingInvitationsReturnsOnCall[len(fake.listPendingInvitationsArgsForCall)]
+	fake.listPendingInvitationsArgsForCall = append(fake.listPendingInvitationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListPendingInvitationsStub
+	fakeReturns := fake.listPendingInvitationsReturns
+	fake.recordInvocation("ListPendingInvitations", []interface{}{arg1, arg2, arg3})
+	fake.listPendingInvitationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) ListPendingInvitationsCallCount() int {
+	fake.listPendingInvitationsMutex.RLock()
+	defer fake.listPendingInvitationsMutex.RUnlock()
+	return len(fake.listPendingInvitationsArgsForCall)
+}
+
+func (fake *FakeInvitationService) ListPendingInvitationsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]domain.Invitation, error)) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = stub
+}
+
+func (fake *FakeInvitationService) ListPendingInvitationsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listPendingInvitationsMutex.RLock()
+	defer fake.listPendingInvitationsMutex.RUnlock()
+	argsForCall := fake.listPendingInvitationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationService) ListPendingInvitationsReturns(result1 []domain.Invitation, result2 error) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = nil
+	fake.listPendingInvitationsReturns = struct {
+		result1 []domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) ListPendingInvitationsReturnsOnCall(i int, result1 []domain.Invitation, result2 error) {
+	fake.listPendingInvitationsMutex.Lock()
+	defer fake.listPendingInvitationsMutex.Unlock()
+	fake.ListPendingInvitationsStub = nil
+	if fake.listPendingInvitationsReturnsOnCall == nil {
+		fake.listPendingInvitationsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invitation
+			result2 error
+		})
+	}
+	fake.listPendingInvitationsReturnsOnCall[i] = struct {
+		result1 []domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) ResendInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Invitation, error) {
+	fake.resendInvitationMutex.Lock()
+	ret, specificReturn := fake.resendInvitationReturnsOnCall[len(fake.resendInvitationArgsForCall)]
+	fake.resendInvitationArgsForCall = append(fake.resendInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResendInvitationStub
+	fakeReturns := fake.resendInvitationReturns
+	fake.recordInvocation("ResendInvitation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resendInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) ResendInvitationCallCount() int {
+	fake.resendInvitationMutex.RLock()
+	defer fake.resendInvitationMutex.RUnlock()
+	return len(fake.resendInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) ResendInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invitation, error)) {
+	fake.resendInvitationMutex.Lock()
+	defer fake.resendInvitationMutex.Unlock()
+	fake.ResendInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) ResendInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.resendInvitationMutex.RLock()
+	defer fake.resendInvitationMutex.RUnlock()
+	argsForCall := fake.resendInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvitationService) ResendInvitationReturns(result1 *domain.Invitation, result2 error) {
+	fake.resendInvitationMutex.Lock()
+	defer fake.resendInvitationMutex.Unlock()
+	fake.ResendInvitationStub = nil
+	fake.resendInvitationReturns = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) ResendInvitationReturnsOnCall(i int, result1 *domain.Invitation, result2 error) {
+	fake.resendInvitationMutex.Lock()
+	defer fake.resendInvitationMutex.Unlock()
+	fake.ResendInvitationStub = nil
+	if fake.resendInvitationReturnsOnCall == nil {
+		fake.resendInvitationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invitation
+			result2 error
+		})
+	}
+	fake.resendInvitationReturnsOnCall[i] = struct {
+		result1 *domain.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) RevokeInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.revokeInvitationMutex.Lock()
+	ret, specificReturn := fake.revokeInvitationReturnsOnCall[len(fake.revokeInvitationArgsForCall)]
+	fake.revokeInvitationArgsForCall = append(fake.revokeInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeInvitationStub
+	fakeReturns := fake.revokeInvitationReturns
+	fake.recordInvocation("RevokeInvitation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationService) RevokeInvitationCallCount() int {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	return len(fake.revokeInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) RevokeInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) RevokeInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	argsForCall := fake.revokeInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvitationService) RevokeInvitationReturns(result1 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	fake.revokeInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationService) RevokeInvitationReturnsOnCall(i int, result1 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	if fake.revokeInvitationReturnsOnCall == nil {
+		fake.revokeInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvitationService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvitationService = new(FakeInvitationService)
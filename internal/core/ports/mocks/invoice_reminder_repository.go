@@ -0,0 +1,528 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceReminderRepository struct {
+	ClaimReminderStub        func(context.Context, uuid.UUID, int, time.Time) (*domain.InvoiceReminder, *domain.Invoice, error)
+	claimReminderMutex       sync.RWMutex
+	claimReminderArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 time.Time
+	}
+	claimReminderReturns struct {
+		result1 *domain.InvoiceReminder
+		result2 *domain.Invoice
+		result3 error
+	}
+	claimReminderReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceReminder
+		result2 *domain.Invoice
+		result3 error
+	}
+	GetReminderSettingsStub        func(context.Context, uuid.UUID) (*domain.InvoiceReminderSettings, error)
+	getReminderSettingsMutex       sync.RWMutex
+	getReminderSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getReminderSettingsReturns struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	getReminderSettingsReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	ListDueRemindersStub        func(context.Context, time.Time, int) ([]domain.DueInvoiceReminder, error)
+	listDueRemindersMutex       sync.RWMutex
+	listDueRemindersArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	listDueRemindersReturns struct {
+		result1 []domain.DueInvoiceReminder
+		result2 error
+	}
+	listDueRemindersReturnsOnCall map[int]struct {
+		result1 []domain.DueInvoiceReminder
+		result2 error
+	}
+	ListInvoiceRemindersStub        func(context.Context, uuid.UUID) ([]domain.InvoiceReminder, error)
+	listInvoiceRemindersMutex       sync.RWMutex
+	listInvoiceRemindersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listInvoiceRemindersReturns struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}
+	listInvoiceRemindersReturnsOnCall map[int]struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}
+	ReleaseReminderStub        func(context.Context, domain.InvoiceReminder, time.Time) (int, error)
+	releaseReminderMutex       sync.RWMutex
+	releaseReminderArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceReminder
+		arg3 time.Time
+	}
+	releaseReminderReturns struct {
+		result1 int
+		result2 error
+	}
+	releaseReminderReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	SaveReminderSettingsStub        func(context.Context, domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)
+	saveReminderSettingsMutex       sync.RWMutex
+	saveReminderSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceReminderSettings
+	}
+	saveReminderSettingsReturns struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	saveReminderSettingsReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminder(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 time.Time) (*domain.InvoiceReminder, *domain.Invoice, error) {
+	fake.claimReminderMutex.Lock()
+	ret, specificReturn := fake.claimReminderReturnsOnCall[len(fake.claimReminderArgsForCall)]
+	fake.claimReminderArgsForCall = append(fake.claimReminderArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimReminderStub
+	fakeReturns := fake.claimReminderReturns
+	fake.recordInvocation("ClaimReminder", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimReminderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminderCallCount() int {
+	fake.claimReminderMutex.RLock()
+	defer fake.claimReminderMutex.RUnlock()
+	return len(fake.claimReminderArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminderCalls(stub func(context.Context, uuid.UUID, int, time.Time) (*domain.InvoiceReminder, *domain.Invoice, error)) {
+	fake.claimReminderMutex.Lock()
+	defer fake.claimReminderMutex.Unlock()
+	fake.ClaimReminderStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminderArgsForCall(i int) (context.Context, uuid.UUID, int, time.Time) {
+	fake.claimReminderMutex.RLock()
+	defer fake.claimReminderMutex.RUnlock()
+	argsForCall := fake.claimReminderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminderReturns(result1 *domain.InvoiceReminder, result2 *domain.Invoice, result3 error) {
+	fake.claimReminderMutex.Lock()
+	defer fake.claimReminderMutex.Unlock()
+	fake.ClaimReminderStub = nil
+	fake.claimReminderReturns = struct {
+		result1 *domain.InvoiceReminder
+		result2 *domain.Invoice
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceReminderRepository) ClaimReminderReturnsOnCall(i int, result1 *domain.InvoiceReminder, result2 *domain.Invoice, result3 error) {
+	fake.claimReminderMutex.Lock()
+	defer fake.claimReminderMutex.Unlock()
+	fake.ClaimReminderStub = nil
+	if fake.claimReminderReturnsOnCall == nil {
+		fake.claimReminderReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceReminder
+			result2 *domain.Invoice
+			result3 error
+		})
+	}
+	fake.claimReminderReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceReminder
+		result2 *domain.Invoice
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettings(arg1 context.Context, arg2 uuid.UUID) (*domain.InvoiceReminderSettings, error) {
+	fake.getReminderSettingsMutex.Lock()
+	ret, specificReturn := fake.getReminderSettingsReturnsOnCall[len(fake.getReminderSettingsArgsForCall)]
+	fake.getReminderSettingsArgsForCall = append(fake.getReminderSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetReminderSettingsStub
+	fakeReturns := fake.getReminderSettingsReturns
+	fake.recordInvocation("GetReminderSettings", []interface{}{arg1, arg2})
+	fake.getReminderSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettingsCallCount() int {
+	fake.getReminderSettingsMutex.RLock()
+	defer fake.getReminderSettingsMutex.RUnlock()
+	return len(fake.getReminderSettingsArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettingsCalls(stub func(context.Context, uuid.UUID) (*domain.InvoiceReminderSettings, error)) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettingsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getReminderSettingsMutex.RLock()
+	defer fake.getReminderSettingsMutex.RUnlock()
+	argsForCall := fake.getReminderSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettingsReturns(result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = nil
+	fake.getReminderSettingsReturns = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) GetReminderSettingsReturnsOnCall(i int, result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = nil
+	if fake.getReminderSettingsReturnsOnCall == nil {
+		fake.getReminderSettingsReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceReminderSettings
+			result2 error
+		})
+	}
+	fake.getReminderSettingsReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueReminders(arg1 context.Context, arg2 time.Time, arg3 int) ([]domain.DueInvoiceReminder, error) {
+	fake.listDueRemindersMutex.Lock()
+	ret, specificReturn := fake.listDueRemindersReturnsOnCall[len(fake.listDueRemindersArgsForCall)]
+	fake.listDueRemindersArgsForCall = append(fake.listDueRemindersArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListDueRemindersStub
+	fakeReturns := fake.listDueRemindersReturns
+	fake.recordInvocation("ListDueReminders", []interface{}{arg1, arg2, arg3})
+	fake.listDueRemindersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueRemindersCallCount() int {
+	fake.listDueRemindersMutex.RLock()
+	defer fake.listDueRemindersMutex.RUnlock()
+	return len(fake.listDueRemindersArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueRemindersCalls(stub func(context.Context, time.Time, int) ([]domain.DueInvoiceReminder, error)) {
+	fake.listDueRemindersMutex.Lock()
+	defer fake.listDueRemindersMutex.Unlock()
+	fake.ListDueRemindersStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueRemindersArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.listDueRemindersMutex.RLock()
+	defer fake.listDueRemindersMutex.RUnlock()
+	argsForCall := fake.listDueRemindersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueRemindersReturns(result1 []domain.DueInvoiceReminder, result2 error) {
+	fake.listDueRemindersMutex.Lock()
+	defer fake.listDueRemindersMutex.Unlock()
+	fake.ListDueRemindersStub = nil
+	fake.listDueRemindersReturns = struct {
+		result1 []domain.DueInvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ListDueRemindersReturnsOnCall(i int, result1 []domain.DueInvoiceReminder, result2 error) {
+	fake.listDueRemindersMutex.Lock()
+	defer fake.listDueRemindersMutex.Unlock()
+	fake.ListDueRemindersStub = nil
+	if fake.listDueRemindersReturnsOnCall == nil {
+		fake.listDueRemindersReturnsOnCall = make(map[int]struct {
+			result1 []domain.DueInvoiceReminder
+			result2 error
+		})
+	}
+	fake.listDueRemindersReturnsOnCall[i] = struct {
+		result1 []domain.DueInvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceReminders(arg1 context.Context, arg2 uuid.UUID) ([]domain.InvoiceReminder, error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	ret, specificReturn := fake.listInvoiceRemindersReturnsOnCall[len(fake.listInvoiceRemindersArgsForCall)]
+	fake.listInvoiceRemindersArgsForCall = append(fake.listInvoiceRemindersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListInvoiceRemindersStub
+	fakeReturns := fake.listInvoiceRemindersReturns
+	fake.recordInvocation("ListInvoiceReminders", []interface{}{arg1, arg2})
+	fake.listInvoiceRemindersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceRemindersCallCount() int {
+	fake.listInvoiceRemindersMutex.RLock()
+	defer fake.listInvoiceRemindersMutex.RUnlock()
+	return len(fake.listInvoiceRemindersArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceRemindersCalls(stub func(context.Context, uuid.UUID) ([]domain.InvoiceReminder, error)) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceRemindersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listInvoiceRemindersMutex.RLock()
+	defer fake.listInvoiceRemindersMutex.RUnlock()
+	argsForCall := fake.listInvoiceRemindersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceRemindersReturns(result1 []domain.InvoiceReminder, result2 error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = nil
+	fake.listInvoiceRemindersReturns = struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ListInvoiceRemindersReturnsOnCall(i int, result1 []domain.InvoiceReminder, result2 error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = nil
+	if fake.listInvoiceRemindersReturnsOnCall == nil {
+		fake.listInvoiceRemindersReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoiceReminder
+			result2 error
+		})
+	}
+	fake.listInvoiceRemindersReturnsOnCall[i] = struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminder(arg1 context.Context, arg2 domain.InvoiceReminder, arg3 time.Time) (int, error) {
+	fake.releaseReminderMutex.Lock()
+	ret, specificReturn := fake.releaseReminderReturnsOnCall[len(fake.releaseReminderArgsForCall)]
+	fake.releaseReminderArgsForCall = append(fake.releaseReminderArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceReminder
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseReminderStub
+	fakeReturns := fake.releaseReminderReturns
+	fake.recordInvocation("ReleaseReminder", []interface{}{arg1, arg2, arg3})
+	fake.releaseReminderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminderCallCount() int {
+	fake.releaseReminderMutex.RLock()
+	defer fake.releaseReminderMutex.RUnlock()
+	return len(fake.releaseReminderArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminderCalls(stub func(context.Context, domain.InvoiceReminder, time.Time) (int, error)) {
+	fake.releaseReminderMutex.Lock()
+	defer fake.releaseReminderMutex.Unlock()
+	fake.ReleaseReminderStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminderArgsForCall(i int) (context.Context, domain.InvoiceReminder, time.Time) {
+	fake.releaseReminderMutex.RLock()
+	defer fake.releaseReminderMutex.RUnlock()
+	argsForCall := fake.releaseReminderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminderReturns(result1 int, result2 error) {
+	fake.releaseReminderMutex.Lock()
+	defer fake.releaseReminderMutex.Unlock()
+	fake.ReleaseReminderStub = nil
+	fake.releaseReminderReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) ReleaseReminderReturnsOnCall(i int, result1 int, result2 error) {
+	fake.releaseReminderMutex.Lock()
+	defer fake.releaseReminderMutex.Unlock()
+	fake.ReleaseReminderStub = nil
+	if fake.releaseReminderReturnsOnCall == nil {
+		fake.releaseReminderReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.releaseReminderReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettings(arg1 context.Context, arg2 domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error) {
+	fake.saveReminderSettingsMutex.Lock()
+	ret, specificReturn := fake.saveReminderSettingsReturnsOnCall[len(fake.saveReminderSettingsArgsForCall)]
+	fake.saveReminderSettingsArgsForCall = append(fake.saveReminderSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceReminderSettings
+	}{arg1, arg2})
+	stub := fake.SaveReminderSettingsStub
+	fakeReturns := fake.saveReminderSettingsReturns
+	fake.recordInvocation("SaveReminderSettings", []interface{}{arg1, arg2})
+	fake.saveReminderSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettingsCallCount() int {
+	fake.saveReminderSettingsMutex.RLock()
+	defer fake.saveReminderSettingsMutex.RUnlock()
+	return len(fake.saveReminderSettingsArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettingsCalls(stub func(context.Context, domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)) {
+	fake.saveReminderSettingsMutex.Lock()
+	defer fake.saveReminderSettingsMutex.Unlock()
+	fake.SaveReminderSettingsStub = stub
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettingsArgsForCall(i int) (context.Context, domain.InvoiceReminderSettings) {
+	fake.saveReminderSettingsMutex.RLock()
+	defer fake.saveReminderSettingsMutex.RUnlock()
+	argsForCall := fake.saveReminderSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettingsReturns(result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.saveReminderSettingsMutex.Lock()
+	defer fake.saveReminderSettingsMutex.Unlock()
+	fake.SaveReminderSettingsStub = nil
+	fake.saveReminderSettingsReturns = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) SaveReminderSettingsReturnsOnCall(i int, result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.saveReminderSettingsMutex.Lock()
+	defer fake.saveReminderSettingsMutex.Unlock()
+	fake.SaveReminderSettingsStub = nil
+	if fake.saveReminderSettingsReturnsOnCall == nil {
+		fake.saveReminderSettingsReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceReminderSettings
+			result2 error
+		})
+	}
+	fake.saveReminderSettingsReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceReminderRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceReminderRepository = new(FakeInvoiceReminderRepository)
@@ -0,0 +1,364 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceReminderService struct {
+	GetReminderSettingsStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.InvoiceReminderSettings, error)
+	getReminderSettingsMutex       sync.RWMutex
+	getReminderSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getReminderSettingsReturns struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	getReminderSettingsReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	ListInvoiceRemindersStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoiceReminder, error)
+	listInvoiceRemindersMutex       sync.RWMutex
+	listInvoiceRemindersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	listInvoiceRemindersReturns struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}
+	listInvoiceRemindersReturnsOnCall map[int]struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}
+	SendDueRemindersStub        func(context.Context) (int, error)
+	sendDueRemindersMutex       sync.RWMutex
+	sendDueRemindersArgsForCall []struct {
+		arg1 context.Context
+	}
+	sendDueRemindersReturns struct {
+		result1 int
+		result2 error
+	}
+	sendDueRemindersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UpdateReminderSettingsStub        func(context.Context, uuid.UUID, uuid.UUID, domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)
+	updateReminderSettingsMutex       sync.RWMutex
+	updateReminderSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.InvoiceReminderSettings
+	}
+	updateReminderSettingsReturns struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	updateReminderSettingsReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettings(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.InvoiceReminderSettings, error) {
+	fake.getReminderSettingsMutex.Lock()
+	ret, specificReturn := fake.getReminderSettingsReturnsOnCall[len(fake.getReminderSettingsArgsForCall)]
+	fake.getReminderSettingsArgsForCall = append(fake.getReminderSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetReminderSettingsStub
+	fakeReturns := fake.getReminderSettingsReturns
+	fake.recordInvocation("GetReminderSettings", []interface{}{arg1, arg2, arg3})
+	fake.getReminderSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettingsCallCount() int {
+	fake.getReminderSettingsMutex.RLock()
+	defer fake.getReminderSettingsMutex.RUnlock()
+	return len(fake.getReminderSettingsArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettingsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.InvoiceReminderSettings, error)) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = stub
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettingsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getReminderSettingsMutex.RLock()
+	defer fake.getReminderSettingsMutex.RUnlock()
+	argsForCall := fake.getReminderSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettingsReturns(result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = nil
+	fake.getReminderSettingsReturns = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) GetReminderSettingsReturnsOnCall(i int, result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.getReminderSettingsMutex.Lock()
+	defer fake.getReminderSettingsMutex.Unlock()
+	fake.GetReminderSettingsStub = nil
+	if fake.getReminderSettingsReturnsOnCall == nil {
+		fake.getReminderSettingsReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceReminderSettings
+			result2 error
+		})
+	}
+	fake.getReminderSettingsReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceReminders(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]domain.InvoiceReminder, error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	ret, specificReturn := fake.listInvoiceRemindersReturnsOnCall[len(fake.listInvoiceRemindersArgsForCall)]
+	fake.listInvoiceRemindersArgsForCall = append(fake.listInvoiceRemindersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListInvoiceRemindersStub
+	fakeReturns := fake.listInvoiceRemindersReturns
+	fake.recordInvocation("ListInvoiceReminders", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listInvoiceRemindersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceRemindersCallCount() int {
+	fake.listInvoiceRemindersMutex.RLock()
+	defer fake.listInvoiceRemindersMutex.RUnlock()
+	return len(fake.listInvoiceRemindersArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceRemindersCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoiceReminder, error)) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = stub
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceRemindersArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.listInvoiceRemindersMutex.RLock()
+	defer fake.listInvoiceRemindersMutex.RUnlock()
+	argsForCall := fake.listInvoiceRemindersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceRemindersReturns(result1 []domain.InvoiceReminder, result2 error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = nil
+	fake.listInvoiceRemindersReturns = struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) ListInvoiceRemindersReturnsOnCall(i int, result1 []domain.InvoiceReminder, result2 error) {
+	fake.listInvoiceRemindersMutex.Lock()
+	defer fake.listInvoiceRemindersMutex.Unlock()
+	fake.ListInvoiceRemindersStub = nil
+	if fake.listInvoiceRemindersReturnsOnCall == nil {
+		fake.listInvoiceRemindersReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoiceReminder
+			result2 error
+		})
+	}
+	fake.listInvoiceRemindersReturnsOnCall[i] = struct {
+		result1 []domain.InvoiceReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) SendDueReminders(arg1 context.Context) (int, error) {
+	fake.sendDueRemindersMutex.Lock()
+	ret, specificReturn := fake.sendDueRemindersReturnsOnCall[len(fake.sendDueRemindersArgsForCall)]
+	fake.sendDueRemindersArgsForCall = append(fake.sendDueRemindersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.SendDueRemindersStub
+	fakeReturns := fake.sendDueRemindersReturns
+	fake.recordInvocation("SendDueReminders", []interface{}{arg1})
+	fake.sendDueRemindersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderService) SendDueRemindersCallCount() int {
+	fake.sendDueRemindersMutex.RLock()
+	defer fake.sendDueRemindersMutex.RUnlock()
+	return len(fake.sendDueRemindersArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderService) SendDueRemindersCalls(stub func(context.Context) (int, error)) {
+	fake.sendDueRemindersMutex.Lock()
+	defer fake.sendDueRemindersMutex.Unlock()
+	fake.SendDueRemindersStub = stub
+}
+
+func (fake *FakeInvoiceReminderService) SendDueRemindersArgsForCall(i int) context.Context {
+	fake.sendDueRemindersMutex.RLock()
+	defer fake.sendDueRemindersMutex.RUnlock()
+	argsForCall := fake.sendDueRemindersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInvoiceReminderService) SendDueRemindersReturns(result1 int, result2 error) {
+	fake.sendDueRemindersMutex.Lock()
+	defer fake.sendDueRemindersMutex.Unlock()
+	fake.SendDueRemindersStub = nil
+	fake.sendDueRemindersReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) SendDueRemindersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.sendDueRemindersMutex.Lock()
+	defer fake.sendDueRemindersMutex.Unlock()
+	fake.SendDueRemindersStub = nil
+	if fake.sendDueRemindersReturnsOnCall == nil {
+		fake.sendDueRemindersReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.sendDueRemindersReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettings(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error) {
+	fake.updateReminderSettingsMutex.Lock()
+	ret, specificReturn := fake.updateReminderSettingsReturnsOnCall[len(fake.updateReminderSettingsArgsForCall)]
+	fake.updateReminderSettingsArgsForCall = append(fake.updateReminderSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.InvoiceReminderSettings
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateReminderSettingsStub
+	fakeReturns := fake.updateReminderSettingsReturns
+	fake.recordInvocation("UpdateReminderSettings", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateReminderSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettingsCallCount() int {
+	fake.updateReminderSettingsMutex.RLock()
+	defer fake.updateReminderSettingsMutex.RUnlock()
+	return len(fake.updateReminderSettingsArgsForCall)
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettingsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)) {
+	fake.updateReminderSettingsMutex.Lock()
+	defer fake.updateReminderSettingsMutex.Unlock()
+	fake.UpdateReminderSettingsStub = stub
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettingsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.InvoiceReminderSettings) {
+	fake.updateReminderSettingsMutex.RLock()
+	defer fake.updateReminderSettingsMutex.RUnlock()
+	argsForCall := fake.updateReminderSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettingsReturns(result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.updateReminderSettingsMutex.Lock()
+	defer fake.updateReminderSettingsMutex.Unlock()
+	fake.UpdateReminderSettingsStub = nil
+	fake.updateReminderSettingsReturns = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) UpdateReminderSettingsReturnsOnCall(i int, result1 *domain.InvoiceReminderSettings, result2 error) {
+	fake.updateReminderSettingsMutex.Lock()
+	defer fake.updateReminderSettingsMutex.Unlock()
+	fake.UpdateReminderSettingsStub = nil
+	if fake.updateReminderSettingsReturnsOnCall == nil {
+		fake.updateReminderSettingsReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceReminderSettings
+			result2 error
+		})
+	}
+	fake.updateReminderSettingsReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceReminderSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceReminderService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceReminderService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceReminderService = new(FakeInvoiceReminderService)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeInvoiceRenderer struct {
+	RenderInvoiceStub        func(context.Context, domain.InvoiceDocument) ([]byte, error)
+	renderInvoiceMutex       sync.RWMutex
+	renderInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceDocument
+	}
+	renderInvoiceReturns struct {
+		result1 []byte
+		result2 error
+	}
+	renderInvoiceReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoice(arg1 context.Context, arg2 domain.InvoiceDocument) ([]byte, error) {
+	fake.renderInvoiceMutex.Lock()
+	ret, specificReturn := fake.renderInvoiceReturnsOnCall[len(fake.renderInvoiceArgsForCall)]
+	fake.renderInvoiceArgsForCall = append(fake.renderInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceDocument
+	}{arg1, arg2})
+	stub := fake.RenderInvoiceStub
+	fakeReturns := fake.renderInvoiceReturns
+	fake.recordInvocation("RenderInvoice", []interface{}{arg1, arg2})
+	fake.renderInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoiceCallCount() int {
+	fake.renderInvoiceMutex.RLock()
+	defer fake.renderInvoiceMutex.RUnlock()
+	return len(fake.renderInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoiceCalls(stub func(context.Context, domain.InvoiceDocument) ([]byte, error)) {
+	fake.renderInvoiceMutex.Lock()
+	defer fake.renderInvoiceMutex.Unlock()
+	fake.RenderInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoiceArgsForCall(i int) (context.Context, domain.InvoiceDocument) {
+	fake.renderInvoiceMutex.RLock()
+	defer fake.renderInvoiceMutex.RUnlock()
+	argsForCall := fake.renderInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoiceReturns(result1 []byte, result2 error) {
+	fake.renderInvoiceMutex.Lock()
+	defer fake.renderInvoiceMutex.Unlock()
+	fake.RenderInvoiceStub = nil
+	fake.renderInvoiceReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRenderer) RenderInvoiceReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.renderInvoiceMutex.Lock()
+	defer fake.renderInvoiceMutex.Unlock()
+	fake.RenderInvoiceStub = nil
+	if fake.renderInvoiceReturnsOnCall == nil {
+		fake.renderInvoiceReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.renderInvoiceReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRenderer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceRenderer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceRenderer = new(FakeInvoiceRenderer)
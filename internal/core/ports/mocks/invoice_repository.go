@@ -0,0 +1,1508 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceRepository struct {
+	AllocateDepositIndexStub        func(context.Context, uuid.UUID) (string, int64, error)
+	allocateDepositIndexMutex       sync.RWMutex
+	allocateDepositIndexArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	allocateDepositIndexReturns struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	allocateDepositIndexReturnsOnCall map[int]struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	CreateInvoiceStub        func(context.Context, domain.Invoice) (*domain.Invoice, error)
+	createInvoiceMutex       sync.RWMutex
+	createInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}
+	createInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	createInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	CreateTimesheetInvoiceStub        func(context.Context, domain.Invoice, []uuid.UUID) (*domain.Invoice, error)
+	createTimesheetInvoiceMutex       sync.RWMutex
+	createTimesheetInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 []uuid.UUID
+	}
+	createTimesheetInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	createTimesheetInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	DeleteDepositKeyStub        func(context.Context, uuid.UUID) error
+	deleteDepositKeyMutex       sync.RWMutex
+	deleteDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deleteDepositKeyReturns struct {
+		result1 error
+	}
+	deleteDepositKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DepositAddressUsedStub        func(context.Context, string) (bool, error)
+	depositAddressUsedMutex       sync.RWMutex
+	depositAddressUsedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	depositAddressUsedReturns struct {
+		result1 bool
+		result2 error
+	}
+	depositAddressUsedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetDepositKeyStub        func(context.Context, uuid.UUID) (*domain.InvoiceDepositKey, error)
+	getDepositKeyMutex       sync.RWMutex
+	getDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getDepositKeyReturns struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	getDepositKeyReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	GetInvoiceStub        func(context.Context, uuid.UUID) (*domain.Invoice, error)
+	getInvoiceMutex       sync.RWMutex
+	getInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	getInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	ListInvoicePaymentsStub        func(context.Context, uuid.UUID) ([]domain.InvoicePayment, error)
+	listInvoicePaymentsMutex       sync.RWMutex
+	listInvoicePaymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listInvoicePaymentsReturns struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}
+	listInvoicePaymentsReturnsOnCall map[int]struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}
+	ListInvoicesStub        func(context.Context, uuid.UUID, *domain.InvoiceStatus, int, int) ([]domain.Invoice, int64, error)
+	listInvoicesMutex       sync.RWMutex
+	listInvoicesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *domain.InvoiceStatus
+		arg4 int
+		arg5 int
+	}
+	listInvoicesReturns struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}
+	listInvoicesReturnsOnCall map[int]struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}
+	ListInvoicesAwaitingDepositStub        func(context.Context, time.Time) ([]domain.Invoice, error)
+	listInvoicesAwaitingDepositMutex       sync.RWMutex
+	listInvoicesAwaitingDepositArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	listInvoicesAwaitingDepositReturns struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	listInvoicesAwaitingDepositReturnsOnCall map[int]struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	MarkOverdueStub        func(context.Context, time.Time, int) ([]domain.Invoice, error)
+	markOverdueMutex       sync.RWMutex
+	markOverdueArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	markOverdueReturns struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	markOverdueReturnsOnCall map[int]struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	MarkPaidStub        func(context.Context, uuid.UUID, money.Money, time.Time, string, *domain.LedgerEntry) (*domain.Invoice, error)
+	markPaidMutex       sync.RWMutex
+	markPaidArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 money.Money
+		arg4 time.Time
+		arg5 string
+		arg6 *domain.LedgerEntry
+	}
+	markPaidReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	markPaidReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	MarkSentStub        func(context.Context, uuid.UUID, time.Time, *domain.LedgerEntry) (*domain.Invoice, error)
+	markSentMutex       sync.RWMutex
+	markSentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 *domain.LedgerEntry
+	}
+	markSentReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	markSentReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	MarkViewedStub        func(context.Context, uuid.UUID, time.Time) (*domain.Invoice, error)
+	markViewedMutex       sync.RWMutex
+	markViewedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	markViewedReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	markViewedReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	RecordInvoicePaymentStub        func(context.Context, domain.InvoicePayment, time.Time, func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error)
+	recordInvoicePaymentMutex       sync.RWMutex
+	recordInvoicePaymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoicePayment
+		arg3 time.Time
+		arg4 func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)
+	}
+	recordInvoicePaymentReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	recordInvoicePaymentReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	SaveDepositKeyStub        func(context.Context, domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error)
+	saveDepositKeyMutex       sync.RWMutex
+	saveDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceDepositKey
+	}
+	saveDepositKeyReturns struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	saveDepositKeyReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	UpdateDraftInvoiceStub        func(context.Context, domain.Invoice) (*domain.Invoice, error)
+	updateDraftInvoiceMutex       sync.RWMutex
+	updateDraftInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}
+	updateDraftInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	updateDraftInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	VoidStub        func(context.Context, uuid.UUID, time.Time, string, *domain.LedgerEntry) (*domain.Invoice, error)
+	voidMutex       sync.RWMutex
+	voidArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 string
+		arg5 *domain.LedgerEntry
+	}
+	voidReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	voidReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndex(arg1 context.Context, arg2 uuid.UUID) (string, int64, error) {
+	fake.allocateDepositIndexMutex.Lock()
+	ret, specificReturn := fake.allocateDepositIndexReturnsOnCall[len(fake.allocateDepositIndexArgsForCall)]
+	fake.allocateDepositIndexArgsForCall = append(fake.allocateDepositIndexArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.AllocateDepositIndexStub
+	fakeReturns := fake.allocateDepositIndexReturns
+	fake.recordInvocation("AllocateDepositIndex", []interface{}{arg1, arg2})
+	fake.allocateDepositIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndexCallCount() int {
+	fake.allocateDepositIndexMutex.RLock()
+	defer fake.allocateDepositIndexMutex.RUnlock()
+	return len(fake.allocateDepositIndexArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndexCalls(stub func(context.Context, uuid.UUID) (string, int64, error)) {
+	fake.allocateDepositIndexMutex.Lock()
+	defer fake.allocateDepositIndexMutex.Unlock()
+	fake.AllocateDepositIndexStub = stub
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndexArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.allocateDepositIndexMutex.RLock()
+	defer fake.allocateDepositIndexMutex.RUnlock()
+	argsForCall := fake.allocateDepositIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndexReturns(result1 string, result2 int64, result3 error) {
+	fake.allocateDepositIndexMutex.Lock()
+	defer fake.allocateDepositIndexMutex.Unlock()
+	fake.AllocateDepositIndexStub = nil
+	fake.allocateDepositIndexReturns = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceRepository) AllocateDepositIndexReturnsOnCall(i int, result1 string, result2 int64, result3 error) {
+	fake.allocateDepositIndexMutex.Lock()
+	defer fake.allocateDepositIndexMutex.Unlock()
+	fake.AllocateDepositIndexStub = nil
+	if fake.allocateDepositIndexReturnsOnCall == nil {
+		fake.allocateDepositIndexReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 int64
+			result3 error
+		})
+	}
+	fake.allocateDepositIndexReturnsOnCall[i] = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoice(arg1 context.Context, arg2 domain.Invoice) (*domain.Invoice, error) {
+	fake.createInvoiceMutex.Lock()
+	ret, specificReturn := fake.createInvoiceReturnsOnCall[len(fake.createInvoiceArgsForCall)]
+	fake.createInvoiceArgsForCall = append(fake.createInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}{arg1, arg2})
+	stub := fake.CreateInvoiceStub
+	fakeReturns := fake.createInvoiceReturns
+	fake.recordInvocation("CreateInvoice", []interface{}{arg1, arg2})
+	fake.createInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoiceCallCount() int {
+	fake.createInvoiceMutex.RLock()
+	defer fake.createInvoiceMutex.RUnlock()
+	return len(fake.createInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoiceCalls(stub func(context.Context, domain.Invoice) (*domain.Invoice, error)) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoiceArgsForCall(i int) (context.Context, domain.Invoice) {
+	fake.createInvoiceMutex.RLock()
+	defer fake.createInvoiceMutex.RUnlock()
+	argsForCall := fake.createInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = nil
+	fake.createInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) CreateInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = nil
+	if fake.createInvoiceReturnsOnCall == nil {
+		fake.createInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.createInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoice(arg1 context.Context, arg2 domain.Invoice, arg3 []uuid.UUID) (*domain.Invoice, error) {
+	var arg3Copy []uuid.UUID
+	if arg3 != nil {
+		arg3Copy = make([]uuid.UUID, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createTimesheetInvoiceMutex.Lock()
+	ret, specificReturn := fake.createTimesheetInvoiceReturnsOnCall[len(fake.createTimesheetInvoiceArgsForCall)]
+	fake.createTimesheetInvoiceArgsForCall = append(fake.createTimesheetInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+		arg3 []uuid.UUID
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CreateTimesheetInvoiceStub
+	fakeReturns := fake.createTimesheetInvoiceReturns
+	fake.recordInvocation("CreateTimesheetInvoice", []interface{}{arg1, arg2, arg3Copy})
+	fake.createTimesheetInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoiceCallCount() int {
+	fake.createTimesheetInvoiceMutex.RLock()
+	defer fake.createTimesheetInvoiceMutex.RUnlock()
+	return len(fake.createTimesheetInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoiceCalls(stub func(context.Context, domain.Invoice, []uuid.UUID) (*domain.Invoice, error)) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoiceArgsForCall(i int) (context.Context, domain.Invoice, []uuid.UUID) {
+	fake.createTimesheetInvoiceMutex.RLock()
+	defer fake.createTimesheetInvoiceMutex.RUnlock()
+	argsForCall := fake.createTimesheetInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = nil
+	fake.createTimesheetInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) CreateTimesheetInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = nil
+	if fake.createTimesheetInvoiceReturnsOnCall == nil {
+		fake.createTimesheetInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.createTimesheetInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKey(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteDepositKeyMutex.Lock()
+	ret, specificReturn := fake.deleteDepositKeyReturnsOnCall[len(fake.deleteDepositKeyArgsForCall)]
+	fake.deleteDepositKeyArgsForCall = append(fake.deleteDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteDepositKeyStub
+	fakeReturns := fake.deleteDepositKeyReturns
+	fake.recordInvocation("DeleteDepositKey", []interface{}{arg1, arg2})
+	fake.deleteDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKeyCallCount() int {
+	fake.deleteDepositKeyMutex.RLock()
+	defer fake.deleteDepositKeyMutex.RUnlock()
+	return len(fake.deleteDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKeyCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKeyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteDepositKeyMutex.RLock()
+	defer fake.deleteDepositKeyMutex.RUnlock()
+	argsForCall := fake.deleteDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKeyReturns(result1 error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = nil
+	fake.deleteDepositKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceRepository) DeleteDepositKeyReturnsOnCall(i int, result1 error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = nil
+	if fake.deleteDepositKeyReturnsOnCall == nil {
+		fake.deleteDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDepositKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsed(arg1 context.Context, arg2 string) (bool, error) {
+	fake.depositAddressUsedMutex.Lock()
+	ret, specificReturn := fake.depositAddressUsedReturnsOnCall[len(fake.depositAddressUsedArgsForCall)]
+	fake.depositAddressUsedArgsForCall = append(fake.depositAddressUsedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DepositAddressUsedStub
+	fakeReturns := fake.depositAddressUsedReturns
+	fake.recordInvocation("DepositAddressUsed", []interface{}{arg1, arg2})
+	fake.depositAddressUsedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsedCallCount() int {
+	fake.depositAddressUsedMutex.RLock()
+	defer fake.depositAddressUsedMutex.RUnlock()
+	return len(fake.depositAddressUsedArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsedCalls(stub func(context.Context, string) (bool, error)) {
+	fake.depositAddressUsedMutex.Lock()
+	defer fake.depositAddressUsedMutex.Unlock()
+	fake.DepositAddressUsedStub = stub
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsedArgsForCall(i int) (context.Context, string) {
+	fake.depositAddressUsedMutex.RLock()
+	defer fake.depositAddressUsedMutex.RUnlock()
+	argsForCall := fake.depositAddressUsedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsedReturns(result1 bool, result2 error) {
+	fake.depositAddressUsedMutex.Lock()
+	defer fake.depositAddressUsedMutex.Unlock()
+	fake.DepositAddressUsedStub = nil
+	fake.depositAddressUsedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) DepositAddressUsedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.depositAddressUsedMutex.Lock()
+	defer fake.depositAddressUsedMutex.Unlock()
+	fake.DepositAddressUsedStub = nil
+	if fake.depositAddressUsedReturnsOnCall == nil {
+		fake.depositAddressUsedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.depositAddressUsedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKey(arg1 context.Context, arg2 uuid.UUID) (*domain.InvoiceDepositKey, error) {
+	fake.getDepositKeyMutex.Lock()
+	ret, specificReturn := fake.getDepositKeyReturnsOnCall[len(fake.getDepositKeyArgsForCall)]
+	fake.getDepositKeyArgsForCall = append(fake.getDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetDepositKeyStub
+	fakeReturns := fake.getDepositKeyReturns
+	fake.recordInvocation("GetDepositKey", []interface{}{arg1, arg2})
+	fake.getDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKeyCallCount() int {
+	fake.getDepositKeyMutex.RLock()
+	defer fake.getDepositKeyMutex.RUnlock()
+	return len(fake.getDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKeyCalls(stub func(context.Context, uuid.UUID) (*domain.InvoiceDepositKey, error)) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKeyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getDepositKeyMutex.RLock()
+	defer fake.getDepositKeyMutex.RUnlock()
+	argsForCall := fake.getDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKeyReturns(result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = nil
+	fake.getDepositKeyReturns = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) GetDepositKeyReturnsOnCall(i int, result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = nil
+	if fake.getDepositKeyReturnsOnCall == nil {
+		fake.getDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceDepositKey
+			result2 error
+		})
+	}
+	fake.getDepositKeyReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) GetInvoice(arg1 context.Context, arg2 uuid.UUID) (*domain.Invoice, error) {
+	fake.getInvoiceMutex.Lock()
+	ret, specificReturn := fake.getInvoiceReturnsOnCall[len(fake.getInvoiceArgsForCall)]
+	fake.getInvoiceArgsForCall = append(fake.getInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetInvoiceStub
+	fakeReturns := fake.getInvoiceReturns
+	fake.recordInvocation("GetInvoice", []interface{}{arg1, arg2})
+	fake.getInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) GetInvoiceCallCount() int {
+	fake.getInvoiceMutex.RLock()
+	defer fake.getInvoiceMutex.RUnlock()
+	return len(fake.getInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) GetInvoiceCalls(stub func(context.Context, uuid.UUID) (*domain.Invoice, error)) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceRepository) GetInvoiceArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getInvoiceMutex.RLock()
+	defer fake.getInvoiceMutex.RUnlock()
+	argsForCall := fake.getInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) GetInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = nil
+	fake.getInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) GetInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = nil
+	if fake.getInvoiceReturnsOnCall == nil {
+		fake.getInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.getInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePayments(arg1 context.Context, arg2 uuid.UUID) ([]domain.InvoicePayment, error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	ret, specificReturn := fake.listInvoicePaymentsReturnsOnCall[len(fake.listInvoicePaymentsArgsForCall)]
+	fake.listInvoicePaymentsArgsForCall = append(fake.listInvoicePaymentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListInvoicePaymentsStub
+	fakeReturns := fake.listInvoicePaymentsReturns
+	fake.recordInvocation("ListInvoicePayments", []interface{}{arg1, arg2})
+	fake.listInvoicePaymentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePaymentsCallCount() int {
+	fake.listInvoicePaymentsMutex.RLock()
+	defer fake.listInvoicePaymentsMutex.RUnlock()
+	return len(fake.listInvoicePaymentsArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePaymentsCalls(stub func(context.Context, uuid.UUID) ([]domain.InvoicePayment, error)) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = stub
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePaymentsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listInvoicePaymentsMutex.RLock()
+	defer fake.listInvoicePaymentsMutex.RUnlock()
+	argsForCall := fake.listInvoicePaymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePaymentsReturns(result1 []domain.InvoicePayment, result2 error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = nil
+	fake.listInvoicePaymentsReturns = struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicePaymentsReturnsOnCall(i int, result1 []domain.InvoicePayment, result2 error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = nil
+	if fake.listInvoicePaymentsReturnsOnCall == nil {
+		fake.listInvoicePaymentsReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoicePayment
+			result2 error
+		})
+	}
+	fake.listInvoicePaymentsReturnsOnCall[i] = struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoices(arg1 context.Context, arg2 uuid.UUID, arg3 *domain.InvoiceStatus, arg4 int, arg5 int) ([]domain.Invoice, int64, error) {
+	fake.listInvoicesMutex.Lock()
+	ret, specificReturn := fake.listInvoicesReturnsOnCall[len(fake.listInvoicesArgsForCall)]
+	fake.listInvoicesArgsForCall = append(fake.listInvoicesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *domain.InvoiceStatus
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListInvoicesStub
+	fakeReturns := fake.listInvoicesReturns
+	fake.recordInvocation("ListInvoices", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesCallCount() int {
+	fake.listInvoicesMutex.RLock()
+	defer fake.listInvoicesMutex.RUnlock()
+	return len(fake.listInvoicesArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesCalls(stub func(context.Context, uuid.UUID, *domain.InvoiceStatus, int, int) ([]domain.Invoice, int64, error)) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = stub
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesArgsForCall(i int) (context.Context, uuid.UUID, *domain.InvoiceStatus, int, int) {
+	fake.listInvoicesMutex.RLock()
+	defer fake.listInvoicesMutex.RUnlock()
+	argsForCall := fake.listInvoicesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesReturns(result1 []domain.Invoice, result2 int64, result3 error) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = nil
+	fake.listInvoicesReturns = struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesReturnsOnCall(i int, result1 []domain.Invoice, result2 int64, result3 error) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = nil
+	if fake.listInvoicesReturnsOnCall == nil {
+		fake.listInvoicesReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invoice
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listInvoicesReturnsOnCall[i] = struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDeposit(arg1 context.Context, arg2 time.Time) ([]domain.Invoice, error) {
+	fake.listInvoicesAwaitingDepositMutex.Lock()
+	ret, specificReturn := fake.listInvoicesAwaitingDepositReturnsOnCall[len(fake.listInvoicesAwaitingDepositArgsForCall)]
+	fake.listInvoicesAwaitingDepositArgsForCall = append(fake.listInvoicesAwaitingDepositArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ListInvoicesAwaitingDepositStub
+	fakeReturns := fake.listInvoicesAwaitingDepositReturns
+	fake.recordInvocation("ListInvoicesAwaitingDeposit", []interface{}{arg1, arg2})
+	fake.listInvoicesAwaitingDepositMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDepositCallCount() int {
+	fake.listInvoicesAwaitingDepositMutex.RLock()
+	defer fake.listInvoicesAwaitingDepositMutex.RUnlock()
+	return len(fake.listInvoicesAwaitingDepositArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDepositCalls(stub func(context.Context, time.Time) ([]domain.Invoice, error)) {
+	fake.listInvoicesAwaitingDepositMutex.Lock()
+	defer fake.listInvoicesAwaitingDepositMutex.Unlock()
+	fake.ListInvoicesAwaitingDepositStub = stub
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDepositArgsForCall(i int) (context.Context, time.Time) {
+	fake.listInvoicesAwaitingDepositMutex.RLock()
+	defer fake.listInvoicesAwaitingDepositMutex.RUnlock()
+	argsForCall := fake.listInvoicesAwaitingDepositArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDepositReturns(result1 []domain.Invoice, result2 error) {
+	fake.listInvoicesAwaitingDepositMutex.Lock()
+	defer fake.listInvoicesAwaitingDepositMutex.Unlock()
+	fake.ListInvoicesAwaitingDepositStub = nil
+	fake.listInvoicesAwaitingDepositReturns = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) ListInvoicesAwaitingDepositReturnsOnCall(i int, result1 []domain.Invoice, result2 error) {
+	fake.listInvoicesAwaitingDepositMutex.Lock()
+	defer fake.listInvoicesAwaitingDepositMutex.Unlock()
+	fake.ListInvoicesAwaitingDepositStub = nil
+	if fake.listInvoicesAwaitingDepositReturnsOnCall == nil {
+		fake.listInvoicesAwaitingDepositReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invoice
+			result2 error
+		})
+	}
+	fake.listInvoicesAwaitingDepositReturnsOnCall[i] = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdue(arg1 context.Context, arg2 time.Time, arg3 int) ([]domain.Invoice, error) {
+	fake.markOverdueMutex.Lock()
+	ret, specificReturn := fake.markOverdueReturnsOnCall[len(fake.markOverdueArgsForCall)]
+	fake.markOverdueArgsForCall = append(fake.markOverdueArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.MarkOverdueStub
+	fakeReturns := fake.markOverdueReturns
+	fake.recordInvocation("MarkOverdue", []interface{}{arg1, arg2, arg3})
+	fake.markOverdueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdueCallCount() int {
+	fake.markOverdueMutex.RLock()
+	defer fake.markOverdueMutex.RUnlock()
+	return len(fake.markOverdueArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdueCalls(stub func(context.Context, time.Time, int) ([]domain.Invoice, error)) {
+	fake.markOverdueMutex.Lock()
+	defer fake.markOverdueMutex.Unlock()
+	fake.MarkOverdueStub = stub
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdueArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.markOverdueMutex.RLock()
+	defer fake.markOverdueMutex.RUnlock()
+	argsForCall := fake.markOverdueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdueReturns(result1 []domain.Invoice, result2 error) {
+	fake.markOverdueMutex.Lock()
+	defer fake.markOverdueMutex.Unlock()
+	fake.MarkOverdueStub = nil
+	fake.markOverdueReturns = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkOverdueReturnsOnCall(i int, result1 []domain.Invoice, result2 error) {
+	fake.markOverdueMutex.Lock()
+	defer fake.markOverdueMutex.Unlock()
+	fake.MarkOverdueStub = nil
+	if fake.markOverdueReturnsOnCall == nil {
+		fake.markOverdueReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invoice
+			result2 error
+		})
+	}
+	fake.markOverdueReturnsOnCall[i] = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkPaid(arg1 context.Context, arg2 uuid.UUID, arg3 money.Money, arg4 time.Time, arg5 string, arg6 *domain.LedgerEntry) (*domain.Invoice, error) {
+	fake.markPaidMutex.Lock()
+	ret, specificReturn := fake.markPaidReturnsOnCall[len(fake.markPaidArgsForCall)]
+	fake.markPaidArgsForCall = append(fake.markPaidArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 money.Money
+		arg4 time.Time
+		arg5 string
+		arg6 *domain.LedgerEntry
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.MarkPaidStub
+	fakeReturns := fake.markPaidReturns
+	fake.recordInvocation("MarkPaid", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.markPaidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) MarkPaidCallCount() int {
+	fake.markPaidMutex.RLock()
+	defer fake.markPaidMutex.RUnlock()
+	return len(fake.markPaidArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) MarkPaidCalls(stub func(context.Context, uuid.UUID, money.Money, time.Time, string, *domain.LedgerEntry) (*domain.Invoice, error)) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = stub
+}
+
+func (fake *FakeInvoiceRepository) MarkPaidArgsForCall(i int) (context.Context, uuid.UUID, money.Money, time.Time, string, *domain.LedgerEntry) {
+	fake.markPaidMutex.RLock()
+	defer fake.markPaidMutex.RUnlock()
+	argsForCall := fake.markPaidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInvoiceRepository) MarkPaidReturns(result1 *domain.Invoice, result2 error) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = nil
+	fake.markPaidReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkPaidReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = nil
+	if fake.markPaidReturnsOnCall == nil {
+		fake.markPaidReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.markPaidReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkSent(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time, arg4 *domain.LedgerEntry) (*domain.Invoice, error) {
+	fake.markSentMutex.Lock()
+	ret, specificReturn := fake.markSentReturnsOnCall[len(fake.markSentArgsForCall)]
+	fake.markSentArgsForCall = append(fake.markSentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 *domain.LedgerEntry
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.MarkSentStub
+	fakeReturns := fake.markSentReturns
+	fake.recordInvocation("MarkSent", []interface{}{arg1, arg2, arg3, arg4})
+	fake.markSentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) MarkSentCallCount() int {
+	fake.markSentMutex.RLock()
+	defer fake.markSentMutex.RUnlock()
+	return len(fake.markSentArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) MarkSentCalls(stub func(context.Context, uuid.UUID, time.Time, *domain.LedgerEntry) (*domain.Invoice, error)) {
+	fake.markSentMutex.Lock()
+	defer fake.markSentMutex.Unlock()
+	fake.MarkSentStub = stub
+}
+
+func (fake *FakeInvoiceRepository) MarkSentArgsForCall(i int) (context.Context, uuid.UUID, time.Time, *domain.LedgerEntry) {
+	fake.markSentMutex.RLock()
+	defer fake.markSentMutex.RUnlock()
+	argsForCall := fake.markSentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceRepository) MarkSentReturns(result1 *domain.Invoice, result2 error) {
+	fake.markSentMutex.Lock()
+	defer fake.markSentMutex.Unlock()
+	fake.MarkSentStub = nil
+	fake.markSentReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkSentReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.markSentMutex.Lock()
+	defer fake.markSentMutex.Unlock()
+	fake.MarkSentStub = nil
+	if fake.markSentReturnsOnCall == nil {
+		fake.markSentReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.markSentReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkViewed(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) (*domain.Invoice, error) {
+	fake.markViewedMutex.Lock()
+	ret, specificReturn := fake.markViewedReturnsOnCall[len(fake.markViewedArgsForCall)]
+	fake.markViewedArgsForCall = append(fake.markViewedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.MarkViewedStub
+	fakeReturns := fake.markViewedReturns
+	fake.recordInvocation("MarkViewed", []interface{}{arg1, arg2, arg3})
+	fake.markViewedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) MarkViewedCallCount() int {
+	fake.markViewedMutex.RLock()
+	defer fake.markViewedMutex.RUnlock()
+	return len(fake.markViewedArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) MarkViewedCalls(stub func(context.Context, uuid.UUID, time.Time) (*domain.Invoice, error)) {
+	fake.markViewedMutex.Lock()
+	defer fake.markViewedMutex.Unlock()
+	fake.MarkViewedStub = stub
+}
+
+func (fake *FakeInvoiceRepository) MarkViewedArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.markViewedMutex.RLock()
+	defer fake.markViewedMutex.RUnlock()
+	argsForCall := fake.markViewedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceRepository) MarkViewedReturns(result1 *domain.Invoice, result2 error) {
+	fake.markViewedMutex.Lock()
+	defer fake.markViewedMutex.Unlock()
+	fake.MarkViewedStub = nil
+	fake.markViewedReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) MarkViewedReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.markViewedMutex.Lock()
+	defer fake.markViewedMutex.Unlock()
+	fake.MarkViewedStub = nil
+	if fake.markViewedReturnsOnCall == nil {
+		fake.markViewedReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.markViewedReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePayment(arg1 context.Context, arg2 domain.InvoicePayment, arg3 time.Time, arg4 func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error) {
+	fake.recordInvoicePaymentMutex.Lock()
+	ret, specificReturn := fake.recordInvoicePaymentReturnsOnCall[len(fake.recordInvoicePaymentArgsForCall)]
+	fake.recordInvoicePaymentArgsForCall = append(fake.recordInvoicePaymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoicePayment
+		arg3 time.Time
+		arg4 func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordInvoicePaymentStub
+	fakeReturns := fake.recordInvoicePaymentReturns
+	fake.recordInvocation("RecordInvoicePayment", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvoicePaymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePaymentCallCount() int {
+	fake.recordInvoicePaymentMutex.RLock()
+	defer fake.recordInvoicePaymentMutex.RUnlock()
+	return len(fake.recordInvoicePaymentArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePaymentCalls(stub func(context.Context, domain.InvoicePayment, time.Time, func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error)) {
+	fake.recordInvoicePaymentMutex.Lock()
+	defer fake.recordInvoicePaymentMutex.Unlock()
+	fake.RecordInvoicePaymentStub = stub
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePaymentArgsForCall(i int) (context.Context, domain.InvoicePayment, time.Time, func(before domain.Invoice, after domain.Invoice) (*domain.LedgerEntry, error)) {
+	fake.recordInvoicePaymentMutex.RLock()
+	defer fake.recordInvoicePaymentMutex.RUnlock()
+	argsForCall := fake.recordInvoicePaymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePaymentReturns(result1 *domain.Invoice, result2 error) {
+	fake.recordInvoicePaymentMutex.Lock()
+	defer fake.recordInvoicePaymentMutex.Unlock()
+	fake.RecordInvoicePaymentStub = nil
+	fake.recordInvoicePaymentReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) RecordInvoicePaymentReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.recordInvoicePaymentMutex.Lock()
+	defer fake.recordInvoicePaymentMutex.Unlock()
+	fake.RecordInvoicePaymentStub = nil
+	if fake.recordInvoicePaymentReturnsOnCall == nil {
+		fake.recordInvoicePaymentReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.recordInvoicePaymentReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKey(arg1 context.Context, arg2 domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error) {
+	fake.saveDepositKeyMutex.Lock()
+	ret, specificReturn := fake.saveDepositKeyReturnsOnCall[len(fake.saveDepositKeyArgsForCall)]
+	fake.saveDepositKeyArgsForCall = append(fake.saveDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceDepositKey
+	}{arg1, arg2})
+	stub := fake.SaveDepositKeyStub
+	fakeReturns := fake.saveDepositKeyReturns
+	fake.recordInvocation("SaveDepositKey", []interface{}{arg1, arg2})
+	fake.saveDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKeyCallCount() int {
+	fake.saveDepositKeyMutex.RLock()
+	defer fake.saveDepositKeyMutex.RUnlock()
+	return len(fake.saveDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKeyCalls(stub func(context.Context, domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error)) {
+	fake.saveDepositKeyMutex.Lock()
+	defer fake.saveDepositKeyMutex.Unlock()
+	fake.SaveDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKeyArgsForCall(i int) (context.Context, domain.InvoiceDepositKey) {
+	fake.saveDepositKeyMutex.RLock()
+	defer fake.saveDepositKeyMutex.RUnlock()
+	argsForCall := fake.saveDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKeyReturns(result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.saveDepositKeyMutex.Lock()
+	defer fake.saveDepositKeyMutex.Unlock()
+	fake.SaveDepositKeyStub = nil
+	fake.saveDepositKeyReturns = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) SaveDepositKeyReturnsOnCall(i int, result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.saveDepositKeyMutex.Lock()
+	defer fake.saveDepositKeyMutex.Unlock()
+	fake.SaveDepositKeyStub = nil
+	if fake.saveDepositKeyReturnsOnCall == nil {
+		fake.saveDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceDepositKey
+			result2 error
+		})
+	}
+	fake.saveDepositKeyReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoice(arg1 context.Context, arg2 domain.Invoice) (*domain.Invoice, error) {
+	fake.updateDraftInvoiceMutex.Lock()
+	ret, specificReturn := fake.updateDraftInvoiceReturnsOnCall[len(fake.updateDraftInvoiceArgsForCall)]
+	fake.updateDraftInvoiceArgsForCall = append(fake.updateDraftInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}{arg1, arg2})
+	stub := fake.UpdateDraftInvoiceStub
+	fakeReturns := fake.updateDraftInvoiceReturns
+	fake.recordInvocation("UpdateDraftInvoice", []interface{}{arg1, arg2})
+	fake.updateDraftInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoiceCallCount() int {
+	fake.updateDraftInvoiceMutex.RLock()
+	defer fake.updateDraftInvoiceMutex.RUnlock()
+	return len(fake.updateDraftInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoiceCalls(stub func(context.Context, domain.Invoice) (*domain.Invoice, error)) {
+	fake.updateDraftInvoiceMutex.Lock()
+	defer fake.updateDraftInvoiceMutex.Unlock()
+	fake.UpdateDraftInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoiceArgsForCall(i int) (context.Context, domain.Invoice) {
+	fake.updateDraftInvoiceMutex.RLock()
+	defer fake.updateDraftInvoiceMutex.RUnlock()
+	argsForCall := fake.updateDraftInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.updateDraftInvoiceMutex.Lock()
+	defer fake.updateDraftInvoiceMutex.Unlock()
+	fake.UpdateDraftInvoiceStub = nil
+	fake.updateDraftInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) UpdateDraftInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.updateDraftInvoiceMutex.Lock()
+	defer fake.updateDraftInvoiceMutex.Unlock()
+	fake.UpdateDraftInvoiceStub = nil
+	if fake.updateDraftInvoiceReturnsOnCall == nil {
+		fake.updateDraftInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.updateDraftInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) Void(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time, arg4 string, arg5 *domain.LedgerEntry) (*domain.Invoice, error) {
+	fake.voidMutex.Lock()
+	ret, specificReturn := fake.voidReturnsOnCall[len(fake.voidArgsForCall)]
+	fake.voidArgsForCall = append(fake.voidArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 string
+		arg5 *domain.LedgerEntry
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.VoidStub
+	fakeReturns := fake.voidReturns
+	fake.recordInvocation("Void", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.voidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceRepository) VoidCallCount() int {
+	fake.voidMutex.RLock()
+	defer fake.voidMutex.RUnlock()
+	return len(fake.voidArgsForCall)
+}
+
+func (fake *FakeInvoiceRepository) VoidCalls(stub func(context.Context, uuid.UUID, time.Time, string, *domain.LedgerEntry) (*domain.Invoice, error)) {
+	fake.voidMutex.Lock()
+	defer fake.voidMutex.Unlock()
+	fake.VoidStub = stub
+}
+
+func (fake *FakeInvoiceRepository) VoidArgsForCall(i int) (context.Context, uuid.UUID, time.Time, string, *domain.LedgerEntry) {
+	fake.voidMutex.RLock()
+	defer fake.voidMutex.RUnlock()
+	argsForCall := fake.voidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceRepository) VoidReturns(result1 *domain.Invoice, result2 error) {
+	fake.voidMutex.Lock()
+	defer fake.voidMutex.Unlock()
+	fake.VoidStub = nil
+	fake.voidReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) VoidReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.voidMutex.Lock()
+	defer fake.voidMutex.Unlock()
+	fake.VoidStub = nil
+	if fake.voidReturnsOnCall == nil {
+		fake.voidReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.voidReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceRepository = new(FakeInvoiceRepository)
@@ -0,0 +1,1456 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceService struct {
+	AssignDepositAddressStub        func(context.Context, domain.Invoice) (*domain.Invoice, error)
+	assignDepositAddressMutex       sync.RWMutex
+	assignDepositAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}
+	assignDepositAddressReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	assignDepositAddressReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	CreateInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, domain.Invoice) (*domain.Invoice, error)
+	createInvoiceMutex       sync.RWMutex
+	createInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Invoice
+	}
+	createInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	createInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	CreateTimesheetInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, domain.Invoice, []uuid.UUID) (*domain.Invoice, error)
+	createTimesheetInvoiceMutex       sync.RWMutex
+	createTimesheetInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Invoice
+		arg5 []uuid.UUID
+	}
+	createTimesheetInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	createTimesheetInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	DeleteDepositKeyStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteDepositKeyMutex       sync.RWMutex
+	deleteDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteDepositKeyReturns struct {
+		result1 error
+	}
+	deleteDepositKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeliverInvoiceStub        func(context.Context, uuid.UUID) (*domain.Invoice, error)
+	deliverInvoiceMutex       sync.RWMutex
+	deliverInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deliverInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	deliverInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	GetDepositKeyStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.InvoiceDepositKey, error)
+	getDepositKeyMutex       sync.RWMutex
+	getDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getDepositKeyReturns struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	getDepositKeyReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	GetInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invoice, error)
+	getInvoiceMutex       sync.RWMutex
+	getInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	getInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	ListInvoicePaymentsStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoicePayment, error)
+	listInvoicePaymentsMutex       sync.RWMutex
+	listInvoicePaymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	listInvoicePaymentsReturns struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}
+	listInvoicePaymentsReturnsOnCall map[int]struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}
+	ListInvoicesStub        func(context.Context, uuid.UUID, uuid.UUID, *domain.InvoiceStatus, int, int) ([]domain.Invoice, int64, error)
+	listInvoicesMutex       sync.RWMutex
+	listInvoicesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *domain.InvoiceStatus
+		arg5 int
+		arg6 int
+	}
+	listInvoicesReturns struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}
+	listInvoicesReturnsOnCall map[int]struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}
+	MarkOverdueInvoicesStub        func(context.Context) (int, error)
+	markOverdueInvoicesMutex       sync.RWMutex
+	markOverdueInvoicesArgsForCall []struct {
+		arg1 context.Context
+	}
+	markOverdueInvoicesReturns struct {
+		result1 int
+		result2 error
+	}
+	markOverdueInvoicesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	MarkPaidStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time, string) (*domain.Invoice, error)
+	markPaidMutex       sync.RWMutex
+	markPaidArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+		arg6 string
+	}
+	markPaidReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	markPaidReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	PrepareInvoiceStub        func(context.Context, domain.Invoice) (*domain.Invoice, error)
+	prepareInvoiceMutex       sync.RWMutex
+	prepareInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}
+	prepareInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	prepareInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	RenderInvoicePDFStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]byte, string, error)
+	renderInvoicePDFMutex       sync.RWMutex
+	renderInvoicePDFArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	renderInvoicePDFReturns struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	renderInvoicePDFReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	SendInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invoice, error)
+	sendInvoiceMutex       sync.RWMutex
+	sendInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	sendInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	sendInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	SetDepositKeyStub        func(context.Context, uuid.UUID, uuid.UUID, string, int) (*domain.InvoiceDepositKey, error)
+	setDepositKeyMutex       sync.RWMutex
+	setDepositKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 int
+	}
+	setDepositKeyReturns struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	setDepositKeyReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}
+	UpdateInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Invoice) (*domain.Invoice, error)
+	updateInvoiceMutex       sync.RWMutex
+	updateInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.Invoice
+	}
+	updateInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	updateInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	VoidInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.Invoice, error)
+	voidInvoiceMutex       sync.RWMutex
+	voidInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	voidInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	voidInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddress(arg1 context.Context, arg2 domain.Invoice) (*domain.Invoice, error) {
+	fake.assignDepositAddressMutex.Lock()
+	ret, specificReturn := fake.assignDepositAddressReturnsOnCall[len(fake.assignDepositAddressArgsForCall)]
+	fake.assignDepositAddressArgsForCall = append(fake.assignDepositAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}{arg1, arg2})
+	stub := fake.AssignDepositAddressStub
+	fakeReturns := fake.assignDepositAddressReturns
+	fake.recordInvocation("AssignDepositAddress", []interface{}{arg1, arg2})
+	fake.assignDepositAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddressCallCount() int {
+	fake.assignDepositAddressMutex.RLock()
+	defer fake.assignDepositAddressMutex.RUnlock()
+	return len(fake.assignDepositAddressArgsForCall)
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddressCalls(stub func(context.Context, domain.Invoice) (*domain.Invoice, error)) {
+	fake.assignDepositAddressMutex.Lock()
+	defer fake.assignDepositAddressMutex.Unlock()
+	fake.AssignDepositAddressStub = stub
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddressArgsForCall(i int) (context.Context, domain.Invoice) {
+	fake.assignDepositAddressMutex.RLock()
+	defer fake.assignDepositAddressMutex.RUnlock()
+	argsForCall := fake.assignDepositAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddressReturns(result1 *domain.Invoice, result2 error) {
+	fake.assignDepositAddressMutex.Lock()
+	defer fake.assignDepositAddressMutex.Unlock()
+	fake.AssignDepositAddressStub = nil
+	fake.assignDepositAddressReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) AssignDepositAddressReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.assignDepositAddressMutex.Lock()
+	defer fake.assignDepositAddressMutex.Unlock()
+	fake.AssignDepositAddressStub = nil
+	if fake.assignDepositAddressReturnsOnCall == nil {
+		fake.assignDepositAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.assignDepositAddressReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) CreateInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.Invoice) (*domain.Invoice, error) {
+	fake.createInvoiceMutex.Lock()
+	ret, specificReturn := fake.createInvoiceReturnsOnCall[len(fake.createInvoiceArgsForCall)]
+	fake.createInvoiceArgsForCall = append(fake.createInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Invoice
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateInvoiceStub
+	fakeReturns := fake.createInvoiceReturns
+	fake.recordInvocation("CreateInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) CreateInvoiceCallCount() int {
+	fake.createInvoiceMutex.RLock()
+	defer fake.createInvoiceMutex.RUnlock()
+	return len(fake.createInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) CreateInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.Invoice) (*domain.Invoice, error)) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) CreateInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.Invoice) {
+	fake.createInvoiceMutex.RLock()
+	defer fake.createInvoiceMutex.RUnlock()
+	argsForCall := fake.createInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceService) CreateInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = nil
+	fake.createInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) CreateInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.createInvoiceMutex.Lock()
+	defer fake.createInvoiceMutex.Unlock()
+	fake.CreateInvoiceStub = nil
+	if fake.createInvoiceReturnsOnCall == nil {
+		fake.createInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.createInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.Invoice, arg5 []uuid.UUID) (*domain.Invoice, error) {
+	var arg5Copy []uuid.UUID
+	if arg5 != nil {
+		arg5Copy = make([]uuid.UUID, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.createTimesheetInvoiceMutex.Lock()
+	ret, specificReturn := fake.createTimesheetInvoiceReturnsOnCall[len(fake.createTimesheetInvoiceArgsForCall)]
+	fake.createTimesheetInvoiceArgsForCall = append(fake.createTimesheetInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.Invoice
+		arg5 []uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5Copy})
+	stub := fake.CreateTimesheetInvoiceStub
+	fakeReturns := fake.createTimesheetInvoiceReturns
+	fake.recordInvocation("CreateTimesheetInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+	fake.createTimesheetInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoiceCallCount() int {
+	fake.createTimesheetInvoiceMutex.RLock()
+	defer fake.createTimesheetInvoiceMutex.RUnlock()
+	return len(fake.createTimesheetInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.Invoice, []uuid.UUID) (*domain.Invoice, error)) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.Invoice, []uuid.UUID) {
+	fake.createTimesheetInvoiceMutex.RLock()
+	defer fake.createTimesheetInvoiceMutex.RUnlock()
+	argsForCall := fake.createTimesheetInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = nil
+	fake.createTimesheetInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) CreateTimesheetInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.createTimesheetInvoiceMutex.Lock()
+	defer fake.createTimesheetInvoiceMutex.Unlock()
+	fake.CreateTimesheetInvoiceStub = nil
+	if fake.createTimesheetInvoiceReturnsOnCall == nil {
+		fake.createTimesheetInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.createTimesheetInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteDepositKeyMutex.Lock()
+	ret, specificReturn := fake.deleteDepositKeyReturnsOnCall[len(fake.deleteDepositKeyArgsForCall)]
+	fake.deleteDepositKeyArgsForCall = append(fake.deleteDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDepositKeyStub
+	fakeReturns := fake.deleteDepositKeyReturns
+	fake.recordInvocation("DeleteDepositKey", []interface{}{arg1, arg2, arg3})
+	fake.deleteDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKeyCallCount() int {
+	fake.deleteDepositKeyMutex.RLock()
+	defer fake.deleteDepositKeyMutex.RUnlock()
+	return len(fake.deleteDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteDepositKeyMutex.RLock()
+	defer fake.deleteDepositKeyMutex.RUnlock()
+	argsForCall := fake.deleteDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKeyReturns(result1 error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = nil
+	fake.deleteDepositKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceService) DeleteDepositKeyReturnsOnCall(i int, result1 error) {
+	fake.deleteDepositKeyMutex.Lock()
+	defer fake.deleteDepositKeyMutex.Unlock()
+	fake.DeleteDepositKeyStub = nil
+	if fake.deleteDepositKeyReturnsOnCall == nil {
+		fake.deleteDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDepositKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceService) DeliverInvoice(arg1 context.Context, arg2 uuid.UUID) (*domain.Invoice, error) {
+	fake.deliverInvoiceMutex.Lock()
+	ret, specificReturn := fake.deliverInvoiceReturnsOnCall[len(fake.deliverInvoiceArgsForCall)]
+	fake.deliverInvoiceArgsForCall = append(fake.deliverInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeliverInvoiceStub
+	fakeReturns := fake.deliverInvoiceReturns
+	fake.recordInvocation("DeliverInvoice", []interface{}{arg1, arg2})
+	fake.deliverInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) DeliverInvoiceCallCount() int {
+	fake.deliverInvoiceMutex.RLock()
+	defer fake.deliverInvoiceMutex.RUnlock()
+	return len(fake.deliverInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) DeliverInvoiceCalls(stub func(context.Context, uuid.UUID) (*domain.Invoice, error)) {
+	fake.deliverInvoiceMutex.Lock()
+	defer fake.deliverInvoiceMutex.Unlock()
+	fake.DeliverInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) DeliverInvoiceArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deliverInvoiceMutex.RLock()
+	defer fake.deliverInvoiceMutex.RUnlock()
+	argsForCall := fake.deliverInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceService) DeliverInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.deliverInvoiceMutex.Lock()
+	defer fake.deliverInvoiceMutex.Unlock()
+	fake.DeliverInvoiceStub = nil
+	fake.deliverInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) DeliverInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.deliverInvoiceMutex.Lock()
+	defer fake.deliverInvoiceMutex.Unlock()
+	fake.DeliverInvoiceStub = nil
+	if fake.deliverInvoiceReturnsOnCall == nil {
+		fake.deliverInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.deliverInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) GetDepositKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.InvoiceDepositKey, error) {
+	fake.getDepositKeyMutex.Lock()
+	ret, specificReturn := fake.getDepositKeyReturnsOnCall[len(fake.getDepositKeyArgsForCall)]
+	fake.getDepositKeyArgsForCall = append(fake.getDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetDepositKeyStub
+	fakeReturns := fake.getDepositKeyReturns
+	fake.recordInvocation("GetDepositKey", []interface{}{arg1, arg2, arg3})
+	fake.getDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) GetDepositKeyCallCount() int {
+	fake.getDepositKeyMutex.RLock()
+	defer fake.getDepositKeyMutex.RUnlock()
+	return len(fake.getDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceService) GetDepositKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.InvoiceDepositKey, error)) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceService) GetDepositKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getDepositKeyMutex.RLock()
+	defer fake.getDepositKeyMutex.RUnlock()
+	argsForCall := fake.getDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceService) GetDepositKeyReturns(result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = nil
+	fake.getDepositKeyReturns = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) GetDepositKeyReturnsOnCall(i int, result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.getDepositKeyMutex.Lock()
+	defer fake.getDepositKeyMutex.Unlock()
+	fake.GetDepositKeyStub = nil
+	if fake.getDepositKeyReturnsOnCall == nil {
+		fake.getDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceDepositKey
+			result2 error
+		})
+	}
+	fake.getDepositKeyReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) GetInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Invoice, error) {
+	fake.getInvoiceMutex.Lock()
+	ret, specificReturn := fake.getInvoiceReturnsOnCall[len(fake.getInvoiceArgsForCall)]
+	fake.getInvoiceArgsForCall = append(fake.getInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetInvoiceStub
+	fakeReturns := fake.getInvoiceReturns
+	fake.recordInvocation("GetInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) GetInvoiceCallCount() int {
+	fake.getInvoiceMutex.RLock()
+	defer fake.getInvoiceMutex.RUnlock()
+	return len(fake.getInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) GetInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invoice, error)) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) GetInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getInvoiceMutex.RLock()
+	defer fake.getInvoiceMutex.RUnlock()
+	argsForCall := fake.getInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceService) GetInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = nil
+	fake.getInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) GetInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.getInvoiceMutex.Lock()
+	defer fake.getInvoiceMutex.Unlock()
+	fake.GetInvoiceStub = nil
+	if fake.getInvoiceReturnsOnCall == nil {
+		fake.getInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.getInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) ListInvoicePayments(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]domain.InvoicePayment, error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	ret, specificReturn := fake.listInvoicePaymentsReturnsOnCall[len(fake.listInvoicePaymentsArgsForCall)]
+	fake.listInvoicePaymentsArgsForCall = append(fake.listInvoicePaymentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListInvoicePaymentsStub
+	fakeReturns := fake.listInvoicePaymentsReturns
+	fake.recordInvocation("ListInvoicePayments", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listInvoicePaymentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) ListInvoicePaymentsCallCount() int {
+	fake.listInvoicePaymentsMutex.RLock()
+	defer fake.listInvoicePaymentsMutex.RUnlock()
+	return len(fake.listInvoicePaymentsArgsForCall)
+}
+
+func (fake *FakeInvoiceService) ListInvoicePaymentsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoicePayment, error)) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = stub
+}
+
+func (fake *FakeInvoiceService) ListInvoicePaymentsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.listInvoicePaymentsMutex.RLock()
+	defer fake.listInvoicePaymentsMutex.RUnlock()
+	argsForCall := fake.listInvoicePaymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceService) ListInvoicePaymentsReturns(result1 []domain.InvoicePayment, result2 error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = nil
+	fake.listInvoicePaymentsReturns = struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) ListInvoicePaymentsReturnsOnCall(i int, result1 []domain.InvoicePayment, result2 error) {
+	fake.listInvoicePaymentsMutex.Lock()
+	defer fake.listInvoicePaymentsMutex.Unlock()
+	fake.ListInvoicePaymentsStub = nil
+	if fake.listInvoicePaymentsReturnsOnCall == nil {
+		fake.listInvoicePaymentsReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoicePayment
+			result2 error
+		})
+	}
+	fake.listInvoicePaymentsReturnsOnCall[i] = struct {
+		result1 []domain.InvoicePayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) ListInvoices(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 *domain.InvoiceStatus, arg5 int, arg6 int) ([]domain.Invoice, int64, error) {
+	fake.listInvoicesMutex.Lock()
+	ret, specificReturn := fake.listInvoicesReturnsOnCall[len(fake.listInvoicesArgsForCall)]
+	fake.listInvoicesArgsForCall = append(fake.listInvoicesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *domain.InvoiceStatus
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListInvoicesStub
+	fakeReturns := fake.listInvoicesReturns
+	fake.recordInvocation("ListInvoices", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceService) ListInvoicesCallCount() int {
+	fake.listInvoicesMutex.RLock()
+	defer fake.listInvoicesMutex.RUnlock()
+	return len(fake.listInvoicesArgsForCall)
+}
+
+func (fake *FakeInvoiceService) ListInvoicesCalls(stub func(context.Context, uuid.UUID, uuid.UUID, *domain.InvoiceStatus, int, int) ([]domain.Invoice, int64, error)) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = stub
+}
+
+func (fake *FakeInvoiceService) ListInvoicesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, *domain.InvoiceStatus, int, int) {
+	fake.listInvoicesMutex.RLock()
+	defer fake.listInvoicesMutex.RUnlock()
+	argsForCall := fake.listInvoicesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInvoiceService) ListInvoicesReturns(result1 []domain.Invoice, result2 int64, result3 error) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = nil
+	fake.listInvoicesReturns = struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceService) ListInvoicesReturnsOnCall(i int, result1 []domain.Invoice, result2 int64, result3 error) {
+	fake.listInvoicesMutex.Lock()
+	defer fake.listInvoicesMutex.Unlock()
+	fake.ListInvoicesStub = nil
+	if fake.listInvoicesReturnsOnCall == nil {
+		fake.listInvoicesReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invoice
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listInvoicesReturnsOnCall[i] = struct {
+		result1 []domain.Invoice
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoices(arg1 context.Context) (int, error) {
+	fake.markOverdueInvoicesMutex.Lock()
+	ret, specificReturn := fake.markOverdueInvoicesReturnsOnCall[len(fake.markOverdueInvoicesArgsForCall)]
+	fake.markOverdueInvoicesArgsForCall = append(fake.markOverdueInvoicesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.MarkOverdueInvoicesStub
+	fakeReturns := fake.markOverdueInvoicesReturns
+	fake.recordInvocation("MarkOverdueInvoices", []interface{}{arg1})
+	fake.markOverdueInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoicesCallCount() int {
+	fake.markOverdueInvoicesMutex.RLock()
+	defer fake.markOverdueInvoicesMutex.RUnlock()
+	return len(fake.markOverdueInvoicesArgsForCall)
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoicesCalls(stub func(context.Context) (int, error)) {
+	fake.markOverdueInvoicesMutex.Lock()
+	defer fake.markOverdueInvoicesMutex.Unlock()
+	fake.MarkOverdueInvoicesStub = stub
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoicesArgsForCall(i int) context.Context {
+	fake.markOverdueInvoicesMutex.RLock()
+	defer fake.markOverdueInvoicesMutex.RUnlock()
+	argsForCall := fake.markOverdueInvoicesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoicesReturns(result1 int, result2 error) {
+	fake.markOverdueInvoicesMutex.Lock()
+	defer fake.markOverdueInvoicesMutex.Unlock()
+	fake.MarkOverdueInvoicesStub = nil
+	fake.markOverdueInvoicesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) MarkOverdueInvoicesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.markOverdueInvoicesMutex.Lock()
+	defer fake.markOverdueInvoicesMutex.Unlock()
+	fake.MarkOverdueInvoicesStub = nil
+	if fake.markOverdueInvoicesReturnsOnCall == nil {
+		fake.markOverdueInvoicesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.markOverdueInvoicesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) MarkPaid(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 *time.Time, arg6 string) (*domain.Invoice, error) {
+	fake.markPaidMutex.Lock()
+	ret, specificReturn := fake.markPaidReturnsOnCall[len(fake.markPaidArgsForCall)]
+	fake.markPaidArgsForCall = append(fake.markPaidArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.MarkPaidStub
+	fakeReturns := fake.markPaidReturns
+	fake.recordInvocation("MarkPaid", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.markPaidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) MarkPaidCallCount() int {
+	fake.markPaidMutex.RLock()
+	defer fake.markPaidMutex.RUnlock()
+	return len(fake.markPaidArgsForCall)
+}
+
+func (fake *FakeInvoiceService) MarkPaidCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time, string) (*domain.Invoice, error)) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = stub
+}
+
+func (fake *FakeInvoiceService) MarkPaidArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time, string) {
+	fake.markPaidMutex.RLock()
+	defer fake.markPaidMutex.RUnlock()
+	argsForCall := fake.markPaidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInvoiceService) MarkPaidReturns(result1 *domain.Invoice, result2 error) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = nil
+	fake.markPaidReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) MarkPaidReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.markPaidMutex.Lock()
+	defer fake.markPaidMutex.Unlock()
+	fake.MarkPaidStub = nil
+	if fake.markPaidReturnsOnCall == nil {
+		fake.markPaidReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.markPaidReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) PrepareInvoice(arg1 context.Context, arg2 domain.Invoice) (*domain.Invoice, error) {
+	fake.prepareInvoiceMutex.Lock()
+	ret, specificReturn := fake.prepareInvoiceReturnsOnCall[len(fake.prepareInvoiceArgsForCall)]
+	fake.prepareInvoiceArgsForCall = append(fake.prepareInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Invoice
+	}{arg1, arg2})
+	stub := fake.PrepareInvoiceStub
+	fakeReturns := fake.prepareInvoiceReturns
+	fake.recordInvocation("PrepareInvoice", []interface{}{arg1, arg2})
+	fake.prepareInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) PrepareInvoiceCallCount() int {
+	fake.prepareInvoiceMutex.RLock()
+	defer fake.prepareInvoiceMutex.RUnlock()
+	return len(fake.prepareInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) PrepareInvoiceCalls(stub func(context.Context, domain.Invoice) (*domain.Invoice, error)) {
+	fake.prepareInvoiceMutex.Lock()
+	defer fake.prepareInvoiceMutex.Unlock()
+	fake.PrepareInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) PrepareInvoiceArgsForCall(i int) (context.Context, domain.Invoice) {
+	fake.prepareInvoiceMutex.RLock()
+	defer fake.prepareInvoiceMutex.RUnlock()
+	argsForCall := fake.prepareInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceService) PrepareInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.prepareInvoiceMutex.Lock()
+	defer fake.prepareInvoiceMutex.Unlock()
+	fake.PrepareInvoiceStub = nil
+	fake.prepareInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) PrepareInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.prepareInvoiceMutex.Lock()
+	defer fake.prepareInvoiceMutex.Unlock()
+	fake.PrepareInvoiceStub = nil
+	if fake.prepareInvoiceReturnsOnCall == nil {
+		fake.prepareInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.prepareInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDF(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]byte, string, error) {
+	fake.renderInvoicePDFMutex.Lock()
+	ret, specificReturn := fake.renderInvoicePDFReturnsOnCall[len(fake.renderInvoicePDFArgsForCall)]
+	fake.renderInvoicePDFArgsForCall = append(fake.renderInvoicePDFArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RenderInvoicePDFStub
+	fakeReturns := fake.renderInvoicePDFReturns
+	fake.recordInvocation("RenderInvoicePDF", []interface{}{arg1, arg2, arg3, arg4})
+	fake.renderInvoicePDFMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDFCallCount() int {
+	fake.renderInvoicePDFMutex.RLock()
+	defer fake.renderInvoicePDFMutex.RUnlock()
+	return len(fake.renderInvoicePDFArgsForCall)
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDFCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]byte, string, error)) {
+	fake.renderInvoicePDFMutex.Lock()
+	defer fake.renderInvoicePDFMutex.Unlock()
+	fake.RenderInvoicePDFStub = stub
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDFArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.renderInvoicePDFMutex.RLock()
+	defer fake.renderInvoicePDFMutex.RUnlock()
+	argsForCall := fake.renderInvoicePDFArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDFReturns(result1 []byte, result2 string, result3 error) {
+	fake.renderInvoicePDFMutex.Lock()
+	defer fake.renderInvoicePDFMutex.Unlock()
+	fake.RenderInvoicePDFStub = nil
+	fake.renderInvoicePDFReturns = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceService) RenderInvoicePDFReturnsOnCall(i int, result1 []byte, result2 string, result3 error) {
+	fake.renderInvoicePDFMutex.Lock()
+	defer fake.renderInvoicePDFMutex.Unlock()
+	fake.RenderInvoicePDFStub = nil
+	if fake.renderInvoicePDFReturnsOnCall == nil {
+		fake.renderInvoicePDFReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 string
+			result3 error
+		})
+	}
+	fake.renderInvoicePDFReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceService) SendInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Invoice, error) {
+	fake.sendInvoiceMutex.Lock()
+	ret, specificReturn := fake.sendInvoiceReturnsOnCall[len(fake.sendInvoiceArgsForCall)]
+	fake.sendInvoiceArgsForCall = append(fake.sendInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SendInvoiceStub
+	fakeReturns := fake.sendInvoiceReturns
+	fake.recordInvocation("SendInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.sendInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) SendInvoiceCallCount() int {
+	fake.sendInvoiceMutex.RLock()
+	defer fake.sendInvoiceMutex.RUnlock()
+	return len(fake.sendInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) SendInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Invoice, error)) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) SendInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.sendInvoiceMutex.RLock()
+	defer fake.sendInvoiceMutex.RUnlock()
+	argsForCall := fake.sendInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceService) SendInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = nil
+	fake.sendInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) SendInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.sendInvoiceMutex.Lock()
+	defer fake.sendInvoiceMutex.Unlock()
+	fake.SendInvoiceStub = nil
+	if fake.sendInvoiceReturnsOnCall == nil {
+		fake.sendInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.sendInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) SetDepositKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string, arg5 int) (*domain.InvoiceDepositKey, error) {
+	fake.setDepositKeyMutex.Lock()
+	ret, specificReturn := fake.setDepositKeyReturnsOnCall[len(fake.setDepositKeyArgsForCall)]
+	fake.setDepositKeyArgsForCall = append(fake.setDepositKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SetDepositKeyStub
+	fakeReturns := fake.setDepositKeyReturns
+	fake.recordInvocation("SetDepositKey", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.setDepositKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) SetDepositKeyCallCount() int {
+	fake.setDepositKeyMutex.RLock()
+	defer fake.setDepositKeyMutex.RUnlock()
+	return len(fake.setDepositKeyArgsForCall)
+}
+
+func (fake *FakeInvoiceService) SetDepositKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string, int) (*domain.InvoiceDepositKey, error)) {
+	fake.setDepositKeyMutex.Lock()
+	defer fake.setDepositKeyMutex.Unlock()
+	fake.SetDepositKeyStub = stub
+}
+
+func (fake *FakeInvoiceService) SetDepositKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string, int) {
+	fake.setDepositKeyMutex.RLock()
+	defer fake.setDepositKeyMutex.RUnlock()
+	argsForCall := fake.setDepositKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceService) SetDepositKeyReturns(result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.setDepositKeyMutex.Lock()
+	defer fake.setDepositKeyMutex.Unlock()
+	fake.SetDepositKeyStub = nil
+	fake.setDepositKeyReturns = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) SetDepositKeyReturnsOnCall(i int, result1 *domain.InvoiceDepositKey, result2 error) {
+	fake.setDepositKeyMutex.Lock()
+	defer fake.setDepositKeyMutex.Unlock()
+	fake.SetDepositKeyStub = nil
+	if fake.setDepositKeyReturnsOnCall == nil {
+		fake.setDepositKeyReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceDepositKey
+			result2 error
+		})
+	}
+	fake.setDepositKeyReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceDepositKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) UpdateInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.Invoice) (*domain.Invoice, error) {
+	fake.updateInvoiceMutex.Lock()
+	ret, specificReturn := fake.updateInvoiceReturnsOnCall[len(fake.updateInvoiceArgsForCall)]
+	fake.updateInvoiceArgsForCall = append(fake.updateInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.Invoice
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateInvoiceStub
+	fakeReturns := fake.updateInvoiceReturns
+	fake.recordInvocation("UpdateInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) UpdateInvoiceCallCount() int {
+	fake.updateInvoiceMutex.RLock()
+	defer fake.updateInvoiceMutex.RUnlock()
+	return len(fake.updateInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) UpdateInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Invoice) (*domain.Invoice, error)) {
+	fake.updateInvoiceMutex.Lock()
+	defer fake.updateInvoiceMutex.Unlock()
+	fake.UpdateInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) UpdateInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.Invoice) {
+	fake.updateInvoiceMutex.RLock()
+	defer fake.updateInvoiceMutex.RUnlock()
+	argsForCall := fake.updateInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceService) UpdateInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.updateInvoiceMutex.Lock()
+	defer fake.updateInvoiceMutex.Unlock()
+	fake.UpdateInvoiceStub = nil
+	fake.updateInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) UpdateInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.updateInvoiceMutex.Lock()
+	defer fake.updateInvoiceMutex.Unlock()
+	fake.UpdateInvoiceStub = nil
+	if fake.updateInvoiceReturnsOnCall == nil {
+		fake.updateInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.updateInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) VoidInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) (*domain.Invoice, error) {
+	fake.voidInvoiceMutex.Lock()
+	ret, specificReturn := fake.voidInvoiceReturnsOnCall[len(fake.voidInvoiceArgsForCall)]
+	fake.voidInvoiceArgsForCall = append(fake.voidInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.VoidInvoiceStub
+	fakeReturns := fake.voidInvoiceReturns
+	fake.recordInvocation("VoidInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.voidInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceService) VoidInvoiceCallCount() int {
+	fake.voidInvoiceMutex.RLock()
+	defer fake.voidInvoiceMutex.RUnlock()
+	return len(fake.voidInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceService) VoidInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.Invoice, error)) {
+	fake.voidInvoiceMutex.Lock()
+	defer fake.voidInvoiceMutex.Unlock()
+	fake.VoidInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceService) VoidInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.voidInvoiceMutex.RLock()
+	defer fake.voidInvoiceMutex.RUnlock()
+	argsForCall := fake.voidInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceService) VoidInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.voidInvoiceMutex.Lock()
+	defer fake.voidInvoiceMutex.Unlock()
+	fake.VoidInvoiceStub = nil
+	fake.voidInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) VoidInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.voidInvoiceMutex.Lock()
+	defer fake.voidInvoiceMutex.Unlock()
+	fake.VoidInvoiceStub = nil
+	if fake.voidInvoiceReturnsOnCall == nil {
+		fake.voidInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.voidInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceService = new(FakeInvoiceService)
@@ -0,0 +1,439 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceShareRepository struct {
+	CreateShareLinkStub        func(context.Context, domain.InvoiceShareLink) (*domain.InvoiceShareLink, error)
+	createShareLinkMutex       sync.RWMutex
+	createShareLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceShareLink
+	}
+	createShareLinkReturns struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	createShareLinkReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	GetShareLinkStub        func(context.Context, uuid.UUID) (*domain.InvoiceShareLink, error)
+	getShareLinkMutex       sync.RWMutex
+	getShareLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getShareLinkReturns struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	getShareLinkReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	ListShareLinksStub        func(context.Context, uuid.UUID) ([]domain.InvoiceShareLink, error)
+	listShareLinksMutex       sync.RWMutex
+	listShareLinksArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listShareLinksReturns struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}
+	listShareLinksReturnsOnCall map[int]struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}
+	RecordShareLinkViewStub        func(context.Context, domain.InvoiceShareLink, time.Time) error
+	recordShareLinkViewMutex       sync.RWMutex
+	recordShareLinkViewArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.InvoiceShareLink
+		arg3 time.Time
+	}
+	recordShareLinkViewReturns struct {
+		result1 error
+	}
+	recordShareLinkViewReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeShareLinkStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*domain.InvoiceShareLink, error)
+	revokeShareLinkMutex       sync.RWMutex
+	revokeShareLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 time.Time
+	}
+	revokeShareLinkReturns struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	revokeShareLinkReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLink(arg1 context.Context, arg2 domain.InvoiceShareLink) (*domain.InvoiceShareLink, error) {
+	fake.createShareLinkMutex.Lock()
+	ret, specificReturn := fake.createShareLinkReturnsOnCall[len(fake.createShareLinkArgsForCall)]
+	fake.createShareLinkArgsForCall = append(fake.createShareLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceShareLink
+	}{arg1, arg2})
+	stub := fake.CreateShareLinkStub
+	fakeReturns := fake.createShareLinkReturns
+	fake.recordInvocation("CreateShareLink", []interface{}{arg1, arg2})
+	fake.createShareLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLinkCallCount() int {
+	fake.createShareLinkMutex.RLock()
+	defer fake.createShareLinkMutex.RUnlock()
+	return len(fake.createShareLinkArgsForCall)
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLinkCalls(stub func(context.Context, domain.InvoiceShareLink) (*domain.InvoiceShareLink, error)) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = stub
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLinkArgsForCall(i int) (context.Context, domain.InvoiceShareLink) {
+	fake.createShareLinkMutex.RLock()
+	defer fake.createShareLinkMutex.RUnlock()
+	argsForCall := fake.createShareLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLinkReturns(result1 *domain.InvoiceShareLink, result2 error) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = nil
+	fake.createShareLinkReturns = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) CreateShareLinkReturnsOnCall(i int, result1 *domain.InvoiceShareLink, result2 error) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = nil
+	if fake.createShareLinkReturnsOnCall == nil {
+		fake.createShareLinkReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.createShareLinkReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLink(arg1 context.Context, arg2 uuid.UUID) (*domain.InvoiceShareLink, error) {
+	fake.getShareLinkMutex.Lock()
+	ret, specificReturn := fake.getShareLinkReturnsOnCall[len(fake.getShareLinkArgsForCall)]
+	fake.getShareLinkArgsForCall = append(fake.getShareLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetShareLinkStub
+	fakeReturns := fake.getShareLinkReturns
+	fake.recordInvocation("GetShareLink", []interface{}{arg1, arg2})
+	fake.getShareLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLinkCallCount() int {
+	fake.getShareLinkMutex.RLock()
+	defer fake.getShareLinkMutex.RUnlock()
+	return len(fake.getShareLinkArgsForCall)
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLinkCalls(stub func(context.Context, uuid.UUID) (*domain.InvoiceShareLink, error)) {
+	fake.getShareLinkMutex.Lock()
+	defer fake.getShareLinkMutex.Unlock()
+	fake.GetShareLinkStub = stub
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLinkArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getShareLinkMutex.RLock()
+	defer fake.getShareLinkMutex.RUnlock()
+	argsForCall := fake.getShareLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLinkReturns(result1 *domain.InvoiceShareLink, result2 error) {
+	fake.getShareLinkMutex.Lock()
+	defer fake.getShareLinkMutex.Unlock()
+	fake.GetShareLinkStub = nil
+	fake.getShareLinkReturns = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) GetShareLinkReturnsOnCall(i int, result1 *domain.InvoiceShareLink, result2 error) {
+	fake.getShareLinkMutex.Lock()
+	defer fake.getShareLinkMutex.Unlock()
+	fake.GetShareLinkStub = nil
+	if fake.getShareLinkReturnsOnCall == nil {
+		fake.getShareLinkReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.getShareLinkReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinks(arg1 context.Context, arg2 uuid.UUID) ([]domain.InvoiceShareLink, error) {
+	fake.listShareLinksMutex.Lock()
+	ret, specificReturn := fake.listShareLinksReturnsOnCall[len(fake.listShareLinksArgsForCall)]
+	fake.listShareLinksArgsForCall = append(fake.listShareLinksArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListShareLinksStub
+	fakeReturns := fake.listShareLinksReturns
+	fake.recordInvocation("ListShareLinks", []interface{}{arg1, arg2})
+	fake.listShareLinksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinksCallCount() int {
+	fake.listShareLinksMutex.RLock()
+	defer fake.listShareLinksMutex.RUnlock()
+	return len(fake.listShareLinksArgsForCall)
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinksCalls(stub func(context.Context, uuid.UUID) ([]domain.InvoiceShareLink, error)) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = stub
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinksArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listShareLinksMutex.RLock()
+	defer fake.listShareLinksMutex.RUnlock()
+	argsForCall := fake.listShareLinksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinksReturns(result1 []domain.InvoiceShareLink, result2 error) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = nil
+	fake.listShareLinksReturns = struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) ListShareLinksReturnsOnCall(i int, result1 []domain.InvoiceShareLink, result2 error) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = nil
+	if fake.listShareLinksReturnsOnCall == nil {
+		fake.listShareLinksReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.listShareLinksReturnsOnCall[i] = struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkView(arg1 context.Context, arg2 domain.InvoiceShareLink, arg3 time.Time) error {
+	fake.recordShareLinkViewMutex.Lock()
+	ret, specificReturn := fake.recordShareLinkViewReturnsOnCall[len(fake.recordShareLinkViewArgsForCall)]
+	fake.recordShareLinkViewArgsForCall = append(fake.recordShareLinkViewArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.InvoiceShareLink
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RecordShareLinkViewStub
+	fakeReturns := fake.recordShareLinkViewReturns
+	fake.recordInvocation("RecordShareLinkView", []interface{}{arg1, arg2, arg3})
+	fake.recordShareLinkViewMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkViewCallCount() int {
+	fake.recordShareLinkViewMutex.RLock()
+	defer fake.recordShareLinkViewMutex.RUnlock()
+	return len(fake.recordShareLinkViewArgsForCall)
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkViewCalls(stub func(context.Context, domain.InvoiceShareLink, time.Time) error) {
+	fake.recordShareLinkViewMutex.Lock()
+	defer fake.recordShareLinkViewMutex.Unlock()
+	fake.RecordShareLinkViewStub = stub
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkViewArgsForCall(i int) (context.Context, domain.InvoiceShareLink, time.Time) {
+	fake.recordShareLinkViewMutex.RLock()
+	defer fake.recordShareLinkViewMutex.RUnlock()
+	argsForCall := fake.recordShareLinkViewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkViewReturns(result1 error) {
+	fake.recordShareLinkViewMutex.Lock()
+	defer fake.recordShareLinkViewMutex.Unlock()
+	fake.RecordShareLinkViewStub = nil
+	fake.recordShareLinkViewReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceShareRepository) RecordShareLinkViewReturnsOnCall(i int, result1 error) {
+	fake.recordShareLinkViewMutex.Lock()
+	defer fake.recordShareLinkViewMutex.Unlock()
+	fake.RecordShareLinkViewStub = nil
+	if fake.recordShareLinkViewReturnsOnCall == nil {
+		fake.recordShareLinkViewReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordShareLinkViewReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLink(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 time.Time) (*domain.InvoiceShareLink, error) {
+	fake.revokeShareLinkMutex.Lock()
+	ret, specificReturn := fake.revokeShareLinkReturnsOnCall[len(fake.revokeShareLinkArgsForCall)]
+	fake.revokeShareLinkArgsForCall = append(fake.revokeShareLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RevokeShareLinkStub
+	fakeReturns := fake.revokeShareLinkReturns
+	fake.recordInvocation("RevokeShareLink", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.revokeShareLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLinkCallCount() int {
+	fake.revokeShareLinkMutex.RLock()
+	defer fake.revokeShareLinkMutex.RUnlock()
+	return len(fake.revokeShareLinkArgsForCall)
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLinkCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*domain.InvoiceShareLink, error)) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = stub
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLinkArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) {
+	fake.revokeShareLinkMutex.RLock()
+	defer fake.revokeShareLinkMutex.RUnlock()
+	argsForCall := fake.revokeShareLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLinkReturns(result1 *domain.InvoiceShareLink, result2 error) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = nil
+	fake.revokeShareLinkReturns = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) RevokeShareLinkReturnsOnCall(i int, result1 *domain.InvoiceShareLink, result2 error) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = nil
+	if fake.revokeShareLinkReturnsOnCall == nil {
+		fake.revokeShareLinkReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.revokeShareLinkReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceShareRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceShareRepository = new(FakeInvoiceShareRepository)
@@ -0,0 +1,462 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeInvoiceShareService struct {
+	CreateShareLinkStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) (*domain.InvoiceShareLink, string, error)
+	createShareLinkMutex       sync.RWMutex
+	createShareLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+	}
+	createShareLinkReturns struct {
+		result1 *domain.InvoiceShareLink
+		result2 string
+		result3 error
+	}
+	createShareLinkReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceShareLink
+		result2 string
+		result3 error
+	}
+	GetSharedInvoiceStub        func(context.Context, string) (*domain.SharedInvoice, error)
+	getSharedInvoiceMutex       sync.RWMutex
+	getSharedInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getSharedInvoiceReturns struct {
+		result1 *domain.SharedInvoice
+		result2 error
+	}
+	getSharedInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.SharedInvoice
+		result2 error
+	}
+	ListShareLinksStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoiceShareLink, error)
+	listShareLinksMutex       sync.RWMutex
+	listShareLinksArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	listShareLinksReturns struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}
+	listShareLinksReturnsOnCall map[int]struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}
+	RenderSharedInvoicePDFStub        func(context.Context, string) ([]byte, string, error)
+	renderSharedInvoicePDFMutex       sync.RWMutex
+	renderSharedInvoicePDFArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	renderSharedInvoicePDFReturns struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	renderSharedInvoicePDFReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	RevokeShareLinkStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.InvoiceShareLink, error)
+	revokeShareLinkMutex       sync.RWMutex
+	revokeShareLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 uuid.UUID
+	}
+	revokeShareLinkReturns struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	revokeShareLinkReturnsOnCall map[int]struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLink(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 *time.Time) (*domain.InvoiceShareLink, string, error) {
+	fake.createShareLinkMutex.Lock()
+	ret, specificReturn := fake.createShareLinkReturnsOnCall[len(fake.createShareLinkArgsForCall)]
+	fake.createShareLinkArgsForCall = append(fake.createShareLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateShareLinkStub
+	fakeReturns := fake.createShareLinkReturns
+	fake.recordInvocation("CreateShareLink", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createShareLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLinkCallCount() int {
+	fake.createShareLinkMutex.RLock()
+	defer fake.createShareLinkMutex.RUnlock()
+	return len(fake.createShareLinkArgsForCall)
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLinkCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) (*domain.InvoiceShareLink, string, error)) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = stub
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLinkArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) {
+	fake.createShareLinkMutex.RLock()
+	defer fake.createShareLinkMutex.RUnlock()
+	argsForCall := fake.createShareLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLinkReturns(result1 *domain.InvoiceShareLink, result2 string, result3 error) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = nil
+	fake.createShareLinkReturns = struct {
+		result1 *domain.InvoiceShareLink
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceShareService) CreateShareLinkReturnsOnCall(i int, result1 *domain.InvoiceShareLink, result2 string, result3 error) {
+	fake.createShareLinkMutex.Lock()
+	defer fake.createShareLinkMutex.Unlock()
+	fake.CreateShareLinkStub = nil
+	if fake.createShareLinkReturnsOnCall == nil {
+		fake.createShareLinkReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceShareLink
+			result2 string
+			result3 error
+		})
+	}
+	fake.createShareLinkReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceShareLink
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoice(arg1 context.Context, arg2 string) (*domain.SharedInvoice, error) {
+	fake.getSharedInvoiceMutex.Lock()
+	ret, specificReturn := fake.getSharedInvoiceReturnsOnCall[len(fake.getSharedInvoiceArgsForCall)]
+	fake.getSharedInvoiceArgsForCall = append(fake.getSharedInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSharedInvoiceStub
+	fakeReturns := fake.getSharedInvoiceReturns
+	fake.recordInvocation("GetSharedInvoice", []interface{}{arg1, arg2})
+	fake.getSharedInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoiceCallCount() int {
+	fake.getSharedInvoiceMutex.RLock()
+	defer fake.getSharedInvoiceMutex.RUnlock()
+	return len(fake.getSharedInvoiceArgsForCall)
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoiceCalls(stub func(context.Context, string) (*domain.SharedInvoice, error)) {
+	fake.getSharedInvoiceMutex.Lock()
+	defer fake.getSharedInvoiceMutex.Unlock()
+	fake.GetSharedInvoiceStub = stub
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoiceArgsForCall(i int) (context.Context, string) {
+	fake.getSharedInvoiceMutex.RLock()
+	defer fake.getSharedInvoiceMutex.RUnlock()
+	argsForCall := fake.getSharedInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoiceReturns(result1 *domain.SharedInvoice, result2 error) {
+	fake.getSharedInvoiceMutex.Lock()
+	defer fake.getSharedInvoiceMutex.Unlock()
+	fake.GetSharedInvoiceStub = nil
+	fake.getSharedInvoiceReturns = struct {
+		result1 *domain.SharedInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) GetSharedInvoiceReturnsOnCall(i int, result1 *domain.SharedInvoice, result2 error) {
+	fake.getSharedInvoiceMutex.Lock()
+	defer fake.getSharedInvoiceMutex.Unlock()
+	fake.GetSharedInvoiceStub = nil
+	if fake.getSharedInvoiceReturnsOnCall == nil {
+		fake.getSharedInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.SharedInvoice
+			result2 error
+		})
+	}
+	fake.getSharedInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.SharedInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinks(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]domain.InvoiceShareLink, error) {
+	fake.listShareLinksMutex.Lock()
+	ret, specificReturn := fake.listShareLinksReturnsOnCall[len(fake.listShareLinksArgsForCall)]
+	fake.listShareLinksArgsForCall = append(fake.listShareLinksArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListShareLinksStub
+	fakeReturns := fake.listShareLinksReturns
+	fake.recordInvocation("ListShareLinks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listShareLinksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinksCallCount() int {
+	fake.listShareLinksMutex.RLock()
+	defer fake.listShareLinksMutex.RUnlock()
+	return len(fake.listShareLinksArgsForCall)
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinksCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.InvoiceShareLink, error)) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = stub
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinksArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.listShareLinksMutex.RLock()
+	defer fake.listShareLinksMutex.RUnlock()
+	argsForCall := fake.listShareLinksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinksReturns(result1 []domain.InvoiceShareLink, result2 error) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = nil
+	fake.listShareLinksReturns = struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) ListShareLinksReturnsOnCall(i int, result1 []domain.InvoiceShareLink, result2 error) {
+	fake.listShareLinksMutex.Lock()
+	defer fake.listShareLinksMutex.Unlock()
+	fake.ListShareLinksStub = nil
+	if fake.listShareLinksReturnsOnCall == nil {
+		fake.listShareLinksReturnsOnCall = make(map[int]struct {
+			result1 []domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.listShareLinksReturnsOnCall[i] = struct {
+		result1 []domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDF(arg1 context.Context, arg2 string) ([]byte, string, error) {
+	fake.renderSharedInvoicePDFMutex.Lock()
+	ret, specificReturn := fake.renderSharedInvoicePDFReturnsOnCall[len(fake.renderSharedInvoicePDFArgsForCall)]
+	fake.renderSharedInvoicePDFArgsForCall = append(fake.renderSharedInvoicePDFArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RenderSharedInvoicePDFStub
+	fakeReturns := fake.renderSharedInvoicePDFReturns
+	fake.recordInvocation("RenderSharedInvoicePDF", []interface{}{arg1, arg2})
+	fake.renderSharedInvoicePDFMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDFCallCount() int {
+	fake.renderSharedInvoicePDFMutex.RLock()
+	defer fake.renderSharedInvoicePDFMutex.RUnlock()
+	return len(fake.renderSharedInvoicePDFArgsForCall)
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDFCalls(stub func(context.Context, string) ([]byte, string, error)) {
+	fake.renderSharedInvoicePDFMutex.Lock()
+	defer fake.renderSharedInvoicePDFMutex.Unlock()
+	fake.RenderSharedInvoicePDFStub = stub
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDFArgsForCall(i int) (context.Context, string) {
+	fake.renderSharedInvoicePDFMutex.RLock()
+	defer fake.renderSharedInvoicePDFMutex.RUnlock()
+	argsForCall := fake.renderSharedInvoicePDFArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDFReturns(result1 []byte, result2 string, result3 error) {
+	fake.renderSharedInvoicePDFMutex.Lock()
+	defer fake.renderSharedInvoicePDFMutex.Unlock()
+	fake.RenderSharedInvoicePDFStub = nil
+	fake.renderSharedInvoicePDFReturns = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceShareService) RenderSharedInvoicePDFReturnsOnCall(i int, result1 []byte, result2 string, result3 error) {
+	fake.renderSharedInvoicePDFMutex.Lock()
+	defer fake.renderSharedInvoicePDFMutex.Unlock()
+	fake.RenderSharedInvoicePDFStub = nil
+	if fake.renderSharedInvoicePDFReturnsOnCall == nil {
+		fake.renderSharedInvoicePDFReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 string
+			result3 error
+		})
+	}
+	fake.renderSharedInvoicePDFReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLink(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 uuid.UUID) (*domain.InvoiceShareLink, error) {
+	fake.revokeShareLinkMutex.Lock()
+	ret, specificReturn := fake.revokeShareLinkReturnsOnCall[len(fake.revokeShareLinkArgsForCall)]
+	fake.revokeShareLinkArgsForCall = append(fake.revokeShareLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RevokeShareLinkStub
+	fakeReturns := fake.revokeShareLinkReturns
+	fake.recordInvocation("RevokeShareLink", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.revokeShareLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLinkCallCount() int {
+	fake.revokeShareLinkMutex.RLock()
+	defer fake.revokeShareLinkMutex.RUnlock()
+	return len(fake.revokeShareLinkArgsForCall)
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLinkCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.InvoiceShareLink, error)) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = stub
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLinkArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.revokeShareLinkMutex.RLock()
+	defer fake.revokeShareLinkMutex.RUnlock()
+	argsForCall := fake.revokeShareLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLinkReturns(result1 *domain.InvoiceShareLink, result2 error) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = nil
+	fake.revokeShareLinkReturns = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) RevokeShareLinkReturnsOnCall(i int, result1 *domain.InvoiceShareLink, result2 error) {
+	fake.revokeShareLinkMutex.Lock()
+	defer fake.revokeShareLinkMutex.Unlock()
+	fake.RevokeShareLinkStub = nil
+	if fake.revokeShareLinkReturnsOnCall == nil {
+		fake.revokeShareLinkReturnsOnCall = make(map[int]struct {
+			result1 *domain.InvoiceShareLink
+			result2 error
+		})
+	}
+	fake.revokeShareLinkReturnsOnCall[i] = struct {
+		result1 *domain.InvoiceShareLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvoiceShareService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvoiceShareService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.InvoiceShareService = new(FakeInvoiceShareService)
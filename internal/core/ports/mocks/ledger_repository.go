@@ -0,0 +1,679 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeLedgerRepository struct {
+	CreateAccountStub        func(context.Context, domain.LedgerAccount) (*domain.LedgerAccount, error)
+	createAccountMutex       sync.RWMutex
+	createAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.LedgerAccount
+	}
+	createAccountReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	createAccountReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetAccountByCodeStub        func(context.Context, string) (*domain.LedgerAccount, error)
+	getAccountByCodeMutex       sync.RWMutex
+	getAccountByCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountByCodeReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	getAccountByCodeReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetAccountByIDStub        func(context.Context, uuid.UUID) (*domain.LedgerAccount, error)
+	getAccountByIDMutex       sync.RWMutex
+	getAccountByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getAccountByIDReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	getAccountByIDReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetBalanceStub        func(context.Context, uuid.UUID, string) (*domain.LedgerBalance, error)
+	getBalanceMutex       sync.RWMutex
+	getBalanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getBalanceReturns struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}
+	getBalanceReturnsOnCall map[int]struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}
+	GetBalancesStub        func(context.Context, uuid.UUID) ([]domain.LedgerBalance, error)
+	getBalancesMutex       sync.RWMutex
+	getBalancesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getBalancesReturns struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}
+	getBalancesReturnsOnCall map[int]struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}
+	GetEntryByReferenceStub        func(context.Context, string) (*domain.LedgerEntry, error)
+	getEntryByReferenceMutex       sync.RWMutex
+	getEntryByReferenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getEntryByReferenceReturns struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	getEntryByReferenceReturnsOnCall map[int]struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	ListAccountsByOwnerStub        func(context.Context, uuid.UUID) ([]domain.LedgerAccount, error)
+	listAccountsByOwnerMutex       sync.RWMutex
+	listAccountsByOwnerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAccountsByOwnerReturns struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}
+	listAccountsByOwnerReturnsOnCall map[int]struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}
+	PostEntryStub        func(context.Context, domain.LedgerEntry) (*domain.LedgerEntry, bool, error)
+	postEntryMutex       sync.RWMutex
+	postEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}
+	postEntryReturns struct {
+		result1 *domain.LedgerEntry
+		result2 bool
+		result3 error
+	}
+	postEntryReturnsOnCall map[int]struct {
+		result1 *domain.LedgerEntry
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLedgerRepository) CreateAccount(arg1 context.Context, arg2 domain.LedgerAccount) (*domain.LedgerAccount, error) {
+	fake.createAccountMutex.Lock()
+	ret, specificReturn := fake.createAccountReturnsOnCall[len(fake.createAccountArgsForCall)]
+	fake.createAccountArgsForCall = append(fake.createAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.LedgerAccount
+	}{arg1, arg2})
+	stub := fake.CreateAccountStub
+	fakeReturns := fake.createAccountReturns
+	fake.recordInvocation("CreateAccount", []interface{}{arg1, arg2})
+	fake.createAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) CreateAccountCallCount() int {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	return len(fake.createAccountArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) CreateAccountCalls(stub func(context.Context, domain.LedgerAccount) (*domain.LedgerAccount, error)) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = stub
+}
+
+func (fake *FakeLedgerRepository) CreateAccountArgsForCall(i int) (context.Context, domain.LedgerAccount) {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	argsForCall := fake.createAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) CreateAccountReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	fake.createAccountReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) CreateAccountReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	if fake.createAccountReturnsOnCall == nil {
+		fake.createAccountReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.createAccountReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCode(arg1 context.Context, arg2 string) (*domain.LedgerAccount, error) {
+	fake.getAccountByCodeMutex.Lock()
+	ret, specificReturn := fake.getAccountByCodeReturnsOnCall[len(fake.getAccountByCodeArgsForCall)]
+	fake.getAccountByCodeArgsForCall = append(fake.getAccountByCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountByCodeStub
+	fakeReturns := fake.getAccountByCodeReturns
+	fake.recordInvocation("GetAccountByCode", []interface{}{arg1, arg2})
+	fake.getAccountByCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCodeCallCount() int {
+	fake.getAccountByCodeMutex.RLock()
+	defer fake.getAccountByCodeMutex.RUnlock()
+	return len(fake.getAccountByCodeArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCodeCalls(stub func(context.Context, string) (*domain.LedgerAccount, error)) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = stub
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCodeArgsForCall(i int) (context.Context, string) {
+	fake.getAccountByCodeMutex.RLock()
+	defer fake.getAccountByCodeMutex.RUnlock()
+	argsForCall := fake.getAccountByCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCodeReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = nil
+	fake.getAccountByCodeReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetAccountByCodeReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = nil
+	if fake.getAccountByCodeReturnsOnCall == nil {
+		fake.getAccountByCodeReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.getAccountByCodeReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetAccountByID(arg1 context.Context, arg2 uuid.UUID) (*domain.LedgerAccount, error) {
+	fake.getAccountByIDMutex.Lock()
+	ret, specificReturn := fake.getAccountByIDReturnsOnCall[len(fake.getAccountByIDArgsForCall)]
+	fake.getAccountByIDArgsForCall = append(fake.getAccountByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetAccountByIDStub
+	fakeReturns := fake.getAccountByIDReturns
+	fake.recordInvocation("GetAccountByID", []interface{}{arg1, arg2})
+	fake.getAccountByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) GetAccountByIDCallCount() int {
+	fake.getAccountByIDMutex.RLock()
+	defer fake.getAccountByIDMutex.RUnlock()
+	return len(fake.getAccountByIDArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) GetAccountByIDCalls(stub func(context.Context, uuid.UUID) (*domain.LedgerAccount, error)) {
+	fake.getAccountByIDMutex.Lock()
+	defer fake.getAccountByIDMutex.Unlock()
+	fake.GetAccountByIDStub = stub
+}
+
+func (fake *FakeLedgerRepository) GetAccountByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getAccountByIDMutex.RLock()
+	defer fake.getAccountByIDMutex.RUnlock()
+	argsForCall := fake.getAccountByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) GetAccountByIDReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByIDMutex.Lock()
+	defer fake.getAccountByIDMutex.Unlock()
+	fake.GetAccountByIDStub = nil
+	fake.getAccountByIDReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetAccountByIDReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByIDMutex.Lock()
+	defer fake.getAccountByIDMutex.Unlock()
+	fake.GetAccountByIDStub = nil
+	if fake.getAccountByIDReturnsOnCall == nil {
+		fake.getAccountByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.getAccountByIDReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetBalance(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.LedgerBalance, error) {
+	fake.getBalanceMutex.Lock()
+	ret, specificReturn := fake.getBalanceReturnsOnCall[len(fake.getBalanceArgsForCall)]
+	fake.getBalanceArgsForCall = append(fake.getBalanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetBalanceStub
+	fakeReturns := fake.getBalanceReturns
+	fake.recordInvocation("GetBalance", []interface{}{arg1, arg2, arg3})
+	fake.getBalanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) GetBalanceCallCount() int {
+	fake.getBalanceMutex.RLock()
+	defer fake.getBalanceMutex.RUnlock()
+	return len(fake.getBalanceArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) GetBalanceCalls(stub func(context.Context, uuid.UUID, string) (*domain.LedgerBalance, error)) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = stub
+}
+
+func (fake *FakeLedgerRepository) GetBalanceArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getBalanceMutex.RLock()
+	defer fake.getBalanceMutex.RUnlock()
+	argsForCall := fake.getBalanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLedgerRepository) GetBalanceReturns(result1 *domain.LedgerBalance, result2 error) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = nil
+	fake.getBalanceReturns = struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetBalanceReturnsOnCall(i int, result1 *domain.LedgerBalance, result2 error) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = nil
+	if fake.getBalanceReturnsOnCall == nil {
+		fake.getBalanceReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerBalance
+			result2 error
+		})
+	}
+	fake.getBalanceReturnsOnCall[i] = struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetBalances(arg1 context.Context, arg2 uuid.UUID) ([]domain.LedgerBalance, error) {
+	fake.getBalancesMutex.Lock()
+	ret, specificReturn := fake.getBalancesReturnsOnCall[len(fake.getBalancesArgsForCall)]
+	fake.getBalancesArgsForCall = append(fake.getBalancesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetBalancesStub
+	fakeReturns := fake.getBalancesReturns
+	fake.recordInvocation("GetBalances", []interface{}{arg1, arg2})
+	fake.getBalancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) GetBalancesCallCount() int {
+	fake.getBalancesMutex.RLock()
+	defer fake.getBalancesMutex.RUnlock()
+	return len(fake.getBalancesArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) GetBalancesCalls(stub func(context.Context, uuid.UUID) ([]domain.LedgerBalance, error)) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = stub
+}
+
+func (fake *FakeLedgerRepository) GetBalancesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getBalancesMutex.RLock()
+	defer fake.getBalancesMutex.RUnlock()
+	argsForCall := fake.getBalancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) GetBalancesReturns(result1 []domain.LedgerBalance, result2 error) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = nil
+	fake.getBalancesReturns = struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetBalancesReturnsOnCall(i int, result1 []domain.LedgerBalance, result2 error) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = nil
+	if fake.getBalancesReturnsOnCall == nil {
+		fake.getBalancesReturnsOnCall = make(map[int]struct {
+			result1 []domain.LedgerBalance
+			result2 error
+		})
+	}
+	fake.getBalancesReturnsOnCall[i] = struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReference(arg1 context.Context, arg2 string) (*domain.LedgerEntry, error) {
+	fake.getEntryByReferenceMutex.Lock()
+	ret, specificReturn := fake.getEntryByReferenceReturnsOnCall[len(fake.getEntryByReferenceArgsForCall)]
+	fake.getEntryByReferenceArgsForCall = append(fake.getEntryByReferenceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetEntryByReferenceStub
+	fakeReturns := fake.getEntryByReferenceReturns
+	fake.recordInvocation("GetEntryByReference", []interface{}{arg1, arg2})
+	fake.getEntryByReferenceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReferenceCallCount() int {
+	fake.getEntryByReferenceMutex.RLock()
+	defer fake.getEntryByReferenceMutex.RUnlock()
+	return len(fake.getEntryByReferenceArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReferenceCalls(stub func(context.Context, string) (*domain.LedgerEntry, error)) {
+	fake.getEntryByReferenceMutex.Lock()
+	defer fake.getEntryByReferenceMutex.Unlock()
+	fake.GetEntryByReferenceStub = stub
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReferenceArgsForCall(i int) (context.Context, string) {
+	fake.getEntryByReferenceMutex.RLock()
+	defer fake.getEntryByReferenceMutex.RUnlock()
+	argsForCall := fake.getEntryByReferenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReferenceReturns(result1 *domain.LedgerEntry, result2 error) {
+	fake.getEntryByReferenceMutex.Lock()
+	defer fake.getEntryByReferenceMutex.Unlock()
+	fake.GetEntryByReferenceStub = nil
+	fake.getEntryByReferenceReturns = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) GetEntryByReferenceReturnsOnCall(i int, result1 *domain.LedgerEntry, result2 error) {
+	fake.getEntryByReferenceMutex.Lock()
+	defer fake.getEntryByReferenceMutex.Unlock()
+	fake.GetEntryByReferenceStub = nil
+	if fake.getEntryByReferenceReturnsOnCall == nil {
+		fake.getEntryByReferenceReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerEntry
+			result2 error
+		})
+	}
+	fake.getEntryByReferenceReturnsOnCall[i] = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwner(arg1 context.Context, arg2 uuid.UUID) ([]domain.LedgerAccount, error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	ret, specificReturn := fake.listAccountsByOwnerReturnsOnCall[len(fake.listAccountsByOwnerArgsForCall)]
+	fake.listAccountsByOwnerArgsForCall = append(fake.listAccountsByOwnerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAccountsByOwnerStub
+	fakeReturns := fake.listAccountsByOwnerReturns
+	fake.recordInvocation("ListAccountsByOwner", []interface{}{arg1, arg2})
+	fake.listAccountsByOwnerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwnerCallCount() int {
+	fake.listAccountsByOwnerMutex.RLock()
+	defer fake.listAccountsByOwnerMutex.RUnlock()
+	return len(fake.listAccountsByOwnerArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwnerCalls(stub func(context.Context, uuid.UUID) ([]domain.LedgerAccount, error)) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = stub
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwnerArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAccountsByOwnerMutex.RLock()
+	defer fake.listAccountsByOwnerMutex.RUnlock()
+	argsForCall := fake.listAccountsByOwnerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwnerReturns(result1 []domain.LedgerAccount, result2 error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = nil
+	fake.listAccountsByOwnerReturns = struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) ListAccountsByOwnerReturnsOnCall(i int, result1 []domain.LedgerAccount, result2 error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = nil
+	if fake.listAccountsByOwnerReturnsOnCall == nil {
+		fake.listAccountsByOwnerReturnsOnCall = make(map[int]struct {
+			result1 []domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.listAccountsByOwnerReturnsOnCall[i] = struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerRepository) PostEntry(arg1 context.Context, arg2 domain.LedgerEntry) (*domain.LedgerEntry, bool, error) {
+	fake.postEntryMutex.Lock()
+	ret, specificReturn := fake.postEntryReturnsOnCall[len(fake.postEntryArgsForCall)]
+	fake.postEntryArgsForCall = append(fake.postEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}{arg1, arg2})
+	stub := fake.PostEntryStub
+	fakeReturns := fake.postEntryReturns
+	fake.recordInvocation("PostEntry", []interface{}{arg1, arg2})
+	fake.postEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLedgerRepository) PostEntryCallCount() int {
+	fake.postEntryMutex.RLock()
+	defer fake.postEntryMutex.RUnlock()
+	return len(fake.postEntryArgsForCall)
+}
+
+func (fake *FakeLedgerRepository) PostEntryCalls(stub func(context.Context, domain.LedgerEntry) (*domain.LedgerEntry, bool, error)) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = stub
+}
+
+func (fake *FakeLedgerRepository) PostEntryArgsForCall(i int) (context.Context, domain.LedgerEntry) {
+	fake.postEntryMutex.RLock()
+	defer fake.postEntryMutex.RUnlock()
+	argsForCall := fake.postEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerRepository) PostEntryReturns(result1 *domain.LedgerEntry, result2 bool, result3 error) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = nil
+	fake.postEntryReturns = struct {
+		result1 *domain.LedgerEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLedgerRepository) PostEntryReturnsOnCall(i int, result1 *domain.LedgerEntry, result2 bool, result3 error) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = nil
+	if fake.postEntryReturnsOnCall == nil {
+		fake.postEntryReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerEntry
+			result2 bool
+			result3 error
+		})
+	}
+	fake.postEntryReturnsOnCall[i] = struct {
+		result1 *domain.LedgerEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLedgerRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLedgerRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.LedgerRepository = new(FakeLedgerRepository)
@@ -0,0 +1,748 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeLedgerService struct {
+	CreateAccountStub        func(context.Context, domain.LedgerAccount) (*domain.LedgerAccount, error)
+	createAccountMutex       sync.RWMutex
+	createAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.LedgerAccount
+	}
+	createAccountReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	createAccountReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetAccountStub        func(context.Context, uuid.UUID) (*domain.LedgerAccount, error)
+	getAccountMutex       sync.RWMutex
+	getAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getAccountReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	getAccountReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetAccountByCodeStub        func(context.Context, string) (*domain.LedgerAccount, error)
+	getAccountByCodeMutex       sync.RWMutex
+	getAccountByCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountByCodeReturns struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	getAccountByCodeReturnsOnCall map[int]struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}
+	GetBalanceStub        func(context.Context, uuid.UUID, string) (*domain.LedgerBalance, error)
+	getBalanceMutex       sync.RWMutex
+	getBalanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getBalanceReturns struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}
+	getBalanceReturnsOnCall map[int]struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}
+	GetBalancesStub        func(context.Context, uuid.UUID) ([]domain.LedgerBalance, error)
+	getBalancesMutex       sync.RWMutex
+	getBalancesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getBalancesReturns struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}
+	getBalancesReturnsOnCall map[int]struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}
+	GetEntryStub        func(context.Context, string) (*domain.LedgerEntry, error)
+	getEntryMutex       sync.RWMutex
+	getEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getEntryReturns struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	getEntryReturnsOnCall map[int]struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	ListAccountsByOwnerStub        func(context.Context, uuid.UUID) ([]domain.LedgerAccount, error)
+	listAccountsByOwnerMutex       sync.RWMutex
+	listAccountsByOwnerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAccountsByOwnerReturns struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}
+	listAccountsByOwnerReturnsOnCall map[int]struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}
+	PostEntryStub        func(context.Context, domain.LedgerEntry) (*domain.LedgerEntry, error)
+	postEntryMutex       sync.RWMutex
+	postEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}
+	postEntryReturns struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	postEntryReturnsOnCall map[int]struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}
+	ValidateEntryStub        func(context.Context, domain.LedgerEntry) error
+	validateEntryMutex       sync.RWMutex
+	validateEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}
+	validateEntryReturns struct {
+		result1 error
+	}
+	validateEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLedgerService) CreateAccount(arg1 context.Context, arg2 domain.LedgerAccount) (*domain.LedgerAccount, error) {
+	fake.createAccountMutex.Lock()
+	ret, specificReturn := fake.createAccountReturnsOnCall[len(fake.createAccountArgsForCall)]
+	fake.createAccountArgsForCall = append(fake.createAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.LedgerAccount
+	}{arg1, arg2})
+	stub := fake.CreateAccountStub
+	fakeReturns := fake.createAccountReturns
+	fake.recordInvocation("CreateAccount", []interface{}{arg1, arg2})
+	fake.createAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) CreateAccountCallCount() int {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	return len(fake.createAccountArgsForCall)
+}
+
+func (fake *FakeLedgerService) CreateAccountCalls(stub func(context.Context, domain.LedgerAccount) (*domain.LedgerAccount, error)) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = stub
+}
+
+func (fake *FakeLedgerService) CreateAccountArgsForCall(i int) (context.Context, domain.LedgerAccount) {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	argsForCall := fake.createAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) CreateAccountReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	fake.createAccountReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) CreateAccountReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	if fake.createAccountReturnsOnCall == nil {
+		fake.createAccountReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.createAccountReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetAccount(arg1 context.Context, arg2 uuid.UUID) (*domain.LedgerAccount, error) {
+	fake.getAccountMutex.Lock()
+	ret, specificReturn := fake.getAccountReturnsOnCall[len(fake.getAccountArgsForCall)]
+	fake.getAccountArgsForCall = append(fake.getAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetAccountStub
+	fakeReturns := fake.getAccountReturns
+	fake.recordInvocation("GetAccount", []interface{}{arg1, arg2})
+	fake.getAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) GetAccountCallCount() int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	return len(fake.getAccountArgsForCall)
+}
+
+func (fake *FakeLedgerService) GetAccountCalls(stub func(context.Context, uuid.UUID) (*domain.LedgerAccount, error)) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = stub
+}
+
+func (fake *FakeLedgerService) GetAccountArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	argsForCall := fake.getAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) GetAccountReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	fake.getAccountReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetAccountReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	if fake.getAccountReturnsOnCall == nil {
+		fake.getAccountReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.getAccountReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetAccountByCode(arg1 context.Context, arg2 string) (*domain.LedgerAccount, error) {
+	fake.getAccountByCodeMutex.Lock()
+	ret, specificReturn := fake.getAccountByCodeReturnsOnCall[len(fake.getAccountByCodeArgsForCall)]
+	fake.getAccountByCodeArgsForCall = append(fake.getAccountByCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountByCodeStub
+	fakeReturns := fake.getAccountByCodeReturns
+	fake.recordInvocation("GetAccountByCode", []interface{}{arg1, arg2})
+	fake.getAccountByCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) GetAccountByCodeCallCount() int {
+	fake.getAccountByCodeMutex.RLock()
+	defer fake.getAccountByCodeMutex.RUnlock()
+	return len(fake.getAccountByCodeArgsForCall)
+}
+
+func (fake *FakeLedgerService) GetAccountByCodeCalls(stub func(context.Context, string) (*domain.LedgerAccount, error)) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = stub
+}
+
+func (fake *FakeLedgerService) GetAccountByCodeArgsForCall(i int) (context.Context, string) {
+	fake.getAccountByCodeMutex.RLock()
+	defer fake.getAccountByCodeMutex.RUnlock()
+	argsForCall := fake.getAccountByCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) GetAccountByCodeReturns(result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = nil
+	fake.getAccountByCodeReturns = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetAccountByCodeReturnsOnCall(i int, result1 *domain.LedgerAccount, result2 error) {
+	fake.getAccountByCodeMutex.Lock()
+	defer fake.getAccountByCodeMutex.Unlock()
+	fake.GetAccountByCodeStub = nil
+	if fake.getAccountByCodeReturnsOnCall == nil {
+		fake.getAccountByCodeReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.getAccountByCodeReturnsOnCall[i] = struct {
+		result1 *domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetBalance(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.LedgerBalance, error) {
+	fake.getBalanceMutex.Lock()
+	ret, specificReturn := fake.getBalanceReturnsOnCall[len(fake.getBalanceArgsForCall)]
+	fake.getBalanceArgsForCall = append(fake.getBalanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetBalanceStub
+	fakeReturns := fake.getBalanceReturns
+	fake.recordInvocation("GetBalance", []interface{}{arg1, arg2, arg3})
+	fake.getBalanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) GetBalanceCallCount() int {
+	fake.getBalanceMutex.RLock()
+	defer fake.getBalanceMutex.RUnlock()
+	return len(fake.getBalanceArgsForCall)
+}
+
+func (fake *FakeLedgerService) GetBalanceCalls(stub func(context.Context, uuid.UUID, string) (*domain.LedgerBalance, error)) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = stub
+}
+
+func (fake *FakeLedgerService) GetBalanceArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getBalanceMutex.RLock()
+	defer fake.getBalanceMutex.RUnlock()
+	argsForCall := fake.getBalanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLedgerService) GetBalanceReturns(result1 *domain.LedgerBalance, result2 error) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = nil
+	fake.getBalanceReturns = struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetBalanceReturnsOnCall(i int, result1 *domain.LedgerBalance, result2 error) {
+	fake.getBalanceMutex.Lock()
+	defer fake.getBalanceMutex.Unlock()
+	fake.GetBalanceStub = nil
+	if fake.getBalanceReturnsOnCall == nil {
+		fake.getBalanceReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerBalance
+			result2 error
+		})
+	}
+	fake.getBalanceReturnsOnCall[i] = struct {
+		result1 *domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetBalances(arg1 context.Context, arg2 uuid.UUID) ([]domain.LedgerBalance, error) {
+	fake.getBalancesMutex.Lock()
+	ret, specificReturn := fake.getBalancesReturnsOnCall[len(fake.getBalancesArgsForCall)]
+	fake.getBalancesArgsForCall = append(fake.getBalancesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetBalancesStub
+	fakeReturns := fake.getBalancesReturns
+	fake.recordInvocation("GetBalances", []interface{}{arg1, arg2})
+	fake.getBalancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) GetBalancesCallCount() int {
+	fake.getBalancesMutex.RLock()
+	defer fake.getBalancesMutex.RUnlock()
+	return len(fake.getBalancesArgsForCall)
+}
+
+func (fake *FakeLedgerService) GetBalancesCalls(stub func(context.Context, uuid.UUID) ([]domain.LedgerBalance, error)) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = stub
+}
+
+func (fake *FakeLedgerService) GetBalancesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getBalancesMutex.RLock()
+	defer fake.getBalancesMutex.RUnlock()
+	argsForCall := fake.getBalancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) GetBalancesReturns(result1 []domain.LedgerBalance, result2 error) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = nil
+	fake.getBalancesReturns = struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetBalancesReturnsOnCall(i int, result1 []domain.LedgerBalance, result2 error) {
+	fake.getBalancesMutex.Lock()
+	defer fake.getBalancesMutex.Unlock()
+	fake.GetBalancesStub = nil
+	if fake.getBalancesReturnsOnCall == nil {
+		fake.getBalancesReturnsOnCall = make(map[int]struct {
+			result1 []domain.LedgerBalance
+			result2 error
+		})
+	}
+	fake.getBalancesReturnsOnCall[i] = struct {
+		result1 []domain.LedgerBalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetEntry(arg1 context.Context, arg2 string) (*domain.LedgerEntry, error) {
+	fake.getEntryMutex.Lock()
+	ret, specificReturn := fake.getEntryReturnsOnCall[len(fake.getEntryArgsForCall)]
+	fake.getEntryArgsForCall = append(fake.getEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetEntryStub
+	fakeReturns := fake.getEntryReturns
+	fake.recordInvocation("GetEntry", []interface{}{arg1, arg2})
+	fake.getEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) GetEntryCallCount() int {
+	fake.getEntryMutex.RLock()
+	defer fake.getEntryMutex.RUnlock()
+	return len(fake.getEntryArgsForCall)
+}
+
+func (fake *FakeLedgerService) GetEntryCalls(stub func(context.Context, string) (*domain.LedgerEntry, error)) {
+	fake.getEntryMutex.Lock()
+	defer fake.getEntryMutex.Unlock()
+	fake.GetEntryStub = stub
+}
+
+func (fake *FakeLedgerService) GetEntryArgsForCall(i int) (context.Context, string) {
+	fake.getEntryMutex.RLock()
+	defer fake.getEntryMutex.RUnlock()
+	argsForCall := fake.getEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) GetEntryReturns(result1 *domain.LedgerEntry, result2 error) {
+	fake.getEntryMutex.Lock()
+	defer fake.getEntryMutex.Unlock()
+	fake.GetEntryStub = nil
+	fake.getEntryReturns = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) GetEntryReturnsOnCall(i int, result1 *domain.LedgerEntry, result2 error) {
+	fake.getEntryMutex.Lock()
+	defer fake.getEntryMutex.Unlock()
+	fake.GetEntryStub = nil
+	if fake.getEntryReturnsOnCall == nil {
+		fake.getEntryReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerEntry
+			result2 error
+		})
+	}
+	fake.getEntryReturnsOnCall[i] = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwner(arg1 context.Context, arg2 uuid.UUID) ([]domain.LedgerAccount, error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	ret, specificReturn := fake.listAccountsByOwnerReturnsOnCall[len(fake.listAccountsByOwnerArgsForCall)]
+	fake.listAccountsByOwnerArgsForCall = append(fake.listAccountsByOwnerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAccountsByOwnerStub
+	fakeReturns := fake.listAccountsByOwnerReturns
+	fake.recordInvocation("ListAccountsByOwner", []interface{}{arg1, arg2})
+	fake.listAccountsByOwnerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwnerCallCount() int {
+	fake.listAccountsByOwnerMutex.RLock()
+	defer fake.listAccountsByOwnerMutex.RUnlock()
+	return len(fake.listAccountsByOwnerArgsForCall)
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwnerCalls(stub func(context.Context, uuid.UUID) ([]domain.LedgerAccount, error)) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = stub
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwnerArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAccountsByOwnerMutex.RLock()
+	defer fake.listAccountsByOwnerMutex.RUnlock()
+	argsForCall := fake.listAccountsByOwnerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwnerReturns(result1 []domain.LedgerAccount, result2 error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = nil
+	fake.listAccountsByOwnerReturns = struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) ListAccountsByOwnerReturnsOnCall(i int, result1 []domain.LedgerAccount, result2 error) {
+	fake.listAccountsByOwnerMutex.Lock()
+	defer fake.listAccountsByOwnerMutex.Unlock()
+	fake.ListAccountsByOwnerStub = nil
+	if fake.listAccountsByOwnerReturnsOnCall == nil {
+		fake.listAccountsByOwnerReturnsOnCall = make(map[int]struct {
+			result1 []domain.LedgerAccount
+			result2 error
+		})
+	}
+	fake.listAccountsByOwnerReturnsOnCall[i] = struct {
+		result1 []domain.LedgerAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) PostEntry(arg1 context.Context, arg2 domain.LedgerEntry) (*domain.LedgerEntry, error) {
+	fake.postEntryMutex.Lock()
+	ret, specificReturn := fake.postEntryReturnsOnCall[len(fake.postEntryArgsForCall)]
+	fake.postEntryArgsForCall = append(fake.postEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}{arg1, arg2})
+	stub := fake.PostEntryStub
+	fakeReturns := fake.postEntryReturns
+	fake.recordInvocation("PostEntry", []interface{}{arg1, arg2})
+	fake.postEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLedgerService) PostEntryCallCount() int {
+	fake.postEntryMutex.RLock()
+	defer fake.postEntryMutex.RUnlock()
+	return len(fake.postEntryArgsForCall)
+}
+
+func (fake *FakeLedgerService) PostEntryCalls(stub func(context.Context, domain.LedgerEntry) (*domain.LedgerEntry, error)) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = stub
+}
+
+func (fake *FakeLedgerService) PostEntryArgsForCall(i int) (context.Context, domain.LedgerEntry) {
+	fake.postEntryMutex.RLock()
+	defer fake.postEntryMutex.RUnlock()
+	argsForCall := fake.postEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) PostEntryReturns(result1 *domain.LedgerEntry, result2 error) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = nil
+	fake.postEntryReturns = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) PostEntryReturnsOnCall(i int, result1 *domain.LedgerEntry, result2 error) {
+	fake.postEntryMutex.Lock()
+	defer fake.postEntryMutex.Unlock()
+	fake.PostEntryStub = nil
+	if fake.postEntryReturnsOnCall == nil {
+		fake.postEntryReturnsOnCall = make(map[int]struct {
+			result1 *domain.LedgerEntry
+			result2 error
+		})
+	}
+	fake.postEntryReturnsOnCall[i] = struct {
+		result1 *domain.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLedgerService) ValidateEntry(arg1 context.Context, arg2 domain.LedgerEntry) error {
+	fake.validateEntryMutex.Lock()
+	ret, specificReturn := fake.validateEntryReturnsOnCall[len(fake.validateEntryArgsForCall)]
+	fake.validateEntryArgsForCall = append(fake.validateEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.LedgerEntry
+	}{arg1, arg2})
+	stub := fake.ValidateEntryStub
+	fakeReturns := fake.validateEntryReturns
+	fake.recordInvocation("ValidateEntry", []interface{}{arg1, arg2})
+	fake.validateEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLedgerService) ValidateEntryCallCount() int {
+	fake.validateEntryMutex.RLock()
+	defer fake.validateEntryMutex.RUnlock()
+	return len(fake.validateEntryArgsForCall)
+}
+
+func (fake *FakeLedgerService) ValidateEntryCalls(stub func(context.Context, domain.LedgerEntry) error) {
+	fake.validateEntryMutex.Lock()
+	defer fake.validateEntryMutex.Unlock()
+	fake.ValidateEntryStub = stub
+}
+
+func (fake *FakeLedgerService) ValidateEntryArgsForCall(i int) (context.Context, domain.LedgerEntry) {
+	fake.validateEntryMutex.RLock()
+	defer fake.validateEntryMutex.RUnlock()
+	argsForCall := fake.validateEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLedgerService) ValidateEntryReturns(result1 error) {
+	fake.validateEntryMutex.Lock()
+	defer fake.validateEntryMutex.Unlock()
+	fake.ValidateEntryStub = nil
+	fake.validateEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLedgerService) ValidateEntryReturnsOnCall(i int, result1 error) {
+	fake.validateEntryMutex.Lock()
+	defer fake.validateEntryMutex.Unlock()
+	fake.ValidateEntryStub = nil
+	if fake.validateEntryReturnsOnCall == nil {
+		fake.validateEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLedgerService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLedgerService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.LedgerService = new(FakeLedgerService)
@@ -0,0 +1,837 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeOrganizationRepository struct {
+	AddMemberStub        func(context.Context, domain.OrganizationMember) (*domain.OrganizationMember, error)
+	addMemberMutex       sync.RWMutex
+	addMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.OrganizationMember
+	}
+	addMemberReturns struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	addMemberReturnsOnCall map[int]struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	CountMembersByRoleStub        func(context.Context, uuid.UUID, domain.OrganizationRole) (int64, error)
+	countMembersByRoleMutex       sync.RWMutex
+	countMembersByRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.OrganizationRole
+	}
+	countMembersByRoleReturns struct {
+		result1 int64
+		result2 error
+	}
+	countMembersByRoleReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	CreateOrganizationStub        func(context.Context, domain.Organization, uuid.UUID) (*domain.Organization, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Organization
+		arg3 uuid.UUID
+	}
+	createOrganizationReturns struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	GetMemberStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.OrganizationMember, error)
+	getMemberMutex       sync.RWMutex
+	getMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getMemberReturns struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	getMemberReturnsOnCall map[int]struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	GetOrganizationByIDStub        func(context.Context, uuid.UUID) (*domain.Organization, error)
+	getOrganizationByIDMutex       sync.RWMutex
+	getOrganizationByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getOrganizationByIDReturns struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	getOrganizationByIDReturnsOnCall map[int]struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	ListMembersStub        func(context.Context, uuid.UUID) ([]domain.OrganizationMember, error)
+	listMembersMutex       sync.RWMutex
+	listMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listMembersReturns struct {
+		result1 []domain.OrganizationMember
+		result2 error
+	}
+	listMembersReturnsOnCall map[int]struct {
+		result1 []domain.OrganizationMember
+		result2 error
+	}
+	ListOrganizationsByUserStub        func(context.Context, uuid.UUID) ([]domain.OrganizationMembership, error)
+	listOrganizationsByUserMutex       sync.RWMutex
+	listOrganizationsByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listOrganizationsByUserReturns struct {
+		result1 []domain.OrganizationMembership
+		result2 error
+	}
+	listOrganizationsByUserReturnsOnCall map[int]struct {
+		result1 []domain.OrganizationMembership
+		result2 error
+	}
+	RemoveMemberStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	removeMemberMutex       sync.RWMutex
+	removeMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	removeMemberReturns struct {
+		result1 error
+	}
+	removeMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateMemberRoleStub        func(context.Context, uuid.UUID, uuid.UUID, domain.OrganizationRole) (*domain.OrganizationMember, error)
+	updateMemberRoleMutex       sync.RWMutex
+	updateMemberRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.OrganizationRole
+	}
+	updateMemberRoleReturns struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	updateMemberRoleReturnsOnCall map[int]struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}
+	UpdateOrganizationStub        func(context.Context, domain.Organization) (*domain.Organization, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 *domain.Organization
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrganizationRepository) AddMember(arg1 context.Context, arg2 domain.OrganizationMember) (*domain.OrganizationMember, error) {
+	fake.addMemberMutex.Lock()
+	ret, specificReturn := fake.addMemberReturnsOnCall[len(fake.addMemberArgsForCall)]
+	fake.addMemberArgsForCall = append(fake.addMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.OrganizationMember
+	}{arg1, arg2})
+	stub := fake.AddMemberStub
+	fakeReturns := fake.addMemberReturns
+	fake.recordInvocation("AddMember", []interface{}{arg1, arg2})
+	fake.addMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) AddMemberCallCount() int {
+	fake.addMemberMutex.RLock()
+	defer fake.addMemberMutex.RUnlock()
+	return len(fake.addMemberArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) AddMemberCalls(stub func(context.Context, domain.OrganizationMember) (*domain.OrganizationMember, error)) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = stub
+}
+
+func (fake *FakeOrganizationRepository) AddMemberArgsForCall(i int) (context.Context, domain.OrganizationMember) {
+	fake.addMemberMutex.RLock()
+	defer fake.addMemberMutex.RUnlock()
+	argsForCall := fake.addMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrganizationRepository) AddMemberReturns(result1 *domain.OrganizationMember, result2 error) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = nil
+	fake.addMemberReturns = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) AddMemberReturnsOnCall(i int, result1 *domain.OrganizationMember, result2 error) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = nil
+	if fake.addMemberReturnsOnCall == nil {
+		fake.addMemberReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.addMemberReturnsOnCall[i] = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRole(arg1 context.Context, arg2 uuid.UUID, arg3 domain.OrganizationRole) (int64, error) {
+	fake.countMembersByRoleMutex.Lock()
+	ret, specificReturn := fake.countMembersByRoleReturnsOnCall[len(fake.countMembersByRoleArgsForCall)]
+	fake.countMembersByRoleArgsForCall = append(fake.countMembersByRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.OrganizationRole
+	}{arg1, arg2, arg3})
+	stub := fake.CountMembersByRoleStub
+	fakeReturns := fake.countMembersByRoleReturns
+	fake.recordInvocation("CountMembersByRole", []interface{}{arg1, arg2, arg3})
+	fake.countMembersByRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRoleCallCount() int {
+	fake.countMembersByRoleMutex.RLock()
+	defer fake.countMembersByRoleMutex.RUnlock()
+	return len(fake.countMembersByRoleArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRoleCalls(stub func(context.Context, uuid.UUID, domain.OrganizationRole) (int64, error)) {
+	fake.countMembersByRoleMutex.Lock()
+	defer fake.countMembersByRoleMutex.Unlock()
+	fake.CountMembersByRoleStub = stub
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRoleArgsForCall(i int) (context.Context, uuid.UUID, domain.OrganizationRole) {
+	fake.countMembersByRoleMutex.RLock()
+	defer fake.countMembersByRoleMutex.RUnlock()
+	argsForCall := fake.countMembersByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRoleReturns(result1 int64, result2 error) {
+	fake.countMembersByRoleMutex.Lock()
+	defer fake.countMembersByRoleMutex.Unlock()
+	fake.CountMembersByRoleStub = nil
+	fake.countMembersByRoleReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) CountMembersByRoleReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countMembersByRoleMutex.Lock()
+	defer fake.countMembersByRoleMutex.Unlock()
+	fake.CountMembersByRoleStub = nil
+	if fake.countMembersByRoleReturnsOnCall == nil {
+		fake.countMembersByRoleReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countMembersByRoleReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganization(arg1 context.Context, arg2 domain.Organization, arg3 uuid.UUID) (*domain.Organization, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Organization
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.CreateOrganizationStub
+	fakeReturns := fake.createOrganizationReturns
+	fake.recordInvocation("CreateOrganization", []interface{}{arg1, arg2, arg3})
+	fake.createOrganizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganizationCalls(stub func(context.Context, domain.Organization, uuid.UUID) (*domain.Organization, error)) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = stub
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganizationArgsForCall(i int) (context.Context, domain.Organization, uuid.UUID) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	argsForCall := fake.createOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganizationReturns(result1 *domain.Organization, result2 error) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) CreateOrganizationReturnsOnCall(i int, result1 *domain.Organization, result2 error) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Organization
+			result2 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) GetMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.OrganizationMember, error) {
+	fake.getMemberMutex.Lock()
+	ret, specificReturn := fake.getMemberReturnsOnCall[len(fake.getMemberArgsForCall)]
+	fake.getMemberArgsForCall = append(fake.getMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetMemberStub
+	fakeReturns := fake.getMemberReturns
+	fake.recordInvocation("GetMember", []interface{}{arg1, arg2, arg3})
+	fake.getMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) GetMemberCallCount() int {
+	fake.getMemberMutex.RLock()
+	defer fake.getMemberMutex.RUnlock()
+	return len(fake.getMemberArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) GetMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.OrganizationMember, error)) {
+	fake.getMemberMutex.Lock()
+	defer fake.getMemberMutex.Unlock()
+	fake.GetMemberStub = stub
+}
+
+func (fake *FakeOrganizationRepository) GetMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getMemberMutex.RLock()
+	defer fake.getMemberMutex.RUnlock()
+	argsForCall := fake.getMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrganizationRepository) GetMemberReturns(result1 *domain.OrganizationMember, result2 error) {
+	fake.getMemberMutex.Lock()
+	defer fake.getMemberMutex.Unlock()
+	fake.GetMemberStub = nil
+	fake.getMemberReturns = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) GetMemberReturnsOnCall(i int, result1 *domain.OrganizationMember, result2 error) {
+	fake.getMemberMutex.Lock()
+	defer fake.getMemberMutex.Unlock()
+	fake.GetMemberStub = nil
+	if fake.getMemberReturnsOnCall == nil {
+		fake.getMemberReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.getMemberReturnsOnCall[i] = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByID(arg1 context.Context, arg2 uuid.UUID) (*domain.Organization, error) {
+	fake.getOrganizationByIDMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByIDReturnsOnCall[len(fake.getOrganizationByIDArgsForCall)]
+	fake.getOrganizationByIDArgsForCall = append(fake.getOrganizationByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetOrganizationByIDStub
+	fakeReturns := fake.getOrganizationByIDReturns
+	fake.recordInvocation("GetOrganizationByID", []interface{}{arg1, arg2})
+	fake.getOrganizationByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByIDCallCount() int {
+	fake.getOrganizationByIDMutex.RLock()
+	defer fake.getOrganizationByIDMutex.RUnlock()
+	return len(fake.getOrganizationByIDArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByIDCalls(stub func(context.Context, uuid.UUID) (*domain.Organization, error)) {
+	fake.getOrganizationByIDMutex.Lock()
+	defer fake.getOrganizationByIDMutex.Unlock()
+	fake.GetOrganizationByIDStub = stub
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getOrganizationByIDMutex.RLock()
+	defer fake.getOrganizationByIDMutex.RUnlock()
+	argsForCall := fake.getOrganizationByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByIDReturns(result1 *domain.Organization, result2 error) {
+	fake.getOrganizationByIDMutex.Lock()
+	defer fake.getOrganizationByIDMutex.Unlock()
+	fake.GetOrganizationByIDStub = nil
+	fake.getOrganizationByIDReturns = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) GetOrganizationByIDReturnsOnCall(i int, result1 *domain.Organization, result2 error) {
+	fake.getOrganizationByIDMutex.Lock()
+	defer fake.getOrganizationByIDMutex.Unlock()
+	fake.GetOrganizationByIDStub = nil
+	if fake.getOrganizationByIDReturnsOnCall == nil {
+		fake.getOrganizationByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.Organization
+			result2 error
+		})
+	}
+	fake.getOrganizationByIDReturnsOnCall[i] = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) ListMembers(arg1 context.Context, arg2 uuid.UUID) ([]domain.OrganizationMember, error) {
+	fake.listMembersMutex.Lock()
+	ret, specificReturn := fake.listMembersReturnsOnCall[len(fake.listMembersArgsForCall)]
+	fake.listMembersArgsForCall = append(fake.listMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListMembersStub
+	fakeReturns := fake.listMembersReturns
+	fake.recordInvocation("ListMembers", []interface{}{arg1, arg2})
+	fake.listMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) ListMembersCallCount() int {
+	fake.listMembersMutex.RLock()
+	defer fake.listMembersMutex.RUnlock()
+	return len(fake.listMembersArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) ListMembersCalls(stub func(context.Context, uuid.UUID) ([]domain.OrganizationMember, error)) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = stub
+}
+
+func (fake *FakeOrganizationRepository) ListMembersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listMembersMutex.RLock()
+	defer fake.listMembersMutex.RUnlock()
+	argsForCall := fake.listMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrganizationRepository) ListMembersReturns(result1 []domain.OrganizationMember, result2 error) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = nil
+	fake.listMembersReturns = struct {
+		result1 []domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) ListMembersReturnsOnCall(i int, result1 []domain.OrganizationMember, result2 error) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = nil
+	if fake.listMembersReturnsOnCall == nil {
+		fake.listMembersReturnsOnCall = make(map[int]struct {
+			result1 []domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.listMembersReturnsOnCall[i] = struct {
+		result1 []domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUser(arg1 context.Context, arg2 uuid.UUID) ([]domain.OrganizationMembership, error) {
+	fake.listOrganizationsByUserMutex.Lock()
+	ret, specificReturn := fake.listOrganizationsByUserReturnsOnCall[len(fake.listOrganizationsByUserArgsForCall)]
+	fake.listOrganizationsByUserArgsForCall = append(fake.listOrganizationsByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListOrganizationsByUserStub
+	fakeReturns := fake.listOrganizationsByUserReturns
+	fake.recordInvocation("ListOrganizationsByUser", []interface{}{arg1, arg2})
+	fake.listOrganizationsByUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUserCallCount() int {
+	fake.listOrganizationsByUserMutex.RLock()
+	defer fake.listOrganizationsByUserMutex.RUnlock()
+	return len(fake.listOrganizationsByUserArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUserCalls(stub func(context.Context, uuid.UUID) ([]domain.OrganizationMembership, error)) {
+	fake.listOrganizationsByUserMutex.Lock()
+	defer fake.listOrganizationsByUserMutex.Unlock()
+	fake.ListOrganizationsByUserStub = stub
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listOrganizationsByUserMutex.RLock()
+	defer fake.listOrganizationsByUserMutex.RUnlock()
+	argsForCall := fake.listOrganizationsByUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUserReturns(result1 []domain.OrganizationMembership, result2 error) {
+	fake.listOrganizationsByUserMutex.Lock()
+	defer fake.listOrganizationsByUserMutex.Unlock()
+	fake.ListOrganizationsByUserStub = nil
+	fake.listOrganizationsByUserReturns = struct {
+		result1 []domain.OrganizationMembership
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) ListOrganizationsByUserReturnsOnCall(i int, result1 []domain.OrganizationMembership, result2 error) {
+	fake.listOrganizationsByUserMutex.Lock()
+	defer fake.listOrganizationsByUserMutex.Unlock()
+	fake.ListOrganizationsByUserStub = nil
+	if fake.listOrganizationsByUserReturnsOnCall == nil {
+		fake.listOrganizationsByUserReturnsOnCall = make(map[int]struct {
+			result1 []domain.OrganizationMembership
+			result2 error
+		})
+	}
+	fake.listOrganizationsByUserReturnsOnCall[i] = struct {
+		result1 []domain.OrganizationMembership
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) RemoveMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.removeMemberMutex.Lock()
+	ret, specificReturn := fake.removeMemberReturnsOnCall[len(fake.removeMemberArgsForCall)]
+	fake.removeMemberArgsForCall = append(fake.removeMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveMemberStub
+	fakeReturns := fake.removeMemberReturns
+	fake.recordInvocation("RemoveMember", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOrganizationRepository) RemoveMemberCallCount() int {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return len(fake.removeMemberArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) RemoveMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = stub
+}
+
+func (fake *FakeOrganizationRepository) RemoveMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	argsForCall := fake.removeMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrganizationRepository) RemoveMemberReturns(result1 error) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = nil
+	fake.removeMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOrganizationRepository) RemoveMemberReturnsOnCall(i int, result1 error) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = nil
+	if fake.removeMemberReturnsOnCall == nil {
+		fake.removeMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRole(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	fake.updateMemberRoleMutex.Lock()
+	ret, specificReturn := fake.updateMemberRoleReturnsOnCall[len(fake.updateMemberRoleArgsForCall)]
+	fake.updateMemberRoleArgsForCall = append(fake.updateMemberRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.OrganizationRole
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateMemberRoleStub
+	fakeReturns := fake.updateMemberRoleReturns
+	fake.recordInvocation("UpdateMemberRole", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateMemberRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRoleCallCount() int {
+	fake.updateMemberRoleMutex.RLock()
+	defer fake.updateMemberRoleMutex.RUnlock()
+	return len(fake.updateMemberRoleArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRoleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.OrganizationRole) (*domain.OrganizationMember, error)) {
+	fake.updateMemberRoleMutex.Lock()
+	defer fake.updateMemberRoleMutex.Unlock()
+	fake.UpdateMemberRoleStub = stub
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRoleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.OrganizationRole) {
+	fake.updateMemberRoleMutex.RLock()
+	defer fake.updateMemberRoleMutex.RUnlock()
+	argsForCall := fake.updateMemberRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRoleReturns(result1 *domain.OrganizationMember, result2 error) {
+	fake.updateMemberRoleMutex.Lock()
+	defer fake.updateMemberRoleMutex.Unlock()
+	fake.UpdateMemberRoleStub = nil
+	fake.updateMemberRoleReturns = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) UpdateMemberRoleReturnsOnCall(i int, result1 *domain.OrganizationMember, result2 error) {
+	fake.updateMemberRoleMutex.Lock()
+	defer fake.updateMemberRoleMutex.Unlock()
+	fake.UpdateMemberRoleStub = nil
+	if fake.updateMemberRoleReturnsOnCall == nil {
+		fake.updateMemberRoleReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrganizationMember
+			result2 error
+		})
+	}
+	fake.updateMemberRoleReturnsOnCall[i] = struct {
+		result1 *domain.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganization(arg1 context.Context, arg2 domain.Organization) (*domain.Organization, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Organization
+	}{arg1, arg2})
+	stub := fake.UpdateOrganizationStub
+	fakeReturns := fake.updateOrganizationReturns
+	fake.recordInvocation("UpdateOrganization", []interface{}{arg1, arg2})
+	fake.updateOrganizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganizationCalls(stub func(context.Context, domain.Organization) (*domain.Organization, error)) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = stub
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganizationArgsForCall(i int) (context.Context, domain.Organization) {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	argsForCall := fake.updateOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganizationReturns(result1 *domain.Organization, result2 error) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) UpdateOrganizationReturnsOnCall(i int, result1 *domain.Organization, result2 error) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *domain.Organization
+			result2 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 *domain.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrganizationRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOrganizationRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.OrganizationRepository = new(FakeOrganizationRepository)
@@ -0,0 +1,593 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakePayoutAddressRepository struct {
+	ActivateDuePayoutAddressesStub        func(context.Context, time.Time) error
+	activateDuePayoutAddressesMutex       sync.RWMutex
+	activateDuePayoutAddressesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	activateDuePayoutAddressesReturns struct {
+		result1 error
+	}
+	activateDuePayoutAddressesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CancelPayoutAddressStub        func(context.Context, uuid.UUID) (*domain.PayoutAddress, error)
+	cancelPayoutAddressMutex       sync.RWMutex
+	cancelPayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	cancelPayoutAddressReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	cancelPayoutAddressReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	CreateWalletWithPayoutAddressStub        func(context.Context, domain.UserWallet, domain.PayoutAddress) (*domain.PayoutAddress, error)
+	createWalletWithPayoutAddressMutex       sync.RWMutex
+	createWalletWithPayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+		arg3 domain.PayoutAddress
+	}
+	createWalletWithPayoutAddressReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	createWalletWithPayoutAddressReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	GetLatestPayoutAddressStub        func(context.Context, uuid.UUID, string) (*domain.PayoutAddress, error)
+	getLatestPayoutAddressMutex       sync.RWMutex
+	getLatestPayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getLatestPayoutAddressReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	getLatestPayoutAddressReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	GetPayoutAddressByCancelTokenHashStub        func(context.Context, string) (*domain.PayoutAddress, error)
+	getPayoutAddressByCancelTokenHashMutex       sync.RWMutex
+	getPayoutAddressByCancelTokenHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getPayoutAddressByCancelTokenHashReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	getPayoutAddressByCancelTokenHashReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	GetPayoutAddressByIDStub        func(context.Context, uuid.UUID) (*domain.PayoutAddress, error)
+	getPayoutAddressByIDMutex       sync.RWMutex
+	getPayoutAddressByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPayoutAddressByIDReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	getPayoutAddressByIDReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	ListPayoutAddressesByUserIDStub        func(context.Context, uuid.UUID) ([]domain.PayoutAddress, error)
+	listPayoutAddressesByUserIDMutex       sync.RWMutex
+	listPayoutAddressesByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPayoutAddressesByUserIDReturns struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}
+	listPayoutAddressesByUserIDReturnsOnCall map[int]struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddresses(arg1 context.Context, arg2 time.Time) error {
+	fake.activateDuePayoutAddressesMutex.Lock()
+	ret, specificReturn := fake.activateDuePayoutAddressesReturnsOnCall[len(fake.activateDuePayoutAddressesArgsForCall)]
+	fake.activateDuePayoutAddressesArgsForCall = append(fake.activateDuePayoutAddressesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ActivateDuePayoutAddressesStub
+	fakeReturns := fake.activateDuePayoutAddressesReturns
+	fake.recordInvocation("ActivateDuePayoutAddresses", []interface{}{arg1, arg2})
+	fake.activateDuePayoutAddressesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddressesCallCount() int {
+	fake.activateDuePayoutAddressesMutex.RLock()
+	defer fake.activateDuePayoutAddressesMutex.RUnlock()
+	return len(fake.activateDuePayoutAddressesArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddressesCalls(stub func(context.Context, time.Time) error) {
+	fake.activateDuePayoutAddressesMutex.Lock()
+	defer fake.activateDuePayoutAddressesMutex.Unlock()
+	fake.ActivateDuePayoutAddressesStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddressesArgsForCall(i int) (context.Context, time.Time) {
+	fake.activateDuePayoutAddressesMutex.RLock()
+	defer fake.activateDuePayoutAddressesMutex.RUnlock()
+	argsForCall := fake.activateDuePayoutAddressesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddressesReturns(result1 error) {
+	fake.activateDuePayoutAddressesMutex.Lock()
+	defer fake.activateDuePayoutAddressesMutex.Unlock()
+	fake.ActivateDuePayoutAddressesStub = nil
+	fake.activateDuePayoutAddressesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePayoutAddressRepository) ActivateDuePayoutAddressesReturnsOnCall(i int, result1 error) {
+	fake.activateDuePayoutAddressesMutex.Lock()
+	defer fake.activateDuePayoutAddressesMutex.Unlock()
+	fake.ActivateDuePayoutAddressesStub = nil
+	if fake.activateDuePayoutAddressesReturnsOnCall == nil {
+		fake.activateDuePayoutAddressesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.activateDuePayoutAddressesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddress(arg1 context.Context, arg2 uuid.UUID) (*domain.PayoutAddress, error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	ret, specificReturn := fake.cancelPayoutAddressReturnsOnCall[len(fake.cancelPayoutAddressArgsForCall)]
+	fake.cancelPayoutAddressArgsForCall = append(fake.cancelPayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CancelPayoutAddressStub
+	fakeReturns := fake.cancelPayoutAddressReturns
+	fake.recordInvocation("CancelPayoutAddress", []interface{}{arg1, arg2})
+	fake.cancelPayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddressCallCount() int {
+	fake.cancelPayoutAddressMutex.RLock()
+	defer fake.cancelPayoutAddressMutex.RUnlock()
+	return len(fake.cancelPayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddressCalls(stub func(context.Context, uuid.UUID) (*domain.PayoutAddress, error)) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddressArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.cancelPayoutAddressMutex.RLock()
+	defer fake.cancelPayoutAddressMutex.RUnlock()
+	argsForCall := fake.cancelPayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddressReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = nil
+	fake.cancelPayoutAddressReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) CancelPayoutAddressReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = nil
+	if fake.cancelPayoutAddressReturnsOnCall == nil {
+		fake.cancelPayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.cancelPayoutAddressReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddress(arg1 context.Context, arg2 domain.UserWallet, arg3 domain.PayoutAddress) (*domain.PayoutAddress, error) {
+	fake.createWalletWithPayoutAddressMutex.Lock()
+	ret, specificReturn := fake.createWalletWithPayoutAddressReturnsOnCall[len(fake.createWalletWithPayoutAddressArgsForCall)]
+	fake.createWalletWithPayoutAddressArgsForCall = append(fake.createWalletWithPayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+		arg3 domain.PayoutAddress
+	}{arg1, arg2, arg3})
+	stub := fake.CreateWalletWithPayoutAddressStub
+	fakeReturns := fake.createWalletWithPayoutAddressReturns
+	fake.recordInvocation("CreateWalletWithPayoutAddress", []interface{}{arg1, arg2, arg3})
+	fake.createWalletWithPayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddressCallCount() int {
+	fake.createWalletWithPayoutAddressMutex.RLock()
+	defer fake.createWalletWithPayoutAddressMutex.RUnlock()
+	return len(fake.createWalletWithPayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddressCalls(stub func(context.Context, domain.UserWallet, domain.PayoutAddress) (*domain.PayoutAddress, error)) {
+	fake.createWalletWithPayoutAddressMutex.Lock()
+	defer fake.createWalletWithPayoutAddressMutex.Unlock()
+	fake.CreateWalletWithPayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddressArgsForCall(i int) (context.Context, domain.UserWallet, domain.PayoutAddress) {
+	fake.createWalletWithPayoutAddressMutex.RLock()
+	defer fake.createWalletWithPayoutAddressMutex.RUnlock()
+	argsForCall := fake.createWalletWithPayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddressReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.createWalletWithPayoutAddressMutex.Lock()
+	defer fake.createWalletWithPayoutAddressMutex.Unlock()
+	fake.CreateWalletWithPayoutAddressStub = nil
+	fake.createWalletWithPayoutAddressReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) CreateWalletWithPayoutAddressReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.createWalletWithPayoutAddressMutex.Lock()
+	defer fake.createWalletWithPayoutAddressMutex.Unlock()
+	fake.CreateWalletWithPayoutAddressStub = nil
+	if fake.createWalletWithPayoutAddressReturnsOnCall == nil {
+		fake.createWalletWithPayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.createWalletWithPayoutAddressReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddress(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.PayoutAddress, error) {
+	fake.getLatestPayoutAddressMutex.Lock()
+	ret, specificReturn := fake.getLatestPayoutAddressReturnsOnCall[len(fake.getLatestPayoutAddressArgsForCall)]
+	fake.getLatestPayoutAddressArgsForCall = append(fake.getLatestPayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetLatestPayoutAddressStub
+	fakeReturns := fake.getLatestPayoutAddressReturns
+	fake.recordInvocation("GetLatestPayoutAddress", []interface{}{arg1, arg2, arg3})
+	fake.getLatestPayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddressCallCount() int {
+	fake.getLatestPayoutAddressMutex.RLock()
+	defer fake.getLatestPayoutAddressMutex.RUnlock()
+	return len(fake.getLatestPayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddressCalls(stub func(context.Context, uuid.UUID, string) (*domain.PayoutAddress, error)) {
+	fake.getLatestPayoutAddressMutex.Lock()
+	defer fake.getLatestPayoutAddressMutex.Unlock()
+	fake.GetLatestPayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddressArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getLatestPayoutAddressMutex.RLock()
+	defer fake.getLatestPayoutAddressMutex.RUnlock()
+	argsForCall := fake.getLatestPayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddressReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.getLatestPayoutAddressMutex.Lock()
+	defer fake.getLatestPayoutAddressMutex.Unlock()
+	fake.GetLatestPayoutAddressStub = nil
+	fake.getLatestPayoutAddressReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetLatestPayoutAddressReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.getLatestPayoutAddressMutex.Lock()
+	defer fake.getLatestPayoutAddressMutex.Unlock()
+	fake.GetLatestPayoutAddressStub = nil
+	if fake.getLatestPayoutAddressReturnsOnCall == nil {
+		fake.getLatestPayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.getLatestPayoutAddressReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHash(arg1 context.Context, arg2 string) (*domain.PayoutAddress, error) {
+	fake.getPayoutAddressByCancelTokenHashMutex.Lock()
+	ret, specificReturn := fake.getPayoutAddressByCancelTokenHashReturnsOnCall[len(fake.getPayoutAddressByCancelTokenHashArgsForCall)]
+	fake.getPayoutAddressByCancelTokenHashArgsForCall = append(fake.getPayoutAddressByCancelTokenHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPayoutAddressByCancelTokenHashStub
+	fakeReturns := fake.getPayoutAddressByCancelTokenHashReturns
+	fake.recordInvocation("GetPayoutAddressByCancelTokenHash", []interface{}{arg1, arg2})
+	fake.getPayoutAddressByCancelTokenHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHashCallCount() int {
+	fake.getPayoutAddressByCancelTokenHashMutex.RLock()
+	defer fake.getPayoutAddressByCancelTokenHashMutex.RUnlock()
+	return len(fake.getPayoutAddressByCancelTokenHashArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHashCalls(stub func(context.Context, string) (*domain.PayoutAddress, error)) {
+	fake.getPayoutAddressByCancelTokenHashMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenHashMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenHashStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHashArgsForCall(i int) (context.Context, string) {
+	fake.getPayoutAddressByCancelTokenHashMutex.RLock()
+	defer fake.getPayoutAddressByCancelTokenHashMutex.RUnlock()
+	argsForCall := fake.getPayoutAddressByCancelTokenHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHashReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByCancelTokenHashMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenHashMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenHashStub = nil
+	fake.getPayoutAddressByCancelTokenHashReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByCancelTokenHashReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByCancelTokenHashMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenHashMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenHashStub = nil
+	if fake.getPayoutAddressByCancelTokenHashReturnsOnCall == nil {
+		fake.getPayoutAddressByCancelTokenHashReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.getPayoutAddressByCancelTokenHashReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByID(arg1 context.Context, arg2 uuid.UUID) (*domain.PayoutAddress, error) {
+	fake.getPayoutAddressByIDMutex.Lock()
+	ret, specificReturn := fake.getPayoutAddressByIDReturnsOnCall[len(fake.getPayoutAddressByIDArgsForCall)]
+	fake.getPayoutAddressByIDArgsForCall = append(fake.getPayoutAddressByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPayoutAddressByIDStub
+	fakeReturns := fake.getPayoutAddressByIDReturns
+	fake.recordInvocation("GetPayoutAddressByID", []interface{}{arg1, arg2})
+	fake.getPayoutAddressByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByIDCallCount() int {
+	fake.getPayoutAddressByIDMutex.RLock()
+	defer fake.getPayoutAddressByIDMutex.RUnlock()
+	return len(fake.getPayoutAddressByIDArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByIDCalls(stub func(context.Context, uuid.UUID) (*domain.PayoutAddress, error)) {
+	fake.getPayoutAddressByIDMutex.Lock()
+	defer fake.getPayoutAddressByIDMutex.Unlock()
+	fake.GetPayoutAddressByIDStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPayoutAddressByIDMutex.RLock()
+	defer fake.getPayoutAddressByIDMutex.RUnlock()
+	argsForCall := fake.getPayoutAddressByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByIDReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByIDMutex.Lock()
+	defer fake.getPayoutAddressByIDMutex.Unlock()
+	fake.GetPayoutAddressByIDStub = nil
+	fake.getPayoutAddressByIDReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) GetPayoutAddressByIDReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByIDMutex.Lock()
+	defer fake.getPayoutAddressByIDMutex.Unlock()
+	fake.GetPayoutAddressByIDStub = nil
+	if fake.getPayoutAddressByIDReturnsOnCall == nil {
+		fake.getPayoutAddressByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.getPayoutAddressByIDReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserID(arg1 context.Context, arg2 uuid.UUID) ([]domain.PayoutAddress, error) {
+	fake.listPayoutAddressesByUserIDMutex.Lock()
+	ret, specificReturn := fake.listPayoutAddressesByUserIDReturnsOnCall[len(fake.listPayoutAddressesByUserIDArgsForCall)]
+	fake.listPayoutAddressesByUserIDArgsForCall = append(fake.listPayoutAddressesByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPayoutAddressesByUserIDStub
+	fakeReturns := fake.listPayoutAddressesByUserIDReturns
+	fake.recordInvocation("ListPayoutAddressesByUserID", []interface{}{arg1, arg2})
+	fake.listPayoutAddressesByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserIDCallCount() int {
+	fake.listPayoutAddressesByUserIDMutex.RLock()
+	defer fake.listPayoutAddressesByUserIDMutex.RUnlock()
+	return len(fake.listPayoutAddressesByUserIDArgsForCall)
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserIDCalls(stub func(context.Context, uuid.UUID) ([]domain.PayoutAddress, error)) {
+	fake.listPayoutAddressesByUserIDMutex.Lock()
+	defer fake.listPayoutAddressesByUserIDMutex.Unlock()
+	fake.ListPayoutAddressesByUserIDStub = stub
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPayoutAddressesByUserIDMutex.RLock()
+	defer fake.listPayoutAddressesByUserIDMutex.RUnlock()
+	argsForCall := fake.listPayoutAddressesByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserIDReturns(result1 []domain.PayoutAddress, result2 error) {
+	fake.listPayoutAddressesByUserIDMutex.Lock()
+	defer fake.listPayoutAddressesByUserIDMutex.Unlock()
+	fake.ListPayoutAddressesByUserIDStub = nil
+	fake.listPayoutAddressesByUserIDReturns = struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) ListPayoutAddressesByUserIDReturnsOnCall(i int, result1 []domain.PayoutAddress, result2 error) {
+	fake.listPayoutAddressesByUserIDMutex.Lock()
+	defer fake.listPayoutAddressesByUserIDMutex.Unlock()
+	fake.ListPayoutAddressesByUserIDStub = nil
+	if fake.listPayoutAddressesByUserIDReturnsOnCall == nil {
+		fake.listPayoutAddressesByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.listPayoutAddressesByUserIDReturnsOnCall[i] = struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePayoutAddressRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.PayoutAddressRepository = new(FakePayoutAddressRepository)
@@ -0,0 +1,515 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakePayoutAddressService struct {
+	CancelPayoutAddressStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.PayoutAddress, error)
+	cancelPayoutAddressMutex       sync.RWMutex
+	cancelPayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	cancelPayoutAddressReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	cancelPayoutAddressReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	CancelPayoutAddressByTokenStub        func(context.Context, string) (*domain.PayoutAddress, error)
+	cancelPayoutAddressByTokenMutex       sync.RWMutex
+	cancelPayoutAddressByTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	cancelPayoutAddressByTokenReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	cancelPayoutAddressByTokenReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	GetPayoutAddressByCancelTokenStub        func(context.Context, string) (*domain.PayoutAddress, error)
+	getPayoutAddressByCancelTokenMutex       sync.RWMutex
+	getPayoutAddressByCancelTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getPayoutAddressByCancelTokenReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	getPayoutAddressByCancelTokenReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	ListPayoutAddressesStub        func(context.Context, uuid.UUID) ([]domain.PayoutAddress, error)
+	listPayoutAddressesMutex       sync.RWMutex
+	listPayoutAddressesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPayoutAddressesReturns struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}
+	listPayoutAddressesReturnsOnCall map[int]struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}
+	RequestPayoutAddressStub        func(context.Context, domain.User, domain.UserWallet) (*domain.PayoutAddress, error)
+	requestPayoutAddressMutex       sync.RWMutex
+	requestPayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.User
+		arg3 domain.UserWallet
+	}
+	requestPayoutAddressReturns struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	requestPayoutAddressReturnsOnCall map[int]struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}
+	ValidatePayoutAddressStub        func(context.Context, uuid.UUID, string) error
+	validatePayoutAddressMutex       sync.RWMutex
+	validatePayoutAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	validatePayoutAddressReturns struct {
+		result1 error
+	}
+	validatePayoutAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddress(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.PayoutAddress, error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	ret, specificReturn := fake.cancelPayoutAddressReturnsOnCall[len(fake.cancelPayoutAddressArgsForCall)]
+	fake.cancelPayoutAddressArgsForCall = append(fake.cancelPayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.CancelPayoutAddressStub
+	fakeReturns := fake.cancelPayoutAddressReturns
+	fake.recordInvocation("CancelPayoutAddress", []interface{}{arg1, arg2, arg3})
+	fake.cancelPayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressCallCount() int {
+	fake.cancelPayoutAddressMutex.RLock()
+	defer fake.cancelPayoutAddressMutex.RUnlock()
+	return len(fake.cancelPayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.PayoutAddress, error)) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.cancelPayoutAddressMutex.RLock()
+	defer fake.cancelPayoutAddressMutex.RUnlock()
+	argsForCall := fake.cancelPayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = nil
+	fake.cancelPayoutAddressReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressMutex.Lock()
+	defer fake.cancelPayoutAddressMutex.Unlock()
+	fake.CancelPayoutAddressStub = nil
+	if fake.cancelPayoutAddressReturnsOnCall == nil {
+		fake.cancelPayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.cancelPayoutAddressReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByToken(arg1 context.Context, arg2 string) (*domain.PayoutAddress, error) {
+	fake.cancelPayoutAddressByTokenMutex.Lock()
+	ret, specificReturn := fake.cancelPayoutAddressByTokenReturnsOnCall[len(fake.cancelPayoutAddressByTokenArgsForCall)]
+	fake.cancelPayoutAddressByTokenArgsForCall = append(fake.cancelPayoutAddressByTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CancelPayoutAddressByTokenStub
+	fakeReturns := fake.cancelPayoutAddressByTokenReturns
+	fake.recordInvocation("CancelPayoutAddressByToken", []interface{}{arg1, arg2})
+	fake.cancelPayoutAddressByTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByTokenCallCount() int {
+	fake.cancelPayoutAddressByTokenMutex.RLock()
+	defer fake.cancelPayoutAddressByTokenMutex.RUnlock()
+	return len(fake.cancelPayoutAddressByTokenArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByTokenCalls(stub func(context.Context, string) (*domain.PayoutAddress, error)) {
+	fake.cancelPayoutAddressByTokenMutex.Lock()
+	defer fake.cancelPayoutAddressByTokenMutex.Unlock()
+	fake.CancelPayoutAddressByTokenStub = stub
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByTokenArgsForCall(i int) (context.Context, string) {
+	fake.cancelPayoutAddressByTokenMutex.RLock()
+	defer fake.cancelPayoutAddressByTokenMutex.RUnlock()
+	argsForCall := fake.cancelPayoutAddressByTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByTokenReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressByTokenMutex.Lock()
+	defer fake.cancelPayoutAddressByTokenMutex.Unlock()
+	fake.CancelPayoutAddressByTokenStub = nil
+	fake.cancelPayoutAddressByTokenReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) CancelPayoutAddressByTokenReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.cancelPayoutAddressByTokenMutex.Lock()
+	defer fake.cancelPayoutAddressByTokenMutex.Unlock()
+	fake.CancelPayoutAddressByTokenStub = nil
+	if fake.cancelPayoutAddressByTokenReturnsOnCall == nil {
+		fake.cancelPayoutAddressByTokenReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.cancelPayoutAddressByTokenReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelToken(arg1 context.Context, arg2 string) (*domain.PayoutAddress, error) {
+	fake.getPayoutAddressByCancelTokenMutex.Lock()
+	ret, specificReturn := fake.getPayoutAddressByCancelTokenReturnsOnCall[len(fake.getPayoutAddressByCancelTokenArgsForCall)]
+	fake.getPayoutAddressByCancelTokenArgsForCall = append(fake.getPayoutAddressByCancelTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPayoutAddressByCancelTokenStub
+	fakeReturns := fake.getPayoutAddressByCancelTokenReturns
+	fake.recordInvocation("GetPayoutAddressByCancelToken", []interface{}{arg1, arg2})
+	fake.getPayoutAddressByCancelTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelTokenCallCount() int {
+	fake.getPayoutAddressByCancelTokenMutex.RLock()
+	defer fake.getPayoutAddressByCancelTokenMutex.RUnlock()
+	return len(fake.getPayoutAddressByCancelTokenArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelTokenCalls(stub func(context.Context, string) (*domain.PayoutAddress, error)) {
+	fake.getPayoutAddressByCancelTokenMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenStub = stub
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelTokenArgsForCall(i int) (context.Context, string) {
+	fake.getPayoutAddressByCancelTokenMutex.RLock()
+	defer fake.getPayoutAddressByCancelTokenMutex.RUnlock()
+	argsForCall := fake.getPayoutAddressByCancelTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelTokenReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByCancelTokenMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenStub = nil
+	fake.getPayoutAddressByCancelTokenReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) GetPayoutAddressByCancelTokenReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.getPayoutAddressByCancelTokenMutex.Lock()
+	defer fake.getPayoutAddressByCancelTokenMutex.Unlock()
+	fake.GetPayoutAddressByCancelTokenStub = nil
+	if fake.getPayoutAddressByCancelTokenReturnsOnCall == nil {
+		fake.getPayoutAddressByCancelTokenReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.getPayoutAddressByCancelTokenReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddresses(arg1 context.Context, arg2 uuid.UUID) ([]domain.PayoutAddress, error) {
+	fake.listPayoutAddressesMutex.Lock()
+	ret, specificReturn := fake.listPayoutAddressesReturnsOnCall[len(fake.listPayoutAddressesArgsForCall)]
+	fake.listPayoutAddressesArgsForCall = append(fake.listPayoutAddressesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPayoutAddressesStub
+	fakeReturns := fake.listPayoutAddressesReturns
+	fake.recordInvocation("ListPayoutAddresses", []interface{}{arg1, arg2})
+	fake.listPayoutAddressesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddressesCallCount() int {
+	fake.listPayoutAddressesMutex.RLock()
+	defer fake.listPayoutAddressesMutex.RUnlock()
+	return len(fake.listPayoutAddressesArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddressesCalls(stub func(context.Context, uuid.UUID) ([]domain.PayoutAddress, error)) {
+	fake.listPayoutAddressesMutex.Lock()
+	defer fake.listPayoutAddressesMutex.Unlock()
+	fake.ListPayoutAddressesStub = stub
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddressesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPayoutAddressesMutex.RLock()
+	defer fake.listPayoutAddressesMutex.RUnlock()
+	argsForCall := fake.listPayoutAddressesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddressesReturns(result1 []domain.PayoutAddress, result2 error) {
+	fake.listPayoutAddressesMutex.Lock()
+	defer fake.listPayoutAddressesMutex.Unlock()
+	fake.ListPayoutAddressesStub = nil
+	fake.listPayoutAddressesReturns = struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) ListPayoutAddressesReturnsOnCall(i int, result1 []domain.PayoutAddress, result2 error) {
+	fake.listPayoutAddressesMutex.Lock()
+	defer fake.listPayoutAddressesMutex.Unlock()
+	fake.ListPayoutAddressesStub = nil
+	if fake.listPayoutAddressesReturnsOnCall == nil {
+		fake.listPayoutAddressesReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.listPayoutAddressesReturnsOnCall[i] = struct {
+		result1 []domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddress(arg1 context.Context, arg2 domain.User, arg3 domain.UserWallet) (*domain.PayoutAddress, error) {
+	fake.requestPayoutAddressMutex.Lock()
+	ret, specificReturn := fake.requestPayoutAddressReturnsOnCall[len(fake.requestPayoutAddressArgsForCall)]
+	fake.requestPayoutAddressArgsForCall = append(fake.requestPayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.User
+		arg3 domain.UserWallet
+	}{arg1, arg2, arg3})
+	stub := fake.RequestPayoutAddressStub
+	fakeReturns := fake.requestPayoutAddressReturns
+	fake.recordInvocation("RequestPayoutAddress", []interface{}{arg1, arg2, arg3})
+	fake.requestPayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddressCallCount() int {
+	fake.requestPayoutAddressMutex.RLock()
+	defer fake.requestPayoutAddressMutex.RUnlock()
+	return len(fake.requestPayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddressCalls(stub func(context.Context, domain.User, domain.UserWallet) (*domain.PayoutAddress, error)) {
+	fake.requestPayoutAddressMutex.Lock()
+	defer fake.requestPayoutAddressMutex.Unlock()
+	fake.RequestPayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddressArgsForCall(i int) (context.Context, domain.User, domain.UserWallet) {
+	fake.requestPayoutAddressMutex.RLock()
+	defer fake.requestPayoutAddressMutex.RUnlock()
+	argsForCall := fake.requestPayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddressReturns(result1 *domain.PayoutAddress, result2 error) {
+	fake.requestPayoutAddressMutex.Lock()
+	defer fake.requestPayoutAddressMutex.Unlock()
+	fake.RequestPayoutAddressStub = nil
+	fake.requestPayoutAddressReturns = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) RequestPayoutAddressReturnsOnCall(i int, result1 *domain.PayoutAddress, result2 error) {
+	fake.requestPayoutAddressMutex.Lock()
+	defer fake.requestPayoutAddressMutex.Unlock()
+	fake.RequestPayoutAddressStub = nil
+	if fake.requestPayoutAddressReturnsOnCall == nil {
+		fake.requestPayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayoutAddress
+			result2 error
+		})
+	}
+	fake.requestPayoutAddressReturnsOnCall[i] = struct {
+		result1 *domain.PayoutAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddress(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.validatePayoutAddressMutex.Lock()
+	ret, specificReturn := fake.validatePayoutAddressReturnsOnCall[len(fake.validatePayoutAddressArgsForCall)]
+	fake.validatePayoutAddressArgsForCall = append(fake.validatePayoutAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ValidatePayoutAddressStub
+	fakeReturns := fake.validatePayoutAddressReturns
+	fake.recordInvocation("ValidatePayoutAddress", []interface{}{arg1, arg2, arg3})
+	fake.validatePayoutAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddressCallCount() int {
+	fake.validatePayoutAddressMutex.RLock()
+	defer fake.validatePayoutAddressMutex.RUnlock()
+	return len(fake.validatePayoutAddressArgsForCall)
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddressCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.validatePayoutAddressMutex.Lock()
+	defer fake.validatePayoutAddressMutex.Unlock()
+	fake.ValidatePayoutAddressStub = stub
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddressArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.validatePayoutAddressMutex.RLock()
+	defer fake.validatePayoutAddressMutex.RUnlock()
+	argsForCall := fake.validatePayoutAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddressReturns(result1 error) {
+	fake.validatePayoutAddressMutex.Lock()
+	defer fake.validatePayoutAddressMutex.Unlock()
+	fake.ValidatePayoutAddressStub = nil
+	fake.validatePayoutAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePayoutAddressService) ValidatePayoutAddressReturnsOnCall(i int, result1 error) {
+	fake.validatePayoutAddressMutex.Lock()
+	defer fake.validatePayoutAddressMutex.Unlock()
+	fake.ValidatePayoutAddressStub = nil
+	if fake.validatePayoutAddressReturnsOnCall == nil {
+		fake.validatePayoutAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validatePayoutAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePayoutAddressService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePayoutAddressService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.PayoutAddressService = new(FakePayoutAddressService)
@@ -0,0 +1,275 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakePayrollContractClient struct {
+	DisburseStub        func(context.Context, domain.DisbursementBatch) (*domain.DisbursementResult, error)
+	disburseMutex       sync.RWMutex
+	disburseArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.DisbursementBatch
+	}
+	disburseReturns struct {
+		result1 *domain.DisbursementResult
+		result2 error
+	}
+	disburseReturnsOnCall map[int]struct {
+		result1 *domain.DisbursementResult
+		result2 error
+	}
+	EstimateDisbursementGasStub        func(context.Context, domain.DisbursementBatch) (uint64, error)
+	estimateDisbursementGasMutex       sync.RWMutex
+	estimateDisbursementGasArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.DisbursementBatch
+	}
+	estimateDisbursementGasReturns struct {
+		result1 uint64
+		result2 error
+	}
+	estimateDisbursementGasReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	SuggestGasFeeCapStub        func(context.Context) (*big.Int, error)
+	suggestGasFeeCapMutex       sync.RWMutex
+	suggestGasFeeCapArgsForCall []struct {
+		arg1 context.Context
+	}
+	suggestGasFeeCapReturns struct {
+		result1 *big.Int
+		result2 error
+	}
+	suggestGasFeeCapReturnsOnCall map[int]struct {
+		result1 *big.Int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePayrollContractClient) Disburse(arg1 context.Context, arg2 domain.DisbursementBatch) (*domain.DisbursementResult, error) {
+	fake.disburseMutex.Lock()
+	ret, specificReturn := fake.disburseReturnsOnCall[len(fake.disburseArgsForCall)]
+	fake.disburseArgsForCall = append(fake.disburseArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.DisbursementBatch
+	}{arg1, arg2})
+	stub := fake.DisburseStub
+	fakeReturns := fake.disburseReturns
+	fake.recordInvocation("Disburse", []interface{}{arg1, arg2})
+	fake.disburseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollContractClient) DisburseCallCount() int {
+	fake.disburseMutex.RLock()
+	defer fake.disburseMutex.RUnlock()
+	return len(fake.disburseArgsForCall)
+}
+
+func (fake *FakePayrollContractClient) DisburseCalls(stub func(context.Context, domain.DisbursementBatch) (*domain.DisbursementResult, error)) {
+	fake.disburseMutex.Lock()
+	defer fake.disburseMutex.Unlock()
+	fake.DisburseStub = stub
+}
+
+func (fake *FakePayrollContractClient) DisburseArgsForCall(i int) (context.Context, domain.DisbursementBatch) {
+	fake.disburseMutex.RLock()
+	defer fake.disburseMutex.RUnlock()
+	argsForCall := fake.disburseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollContractClient) DisburseReturns(result1 *domain.DisbursementResult, result2 error) {
+	fake.disburseMutex.Lock()
+	defer fake.disburseMutex.Unlock()
+	fake.DisburseStub = nil
+	fake.disburseReturns = struct {
+		result1 *domain.DisbursementResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) DisburseReturnsOnCall(i int, result1 *domain.DisbursementResult, result2 error) {
+	fake.disburseMutex.Lock()
+	defer fake.disburseMutex.Unlock()
+	fake.DisburseStub = nil
+	if fake.disburseReturnsOnCall == nil {
+		fake.disburseReturnsOnCall = make(map[int]struct {
+			result1 *domain.DisbursementResult
+			result2 error
+		})
+	}
+	fake.disburseReturnsOnCall[i] = struct {
+		result1 *domain.DisbursementResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGas(arg1 context.Context, arg2 domain.DisbursementBatch) (uint64, error) {
+	fake.estimateDisbursementGasMutex.Lock()
+	ret, specificReturn := fake.estimateDisbursementGasReturnsOnCall[len(fake.estimateDisbursementGasArgsForCall)]
+	fake.estimateDisbursementGasArgsForCall = append(fake.estimateDisbursementGasArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.DisbursementBatch
+	}{arg1, arg2})
+	stub := fake.EstimateDisbursementGasStub
+	fakeReturns := fake.estimateDisbursementGasReturns
+	fake.recordInvocation("EstimateDisbursementGas", []interface{}{arg1, arg2})
+	fake.estimateDisbursementGasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGasCallCount() int {
+	fake.estimateDisbursementGasMutex.RLock()
+	defer fake.estimateDisbursementGasMutex.RUnlock()
+	return len(fake.estimateDisbursementGasArgsForCall)
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGasCalls(stub func(context.Context, domain.DisbursementBatch) (uint64, error)) {
+	fake.estimateDisbursementGasMutex.Lock()
+	defer fake.estimateDisbursementGasMutex.Unlock()
+	fake.EstimateDisbursementGasStub = stub
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGasArgsForCall(i int) (context.Context, domain.DisbursementBatch) {
+	fake.estimateDisbursementGasMutex.RLock()
+	defer fake.estimateDisbursementGasMutex.RUnlock()
+	argsForCall := fake.estimateDisbursementGasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGasReturns(result1 uint64, result2 error) {
+	fake.estimateDisbursementGasMutex.Lock()
+	defer fake.estimateDisbursementGasMutex.Unlock()
+	fake.EstimateDisbursementGasStub = nil
+	fake.estimateDisbursementGasReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) EstimateDisbursementGasReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.estimateDisbursementGasMutex.Lock()
+	defer fake.estimateDisbursementGasMutex.Unlock()
+	fake.EstimateDisbursementGasStub = nil
+	if fake.estimateDisbursementGasReturnsOnCall == nil {
+		fake.estimateDisbursementGasReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.estimateDisbursementGasReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCap(arg1 context.Context) (*big.Int, error) {
+	fake.suggestGasFeeCapMutex.Lock()
+	ret, specificReturn := fake.suggestGasFeeCapReturnsOnCall[len(fake.suggestGasFeeCapArgsForCall)]
+	fake.suggestGasFeeCapArgsForCall = append(fake.suggestGasFeeCapArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.SuggestGasFeeCapStub
+	fakeReturns := fake.suggestGasFeeCapReturns
+	fake.recordInvocation("SuggestGasFeeCap", []interface{}{arg1})
+	fake.suggestGasFeeCapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCapCallCount() int {
+	fake.suggestGasFeeCapMutex.RLock()
+	defer fake.suggestGasFeeCapMutex.RUnlock()
+	return len(fake.suggestGasFeeCapArgsForCall)
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCapCalls(stub func(context.Context) (*big.Int, error)) {
+	fake.suggestGasFeeCapMutex.Lock()
+	defer fake.suggestGasFeeCapMutex.Unlock()
+	fake.SuggestGasFeeCapStub = stub
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCapArgsForCall(i int) context.Context {
+	fake.suggestGasFeeCapMutex.RLock()
+	defer fake.suggestGasFeeCapMutex.RUnlock()
+	argsForCall := fake.suggestGasFeeCapArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCapReturns(result1 *big.Int, result2 error) {
+	fake.suggestGasFeeCapMutex.Lock()
+	defer fake.suggestGasFeeCapMutex.Unlock()
+	fake.SuggestGasFeeCapStub = nil
+	fake.suggestGasFeeCapReturns = struct {
+		result1 *big.Int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) SuggestGasFeeCapReturnsOnCall(i int, result1 *big.Int, result2 error) {
+	fake.suggestGasFeeCapMutex.Lock()
+	defer fake.suggestGasFeeCapMutex.Unlock()
+	fake.SuggestGasFeeCapStub = nil
+	if fake.suggestGasFeeCapReturnsOnCall == nil {
+		fake.suggestGasFeeCapReturnsOnCall = make(map[int]struct {
+			result1 *big.Int
+			result2 error
+		})
+	}
+	fake.suggestGasFeeCapReturnsOnCall[i] = struct {
+		result1 *big.Int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollContractClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePayrollContractClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.PayrollContractClient = new(FakePayrollContractClient)
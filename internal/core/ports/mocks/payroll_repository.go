@@ -0,0 +1,1166 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakePayrollRepository struct {
+	ApprovePayRunStub        func(context.Context, domain.PayRun) (bool, error)
+	approvePayRunMutex       sync.RWMutex
+	approvePayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.PayRun
+	}
+	approvePayRunReturns struct {
+		result1 bool
+		result2 error
+	}
+	approvePayRunReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateCompensationStub        func(context.Context, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	createCompensationMutex       sync.RWMutex
+	createCompensationArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.EmployeeCompensation
+	}
+	createCompensationReturns struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	createCompensationReturnsOnCall map[int]struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	CreateScheduleStub        func(context.Context, domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	createScheduleMutex       sync.RWMutex
+	createScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.PayrollSchedule
+	}
+	createScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	createScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	GeneratePayRunStub        func(context.Context, uuid.UUID, time.Time, time.Time) (*domain.PayRun, error)
+	generatePayRunMutex       sync.RWMutex
+	generatePayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 time.Time
+	}
+	generatePayRunReturns struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	generatePayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	GetCompensationStub        func(context.Context, uuid.UUID) (*domain.EmployeeCompensation, error)
+	getCompensationMutex       sync.RWMutex
+	getCompensationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getCompensationReturns struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	getCompensationReturnsOnCall map[int]struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	GetPayRunStub        func(context.Context, uuid.UUID) (*domain.PayRun, error)
+	getPayRunMutex       sync.RWMutex
+	getPayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPayRunReturns struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	getPayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	GetPreviousPayRunStub        func(context.Context, uuid.UUID, time.Time) (*domain.PayRun, error)
+	getPreviousPayRunMutex       sync.RWMutex
+	getPreviousPayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	getPreviousPayRunReturns struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	getPreviousPayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	GetScheduleStub        func(context.Context, uuid.UUID) (*domain.PayrollSchedule, error)
+	getScheduleMutex       sync.RWMutex
+	getScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	getScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	ListCompensationsStub        func(context.Context, uuid.UUID, *uuid.UUID) ([]domain.EmployeeCompensation, error)
+	listCompensationsMutex       sync.RWMutex
+	listCompensationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *uuid.UUID
+	}
+	listCompensationsReturns struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}
+	listCompensationsReturnsOnCall map[int]struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}
+	ListDueSchedulesStub        func(context.Context, time.Time, int) ([]domain.PayrollSchedule, error)
+	listDueSchedulesMutex       sync.RWMutex
+	listDueSchedulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	listDueSchedulesReturns struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	listDueSchedulesReturnsOnCall map[int]struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	ListPayRunsStub        func(context.Context, uuid.UUID, int, int) ([]domain.PayRun, int64, error)
+	listPayRunsMutex       sync.RWMutex
+	listPayRunsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+	}
+	listPayRunsReturns struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}
+	listPayRunsReturnsOnCall map[int]struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}
+	ListSchedulesStub        func(context.Context, uuid.UUID) ([]domain.PayrollSchedule, error)
+	listSchedulesMutex       sync.RWMutex
+	listSchedulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listSchedulesReturns struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	listSchedulesReturnsOnCall map[int]struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	UpdateCompensationStub        func(context.Context, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	updateCompensationMutex       sync.RWMutex
+	updateCompensationArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.EmployeeCompensation
+	}
+	updateCompensationReturns struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	updateCompensationReturnsOnCall map[int]struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	UpdateScheduleStub        func(context.Context, domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	updateScheduleMutex       sync.RWMutex
+	updateScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.PayrollSchedule
+	}
+	updateScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	updateScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePayrollRepository) ApprovePayRun(arg1 context.Context, arg2 domain.PayRun) (bool, error) {
+	fake.approvePayRunMutex.Lock()
+	ret, specificReturn := fake.approvePayRunReturnsOnCall[len(fake.approvePayRunArgsForCall)]
+	fake.approvePayRunArgsForCall = append(fake.approvePayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.PayRun
+	}{arg1, arg2})
+	stub := fake.ApprovePayRunStub
+	fakeReturns := fake.approvePayRunReturns
+	fake.recordInvocation("ApprovePayRun", []interface{}{arg1, arg2})
+	fake.approvePayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) ApprovePayRunCallCount() int {
+	fake.approvePayRunMutex.RLock()
+	defer fake.approvePayRunMutex.RUnlock()
+	return len(fake.approvePayRunArgsForCall)
+}
+
+func (fake *FakePayrollRepository) ApprovePayRunCalls(stub func(context.Context, domain.PayRun) (bool, error)) {
+	fake.approvePayRunMutex.Lock()
+	defer fake.approvePayRunMutex.Unlock()
+	fake.ApprovePayRunStub = stub
+}
+
+func (fake *FakePayrollRepository) ApprovePayRunArgsForCall(i int) (context.Context, domain.PayRun) {
+	fake.approvePayRunMutex.RLock()
+	defer fake.approvePayRunMutex.RUnlock()
+	argsForCall := fake.approvePayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) ApprovePayRunReturns(result1 bool, result2 error) {
+	fake.approvePayRunMutex.Lock()
+	defer fake.approvePayRunMutex.Unlock()
+	fake.ApprovePayRunStub = nil
+	fake.approvePayRunReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ApprovePayRunReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.approvePayRunMutex.Lock()
+	defer fake.approvePayRunMutex.Unlock()
+	fake.ApprovePayRunStub = nil
+	if fake.approvePayRunReturnsOnCall == nil {
+		fake.approvePayRunReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.approvePayRunReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) CreateCompensation(arg1 context.Context, arg2 domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	fake.createCompensationMutex.Lock()
+	ret, specificReturn := fake.createCompensationReturnsOnCall[len(fake.createCompensationArgsForCall)]
+	fake.createCompensationArgsForCall = append(fake.createCompensationArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.EmployeeCompensation
+	}{arg1, arg2})
+	stub := fake.CreateCompensationStub
+	fakeReturns := fake.createCompensationReturns
+	fake.recordInvocation("CreateCompensation", []interface{}{arg1, arg2})
+	fake.createCompensationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) CreateCompensationCallCount() int {
+	fake.createCompensationMutex.RLock()
+	defer fake.createCompensationMutex.RUnlock()
+	return len(fake.createCompensationArgsForCall)
+}
+
+func (fake *FakePayrollRepository) CreateCompensationCalls(stub func(context.Context, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = stub
+}
+
+func (fake *FakePayrollRepository) CreateCompensationArgsForCall(i int) (context.Context, domain.EmployeeCompensation) {
+	fake.createCompensationMutex.RLock()
+	defer fake.createCompensationMutex.RUnlock()
+	argsForCall := fake.createCompensationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) CreateCompensationReturns(result1 *domain.EmployeeCompensation, result2 error) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = nil
+	fake.createCompensationReturns = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) CreateCompensationReturnsOnCall(i int, result1 *domain.EmployeeCompensation, result2 error) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = nil
+	if fake.createCompensationReturnsOnCall == nil {
+		fake.createCompensationReturnsOnCall = make(map[int]struct {
+			result1 *domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.createCompensationReturnsOnCall[i] = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) CreateSchedule(arg1 context.Context, arg2 domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	fake.createScheduleMutex.Lock()
+	ret, specificReturn := fake.createScheduleReturnsOnCall[len(fake.createScheduleArgsForCall)]
+	fake.createScheduleArgsForCall = append(fake.createScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.PayrollSchedule
+	}{arg1, arg2})
+	stub := fake.CreateScheduleStub
+	fakeReturns := fake.createScheduleReturns
+	fake.recordInvocation("CreateSchedule", []interface{}{arg1, arg2})
+	fake.createScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) CreateScheduleCallCount() int {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	return len(fake.createScheduleArgsForCall)
+}
+
+func (fake *FakePayrollRepository) CreateScheduleCalls(stub func(context.Context, domain.PayrollSchedule) (*domain.PayrollSchedule, error)) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = stub
+}
+
+func (fake *FakePayrollRepository) CreateScheduleArgsForCall(i int) (context.Context, domain.PayrollSchedule) {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	argsForCall := fake.createScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) CreateScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	fake.createScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) CreateScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	if fake.createScheduleReturnsOnCall == nil {
+		fake.createScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.createScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GeneratePayRun(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time, arg4 time.Time) (*domain.PayRun, error) {
+	fake.generatePayRunMutex.Lock()
+	ret, specificReturn := fake.generatePayRunReturnsOnCall[len(fake.generatePayRunArgsForCall)]
+	fake.generatePayRunArgsForCall = append(fake.generatePayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GeneratePayRunStub
+	fakeReturns := fake.generatePayRunReturns
+	fake.recordInvocation("GeneratePayRun", []interface{}{arg1, arg2, arg3, arg4})
+	fake.generatePayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) GeneratePayRunCallCount() int {
+	fake.generatePayRunMutex.RLock()
+	defer fake.generatePayRunMutex.RUnlock()
+	return len(fake.generatePayRunArgsForCall)
+}
+
+func (fake *FakePayrollRepository) GeneratePayRunCalls(stub func(context.Context, uuid.UUID, time.Time, time.Time) (*domain.PayRun, error)) {
+	fake.generatePayRunMutex.Lock()
+	defer fake.generatePayRunMutex.Unlock()
+	fake.GeneratePayRunStub = stub
+}
+
+func (fake *FakePayrollRepository) GeneratePayRunArgsForCall(i int) (context.Context, uuid.UUID, time.Time, time.Time) {
+	fake.generatePayRunMutex.RLock()
+	defer fake.generatePayRunMutex.RUnlock()
+	argsForCall := fake.generatePayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollRepository) GeneratePayRunReturns(result1 *domain.PayRun, result2 error) {
+	fake.generatePayRunMutex.Lock()
+	defer fake.generatePayRunMutex.Unlock()
+	fake.GeneratePayRunStub = nil
+	fake.generatePayRunReturns = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GeneratePayRunReturnsOnCall(i int, result1 *domain.PayRun, result2 error) {
+	fake.generatePayRunMutex.Lock()
+	defer fake.generatePayRunMutex.Unlock()
+	fake.GeneratePayRunStub = nil
+	if fake.generatePayRunReturnsOnCall == nil {
+		fake.generatePayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRun
+			result2 error
+		})
+	}
+	fake.generatePayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetCompensation(arg1 context.Context, arg2 uuid.UUID) (*domain.EmployeeCompensation, error) {
+	fake.getCompensationMutex.Lock()
+	ret, specificReturn := fake.getCompensationReturnsOnCall[len(fake.getCompensationArgsForCall)]
+	fake.getCompensationArgsForCall = append(fake.getCompensationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetCompensationStub
+	fakeReturns := fake.getCompensationReturns
+	fake.recordInvocation("GetCompensation", []interface{}{arg1, arg2})
+	fake.getCompensationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) GetCompensationCallCount() int {
+	fake.getCompensationMutex.RLock()
+	defer fake.getCompensationMutex.RUnlock()
+	return len(fake.getCompensationArgsForCall)
+}
+
+func (fake *FakePayrollRepository) GetCompensationCalls(stub func(context.Context, uuid.UUID) (*domain.EmployeeCompensation, error)) {
+	fake.getCompensationMutex.Lock()
+	defer fake.getCompensationMutex.Unlock()
+	fake.GetCompensationStub = stub
+}
+
+func (fake *FakePayrollRepository) GetCompensationArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getCompensationMutex.RLock()
+	defer fake.getCompensationMutex.RUnlock()
+	argsForCall := fake.getCompensationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) GetCompensationReturns(result1 *domain.EmployeeCompensation, result2 error) {
+	fake.getCompensationMutex.Lock()
+	defer fake.getCompensationMutex.Unlock()
+	fake.GetCompensationStub = nil
+	fake.getCompensationReturns = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetCompensationReturnsOnCall(i int, result1 *domain.EmployeeCompensation, result2 error) {
+	fake.getCompensationMutex.Lock()
+	defer fake.getCompensationMutex.Unlock()
+	fake.GetCompensationStub = nil
+	if fake.getCompensationReturnsOnCall == nil {
+		fake.getCompensationReturnsOnCall = make(map[int]struct {
+			result1 *domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.getCompensationReturnsOnCall[i] = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetPayRun(arg1 context.Context, arg2 uuid.UUID) (*domain.PayRun, error) {
+	fake.getPayRunMutex.Lock()
+	ret, specificReturn := fake.getPayRunReturnsOnCall[len(fake.getPayRunArgsForCall)]
+	fake.getPayRunArgsForCall = append(fake.getPayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPayRunStub
+	fakeReturns := fake.getPayRunReturns
+	fake.recordInvocation("GetPayRun", []interface{}{arg1, arg2})
+	fake.getPayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) GetPayRunCallCount() int {
+	fake.getPayRunMutex.RLock()
+	defer fake.getPayRunMutex.RUnlock()
+	return len(fake.getPayRunArgsForCall)
+}
+
+func (fake *FakePayrollRepository) GetPayRunCalls(stub func(context.Context, uuid.UUID) (*domain.PayRun, error)) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = stub
+}
+
+func (fake *FakePayrollRepository) GetPayRunArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPayRunMutex.RLock()
+	defer fake.getPayRunMutex.RUnlock()
+	argsForCall := fake.getPayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) GetPayRunReturns(result1 *domain.PayRun, result2 error) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = nil
+	fake.getPayRunReturns = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetPayRunReturnsOnCall(i int, result1 *domain.PayRun, result2 error) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = nil
+	if fake.getPayRunReturnsOnCall == nil {
+		fake.getPayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRun
+			result2 error
+		})
+	}
+	fake.getPayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRun(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) (*domain.PayRun, error) {
+	fake.getPreviousPayRunMutex.Lock()
+	ret, specificReturn := fake.getPreviousPayRunReturnsOnCall[len(fake.getPreviousPayRunArgsForCall)]
+	fake.getPreviousPayRunArgsForCall = append(fake.getPreviousPayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetPreviousPayRunStub
+	fakeReturns := fake.getPreviousPayRunReturns
+	fake.recordInvocation("GetPreviousPayRun", []interface{}{arg1, arg2, arg3})
+	fake.getPreviousPayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRunCallCount() int {
+	fake.getPreviousPayRunMutex.RLock()
+	defer fake.getPreviousPayRunMutex.RUnlock()
+	return len(fake.getPreviousPayRunArgsForCall)
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRunCalls(stub func(context.Context, uuid.UUID, time.Time) (*domain.PayRun, error)) {
+	fake.getPreviousPayRunMutex.Lock()
+	defer fake.getPreviousPayRunMutex.Unlock()
+	fake.GetPreviousPayRunStub = stub
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRunArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.getPreviousPayRunMutex.RLock()
+	defer fake.getPreviousPayRunMutex.RUnlock()
+	argsForCall := fake.getPreviousPayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRunReturns(result1 *domain.PayRun, result2 error) {
+	fake.getPreviousPayRunMutex.Lock()
+	defer fake.getPreviousPayRunMutex.Unlock()
+	fake.GetPreviousPayRunStub = nil
+	fake.getPreviousPayRunReturns = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetPreviousPayRunReturnsOnCall(i int, result1 *domain.PayRun, result2 error) {
+	fake.getPreviousPayRunMutex.Lock()
+	defer fake.getPreviousPayRunMutex.Unlock()
+	fake.GetPreviousPayRunStub = nil
+	if fake.getPreviousPayRunReturnsOnCall == nil {
+		fake.getPreviousPayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRun
+			result2 error
+		})
+	}
+	fake.getPreviousPayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetSchedule(arg1 context.Context, arg2 uuid.UUID) (*domain.PayrollSchedule, error) {
+	fake.getScheduleMutex.Lock()
+	ret, specificReturn := fake.getScheduleReturnsOnCall[len(fake.getScheduleArgsForCall)]
+	fake.getScheduleArgsForCall = append(fake.getScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetScheduleStub
+	fakeReturns := fake.getScheduleReturns
+	fake.recordInvocation("GetSchedule", []interface{}{arg1, arg2})
+	fake.getScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) GetScheduleCallCount() int {
+	fake.getScheduleMutex.RLock()
+	defer fake.getScheduleMutex.RUnlock()
+	return len(fake.getScheduleArgsForCall)
+}
+
+func (fake *FakePayrollRepository) GetScheduleCalls(stub func(context.Context, uuid.UUID) (*domain.PayrollSchedule, error)) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = stub
+}
+
+func (fake *FakePayrollRepository) GetScheduleArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getScheduleMutex.RLock()
+	defer fake.getScheduleMutex.RUnlock()
+	argsForCall := fake.getScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) GetScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = nil
+	fake.getScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) GetScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = nil
+	if fake.getScheduleReturnsOnCall == nil {
+		fake.getScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.getScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListCompensations(arg1 context.Context, arg2 uuid.UUID, arg3 *uuid.UUID) ([]domain.EmployeeCompensation, error) {
+	fake.listCompensationsMutex.Lock()
+	ret, specificReturn := fake.listCompensationsReturnsOnCall[len(fake.listCompensationsArgsForCall)]
+	fake.listCompensationsArgsForCall = append(fake.listCompensationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListCompensationsStub
+	fakeReturns := fake.listCompensationsReturns
+	fake.recordInvocation("ListCompensations", []interface{}{arg1, arg2, arg3})
+	fake.listCompensationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) ListCompensationsCallCount() int {
+	fake.listCompensationsMutex.RLock()
+	defer fake.listCompensationsMutex.RUnlock()
+	return len(fake.listCompensationsArgsForCall)
+}
+
+func (fake *FakePayrollRepository) ListCompensationsCalls(stub func(context.Context, uuid.UUID, *uuid.UUID) ([]domain.EmployeeCompensation, error)) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = stub
+}
+
+func (fake *FakePayrollRepository) ListCompensationsArgsForCall(i int) (context.Context, uuid.UUID, *uuid.UUID) {
+	fake.listCompensationsMutex.RLock()
+	defer fake.listCompensationsMutex.RUnlock()
+	argsForCall := fake.listCompensationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayrollRepository) ListCompensationsReturns(result1 []domain.EmployeeCompensation, result2 error) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = nil
+	fake.listCompensationsReturns = struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListCompensationsReturnsOnCall(i int, result1 []domain.EmployeeCompensation, result2 error) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = nil
+	if fake.listCompensationsReturnsOnCall == nil {
+		fake.listCompensationsReturnsOnCall = make(map[int]struct {
+			result1 []domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.listCompensationsReturnsOnCall[i] = struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListDueSchedules(arg1 context.Context, arg2 time.Time, arg3 int) ([]domain.PayrollSchedule, error) {
+	fake.listDueSchedulesMutex.Lock()
+	ret, specificReturn := fake.listDueSchedulesReturnsOnCall[len(fake.listDueSchedulesArgsForCall)]
+	fake.listDueSchedulesArgsForCall = append(fake.listDueSchedulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListDueSchedulesStub
+	fakeReturns := fake.listDueSchedulesReturns
+	fake.recordInvocation("ListDueSchedules", []interface{}{arg1, arg2, arg3})
+	fake.listDueSchedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) ListDueSchedulesCallCount() int {
+	fake.listDueSchedulesMutex.RLock()
+	defer fake.listDueSchedulesMutex.RUnlock()
+	return len(fake.listDueSchedulesArgsForCall)
+}
+
+func (fake *FakePayrollRepository) ListDueSchedulesCalls(stub func(context.Context, time.Time, int) ([]domain.PayrollSchedule, error)) {
+	fake.listDueSchedulesMutex.Lock()
+	defer fake.listDueSchedulesMutex.Unlock()
+	fake.ListDueSchedulesStub = stub
+}
+
+func (fake *FakePayrollRepository) ListDueSchedulesArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.listDueSchedulesMutex.RLock()
+	defer fake.listDueSchedulesMutex.RUnlock()
+	argsForCall := fake.listDueSchedulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayrollRepository) ListDueSchedulesReturns(result1 []domain.PayrollSchedule, result2 error) {
+	fake.listDueSchedulesMutex.Lock()
+	defer fake.listDueSchedulesMutex.Unlock()
+	fake.ListDueSchedulesStub = nil
+	fake.listDueSchedulesReturns = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListDueSchedulesReturnsOnCall(i int, result1 []domain.PayrollSchedule, result2 error) {
+	fake.listDueSchedulesMutex.Lock()
+	defer fake.listDueSchedulesMutex.Unlock()
+	fake.ListDueSchedulesStub = nil
+	if fake.listDueSchedulesReturnsOnCall == nil {
+		fake.listDueSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.listDueSchedulesReturnsOnCall[i] = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListPayRuns(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 int) ([]domain.PayRun, int64, error) {
+	fake.listPayRunsMutex.Lock()
+	ret, specificReturn := fake.listPayRunsReturnsOnCall[len(fake.listPayRunsArgsForCall)]
+	fake.listPayRunsArgsForCall = append(fake.listPayRunsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPayRunsStub
+	fakeReturns := fake.listPayRunsReturns
+	fake.recordInvocation("ListPayRuns", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPayRunsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePayrollRepository) ListPayRunsCallCount() int {
+	fake.listPayRunsMutex.RLock()
+	defer fake.listPayRunsMutex.RUnlock()
+	return len(fake.listPayRunsArgsForCall)
+}
+
+func (fake *FakePayrollRepository) ListPayRunsCalls(stub func(context.Context, uuid.UUID, int, int) ([]domain.PayRun, int64, error)) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = stub
+}
+
+func (fake *FakePayrollRepository) ListPayRunsArgsForCall(i int) (context.Context, uuid.UUID, int, int) {
+	fake.listPayRunsMutex.RLock()
+	defer fake.listPayRunsMutex.RUnlock()
+	argsForCall := fake.listPayRunsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollRepository) ListPayRunsReturns(result1 []domain.PayRun, result2 int64, result3 error) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = nil
+	fake.listPayRunsReturns = struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePayrollRepository) ListPayRunsReturnsOnCall(i int, result1 []domain.PayRun, result2 int64, result3 error) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = nil
+	if fake.listPayRunsReturnsOnCall == nil {
+		fake.listPayRunsReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayRun
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listPayRunsReturnsOnCall[i] = struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePayrollRepository) ListSchedules(arg1 context.Context, arg2 uuid.UUID) ([]domain.PayrollSchedule, error) {
+	fake.listSchedulesMutex.Lock()
+	ret, specificReturn := fake.listSchedulesReturnsOnCall[len(fake.listSchedulesArgsForCall)]
+	fake.listSchedulesArgsForCall = append(fake.listSchedulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListSchedulesStub
+	fakeReturns := fake.listSchedulesReturns
+	fake.recordInvocation("ListSchedules", []interface{}{arg1, arg2})
+	fake.listSchedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) ListSchedulesCallCount() int {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	return len(fake.listSchedulesArgsForCall)
+}
+
+func (fake *FakePayrollRepository) ListSchedulesCalls(stub func(context.Context, uuid.UUID) ([]domain.PayrollSchedule, error)) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = stub
+}
+
+func (fake *FakePayrollRepository) ListSchedulesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	argsForCall := fake.listSchedulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) ListSchedulesReturns(result1 []domain.PayrollSchedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	fake.listSchedulesReturns = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) ListSchedulesReturnsOnCall(i int, result1 []domain.PayrollSchedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	if fake.listSchedulesReturnsOnCall == nil {
+		fake.listSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.listSchedulesReturnsOnCall[i] = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) UpdateCompensation(arg1 context.Context, arg2 domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	fake.updateCompensationMutex.Lock()
+	ret, specificReturn := fake.updateCompensationReturnsOnCall[len(fake.updateCompensationArgsForCall)]
+	fake.updateCompensationArgsForCall = append(fake.updateCompensationArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.EmployeeCompensation
+	}{arg1, arg2})
+	stub := fake.UpdateCompensationStub
+	fakeReturns := fake.updateCompensationReturns
+	fake.recordInvocation("UpdateCompensation", []interface{}{arg1, arg2})
+	fake.updateCompensationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) UpdateCompensationCallCount() int {
+	fake.updateCompensationMutex.RLock()
+	defer fake.updateCompensationMutex.RUnlock()
+	return len(fake.updateCompensationArgsForCall)
+}
+
+func (fake *FakePayrollRepository) UpdateCompensationCalls(stub func(context.Context, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = stub
+}
+
+func (fake *FakePayrollRepository) UpdateCompensationArgsForCall(i int) (context.Context, domain.EmployeeCompensation) {
+	fake.updateCompensationMutex.RLock()
+	defer fake.updateCompensationMutex.RUnlock()
+	argsForCall := fake.updateCompensationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) UpdateCompensationReturns(result1 *domain.EmployeeCompensation, result2 error) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = nil
+	fake.updateCompensationReturns = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) UpdateCompensationReturnsOnCall(i int, result1 *domain.EmployeeCompensation, result2 error) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = nil
+	if fake.updateCompensationReturnsOnCall == nil {
+		fake.updateCompensationReturnsOnCall = make(map[int]struct {
+			result1 *domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.updateCompensationReturnsOnCall[i] = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) UpdateSchedule(arg1 context.Context, arg2 domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	fake.updateScheduleMutex.Lock()
+	ret, specificReturn := fake.updateScheduleReturnsOnCall[len(fake.updateScheduleArgsForCall)]
+	fake.updateScheduleArgsForCall = append(fake.updateScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.PayrollSchedule
+	}{arg1, arg2})
+	stub := fake.UpdateScheduleStub
+	fakeReturns := fake.updateScheduleReturns
+	fake.recordInvocation("UpdateSchedule", []interface{}{arg1, arg2})
+	fake.updateScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollRepository) UpdateScheduleCallCount() int {
+	fake.updateScheduleMutex.RLock()
+	defer fake.updateScheduleMutex.RUnlock()
+	return len(fake.updateScheduleArgsForCall)
+}
+
+func (fake *FakePayrollRepository) UpdateScheduleCalls(stub func(context.Context, domain.PayrollSchedule) (*domain.PayrollSchedule, error)) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = stub
+}
+
+func (fake *FakePayrollRepository) UpdateScheduleArgsForCall(i int) (context.Context, domain.PayrollSchedule) {
+	fake.updateScheduleMutex.RLock()
+	defer fake.updateScheduleMutex.RUnlock()
+	argsForCall := fake.updateScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePayrollRepository) UpdateScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = nil
+	fake.updateScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) UpdateScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = nil
+	if fake.updateScheduleReturnsOnCall == nil {
+		fake.updateScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.updateScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePayrollRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.PayrollRepository = new(FakePayrollRepository)
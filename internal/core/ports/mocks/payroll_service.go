@@ -0,0 +1,1210 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakePayrollService struct {
+	CreateCompensationStub        func(context.Context, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	createCompensationMutex       sync.RWMutex
+	createCompensationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.EmployeeCompensation
+	}
+	createCompensationReturns struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	createCompensationReturnsOnCall map[int]struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	CreateScheduleStub        func(context.Context, uuid.UUID, uuid.UUID, domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	createScheduleMutex       sync.RWMutex
+	createScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.PayrollSchedule
+	}
+	createScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	createScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	GenerateDuePayRunsStub        func(context.Context) (int, error)
+	generateDuePayRunsMutex       sync.RWMutex
+	generateDuePayRunsArgsForCall []struct {
+		arg1 context.Context
+	}
+	generateDuePayRunsReturns struct {
+		result1 int
+		result2 error
+	}
+	generateDuePayRunsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	GetPayRunStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayRun, error)
+	getPayRunMutex       sync.RWMutex
+	getPayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getPayRunReturns struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	getPayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRun
+		result2 error
+	}
+	GetPayslipStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Payslip, error)
+	getPayslipMutex       sync.RWMutex
+	getPayslipArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 uuid.UUID
+	}
+	getPayslipReturns struct {
+		result1 *domain.Payslip
+		result2 error
+	}
+	getPayslipReturnsOnCall map[int]struct {
+		result1 *domain.Payslip
+		result2 error
+	}
+	GetScheduleStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayrollSchedule, error)
+	getScheduleMutex       sync.RWMutex
+	getScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	getScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	ListCompensationsStub        func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) ([]domain.EmployeeCompensation, error)
+	listCompensationsMutex       sync.RWMutex
+	listCompensationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *uuid.UUID
+	}
+	listCompensationsReturns struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}
+	listCompensationsReturnsOnCall map[int]struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}
+	ListPayRunsStub        func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]domain.PayRun, int64, error)
+	listPayRunsMutex       sync.RWMutex
+	listPayRunsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 int
+		arg5 int
+	}
+	listPayRunsReturns struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}
+	listPayRunsReturnsOnCall map[int]struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}
+	ListPayslipsStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.Payslip, error)
+	listPayslipsMutex       sync.RWMutex
+	listPayslipsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	listPayslipsReturns struct {
+		result1 []domain.Payslip
+		result2 error
+	}
+	listPayslipsReturnsOnCall map[int]struct {
+		result1 []domain.Payslip
+		result2 error
+	}
+	ListSchedulesStub        func(context.Context, uuid.UUID, uuid.UUID) ([]domain.PayrollSchedule, error)
+	listSchedulesMutex       sync.RWMutex
+	listSchedulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listSchedulesReturns struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	listSchedulesReturnsOnCall map[int]struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}
+	SimulatePayRunStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayRunSimulation, error)
+	simulatePayRunMutex       sync.RWMutex
+	simulatePayRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	simulatePayRunReturns struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}
+	simulatePayRunReturnsOnCall map[int]struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}
+	SimulateScheduleStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) (*domain.PayRunSimulation, error)
+	simulateScheduleMutex       sync.RWMutex
+	simulateScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+	}
+	simulateScheduleReturns struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}
+	simulateScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}
+	UpdateCompensationStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	updateCompensationMutex       sync.RWMutex
+	updateCompensationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.EmployeeCompensation
+	}
+	updateCompensationReturns struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	updateCompensationReturnsOnCall map[int]struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}
+	UpdateScheduleStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	updateScheduleMutex       sync.RWMutex
+	updateScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.PayrollSchedule
+	}
+	updateScheduleReturns struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	updateScheduleReturnsOnCall map[int]struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePayrollService) CreateCompensation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	fake.createCompensationMutex.Lock()
+	ret, specificReturn := fake.createCompensationReturnsOnCall[len(fake.createCompensationArgsForCall)]
+	fake.createCompensationArgsForCall = append(fake.createCompensationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.EmployeeCompensation
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateCompensationStub
+	fakeReturns := fake.createCompensationReturns
+	fake.recordInvocation("CreateCompensation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createCompensationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) CreateCompensationCallCount() int {
+	fake.createCompensationMutex.RLock()
+	defer fake.createCompensationMutex.RUnlock()
+	return len(fake.createCompensationArgsForCall)
+}
+
+func (fake *FakePayrollService) CreateCompensationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = stub
+}
+
+func (fake *FakePayrollService) CreateCompensationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) {
+	fake.createCompensationMutex.RLock()
+	defer fake.createCompensationMutex.RUnlock()
+	argsForCall := fake.createCompensationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) CreateCompensationReturns(result1 *domain.EmployeeCompensation, result2 error) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = nil
+	fake.createCompensationReturns = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) CreateCompensationReturnsOnCall(i int, result1 *domain.EmployeeCompensation, result2 error) {
+	fake.createCompensationMutex.Lock()
+	defer fake.createCompensationMutex.Unlock()
+	fake.CreateCompensationStub = nil
+	if fake.createCompensationReturnsOnCall == nil {
+		fake.createCompensationReturnsOnCall = make(map[int]struct {
+			result1 *domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.createCompensationReturnsOnCall[i] = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) CreateSchedule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	fake.createScheduleMutex.Lock()
+	ret, specificReturn := fake.createScheduleReturnsOnCall[len(fake.createScheduleArgsForCall)]
+	fake.createScheduleArgsForCall = append(fake.createScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.PayrollSchedule
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateScheduleStub
+	fakeReturns := fake.createScheduleReturns
+	fake.recordInvocation("CreateSchedule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) CreateScheduleCallCount() int {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	return len(fake.createScheduleArgsForCall)
+}
+
+func (fake *FakePayrollService) CreateScheduleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.PayrollSchedule) (*domain.PayrollSchedule, error)) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = stub
+}
+
+func (fake *FakePayrollService) CreateScheduleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.PayrollSchedule) {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	argsForCall := fake.createScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) CreateScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	fake.createScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) CreateScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	if fake.createScheduleReturnsOnCall == nil {
+		fake.createScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.createScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GenerateDuePayRuns(arg1 context.Context) (int, error) {
+	fake.generateDuePayRunsMutex.Lock()
+	ret, specificReturn := fake.generateDuePayRunsReturnsOnCall[len(fake.generateDuePayRunsArgsForCall)]
+	fake.generateDuePayRunsArgsForCall = append(fake.generateDuePayRunsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GenerateDuePayRunsStub
+	fakeReturns := fake.generateDuePayRunsReturns
+	fake.recordInvocation("GenerateDuePayRuns", []interface{}{arg1})
+	fake.generateDuePayRunsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) GenerateDuePayRunsCallCount() int {
+	fake.generateDuePayRunsMutex.RLock()
+	defer fake.generateDuePayRunsMutex.RUnlock()
+	return len(fake.generateDuePayRunsArgsForCall)
+}
+
+func (fake *FakePayrollService) GenerateDuePayRunsCalls(stub func(context.Context) (int, error)) {
+	fake.generateDuePayRunsMutex.Lock()
+	defer fake.generateDuePayRunsMutex.Unlock()
+	fake.GenerateDuePayRunsStub = stub
+}
+
+func (fake *FakePayrollService) GenerateDuePayRunsArgsForCall(i int) context.Context {
+	fake.generateDuePayRunsMutex.RLock()
+	defer fake.generateDuePayRunsMutex.RUnlock()
+	argsForCall := fake.generateDuePayRunsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePayrollService) GenerateDuePayRunsReturns(result1 int, result2 error) {
+	fake.generateDuePayRunsMutex.Lock()
+	defer fake.generateDuePayRunsMutex.Unlock()
+	fake.GenerateDuePayRunsStub = nil
+	fake.generateDuePayRunsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GenerateDuePayRunsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.generateDuePayRunsMutex.Lock()
+	defer fake.generateDuePayRunsMutex.Unlock()
+	fake.GenerateDuePayRunsStub = nil
+	if fake.generateDuePayRunsReturnsOnCall == nil {
+		fake.generateDuePayRunsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.generateDuePayRunsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetPayRun(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.PayRun, error) {
+	fake.getPayRunMutex.Lock()
+	ret, specificReturn := fake.getPayRunReturnsOnCall[len(fake.getPayRunArgsForCall)]
+	fake.getPayRunArgsForCall = append(fake.getPayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetPayRunStub
+	fakeReturns := fake.getPayRunReturns
+	fake.recordInvocation("GetPayRun", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getPayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) GetPayRunCallCount() int {
+	fake.getPayRunMutex.RLock()
+	defer fake.getPayRunMutex.RUnlock()
+	return len(fake.getPayRunArgsForCall)
+}
+
+func (fake *FakePayrollService) GetPayRunCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayRun, error)) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = stub
+}
+
+func (fake *FakePayrollService) GetPayRunArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getPayRunMutex.RLock()
+	defer fake.getPayRunMutex.RUnlock()
+	argsForCall := fake.getPayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) GetPayRunReturns(result1 *domain.PayRun, result2 error) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = nil
+	fake.getPayRunReturns = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetPayRunReturnsOnCall(i int, result1 *domain.PayRun, result2 error) {
+	fake.getPayRunMutex.Lock()
+	defer fake.getPayRunMutex.Unlock()
+	fake.GetPayRunStub = nil
+	if fake.getPayRunReturnsOnCall == nil {
+		fake.getPayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRun
+			result2 error
+		})
+	}
+	fake.getPayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetPayslip(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 uuid.UUID) (*domain.Payslip, error) {
+	fake.getPayslipMutex.Lock()
+	ret, specificReturn := fake.getPayslipReturnsOnCall[len(fake.getPayslipArgsForCall)]
+	fake.getPayslipArgsForCall = append(fake.getPayslipArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetPayslipStub
+	fakeReturns := fake.getPayslipReturns
+	fake.recordInvocation("GetPayslip", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getPayslipMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) GetPayslipCallCount() int {
+	fake.getPayslipMutex.RLock()
+	defer fake.getPayslipMutex.RUnlock()
+	return len(fake.getPayslipArgsForCall)
+}
+
+func (fake *FakePayrollService) GetPayslipCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Payslip, error)) {
+	fake.getPayslipMutex.Lock()
+	defer fake.getPayslipMutex.Unlock()
+	fake.GetPayslipStub = stub
+}
+
+func (fake *FakePayrollService) GetPayslipArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getPayslipMutex.RLock()
+	defer fake.getPayslipMutex.RUnlock()
+	argsForCall := fake.getPayslipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePayrollService) GetPayslipReturns(result1 *domain.Payslip, result2 error) {
+	fake.getPayslipMutex.Lock()
+	defer fake.getPayslipMutex.Unlock()
+	fake.GetPayslipStub = nil
+	fake.getPayslipReturns = struct {
+		result1 *domain.Payslip
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetPayslipReturnsOnCall(i int, result1 *domain.Payslip, result2 error) {
+	fake.getPayslipMutex.Lock()
+	defer fake.getPayslipMutex.Unlock()
+	fake.GetPayslipStub = nil
+	if fake.getPayslipReturnsOnCall == nil {
+		fake.getPayslipReturnsOnCall = make(map[int]struct {
+			result1 *domain.Payslip
+			result2 error
+		})
+	}
+	fake.getPayslipReturnsOnCall[i] = struct {
+		result1 *domain.Payslip
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetSchedule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.PayrollSchedule, error) {
+	fake.getScheduleMutex.Lock()
+	ret, specificReturn := fake.getScheduleReturnsOnCall[len(fake.getScheduleArgsForCall)]
+	fake.getScheduleArgsForCall = append(fake.getScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetScheduleStub
+	fakeReturns := fake.getScheduleReturns
+	fake.recordInvocation("GetSchedule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) GetScheduleCallCount() int {
+	fake.getScheduleMutex.RLock()
+	defer fake.getScheduleMutex.RUnlock()
+	return len(fake.getScheduleArgsForCall)
+}
+
+func (fake *FakePayrollService) GetScheduleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayrollSchedule, error)) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = stub
+}
+
+func (fake *FakePayrollService) GetScheduleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getScheduleMutex.RLock()
+	defer fake.getScheduleMutex.RUnlock()
+	argsForCall := fake.getScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) GetScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = nil
+	fake.getScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) GetScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.getScheduleMutex.Lock()
+	defer fake.getScheduleMutex.Unlock()
+	fake.GetScheduleStub = nil
+	if fake.getScheduleReturnsOnCall == nil {
+		fake.getScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.getScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListCompensations(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 *uuid.UUID) ([]domain.EmployeeCompensation, error) {
+	fake.listCompensationsMutex.Lock()
+	ret, specificReturn := fake.listCompensationsReturnsOnCall[len(fake.listCompensationsArgsForCall)]
+	fake.listCompensationsArgsForCall = append(fake.listCompensationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 *uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListCompensationsStub
+	fakeReturns := fake.listCompensationsReturns
+	fake.recordInvocation("ListCompensations", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listCompensationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) ListCompensationsCallCount() int {
+	fake.listCompensationsMutex.RLock()
+	defer fake.listCompensationsMutex.RUnlock()
+	return len(fake.listCompensationsArgsForCall)
+}
+
+func (fake *FakePayrollService) ListCompensationsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) ([]domain.EmployeeCompensation, error)) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = stub
+}
+
+func (fake *FakePayrollService) ListCompensationsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) {
+	fake.listCompensationsMutex.RLock()
+	defer fake.listCompensationsMutex.RUnlock()
+	argsForCall := fake.listCompensationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) ListCompensationsReturns(result1 []domain.EmployeeCompensation, result2 error) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = nil
+	fake.listCompensationsReturns = struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListCompensationsReturnsOnCall(i int, result1 []domain.EmployeeCompensation, result2 error) {
+	fake.listCompensationsMutex.Lock()
+	defer fake.listCompensationsMutex.Unlock()
+	fake.ListCompensationsStub = nil
+	if fake.listCompensationsReturnsOnCall == nil {
+		fake.listCompensationsReturnsOnCall = make(map[int]struct {
+			result1 []domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.listCompensationsReturnsOnCall[i] = struct {
+		result1 []domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListPayRuns(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 int, arg5 int) ([]domain.PayRun, int64, error) {
+	fake.listPayRunsMutex.Lock()
+	ret, specificReturn := fake.listPayRunsReturnsOnCall[len(fake.listPayRunsArgsForCall)]
+	fake.listPayRunsArgsForCall = append(fake.listPayRunsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListPayRunsStub
+	fakeReturns := fake.listPayRunsReturns
+	fake.recordInvocation("ListPayRuns", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listPayRunsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePayrollService) ListPayRunsCallCount() int {
+	fake.listPayRunsMutex.RLock()
+	defer fake.listPayRunsMutex.RUnlock()
+	return len(fake.listPayRunsArgsForCall)
+}
+
+func (fake *FakePayrollService) ListPayRunsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]domain.PayRun, int64, error)) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = stub
+}
+
+func (fake *FakePayrollService) ListPayRunsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, int, int) {
+	fake.listPayRunsMutex.RLock()
+	defer fake.listPayRunsMutex.RUnlock()
+	argsForCall := fake.listPayRunsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePayrollService) ListPayRunsReturns(result1 []domain.PayRun, result2 int64, result3 error) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = nil
+	fake.listPayRunsReturns = struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePayrollService) ListPayRunsReturnsOnCall(i int, result1 []domain.PayRun, result2 int64, result3 error) {
+	fake.listPayRunsMutex.Lock()
+	defer fake.listPayRunsMutex.Unlock()
+	fake.ListPayRunsStub = nil
+	if fake.listPayRunsReturnsOnCall == nil {
+		fake.listPayRunsReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayRun
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listPayRunsReturnsOnCall[i] = struct {
+		result1 []domain.PayRun
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePayrollService) ListPayslips(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) ([]domain.Payslip, error) {
+	fake.listPayslipsMutex.Lock()
+	ret, specificReturn := fake.listPayslipsReturnsOnCall[len(fake.listPayslipsArgsForCall)]
+	fake.listPayslipsArgsForCall = append(fake.listPayslipsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPayslipsStub
+	fakeReturns := fake.listPayslipsReturns
+	fake.recordInvocation("ListPayslips", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPayslipsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) ListPayslipsCallCount() int {
+	fake.listPayslipsMutex.RLock()
+	defer fake.listPayslipsMutex.RUnlock()
+	return len(fake.listPayslipsArgsForCall)
+}
+
+func (fake *FakePayrollService) ListPayslipsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]domain.Payslip, error)) {
+	fake.listPayslipsMutex.Lock()
+	defer fake.listPayslipsMutex.Unlock()
+	fake.ListPayslipsStub = stub
+}
+
+func (fake *FakePayrollService) ListPayslipsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.listPayslipsMutex.RLock()
+	defer fake.listPayslipsMutex.RUnlock()
+	argsForCall := fake.listPayslipsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) ListPayslipsReturns(result1 []domain.Payslip, result2 error) {
+	fake.listPayslipsMutex.Lock()
+	defer fake.listPayslipsMutex.Unlock()
+	fake.ListPayslipsStub = nil
+	fake.listPayslipsReturns = struct {
+		result1 []domain.Payslip
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListPayslipsReturnsOnCall(i int, result1 []domain.Payslip, result2 error) {
+	fake.listPayslipsMutex.Lock()
+	defer fake.listPayslipsMutex.Unlock()
+	fake.ListPayslipsStub = nil
+	if fake.listPayslipsReturnsOnCall == nil {
+		fake.listPayslipsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Payslip
+			result2 error
+		})
+	}
+	fake.listPayslipsReturnsOnCall[i] = struct {
+		result1 []domain.Payslip
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListSchedules(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]domain.PayrollSchedule, error) {
+	fake.listSchedulesMutex.Lock()
+	ret, specificReturn := fake.listSchedulesReturnsOnCall[len(fake.listSchedulesArgsForCall)]
+	fake.listSchedulesArgsForCall = append(fake.listSchedulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListSchedulesStub
+	fakeReturns := fake.listSchedulesReturns
+	fake.recordInvocation("ListSchedules", []interface{}{arg1, arg2, arg3})
+	fake.listSchedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) ListSchedulesCallCount() int {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	return len(fake.listSchedulesArgsForCall)
+}
+
+func (fake *FakePayrollService) ListSchedulesCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]domain.PayrollSchedule, error)) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = stub
+}
+
+func (fake *FakePayrollService) ListSchedulesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	argsForCall := fake.listSchedulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePayrollService) ListSchedulesReturns(result1 []domain.PayrollSchedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	fake.listSchedulesReturns = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) ListSchedulesReturnsOnCall(i int, result1 []domain.PayrollSchedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	if fake.listSchedulesReturnsOnCall == nil {
+		fake.listSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.listSchedulesReturnsOnCall[i] = struct {
+		result1 []domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) SimulatePayRun(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.PayRunSimulation, error) {
+	fake.simulatePayRunMutex.Lock()
+	ret, specificReturn := fake.simulatePayRunReturnsOnCall[len(fake.simulatePayRunArgsForCall)]
+	fake.simulatePayRunArgsForCall = append(fake.simulatePayRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SimulatePayRunStub
+	fakeReturns := fake.simulatePayRunReturns
+	fake.recordInvocation("SimulatePayRun", []interface{}{arg1, arg2, arg3, arg4})
+	fake.simulatePayRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) SimulatePayRunCallCount() int {
+	fake.simulatePayRunMutex.RLock()
+	defer fake.simulatePayRunMutex.RUnlock()
+	return len(fake.simulatePayRunArgsForCall)
+}
+
+func (fake *FakePayrollService) SimulatePayRunCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.PayRunSimulation, error)) {
+	fake.simulatePayRunMutex.Lock()
+	defer fake.simulatePayRunMutex.Unlock()
+	fake.SimulatePayRunStub = stub
+}
+
+func (fake *FakePayrollService) SimulatePayRunArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.simulatePayRunMutex.RLock()
+	defer fake.simulatePayRunMutex.RUnlock()
+	argsForCall := fake.simulatePayRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePayrollService) SimulatePayRunReturns(result1 *domain.PayRunSimulation, result2 error) {
+	fake.simulatePayRunMutex.Lock()
+	defer fake.simulatePayRunMutex.Unlock()
+	fake.SimulatePayRunStub = nil
+	fake.simulatePayRunReturns = struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) SimulatePayRunReturnsOnCall(i int, result1 *domain.PayRunSimulation, result2 error) {
+	fake.simulatePayRunMutex.Lock()
+	defer fake.simulatePayRunMutex.Unlock()
+	fake.SimulatePayRunStub = nil
+	if fake.simulatePayRunReturnsOnCall == nil {
+		fake.simulatePayRunReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRunSimulation
+			result2 error
+		})
+	}
+	fake.simulatePayRunReturnsOnCall[i] = struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) SimulateSchedule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 *time.Time) (*domain.PayRunSimulation, error) {
+	fake.simulateScheduleMutex.Lock()
+	ret, specificReturn := fake.simulateScheduleReturnsOnCall[len(fake.simulateScheduleArgsForCall)]
+	fake.simulateScheduleArgsForCall = append(fake.simulateScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 *time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SimulateScheduleStub
+	fakeReturns := fake.simulateScheduleReturns
+	fake.recordInvocation("SimulateSchedule", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.simulateScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) SimulateScheduleCallCount() int {
+	fake.simulateScheduleMutex.RLock()
+	defer fake.simulateScheduleMutex.RUnlock()
+	return len(fake.simulateScheduleArgsForCall)
+}
+
+func (fake *FakePayrollService) SimulateScheduleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) (*domain.PayRunSimulation, error)) {
+	fake.simulateScheduleMutex.Lock()
+	defer fake.simulateScheduleMutex.Unlock()
+	fake.SimulateScheduleStub = stub
+}
+
+func (fake *FakePayrollService) SimulateScheduleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *time.Time) {
+	fake.simulateScheduleMutex.RLock()
+	defer fake.simulateScheduleMutex.RUnlock()
+	argsForCall := fake.simulateScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePayrollService) SimulateScheduleReturns(result1 *domain.PayRunSimulation, result2 error) {
+	fake.simulateScheduleMutex.Lock()
+	defer fake.simulateScheduleMutex.Unlock()
+	fake.SimulateScheduleStub = nil
+	fake.simulateScheduleReturns = struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) SimulateScheduleReturnsOnCall(i int, result1 *domain.PayRunSimulation, result2 error) {
+	fake.simulateScheduleMutex.Lock()
+	defer fake.simulateScheduleMutex.Unlock()
+	fake.SimulateScheduleStub = nil
+	if fake.simulateScheduleReturnsOnCall == nil {
+		fake.simulateScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayRunSimulation
+			result2 error
+		})
+	}
+	fake.simulateScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayRunSimulation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) UpdateCompensation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	fake.updateCompensationMutex.Lock()
+	ret, specificReturn := fake.updateCompensationReturnsOnCall[len(fake.updateCompensationArgsForCall)]
+	fake.updateCompensationArgsForCall = append(fake.updateCompensationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.EmployeeCompensation
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateCompensationStub
+	fakeReturns := fake.updateCompensationReturns
+	fake.recordInvocation("UpdateCompensation", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateCompensationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) UpdateCompensationCallCount() int {
+	fake.updateCompensationMutex.RLock()
+	defer fake.updateCompensationMutex.RUnlock()
+	return len(fake.updateCompensationArgsForCall)
+}
+
+func (fake *FakePayrollService) UpdateCompensationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = stub
+}
+
+func (fake *FakePayrollService) UpdateCompensationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.EmployeeCompensation) {
+	fake.updateCompensationMutex.RLock()
+	defer fake.updateCompensationMutex.RUnlock()
+	argsForCall := fake.updateCompensationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePayrollService) UpdateCompensationReturns(result1 *domain.EmployeeCompensation, result2 error) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = nil
+	fake.updateCompensationReturns = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) UpdateCompensationReturnsOnCall(i int, result1 *domain.EmployeeCompensation, result2 error) {
+	fake.updateCompensationMutex.Lock()
+	defer fake.updateCompensationMutex.Unlock()
+	fake.UpdateCompensationStub = nil
+	if fake.updateCompensationReturnsOnCall == nil {
+		fake.updateCompensationReturnsOnCall = make(map[int]struct {
+			result1 *domain.EmployeeCompensation
+			result2 error
+		})
+	}
+	fake.updateCompensationReturnsOnCall[i] = struct {
+		result1 *domain.EmployeeCompensation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) UpdateSchedule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	fake.updateScheduleMutex.Lock()
+	ret, specificReturn := fake.updateScheduleReturnsOnCall[len(fake.updateScheduleArgsForCall)]
+	fake.updateScheduleArgsForCall = append(fake.updateScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.PayrollSchedule
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateScheduleStub
+	fakeReturns := fake.updateScheduleReturns
+	fake.recordInvocation("UpdateSchedule", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePayrollService) UpdateScheduleCallCount() int {
+	fake.updateScheduleMutex.RLock()
+	defer fake.updateScheduleMutex.RUnlock()
+	return len(fake.updateScheduleArgsForCall)
+}
+
+func (fake *FakePayrollService) UpdateScheduleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.PayrollSchedule) (*domain.PayrollSchedule, error)) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = stub
+}
+
+func (fake *FakePayrollService) UpdateScheduleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.PayrollSchedule) {
+	fake.updateScheduleMutex.RLock()
+	defer fake.updateScheduleMutex.RUnlock()
+	argsForCall := fake.updateScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePayrollService) UpdateScheduleReturns(result1 *domain.PayrollSchedule, result2 error) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = nil
+	fake.updateScheduleReturns = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) UpdateScheduleReturnsOnCall(i int, result1 *domain.PayrollSchedule, result2 error) {
+	fake.updateScheduleMutex.Lock()
+	defer fake.updateScheduleMutex.Unlock()
+	fake.UpdateScheduleStub = nil
+	if fake.updateScheduleReturnsOnCall == nil {
+		fake.updateScheduleReturnsOnCall = make(map[int]struct {
+			result1 *domain.PayrollSchedule
+			result2 error
+		})
+	}
+	fake.updateScheduleReturnsOnCall[i] = struct {
+		result1 *domain.PayrollSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePayrollService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePayrollService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.PayrollService = new(FakePayrollService)
@@ -0,0 +1,523 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeRecurringInvoiceRepository struct {
+	CreateRecurringInvoiceStub        func(context.Context, domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	createRecurringInvoiceMutex       sync.RWMutex
+	createRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.RecurringInvoice
+	}
+	createRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	createRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	GenerateRecurringInvoiceStub        func(context.Context, uuid.UUID, domain.Invoice, time.Time, bool) (*domain.Invoice, error)
+	generateRecurringInvoiceMutex       sync.RWMutex
+	generateRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.Invoice
+		arg4 time.Time
+		arg5 bool
+	}
+	generateRecurringInvoiceReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	generateRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	GetRecurringInvoiceStub        func(context.Context, uuid.UUID) (*domain.RecurringInvoice, error)
+	getRecurringInvoiceMutex       sync.RWMutex
+	getRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	getRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	ListDueRecurringInvoicesStub        func(context.Context, time.Time, int) ([]domain.RecurringInvoice, error)
+	listDueRecurringInvoicesMutex       sync.RWMutex
+	listDueRecurringInvoicesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	listDueRecurringInvoicesReturns struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	listDueRecurringInvoicesReturnsOnCall map[int]struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	ListRecurringInvoicesStub        func(context.Context, uuid.UUID) ([]domain.RecurringInvoice, error)
+	listRecurringInvoicesMutex       sync.RWMutex
+	listRecurringInvoicesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listRecurringInvoicesReturns struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	listRecurringInvoicesReturnsOnCall map[int]struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	UpdateRecurringInvoiceStub        func(context.Context, domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	updateRecurringInvoiceMutex       sync.RWMutex
+	updateRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.RecurringInvoice
+	}
+	updateRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	updateRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoice(arg1 context.Context, arg2 domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.createRecurringInvoiceReturnsOnCall[len(fake.createRecurringInvoiceArgsForCall)]
+	fake.createRecurringInvoiceArgsForCall = append(fake.createRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.RecurringInvoice
+	}{arg1, arg2})
+	stub := fake.CreateRecurringInvoiceStub
+	fakeReturns := fake.createRecurringInvoiceReturns
+	fake.recordInvocation("CreateRecurringInvoice", []interface{}{arg1, arg2})
+	fake.createRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoiceCallCount() int {
+	fake.createRecurringInvoiceMutex.RLock()
+	defer fake.createRecurringInvoiceMutex.RUnlock()
+	return len(fake.createRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoiceCalls(stub func(context.Context, domain.RecurringInvoice) (*domain.RecurringInvoice, error)) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoiceArgsForCall(i int) (context.Context, domain.RecurringInvoice) {
+	fake.createRecurringInvoiceMutex.RLock()
+	defer fake.createRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.createRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = nil
+	fake.createRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) CreateRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = nil
+	if fake.createRecurringInvoiceReturnsOnCall == nil {
+		fake.createRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.createRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 domain.Invoice, arg4 time.Time, arg5 bool) (*domain.Invoice, error) {
+	fake.generateRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.generateRecurringInvoiceReturnsOnCall[len(fake.generateRecurringInvoiceArgsForCall)]
+	fake.generateRecurringInvoiceArgsForCall = append(fake.generateRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.Invoice
+		arg4 time.Time
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GenerateRecurringInvoiceStub
+	fakeReturns := fake.generateRecurringInvoiceReturns
+	fake.recordInvocation("GenerateRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.generateRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoiceCallCount() int {
+	fake.generateRecurringInvoiceMutex.RLock()
+	defer fake.generateRecurringInvoiceMutex.RUnlock()
+	return len(fake.generateRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, domain.Invoice, time.Time, bool) (*domain.Invoice, error)) {
+	fake.generateRecurringInvoiceMutex.Lock()
+	defer fake.generateRecurringInvoiceMutex.Unlock()
+	fake.GenerateRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, domain.Invoice, time.Time, bool) {
+	fake.generateRecurringInvoiceMutex.RLock()
+	defer fake.generateRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.generateRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoiceReturns(result1 *domain.Invoice, result2 error) {
+	fake.generateRecurringInvoiceMutex.Lock()
+	defer fake.generateRecurringInvoiceMutex.Unlock()
+	fake.GenerateRecurringInvoiceStub = nil
+	fake.generateRecurringInvoiceReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) GenerateRecurringInvoiceReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.generateRecurringInvoiceMutex.Lock()
+	defer fake.generateRecurringInvoiceMutex.Unlock()
+	fake.GenerateRecurringInvoiceStub = nil
+	if fake.generateRecurringInvoiceReturnsOnCall == nil {
+		fake.generateRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.generateRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoice(arg1 context.Context, arg2 uuid.UUID) (*domain.RecurringInvoice, error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.getRecurringInvoiceReturnsOnCall[len(fake.getRecurringInvoiceArgsForCall)]
+	fake.getRecurringInvoiceArgsForCall = append(fake.getRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetRecurringInvoiceStub
+	fakeReturns := fake.getRecurringInvoiceReturns
+	fake.recordInvocation("GetRecurringInvoice", []interface{}{arg1, arg2})
+	fake.getRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoiceCallCount() int {
+	fake.getRecurringInvoiceMutex.RLock()
+	defer fake.getRecurringInvoiceMutex.RUnlock()
+	return len(fake.getRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoiceCalls(stub func(context.Context, uuid.UUID) (*domain.RecurringInvoice, error)) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getRecurringInvoiceMutex.RLock()
+	defer fake.getRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.getRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = nil
+	fake.getRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) GetRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = nil
+	if fake.getRecurringInvoiceReturnsOnCall == nil {
+		fake.getRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.getRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoices(arg1 context.Context, arg2 time.Time, arg3 int) ([]domain.RecurringInvoice, error) {
+	fake.listDueRecurringInvoicesMutex.Lock()
+	ret, specificReturn := fake.listDueRecurringInvoicesReturnsOnCall[len(fake.listDueRecurringInvoicesArgsForCall)]
+	fake.listDueRecurringInvoicesArgsForCall = append(fake.listDueRecurringInvoicesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListDueRecurringInvoicesStub
+	fakeReturns := fake.listDueRecurringInvoicesReturns
+	fake.recordInvocation("ListDueRecurringInvoices", []interface{}{arg1, arg2, arg3})
+	fake.listDueRecurringInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoicesCallCount() int {
+	fake.listDueRecurringInvoicesMutex.RLock()
+	defer fake.listDueRecurringInvoicesMutex.RUnlock()
+	return len(fake.listDueRecurringInvoicesArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoicesCalls(stub func(context.Context, time.Time, int) ([]domain.RecurringInvoice, error)) {
+	fake.listDueRecurringInvoicesMutex.Lock()
+	defer fake.listDueRecurringInvoicesMutex.Unlock()
+	fake.ListDueRecurringInvoicesStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoicesArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.listDueRecurringInvoicesMutex.RLock()
+	defer fake.listDueRecurringInvoicesMutex.RUnlock()
+	argsForCall := fake.listDueRecurringInvoicesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoicesReturns(result1 []domain.RecurringInvoice, result2 error) {
+	fake.listDueRecurringInvoicesMutex.Lock()
+	defer fake.listDueRecurringInvoicesMutex.Unlock()
+	fake.ListDueRecurringInvoicesStub = nil
+	fake.listDueRecurringInvoicesReturns = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListDueRecurringInvoicesReturnsOnCall(i int, result1 []domain.RecurringInvoice, result2 error) {
+	fake.listDueRecurringInvoicesMutex.Lock()
+	defer fake.listDueRecurringInvoicesMutex.Unlock()
+	fake.ListDueRecurringInvoicesStub = nil
+	if fake.listDueRecurringInvoicesReturnsOnCall == nil {
+		fake.listDueRecurringInvoicesReturnsOnCall = make(map[int]struct {
+			result1 []domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.listDueRecurringInvoicesReturnsOnCall[i] = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoices(arg1 context.Context, arg2 uuid.UUID) ([]domain.RecurringInvoice, error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	ret, specificReturn := fake.listRecurringInvoicesReturnsOnCall[len(fake.listRecurringInvoicesArgsForCall)]
+	fake.listRecurringInvoicesArgsForCall = append(fake.listRecurringInvoicesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListRecurringInvoicesStub
+	fakeReturns := fake.listRecurringInvoicesReturns
+	fake.recordInvocation("ListRecurringInvoices", []interface{}{arg1, arg2})
+	fake.listRecurringInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoicesCallCount() int {
+	fake.listRecurringInvoicesMutex.RLock()
+	defer fake.listRecurringInvoicesMutex.RUnlock()
+	return len(fake.listRecurringInvoicesArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoicesCalls(stub func(context.Context, uuid.UUID) ([]domain.RecurringInvoice, error)) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoicesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listRecurringInvoicesMutex.RLock()
+	defer fake.listRecurringInvoicesMutex.RUnlock()
+	argsForCall := fake.listRecurringInvoicesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoicesReturns(result1 []domain.RecurringInvoice, result2 error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = nil
+	fake.listRecurringInvoicesReturns = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) ListRecurringInvoicesReturnsOnCall(i int, result1 []domain.RecurringInvoice, result2 error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = nil
+	if fake.listRecurringInvoicesReturnsOnCall == nil {
+		fake.listRecurringInvoicesReturnsOnCall = make(map[int]struct {
+			result1 []domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.listRecurringInvoicesReturnsOnCall[i] = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoice(arg1 context.Context, arg2 domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.updateRecurringInvoiceReturnsOnCall[len(fake.updateRecurringInvoiceArgsForCall)]
+	fake.updateRecurringInvoiceArgsForCall = append(fake.updateRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.RecurringInvoice
+	}{arg1, arg2})
+	stub := fake.UpdateRecurringInvoiceStub
+	fakeReturns := fake.updateRecurringInvoiceReturns
+	fake.recordInvocation("UpdateRecurringInvoice", []interface{}{arg1, arg2})
+	fake.updateRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoiceCallCount() int {
+	fake.updateRecurringInvoiceMutex.RLock()
+	defer fake.updateRecurringInvoiceMutex.RUnlock()
+	return len(fake.updateRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoiceCalls(stub func(context.Context, domain.RecurringInvoice) (*domain.RecurringInvoice, error)) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoiceArgsForCall(i int) (context.Context, domain.RecurringInvoice) {
+	fake.updateRecurringInvoiceMutex.RLock()
+	defer fake.updateRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.updateRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = nil
+	fake.updateRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) UpdateRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = nil
+	if fake.updateRecurringInvoiceReturnsOnCall == nil {
+		fake.updateRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.updateRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRecurringInvoiceRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.RecurringInvoiceRepository = new(FakeRecurringInvoiceRepository)
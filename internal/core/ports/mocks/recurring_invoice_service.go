@@ -0,0 +1,700 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeRecurringInvoiceService struct {
+	CreateRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	createRecurringInvoiceMutex       sync.RWMutex
+	createRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.RecurringInvoice
+	}
+	createRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	createRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	GenerateRecurringInvoicesStub        func(context.Context) (int, error)
+	generateRecurringInvoicesMutex       sync.RWMutex
+	generateRecurringInvoicesArgsForCall []struct {
+		arg1 context.Context
+	}
+	generateRecurringInvoicesReturns struct {
+		result1 int
+		result2 error
+	}
+	generateRecurringInvoicesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	GetRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)
+	getRecurringInvoiceMutex       sync.RWMutex
+	getRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	getRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	ListRecurringInvoicesStub        func(context.Context, uuid.UUID, uuid.UUID) ([]domain.RecurringInvoice, error)
+	listRecurringInvoicesMutex       sync.RWMutex
+	listRecurringInvoicesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listRecurringInvoicesReturns struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	listRecurringInvoicesReturnsOnCall map[int]struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}
+	PauseRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)
+	pauseRecurringInvoiceMutex       sync.RWMutex
+	pauseRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	pauseRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	pauseRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	PreviewRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) ([]domain.Invoice, error)
+	previewRecurringInvoiceMutex       sync.RWMutex
+	previewRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 int
+	}
+	previewRecurringInvoiceReturns struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	previewRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 []domain.Invoice
+		result2 error
+	}
+	ResumeRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)
+	resumeRecurringInvoiceMutex       sync.RWMutex
+	resumeRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	resumeRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	resumeRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	UpdateRecurringInvoiceStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	updateRecurringInvoiceMutex       sync.RWMutex
+	updateRecurringInvoiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.RecurringInvoice
+	}
+	updateRecurringInvoiceReturns struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	updateRecurringInvoiceReturnsOnCall map[int]struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.createRecurringInvoiceReturnsOnCall[len(fake.createRecurringInvoiceArgsForCall)]
+	fake.createRecurringInvoiceArgsForCall = append(fake.createRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.RecurringInvoice
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateRecurringInvoiceStub
+	fakeReturns := fake.createRecurringInvoiceReturns
+	fake.recordInvocation("CreateRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoiceCallCount() int {
+	fake.createRecurringInvoiceMutex.RLock()
+	defer fake.createRecurringInvoiceMutex.RUnlock()
+	return len(fake.createRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.RecurringInvoice) (*domain.RecurringInvoice, error)) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.RecurringInvoice) {
+	fake.createRecurringInvoiceMutex.RLock()
+	defer fake.createRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.createRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = nil
+	fake.createRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) CreateRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.createRecurringInvoiceMutex.Lock()
+	defer fake.createRecurringInvoiceMutex.Unlock()
+	fake.CreateRecurringInvoiceStub = nil
+	if fake.createRecurringInvoiceReturnsOnCall == nil {
+		fake.createRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.createRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoices(arg1 context.Context) (int, error) {
+	fake.generateRecurringInvoicesMutex.Lock()
+	ret, specificReturn := fake.generateRecurringInvoicesReturnsOnCall[len(fake.generateRecurringInvoicesArgsForCall)]
+	fake.generateRecurringInvoicesArgsForCall = append(fake.generateRecurringInvoicesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GenerateRecurringInvoicesStub
+	fakeReturns := fake.generateRecurringInvoicesReturns
+	fake.recordInvocation("GenerateRecurringInvoices", []interface{}{arg1})
+	fake.generateRecurringInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoicesCallCount() int {
+	fake.generateRecurringInvoicesMutex.RLock()
+	defer fake.generateRecurringInvoicesMutex.RUnlock()
+	return len(fake.generateRecurringInvoicesArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoicesCalls(stub func(context.Context) (int, error)) {
+	fake.generateRecurringInvoicesMutex.Lock()
+	defer fake.generateRecurringInvoicesMutex.Unlock()
+	fake.GenerateRecurringInvoicesStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoicesArgsForCall(i int) context.Context {
+	fake.generateRecurringInvoicesMutex.RLock()
+	defer fake.generateRecurringInvoicesMutex.RUnlock()
+	argsForCall := fake.generateRecurringInvoicesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoicesReturns(result1 int, result2 error) {
+	fake.generateRecurringInvoicesMutex.Lock()
+	defer fake.generateRecurringInvoicesMutex.Unlock()
+	fake.GenerateRecurringInvoicesStub = nil
+	fake.generateRecurringInvoicesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) GenerateRecurringInvoicesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.generateRecurringInvoicesMutex.Lock()
+	defer fake.generateRecurringInvoicesMutex.Unlock()
+	fake.GenerateRecurringInvoicesStub = nil
+	if fake.generateRecurringInvoicesReturnsOnCall == nil {
+		fake.generateRecurringInvoicesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.generateRecurringInvoicesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.RecurringInvoice, error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.getRecurringInvoiceReturnsOnCall[len(fake.getRecurringInvoiceArgsForCall)]
+	fake.getRecurringInvoiceArgsForCall = append(fake.getRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetRecurringInvoiceStub
+	fakeReturns := fake.getRecurringInvoiceReturns
+	fake.recordInvocation("GetRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoiceCallCount() int {
+	fake.getRecurringInvoiceMutex.RLock()
+	defer fake.getRecurringInvoiceMutex.RUnlock()
+	return len(fake.getRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getRecurringInvoiceMutex.RLock()
+	defer fake.getRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.getRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = nil
+	fake.getRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) GetRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.getRecurringInvoiceMutex.Lock()
+	defer fake.getRecurringInvoiceMutex.Unlock()
+	fake.GetRecurringInvoiceStub = nil
+	if fake.getRecurringInvoiceReturnsOnCall == nil {
+		fake.getRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.getRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoices(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]domain.RecurringInvoice, error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	ret, specificReturn := fake.listRecurringInvoicesReturnsOnCall[len(fake.listRecurringInvoicesArgsForCall)]
+	fake.listRecurringInvoicesArgsForCall = append(fake.listRecurringInvoicesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListRecurringInvoicesStub
+	fakeReturns := fake.listRecurringInvoicesReturns
+	fake.recordInvocation("ListRecurringInvoices", []interface{}{arg1, arg2, arg3})
+	fake.listRecurringInvoicesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoicesCallCount() int {
+	fake.listRecurringInvoicesMutex.RLock()
+	defer fake.listRecurringInvoicesMutex.RUnlock()
+	return len(fake.listRecurringInvoicesArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoicesCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]domain.RecurringInvoice, error)) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoicesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listRecurringInvoicesMutex.RLock()
+	defer fake.listRecurringInvoicesMutex.RUnlock()
+	argsForCall := fake.listRecurringInvoicesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoicesReturns(result1 []domain.RecurringInvoice, result2 error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = nil
+	fake.listRecurringInvoicesReturns = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) ListRecurringInvoicesReturnsOnCall(i int, result1 []domain.RecurringInvoice, result2 error) {
+	fake.listRecurringInvoicesMutex.Lock()
+	defer fake.listRecurringInvoicesMutex.Unlock()
+	fake.ListRecurringInvoicesStub = nil
+	if fake.listRecurringInvoicesReturnsOnCall == nil {
+		fake.listRecurringInvoicesReturnsOnCall = make(map[int]struct {
+			result1 []domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.listRecurringInvoicesReturnsOnCall[i] = struct {
+		result1 []domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.RecurringInvoice, error) {
+	fake.pauseRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.pauseRecurringInvoiceReturnsOnCall[len(fake.pauseRecurringInvoiceArgsForCall)]
+	fake.pauseRecurringInvoiceArgsForCall = append(fake.pauseRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PauseRecurringInvoiceStub
+	fakeReturns := fake.pauseRecurringInvoiceReturns
+	fake.recordInvocation("PauseRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pauseRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoiceCallCount() int {
+	fake.pauseRecurringInvoiceMutex.RLock()
+	defer fake.pauseRecurringInvoiceMutex.RUnlock()
+	return len(fake.pauseRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)) {
+	fake.pauseRecurringInvoiceMutex.Lock()
+	defer fake.pauseRecurringInvoiceMutex.Unlock()
+	fake.PauseRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.pauseRecurringInvoiceMutex.RLock()
+	defer fake.pauseRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.pauseRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.pauseRecurringInvoiceMutex.Lock()
+	defer fake.pauseRecurringInvoiceMutex.Unlock()
+	fake.PauseRecurringInvoiceStub = nil
+	fake.pauseRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) PauseRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.pauseRecurringInvoiceMutex.Lock()
+	defer fake.pauseRecurringInvoiceMutex.Unlock()
+	fake.PauseRecurringInvoiceStub = nil
+	if fake.pauseRecurringInvoiceReturnsOnCall == nil {
+		fake.pauseRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.pauseRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 int) ([]domain.Invoice, error) {
+	fake.previewRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.previewRecurringInvoiceReturnsOnCall[len(fake.previewRecurringInvoiceArgsForCall)]
+	fake.previewRecurringInvoiceArgsForCall = append(fake.previewRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.PreviewRecurringInvoiceStub
+	fakeReturns := fake.previewRecurringInvoiceReturns
+	fake.recordInvocation("PreviewRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.previewRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoiceCallCount() int {
+	fake.previewRecurringInvoiceMutex.RLock()
+	defer fake.previewRecurringInvoiceMutex.RUnlock()
+	return len(fake.previewRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) ([]domain.Invoice, error)) {
+	fake.previewRecurringInvoiceMutex.Lock()
+	defer fake.previewRecurringInvoiceMutex.Unlock()
+	fake.PreviewRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) {
+	fake.previewRecurringInvoiceMutex.RLock()
+	defer fake.previewRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.previewRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoiceReturns(result1 []domain.Invoice, result2 error) {
+	fake.previewRecurringInvoiceMutex.Lock()
+	defer fake.previewRecurringInvoiceMutex.Unlock()
+	fake.PreviewRecurringInvoiceStub = nil
+	fake.previewRecurringInvoiceReturns = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) PreviewRecurringInvoiceReturnsOnCall(i int, result1 []domain.Invoice, result2 error) {
+	fake.previewRecurringInvoiceMutex.Lock()
+	defer fake.previewRecurringInvoiceMutex.Unlock()
+	fake.PreviewRecurringInvoiceStub = nil
+	if fake.previewRecurringInvoiceReturnsOnCall == nil {
+		fake.previewRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 []domain.Invoice
+			result2 error
+		})
+	}
+	fake.previewRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 []domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.RecurringInvoice, error) {
+	fake.resumeRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.resumeRecurringInvoiceReturnsOnCall[len(fake.resumeRecurringInvoiceArgsForCall)]
+	fake.resumeRecurringInvoiceArgsForCall = append(fake.resumeRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResumeRecurringInvoiceStub
+	fakeReturns := fake.resumeRecurringInvoiceReturns
+	fake.recordInvocation("ResumeRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resumeRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoiceCallCount() int {
+	fake.resumeRecurringInvoiceMutex.RLock()
+	defer fake.resumeRecurringInvoiceMutex.RUnlock()
+	return len(fake.resumeRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.RecurringInvoice, error)) {
+	fake.resumeRecurringInvoiceMutex.Lock()
+	defer fake.resumeRecurringInvoiceMutex.Unlock()
+	fake.ResumeRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.resumeRecurringInvoiceMutex.RLock()
+	defer fake.resumeRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.resumeRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.resumeRecurringInvoiceMutex.Lock()
+	defer fake.resumeRecurringInvoiceMutex.Unlock()
+	fake.ResumeRecurringInvoiceStub = nil
+	fake.resumeRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) ResumeRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.resumeRecurringInvoiceMutex.Lock()
+	defer fake.resumeRecurringInvoiceMutex.Unlock()
+	fake.ResumeRecurringInvoiceStub = nil
+	if fake.resumeRecurringInvoiceReturnsOnCall == nil {
+		fake.resumeRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.resumeRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoice(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	ret, specificReturn := fake.updateRecurringInvoiceReturnsOnCall[len(fake.updateRecurringInvoiceArgsForCall)]
+	fake.updateRecurringInvoiceArgsForCall = append(fake.updateRecurringInvoiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.RecurringInvoice
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateRecurringInvoiceStub
+	fakeReturns := fake.updateRecurringInvoiceReturns
+	fake.recordInvocation("UpdateRecurringInvoice", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateRecurringInvoiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoiceCallCount() int {
+	fake.updateRecurringInvoiceMutex.RLock()
+	defer fake.updateRecurringInvoiceMutex.RUnlock()
+	return len(fake.updateRecurringInvoiceArgsForCall)
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoiceCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.RecurringInvoice) (*domain.RecurringInvoice, error)) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = stub
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoiceArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.RecurringInvoice) {
+	fake.updateRecurringInvoiceMutex.RLock()
+	defer fake.updateRecurringInvoiceMutex.RUnlock()
+	argsForCall := fake.updateRecurringInvoiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoiceReturns(result1 *domain.RecurringInvoice, result2 error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = nil
+	fake.updateRecurringInvoiceReturns = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) UpdateRecurringInvoiceReturnsOnCall(i int, result1 *domain.RecurringInvoice, result2 error) {
+	fake.updateRecurringInvoiceMutex.Lock()
+	defer fake.updateRecurringInvoiceMutex.Unlock()
+	fake.UpdateRecurringInvoiceStub = nil
+	if fake.updateRecurringInvoiceReturnsOnCall == nil {
+		fake.updateRecurringInvoiceReturnsOnCall = make(map[int]struct {
+			result1 *domain.RecurringInvoice
+			result2 error
+		})
+	}
+	fake.updateRecurringInvoiceReturnsOnCall[i] = struct {
+		result1 *domain.RecurringInvoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecurringInvoiceService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRecurringInvoiceService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.RecurringInvoiceService = new(FakeRecurringInvoiceService)
@@ -0,0 +1,281 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeSecurityRepository struct {
+	GetRecentLoginsByUserIDStub        func(context.Context, uuid.UUID, int) ([]domain.SecurityEvent, error)
+	getRecentLoginsByUserIDMutex       sync.RWMutex
+	getRecentLoginsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}
+	getRecentLoginsByUserIDReturns struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}
+	getRecentLoginsByUserIDReturnsOnCall map[int]struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}
+	GetSecurityEventsByUserIDStub        func(context.Context, uuid.UUID, string, time.Time, time.Time) ([]domain.SecurityEvent, error)
+	getSecurityEventsByUserIDMutex       sync.RWMutex
+	getSecurityEventsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 time.Time
+		arg5 time.Time
+	}
+	getSecurityEventsByUserIDReturns struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}
+	getSecurityEventsByUserIDReturnsOnCall map[int]struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}
+	LogSecurityEventStub        func(context.Context, domain.SecurityEvent) error
+	logSecurityEventMutex       sync.RWMutex
+	logSecurityEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SecurityEvent
+	}
+	logSecurityEventReturns struct {
+		result1 error
+	}
+	logSecurityEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserID(arg1 context.Context, arg2 uuid.UUID, arg3 int) ([]domain.SecurityEvent, error) {
+	fake.getRecentLoginsByUserIDMutex.Lock()
+	ret, specificReturn := fake.getRecentLoginsByUserIDReturnsOnCall[len(fake.getRecentLoginsByUserIDArgsForCall)]
+	fake.getRecentLoginsByUserIDArgsForCall = append(fake.getRecentLoginsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetRecentLoginsByUserIDStub
+	fakeReturns := fake.getRecentLoginsByUserIDReturns
+	fake.recordInvocation("GetRecentLoginsByUserID", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLoginsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserIDCallCount() int {
+	fake.getRecentLoginsByUserIDMutex.RLock()
+	defer fake.getRecentLoginsByUserIDMutex.RUnlock()
+	return len(fake.getRecentLoginsByUserIDArgsForCall)
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserIDCalls(stub func(context.Context, uuid.UUID, int) ([]domain.SecurityEvent, error)) {
+	fake.getRecentLoginsByUserIDMutex.Lock()
+	defer fake.getRecentLoginsByUserIDMutex.Unlock()
+	fake.GetRecentLoginsByUserIDStub = stub
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserIDArgsForCall(i int) (context.Context, uuid.UUID, int) {
+	fake.getRecentLoginsByUserIDMutex.RLock()
+	defer fake.getRecentLoginsByUserIDMutex.RUnlock()
+	argsForCall := fake.getRecentLoginsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserIDReturns(result1 []domain.SecurityEvent, result2 error) {
+	fake.getRecentLoginsByUserIDMutex.Lock()
+	defer fake.getRecentLoginsByUserIDMutex.Unlock()
+	fake.GetRecentLoginsByUserIDStub = nil
+	fake.getRecentLoginsByUserIDReturns = struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecurityRepository) GetRecentLoginsByUserIDReturnsOnCall(i int, result1 []domain.SecurityEvent, result2 error) {
+	fake.getRecentLoginsByUserIDMutex.Lock()
+	defer fake.getRecentLoginsByUserIDMutex.Unlock()
+	fake.GetRecentLoginsByUserIDStub = nil
+	if fake.getRecentLoginsByUserIDReturnsOnCall == nil {
+		fake.getRecentLoginsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []domain.SecurityEvent
+			result2 error
+		})
+	}
+	fake.getRecentLoginsByUserIDReturnsOnCall[i] = struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserID(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 time.Time, arg5 time.Time) ([]domain.SecurityEvent, error) {
+	fake.getSecurityEventsByUserIDMutex.Lock()
+	ret, specificReturn := fake.getSecurityEventsByUserIDReturnsOnCall[len(fake.getSecurityEventsByUserIDArgsForCall)]
+	fake.getSecurityEventsByUserIDArgsForCall = append(fake.getSecurityEventsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 time.Time
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetSecurityEventsByUserIDStub
+	fakeReturns := fake.getSecurityEventsByUserIDReturns
+	fake.recordInvocation("GetSecurityEventsByUserID", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getSecurityEventsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserIDCallCount() int {
+	fake.getSecurityEventsByUserIDMutex.RLock()
+	defer fake.getSecurityEventsByUserIDMutex.RUnlock()
+	return len(fake.getSecurityEventsByUserIDArgsForCall)
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserIDCalls(stub func(context.Context, uuid.UUID, string, time.Time, time.Time) ([]domain.SecurityEvent, error)) {
+	fake.getSecurityEventsByUserIDMutex.Lock()
+	defer fake.getSecurityEventsByUserIDMutex.Unlock()
+	fake.GetSecurityEventsByUserIDStub = stub
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserIDArgsForCall(i int) (context.Context, uuid.UUID, string, time.Time, time.Time) {
+	fake.getSecurityEventsByUserIDMutex.RLock()
+	defer fake.getSecurityEventsByUserIDMutex.RUnlock()
+	argsForCall := fake.getSecurityEventsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserIDReturns(result1 []domain.SecurityEvent, result2 error) {
+	fake.getSecurityEventsByUserIDMutex.Lock()
+	defer fake.getSecurityEventsByUserIDMutex.Unlock()
+	fake.GetSecurityEventsByUserIDStub = nil
+	fake.getSecurityEventsByUserIDReturns = struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecurityRepository) GetSecurityEventsByUserIDReturnsOnCall(i int, result1 []domain.SecurityEvent, result2 error) {
+	fake.getSecurityEventsByUserIDMutex.Lock()
+	defer fake.getSecurityEventsByUserIDMutex.Unlock()
+	fake.GetSecurityEventsByUserIDStub = nil
+	if fake.getSecurityEventsByUserIDReturnsOnCall == nil {
+		fake.getSecurityEventsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []domain.SecurityEvent
+			result2 error
+		})
+	}
+	fake.getSecurityEventsByUserIDReturnsOnCall[i] = struct {
+		result1 []domain.SecurityEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEvent(arg1 context.Context, arg2 domain.SecurityEvent) error {
+	fake.logSecurityEventMutex.Lock()
+	ret, specificReturn := fake.logSecurityEventReturnsOnCall[len(fake.logSecurityEventArgsForCall)]
+	fake.logSecurityEventArgsForCall = append(fake.logSecurityEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SecurityEvent
+	}{arg1, arg2})
+	stub := fake.LogSecurityEventStub
+	fakeReturns := fake.logSecurityEventReturns
+	fake.recordInvocation("LogSecurityEvent", []interface{}{arg1, arg2})
+	fake.logSecurityEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEventCallCount() int {
+	fake.logSecurityEventMutex.RLock()
+	defer fake.logSecurityEventMutex.RUnlock()
+	return len(fake.logSecurityEventArgsForCall)
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEventCalls(stub func(context.Context, domain.SecurityEvent) error) {
+	fake.logSecurityEventMutex.Lock()
+	defer fake.logSecurityEventMutex.Unlock()
+	fake.LogSecurityEventStub = stub
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEventArgsForCall(i int) (context.Context, domain.SecurityEvent) {
+	fake.logSecurityEventMutex.RLock()
+	defer fake.logSecurityEventMutex.RUnlock()
+	argsForCall := fake.logSecurityEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEventReturns(result1 error) {
+	fake.logSecurityEventMutex.Lock()
+	defer fake.logSecurityEventMutex.Unlock()
+	fake.LogSecurityEventStub = nil
+	fake.logSecurityEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecurityRepository) LogSecurityEventReturnsOnCall(i int, result1 error) {
+	fake.logSecurityEventMutex.Lock()
+	defer fake.logSecurityEventMutex.Unlock()
+	fake.LogSecurityEventStub = nil
+	if fake.logSecurityEventReturnsOnCall == nil {
+		fake.logSecurityEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logSecurityEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecurityRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSecurityRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.SecurityRepository = new(FakeSecurityRepository)
@@ -0,0 +1,518 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeSupportedAssetRepository struct {
+	CreateAssetStub        func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)
+	createAssetMutex       sync.RWMutex
+	createAssetArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}
+	createAssetReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	createAssetReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	GetAssetByContractStub        func(context.Context, string, string) (*domain.SupportedAsset, error)
+	getAssetByContractMutex       sync.RWMutex
+	getAssetByContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getAssetByContractReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	getAssetByContractReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	GetAssetByIDStub        func(context.Context, uuid.UUID) (*domain.SupportedAsset, error)
+	getAssetByIDMutex       sync.RWMutex
+	getAssetByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getAssetByIDReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	getAssetByIDReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	GetAssetBySymbolStub        func(context.Context, string, string) (*domain.SupportedAsset, error)
+	getAssetBySymbolMutex       sync.RWMutex
+	getAssetBySymbolArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getAssetBySymbolReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	getAssetBySymbolReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	ListAssetsStub        func(context.Context, domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)
+	listAssetsMutex       sync.RWMutex
+	listAssetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SupportedAssetFilter
+	}
+	listAssetsReturns struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}
+	listAssetsReturnsOnCall map[int]struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}
+	UpdateAssetStub        func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)
+	updateAssetMutex       sync.RWMutex
+	updateAssetArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}
+	updateAssetReturns struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	updateAssetReturnsOnCall map[int]struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAsset(arg1 context.Context, arg2 domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	fake.createAssetMutex.Lock()
+	ret, specificReturn := fake.createAssetReturnsOnCall[len(fake.createAssetArgsForCall)]
+	fake.createAssetArgsForCall = append(fake.createAssetArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}{arg1, arg2})
+	stub := fake.CreateAssetStub
+	fakeReturns := fake.createAssetReturns
+	fake.recordInvocation("CreateAsset", []interface{}{arg1, arg2})
+	fake.createAssetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAssetCallCount() int {
+	fake.createAssetMutex.RLock()
+	defer fake.createAssetMutex.RUnlock()
+	return len(fake.createAssetArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAssetCalls(stub func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAssetArgsForCall(i int) (context.Context, domain.SupportedAsset) {
+	fake.createAssetMutex.RLock()
+	defer fake.createAssetMutex.RUnlock()
+	argsForCall := fake.createAssetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAssetReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = nil
+	fake.createAssetReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) CreateAssetReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.createAssetMutex.Lock()
+	defer fake.createAssetMutex.Unlock()
+	fake.CreateAssetStub = nil
+	if fake.createAssetReturnsOnCall == nil {
+		fake.createAssetReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.createAssetReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContract(arg1 context.Context, arg2 string, arg3 string) (*domain.SupportedAsset, error) {
+	fake.getAssetByContractMutex.Lock()
+	ret, specificReturn := fake.getAssetByContractReturnsOnCall[len(fake.getAssetByContractArgsForCall)]
+	fake.getAssetByContractArgsForCall = append(fake.getAssetByContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetAssetByContractStub
+	fakeReturns := fake.getAssetByContractReturns
+	fake.recordInvocation("GetAssetByContract", []interface{}{arg1, arg2, arg3})
+	fake.getAssetByContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContractCallCount() int {
+	fake.getAssetByContractMutex.RLock()
+	defer fake.getAssetByContractMutex.RUnlock()
+	return len(fake.getAssetByContractArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContractCalls(stub func(context.Context, string, string) (*domain.SupportedAsset, error)) {
+	fake.getAssetByContractMutex.Lock()
+	defer fake.getAssetByContractMutex.Unlock()
+	fake.GetAssetByContractStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContractArgsForCall(i int) (context.Context, string, string) {
+	fake.getAssetByContractMutex.RLock()
+	defer fake.getAssetByContractMutex.RUnlock()
+	argsForCall := fake.getAssetByContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContractReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetByContractMutex.Lock()
+	defer fake.getAssetByContractMutex.Unlock()
+	fake.GetAssetByContractStub = nil
+	fake.getAssetByContractReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByContractReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetByContractMutex.Lock()
+	defer fake.getAssetByContractMutex.Unlock()
+	fake.GetAssetByContractStub = nil
+	if fake.getAssetByContractReturnsOnCall == nil {
+		fake.getAssetByContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.getAssetByContractReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByID(arg1 context.Context, arg2 uuid.UUID) (*domain.SupportedAsset, error) {
+	fake.getAssetByIDMutex.Lock()
+	ret, specificReturn := fake.getAssetByIDReturnsOnCall[len(fake.getAssetByIDArgsForCall)]
+	fake.getAssetByIDArgsForCall = append(fake.getAssetByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetAssetByIDStub
+	fakeReturns := fake.getAssetByIDReturns
+	fake.recordInvocation("GetAssetByID", []interface{}{arg1, arg2})
+	fake.getAssetByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByIDCallCount() int {
+	fake.getAssetByIDMutex.RLock()
+	defer fake.getAssetByIDMutex.RUnlock()
+	return len(fake.getAssetByIDArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByIDCalls(stub func(context.Context, uuid.UUID) (*domain.SupportedAsset, error)) {
+	fake.getAssetByIDMutex.Lock()
+	defer fake.getAssetByIDMutex.Unlock()
+	fake.GetAssetByIDStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getAssetByIDMutex.RLock()
+	defer fake.getAssetByIDMutex.RUnlock()
+	argsForCall := fake.getAssetByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByIDReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetByIDMutex.Lock()
+	defer fake.getAssetByIDMutex.Unlock()
+	fake.GetAssetByIDStub = nil
+	fake.getAssetByIDReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetByIDReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetByIDMutex.Lock()
+	defer fake.getAssetByIDMutex.Unlock()
+	fake.GetAssetByIDStub = nil
+	if fake.getAssetByIDReturnsOnCall == nil {
+		fake.getAssetByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.getAssetByIDReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbol(arg1 context.Context, arg2 string, arg3 string) (*domain.SupportedAsset, error) {
+	fake.getAssetBySymbolMutex.Lock()
+	ret, specificReturn := fake.getAssetBySymbolReturnsOnCall[len(fake.getAssetBySymbolArgsForCall)]
+	fake.getAssetBySymbolArgsForCall = append(fake.getAssetBySymbolArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetAssetBySymbolStub
+	fakeReturns := fake.getAssetBySymbolReturns
+	fake.recordInvocation("GetAssetBySymbol", []interface{}{arg1, arg2, arg3})
+	fake.getAssetBySymbolMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbolCallCount() int {
+	fake.getAssetBySymbolMutex.RLock()
+	defer fake.getAssetBySymbolMutex.RUnlock()
+	return len(fake.getAssetBySymbolArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbolCalls(stub func(context.Context, string, string) (*domain.SupportedAsset, error)) {
+	fake.getAssetBySymbolMutex.Lock()
+	defer fake.getAssetBySymbolMutex.Unlock()
+	fake.GetAssetBySymbolStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbolArgsForCall(i int) (context.Context, string, string) {
+	fake.getAssetBySymbolMutex.RLock()
+	defer fake.getAssetBySymbolMutex.RUnlock()
+	argsForCall := fake.getAssetBySymbolArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbolReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetBySymbolMutex.Lock()
+	defer fake.getAssetBySymbolMutex.Unlock()
+	fake.GetAssetBySymbolStub = nil
+	fake.getAssetBySymbolReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) GetAssetBySymbolReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.getAssetBySymbolMutex.Lock()
+	defer fake.getAssetBySymbolMutex.Unlock()
+	fake.GetAssetBySymbolStub = nil
+	if fake.getAssetBySymbolReturnsOnCall == nil {
+		fake.getAssetBySymbolReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.getAssetBySymbolReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssets(arg1 context.Context, arg2 domain.SupportedAssetFilter) ([]domain.SupportedAsset, error) {
+	fake.listAssetsMutex.Lock()
+	ret, specificReturn := fake.listAssetsReturnsOnCall[len(fake.listAssetsArgsForCall)]
+	fake.listAssetsArgsForCall = append(fake.listAssetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SupportedAssetFilter
+	}{arg1, arg2})
+	stub := fake.ListAssetsStub
+	fakeReturns := fake.listAssetsReturns
+	fake.recordInvocation("ListAssets", []interface{}{arg1, arg2})
+	fake.listAssetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssetsCallCount() int {
+	fake.listAssetsMutex.RLock()
+	defer fake.listAssetsMutex.RUnlock()
+	return len(fake.listAssetsArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssetsCalls(stub func(context.Context, domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssetsArgsForCall(i int) (context.Context, domain.SupportedAssetFilter) {
+	fake.listAssetsMutex.RLock()
+	defer fake.listAssetsMutex.RUnlock()
+	argsForCall := fake.listAssetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssetsReturns(result1 []domain.SupportedAsset, result2 error) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = nil
+	fake.listAssetsReturns = struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) ListAssetsReturnsOnCall(i int, result1 []domain.SupportedAsset, result2 error) {
+	fake.listAssetsMutex.Lock()
+	defer fake.listAssetsMutex.Unlock()
+	fake.ListAssetsStub = nil
+	if fake.listAssetsReturnsOnCall == nil {
+		fake.listAssetsReturnsOnCall = make(map[int]struct {
+			result1 []domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.listAssetsReturnsOnCall[i] = struct {
+		result1 []domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAsset(arg1 context.Context, arg2 domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	fake.updateAssetMutex.Lock()
+	ret, specificReturn := fake.updateAssetReturnsOnCall[len(fake.updateAssetArgsForCall)]
+	fake.updateAssetArgsForCall = append(fake.updateAssetArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.SupportedAsset
+	}{arg1, arg2})
+	stub := fake.UpdateAssetStub
+	fakeReturns := fake.updateAssetReturns
+	fake.recordInvocation("UpdateAsset", []interface{}{arg1, arg2})
+	fake.updateAssetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAssetCallCount() int {
+	fake.updateAssetMutex.RLock()
+	defer fake.updateAssetMutex.RUnlock()
+	return len(fake.updateAssetArgsForCall)
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAssetCalls(stub func(context.Context, domain.SupportedAsset) (*domain.SupportedAsset, error)) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = stub
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAssetArgsForCall(i int) (context.Context, domain.SupportedAsset) {
+	fake.updateAssetMutex.RLock()
+	defer fake.updateAssetMutex.RUnlock()
+	argsForCall := fake.updateAssetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAssetReturns(result1 *domain.SupportedAsset, result2 error) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = nil
+	fake.updateAssetReturns = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) UpdateAssetReturnsOnCall(i int, result1 *domain.SupportedAsset, result2 error) {
+	fake.updateAssetMutex.Lock()
+	defer fake.updateAssetMutex.Unlock()
+	fake.UpdateAssetStub = nil
+	if fake.updateAssetReturnsOnCall == nil {
+		fake.updateAssetReturnsOnCall = make(map[int]struct {
+			result1 *domain.SupportedAsset
+			result2 error
+		})
+	}
+	fake.updateAssetReturnsOnCall[i] = struct {
+		result1 *domain.SupportedAsset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSupportedAssetRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSupportedAssetRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.SupportedAssetRepository = new(FakeSupportedAssetRepository)
@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeTaxService struct {
+	DetermineStub        func(context.Context, domain.TaxParty, domain.TaxParty, time.Time) (*domain.TaxDetermination, error)
+	determineMutex       sync.RWMutex
+	determineArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.TaxParty
+		arg3 domain.TaxParty
+		arg4 time.Time
+	}
+	determineReturns struct {
+		result1 *domain.TaxDetermination
+		result2 error
+	}
+	determineReturnsOnCall map[int]struct {
+		result1 *domain.TaxDetermination
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaxService) Determine(arg1 context.Context, arg2 domain.TaxParty, arg3 domain.TaxParty, arg4 time.Time) (*domain.TaxDetermination, error) {
+	fake.determineMutex.Lock()
+	ret, specificReturn := fake.determineReturnsOnCall[len(fake.determineArgsForCall)]
+	fake.determineArgsForCall = append(fake.determineArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.TaxParty
+		arg3 domain.TaxParty
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DetermineStub
+	fakeReturns := fake.determineReturns
+	fake.recordInvocation("Determine", []interface{}{arg1, arg2, arg3, arg4})
+	fake.determineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaxService) DetermineCallCount() int {
+	fake.determineMutex.RLock()
+	defer fake.determineMutex.RUnlock()
+	return len(fake.determineArgsForCall)
+}
+
+func (fake *FakeTaxService) DetermineCalls(stub func(context.Context, domain.TaxParty, domain.TaxParty, time.Time) (*domain.TaxDetermination, error)) {
+	fake.determineMutex.Lock()
+	defer fake.determineMutex.Unlock()
+	fake.DetermineStub = stub
+}
+
+func (fake *FakeTaxService) DetermineArgsForCall(i int) (context.Context, domain.TaxParty, domain.TaxParty, time.Time) {
+	fake.determineMutex.RLock()
+	defer fake.determineMutex.RUnlock()
+	argsForCall := fake.determineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTaxService) DetermineReturns(result1 *domain.TaxDetermination, result2 error) {
+	fake.determineMutex.Lock()
+	defer fake.determineMutex.Unlock()
+	fake.DetermineStub = nil
+	fake.determineReturns = struct {
+		result1 *domain.TaxDetermination
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaxService) DetermineReturnsOnCall(i int, result1 *domain.TaxDetermination, result2 error) {
+	fake.determineMutex.Lock()
+	defer fake.determineMutex.Unlock()
+	fake.DetermineStub = nil
+	if fake.determineReturnsOnCall == nil {
+		fake.determineReturnsOnCall = make(map[int]struct {
+			result1 *domain.TaxDetermination
+			result2 error
+		})
+	}
+	fake.determineReturnsOnCall[i] = struct {
+		result1 *domain.TaxDetermination
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaxService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaxService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TaxService = new(FakeTaxService)
@@ -0,0 +1,779 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTimesheetRepository struct {
+	CreateContractorContractStub        func(context.Context, domain.ContractorContract) (*domain.ContractorContract, error)
+	createContractorContractMutex       sync.RWMutex
+	createContractorContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ContractorContract
+	}
+	createContractorContractReturns struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	createContractorContractReturnsOnCall map[int]struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	GetContractorContractStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.ContractorContract, error)
+	getContractorContractMutex       sync.RWMutex
+	getContractorContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getContractorContractReturns struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	getContractorContractReturnsOnCall map[int]struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	GetTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)
+	getTimesheetMutex       sync.RWMutex
+	getTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	getTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	ListContractorContractsStub        func(context.Context, uuid.UUID, *uuid.UUID) ([]domain.ContractorContract, error)
+	listContractorContractsMutex       sync.RWMutex
+	listContractorContractsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *uuid.UUID
+	}
+	listContractorContractsReturns struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}
+	listContractorContractsReturnsOnCall map[int]struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}
+	ListTimesheetsStub        func(context.Context, uuid.UUID, domain.TimesheetFilter, int, int) ([]domain.Timesheet, int64, error)
+	listTimesheetsMutex       sync.RWMutex
+	listTimesheetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TimesheetFilter
+		arg4 int
+		arg5 int
+	}
+	listTimesheetsReturns struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}
+	listTimesheetsReturnsOnCall map[int]struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}
+	ReviewTimesheetStub        func(context.Context, uuid.UUID, domain.TimesheetStatus, uuid.UUID, time.Time, string) (*domain.Timesheet, error)
+	reviewTimesheetMutex       sync.RWMutex
+	reviewTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TimesheetStatus
+		arg4 uuid.UUID
+		arg5 time.Time
+		arg6 string
+	}
+	reviewTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	reviewTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	SaveTimesheetStub        func(context.Context, domain.Timesheet) (*domain.Timesheet, error)
+	saveTimesheetMutex       sync.RWMutex
+	saveTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Timesheet
+	}
+	saveTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	saveTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	SubmitTimesheetStub        func(context.Context, uuid.UUID, time.Time) (*domain.Timesheet, error)
+	submitTimesheetMutex       sync.RWMutex
+	submitTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	submitTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	submitTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	UpdateContractorContractStub        func(context.Context, domain.ContractorContract) (*domain.ContractorContract, error)
+	updateContractorContractMutex       sync.RWMutex
+	updateContractorContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.ContractorContract
+	}
+	updateContractorContractReturns struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	updateContractorContractReturnsOnCall map[int]struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContract(arg1 context.Context, arg2 domain.ContractorContract) (*domain.ContractorContract, error) {
+	fake.createContractorContractMutex.Lock()
+	ret, specificReturn := fake.createContractorContractReturnsOnCall[len(fake.createContractorContractArgsForCall)]
+	fake.createContractorContractArgsForCall = append(fake.createContractorContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ContractorContract
+	}{arg1, arg2})
+	stub := fake.CreateContractorContractStub
+	fakeReturns := fake.createContractorContractReturns
+	fake.recordInvocation("CreateContractorContract", []interface{}{arg1, arg2})
+	fake.createContractorContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContractCallCount() int {
+	fake.createContractorContractMutex.RLock()
+	defer fake.createContractorContractMutex.RUnlock()
+	return len(fake.createContractorContractArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContractCalls(stub func(context.Context, domain.ContractorContract) (*domain.ContractorContract, error)) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = stub
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContractArgsForCall(i int) (context.Context, domain.ContractorContract) {
+	fake.createContractorContractMutex.RLock()
+	defer fake.createContractorContractMutex.RUnlock()
+	argsForCall := fake.createContractorContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContractReturns(result1 *domain.ContractorContract, result2 error) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = nil
+	fake.createContractorContractReturns = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) CreateContractorContractReturnsOnCall(i int, result1 *domain.ContractorContract, result2 error) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = nil
+	if fake.createContractorContractReturnsOnCall == nil {
+		fake.createContractorContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.createContractorContractReturnsOnCall[i] = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContract(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.ContractorContract, error) {
+	fake.getContractorContractMutex.Lock()
+	ret, specificReturn := fake.getContractorContractReturnsOnCall[len(fake.getContractorContractArgsForCall)]
+	fake.getContractorContractArgsForCall = append(fake.getContractorContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetContractorContractStub
+	fakeReturns := fake.getContractorContractReturns
+	fake.recordInvocation("GetContractorContract", []interface{}{arg1, arg2, arg3})
+	fake.getContractorContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContractCallCount() int {
+	fake.getContractorContractMutex.RLock()
+	defer fake.getContractorContractMutex.RUnlock()
+	return len(fake.getContractorContractArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContractCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.ContractorContract, error)) {
+	fake.getContractorContractMutex.Lock()
+	defer fake.getContractorContractMutex.Unlock()
+	fake.GetContractorContractStub = stub
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContractArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getContractorContractMutex.RLock()
+	defer fake.getContractorContractMutex.RUnlock()
+	argsForCall := fake.getContractorContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContractReturns(result1 *domain.ContractorContract, result2 error) {
+	fake.getContractorContractMutex.Lock()
+	defer fake.getContractorContractMutex.Unlock()
+	fake.GetContractorContractStub = nil
+	fake.getContractorContractReturns = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) GetContractorContractReturnsOnCall(i int, result1 *domain.ContractorContract, result2 error) {
+	fake.getContractorContractMutex.Lock()
+	defer fake.getContractorContractMutex.Unlock()
+	fake.GetContractorContractStub = nil
+	if fake.getContractorContractReturnsOnCall == nil {
+		fake.getContractorContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.getContractorContractReturnsOnCall[i] = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.Timesheet, error) {
+	fake.getTimesheetMutex.Lock()
+	ret, specificReturn := fake.getTimesheetReturnsOnCall[len(fake.getTimesheetArgsForCall)]
+	fake.getTimesheetArgsForCall = append(fake.getTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetTimesheetStub
+	fakeReturns := fake.getTimesheetReturns
+	fake.recordInvocation("GetTimesheet", []interface{}{arg1, arg2, arg3})
+	fake.getTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheetCallCount() int {
+	fake.getTimesheetMutex.RLock()
+	defer fake.getTimesheetMutex.RUnlock()
+	return len(fake.getTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getTimesheetMutex.RLock()
+	defer fake.getTimesheetMutex.RUnlock()
+	argsForCall := fake.getTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = nil
+	fake.getTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) GetTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = nil
+	if fake.getTimesheetReturnsOnCall == nil {
+		fake.getTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.getTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContracts(arg1 context.Context, arg2 uuid.UUID, arg3 *uuid.UUID) ([]domain.ContractorContract, error) {
+	fake.listContractorContractsMutex.Lock()
+	ret, specificReturn := fake.listContractorContractsReturnsOnCall[len(fake.listContractorContractsArgsForCall)]
+	fake.listContractorContractsArgsForCall = append(fake.listContractorContractsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListContractorContractsStub
+	fakeReturns := fake.listContractorContractsReturns
+	fake.recordInvocation("ListContractorContracts", []interface{}{arg1, arg2, arg3})
+	fake.listContractorContractsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContractsCallCount() int {
+	fake.listContractorContractsMutex.RLock()
+	defer fake.listContractorContractsMutex.RUnlock()
+	return len(fake.listContractorContractsArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContractsCalls(stub func(context.Context, uuid.UUID, *uuid.UUID) ([]domain.ContractorContract, error)) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = stub
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContractsArgsForCall(i int) (context.Context, uuid.UUID, *uuid.UUID) {
+	fake.listContractorContractsMutex.RLock()
+	defer fake.listContractorContractsMutex.RUnlock()
+	argsForCall := fake.listContractorContractsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContractsReturns(result1 []domain.ContractorContract, result2 error) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = nil
+	fake.listContractorContractsReturns = struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) ListContractorContractsReturnsOnCall(i int, result1 []domain.ContractorContract, result2 error) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = nil
+	if fake.listContractorContractsReturnsOnCall == nil {
+		fake.listContractorContractsReturnsOnCall = make(map[int]struct {
+			result1 []domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.listContractorContractsReturnsOnCall[i] = struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheets(arg1 context.Context, arg2 uuid.UUID, arg3 domain.TimesheetFilter, arg4 int, arg5 int) ([]domain.Timesheet, int64, error) {
+	fake.listTimesheetsMutex.Lock()
+	ret, specificReturn := fake.listTimesheetsReturnsOnCall[len(fake.listTimesheetsArgsForCall)]
+	fake.listTimesheetsArgsForCall = append(fake.listTimesheetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TimesheetFilter
+		arg4 int
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListTimesheetsStub
+	fakeReturns := fake.listTimesheetsReturns
+	fake.recordInvocation("ListTimesheets", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listTimesheetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheetsCallCount() int {
+	fake.listTimesheetsMutex.RLock()
+	defer fake.listTimesheetsMutex.RUnlock()
+	return len(fake.listTimesheetsArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheetsCalls(stub func(context.Context, uuid.UUID, domain.TimesheetFilter, int, int) ([]domain.Timesheet, int64, error)) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = stub
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheetsArgsForCall(i int) (context.Context, uuid.UUID, domain.TimesheetFilter, int, int) {
+	fake.listTimesheetsMutex.RLock()
+	defer fake.listTimesheetsMutex.RUnlock()
+	argsForCall := fake.listTimesheetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheetsReturns(result1 []domain.Timesheet, result2 int64, result3 error) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = nil
+	fake.listTimesheetsReturns = struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTimesheetRepository) ListTimesheetsReturnsOnCall(i int, result1 []domain.Timesheet, result2 int64, result3 error) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = nil
+	if fake.listTimesheetsReturnsOnCall == nil {
+		fake.listTimesheetsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Timesheet
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listTimesheetsReturnsOnCall[i] = struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 domain.TimesheetStatus, arg4 uuid.UUID, arg5 time.Time, arg6 string) (*domain.Timesheet, error) {
+	fake.reviewTimesheetMutex.Lock()
+	ret, specificReturn := fake.reviewTimesheetReturnsOnCall[len(fake.reviewTimesheetArgsForCall)]
+	fake.reviewTimesheetArgsForCall = append(fake.reviewTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TimesheetStatus
+		arg4 uuid.UUID
+		arg5 time.Time
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ReviewTimesheetStub
+	fakeReturns := fake.reviewTimesheetReturns
+	fake.recordInvocation("ReviewTimesheet", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.reviewTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheetCallCount() int {
+	fake.reviewTimesheetMutex.RLock()
+	defer fake.reviewTimesheetMutex.RUnlock()
+	return len(fake.reviewTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheetCalls(stub func(context.Context, uuid.UUID, domain.TimesheetStatus, uuid.UUID, time.Time, string) (*domain.Timesheet, error)) {
+	fake.reviewTimesheetMutex.Lock()
+	defer fake.reviewTimesheetMutex.Unlock()
+	fake.ReviewTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheetArgsForCall(i int) (context.Context, uuid.UUID, domain.TimesheetStatus, uuid.UUID, time.Time, string) {
+	fake.reviewTimesheetMutex.RLock()
+	defer fake.reviewTimesheetMutex.RUnlock()
+	argsForCall := fake.reviewTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.reviewTimesheetMutex.Lock()
+	defer fake.reviewTimesheetMutex.Unlock()
+	fake.ReviewTimesheetStub = nil
+	fake.reviewTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) ReviewTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.reviewTimesheetMutex.Lock()
+	defer fake.reviewTimesheetMutex.Unlock()
+	fake.ReviewTimesheetStub = nil
+	if fake.reviewTimesheetReturnsOnCall == nil {
+		fake.reviewTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.reviewTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheet(arg1 context.Context, arg2 domain.Timesheet) (*domain.Timesheet, error) {
+	fake.saveTimesheetMutex.Lock()
+	ret, specificReturn := fake.saveTimesheetReturnsOnCall[len(fake.saveTimesheetArgsForCall)]
+	fake.saveTimesheetArgsForCall = append(fake.saveTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Timesheet
+	}{arg1, arg2})
+	stub := fake.SaveTimesheetStub
+	fakeReturns := fake.saveTimesheetReturns
+	fake.recordInvocation("SaveTimesheet", []interface{}{arg1, arg2})
+	fake.saveTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheetCallCount() int {
+	fake.saveTimesheetMutex.RLock()
+	defer fake.saveTimesheetMutex.RUnlock()
+	return len(fake.saveTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheetCalls(stub func(context.Context, domain.Timesheet) (*domain.Timesheet, error)) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheetArgsForCall(i int) (context.Context, domain.Timesheet) {
+	fake.saveTimesheetMutex.RLock()
+	defer fake.saveTimesheetMutex.RUnlock()
+	argsForCall := fake.saveTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = nil
+	fake.saveTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) SaveTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = nil
+	if fake.saveTimesheetReturnsOnCall == nil {
+		fake.saveTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.saveTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) (*domain.Timesheet, error) {
+	fake.submitTimesheetMutex.Lock()
+	ret, specificReturn := fake.submitTimesheetReturnsOnCall[len(fake.submitTimesheetArgsForCall)]
+	fake.submitTimesheetArgsForCall = append(fake.submitTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.SubmitTimesheetStub
+	fakeReturns := fake.submitTimesheetReturns
+	fake.recordInvocation("SubmitTimesheet", []interface{}{arg1, arg2, arg3})
+	fake.submitTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheetCallCount() int {
+	fake.submitTimesheetMutex.RLock()
+	defer fake.submitTimesheetMutex.RUnlock()
+	return len(fake.submitTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheetCalls(stub func(context.Context, uuid.UUID, time.Time) (*domain.Timesheet, error)) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheetArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.submitTimesheetMutex.RLock()
+	defer fake.submitTimesheetMutex.RUnlock()
+	argsForCall := fake.submitTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = nil
+	fake.submitTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) SubmitTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = nil
+	if fake.submitTimesheetReturnsOnCall == nil {
+		fake.submitTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.submitTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContract(arg1 context.Context, arg2 domain.ContractorContract) (*domain.ContractorContract, error) {
+	fake.updateContractorContractMutex.Lock()
+	ret, specificReturn := fake.updateContractorContractReturnsOnCall[len(fake.updateContractorContractArgsForCall)]
+	fake.updateContractorContractArgsForCall = append(fake.updateContractorContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.ContractorContract
+	}{arg1, arg2})
+	stub := fake.UpdateContractorContractStub
+	fakeReturns := fake.updateContractorContractReturns
+	fake.recordInvocation("UpdateContractorContract", []interface{}{arg1, arg2})
+	fake.updateContractorContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContractCallCount() int {
+	fake.updateContractorContractMutex.RLock()
+	defer fake.updateContractorContractMutex.RUnlock()
+	return len(fake.updateContractorContractArgsForCall)
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContractCalls(stub func(context.Context, domain.ContractorContract) (*domain.ContractorContract, error)) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = stub
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContractArgsForCall(i int) (context.Context, domain.ContractorContract) {
+	fake.updateContractorContractMutex.RLock()
+	defer fake.updateContractorContractMutex.RUnlock()
+	argsForCall := fake.updateContractorContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContractReturns(result1 *domain.ContractorContract, result2 error) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = nil
+	fake.updateContractorContractReturns = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) UpdateContractorContractReturnsOnCall(i int, result1 *domain.ContractorContract, result2 error) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = nil
+	if fake.updateContractorContractReturnsOnCall == nil {
+		fake.updateContractorContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.updateContractorContractReturnsOnCall[i] = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTimesheetRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TimesheetRepository = new(FakeTimesheetRepository)
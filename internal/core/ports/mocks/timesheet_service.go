@@ -0,0 +1,896 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTimesheetService struct {
+	ApproveTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)
+	approveTimesheetMutex       sync.RWMutex
+	approveTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	approveTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	approveTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	CreateContractorContractStub        func(context.Context, uuid.UUID, uuid.UUID, domain.ContractorContract) (*domain.ContractorContract, error)
+	createContractorContractMutex       sync.RWMutex
+	createContractorContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.ContractorContract
+	}
+	createContractorContractReturns struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	createContractorContractReturnsOnCall map[int]struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	GetTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)
+	getTimesheetMutex       sync.RWMutex
+	getTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	getTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	getTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	InvoiceTimesheetsStub        func(context.Context, uuid.UUID, uuid.UUID, []uuid.UUID) (*domain.Invoice, error)
+	invoiceTimesheetsMutex       sync.RWMutex
+	invoiceTimesheetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 []uuid.UUID
+	}
+	invoiceTimesheetsReturns struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	invoiceTimesheetsReturnsOnCall map[int]struct {
+		result1 *domain.Invoice
+		result2 error
+	}
+	ListContractorContractsStub        func(context.Context, uuid.UUID, uuid.UUID) ([]domain.ContractorContract, error)
+	listContractorContractsMutex       sync.RWMutex
+	listContractorContractsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listContractorContractsReturns struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}
+	listContractorContractsReturnsOnCall map[int]struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}
+	ListTimesheetsStub        func(context.Context, uuid.UUID, uuid.UUID, domain.TimesheetFilter, int, int) ([]domain.Timesheet, int64, error)
+	listTimesheetsMutex       sync.RWMutex
+	listTimesheetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.TimesheetFilter
+		arg5 int
+		arg6 int
+	}
+	listTimesheetsReturns struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}
+	listTimesheetsReturnsOnCall map[int]struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}
+	RejectTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.Timesheet, error)
+	rejectTimesheetMutex       sync.RWMutex
+	rejectTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	rejectTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	rejectTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	SaveTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, []domain.TimesheetEntry) (*domain.Timesheet, error)
+	saveTimesheetMutex       sync.RWMutex
+	saveTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 time.Time
+		arg6 []domain.TimesheetEntry
+	}
+	saveTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	saveTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	SubmitTimesheetStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)
+	submitTimesheetMutex       sync.RWMutex
+	submitTimesheetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	submitTimesheetReturns struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	submitTimesheetReturnsOnCall map[int]struct {
+		result1 *domain.Timesheet
+		result2 error
+	}
+	UpdateContractorContractStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ContractorContract) (*domain.ContractorContract, error)
+	updateContractorContractMutex       sync.RWMutex
+	updateContractorContractArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.ContractorContract
+	}
+	updateContractorContractReturns struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	updateContractorContractReturnsOnCall map[int]struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Timesheet, error) {
+	fake.approveTimesheetMutex.Lock()
+	ret, specificReturn := fake.approveTimesheetReturnsOnCall[len(fake.approveTimesheetArgsForCall)]
+	fake.approveTimesheetArgsForCall = append(fake.approveTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApproveTimesheetStub
+	fakeReturns := fake.approveTimesheetReturns
+	fake.recordInvocation("ApproveTimesheet", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheetCallCount() int {
+	fake.approveTimesheetMutex.RLock()
+	defer fake.approveTimesheetMutex.RUnlock()
+	return len(fake.approveTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)) {
+	fake.approveTimesheetMutex.Lock()
+	defer fake.approveTimesheetMutex.Unlock()
+	fake.ApproveTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.approveTimesheetMutex.RLock()
+	defer fake.approveTimesheetMutex.RUnlock()
+	argsForCall := fake.approveTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.approveTimesheetMutex.Lock()
+	defer fake.approveTimesheetMutex.Unlock()
+	fake.ApproveTimesheetStub = nil
+	fake.approveTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) ApproveTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.approveTimesheetMutex.Lock()
+	defer fake.approveTimesheetMutex.Unlock()
+	fake.ApproveTimesheetStub = nil
+	if fake.approveTimesheetReturnsOnCall == nil {
+		fake.approveTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.approveTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) CreateContractorContract(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.ContractorContract) (*domain.ContractorContract, error) {
+	fake.createContractorContractMutex.Lock()
+	ret, specificReturn := fake.createContractorContractReturnsOnCall[len(fake.createContractorContractArgsForCall)]
+	fake.createContractorContractArgsForCall = append(fake.createContractorContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.ContractorContract
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateContractorContractStub
+	fakeReturns := fake.createContractorContractReturns
+	fake.recordInvocation("CreateContractorContract", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createContractorContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) CreateContractorContractCallCount() int {
+	fake.createContractorContractMutex.RLock()
+	defer fake.createContractorContractMutex.RUnlock()
+	return len(fake.createContractorContractArgsForCall)
+}
+
+func (fake *FakeTimesheetService) CreateContractorContractCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.ContractorContract) (*domain.ContractorContract, error)) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = stub
+}
+
+func (fake *FakeTimesheetService) CreateContractorContractArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.ContractorContract) {
+	fake.createContractorContractMutex.RLock()
+	defer fake.createContractorContractMutex.RUnlock()
+	argsForCall := fake.createContractorContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTimesheetService) CreateContractorContractReturns(result1 *domain.ContractorContract, result2 error) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = nil
+	fake.createContractorContractReturns = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) CreateContractorContractReturnsOnCall(i int, result1 *domain.ContractorContract, result2 error) {
+	fake.createContractorContractMutex.Lock()
+	defer fake.createContractorContractMutex.Unlock()
+	fake.CreateContractorContractStub = nil
+	if fake.createContractorContractReturnsOnCall == nil {
+		fake.createContractorContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.createContractorContractReturnsOnCall[i] = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) GetTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Timesheet, error) {
+	fake.getTimesheetMutex.Lock()
+	ret, specificReturn := fake.getTimesheetReturnsOnCall[len(fake.getTimesheetArgsForCall)]
+	fake.getTimesheetArgsForCall = append(fake.getTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetTimesheetStub
+	fakeReturns := fake.getTimesheetReturns
+	fake.recordInvocation("GetTimesheet", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) GetTimesheetCallCount() int {
+	fake.getTimesheetMutex.RLock()
+	defer fake.getTimesheetMutex.RUnlock()
+	return len(fake.getTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetService) GetTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetService) GetTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.getTimesheetMutex.RLock()
+	defer fake.getTimesheetMutex.RUnlock()
+	argsForCall := fake.getTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTimesheetService) GetTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = nil
+	fake.getTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) GetTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.getTimesheetMutex.Lock()
+	defer fake.getTimesheetMutex.Unlock()
+	fake.GetTimesheetStub = nil
+	if fake.getTimesheetReturnsOnCall == nil {
+		fake.getTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.getTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheets(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 []uuid.UUID) (*domain.Invoice, error) {
+	var arg4Copy []uuid.UUID
+	if arg4 != nil {
+		arg4Copy = make([]uuid.UUID, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.invoiceTimesheetsMutex.Lock()
+	ret, specificReturn := fake.invoiceTimesheetsReturnsOnCall[len(fake.invoiceTimesheetsArgsForCall)]
+	fake.invoiceTimesheetsArgsForCall = append(fake.invoiceTimesheetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 []uuid.UUID
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.InvoiceTimesheetsStub
+	fakeReturns := fake.invoiceTimesheetsReturns
+	fake.recordInvocation("InvoiceTimesheets", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.invoiceTimesheetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheetsCallCount() int {
+	fake.invoiceTimesheetsMutex.RLock()
+	defer fake.invoiceTimesheetsMutex.RUnlock()
+	return len(fake.invoiceTimesheetsArgsForCall)
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheetsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, []uuid.UUID) (*domain.Invoice, error)) {
+	fake.invoiceTimesheetsMutex.Lock()
+	defer fake.invoiceTimesheetsMutex.Unlock()
+	fake.InvoiceTimesheetsStub = stub
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheetsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, []uuid.UUID) {
+	fake.invoiceTimesheetsMutex.RLock()
+	defer fake.invoiceTimesheetsMutex.RUnlock()
+	argsForCall := fake.invoiceTimesheetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheetsReturns(result1 *domain.Invoice, result2 error) {
+	fake.invoiceTimesheetsMutex.Lock()
+	defer fake.invoiceTimesheetsMutex.Unlock()
+	fake.InvoiceTimesheetsStub = nil
+	fake.invoiceTimesheetsReturns = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) InvoiceTimesheetsReturnsOnCall(i int, result1 *domain.Invoice, result2 error) {
+	fake.invoiceTimesheetsMutex.Lock()
+	defer fake.invoiceTimesheetsMutex.Unlock()
+	fake.InvoiceTimesheetsStub = nil
+	if fake.invoiceTimesheetsReturnsOnCall == nil {
+		fake.invoiceTimesheetsReturnsOnCall = make(map[int]struct {
+			result1 *domain.Invoice
+			result2 error
+		})
+	}
+	fake.invoiceTimesheetsReturnsOnCall[i] = struct {
+		result1 *domain.Invoice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) ListContractorContracts(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]domain.ContractorContract, error) {
+	fake.listContractorContractsMutex.Lock()
+	ret, specificReturn := fake.listContractorContractsReturnsOnCall[len(fake.listContractorContractsArgsForCall)]
+	fake.listContractorContractsArgsForCall = append(fake.listContractorContractsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListContractorContractsStub
+	fakeReturns := fake.listContractorContractsReturns
+	fake.recordInvocation("ListContractorContracts", []interface{}{arg1, arg2, arg3})
+	fake.listContractorContractsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) ListContractorContractsCallCount() int {
+	fake.listContractorContractsMutex.RLock()
+	defer fake.listContractorContractsMutex.RUnlock()
+	return len(fake.listContractorContractsArgsForCall)
+}
+
+func (fake *FakeTimesheetService) ListContractorContractsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]domain.ContractorContract, error)) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = stub
+}
+
+func (fake *FakeTimesheetService) ListContractorContractsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listContractorContractsMutex.RLock()
+	defer fake.listContractorContractsMutex.RUnlock()
+	argsForCall := fake.listContractorContractsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTimesheetService) ListContractorContractsReturns(result1 []domain.ContractorContract, result2 error) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = nil
+	fake.listContractorContractsReturns = struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) ListContractorContractsReturnsOnCall(i int, result1 []domain.ContractorContract, result2 error) {
+	fake.listContractorContractsMutex.Lock()
+	defer fake.listContractorContractsMutex.Unlock()
+	fake.ListContractorContractsStub = nil
+	if fake.listContractorContractsReturnsOnCall == nil {
+		fake.listContractorContractsReturnsOnCall = make(map[int]struct {
+			result1 []domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.listContractorContractsReturnsOnCall[i] = struct {
+		result1 []domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) ListTimesheets(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 domain.TimesheetFilter, arg5 int, arg6 int) ([]domain.Timesheet, int64, error) {
+	fake.listTimesheetsMutex.Lock()
+	ret, specificReturn := fake.listTimesheetsReturnsOnCall[len(fake.listTimesheetsArgsForCall)]
+	fake.listTimesheetsArgsForCall = append(fake.listTimesheetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 domain.TimesheetFilter
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListTimesheetsStub
+	fakeReturns := fake.listTimesheetsReturns
+	fake.recordInvocation("ListTimesheets", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listTimesheetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTimesheetService) ListTimesheetsCallCount() int {
+	fake.listTimesheetsMutex.RLock()
+	defer fake.listTimesheetsMutex.RUnlock()
+	return len(fake.listTimesheetsArgsForCall)
+}
+
+func (fake *FakeTimesheetService) ListTimesheetsCalls(stub func(context.Context, uuid.UUID, uuid.UUID, domain.TimesheetFilter, int, int) ([]domain.Timesheet, int64, error)) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = stub
+}
+
+func (fake *FakeTimesheetService) ListTimesheetsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, domain.TimesheetFilter, int, int) {
+	fake.listTimesheetsMutex.RLock()
+	defer fake.listTimesheetsMutex.RUnlock()
+	argsForCall := fake.listTimesheetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTimesheetService) ListTimesheetsReturns(result1 []domain.Timesheet, result2 int64, result3 error) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = nil
+	fake.listTimesheetsReturns = struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTimesheetService) ListTimesheetsReturnsOnCall(i int, result1 []domain.Timesheet, result2 int64, result3 error) {
+	fake.listTimesheetsMutex.Lock()
+	defer fake.listTimesheetsMutex.Unlock()
+	fake.ListTimesheetsStub = nil
+	if fake.listTimesheetsReturnsOnCall == nil {
+		fake.listTimesheetsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Timesheet
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listTimesheetsReturnsOnCall[i] = struct {
+		result1 []domain.Timesheet
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTimesheetService) RejectTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) (*domain.Timesheet, error) {
+	fake.rejectTimesheetMutex.Lock()
+	ret, specificReturn := fake.rejectTimesheetReturnsOnCall[len(fake.rejectTimesheetArgsForCall)]
+	fake.rejectTimesheetArgsForCall = append(fake.rejectTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RejectTimesheetStub
+	fakeReturns := fake.rejectTimesheetReturns
+	fake.recordInvocation("RejectTimesheet", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.rejectTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) RejectTimesheetCallCount() int {
+	fake.rejectTimesheetMutex.RLock()
+	defer fake.rejectTimesheetMutex.RUnlock()
+	return len(fake.rejectTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetService) RejectTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*domain.Timesheet, error)) {
+	fake.rejectTimesheetMutex.Lock()
+	defer fake.rejectTimesheetMutex.Unlock()
+	fake.RejectTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetService) RejectTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.rejectTimesheetMutex.RLock()
+	defer fake.rejectTimesheetMutex.RUnlock()
+	argsForCall := fake.rejectTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTimesheetService) RejectTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.rejectTimesheetMutex.Lock()
+	defer fake.rejectTimesheetMutex.Unlock()
+	fake.RejectTimesheetStub = nil
+	fake.rejectTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) RejectTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.rejectTimesheetMutex.Lock()
+	defer fake.rejectTimesheetMutex.Unlock()
+	fake.RejectTimesheetStub = nil
+	if fake.rejectTimesheetReturnsOnCall == nil {
+		fake.rejectTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.rejectTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) SaveTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 time.Time, arg6 []domain.TimesheetEntry) (*domain.Timesheet, error) {
+	var arg6Copy []domain.TimesheetEntry
+	if arg6 != nil {
+		arg6Copy = make([]domain.TimesheetEntry, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.saveTimesheetMutex.Lock()
+	ret, specificReturn := fake.saveTimesheetReturnsOnCall[len(fake.saveTimesheetArgsForCall)]
+	fake.saveTimesheetArgsForCall = append(fake.saveTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 time.Time
+		arg6 []domain.TimesheetEntry
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+	stub := fake.SaveTimesheetStub
+	fakeReturns := fake.saveTimesheetReturns
+	fake.recordInvocation("SaveTimesheet", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+	fake.saveTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) SaveTimesheetCallCount() int {
+	fake.saveTimesheetMutex.RLock()
+	defer fake.saveTimesheetMutex.RUnlock()
+	return len(fake.saveTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetService) SaveTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, []domain.TimesheetEntry) (*domain.Timesheet, error)) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetService) SaveTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, []domain.TimesheetEntry) {
+	fake.saveTimesheetMutex.RLock()
+	defer fake.saveTimesheetMutex.RUnlock()
+	argsForCall := fake.saveTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTimesheetService) SaveTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = nil
+	fake.saveTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) SaveTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.saveTimesheetMutex.Lock()
+	defer fake.saveTimesheetMutex.Unlock()
+	fake.SaveTimesheetStub = nil
+	if fake.saveTimesheetReturnsOnCall == nil {
+		fake.saveTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.saveTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheet(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (*domain.Timesheet, error) {
+	fake.submitTimesheetMutex.Lock()
+	ret, specificReturn := fake.submitTimesheetReturnsOnCall[len(fake.submitTimesheetArgsForCall)]
+	fake.submitTimesheetArgsForCall = append(fake.submitTimesheetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SubmitTimesheetStub
+	fakeReturns := fake.submitTimesheetReturns
+	fake.recordInvocation("SubmitTimesheet", []interface{}{arg1, arg2, arg3, arg4})
+	fake.submitTimesheetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheetCallCount() int {
+	fake.submitTimesheetMutex.RLock()
+	defer fake.submitTimesheetMutex.RUnlock()
+	return len(fake.submitTimesheetArgsForCall)
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheetCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*domain.Timesheet, error)) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = stub
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheetArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.submitTimesheetMutex.RLock()
+	defer fake.submitTimesheetMutex.RUnlock()
+	argsForCall := fake.submitTimesheetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheetReturns(result1 *domain.Timesheet, result2 error) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = nil
+	fake.submitTimesheetReturns = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) SubmitTimesheetReturnsOnCall(i int, result1 *domain.Timesheet, result2 error) {
+	fake.submitTimesheetMutex.Lock()
+	defer fake.submitTimesheetMutex.Unlock()
+	fake.SubmitTimesheetStub = nil
+	if fake.submitTimesheetReturnsOnCall == nil {
+		fake.submitTimesheetReturnsOnCall = make(map[int]struct {
+			result1 *domain.Timesheet
+			result2 error
+		})
+	}
+	fake.submitTimesheetReturnsOnCall[i] = struct {
+		result1 *domain.Timesheet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContract(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 domain.ContractorContract) (*domain.ContractorContract, error) {
+	fake.updateContractorContractMutex.Lock()
+	ret, specificReturn := fake.updateContractorContractReturnsOnCall[len(fake.updateContractorContractArgsForCall)]
+	fake.updateContractorContractArgsForCall = append(fake.updateContractorContractArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 domain.ContractorContract
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateContractorContractStub
+	fakeReturns := fake.updateContractorContractReturns
+	fake.recordInvocation("UpdateContractorContract", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateContractorContractMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContractCallCount() int {
+	fake.updateContractorContractMutex.RLock()
+	defer fake.updateContractorContractMutex.RUnlock()
+	return len(fake.updateContractorContractArgsForCall)
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContractCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ContractorContract) (*domain.ContractorContract, error)) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = stub
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContractArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, domain.ContractorContract) {
+	fake.updateContractorContractMutex.RLock()
+	defer fake.updateContractorContractMutex.RUnlock()
+	argsForCall := fake.updateContractorContractArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContractReturns(result1 *domain.ContractorContract, result2 error) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = nil
+	fake.updateContractorContractReturns = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) UpdateContractorContractReturnsOnCall(i int, result1 *domain.ContractorContract, result2 error) {
+	fake.updateContractorContractMutex.Lock()
+	defer fake.updateContractorContractMutex.Unlock()
+	fake.UpdateContractorContractStub = nil
+	if fake.updateContractorContractReturnsOnCall == nil {
+		fake.updateContractorContractReturnsOnCall = make(map[int]struct {
+			result1 *domain.ContractorContract
+			result2 error
+		})
+	}
+	fake.updateContractorContractReturnsOnCall[i] = struct {
+		result1 *domain.ContractorContract
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTimesheetService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTimesheetService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TimesheetService = new(FakeTimesheetService)
@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+type FakeTransactionEventPublisher struct {
+	PublishTransactionEventStub        func(context.Context, domain.TransactionEvent) error
+	publishTransactionEventMutex       sync.RWMutex
+	publishTransactionEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.TransactionEvent
+	}
+	publishTransactionEventReturns struct {
+		result1 error
+	}
+	publishTransactionEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEvent(arg1 context.Context, arg2 domain.TransactionEvent) error {
+	fake.publishTransactionEventMutex.Lock()
+	ret, specificReturn := fake.publishTransactionEventReturnsOnCall[len(fake.publishTransactionEventArgsForCall)]
+	fake.publishTransactionEventArgsForCall = append(fake.publishTransactionEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.TransactionEvent
+	}{arg1, arg2})
+	stub := fake.PublishTransactionEventStub
+	fakeReturns := fake.publishTransactionEventReturns
+	fake.recordInvocation("PublishTransactionEvent", []interface{}{arg1, arg2})
+	fake.publishTransactionEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEventCallCount() int {
+	fake.publishTransactionEventMutex.RLock()
+	defer fake.publishTransactionEventMutex.RUnlock()
+	return len(fake.publishTransactionEventArgsForCall)
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEventCalls(stub func(context.Context, domain.TransactionEvent) error) {
+	fake.publishTransactionEventMutex.Lock()
+	defer fake.publishTransactionEventMutex.Unlock()
+	fake.PublishTransactionEventStub = stub
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEventArgsForCall(i int) (context.Context, domain.TransactionEvent) {
+	fake.publishTransactionEventMutex.RLock()
+	defer fake.publishTransactionEventMutex.RUnlock()
+	argsForCall := fake.publishTransactionEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEventReturns(result1 error) {
+	fake.publishTransactionEventMutex.Lock()
+	defer fake.publishTransactionEventMutex.Unlock()
+	fake.PublishTransactionEventStub = nil
+	fake.publishTransactionEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionEventPublisher) PublishTransactionEventReturnsOnCall(i int, result1 error) {
+	fake.publishTransactionEventMutex.Lock()
+	defer fake.publishTransactionEventMutex.Unlock()
+	fake.PublishTransactionEventStub = nil
+	if fake.publishTransactionEventReturnsOnCall == nil {
+		fake.publishTransactionEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishTransactionEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionEventPublisher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransactionEventPublisher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TransactionEventPublisher = new(FakeTransactionEventPublisher)
@@ -0,0 +1,358 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTransactionPINRepository struct {
+	GetTransactionPINStub        func(context.Context, uuid.UUID) (*domain.TransactionPIN, error)
+	getTransactionPINMutex       sync.RWMutex
+	getTransactionPINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getTransactionPINReturns struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	getTransactionPINReturnsOnCall map[int]struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	RecordFailedAttemptStub        func(context.Context, uuid.UUID, int, time.Time) (*domain.TransactionPIN, error)
+	recordFailedAttemptMutex       sync.RWMutex
+	recordFailedAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 time.Time
+	}
+	recordFailedAttemptReturns struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	recordFailedAttemptReturnsOnCall map[int]struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	ResetFailedAttemptsStub        func(context.Context, uuid.UUID) error
+	resetFailedAttemptsMutex       sync.RWMutex
+	resetFailedAttemptsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	resetFailedAttemptsReturns struct {
+		result1 error
+	}
+	resetFailedAttemptsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetTransactionPINStub        func(context.Context, uuid.UUID, string) (*domain.TransactionPIN, error)
+	setTransactionPINMutex       sync.RWMutex
+	setTransactionPINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	setTransactionPINReturns struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	setTransactionPINReturnsOnCall map[int]struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPIN(arg1 context.Context, arg2 uuid.UUID) (*domain.TransactionPIN, error) {
+	fake.getTransactionPINMutex.Lock()
+	ret, specificReturn := fake.getTransactionPINReturnsOnCall[len(fake.getTransactionPINArgsForCall)]
+	fake.getTransactionPINArgsForCall = append(fake.getTransactionPINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetTransactionPINStub
+	fakeReturns := fake.getTransactionPINReturns
+	fake.recordInvocation("GetTransactionPIN", []interface{}{arg1, arg2})
+	fake.getTransactionPINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPINCallCount() int {
+	fake.getTransactionPINMutex.RLock()
+	defer fake.getTransactionPINMutex.RUnlock()
+	return len(fake.getTransactionPINArgsForCall)
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPINCalls(stub func(context.Context, uuid.UUID) (*domain.TransactionPIN, error)) {
+	fake.getTransactionPINMutex.Lock()
+	defer fake.getTransactionPINMutex.Unlock()
+	fake.GetTransactionPINStub = stub
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPINArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getTransactionPINMutex.RLock()
+	defer fake.getTransactionPINMutex.RUnlock()
+	argsForCall := fake.getTransactionPINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPINReturns(result1 *domain.TransactionPIN, result2 error) {
+	fake.getTransactionPINMutex.Lock()
+	defer fake.getTransactionPINMutex.Unlock()
+	fake.GetTransactionPINStub = nil
+	fake.getTransactionPINReturns = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) GetTransactionPINReturnsOnCall(i int, result1 *domain.TransactionPIN, result2 error) {
+	fake.getTransactionPINMutex.Lock()
+	defer fake.getTransactionPINMutex.Unlock()
+	fake.GetTransactionPINStub = nil
+	if fake.getTransactionPINReturnsOnCall == nil {
+		fake.getTransactionPINReturnsOnCall = make(map[int]struct {
+			result1 *domain.TransactionPIN
+			result2 error
+		})
+	}
+	fake.getTransactionPINReturnsOnCall[i] = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttempt(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 time.Time) (*domain.TransactionPIN, error) {
+	fake.recordFailedAttemptMutex.Lock()
+	ret, specificReturn := fake.recordFailedAttemptReturnsOnCall[len(fake.recordFailedAttemptArgsForCall)]
+	fake.recordFailedAttemptArgsForCall = append(fake.recordFailedAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordFailedAttemptStub
+	fakeReturns := fake.recordFailedAttemptReturns
+	fake.recordInvocation("RecordFailedAttempt", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordFailedAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttemptCallCount() int {
+	fake.recordFailedAttemptMutex.RLock()
+	defer fake.recordFailedAttemptMutex.RUnlock()
+	return len(fake.recordFailedAttemptArgsForCall)
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttemptCalls(stub func(context.Context, uuid.UUID, int, time.Time) (*domain.TransactionPIN, error)) {
+	fake.recordFailedAttemptMutex.Lock()
+	defer fake.recordFailedAttemptMutex.Unlock()
+	fake.RecordFailedAttemptStub = stub
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttemptArgsForCall(i int) (context.Context, uuid.UUID, int, time.Time) {
+	fake.recordFailedAttemptMutex.RLock()
+	defer fake.recordFailedAttemptMutex.RUnlock()
+	argsForCall := fake.recordFailedAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttemptReturns(result1 *domain.TransactionPIN, result2 error) {
+	fake.recordFailedAttemptMutex.Lock()
+	defer fake.recordFailedAttemptMutex.Unlock()
+	fake.RecordFailedAttemptStub = nil
+	fake.recordFailedAttemptReturns = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) RecordFailedAttemptReturnsOnCall(i int, result1 *domain.TransactionPIN, result2 error) {
+	fake.recordFailedAttemptMutex.Lock()
+	defer fake.recordFailedAttemptMutex.Unlock()
+	fake.RecordFailedAttemptStub = nil
+	if fake.recordFailedAttemptReturnsOnCall == nil {
+		fake.recordFailedAttemptReturnsOnCall = make(map[int]struct {
+			result1 *domain.TransactionPIN
+			result2 error
+		})
+	}
+	fake.recordFailedAttemptReturnsOnCall[i] = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttempts(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.resetFailedAttemptsMutex.Lock()
+	ret, specificReturn := fake.resetFailedAttemptsReturnsOnCall[len(fake.resetFailedAttemptsArgsForCall)]
+	fake.resetFailedAttemptsArgsForCall = append(fake.resetFailedAttemptsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ResetFailedAttemptsStub
+	fakeReturns := fake.resetFailedAttemptsReturns
+	fake.recordInvocation("ResetFailedAttempts", []interface{}{arg1, arg2})
+	fake.resetFailedAttemptsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttemptsCallCount() int {
+	fake.resetFailedAttemptsMutex.RLock()
+	defer fake.resetFailedAttemptsMutex.RUnlock()
+	return len(fake.resetFailedAttemptsArgsForCall)
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttemptsCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.resetFailedAttemptsMutex.Lock()
+	defer fake.resetFailedAttemptsMutex.Unlock()
+	fake.ResetFailedAttemptsStub = stub
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttemptsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.resetFailedAttemptsMutex.RLock()
+	defer fake.resetFailedAttemptsMutex.RUnlock()
+	argsForCall := fake.resetFailedAttemptsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttemptsReturns(result1 error) {
+	fake.resetFailedAttemptsMutex.Lock()
+	defer fake.resetFailedAttemptsMutex.Unlock()
+	fake.ResetFailedAttemptsStub = nil
+	fake.resetFailedAttemptsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINRepository) ResetFailedAttemptsReturnsOnCall(i int, result1 error) {
+	fake.resetFailedAttemptsMutex.Lock()
+	defer fake.resetFailedAttemptsMutex.Unlock()
+	fake.ResetFailedAttemptsStub = nil
+	if fake.resetFailedAttemptsReturnsOnCall == nil {
+		fake.resetFailedAttemptsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetFailedAttemptsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPIN(arg1 context.Context, arg2 uuid.UUID, arg3 string) (*domain.TransactionPIN, error) {
+	fake.setTransactionPINMutex.Lock()
+	ret, specificReturn := fake.setTransactionPINReturnsOnCall[len(fake.setTransactionPINArgsForCall)]
+	fake.setTransactionPINArgsForCall = append(fake.setTransactionPINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetTransactionPINStub
+	fakeReturns := fake.setTransactionPINReturns
+	fake.recordInvocation("SetTransactionPIN", []interface{}{arg1, arg2, arg3})
+	fake.setTransactionPINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPINCallCount() int {
+	fake.setTransactionPINMutex.RLock()
+	defer fake.setTransactionPINMutex.RUnlock()
+	return len(fake.setTransactionPINArgsForCall)
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPINCalls(stub func(context.Context, uuid.UUID, string) (*domain.TransactionPIN, error)) {
+	fake.setTransactionPINMutex.Lock()
+	defer fake.setTransactionPINMutex.Unlock()
+	fake.SetTransactionPINStub = stub
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPINArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.setTransactionPINMutex.RLock()
+	defer fake.setTransactionPINMutex.RUnlock()
+	argsForCall := fake.setTransactionPINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPINReturns(result1 *domain.TransactionPIN, result2 error) {
+	fake.setTransactionPINMutex.Lock()
+	defer fake.setTransactionPINMutex.Unlock()
+	fake.SetTransactionPINStub = nil
+	fake.setTransactionPINReturns = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) SetTransactionPINReturnsOnCall(i int, result1 *domain.TransactionPIN, result2 error) {
+	fake.setTransactionPINMutex.Lock()
+	defer fake.setTransactionPINMutex.Unlock()
+	fake.SetTransactionPINStub = nil
+	if fake.setTransactionPINReturnsOnCall == nil {
+		fake.setTransactionPINReturnsOnCall = make(map[int]struct {
+			result1 *domain.TransactionPIN
+			result2 error
+		})
+	}
+	fake.setTransactionPINReturnsOnCall[i] = struct {
+		result1 *domain.TransactionPIN
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransactionPINRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TransactionPINRepository = new(FakeTransactionPINRepository)
@@ -0,0 +1,501 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTransactionPINService struct {
+	ChangePINStub        func(context.Context, uuid.UUID, string, string) error
+	changePINMutex       sync.RWMutex
+	changePINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+	}
+	changePINReturns struct {
+		result1 error
+	}
+	changePINReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetPINStatusStub        func(context.Context, uuid.UUID) (*domain.TransactionPINStatus, error)
+	getPINStatusMutex       sync.RWMutex
+	getPINStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPINStatusReturns struct {
+		result1 *domain.TransactionPINStatus
+		result2 error
+	}
+	getPINStatusReturnsOnCall map[int]struct {
+		result1 *domain.TransactionPINStatus
+		result2 error
+	}
+	RequestPINResetStub        func(context.Context, uuid.UUID) error
+	requestPINResetMutex       sync.RWMutex
+	requestPINResetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	requestPINResetReturns struct {
+		result1 error
+	}
+	requestPINResetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetPINStub        func(context.Context, uuid.UUID, string, string) error
+	resetPINMutex       sync.RWMutex
+	resetPINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+	}
+	resetPINReturns struct {
+		result1 error
+	}
+	resetPINReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetPINStub        func(context.Context, uuid.UUID, string) error
+	setPINMutex       sync.RWMutex
+	setPINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	setPINReturns struct {
+		result1 error
+	}
+	setPINReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyPINStub        func(context.Context, uuid.UUID, string) error
+	verifyPINMutex       sync.RWMutex
+	verifyPINArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	verifyPINReturns struct {
+		result1 error
+	}
+	verifyPINReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransactionPINService) ChangePIN(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 string) error {
+	fake.changePINMutex.Lock()
+	ret, specificReturn := fake.changePINReturnsOnCall[len(fake.changePINArgsForCall)]
+	fake.changePINArgsForCall = append(fake.changePINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ChangePINStub
+	fakeReturns := fake.changePINReturns
+	fake.recordInvocation("ChangePIN", []interface{}{arg1, arg2, arg3, arg4})
+	fake.changePINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINService) ChangePINCallCount() int {
+	fake.changePINMutex.RLock()
+	defer fake.changePINMutex.RUnlock()
+	return len(fake.changePINArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) ChangePINCalls(stub func(context.Context, uuid.UUID, string, string) error) {
+	fake.changePINMutex.Lock()
+	defer fake.changePINMutex.Unlock()
+	fake.ChangePINStub = stub
+}
+
+func (fake *FakeTransactionPINService) ChangePINArgsForCall(i int) (context.Context, uuid.UUID, string, string) {
+	fake.changePINMutex.RLock()
+	defer fake.changePINMutex.RUnlock()
+	argsForCall := fake.changePINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionPINService) ChangePINReturns(result1 error) {
+	fake.changePINMutex.Lock()
+	defer fake.changePINMutex.Unlock()
+	fake.ChangePINStub = nil
+	fake.changePINReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) ChangePINReturnsOnCall(i int, result1 error) {
+	fake.changePINMutex.Lock()
+	defer fake.changePINMutex.Unlock()
+	fake.ChangePINStub = nil
+	if fake.changePINReturnsOnCall == nil {
+		fake.changePINReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changePINReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) GetPINStatus(arg1 context.Context, arg2 uuid.UUID) (*domain.TransactionPINStatus, error) {
+	fake.getPINStatusMutex.Lock()
+	ret, specificReturn := fake.getPINStatusReturnsOnCall[len(fake.getPINStatusArgsForCall)]
+	fake.getPINStatusArgsForCall = append(fake.getPINStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPINStatusStub
+	fakeReturns := fake.getPINStatusReturns
+	fake.recordInvocation("GetPINStatus", []interface{}{arg1, arg2})
+	fake.getPINStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionPINService) GetPINStatusCallCount() int {
+	fake.getPINStatusMutex.RLock()
+	defer fake.getPINStatusMutex.RUnlock()
+	return len(fake.getPINStatusArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) GetPINStatusCalls(stub func(context.Context, uuid.UUID) (*domain.TransactionPINStatus, error)) {
+	fake.getPINStatusMutex.Lock()
+	defer fake.getPINStatusMutex.Unlock()
+	fake.GetPINStatusStub = stub
+}
+
+func (fake *FakeTransactionPINService) GetPINStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPINStatusMutex.RLock()
+	defer fake.getPINStatusMutex.RUnlock()
+	argsForCall := fake.getPINStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionPINService) GetPINStatusReturns(result1 *domain.TransactionPINStatus, result2 error) {
+	fake.getPINStatusMutex.Lock()
+	defer fake.getPINStatusMutex.Unlock()
+	fake.GetPINStatusStub = nil
+	fake.getPINStatusReturns = struct {
+		result1 *domain.TransactionPINStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINService) GetPINStatusReturnsOnCall(i int, result1 *domain.TransactionPINStatus, result2 error) {
+	fake.getPINStatusMutex.Lock()
+	defer fake.getPINStatusMutex.Unlock()
+	fake.GetPINStatusStub = nil
+	if fake.getPINStatusReturnsOnCall == nil {
+		fake.getPINStatusReturnsOnCall = make(map[int]struct {
+			result1 *domain.TransactionPINStatus
+			result2 error
+		})
+	}
+	fake.getPINStatusReturnsOnCall[i] = struct {
+		result1 *domain.TransactionPINStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionPINService) RequestPINReset(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.requestPINResetMutex.Lock()
+	ret, specificReturn := fake.requestPINResetReturnsOnCall[len(fake.requestPINResetArgsForCall)]
+	fake.requestPINResetArgsForCall = append(fake.requestPINResetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RequestPINResetStub
+	fakeReturns := fake.requestPINResetReturns
+	fake.recordInvocation("RequestPINReset", []interface{}{arg1, arg2})
+	fake.requestPINResetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINService) RequestPINResetCallCount() int {
+	fake.requestPINResetMutex.RLock()
+	defer fake.requestPINResetMutex.RUnlock()
+	return len(fake.requestPINResetArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) RequestPINResetCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.requestPINResetMutex.Lock()
+	defer fake.requestPINResetMutex.Unlock()
+	fake.RequestPINResetStub = stub
+}
+
+func (fake *FakeTransactionPINService) RequestPINResetArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.requestPINResetMutex.RLock()
+	defer fake.requestPINResetMutex.RUnlock()
+	argsForCall := fake.requestPINResetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionPINService) RequestPINResetReturns(result1 error) {
+	fake.requestPINResetMutex.Lock()
+	defer fake.requestPINResetMutex.Unlock()
+	fake.RequestPINResetStub = nil
+	fake.requestPINResetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) RequestPINResetReturnsOnCall(i int, result1 error) {
+	fake.requestPINResetMutex.Lock()
+	defer fake.requestPINResetMutex.Unlock()
+	fake.RequestPINResetStub = nil
+	if fake.requestPINResetReturnsOnCall == nil {
+		fake.requestPINResetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestPINResetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) ResetPIN(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 string) error {
+	fake.resetPINMutex.Lock()
+	ret, specificReturn := fake.resetPINReturnsOnCall[len(fake.resetPINArgsForCall)]
+	fake.resetPINArgsForCall = append(fake.resetPINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetPINStub
+	fakeReturns := fake.resetPINReturns
+	fake.recordInvocation("ResetPIN", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetPINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINService) ResetPINCallCount() int {
+	fake.resetPINMutex.RLock()
+	defer fake.resetPINMutex.RUnlock()
+	return len(fake.resetPINArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) ResetPINCalls(stub func(context.Context, uuid.UUID, string, string) error) {
+	fake.resetPINMutex.Lock()
+	defer fake.resetPINMutex.Unlock()
+	fake.ResetPINStub = stub
+}
+
+func (fake *FakeTransactionPINService) ResetPINArgsForCall(i int) (context.Context, uuid.UUID, string, string) {
+	fake.resetPINMutex.RLock()
+	defer fake.resetPINMutex.RUnlock()
+	argsForCall := fake.resetPINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionPINService) ResetPINReturns(result1 error) {
+	fake.resetPINMutex.Lock()
+	defer fake.resetPINMutex.Unlock()
+	fake.ResetPINStub = nil
+	fake.resetPINReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) ResetPINReturnsOnCall(i int, result1 error) {
+	fake.resetPINMutex.Lock()
+	defer fake.resetPINMutex.Unlock()
+	fake.ResetPINStub = nil
+	if fake.resetPINReturnsOnCall == nil {
+		fake.resetPINReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetPINReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) SetPIN(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.setPINMutex.Lock()
+	ret, specificReturn := fake.setPINReturnsOnCall[len(fake.setPINArgsForCall)]
+	fake.setPINArgsForCall = append(fake.setPINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetPINStub
+	fakeReturns := fake.setPINReturns
+	fake.recordInvocation("SetPIN", []interface{}{arg1, arg2, arg3})
+	fake.setPINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINService) SetPINCallCount() int {
+	fake.setPINMutex.RLock()
+	defer fake.setPINMutex.RUnlock()
+	return len(fake.setPINArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) SetPINCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.setPINMutex.Lock()
+	defer fake.setPINMutex.Unlock()
+	fake.SetPINStub = stub
+}
+
+func (fake *FakeTransactionPINService) SetPINArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.setPINMutex.RLock()
+	defer fake.setPINMutex.RUnlock()
+	argsForCall := fake.setPINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransactionPINService) SetPINReturns(result1 error) {
+	fake.setPINMutex.Lock()
+	defer fake.setPINMutex.Unlock()
+	fake.SetPINStub = nil
+	fake.setPINReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) SetPINReturnsOnCall(i int, result1 error) {
+	fake.setPINMutex.Lock()
+	defer fake.setPINMutex.Unlock()
+	fake.SetPINStub = nil
+	if fake.setPINReturnsOnCall == nil {
+		fake.setPINReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPINReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) VerifyPIN(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.verifyPINMutex.Lock()
+	ret, specificReturn := fake.verifyPINReturnsOnCall[len(fake.verifyPINArgsForCall)]
+	fake.verifyPINArgsForCall = append(fake.verifyPINArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.VerifyPINStub
+	fakeReturns := fake.verifyPINReturns
+	fake.recordInvocation("VerifyPIN", []interface{}{arg1, arg2, arg3})
+	fake.verifyPINMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransactionPINService) VerifyPINCallCount() int {
+	fake.verifyPINMutex.RLock()
+	defer fake.verifyPINMutex.RUnlock()
+	return len(fake.verifyPINArgsForCall)
+}
+
+func (fake *FakeTransactionPINService) VerifyPINCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.verifyPINMutex.Lock()
+	defer fake.verifyPINMutex.Unlock()
+	fake.VerifyPINStub = stub
+}
+
+func (fake *FakeTransactionPINService) VerifyPINArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.verifyPINMutex.RLock()
+	defer fake.verifyPINMutex.RUnlock()
+	argsForCall := fake.verifyPINArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransactionPINService) VerifyPINReturns(result1 error) {
+	fake.verifyPINMutex.Lock()
+	defer fake.verifyPINMutex.Unlock()
+	fake.VerifyPINStub = nil
+	fake.verifyPINReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) VerifyPINReturnsOnCall(i int, result1 error) {
+	fake.verifyPINMutex.Lock()
+	defer fake.verifyPINMutex.Unlock()
+	fake.VerifyPINStub = nil
+	if fake.verifyPINReturnsOnCall == nil {
+		fake.verifyPINReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyPINReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTransactionPINService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransactionPINService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TransactionPINService = new(FakeTransactionPINService)
@@ -0,0 +1,695 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTransactionRepository struct {
+	CreateTransactionStub        func(context.Context, domain.Transaction) (*domain.Transaction, error)
+	createTransactionMutex       sync.RWMutex
+	createTransactionArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Transaction
+	}
+	createTransactionReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	createTransactionReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	GetTransactionByIDStub        func(context.Context, uuid.UUID) (*domain.Transaction, error)
+	getTransactionByIDMutex       sync.RWMutex
+	getTransactionByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getTransactionByIDReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	getTransactionByIDReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	GetTransactionByTxHashStub        func(context.Context, string) (*domain.Transaction, error)
+	getTransactionByTxHashMutex       sync.RWMutex
+	getTransactionByTxHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getTransactionByTxHashReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	getTransactionByTxHashReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	GetTransactionsByStatusStub        func(context.Context, domain.TransactionStatus, int, int) ([]domain.Transaction, error)
+	getTransactionsByStatusMutex       sync.RWMutex
+	getTransactionsByStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.TransactionStatus
+		arg3 int
+		arg4 int
+	}
+	getTransactionsByStatusReturns struct {
+		result1 []domain.Transaction
+		result2 error
+	}
+	getTransactionsByStatusReturnsOnCall map[int]struct {
+		result1 []domain.Transaction
+		result2 error
+	}
+	ListTransactionsByUserIDStub        func(context.Context, uuid.UUID, int, int, domain.TransactionFilter) ([]domain.Transaction, int64, error)
+	listTransactionsByUserIDMutex       sync.RWMutex
+	listTransactionsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+		arg5 domain.TransactionFilter
+	}
+	listTransactionsByUserIDReturns struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}
+	listTransactionsByUserIDReturnsOnCall map[int]struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}
+	TransitionTransactionStatusStub        func(context.Context, uuid.UUID, domain.TransactionStatus, domain.TransactionStatus) (*domain.Transaction, error)
+	transitionTransactionStatusMutex       sync.RWMutex
+	transitionTransactionStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TransactionStatus
+		arg4 domain.TransactionStatus
+	}
+	transitionTransactionStatusReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	transitionTransactionStatusReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	UpdateTransactionBlockStub        func(context.Context, uuid.UUID, *int64, string) (*domain.Transaction, error)
+	updateTransactionBlockMutex       sync.RWMutex
+	updateTransactionBlockArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *int64
+		arg4 string
+	}
+	updateTransactionBlockReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	updateTransactionBlockReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	UpsertInboundTransferStub        func(context.Context, domain.Transaction) (*domain.Transaction, error)
+	upsertInboundTransferMutex       sync.RWMutex
+	upsertInboundTransferArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.Transaction
+	}
+	upsertInboundTransferReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	upsertInboundTransferReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransactionRepository) CreateTransaction(arg1 context.Context, arg2 domain.Transaction) (*domain.Transaction, error) {
+	fake.createTransactionMutex.Lock()
+	ret, specificReturn := fake.createTransactionReturnsOnCall[len(fake.createTransactionArgsForCall)]
+	fake.createTransactionArgsForCall = append(fake.createTransactionArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Transaction
+	}{arg1, arg2})
+	stub := fake.CreateTransactionStub
+	fakeReturns := fake.createTransactionReturns
+	fake.recordInvocation("CreateTransaction", []interface{}{arg1, arg2})
+	fake.createTransactionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) CreateTransactionCallCount() int {
+	fake.createTransactionMutex.RLock()
+	defer fake.createTransactionMutex.RUnlock()
+	return len(fake.createTransactionArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) CreateTransactionCalls(stub func(context.Context, domain.Transaction) (*domain.Transaction, error)) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = stub
+}
+
+func (fake *FakeTransactionRepository) CreateTransactionArgsForCall(i int) (context.Context, domain.Transaction) {
+	fake.createTransactionMutex.RLock()
+	defer fake.createTransactionMutex.RUnlock()
+	argsForCall := fake.createTransactionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionRepository) CreateTransactionReturns(result1 *domain.Transaction, result2 error) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = nil
+	fake.createTransactionReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) CreateTransactionReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = nil
+	if fake.createTransactionReturnsOnCall == nil {
+		fake.createTransactionReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.createTransactionReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByID(arg1 context.Context, arg2 uuid.UUID) (*domain.Transaction, error) {
+	fake.getTransactionByIDMutex.Lock()
+	ret, specificReturn := fake.getTransactionByIDReturnsOnCall[len(fake.getTransactionByIDArgsForCall)]
+	fake.getTransactionByIDArgsForCall = append(fake.getTransactionByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetTransactionByIDStub
+	fakeReturns := fake.getTransactionByIDReturns
+	fake.recordInvocation("GetTransactionByID", []interface{}{arg1, arg2})
+	fake.getTransactionByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByIDCallCount() int {
+	fake.getTransactionByIDMutex.RLock()
+	defer fake.getTransactionByIDMutex.RUnlock()
+	return len(fake.getTransactionByIDArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByIDCalls(stub func(context.Context, uuid.UUID) (*domain.Transaction, error)) {
+	fake.getTransactionByIDMutex.Lock()
+	defer fake.getTransactionByIDMutex.Unlock()
+	fake.GetTransactionByIDStub = stub
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getTransactionByIDMutex.RLock()
+	defer fake.getTransactionByIDMutex.RUnlock()
+	argsForCall := fake.getTransactionByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByIDReturns(result1 *domain.Transaction, result2 error) {
+	fake.getTransactionByIDMutex.Lock()
+	defer fake.getTransactionByIDMutex.Unlock()
+	fake.GetTransactionByIDStub = nil
+	fake.getTransactionByIDReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByIDReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.getTransactionByIDMutex.Lock()
+	defer fake.getTransactionByIDMutex.Unlock()
+	fake.GetTransactionByIDStub = nil
+	if fake.getTransactionByIDReturnsOnCall == nil {
+		fake.getTransactionByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.getTransactionByIDReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHash(arg1 context.Context, arg2 string) (*domain.Transaction, error) {
+	fake.getTransactionByTxHashMutex.Lock()
+	ret, specificReturn := fake.getTransactionByTxHashReturnsOnCall[len(fake.getTransactionByTxHashArgsForCall)]
+	fake.getTransactionByTxHashArgsForCall = append(fake.getTransactionByTxHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetTransactionByTxHashStub
+	fakeReturns := fake.getTransactionByTxHashReturns
+	fake.recordInvocation("GetTransactionByTxHash", []interface{}{arg1, arg2})
+	fake.getTransactionByTxHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHashCallCount() int {
+	fake.getTransactionByTxHashMutex.RLock()
+	defer fake.getTransactionByTxHashMutex.RUnlock()
+	return len(fake.getTransactionByTxHashArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHashCalls(stub func(context.Context, string) (*domain.Transaction, error)) {
+	fake.getTransactionByTxHashMutex.Lock()
+	defer fake.getTransactionByTxHashMutex.Unlock()
+	fake.GetTransactionByTxHashStub = stub
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHashArgsForCall(i int) (context.Context, string) {
+	fake.getTransactionByTxHashMutex.RLock()
+	defer fake.getTransactionByTxHashMutex.RUnlock()
+	argsForCall := fake.getTransactionByTxHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHashReturns(result1 *domain.Transaction, result2 error) {
+	fake.getTransactionByTxHashMutex.Lock()
+	defer fake.getTransactionByTxHashMutex.Unlock()
+	fake.GetTransactionByTxHashStub = nil
+	fake.getTransactionByTxHashReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionByTxHashReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.getTransactionByTxHashMutex.Lock()
+	defer fake.getTransactionByTxHashMutex.Unlock()
+	fake.GetTransactionByTxHashStub = nil
+	if fake.getTransactionByTxHashReturnsOnCall == nil {
+		fake.getTransactionByTxHashReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.getTransactionByTxHashReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatus(arg1 context.Context, arg2 domain.TransactionStatus, arg3 int, arg4 int) ([]domain.Transaction, error) {
+	fake.getTransactionsByStatusMutex.Lock()
+	ret, specificReturn := fake.getTransactionsByStatusReturnsOnCall[len(fake.getTransactionsByStatusArgsForCall)]
+	fake.getTransactionsByStatusArgsForCall = append(fake.getTransactionsByStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.TransactionStatus
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetTransactionsByStatusStub
+	fakeReturns := fake.getTransactionsByStatusReturns
+	fake.recordInvocation("GetTransactionsByStatus", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getTransactionsByStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatusCallCount() int {
+	fake.getTransactionsByStatusMutex.RLock()
+	defer fake.getTransactionsByStatusMutex.RUnlock()
+	return len(fake.getTransactionsByStatusArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatusCalls(stub func(context.Context, domain.TransactionStatus, int, int) ([]domain.Transaction, error)) {
+	fake.getTransactionsByStatusMutex.Lock()
+	defer fake.getTransactionsByStatusMutex.Unlock()
+	fake.GetTransactionsByStatusStub = stub
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatusArgsForCall(i int) (context.Context, domain.TransactionStatus, int, int) {
+	fake.getTransactionsByStatusMutex.RLock()
+	defer fake.getTransactionsByStatusMutex.RUnlock()
+	argsForCall := fake.getTransactionsByStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatusReturns(result1 []domain.Transaction, result2 error) {
+	fake.getTransactionsByStatusMutex.Lock()
+	defer fake.getTransactionsByStatusMutex.Unlock()
+	fake.GetTransactionsByStatusStub = nil
+	fake.getTransactionsByStatusReturns = struct {
+		result1 []domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) GetTransactionsByStatusReturnsOnCall(i int, result1 []domain.Transaction, result2 error) {
+	fake.getTransactionsByStatusMutex.Lock()
+	defer fake.getTransactionsByStatusMutex.Unlock()
+	fake.GetTransactionsByStatusStub = nil
+	if fake.getTransactionsByStatusReturnsOnCall == nil {
+		fake.getTransactionsByStatusReturnsOnCall = make(map[int]struct {
+			result1 []domain.Transaction
+			result2 error
+		})
+	}
+	fake.getTransactionsByStatusReturnsOnCall[i] = struct {
+		result1 []domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserID(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 int, arg5 domain.TransactionFilter) ([]domain.Transaction, int64, error) {
+	fake.listTransactionsByUserIDMutex.Lock()
+	ret, specificReturn := fake.listTransactionsByUserIDReturnsOnCall[len(fake.listTransactionsByUserIDArgsForCall)]
+	fake.listTransactionsByUserIDArgsForCall = append(fake.listTransactionsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+		arg5 domain.TransactionFilter
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListTransactionsByUserIDStub
+	fakeReturns := fake.listTransactionsByUserIDReturns
+	fake.recordInvocation("ListTransactionsByUserID", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listTransactionsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserIDCallCount() int {
+	fake.listTransactionsByUserIDMutex.RLock()
+	defer fake.listTransactionsByUserIDMutex.RUnlock()
+	return len(fake.listTransactionsByUserIDArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserIDCalls(stub func(context.Context, uuid.UUID, int, int, domain.TransactionFilter) ([]domain.Transaction, int64, error)) {
+	fake.listTransactionsByUserIDMutex.Lock()
+	defer fake.listTransactionsByUserIDMutex.Unlock()
+	fake.ListTransactionsByUserIDStub = stub
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserIDArgsForCall(i int) (context.Context, uuid.UUID, int, int, domain.TransactionFilter) {
+	fake.listTransactionsByUserIDMutex.RLock()
+	defer fake.listTransactionsByUserIDMutex.RUnlock()
+	argsForCall := fake.listTransactionsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserIDReturns(result1 []domain.Transaction, result2 int64, result3 error) {
+	fake.listTransactionsByUserIDMutex.Lock()
+	defer fake.listTransactionsByUserIDMutex.Unlock()
+	fake.ListTransactionsByUserIDStub = nil
+	fake.listTransactionsByUserIDReturns = struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTransactionRepository) ListTransactionsByUserIDReturnsOnCall(i int, result1 []domain.Transaction, result2 int64, result3 error) {
+	fake.listTransactionsByUserIDMutex.Lock()
+	defer fake.listTransactionsByUserIDMutex.Unlock()
+	fake.ListTransactionsByUserIDStub = nil
+	if fake.listTransactionsByUserIDReturnsOnCall == nil {
+		fake.listTransactionsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []domain.Transaction
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listTransactionsByUserIDReturnsOnCall[i] = struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatus(arg1 context.Context, arg2 uuid.UUID, arg3 domain.TransactionStatus, arg4 domain.TransactionStatus) (*domain.Transaction, error) {
+	fake.transitionTransactionStatusMutex.Lock()
+	ret, specificReturn := fake.transitionTransactionStatusReturnsOnCall[len(fake.transitionTransactionStatusArgsForCall)]
+	fake.transitionTransactionStatusArgsForCall = append(fake.transitionTransactionStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TransactionStatus
+		arg4 domain.TransactionStatus
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TransitionTransactionStatusStub
+	fakeReturns := fake.transitionTransactionStatusReturns
+	fake.recordInvocation("TransitionTransactionStatus", []interface{}{arg1, arg2, arg3, arg4})
+	fake.transitionTransactionStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatusCallCount() int {
+	fake.transitionTransactionStatusMutex.RLock()
+	defer fake.transitionTransactionStatusMutex.RUnlock()
+	return len(fake.transitionTransactionStatusArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatusCalls(stub func(context.Context, uuid.UUID, domain.TransactionStatus, domain.TransactionStatus) (*domain.Transaction, error)) {
+	fake.transitionTransactionStatusMutex.Lock()
+	defer fake.transitionTransactionStatusMutex.Unlock()
+	fake.TransitionTransactionStatusStub = stub
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatusArgsForCall(i int) (context.Context, uuid.UUID, domain.TransactionStatus, domain.TransactionStatus) {
+	fake.transitionTransactionStatusMutex.RLock()
+	defer fake.transitionTransactionStatusMutex.RUnlock()
+	argsForCall := fake.transitionTransactionStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatusReturns(result1 *domain.Transaction, result2 error) {
+	fake.transitionTransactionStatusMutex.Lock()
+	defer fake.transitionTransactionStatusMutex.Unlock()
+	fake.TransitionTransactionStatusStub = nil
+	fake.transitionTransactionStatusReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) TransitionTransactionStatusReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.transitionTransactionStatusMutex.Lock()
+	defer fake.transitionTransactionStatusMutex.Unlock()
+	fake.TransitionTransactionStatusStub = nil
+	if fake.transitionTransactionStatusReturnsOnCall == nil {
+		fake.transitionTransactionStatusReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.transitionTransactionStatusReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlock(arg1 context.Context, arg2 uuid.UUID, arg3 *int64, arg4 string) (*domain.Transaction, error) {
+	fake.updateTransactionBlockMutex.Lock()
+	ret, specificReturn := fake.updateTransactionBlockReturnsOnCall[len(fake.updateTransactionBlockArgsForCall)]
+	fake.updateTransactionBlockArgsForCall = append(fake.updateTransactionBlockArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 *int64
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateTransactionBlockStub
+	fakeReturns := fake.updateTransactionBlockReturns
+	fake.recordInvocation("UpdateTransactionBlock", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateTransactionBlockMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlockCallCount() int {
+	fake.updateTransactionBlockMutex.RLock()
+	defer fake.updateTransactionBlockMutex.RUnlock()
+	return len(fake.updateTransactionBlockArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlockCalls(stub func(context.Context, uuid.UUID, *int64, string) (*domain.Transaction, error)) {
+	fake.updateTransactionBlockMutex.Lock()
+	defer fake.updateTransactionBlockMutex.Unlock()
+	fake.UpdateTransactionBlockStub = stub
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlockArgsForCall(i int) (context.Context, uuid.UUID, *int64, string) {
+	fake.updateTransactionBlockMutex.RLock()
+	defer fake.updateTransactionBlockMutex.RUnlock()
+	argsForCall := fake.updateTransactionBlockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlockReturns(result1 *domain.Transaction, result2 error) {
+	fake.updateTransactionBlockMutex.Lock()
+	defer fake.updateTransactionBlockMutex.Unlock()
+	fake.UpdateTransactionBlockStub = nil
+	fake.updateTransactionBlockReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) UpdateTransactionBlockReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.updateTransactionBlockMutex.Lock()
+	defer fake.updateTransactionBlockMutex.Unlock()
+	fake.UpdateTransactionBlockStub = nil
+	if fake.updateTransactionBlockReturnsOnCall == nil {
+		fake.updateTransactionBlockReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.updateTransactionBlockReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransfer(arg1 context.Context, arg2 domain.Transaction) (*domain.Transaction, error) {
+	fake.upsertInboundTransferMutex.Lock()
+	ret, specificReturn := fake.upsertInboundTransferReturnsOnCall[len(fake.upsertInboundTransferArgsForCall)]
+	fake.upsertInboundTransferArgsForCall = append(fake.upsertInboundTransferArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.Transaction
+	}{arg1, arg2})
+	stub := fake.UpsertInboundTransferStub
+	fakeReturns := fake.upsertInboundTransferReturns
+	fake.recordInvocation("UpsertInboundTransfer", []interface{}{arg1, arg2})
+	fake.upsertInboundTransferMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransferCallCount() int {
+	fake.upsertInboundTransferMutex.RLock()
+	defer fake.upsertInboundTransferMutex.RUnlock()
+	return len(fake.upsertInboundTransferArgsForCall)
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransferCalls(stub func(context.Context, domain.Transaction) (*domain.Transaction, error)) {
+	fake.upsertInboundTransferMutex.Lock()
+	defer fake.upsertInboundTransferMutex.Unlock()
+	fake.UpsertInboundTransferStub = stub
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransferArgsForCall(i int) (context.Context, domain.Transaction) {
+	fake.upsertInboundTransferMutex.RLock()
+	defer fake.upsertInboundTransferMutex.RUnlock()
+	argsForCall := fake.upsertInboundTransferArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransferReturns(result1 *domain.Transaction, result2 error) {
+	fake.upsertInboundTransferMutex.Lock()
+	defer fake.upsertInboundTransferMutex.Unlock()
+	fake.UpsertInboundTransferStub = nil
+	fake.upsertInboundTransferReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) UpsertInboundTransferReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.upsertInboundTransferMutex.Lock()
+	defer fake.upsertInboundTransferMutex.Unlock()
+	fake.UpsertInboundTransferStub = nil
+	if fake.upsertInboundTransferReturnsOnCall == nil {
+		fake.upsertInboundTransferReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.upsertInboundTransferReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransactionRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TransactionRepository = new(FakeTransactionRepository)
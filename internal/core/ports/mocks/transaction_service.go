@@ -0,0 +1,375 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeTransactionService struct {
+	CreateTransactionStub        func(context.Context, uuid.UUID, string, *domain.TransactionTransfer) (*domain.Transaction, error)
+	createTransactionMutex       sync.RWMutex
+	createTransactionArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 *domain.TransactionTransfer
+	}
+	createTransactionReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	createTransactionReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	GetTransactionStub        func(context.Context, uuid.UUID, uuid.UUID) (*domain.Transaction, error)
+	getTransactionMutex       sync.RWMutex
+	getTransactionArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getTransactionReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	getTransactionReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	ListTransactionsStub        func(context.Context, uuid.UUID, int, int, domain.TransactionFilter) ([]domain.Transaction, int64, error)
+	listTransactionsMutex       sync.RWMutex
+	listTransactionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+		arg5 domain.TransactionFilter
+	}
+	listTransactionsReturns struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}
+	listTransactionsReturnsOnCall map[int]struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}
+	UpdateTransactionStatusStub        func(context.Context, uuid.UUID, domain.TransactionStatus) (*domain.Transaction, error)
+	updateTransactionStatusMutex       sync.RWMutex
+	updateTransactionStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TransactionStatus
+	}
+	updateTransactionStatusReturns struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	updateTransactionStatusReturnsOnCall map[int]struct {
+		result1 *domain.Transaction
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransactionService) CreateTransaction(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 *domain.TransactionTransfer) (*domain.Transaction, error) {
+	fake.createTransactionMutex.Lock()
+	ret, specificReturn := fake.createTransactionReturnsOnCall[len(fake.createTransactionArgsForCall)]
+	fake.createTransactionArgsForCall = append(fake.createTransactionArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 *domain.TransactionTransfer
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateTransactionStub
+	fakeReturns := fake.createTransactionReturns
+	fake.recordInvocation("CreateTransaction", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createTransactionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionService) CreateTransactionCallCount() int {
+	fake.createTransactionMutex.RLock()
+	defer fake.createTransactionMutex.RUnlock()
+	return len(fake.createTransactionArgsForCall)
+}
+
+func (fake *FakeTransactionService) CreateTransactionCalls(stub func(context.Context, uuid.UUID, string, *domain.TransactionTransfer) (*domain.Transaction, error)) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = stub
+}
+
+func (fake *FakeTransactionService) CreateTransactionArgsForCall(i int) (context.Context, uuid.UUID, string, *domain.TransactionTransfer) {
+	fake.createTransactionMutex.RLock()
+	defer fake.createTransactionMutex.RUnlock()
+	argsForCall := fake.createTransactionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTransactionService) CreateTransactionReturns(result1 *domain.Transaction, result2 error) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = nil
+	fake.createTransactionReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) CreateTransactionReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.createTransactionMutex.Lock()
+	defer fake.createTransactionMutex.Unlock()
+	fake.CreateTransactionStub = nil
+	if fake.createTransactionReturnsOnCall == nil {
+		fake.createTransactionReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.createTransactionReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) GetTransaction(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (*domain.Transaction, error) {
+	fake.getTransactionMutex.Lock()
+	ret, specificReturn := fake.getTransactionReturnsOnCall[len(fake.getTransactionArgsForCall)]
+	fake.getTransactionArgsForCall = append(fake.getTransactionArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetTransactionStub
+	fakeReturns := fake.getTransactionReturns
+	fake.recordInvocation("GetTransaction", []interface{}{arg1, arg2, arg3})
+	fake.getTransactionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionService) GetTransactionCallCount() int {
+	fake.getTransactionMutex.RLock()
+	defer fake.getTransactionMutex.RUnlock()
+	return len(fake.getTransactionArgsForCall)
+}
+
+func (fake *FakeTransactionService) GetTransactionCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (*domain.Transaction, error)) {
+	fake.getTransactionMutex.Lock()
+	defer fake.getTransactionMutex.Unlock()
+	fake.GetTransactionStub = stub
+}
+
+func (fake *FakeTransactionService) GetTransactionArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getTransactionMutex.RLock()
+	defer fake.getTransactionMutex.RUnlock()
+	argsForCall := fake.getTransactionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransactionService) GetTransactionReturns(result1 *domain.Transaction, result2 error) {
+	fake.getTransactionMutex.Lock()
+	defer fake.getTransactionMutex.Unlock()
+	fake.GetTransactionStub = nil
+	fake.getTransactionReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) GetTransactionReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.getTransactionMutex.Lock()
+	defer fake.getTransactionMutex.Unlock()
+	fake.GetTransactionStub = nil
+	if fake.getTransactionReturnsOnCall == nil {
+		fake.getTransactionReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.getTransactionReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) ListTransactions(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 int, arg5 domain.TransactionFilter) ([]domain.Transaction, int64, error) {
+	fake.listTransactionsMutex.Lock()
+	ret, specificReturn := fake.listTransactionsReturnsOnCall[len(fake.listTransactionsArgsForCall)]
+	fake.listTransactionsArgsForCall = append(fake.listTransactionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+		arg5 domain.TransactionFilter
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ListTransactionsStub
+	fakeReturns := fake.listTransactionsReturns
+	fake.recordInvocation("ListTransactions", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.listTransactionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTransactionService) ListTransactionsCallCount() int {
+	fake.listTransactionsMutex.RLock()
+	defer fake.listTransactionsMutex.RUnlock()
+	return len(fake.listTransactionsArgsForCall)
+}
+
+func (fake *FakeTransactionService) ListTransactionsCalls(stub func(context.Context, uuid.UUID, int, int, domain.TransactionFilter) ([]domain.Transaction, int64, error)) {
+	fake.listTransactionsMutex.Lock()
+	defer fake.listTransactionsMutex.Unlock()
+	fake.ListTransactionsStub = stub
+}
+
+func (fake *FakeTransactionService) ListTransactionsArgsForCall(i int) (context.Context, uuid.UUID, int, int, domain.TransactionFilter) {
+	fake.listTransactionsMutex.RLock()
+	defer fake.listTransactionsMutex.RUnlock()
+	argsForCall := fake.listTransactionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTransactionService) ListTransactionsReturns(result1 []domain.Transaction, result2 int64, result3 error) {
+	fake.listTransactionsMutex.Lock()
+	defer fake.listTransactionsMutex.Unlock()
+	fake.ListTransactionsStub = nil
+	fake.listTransactionsReturns = struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTransactionService) ListTransactionsReturnsOnCall(i int, result1 []domain.Transaction, result2 int64, result3 error) {
+	fake.listTransactionsMutex.Lock()
+	defer fake.listTransactionsMutex.Unlock()
+	fake.ListTransactionsStub = nil
+	if fake.listTransactionsReturnsOnCall == nil {
+		fake.listTransactionsReturnsOnCall = make(map[int]struct {
+			result1 []domain.Transaction
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listTransactionsReturnsOnCall[i] = struct {
+		result1 []domain.Transaction
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatus(arg1 context.Context, arg2 uuid.UUID, arg3 domain.TransactionStatus) (*domain.Transaction, error) {
+	fake.updateTransactionStatusMutex.Lock()
+	ret, specificReturn := fake.updateTransactionStatusReturnsOnCall[len(fake.updateTransactionStatusArgsForCall)]
+	fake.updateTransactionStatusArgsForCall = append(fake.updateTransactionStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 domain.TransactionStatus
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTransactionStatusStub
+	fakeReturns := fake.updateTransactionStatusReturns
+	fake.recordInvocation("UpdateTransactionStatus", []interface{}{arg1, arg2, arg3})
+	fake.updateTransactionStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatusCallCount() int {
+	fake.updateTransactionStatusMutex.RLock()
+	defer fake.updateTransactionStatusMutex.RUnlock()
+	return len(fake.updateTransactionStatusArgsForCall)
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatusCalls(stub func(context.Context, uuid.UUID, domain.TransactionStatus) (*domain.Transaction, error)) {
+	fake.updateTransactionStatusMutex.Lock()
+	defer fake.updateTransactionStatusMutex.Unlock()
+	fake.UpdateTransactionStatusStub = stub
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatusArgsForCall(i int) (context.Context, uuid.UUID, domain.TransactionStatus) {
+	fake.updateTransactionStatusMutex.RLock()
+	defer fake.updateTransactionStatusMutex.RUnlock()
+	argsForCall := fake.updateTransactionStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatusReturns(result1 *domain.Transaction, result2 error) {
+	fake.updateTransactionStatusMutex.Lock()
+	defer fake.updateTransactionStatusMutex.Unlock()
+	fake.UpdateTransactionStatusStub = nil
+	fake.updateTransactionStatusReturns = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) UpdateTransactionStatusReturnsOnCall(i int, result1 *domain.Transaction, result2 error) {
+	fake.updateTransactionStatusMutex.Lock()
+	defer fake.updateTransactionStatusMutex.Unlock()
+	fake.UpdateTransactionStatusStub = nil
+	if fake.updateTransactionStatusReturnsOnCall == nil {
+		fake.updateTransactionStatusReturnsOnCall = make(map[int]struct {
+			result1 *domain.Transaction
+			result2 error
+		})
+	}
+	fake.updateTransactionStatusReturnsOnCall[i] = struct {
+		result1 *domain.Transaction
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTransactionService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransactionService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.TransactionService = new(FakeTransactionService)
@@ -159,17 +159,17 @@ type FakeUserRepository struct {
 		result1 *domain.User
 		result2 error
 	}
-	UpdateUserBusinessDetailsStub        func(context.Context, domain.User) (*domain.User, error)
-	updateUserBusinessDetailsMutex       sync.RWMutex
-	updateUserBusinessDetailsArgsForCall []struct {
+	UpdateUserEmploymentTypeStub        func(context.Context, domain.User) (*domain.User, error)
+	updateUserEmploymentTypeMutex       sync.RWMutex
+	updateUserEmploymentTypeArgsForCall []struct {
 		arg1 context.Context
 		arg2 domain.User
 	}
-	updateUserBusinessDetailsReturns struct {
+	updateUserEmploymentTypeReturns struct {
 		result1 *domain.User
 		result2 error
 	}
-	updateUserBusinessDetailsReturnsOnCall map[int]struct {
+	updateUserEmploymentTypeReturnsOnCall map[int]struct {
 		result1 *domain.User
 		result2 error
 	}
@@ -896,17 +896,17 @@ func (fake *FakeUserRepository) UpdateUserAddressDetailsReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetails(arg1 context.Context, arg2 domain.User) (*domain.User, error) {
-	fake.updateUserBusinessDetailsMutex.Lock()
-	ret, specificReturn := fake.updateUserBusinessDetailsReturnsOnCall[len(fake.updateUserBusinessDetailsArgsForCall)]
-	fake.updateUserBusinessDetailsArgsForCall = append(fake.updateUserBusinessDetailsArgsForCall, struct {
+func (fake *FakeUserRepository) UpdateUserEmploymentType(arg1 context.Context, arg2 domain.User) (*domain.User, error) {
+	fake.updateUserEmploymentTypeMutex.Lock()
+	ret, specificReturn := fake.updateUserEmploymentTypeReturnsOnCall[len(fake.updateUserEmploymentTypeArgsForCall)]
+	fake.updateUserEmploymentTypeArgsForCall = append(fake.updateUserEmploymentTypeArgsForCall, struct {
 		arg1 context.Context
 		arg2 domain.User
 	}{arg1, arg2})
-	stub := fake.UpdateUserBusinessDetailsStub
-	fakeReturns := fake.updateUserBusinessDetailsReturns
-	fake.recordInvocation("UpdateUserBusinessDetails", []interface{}{arg1, arg2})
-	fake.updateUserBusinessDetailsMutex.Unlock()
+	stub := fake.UpdateUserEmploymentTypeStub
+	fakeReturns := fake.updateUserEmploymentTypeReturns
+	fake.recordInvocation("UpdateUserEmploymentType", []interface{}{arg1, arg2})
+	fake.updateUserEmploymentTypeMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -916,46 +916,46 @@ func (fake *FakeUserRepository) UpdateUserBusinessDetails(arg1 context.Context,
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetailsCallCount() int {
-	fake.updateUserBusinessDetailsMutex.RLock()
-	defer fake.updateUserBusinessDetailsMutex.RUnlock()
-	return len(fake.updateUserBusinessDetailsArgsForCall)
+func (fake *FakeUserRepository) UpdateUserEmploymentTypeCallCount() int {
+	fake.updateUserEmploymentTypeMutex.RLock()
+	defer fake.updateUserEmploymentTypeMutex.RUnlock()
+	return len(fake.updateUserEmploymentTypeArgsForCall)
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetailsCalls(stub func(context.Context, domain.User) (*domain.User, error)) {
-	fake.updateUserBusinessDetailsMutex.Lock()
-	defer fake.updateUserBusinessDetailsMutex.Unlock()
-	fake.UpdateUserBusinessDetailsStub = stub
+func (fake *FakeUserRepository) UpdateUserEmploymentTypeCalls(stub func(context.Context, domain.User) (*domain.User, error)) {
+	fake.updateUserEmploymentTypeMutex.Lock()
+	defer fake.updateUserEmploymentTypeMutex.Unlock()
+	fake.UpdateUserEmploymentTypeStub = stub
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetailsArgsForCall(i int) (context.Context, domain.User) {
-	fake.updateUserBusinessDetailsMutex.RLock()
-	defer fake.updateUserBusinessDetailsMutex.RUnlock()
-	argsForCall := fake.updateUserBusinessDetailsArgsForCall[i]
+func (fake *FakeUserRepository) UpdateUserEmploymentTypeArgsForCall(i int) (context.Context, domain.User) {
+	fake.updateUserEmploymentTypeMutex.RLock()
+	defer fake.updateUserEmploymentTypeMutex.RUnlock()
+	argsForCall := fake.updateUserEmploymentTypeArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetailsReturns(result1 *domain.User, result2 error) {
-	fake.updateUserBusinessDetailsMutex.Lock()
-	defer fake.updateUserBusinessDetailsMutex.Unlock()
-	fake.UpdateUserBusinessDetailsStub = nil
-	fake.updateUserBusinessDetailsReturns = struct {
+func (fake *FakeUserRepository) UpdateUserEmploymentTypeReturns(result1 *domain.User, result2 error) {
+	fake.updateUserEmploymentTypeMutex.Lock()
+	defer fake.updateUserEmploymentTypeMutex.Unlock()
+	fake.UpdateUserEmploymentTypeStub = nil
+	fake.updateUserEmploymentTypeReturns = struct {
 		result1 *domain.User
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeUserRepository) UpdateUserBusinessDetailsReturnsOnCall(i int, result1 *domain.User, result2 error) {
-	fake.updateUserBusinessDetailsMutex.Lock()
-	defer fake.updateUserBusinessDetailsMutex.Unlock()
-	fake.UpdateUserBusinessDetailsStub = nil
-	if fake.updateUserBusinessDetailsReturnsOnCall == nil {
-		fake.updateUserBusinessDetailsReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserRepository) UpdateUserEmploymentTypeReturnsOnCall(i int, result1 *domain.User, result2 error) {
+	fake.updateUserEmploymentTypeMutex.Lock()
+	defer fake.updateUserEmploymentTypeMutex.Unlock()
+	fake.UpdateUserEmploymentTypeStub = nil
+	if fake.updateUserEmploymentTypeReturnsOnCall == nil {
+		fake.updateUserEmploymentTypeReturnsOnCall = make(map[int]struct {
 			result1 *domain.User
 			result2 error
 		})
 	}
-	fake.updateUserBusinessDetailsReturnsOnCall[i] = struct {
+	fake.updateUserEmploymentTypeReturnsOnCall[i] = struct {
 		result1 *domain.User
 		result2 error
 	}{result1, result2}
@@ -1029,32 +1029,6 @@ func (fake *FakeUserRepository) UpdateUserPersonalDetailsReturnsOnCall(i int, re
 func (fake *FakeUserRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.checkEmailExistsMutex.RLock()
-	defer fake.checkEmailExistsMutex.RUnlock()
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	fake.deactivateUserMutex.RLock()
-	defer fake.deactivateUserMutex.RUnlock()
-	fake.deleteUserMutex.RLock()
-	defer fake.deleteUserMutex.RUnlock()
-	fake.getMFASecretMutex.RLock()
-	defer fake.getMFASecretMutex.RUnlock()
-	fake.getUserByEmailMutex.RLock()
-	defer fake.getUserByEmailMutex.RUnlock()
-	fake.getUserByIDMutex.RLock()
-	defer fake.getUserByIDMutex.RUnlock()
-	fake.setMFASecretMutex.RLock()
-	defer fake.setMFASecretMutex.RUnlock()
-	fake.updatePasswordMutex.RLock()
-	defer fake.updatePasswordMutex.RUnlock()
-	fake.updateUserMutex.RLock()
-	defer fake.updateUserMutex.RUnlock()
-	fake.updateUserAddressDetailsMutex.RLock()
-	defer fake.updateUserAddressDetailsMutex.RUnlock()
-	fake.updateUserBusinessDetailsMutex.RLock()
-	defer fake.updateUserBusinessDetailsMutex.RUnlock()
-	fake.updateUserPersonalDetailsMutex.RLock()
-	defer fake.updateUserPersonalDetailsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,497 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FakeWalletRepository struct {
+	CreateWalletStub        func(context.Context, domain.UserWallet) error
+	createWalletMutex       sync.RWMutex
+	createWalletArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+	}
+	createWalletReturns struct {
+		result1 error
+	}
+	createWalletReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteWalletStub        func(context.Context, uuid.UUID) error
+	deleteWalletMutex       sync.RWMutex
+	deleteWalletArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deleteWalletReturns struct {
+		result1 error
+	}
+	deleteWalletReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetWalletByAddressStub        func(context.Context, string) (*domain.UserWallet, error)
+	getWalletByAddressMutex       sync.RWMutex
+	getWalletByAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getWalletByAddressReturns struct {
+		result1 *domain.UserWallet
+		result2 error
+	}
+	getWalletByAddressReturnsOnCall map[int]struct {
+		result1 *domain.UserWallet
+		result2 error
+	}
+	GetWalletsByUserIDStub        func(context.Context, uuid.UUID) ([]domain.UserWallet, error)
+	getWalletsByUserIDMutex       sync.RWMutex
+	getWalletsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getWalletsByUserIDReturns struct {
+		result1 []domain.UserWallet
+		result2 error
+	}
+	getWalletsByUserIDReturnsOnCall map[int]struct {
+		result1 []domain.UserWallet
+		result2 error
+	}
+	ListWalletsStub        func(context.Context) ([]domain.UserWallet, error)
+	listWalletsMutex       sync.RWMutex
+	listWalletsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listWalletsReturns struct {
+		result1 []domain.UserWallet
+		result2 error
+	}
+	listWalletsReturnsOnCall map[int]struct {
+		result1 []domain.UserWallet
+		result2 error
+	}
+	UpdateWalletStub        func(context.Context, domain.UserWallet) error
+	updateWalletMutex       sync.RWMutex
+	updateWalletArgsForCall []struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+	}
+	updateWalletReturns struct {
+		result1 error
+	}
+	updateWalletReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWalletRepository) CreateWallet(arg1 context.Context, arg2 domain.UserWallet) error {
+	fake.createWalletMutex.Lock()
+	ret, specificReturn := fake.createWalletReturnsOnCall[len(fake.createWalletArgsForCall)]
+	fake.createWalletArgsForCall = append(fake.createWalletArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+	}{arg1, arg2})
+	stub := fake.CreateWalletStub
+	fakeReturns := fake.createWalletReturns
+	fake.recordInvocation("CreateWallet", []interface{}{arg1, arg2})
+	fake.createWalletMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWalletRepository) CreateWalletCallCount() int {
+	fake.createWalletMutex.RLock()
+	defer fake.createWalletMutex.RUnlock()
+	return len(fake.createWalletArgsForCall)
+}
+
+func (fake *FakeWalletRepository) CreateWalletCalls(stub func(context.Context, domain.UserWallet) error) {
+	fake.createWalletMutex.Lock()
+	defer fake.createWalletMutex.Unlock()
+	fake.CreateWalletStub = stub
+}
+
+func (fake *FakeWalletRepository) CreateWalletArgsForCall(i int) (context.Context, domain.UserWallet) {
+	fake.createWalletMutex.RLock()
+	defer fake.createWalletMutex.RUnlock()
+	argsForCall := fake.createWalletArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWalletRepository) CreateWalletReturns(result1 error) {
+	fake.createWalletMutex.Lock()
+	defer fake.createWalletMutex.Unlock()
+	fake.CreateWalletStub = nil
+	fake.createWalletReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) CreateWalletReturnsOnCall(i int, result1 error) {
+	fake.createWalletMutex.Lock()
+	defer fake.createWalletMutex.Unlock()
+	fake.CreateWalletStub = nil
+	if fake.createWalletReturnsOnCall == nil {
+		fake.createWalletReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createWalletReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) DeleteWallet(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteWalletMutex.Lock()
+	ret, specificReturn := fake.deleteWalletReturnsOnCall[len(fake.deleteWalletArgsForCall)]
+	fake.deleteWalletArgsForCall = append(fake.deleteWalletArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteWalletStub
+	fakeReturns := fake.deleteWalletReturns
+	fake.recordInvocation("DeleteWallet", []interface{}{arg1, arg2})
+	fake.deleteWalletMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWalletRepository) DeleteWalletCallCount() int {
+	fake.deleteWalletMutex.RLock()
+	defer fake.deleteWalletMutex.RUnlock()
+	return len(fake.deleteWalletArgsForCall)
+}
+
+func (fake *FakeWalletRepository) DeleteWalletCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteWalletMutex.Lock()
+	defer fake.deleteWalletMutex.Unlock()
+	fake.DeleteWalletStub = stub
+}
+
+func (fake *FakeWalletRepository) DeleteWalletArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteWalletMutex.RLock()
+	defer fake.deleteWalletMutex.RUnlock()
+	argsForCall := fake.deleteWalletArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWalletRepository) DeleteWalletReturns(result1 error) {
+	fake.deleteWalletMutex.Lock()
+	defer fake.deleteWalletMutex.Unlock()
+	fake.DeleteWalletStub = nil
+	fake.deleteWalletReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) DeleteWalletReturnsOnCall(i int, result1 error) {
+	fake.deleteWalletMutex.Lock()
+	defer fake.deleteWalletMutex.Unlock()
+	fake.DeleteWalletStub = nil
+	if fake.deleteWalletReturnsOnCall == nil {
+		fake.deleteWalletReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteWalletReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddress(arg1 context.Context, arg2 string) (*domain.UserWallet, error) {
+	fake.getWalletByAddressMutex.Lock()
+	ret, specificReturn := fake.getWalletByAddressReturnsOnCall[len(fake.getWalletByAddressArgsForCall)]
+	fake.getWalletByAddressArgsForCall = append(fake.getWalletByAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetWalletByAddressStub
+	fakeReturns := fake.getWalletByAddressReturns
+	fake.recordInvocation("GetWalletByAddress", []interface{}{arg1, arg2})
+	fake.getWalletByAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddressCallCount() int {
+	fake.getWalletByAddressMutex.RLock()
+	defer fake.getWalletByAddressMutex.RUnlock()
+	return len(fake.getWalletByAddressArgsForCall)
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddressCalls(stub func(context.Context, string) (*domain.UserWallet, error)) {
+	fake.getWalletByAddressMutex.Lock()
+	defer fake.getWalletByAddressMutex.Unlock()
+	fake.GetWalletByAddressStub = stub
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddressArgsForCall(i int) (context.Context, string) {
+	fake.getWalletByAddressMutex.RLock()
+	defer fake.getWalletByAddressMutex.RUnlock()
+	argsForCall := fake.getWalletByAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddressReturns(result1 *domain.UserWallet, result2 error) {
+	fake.getWalletByAddressMutex.Lock()
+	defer fake.getWalletByAddressMutex.Unlock()
+	fake.GetWalletByAddressStub = nil
+	fake.getWalletByAddressReturns = struct {
+		result1 *domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) GetWalletByAddressReturnsOnCall(i int, result1 *domain.UserWallet, result2 error) {
+	fake.getWalletByAddressMutex.Lock()
+	defer fake.getWalletByAddressMutex.Unlock()
+	fake.GetWalletByAddressStub = nil
+	if fake.getWalletByAddressReturnsOnCall == nil {
+		fake.getWalletByAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserWallet
+			result2 error
+		})
+	}
+	fake.getWalletByAddressReturnsOnCall[i] = struct {
+		result1 *domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserID(arg1 context.Context, arg2 uuid.UUID) ([]domain.UserWallet, error) {
+	fake.getWalletsByUserIDMutex.Lock()
+	ret, specificReturn := fake.getWalletsByUserIDReturnsOnCall[len(fake.getWalletsByUserIDArgsForCall)]
+	fake.getWalletsByUserIDArgsForCall = append(fake.getWalletsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetWalletsByUserIDStub
+	fakeReturns := fake.getWalletsByUserIDReturns
+	fake.recordInvocation("GetWalletsByUserID", []interface{}{arg1, arg2})
+	fake.getWalletsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserIDCallCount() int {
+	fake.getWalletsByUserIDMutex.RLock()
+	defer fake.getWalletsByUserIDMutex.RUnlock()
+	return len(fake.getWalletsByUserIDArgsForCall)
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserIDCalls(stub func(context.Context, uuid.UUID) ([]domain.UserWallet, error)) {
+	fake.getWalletsByUserIDMutex.Lock()
+	defer fake.getWalletsByUserIDMutex.Unlock()
+	fake.GetWalletsByUserIDStub = stub
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getWalletsByUserIDMutex.RLock()
+	defer fake.getWalletsByUserIDMutex.RUnlock()
+	argsForCall := fake.getWalletsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserIDReturns(result1 []domain.UserWallet, result2 error) {
+	fake.getWalletsByUserIDMutex.Lock()
+	defer fake.getWalletsByUserIDMutex.Unlock()
+	fake.GetWalletsByUserIDStub = nil
+	fake.getWalletsByUserIDReturns = struct {
+		result1 []domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) GetWalletsByUserIDReturnsOnCall(i int, result1 []domain.UserWallet, result2 error) {
+	fake.getWalletsByUserIDMutex.Lock()
+	defer fake.getWalletsByUserIDMutex.Unlock()
+	fake.GetWalletsByUserIDStub = nil
+	if fake.getWalletsByUserIDReturnsOnCall == nil {
+		fake.getWalletsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []domain.UserWallet
+			result2 error
+		})
+	}
+	fake.getWalletsByUserIDReturnsOnCall[i] = struct {
+		result1 []domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) ListWallets(arg1 context.Context) ([]domain.UserWallet, error) {
+	fake.listWalletsMutex.Lock()
+	ret, specificReturn := fake.listWalletsReturnsOnCall[len(fake.listWalletsArgsForCall)]
+	fake.listWalletsArgsForCall = append(fake.listWalletsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListWalletsStub
+	fakeReturns := fake.listWalletsReturns
+	fake.recordInvocation("ListWallets", []interface{}{arg1})
+	fake.listWalletsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWalletRepository) ListWalletsCallCount() int {
+	fake.listWalletsMutex.RLock()
+	defer fake.listWalletsMutex.RUnlock()
+	return len(fake.listWalletsArgsForCall)
+}
+
+func (fake *FakeWalletRepository) ListWalletsCalls(stub func(context.Context) ([]domain.UserWallet, error)) {
+	fake.listWalletsMutex.Lock()
+	defer fake.listWalletsMutex.Unlock()
+	fake.ListWalletsStub = stub
+}
+
+func (fake *FakeWalletRepository) ListWalletsArgsForCall(i int) context.Context {
+	fake.listWalletsMutex.RLock()
+	defer fake.listWalletsMutex.RUnlock()
+	argsForCall := fake.listWalletsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWalletRepository) ListWalletsReturns(result1 []domain.UserWallet, result2 error) {
+	fake.listWalletsMutex.Lock()
+	defer fake.listWalletsMutex.Unlock()
+	fake.ListWalletsStub = nil
+	fake.listWalletsReturns = struct {
+		result1 []domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) ListWalletsReturnsOnCall(i int, result1 []domain.UserWallet, result2 error) {
+	fake.listWalletsMutex.Lock()
+	defer fake.listWalletsMutex.Unlock()
+	fake.ListWalletsStub = nil
+	if fake.listWalletsReturnsOnCall == nil {
+		fake.listWalletsReturnsOnCall = make(map[int]struct {
+			result1 []domain.UserWallet
+			result2 error
+		})
+	}
+	fake.listWalletsReturnsOnCall[i] = struct {
+		result1 []domain.UserWallet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWalletRepository) UpdateWallet(arg1 context.Context, arg2 domain.UserWallet) error {
+	fake.updateWalletMutex.Lock()
+	ret, specificReturn := fake.updateWalletReturnsOnCall[len(fake.updateWalletArgsForCall)]
+	fake.updateWalletArgsForCall = append(fake.updateWalletArgsForCall, struct {
+		arg1 context.Context
+		arg2 domain.UserWallet
+	}{arg1, arg2})
+	stub := fake.UpdateWalletStub
+	fakeReturns := fake.updateWalletReturns
+	fake.recordInvocation("UpdateWallet", []interface{}{arg1, arg2})
+	fake.updateWalletMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWalletRepository) UpdateWalletCallCount() int {
+	fake.updateWalletMutex.RLock()
+	defer fake.updateWalletMutex.RUnlock()
+	return len(fake.updateWalletArgsForCall)
+}
+
+func (fake *FakeWalletRepository) UpdateWalletCalls(stub func(context.Context, domain.UserWallet) error) {
+	fake.updateWalletMutex.Lock()
+	defer fake.updateWalletMutex.Unlock()
+	fake.UpdateWalletStub = stub
+}
+
+func (fake *FakeWalletRepository) UpdateWalletArgsForCall(i int) (context.Context, domain.UserWallet) {
+	fake.updateWalletMutex.RLock()
+	defer fake.updateWalletMutex.RUnlock()
+	argsForCall := fake.updateWalletArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWalletRepository) UpdateWalletReturns(result1 error) {
+	fake.updateWalletMutex.Lock()
+	defer fake.updateWalletMutex.Unlock()
+	fake.UpdateWalletStub = nil
+	fake.updateWalletReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) UpdateWalletReturnsOnCall(i int, result1 error) {
+	fake.updateWalletMutex.Lock()
+	defer fake.updateWalletMutex.Unlock()
+	fake.UpdateWalletStub = nil
+	if fake.updateWalletReturnsOnCall == nil {
+		fake.updateWalletReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateWalletReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWalletRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWalletRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ports.WalletRepository = new(FakeWalletRepository)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +17,7 @@ type UserRepository interface {
 	UpdateUser(ctx context.Context, user domain.User) (*domain.User, error)
 	UpdateUserPersonalDetails(ctx context.Context, user domain.User) (*domain.User, error)
 	UpdateUserAddressDetails(ctx context.Context, user domain.User) (*domain.User, error)
-	UpdateUserBusinessDetails(ctx context.Context, user domain.User) (*domain.User, error)
+	UpdateUserEmploymentType(ctx context.Context, user domain.User) (*domain.User, error)
 	DeactivateUser(ctx context.Context, id uuid.UUID) error
 	UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error 
 	DeleteUser(ctx context.Context, id uuid.UUID) error
@@ -33,10 +34,292 @@ type WalletRepository interface {
 	CreateWallet(ctx context.Context, wallet domain.UserWallet) error
 	GetWalletByAddress(ctx context.Context, address string) (*domain.UserWallet, error)
 	GetWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]domain.UserWallet, error)
+	ListWallets(ctx context.Context) ([]domain.UserWallet, error)
 	UpdateWallet(ctx context.Context, wallet domain.UserWallet) error
 	DeleteWallet(ctx context.Context, walletID uuid.UUID) error
 }
 
+// PayoutAddressRepository defines the data access operations for the payout address allowlist
+type PayoutAddressRepository interface {
+	CreateWalletWithPayoutAddress(ctx context.Context, wallet domain.UserWallet, address domain.PayoutAddress) (*domain.PayoutAddress, error)
+	GetPayoutAddressByID(ctx context.Context, id uuid.UUID) (*domain.PayoutAddress, error)
+	GetPayoutAddressByCancelTokenHash(ctx context.Context, tokenHash string) (*domain.PayoutAddress, error)
+	GetLatestPayoutAddress(ctx context.Context, userID uuid.UUID, address string) (*domain.PayoutAddress, error)
+	ListPayoutAddressesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.PayoutAddress, error)
+	ActivateDuePayoutAddresses(ctx context.Context, now time.Time) error
+	CancelPayoutAddress(ctx context.Context, id uuid.UUID) (*domain.PayoutAddress, error)
+}
+
+// TransactionRepository defines the data access operations for on-chain transactions
+type TransactionRepository interface {
+	CreateTransaction(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error)
+	GetTransactionByID(ctx context.Context, id uuid.UUID) (*domain.Transaction, error)
+	GetTransactionByTxHash(ctx context.Context, txHash string) (*domain.Transaction, error)
+	ListTransactionsByUserID(ctx context.Context, userID uuid.UUID, limit, offset int, filter domain.TransactionFilter) ([]domain.Transaction, int64, error)
+	GetTransactionsByStatus(ctx context.Context, status domain.TransactionStatus, limit, offset int) ([]domain.Transaction, error)
+	TransitionTransactionStatus(ctx context.Context, id uuid.UUID, from, to domain.TransactionStatus) (*domain.Transaction, error)
+	UpdateTransactionBlock(ctx context.Context, id uuid.UUID, blockNumber *int64, blockHash string) (*domain.Transaction, error)
+	UpsertInboundTransfer(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error)
+}
+
+// SupportedAssetRepository defines the data access operations for the assets registry
+type SupportedAssetRepository interface {
+	CreateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error)
+	GetAssetByID(ctx context.Context, id uuid.UUID) (*domain.SupportedAsset, error)
+	GetAssetBySymbol(ctx context.Context, chain, symbol string) (*domain.SupportedAsset, error)
+	GetAssetByContract(ctx context.Context, chain, contractAddress string) (*domain.SupportedAsset, error)
+	ListAssets(ctx context.Context, filter domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)
+	UpdateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error)
+}
+
+// FXRateRepository stores fetched exchange rates and locked quotes
+type FXRateRepository interface {
+	SaveRate(ctx context.Context, rate domain.FXRate) (*domain.FXRate, error)
+	GetLatestRate(ctx context.Context, base, quote string) (*domain.FXRate, error)
+	ListLatestRates(ctx context.Context, base string) ([]domain.FXRate, error)
+	ListRateHistory(ctx context.Context, base, quote string, from, to time.Time, limit int) ([]domain.FXRate, error)
+	CreateQuote(ctx context.Context, quote domain.FXQuote) (*domain.FXQuote, error)
+	GetQuote(ctx context.Context, id uuid.UUID) (*domain.FXQuote, error)
+}
+
+// LedgerRepository defines the data access operations for the double-entry ledger
+type LedgerRepository interface {
+	CreateAccount(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error)
+	GetAccountByID(ctx context.Context, id uuid.UUID) (*domain.LedgerAccount, error)
+	GetAccountByCode(ctx context.Context, code string) (*domain.LedgerAccount, error)
+	ListAccountsByOwner(ctx context.Context, ownerID uuid.UUID) ([]domain.LedgerAccount, error)
+	// PostEntry atomically records an entry with its postings. If an entry with the
+	// same external reference exists, it is returned unchanged with created false.
+	PostEntry(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, bool, error)
+	GetEntryByReference(ctx context.Context, reference string) (*domain.LedgerEntry, error)
+	GetBalances(ctx context.Context, accountID uuid.UUID) ([]domain.LedgerBalance, error)
+	GetBalance(ctx context.Context, accountID uuid.UUID, asset string) (*domain.LedgerBalance, error)
+}
+
+// OrganizationRepository defines the data access operations for organizations and their members
+type OrganizationRepository interface {
+	// CreateOrganization atomically creates the organization with ownerID as its first owner
+	CreateOrganization(ctx context.Context, org domain.Organization, ownerID uuid.UUID) (*domain.Organization, error)
+	GetOrganizationByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error)
+	UpdateOrganization(ctx context.Context, org domain.Organization) (*domain.Organization, error)
+	ListOrganizationsByUser(ctx context.Context, userID uuid.UUID) ([]domain.OrganizationMembership, error)
+	AddMember(ctx context.Context, member domain.OrganizationMember) (*domain.OrganizationMember, error)
+	GetMember(ctx context.Context, orgID, userID uuid.UUID) (*domain.OrganizationMember, error)
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.OrganizationMember, error)
+	UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error)
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+	CountMembersByRole(ctx context.Context, orgID uuid.UUID, role domain.OrganizationRole) (int64, error)
+}
+
+// InvitationRepository defines the data access operations for organization invitations
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, invitation domain.Invitation) (*domain.Invitation, error)
+	GetInvitationByID(ctx context.Context, id uuid.UUID) (*domain.Invitation, error)
+	GetPendingInvitationByEmail(ctx context.Context, orgID uuid.UUID, email string) (*domain.Invitation, error)
+	ListPendingInvitations(ctx context.Context, orgID uuid.UUID) ([]domain.Invitation, error)
+	// RefreshInvitationToken replaces a pending invitation's token, or returns nil if it is no longer pending
+	RefreshInvitationToken(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (*domain.Invitation, error)
+	// AcceptInvitation atomically marks a pending invitation accepted and adds the user to the
+	// organization with the invited role. It returns nil if the invitation is no longer pending.
+	AcceptInvitation(ctx context.Context, id, userID uuid.UUID) (*domain.OrganizationMember, error)
+	// RevokeInvitation revokes a pending invitation, or returns nil if it is no longer pending
+	RevokeInvitation(ctx context.Context, id uuid.UUID) (*domain.Invitation, error)
+}
+
+// PayrollRepository defines the data access operations for payroll schedules,
+// compensation records and pay runs
+type PayrollRepository interface {
+	CreateSchedule(ctx context.Context, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	GetSchedule(ctx context.Context, id uuid.UUID) (*domain.PayrollSchedule, error)
+	ListSchedules(ctx context.Context, orgID uuid.UUID) ([]domain.PayrollSchedule, error)
+	UpdateSchedule(ctx context.Context, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]domain.PayrollSchedule, error)
+	CreateCompensation(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	GetCompensation(ctx context.Context, id uuid.UUID) (*domain.EmployeeCompensation, error)
+	// ListCompensations lists an organization's compensation records, all schedules when scheduleID is nil
+	ListCompensations(ctx context.Context, orgID uuid.UUID, scheduleID *uuid.UUID) ([]domain.EmployeeCompensation, error)
+	UpdateCompensation(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	// GeneratePayRun atomically creates the draft pay run for the schedule's pay date from its
+	// active compensation records and moves the schedule on to nextRunAt. It returns nil if the
+	// schedule is no longer due on payDate, so concurrent generators create the run once.
+	GeneratePayRun(ctx context.Context, scheduleID uuid.UUID, payDate, nextRunAt time.Time) (*domain.PayRun, error)
+	// GetPayRun retrieves a pay run with its line items
+	GetPayRun(ctx context.Context, id uuid.UUID) (*domain.PayRun, error)
+	// GetPreviousPayRun retrieves the schedule's latest pay run before the given pay date, with its line items
+	GetPreviousPayRun(ctx context.Context, scheduleID uuid.UUID, before time.Time) (*domain.PayRun, error)
+	ListPayRuns(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]domain.PayRun, int64, error)
+	// ApprovePayRun moves a draft pay run straight to approved and stores the payouts locked on
+	// its line items in one transaction. It reports false if the run is no longer a draft.
+	ApprovePayRun(ctx context.Context, run domain.PayRun) (bool, error)
+}
+
+// ApprovalRepository stores approval policies and the approval requests of pay runs
+type ApprovalRepository interface {
+	CreatePolicy(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	GetPolicy(ctx context.Context, id uuid.UUID) (*domain.ApprovalPolicy, error)
+	ListPolicies(ctx context.Context, orgID uuid.UUID) ([]domain.ApprovalPolicy, error)
+	UpdatePolicy(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	// CreateRequest moves the pay run from draft to pending approval, stores the payouts locked
+	// on its line items and creates the request in one transaction. It returns nil if the pay
+	// run is no longer a draft.
+	CreateRequest(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error)
+	// GetRequest retrieves a request with its decisions
+	GetRequest(ctx context.Context, id uuid.UUID) (*domain.ApprovalRequest, error)
+	// ListRequests lists an organization's requests, all statuses when status is nil
+	ListRequests(ctx context.Context, orgID uuid.UUID, status *domain.ApprovalStatus, limit, offset int) ([]domain.ApprovalRequest, int64, error)
+	// RecordDecision records an approver's decision and resolves the request once it is rejected
+	// or has its required approvals, moving the pay run along with it. It returns nil if the
+	// request is no longer open at now or the approver already decided.
+	RecordDecision(ctx context.Context, decision domain.ApprovalDecision, now time.Time) (*domain.ApprovalRequest, error)
+	// ExpireRequests expires the pending requests whose window has passed at now and returns
+	// their pay runs to draft
+	ExpireRequests(ctx context.Context, now time.Time) ([]domain.ApprovalRequest, error)
+}
+
+// ClientRepository stores organizations' client directories. A client's email
+// and tax ID are unique within its organization.
+type ClientRepository interface {
+	// CreateClient adds a client to the directory, or returns nil if one with the same email or tax ID exists
+	CreateClient(ctx context.Context, client domain.Client) (*domain.Client, error)
+	GetClient(ctx context.Context, orgID, id uuid.UUID) (*domain.Client, error)
+	// FindDuplicateClient finds another client of the organization with the same email or tax ID
+	FindDuplicateClient(ctx context.Context, orgID uuid.UUID, email, taxID string, excludeID uuid.UUID) (*domain.Client, error)
+	ListClients(ctx context.Context, orgID uuid.UUID, search string, limit, offset int) ([]domain.Client, int64, error)
+	UpdateClient(ctx context.Context, client domain.Client) (*domain.Client, error)
+	DeleteClient(ctx context.Context, orgID, id uuid.UUID) (bool, error)
+}
+
+// InvoiceRepository stores invoices with their line items. Status changes are
+// conditional on the current status and return nil when the invoice was no
+// longer in a status the change applies to.
+type InvoiceRepository interface {
+	// CreateInvoice numbers the invoice with the organization's next number and stores it with its
+	// line items in one transaction
+	CreateInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error)
+	// CreateTimesheetInvoice creates an invoice and marks the approved timesheets it bills invoiced in one
+	// transaction, or returns nil if any of them is no longer approved
+	CreateTimesheetInvoice(ctx context.Context, invoice domain.Invoice, timesheetIDs []uuid.UUID) (*domain.Invoice, error)
+	// GetInvoice retrieves an invoice with its line items
+	GetInvoice(ctx context.Context, id uuid.UUID) (*domain.Invoice, error)
+	// ListInvoices lists an organization's invoices without line items, all statuses when status is nil
+	ListInvoices(ctx context.Context, orgID uuid.UUID, status *domain.InvoiceStatus, limit, offset int) ([]domain.Invoice, int64, error)
+	// UpdateDraftInvoice replaces a draft invoice's details and line items
+	UpdateDraftInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error)
+	// MarkSent moves a draft invoice to sent and posts its issuance entry, if any, in the same transaction
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time, issued *domain.LedgerEntry) (*domain.Invoice, error)
+	// MarkViewed records the first time the customer opened an open invoice
+	MarkViewed(ctx context.Context, id uuid.UUID, at time.Time) (*domain.Invoice, error)
+	// MarkPaid moves an open invoice whose balance is still balanceDue to paid and posts the
+	// settlement, if any, in the same transaction; it returns nil if the invoice changed
+	MarkPaid(ctx context.Context, id uuid.UUID, balanceDue money.Money, at time.Time, reference string, settlement *domain.LedgerEntry) (*domain.Invoice, error)
+	Void(ctx context.Context, id uuid.UUID, at time.Time, reason string, reversal *domain.LedgerEntry) (*domain.Invoice, error)
+	// MarkOverdue moves up to limit sent, viewed and partially paid invoices due before now to overdue
+	MarkOverdue(ctx context.Context, now time.Time, limit int) ([]domain.Invoice, error)
+	GetDepositKey(ctx context.Context, orgID uuid.UUID) (*domain.InvoiceDepositKey, error)
+	// SaveDepositKey sets the organization's deposit key, starting again at index 0 if the key changed
+	SaveDepositKey(ctx context.Context, key domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error)
+	DeleteDepositKey(ctx context.Context, orgID uuid.UUID) error
+	// AllocateDepositIndex hands out the organization's next receiving address index with the key
+	// to derive it from; the key is empty if the organization has none
+	AllocateDepositIndex(ctx context.Context, orgID uuid.UUID) (string, int64, error)
+	// DepositAddressUsed reports whether an invoice already has the address as its deposit address
+	DepositAddressUsed(ctx context.Context, address string) (bool, error)
+	// ListInvoicesAwaitingDeposit lists the invoices with a deposit address that are not yet paid or
+	// voided, or were paid or voided since closedSince
+	ListInvoicesAwaitingDeposit(ctx context.Context, closedSince time.Time) ([]domain.Invoice, error)
+	// RecordInvoicePayment stores a transfer into an invoice's deposit address, settles the
+	// invoice against everything received and posts the entry settlement builds from the invoice
+	// before and after, in one transaction; it returns nil if the transfer was already recorded
+	RecordInvoicePayment(ctx context.Context, payment domain.InvoicePayment, at time.Time, settlement func(before, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error)
+	ListInvoicePayments(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoicePayment, error)
+}
+
+// RecurringInvoiceRepository stores recurring invoices with their line items
+type RecurringInvoiceRepository interface {
+	CreateRecurringInvoice(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	// GetRecurringInvoice retrieves a recurring invoice with its line items
+	GetRecurringInvoice(ctx context.Context, id uuid.UUID) (*domain.RecurringInvoice, error)
+	ListRecurringInvoices(ctx context.Context, orgID uuid.UUID) ([]domain.RecurringInvoice, error)
+	// UpdateRecurringInvoice replaces a recurring invoice's settings, status and line items
+	UpdateRecurringInvoice(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	// ListDueRecurringInvoices lists active recurring invoices, with line items, due at or before now
+	ListDueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]domain.RecurringInvoice, error)
+	// GenerateRecurringInvoice atomically creates the invoice the recurring invoice issues on
+	// invoice.IssueDate and moves it on to nextIssueAt, completing it if completed is set. It
+	// returns nil if the recurring invoice is no longer due on that date, so concurrent generators
+	// create the invoice once.
+	GenerateRecurringInvoice(ctx context.Context, recurringID uuid.UUID, invoice domain.Invoice, nextIssueAt time.Time, completed bool) (*domain.Invoice, error)
+}
+
+// InvoiceShareRepository stores the public links invoices are shared with
+type InvoiceShareRepository interface {
+	CreateShareLink(ctx context.Context, link domain.InvoiceShareLink) (*domain.InvoiceShareLink, error)
+	GetShareLink(ctx context.Context, id uuid.UUID) (*domain.InvoiceShareLink, error)
+	ListShareLinks(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoiceShareLink, error)
+	// RevokeShareLink revokes one of an invoice's share links, or returns nil if it was already revoked
+	RevokeShareLink(ctx context.Context, invoiceID, id, revokedBy uuid.UUID, at time.Time) (*domain.InvoiceShareLink, error)
+	// RecordShareLinkView counts a view through the link and records the customer's first view of the invoice
+	RecordShareLinkView(ctx context.Context, link domain.InvoiceShareLink, at time.Time) error
+}
+
+// InvoiceReminderRepository stores organizations' reminder settings and the
+// reminders sent for unpaid invoices
+type InvoiceReminderRepository interface {
+	GetReminderSettings(ctx context.Context, orgID uuid.UUID) (*domain.InvoiceReminderSettings, error)
+	SaveReminderSettings(ctx context.Context, settings domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)
+	// ListDueReminders lists invoices with a reminder step that has come due, with the latest such step of each
+	ListDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.DueInvoiceReminder, error)
+	// ClaimReminder records a reminder step before it is sent; it returns nil if the invoice is no
+	// longer awaiting payment or the step was already claimed
+	ClaimReminder(ctx context.Context, invoiceID uuid.UUID, offsetDays int, at time.Time) (*domain.InvoiceReminder, *domain.Invoice, error)
+	// ReleaseReminder removes a claimed reminder that could not be sent so it is tried again after a backoff, and returns how many attempts at the step have failed
+	ReleaseReminder(ctx context.Context, reminder domain.InvoiceReminder, failedAt time.Time) (int, error)
+	ListInvoiceReminders(ctx context.Context, invoiceID uuid.UUID) ([]domain.InvoiceReminder, error)
+}
+
+// CreditNoteRepository stores the credit notes issued against invoices
+type CreditNoteRepository interface {
+	// IssueCreditNote numbers the credit note, posts its ledger entry and applies it to the invoice in
+	// one transaction, or returns nil if the invoice is no longer open or its balance is below the amount
+	IssueCreditNote(ctx context.Context, note domain.CreditNote, entry domain.LedgerEntry) (*domain.CreditNote, *domain.Invoice, error)
+	GetCreditNote(ctx context.Context, orgID, id uuid.UUID) (*domain.CreditNote, error)
+	ListInvoiceCreditNotes(ctx context.Context, invoiceID uuid.UUID) ([]domain.CreditNote, error)
+	ListCreditNotes(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]domain.CreditNote, int64, error)
+}
+
+// TimesheetRepository stores contractors' contracts and weekly timesheets.
+// Status changes are conditional on the current status and return nil when
+// the timesheet was no longer in a status the change applies to.
+type TimesheetRepository interface {
+	// CreateContractorContract adds a contract, or returns nil if the contractor already has an active one for the project
+	CreateContractorContract(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error)
+	GetContractorContract(ctx context.Context, orgID, id uuid.UUID) (*domain.ContractorContract, error)
+	ListContractorContracts(ctx context.Context, orgID uuid.UUID, contractorID *uuid.UUID) ([]domain.ContractorContract, error)
+	UpdateContractorContract(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error)
+	// SaveTimesheet writes a week's timesheet and its entries as a draft, or returns nil if it was already submitted
+	SaveTimesheet(ctx context.Context, timesheet domain.Timesheet) (*domain.Timesheet, error)
+	GetTimesheet(ctx context.Context, orgID, id uuid.UUID) (*domain.Timesheet, error)
+	ListTimesheets(ctx context.Context, orgID uuid.UUID, filter domain.TimesheetFilter, limit, offset int) ([]domain.Timesheet, int64, error)
+	// SubmitTimesheet submits a draft timesheet with hours on it, or returns nil if it is not one
+	SubmitTimesheet(ctx context.Context, id uuid.UUID, at time.Time) (*domain.Timesheet, error)
+	// ReviewTimesheet approves or rejects a submitted timesheet, or returns nil if it is no longer submitted
+	ReviewTimesheet(ctx context.Context, id uuid.UUID, status domain.TimesheetStatus, reviewerID uuid.UUID, at time.Time, reason string) (*domain.Timesheet, error)
+}
+
+// IndexerCheckpointRepository stores how far each chain indexer has processed
+type IndexerCheckpointRepository interface {
+	GetCheckpoint(ctx context.Context, name string) (*domain.IndexerCheckpoint, error)
+	SaveCheckpoint(ctx context.Context, checkpoint domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error)
+}
+
+// TransactionPINRepository defines the data access operations for user transaction PINs
+type TransactionPINRepository interface {
+	GetTransactionPIN(ctx context.Context, userID uuid.UUID) (*domain.TransactionPIN, error)
+	SetTransactionPIN(ctx context.Context, userID uuid.UUID, pinHash string) (*domain.TransactionPIN, error)
+	RecordFailedAttempt(ctx context.Context, userID uuid.UUID, maxAttempts int, lockUntil time.Time) (*domain.TransactionPIN, error)
+	ResetFailedAttempts(ctx context.Context, userID uuid.UUID) error
+}
+
 type SecurityRepository interface {
 	LogSecurityEvent(ctx context.Context, event domain.SecurityEvent) error
 	GetRecentLoginsByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]domain.SecurityEvent, error)
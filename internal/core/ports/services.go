@@ -2,10 +2,15 @@ package ports
 
 import (
 	"context"
+	"io"
+	"math/big"
+	"time"
 
 	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/pkg/money"
 	emailEnums "github.com/demola234/defifundr/pkg/utils"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type AuthService interface {
@@ -19,7 +24,8 @@ type AuthService interface {
 	// User profile completion
 	RegisterPersonalDetails(ctx context.Context, user domain.User) (*domain.User, error)
 	RegisterAddressDetails(ctx context.Context, user domain.User) (*domain.User, error)
-	RegisterBusinessDetails(ctx context.Context, user domain.User) (*domain.User, error)
+	// RegisterBusinessDetails saves the company details to the organization the user set up; employmentType is optional
+	RegisterBusinessDetails(ctx context.Context, userID uuid.UUID, profile domain.Organization, employmentType *string) (*domain.User, error)
 	GetProfileCompletionStatus(ctx context.Context, userID uuid.UUID) (*domain.ProfileCompletion, error)
 
 	// Multi-factor authentication
@@ -69,6 +75,46 @@ type WaitlistService interface {
 	ExportWaitlist(ctx context.Context) ([]byte, error)
 }
 
+// PayoutAddressService defines the use cases for the payout address allowlist
+type PayoutAddressService interface {
+	RequestPayoutAddress(ctx context.Context, user domain.User, wallet domain.UserWallet) (*domain.PayoutAddress, error)
+	ListPayoutAddresses(ctx context.Context, userID uuid.UUID) ([]domain.PayoutAddress, error)
+	CancelPayoutAddress(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*domain.PayoutAddress, error)
+	GetPayoutAddressByCancelToken(ctx context.Context, token string) (*domain.PayoutAddress, error)
+	CancelPayoutAddressByToken(ctx context.Context, token string) (*domain.PayoutAddress, error)
+	ValidatePayoutAddress(ctx context.Context, userID uuid.UUID, address string) error
+}
+
+// TransactionService defines the use cases for tracking on-chain transactions
+type TransactionService interface {
+	CreateTransaction(ctx context.Context, userID uuid.UUID, txHash string, transfer *domain.TransactionTransfer) (*domain.Transaction, error)
+	GetTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*domain.Transaction, error)
+	ListTransactions(ctx context.Context, userID uuid.UUID, page, pageSize int, filter domain.TransactionFilter) ([]domain.Transaction, int64, error)
+	UpdateTransactionStatus(ctx context.Context, id uuid.UUID, status domain.TransactionStatus) (*domain.Transaction, error)
+}
+
+// TransactionPINService defines the use cases for the per-user transaction PIN
+type TransactionPINService interface {
+	GetPINStatus(ctx context.Context, userID uuid.UUID) (*domain.TransactionPINStatus, error)
+	SetPIN(ctx context.Context, userID uuid.UUID, pin string) error
+	ChangePIN(ctx context.Context, userID uuid.UUID, currentPIN, newPIN string) error
+	RequestPINReset(ctx context.Context, userID uuid.UUID) error
+	ResetPIN(ctx context.Context, userID uuid.UUID, otpCode, newPIN string) error
+	VerifyPIN(ctx context.Context, userID uuid.UUID, pin string) error
+}
+
+// AssetService manages the supported assets registry and checks amounts against it
+type AssetService interface {
+	ListAssets(ctx context.Context, filter domain.SupportedAssetFilter) ([]domain.SupportedAsset, error)
+	GetAsset(ctx context.Context, id uuid.UUID) (*domain.SupportedAsset, error)
+	CreateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error)
+	UpdateAsset(ctx context.Context, id uuid.UUID, name *string, enabled *bool) (*domain.SupportedAsset, error)
+	// ValidateAmount checks a display-unit amount of an asset identified by chain and the amount's currency code
+	ValidateAmount(ctx context.Context, chain string, amount money.Money) (*domain.SupportedAsset, error)
+	// ValidateBaseUnitAmount checks a base-unit amount of a token identified by chain and contract address
+	ValidateBaseUnitAmount(ctx context.Context, chain, contractAddress string, amount *big.Int) (*domain.SupportedAsset, error)
+}
+
 // EmailService defines methods for sending application emails
 type EmailSender interface {
 	SendEmail(ctx context.Context, recipient string, subject string, templateName string, data map[string]interface{}) error
@@ -84,4 +130,246 @@ type EmailService interface {
 	SendPasswordResetEmail(ctx context.Context, email, name, otpCode string) error
 	SendWaitlistInvitation(ctx context.Context, email, name string, inviteLink string) error
 	SendBatchUpdate(ctx context.Context, emails []string, subject, message string) error
+	SendPayoutAddressChangeNotification(ctx context.Context, email, name string, address domain.PayoutAddress, cancelLink string) error
+	SendPayoutAddressCancelledNotification(ctx context.Context, email, name string, address domain.PayoutAddress) error
+	SendTransactionPINResetEmail(ctx context.Context, email, name, otpCode string) error
+	SendTeamInvitation(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error
+	SendInvoice(ctx context.Context, invoice domain.Invoice, organizationName string, pdf []byte) error
+	SendInvoiceReminder(ctx context.Context, invoice domain.Invoice, organizationName string, offsetDays int) error
+}
+
+// TaxService works out the tax on a sale from a versioned table of rates
+type TaxService interface {
+	// Determine works out the treatment and rate of a sale at the given time, or returns a not
+	// found error when the seller's country has no rates in the table
+	Determine(ctx context.Context, seller, buyer domain.TaxParty, at time.Time) (*domain.TaxDetermination, error)
+}
+
+// FXService provides exchange rates and converts amounts at locked rates
+type FXService interface {
+	// GetRates returns the current rate from base to each quote, refreshing stale rates from the provider
+	GetRates(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error)
+	// GetRateHistory lists the stored rates of a pair, newest first
+	GetRateHistory(ctx context.Context, base, quote string, from, to *time.Time) ([]domain.FXRate, error)
+	// LockQuote locks the current rate of a pair for the configured window
+	LockQuote(ctx context.Context, base, quote string) (*domain.FXQuote, error)
+	GetQuote(ctx context.Context, id uuid.UUID) (*domain.FXQuote, error)
+	// Convert converts an amount in either currency of a locked quote into the other one
+	Convert(ctx context.Context, quoteID uuid.UUID, amount money.Money, places int32) (money.Money, error)
+}
+
+// LedgerService records balanced journal entries and reports account balances
+type LedgerService interface {
+	CreateAccount(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error)
+	GetAccount(ctx context.Context, id uuid.UUID) (*domain.LedgerAccount, error)
+	GetAccountByCode(ctx context.Context, code string) (*domain.LedgerAccount, error)
+	ListAccountsByOwner(ctx context.Context, ownerID uuid.UUID) ([]domain.LedgerAccount, error)
+	// PostEntry records a balanced entry once per external reference
+	PostEntry(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, error)
+	// ValidateEntry checks an entry would post, for entries recorded by other repositories
+	ValidateEntry(ctx context.Context, entry domain.LedgerEntry) error
+	GetEntry(ctx context.Context, reference string) (*domain.LedgerEntry, error)
+	GetBalances(ctx context.Context, accountID uuid.UUID) ([]domain.LedgerBalance, error)
+	GetBalance(ctx context.Context, accountID uuid.UUID, asset string) (*domain.LedgerBalance, error)
+}
+
+// OrganizationService manages business organizations and what each member may do in them
+type OrganizationService interface {
+	// CreateOrganization creates an organization owned by userID
+	CreateOrganization(ctx context.Context, userID uuid.UUID, org domain.Organization) (*domain.Organization, error)
+	ListOrganizations(ctx context.Context, userID uuid.UUID) ([]domain.OrganizationMembership, error)
+	GetOrganization(ctx context.Context, userID, orgID uuid.UUID) (*domain.Organization, error)
+	// GetOrganizationByID retrieves an organization without checking membership, for background jobs
+	GetOrganizationByID(ctx context.Context, orgID uuid.UUID) (*domain.Organization, error)
+	UpdateOrganization(ctx context.Context, userID, orgID uuid.UUID, org domain.Organization) (*domain.Organization, error)
+	ListMembers(ctx context.Context, userID, orgID uuid.UUID) ([]domain.OrganizationMember, error)
+	UpdateMemberRole(ctx context.Context, actorID, orgID, memberID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error)
+	RemoveMember(ctx context.Context, actorID, orgID, memberID uuid.UUID) error
+	// AuthorizeMember returns the user's membership of the organization if allowed accepts their role
+	AuthorizeMember(ctx context.Context, userID, orgID uuid.UUID, allowed func(domain.OrganizationRole) bool) (*domain.OrganizationMember, error)
+	// SaveBusinessProfile saves a business user's onboarding company details to the organization they set up, creating it the first time
+	SaveBusinessProfile(ctx context.Context, userID uuid.UUID, profile domain.Organization) (*domain.Organization, error)
+	// GetBusinessProfile returns the organization a business user set up during onboarding and still manages, or nil if there is none
+	GetBusinessProfile(ctx context.Context, userID uuid.UUID) (*domain.Organization, error)
+}
+
+// InvitationService invites people to organizations by email and lets them join
+type InvitationService interface {
+	// CreateInvitation records a pending invitation and emails a signed, expiring link
+	CreateInvitation(ctx context.Context, inviterID, orgID uuid.UUID, email string, role domain.OrganizationRole) (*domain.Invitation, error)
+	ListPendingInvitations(ctx context.Context, userID, orgID uuid.UUID) ([]domain.Invitation, error)
+	// ResendInvitation issues a new link with a fresh expiry; earlier links stop working
+	ResendInvitation(ctx context.Context, userID, orgID, invitationID uuid.UUID) (*domain.Invitation, error)
+	RevokeInvitation(ctx context.Context, userID, orgID, invitationID uuid.UUID) error
+	// GetInvitationByToken returns the invitation behind a link and whether an account already exists for its email
+	GetInvitationByToken(ctx context.Context, token string) (*domain.Invitation, bool, error)
+	// AcceptInvitation adds the signed-in user, whose email must match the invitation, to the organization
+	AcceptInvitation(ctx context.Context, userID uuid.UUID, token string) (*domain.OrganizationMember, error)
+	// AcceptInvitationWithRegistration registers a new email account for the invited address through
+	// AuthService.RegisterUser and adds it to the organization
+	AcceptInvitationWithRegistration(ctx context.Context, token string, user domain.User, password string) (*domain.User, *domain.OrganizationMember, error)
+}
+
+// PayrollService manages payroll schedules and employee compensation, and
+// generates draft pay runs on each schedule's pay dates
+type PayrollService interface {
+	CreateSchedule(ctx context.Context, userID, orgID uuid.UUID, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	ListSchedules(ctx context.Context, userID, orgID uuid.UUID) ([]domain.PayrollSchedule, error)
+	GetSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID) (*domain.PayrollSchedule, error)
+	// UpdateSchedule replaces a schedule's settings and recomputes its next pay date
+	UpdateSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error)
+	CreateCompensation(ctx context.Context, userID, orgID uuid.UUID, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	ListCompensations(ctx context.Context, userID, orgID uuid.UUID, scheduleID *uuid.UUID) ([]domain.EmployeeCompensation, error)
+	UpdateCompensation(ctx context.Context, userID, orgID, compensationID uuid.UUID, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error)
+	ListPayRuns(ctx context.Context, userID, orgID uuid.UUID, page, pageSize int) ([]domain.PayRun, int64, error)
+	GetPayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID) (*domain.PayRun, error)
+	// GenerateDuePayRuns creates the draft pay runs of every schedule whose pay date has arrived,
+	// catching up on dates missed while the generator was not running
+	GenerateDuePayRuns(ctx context.Context) (int, error)
+	// SimulateSchedule computes the schedule's pay run for payDate, or its next pay date when
+	// payDate is nil, without creating it
+	SimulateSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID, payDate *time.Time) (*domain.PayRunSimulation, error)
+	// SimulatePayRun computes what an existing pay run would pay out at the current rates
+	SimulatePayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID) (*domain.PayRunSimulation, error)
+	// ListPayslips builds the payslip of every employee in a pay run, with any tax on their pay broken out
+	ListPayslips(ctx context.Context, userID, orgID, payRunID uuid.UUID) ([]domain.Payslip, error)
+	// GetPayslip builds one employee's payslip in a pay run; employees can view their own
+	GetPayslip(ctx context.Context, userID, orgID, payRunID, employeeID uuid.UUID) (*domain.Payslip, error)
+}
+
+// ApprovalService runs the sign-off of pay runs under each organization's
+// approval policies and keeps an audit trail of every step
+type ApprovalService interface {
+	CreatePolicy(ctx context.Context, userID, orgID uuid.UUID, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	ListPolicies(ctx context.Context, userID, orgID uuid.UUID) ([]domain.ApprovalPolicy, error)
+	UpdatePolicy(ctx context.Context, userID, orgID, policyID uuid.UUID, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error)
+	// SubmitPayRun sends a draft pay run for approval under the strictest policy that applies to it.
+	// A pay run no policy applies to is approved straight away and no request is returned. Its
+	// payouts are locked with the quotes given, or at the current rate for pairs they do not cover.
+	SubmitPayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID, quoteIDs []uuid.UUID) (*domain.PayRun, *domain.ApprovalRequest, error)
+	ListRequests(ctx context.Context, userID, orgID uuid.UUID, status *domain.ApprovalStatus, page, pageSize int) ([]domain.ApprovalRequest, int64, error)
+	GetRequest(ctx context.Context, userID, orgID, requestID uuid.UUID) (*domain.ApprovalRequest, error)
+	// Approve records the user's approval; the pay run is approved once the request has its required approvals
+	Approve(ctx context.Context, userID, orgID, requestID uuid.UUID, comment string) (*domain.ApprovalRequest, error)
+	// Reject rejects the request and returns the pay run to draft
+	Reject(ctx context.Context, userID, orgID, requestID uuid.UUID, reason string) (*domain.ApprovalRequest, error)
+	// ExpireStaleApprovals expires requests whose approval window has passed and returns their pay runs to draft
+	ExpireStaleApprovals(ctx context.Context) (int, error)
+}
+
+// ClientService manages organizations' directories of the clients they invoice
+type ClientService interface {
+	CreateClient(ctx context.Context, userID, orgID uuid.UUID, client domain.Client) (*domain.Client, error)
+	// ListClients lists the organization's clients by name, optionally only those matching search
+	ListClients(ctx context.Context, userID, orgID uuid.UUID, search string, page, pageSize int) ([]domain.Client, int64, error)
+	GetClient(ctx context.Context, userID, orgID, clientID uuid.UUID) (*domain.Client, error)
+	UpdateClient(ctx context.Context, userID, orgID, clientID uuid.UUID, client domain.Client) (*domain.Client, error)
+	DeleteClient(ctx context.Context, userID, orgID, clientID uuid.UUID) error
+	// ImportClients adds the clients in a CSV file, leaving out invalid rows and duplicates
+	ImportClients(ctx context.Context, userID, orgID uuid.UUID, data io.Reader) (*domain.ClientImportResult, error)
+}
+
+// InvoiceService manages organizations' invoices through their lifecycle
+type InvoiceService interface {
+	CreateInvoice(ctx context.Context, userID, orgID uuid.UUID, invoice domain.Invoice) (*domain.Invoice, error)
+	// CreateTimesheetInvoice creates a draft invoice billing approved timesheets and marks them invoiced with it
+	CreateTimesheetInvoice(ctx context.Context, userID, orgID uuid.UUID, invoice domain.Invoice, timesheetIDs []uuid.UUID) (*domain.Invoice, error)
+	ListInvoices(ctx context.Context, userID, orgID uuid.UUID, status *domain.InvoiceStatus, page, pageSize int) ([]domain.Invoice, int64, error)
+	GetInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID) (*domain.Invoice, error)
+	// UpdateInvoice replaces the details and line items of a draft invoice
+	UpdateInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID, invoice domain.Invoice) (*domain.Invoice, error)
+	// SendInvoice emails the invoice to the customer with the PDF attached, marking a draft as sent
+	SendInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID) (*domain.Invoice, error)
+	// RenderInvoicePDF renders the invoice as a PDF and returns it with its file name
+	RenderInvoicePDF(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]byte, string, error)
+	// MarkPaid records payment of an open invoice received outside the platform
+	MarkPaid(ctx context.Context, userID, orgID, invoiceID uuid.UUID, paidAt *time.Time, reference string) (*domain.Invoice, error)
+	// VoidInvoice cancels an invoice that has not been paid or credited, reversing it in the ledger
+	VoidInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID, reason string) (*domain.Invoice, error)
+	// MarkOverdueInvoices moves open invoices past their due date to overdue
+	MarkOverdueInvoices(ctx context.Context) (int, error)
+	// PrepareInvoice normalises and validates an invoice and works out its amounts without storing it
+	PrepareInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error)
+	// AssignDepositAddress gives an invoice that asks for a deposit address the organization's next one
+	AssignDepositAddress(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error)
+	// DeliverInvoice emails an invoice on its organization's behalf, marking a draft as sent
+	DeliverInvoice(ctx context.Context, invoiceID uuid.UUID) (*domain.Invoice, error)
+	GetDepositKey(ctx context.Context, userID, orgID uuid.UUID) (*domain.InvoiceDepositKey, error)
+	// SetDepositKey sets the extended public key invoice deposit addresses are derived from and the
+	// underpayment still accepted as paid
+	SetDepositKey(ctx context.Context, userID, orgID uuid.UUID, extendedPublicKey string, toleranceBPS int) (*domain.InvoiceDepositKey, error)
+	// DeleteDepositKey stops deriving deposit addresses for new invoices
+	DeleteDepositKey(ctx context.Context, userID, orgID uuid.UUID) error
+	// ListInvoicePayments lists the on-chain payments matched to an invoice
+	ListInvoicePayments(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoicePayment, error)
+}
+
+// RecurringInvoiceService manages templates that generate an organization's
+// invoices on a schedule
+type RecurringInvoiceService interface {
+	// CreateRecurringInvoice creates a template that generates an invoice on each of its issue dates
+	CreateRecurringInvoice(ctx context.Context, userID, orgID uuid.UUID, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	ListRecurringInvoices(ctx context.Context, userID, orgID uuid.UUID) ([]domain.RecurringInvoice, error)
+	GetRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error)
+	// UpdateRecurringInvoice replaces a recurring invoice's settings and line items; invoices already generated keep theirs
+	UpdateRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error)
+	// PauseRecurringInvoice stops an active recurring invoice from generating invoices
+	PauseRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error)
+	// ResumeRecurringInvoice restarts a paused recurring invoice from its next issue date after now
+	ResumeRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error)
+	// PreviewRecurringInvoice lists the next count invoices the recurring invoice will generate, without creating them
+	PreviewRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID, count int) ([]domain.Invoice, error)
+	// GenerateRecurringInvoices creates the invoices of every recurring invoice that has come due, sending them if set to
+	GenerateRecurringInvoices(ctx context.Context) (int, error)
+}
+
+// InvoiceShareService shares sent invoices with customers through signed public links
+type InvoiceShareService interface {
+	// CreateShareLink issues a signed public link to a sent invoice and returns it with its URL
+	CreateShareLink(ctx context.Context, userID, orgID, invoiceID uuid.UUID, expiresAt *time.Time) (*domain.InvoiceShareLink, string, error)
+	ListShareLinks(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoiceShareLink, error)
+	RevokeShareLink(ctx context.Context, userID, orgID, invoiceID, linkID uuid.UUID) (*domain.InvoiceShareLink, error)
+	// GetSharedInvoice returns the read-only invoice behind a share link and records the view
+	GetSharedInvoice(ctx context.Context, token string) (*domain.SharedInvoice, error)
+	// RenderSharedInvoicePDF renders the invoice behind a share link and returns it with its file name
+	RenderSharedInvoicePDF(ctx context.Context, token string) ([]byte, string, error)
+}
+
+// InvoiceReminderService reminds customers of the invoices they have not paid
+type InvoiceReminderService interface {
+	// GetReminderSettings returns when the organization's customers are reminded of unpaid invoices
+	GetReminderSettings(ctx context.Context, userID, orgID uuid.UUID) (*domain.InvoiceReminderSettings, error)
+	UpdateReminderSettings(ctx context.Context, userID, orgID uuid.UUID, settings domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error)
+	ListInvoiceReminders(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoiceReminder, error)
+	// SendDueReminders emails the customer of every unpaid invoice whose next reminder has come due
+	SendDueReminders(ctx context.Context) (int, error)
+}
+
+// CreditNoteService credits the balance of open invoices
+type CreditNoteService interface {
+	// IssueCreditNote credits part of an open invoice's balance, or all of it when amount is nil
+	IssueCreditNote(ctx context.Context, userID, orgID, invoiceID uuid.UUID, amount *decimal.Decimal, reason string) (*domain.CreditNote, *domain.Invoice, error)
+	ListInvoiceCreditNotes(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.CreditNote, error)
+	ListCreditNotes(ctx context.Context, userID, orgID uuid.UUID, page, pageSize int) ([]domain.CreditNote, int64, error)
+	GetCreditNote(ctx context.Context, userID, orgID, creditNoteID uuid.UUID) (*domain.CreditNote, error)
+}
+
+// TimesheetService manages contractors' contracts and weekly timesheets, and
+// bills approved hours through the invoice service
+type TimesheetService interface {
+	// CreateContractorContract sets the hourly rate a contractor's time on a project is billed to a client at
+	CreateContractorContract(ctx context.Context, userID, orgID uuid.UUID, contract domain.ContractorContract) (*domain.ContractorContract, error)
+	// ListContractorContracts lists the organization's contracts, or only the caller's own for members who do not manage finances
+	ListContractorContracts(ctx context.Context, userID, orgID uuid.UUID) ([]domain.ContractorContract, error)
+	UpdateContractorContract(ctx context.Context, userID, orgID, contractID uuid.UUID, contract domain.ContractorContract) (*domain.ContractorContract, error)
+	// SaveTimesheet replaces the contractor's entries for a week on one of their contracts
+	SaveTimesheet(ctx context.Context, userID, orgID, contractID uuid.UUID, weekStart time.Time, entries []domain.TimesheetEntry) (*domain.Timesheet, error)
+	// ListTimesheets lists the organization's timesheets, or only the caller's own for members who do not manage finances
+	ListTimesheets(ctx context.Context, userID, orgID uuid.UUID, filter domain.TimesheetFilter, page, pageSize int) ([]domain.Timesheet, int64, error)
+	GetTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error)
+	SubmitTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error)
+	ApproveTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error)
+	RejectTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID, reason string) (*domain.Timesheet, error)
+	// InvoiceTimesheets raises a draft invoice billing the hours of approved timesheets at their contracts' rates
+	InvoiceTimesheets(ctx context.Context, userID, orgID uuid.UUID, timesheetIDs []uuid.UUID) (*domain.Invoice, error)
 }
@@ -0,0 +1,645 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxApprovalWindowHours caps how long an approval request may stay open
+const maxApprovalWindowHours = 30 * 24
+
+type approvalService struct {
+	approvalRepo  ports.ApprovalRepository
+	payrollRepo   ports.PayrollRepository
+	orgService    ports.OrganizationService
+	payoutService ports.PayoutAddressService
+	assetService  ports.AssetService
+	fxService     ports.FXService
+	securityRepo  ports.SecurityRepository
+	logger        logging.Logger
+	now           func() time.Time
+}
+
+// NewApprovalService creates a new pay run approval service
+func NewApprovalService(
+	approvalRepo ports.ApprovalRepository,
+	payrollRepo ports.PayrollRepository,
+	orgService ports.OrganizationService,
+	payoutService ports.PayoutAddressService,
+	assetService ports.AssetService,
+	fxService ports.FXService,
+	securityRepo ports.SecurityRepository,
+	logger logging.Logger,
+) ports.ApprovalService {
+	return &approvalService{
+		approvalRepo:  approvalRepo,
+		payrollRepo:   payrollRepo,
+		orgService:    orgService,
+		payoutService: payoutService,
+		assetService:  assetService,
+		fxService:     fxService,
+		securityRepo:  securityRepo,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// CreatePolicy creates an approval policy. Only owners and admins may change
+// the rules, so finance members cannot relax the controls on their own work.
+func (s *approvalService) CreatePolicy(ctx context.Context, userID, orgID uuid.UUID, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	policy.ID = uuid.New()
+	policy.OrganizationID = orgID
+	policy.CreatedBy = &userID
+	policy.Active = true
+
+	if err := s.preparePolicy(ctx, orgID, &policy); err != nil {
+		return nil, err
+	}
+
+	created, err := s.approvalRepo.CreatePolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logSecurityEvent(ctx, "approval_policy_created", userID, policyEventMetadata(*created))
+
+	return created, nil
+}
+
+// ListPolicies lists an organization's approval policies
+func (s *approvalService) ListPolicies(ctx context.Context, userID, orgID uuid.UUID) ([]domain.ApprovalPolicy, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	return s.approvalRepo.ListPolicies(ctx, orgID)
+}
+
+// UpdatePolicy replaces a policy's settings. Requests already submitted keep
+// the approvers and approval count they were created with.
+func (s *approvalService) UpdatePolicy(ctx context.Context, userID, orgID, policyID uuid.UUID, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.approvalRepo.GetPolicy(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("approval policy not found")
+	}
+
+	existing.Name = policy.Name
+	existing.Currency = policy.Currency
+	existing.MinTotalAmount = policy.MinTotalAmount
+	existing.MinPayoutAmount = policy.MinPayoutAmount
+	existing.RequiredApprovals = policy.RequiredApprovals
+	existing.ApproverIDs = policy.ApproverIDs
+	existing.ApprovalWindowHours = policy.ApprovalWindowHours
+	existing.Active = policy.Active
+
+	if err := s.preparePolicy(ctx, orgID, existing); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.approvalRepo.UpdatePolicy(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logSecurityEvent(ctx, "approval_policy_updated", userID, policyEventMetadata(*updated))
+
+	return updated, nil
+}
+
+// SubmitPayRun sends a draft pay run for approval. When several policies
+// apply, the one needing the most approvals wins. Every wallet on the run must
+// still be usable on its employee's payout address allowlist. Each payout is
+// converted into its asset with a locked quote and stored on the run, so what
+// is approved is what is paid: quoteIDs are quotes the submitter locked, and
+// the current rate is locked for any pair they do not cover.
+func (s *approvalService) SubmitPayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID, quoteIDs []uuid.UUID) (*domain.PayRun, *domain.ApprovalRequest, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, nil, err
+	}
+
+	run, err := s.getPayRun(ctx, orgID, payRunID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if run.Status != domain.PayRunStatusDraft {
+		return nil, nil, appErrors.NewConflictError(fmt.Sprintf("pay run is %s, only draft pay runs can be submitted", run.Status))
+	}
+	if len(run.LineItems) == 0 {
+		return nil, nil, appErrors.NewValidationError("pay run has no line items")
+	}
+	if err := s.validatePayoutAddresses(ctx, *run); err != nil {
+		return nil, nil, err
+	}
+
+	quotes, err := s.getQuotes(ctx, quoteIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if quotes, err = s.lockPayouts(ctx, run, quotes); err != nil {
+		return nil, nil, err
+	}
+
+	policy, err := s.selectPolicy(ctx, orgID, *run, quotes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if policy == nil {
+		ok, err := s.payrollRepo.ApprovePayRun(ctx, *run)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, appErrors.NewConflictError("pay run was submitted by someone else")
+		}
+
+		s.logSecurityEvent(ctx, "pay_run_approved", userID, map[string]interface{}{
+			"organization_id": orgID.String(),
+			"pay_run_id":      run.ID.String(),
+			"reason":          "no approval policy applies",
+		})
+
+		run.Status = domain.PayRunStatusApproved
+		return run, nil, nil
+	}
+
+	// The submitter cannot sign off on their own pay run
+	approvers := make([]uuid.UUID, 0, len(policy.ApproverIDs))
+	for _, approverID := range policy.ApproverIDs {
+		if approverID != userID {
+			approvers = append(approvers, approverID)
+		}
+	}
+	if len(approvers) < policy.RequiredApprovals {
+		return nil, nil, appErrors.NewValidationError(fmt.Sprintf(
+			"policy %q needs %d approvers other than the submitter", policy.Name, policy.RequiredApprovals))
+	}
+
+	request, err := s.approvalRepo.CreateRequest(ctx, domain.ApprovalRequest{
+		ID:                uuid.New(),
+		OrganizationID:    orgID,
+		PayRunID:          run.ID,
+		PolicyID:          &policy.ID,
+		RequiredApprovals: policy.RequiredApprovals,
+		ApproverIDs:       approvers,
+		Status:            domain.ApprovalStatusPending,
+		RequestedBy:       userID,
+		ExpiresAt:         s.now().Add(policy.ApprovalWindow()),
+	}, run.LineItems)
+	if err != nil {
+		return nil, nil, err
+	}
+	if request == nil {
+		return nil, nil, appErrors.NewConflictError("pay run was submitted by someone else")
+	}
+
+	s.logSecurityEvent(ctx, "pay_run_submitted_for_approval", userID, map[string]interface{}{
+		"organization_id":    orgID.String(),
+		"pay_run_id":         run.ID.String(),
+		"approval_request":   request.ID.String(),
+		"approval_policy":    policy.ID.String(),
+		"required_approvals": request.RequiredApprovals,
+		"expires_at":         request.ExpiresAt.Format(time.RFC3339),
+	})
+
+	run.Status = domain.PayRunStatusPendingApproval
+	return run, request, nil
+}
+
+// ListRequests lists an organization's approval requests, newest first
+func (s *approvalService) ListRequests(ctx context.Context, userID, orgID uuid.UUID, status *domain.ApprovalStatus, page, pageSize int) ([]domain.ApprovalRequest, int64, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, 0, err
+	}
+
+	if status != nil && !status.IsValid() {
+		return nil, 0, appErrors.NewValidationError(fmt.Sprintf("invalid approval status %q", *status))
+	}
+
+	return s.approvalRepo.ListRequests(ctx, orgID, status, pageSize, (page-1)*pageSize)
+}
+
+// GetRequest retrieves one of the organization's approval requests with its decisions
+func (s *approvalService) GetRequest(ctx context.Context, userID, orgID, requestID uuid.UUID) (*domain.ApprovalRequest, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	return s.getRequest(ctx, orgID, requestID)
+}
+
+// Approve records the user's approval of a pending request
+func (s *approvalService) Approve(ctx context.Context, userID, orgID, requestID uuid.UUID, comment string) (*domain.ApprovalRequest, error) {
+	return s.decide(ctx, userID, orgID, requestID, domain.ApprovalStatusApproved, strings.TrimSpace(comment))
+}
+
+// Reject rejects a pending request. A reason is required so the submitter
+// knows what to fix.
+func (s *approvalService) Reject(ctx context.Context, userID, orgID, requestID uuid.UUID, reason string) (*domain.ApprovalRequest, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, appErrors.NewValidationError("a reason is required to reject a pay run")
+	}
+
+	return s.decide(ctx, userID, orgID, requestID, domain.ApprovalStatusRejected, reason)
+}
+
+// ExpireStaleApprovals expires every pending request whose window has passed
+func (s *approvalService) ExpireStaleApprovals(ctx context.Context) (int, error) {
+	expired, err := s.approvalRepo.ExpireRequests(ctx, s.now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, request := range expired {
+		s.logSecurityEvent(ctx, "pay_run_approval_expired", request.RequestedBy, map[string]interface{}{
+			"organization_id":  request.OrganizationID.String(),
+			"pay_run_id":       request.PayRunID.String(),
+			"approval_request": request.ID.String(),
+			"expires_at":       request.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+
+	if len(expired) > 0 {
+		s.logger.Info("Stale pay run approvals expired", map[string]interface{}{
+			"count": len(expired),
+		})
+	}
+
+	return len(expired), nil
+}
+
+// decide records an approver's decision after checking they may make it
+func (s *approvalService) decide(ctx context.Context, userID, orgID, requestID uuid.UUID, decision domain.ApprovalStatus, comment string) (*domain.ApprovalRequest, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	request, err := s.getRequest(ctx, orgID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	switch {
+	case request.Status != domain.ApprovalStatusPending:
+		return nil, appErrors.NewConflictError(fmt.Sprintf("approval request is already %s", request.Status))
+	case !now.Before(request.ExpiresAt):
+		return nil, appErrors.NewConflictError("approval request has expired")
+	case request.RequestedBy == userID:
+		return nil, appErrors.NewForbiddenError("you cannot approve a pay run you submitted")
+	case !request.IsApprover(userID):
+		return nil, appErrors.NewForbiddenError("you are not an approver of this pay run")
+	case request.HasDecided(userID):
+		return nil, appErrors.NewConflictError("you have already decided on this pay run")
+	}
+
+	updated, err := s.approvalRepo.RecordDecision(ctx, domain.ApprovalDecision{
+		ID:         uuid.New(),
+		RequestID:  request.ID,
+		ApproverID: userID,
+		Decision:   decision,
+		Comment:    comment,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, appErrors.NewConflictError("approval request is no longer pending")
+	}
+
+	metadata := map[string]interface{}{
+		"organization_id":  orgID.String(),
+		"pay_run_id":       request.PayRunID.String(),
+		"approval_request": request.ID.String(),
+		"approvals":        updated.Approvals(),
+		"required":         updated.RequiredApprovals,
+	}
+	if comment != "" {
+		metadata["comment"] = comment
+	}
+
+	if decision == domain.ApprovalStatusRejected {
+		s.logSecurityEvent(ctx, "pay_run_rejected", userID, metadata)
+		return updated, nil
+	}
+
+	s.logSecurityEvent(ctx, "pay_run_approval_recorded", userID, metadata)
+	if updated.Status == domain.ApprovalStatusApproved {
+		s.logSecurityEvent(ctx, "pay_run_approved", userID, metadata)
+	}
+
+	return updated, nil
+}
+
+// validatePayoutAddresses checks each line item's wallet against its
+// employee's payout address allowlist
+func (s *approvalService) validatePayoutAddresses(ctx context.Context, run domain.PayRun) error {
+	for _, item := range run.LineItems {
+		err := s.payoutService.ValidatePayoutAddress(ctx, item.UserID, item.WalletAddress)
+		if err == nil {
+			continue
+		}
+		if appErrors.GetErrorType(err) != appErrors.ErrorTypeForbidden {
+			return err
+		}
+
+		name := strings.TrimSpace(item.FirstName + " " + item.LastName)
+		if name == "" {
+			name = item.Email
+		}
+		return appErrors.NewValidationError(fmt.Sprintf("%s cannot be paid to %s: %s", name, item.WalletAddress, errorDetails(err)))
+	}
+
+	return nil
+}
+
+// getQuotes loads the quotes a submitter locked, none of which may have expired
+func (s *approvalService) getQuotes(ctx context.Context, ids []uuid.UUID) ([]domain.FXQuote, error) {
+	quotes := make([]domain.FXQuote, 0, len(ids))
+	for _, id := range ids {
+		quote, err := s.fxService.GetQuote(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if quote.IsExpired(s.now()) {
+			return nil, appErrors.NewValidationError(fmt.Sprintf("quote %s has expired; lock a new one", quote.ID))
+		}
+		quotes = append(quotes, *quote)
+	}
+
+	return quotes, nil
+}
+
+// lockPayouts converts each of the run's line items into its payout asset with
+// a quote for the pair, locking the current rate for pairs the quotes do not
+// cover, and returns the quotes with any it locked
+func (s *approvalService) lockPayouts(ctx context.Context, run *domain.PayRun, quotes []domain.FXQuote) ([]domain.FXQuote, error) {
+	assets := make(map[uuid.UUID]*domain.SupportedAsset)
+
+	for i := range run.LineItems {
+		item := &run.LineItems[i]
+
+		asset, ok := assets[item.PayoutAssetID]
+		if !ok {
+			var err error
+			if asset, err = s.assetService.GetAsset(ctx, item.PayoutAssetID); err != nil {
+				return nil, err
+			}
+			assets[item.PayoutAssetID] = asset
+		}
+
+		if item.Amount.Currency() == asset.Symbol {
+			payout := item.Amount
+			item.LockedPayout = &payout
+			continue
+		}
+
+		quote := findQuote(quotes, item.Amount.Currency(), asset.Symbol)
+		if quote == nil {
+			locked, err := s.fxService.LockQuote(ctx, item.Amount.Currency(), asset.Symbol)
+			if err != nil {
+				return nil, fmt.Errorf("failed to lock a %s/%s quote for the pay run: %w", item.Amount.Currency(), asset.Symbol, err)
+			}
+			quotes = append(quotes, *locked)
+			quote = locked
+		}
+
+		payout, err := s.fxService.Convert(ctx, quote.ID, item.Amount, int32(min(asset.Decimals, 18)))
+		if err != nil {
+			return nil, err
+		}
+		quoteID := quote.ID
+		item.FXQuoteID = &quoteID
+		item.LockedPayout = &payout
+	}
+
+	return quotes, nil
+}
+
+// findQuote returns the quote converting between the two currencies, or nil if there is none
+func findQuote(quotes []domain.FXQuote, a, b string) *domain.FXQuote {
+	for i := range quotes {
+		if quotes[i].Converts(a, b) {
+			return &quotes[i]
+		}
+	}
+	return nil
+}
+
+// selectPolicy returns the active policy needing the most approvals among
+// those that apply to the pay run, or nil if none does
+func (s *approvalService) selectPolicy(ctx context.Context, orgID uuid.UUID, run domain.PayRun, quotes []domain.FXQuote) (*domain.ApprovalPolicy, error) {
+	policies, err := s.approvalRepo.ListPolicies(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]decimal.Decimal)
+	var selected *domain.ApprovalPolicy
+
+	for i := range policies {
+		policy := policies[i]
+		if !policy.Active {
+			continue
+		}
+		if selected != nil && selected.RequiredApprovals >= policy.RequiredApprovals {
+			continue
+		}
+
+		total := money.Zero(policy.Currency)
+		largest := money.Zero(policy.Currency)
+		for _, item := range run.LineItems {
+			amount, err := s.convert(ctx, item, policy.Currency, quotes, rates)
+			if err != nil {
+				return nil, err
+			}
+			total, _ = total.Add(amount)
+			if amount.Amount().GreaterThan(largest.Amount()) {
+				largest = amount
+			}
+		}
+
+		if policy.Applies(total, largest) {
+			selected = &policy
+		}
+	}
+
+	return selected, nil
+}
+
+// convert converts a line item's pay into a policy's currency. The locked
+// payout is used when it is in that currency, then a locked quote for the
+// pair, and otherwise the current rate, cached per currency pair for the
+// duration of a submission.
+func (s *approvalService) convert(ctx context.Context, item domain.PayRunLineItem, currency string, quotes []domain.FXQuote, rates map[string]decimal.Decimal) (money.Money, error) {
+	amount := item.Amount
+	if amount.Currency() == currency {
+		return amount, nil
+	}
+	if item.LockedPayout != nil && item.LockedPayout.Currency() == currency {
+		return *item.LockedPayout, nil
+	}
+
+	if quote := findQuote(quotes, amount.Currency(), currency); quote != nil {
+		places, err := currencyAmountPlaces(ctx, s.assetService, currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+		return s.fxService.Convert(ctx, quote.ID, amount, places)
+	}
+
+	pair := amount.Currency() + "/" + currency
+	rate, ok := rates[pair]
+	if !ok {
+		fxRates, err := s.fxService.GetRates(ctx, amount.Currency(), []string{currency})
+		if err != nil {
+			return money.Money{}, fmt.Errorf("failed to convert %s into %s for the approval policy: %w", amount.Currency(), currency, err)
+		}
+		if len(fxRates) == 0 {
+			return money.Money{}, fmt.Errorf("no exchange rate from %s to %s", amount.Currency(), currency)
+		}
+		rate = fxRates[0].Rate
+		rates[pair] = rate
+	}
+
+	return money.New(amount.Amount().Mul(rate), currency), nil
+}
+
+// preparePolicy normalises and validates a policy's settings. Every approver
+// must be able to manage the organization's finances.
+func (s *approvalService) preparePolicy(ctx context.Context, orgID uuid.UUID, policy *domain.ApprovalPolicy) error {
+	policy.Name = strings.TrimSpace(policy.Name)
+	if policy.Name == "" {
+		return appErrors.NewValidationError("name is required")
+	}
+
+	policy.Currency = strings.ToUpper(strings.TrimSpace(policy.Currency))
+	if policy.Currency == "" {
+		return appErrors.NewValidationError("currency is required")
+	}
+
+	for _, threshold := range []*money.Money{policy.MinTotalAmount, policy.MinPayoutAmount} {
+		if threshold == nil {
+			continue
+		}
+		if threshold.IsNegative() {
+			return appErrors.NewValidationError("thresholds cannot be negative")
+		}
+		if threshold.Currency() != policy.Currency {
+			return appErrors.NewValidationError("thresholds must be in the policy currency")
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(policy.ApproverIDs))
+	approvers := make([]uuid.UUID, 0, len(policy.ApproverIDs))
+	for _, approverID := range policy.ApproverIDs {
+		if seen[approverID] {
+			continue
+		}
+		seen[approverID] = true
+
+		if _, err := s.orgService.AuthorizeMember(ctx, approverID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+			switch appErrors.GetErrorType(err) {
+			case appErrors.ErrorTypeNotFound, appErrors.ErrorTypeForbidden:
+				return appErrors.NewValidationError(fmt.Sprintf("approver %s is not a finance member of the organization", approverID))
+			}
+			return err
+		}
+		approvers = append(approvers, approverID)
+	}
+	policy.ApproverIDs = approvers
+
+	if policy.RequiredApprovals < 1 {
+		return appErrors.NewValidationError("at least one approval must be required")
+	}
+	if policy.RequiredApprovals > len(policy.ApproverIDs) {
+		return appErrors.NewValidationError(fmt.Sprintf(
+			"%d approvals are required but only %d approvers are listed", policy.RequiredApprovals, len(policy.ApproverIDs)))
+	}
+
+	if policy.ApprovalWindowHours == 0 {
+		policy.ApprovalWindowHours = domain.DefaultApprovalWindowHours
+	}
+	if policy.ApprovalWindowHours < 1 || policy.ApprovalWindowHours > maxApprovalWindowHours {
+		return appErrors.NewValidationError(fmt.Sprintf("approval window must be between 1 and %d hours", maxApprovalWindowHours))
+	}
+
+	return nil
+}
+
+// getPayRun loads a pay run that belongs to the organization
+func (s *approvalService) getPayRun(ctx context.Context, orgID, payRunID uuid.UUID) (*domain.PayRun, error) {
+	run, err := s.payrollRepo.GetPayRun(ctx, payRunID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil || run.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("pay run not found")
+	}
+
+	return run, nil
+}
+
+// getRequest loads an approval request that belongs to the organization
+func (s *approvalService) getRequest(ctx context.Context, orgID, requestID uuid.UUID) (*domain.ApprovalRequest, error) {
+	request, err := s.approvalRepo.GetRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil || request.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("approval request not found")
+	}
+
+	return request, nil
+}
+
+// logSecurityEvent records an approval step in the security audit log
+func (s *approvalService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
+
+func policyEventMetadata(policy domain.ApprovalPolicy) map[string]interface{} {
+	approvers := make([]string, len(policy.ApproverIDs))
+	for i, approverID := range policy.ApproverIDs {
+		approvers[i] = approverID.String()
+	}
+
+	metadata := map[string]interface{}{
+		"organization_id":    policy.OrganizationID.String(),
+		"approval_policy":    policy.ID.String(),
+		"currency":           policy.Currency,
+		"required_approvals": policy.RequiredApprovals,
+		"approvers":          approvers,
+		"active":             policy.Active,
+	}
+	if policy.MinTotalAmount != nil {
+		metadata["min_total_amount"] = policy.MinTotalAmount.String()
+	}
+	if policy.MinPayoutAmount != nil {
+		metadata["min_payout_amount"] = policy.MinPayoutAmount.String()
+	}
+
+	return metadata
+}
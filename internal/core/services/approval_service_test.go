@@ -0,0 +1,694 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDraftPayRun is a draft pay run in orgID paying each amount to its own contractor
+func testDraftPayRun(orgID uuid.UUID, amounts ...money.Money) *domain.PayRun {
+	run := &domain.PayRun{ID: uuid.New(), OrganizationID: orgID, Status: domain.PayRunStatusDraft}
+	for _, amount := range amounts {
+		run.LineItems = append(run.LineItems, domain.PayRunLineItem{ID: uuid.New(), UserID: uuid.New(), Amount: amount})
+	}
+	return run
+}
+
+func amountPtr(amount, currency string) *money.Money {
+	m := money.MustParse(amount, currency)
+	return &m
+}
+
+func TestApprovalPolicy_Applies(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   domain.ApprovalPolicy
+		total    string
+		largest  string
+		expected bool
+	}{
+		{name: "no_thresholds", policy: domain.ApprovalPolicy{}, total: "1", largest: "1", expected: true},
+		{name: "total_reached", policy: domain.ApprovalPolicy{MinTotalAmount: amountPtr("10000", "USD")}, total: "10000", largest: "500", expected: true},
+		{name: "total_below", policy: domain.ApprovalPolicy{MinTotalAmount: amountPtr("10000", "USD")}, total: "9999.99", largest: "5000", expected: false},
+		{name: "large_payout", policy: domain.ApprovalPolicy{MinTotalAmount: amountPtr("10000", "USD"), MinPayoutAmount: amountPtr("2500", "USD")}, total: "3000", largest: "2500", expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			applies := tc.policy.Applies(money.MustParse(tc.total, "USD"), money.MustParse(tc.largest, "USD"))
+
+			// Assert
+			assert.Equal(t, tc.expected, applies)
+		})
+	}
+}
+
+func TestApprovalService_CreatePolicy(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID, financeID, otherFinanceID, viewerID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		adminID:        domain.OrganizationRoleAdmin,
+		financeID:      domain.OrganizationRoleFinance,
+		otherFinanceID: domain.OrganizationRoleFinance,
+		viewerID:       domain.OrganizationRoleViewer,
+	})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	policy, err := service.CreatePolicy(ctx, adminID, orgID, domain.ApprovalPolicy{
+		Name:              " Large runs ",
+		Currency:          "usd",
+		MinTotalAmount:    amountPtr("50000", "USD"),
+		RequiredApprovals: 2,
+		ApproverIDs:       []uuid.UUID{financeID, otherFinanceID, financeID},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Large runs", policy.Name)
+	assert.Equal(t, "USD", policy.Currency)
+	assert.Equal(t, []uuid.UUID{financeID, otherFinanceID}, policy.ApproverIDs)
+	assert.Equal(t, domain.DefaultApprovalWindowHours, policy.ApprovalWindowHours)
+	assert.True(t, policy.Active)
+	assert.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+
+	testCases := []struct {
+		name    string
+		userID  uuid.UUID
+		policy  domain.ApprovalPolicy
+		errType appErrors.ErrorType
+	}{
+		{
+			name:    "finance_cannot_set_rules",
+			userID:  financeID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{otherFinanceID}},
+			errType: appErrors.ErrorTypeForbidden,
+		},
+		{
+			name:    "viewer_cannot_approve",
+			userID:  adminID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{viewerID}},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:    "approver_outside_organization",
+			userID:  adminID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{uuid.New()}},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:    "more_approvals_than_approvers",
+			userID:  adminID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", RequiredApprovals: 3, ApproverIDs: []uuid.UUID{financeID, otherFinanceID}},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:    "threshold_in_other_currency",
+			userID:  adminID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", MinPayoutAmount: amountPtr("100", "EUR"), RequiredApprovals: 1, ApproverIDs: []uuid.UUID{financeID}},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:    "window_too_long",
+			userID:  adminID,
+			policy:  domain.ApprovalPolicy{Name: "Any", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{financeID}, ApprovalWindowHours: 24 * 365},
+			errType: appErrors.ErrorTypeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			_, err := service.CreatePolicy(ctx, tc.userID, orgID, tc.policy)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+		})
+	}
+}
+
+func TestApprovalService_SubmitPayRun_NoPolicyApplies(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	run := testDraftPayRun(orgID, money.MustParse("1000", "USD"))
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	mockApprovalRepo.ListPoliciesReturns([]domain.ApprovalPolicy{
+		{ID: uuid.New(), Currency: "USD", MinTotalAmount: amountPtr("5000", "USD"), RequiredApprovals: 1, Active: true},
+	}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	submitted, request, err := service.SubmitPayRun(context.Background(), financeID, orgID, run.ID, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	assert.Equal(t, domain.PayRunStatusApproved, submitted.Status)
+
+	_, approved := mockPayrollRepo.ApprovePayRunArgsForCall(0)
+	assert.Equal(t, run.ID, approved.ID)
+	// A payout already in its asset is locked as it is
+	assert.Equal(t, "1000 USD", approved.LineItems[0].LockedPayout.String())
+	assert.Nil(t, approved.LineItems[0].FXQuoteID)
+	assert.Equal(t, 0, mockApprovalRepo.CreateRequestCallCount())
+	assert.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+}
+
+func TestApprovalService_SubmitPayRun_SelectsStrictestPolicy(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	submitterID, approverA, approverB := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{submitterID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	// 1,500,000 NGN is locked at 1,000 USD, which makes it the largest payout
+	run := testDraftPayRun(orgID, money.MustParse("400", "USD"), money.MustParse("1500000", "NGN"))
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	quote := &domain.FXQuote{ID: uuid.New(), Base: "NGN", Quote: "USD", Rate: decimal.RequireFromString("0.000666666666666667"), ExpiresAt: now.Add(time.Minute)}
+	mockFXService.LockQuoteReturns(quote, nil)
+	mockFXService.ConvertReturns(money.MustParse("1000", "USD"), nil)
+
+	lenient := domain.ApprovalPolicy{
+		ID: uuid.New(), Name: "Everything", Currency: "USD", RequiredApprovals: 1,
+		ApproverIDs: []uuid.UUID{approverA}, ApprovalWindowHours: 24, Active: true,
+	}
+	strict := domain.ApprovalPolicy{
+		ID: uuid.New(), Name: "Large payouts", Currency: "USD", MinPayoutAmount: amountPtr("1000", "USD"), RequiredApprovals: 2,
+		ApproverIDs: []uuid.UUID{submitterID, approverA, approverB}, ApprovalWindowHours: 48, Active: true,
+	}
+	inactive := domain.ApprovalPolicy{
+		ID: uuid.New(), Name: "Old", Currency: "USD", RequiredApprovals: 3,
+		ApproverIDs: []uuid.UUID{approverA, approverB, uuid.New()}, ApprovalWindowHours: 24,
+	}
+	mockApprovalRepo.ListPoliciesReturns([]domain.ApprovalPolicy{inactive, strict, lenient}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	submitted, request, err := service.SubmitPayRun(context.Background(), submitterID, orgID, run.ID, nil)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, request)
+	assert.Equal(t, domain.PayRunStatusPendingApproval, submitted.Status)
+	assert.Equal(t, strict.ID, *request.PolicyID)
+	assert.Equal(t, 2, request.RequiredApprovals)
+	// The submitter is left out of the approvers of their own pay run
+	assert.Equal(t, []uuid.UUID{approverA, approverB}, request.ApproverIDs)
+	assert.Equal(t, now.Add(48*time.Hour), request.ExpiresAt)
+
+	// The pair without a quote was locked at submission and the policy
+	// was checked against the locked payout, not a live rate
+	assert.Equal(t, 1, mockFXService.LockQuoteCallCount())
+	assert.Zero(t, mockFXService.GetRatesCallCount())
+	_, _, lineItems := mockApprovalRepo.CreateRequestArgsForCall(0)
+	assert.Equal(t, quote.ID, *lineItems[1].FXQuoteID)
+	assert.Equal(t, "1000 USD", lineItems[1].LockedPayout.String())
+}
+
+func TestApprovalService_SubmitPayRun_UsesSubmittedQuote(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	run := testDraftPayRun(orgID, money.MustParse("920", "EUR"))
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	quote := &domain.FXQuote{ID: uuid.New(), Base: "USD", Quote: "EUR", Rate: decimal.RequireFromString("0.92"), ExpiresAt: now.Add(time.Minute)}
+	mockFXService.GetQuoteReturns(quote, nil)
+	mockFXService.ConvertReturns(money.MustParse("1000", "USD"), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	submitted, _, err := service.SubmitPayRun(context.Background(), financeID, orgID, run.ID, []uuid.UUID{quote.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.PayRunStatusApproved, submitted.Status)
+
+	// The submitted quote covers the pair either way round
+	assert.Zero(t, mockFXService.LockQuoteCallCount())
+	_, quoteID, amount, places := mockFXService.ConvertArgsForCall(0)
+	assert.Equal(t, quote.ID, quoteID)
+	assert.Equal(t, "920 EUR", amount.String())
+	assert.Equal(t, int32(6), places)
+
+	_, approved := mockPayrollRepo.ApprovePayRunArgsForCall(0)
+	assert.Equal(t, quote.ID, *approved.LineItems[0].FXQuoteID)
+	assert.Equal(t, "1000 USD", approved.LineItems[0].LockedPayout.String())
+}
+
+func TestApprovalService_SubmitPayRun_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		asViewer bool
+		amounts  []money.Money
+		quoteIDs []uuid.UUID
+		mutate   func(run *domain.PayRun)
+		errType  appErrors.ErrorType
+	}{
+		{name: "viewer_cannot_submit", asViewer: true, amounts: []money.Money{money.MustParse("100", "USD")}, errType: appErrors.ErrorTypeForbidden},
+		{name: "not_a_draft", amounts: []money.Money{money.MustParse("100", "USD")}, mutate: func(run *domain.PayRun) { run.Status = domain.PayRunStatusPendingApproval }, errType: appErrors.ErrorTypeConflict},
+		{name: "empty_pay_run", errType: appErrors.ErrorTypeValidation},
+		{name: "only_approver_is_the_submitter", amounts: []money.Money{money.MustParse("100", "USD")}, errType: appErrors.ErrorTypeValidation},
+		{name: "expired_quote", amounts: []money.Money{money.MustParse("100", "USD")}, quoteIDs: []uuid.UUID{uuid.New()}, errType: appErrors.ErrorTypeValidation},
+		{name: "other_organization", amounts: []money.Money{money.MustParse("100", "USD")}, mutate: func(run *domain.PayRun) { run.OrganizationID = uuid.New() }, errType: appErrors.ErrorTypeNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockApprovalRepo := new(mocks.FakeApprovalRepository)
+			mockPayrollRepo := new(mocks.FakePayrollRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockPayoutService := new(mocks.FakePayoutAddressService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockFXService := new(mocks.FakeFXService)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+			now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			submitterID, viewerID := uuid.New(), uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+				submitterID: domain.OrganizationRoleFinance,
+				viewerID:    domain.OrganizationRoleViewer,
+			})
+			mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+				return &policy, nil
+			}
+			mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+				return &request, nil
+			}
+			mockPayrollRepo.ApprovePayRunReturns(true, nil)
+			mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+			mockApprovalRepo.ListPoliciesReturns([]domain.ApprovalPolicy{
+				{ID: uuid.New(), Name: "Everything", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{submitterID}, Active: true},
+			}, nil)
+			mockFXService.GetQuoteReturns(&domain.FXQuote{ID: uuid.New(), Base: "USD", Quote: "EUR", ExpiresAt: now}, nil)
+
+			run := testDraftPayRun(orgID, tc.amounts...)
+			if tc.mutate != nil {
+				tc.mutate(run)
+			}
+			mockPayrollRepo.GetPayRunReturns(run, nil)
+			userID := submitterID
+			if tc.asViewer {
+				userID = viewerID
+			}
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, _, err := service.SubmitPayRun(context.Background(), userID, orgID, run.ID, tc.quoteIDs)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+		})
+	}
+}
+
+func TestApprovalService_SubmitPayRun_WalletNotUsable(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	submitterID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{submitterID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	mockApprovalRepo.ListPoliciesReturns([]domain.ApprovalPolicy{
+		{ID: uuid.New(), Name: "Everything", Currency: "USD", RequiredApprovals: 1, ApproverIDs: []uuid.UUID{uuid.New()}, Active: true},
+	}, nil)
+
+	run := testDraftPayRun(orgID, money.MustParse("100", "USD"))
+	run.LineItems[0].FirstName = "Ada"
+	run.LineItems[0].WalletAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	mockPayoutService.ValidatePayoutAddressReturns(appErrors.NewForbiddenError("payout address change was cancelled"))
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, _, err := service.SubmitPayRun(context.Background(), submitterID, orgID, run.ID, nil)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	assert.Contains(t, err.Error(), "Ada cannot be paid")
+	assert.Zero(t, mockApprovalRepo.CreateRequestCallCount())
+}
+
+// testApprovalRequest is a pending request for two approvals that decidedID
+// has already approved
+func testApprovalRequest(orgID, submitterID, approverID, decidedID uuid.UUID, expiresAt time.Time) *domain.ApprovalRequest {
+	return &domain.ApprovalRequest{
+		ID:                uuid.New(),
+		OrganizationID:    orgID,
+		PayRunID:          uuid.New(),
+		RequiredApprovals: 2,
+		ApproverIDs:       []uuid.UUID{approverID, decidedID},
+		Status:            domain.ApprovalStatusPending,
+		RequestedBy:       submitterID,
+		ExpiresAt:         expiresAt,
+		Decisions:         []domain.ApprovalDecision{{ApproverID: decidedID, Decision: domain.ApprovalStatusApproved}},
+	}
+}
+
+func TestApprovalService_Approve_Refused(t *testing.T) {
+	testCases := []struct {
+		name    string
+		as      string
+		mutate  func(r *domain.ApprovalRequest, now time.Time)
+		errType appErrors.ErrorType
+	}{
+		{name: "submitter", as: "submitter", errType: appErrors.ErrorTypeForbidden},
+		{name: "not_an_approver", as: "outsider", errType: appErrors.ErrorTypeForbidden},
+		{name: "already_decided", as: "decided", errType: appErrors.ErrorTypeConflict},
+		{name: "expired", as: "approver", mutate: func(r *domain.ApprovalRequest, now time.Time) { r.ExpiresAt = now }, errType: appErrors.ErrorTypeConflict},
+		{name: "already_rejected", as: "approver", mutate: func(r *domain.ApprovalRequest, now time.Time) { r.Status = domain.ApprovalStatusRejected }, errType: appErrors.ErrorTypeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockApprovalRepo := new(mocks.FakeApprovalRepository)
+			mockPayrollRepo := new(mocks.FakePayrollRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockPayoutService := new(mocks.FakePayoutAddressService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockFXService := new(mocks.FakeFXService)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+			now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			users := map[string]uuid.UUID{"submitter": uuid.New(), "approver": uuid.New(), "decided": uuid.New(), "outsider": uuid.New()}
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+				users["submitter"]: domain.OrganizationRoleFinance,
+				users["approver"]:  domain.OrganizationRoleFinance,
+				users["decided"]:   domain.OrganizationRoleAdmin,
+				users["outsider"]:  domain.OrganizationRoleFinance,
+			})
+			mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+				return &policy, nil
+			}
+			mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+				return &request, nil
+			}
+			mockPayrollRepo.ApprovePayRunReturns(true, nil)
+			mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+			request := testApprovalRequest(orgID, users["submitter"], users["approver"], users["decided"], now.Add(time.Hour))
+			if tc.mutate != nil {
+				tc.mutate(request, now)
+			}
+			mockApprovalRepo.GetRequestReturns(request, nil)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.Approve(context.Background(), users[tc.as], orgID, request.ID, "")
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Equal(t, 0, mockApprovalRepo.RecordDecisionCallCount())
+		})
+	}
+}
+
+func TestApprovalService_Reject_NeedsReason(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	submitterID, approverID, decidedID := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{approverID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	mockApprovalRepo.GetRequestReturns(testApprovalRequest(orgID, submitterID, approverID, decidedID, now.Add(time.Hour)), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.Reject(context.Background(), approverID, orgID, uuid.New(), "  ")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	assert.Equal(t, 0, mockApprovalRepo.RecordDecisionCallCount())
+}
+
+func TestApprovalService_Approve_FinalApproval(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	submitterID, approverID, decidedID := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{approverID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	request := testApprovalRequest(orgID, submitterID, approverID, decidedID, now.Add(time.Hour))
+	mockApprovalRepo.GetRequestReturns(request, nil)
+
+	approved := testApprovalRequest(orgID, submitterID, approverID, decidedID, now.Add(time.Hour))
+	approved.Status = domain.ApprovalStatusApproved
+	approved.Decisions = append(approved.Decisions, domain.ApprovalDecision{ApproverID: approverID, Decision: domain.ApprovalStatusApproved})
+	mockApprovalRepo.RecordDecisionReturns(approved, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	result, err := service.Approve(context.Background(), approverID, orgID, request.ID, " looks right ")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.ApprovalStatusApproved, result.Status)
+
+	_, decision, at := mockApprovalRepo.RecordDecisionArgsForCall(0)
+	assert.Equal(t, approverID, decision.ApproverID)
+	assert.Equal(t, domain.ApprovalStatusApproved, decision.Decision)
+	assert.Equal(t, "looks right", decision.Comment)
+	assert.Equal(t, now, at)
+
+	// The approval and the pay run sign-off are both audited
+	assert.Equal(t, 2, mockSecurityRepo.LogSecurityEventCallCount())
+}
+
+func TestApprovalService_Reject_LostRace(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	submitterID, approverID, decidedID := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{approverID: domain.OrganizationRoleFinance})
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	mockApprovalRepo.GetRequestReturns(testApprovalRequest(orgID, submitterID, approverID, decidedID, now.Add(time.Hour)), nil)
+	mockApprovalRepo.RecordDecisionReturns(nil, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.Reject(context.Background(), approverID, orgID, uuid.New(), "wrong amounts")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+}
+
+func TestApprovalService_ExpireStaleApprovals(t *testing.T) {
+	// Arrange
+	mockApprovalRepo := new(mocks.FakeApprovalRepository)
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockFXService := new(mocks.FakeFXService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+
+	now := time.Date(2025, 5, 23, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	mockApprovalRepo.CreatePolicyStub = func(ctx context.Context, policy domain.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+		return &policy, nil
+	}
+	mockApprovalRepo.CreateRequestStub = func(ctx context.Context, request domain.ApprovalRequest, lineItems []domain.PayRunLineItem) (*domain.ApprovalRequest, error) {
+		return &request, nil
+	}
+	mockPayrollRepo.ApprovePayRunReturns(true, nil)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: uuid.New(), Symbol: "USD", Decimals: 6}, nil)
+	mockApprovalRepo.ExpireRequestsReturns([]domain.ApprovalRequest{
+		{ID: uuid.New(), OrganizationID: orgID, PayRunID: uuid.New(), RequestedBy: uuid.New()},
+		{ID: uuid.New(), OrganizationID: orgID, PayRunID: uuid.New(), RequestedBy: uuid.New()},
+	}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewApprovalService(mockApprovalRepo, mockPayrollRepo, mockOrgService, mockPayoutService, mockAssetService, mockFXService, mockSecurityRepo, logging.New(&cfg)).(*approvalService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	expired, err := service.ExpireStaleApprovals(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, expired)
+
+	_, at := mockApprovalRepo.ExpireRequestsArgsForCall(0)
+	assert.Equal(t, now, at)
+	assert.Equal(t, 2, mockSecurityRepo.LogSecurityEventCallCount())
+
+	_, event := mockSecurityRepo.LogSecurityEventArgsForCall(0)
+	assert.Equal(t, "pay_run_approval_expired", event.EventType)
+}
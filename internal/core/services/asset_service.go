@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxAssetDecimals matches the decimals check constraint on supported_assets
+	maxAssetDecimals = 36
+	// tronAddressVersion prefixes every Tron address before base58check encoding
+	tronAddressVersion = 0x41
+)
+
+type assetService struct {
+	assetRepo ports.SupportedAssetRepository
+	logger    logging.Logger
+}
+
+// NewAssetService creates a new supported assets registry service
+func NewAssetService(assetRepo ports.SupportedAssetRepository, logger logging.Logger) ports.AssetService {
+	return &assetService{
+		assetRepo: assetRepo,
+		logger:    logger,
+	}
+}
+
+// ListAssets lists registered assets matching the filter
+func (s *assetService) ListAssets(ctx context.Context, filter domain.SupportedAssetFilter) ([]domain.SupportedAsset, error) {
+	filter.Chain = normalizeChain(filter.Chain)
+	return s.assetRepo.ListAssets(ctx, filter)
+}
+
+// GetAsset retrieves a registered asset by ID
+func (s *assetService) GetAsset(ctx context.Context, id uuid.UUID) (*domain.SupportedAsset, error) {
+	asset, err := s.assetRepo.GetAssetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if asset == nil {
+		return nil, appErrors.NewNotFoundError("asset not found")
+	}
+
+	return asset, nil
+}
+
+// CreateAsset registers a new asset. Chain and symbol are normalised to lower
+// and upper case, and must not already be registered.
+func (s *assetService) CreateAsset(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	asset.Chain = normalizeChain(asset.Chain)
+	asset.Symbol = strings.ToUpper(strings.TrimSpace(asset.Symbol))
+	asset.Name = strings.TrimSpace(asset.Name)
+	asset.ContractAddress = strings.TrimSpace(asset.ContractAddress)
+
+	if asset.Chain == "" || asset.Symbol == "" || asset.Name == "" {
+		return nil, appErrors.NewValidationError("chain, symbol and name are required")
+	}
+
+	if len(asset.Symbol) > 20 {
+		return nil, appErrors.NewValidationError("symbol must be at most 20 characters")
+	}
+
+	if asset.Decimals < 0 || asset.Decimals > maxAssetDecimals {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("decimals must be between 0 and %d", maxAssetDecimals))
+	}
+
+	if strings.ContainsAny(asset.ContractAddress, " \t\n") || len(asset.ContractAddress) > 100 {
+		return nil, appErrors.NewValidationError("invalid contract address")
+	}
+
+	existing, err := s.assetRepo.GetAssetBySymbol(ctx, asset.Chain, asset.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("%s is already registered on %s", asset.Symbol, asset.Chain))
+	}
+
+	if !asset.IsNative() {
+		existing, err = s.assetRepo.GetAssetByContract(ctx, asset.Chain, asset.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, appErrors.NewConflictError(fmt.Sprintf("contract %s is already registered as %s", asset.ContractAddress, existing.Symbol))
+		}
+	}
+
+	asset.ID = uuid.New()
+	created, err := s.assetRepo.CreateAsset(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Supported asset registered", map[string]interface{}{
+		"asset_id": created.ID,
+		"chain":    created.Chain,
+		"symbol":   created.Symbol,
+		"enabled":  created.Enabled,
+	})
+
+	return created, nil
+}
+
+// UpdateAsset renames an asset or switches it on or off. Decimals and the
+// contract address are fixed once registered, since stored amounts depend on them.
+func (s *assetService) UpdateAsset(ctx context.Context, id uuid.UUID, name *string, enabled *bool) (*domain.SupportedAsset, error) {
+	asset, err := s.GetAsset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		asset.Name = strings.TrimSpace(*name)
+		if asset.Name == "" {
+			return nil, appErrors.NewValidationError("name cannot be empty")
+		}
+	}
+
+	if enabled != nil {
+		asset.Enabled = *enabled
+	}
+
+	updated, err := s.assetRepo.UpdateAsset(ctx, *asset)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Supported asset updated", map[string]interface{}{
+		"asset_id": updated.ID,
+		"chain":    updated.Chain,
+		"symbol":   updated.Symbol,
+		"enabled":  updated.Enabled,
+	})
+
+	return updated, nil
+}
+
+// ValidateAmount checks that the amount's asset is registered and enabled on the
+// chain, and that the amount is positive with no more decimal places than the asset has
+func (s *assetService) ValidateAmount(ctx context.Context, chain string, amount money.Money) (*domain.SupportedAsset, error) {
+	chain = normalizeChain(chain)
+	symbol := amount.Currency()
+
+	asset, err := s.assetRepo.GetAssetBySymbol(ctx, chain, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAssetUsable(asset, symbol, chain); err != nil {
+		return nil, err
+	}
+
+	if !amount.IsPositive() {
+		return nil, appErrors.NewValidationError("amount must be greater than zero")
+	}
+
+	if _, err := amount.ToBaseUnits(asset.Decimals); err != nil {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("%s supports at most %d decimal places", asset.Symbol, asset.Decimals))
+	}
+
+	return asset, nil
+}
+
+// ValidateBaseUnitAmount checks that the token is registered and enabled on the chain, and that the amount is positive
+func (s *assetService) ValidateBaseUnitAmount(ctx context.Context, chain, contractAddress string, amount *big.Int) (*domain.SupportedAsset, error) {
+	chain = normalizeChain(chain)
+
+	asset, err := s.assetRepo.GetAssetByContract(ctx, chain, strings.TrimSpace(contractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAssetUsable(asset, contractAddress, chain); err != nil {
+		return nil, err
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, appErrors.NewValidationError("amount must be greater than zero")
+	}
+
+	return asset, nil
+}
+
+// checkAssetUsable rejects assets that are not registered or have been disabled
+func checkAssetUsable(asset *domain.SupportedAsset, identifier, chain string) error {
+	if asset == nil {
+		return appErrors.NewValidationError(fmt.Sprintf("%s is not a supported asset on %s", identifier, chain))
+	}
+
+	if !asset.Enabled {
+		return appErrors.NewValidationError(fmt.Sprintf("%s on %s is currently disabled", asset.Symbol, chain))
+	}
+
+	return nil
+}
+
+// normalizeChain returns the lower-case chain slug used across the registry and wallets
+func normalizeChain(chain string) string {
+	return strings.ToLower(strings.TrimSpace(chain))
+}
+
+// normalizeAddress checks that the address is a valid, non-zero recipient on
+// the chain and returns it in canonical form: base58 on Tron and Solana, and
+// checksummed hex on every other chain, which are EVM chains
+func normalizeAddress(chain, address string) (string, bool) {
+	address = strings.TrimSpace(address)
+
+	switch normalizeChain(chain) {
+	case "tron":
+		payload, version, err := base58.CheckDecode(address)
+		if err != nil || version != tronAddressVersion || len(payload) != common.AddressLength || common.BytesToAddress(payload) == (common.Address{}) {
+			return "", false
+		}
+		return address, true
+	case "solana":
+		key := base58.Decode(address)
+		if len(key) != 32 || common.BytesToHash(key) == (common.Hash{}) {
+			return "", false
+		}
+		return address, true
+	default:
+		if !common.IsHexAddress(address) || common.HexToAddress(address) == (common.Address{}) {
+			return "", false
+		}
+		return common.HexToAddress(address).Hex(), true
+	}
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAssetTestService() (*mocks.FakeSupportedAssetRepository, *assetService) {
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewAssetService(mockAssetRepo, logging.New(&cfg))
+	return mockAssetRepo, service.(*assetService)
+}
+
+func testUSDCAsset() *domain.SupportedAsset {
+	return &domain.SupportedAsset{
+		ID:              uuid.New(),
+		Chain:           "ethereum",
+		Symbol:          "USDC",
+		Name:            "USD Coin",
+		ContractAddress: testUSDC,
+		Decimals:        6,
+		Enabled:         true,
+	}
+}
+
+func TestAssetService_CreateAsset(t *testing.T) {
+	mockAssetRepo, service := newAssetTestService()
+	mockAssetRepo.CreateAssetStub = func(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error) {
+		return &asset, nil
+	}
+
+	result, err := service.CreateAsset(context.Background(), domain.SupportedAsset{
+		Chain:           " Base ",
+		Symbol:          "usdc",
+		Name:            "USD Coin",
+		ContractAddress: "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913",
+		Decimals:        6,
+		Enabled:         true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "base", result.Chain)
+	assert.Equal(t, "USDC", result.Symbol)
+	assert.NotEqual(t, uuid.Nil, result.ID)
+
+	_, chain, symbol := mockAssetRepo.GetAssetBySymbolArgsForCall(0)
+	assert.Equal(t, "base", chain)
+	assert.Equal(t, "USDC", symbol)
+}
+
+func TestAssetService_CreateAsset_Conflicts(t *testing.T) {
+	t.Run("duplicate_symbol", func(t *testing.T) {
+		mockAssetRepo, service := newAssetTestService()
+		mockAssetRepo.GetAssetBySymbolReturns(testUSDCAsset(), nil)
+
+		_, err := service.CreateAsset(context.Background(), domain.SupportedAsset{Chain: "ethereum", Symbol: "USDC", Name: "USD Coin", ContractAddress: testUSDC, Decimals: 6})
+
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Equal(t, 0, mockAssetRepo.CreateAssetCallCount())
+	})
+
+	t.Run("duplicate_contract", func(t *testing.T) {
+		mockAssetRepo, service := newAssetTestService()
+		mockAssetRepo.GetAssetByContractReturns(testUSDCAsset(), nil)
+
+		_, err := service.CreateAsset(context.Background(), domain.SupportedAsset{Chain: "ethereum", Symbol: "USDC.E", Name: "Bridged USDC", ContractAddress: testUSDC, Decimals: 6})
+
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Equal(t, 0, mockAssetRepo.CreateAssetCallCount())
+	})
+}
+
+func TestAssetService_CreateAsset_Invalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		asset domain.SupportedAsset
+	}{
+		{name: "missing_chain", asset: domain.SupportedAsset{Symbol: "USDC", Name: "USD Coin", Decimals: 6}},
+		{name: "missing_name", asset: domain.SupportedAsset{Chain: "ethereum", Symbol: "USDC", Decimals: 6}},
+		{name: "negative_decimals", asset: domain.SupportedAsset{Chain: "ethereum", Symbol: "USDC", Name: "USD Coin", Decimals: -1}},
+		{name: "too_many_decimals", asset: domain.SupportedAsset{Chain: "ethereum", Symbol: "USDC", Name: "USD Coin", Decimals: 37}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockAssetRepo, service := newAssetTestService()
+
+			_, err := service.CreateAsset(context.Background(), tc.asset)
+
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Equal(t, 0, mockAssetRepo.CreateAssetCallCount())
+		})
+	}
+}
+
+func TestAssetService_UpdateAsset(t *testing.T) {
+	mockAssetRepo, service := newAssetTestService()
+	asset := testUSDCAsset()
+	mockAssetRepo.GetAssetByIDReturns(asset, nil)
+	mockAssetRepo.UpdateAssetStub = func(ctx context.Context, asset domain.SupportedAsset) (*domain.SupportedAsset, error) {
+		return &asset, nil
+	}
+	disabled := false
+
+	result, err := service.UpdateAsset(context.Background(), asset.ID, nil, &disabled)
+
+	require.NoError(t, err)
+	assert.False(t, result.Enabled)
+	assert.Equal(t, "USD Coin", result.Name)
+}
+
+func TestAssetService_UpdateAsset_NotFound(t *testing.T) {
+	mockAssetRepo, service := newAssetTestService()
+	mockAssetRepo.GetAssetByIDReturns(nil, nil)
+
+	_, err := service.UpdateAsset(context.Background(), uuid.New(), nil, nil)
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeNotFound)
+	assert.Equal(t, 0, mockAssetRepo.UpdateAssetCallCount())
+}
+
+func TestAssetService_ValidateAmount(t *testing.T) {
+	disabled := testUSDCAsset()
+	disabled.Enabled = false
+
+	testCases := []struct {
+		name     string
+		asset    *domain.SupportedAsset
+		amount   string
+		expected appErrors.ErrorType
+	}{
+		{name: "valid", asset: testUSDCAsset(), amount: "1250.000001"},
+		{name: "trailing_zeros_beyond_precision", asset: testUSDCAsset(), amount: "10.50000000"},
+		{name: "too_precise", asset: testUSDCAsset(), amount: "0.0000001", expected: appErrors.ErrorTypeValidation},
+		{name: "zero", asset: testUSDCAsset(), amount: "0", expected: appErrors.ErrorTypeValidation},
+		{name: "negative", asset: testUSDCAsset(), amount: "-5", expected: appErrors.ErrorTypeValidation},
+		{name: "disabled", asset: disabled, amount: "5", expected: appErrors.ErrorTypeValidation},
+		{name: "unknown", amount: "5", expected: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockAssetRepo, service := newAssetTestService()
+			mockAssetRepo.GetAssetBySymbolReturns(tc.asset, nil)
+
+			asset, err := service.ValidateAmount(context.Background(), "Ethereum", money.MustParse(tc.amount, "usdc"))
+
+			if tc.expected == "" {
+				require.NoError(t, err)
+				assert.Equal(t, "USDC", asset.Symbol)
+				return
+			}
+			assertAppErrorType(t, err, tc.expected)
+		})
+	}
+}
+
+func TestAssetService_ValidateBaseUnitAmount(t *testing.T) {
+	mockAssetRepo, service := newAssetTestService()
+	mockAssetRepo.GetAssetByContractReturns(testUSDCAsset(), nil)
+
+	asset, err := service.ValidateBaseUnitAmount(context.Background(), "ethereum", testUSDC, big.NewInt(1_000_000))
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", asset.Symbol)
+
+	_, err = service.ValidateBaseUnitAmount(context.Background(), "ethereum", testUSDC, big.NewInt(0))
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+
+	mockAssetRepo.GetAssetByContractReturns(nil, nil)
+	_, err = service.ValidateBaseUnitAmount(context.Background(), "ethereum", testOtherToken, big.NewInt(1))
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	testCases := []struct {
+		name    string
+		chain   string
+		address string
+		want    string
+		valid   bool
+	}{
+		{name: "evm_checksummed", chain: "ethereum", address: " 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed ", want: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", valid: true},
+		{name: "evm_zero", chain: "base", address: "0x0000000000000000000000000000000000000000"},
+		{name: "evm_tron_address", chain: "ethereum", address: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{name: "tron", chain: "Tron", address: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", want: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", valid: true},
+		{name: "tron_bad_checksum", chain: "tron", address: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u"},
+		{name: "tron_evm_address", chain: "tron", address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{name: "solana", chain: "solana", address: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", want: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", valid: true},
+		{name: "solana_system_program", chain: "solana", address: "11111111111111111111111111111111"},
+		{name: "solana_too_short", chain: "solana", address: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4w"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := normalizeAddress(tc.chain, tc.address)
+			assert.Equal(t, tc.valid, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
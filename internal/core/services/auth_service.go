@@ -31,6 +31,8 @@ type authService struct {
 	logger       logging.Logger
 	otpRepo      ports.OTPRepository
 	userService  ports.UserService 
+	payoutService ports.PayoutAddressService
+	organizationService ports.OrganizationService
 }
 
 // SetupMFA sets up multi-factor authentication for a user
@@ -98,6 +100,8 @@ func NewAuthService(
 	logger logging.Logger,
 	otpRepo ports.OTPRepository,
 	userService ports.UserService,
+	payoutService ports.PayoutAddressService,
+	organizationService ports.OrganizationService,
 ) ports.AuthService {
 	return &authService{
 		userRepo:     userRepo,
@@ -111,6 +115,8 @@ func NewAuthService(
 		logger:       logger,
 		otpRepo:      otpRepo,
 		userService:  userService,
+		payoutService: payoutService,
+		organizationService: organizationService,
 	}
 }
 
@@ -307,45 +313,6 @@ func (a *authService) RegisterUser(ctx context.Context, user domain.User, passwo
 	return createdUser, nil
 }
 
-// RegisterBusiness implements ports.AuthService.
-func (a *authService) RegisterBusiness(ctx context.Context, user domain.User) (*domain.User, error) {
-	// Add Users business details
-	// Update the user with business details
-	a.logger.Info("Starting user personal details update process", map[string]interface{}{
-		"user_id": user.ID,
-	})
-
-	// Get the existing user by ID
-	existingUser, err := a.userRepo.GetUserByID(ctx, user.ID)
-	if err != nil {
-		a.logger.Error("Failed to get user by ID", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		return nil, fmt.Errorf("failed to get user by ID: %w", err)
-	}
-
-	// Update only the personal details fields, keeping other fields as they are
-	updatedUser := *existingUser
-	// Update only the company details fields, keeping other fields as they are
-	updatedUser.CompanyName = user.CompanyName
-	updatedUser.CompanyAddress = user.CompanyAddress
-	updatedUser.CompanyCity = user.CompanyCity
-	updatedUser.CompanyCountry = user.CompanyCountry
-	updatedUser.CompanyPostalCode = user.CompanyPostalCode
-	updatedUser.CompanyWebsite = user.CompanyWebsite
-
-	// Update the user in the database
-	users, err := a.userRepo.UpdateUserBusinessDetails(ctx, updatedUser)
-	if err != nil {
-		a.logger.Error("Failed to update user", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		return nil, fmt.Errorf("failed to update user: %w", err)
-	}
-
-	return users, nil
-}
-
 // RegisterPersonalDetails implements ports.AuthService
 func (a *authService) RegisterPersonalDetails(ctx context.Context, user domain.User) (*domain.User, error) {
 	a.logger.Info("Starting user personal details update process", map[string]interface{}{
@@ -393,63 +360,48 @@ func (a *authService) RegisterPersonalDetails(ctx context.Context, user domain.U
 	return result, nil
 }
 
-// RegisterBusinessDetails implements ports.AuthService
-func (a *authService) RegisterBusinessDetails(ctx context.Context, user domain.User) (*domain.User, error) {
+// RegisterBusinessDetails implements ports.AuthService. Company details are
+// only kept on the organization the user sets up, which is saved before the
+// optional employment type so a failure never leaves the user ahead of it.
+func (a *authService) RegisterBusinessDetails(ctx context.Context, userID uuid.UUID, profile domain.Organization, employmentType *string) (*domain.User, error) {
 	a.logger.Info("Starting business details update process", map[string]interface{}{
-		"user_id": user.ID,
+		"user_id": userID,
 	})
 
 	// Get the existing user by ID
-	existingUser, err := a.userRepo.GetUserByID(ctx, user.ID)
+	existingUser, err := a.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		a.logger.Error("Failed to get user by ID", err, map[string]interface{}{
-			"user_id": user.ID,
+			"user_id": userID,
 		})
 		return nil, fmt.Errorf("failed to get user by ID: %w", err)
 	}
 
-	// Update only the business details fields, keeping other fields as they are
-	updatedUser := *existingUser
-
-	if user.CompanyName != "" {
-		updatedUser.CompanyName = user.CompanyName
-	}
-
-	if user.CompanyAddress != "" {
-		updatedUser.CompanyAddress = user.CompanyAddress
-	}
-
-	if user.CompanyCity != "" {
-		updatedUser.CompanyCity = user.CompanyCity
-	}
-
-	if user.CompanyPostalCode != "" {
-		updatedUser.CompanyPostalCode = user.CompanyPostalCode
-	}
-
-	if user.CompanyCountry != "" {
-		updatedUser.CompanyCountry = user.CompanyCountry
-	}
-
-	if user.CompanyWebsite != nil {
-		updatedUser.CompanyWebsite = user.CompanyWebsite
+	org, err := a.organizationService.SaveBusinessProfile(ctx, userID, profile)
+	if err != nil {
+		a.logger.Error("Failed to save organization profile", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to save organization profile: %w", err)
 	}
 
-	if user.EmploymentType != nil {
-		updatedUser.EmploymentType = user.EmploymentType
-	}
+	result := existingUser
+	if employmentType != nil {
+		updatedUser := *existingUser
+		updatedUser.EmploymentType = employmentType
 
-	// Update the user with business details
-	result, err := a.userRepo.UpdateUserBusinessDetails(ctx, updatedUser)
-	if err != nil {
-		a.logger.Error("Failed to update business details", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		return nil, fmt.Errorf("failed to update business details: %w", err)
+		result, err = a.userRepo.UpdateUserEmploymentType(ctx, updatedUser)
+		if err != nil {
+			a.logger.Error("Failed to update employment type", err, map[string]interface{}{
+				"user_id": userID,
+			})
+			return nil, fmt.Errorf("failed to update employment type: %w", err)
+		}
 	}
 
 	a.logger.Info("Business details updated successfully", map[string]interface{}{
-		"user_id": user.ID,
+		"user_id":         userID,
+		"organization_id": org.ID,
 	})
 
 	return result, nil
@@ -696,9 +648,46 @@ func (a *authService) processWallet(ctx context.Context, userID uuid.UUID, walle
 	return a.LinkWallet(ctx, userID, wallet.PublicKey, wallet.Type, chain)
 }
 
-// LinkWallet links a blockchain wallet to a user account (continued)
+// LinkWallet links a blockchain wallet to a user account
 func (a *authService) LinkWallet(ctx context.Context, userID uuid.UUID, walletAddress string, walletType string, chain string) error {
-	// ... (previous code)
+	if !isValidWalletAddress(walletAddress) {
+		return errors.New("invalid wallet address format")
+	}
+
+	user, err := a.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check if wallet already exists
+	existingWallet, err := a.walletRepo.GetWalletByAddress(ctx, walletAddress)
+	if err != nil {
+		return fmt.Errorf("error checking wallet existence: %w", err)
+	}
+
+	if existingWallet != nil {
+		if existingWallet.UserID != userID {
+			return errors.New("wallet already linked to another account")
+		}
+		return nil
+	}
+
+	wallet := domain.UserWallet{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Address:   walletAddress,
+		Type:      walletType,
+		Chain:     chain,
+		IsDefault: false,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// The wallet is saved together with its allowlist entry, so new addresses
+	// are always quarantined before they can receive payouts
+	if _, err := a.payoutService.RequestPayoutAddress(ctx, *user, wallet); err != nil {
+		return fmt.Errorf("failed to allowlist payout address: %w", err)
+	}
 
 	// Log security event
 	a.LogSecurityEvent(ctx, "wallet_linked", userID, map[string]interface{}{
@@ -739,11 +728,20 @@ func (a *authService) GetProfileCompletionStatus(ctx context.Context, userID uui
 
 	// Account type specific fields
 	if user.AccountType == "business" {
+		// Company details are kept on the organization the user set up
+		org, err := a.organizationService.GetBusinessProfile(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get organization: %w", err)
+		}
+		if org == nil {
+			org = &domain.Organization{}
+		}
+
 		fields = append(fields, []fieldCheck{
-			{"Company Name", true, user.CompanyName != ""},
-			{"Company Address", true, user.CompanyAddress != ""},
-			{"Company City", true, user.CompanyCity != ""},
-			{"Company Country", true, user.CompanyCountry != ""},
+			{"Company Name", true, org.Name != ""},
+			{"Company Address", true, org.Address != ""},
+			{"Company City", true, org.City != ""},
+			{"Company Country", true, org.Country != ""},
 		}...)
 	} else {
 		fields = append(fields, []fieldCheck{
@@ -0,0 +1,500 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+// clientImportColumns are the columns a client CSV file may have; name and
+// email are required. cc_emails lists addresses separated by semicolons.
+var clientImportColumns = []string{
+	"name", "email", "cc_emails", "tax_id",
+	"address_line1", "address_line2", "city", "region", "postal_code", "country",
+	"preferred_currency", "payment_terms_days", "notes",
+}
+
+type clientService struct {
+	clientRepo   ports.ClientRepository
+	orgService   ports.OrganizationService
+	assetService ports.AssetService
+	logger       logging.Logger
+}
+
+// NewClientService creates a new client directory service
+func NewClientService(
+	clientRepo ports.ClientRepository,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	logger logging.Logger,
+) ports.ClientService {
+	return &clientService{
+		clientRepo:   clientRepo,
+		orgService:   orgService,
+		assetService: assetService,
+		logger:       logger,
+	}
+}
+
+// CreateClient adds a client to the organization's directory. Finance
+// managers may add clients; an email or tax ID another client already has is
+// a conflict.
+func (s *clientService) CreateClient(ctx context.Context, userID, orgID uuid.UUID, client domain.Client) (*domain.Client, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	client.OrganizationID = orgID
+	client.CreatedBy = &userID
+	if err := s.prepareClient(ctx, &client); err != nil {
+		return nil, err
+	}
+
+	created, duplicate, err := s.addClient(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, appErrors.NewConflictError(duplicateClientMessage(client, duplicate))
+	}
+
+	s.logger.Info("Client created", map[string]interface{}{
+		"organization_id": orgID,
+		"client_id":       created.ID,
+	})
+
+	return created, nil
+}
+
+// ListClients lists the organization's clients by name, optionally only those
+// whose name, email or tax ID contains search. Any member may view them.
+func (s *clientService) ListClients(ctx context.Context, userID, orgID uuid.UUID, search string, page, pageSize int) ([]domain.Client, int64, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, 0, err
+	}
+
+	return s.clientRepo.ListClients(ctx, orgID, strings.TrimSpace(search), pageSize, (page-1)*pageSize)
+}
+
+// GetClient retrieves one of the organization's clients. Any member may view it.
+func (s *clientService) GetClient(ctx context.Context, userID, orgID, clientID uuid.UUID) (*domain.Client, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.getClient(ctx, orgID, clientID)
+}
+
+// UpdateClient replaces a client's details. Invoices already raised for the
+// client keep the details they were issued with.
+func (s *clientService) UpdateClient(ctx context.Context, userID, orgID, clientID uuid.UUID, client domain.Client) (*domain.Client, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getClient(ctx, orgID, clientID); err != nil {
+		return nil, err
+	}
+
+	client.ID = clientID
+	client.OrganizationID = orgID
+	if err := s.prepareClient(ctx, &client); err != nil {
+		return nil, err
+	}
+
+	duplicate, err := s.clientRepo.FindDuplicateClient(ctx, orgID, client.Email, client.TaxID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate != nil {
+		return nil, appErrors.NewConflictError(duplicateClientMessage(client, duplicate))
+	}
+
+	updated, err := s.clientRepo.UpdateClient(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, appErrors.NewNotFoundError("client not found")
+	}
+
+	s.logger.Info("Client updated", map[string]interface{}{
+		"organization_id": orgID,
+		"client_id":       clientID,
+	})
+
+	return updated, nil
+}
+
+// DeleteClient removes a client from the directory. Its invoices keep the
+// details they were issued with.
+func (s *clientService) DeleteClient(ctx context.Context, userID, orgID, clientID uuid.UUID) error {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return err
+	}
+
+	deleted, err := s.clientRepo.DeleteClient(ctx, orgID, clientID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return appErrors.NewNotFoundError("client not found")
+	}
+
+	s.logger.Info("Client deleted", map[string]interface{}{
+		"organization_id": orgID,
+		"client_id":       clientID,
+	})
+
+	return nil
+}
+
+// ImportClients adds the clients in a CSV file with a header row. The whole
+// file is read before anything is added, so a malformed file adds nothing.
+// Rows that are invalid, or whose email or tax ID matches an existing client
+// or an earlier row, are left out and reported; the rest are added.
+func (s *clientService) ImportClients(ctx context.Context, userID, orgID uuid.UUID, data io.Reader) (*domain.ClientImportResult, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	columns, records, err := readClientCSV(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ClientImportResult{
+		Created: []domain.Client{},
+		Skipped: []domain.ClientImportIssue{},
+	}
+	emailRows := make(map[string]int)
+	taxIDRows := make(map[string]int)
+
+	for i, record := range records {
+		row := i + 2
+
+		client, err := clientFromCSVRecord(columns, record)
+		if err == nil {
+			client.OrganizationID = orgID
+			client.CreatedBy = &userID
+			err = s.prepareClient(ctx, &client)
+		}
+		if err != nil {
+			if appErrors.GetErrorType(err) != appErrors.ErrorTypeValidation {
+				return nil, err
+			}
+			result.Skipped = append(result.Skipped, domain.ClientImportIssue{Row: row, Reason: errorDetails(err)})
+			continue
+		}
+
+		if earlier, ok := emailRows[client.Email]; ok {
+			result.Skipped = append(result.Skipped, domain.ClientImportIssue{Row: row, Reason: fmt.Sprintf("email %s is already on row %d", client.Email, earlier)})
+			continue
+		}
+		if earlier, ok := taxIDRows[client.TaxID]; ok && client.TaxID != "" {
+			result.Skipped = append(result.Skipped, domain.ClientImportIssue{Row: row, Reason: fmt.Sprintf("tax ID %s is already on row %d", client.TaxID, earlier)})
+			continue
+		}
+		emailRows[client.Email] = row
+		if client.TaxID != "" {
+			taxIDRows[client.TaxID] = row
+		}
+
+		created, duplicate, err := s.addClient(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if created == nil {
+			issue := domain.ClientImportIssue{Row: row, Reason: duplicateClientMessage(client, duplicate)}
+			if duplicate != nil {
+				issue.ExistingClientID = &duplicate.ID
+			}
+			result.Skipped = append(result.Skipped, issue)
+			continue
+		}
+
+		result.Created = append(result.Created, *created)
+	}
+
+	s.logger.Info("Clients imported", map[string]interface{}{
+		"organization_id": orgID,
+		"created":         len(result.Created),
+		"skipped":         len(result.Skipped),
+	})
+
+	return result, nil
+}
+
+// addClient stores a prepared client unless the organization already has one
+// with the same email or tax ID, which it returns instead. A client added
+// concurrently with the same details is caught by the store, and then neither
+// is returned.
+func (s *clientService) addClient(ctx context.Context, client domain.Client) (*domain.Client, *domain.Client, error) {
+	duplicate, err := s.clientRepo.FindDuplicateClient(ctx, client.OrganizationID, client.Email, client.TaxID, uuid.Nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if duplicate != nil {
+		return nil, duplicate, nil
+	}
+
+	client.ID = uuid.New()
+	created, err := s.clientRepo.CreateClient(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, nil, nil
+}
+
+func (s *clientService) getClient(ctx context.Context, orgID, clientID uuid.UUID) (*domain.Client, error) {
+	client, err := s.clientRepo.GetClient(ctx, orgID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, appErrors.NewNotFoundError("client not found")
+	}
+
+	return client, nil
+}
+
+// prepareClient normalises and validates a client's details. Emails are
+// lowercased and tax IDs normalised, so duplicates are found however they are
+// written.
+func (s *clientService) prepareClient(ctx context.Context, client *domain.Client) error {
+	client.Name = strings.TrimSpace(client.Name)
+	if client.Name == "" {
+		return appErrors.NewValidationError("client name is required")
+	}
+	if len(client.Name) > 255 {
+		return appErrors.NewValidationError("client name can be at most 255 characters")
+	}
+
+	email, err := normalizeEmailAddress(client.Email)
+	if err != nil {
+		return appErrors.NewValidationError("a valid client email address is required")
+	}
+	client.Email = email
+
+	ccEmails := make([]string, 0, len(client.CCEmails))
+	for _, cc := range client.CCEmails {
+		address, err := normalizeEmailAddress(cc)
+		if err != nil {
+			return appErrors.NewValidationError(fmt.Sprintf("%q is not a valid email address", strings.TrimSpace(cc)))
+		}
+		if address != client.Email && !slices.Contains(ccEmails, address) {
+			ccEmails = append(ccEmails, address)
+		}
+	}
+	if len(ccEmails) > domain.MaxClientCCEmails {
+		return appErrors.NewValidationError(fmt.Sprintf("a client can have at most %d contacts copied on its invoices", domain.MaxClientCCEmails))
+	}
+	client.CCEmails = ccEmails
+
+	client.TaxID = domain.NormalizeTaxID(client.TaxID)
+	if len(client.TaxID) > 64 {
+		return appErrors.NewValidationError("tax ID can be at most 64 characters")
+	}
+
+	address := &client.BillingAddress
+	address.Line1 = strings.TrimSpace(address.Line1)
+	address.Line2 = strings.TrimSpace(address.Line2)
+	address.City = strings.TrimSpace(address.City)
+	address.Region = strings.TrimSpace(address.Region)
+	address.PostalCode = strings.TrimSpace(address.PostalCode)
+	address.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+	if address.Country != "" && !isCountryCode(address.Country) {
+		return appErrors.NewValidationError("country must be a two-letter ISO 3166-1 code")
+	}
+
+	if strings.TrimSpace(client.PreferredCurrency) != "" {
+		currency, err := normalizeCurrencyCode(client.PreferredCurrency)
+		if err != nil {
+			return err
+		}
+		client.PreferredCurrency = currency
+	} else {
+		client.PreferredCurrency = ""
+	}
+
+	if client.PreferredAssetID != nil {
+		asset, err := s.assetService.GetAsset(ctx, *client.PreferredAssetID)
+		if err != nil {
+			if appErrors.GetErrorType(err) == appErrors.ErrorTypeNotFound {
+				return appErrors.NewValidationError("preferred asset is not supported")
+			}
+			return err
+		}
+		if !asset.Enabled {
+			return appErrors.NewValidationError(fmt.Sprintf("%s on %s is not enabled for payments", asset.Symbol, asset.Chain))
+		}
+	}
+
+	if client.PaymentTermsDays < 0 || client.PaymentTermsDays > domain.MaxClientPaymentTermsDays {
+		return appErrors.NewValidationError(fmt.Sprintf("payment terms must be between 0 and %d days", domain.MaxClientPaymentTermsDays))
+	}
+
+	client.Notes = strings.TrimSpace(client.Notes)
+
+	return nil
+}
+
+// duplicateClientMessage says which of client's details another client
+// already has. duplicate is nil when the store caught a client added at the
+// same time.
+func duplicateClientMessage(client domain.Client, duplicate *domain.Client) string {
+	switch {
+	case duplicate == nil:
+		return "a client with this email or tax ID already exists"
+	case duplicate.Email == client.Email:
+		return fmt.Sprintf("client %q already has email %s", duplicate.Name, client.Email)
+	default:
+		return fmt.Sprintf("client %q already has tax ID %s", duplicate.Name, client.TaxID)
+	}
+}
+
+// readClientCSV reads a client CSV file, returning the position of each known
+// column and the rows below the header
+func readClientCSV(data io.Reader) (map[string]int, [][]string, error) {
+	reader := csv.NewReader(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, appErrors.NewValidationError("the file is empty")
+		}
+		return nil, nil, appErrors.NewValidationError(fmt.Sprintf("the file is not valid CSV: %v", err))
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(clientImportColumns, name) {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("unknown column %q; the columns are %s", name, strings.Join(clientImportColumns, ", ")))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("column %q appears more than once", name))
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"name", "email"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("the %q column is required", required))
+		}
+	}
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("the file is not valid CSV: %v", err))
+		}
+		if len(records) == domain.MaxClientImportRows {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("a file can have at most %d clients", domain.MaxClientImportRows))
+		}
+		records = append(records, record)
+	}
+
+	return columns, records, nil
+}
+
+// clientFromCSVRecord maps a CSV row to a client. Payment terms default to
+// the usual terms when the column is left empty.
+func clientFromCSVRecord(columns map[string]int, record []string) (domain.Client, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	client := domain.Client{
+		Name:  field("name"),
+		Email: field("email"),
+		TaxID: field("tax_id"),
+		BillingAddress: domain.ClientAddress{
+			Line1:      field("address_line1"),
+			Line2:      field("address_line2"),
+			City:       field("city"),
+			Region:     field("region"),
+			PostalCode: field("postal_code"),
+			Country:    field("country"),
+		},
+		PreferredCurrency: field("preferred_currency"),
+		PaymentTermsDays:  domain.DefaultClientPaymentTermsDays,
+		Notes:             field("notes"),
+	}
+
+	if ccEmails := field("cc_emails"); ccEmails != "" {
+		for _, cc := range strings.Split(ccEmails, ";") {
+			if cc = strings.TrimSpace(cc); cc != "" {
+				client.CCEmails = append(client.CCEmails, cc)
+			}
+		}
+	}
+
+	if terms := field("payment_terms_days"); terms != "" {
+		days, err := strconv.Atoi(terms)
+		if err != nil {
+			return domain.Client{}, appErrors.NewValidationError(fmt.Sprintf("payment terms %q are not a whole number of days", terms))
+		}
+		client.PaymentTermsDays = days
+	}
+
+	return client, nil
+}
+
+// normalizeEmailAddress checks value is a bare email address and lowercases it
+func normalizeEmailAddress(value string) (string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(value))
+	if err != nil {
+		return "", err
+	}
+	if address.Name != "" {
+		return "", fmt.Errorf("email address %q has a display name", value)
+	}
+
+	return strings.ToLower(address.Address), nil
+}
+
+// isCountryCode reports whether code looks like an ISO 3166-1 alpha-2 code
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// errorDetails is what an application error says went wrong, without the
+// generic message for its type
+func errorDetails(err error) string {
+	var appErr *appErrors.AppError
+	if errors.As(err, &appErr) && appErr.Details != "" {
+		return appErr.Details
+	}
+	return err.Error()
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubClientDirectory keeps clients in memory and finds duplicates the way the
+// repository does
+func stubClientDirectory(repo *mocks.FakeClientRepository, existing ...domain.Client) map[uuid.UUID]domain.Client {
+	clients := make(map[uuid.UUID]domain.Client)
+	for _, client := range existing {
+		clients[client.ID] = client
+	}
+
+	repo.FindDuplicateClientStub = func(ctx context.Context, orgID uuid.UUID, email, taxID string, excludeID uuid.UUID) (*domain.Client, error) {
+		for _, client := range clients {
+			if client.OrganizationID == orgID && client.ID != excludeID && (client.Email == email || (taxID != "" && client.TaxID == taxID)) {
+				return &client, nil
+			}
+		}
+		return nil, nil
+	}
+	repo.CreateClientStub = func(ctx context.Context, client domain.Client) (*domain.Client, error) {
+		clients[client.ID] = client
+		return &client, nil
+	}
+	repo.GetClientStub = func(ctx context.Context, orgID, id uuid.UUID) (*domain.Client, error) {
+		client, ok := clients[id]
+		if !ok || client.OrganizationID != orgID {
+			return nil, nil
+		}
+		return &client, nil
+	}
+
+	return clients
+}
+
+func TestClientService_CreateClient(t *testing.T) {
+	// Arrange
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+
+	orgID := uuid.New()
+	finance := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+	stubClientDirectory(mockClientRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+	// Act
+	client, err := service.CreateClient(context.Background(), finance, orgID, domain.Client{
+		Name:              " Globex ",
+		Email:             "Billing@Globex.test",
+		CCEmails:          []string{"CFO@globex.test", "billing@globex.test", "cfo@globex.test"},
+		TaxID:             "gb 123.456-789",
+		BillingAddress:    domain.ClientAddress{Line1: "1 Main St", City: "London", PostalCode: "EC1A 1BB", Country: "gb"},
+		PreferredCurrency: "gbp",
+		PaymentTermsDays:  14,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Globex", client.Name)
+	assert.Equal(t, "billing@globex.test", client.Email)
+	assert.Equal(t, []string{"cfo@globex.test"}, client.CCEmails)
+	assert.Equal(t, "GB123456789", client.TaxID)
+	assert.Equal(t, "GB", client.BillingAddress.Country)
+	assert.Equal(t, "GBP", client.PreferredCurrency)
+	assert.Equal(t, orgID, client.OrganizationID)
+	assert.Equal(t, finance, *client.CreatedBy)
+	assert.Equal(t, "1 Main St\nLondon, EC1A 1BB\nGB", client.BillingAddress.String())
+}
+
+func TestClientService_CreateClient_Duplicates(t *testing.T) {
+	testCases := []struct {
+		name  string
+		email string
+		taxID string
+	}{
+		{name: "same_email", email: "BILLING@globex.test", taxID: ""},
+		{name: "same_tax_id", email: "ap@globex.test", taxID: "gb123456789"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+
+			orgID := uuid.New()
+			admin := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{admin: domain.OrganizationRoleAdmin})
+			stubClientDirectory(mockClientRepo, domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test", TaxID: "GB123456789"})
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+			// Act
+			_, err := service.CreateClient(context.Background(), admin, orgID, domain.Client{Name: "Globex Ltd", Email: tc.email, TaxID: tc.taxID})
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, appErrors.ErrorTypeConflict, appErrors.GetErrorType(err))
+			assert.Zero(t, mockClientRepo.CreateClientCallCount())
+		})
+	}
+}
+
+func TestClientService_CreateClient_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		role    domain.OrganizationRole
+		client  domain.Client
+		errType appErrors.ErrorType
+	}{
+		{name: "viewer", role: domain.OrganizationRoleViewer, client: domain.Client{Name: "Globex", Email: "ap@globex.test"}, errType: appErrors.ErrorTypeForbidden},
+		{name: "missing_name", role: domain.OrganizationRoleFinance, client: domain.Client{Name: " ", Email: "ap@globex.test"}, errType: appErrors.ErrorTypeValidation},
+		{name: "bad_email", role: domain.OrganizationRoleFinance, client: domain.Client{Name: "Globex", Email: "Globex <ap@globex.test>"}, errType: appErrors.ErrorTypeValidation},
+		{name: "bad_cc_email", role: domain.OrganizationRoleFinance, client: domain.Client{Name: "Globex", Email: "ap@globex.test", CCEmails: []string{"nope"}}, errType: appErrors.ErrorTypeValidation},
+		{name: "bad_country", role: domain.OrganizationRoleFinance, client: domain.Client{Name: "Globex", Email: "ap@globex.test", BillingAddress: domain.ClientAddress{Country: "GBR"}}, errType: appErrors.ErrorTypeValidation},
+		{name: "bad_terms", role: domain.OrganizationRoleFinance, client: domain.Client{Name: "Globex", Email: "ap@globex.test", PaymentTermsDays: 366}, errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: tc.role})
+			stubClientDirectory(mockClientRepo)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+			// Act
+			_, err := service.CreateClient(context.Background(), userID, orgID, tc.client)
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, tc.errType, appErrors.GetErrorType(err))
+			assert.Zero(t, mockClientRepo.CreateClientCallCount())
+		})
+	}
+}
+
+func TestClientService_UpdateClient_IgnoresItself(t *testing.T) {
+	// Arrange
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+
+	orgID := uuid.New()
+	finance := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+	existing := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test", TaxID: "GB123456789"}
+	stubClientDirectory(mockClientRepo, existing)
+	mockClientRepo.UpdateClientStub = func(ctx context.Context, client domain.Client) (*domain.Client, error) {
+		return &client, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+	// Act
+	updated, err := service.UpdateClient(context.Background(), finance, orgID, existing.ID, domain.Client{Name: "Globex Ltd", Email: "billing@globex.test", TaxID: "GB 123 456 789"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID, updated.ID)
+	assert.Equal(t, "Globex Ltd", updated.Name)
+}
+
+func TestClientService_ImportClients(t *testing.T) {
+	// Arrange
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+
+	orgID := uuid.New()
+	finance := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+	existing := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Initech", Email: "ap@initech.test"}
+	clients := stubClientDirectory(mockClientRepo, existing)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+	csv := "\ufeffName,Email,Tax_ID,Country,CC_Emails,Payment_Terms_Days\n" +
+		"Globex,billing@globex.test,GB123456789,gb,cfo@globex.test; ceo@globex.test,14\n" +
+		"Globex Again,BILLING@globex.test,,,,\n" +
+		"Globex Subsidiary,sub@globex.test,gb-123-456-789,,,\n" +
+		"Initech,AP@initech.test,,,,\n" +
+		"No Email,,,,,\n" +
+		"Hooli,ap@hooli.test,,US,,net 30\n" +
+		"Umbrella,ap@umbrella.test\n"
+
+	// Act
+	result, err := service.ImportClients(context.Background(), finance, orgID, strings.NewReader(csv))
+
+	// Assert
+	require.NoError(t, err)
+
+	require.Len(t, result.Created, 2)
+	assert.Equal(t, "Globex", result.Created[0].Name)
+	assert.Equal(t, []string{"cfo@globex.test", "ceo@globex.test"}, result.Created[0].CCEmails)
+	assert.Equal(t, 14, result.Created[0].PaymentTermsDays)
+	assert.Equal(t, "Umbrella", result.Created[1].Name)
+	assert.Equal(t, domain.DefaultClientPaymentTermsDays, result.Created[1].PaymentTermsDays)
+	assert.Len(t, clients, 3)
+
+	require.Len(t, result.Skipped, 5)
+	assert.Equal(t, 3, result.Skipped[0].Row)
+	assert.Contains(t, result.Skipped[0].Reason, "row 2")
+	assert.Equal(t, 4, result.Skipped[1].Row)
+	assert.Contains(t, result.Skipped[1].Reason, "tax ID")
+	assert.Equal(t, 5, result.Skipped[2].Row)
+	assert.Equal(t, existing.ID, *result.Skipped[2].ExistingClientID)
+	assert.Equal(t, 6, result.Skipped[3].Row)
+	assert.Equal(t, 7, result.Skipped[4].Row)
+}
+
+func TestClientService_ImportClients_RejectsFile(t *testing.T) {
+	testCases := []struct {
+		name string
+		csv  string
+	}{
+		{name: "empty", csv: ""},
+		{name: "unknown_column", csv: "name,email,phone\nGlobex,ap@globex.test,555\n"},
+		{name: "missing_email_column", csv: "name\nGlobex\n"},
+		{name: "malformed", csv: "name,email\n\"Globex,ap@globex.test\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+
+			orgID := uuid.New()
+			finance := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+			stubClientDirectory(mockClientRepo)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewClientService(mockClientRepo, mockOrgService, mockAssetService, logging.New(&cfg))
+
+			// Act
+			_, err := service.ImportClients(context.Background(), finance, orgID, strings.NewReader(tc.csv))
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, appErrors.ErrorTypeValidation, appErrors.GetErrorType(err))
+			assert.Zero(t, mockClientRepo.CreateClientCallCount())
+		})
+	}
+}
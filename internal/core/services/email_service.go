@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/demola234/defifundr/config"
 	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
 	"github.com/demola234/defifundr/internal/core/ports"
 	emailEnums "github.com/demola234/defifundr/pkg/utils"
 )
@@ -168,6 +170,261 @@ func (s *EmailService) SendBatchUpdate(ctx context.Context, emails []string, sub
 	return nil
 }
 
+// SendPayoutAddressChangeNotification notifies a user that a payout address was added to their account
+func (s *EmailService) SendPayoutAddressChangeNotification(ctx context.Context, email, name string, address domain.PayoutAddress, cancelLink string) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send payout address change notification")
+		return nil
+	}
+
+	subject := "DefiFundr - New Payout Address Added"
+
+	// Create plain text email body
+	body := fmt.Sprintf(
+		"Hello %s,\n\n"+
+			"A new payout address was added to your DefiFundr account:\n\n"+
+			"Address: %s\n"+
+			"Network: %s\n\n"+
+			"For your security, no funds will be sent to this address until %s.\n\n"+
+			"If you did not make this change, cancel it immediately using the link below:\n%s\n\n"+
+			"Best regards,\n"+
+			"The DefiFundr Team",
+		name, address.Address, address.Chain, address.AvailableAt.UTC().Format(time.RFC1123), cancelLink,
+	)
+
+	templateData := map[string]interface{}{
+		"Name":        name,
+		"Address":     address.Address,
+		"Chain":       address.Chain,
+		"AvailableAt": address.AvailableAt,
+		"CancelLink":  cancelLink,
+		"AppName":     "DefiFundr",
+		"TextBody":    body,
+	}
+
+	// Queue email with critical priority, the user must be able to react within the cooling-off period
+	_, err := s.emailSender.QueueEmail(ctx, email, subject, "payout_address_change", templateData, emailEnums.CriticalPriority)
+	if err != nil {
+		s.logger.Error("Failed to queue payout address change email", err, map[string]interface{}{
+			"email": email,
+		})
+		return fmt.Errorf("failed to queue payout address change email: %w", err)
+	}
+
+	s.logger.Info("Queued payout address change email", map[string]interface{}{
+		"email": email,
+	})
+	return nil
+}
+
+// SendPayoutAddressCancelledNotification confirms that a pending payout address was blocked
+func (s *EmailService) SendPayoutAddressCancelledNotification(ctx context.Context, email, name string, address domain.PayoutAddress) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send payout address cancelled notification")
+		return nil
+	}
+
+	subject := "DefiFundr - Payout Address Change Cancelled"
+
+	// Create plain text email body
+	body := fmt.Sprintf(
+		"Hello %s,\n\n"+
+			"The payout address %s (%s) has been blocked and will not receive any funds.\n\n"+
+			"If you did not request this, please contact support and review your account security.\n\n"+
+			"Best regards,\n"+
+			"The DefiFundr Team",
+		name, address.Address, address.Chain,
+	)
+
+	templateData := map[string]interface{}{
+		"Name":     name,
+		"Address":  address.Address,
+		"Chain":    address.Chain,
+		"AppName":  "DefiFundr",
+		"TextBody": body,
+	}
+
+	_, err := s.emailSender.QueueEmail(ctx, email, subject, "payout_address_cancelled", templateData, emailEnums.HighPriority)
+	if err != nil {
+		s.logger.Error("Failed to queue payout address cancelled email", err, map[string]interface{}{
+			"email": email,
+		})
+		return fmt.Errorf("failed to queue payout address cancelled email: %w", err)
+	}
+
+	s.logger.Info("Queued payout address cancelled email", map[string]interface{}{
+		"email": email,
+	})
+	return nil
+}
+
+// SendTransactionPINResetEmail sends the OTP needed to reset a transaction PIN
+func (s *EmailService) SendTransactionPINResetEmail(ctx context.Context, email, name, otpCode string) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send transaction PIN reset email")
+		return nil
+	}
+
+	subject := "DefiFundr - Transaction PIN Reset Request"
+
+	// Create plain text email body
+	body := fmt.Sprintf(
+		"Hello %s,\n\n"+
+			"Use the code %s to reset your transaction PIN. The code expires in 15 minutes.\n\n"+
+			"If you did not request this, do not share the code and contact support immediately.\n\n"+
+			"Best regards,\n"+
+			"The DefiFundr Team",
+		name, otpCode,
+	)
+
+	templateData := map[string]interface{}{
+		"Name":       name,
+		"OTPCode":    otpCode,
+		"AppName":    "DefiFundr",
+		"ExpiryTime": "15 minutes",
+		"TextBody":   body,
+	}
+
+	_, err := s.emailSender.QueueEmail(ctx, email, subject, "transaction_pin_reset", templateData, emailEnums.HighPriority)
+	if err != nil {
+		s.logger.Error("Failed to queue transaction PIN reset email", err, map[string]interface{}{
+			"email": email,
+		})
+		return fmt.Errorf("failed to queue transaction PIN reset email: %w", err)
+	}
+
+	s.logger.Info("Queued transaction PIN reset email", map[string]interface{}{
+		"email": email,
+	})
+	return nil
+}
+
+// SendTeamInvitation invites someone to join an organization through the team_invitation template
+func (s *EmailService) SendTeamInvitation(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send team invitation")
+		return nil
+	}
+
+	subject := fmt.Sprintf("%s invited you to join %s on DefiFundr", inviterName, invitation.OrganizationName)
+
+	templateData := map[string]interface{}{
+		"InviterName":      inviterName,
+		"OrganizationName": invitation.OrganizationName,
+		"Role":             string(invitation.Role),
+		"InviteLink":       inviteLink,
+		"ExpiresAt":        invitation.ExpiresAt.UTC().Format(time.RFC1123),
+		"AppName":          "DefiFundr",
+	}
+
+	_, err := s.emailSender.QueueEmail(ctx, email, subject, "team_invitation", templateData, emailEnums.HighPriority)
+	if err != nil {
+		s.logger.Error("Failed to queue team invitation email", err, map[string]interface{}{
+			"email":         email,
+			"invitation_id": invitation.ID,
+		})
+		return fmt.Errorf("failed to queue team invitation email: %w", err)
+	}
+
+	s.logger.Info("Queued team invitation email", map[string]interface{}{
+		"email":         email,
+		"invitation_id": invitation.ID,
+	})
+	return nil
+}
+
+// SendInvoice emails a customer their invoice with its total and due date and
+// the rendered PDF attached
+func (s *EmailService) SendInvoice(ctx context.Context, invoice domain.Invoice, organizationName string, pdf []byte) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send invoice")
+		return nil
+	}
+
+	subject := fmt.Sprintf("Invoice %s from %s", invoice.DisplayNumber(), organizationName)
+
+	templateData := map[string]interface{}{
+		"OrganizationName": organizationName,
+		"CustomerName":     invoice.CustomerName,
+		"InvoiceNumber":    invoice.DisplayNumber(),
+		"Total":            invoice.Total.Amount().String(),
+		"Currency":         invoice.Total.Currency(),
+		"IssueDate":        invoice.IssueDate.UTC().Format("2 January 2006"),
+		"DueDate":          invoice.DueDate.UTC().Format("2 January 2006"),
+		"Notes":            invoice.Notes,
+		"AppName":          "DefiFundr",
+	}
+
+	attachments := []emailEnums.EmailAttachment{{
+		Filename: invoice.PDFFilename(),
+		Content:  pdf,
+		MimeType: "application/pdf",
+	}}
+
+	err := s.emailSender.SendEmailWithAttachment(ctx, invoice.CustomerEmail, subject, "invoice", templateData, attachments)
+	if err != nil {
+		s.logger.Error("Failed to queue invoice email", err, map[string]interface{}{
+			"invoice_id": invoice.ID,
+		})
+		return fmt.Errorf("failed to queue invoice email: %w", err)
+	}
+
+	s.logger.Info("Queued invoice email", map[string]interface{}{
+		"invoice_id": invoice.ID,
+	})
+	return nil
+}
+
+// SendInvoiceReminder reminds a customer through the queue of what is left to
+// pay on an invoice, offsetDays from its due date
+func (s *EmailService) SendInvoiceReminder(ctx context.Context, invoice domain.Invoice, organizationName string, offsetDays int) error {
+	if s.isTestMode() {
+		s.logger.Info("Test mode: Would send invoice reminder")
+		return nil
+	}
+
+	dueDate := invoice.DueDate.UTC().Format("2 January 2006")
+
+	var subject, status string
+	switch {
+	case offsetDays < 0:
+		subject = fmt.Sprintf("Reminder: invoice %s from %s is due on %s", invoice.DisplayNumber(), organizationName, dueDate)
+		status = fmt.Sprintf("is due on %s", dueDate)
+	case offsetDays == 0:
+		subject = fmt.Sprintf("Reminder: invoice %s from %s is due today", invoice.DisplayNumber(), organizationName)
+		status = "is due today"
+	default:
+		subject = fmt.Sprintf("Overdue: invoice %s from %s", invoice.DisplayNumber(), organizationName)
+		status = fmt.Sprintf("was due on %s and is now overdue", dueDate)
+	}
+
+	templateData := map[string]interface{}{
+		"OrganizationName": organizationName,
+		"CustomerName":     invoice.CustomerName,
+		"InvoiceNumber":    invoice.DisplayNumber(),
+		"Status":           status,
+		"BalanceDue":       invoice.BalanceDue().Amount().String(),
+		"Currency":         invoice.Currency,
+		"PartiallyPaid":    invoice.AmountPaid.IsPositive(),
+		"PaymentAddress":   invoice.PaymentAddress,
+		"AppName":          "DefiFundr",
+	}
+
+	_, err := s.emailSender.QueueEmail(ctx, invoice.CustomerEmail, subject, "invoice_reminder", templateData, emailEnums.NormalPriority)
+	if err != nil {
+		s.logger.Error("Failed to queue invoice reminder email", err, map[string]interface{}{
+			"invoice_id": invoice.ID,
+		})
+		return fmt.Errorf("failed to queue invoice reminder email: %w", err)
+	}
+
+	s.logger.Info("Queued invoice reminder email", map[string]interface{}{
+		"invoice_id":  invoice.ID,
+		"offset_days": offsetDays,
+	})
+	return nil
+}
+
 // isTestMode checks if the service is running in test mode
 func (s *EmailService) isTestMode() bool {
 	return strings.ToLower(s.config.Environment) == "test" ||
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// fxParitySource marks rates between a currency and itself or its peg
+	fxParitySource = "parity"
+	// maxFXQuotesPerRequest bounds how many quote currencies one rates lookup may ask for
+	maxFXQuotesPerRequest = 20
+	// fxHistoryLimit bounds how many historical rates are returned at once
+	fxHistoryLimit = 500
+	// fxDefaultHistoryWindow is how far back history goes when no start time is given
+	fxDefaultHistoryWindow = 30 * 24 * time.Hour
+)
+
+type fxService struct {
+	rateRepo ports.FXRateRepository
+	provider ports.FXRateProvider
+	config   config.Config
+	logger   logging.Logger
+	now      func() time.Time
+}
+
+// NewFXService creates a new exchange rate service
+func NewFXService(rateRepo ports.FXRateRepository, provider ports.FXRateProvider, config config.Config, logger logging.Logger) ports.FXService {
+	return &fxService{
+		rateRepo: rateRepo,
+		provider: provider,
+		config:   config,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// GetRates returns the current rate from base to each quote, in the order asked.
+// Stored rates younger than FXRateMaxAge are reused; the rest are fetched from
+// the provider in a single call and stored as history. Pegged codes such as
+// USDC are looked up as the currency they are pegged to.
+func (s *fxService) GetRates(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error) {
+	base, err := normalizeCurrencyCode(base)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotes) == 0 {
+		return nil, appErrors.NewValidationError("at least one quote currency is required")
+	}
+	if len(quotes) > maxFXQuotesPerRequest {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("at most %d quote currencies can be requested at once", maxFXQuotesPerRequest))
+	}
+
+	normalized := make([]string, len(quotes))
+	for i, quote := range quotes {
+		if normalized[i], err = normalizeCurrencyCode(quote); err != nil {
+			return nil, err
+		}
+	}
+	quotes = normalized
+
+	peggedBase := s.peg(base)
+	resolved := make(map[string]domain.FXRate)
+	var missing []string
+
+	for _, quote := range quotes {
+		peggedQuote := s.peg(quote)
+		if peggedQuote == peggedBase || slices.Contains(missing, peggedQuote) {
+			continue
+		}
+		if _, ok := resolved[peggedQuote]; ok {
+			continue
+		}
+
+		stored, err := s.rateRepo.GetLatestRate(ctx, peggedBase, peggedQuote)
+		if err != nil {
+			return nil, err
+		}
+
+		if stored != nil && s.now().Sub(stored.FetchedAt) <= s.config.FXRateMaxAge {
+			resolved[peggedQuote] = *stored
+			continue
+		}
+
+		missing = append(missing, peggedQuote)
+	}
+
+	if len(missing) > 0 {
+		if err := s.refreshRates(ctx, peggedBase, missing, resolved); err != nil {
+			return nil, err
+		}
+	}
+
+	rates := make([]domain.FXRate, 0, len(quotes))
+	for _, quote := range quotes {
+		peggedQuote := s.peg(quote)
+
+		if peggedQuote == peggedBase {
+			rates = append(rates, domain.FXRate{
+				Base:      base,
+				Quote:     quote,
+				Rate:      decimal.NewFromInt(1),
+				Source:    fxParitySource,
+				FetchedAt: s.now(),
+			})
+			continue
+		}
+
+		rate, ok := resolved[peggedQuote]
+		if !ok {
+			return nil, appErrors.NewValidationError(fmt.Sprintf("no exchange rate available for %s/%s", base, quote))
+		}
+
+		// Report the pair as asked, even when it was looked up through a peg
+		rate.Base = base
+		rate.Quote = quote
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// refreshRates fetches rates from the provider, stores them and adds them to resolved
+func (s *fxService) refreshRates(ctx context.Context, base string, quotes []string, resolved map[string]domain.FXRate) error {
+	fetched, err := s.provider.GetRates(ctx, base, quotes)
+	if err != nil {
+		return fmt.Errorf("failed to fetch exchange rates from %s: %w", s.provider.Name(), err)
+	}
+
+	for _, rate := range fetched {
+		quote := strings.ToUpper(rate.Quote)
+		if !strings.EqualFold(rate.Base, base) || !slices.Contains(quotes, quote) || !rate.Rate.IsPositive() {
+			continue
+		}
+
+		rate.ID = uuid.New()
+		rate.Base = base
+		rate.Quote = quote
+		if rate.Source == "" {
+			rate.Source = s.provider.Name()
+		}
+		if rate.FetchedAt.IsZero() {
+			rate.FetchedAt = s.now()
+		}
+
+		saved, err := s.rateRepo.SaveRate(ctx, rate)
+		if err != nil {
+			return err
+		}
+
+		resolved[quote] = *saved
+	}
+
+	s.logger.Info("Exchange rates refreshed", map[string]interface{}{
+		"provider": s.provider.Name(),
+		"base":     base,
+		"quotes":   quotes,
+		"fetched":  len(fetched),
+	})
+
+	return nil
+}
+
+// GetRateHistory lists stored rates of a pair between from and to, defaulting
+// to the last 30 days
+func (s *fxService) GetRateHistory(ctx context.Context, base, quote string, from, to *time.Time) ([]domain.FXRate, error) {
+	base, err := normalizeCurrencyCode(base)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err = normalizeCurrencyCode(quote)
+	if err != nil {
+		return nil, err
+	}
+
+	end := s.now()
+	if to != nil {
+		end = *to
+	}
+
+	start := end.Add(-fxDefaultHistoryWindow)
+	if from != nil {
+		start = *from
+	}
+
+	if !start.Before(end) {
+		return nil, appErrors.NewValidationError("from must be before to")
+	}
+
+	rates, err := s.rateRepo.ListRateHistory(ctx, s.peg(base), s.peg(quote), start, end, fxHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rates {
+		rates[i].Base = base
+		rates[i].Quote = quote
+	}
+
+	return rates, nil
+}
+
+// LockQuote locks the current rate of a pair until FXQuoteLockDuration from now
+func (s *fxService) LockQuote(ctx context.Context, base, quote string) (*domain.FXQuote, error) {
+	rates, err := s.GetRates(ctx, base, []string{quote})
+	if err != nil {
+		return nil, err
+	}
+	rate := rates[0]
+
+	created, err := s.rateRepo.CreateQuote(ctx, domain.FXQuote{
+		ID:        uuid.New(),
+		Base:      rate.Base,
+		Quote:     rate.Quote,
+		Rate:      rate.Rate,
+		Source:    rate.Source,
+		ExpiresAt: s.now().Add(s.config.FXQuoteLockDuration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Exchange rate quote locked", map[string]interface{}{
+		"quote_id":   created.ID,
+		"pair":       created.Base + "/" + created.Quote,
+		"rate":       created.Rate.String(),
+		"expires_at": created.ExpiresAt,
+	})
+
+	return created, nil
+}
+
+// GetQuote retrieves a locked quote by ID
+func (s *fxService) GetQuote(ctx context.Context, id uuid.UUID) (*domain.FXQuote, error) {
+	quote, err := s.rateRepo.GetQuote(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if quote == nil {
+		return nil, appErrors.NewNotFoundError("quote not found")
+	}
+
+	return quote, nil
+}
+
+// Convert converts an amount with a locked quote. An amount in the quote's base
+// is multiplied by the rate and an amount in its quote currency is divided by
+// it; either way the result is rounded half-even to places decimal places.
+// Expired quotes are rejected so conversions never silently use an old rate.
+func (s *fxService) Convert(ctx context.Context, quoteID uuid.UUID, amount money.Money, places int32) (money.Money, error) {
+	quote, err := s.GetQuote(ctx, quoteID)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	if quote.IsExpired(s.now()) {
+		return money.Money{}, appErrors.NewValidationError("quote has expired")
+	}
+
+	switch amount.Currency() {
+	case quote.Base:
+		converted := amount.Mul(quote.Rate).Round(places, money.RoundHalfEven)
+		return money.New(converted.Amount(), quote.Quote), nil
+	case quote.Quote:
+		converted, err := amount.Div(quote.Rate, places, money.RoundHalfEven)
+		if err != nil {
+			return money.Money{}, err
+		}
+		return money.New(converted.Amount(), quote.Base), nil
+	default:
+		return money.Money{}, appErrors.NewValidationError(fmt.Sprintf("quote is for %s/%s, not %s", quote.Base, quote.Quote, amount.Currency()))
+	}
+}
+
+// peg returns the currency a code is pegged to, or the code itself
+func (s *fxService) peg(code string) string {
+	if pegged, ok := s.config.FXPegs[code]; ok {
+		return pegged
+	}
+	return code
+}
+
+// normalizeCurrencyCode upper-cases a currency or asset code and checks it is 2-20 letters or digits
+func normalizeCurrencyCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if len(code) < 2 || len(code) > 20 {
+		return "", appErrors.NewValidationError(fmt.Sprintf("invalid currency code %q", code))
+	}
+
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return "", appErrors.NewValidationError(fmt.Sprintf("invalid currency code %q", code))
+		}
+	}
+
+	return code, nil
+}
@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fxTestNow = time.Date(2025, 5, 18, 12, 0, 0, 0, time.UTC)
+
+// stubFXQuoteStore keeps the quotes created through the repository fake so
+// they can be read back
+func stubFXQuoteStore(repo *mocks.FakeFXRateRepository) {
+	quotes := make(map[uuid.UUID]domain.FXQuote)
+	repo.SaveRateStub = func(ctx context.Context, rate domain.FXRate) (*domain.FXRate, error) {
+		return &rate, nil
+	}
+	repo.CreateQuoteStub = func(ctx context.Context, quote domain.FXQuote) (*domain.FXQuote, error) {
+		quote.CreatedAt = fxTestNow
+		quotes[quote.ID] = quote
+		return &quote, nil
+	}
+	repo.GetQuoteStub = func(ctx context.Context, id uuid.UUID) (*domain.FXQuote, error) {
+		if quote, ok := quotes[id]; ok {
+			return &quote, nil
+		}
+		return nil, nil
+	}
+}
+
+func testRate(base, quote, rate string, fetchedAt time.Time) domain.FXRate {
+	return domain.FXRate{ID: uuid.New(), Base: base, Quote: quote, Rate: decimal.RequireFromString(rate), Source: "static", FetchedAt: fetchedAt}
+}
+
+func TestFXService_GetRates_UsesFreshStoredRates(t *testing.T) {
+	// Arrange
+	mockFXRepo := new(mocks.FakeFXRateRepository)
+	mockProvider := new(mocks.FakeFXRateProvider)
+	mockProvider.NameReturns("static")
+	stubFXQuoteStore(mockFXRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+	service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+	service.now = func() time.Time { return fxTestNow }
+
+	stored := testRate("EUR", "USD", "1.08", fxTestNow.Add(-10*time.Minute))
+	mockFXRepo.GetLatestRateReturns(&stored, nil)
+
+	// Act
+	rates, err := service.GetRates(context.Background(), "eur", []string{"usd"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.Equal(t, "USD", rates[0].Quote)
+	assert.True(t, rates[0].Rate.Equal(decimal.RequireFromString("1.08")))
+	assert.Equal(t, 0, mockProvider.GetRatesCallCount())
+}
+
+func TestFXService_GetRates_RefreshesStaleAndMissingRates(t *testing.T) {
+	// Arrange
+	mockFXRepo := new(mocks.FakeFXRateRepository)
+	mockProvider := new(mocks.FakeFXRateProvider)
+	mockProvider.NameReturns("static")
+	stubFXQuoteStore(mockFXRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+	service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+	service.now = func() time.Time { return fxTestNow }
+
+	stale := testRate("EUR", "USD", "1.05", fxTestNow.Add(-2*time.Hour))
+	mockFXRepo.GetLatestRateStub = func(ctx context.Context, base, quote string) (*domain.FXRate, error) {
+		if quote == "USD" {
+			return &stale, nil
+		}
+		return nil, nil
+	}
+	mockProvider.GetRatesReturns([]domain.FXRate{
+		{Base: "EUR", Quote: "USD", Rate: decimal.RequireFromString("1.08")},
+		{Base: "EUR", Quote: "NGN", Rate: decimal.RequireFromString("1675.2")},
+	}, nil)
+
+	// Act: USDC is pegged to USD, so it shares the USD lookup
+	rates, err := service.GetRates(context.Background(), "EUR", []string{"NGN", "USDC", "USD"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	assert.Equal(t, "NGN", rates[0].Quote)
+	assert.Equal(t, "USDC", rates[1].Quote)
+	assert.True(t, rates[1].Rate.Equal(decimal.RequireFromString("1.08")))
+	assert.Equal(t, "USD", rates[2].Quote)
+
+	// One provider call for everything missing, and every fetched rate is kept as history
+	require.Equal(t, 1, mockProvider.GetRatesCallCount())
+	_, base, quotes := mockProvider.GetRatesArgsForCall(0)
+	assert.Equal(t, "EUR", base)
+	assert.ElementsMatch(t, []string{"NGN", "USD"}, quotes)
+	assert.Equal(t, 2, mockFXRepo.SaveRateCallCount())
+	_, saved := mockFXRepo.SaveRateArgsForCall(0)
+	assert.Equal(t, fxTestNow, saved.FetchedAt)
+	assert.Equal(t, "static", saved.Source)
+}
+
+func TestFXService_GetRates_Parity(t *testing.T) {
+	// Arrange
+	mockFXRepo := new(mocks.FakeFXRateRepository)
+	mockProvider := new(mocks.FakeFXRateProvider)
+	mockProvider.NameReturns("static")
+	stubFXQuoteStore(mockFXRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+	service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+	service.now = func() time.Time { return fxTestNow }
+
+	// Act
+	rates, err := service.GetRates(context.Background(), "USDC", []string{"USD", "USDT"})
+
+	// Assert
+	require.NoError(t, err)
+	for _, rate := range rates {
+		assert.True(t, rate.Rate.Equal(decimal.NewFromInt(1)))
+		assert.Equal(t, fxParitySource, rate.Source)
+	}
+	assert.Equal(t, 0, mockFXRepo.GetLatestRateCallCount())
+	assert.Equal(t, 0, mockProvider.GetRatesCallCount())
+}
+
+func TestFXService_GetRates_Errors(t *testing.T) {
+	t.Run("unsupported_pair", func(t *testing.T) {
+		// Arrange
+		mockFXRepo := new(mocks.FakeFXRateRepository)
+		mockProvider := new(mocks.FakeFXRateProvider)
+		mockProvider.NameReturns("static")
+		stubFXQuoteStore(mockFXRepo)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+		service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+		service.now = func() time.Time { return fxTestNow }
+		mockProvider.GetRatesReturns(nil, nil)
+
+		// Act
+		_, err := service.GetRates(context.Background(), "EUR", []string{"XYZ"})
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+		assert.Equal(t, 0, mockFXRepo.SaveRateCallCount())
+	})
+
+	t.Run("invalid_code", func(t *testing.T) {
+		// Arrange
+		mockFXRepo := new(mocks.FakeFXRateRepository)
+		mockProvider := new(mocks.FakeFXRateProvider)
+		mockProvider.NameReturns("static")
+		stubFXQuoteStore(mockFXRepo)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+		service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+		service.now = func() time.Time { return fxTestNow }
+
+		// Act
+		_, err := service.GetRates(context.Background(), "E-R", []string{"USD"})
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+
+	t.Run("no_quotes", func(t *testing.T) {
+		// Arrange
+		mockFXRepo := new(mocks.FakeFXRateRepository)
+		mockProvider := new(mocks.FakeFXRateProvider)
+		mockProvider.NameReturns("static")
+		stubFXQuoteStore(mockFXRepo)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+		service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+		service.now = func() time.Time { return fxTestNow }
+
+		// Act
+		_, err := service.GetRates(context.Background(), "EUR", nil)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+
+	t.Run("provider_down", func(t *testing.T) {
+		// Arrange
+		mockFXRepo := new(mocks.FakeFXRateRepository)
+		mockProvider := new(mocks.FakeFXRateProvider)
+		mockProvider.NameReturns("static")
+		stubFXQuoteStore(mockFXRepo)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+		service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+		service.now = func() time.Time { return fxTestNow }
+		mockProvider.GetRatesReturns(nil, errors.New("connection refused"))
+
+		// Act
+		_, err := service.GetRates(context.Background(), "EUR", []string{"USD"})
+
+		// Assert
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestFXService_LockQuoteAndConvert(t *testing.T) {
+	// Arrange
+	mockFXRepo := new(mocks.FakeFXRateRepository)
+	mockProvider := new(mocks.FakeFXRateProvider)
+	mockProvider.NameReturns("static")
+	stubFXQuoteStore(mockFXRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+	service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+	service.now = func() time.Time { return fxTestNow }
+	mockProvider.GetRatesReturns([]domain.FXRate{{Base: "EUR", Quote: "USD", Rate: decimal.RequireFromString("1.0837")}}, nil)
+	ctx := context.Background()
+
+	// Act
+	quote, err := service.LockQuote(ctx, "EUR", "USDC")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", quote.Base)
+	assert.Equal(t, "USDC", quote.Quote)
+	assert.Equal(t, fxTestNow.Add(15*time.Minute), quote.ExpiresAt)
+
+	// Act: the rate moves, but conversions with the quote do not
+	mockProvider.GetRatesReturns([]domain.FXRate{{Base: "EUR", Quote: "USD", Rate: decimal.RequireFromString("1.2")}}, nil)
+	service.now = func() time.Time { return fxTestNow.Add(14 * time.Minute) }
+	salary, salaryErr := service.Convert(ctx, quote.ID, money.MustParse("4250.00", "EUR"), 6)
+	back, backErr := service.Convert(ctx, quote.ID, money.MustParse("1000", "USDC"), 2)
+	_, otherErr := service.Convert(ctx, quote.ID, money.MustParse("1000", "NGN"), 2)
+
+	// Assert
+	require.NoError(t, salaryErr)
+	assert.True(t, salary.Equal(money.MustParse("4605.725", "USDC")), salary.String())
+	require.NoError(t, backErr)
+	assert.True(t, back.Equal(money.MustParse("922.76", "EUR")), back.String())
+	assertAppErrorType(t, otherErr, appErrors.ErrorTypeValidation)
+
+	// Act: the quote expires, and unknown quotes are not found
+	service.now = func() time.Time { return fxTestNow.Add(15 * time.Minute) }
+	_, expiredErr := service.Convert(ctx, quote.ID, money.MustParse("4250.00", "EUR"), 6)
+	_, unknownErr := service.Convert(ctx, uuid.New(), money.MustParse("1", "EUR"), 2)
+
+	// Assert
+	assertAppErrorType(t, expiredErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, unknownErr, appErrors.ErrorTypeNotFound)
+}
+
+func TestFXService_GetRateHistory(t *testing.T) {
+	// Arrange
+	mockFXRepo := new(mocks.FakeFXRateRepository)
+	mockProvider := new(mocks.FakeFXRateProvider)
+	mockProvider.NameReturns("static")
+	stubFXQuoteStore(mockFXRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", FXRateMaxAge: time.Hour, FXQuoteLockDuration: 15 * time.Minute, FXPegs: map[string]string{"USDC": "USD", "USDT": "USD"}}
+	service := NewFXService(mockFXRepo, mockProvider, cfg, logging.New(&cfg)).(*fxService)
+	service.now = func() time.Time { return fxTestNow }
+	mockFXRepo.ListRateHistoryReturns([]domain.FXRate{testRate("EUR", "USD", "1.08", fxTestNow.Add(-time.Hour))}, nil)
+	later := fxTestNow.Add(time.Hour)
+
+	// Act
+	rates, err := service.GetRateHistory(context.Background(), "EUR", "USDC", nil, nil)
+	_, invertedErr := service.GetRateHistory(context.Background(), "EUR", "USD", &later, &fxTestNow)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, "USDC", rates[0].Quote)
+
+	_, base, quote, from, to, _ := mockFXRepo.ListRateHistoryArgsForCall(0)
+	assert.Equal(t, "EUR", base)
+	assert.Equal(t, "USD", quote)
+	assert.Equal(t, fxTestNow, to)
+	assert.Equal(t, fxTestNow.Add(-fxDefaultHistoryWindow), from)
+
+	assertAppErrorType(t, invertedErr, appErrors.ErrorTypeValidation)
+}
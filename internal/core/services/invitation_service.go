@@ -0,0 +1,390 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	signedToken "github.com/demola234/defifundr/pkg/signed_token"
+	"github.com/google/uuid"
+)
+
+// invitationResendInterval is the minimum time between two emails for the same invitation
+const invitationResendInterval = time.Minute
+
+type invitationService struct {
+	invitationRepo ports.InvitationRepository
+	orgService     ports.OrganizationService
+	authService    ports.AuthService
+	emailService   ports.EmailService
+	signer         *signedToken.Signer
+	config         config.Config
+	logger         logging.Logger
+	now            func() time.Time
+}
+
+// NewInvitationService creates a new team invitation service. Invitation links
+// carry tokens signed by signer.
+func NewInvitationService(
+	invitationRepo ports.InvitationRepository,
+	orgService ports.OrganizationService,
+	authService ports.AuthService,
+	emailService ports.EmailService,
+	signer *signedToken.Signer,
+	config config.Config,
+	logger logging.Logger,
+) ports.InvitationService {
+	return &invitationService{
+		invitationRepo: invitationRepo,
+		orgService:     orgService,
+		authService:    authService,
+		emailService:   emailService,
+		signer:         signer,
+		config:         config,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// CreateInvitation invites an email address to the organization with a role.
+// Owners and admins may invite, but only owners may invite another owner.
+func (s *invitationService) CreateInvitation(ctx context.Context, inviterID, orgID uuid.UUID, email string, role domain.OrganizationRole) (*domain.Invitation, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Name != "" {
+		return nil, appErrors.NewValidationError("a valid email address is required")
+	}
+	email = strings.ToLower(address.Address)
+
+	if !role.IsValid() {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("invalid role %q", role))
+	}
+
+	inviter, err := s.orgService.AuthorizeMember(ctx, inviterID, orgID, domain.OrganizationRole.CanManageOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == domain.OrganizationRoleOwner && inviter.Role != domain.OrganizationRoleOwner {
+		return nil, appErrors.NewForbiddenError("only owners can invite another owner")
+	}
+
+	members, err := s.orgService.ListMembers(ctx, inviterID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return nil, appErrors.NewConflictError("this person is already a member of the organization")
+		}
+	}
+
+	existing, err := s.invitationRepo.GetPendingInvitationByEmail(ctx, orgID, email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, appErrors.NewConflictError("this email already has a pending invitation, resend it instead")
+	}
+
+	org, err := s.orgService.GetOrganization(ctx, inviterID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := domain.Invitation{
+		ID:               uuid.New(),
+		OrganizationID:   orgID,
+		OrganizationName: org.Name,
+		Email:            email,
+		Role:             role,
+		InvitedBy:        &inviterID,
+		ExpiresAt:        s.now().Add(s.config.InvitationTTL),
+	}
+	token := s.signer.Sign(invitation.ID.String(), invitation.ExpiresAt)
+	invitation.TokenHash = hashInvitationToken(token)
+
+	created, err := s.invitationRepo.CreateInvitation(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Organization invitation created", map[string]interface{}{
+		"organization_id": orgID,
+		"invitation_id":   created.ID,
+		"invited_by":      inviterID,
+		"role":            role,
+	})
+
+	s.sendInvitation(ctx, inviterID, *created, token)
+
+	return created, nil
+}
+
+// ListPendingInvitations lists invitations that have not been accepted or
+// revoked, including expired ones that can still be resent
+func (s *invitationService) ListPendingInvitations(ctx context.Context, userID, orgID uuid.UUID) ([]domain.Invitation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	return s.invitationRepo.ListPendingInvitations(ctx, orgID)
+}
+
+// ResendInvitation emails a new link with a fresh expiry. The previous link
+// stops working because only the latest token's hash is kept.
+func (s *invitationService) ResendInvitation(ctx context.Context, userID, orgID, invitationID uuid.UUID) (*domain.Invitation, error) {
+	invitation, err := s.getPendingInvitation(ctx, userID, orgID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.now().Sub(invitation.LastSentAt) < invitationResendInterval {
+		return nil, appErrors.NewValidationError("the invitation was just sent, please wait a minute before resending")
+	}
+
+	expiresAt := s.now().Add(s.config.InvitationTTL)
+	token := s.signer.Sign(invitation.ID.String(), expiresAt)
+
+	refreshed, err := s.invitationRepo.RefreshInvitationToken(ctx, invitation.ID, hashInvitationToken(token), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if refreshed == nil {
+		return nil, appErrors.NewConflictError("invitation is no longer pending")
+	}
+	refreshed.OrganizationName = invitation.OrganizationName
+
+	s.logger.Info("Organization invitation resent", map[string]interface{}{
+		"organization_id": orgID,
+		"invitation_id":   invitationID,
+		"resent_by":       userID,
+		"send_count":      refreshed.SendCount,
+	})
+
+	s.sendInvitation(ctx, userID, *refreshed, token)
+
+	return refreshed, nil
+}
+
+// RevokeInvitation cancels a pending invitation so its link stops working
+func (s *invitationService) RevokeInvitation(ctx context.Context, userID, orgID, invitationID uuid.UUID) error {
+	if _, err := s.getPendingInvitation(ctx, userID, orgID, invitationID); err != nil {
+		return err
+	}
+
+	revoked, err := s.invitationRepo.RevokeInvitation(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+	if revoked == nil {
+		return appErrors.NewConflictError("invitation is no longer pending")
+	}
+
+	s.logger.Info("Organization invitation revoked", map[string]interface{}{
+		"organization_id": orgID,
+		"invitation_id":   invitationID,
+		"revoked_by":      userID,
+	})
+
+	return nil
+}
+
+// GetInvitationByToken returns the invitation behind a link, so the invitee can
+// be shown who invited them and sent to sign in or to sign up
+func (s *invitationService) GetInvitationByToken(ctx context.Context, token string) (*domain.Invitation, bool, error) {
+	invitation, err := s.resolveToken(ctx, token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	exists, err := s.authService.CheckEmailExists(ctx, invitation.Email)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return invitation, exists, nil
+}
+
+// AcceptInvitation links an existing, signed-in user to the organization. The
+// invitation can only be used by the email address it was sent to.
+func (s *invitationService) AcceptInvitation(ctx context.Context, userID uuid.UUID, token string) (*domain.OrganizationMember, error) {
+	invitation, err := s.resolveToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.authService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, appErrors.NewForbiddenError("this invitation was sent to a different email address")
+	}
+
+	return s.accept(ctx, *invitation, userID)
+}
+
+// AcceptInvitationWithRegistration signs up the invitee with the invited email
+// and a password through RegisterUser, then adds them to the organization
+func (s *invitationService) AcceptInvitationWithRegistration(ctx context.Context, token string, user domain.User, password string) (*domain.User, *domain.OrganizationMember, error) {
+	invitation, err := s.resolveToken(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exists, err := s.authService.CheckEmailExists(ctx, invitation.Email)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		return nil, nil, appErrors.NewConflictError("an account already exists for this email, sign in to accept the invitation")
+	}
+
+	// The invited address is verified by the link, so it is not taken from the request
+	user.Email = invitation.Email
+	user.AuthProvider = "email"
+	user.ProviderID = invitation.Email
+	if user.AccountType == "" {
+		user.AccountType = "personal"
+	}
+
+	created, err := s.authService.RegisterUser(ctx, user, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := s.accept(ctx, *invitation, created.ID)
+	if err != nil {
+		return created, nil, err
+	}
+
+	return created, member, nil
+}
+
+// accept records the acceptance and membership together
+func (s *invitationService) accept(ctx context.Context, invitation domain.Invitation, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, invitation.OrganizationID, nil); err == nil {
+		return nil, appErrors.NewConflictError("you are already a member of this organization")
+	} else if appErrors.GetErrorType(err) != appErrors.ErrorTypeNotFound {
+		return nil, err
+	}
+
+	member, err := s.invitationRepo.AcceptInvitation(ctx, invitation.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, appErrors.NewConflictError("invitation is no longer pending")
+	}
+
+	s.logger.Info("Organization invitation accepted", map[string]interface{}{
+		"organization_id": invitation.OrganizationID,
+		"invitation_id":   invitation.ID,
+		"user_id":         userID,
+		"role":            member.Role,
+	})
+
+	return member, nil
+}
+
+// resolveToken verifies a link's signature and expiry and loads its invitation,
+// rejecting links replaced by a resend and invitations that are not pending
+func (s *invitationService) resolveToken(ctx context.Context, token string) (*domain.Invitation, error) {
+	subject, err := s.signer.Verify(strings.TrimSpace(token), s.now())
+	if errors.Is(err, signedToken.ErrExpiredToken) {
+		return nil, appErrors.NewValidationError("invitation link has expired, ask for a new one")
+	}
+	if err != nil {
+		return nil, appErrors.NewValidationError("invalid invitation link")
+	}
+
+	invitationID, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, appErrors.NewValidationError("invalid invitation link")
+	}
+
+	invitation, err := s.invitationRepo.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, appErrors.NewNotFoundError("invitation not found")
+	}
+
+	if invitation.TokenHash != hashInvitationToken(strings.TrimSpace(token)) {
+		return nil, appErrors.NewValidationError("this invitation link has been replaced by a newer one")
+	}
+
+	switch invitation.Status {
+	case domain.InvitationStatusAccepted:
+		return nil, appErrors.NewConflictError("invitation has already been accepted")
+	case domain.InvitationStatusRevoked:
+		return nil, appErrors.NewValidationError("invitation has been revoked")
+	}
+
+	if !invitation.IsAcceptable(s.now()) {
+		return nil, appErrors.NewValidationError("invitation link has expired, ask for a new one")
+	}
+
+	return invitation, nil
+}
+
+// getPendingInvitation loads a pending invitation of the organization for an owner or admin
+func (s *invitationService) getPendingInvitation(ctx context.Context, userID, orgID, invitationID uuid.UUID) (*domain.Invitation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.invitationRepo.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil || invitation.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("invitation not found")
+	}
+
+	if invitation.Status != domain.InvitationStatusPending {
+		return nil, appErrors.NewConflictError("invitation is no longer pending")
+	}
+
+	return invitation, nil
+}
+
+// sendInvitation emails the link. Failures are logged rather than returned;
+// the invitation stays pending and can be resent.
+func (s *invitationService) sendInvitation(ctx context.Context, inviterID uuid.UUID, invitation domain.Invitation, token string) {
+	inviterName := "A teammate"
+	if inviter, err := s.authService.GetUserByID(ctx, inviterID); err == nil && inviter != nil {
+		inviterName = strings.TrimSpace(inviter.FirstName + " " + inviter.LastName)
+	}
+
+	if err := s.emailService.SendTeamInvitation(ctx, invitation.Email, inviterName, invitation, s.inviteLink(token)); err != nil {
+		s.logger.Error("Failed to send organization invitation", err, map[string]interface{}{
+			"organization_id": invitation.OrganizationID,
+			"invitation_id":   invitation.ID,
+		})
+	}
+}
+
+// inviteLink builds the accept URL sent to the invitee
+func (s *invitationService) inviteLink(token string) string {
+	return fmt.Sprintf("%s?token=%s", s.config.InvitationAcceptURL, url.QueryEscape(token))
+}
+
+// hashInvitationToken hashes an invitation token so only the digest is stored
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
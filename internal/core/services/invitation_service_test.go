@@ -0,0 +1,434 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	signedToken "github.com/demola234/defifundr/pkg/signed_token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubInvitationDirectory backs the member list and user lookups the
+// invitation service makes with the given members and users
+func stubInvitationDirectory(orgService *mocks.FakeOrganizationService, authService *mocks.FakeAuthService, members map[uuid.UUID]domain.OrganizationRole, users map[uuid.UUID]domain.User) {
+	orgService.ListMembersStub = func(ctx context.Context, userID, orgID uuid.UUID) ([]domain.OrganizationMember, error) {
+		var result []domain.OrganizationMember
+		for id, role := range members {
+			result = append(result, domain.OrganizationMember{UserID: id, Role: role, Email: users[id].Email})
+		}
+		return result, nil
+	}
+	authService.GetUserByIDStub = func(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+		user, ok := users[userID]
+		if !ok {
+			return nil, appErrors.NewNotFoundError("user not found")
+		}
+		return &user, nil
+	}
+	authService.CheckEmailExistsStub = func(ctx context.Context, email string) (bool, error) {
+		for _, user := range users {
+			if user.Email == email {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	authService.RegisterUserStub = func(ctx context.Context, user domain.User, password string) (*domain.User, error) {
+		users[user.ID] = user
+		return &user, nil
+	}
+}
+
+// stubInvitationStore keeps invitations in memory, adding whoever accepts one
+// to members and stamping sends with the time now points at
+func stubInvitationStore(repo *mocks.FakeInvitationRepository, members map[uuid.UUID]domain.OrganizationRole, now *time.Time) {
+	invitations := make(map[uuid.UUID]*domain.Invitation)
+	repo.CreateInvitationStub = func(ctx context.Context, invitation domain.Invitation) (*domain.Invitation, error) {
+		invitation.Status = domain.InvitationStatusPending
+		invitation.SendCount = 1
+		invitation.LastSentAt = *now
+		invitations[invitation.ID] = &invitation
+		stored := invitation
+		return &stored, nil
+	}
+	repo.GetInvitationByIDStub = func(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+		invitation, ok := invitations[id]
+		if !ok {
+			return nil, nil
+		}
+		stored := *invitation
+		return &stored, nil
+	}
+	repo.GetPendingInvitationByEmailStub = func(ctx context.Context, orgID uuid.UUID, email string) (*domain.Invitation, error) {
+		for _, invitation := range invitations {
+			if invitation.OrganizationID == orgID && invitation.Email == email && invitation.Status == domain.InvitationStatusPending {
+				stored := *invitation
+				return &stored, nil
+			}
+		}
+		return nil, nil
+	}
+	repo.RefreshInvitationTokenStub = func(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (*domain.Invitation, error) {
+		invitation := invitations[id]
+		invitation.TokenHash = tokenHash
+		invitation.ExpiresAt = expiresAt
+		invitation.SendCount++
+		invitation.LastSentAt = *now
+		stored := *invitation
+		return &stored, nil
+	}
+	repo.AcceptInvitationStub = func(ctx context.Context, id, userID uuid.UUID) (*domain.OrganizationMember, error) {
+		invitation := invitations[id]
+		if invitation.Status != domain.InvitationStatusPending {
+			return nil, nil
+		}
+		invitation.Status = domain.InvitationStatusAccepted
+		members[userID] = invitation.Role
+		return &domain.OrganizationMember{OrganizationID: invitation.OrganizationID, UserID: userID, Role: invitation.Role}, nil
+	}
+	repo.RevokeInvitationStub = func(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+		invitation := invitations[id]
+		invitation.Status = domain.InvitationStatusRevoked
+		stored := *invitation
+		return &stored, nil
+	}
+}
+
+func testInvitationUser(email string) domain.User {
+	return domain.User{ID: uuid.New(), Email: email, FirstName: "Ada", LastName: "Obi"}
+}
+
+// lastInvitationToken returns the token from the most recently emailed link
+func lastInvitationToken(t *testing.T, links []string) string {
+	t.Helper()
+	require.NotEmpty(t, links)
+	link := links[len(links)-1]
+	prefix := "https://app.example.com/invitations/accept?token="
+	require.Contains(t, link, prefix)
+	return link[len(prefix):]
+}
+
+func TestInvitationService_CreateInvitation(t *testing.T) {
+	// Arrange
+	mockInvitationRepo := new(mocks.FakeInvitationRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAuthService := new(mocks.FakeAuthService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := testInvitationUser("admin@acme.test")
+	viewer := testInvitationUser("viewer@acme.test")
+	users := map[uuid.UUID]domain.User{admin.ID: admin, viewer.ID: viewer}
+	members := map[uuid.UUID]domain.OrganizationRole{admin.ID: domain.OrganizationRoleAdmin, viewer.ID: domain.OrganizationRoleViewer}
+	stubOrganizationAccess(mockOrgService, orgID, members)
+	stubInvitationDirectory(mockOrgService, mockAuthService, members, users)
+	stubInvitationStore(mockInvitationRepo, members, &now)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme Ltd"}, nil)
+
+	var links []string
+	mockEmailService.SendTeamInvitationStub = func(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+		links = append(links, inviteLink)
+		return nil
+	}
+
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "organization_invitation")
+	require.NoError(t, err)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvitationTTL: 72 * time.Hour, InvitationAcceptURL: "https://app.example.com/invitations/accept"}
+	service := NewInvitationService(mockInvitationRepo, mockOrgService, mockAuthService, mockEmailService, signer, cfg, logging.New(&cfg)).(*invitationService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	invitation, err := service.CreateInvitation(ctx, admin.ID, orgID, " New.Hire@Acme.test ", domain.OrganizationRoleFinance)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "new.hire@acme.test", invitation.Email)
+	assert.Equal(t, "Acme Ltd", invitation.OrganizationName)
+	assert.Equal(t, now.Add(72*time.Hour), invitation.ExpiresAt)
+	assert.Equal(t, hashInvitationToken(lastInvitationToken(t, links)), invitation.TokenHash)
+
+	require.Equal(t, 1, mockEmailService.SendTeamInvitationCallCount())
+	_, to, inviterName, _, _ := mockEmailService.SendTeamInvitationArgsForCall(0)
+	assert.Equal(t, "new.hire@acme.test", to)
+	assert.Equal(t, "Ada Obi", inviterName)
+
+	testCases := []struct {
+		name      string
+		inviterID uuid.UUID
+		email     string
+		role      domain.OrganizationRole
+		errType   appErrors.ErrorType
+	}{
+		{name: "invalid_email", inviterID: admin.ID, email: "not-an-email", role: domain.OrganizationRoleViewer, errType: appErrors.ErrorTypeValidation},
+		{name: "invalid_role", inviterID: admin.ID, email: "a@acme.test", role: "superuser", errType: appErrors.ErrorTypeValidation},
+		{name: "viewer_cannot_invite", inviterID: viewer.ID, email: "a@acme.test", role: domain.OrganizationRoleViewer, errType: appErrors.ErrorTypeForbidden},
+		{name: "admin_cannot_invite_owner", inviterID: admin.ID, email: "a@acme.test", role: domain.OrganizationRoleOwner, errType: appErrors.ErrorTypeForbidden},
+		{name: "already_member", inviterID: admin.ID, email: "viewer@acme.test", role: domain.OrganizationRoleAdmin, errType: appErrors.ErrorTypeConflict},
+		{name: "already_invited", inviterID: admin.ID, email: "new.hire@acme.test", role: domain.OrganizationRoleViewer, errType: appErrors.ErrorTypeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			_, err := service.CreateInvitation(ctx, tc.inviterID, orgID, tc.email, tc.role)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+		})
+	}
+}
+
+func TestInvitationService_ResendReplacesLink(t *testing.T) {
+	// Arrange
+	mockInvitationRepo := new(mocks.FakeInvitationRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAuthService := new(mocks.FakeAuthService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := testInvitationUser("admin@acme.test")
+	users := map[uuid.UUID]domain.User{admin.ID: admin}
+	members := map[uuid.UUID]domain.OrganizationRole{admin.ID: domain.OrganizationRoleAdmin}
+	stubOrganizationAccess(mockOrgService, orgID, members)
+	stubInvitationDirectory(mockOrgService, mockAuthService, members, users)
+	stubInvitationStore(mockInvitationRepo, members, &now)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme Ltd"}, nil)
+
+	var links []string
+	mockEmailService.SendTeamInvitationStub = func(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+		links = append(links, inviteLink)
+		return nil
+	}
+
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "organization_invitation")
+	require.NoError(t, err)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvitationTTL: 72 * time.Hour, InvitationAcceptURL: "https://app.example.com/invitations/accept"}
+	service := NewInvitationService(mockInvitationRepo, mockOrgService, mockAuthService, mockEmailService, signer, cfg, logging.New(&cfg)).(*invitationService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	invitation, err := service.CreateInvitation(ctx, admin.ID, orgID, "new@acme.test", domain.OrganizationRoleViewer)
+	require.NoError(t, err)
+	firstToken := lastInvitationToken(t, links)
+
+	// Act: too soon after the first send
+	_, err = service.ResendInvitation(ctx, admin.ID, orgID, invitation.ID)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+
+	// Act
+	now = now.Add(2 * time.Minute)
+	resent, err := service.ResendInvitation(ctx, admin.ID, orgID, invitation.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, resent.SendCount)
+	assert.Equal(t, "Acme Ltd", resent.OrganizationName)
+	secondToken := lastInvitationToken(t, links)
+	assert.NotEqual(t, firstToken, secondToken)
+
+	_, _, err = service.GetInvitationByToken(ctx, firstToken)
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+
+	found, exists, err := service.GetInvitationByToken(ctx, secondToken)
+	require.NoError(t, err)
+	assert.Equal(t, invitation.ID, found.ID)
+	assert.False(t, exists)
+
+	// Act: through another organization
+	_, err = service.ResendInvitation(ctx, admin.ID, uuid.New(), invitation.ID)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeNotFound)
+}
+
+func TestInvitationService_AcceptInvitation(t *testing.T) {
+	// Arrange
+	mockInvitationRepo := new(mocks.FakeInvitationRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAuthService := new(mocks.FakeAuthService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := testInvitationUser("admin@acme.test")
+	invitee := testInvitationUser("invitee@acme.test")
+	other := testInvitationUser("other@acme.test")
+	users := map[uuid.UUID]domain.User{admin.ID: admin, invitee.ID: invitee, other.ID: other}
+	members := map[uuid.UUID]domain.OrganizationRole{admin.ID: domain.OrganizationRoleAdmin}
+	stubOrganizationAccess(mockOrgService, orgID, members)
+	stubInvitationDirectory(mockOrgService, mockAuthService, members, users)
+	stubInvitationStore(mockInvitationRepo, members, &now)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme Ltd"}, nil)
+
+	var links []string
+	mockEmailService.SendTeamInvitationStub = func(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+		links = append(links, inviteLink)
+		return nil
+	}
+
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "organization_invitation")
+	require.NoError(t, err)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvitationTTL: 72 * time.Hour, InvitationAcceptURL: "https://app.example.com/invitations/accept"}
+	service := NewInvitationService(mockInvitationRepo, mockOrgService, mockAuthService, mockEmailService, signer, cfg, logging.New(&cfg)).(*invitationService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	_, err = service.CreateInvitation(ctx, admin.ID, orgID, "Invitee@acme.test", domain.OrganizationRoleFinance)
+	require.NoError(t, err)
+	token := lastInvitationToken(t, links)
+
+	_, exists, err := service.GetInvitationByToken(ctx, token)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// Act
+	_, otherErr := service.AcceptInvitation(ctx, other.ID, token)
+	member, err := service.AcceptInvitation(ctx, invitee.ID, token)
+	_, againErr := service.AcceptInvitation(ctx, invitee.ID, token)
+
+	// Assert
+	assertAppErrorType(t, otherErr, appErrors.ErrorTypeForbidden)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrganizationRoleFinance, member.Role)
+	assert.Equal(t, domain.OrganizationRoleFinance, members[invitee.ID])
+	assertAppErrorType(t, againErr, appErrors.ErrorTypeConflict)
+}
+
+func TestInvitationService_RejectsUnusableLinks(t *testing.T) {
+	// Arrange
+	mockInvitationRepo := new(mocks.FakeInvitationRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAuthService := new(mocks.FakeAuthService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := testInvitationUser("admin@acme.test")
+	invitee := testInvitationUser("invitee@acme.test")
+	users := map[uuid.UUID]domain.User{admin.ID: admin, invitee.ID: invitee}
+	members := map[uuid.UUID]domain.OrganizationRole{admin.ID: domain.OrganizationRoleAdmin}
+	stubOrganizationAccess(mockOrgService, orgID, members)
+	stubInvitationDirectory(mockOrgService, mockAuthService, members, users)
+	stubInvitationStore(mockInvitationRepo, members, &now)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme Ltd"}, nil)
+
+	var links []string
+	mockEmailService.SendTeamInvitationStub = func(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+		links = append(links, inviteLink)
+		return nil
+	}
+
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "organization_invitation")
+	require.NoError(t, err)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvitationTTL: 72 * time.Hour, InvitationAcceptURL: "https://app.example.com/invitations/accept"}
+	service := NewInvitationService(mockInvitationRepo, mockOrgService, mockAuthService, mockEmailService, signer, cfg, logging.New(&cfg)).(*invitationService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	invitation, err := service.CreateInvitation(ctx, admin.ID, orgID, "invitee@acme.test", domain.OrganizationRoleViewer)
+	require.NoError(t, err)
+	token := lastInvitationToken(t, links)
+
+	// Act: a malformed token
+	_, err = service.AcceptInvitation(ctx, invitee.ID, "garbage")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+
+	// Act: an expired link
+	now = now.Add(73 * time.Hour)
+	_, err = service.AcceptInvitation(ctx, invitee.ID, token)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+
+	// Act: a revoked invitation
+	now = now.Add(-73 * time.Hour)
+	revokeErr := service.RevokeInvitation(ctx, admin.ID, orgID, invitation.ID)
+	_, err = service.AcceptInvitation(ctx, invitee.ID, token)
+	revokeAgainErr := service.RevokeInvitation(ctx, admin.ID, orgID, invitation.ID)
+
+	// Assert
+	require.NoError(t, revokeErr)
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, revokeAgainErr, appErrors.ErrorTypeConflict)
+}
+
+func TestInvitationService_AcceptInvitationWithRegistration(t *testing.T) {
+	// Arrange
+	mockInvitationRepo := new(mocks.FakeInvitationRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAuthService := new(mocks.FakeAuthService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := testInvitationUser("admin@acme.test")
+	users := map[uuid.UUID]domain.User{admin.ID: admin}
+	members := map[uuid.UUID]domain.OrganizationRole{admin.ID: domain.OrganizationRoleAdmin}
+	stubOrganizationAccess(mockOrgService, orgID, members)
+	stubInvitationDirectory(mockOrgService, mockAuthService, members, users)
+	stubInvitationStore(mockInvitationRepo, members, &now)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme Ltd"}, nil)
+
+	var links []string
+	mockEmailService.SendTeamInvitationStub = func(ctx context.Context, email, inviterName string, invitation domain.Invitation, inviteLink string) error {
+		links = append(links, inviteLink)
+		return nil
+	}
+
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "organization_invitation")
+	require.NoError(t, err)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvitationTTL: 72 * time.Hour, InvitationAcceptURL: "https://app.example.com/invitations/accept"}
+	service := NewInvitationService(mockInvitationRepo, mockOrgService, mockAuthService, mockEmailService, signer, cfg, logging.New(&cfg)).(*invitationService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	_, err = service.CreateInvitation(ctx, admin.ID, orgID, "new@acme.test", domain.OrganizationRoleViewer)
+	require.NoError(t, err)
+	token := lastInvitationToken(t, links)
+
+	// Act
+	user, member, err := service.AcceptInvitationWithRegistration(ctx, token, domain.User{
+		ID:        uuid.New(),
+		Email:     "someone.else@acme.test",
+		FirstName: "New",
+		LastName:  "Hire",
+	}, "password123")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "new@acme.test", user.Email)
+	assert.Equal(t, "email", user.AuthProvider)
+	assert.Equal(t, user.ID, member.UserID)
+	assert.Equal(t, domain.OrganizationRoleViewer, members[user.ID])
+
+	// Act: the invited email has registered since
+	_, err = service.CreateInvitation(ctx, admin.ID, orgID, "existing@acme.test", domain.OrganizationRoleViewer)
+	require.NoError(t, err)
+	existing := testInvitationUser("existing@acme.test")
+	users[existing.ID] = existing
+	_, _, err = service.AcceptInvitationWithRegistration(ctx, lastInvitationToken(t, links), domain.User{ID: uuid.New()}, "password123")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Equal(t, 1, mockAuthService.RegisterUserCallCount())
+}
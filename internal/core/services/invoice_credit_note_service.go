@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type creditNoteService struct {
+	creditNoteRepo ports.CreditNoteRepository
+	invoiceRepo    ports.InvoiceRepository
+	orgService     ports.OrganizationService
+	assetService   ports.AssetService
+	securityRepo   ports.SecurityRepository
+	ledger         invoiceLedger
+	logger         logging.Logger
+	now            func() time.Time
+}
+
+// NewCreditNoteService creates a new credit note service. Credit notes are
+// posted to ledger as they are issued.
+func NewCreditNoteService(
+	creditNoteRepo ports.CreditNoteRepository,
+	invoiceRepo ports.InvoiceRepository,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	securityRepo ports.SecurityRepository,
+	ledger ports.LedgerService,
+	logger logging.Logger,
+) ports.CreditNoteService {
+	return &creditNoteService{
+		creditNoteRepo: creditNoteRepo,
+		invoiceRepo:    invoiceRepo,
+		orgService:     orgService,
+		assetService:   assetService,
+		securityRepo:   securityRepo,
+		ledger:         invoiceLedger{ledger: ledger},
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// IssueCreditNote credits part or all of an open invoice's balance, the whole
+// balance when amount is nil. The credit note is numbered in the
+// organization's credit note sequence and reverses the invoice's revenue and
+// tax in proportion in the ledger.
+func (s *creditNoteService) IssueCreditNote(ctx context.Context, userID, orgID, invoiceID uuid.UUID, amount *decimal.Decimal, reason string) (*domain.CreditNote, *domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil, appErrors.NewValidationError("a reason is required to issue a credit note")
+	}
+
+	invoice, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !invoice.Status.IsOpen() {
+		return nil, nil, appErrors.NewConflictError(fmt.Sprintf("a %s invoice cannot be credited", invoice.Status))
+	}
+
+	balance := invoice.BalanceDue()
+	credit := balance
+	if amount != nil {
+		places, err := currencyAmountPlaces(ctx, s.assetService, invoice.Currency)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		credit = money.New(*amount, invoice.Currency)
+		if !credit.IsPositive() {
+			return nil, nil, appErrors.NewValidationError("credit note amount must be positive")
+		}
+		if !credit.Round(places, money.RoundHalfEven).Equal(credit) {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("%s amounts have at most %d decimal places", invoice.Currency, places))
+		}
+		if credit.Amount().GreaterThan(balance.Amount()) {
+			return nil, nil, appErrors.NewValidationError(fmt.Sprintf("credit note amount cannot exceed the balance due of %s", balance.Amount().String()))
+		}
+	}
+
+	taxAmount, err := s.creditNoteTax(ctx, *invoice, credit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := s.now()
+	note := domain.CreditNote{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		InvoiceID:      invoice.ID,
+		Amount:         credit,
+		TaxAmount:      taxAmount,
+		Reason:         reason,
+		IssuedAt:       now,
+		CreatedBy:      &userID,
+	}
+
+	entry, err := s.ledger.creditNoteEntry(ctx, *invoice, note)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issued, credited, err := s.creditNoteRepo.IssueCreditNote(ctx, note, *entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issued == nil {
+		return nil, nil, appErrors.NewConflictError("the invoice was paid, credited or voided in the meantime")
+	}
+
+	s.logSecurityEvent(ctx, "credit_note_issued", userID, map[string]interface{}{
+		"organization_id":  orgID,
+		"invoice_id":       invoice.ID,
+		"invoice_number":   invoice.Number,
+		"credit_note_id":   issued.ID,
+		"number":           issued.Number,
+		"amount":           issued.Amount.String(),
+		"tax_amount":       issued.TaxAmount.String(),
+		"reason":           reason,
+		"previous_status":  string(invoice.Status),
+		"status":           string(credited.Status),
+		"ledger_reference": entry.ExternalReference,
+	})
+
+	return issued, credited, nil
+}
+
+// ListInvoiceCreditNotes lists the credit notes issued against one of the organization's invoices
+func (s *creditNoteService) ListInvoiceCreditNotes(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.CreditNote, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	invoice, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.creditNoteRepo.ListInvoiceCreditNotes(ctx, invoice.ID)
+}
+
+// ListCreditNotes lists the organization's credit notes, latest first
+func (s *creditNoteService) ListCreditNotes(ctx context.Context, userID, orgID uuid.UUID, page, pageSize int) ([]domain.CreditNote, int64, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, 0, err
+	}
+
+	return s.creditNoteRepo.ListCreditNotes(ctx, orgID, pageSize, (page-1)*pageSize)
+}
+
+// GetCreditNote retrieves one of the organization's credit notes
+func (s *creditNoteService) GetCreditNote(ctx context.Context, userID, orgID, creditNoteID uuid.UUID) (*domain.CreditNote, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	note, err := s.creditNoteRepo.GetCreditNote(ctx, orgID, creditNoteID)
+	if err != nil {
+		return nil, err
+	}
+	if note == nil {
+		return nil, appErrors.NewNotFoundError("credit note not found")
+	}
+
+	return note, nil
+}
+
+// creditNoteTax is the part of a credit that reverses tax: the same share of
+// it as tax is of the invoice total
+func (s *creditNoteService) creditNoteTax(ctx context.Context, invoice domain.Invoice, credit money.Money) (money.Money, error) {
+	if !invoice.TaxTotal.IsPositive() || !invoice.Total.IsPositive() {
+		return money.Zero(invoice.Currency), nil
+	}
+
+	places, err := currencyAmountPlaces(ctx, s.assetService, invoice.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	share := invoice.TaxTotal.Amount().Div(invoice.Total.Amount())
+	return credit.Mul(share).Round(places, money.RoundHalfEven), nil
+}
+
+func (s *creditNoteService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTaxedInvoice builds a sent invoice of 100 USD plus 10 USD tax
+func testTaxedInvoice(orgID uuid.UUID, status domain.InvoiceStatus, sentAt time.Time) *domain.Invoice {
+	invoice := testStoredInvoice(orgID, status)
+	invoice.Subtotal = money.MustParse("100", "USD")
+	invoice.TaxTotal = money.MustParse("10", "USD")
+	invoice.Total = money.MustParse("110", "USD")
+	invoice.AmountPaid = money.Zero("USD")
+	invoice.AmountCredited = money.Zero("USD")
+	invoice.SentAt = &sentAt
+	return invoice
+}
+
+func TestCreditNoteService_IssueCreditNote(t *testing.T) {
+	// Arrange
+	mockCreditNoteRepo := new(mocks.FakeCreditNoteRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	invoice := testTaxedInvoice(orgID, domain.InvoiceStatusSent, now)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockCreditNoteRepo.IssueCreditNoteStub = func(ctx context.Context, note domain.CreditNote, entry domain.LedgerEntry) (*domain.CreditNote, *domain.Invoice, error) {
+		note.Number = 1
+		note.LedgerEntryID = entry.ID
+		credited := *invoice
+		credited.ApplyCredit(note.Amount)
+		return &note, &credited, nil
+	}
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	amount := decimal.RequireFromString("55")
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewCreditNoteService(mockCreditNoteRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockSecurityRepo, mockLedgerService, logging.New(&cfg)).(*creditNoteService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	note, credited, err := service.IssueCreditNote(context.Background(), financeID, orgID, invoice.ID, &amount, " Damaged goods ")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "CN-000001", note.DisplayNumber())
+	assert.Equal(t, "55", note.Amount.Amount().String())
+	assert.Equal(t, "5", note.TaxAmount.Amount().String())
+	assert.Equal(t, "Damaged goods", note.Reason)
+	assert.Equal(t, financeID, *note.CreatedBy)
+	assert.Equal(t, domain.InvoiceStatusSent, credited.Status)
+	assert.Equal(t, "55", credited.BalanceDue().Amount().String())
+
+	// The invoice is posted before the credit note reverses part of it
+	require.Equal(t, 1, mockLedgerService.PostEntryCallCount())
+	_, issued := mockLedgerService.PostEntryArgsForCall(0)
+	assert.Equal(t, "invoice:"+invoice.ID.String()+":issued", issued.ExternalReference)
+	require.Len(t, issued.Postings, 3)
+	receivables, revenue, taxPayable := issued.Postings[0].AccountID, issued.Postings[1].AccountID, issued.Postings[2].AccountID
+	assert.Equal(t, "110", issued.Postings[0].Amount.Amount().String())
+	assert.Equal(t, "-100", issued.Postings[1].Amount.Amount().String())
+	assert.Equal(t, "-10", issued.Postings[2].Amount.Amount().String())
+
+	_, _, entry := mockCreditNoteRepo.IssueCreditNoteArgsForCall(0)
+	assert.Equal(t, "credit_note:"+note.ID.String(), entry.ExternalReference)
+	assert.Equal(t, entry.ID, note.LedgerEntryID)
+	require.Len(t, entry.Postings, 3)
+	assert.Equal(t, revenue, entry.Postings[0].AccountID)
+	assert.Equal(t, "50", entry.Postings[0].Amount.Amount().String())
+	assert.Equal(t, taxPayable, entry.Postings[1].AccountID)
+	assert.Equal(t, "5", entry.Postings[1].Amount.Amount().String())
+	assert.Equal(t, receivables, entry.Postings[2].AccountID)
+	assert.Equal(t, "-55", entry.Postings[2].Amount.Amount().String())
+
+	require.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+	_, event := mockSecurityRepo.LogSecurityEventArgsForCall(0)
+	assert.Equal(t, "credit_note_issued", event.EventType)
+}
+
+func TestCreditNoteService_IssueCreditNote_FullBalance(t *testing.T) {
+	// Arrange
+	mockCreditNoteRepo := new(mocks.FakeCreditNoteRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+	invoice := testTaxedInvoice(orgID, domain.InvoiceStatusPartiallyPaid, now)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	invoice.AmountPaid = money.MustParse("40", "USD")
+	mockCreditNoteRepo.IssueCreditNoteStub = func(ctx context.Context, note domain.CreditNote, entry domain.LedgerEntry) (*domain.CreditNote, *domain.Invoice, error) {
+		note.Number = 1
+		note.LedgerEntryID = entry.ID
+		credited := *invoice
+		credited.ApplyCredit(note.Amount)
+		return &note, &credited, nil
+	}
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewCreditNoteService(mockCreditNoteRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockSecurityRepo, mockLedgerService, logging.New(&cfg)).(*creditNoteService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	note, credited, err := service.IssueCreditNote(context.Background(), adminID, orgID, invoice.ID, nil, "Written off")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "70", note.Amount.Amount().String())
+	assert.Equal(t, "6.36", note.TaxAmount.Amount().String())
+	assert.Equal(t, domain.InvoiceStatusCredited, credited.Status)
+	assert.True(t, credited.BalanceDue().IsZero())
+}
+
+func TestCreditNoteService_IssueCreditNote_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		role    domain.OrganizationRole
+		status  domain.InvoiceStatus
+		amount  string
+		reason  string
+		errType appErrors.ErrorType
+	}{
+		{name: "viewer", role: domain.OrganizationRoleViewer, status: domain.InvoiceStatusSent, amount: "10", reason: "Refund", errType: appErrors.ErrorTypeForbidden},
+		{name: "missing_reason", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusSent, amount: "10", reason: " ", errType: appErrors.ErrorTypeValidation},
+		{name: "zero_amount", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusSent, amount: "0", reason: "Refund", errType: appErrors.ErrorTypeValidation},
+		{name: "too_precise", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusSent, amount: "10.001", reason: "Refund", errType: appErrors.ErrorTypeValidation},
+		{name: "above_balance", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusSent, amount: "110.01", reason: "Refund", errType: appErrors.ErrorTypeValidation},
+		{name: "draft", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusDraft, amount: "10", reason: "Refund", errType: appErrors.ErrorTypeConflict},
+		{name: "paid", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusPaid, amount: "10", reason: "Refund", errType: appErrors.ErrorTypeConflict},
+		{name: "void", role: domain.OrganizationRoleFinance, status: domain.InvoiceStatusVoid, amount: "10", reason: "Refund", errType: appErrors.ErrorTypeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockCreditNoteRepo := new(mocks.FakeCreditNoteRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: tc.role})
+			invoice := testTaxedInvoice(orgID, tc.status, now)
+			mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+			stubInvoiceLedgerAccounts(mockLedgerService)
+
+			amount := decimal.RequireFromString(tc.amount)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewCreditNoteService(mockCreditNoteRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockSecurityRepo, mockLedgerService, logging.New(&cfg)).(*creditNoteService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, _, err := service.IssueCreditNote(context.Background(), userID, orgID, invoice.ID, &amount, tc.reason)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Zero(t, mockCreditNoteRepo.IssueCreditNoteCallCount())
+			assert.Zero(t, mockSecurityRepo.LogSecurityEventCallCount())
+		})
+	}
+}
+
+func TestCreditNoteService_IssueCreditNote_ChangedMeanwhile(t *testing.T) {
+	// Arrange
+	mockCreditNoteRepo := new(mocks.FakeCreditNoteRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	invoice := testTaxedInvoice(orgID, domain.InvoiceStatusSent, now)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockCreditNoteRepo.IssueCreditNoteReturns(nil, nil, nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewCreditNoteService(mockCreditNoteRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockSecurityRepo, mockLedgerService, logging.New(&cfg)).(*creditNoteService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, _, err := service.IssueCreditNote(context.Background(), financeID, orgID, invoice.ID, nil, "Refund")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Zero(t, mockSecurityRepo.LogSecurityEventCallCount())
+}
+
+func TestInvoiceService_VoidInvoice_Credited(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	invoice := testTaxedInvoice(orgID, domain.InvoiceStatusSent, now)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	invoice.AmountCredited = money.MustParse("10", "USD")
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	_, partlyCreditedErr := service.VoidInvoice(ctx, financeID, orgID, invoice.ID, "Duplicate")
+	invoice.Status = domain.InvoiceStatusCredited
+	_, creditedErr := service.VoidInvoice(ctx, financeID, orgID, invoice.ID, "Duplicate")
+
+	// Assert
+	assertAppErrorType(t, partlyCreditedErr, appErrors.ErrorTypeConflict)
+	assertAppErrorType(t, creditedErr, appErrors.ErrorTypeConflict)
+	assert.Zero(t, mockInvoiceRepo.VoidCallCount())
+}
+
+func TestInvoiceService_VoidInvoice_Draft(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockInvoiceRepo.VoidReturns(withStatus(invoice, domain.InvoiceStatusVoid), nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.VoidInvoice(context.Background(), financeID, orgID, invoice.ID, "Not needed")
+
+	// Assert
+	require.NoError(t, err)
+
+	// A draft was never posted, so there is nothing to reverse
+	_, _, _, _, reversal := mockInvoiceRepo.VoidArgsForCall(0)
+	assert.Nil(t, reversal)
+	assert.Zero(t, mockLedgerService.PostEntryCallCount())
+}
+
+func TestInvoice_ApplyCredit(t *testing.T) {
+	// Arrange
+	invoice := domain.Invoice{
+		Status:         domain.InvoiceStatusOverdue,
+		Currency:       "USD",
+		Total:          money.MustParse("100", "USD"),
+		AmountPaid:     money.MustParse("30", "USD"),
+		AmountCredited: money.Zero("USD"),
+	}
+
+	// Act
+	invoice.ApplyCredit(money.MustParse("20", "USD"))
+
+	// Assert
+	assert.Equal(t, domain.InvoiceStatusOverdue, invoice.Status)
+	assert.Equal(t, "50", invoice.BalanceDue().Amount().String())
+
+	// Act: payments only need to cover what is left after credit notes
+	invoice.ApplyPayments(decimal.RequireFromString("80"), 0, time.Time{}, "0xabc")
+
+	// Assert
+	assert.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+
+	// Act
+	invoice.Status = domain.InvoiceStatusSent
+	invoice.AmountPaid = money.MustParse("30", "USD")
+	invoice.ApplyCredit(money.MustParse("50", "USD"))
+
+	// Assert
+	assert.Equal(t, domain.InvoiceStatusCredited, invoice.Status)
+	assert.True(t, invoice.BalanceDue().IsZero())
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	hdWallet "github.com/demola234/defifundr/pkg/hd_wallet"
+	"github.com/google/uuid"
+)
+
+// GetDepositKey retrieves the organization's deposit key. Finance managers may view it.
+func (s *invoiceService) GetDepositKey(ctx context.Context, userID, orgID uuid.UUID) (*domain.InvoiceDepositKey, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	key, err := s.invoiceRepo.GetDepositKey(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, appErrors.NewNotFoundError("the organization has no deposit key")
+	}
+
+	return key, nil
+}
+
+// SetDepositKey sets the account-level extended public key the organization's
+// invoice deposit addresses are derived from, and how far below the total, in
+// basis points, a payment may fall and still pay an invoice. Only owners and
+// admins may change where customers pay. A key whose addresses were already
+// handed out, by this organization or another, is rejected so no two invoices
+// ever share a deposit address.
+func (s *invoiceService) SetDepositKey(ctx context.Context, userID, orgID uuid.UUID, extendedPublicKey string, toleranceBPS int) (*domain.InvoiceDepositKey, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	account, err := hdWallet.ParseAccountKey(strings.TrimSpace(extendedPublicKey))
+	if err != nil {
+		if errors.Is(err, hdWallet.ErrInvalidKey) || errors.Is(err, hdWallet.ErrPrivateKey) || errors.Is(err, hdWallet.ErrNotAccountKey) {
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+		return nil, err
+	}
+
+	if toleranceBPS < 0 || toleranceBPS > domain.MaxInvoiceToleranceBPS {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("tolerance must be between 0 and %d basis points", domain.MaxInvoiceToleranceBPS))
+	}
+
+	existing, err := s.invoiceRepo.GetDepositKey(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := existing == nil || existing.ExtendedPublicKey != account.String()
+	if changed {
+		first, err := account.Address(0)
+		if err != nil {
+			return nil, err
+		}
+		used, err := s.invoiceRepo.DepositAddressUsed(ctx, first)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, appErrors.NewConflictError("deposit addresses of this key have already been used; export a new account")
+		}
+	}
+
+	key, err := s.invoiceRepo.SaveDepositKey(ctx, domain.InvoiceDepositKey{
+		OrganizationID:    orgID,
+		ExtendedPublicKey: account.String(),
+		ToleranceBPS:      toleranceBPS,
+		CreatedBy:         &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logSecurityEvent(ctx, "invoice_deposit_key_set", userID, map[string]interface{}{
+		"organization_id": orgID,
+		"key_changed":     changed,
+		"tolerance_bps":   toleranceBPS,
+	})
+
+	return key, nil
+}
+
+// DeleteDepositKey removes the organization's deposit key. New invoices then
+// need a payment address; those that already have a deposit address keep it
+// and stay watched.
+func (s *invoiceService) DeleteDepositKey(ctx context.Context, userID, orgID uuid.UUID) error {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return err
+	}
+
+	key, err := s.invoiceRepo.GetDepositKey(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return appErrors.NewNotFoundError("the organization has no deposit key")
+	}
+
+	if err := s.invoiceRepo.DeleteDepositKey(ctx, orgID); err != nil {
+		return err
+	}
+
+	s.logSecurityEvent(ctx, "invoice_deposit_key_deleted", userID, map[string]interface{}{
+		"organization_id": orgID,
+		"addresses_used":  key.NextIndex,
+	})
+
+	return nil
+}
+
+// ListInvoicePayments lists the transfers matched to one of the organization's
+// invoices. Any member may view them.
+func (s *invoiceService) ListInvoicePayments(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoicePayment, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getInvoice(ctx, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	return s.invoiceRepo.ListInvoicePayments(ctx, invoiceID)
+}
+
+// checkDepositPayment checks that payments in the asset into a deposit address
+// can be matched to the invoice: the organization has a deposit key, the asset
+// is a token on the watched chain, and its amounts are in the invoice currency
+// because it is that currency or pegged to it
+func (s *invoiceService) checkDepositPayment(ctx context.Context, invoice *domain.Invoice, asset domain.SupportedAsset) error {
+	key, err := s.invoiceRepo.GetDepositKey(ctx, invoice.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return appErrors.NewValidationError("a payment address is required until the organization sets up a deposit key")
+	}
+
+	if asset.IsNative() || asset.Chain != s.config.IndexerChain {
+		return appErrors.NewValidationError(fmt.Sprintf("payments in %s on %s cannot be matched to a deposit address", asset.Symbol, asset.Chain))
+	}
+
+	symbol := strings.ToUpper(asset.Symbol)
+	if symbol != invoice.Currency && s.config.FXPegs[symbol] != invoice.Currency {
+		return appErrors.NewValidationError(fmt.Sprintf("a deposit address in %s can only be used for %s invoices", asset.Symbol, symbol))
+	}
+
+	return nil
+}
+
+// assignDepositAddress gives an invoice that asks for a deposit address the
+// organization's next one. A draft being edited keeps the address it already
+// had.
+func (s *invoiceService) assignDepositAddress(ctx context.Context, invoice *domain.Invoice, existing *domain.Invoice) error {
+	if invoice.PaymentAssetID == nil || invoice.PaymentAddress != "" {
+		return nil
+	}
+
+	if existing != nil && existing.DepositIndex != nil {
+		invoice.PaymentAddress = existing.PaymentAddress
+		invoice.DepositIndex = existing.DepositIndex
+		return nil
+	}
+
+	key, index, err := s.invoiceRepo.AllocateDepositIndex(ctx, invoice.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		return appErrors.NewValidationError("a payment address is required until the organization sets up a deposit key")
+	}
+
+	account, err := hdWallet.ParseAccountKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to parse deposit key: %w", err)
+	}
+	address, err := account.Address(uint32(index))
+	if err != nil {
+		return err
+	}
+
+	invoice.PaymentAddress = address
+	invoice.DepositIndex = &index
+
+	return nil
+}
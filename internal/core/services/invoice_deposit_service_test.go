@@ -0,0 +1,542 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	hdWallet "github.com/demola234/defifundr/pkg/hd_wallet"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAccountKeys returns the private and public keys at m/44'/60'/0' of a fixed seed
+func testAccountKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := hdkeychain.NewMaster(bytes.Repeat([]byte{0x42}, 32), &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	for _, index := range []uint32{44, 60, 0} {
+		key, err = key.Derive(hdkeychain.HardenedKeyStart + index)
+		require.NoError(t, err)
+	}
+
+	public, err := key.Neuter()
+	require.NoError(t, err)
+
+	return key.String(), public.String()
+}
+
+// testDepositAsset builds USDC on the watched chain, the asset invoices are paid in
+func testDepositAsset() *domain.SupportedAsset {
+	return &domain.SupportedAsset{ID: uuid.New(), Chain: "base", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}
+}
+
+func TestInvoiceService_SetDepositKey(t *testing.T) {
+	private, public := testAccountKeys(t)
+
+	t.Run("stores the public key", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		adminID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+		mockInvoiceRepo.SaveDepositKeyStub = func(ctx context.Context, key domain.InvoiceDepositKey) (*domain.InvoiceDepositKey, error) {
+			return &key, nil
+		}
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		key, err := service.SetDepositKey(context.Background(), adminID, orgID, " "+public+" ", 50)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, public, key.ExtendedPublicKey)
+		assert.Equal(t, 50, key.ToleranceBPS)
+		assert.Equal(t, orgID, key.OrganizationID)
+
+		// A new key is checked for addresses already handed out
+		account, err := hdWallet.ParseAccountKey(public)
+		require.NoError(t, err)
+		first, err := account.Address(0)
+		require.NoError(t, err)
+		_, address := mockInvoiceRepo.DepositAddressUsedArgsForCall(0)
+		assert.Equal(t, first, address)
+		assert.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+	})
+
+	t.Run("same key only changes the tolerance", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		adminID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+		mockInvoiceRepo.GetDepositKeyReturns(&domain.InvoiceDepositKey{OrganizationID: orgID, ExtendedPublicKey: public, NextIndex: 12}, nil)
+		mockInvoiceRepo.SaveDepositKeyReturns(&domain.InvoiceDepositKey{}, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SetDepositKey(context.Background(), adminID, orgID, public, 100)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, mockInvoiceRepo.DepositAddressUsedCallCount())
+	})
+
+	t.Run("private key", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		adminID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SetDepositKey(context.Background(), adminID, orgID, private, 0)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+		assert.Equal(t, 0, mockInvoiceRepo.SaveDepositKeyCallCount())
+	})
+
+	t.Run("tolerance out of range", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		adminID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SetDepositKey(context.Background(), adminID, orgID, public, domain.MaxInvoiceToleranceBPS+1)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+
+	t.Run("key already used", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		adminID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+		mockInvoiceRepo.DepositAddressUsedReturns(true, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SetDepositKey(context.Background(), adminID, orgID, public, 0)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Equal(t, 0, mockInvoiceRepo.SaveDepositKeyCallCount())
+	})
+
+	t.Run("finance members cannot change it", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		financeID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SetDepositKey(context.Background(), financeID, orgID, public, 0)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	})
+}
+
+func TestInvoiceService_CreateInvoice_DepositAddress(t *testing.T) {
+	_, public := testAccountKeys(t)
+	account, err := hdWallet.ParseAccountKey(public)
+	require.NoError(t, err)
+	expected, err := account.Address(4)
+	require.NoError(t, err)
+
+	t.Run("derives the next address", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+			invoice.Number = 1
+			return &invoice, nil
+		}
+		asset := testDepositAsset()
+		mockAssetService.GetAssetReturns(asset, nil)
+		mockInvoiceRepo.GetDepositKeyReturns(&domain.InvoiceDepositKey{OrganizationID: orgID, ExtendedPublicKey: public}, nil)
+		mockInvoiceRepo.AllocateDepositIndexReturns(public, 4, nil)
+
+		request := testInvoiceDraft(now)
+		request.PaymentAssetID = &asset.ID
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		created, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expected, created.PaymentAddress)
+		require.NotNil(t, created.DepositIndex)
+		assert.Equal(t, int64(4), *created.DepositIndex)
+
+		_, allocatedFor := mockInvoiceRepo.AllocateDepositIndexArgsForCall(0)
+		assert.Equal(t, orgID, allocatedFor)
+	})
+
+	t.Run("without a deposit key", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+			invoice.Number = 1
+			return &invoice, nil
+		}
+		asset := testDepositAsset()
+		mockAssetService.GetAssetReturns(asset, nil)
+
+		request := testInvoiceDraft(now)
+		request.PaymentAssetID = &asset.ID
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+		assert.Equal(t, 0, mockInvoiceRepo.AllocateDepositIndexCallCount())
+	})
+
+	t.Run("currency the asset is not pegged to", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+			invoice.Number = 1
+			return &invoice, nil
+		}
+		asset := testDepositAsset()
+		mockAssetService.GetAssetReturns(asset, nil)
+		mockInvoiceRepo.GetDepositKeyReturns(&domain.InvoiceDepositKey{OrganizationID: orgID, ExtendedPublicKey: public}, nil)
+
+		request := testInvoiceDraft(now)
+		request.Currency = "EUR"
+		request.PaymentAssetID = &asset.ID
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+
+	t.Run("asset on another chain", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+			invoice.Number = 1
+			return &invoice, nil
+		}
+		asset := testDepositAsset()
+		asset.Chain = "polygon"
+		mockAssetService.GetAssetReturns(asset, nil)
+		mockInvoiceRepo.GetDepositKeyReturns(&domain.InvoiceDepositKey{OrganizationID: orgID, ExtendedPublicKey: public}, nil)
+
+		request := testInvoiceDraft(now)
+		request.PaymentAssetID = &asset.ID
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+}
+
+func TestInvoiceService_UpdateInvoice_KeepsDepositAddress(t *testing.T) {
+	_, public := testAccountKeys(t)
+
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	asset := testDepositAsset()
+	mockAssetService.GetAssetReturns(asset, nil)
+	mockInvoiceRepo.GetDepositKeyReturns(&domain.InvoiceDepositKey{OrganizationID: orgID, ExtendedPublicKey: public}, nil)
+
+	existing := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+	index := int64(3)
+	existing.PaymentAssetID = &asset.ID
+	existing.PaymentAddress = "0x52908400098527886E0F7030069857D2E4169EE7"
+	existing.DepositIndex = &index
+	mockInvoiceRepo.GetInvoiceReturns(existing, nil)
+	mockInvoiceRepo.UpdateDraftInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		return &invoice, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act: leaving the address out and sending it back both keep it
+	for _, address := range []string{"", "0x52908400098527886e0f7030069857d2e4169ee7"} {
+		request := testInvoiceDraft(now)
+		request.PaymentAssetID = &asset.ID
+		request.PaymentAddress = address
+
+		updated, err := service.UpdateInvoice(ctx, userID, orgID, existing.ID, request)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, existing.PaymentAddress, updated.PaymentAddress)
+		assert.Equal(t, &index, updated.DepositIndex)
+	}
+	assert.Equal(t, 0, mockInvoiceRepo.AllocateDepositIndexCallCount())
+
+	// Act: an address entered by hand replaces it
+	request := testInvoiceDraft(now)
+	request.PaymentAssetID = &asset.ID
+	request.PaymentAddress = "0x00000000000000000000000000000000000000aA"
+	updated, err := service.UpdateInvoice(ctx, userID, orgID, existing.ID, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, updated.DepositIndex)
+}
+
+func TestInvoiceService_VoidInvoice_WithPaymentsReceived(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusPartiallyPaid)
+	invoice.AmountPaid = money.MustParse("40", "USD")
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "base", FXPegs: map[string]string{"USDC": "USD"}}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.VoidInvoice(context.Background(), userID, orgID, invoice.ID, "duplicate")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Equal(t, 0, mockInvoiceRepo.VoidCallCount())
+}
+
+func TestInvoice_ApplyPayments(t *testing.T) {
+	at := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    domain.InvoiceStatus
+		received  string
+		tolerance int
+		want      domain.InvoiceStatus
+	}{
+		{name: "exact", status: domain.InvoiceStatusSent, received: "100", want: domain.InvoiceStatusPaid},
+		{name: "within tolerance", status: domain.InvoiceStatusViewed, received: "99.5", tolerance: 50, want: domain.InvoiceStatusPaid},
+		{name: "below tolerance", status: domain.InvoiceStatusViewed, received: "99.49", tolerance: 50, want: domain.InvoiceStatusPartiallyPaid},
+		{name: "overpaid", status: domain.InvoiceStatusSent, received: "120", want: domain.InvoiceStatusPaid},
+		{name: "partial on an overdue invoice", status: domain.InvoiceStatusOverdue, received: "10", want: domain.InvoiceStatusOverdue},
+		{name: "paid overdue invoice", status: domain.InvoiceStatusOverdue, received: "100", want: domain.InvoiceStatusPaid},
+		{name: "draft is left alone", status: domain.InvoiceStatusDraft, received: "100", want: domain.InvoiceStatusDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			invoice := domain.Invoice{Status: tt.status, Currency: "USD", Total: money.MustParse("100", "USD")}
+
+			// Act
+			invoice.ApplyPayments(decimal.RequireFromString(tt.received), tt.tolerance, at, "0xabc")
+
+			// Assert
+			assert.Equal(t, tt.want, invoice.Status)
+			assert.Equal(t, tt.received+" USD", invoice.AmountPaid.String())
+			if tt.want == domain.InvoiceStatusPaid {
+				assert.Equal(t, at, *invoice.PaidAt)
+				assert.Equal(t, "0xabc", invoice.PaymentReference)
+			} else {
+				assert.Nil(t, invoice.PaidAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// invoiceLedger builds the ledger entries for an organization's invoices. An
+// issued invoice is owed to the organization in receivables until payments,
+// credit notes or voiding clear it. Entries are validated by the ledger
+// service and handed to the invoice repository, which posts them in the same
+// transaction as the change they account for.
+type invoiceLedger struct {
+	ledger ports.LedgerService
+}
+
+// invoiceLedgerAccounts are the ledger accounts an organization's invoices post to
+type invoiceLedgerAccounts struct {
+	receivables      uuid.UUID
+	revenue          uuid.UUID
+	taxPayable       uuid.UUID
+	paymentsReceived uuid.UUID
+	shortfalls       uuid.UUID
+}
+
+// issuedEntry builds the entry for an invoice sent at: the customer owes the
+// total, earned as revenue and tax collected. It returns nil for an invoice
+// with nothing to pay.
+func (l invoiceLedger) issuedEntry(ctx context.Context, invoice domain.Invoice, at time.Time) (*domain.LedgerEntry, error) {
+	if !invoice.Total.IsPositive() {
+		return nil, nil
+	}
+
+	accounts, err := l.accounts(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue := money.New(invoice.Total.Amount().Sub(invoice.TaxTotal.Amount()), invoice.Currency)
+	return l.validated(ctx, newLedgerEntry(
+		fmt.Sprintf("invoice:%s:issued", invoice.ID),
+		fmt.Sprintf("Invoice %s issued", invoice.DisplayNumber()),
+		at,
+		domain.LedgerPosting{AccountID: accounts.receivables, Amount: invoice.Total},
+		domain.LedgerPosting{AccountID: accounts.revenue, Amount: revenue.Neg()},
+		domain.LedgerPosting{AccountID: accounts.taxPayable, Amount: invoice.TaxTotal.Neg()},
+	))
+}
+
+// postIssued makes sure a sent invoice is in the ledger before it is paid,
+// credited or voided. Invoices are posted when they are sent; this catches up
+// invoices sent before that was the case. Posting again is a no-op.
+func (l invoiceLedger) postIssued(ctx context.Context, invoice domain.Invoice) error {
+	at := invoice.CreatedAt
+	if invoice.SentAt != nil {
+		at = *invoice.SentAt
+	}
+
+	entry, err := l.issuedEntry(ctx, invoice, at)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	_, err = l.ledger.PostEntry(ctx, *entry)
+	return err
+}
+
+// creditNoteEntry builds the entry that reverses the credited revenue and tax
+// from the invoice's receivable
+func (l invoiceLedger) creditNoteEntry(ctx context.Context, invoice domain.Invoice, note domain.CreditNote) (*domain.LedgerEntry, error) {
+	if err := l.postIssued(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.accounts(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue := money.New(note.Amount.Amount().Sub(note.TaxAmount.Amount()), invoice.Currency)
+	return l.validated(ctx, newLedgerEntry(
+		fmt.Sprintf("credit_note:%s", note.ID),
+		fmt.Sprintf("Credit note against invoice %s", invoice.DisplayNumber()),
+		note.IssuedAt,
+		domain.LedgerPosting{AccountID: accounts.revenue, Amount: revenue},
+		domain.LedgerPosting{AccountID: accounts.taxPayable, Amount: note.TaxAmount},
+		domain.LedgerPosting{AccountID: accounts.receivables, Amount: note.Amount.Neg()},
+	))
+}
+
+// voidEntry builds the entry that reverses a sent invoice in full
+func (l invoiceLedger) voidEntry(ctx context.Context, invoice domain.Invoice, at time.Time) (*domain.LedgerEntry, error) {
+	if !invoice.Total.IsPositive() {
+		return nil, nil
+	}
+
+	if err := l.postIssued(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.accounts(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue := money.New(invoice.Total.Amount().Sub(invoice.TaxTotal.Amount()), invoice.Currency)
+	return l.validated(ctx, newLedgerEntry(
+		fmt.Sprintf("invoice:%s:voided", invoice.ID),
+		fmt.Sprintf("Invoice %s voided", invoice.DisplayNumber()),
+		at,
+		domain.LedgerPosting{AccountID: accounts.revenue, Amount: revenue},
+		domain.LedgerPosting{AccountID: accounts.taxPayable, Amount: invoice.TaxTotal},
+		domain.LedgerPosting{AccountID: accounts.receivables, Amount: invoice.Total.Neg()},
+	))
+}
+
+// paidEntry builds the entry for an invoice marked as paid outside the
+// deposit watcher: the balance still due was received. It returns nil when
+// nothing is due.
+func (l invoiceLedger) paidEntry(ctx context.Context, invoice domain.Invoice, at time.Time) (*domain.LedgerEntry, error) {
+	due := invoice.BalanceDue()
+	if !due.IsPositive() {
+		return nil, nil
+	}
+
+	if err := l.postIssued(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.accounts(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.validated(ctx, newLedgerEntry(
+		fmt.Sprintf("invoice:%s:paid", invoice.ID),
+		fmt.Sprintf("Invoice %s marked as paid", invoice.DisplayNumber()),
+		at,
+		domain.LedgerPosting{AccountID: accounts.paymentsReceived, Amount: due},
+		domain.LedgerPosting{AccountID: accounts.receivables, Amount: due.Neg()},
+	))
+}
+
+// paymentSettlement returns the settlement RecordInvoicePayment posts for a
+// transfer into the invoice's deposit address: the amount received clears
+// the receivable, and when the transfer settles the invoice within tolerance
+// the rest of the balance is written off as a shortfall. Receivables go
+// negative by any overpayment, which is owed back to the customer.
+func (l invoiceLedger) paymentSettlement(ctx context.Context, invoice domain.Invoice, payment domain.InvoicePayment, at time.Time) (func(before, after domain.Invoice) (*domain.LedgerEntry, error), error) {
+	if err := l.postIssued(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.accounts(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(before, after domain.Invoice) (*domain.LedgerEntry, error) {
+		shortfall := money.Zero(after.Currency)
+		if after.Status == domain.InvoiceStatusPaid && before.Status != domain.InvoiceStatusPaid {
+			shortfall = after.BalanceDue()
+		}
+
+		cleared, err := payment.Amount.Add(shortfall)
+		if err != nil {
+			return nil, err
+		}
+
+		return l.validated(ctx, newLedgerEntry(
+			fmt.Sprintf("invoice_payment:%s", payment.ID),
+			fmt.Sprintf("Payment to invoice %s in %s", after.DisplayNumber(), payment.TxHash),
+			at,
+			domain.LedgerPosting{AccountID: accounts.paymentsReceived, Amount: payment.Amount},
+			domain.LedgerPosting{AccountID: accounts.shortfalls, Amount: shortfall},
+			domain.LedgerPosting{AccountID: accounts.receivables, Amount: cleared.Neg()},
+		))
+	}, nil
+}
+
+// validated checks an entry with the ledger service before a repository posts it
+func (l invoiceLedger) validated(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, error) {
+	if err := l.ledger.ValidateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// accounts returns the organization's invoice ledger accounts, opening any it
+// does not have yet
+func (l invoiceLedger) accounts(ctx context.Context, orgID uuid.UUID) (*invoiceLedgerAccounts, error) {
+	receivables, err := l.account(ctx, orgID, "receivables", "Accounts receivable", domain.LedgerAccountTypeAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, err := l.account(ctx, orgID, "revenue", "Invoiced revenue", domain.LedgerAccountTypeRevenue)
+	if err != nil {
+		return nil, err
+	}
+
+	taxPayable, err := l.account(ctx, orgID, "tax_payable", "Tax collected on invoices", domain.LedgerAccountTypeLiability)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsReceived, err := l.account(ctx, orgID, "payments_received", "Invoice payments received", domain.LedgerAccountTypeAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	shortfalls, err := l.account(ctx, orgID, "payment_shortfalls", "Invoice payment shortfalls written off", domain.LedgerAccountTypeExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoiceLedgerAccounts{
+		receivables:      receivables.ID,
+		revenue:          revenue.ID,
+		taxPayable:       taxPayable.ID,
+		paymentsReceived: paymentsReceived.ID,
+		shortfalls:       shortfalls.ID,
+	}, nil
+}
+
+// account returns the organization's account of a kind, such as
+// receivables:organization:<org id>, opening it on first use
+func (l invoiceLedger) account(ctx context.Context, orgID uuid.UUID, kind, name string, accountType domain.LedgerAccountType) (*domain.LedgerAccount, error) {
+	code := fmt.Sprintf("%s:organization:%s", kind, orgID)
+
+	account, err := l.ledger.GetAccountByCode(ctx, code)
+	if err == nil || appErrors.GetErrorType(err) != appErrors.ErrorTypeNotFound {
+		return account, err
+	}
+
+	account, err = l.ledger.CreateAccount(ctx, domain.LedgerAccount{
+		Code:    code,
+		Name:    name,
+		Type:    accountType,
+		OwnerID: &orgID,
+	})
+	if appErrors.GetErrorType(err) == appErrors.ErrorTypeConflict {
+		// Opened by a concurrent request
+		return l.ledger.GetAccountByCode(ctx, code)
+	}
+
+	return account, err
+}
+
+// newLedgerEntry builds an entry with fresh IDs, leaving out zero postings
+// such as the tax of an untaxed invoice
+func newLedgerEntry(reference, description string, at time.Time, postings ...domain.LedgerPosting) domain.LedgerEntry {
+	entry := domain.LedgerEntry{
+		ID:                uuid.New(),
+		ExternalReference: reference,
+		Description:       description,
+		OccurredAt:        at,
+	}
+
+	for _, posting := range postings {
+		if posting.Amount.IsZero() {
+			continue
+		}
+		posting.ID = uuid.New()
+		posting.EntryID = entry.ID
+		entry.Postings = append(entry.Postings, posting)
+	}
+
+	return entry
+}
@@ -0,0 +1,355 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+const (
+	// invoicePaymentWatcherName identifies the watcher's row in indexer_checkpoints
+	invoicePaymentWatcherName = "invoice_deposits"
+	// invoiceDepositWatchAfterClosed is how long a paid or voided invoice's deposit
+	// address is still watched, so late transfers are flagged
+	invoiceDepositWatchAfterClosed = 30 * 24 * time.Hour
+)
+
+// InvoicePaymentWatcher scans ERC-20 Transfer events into invoice deposit
+// addresses and settles the invoices against what they received: paid once the
+// total is covered within the organization's tolerance, partially paid before
+// that, with any excess kept as an overpayment.
+//
+// Only blocks at least TxTrackerConfirmations deep are scanned, so a matched
+// payment is final and never has to be taken back after a reorg. Each transfer
+// is recorded once by its transaction, token, recipient and position among the
+// transaction's transfers of that token to that recipient, which unlike its
+// log index survive a reorg, so scanning a range again after a crash or a
+// reorg counts nothing twice. A transfer only counts towards an invoice when
+// it is of the invoice's payment asset, and clears the invoice's receivable in
+// the ledger in the same transaction it is recorded in. Overpaid invoices, and
+// voided ones that still receive a transfer, are flagged with a payment
+// exception for follow-up.
+type InvoicePaymentWatcher struct {
+	client         ports.BlockchainClient
+	invoiceRepo    ports.InvoiceRepository
+	checkpointRepo ports.IndexerCheckpointRepository
+	assetService   ports.AssetService
+	ledger         invoiceLedger
+	config         config.Config
+	logger         logging.Logger
+	now            func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewInvoicePaymentWatcher creates a new invoice payment watcher
+func NewInvoicePaymentWatcher(
+	client ports.BlockchainClient,
+	invoiceRepo ports.InvoiceRepository,
+	checkpointRepo ports.IndexerCheckpointRepository,
+	assetService ports.AssetService,
+	ledger ports.LedgerService,
+	config config.Config,
+	logger logging.Logger,
+) *InvoicePaymentWatcher {
+	return &InvoicePaymentWatcher{
+		client:         client,
+		invoiceRepo:    invoiceRepo,
+		checkpointRepo: checkpointRepo,
+		assetService:   assetService,
+		ledger:         invoiceLedger{ledger: ledger},
+		config:         config,
+		logger:         logger,
+		now:            time.Now,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start watches in the background every IndexerPollInterval until Stop is called
+func (w *InvoicePaymentWatcher) Start() {
+	interval := w.config.IndexerPollInterval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := w.Poll(ctx); err != nil {
+					w.logger.Error("Invoice payment watcher poll failed", err)
+				}
+				cancel()
+			}
+		}
+	}()
+
+	w.logger.Info("Invoice payment watcher started", map[string]interface{}{
+		"poll_interval": interval.String(),
+		"confirmations": w.config.TxTrackerConfirmations,
+	})
+}
+
+// Stop stops the background watcher and waits for an in-flight poll to finish
+func (w *InvoicePaymentWatcher) Stop() {
+	close(w.stop)
+	w.wg.Wait()
+	w.logger.Info("Invoice payment watcher stopped")
+}
+
+// watchedInvoice is an invoice whose deposit address is watched, with the asset it is paid in
+type watchedInvoice struct {
+	invoice domain.Invoice
+	asset   domain.SupportedAsset
+}
+
+// Poll matches transfers in every confirmed block from the checkpoint onwards
+func (w *InvoicePaymentWatcher) Poll(ctx context.Context) error {
+	head, err := w.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < w.config.TxTrackerConfirmations {
+		return nil
+	}
+	confirmed := head - w.config.TxTrackerConfirmations
+
+	next, err := w.nextBlock(ctx, confirmed)
+	if err != nil {
+		return err
+	}
+	if next > confirmed {
+		return nil
+	}
+
+	// Loaded after reading the head: an invoice paid in a block up to the head
+	// already existed, so its address is in the list
+	watched, err := w.loadInvoices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for next <= confirmed {
+		to := min(next+w.batchSize()-1, confirmed)
+
+		if err := w.matchRange(ctx, next, to, watched); err != nil {
+			return err
+		}
+
+		hash, err := w.client.BlockHash(ctx, to)
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.checkpointRepo.SaveCheckpoint(ctx, domain.IndexerCheckpoint{
+			Name:        invoicePaymentWatcherName,
+			BlockNumber: to,
+			BlockHash:   hash,
+		}); err != nil {
+			return err
+		}
+
+		next = to + 1
+	}
+
+	return nil
+}
+
+// nextBlock returns the first block to scan
+func (w *InvoicePaymentWatcher) nextBlock(ctx context.Context, confirmed uint64) (uint64, error) {
+	checkpoint, err := w.checkpointRepo.GetCheckpoint(ctx, invoicePaymentWatcherName)
+	if err != nil {
+		return 0, err
+	}
+
+	if checkpoint == nil {
+		if w.config.IndexerStartBlock > 0 {
+			return w.config.IndexerStartBlock, nil
+		}
+		return confirmed, nil
+	}
+
+	return checkpoint.BlockNumber + 1, nil
+}
+
+// loadInvoices returns the invoices to match transfers to, keyed by lower-cased
+// deposit address. Invoices paid in an asset that is no longer a token on the
+// watched chain are skipped.
+func (w *InvoicePaymentWatcher) loadInvoices(ctx context.Context) (map[string]watchedInvoice, error) {
+	invoices, err := w.invoiceRepo.ListInvoicesAwaitingDeposit(ctx, w.now().Add(-invoiceDepositWatchAfterClosed))
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make(map[uuid.UUID]*domain.SupportedAsset)
+	watched := make(map[string]watchedInvoice, len(invoices))
+	for _, invoice := range invoices {
+		if invoice.PaymentAssetID == nil {
+			continue
+		}
+
+		asset, ok := assets[*invoice.PaymentAssetID]
+		if !ok {
+			asset, err = w.assetService.GetAsset(ctx, *invoice.PaymentAssetID)
+			if err != nil {
+				return nil, err
+			}
+			assets[*invoice.PaymentAssetID] = asset
+		}
+		if asset.IsNative() || asset.Chain != w.config.IndexerChain {
+			continue
+		}
+
+		watched[strings.ToLower(invoice.PaymentAddress)] = watchedInvoice{invoice: invoice, asset: *asset}
+	}
+
+	return watched, nil
+}
+
+// matchRange records every transfer into a watched deposit address between from and to inclusive
+func (w *InvoicePaymentWatcher) matchRange(ctx context.Context, from, to uint64, watched map[string]watchedInvoice) error {
+	// An empty topic list matches every address, so never query without recipients
+	if len(watched) == 0 {
+		return nil
+	}
+
+	tokens := make(map[string]struct{})
+	recipients := make([]string, 0, len(watched))
+	for address, entry := range watched {
+		recipients = append(recipients, address)
+		tokens[strings.ToLower(entry.asset.ContractAddress)] = struct{}{}
+	}
+
+	tokenList := make([]string, 0, len(tokens))
+	for token := range tokens {
+		tokenList = append(tokenList, token)
+	}
+
+	for start := 0; start < len(recipients); start += indexerRecipientChunk {
+		logs, err := w.client.TransferLogs(ctx, domain.TransferLogFilter{
+			FromBlock:  from,
+			ToBlock:    to,
+			Tokens:     tokenList,
+			Recipients: recipients[start:min(start+indexerRecipientChunk, len(recipients))],
+		})
+		if err != nil {
+			return err
+		}
+
+		// Every log to a recipient comes back in the same response, so each
+		// transfer's position among the transaction's transfers of the same
+		// token to the same recipient is counted in full
+		slices.SortStableFunc(logs, func(a, b domain.TransferLog) int {
+			return cmp.Or(cmp.Compare(a.BlockNumber, b.BlockNumber), cmp.Compare(a.LogIndex, b.LogIndex))
+		})
+		ordinals := make(map[string]int, len(logs))
+
+		for _, log := range logs {
+			key := strings.ToLower(log.TxHash + "/" + log.Token + "/" + log.To)
+			ordinal := ordinals[key]
+			ordinals[key]++
+
+			entry, ok := watched[strings.ToLower(log.To)]
+			if !ok || !strings.EqualFold(log.Token, entry.asset.ContractAddress) || log.Amount == nil || log.Amount.Sign() <= 0 {
+				continue
+			}
+
+			if err := w.recordPayment(ctx, entry, log, ordinal); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordPayment records a transfer into an invoice's deposit address. ordinal
+// is its position among the transaction's transfers of the token to the address.
+func (w *InvoicePaymentWatcher) recordPayment(ctx context.Context, entry watchedInvoice, log domain.TransferLog, ordinal int) error {
+	payment := domain.InvoicePayment{
+		ID:              uuid.New(),
+		InvoiceID:       entry.invoice.ID,
+		TxHash:          log.TxHash,
+		LogIndex:        int(log.LogIndex),
+		TransferOrdinal: ordinal,
+		TokenAddress:    log.Token,
+		FromAddress:     log.From,
+		ToAddress:       log.To,
+		Amount:          money.FromBaseUnits(log.Amount, entry.invoice.Currency, entry.asset.Decimals),
+		BlockNumber:     int64(log.BlockNumber),
+	}
+
+	// A voided invoice has nothing left to settle, so the transfer is only flagged
+	now := w.now()
+	var settlement func(before, after domain.Invoice) (*domain.LedgerEntry, error)
+	if entry.invoice.Status != domain.InvoiceStatusVoid {
+		var err error
+		settlement, err = w.ledger.paymentSettlement(ctx, entry.invoice, payment, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	invoice, err := w.invoiceRepo.RecordInvoicePayment(ctx, payment, now, settlement)
+	if err != nil {
+		return err
+	}
+
+	// Already counted on an earlier scan
+	if invoice == nil {
+		return nil
+	}
+
+	fields := map[string]interface{}{
+		"organization_id": invoice.OrganizationID,
+		"invoice_id":      invoice.ID,
+		"number":          invoice.Number,
+		"tx_hash":         log.TxHash,
+		"log_index":       log.LogIndex,
+		"status":          string(invoice.Status),
+		"amount_paid":     invoice.AmountPaid.String(),
+		"total":           invoice.Total.String(),
+	}
+
+	if invoice.Status == domain.InvoiceStatusVoid {
+		fields["amount"] = payment.Amount.String()
+		w.logger.Warn("Payment received for voided invoice", fields)
+		return nil
+	}
+
+	if overpayment := invoice.Overpayment(); overpayment.IsPositive() {
+		fields["overpayment"] = overpayment.String()
+		w.logger.Warn("Invoice overpaid", fields)
+		return nil
+	}
+
+	w.logger.Info("Invoice payment matched", fields)
+	return nil
+}
+
+func (w *InvoicePaymentWatcher) batchSize() uint64 {
+	if w.config.IndexerBatchSize == 0 {
+		return 1000
+	}
+	return w.config.IndexerBatchSize
+}
@@ -0,0 +1,437 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/blockchain/rpctest"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDepositAddress = "0x7777777777777777777777777777777777777777"
+
+// stubInvoiceDepositStore keeps invoices and their payments in memory and
+// settles them the way the repository does, with a 50 basis point tolerance
+func stubInvoiceDepositStore(repo *mocks.FakeInvoiceRepository, invoices map[uuid.UUID]*domain.Invoice, payments map[string]domain.InvoicePayment, entries *[]domain.LedgerEntry) {
+	repo.ListInvoicesAwaitingDepositStub = func(ctx context.Context, closedSince time.Time) ([]domain.Invoice, error) {
+		var awaiting []domain.Invoice
+		for _, invoice := range invoices {
+			if invoice.Status.IsOpen() ||
+				invoice.Status == domain.InvoiceStatusPaid && !invoice.PaidAt.Before(closedSince) ||
+				invoice.Status == domain.InvoiceStatusVoid && !invoice.VoidedAt.Before(closedSince) {
+				awaiting = append(awaiting, *invoice)
+			}
+		}
+		return awaiting, nil
+	}
+	repo.RecordInvoicePaymentStub = func(ctx context.Context, payment domain.InvoicePayment, at time.Time, settlement func(before, after domain.Invoice) (*domain.LedgerEntry, error)) (*domain.Invoice, error) {
+		key := fmt.Sprintf("%s:%s:%s:%d", strings.ToLower(payment.TxHash), strings.ToLower(payment.TokenAddress), strings.ToLower(payment.ToAddress), payment.TransferOrdinal)
+		if _, ok := payments[key]; ok {
+			return nil, nil
+		}
+
+		invoice := invoices[payment.InvoiceID]
+		payment.Applied = invoice.Status != domain.InvoiceStatusVoid
+		payments[key] = payment
+
+		if !payment.Applied {
+			invoice.PaymentException = domain.InvoicePaymentExceptionPaidAfterVoid
+			updated := *invoice
+			return &updated, nil
+		}
+
+		received := decimal.Zero
+		for _, recorded := range payments {
+			if recorded.InvoiceID == payment.InvoiceID && recorded.Applied {
+				received = received.Add(recorded.Amount.Amount())
+			}
+		}
+
+		before := *invoice
+		invoice.ApplyPayments(received, 50, at, payment.TxHash)
+		updated := *invoice
+
+		entry, err := settlement(before, updated)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			*entries = append(*entries, *entry)
+		}
+		return &updated, nil
+	}
+}
+
+// stubDepositLedger keeps ledger accounts and entries in memory, posting each
+// external reference once
+func stubDepositLedger(ledger *mocks.FakeLedgerService, accounts map[string]domain.LedgerAccount, entries *[]domain.LedgerEntry) {
+	ledger.GetAccountByCodeStub = func(ctx context.Context, code string) (*domain.LedgerAccount, error) {
+		account, ok := accounts[code]
+		if !ok {
+			return nil, appErrors.NewNotFoundError("ledger account not found")
+		}
+		return &account, nil
+	}
+	ledger.CreateAccountStub = func(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error) {
+		account.ID = uuid.New()
+		accounts[account.Code] = account
+		return &account, nil
+	}
+	ledger.PostEntryStub = func(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, error) {
+		for _, posted := range *entries {
+			if posted.ExternalReference == entry.ExternalReference {
+				return &posted, nil
+			}
+		}
+		*entries = append(*entries, entry)
+		return &entry, nil
+	}
+}
+
+// testDepositInvoice builds a sent invoice paid in the asset to a derived deposit address
+func testDepositInvoice(assetID uuid.UUID, index int64, address string, total string) *domain.Invoice {
+	return &domain.Invoice{
+		ID:             uuid.New(),
+		Status:         domain.InvoiceStatusSent,
+		Currency:       "USD",
+		Total:          money.MustParse(total, "USD"),
+		PaymentAssetID: &assetID,
+		PaymentAddress: address,
+		DepositIndex:   &index,
+	}
+}
+
+// ledgerBalance sums the postings to the organization account of a kind, such as receivables
+func ledgerBalance(accounts map[string]domain.LedgerAccount, entries []domain.LedgerEntry, kind string) string {
+	total := decimal.Zero
+	for _, entry := range entries {
+		for _, posting := range entry.Postings {
+			for code, account := range accounts {
+				if account.ID == posting.AccountID && strings.HasPrefix(code, kind+":") {
+					total = total.Add(posting.Amount.Amount())
+				}
+			}
+		}
+	}
+	return total.String()
+}
+
+func TestInvoicePaymentWatcher_SettlesInvoices(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	paid := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[paid.ID] = paid
+	partial := testDepositInvoice(assetID, 1, testWallet, "100")
+	invoices[partial.ID] = partial
+
+	// 99.60 of 100 is within the 0.5% tolerance for fees taken off in transit
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 0, BlockNumber: 101, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(60_000_000)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 0, BlockNumber: 103, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(39_600_000)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 1, BlockNumber: 103, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(40_000_000)})
+	// Not a payment: another token into a deposit address
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 2, BlockNumber: 104, Token: testOtherToken, From: testPayer, To: testWallet, Amount: big.NewInt(60_000_000)})
+	node.SetHead(110)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 100, TxTrackerConfirmations: 3}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	// Act
+	err := watcher.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusPaid, paid.Status)
+	assert.Equal(t, "99.6 USD", paid.AmountPaid.String())
+	assert.Equal(t, testTransferTxB, paid.PaymentReference)
+	assert.Equal(t, now, *paid.PaidAt)
+	assert.True(t, paid.Overpayment().IsZero())
+
+	assert.Equal(t, domain.InvoiceStatusPartiallyPaid, partial.Status)
+	assert.Equal(t, "60 USD", partial.BalanceDue().String())
+	assert.Nil(t, partial.PaidAt)
+
+	assert.Len(t, payments, 3)
+	assert.Equal(t, uint64(107), checkpoint.BlockNumber)
+
+	// Both invoices are issued, the payments clear their receivables and the
+	// 0.40 left on the paid one is written off
+	assert.Equal(t, "139.6", ledgerBalance(accounts, entries, "payments_received"))
+	assert.Equal(t, "0.4", ledgerBalance(accounts, entries, "payment_shortfalls"))
+	assert.Equal(t, "60", ledgerBalance(accounts, entries, "receivables"))
+	assert.Equal(t, "-200", ledgerBalance(accounts, entries, "revenue"))
+}
+
+func TestInvoicePaymentWatcher_WaitsForConfirmations(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	invoice := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[invoice.ID] = invoice
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 109, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(100_000_000)})
+	node.SetHead(110)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 100, TxTrackerConfirmations: 3}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	err := watcher.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusSent, invoice.Status)
+	assert.Empty(t, payments)
+
+	// Act: the transfer is now confirmed
+	node.SetHead(112)
+	err = watcher.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+}
+
+func TestInvoicePaymentWatcher_CountsLatePaymentsAsOverpayment(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	invoice := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[invoice.ID] = invoice
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(100_000_000)})
+	node.SetHead(10)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 1}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.NoError(t, watcher.Poll(ctx))
+	require.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+
+	// Act: another transfer arrives after the invoice is paid
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, BlockNumber: 12, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(2_500_000)})
+	node.SetHead(12)
+	err := watcher.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+	assert.Equal(t, testTransferTxA, invoice.PaymentReference)
+	assert.Equal(t, "2.5 USD", invoice.Overpayment().String())
+	assert.Equal(t, domain.InvoicePaymentExceptionOverpaid, invoice.PaymentException)
+
+	// The overpayment is owed back to the customer
+	assert.Equal(t, "-2.5", ledgerBalance(accounts, entries, "receivables"))
+	assert.Equal(t, "0", ledgerBalance(accounts, entries, "payment_shortfalls"))
+}
+
+func TestInvoicePaymentWatcher_RescanCountsTransfersOnce(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	invoice := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[invoice.ID] = invoice
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(30_000_000)})
+	node.SetHead(10)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 1}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.NoError(t, watcher.Poll(ctx))
+
+	// Act: a crash before the checkpoint was saved scans the same blocks again
+	checkpoint = nil
+	err := watcher.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, payments, 1)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "70", ledgerBalance(accounts, entries, "receivables"))
+	assert.Equal(t, "30 USD", invoice.AmountPaid.String())
+	assert.Equal(t, domain.InvoiceStatusPartiallyPaid, invoice.Status)
+}
+
+func TestInvoicePaymentWatcher_CountsTransfersInOneTransaction(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	invoice := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[invoice.ID] = invoice
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 3, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(40_000_000)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 7, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(60_000_000)})
+	node.SetHead(10)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 1}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.NoError(t, watcher.Poll(ctx))
+
+	// Act: a reorg that moves the transaction shifts its log indexes but not
+	// the order of its transfers, so the rescan matches the same payments
+	node.RemoveLogs(testTransferTxA)
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 12, BlockNumber: 6, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(40_000_000)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 16, BlockNumber: 6, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(60_000_000)})
+	checkpoint = nil
+	err := watcher.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, payments, 2)
+	assert.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+	assert.Equal(t, "100 USD", invoice.AmountPaid.String())
+	assert.Empty(t, invoice.PaymentException)
+}
+
+func TestInvoicePaymentWatcher_FlagsPaymentsToVoidedInvoices(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockLedgerService := new(mocks.FakeLedgerService)
+
+	now := time.Date(2025, 5, 27, 9, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	payments := make(map[string]domain.InvoicePayment)
+	accounts := make(map[string]domain.LedgerAccount)
+	var entries []domain.LedgerEntry
+	var checkpoint *domain.IndexerCheckpoint
+	stubInvoiceDepositStore(mockInvoiceRepo, invoices, payments, &entries)
+	stubDepositLedger(mockLedgerService, accounts, &entries)
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Chain: "ethereum", Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil)
+
+	invoice := testDepositInvoice(assetID, 0, testDepositAddress, "100")
+	invoices[invoice.ID] = invoice
+	voidedAt := now.Add(-time.Hour)
+	invoice.Status = domain.InvoiceStatusVoid
+	invoice.VoidedAt = &voidedAt
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testDepositAddress, Amount: big.NewInt(100_000_000)})
+	node.SetHead(10)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerStartBlock: 1}
+	watcher := NewInvoicePaymentWatcher(client, mockInvoiceRepo, mockCheckpointRepo, mockAssetService, mockLedgerService, cfg, logging.New(&cfg))
+	watcher.now = func() time.Time { return now }
+
+	// Act
+	err := watcher.Poll(context.Background())
+
+	// Assert: the transfer is kept for a refund but not counted against the invoice
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	for _, payment := range payments {
+		assert.False(t, payment.Applied)
+	}
+	assert.Equal(t, domain.InvoiceStatusVoid, invoice.Status)
+	assert.True(t, invoice.AmountPaid.IsZero())
+	assert.Equal(t, domain.InvoicePaymentExceptionPaidAfterVoid, invoice.PaymentException)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+// invoiceReminderBatchSize is how many reminders are sent per pass
+const invoiceReminderBatchSize = 500
+
+type invoiceReminderService struct {
+	reminderRepo ports.InvoiceReminderRepository
+	invoiceRepo  ports.InvoiceRepository
+	orgService   ports.OrganizationService
+	emailService ports.EmailService
+	logger       logging.Logger
+	now          func() time.Time
+}
+
+// NewInvoiceReminderService creates a new invoice reminder service
+func NewInvoiceReminderService(
+	reminderRepo ports.InvoiceReminderRepository,
+	invoiceRepo ports.InvoiceRepository,
+	orgService ports.OrganizationService,
+	emailService ports.EmailService,
+	logger logging.Logger,
+) ports.InvoiceReminderService {
+	return &invoiceReminderService{
+		reminderRepo: reminderRepo,
+		invoiceRepo:  invoiceRepo,
+		orgService:   orgService,
+		emailService: emailService,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// GetReminderSettings returns the organization's reminder settings, or the
+// default cadence, turned off, if it has never set them. Any member may view
+// them.
+func (s *invoiceReminderService) GetReminderSettings(ctx context.Context, userID, orgID uuid.UUID) (*domain.InvoiceReminderSettings, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.reminderRepo.GetReminderSettings(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return &domain.InvoiceReminderSettings{
+			OrganizationID: orgID,
+			OffsetDays:     slices.Clone(domain.DefaultInvoiceReminderOffsets),
+		}, nil
+	}
+
+	return settings, nil
+}
+
+// UpdateReminderSettings turns reminders on or off and sets the days relative
+// to the due date they go out on. Finance managers may change them. Steps that
+// already came due for an invoice are not sent again when the cadence changes.
+func (s *invoiceReminderService) UpdateReminderSettings(ctx context.Context, userID, orgID uuid.UUID, settings domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	settings.OrganizationID = orgID
+	settings.OffsetDays = slices.Clone(settings.OffsetDays)
+	slices.Sort(settings.OffsetDays)
+	settings.UpdatedBy = &userID
+
+	saved, err := s.reminderRepo.SaveReminderSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Invoice reminder settings updated", map[string]interface{}{
+		"organization_id": orgID,
+		"enabled":         saved.Enabled,
+		"offset_days":     saved.OffsetDays,
+	})
+
+	return saved, nil
+}
+
+// ListInvoiceReminders lists the reminders sent for one of the organization's
+// invoices. Any member may view them.
+func (s *invoiceReminderService) ListInvoiceReminders(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoiceReminder, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	if _, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	return s.reminderRepo.ListInvoiceReminders(ctx, invoiceID)
+}
+
+// SendDueReminders emails the customer of every invoice awaiting payment whose
+// next reminder has come due. Each step is recorded before its email is
+// queued, so a restart or a second instance never sends it twice; an email
+// that cannot be queued releases its step to be retried after a backoff,
+// behind steps that have not failed, and the step is given up after
+// domain.MaxInvoiceReminderAttempts. Invoices that are paid or voided are
+// skipped, so reminders stop as soon as a payment is matched.
+func (s *invoiceReminderService) SendDueReminders(ctx context.Context) (int, error) {
+	now := s.now()
+
+	due, err := s.reminderRepo.ListDueReminders(ctx, now, invoiceReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	organizations := make(map[uuid.UUID]*domain.Organization)
+	sent := 0
+	for _, step := range due {
+		reminder, invoice, err := s.reminderRepo.ClaimReminder(ctx, step.InvoiceID, step.OffsetDays, now)
+		if err != nil {
+			return sent, err
+		}
+		if reminder == nil {
+			continue
+		}
+
+		org, ok := organizations[invoice.OrganizationID]
+		if !ok {
+			org, err = s.orgService.GetOrganizationByID(ctx, invoice.OrganizationID)
+			if err != nil {
+				s.releaseReminder(ctx, *reminder, now)
+				return sent, err
+			}
+			organizations[invoice.OrganizationID] = org
+		}
+
+		if err := s.emailService.SendInvoiceReminder(ctx, *invoice, org.Name, reminder.OffsetDays); err != nil {
+			s.logger.Error("Failed to send invoice reminder", err, map[string]interface{}{
+				"organization_id": invoice.OrganizationID,
+				"invoice_id":      invoice.ID,
+				"offset_days":     reminder.OffsetDays,
+			})
+			s.releaseReminder(ctx, *reminder, now)
+			continue
+		}
+
+		sent++
+	}
+
+	if sent > 0 {
+		s.logger.Info("Invoice reminders sent", map[string]interface{}{
+			"count": sent,
+		})
+	}
+
+	return sent, nil
+}
+
+// releaseReminder hands back a claimed reminder step so it is tried again
+// after a backoff, unless it has failed too many times
+func (s *invoiceReminderService) releaseReminder(ctx context.Context, reminder domain.InvoiceReminder, failedAt time.Time) {
+	attempts, err := s.reminderRepo.ReleaseReminder(ctx, reminder, failedAt)
+	if err != nil {
+		s.logger.Error("Failed to release invoice reminder", err, map[string]interface{}{
+			"invoice_id":  reminder.InvoiceID,
+			"reminder_id": reminder.ID,
+		})
+		return
+	}
+
+	if attempts >= domain.MaxInvoiceReminderAttempts {
+		s.logger.Warn("Invoice reminder given up", map[string]interface{}{
+			"invoice_id":  reminder.InvoiceID,
+			"offset_days": reminder.OffsetDays,
+			"attempts":    attempts,
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoiceReminderService_GetReminderSettings_Default(t *testing.T) {
+	// Arrange
+	mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	viewerID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{viewerID: domain.OrganizationRoleViewer})
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	settings, err := service.GetReminderSettings(context.Background(), viewerID, orgID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, settings.Enabled)
+	assert.Equal(t, domain.DefaultInvoiceReminderOffsets, settings.OffsetDays)
+
+	// The default is handed out as a copy
+	settings.OffsetDays[0] = 99
+	assert.Equal(t, -3, domain.DefaultInvoiceReminderOffsets[0])
+}
+
+func TestInvoiceReminderService_UpdateReminderSettings(t *testing.T) {
+	// Arrange
+	mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockReminderRepo.SaveReminderSettingsStub = func(ctx context.Context, settings domain.InvoiceReminderSettings) (*domain.InvoiceReminderSettings, error) {
+		return &settings, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	settings, err := service.UpdateReminderSettings(context.Background(), financeID, orgID, domain.InvoiceReminderSettings{
+		Enabled:    true,
+		OffsetDays: []int{7, -1, 0},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{-1, 0, 7}, settings.OffsetDays)
+	assert.Equal(t, orgID, settings.OrganizationID)
+	assert.Equal(t, financeID, *settings.UpdatedBy)
+}
+
+func TestInvoiceReminderService_UpdateReminderSettings_Invalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     domain.OrganizationRole
+		settings domain.InvoiceReminderSettings
+		errType  appErrors.ErrorType
+	}{
+		{name: "viewer", role: domain.OrganizationRoleViewer, settings: domain.InvoiceReminderSettings{Enabled: true, OffsetDays: []int{1}}, errType: appErrors.ErrorTypeForbidden},
+		{name: "enabled_without_steps", role: domain.OrganizationRoleAdmin, settings: domain.InvoiceReminderSettings{Enabled: true}, errType: appErrors.ErrorTypeValidation},
+		{name: "too_early", role: domain.OrganizationRoleAdmin, settings: domain.InvoiceReminderSettings{Enabled: true, OffsetDays: []int{-31}}, errType: appErrors.ErrorTypeValidation},
+		{name: "too_late", role: domain.OrganizationRoleAdmin, settings: domain.InvoiceReminderSettings{Enabled: true, OffsetDays: []int{91}}, errType: appErrors.ErrorTypeValidation},
+		{name: "duplicate", role: domain.OrganizationRoleAdmin, settings: domain.InvoiceReminderSettings{Enabled: true, OffsetDays: []int{3, 3}}, errType: appErrors.ErrorTypeValidation},
+		{name: "too_many", role: domain.OrganizationRoleAdmin, settings: domain.InvoiceReminderSettings{Enabled: true, OffsetDays: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}}, errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockEmailService := new(mocks.FakeEmailService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: tc.role})
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.UpdateReminderSettings(context.Background(), userID, orgID, tc.settings)
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, tc.errType, appErrors.GetErrorType(err))
+			assert.Zero(t, mockReminderRepo.SaveReminderSettingsCallCount())
+		})
+	}
+}
+
+// stubReminderSteps keeps claimed reminder steps in memory the way the
+// repository does: a step is claimed once, and never for an invoice that is
+// not open
+func stubReminderSteps(repo *mocks.FakeInvoiceReminderRepository, invoices map[uuid.UUID]*domain.Invoice) map[uuid.UUID]domain.InvoiceReminder {
+	claimed := make(map[uuid.UUID]domain.InvoiceReminder)
+
+	repo.ClaimReminderStub = func(ctx context.Context, invoiceID uuid.UUID, offsetDays int, at time.Time) (*domain.InvoiceReminder, *domain.Invoice, error) {
+		invoice := invoices[invoiceID]
+		if !invoice.Status.IsOpen() {
+			return nil, nil, nil
+		}
+		for _, reminder := range claimed {
+			if reminder.InvoiceID == invoiceID && reminder.OffsetDays == offsetDays {
+				return nil, nil, nil
+			}
+		}
+
+		reminder := domain.InvoiceReminder{ID: uuid.New(), InvoiceID: invoiceID, OffsetDays: offsetDays, Recipient: invoice.CustomerEmail, SentAt: at}
+		claimed[reminder.ID] = reminder
+		return &reminder, invoice, nil
+	}
+	failures := make(map[uuid.UUID]int)
+	repo.ReleaseReminderStub = func(ctx context.Context, reminder domain.InvoiceReminder, failedAt time.Time) (int, error) {
+		delete(claimed, reminder.ID)
+		failures[reminder.InvoiceID]++
+		return failures[reminder.InvoiceID], nil
+	}
+
+	return claimed
+}
+
+func TestInvoiceReminderService_SendDueReminders(t *testing.T) {
+	// Arrange
+	mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+
+	partial := &domain.Invoice{ID: uuid.New(), OrganizationID: orgID, Status: domain.InvoiceStatusPartiallyPaid, Currency: "USD", CustomerEmail: "ap@globex.test", Total: money.MustParse("100", "USD"), AmountPaid: money.MustParse("40", "USD")}
+	paid := &domain.Invoice{ID: uuid.New(), OrganizationID: orgID, Status: domain.InvoiceStatusPaid, Currency: "USD", CustomerEmail: "ap@initech.test"}
+	claimed := stubReminderSteps(mockReminderRepo, map[uuid.UUID]*domain.Invoice{partial.ID: partial, paid.ID: paid})
+
+	// The paid invoice was listed before its payment was matched
+	mockReminderRepo.ListDueRemindersReturns([]domain.DueInvoiceReminder{{InvoiceID: partial.ID, OffsetDays: 7}, {InvoiceID: paid.ID, OffsetDays: 0}}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	sent, err := service.SendDueReminders(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	require.Equal(t, 1, mockEmailService.SendInvoiceReminderCallCount())
+	_, invoice, orgName, offsetDays := mockEmailService.SendInvoiceReminderArgsForCall(0)
+	assert.Equal(t, partial.ID, invoice.ID)
+	assert.Equal(t, "Acme", orgName)
+	assert.Equal(t, 7, offsetDays)
+	assert.Len(t, claimed, 1)
+
+	// Act: listed again after a restart
+	sent, err = service.SendDueReminders(ctx)
+
+	// Assert: the step is not sent twice
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Equal(t, 1, mockEmailService.SendInvoiceReminderCallCount())
+}
+
+func TestInvoiceReminderService_SendDueReminders_RetriesFailedEmails(t *testing.T) {
+	// Arrange
+	mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+
+	invoice := &domain.Invoice{ID: uuid.New(), OrganizationID: orgID, Status: domain.InvoiceStatusSent, Currency: "USD", CustomerEmail: "ap@globex.test"}
+	claimed := stubReminderSteps(mockReminderRepo, map[uuid.UUID]*domain.Invoice{invoice.ID: invoice})
+	mockReminderRepo.ListDueRemindersReturns([]domain.DueInvoiceReminder{{InvoiceID: invoice.ID, OffsetDays: -3}}, nil)
+	mockEmailService.SendInvoiceReminderReturnsOnCall(0, errors.New("queue full"))
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	sent, err := service.SendDueReminders(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Equal(t, 1, mockReminderRepo.ReleaseReminderCallCount())
+	_, released, failedAt := mockReminderRepo.ReleaseReminderArgsForCall(0)
+	assert.Equal(t, invoice.ID, released.InvoiceID)
+	assert.Equal(t, -3, released.OffsetDays)
+	assert.Equal(t, now, failedAt)
+	assert.Empty(t, claimed)
+
+	// Act: the released step is sent on the next pass
+	sent, err = service.SendDueReminders(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Len(t, claimed, 1)
+}
+
+func TestInvoiceReminderService_SendDueReminders_FailureDoesNotStopBatch(t *testing.T) {
+	// Arrange
+	mockReminderRepo := new(mocks.FakeInvoiceReminderRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockEmailService := new(mocks.FakeEmailService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+
+	failing := &domain.Invoice{ID: uuid.New(), OrganizationID: orgID, Status: domain.InvoiceStatusSent, Currency: "USD", CustomerEmail: "bounce@globex.test"}
+	healthy := &domain.Invoice{ID: uuid.New(), OrganizationID: orgID, Status: domain.InvoiceStatusOverdue, Currency: "USD", CustomerEmail: "ap@initech.test"}
+	claimed := stubReminderSteps(mockReminderRepo, map[uuid.UUID]*domain.Invoice{failing.ID: failing, healthy.ID: healthy})
+	mockReminderRepo.ListDueRemindersReturns([]domain.DueInvoiceReminder{{InvoiceID: failing.ID, OffsetDays: 0}, {InvoiceID: healthy.ID, OffsetDays: 7}}, nil)
+
+	mockEmailService.SendInvoiceReminderStub = func(ctx context.Context, invoice domain.Invoice, orgName string, offsetDays int) error {
+		if invoice.ID == failing.ID {
+			return errors.New("mailbox unavailable")
+		}
+		return nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceReminderService(mockReminderRepo, mockInvoiceRepo, mockOrgService, mockEmailService, logging.New(&cfg)).(*invoiceReminderService)
+	service.now = func() time.Time { return now }
+
+	// Act: a step that keeps failing is released each pass until it is given up
+	for range domain.MaxInvoiceReminderAttempts {
+		_, err := service.SendDueReminders(context.Background())
+		require.NoError(t, err)
+	}
+
+	// Assert: the rest of the batch is still sent
+	assert.Equal(t, domain.MaxInvoiceReminderAttempts, mockReminderRepo.ReleaseReminderCallCount())
+	assert.Len(t, claimed, 1)
+	for _, reminder := range claimed {
+		assert.Equal(t, healthy.ID, reminder.InvoiceID)
+	}
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+// InvoiceScheduler periodically generates the invoices of recurring invoices
+// that have come due, moves open invoices past their due date to overdue and
+// sends the reminders that have come due. Each is conditional on the row
+// still being due, so several instances can run side by side.
+type InvoiceScheduler struct {
+	invoiceService   ports.InvoiceService
+	recurringService ports.RecurringInvoiceService
+	reminderService  ports.InvoiceReminderService
+	config           config.Config
+	logger           logging.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewInvoiceScheduler creates a new invoice scheduler
+func NewInvoiceScheduler(invoiceService ports.InvoiceService, recurringService ports.RecurringInvoiceService, reminderService ports.InvoiceReminderService, config config.Config, logger logging.Logger) *InvoiceScheduler {
+	return &InvoiceScheduler{
+		invoiceService:   invoiceService,
+		recurringService: recurringService,
+		reminderService:  reminderService,
+		config:           config,
+		logger:           logger,
+		stop:             make(chan struct{}),
+	}
+}
+
+// Start generates recurring invoices, marks overdue invoices and sends
+// reminders in the background every InvoicePollInterval until Stop is called
+func (s *InvoiceScheduler) Start() {
+	interval := s.config.InvoicePollInterval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if _, err := s.recurringService.GenerateRecurringInvoices(ctx); err != nil {
+					s.logger.Error("Failed to generate recurring invoices", err)
+				}
+				if _, err := s.invoiceService.MarkOverdueInvoices(ctx); err != nil {
+					s.logger.Error("Failed to mark overdue invoices", err)
+				}
+				if _, err := s.reminderService.SendDueReminders(ctx); err != nil {
+					s.logger.Error("Failed to send invoice reminders", err)
+				}
+				cancel()
+			}
+		}
+	}()
+
+	s.logger.Info("Invoice scheduler started", map[string]interface{}{
+		"poll_interval": interval.String(),
+	})
+}
+
+// Stop stops the background scheduler and waits for an in-flight pass to finish
+func (s *InvoiceScheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+	s.logger.Info("Invoice scheduler stopped")
+}
@@ -0,0 +1,708 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// maxInvoiceLineItems caps the lines of one invoice
+	maxInvoiceLineItems = 100
+	// fiatAmountPlaces is the precision of invoice amounts in a currency that is not a supported asset
+	fiatAmountPlaces = 2
+	// invoiceOverdueBatchSize is how many invoices are moved to overdue per pass
+	invoiceOverdueBatchSize = 500
+)
+
+type invoiceService struct {
+	invoiceRepo  ports.InvoiceRepository
+	clientRepo   ports.ClientRepository
+	orgService   ports.OrganizationService
+	assetService ports.AssetService
+	emailService ports.EmailService
+	renderer     ports.InvoiceRenderer
+	securityRepo ports.SecurityRepository
+	ledger       invoiceLedger
+	taxService   ports.TaxService
+	config       config.Config
+	logger       logging.Logger
+	now          func() time.Time
+}
+
+// NewInvoiceService creates a new invoice service. Issuing, paying and voiding
+// an invoice is posted to ledger. Invoices with automatic tax are taxed by
+// taxService.
+func NewInvoiceService(
+	invoiceRepo ports.InvoiceRepository,
+	clientRepo ports.ClientRepository,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	emailService ports.EmailService,
+	renderer ports.InvoiceRenderer,
+	securityRepo ports.SecurityRepository,
+	ledger ports.LedgerService,
+	taxService ports.TaxService,
+	config config.Config,
+	logger logging.Logger,
+) ports.InvoiceService {
+	return &invoiceService{
+		invoiceRepo:  invoiceRepo,
+		clientRepo:   clientRepo,
+		orgService:   orgService,
+		assetService: assetService,
+		emailService: emailService,
+		renderer:     renderer,
+		securityRepo: securityRepo,
+		ledger:       invoiceLedger{ledger: ledger},
+		taxService:   taxService,
+		config:       config,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// CreateInvoice creates a draft invoice with the organization's next invoice number
+func (s *invoiceService) CreateInvoice(ctx context.Context, userID, orgID uuid.UUID, invoice domain.Invoice) (*domain.Invoice, error) {
+	return s.createInvoice(ctx, userID, orgID, invoice, nil)
+}
+
+// CreateTimesheetInvoice creates a draft invoice billing approved timesheets
+// and marks them invoiced with it, failing if any was invoiced or changed
+// since the caller read it
+func (s *invoiceService) CreateTimesheetInvoice(ctx context.Context, userID, orgID uuid.UUID, invoice domain.Invoice, timesheetIDs []uuid.UUID) (*domain.Invoice, error) {
+	if len(timesheetIDs) == 0 {
+		return nil, appErrors.NewValidationError("at least one timesheet is required")
+	}
+
+	return s.createInvoice(ctx, userID, orgID, invoice, timesheetIDs)
+}
+
+// createInvoice creates a draft invoice, together with marking the timesheets
+// it bills invoiced when there are any
+func (s *invoiceService) createInvoice(ctx context.Context, userID, orgID uuid.UUID, invoice domain.Invoice, timesheetIDs []uuid.UUID) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	invoice.OrganizationID = orgID
+	if err := s.prepareInvoice(ctx, &invoice); err != nil {
+		return nil, err
+	}
+	if err := s.assignDepositAddress(ctx, &invoice, nil); err != nil {
+		return nil, err
+	}
+
+	invoice.ID = uuid.New()
+	invoice.Status = domain.InvoiceStatusDraft
+	invoice.CreatedBy = &userID
+
+	var created *domain.Invoice
+	var err error
+	if timesheetIDs == nil {
+		created, err = s.invoiceRepo.CreateInvoice(ctx, invoice)
+	} else {
+		created, err = s.invoiceRepo.CreateTimesheetInvoice(ctx, invoice, timesheetIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, appErrors.NewConflictError("some of the timesheets were invoiced or changed in the meantime")
+	}
+
+	s.logger.Info("Invoice created", map[string]interface{}{
+		"organization_id": orgID,
+		"invoice_id":      created.ID,
+		"number":          created.Number,
+	})
+
+	return created, nil
+}
+
+// ListInvoices lists an organization's invoices, latest first. Any member may view them.
+func (s *invoiceService) ListInvoices(ctx context.Context, userID, orgID uuid.UUID, status *domain.InvoiceStatus, page, pageSize int) ([]domain.Invoice, int64, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, 0, err
+	}
+
+	if status != nil && !status.IsValid() {
+		return nil, 0, appErrors.NewValidationError(fmt.Sprintf("invalid status %q", *status))
+	}
+
+	return s.invoiceRepo.ListInvoices(ctx, orgID, status, pageSize, (page-1)*pageSize)
+}
+
+// GetInvoice retrieves one of the organization's invoices with its line items
+func (s *invoiceService) GetInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.getInvoice(ctx, orgID, invoiceID)
+}
+
+// UpdateInvoice replaces the details and line items of a draft invoice. Sent
+// invoices are fixed; they can only be voided and reissued.
+func (s *invoiceService) UpdateInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID, invoice domain.Invoice) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status != domain.InvoiceStatusDraft {
+		return nil, appErrors.NewConflictError("only draft invoices can be edited")
+	}
+
+	// Sending back the derived deposit address keeps it, like leaving it out
+	invoice.OrganizationID = orgID
+	if existing.DepositIndex != nil && strings.EqualFold(strings.TrimSpace(invoice.PaymentAddress), existing.PaymentAddress) {
+		invoice.PaymentAddress = ""
+	}
+
+	if err := s.prepareInvoice(ctx, &invoice); err != nil {
+		return nil, err
+	}
+	if err := s.assignDepositAddress(ctx, &invoice, existing); err != nil {
+		return nil, err
+	}
+	invoice.ID = existing.ID
+
+	updated, err := s.invoiceRepo.UpdateDraftInvoice(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, appErrors.NewConflictError("only draft invoices can be edited")
+	}
+
+	return updated, nil
+}
+
+// SendInvoice emails the invoice to the customer with the PDF attached. A
+// draft is marked as sent first; sending an invoice that is already awaiting
+// payment emails it again.
+func (s *invoiceService) SendInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.getInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != domain.InvoiceStatusDraft && !invoice.Status.IsOpen() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s invoice cannot be sent", invoice.Status))
+	}
+
+	org, err := s.orgService.GetOrganization(ctx, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliverInvoice(ctx, invoice, *org)
+}
+
+// deliverInvoice renders the invoice, marks a draft as sent and emails it to
+// the customer
+func (s *invoiceService) deliverInvoice(ctx context.Context, invoice *domain.Invoice, org domain.Organization) (*domain.Invoice, error) {
+	// Render before marking the invoice as sent, so a rendering failure leaves it a draft
+	pdf, err := s.renderInvoice(ctx, *invoice, org)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status == domain.InvoiceStatusDraft {
+		// The invoice is posted to the ledger as it is sent, or not sent at all
+		now := s.now()
+		issued, err := s.ledger.issuedEntry(ctx, *invoice, now)
+		if err != nil {
+			return nil, err
+		}
+
+		sent, err := s.invoiceRepo.MarkSent(ctx, invoice.ID, now, issued)
+		if err != nil {
+			return nil, err
+		}
+		if sent == nil {
+			return nil, appErrors.NewConflictError("the invoice was changed while it was being sent")
+		}
+		invoice = sent
+	}
+
+	// The invoice stays sent if the email fails; sending again retries it
+	if err := s.emailService.SendInvoice(ctx, *invoice, org.Name, pdf); err != nil {
+		s.logger.Error("Failed to send invoice email", err, map[string]interface{}{
+			"organization_id": invoice.OrganizationID,
+			"invoice_id":      invoice.ID,
+		})
+	}
+
+	s.logger.Info("Invoice sent", map[string]interface{}{
+		"organization_id": invoice.OrganizationID,
+		"invoice_id":      invoice.ID,
+		"number":          invoice.Number,
+	})
+
+	return invoice, nil
+}
+
+// RenderInvoicePDF renders one of the organization's invoices as a PDF and
+// returns it with its file name. Any member may download invoices.
+func (s *invoiceService) RenderInvoicePDF(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]byte, string, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, "", err
+	}
+
+	invoice, err := s.getInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	org, err := s.orgService.GetOrganization(ctx, userID, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pdf, err := s.renderInvoice(ctx, *invoice, *org)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pdf, invoice.PDFFilename(), nil
+}
+
+// MarkPaid records that an open invoice was paid, at paidAt or now
+func (s *invoiceService) MarkPaid(ctx context.Context, userID, orgID, invoiceID uuid.UUID, paidAt *time.Time, reference string) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.getInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !invoice.Status.IsOpen() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s invoice cannot be marked as paid", invoice.Status))
+	}
+
+	now := s.now()
+	at := now
+	if paidAt != nil {
+		if paidAt.After(now) {
+			return nil, appErrors.NewValidationError("payment date cannot be in the future")
+		}
+		at = *paidAt
+	}
+
+	// The balance still due clears the receivable, in the same transaction
+	settlement, err := s.ledger.paidEntry(ctx, *invoice, at)
+	if err != nil {
+		return nil, err
+	}
+
+	paid, err := s.invoiceRepo.MarkPaid(ctx, invoice.ID, invoice.BalanceDue(), at, strings.TrimSpace(reference), settlement)
+	if err != nil {
+		return nil, err
+	}
+	if paid == nil {
+		return nil, appErrors.NewConflictError("the invoice was paid, credited or voided in the meantime")
+	}
+
+	s.logSecurityEvent(ctx, "invoice_marked_paid", userID, map[string]interface{}{
+		"organization_id":   orgID,
+		"invoice_id":        paid.ID,
+		"number":            paid.Number,
+		"total":             paid.Total.String(),
+		"payment_reference": paid.PaymentReference,
+	})
+
+	return paid, nil
+}
+
+// VoidInvoice cancels an invoice that has not been paid or credited. The
+// number stays used, and a sent invoice's ledger entry is reversed in full.
+func (s *invoiceService) VoidInvoice(ctx context.Context, userID, orgID, invoiceID uuid.UUID, reason string) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, appErrors.NewValidationError("a reason is required to void an invoice")
+	}
+
+	invoice, err := s.getInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != domain.InvoiceStatusDraft && !invoice.Status.IsOpen() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s invoice cannot be voided", invoice.Status))
+	}
+	if invoice.AmountPaid.IsPositive() {
+		return nil, appErrors.NewConflictError("an invoice that has received payments cannot be voided")
+	}
+	if invoice.AmountCredited.IsPositive() {
+		return nil, appErrors.NewConflictError("an invoice with credit notes cannot be voided; credit the remaining balance instead")
+	}
+
+	now := s.now()
+	var reversal *domain.LedgerEntry
+	if invoice.Status != domain.InvoiceStatusDraft {
+		reversal, err = s.ledger.voidEntry(ctx, *invoice, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	voided, err := s.invoiceRepo.Void(ctx, invoice.ID, now, reason, reversal)
+	if err != nil {
+		return nil, err
+	}
+	if voided == nil {
+		return nil, appErrors.NewConflictError("the invoice was paid, credited or voided in the meantime")
+	}
+
+	metadata := map[string]interface{}{
+		"organization_id": orgID,
+		"invoice_id":      voided.ID,
+		"number":          voided.Number,
+		"previous_status": string(invoice.Status),
+		"reason":          reason,
+	}
+	if reversal != nil {
+		metadata["ledger_reference"] = reversal.ExternalReference
+	}
+	s.logSecurityEvent(ctx, "invoice_voided", userID, metadata)
+
+	return voided, nil
+}
+
+// MarkOverdueInvoices moves every open invoice past its due date to overdue,
+// a batch at a time
+func (s *invoiceService) MarkOverdueInvoices(ctx context.Context) (int, error) {
+	now := s.now()
+	marked := 0
+
+	for {
+		invoices, err := s.invoiceRepo.MarkOverdue(ctx, now, invoiceOverdueBatchSize)
+		if err != nil {
+			return marked, err
+		}
+		marked += len(invoices)
+
+		if len(invoices) < invoiceOverdueBatchSize {
+			break
+		}
+	}
+
+	if marked > 0 {
+		s.logger.Info("Invoices marked overdue", map[string]interface{}{
+			"count": marked,
+		})
+	}
+
+	return marked, nil
+}
+
+// PrepareInvoice normalises and validates an invoice another service builds,
+// such as the one a recurring invoice issues, and works out its amounts. The
+// invoice is not stored.
+func (s *invoiceService) PrepareInvoice(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+	if err := s.prepareInvoice(ctx, &invoice); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+// AssignDepositAddress gives an invoice another service builds the
+// organization's next deposit address if it asks for one
+func (s *invoiceService) AssignDepositAddress(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+	if err := s.assignDepositAddress(ctx, &invoice, nil); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+// DeliverInvoice emails an invoice to the customer on its organization's
+// behalf, for invoices sent without a member asking, such as those a
+// recurring invoice sends automatically
+func (s *invoiceService) DeliverInvoice(ctx context.Context, invoiceID uuid.UUID) (*domain.Invoice, error) {
+	invoice, err := s.invoiceRepo.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, appErrors.NewNotFoundError("invoice not found")
+	}
+
+	org, err := s.orgService.GetOrganizationByID(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliverInvoice(ctx, invoice, *org)
+}
+
+// renderInvoice renders the invoice with the organization's branding and the
+// asset it asks to be paid in
+func (s *invoiceService) renderInvoice(ctx context.Context, invoice domain.Invoice, org domain.Organization) ([]byte, error) {
+	return renderInvoiceDocument(ctx, s.assetService, s.renderer, invoice, org)
+}
+
+// renderInvoiceDocument renders the invoice with the organization's branding
+// and the asset it asks to be paid in
+func renderInvoiceDocument(ctx context.Context, assetService ports.AssetService, renderer ports.InvoiceRenderer, invoice domain.Invoice, org domain.Organization) ([]byte, error) {
+	document := domain.InvoiceDocument{Invoice: invoice, Organization: org}
+
+	if invoice.PaymentAssetID != nil {
+		asset, err := assetService.GetAsset(ctx, *invoice.PaymentAssetID)
+		if err != nil {
+			return nil, err
+		}
+		document.PaymentAsset = asset
+	}
+
+	return renderer.RenderInvoice(ctx, document)
+}
+
+// getInvoice loads an invoice that belongs to the organization
+func (s *invoiceService) getInvoice(ctx context.Context, orgID, invoiceID uuid.UUID) (*domain.Invoice, error) {
+	return getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID)
+}
+
+// getOrganizationInvoice loads an invoice that belongs to the organization
+func getOrganizationInvoice(ctx context.Context, invoiceRepo ports.InvoiceRepository, orgID, invoiceID uuid.UUID) (*domain.Invoice, error) {
+	invoice, err := invoiceRepo.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil || invoice.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("invoice not found")
+	}
+
+	return invoice, nil
+}
+
+// prepareInvoice normalises and validates an invoice's details and line
+// items, then works out its amounts. An invoice raised for a directory client
+// takes what it leaves out from the client.
+func (s *invoiceService) prepareInvoice(ctx context.Context, invoice *domain.Invoice) error {
+	if err := s.applyClientDefaults(ctx, invoice); err != nil {
+		return err
+	}
+
+	currency, err := normalizeCurrencyCode(invoice.Currency)
+	if err != nil {
+		return err
+	}
+	invoice.Currency = currency
+
+	invoice.CustomerName = strings.TrimSpace(invoice.CustomerName)
+	if invoice.CustomerName == "" {
+		return appErrors.NewValidationError("customer name is required")
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(invoice.CustomerEmail))
+	if err != nil || address.Name != "" {
+		return appErrors.NewValidationError("a valid customer email address is required")
+	}
+	invoice.CustomerEmail = strings.ToLower(address.Address)
+	invoice.CustomerAddress = strings.TrimSpace(invoice.CustomerAddress)
+	invoice.Notes = strings.TrimSpace(invoice.Notes)
+
+	paymentAsset, err := s.preparePaymentDetails(ctx, invoice)
+	if err != nil {
+		return err
+	}
+
+	if invoice.IssueDate.IsZero() {
+		invoice.IssueDate = s.now()
+	}
+	if invoice.DueDate.IsZero() {
+		return appErrors.NewValidationError("due date is required")
+	}
+	if invoice.DueDate.Before(invoice.IssueDate) {
+		return appErrors.NewValidationError("due date cannot be before the issue date")
+	}
+
+	if len(invoice.LineItems) == 0 {
+		return appErrors.NewValidationError("at least one line item is required")
+	}
+	if len(invoice.LineItems) > maxInvoiceLineItems {
+		return appErrors.NewValidationError(fmt.Sprintf("an invoice can have at most %d line items", maxInvoiceLineItems))
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for i := range invoice.LineItems {
+		item := &invoice.LineItems[i]
+
+		item.Description = strings.TrimSpace(item.Description)
+		if item.Description == "" {
+			return appErrors.NewValidationError(fmt.Sprintf("line %d: description is required", i+1))
+		}
+		if !item.Quantity.IsPositive() {
+			return appErrors.NewValidationError(fmt.Sprintf("line %d: quantity must be greater than zero", i+1))
+		}
+		if item.UnitPrice.IsNegative() {
+			return appErrors.NewValidationError(fmt.Sprintf("line %d: unit price cannot be negative", i+1))
+		}
+		if item.DiscountPercent.IsNegative() || item.DiscountPercent.GreaterThan(hundred) {
+			return appErrors.NewValidationError(fmt.Sprintf("line %d: discount must be between 0 and 100 percent", i+1))
+		}
+		if item.TaxRate.IsNegative() || item.TaxRate.GreaterThan(hundred) {
+			return appErrors.NewValidationError(fmt.Sprintf("line %d: tax rate must be between 0 and 100 percent", i+1))
+		}
+	}
+
+	if err := s.prepareInvoiceTax(ctx, invoice); err != nil {
+		return err
+	}
+
+	// An invoice in its payment asset is rounded to that asset's decimals and
+	// its total must be payable on the asset's chain
+	paidInAsset := paymentAsset != nil && strings.EqualFold(paymentAsset.Symbol, invoice.Currency)
+
+	places := int32(0)
+	if paidInAsset {
+		places = int32(min(paymentAsset.Decimals, 18))
+	} else if places, err = s.amountPlaces(ctx, invoice.Currency); err != nil {
+		return err
+	}
+	invoice.Calculate(places)
+
+	if paidInAsset && invoice.Total.IsPositive() {
+		if _, err := s.assetService.ValidateAmount(ctx, paymentAsset.Chain, invoice.Total); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyClientDefaults fills in what an invoice raised for a directory client
+// leaves out: the customer's details, the currency, the payment asset and a
+// due date from the client's payment terms
+func (s *invoiceService) applyClientDefaults(ctx context.Context, invoice *domain.Invoice) error {
+	if invoice.ClientID == nil {
+		return nil
+	}
+
+	client, err := s.clientRepo.GetClient(ctx, invoice.OrganizationID, *invoice.ClientID)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return appErrors.NewValidationError("client not found")
+	}
+
+	if strings.TrimSpace(invoice.CustomerName) == "" {
+		invoice.CustomerName = client.Name
+	}
+	if strings.TrimSpace(invoice.CustomerEmail) == "" {
+		invoice.CustomerEmail = client.Email
+	}
+	if strings.TrimSpace(invoice.CustomerAddress) == "" {
+		invoice.CustomerAddress = client.BillingAddress.String()
+	}
+	if strings.TrimSpace(invoice.CustomerCountry) == "" {
+		invoice.CustomerCountry = client.BillingAddress.Country
+	}
+	if strings.TrimSpace(invoice.CustomerTaxID) == "" {
+		invoice.CustomerTaxID = client.TaxID
+	}
+	if strings.TrimSpace(invoice.Currency) == "" {
+		invoice.Currency = client.PreferredCurrency
+	}
+	if invoice.PaymentAssetID == nil && strings.TrimSpace(invoice.PaymentAddress) == "" {
+		invoice.PaymentAssetID = client.PreferredAssetID
+	}
+	if invoice.DueDate.IsZero() {
+		if invoice.IssueDate.IsZero() {
+			invoice.IssueDate = s.now()
+		}
+		invoice.DueDate = invoice.IssueDate.AddDate(0, 0, client.PaymentTermsDays)
+	}
+
+	return nil
+}
+
+// preparePaymentDetails checks the asset and wallet printed on the invoice as
+// payment instructions. Both are optional, but a wallet needs an asset. An
+// asset without a wallet asks for a deposit address derived from the
+// organization's deposit key, so the payment can be matched to the invoice.
+// It returns the payment asset, if any.
+func (s *invoiceService) preparePaymentDetails(ctx context.Context, invoice *domain.Invoice) (*domain.SupportedAsset, error) {
+	invoice.PaymentAddress = strings.TrimSpace(invoice.PaymentAddress)
+	invoice.DepositIndex = nil
+	if invoice.PaymentAssetID == nil && invoice.PaymentAddress == "" {
+		return nil, nil
+	}
+	if invoice.PaymentAssetID == nil {
+		return nil, appErrors.NewValidationError("a payment address needs a payment asset")
+	}
+
+	asset, err := s.assetService.GetAsset(ctx, *invoice.PaymentAssetID)
+	if err != nil {
+		if appErrors.GetErrorType(err) == appErrors.ErrorTypeNotFound {
+			return nil, appErrors.NewValidationError("payment asset is not supported")
+		}
+		return nil, err
+	}
+	if !asset.Enabled {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("%s on %s is not enabled for payments", asset.Symbol, asset.Chain))
+	}
+
+	if invoice.PaymentAddress == "" {
+		return asset, s.checkDepositPayment(ctx, invoice, *asset)
+	}
+
+	address, ok := normalizeAddress(asset.Chain, invoice.PaymentAddress)
+	if !ok {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("payment address is not a valid %s address", asset.Chain))
+	}
+	invoice.PaymentAddress = address
+
+	return asset, nil
+}
+
+// amountPlaces returns how many decimal places invoice amounts in the currency carry
+func (s *invoiceService) amountPlaces(ctx context.Context, currency string) (int32, error) {
+	return currencyAmountPlaces(ctx, s.assetService, currency)
+}
+
+// currencyAmountPlaces returns how many decimal places amounts in the currency
+// carry: the decimals of a supported asset with that symbol, or two for fiat
+func currencyAmountPlaces(ctx context.Context, assetService ports.AssetService, currency string) (int32, error) {
+	assets, err := assetService.ListAssets(ctx, domain.SupportedAssetFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, asset := range assets {
+		if strings.EqualFold(asset.Symbol, currency) {
+			return int32(min(asset.Decimals, 18)), nil
+		}
+	}
+
+	return fiatAmountPlaces, nil
+}
+
+func (s *invoiceService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
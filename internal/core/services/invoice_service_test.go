@@ -0,0 +1,1126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStoredInvoice builds invoice INV-000007 for 100 USD billed to Globex
+func testStoredInvoice(orgID uuid.UUID, status domain.InvoiceStatus) *domain.Invoice {
+	return &domain.Invoice{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Number:         7,
+		Status:         status,
+		Currency:       "USD",
+		CustomerName:   "Globex",
+		CustomerEmail:  "billing@globex.test",
+		Total:          money.MustParse("100", "USD"),
+	}
+}
+
+// testInvoiceDraft builds a two-line invoice request due 30 days after now
+func testInvoiceDraft(now time.Time) domain.Invoice {
+	return domain.Invoice{
+		Currency:      "usd",
+		CustomerName:  " Globex ",
+		CustomerEmail: "Billing@Globex.test",
+		DueDate:       now.AddDate(0, 0, 30),
+		LineItems: []domain.InvoiceLineItem{
+			{
+				Description:     "Design work",
+				Quantity:        decimal.RequireFromString("3"),
+				UnitPrice:       money.MustParse("33.335", "USD"),
+				DiscountPercent: decimal.RequireFromString("10"),
+				TaxRate:         decimal.RequireFromString("7.5"),
+			},
+			{
+				Description: "Hosting",
+				Quantity:    decimal.RequireFromString("1"),
+				UnitPrice:   money.MustParse("20", "USD"),
+			},
+		},
+	}
+}
+
+// stubInvoiceLedgerAccounts keeps the ledger accounts invoices post to in
+// memory, creating them on first use, and accepts every entry
+func stubInvoiceLedgerAccounts(ledger *mocks.FakeLedgerService) {
+	accounts := make(map[string]domain.LedgerAccount)
+	ledger.GetAccountByCodeStub = func(ctx context.Context, code string) (*domain.LedgerAccount, error) {
+		account, ok := accounts[code]
+		if !ok {
+			return nil, appErrors.NewNotFoundError("ledger account not found")
+		}
+		return &account, nil
+	}
+	ledger.CreateAccountStub = func(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error) {
+		account.ID = uuid.New()
+		accounts[account.Code] = account
+		return &account, nil
+	}
+	ledger.PostEntryStub = func(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, error) {
+		return &entry, nil
+	}
+}
+
+func withStatus(invoice *domain.Invoice, status domain.InvoiceStatus) *domain.Invoice {
+	updated := *invoice
+	updated.Status = status
+	return &updated
+}
+
+func TestInvoice_Calculate(t *testing.T) {
+	// Arrange
+	invoice := domain.Invoice{
+		Currency: "USD",
+		LineItems: []domain.InvoiceLineItem{
+			{
+				Quantity:        decimal.RequireFromString("3"),
+				UnitPrice:       money.MustParse("33.335", "USD"),
+				DiscountPercent: decimal.RequireFromString("10"),
+				TaxRate:         decimal.RequireFromString("7.5"),
+			},
+			{
+				Quantity:  decimal.RequireFromString("1.5"),
+				UnitPrice: money.MustParse("20", "USD"),
+			},
+		},
+	}
+
+	// Act
+	invoice.Calculate(2)
+
+	// Assert
+	first := invoice.LineItems[0]
+	assert.Equal(t, 1, first.Position)
+	assert.Equal(t, "100", first.Amount.Amount().String())
+	assert.Equal(t, "10", first.DiscountAmount.Amount().String())
+	assert.Equal(t, "6.75", first.TaxAmount.Amount().String())
+	assert.Equal(t, "96.75", first.Total.Amount().String())
+
+	second := invoice.LineItems[1]
+	assert.Equal(t, 2, second.Position)
+	assert.Equal(t, "30", second.Total.Amount().String())
+
+	assert.Equal(t, "130", invoice.Subtotal.Amount().String())
+	assert.Equal(t, "10", invoice.DiscountTotal.Amount().String())
+	assert.Equal(t, "6.75", invoice.TaxTotal.Amount().String())
+	assert.Equal(t, "126.75", invoice.Total.Amount().String())
+	assert.Equal(t, "USD", invoice.Total.Currency())
+}
+
+func TestInvoiceService_CreateInvoice(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	created, err := service.CreateInvoice(context.Background(), userID, orgID, testInvoiceDraft(now))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, orgID, created.OrganizationID)
+	assert.Equal(t, domain.InvoiceStatusDraft, created.Status)
+	assert.Equal(t, "USD", created.Currency)
+	assert.Equal(t, "Globex", created.CustomerName)
+	assert.Equal(t, "billing@globex.test", created.CustomerEmail)
+	assert.Equal(t, now, created.IssueDate)
+	assert.Equal(t, "116.75", created.Total.Amount().String())
+	assert.Equal(t, "INV-000001", created.DisplayNumber())
+	require.NotNil(t, created.CreatedBy)
+	assert.Equal(t, userID, *created.CreatedBy)
+}
+
+func TestInvoiceService_CreateInvoice_UsesAssetDecimals(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+
+	request := testInvoiceDraft(now)
+	request.Currency = "usdc"
+	request.LineItems = request.LineItems[:1]
+	request.LineItems[0].UnitPrice = money.MustParse("0.1234567", "USDC")
+	request.LineItems[0].DiscountPercent = decimal.Zero
+	request.LineItems[0].TaxRate = decimal.Zero
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	created, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "0.37037", created.Total.Amount().String())
+}
+
+func TestInvoiceService_CreateInvoice_InPaymentAsset(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+	usdt := domain.SupportedAsset{ID: uuid.New(), Chain: "tron", Symbol: "USDT", Decimals: 6, Enabled: true}
+	mockAssetService.GetAssetReturns(&usdt, nil)
+
+	request := testInvoiceDraft(now)
+	request.Currency = "usdt"
+	request.PaymentAssetID = &usdt.ID
+	request.PaymentAddress = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	request.LineItems = request.LineItems[:1]
+	request.LineItems[0].UnitPrice = money.MustParse("0.1234567", "USDT")
+	request.LineItems[0].DiscountPercent = decimal.Zero
+	request.LineItems[0].TaxRate = decimal.Zero
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	created, err := service.CreateInvoice(ctx, userID, orgID, request)
+
+	// Assert: rounded to the payment asset's decimals and checked as payable on its chain
+	require.NoError(t, err)
+	assert.Equal(t, "0.37037", created.Total.Amount().String())
+	assert.Equal(t, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", created.PaymentAddress)
+	require.Equal(t, 1, mockAssetService.ValidateAmountCallCount())
+	_, chain, total := mockAssetService.ValidateAmountArgsForCall(0)
+	assert.Equal(t, "tron", chain)
+	assert.Equal(t, created.Total, total)
+
+	// Act: the asset is disabled on its chain
+	mockAssetService.ValidateAmountReturns(nil, appErrors.NewValidationError("USDT on tron is currently disabled"))
+	_, disabledErr := service.CreateInvoice(ctx, userID, orgID, request)
+
+	// Act: a Tron asset is not paid to an EVM address
+	mockAssetService.ValidateAmountReturns(nil, nil)
+	request.PaymentAddress = "0x52908400098527886e0f7030069857d2e4169ee7"
+	_, evmErr := service.CreateInvoice(ctx, userID, orgID, request)
+
+	// Assert
+	assertAppErrorType(t, disabledErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, evmErr, appErrors.ErrorTypeValidation)
+}
+
+func TestInvoiceService_CreateInvoice_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*domain.Invoice)
+	}{
+		{"missing customer name", func(i *domain.Invoice) { i.CustomerName = " " }},
+		{"invalid email", func(i *domain.Invoice) { i.CustomerEmail = "Globex <billing@globex.test>" }},
+		{"missing due date", func(i *domain.Invoice) { i.DueDate = time.Time{} }},
+		{"due before issue", func(i *domain.Invoice) { i.IssueDate = i.DueDate.AddDate(0, 0, 1) }},
+		{"no line items", func(i *domain.Invoice) { i.LineItems = nil }},
+		{"missing description", func(i *domain.Invoice) { i.LineItems[0].Description = "" }},
+		{"zero quantity", func(i *domain.Invoice) { i.LineItems[0].Quantity = decimal.Zero }},
+		{"negative price", func(i *domain.Invoice) { i.LineItems[0].UnitPrice = money.MustParse("-1", "USD") }},
+		{"discount over 100", func(i *domain.Invoice) { i.LineItems[0].DiscountPercent = decimal.NewFromInt(101) }},
+		{"negative tax", func(i *domain.Invoice) { i.LineItems[0].TaxRate = decimal.NewFromInt(-1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+			mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+
+			request := testInvoiceDraft(now)
+			tt.modify(&request)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+			// Assert
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Zero(t, mockInvoiceRepo.CreateInvoiceCallCount())
+		})
+	}
+}
+
+func TestInvoiceService_CreateInvoice_RequiresFinanceRole(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	viewerID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{viewerID: domain.OrganizationRoleViewer})
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.CreateInvoice(context.Background(), viewerID, orgID, testInvoiceDraft(now))
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Zero(t, mockInvoiceRepo.CreateInvoiceCallCount())
+}
+
+func TestInvoiceService_UpdateInvoice_OnlyDrafts(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleAdmin})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.UpdateInvoice(context.Background(), userID, orgID, invoice.ID, testInvoiceDraft(now))
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Zero(t, mockInvoiceRepo.UpdateDraftInvoiceCallCount())
+}
+
+func TestInvoiceService_SendInvoice(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockInvoiceRepo.MarkSentReturns(withStatus(invoice, domain.InvoiceStatusSent), nil)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	sent, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusSent, sent.Status)
+
+	_, id, at, issued := mockInvoiceRepo.MarkSentArgsForCall(0)
+	assert.Equal(t, invoice.ID, id)
+	assert.Equal(t, now, at)
+
+	// The issuance entry is validated and posted with the status change, not separately
+	require.NotNil(t, issued)
+	assert.Equal(t, "invoice:"+invoice.ID.String()+":issued", issued.ExternalReference)
+	assert.Equal(t, now, issued.OccurredAt)
+	assert.Equal(t, 1, mockLedgerService.ValidateEntryCallCount())
+	assert.Zero(t, mockLedgerService.PostEntryCallCount())
+
+	require.Equal(t, 1, mockRenderer.RenderInvoiceCallCount())
+	_, document := mockRenderer.RenderInvoiceArgsForCall(0)
+	assert.Equal(t, invoice.ID, document.Invoice.ID)
+	assert.Equal(t, "Acme", document.Organization.Name)
+
+	require.Equal(t, 1, mockEmailService.SendInvoiceCallCount())
+	_, emailed, orgName, pdf := mockEmailService.SendInvoiceArgsForCall(0)
+	assert.Equal(t, invoice.ID, emailed.ID)
+	assert.Equal(t, "Acme", orgName)
+	assert.Equal(t, []byte("%PDF-1.3"), pdf)
+}
+
+func TestInvoiceService_SendInvoice_ResendsOpenInvoice(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusOverdue)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	sent, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusOverdue, sent.Status)
+	assert.Zero(t, mockInvoiceRepo.MarkSentCallCount())
+	assert.Equal(t, 1, mockEmailService.SendInvoiceCallCount())
+}
+
+func TestInvoiceService_SendInvoice_Errors(t *testing.T) {
+	t.Run("void invoice", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusVoid)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+		mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+		mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Zero(t, mockEmailService.SendInvoiceCallCount())
+	})
+
+	t.Run("sent concurrently", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+		mockInvoiceRepo.MarkSentReturns(nil, nil)
+		mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+		mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+		stubInvoiceLedgerAccounts(mockLedgerService)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Zero(t, mockEmailService.SendInvoiceCallCount())
+	})
+
+	t.Run("rendering fails", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+		mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+		mockRenderer.RenderInvoiceReturns(nil, errors.New("render failed"))
+		stubInvoiceLedgerAccounts(mockLedgerService)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+		// Assert
+		require.Error(t, err)
+		assert.Zero(t, mockInvoiceRepo.MarkSentCallCount())
+		assert.Zero(t, mockEmailService.SendInvoiceCallCount())
+	})
+
+	t.Run("another organization's invoice", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(uuid.New(), domain.InvoiceStatusDraft)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.SendInvoice(context.Background(), userID, orgID, invoice.ID)
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeNotFound)
+	})
+}
+
+func TestInvoiceService_RenderInvoicePDF(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	viewerID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{viewerID: domain.OrganizationRoleViewer})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	assetID := uuid.New()
+	invoice.PaymentAssetID = &assetID
+	invoice.PaymentAddress = "0x00000000000000000000000000000000000000aA"
+	mockAssetService.GetAssetReturns(&domain.SupportedAsset{ID: assetID, Symbol: "USDC", Chain: "base", Enabled: true}, nil)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	pdf, filename, err := service.RenderInvoicePDF(context.Background(), viewerID, orgID, invoice.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []byte("%PDF-1.3"), pdf)
+	assert.Equal(t, "INV-000007.pdf", filename)
+
+	_, document := mockRenderer.RenderInvoiceArgsForCall(0)
+	require.NotNil(t, document.PaymentAsset)
+	assert.Equal(t, "USDC", document.PaymentAsset.Symbol)
+}
+
+func TestInvoiceService_CreateInvoice_PaymentDetails(t *testing.T) {
+	assetID := uuid.New()
+	address := "0x52908400098527886e0f7030069857d2e4169ee7"
+
+	tests := []struct {
+		name    string
+		assetID *uuid.UUID
+		address string
+		asset   *domain.SupportedAsset
+		wantErr bool
+	}{
+		{name: "valid", assetID: &assetID, address: address, asset: &domain.SupportedAsset{ID: assetID, Enabled: true}},
+		{name: "address without asset", address: address, wantErr: true},
+		{name: "asset without address", assetID: &assetID, asset: &domain.SupportedAsset{ID: assetID, Enabled: true}, wantErr: true},
+		{name: "disabled asset", assetID: &assetID, address: address, asset: &domain.SupportedAsset{ID: assetID}, wantErr: true},
+		{name: "invalid address", assetID: &assetID, address: "0x1234", asset: &domain.SupportedAsset{ID: assetID, Enabled: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+			mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+				invoice.Number = 1
+				return &invoice, nil
+			}
+			mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+			mockAssetService.GetAssetReturns(tt.asset, nil)
+
+			request := testInvoiceDraft(now)
+			request.PaymentAssetID = tt.assetID
+			request.PaymentAddress = tt.address
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			created, err := service.CreateInvoice(context.Background(), userID, orgID, request)
+
+			// Assert
+			if tt.wantErr {
+				assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "0x52908400098527886E0F7030069857D2E4169EE7", created.PaymentAddress)
+		})
+	}
+}
+
+func TestInvoiceService_MarkPaid(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusViewed)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockInvoiceRepo.MarkPaidReturns(withStatus(invoice, domain.InvoiceStatusPaid), nil)
+	mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	paidAt := now.Add(-time.Hour)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	paid, err := service.MarkPaid(context.Background(), userID, orgID, invoice.ID, &paidAt, " 0xabc ")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusPaid, paid.Status)
+
+	_, id, balanceDue, at, reference, settlement := mockInvoiceRepo.MarkPaidArgsForCall(0)
+	assert.Equal(t, invoice.ID, id)
+	assert.Equal(t, "100 USD", balanceDue.String())
+	assert.Equal(t, paidAt, at)
+	assert.Equal(t, "0xabc", reference)
+	assert.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+
+	// The balance due clears the receivable the invoice was issued to
+	require.Equal(t, 1, mockLedgerService.PostEntryCallCount())
+	_, issued := mockLedgerService.PostEntryArgsForCall(0)
+	require.NotNil(t, settlement)
+	assert.Equal(t, "invoice:"+invoice.ID.String()+":paid", settlement.ExternalReference)
+	require.Len(t, settlement.Postings, 2)
+	assert.Equal(t, "100", settlement.Postings[0].Amount.Amount().String())
+	assert.Equal(t, issued.Postings[0].AccountID, settlement.Postings[1].AccountID)
+	assert.Equal(t, "-100", settlement.Postings[1].Amount.Amount().String())
+}
+
+func TestInvoiceService_MarkPaid_Errors(t *testing.T) {
+	t.Run("draft invoice", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusDraft)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.MarkPaid(context.Background(), userID, orgID, invoice.ID, nil, "")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	})
+
+	t.Run("future payment date", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+		paidAt := now.Add(time.Hour)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.MarkPaid(context.Background(), userID, orgID, invoice.ID, &paidAt, "")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+		assert.Zero(t, mockInvoiceRepo.MarkPaidCallCount())
+	})
+
+	t.Run("unbalanced settlement", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+		mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+		stubInvoiceLedgerAccounts(mockLedgerService)
+		mockLedgerService.ValidateEntryReturns(appErrors.NewValidationError("entry does not balance"))
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.MarkPaid(context.Background(), userID, orgID, invoice.ID, nil, "")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+		assert.Zero(t, mockInvoiceRepo.MarkPaidCallCount())
+	})
+
+	t.Run("balance changed", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+		mockInvoiceRepo.MarkPaidReturns(nil, nil)
+		mockOrgService.GetOrganizationReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+		stubInvoiceLedgerAccounts(mockLedgerService)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.MarkPaid(context.Background(), userID, orgID, invoice.ID, nil, "")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	})
+}
+
+func TestInvoiceService_VoidInvoice(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	userID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockInvoiceRepo.VoidReturns(withStatus(invoice, domain.InvoiceStatusVoid), nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	voided, err := service.VoidInvoice(context.Background(), userID, orgID, invoice.ID, " Duplicate ")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusVoid, voided.Status)
+
+	_, _, at, reason, reversal := mockInvoiceRepo.VoidArgsForCall(0)
+	assert.Equal(t, now, at)
+	assert.Equal(t, "Duplicate", reason)
+	assert.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+
+	// The sent invoice is posted to the ledger first, then reversed in full
+	require.Equal(t, 1, mockLedgerService.PostEntryCallCount())
+	_, issued := mockLedgerService.PostEntryArgsForCall(0)
+	assert.Equal(t, "invoice:"+invoice.ID.String()+":issued", issued.ExternalReference)
+	require.NotNil(t, reversal)
+	assert.Equal(t, "invoice:"+invoice.ID.String()+":voided", reversal.ExternalReference)
+	require.Len(t, reversal.Postings, 2)
+	assert.Equal(t, issued.Postings[0].AccountID, reversal.Postings[1].AccountID)
+	assert.Equal(t, "-100", reversal.Postings[1].Amount.Amount().String())
+}
+
+func TestInvoiceService_VoidInvoice_Errors(t *testing.T) {
+	t.Run("missing reason", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.VoidInvoice(context.Background(), userID, orgID, invoice.ID, " ")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	})
+
+	t.Run("paid invoice", func(t *testing.T) {
+		// Arrange
+		mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+		mockClientRepo := new(mocks.FakeClientRepository)
+		mockOrgService := new(mocks.FakeOrganizationService)
+		mockAssetService := new(mocks.FakeAssetService)
+		mockEmailService := new(mocks.FakeEmailService)
+		mockRenderer := new(mocks.FakeInvoiceRenderer)
+		mockSecurityRepo := new(mocks.FakeSecurityRepository)
+		mockLedgerService := new(mocks.FakeLedgerService)
+		mockTaxService := new(mocks.FakeTaxService)
+
+		now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+		orgID := uuid.New()
+		userID := uuid.New()
+		stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: domain.OrganizationRoleFinance})
+		invoice := testStoredInvoice(orgID, domain.InvoiceStatusPaid)
+		mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+		cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+		service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+		service.now = func() time.Time { return now }
+
+		// Act
+		_, err := service.VoidInvoice(context.Background(), userID, orgID, invoice.ID, "Duplicate")
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+		assert.Zero(t, mockInvoiceRepo.VoidCallCount())
+	})
+}
+
+func TestInvoiceService_MarkOverdueInvoices(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	mockInvoiceRepo.MarkOverdueReturnsOnCall(0, make([]domain.Invoice, invoiceOverdueBatchSize), nil)
+	mockInvoiceRepo.MarkOverdueReturnsOnCall(1, make([]domain.Invoice, 3), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	marked, err := service.MarkOverdueInvoices(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, invoiceOverdueBatchSize+3, marked)
+	assert.Equal(t, 2, mockInvoiceRepo.MarkOverdueCallCount())
+
+	_, at, limit := mockInvoiceRepo.MarkOverdueArgsForCall(0)
+	assert.Equal(t, now, at)
+	assert.Equal(t, invoiceOverdueBatchSize, limit)
+}
+
+func TestInvoiceService_CreateInvoice_FromClient(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockAssetService.ListAssetsReturns([]domain.SupportedAsset{{Symbol: "USDC", Decimals: 6}}, nil)
+	client := domain.Client{
+		ID:                uuid.New(),
+		OrganizationID:    orgID,
+		Name:              "Globex",
+		Email:             "billing@globex.test",
+		BillingAddress:    domain.ClientAddress{Line1: "1 Main St", City: "Springfield"},
+		PreferredCurrency: "USD",
+		PaymentTermsDays:  14,
+	}
+	stubClientDirectory(mockClientRepo, client)
+
+	draft := testInvoiceDraft(now)
+	draft.ClientID = &client.ID
+	draft.Currency = ""
+	draft.CustomerName = ""
+	draft.CustomerEmail = ""
+	draft.DueDate = time.Time{}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	invoice, err := service.CreateInvoice(ctx, financeID, orgID, draft)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, client.ID, *invoice.ClientID)
+	assert.Equal(t, "Globex", invoice.CustomerName)
+	assert.Equal(t, "billing@globex.test", invoice.CustomerEmail)
+	assert.Equal(t, "1 Main St\nSpringfield", invoice.CustomerAddress)
+	assert.Equal(t, "USD", invoice.Currency)
+	assert.Equal(t, now.AddDate(0, 0, 14), invoice.DueDate)
+	assert.Equal(t, "USD", invoice.Total.Currency())
+
+	// Act: details given on the invoice win over the client's
+	draft = testInvoiceDraft(now)
+	draft.ClientID = &client.ID
+	invoice, err = service.CreateInvoice(ctx, financeID, orgID, draft)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, 30), invoice.DueDate)
+	assert.Equal(t, "Globex", invoice.CustomerName)
+
+	// Act: a client of another organization is not found
+	other := uuid.New()
+	draft.ClientID = &other
+	_, err = service.CreateInvoice(ctx, financeID, orgID, draft)
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, appErrors.ErrorTypeValidation, appErrors.GetErrorType(err))
+}
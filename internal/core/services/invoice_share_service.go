@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	signedToken "github.com/demola234/defifundr/pkg/signed_token"
+	"github.com/google/uuid"
+)
+
+// maxInvoiceShareTTL is the longest a share link can be valid for
+const maxInvoiceShareTTL = 365 * 24 * time.Hour
+
+type invoiceShareService struct {
+	shareRepo    ports.InvoiceShareRepository
+	invoiceRepo  ports.InvoiceRepository
+	orgService   ports.OrganizationService
+	assetService ports.AssetService
+	renderer     ports.InvoiceRenderer
+	securityRepo ports.SecurityRepository
+	shareSigner  *signedToken.Signer
+	config       config.Config
+	logger       logging.Logger
+	now          func() time.Time
+}
+
+// NewInvoiceShareService creates a new invoice share service. Share links
+// carry tokens signed by shareSigner.
+func NewInvoiceShareService(
+	shareRepo ports.InvoiceShareRepository,
+	invoiceRepo ports.InvoiceRepository,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	renderer ports.InvoiceRenderer,
+	securityRepo ports.SecurityRepository,
+	shareSigner *signedToken.Signer,
+	config config.Config,
+	logger logging.Logger,
+) ports.InvoiceShareService {
+	return &invoiceShareService{
+		shareRepo:    shareRepo,
+		invoiceRepo:  invoiceRepo,
+		orgService:   orgService,
+		assetService: assetService,
+		renderer:     renderer,
+		securityRepo: securityRepo,
+		shareSigner:  shareSigner,
+		config:       config,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// CreateShareLink issues a public link to a sent invoice for a customer
+// without an account, valid until expiresAt or for InvoiceShareTTL. Finance
+// managers may share invoices. The link's URL is only returned here; a lost
+// link has to be revoked and replaced.
+func (s *invoiceShareService) CreateShareLink(ctx context.Context, userID, orgID, invoiceID uuid.UUID, expiresAt *time.Time) (*domain.InvoiceShareLink, string, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, "", err
+	}
+
+	invoice, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID)
+	if err != nil {
+		return nil, "", err
+	}
+	switch invoice.Status {
+	case domain.InvoiceStatusDraft:
+		return nil, "", appErrors.NewConflictError("send the invoice before sharing it")
+	case domain.InvoiceStatusVoid:
+		return nil, "", appErrors.NewConflictError("a void invoice cannot be shared")
+	}
+
+	now := s.now()
+	expiry := now.Add(s.config.InvoiceShareTTL)
+	if expiresAt != nil {
+		if !expiresAt.After(now) {
+			return nil, "", appErrors.NewValidationError("expiry must be in the future")
+		}
+		if expiresAt.After(now.Add(maxInvoiceShareTTL)) {
+			return nil, "", appErrors.NewValidationError("a share link can be valid for at most a year")
+		}
+		expiry = *expiresAt
+	}
+
+	// Tokens carry their expiry in whole seconds
+	link := domain.InvoiceShareLink{
+		ID:        uuid.New(),
+		InvoiceID: invoice.ID,
+		ExpiresAt: expiry.Truncate(time.Second),
+		CreatedBy: &userID,
+	}
+	token := s.shareSigner.Sign(link.ID.String(), link.ExpiresAt)
+	link.TokenHash = hashShareToken(token)
+
+	created, err := s.shareRepo.CreateShareLink(ctx, link)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.logSecurityEvent(ctx, "invoice_share_link_created", userID, map[string]interface{}{
+		"organization_id": orgID,
+		"invoice_id":      invoice.ID,
+		"link_id":         created.ID,
+		"expires_at":      created.ExpiresAt,
+	})
+
+	return created, s.shareLink(token), nil
+}
+
+// ListShareLinks lists the links an invoice has been shared with. Any member
+// may view them.
+func (s *invoiceShareService) ListShareLinks(ctx context.Context, userID, orgID, invoiceID uuid.UUID) ([]domain.InvoiceShareLink, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	if _, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	return s.shareRepo.ListShareLinks(ctx, invoiceID)
+}
+
+// RevokeShareLink stops a share link from opening the invoice
+func (s *invoiceShareService) RevokeShareLink(ctx context.Context, userID, orgID, invoiceID, linkID uuid.UUID) (*domain.InvoiceShareLink, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	if _, err := getOrganizationInvoice(ctx, s.invoiceRepo, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.shareRepo.RevokeShareLink(ctx, invoiceID, linkID, userID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if revoked == nil {
+		link, err := s.shareRepo.GetShareLink(ctx, linkID)
+		if err != nil {
+			return nil, err
+		}
+		if link == nil || link.InvoiceID != invoiceID {
+			return nil, appErrors.NewNotFoundError("share link not found")
+		}
+		return nil, appErrors.NewConflictError("share link has already been revoked")
+	}
+
+	s.logSecurityEvent(ctx, "invoice_share_link_revoked", userID, map[string]interface{}{
+		"organization_id": orgID,
+		"invoice_id":      invoiceID,
+		"link_id":         linkID,
+	})
+
+	return revoked, nil
+}
+
+// GetSharedInvoice returns the invoice behind a share link, read-only, with
+// who issued it and the asset it is paid in. Opening the link records a view,
+// which moves a sent invoice to viewed.
+func (s *invoiceShareService) GetSharedInvoice(ctx context.Context, token string) (*domain.SharedInvoice, error) {
+	link, invoice, org, err := s.openShareLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := &domain.SharedInvoice{
+		Invoice:           *invoice,
+		OrganizationName:  org.Name,
+		OrganizationTaxID: org.TaxID,
+		LinkExpiresAt:     link.ExpiresAt,
+	}
+
+	if invoice.PaymentAssetID != nil {
+		asset, err := s.assetService.GetAsset(ctx, *invoice.PaymentAssetID)
+		if err != nil {
+			return nil, err
+		}
+		shared.PaymentAsset = asset
+	}
+
+	return shared, nil
+}
+
+// RenderSharedInvoicePDF renders the invoice behind a share link as a PDF and
+// returns it with its file name. Downloading it counts as a view.
+func (s *invoiceShareService) RenderSharedInvoicePDF(ctx context.Context, token string) ([]byte, string, error) {
+	_, invoice, org, err := s.openShareLink(ctx, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pdf, err := renderInvoiceDocument(ctx, s.assetService, s.renderer, *invoice, *org)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pdf, invoice.PDFFilename(), nil
+}
+
+// openShareLink resolves a share link, records the view and loads the invoice
+// as it is after the view with the organization that issued it
+func (s *invoiceShareService) openShareLink(ctx context.Context, token string) (*domain.InvoiceShareLink, *domain.Invoice, *domain.Organization, error) {
+	link, err := s.resolveShareToken(ctx, token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// A failure to count the view does not keep the customer from the invoice
+	if err := s.shareRepo.RecordShareLinkView(ctx, *link, s.now()); err != nil {
+		s.logger.Error("Failed to record invoice share link view", err, map[string]interface{}{
+			"invoice_id": link.InvoiceID,
+			"link_id":    link.ID,
+		})
+	}
+
+	invoice, err := s.invoiceRepo.GetInvoice(ctx, link.InvoiceID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if invoice == nil {
+		return nil, nil, nil, appErrors.NewNotFoundError("invoice not found")
+	}
+
+	org, err := s.orgService.GetOrganizationByID(ctx, invoice.OrganizationID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return link, invoice, org, nil
+}
+
+// resolveShareToken verifies a link's signature and expiry and loads it,
+// rejecting links that were revoked
+func (s *invoiceShareService) resolveShareToken(ctx context.Context, token string) (*domain.InvoiceShareLink, error) {
+	token = strings.TrimSpace(token)
+
+	subject, err := s.shareSigner.Verify(token, s.now())
+	if errors.Is(err, signedToken.ErrExpiredToken) {
+		return nil, appErrors.NewValidationError("invoice link has expired, ask the sender for a new one")
+	}
+	if err != nil {
+		return nil, appErrors.NewValidationError("invalid invoice link")
+	}
+
+	linkID, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, appErrors.NewValidationError("invalid invoice link")
+	}
+
+	link, err := s.shareRepo.GetShareLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil || link.TokenHash != hashShareToken(token) {
+		return nil, appErrors.NewValidationError("invalid invoice link")
+	}
+
+	if link.RevokedAt != nil {
+		return nil, appErrors.NewValidationError("invoice link has been revoked")
+	}
+	if !link.IsActive(s.now()) {
+		return nil, appErrors.NewValidationError("invoice link has expired, ask the sender for a new one")
+	}
+
+	return link, nil
+}
+
+// shareLink builds the public URL a share link is handed out as
+func (s *invoiceShareService) shareLink(token string) string {
+	return fmt.Sprintf("%s?token=%s", s.config.InvoiceShareURL, url.QueryEscape(token))
+}
+
+func (s *invoiceShareService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
+
+// hashShareToken hashes a share link token so only the digest is stored
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	signedToken "github.com/demola234/defifundr/pkg/signed_token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubShareLinkStore keeps the share links the repository is given in memory
+func stubShareLinkStore(repo *mocks.FakeInvoiceShareRepository) map[uuid.UUID]*domain.InvoiceShareLink {
+	links := make(map[uuid.UUID]*domain.InvoiceShareLink)
+
+	repo.CreateShareLinkStub = func(ctx context.Context, link domain.InvoiceShareLink) (*domain.InvoiceShareLink, error) {
+		links[link.ID] = &link
+		return &link, nil
+	}
+	repo.GetShareLinkStub = func(ctx context.Context, id uuid.UUID) (*domain.InvoiceShareLink, error) {
+		if link, ok := links[id]; ok {
+			stored := *link
+			return &stored, nil
+		}
+		return nil, nil
+	}
+	repo.RevokeShareLinkStub = func(ctx context.Context, invoiceID, id, revokedBy uuid.UUID, at time.Time) (*domain.InvoiceShareLink, error) {
+		link, ok := links[id]
+		if !ok || link.InvoiceID != invoiceID || link.RevokedAt != nil {
+			return nil, nil
+		}
+		link.RevokedAt = &at
+		link.RevokedBy = &revokedBy
+		stored := *link
+		return &stored, nil
+	}
+	repo.RecordShareLinkViewStub = func(ctx context.Context, link domain.InvoiceShareLink, at time.Time) error {
+		links[link.ID].ViewCount++
+		return nil
+	}
+
+	return links
+}
+
+// shareTokenFromURL returns the token carried by a share link URL
+func shareTokenFromURL(t *testing.T, link string) string {
+	t.Helper()
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	return parsed.Query().Get("token")
+}
+
+func TestInvoiceShareService_CreateShareLink(t *testing.T) {
+	// Arrange
+	mockShareRepo := new(mocks.FakeInvoiceShareRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "invoice_share_link")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	links := stubShareLinkStore(mockShareRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvoiceShareTTL: 720 * time.Hour, InvoiceShareURL: "https://app.example.com/invoices/shared"}
+	service := NewInvoiceShareService(mockShareRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockRenderer, mockSecurityRepo, signer, cfg, logging.New(&cfg)).(*invoiceShareService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	link, shareURL, err := service.CreateShareLink(context.Background(), financeID, orgID, invoice.ID, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(shareURL, "https://app.example.com/invoices/shared?token="))
+	assert.Equal(t, invoice.ID, link.InvoiceID)
+	assert.Equal(t, now.Add(720*time.Hour), link.ExpiresAt)
+	assert.Equal(t, financeID, *link.CreatedBy)
+
+	// Only a hash of the token is stored
+	token := shareTokenFromURL(t, shareURL)
+	assert.NotContains(t, links[link.ID].TokenHash, token)
+	assert.Equal(t, hashShareToken(token), links[link.ID].TokenHash)
+
+	require.Equal(t, 1, mockSecurityRepo.LogSecurityEventCallCount())
+	_, event := mockSecurityRepo.LogSecurityEventArgsForCall(0)
+	assert.Equal(t, "invoice_share_link_created", event.EventType)
+}
+
+func TestInvoiceShareService_CreateShareLink_Errors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		role      domain.OrganizationRole
+		status    domain.InvoiceStatus
+		expiresIn time.Duration
+		errType   appErrors.ErrorType
+	}{
+		{name: "viewer", role: domain.OrganizationRoleViewer, status: domain.InvoiceStatusSent, errType: appErrors.ErrorTypeForbidden},
+		{name: "draft", role: domain.OrganizationRoleAdmin, status: domain.InvoiceStatusDraft, errType: appErrors.ErrorTypeConflict},
+		{name: "void", role: domain.OrganizationRoleAdmin, status: domain.InvoiceStatusVoid, errType: appErrors.ErrorTypeConflict},
+		{name: "expiry_in_past", role: domain.OrganizationRoleAdmin, status: domain.InvoiceStatusSent, expiresIn: -time.Minute, errType: appErrors.ErrorTypeValidation},
+		{name: "expiry_too_far", role: domain.OrganizationRoleAdmin, status: domain.InvoiceStatusSent, expiresIn: 400 * 24 * time.Hour, errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockShareRepo := new(mocks.FakeInvoiceShareRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "invoice_share_link")
+			require.NoError(t, err)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			userID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{userID: tc.role})
+			invoice := testStoredInvoice(orgID, tc.status)
+			mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+
+			var expiresAt *time.Time
+			if tc.expiresIn != 0 {
+				at := now.Add(tc.expiresIn)
+				expiresAt = &at
+			}
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvoiceShareTTL: 720 * time.Hour, InvoiceShareURL: "https://app.example.com/invoices/shared"}
+			service := NewInvoiceShareService(mockShareRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockRenderer, mockSecurityRepo, signer, cfg, logging.New(&cfg)).(*invoiceShareService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, _, err = service.CreateShareLink(context.Background(), userID, orgID, invoice.ID, expiresAt)
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, tc.errType, appErrors.GetErrorType(err))
+			assert.Zero(t, mockShareRepo.CreateShareLinkCallCount())
+		})
+	}
+}
+
+func TestInvoiceShareService_GetSharedInvoice(t *testing.T) {
+	// Arrange
+	mockShareRepo := new(mocks.FakeInvoiceShareRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "invoice_share_link")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+	links := stubShareLinkStore(mockShareRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvoiceShareTTL: 720 * time.Hour, InvoiceShareURL: "https://app.example.com/invoices/shared"}
+	service := NewInvoiceShareService(mockShareRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockRenderer, mockSecurityRepo, signer, cfg, logging.New(&cfg)).(*invoiceShareService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	link, shareURL, err := service.CreateShareLink(ctx, adminID, orgID, invoice.ID, nil)
+	require.NoError(t, err)
+
+	// Act
+	shared, err := service.GetSharedInvoice(ctx, shareTokenFromURL(t, shareURL))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, invoice.ID, shared.Invoice.ID)
+	assert.Equal(t, "Acme", shared.OrganizationName)
+	assert.Equal(t, link.ExpiresAt, shared.LinkExpiresAt)
+	assert.Equal(t, 1, links[link.ID].ViewCount)
+
+	require.Equal(t, 1, mockShareRepo.RecordShareLinkViewCallCount())
+	_, viewed, at := mockShareRepo.RecordShareLinkViewArgsForCall(0)
+	assert.Equal(t, invoice.ID, viewed.InvoiceID)
+	assert.Equal(t, now, at)
+
+	// Act: the PDF counts as another view
+	_, filename, err := service.RenderSharedInvoicePDF(ctx, shareTokenFromURL(t, shareURL))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "INV-000007.pdf", filename)
+	assert.Equal(t, 2, links[link.ID].ViewCount)
+}
+
+func TestInvoiceShareService_GetSharedInvoice_RejectsUnusableLinks(t *testing.T) {
+	// Arrange
+	mockShareRepo := new(mocks.FakeInvoiceShareRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "invoice_share_link")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+	stubShareLinkStore(mockShareRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvoiceShareTTL: 720 * time.Hour, InvoiceShareURL: "https://app.example.com/invoices/shared"}
+	service := NewInvoiceShareService(mockShareRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockRenderer, mockSecurityRepo, signer, cfg, logging.New(&cfg)).(*invoiceShareService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	expiresAt := now.Add(time.Hour)
+	expiring, expiringURL, err := service.CreateShareLink(ctx, adminID, orgID, invoice.ID, &expiresAt)
+	require.NoError(t, err)
+	revoked, revokedURL, err := service.CreateShareLink(ctx, adminID, orgID, invoice.ID, nil)
+	require.NoError(t, err)
+	_, err = service.RevokeShareLink(ctx, adminID, orgID, invoice.ID, revoked.ID)
+	require.NoError(t, err)
+
+	// Signed for a link that was never stored
+	unknown := signer.Sign(uuid.NewString(), now.Add(time.Hour))
+
+	// Act
+	_, tamperedErr := service.GetSharedInvoice(ctx, shareTokenFromURL(t, expiringURL)+"x")
+	_, unknownErr := service.GetSharedInvoice(ctx, unknown)
+	_, revokedErr := service.GetSharedInvoice(ctx, shareTokenFromURL(t, revokedURL))
+	now = expiring.ExpiresAt
+	_, expiredErr := service.GetSharedInvoice(ctx, shareTokenFromURL(t, expiringURL))
+
+	// Assert
+	assertAppErrorType(t, tamperedErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, unknownErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, revokedErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, expiredErr, appErrors.ErrorTypeValidation)
+	assert.Zero(t, mockShareRepo.RecordShareLinkViewCallCount())
+}
+
+func TestInvoiceShareService_RevokeShareLink(t *testing.T) {
+	// Arrange
+	mockShareRepo := new(mocks.FakeInvoiceShareRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	signer, err := signedToken.NewSigner("0123456789abcdef0123456789abcdef", "invoice_share_link")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{adminID: domain.OrganizationRoleAdmin})
+	invoice := testStoredInvoice(orgID, domain.InvoiceStatusSent)
+	mockInvoiceRepo.GetInvoiceReturns(invoice, nil)
+	stubShareLinkStore(mockShareRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", InvoiceShareTTL: 720 * time.Hour, InvoiceShareURL: "https://app.example.com/invoices/shared"}
+	service := NewInvoiceShareService(mockShareRepo, mockInvoiceRepo, mockOrgService, mockAssetService, mockRenderer, mockSecurityRepo, signer, cfg, logging.New(&cfg)).(*invoiceShareService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	link, _, err := service.CreateShareLink(ctx, adminID, orgID, invoice.ID, nil)
+	require.NoError(t, err)
+
+	// Act
+	revoked, err := service.RevokeShareLink(ctx, adminID, orgID, invoice.ID, link.ID)
+	_, againErr := service.RevokeShareLink(ctx, adminID, orgID, invoice.ID, link.ID)
+	_, unknownErr := service.RevokeShareLink(ctx, adminID, orgID, invoice.ID, uuid.New())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, now, *revoked.RevokedAt)
+	assert.Equal(t, adminID, *revoked.RevokedBy)
+	assert.Equal(t, appErrors.ErrorTypeConflict, appErrors.GetErrorType(againErr))
+	assert.Equal(t, appErrors.ErrorTypeNotFound, appErrors.GetErrorType(unknownErr))
+}
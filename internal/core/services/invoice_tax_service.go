@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+)
+
+// prepareInvoiceTax checks the customer's tax details and, on an invoice with
+// automatic tax, sets every line to the rate the tax engine works out between
+// the organization and the customer on the issue date. Rates entered by hand
+// are kept when automatic tax is off.
+func (s *invoiceService) prepareInvoiceTax(ctx context.Context, invoice *domain.Invoice) error {
+	invoice.CustomerCountry = strings.ToUpper(strings.TrimSpace(invoice.CustomerCountry))
+	if invoice.CustomerCountry != "" && !isCountryCode(invoice.CustomerCountry) {
+		return appErrors.NewValidationError("customer country must be a two-letter ISO 3166-1 code")
+	}
+
+	invoice.CustomerTaxID = domain.NormalizeTaxID(invoice.CustomerTaxID)
+	if len(invoice.CustomerTaxID) > 64 {
+		return appErrors.NewValidationError("customer tax ID can be at most 64 characters")
+	}
+
+	invoice.TaxTreatment = ""
+	invoice.TaxName = ""
+	invoice.TaxNote = ""
+	invoice.TaxRatesVersion = ""
+	if !invoice.AutomaticTax {
+		return nil
+	}
+
+	org, err := s.orgService.GetOrganizationByID(ctx, invoice.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	seller := domain.TaxParty{Country: org.Country, TaxID: org.TaxID}
+	buyer := domain.TaxParty{Country: invoice.CustomerCountry, TaxID: invoice.CustomerTaxID}
+
+	determination, err := s.taxService.Determine(ctx, seller, buyer, invoice.IssueDate)
+	if err != nil {
+		// A country missing from the rate table is a problem with the
+		// invoice's details rather than a missing resource
+		var appErr *appErrors.AppError
+		if errors.As(err, &appErr) && appErr.ErrorType == appErrors.ErrorTypeNotFound {
+			return appErrors.NewValidationError("automatic tax is not available: " + appErr.Details)
+		}
+		return err
+	}
+
+	for i := range invoice.LineItems {
+		invoice.LineItems[i].TaxRate = determination.Rate
+	}
+	invoice.TaxTreatment = determination.Treatment
+	invoice.TaxName = determination.TaxName
+	invoice.TaxNote = determination.Note
+	invoice.TaxRatesVersion = determination.RatesVersion
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoiceService_CreateInvoice_AutomaticTax(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "DE", TaxID: "DE123456789"}, nil)
+	mockTaxService.DetermineReturns(&domain.TaxDetermination{
+		Treatment:    domain.TaxTreatmentReverseCharge,
+		Country:      "DE",
+		TaxName:      "VAT",
+		Rate:         decimal.Zero,
+		Note:         "Reverse charge: the customer accounts for VAT",
+		RatesVersion: "2025.1",
+	}, nil)
+
+	draft := testInvoiceDraft(now)
+	draft.AutomaticTax = true
+	draft.CustomerCountry = " fr "
+	draft.CustomerTaxID = "fr 12-345678901"
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	invoice, err := service.CreateInvoice(context.Background(), financeID, orgID, draft)
+
+	// Assert
+	require.NoError(t, err)
+
+	_, seller, buyer, at := mockTaxService.DetermineArgsForCall(0)
+	assert.Equal(t, domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, seller)
+	assert.Equal(t, domain.TaxParty{Country: "FR", TaxID: "FR12345678901"}, buyer)
+	assert.Equal(t, now, at)
+
+	// The engine's rate replaces the one entered on the line
+	assert.True(t, invoice.LineItems[0].TaxRate.IsZero())
+	assert.True(t, invoice.TaxTotal.IsZero())
+	assert.Equal(t, domain.TaxTreatmentReverseCharge, invoice.TaxTreatment)
+	assert.Equal(t, "Reverse charge: the customer accounts for VAT", invoice.TaxNote)
+	assert.Equal(t, "2025.1", invoice.TaxRatesVersion)
+
+	lines := invoice.TaxBreakdown()
+	require.Len(t, lines, 1)
+	assert.Equal(t, "VAT reverse charge", lines[0].Label)
+	assert.Equal(t, invoice.Total.Amount().String(), lines[0].Taxable.Amount().String())
+}
+
+func TestInvoiceService_CreateInvoice_AutomaticTaxFromClient(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "GB", TaxID: "GB123456789"}, nil)
+	mockTaxService.DetermineReturns(&domain.TaxDetermination{Treatment: domain.TaxTreatmentStandard, Country: "GB", TaxName: "VAT", Rate: decimal.RequireFromString("20"), RatesVersion: "2025.1"}, nil)
+	client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test", TaxID: "GB987654321", BillingAddress: domain.ClientAddress{Country: "GB"}}
+	stubClientDirectory(mockClientRepo, client)
+
+	draft := testInvoiceDraft(now)
+	draft.ClientID = &client.ID
+	draft.AutomaticTax = true
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	invoice, err := service.CreateInvoice(context.Background(), financeID, orgID, draft)
+
+	// Assert
+	require.NoError(t, err)
+
+	_, _, buyer, _ := mockTaxService.DetermineArgsForCall(0)
+	assert.Equal(t, domain.TaxParty{Country: "GB", TaxID: "GB987654321"}, buyer)
+	assert.Equal(t, "20", invoice.LineItems[1].TaxRate.String())
+	assert.Equal(t, "4", invoice.LineItems[1].TaxAmount.Amount().String())
+
+	lines := invoice.TaxBreakdown()
+	require.Len(t, lines, 1)
+	assert.Equal(t, "VAT 20%", lines[0].Label)
+	assert.Equal(t, invoice.TaxTotal, lines[0].Tax)
+}
+
+func TestInvoiceService_CreateInvoice_ManualTax(t *testing.T) {
+	// Arrange
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+
+	draft := testInvoiceDraft(now)
+	draft.TaxTreatment = domain.TaxTreatmentReverseCharge
+	draft.TaxNote = "Set by hand"
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	invoice, err := service.CreateInvoice(context.Background(), financeID, orgID, draft)
+
+	// Assert
+	require.NoError(t, err)
+
+	// Hand-entered rates are kept and the engine's fields cannot be set directly
+	assert.Zero(t, mockTaxService.DetermineCallCount())
+	assert.Equal(t, "7.5", invoice.LineItems[0].TaxRate.String())
+	assert.Empty(t, invoice.TaxTreatment)
+	assert.Empty(t, invoice.TaxNote)
+
+	lines := invoice.TaxBreakdown()
+	require.Len(t, lines, 2)
+	assert.Equal(t, "Tax 0%", lines[0].Label)
+	assert.Equal(t, "20", lines[0].Taxable.Amount().String())
+	assert.Equal(t, "Tax 7.5%", lines[1].Label)
+	assert.Equal(t, invoice.TaxTotal, lines[1].Tax)
+}
+
+func TestInvoiceService_CreateInvoice_AutomaticTaxErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		country string
+		taxErr  error
+	}{
+		{name: "bad_country", country: "FRA"},
+		{name: "seller_without_rates", country: "FR", taxErr: appErrors.NewNotFoundError(`no tax rates for country "US"`)},
+		{name: "missing_buyer_country", country: "", taxErr: appErrors.NewValidationError("the customer's country is needed to work out tax")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			financeID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+			mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "US", TaxID: "12-3456789"}, nil)
+			mockTaxService.DetermineReturns(nil, tc.taxErr)
+
+			draft := testInvoiceDraft(now)
+			draft.AutomaticTax = true
+			draft.CustomerCountry = tc.country
+			draft.IssueDate = time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logging.New(&cfg)).(*invoiceService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateInvoice(context.Background(), financeID, orgID, draft)
+
+			// Assert
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Zero(t, mockInvoiceRepo.CreateInvoiceCallCount())
+		})
+	}
+}
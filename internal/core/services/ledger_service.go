@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+// maxLedgerAmountDecimals matches the scale of ledger_postings.amount
+const maxLedgerAmountDecimals = 18
+
+type ledgerService struct {
+	ledgerRepo ports.LedgerRepository
+	logger     logging.Logger
+}
+
+// NewLedgerService creates a new double-entry ledger service
+func NewLedgerService(ledgerRepo ports.LedgerRepository, logger logging.Logger) ports.LedgerService {
+	return &ledgerService{
+		ledgerRepo: ledgerRepo,
+		logger:     logger,
+	}
+}
+
+// CreateAccount opens a ledger account with a unique code
+func (s *ledgerService) CreateAccount(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error) {
+	account.Code = strings.TrimSpace(account.Code)
+	account.Name = strings.TrimSpace(account.Name)
+
+	if account.Code == "" || account.Name == "" {
+		return nil, appErrors.NewValidationError("account code and name are required")
+	}
+
+	if !account.Type.IsValid() {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("invalid account type %q", account.Type))
+	}
+
+	existing, err := s.ledgerRepo.GetAccountByCode(ctx, account.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("ledger account %s already exists", account.Code))
+	}
+
+	account.ID = uuid.New()
+	created, err := s.ledgerRepo.CreateAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Ledger account created", map[string]interface{}{
+		"account_id": created.ID,
+		"code":       created.Code,
+		"type":       created.Type,
+	})
+
+	return created, nil
+}
+
+// GetAccount retrieves a ledger account by ID
+func (s *ledgerService) GetAccount(ctx context.Context, id uuid.UUID) (*domain.LedgerAccount, error) {
+	account, err := s.ledgerRepo.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, appErrors.NewNotFoundError("ledger account not found")
+	}
+
+	return account, nil
+}
+
+// GetAccountByCode retrieves a ledger account by its code
+func (s *ledgerService) GetAccountByCode(ctx context.Context, code string) (*domain.LedgerAccount, error) {
+	account, err := s.ledgerRepo.GetAccountByCode(ctx, strings.TrimSpace(code))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, appErrors.NewNotFoundError("ledger account not found")
+	}
+
+	return account, nil
+}
+
+// ListAccountsByOwner lists the accounts belonging to a user or organization
+func (s *ledgerService) ListAccountsByOwner(ctx context.Context, ownerID uuid.UUID) ([]domain.LedgerAccount, error) {
+	return s.ledgerRepo.ListAccountsByOwner(ctx, ownerID)
+}
+
+// PostEntry validates that the entry balances and records it atomically.
+// Posting again with the same external reference is a no-op that returns the
+// original entry, as long as the postings are the same; reusing a reference for
+// different postings is a conflict.
+func (s *ledgerService) PostEntry(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, error) {
+	entry.ExternalReference = strings.TrimSpace(entry.ExternalReference)
+	if err := s.ValidateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	entry.ID = uuid.New()
+	if entry.OccurredAt.IsZero() {
+		entry.OccurredAt = time.Now()
+	}
+	for i := range entry.Postings {
+		entry.Postings[i].ID = uuid.New()
+	}
+
+	posted, created, err := s.ledgerRepo.PostEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		if !samePostings(posted.Postings, entry.Postings) {
+			return nil, appErrors.NewConflictError(fmt.Sprintf("external reference %s was already posted with different postings", entry.ExternalReference))
+		}
+		return posted, nil
+	}
+
+	s.logger.Info("Ledger entry posted", map[string]interface{}{
+		"entry_id":           posted.ID,
+		"external_reference": posted.ExternalReference,
+		"postings":           len(posted.Postings),
+	})
+
+	return posted, nil
+}
+
+// ValidateEntry checks an entry has an external reference and balanced
+// postings, without recording it. Entries that other repositories record in
+// the same transaction as the change they account for are validated with it.
+func (s *ledgerService) ValidateEntry(ctx context.Context, entry domain.LedgerEntry) error {
+	if strings.TrimSpace(entry.ExternalReference) == "" {
+		return appErrors.NewValidationError("external reference is required")
+	}
+
+	return s.validatePostings(ctx, entry.Postings)
+}
+
+// validatePostings checks there are at least two postings on existing accounts,
+// each a non-zero amount within the ledger's precision, summing to zero per asset
+func (s *ledgerService) validatePostings(ctx context.Context, postings []domain.LedgerPosting) error {
+	if len(postings) < 2 {
+		return appErrors.NewValidationError("an entry needs at least two postings")
+	}
+
+	totals := make(map[string]money.Money)
+	checked := make(map[uuid.UUID]bool)
+
+	for _, posting := range postings {
+		if posting.Amount.Currency() == "" {
+			return appErrors.NewValidationError("every posting needs an asset")
+		}
+
+		if posting.Amount.IsZero() {
+			return appErrors.NewValidationError("posting amounts cannot be zero")
+		}
+
+		if _, err := posting.Amount.ToBaseUnits(maxLedgerAmountDecimals); err != nil {
+			return appErrors.NewValidationError(fmt.Sprintf("posting amounts support at most %d decimal places", maxLedgerAmountDecimals))
+		}
+
+		if !checked[posting.AccountID] {
+			if _, err := s.GetAccount(ctx, posting.AccountID); err != nil {
+				return err
+			}
+			checked[posting.AccountID] = true
+		}
+
+		total, ok := totals[posting.Amount.Currency()]
+		if !ok {
+			total = money.Zero(posting.Amount.Currency())
+		}
+		// Currencies match by construction, so Add cannot fail
+		totals[posting.Amount.Currency()], _ = total.Add(posting.Amount)
+	}
+
+	for asset, total := range totals {
+		if !total.IsZero() {
+			return appErrors.NewValidationError(fmt.Sprintf("entry does not balance in %s: debits and credits differ by %s", asset, total.Amount().String()))
+		}
+	}
+
+	return nil
+}
+
+// GetEntry retrieves an entry with its postings by external reference
+func (s *ledgerService) GetEntry(ctx context.Context, reference string) (*domain.LedgerEntry, error) {
+	entry, err := s.ledgerRepo.GetEntryByReference(ctx, strings.TrimSpace(reference))
+	if err != nil {
+		return nil, err
+	}
+
+	if entry == nil {
+		return nil, appErrors.NewNotFoundError("ledger entry not found")
+	}
+
+	return entry, nil
+}
+
+// GetBalances returns an account's balance in every asset it has postings in
+func (s *ledgerService) GetBalances(ctx context.Context, accountID uuid.UUID) ([]domain.LedgerBalance, error) {
+	if _, err := s.GetAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepo.GetBalances(ctx, accountID)
+}
+
+// GetBalance returns an account's balance in one asset, zero if it has no postings in it
+func (s *ledgerService) GetBalance(ctx context.Context, accountID uuid.UUID, asset string) (*domain.LedgerBalance, error) {
+	if _, err := s.GetAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepo.GetBalance(ctx, accountID, strings.ToUpper(strings.TrimSpace(asset)))
+}
+
+// samePostings reports whether two sets of postings move the same amounts
+// between the same accounts, regardless of order
+func samePostings(a, b []domain.LedgerPosting) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	// Decimal strings drop trailing zeros, so the stored 1.500000000000000000 matches 1.5
+	key := func(p domain.LedgerPosting) string {
+		return p.AccountID.String() + "|" + p.Amount.Currency() + "|" + p.Amount.Amount().String()
+	}
+
+	keysA := make([]string, len(a))
+	keysB := make([]string, len(b))
+	for i := range a {
+		keysA[i] = key(a[i])
+		keysB[i] = key(b[i])
+	}
+	sort.Strings(keysA)
+	sort.Strings(keysB)
+
+	for i := range keysA {
+		if keysA[i] != keysB[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubLedgerAccounts makes treasuryID a treasury asset account and payableID
+// a contractor payable account
+func stubLedgerAccounts(repo *mocks.FakeLedgerRepository, treasuryID, payableID uuid.UUID) {
+	repo.GetAccountByIDStub = func(ctx context.Context, id uuid.UUID) (*domain.LedgerAccount, error) {
+		switch id {
+		case treasuryID:
+			return &domain.LedgerAccount{ID: id, Code: "treasury", Type: domain.LedgerAccountTypeAsset}, nil
+		case payableID:
+			return &domain.LedgerAccount{ID: id, Code: "payables:contractor", Type: domain.LedgerAccountTypeLiability}, nil
+		}
+		return nil, nil
+	}
+}
+
+// stubLedgerEntryStore keeps posted entries in memory by external reference
+func stubLedgerEntryStore(repo *mocks.FakeLedgerRepository) {
+	entries := make(map[string]*domain.LedgerEntry)
+	repo.PostEntryStub = func(ctx context.Context, entry domain.LedgerEntry) (*domain.LedgerEntry, bool, error) {
+		if existing, ok := entries[entry.ExternalReference]; ok {
+			return existing, false, nil
+		}
+		entries[entry.ExternalReference] = &entry
+		return &entry, true, nil
+	}
+}
+
+// testAccrual records that the company owes the contractor amount
+func testAccrual(treasuryID, payableID uuid.UUID, reference, amount, asset string) domain.LedgerEntry {
+	owed := money.MustParse(amount, asset)
+	return domain.LedgerEntry{
+		ExternalReference: reference,
+		Description:       "Contractor invoice",
+		Postings: []domain.LedgerPosting{
+			{AccountID: treasuryID, Amount: owed},
+			{AccountID: payableID, Amount: owed.Neg()},
+		},
+	}
+}
+
+func TestLedgerService_PostEntry(t *testing.T) {
+	// Arrange
+	mockLedgerRepo := new(mocks.FakeLedgerRepository)
+	treasuryID, payableID := uuid.New(), uuid.New()
+	stubLedgerAccounts(mockLedgerRepo, treasuryID, payableID)
+	stubLedgerEntryStore(mockLedgerRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+
+	// Act
+	entry, err := service.PostEntry(context.Background(), testAccrual(treasuryID, payableID, "invoice:42", "1500.25", "USDC"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, entry.ID)
+	assert.False(t, entry.OccurredAt.IsZero())
+	assert.Len(t, entry.Postings, 2)
+	assert.Equal(t, 1, mockLedgerRepo.PostEntryCallCount())
+}
+
+func TestLedgerService_PostEntry_Idempotent(t *testing.T) {
+	// Arrange
+	mockLedgerRepo := new(mocks.FakeLedgerRepository)
+	treasuryID, payableID := uuid.New(), uuid.New()
+	stubLedgerAccounts(mockLedgerRepo, treasuryID, payableID)
+	stubLedgerEntryStore(mockLedgerRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+	first, err := service.PostEntry(ctx, testAccrual(treasuryID, payableID, "invoice:42", "1500.25", "USDC"))
+	require.NoError(t, err)
+
+	// A retry of the same event, in the reverse order and with the scale the
+	// database stores amounts at
+	retry := testAccrual(treasuryID, payableID, "invoice:42", "1500.250000000000000000", "USDC")
+	retry.Postings[0], retry.Postings[1] = retry.Postings[1], retry.Postings[0]
+
+	// Act
+	second, retryErr := service.PostEntry(ctx, retry)
+	_, reuseErr := service.PostEntry(ctx, testAccrual(treasuryID, payableID, "invoice:42", "99", "USDC"))
+
+	// Assert
+	require.NoError(t, retryErr)
+	assert.Equal(t, first.ID, second.ID)
+	// The same reference cannot be reused for a different movement
+	assertAppErrorType(t, reuseErr, appErrors.ErrorTypeConflict)
+}
+
+func TestLedgerService_PostEntry_Invalid(t *testing.T) {
+	treasuryID, payableID := uuid.New(), uuid.New()
+	usdc := money.MustParse("100", "USDC")
+
+	testCases := []struct {
+		name     string
+		entry    domain.LedgerEntry
+		expected appErrors.ErrorType
+	}{
+		{
+			name:     "unbalanced",
+			entry:    domain.LedgerEntry{ExternalReference: "a", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: usdc}, {AccountID: payableID, Amount: money.MustParse("-99.99", "USDC")}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "balanced_across_assets_only",
+			entry:    domain.LedgerEntry{ExternalReference: "b", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: usdc}, {AccountID: payableID, Amount: money.MustParse("-100", "USDT")}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "single_posting",
+			entry:    domain.LedgerEntry{ExternalReference: "c", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: usdc}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "zero_amount",
+			entry:    domain.LedgerEntry{ExternalReference: "d", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: money.Zero("USDC")}, {AccountID: payableID, Amount: money.Zero("USDC")}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "too_precise",
+			entry:    domain.LedgerEntry{ExternalReference: "e", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: money.MustParse("0.0000000000000000001", "ETH")}, {AccountID: payableID, Amount: money.MustParse("-0.0000000000000000001", "ETH")}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "missing_reference",
+			entry:    domain.LedgerEntry{Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: usdc}, {AccountID: payableID, Amount: usdc.Neg()}}},
+			expected: appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "unknown_account",
+			entry:    domain.LedgerEntry{ExternalReference: "f", Postings: []domain.LedgerPosting{{AccountID: treasuryID, Amount: usdc}, {AccountID: uuid.New(), Amount: usdc.Neg()}}},
+			expected: appErrors.ErrorTypeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLedgerRepo := new(mocks.FakeLedgerRepository)
+			stubLedgerAccounts(mockLedgerRepo, treasuryID, payableID)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+
+			// Act
+			_, postErr := service.PostEntry(context.Background(), tc.entry)
+			// Entries other repositories post are held to the same rules
+			validateErr := service.ValidateEntry(context.Background(), tc.entry)
+
+			// Assert
+			assertAppErrorType(t, postErr, tc.expected)
+			assertAppErrorType(t, validateErr, tc.expected)
+			assert.Equal(t, 0, mockLedgerRepo.PostEntryCallCount())
+		})
+	}
+}
+
+func TestLedgerService_ValidateEntry(t *testing.T) {
+	// Arrange
+	mockLedgerRepo := new(mocks.FakeLedgerRepository)
+	treasuryID, payableID := uuid.New(), uuid.New()
+	stubLedgerAccounts(mockLedgerRepo, treasuryID, payableID)
+	stubLedgerEntryStore(mockLedgerRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+
+	// Act
+	err := service.ValidateEntry(context.Background(), testAccrual(treasuryID, payableID, "invoice:42", "1500.25", "USDC"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockLedgerRepo.PostEntryCallCount())
+}
+
+func TestLedgerService_CreateAccount(t *testing.T) {
+	// Arrange
+	mockLedgerRepo := new(mocks.FakeLedgerRepository)
+	mockLedgerRepo.CreateAccountStub = func(ctx context.Context, account domain.LedgerAccount) (*domain.LedgerAccount, error) {
+		return &account, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	// Act
+	account, err := service.CreateAccount(ctx, domain.LedgerAccount{
+		Code:    " payables:contractor:" + ownerID.String() + " ",
+		Name:    "Contractor payable",
+		Type:    domain.LedgerAccountTypeLiability,
+		OwnerID: &ownerID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "payables:contractor:"+ownerID.String(), account.Code)
+
+	// Act: the code is taken, and the type is unknown
+	mockLedgerRepo.GetAccountByCodeReturns(account, nil)
+	_, takenErr := service.CreateAccount(ctx, domain.LedgerAccount{Code: account.Code, Name: "Again", Type: domain.LedgerAccountTypeLiability})
+	_, typeErr := service.CreateAccount(ctx, domain.LedgerAccount{Code: "x", Name: "Bad", Type: "cash"})
+
+	// Assert
+	assertAppErrorType(t, takenErr, appErrors.ErrorTypeConflict)
+	assertAppErrorType(t, typeErr, appErrors.ErrorTypeValidation)
+}
+
+func TestLedgerService_GetBalance(t *testing.T) {
+	// Arrange
+	mockLedgerRepo := new(mocks.FakeLedgerRepository)
+	treasuryID, payableID := uuid.New(), uuid.New()
+	stubLedgerAccounts(mockLedgerRepo, treasuryID, payableID)
+	stubLedgerEntryStore(mockLedgerRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewLedgerService(mockLedgerRepo, logging.New(&cfg))
+	mockLedgerRepo.GetBalanceReturns(&domain.LedgerBalance{AccountID: payableID, Balance: money.MustParse("-1500.25", "USDC")}, nil)
+
+	// Act
+	balance, err := service.GetBalance(context.Background(), payableID, " usdc")
+	_, unknownErr := service.GetBalance(context.Background(), uuid.New(), "USDC")
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, balance.Balance.Equal(money.MustParse("-1500.25", "USDC")))
+	_, _, asset := mockLedgerRepo.GetBalanceArgsForCall(0)
+	assert.Equal(t, "USDC", asset)
+	assertAppErrorType(t, unknownErr, appErrors.ErrorTypeNotFound)
+}
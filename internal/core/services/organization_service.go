@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+type organizationService struct {
+	orgRepo ports.OrganizationRepository
+	logger  logging.Logger
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(orgRepo ports.OrganizationRepository, logger logging.Logger) ports.OrganizationService {
+	return &organizationService{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+// CreateOrganization creates an organization with userID as its owner
+func (s *organizationService) CreateOrganization(ctx context.Context, userID uuid.UUID, org domain.Organization) (*domain.Organization, error) {
+	org = normalizeOrganizationProfile(org)
+	if err := validateOrganizationProfile(org); err != nil {
+		return nil, err
+	}
+
+	org.ID = uuid.New()
+	org.CreatedBy = &userID
+
+	created, err := s.orgRepo.CreateOrganization(ctx, org, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Organization created", map[string]interface{}{
+		"organization_id": created.ID,
+		"user_id":         userID,
+	})
+
+	return created, nil
+}
+
+// ListOrganizations lists the organizations the user belongs to with their role in each
+func (s *organizationService) ListOrganizations(ctx context.Context, userID uuid.UUID) ([]domain.OrganizationMembership, error) {
+	return s.orgRepo.ListOrganizationsByUser(ctx, userID)
+}
+
+// GetOrganization retrieves an organization the user is a member of
+func (s *organizationService) GetOrganization(ctx context.Context, userID, orgID uuid.UUID) (*domain.Organization, error) {
+	if _, err := s.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.getOrganization(ctx, orgID)
+}
+
+// GetOrganizationByID retrieves an organization without checking membership.
+// It is for background jobs acting for the organization, never for requests.
+func (s *organizationService) GetOrganizationByID(ctx context.Context, orgID uuid.UUID) (*domain.Organization, error) {
+	return s.getOrganization(ctx, orgID)
+}
+
+// UpdateOrganization replaces the organization's profile. Only owners and admins may edit it.
+func (s *organizationService) UpdateOrganization(ctx context.Context, userID, orgID uuid.UUID, org domain.Organization) (*domain.Organization, error) {
+	if _, err := s.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageOrganization); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	org = normalizeOrganizationProfile(org)
+	if err := validateOrganizationProfile(org); err != nil {
+		return nil, err
+	}
+
+	org.ID = existing.ID
+	org.CreatedBy = existing.CreatedBy
+
+	updated, err := s.orgRepo.UpdateOrganization(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Organization profile updated", map[string]interface{}{
+		"organization_id": orgID,
+		"user_id":         userID,
+	})
+
+	return updated, nil
+}
+
+// ListMembers lists the organization's members; any member may see them
+func (s *organizationService) ListMembers(ctx context.Context, userID, orgID uuid.UUID) ([]domain.OrganizationMember, error) {
+	if _, err := s.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.orgRepo.ListMembers(ctx, orgID)
+}
+
+// UpdateMemberRole changes a member's role. Owners and admins manage roles, but
+// only owners may grant the owner role or change another owner, and the last
+// owner cannot step down.
+func (s *organizationService) UpdateMemberRole(ctx context.Context, actorID, orgID, memberID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	if !role.IsValid() {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("invalid role %q", role))
+	}
+
+	actor, err := s.AuthorizeMember(ctx, actorID, orgID, domain.OrganizationRole.CanManageOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.getMember(ctx, orgID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Role == role {
+		return member, nil
+	}
+
+	if actor.Role != domain.OrganizationRoleOwner && (role == domain.OrganizationRoleOwner || member.Role == domain.OrganizationRoleOwner) {
+		return nil, appErrors.NewForbiddenError("only owners can grant or change the owner role")
+	}
+
+	if member.Role == domain.OrganizationRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.orgRepo.UpdateMemberRole(ctx, orgID, memberID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Organization member role changed", map[string]interface{}{
+		"organization_id": orgID,
+		"member_id":       memberID,
+		"changed_by":      actorID,
+		"from":            member.Role,
+		"to":              role,
+	})
+
+	return updated, nil
+}
+
+// RemoveMember removes a member from the organization. Members may always leave
+// themselves; removing someone else needs an owner or admin, and only owners may
+// remove owners. The last owner can never be removed.
+func (s *organizationService) RemoveMember(ctx context.Context, actorID, orgID, memberID uuid.UUID) error {
+	actor, err := s.AuthorizeMember(ctx, actorID, orgID, nil)
+	if err != nil {
+		return err
+	}
+
+	member := actor
+	if memberID != actorID {
+		if !actor.Role.CanManageOrganization() {
+			return appErrors.NewForbiddenError("only owners and admins can remove members")
+		}
+
+		if member, err = s.getMember(ctx, orgID, memberID); err != nil {
+			return err
+		}
+
+		if member.Role == domain.OrganizationRoleOwner && actor.Role != domain.OrganizationRoleOwner {
+			return appErrors.NewForbiddenError("only owners can remove an owner")
+		}
+	}
+
+	if member.Role == domain.OrganizationRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.orgRepo.RemoveMember(ctx, orgID, memberID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Organization member removed", map[string]interface{}{
+		"organization_id": orgID,
+		"member_id":       memberID,
+		"removed_by":      actorID,
+	})
+
+	return nil
+}
+
+// AuthorizeMember returns the user's membership if allowed accepts their role;
+// a nil allowed accepts any member. Non-members get a not found error so the
+// organization's existence is not revealed to them.
+func (s *organizationService) AuthorizeMember(ctx context.Context, userID, orgID uuid.UUID, allowed func(domain.OrganizationRole) bool) (*domain.OrganizationMember, error) {
+	member, err := s.orgRepo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, appErrors.NewNotFoundError("organization not found")
+	}
+
+	if allowed != nil && !allowed(member.Role) {
+		return nil, appErrors.NewForbiddenError(fmt.Sprintf("the %s role cannot perform this action", member.Role))
+	}
+
+	return member, nil
+}
+
+// SaveBusinessProfile saves the company details a business user entered during
+// onboarding to the organization they set up, creating it on first use. The
+// organization is the only place company details are kept; its CompanyInfo
+// fields are only edited through the organization itself. A user who can no
+// longer manage the organization they set up gets a new one rather than
+// rewriting a profile that is no longer theirs to edit.
+func (s *organizationService) SaveBusinessProfile(ctx context.Context, userID uuid.UUID, profile domain.Organization) (*domain.Organization, error) {
+	existing, err := s.GetBusinessProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile = domain.Organization{
+		Name:       profile.Name,
+		Address:    profile.Address,
+		City:       profile.City,
+		PostalCode: profile.PostalCode,
+		Country:    profile.Country,
+		Website:    profile.Website,
+	}
+
+	if existing == nil {
+		return s.CreateOrganization(ctx, userID, profile)
+	}
+
+	profile.Size = existing.Size
+	profile.Industry = existing.Industry
+	profile.Description = existing.Description
+	profile.Headquarters = existing.Headquarters
+	profile.LogoURL = existing.LogoURL
+	profile.TaxID = existing.TaxID
+
+	profile = normalizeOrganizationProfile(profile)
+	if err := validateOrganizationProfile(profile); err != nil {
+		return nil, err
+	}
+
+	profile.ID = existing.ID
+	profile.CreatedBy = existing.CreatedBy
+
+	return s.orgRepo.UpdateOrganization(ctx, profile)
+}
+
+// GetBusinessProfile returns the first organization the user set up that they
+// can still manage, or nil if there is none
+func (s *organizationService) GetBusinessProfile(ctx context.Context, userID uuid.UUID) (*domain.Organization, error) {
+	memberships, err := s.orgRepo.ListOrganizationsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile *domain.Organization
+	for i, membership := range memberships {
+		org := &memberships[i].Organization
+		if org.CreatedBy == nil || *org.CreatedBy != userID || !membership.Role.CanManageOrganization() {
+			continue
+		}
+		if profile == nil || org.CreatedAt.Before(profile.CreatedAt) {
+			profile = org
+		}
+	}
+
+	return profile, nil
+}
+
+func (s *organizationService) getOrganization(ctx context.Context, orgID uuid.UUID) (*domain.Organization, error) {
+	org, err := s.orgRepo.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if org == nil {
+		return nil, appErrors.NewNotFoundError("organization not found")
+	}
+
+	return org, nil
+}
+
+func (s *organizationService) getMember(ctx context.Context, orgID, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	member, err := s.orgRepo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, appErrors.NewNotFoundError("member not found")
+	}
+
+	return member, nil
+}
+
+// ensureAnotherOwner fails unless the organization has more than one owner
+func (s *organizationService) ensureAnotherOwner(ctx context.Context, orgID uuid.UUID) error {
+	owners, err := s.orgRepo.CountMembersByRole(ctx, orgID, domain.OrganizationRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	if owners <= 1 {
+		return appErrors.NewValidationError("an organization needs at least one owner")
+	}
+
+	return nil
+}
+
+// normalizeOrganizationProfile trims the profile's text fields and drops empty optional ones
+func normalizeOrganizationProfile(org domain.Organization) domain.Organization {
+	org.Name = strings.TrimSpace(org.Name)
+	org.Address = strings.TrimSpace(org.Address)
+	org.City = strings.TrimSpace(org.City)
+	org.PostalCode = strings.TrimSpace(org.PostalCode)
+	org.Country = strings.TrimSpace(org.Country)
+	org.TaxID = domain.NormalizeTaxID(org.TaxID)
+
+	for _, field := range []**string{&org.Website, &org.Size, &org.Industry, &org.Description, &org.Headquarters, &org.LogoURL} {
+		if *field == nil {
+			continue
+		}
+		trimmed := strings.TrimSpace(**field)
+		if trimmed == "" {
+			*field = nil
+		} else {
+			*field = &trimmed
+		}
+	}
+
+	return org
+}
+
+// validateOrganizationProfile checks a normalised profile. The logo is fetched
+// when invoices are rendered, so it has to be an absolute http(s) URL.
+func validateOrganizationProfile(org domain.Organization) error {
+	if org.Name == "" {
+		return appErrors.NewValidationError("organization name is required")
+	}
+
+	if len(org.TaxID) > 64 {
+		return appErrors.NewValidationError("tax ID can be at most 64 characters")
+	}
+
+	if org.LogoURL != nil {
+		logo, err := url.Parse(*org.LogoURL)
+		if err != nil || (logo.Scheme != "https" && logo.Scheme != "http") || logo.Host == "" {
+			return appErrors.NewValidationError("logo URL must be an absolute http or https URL")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOrganizationMembers backs the member lookups and changes of the
+// organization repository fake with the given roles for orgID
+func stubOrganizationMembers(repo *mocks.FakeOrganizationRepository, orgID uuid.UUID, members map[uuid.UUID]domain.OrganizationRole) {
+	repo.GetMemberStub = func(ctx context.Context, id, userID uuid.UUID) (*domain.OrganizationMember, error) {
+		role, ok := members[userID]
+		if !ok || id != orgID {
+			return nil, nil
+		}
+		return &domain.OrganizationMember{OrganizationID: id, UserID: userID, Role: role}, nil
+	}
+	repo.CountMembersByRoleStub = func(ctx context.Context, id uuid.UUID, role domain.OrganizationRole) (int64, error) {
+		var count int64
+		for _, r := range members {
+			if r == role {
+				count++
+			}
+		}
+		return count, nil
+	}
+	repo.UpdateMemberRoleStub = func(ctx context.Context, id, userID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+		members[userID] = role
+		return &domain.OrganizationMember{OrganizationID: id, UserID: userID, Role: role}, nil
+	}
+	repo.RemoveMemberStub = func(ctx context.Context, id, userID uuid.UUID) error {
+		delete(members, userID)
+		return nil
+	}
+}
+
+// stubOrganizationAccess authorizes the given members of orgID through the
+// organization service fake the way the real service does, hiding the
+// organization from everyone else
+func stubOrganizationAccess(orgService *mocks.FakeOrganizationService, orgID uuid.UUID, members map[uuid.UUID]domain.OrganizationRole) {
+	orgService.AuthorizeMemberStub = func(ctx context.Context, userID, id uuid.UUID, allowed func(domain.OrganizationRole) bool) (*domain.OrganizationMember, error) {
+		role, ok := members[userID]
+		if !ok || id != orgID {
+			return nil, appErrors.NewNotFoundError("organization not found")
+		}
+		if allowed != nil && !allowed(role) {
+			return nil, appErrors.NewForbiddenError("role not allowed")
+		}
+		return &domain.OrganizationMember{OrganizationID: id, UserID: userID, Role: role}, nil
+	}
+}
+
+func TestOrganizationService_CreateOrganization(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	mockOrgRepo.CreateOrganizationStub = func(ctx context.Context, org domain.Organization, ownerID uuid.UUID) (*domain.Organization, error) {
+		return &org, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	industry := " Fintech "
+	empty := "  "
+
+	// Act
+	org, err := service.CreateOrganization(ctx, userID, domain.Organization{
+		Name:     " Acme Ltd ",
+		Country:  "Nigeria",
+		Industry: &industry,
+		Website:  &empty,
+	})
+	_, nameErr := service.CreateOrganization(ctx, userID, domain.Organization{Name: " "})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Ltd", org.Name)
+	assert.Equal(t, "Fintech", *org.Industry)
+	assert.Nil(t, org.Website)
+	assert.Equal(t, userID, *org.CreatedBy)
+
+	_, _, ownerID := mockOrgRepo.CreateOrganizationArgsForCall(0)
+	assert.Equal(t, userID, ownerID)
+
+	assertAppErrorType(t, nameErr, appErrors.ErrorTypeValidation)
+}
+
+func TestOrganizationService_AuthorizeMember(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	orgID, finance, viewer := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationMembers(mockOrgRepo, orgID, map[uuid.UUID]domain.OrganizationRole{
+		finance: domain.OrganizationRoleFinance,
+		viewer:  domain.OrganizationRoleViewer,
+	})
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+
+	// Act
+	member, err := service.AuthorizeMember(ctx, finance, orgID, domain.OrganizationRole.CanManageFinances)
+	_, forbiddenErr := service.AuthorizeMember(ctx, viewer, orgID, domain.OrganizationRole.CanManageFinances)
+	_, anyRoleErr := service.AuthorizeMember(ctx, viewer, orgID, nil)
+	_, outsiderErr := service.AuthorizeMember(ctx, uuid.New(), orgID, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrganizationRoleFinance, member.Role)
+	assertAppErrorType(t, forbiddenErr, appErrors.ErrorTypeForbidden)
+	assert.NoError(t, anyRoleErr)
+	// Outsiders cannot tell whether the organization exists
+	assertAppErrorType(t, outsiderErr, appErrors.ErrorTypeNotFound)
+}
+
+func TestOrganizationService_UpdateOrganization_RequiresManager(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	orgID, admin, finance := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationMembers(mockOrgRepo, orgID, map[uuid.UUID]domain.OrganizationRole{
+		admin:   domain.OrganizationRoleAdmin,
+		finance: domain.OrganizationRoleFinance,
+	})
+	createdBy := uuid.New()
+	mockOrgRepo.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", CreatedBy: &createdBy}, nil)
+	mockOrgRepo.UpdateOrganizationStub = func(ctx context.Context, org domain.Organization) (*domain.Organization, error) {
+		return &org, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+
+	// Act
+	org, err := service.UpdateOrganization(ctx, admin, orgID, domain.Organization{Name: "Acme Inc"})
+	_, forbiddenErr := service.UpdateOrganization(ctx, finance, orgID, domain.Organization{Name: "Hijacked"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Inc", org.Name)
+	assert.Equal(t, orgID, org.ID)
+	assert.Equal(t, createdBy, *org.CreatedBy)
+	assertAppErrorType(t, forbiddenErr, appErrors.ErrorTypeForbidden)
+	assert.Equal(t, 1, mockOrgRepo.UpdateOrganizationCallCount())
+}
+
+func TestOrganizationService_UpdateOrganization_LogoURL(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	orgID, admin := uuid.New(), uuid.New()
+	stubOrganizationMembers(mockOrgRepo, orgID, map[uuid.UUID]domain.OrganizationRole{admin: domain.OrganizationRoleAdmin})
+	mockOrgRepo.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockOrgRepo.UpdateOrganizationStub = func(ctx context.Context, org domain.Organization) (*domain.Organization, error) {
+		return &org, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+	logo := " https://cdn.example.com/acme.png "
+
+	// Act
+	org, err := service.UpdateOrganization(ctx, admin, orgID, domain.Organization{Name: "Acme", LogoURL: &logo})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, org.LogoURL)
+	assert.Equal(t, "https://cdn.example.com/acme.png", *org.LogoURL)
+
+	for _, invalid := range []string{"acme.png", "ftp://example.com/acme.png", "https://"} {
+		// Act
+		logo := invalid
+		_, err := service.UpdateOrganization(ctx, admin, orgID, domain.Organization{Name: "Acme", LogoURL: &logo})
+
+		// Assert
+		assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	}
+	assert.Equal(t, 1, mockOrgRepo.UpdateOrganizationCallCount())
+}
+
+func TestOrganizationService_UpdateMemberRole(t *testing.T) {
+	testCases := []struct {
+		name      string
+		actorRole domain.OrganizationRole
+		fromRole  domain.OrganizationRole
+		toRole    domain.OrganizationRole
+		expected  appErrors.ErrorType
+	}{
+		{name: "admin_promotes_viewer", actorRole: domain.OrganizationRoleAdmin, fromRole: domain.OrganizationRoleViewer, toRole: domain.OrganizationRoleFinance},
+		{name: "owner_promotes_admin_to_owner", actorRole: domain.OrganizationRoleOwner, fromRole: domain.OrganizationRoleAdmin, toRole: domain.OrganizationRoleOwner},
+		{name: "admin_cannot_grant_owner", actorRole: domain.OrganizationRoleAdmin, fromRole: domain.OrganizationRoleViewer, toRole: domain.OrganizationRoleOwner, expected: appErrors.ErrorTypeForbidden},
+		{name: "admin_cannot_demote_owner", actorRole: domain.OrganizationRoleAdmin, fromRole: domain.OrganizationRoleOwner, toRole: domain.OrganizationRoleViewer, expected: appErrors.ErrorTypeForbidden},
+		{name: "finance_cannot_manage", actorRole: domain.OrganizationRoleFinance, fromRole: domain.OrganizationRoleViewer, toRole: domain.OrganizationRoleFinance, expected: appErrors.ErrorTypeForbidden},
+		{name: "unknown_role", actorRole: domain.OrganizationRoleOwner, fromRole: domain.OrganizationRoleViewer, toRole: "superuser", expected: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOrgRepo := new(mocks.FakeOrganizationRepository)
+			orgID, actor, member := uuid.New(), uuid.New(), uuid.New()
+			members := map[uuid.UUID]domain.OrganizationRole{actor: tc.actorRole, member: tc.fromRole}
+			stubOrganizationMembers(mockOrgRepo, orgID, members)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+			// Act
+			updated, err := service.UpdateMemberRole(context.Background(), actor, orgID, member, tc.toRole)
+
+			// Assert
+			if tc.expected != "" {
+				assertAppErrorType(t, err, tc.expected)
+				assert.Equal(t, tc.fromRole, members[member])
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.toRole, updated.Role)
+		})
+	}
+}
+
+func TestOrganizationService_LastOwnerIsKept(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	orgID, owner := uuid.New(), uuid.New()
+	members := map[uuid.UUID]domain.OrganizationRole{owner: domain.OrganizationRoleOwner}
+	stubOrganizationMembers(mockOrgRepo, orgID, members)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+
+	// Act
+	_, stepDownErr := service.UpdateMemberRole(ctx, owner, orgID, owner, domain.OrganizationRoleAdmin)
+	leaveErr := service.RemoveMember(ctx, owner, orgID, owner)
+
+	// Assert
+	assertAppErrorType(t, stepDownErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, leaveErr, appErrors.ErrorTypeValidation)
+
+	// Act: with a second owner the first can step down and then leave
+	second := uuid.New()
+	members[second] = domain.OrganizationRoleOwner
+	_, stepDownErr = service.UpdateMemberRole(ctx, second, orgID, owner, domain.OrganizationRoleAdmin)
+	leaveErr = service.RemoveMember(ctx, owner, orgID, owner)
+
+	// Assert
+	require.NoError(t, stepDownErr)
+	require.NoError(t, leaveErr)
+	assert.NotContains(t, members, owner)
+}
+
+func TestOrganizationService_RemoveMember(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	orgID, owner, admin, viewer, finance := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	members := map[uuid.UUID]domain.OrganizationRole{
+		owner:   domain.OrganizationRoleOwner,
+		admin:   domain.OrganizationRoleAdmin,
+		viewer:  domain.OrganizationRoleViewer,
+		finance: domain.OrganizationRoleFinance,
+	}
+	stubOrganizationMembers(mockOrgRepo, orgID, members)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+
+	// Act
+	viewerErr := service.RemoveMember(ctx, viewer, orgID, finance)
+	ownerErr := service.RemoveMember(ctx, admin, orgID, owner)
+	unknownErr := service.RemoveMember(ctx, admin, orgID, uuid.New())
+	removeErr := service.RemoveMember(ctx, admin, orgID, finance)
+	leaveErr := service.RemoveMember(ctx, viewer, orgID, viewer)
+
+	// Assert
+	assertAppErrorType(t, viewerErr, appErrors.ErrorTypeForbidden)
+	assertAppErrorType(t, ownerErr, appErrors.ErrorTypeForbidden)
+	assertAppErrorType(t, unknownErr, appErrors.ErrorTypeNotFound)
+	require.NoError(t, removeErr)
+	require.NoError(t, leaveErr)
+	assert.Len(t, members, 2)
+}
+
+func TestOrganizationService_SaveBusinessProfile(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	mockOrgRepo.CreateOrganizationStub = func(ctx context.Context, org domain.Organization, ownerID uuid.UUID) (*domain.Organization, error) {
+		return &org, nil
+	}
+	mockOrgRepo.UpdateOrganizationStub = func(ctx context.Context, org domain.Organization) (*domain.Organization, error) {
+		return &org, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	ctx := context.Background()
+	website := "https://acme.example"
+	userID := uuid.New()
+	profile := domain.Organization{
+		Name:    "Acme Ltd",
+		City:    "Lagos",
+		Country: "Nigeria",
+		Website: &website,
+	}
+
+	// Act: first onboarding creates the organization with the user as owner
+	created, err := service.SaveBusinessProfile(ctx, userID, profile)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Ltd", created.Name)
+	assert.Equal(t, website, *created.Website)
+	_, _, ownerID := mockOrgRepo.CreateOrganizationArgsForCall(0)
+	assert.Equal(t, userID, ownerID)
+
+	// Act: later edits update it and keep the CompanyInfo fields set on the organization
+	size := "11-50"
+	created.Size = &size
+	mockOrgRepo.ListOrganizationsByUserReturns([]domain.OrganizationMembership{{Organization: *created, Role: domain.OrganizationRoleOwner}}, nil)
+	profile.City = "Abuja"
+	updated, err := service.SaveBusinessProfile(ctx, userID, profile)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, "Abuja", updated.City)
+	assert.Equal(t, size, *updated.CompanyInfo().CompanySize)
+	assert.Equal(t, 1, mockOrgRepo.CreateOrganizationCallCount())
+}
+
+func TestOrganizationService_SaveBusinessProfile_CreatorNoLongerManages(t *testing.T) {
+	// Arrange
+	mockOrgRepo := new(mocks.FakeOrganizationRepository)
+	userID := uuid.New()
+	original := domain.Organization{ID: uuid.New(), Name: "Acme Ltd", Country: "Nigeria", CreatedBy: &userID}
+	mockOrgRepo.ListOrganizationsByUserReturns([]domain.OrganizationMembership{{Organization: original, Role: domain.OrganizationRoleViewer}}, nil)
+	mockOrgRepo.CreateOrganizationStub = func(ctx context.Context, org domain.Organization, ownerID uuid.UUID) (*domain.Organization, error) {
+		org.ID = uuid.New()
+		return &org, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewOrganizationService(mockOrgRepo, logging.New(&cfg))
+
+	// Act: a creator who was demoted cannot rewrite the organization, so
+	// onboarding sets up a new one for them instead
+	saved, err := service.SaveBusinessProfile(context.Background(), userID, domain.Organization{Name: "Acme Holdings", Country: "Nigeria"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, original.ID, saved.ID)
+	assert.Equal(t, "Acme Holdings", saved.Name)
+	assert.Equal(t, 1, mockOrgRepo.CreateOrganizationCallCount())
+	assert.Equal(t, 0, mockOrgRepo.UpdateOrganizationCallCount())
+}
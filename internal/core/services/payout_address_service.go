@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+type payoutAddressService struct {
+	payoutRepo   ports.PayoutAddressRepository
+	userRepo     ports.UserRepository
+	securityRepo ports.SecurityRepository
+	emailService ports.EmailService
+	config       config.Config
+	logger       logging.Logger
+}
+
+// NewPayoutAddressService creates a new payout address allowlist service
+func NewPayoutAddressService(
+	payoutRepo ports.PayoutAddressRepository,
+	userRepo ports.UserRepository,
+	securityRepo ports.SecurityRepository,
+	emailService ports.EmailService,
+	config config.Config,
+	logger logging.Logger,
+) ports.PayoutAddressService {
+	return &payoutAddressService{
+		payoutRepo:   payoutRepo,
+		userRepo:     userRepo,
+		securityRepo: securityRepo,
+		emailService: emailService,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// RequestPayoutAddress saves a newly linked wallet with its address quarantined
+// on the allowlist, in one transaction, and notifies the owner with a cancel link
+func (s *payoutAddressService) RequestPayoutAddress(ctx context.Context, user domain.User, wallet domain.UserWallet) (*domain.PayoutAddress, error) {
+	token, err := generateCancelToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cancel token: %w", err)
+	}
+
+	now := time.Now()
+	address := domain.PayoutAddress{
+		ID:              uuid.New(),
+		UserID:          user.ID,
+		WalletID:        wallet.ID,
+		Address:         wallet.Address,
+		Chain:           wallet.Chain,
+		Status:          domain.PayoutAddressStatusPending,
+		CancelTokenHash: hashCancelToken(token),
+		AvailableAt:     now.Add(s.config.PayoutAddressCoolingOff),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	created, err := s.payoutRepo.CreateWalletWithPayoutAddress(ctx, wallet, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add payout address to allowlist: %w", err)
+	}
+
+	s.logSecurityEvent(ctx, "payout_address_added", user.ID, map[string]interface{}{
+		"payout_address_id": created.ID.String(),
+		"wallet_address":    created.Address,
+		"chain":             created.Chain,
+		"available_at":      created.AvailableAt.Format(time.RFC3339),
+	})
+
+	// Notification failures must not roll back the allowlist entry, the address
+	// stays quarantined either way
+	if err := s.emailService.SendPayoutAddressChangeNotification(ctx, user.Email, user.FirstName, *created, s.cancelLink(token)); err != nil {
+		s.logger.Error("Failed to send payout address change notification", err, map[string]interface{}{
+			"user_id":           user.ID,
+			"payout_address_id": created.ID,
+		})
+	}
+
+	return created, nil
+}
+
+// ListPayoutAddresses returns all allowlist entries for a user
+func (s *payoutAddressService) ListPayoutAddresses(ctx context.Context, userID uuid.UUID) ([]domain.PayoutAddress, error) {
+	if err := s.payoutRepo.ActivateDuePayoutAddresses(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return s.payoutRepo.ListPayoutAddressesByUserID(ctx, userID)
+}
+
+// CancelPayoutAddress lets the authenticated owner block a quarantined address
+func (s *payoutAddressService) CancelPayoutAddress(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*domain.PayoutAddress, error) {
+	address, err := s.payoutRepo.GetPayoutAddressByID(ctx, id)
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("payout address not found")
+	}
+
+	if address.UserID != userID {
+		return nil, appErrors.NewNotFoundError("payout address not found")
+	}
+
+	return s.cancel(ctx, address, "owner")
+}
+
+// GetPayoutAddressByCancelToken returns the address an emailed cancel link
+// would block, so the owner can confirm before anything changes
+func (s *payoutAddressService) GetPayoutAddressByCancelToken(ctx context.Context, token string) (*domain.PayoutAddress, error) {
+	if token == "" {
+		return nil, appErrors.NewValidationError("cancel token is required")
+	}
+
+	address, err := s.payoutRepo.GetPayoutAddressByCancelTokenHash(ctx, hashCancelToken(token))
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("invalid or expired cancel link")
+	}
+
+	return address, nil
+}
+
+// CancelPayoutAddressByToken blocks a quarantined address once the owner confirms the emailed cancel link
+func (s *payoutAddressService) CancelPayoutAddressByToken(ctx context.Context, token string) (*domain.PayoutAddress, error) {
+	address, err := s.GetPayoutAddressByCancelToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cancel(ctx, address, "email_link")
+}
+
+// ValidatePayoutAddress returns an error unless the address has cleared its
+// cooling-off period. It guards every place a payout destination is set or
+// paid. The cooling-off period is checked against the clock, so an address is
+// usable as soon as it elapses; the stored status catches up here.
+func (s *payoutAddressService) ValidatePayoutAddress(ctx context.Context, userID uuid.UUID, address string) error {
+	entry, err := s.payoutRepo.GetLatestPayoutAddress(ctx, userID, address)
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return appErrors.NewForbiddenError("payout address is not on the allowlist")
+	}
+
+	now := time.Now()
+	if entry.IsUsable(now) {
+		if entry.Status == domain.PayoutAddressStatusPending {
+			if err := s.payoutRepo.ActivateDuePayoutAddresses(ctx, now); err != nil {
+				s.logger.Error("Failed to activate due payout addresses", err, map[string]interface{}{
+					"payout_address_id": entry.ID,
+				})
+			}
+		}
+		return nil
+	}
+
+	if entry.Status == domain.PayoutAddressStatusCancelled {
+		return appErrors.NewForbiddenError("payout address change was cancelled")
+	}
+
+	return appErrors.NewForbiddenError(fmt.Sprintf("payout address is in its cooling-off period until %s", entry.AvailableAt.UTC().Format(time.RFC3339)))
+}
+
+// cancel blocks a pending address, records the event and notifies the owner
+func (s *payoutAddressService) cancel(ctx context.Context, address *domain.PayoutAddress, source string) (*domain.PayoutAddress, error) {
+	if address.Status != domain.PayoutAddressStatusPending || address.IsUsable(time.Now()) {
+		return nil, appErrors.NewConflictError("payout address can no longer be cancelled")
+	}
+
+	cancelled, err := s.payoutRepo.CancelPayoutAddress(ctx, address.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel payout address: %w", err)
+	}
+
+	s.logSecurityEvent(ctx, "payout_address_cancelled", cancelled.UserID, map[string]interface{}{
+		"payout_address_id": cancelled.ID.String(),
+		"wallet_address":    cancelled.Address,
+		"chain":             cancelled.Chain,
+		"source":            source,
+	})
+
+	user, err := s.userRepo.GetUserByID(ctx, cancelled.UserID)
+	if err != nil {
+		s.logger.Error("Failed to load user for payout address cancellation notice", err, map[string]interface{}{
+			"user_id": cancelled.UserID,
+		})
+		return cancelled, nil
+	}
+
+	if err := s.emailService.SendPayoutAddressCancelledNotification(ctx, user.Email, user.FirstName, *cancelled); err != nil {
+		s.logger.Error("Failed to send payout address cancelled notification", err, map[string]interface{}{
+			"user_id":           user.ID,
+			"payout_address_id": cancelled.ID,
+		})
+	}
+
+	return cancelled, nil
+}
+
+// cancelLink builds the cancel URL sent to the user; it opens a confirmation page
+func (s *payoutAddressService) cancelLink(token string) string {
+	return fmt.Sprintf("%s?token=%s", s.config.PayoutAddressCancelURL, url.QueryEscape(token))
+}
+
+// logSecurityEvent records an allowlist change in the security audit log
+func (s *payoutAddressService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
+
+// generateCancelToken creates a random, URL-safe cancel token
+func generateCancelToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashCancelToken hashes a cancel token so only the digest is stored
+func hashCancelToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
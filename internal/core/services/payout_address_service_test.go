@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type payoutAddressTestDeps struct {
+	payoutRepo   *mocks.FakePayoutAddressRepository
+	userRepo     *mocks.FakeUserRepository
+	securityRepo *mocks.FakeSecurityRepository
+	emailService *mocks.FakeEmailService
+}
+
+func newPayoutAddressTestService() (*payoutAddressTestDeps, *payoutAddressService) {
+	deps := &payoutAddressTestDeps{
+		payoutRepo:   new(mocks.FakePayoutAddressRepository),
+		userRepo:     new(mocks.FakeUserRepository),
+		securityRepo: new(mocks.FakeSecurityRepository),
+		emailService: new(mocks.FakeEmailService),
+	}
+
+	cfg := config.Config{
+		PayoutAddressCoolingOff: 24 * time.Hour,
+		PayoutAddressCancelURL:  "https://app.defifundr.com/payout-addresses/cancel",
+		LogOutput:               "stdout",
+		LogLevel:                "panic",
+	}
+
+	service := NewPayoutAddressService(deps.payoutRepo, deps.userRepo, deps.securityRepo, deps.emailService, cfg, logging.New(&cfg))
+	return deps, service.(*payoutAddressService)
+}
+
+func TestPayoutAddressService_RequestPayoutAddress(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	deps.payoutRepo.CreateWalletWithPayoutAddressStub = func(ctx context.Context, wallet domain.UserWallet, address domain.PayoutAddress) (*domain.PayoutAddress, error) {
+		return &address, nil
+	}
+
+	ctx := context.Background()
+	user := domain.User{ID: uuid.New(), Email: "test@example.com", FirstName: "Test"}
+	wallet := domain.UserWallet{ID: uuid.New(), UserID: user.ID, Address: "0x71C7656EC7ab88b098defB751B7401B5f6d8976F", Chain: "ethereum"}
+
+	// Act
+	result, err := service.RequestPayoutAddress(ctx, user, wallet)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PayoutAddressStatusPending, result.Status)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), result.AvailableAt, time.Minute)
+	assert.False(t, result.IsUsable(time.Now()))
+	assert.Equal(t, 1, deps.securityRepo.LogSecurityEventCallCount())
+
+	// The cancel link carries the raw token, only its hash is persisted
+	assert.Equal(t, 1, deps.emailService.SendPayoutAddressChangeNotificationCallCount())
+	_, email, _, _, cancelLink := deps.emailService.SendPayoutAddressChangeNotificationArgsForCall(0)
+	assert.Equal(t, user.Email, email)
+
+	link, err := url.Parse(cancelLink)
+	assert.NoError(t, err)
+	token := link.Query().Get("token")
+	assert.NotEmpty(t, token)
+	assert.NotEqual(t, token, result.CancelTokenHash)
+	assert.Equal(t, hashCancelToken(token), result.CancelTokenHash)
+}
+
+func TestPayoutAddressService_RequestPayoutAddress_EmailFailureKeepsEntry(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	deps.payoutRepo.CreateWalletWithPayoutAddressStub = func(ctx context.Context, wallet domain.UserWallet, address domain.PayoutAddress) (*domain.PayoutAddress, error) {
+		return &address, nil
+	}
+	deps.emailService.SendPayoutAddressChangeNotificationReturns(errors.New("smtp down"))
+
+	// Act
+	result, err := service.RequestPayoutAddress(context.Background(), domain.User{ID: uuid.New()}, domain.UserWallet{ID: uuid.New()})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestPayoutAddressService_ValidatePayoutAddress(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		entry     *domain.PayoutAddress
+		expectErr bool
+	}{
+		{
+			name:      "not_allowlisted",
+			entry:     nil,
+			expectErr: true,
+		},
+		{
+			name:      "cooling_off",
+			entry:     &domain.PayoutAddress{Status: domain.PayoutAddressStatusPending, AvailableAt: now.Add(time.Hour)},
+			expectErr: true,
+		},
+		{
+			name:      "cooling_off_elapsed",
+			entry:     &domain.PayoutAddress{Status: domain.PayoutAddressStatusPending, AvailableAt: now.Add(-time.Minute)},
+			expectErr: false,
+		},
+		{
+			name:      "active",
+			entry:     &domain.PayoutAddress{Status: domain.PayoutAddressStatusActive, AvailableAt: now.Add(-time.Hour)},
+			expectErr: false,
+		},
+		{
+			name:      "cancelled",
+			entry:     &domain.PayoutAddress{Status: domain.PayoutAddressStatusCancelled, AvailableAt: now.Add(-time.Hour)},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, service := newPayoutAddressTestService()
+			deps.payoutRepo.GetLatestPayoutAddressReturns(tt.entry, nil)
+
+			err := service.ValidatePayoutAddress(context.Background(), uuid.New(), "0xabc")
+
+			if tt.expectErr {
+				var appErr *appErrors.AppError
+				assert.True(t, errors.As(err, &appErr))
+				assert.Equal(t, appErrors.ErrorTypeForbidden, appErr.ErrorType)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// A pending address whose cooling-off period elapsed is activated on use
+			activated := tt.entry != nil && tt.entry.Status == domain.PayoutAddressStatusPending && !tt.expectErr
+			assert.Equal(t, activated, deps.payoutRepo.ActivateDuePayoutAddressesCallCount() == 1)
+		})
+	}
+}
+
+func TestPayoutAddressService_CancelPayoutAddressByToken(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	entry := &domain.PayoutAddress{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		Status:      domain.PayoutAddressStatusPending,
+		AvailableAt: time.Now().Add(time.Hour),
+	}
+	cancelled := *entry
+	cancelled.Status = domain.PayoutAddressStatusCancelled
+
+	deps.payoutRepo.GetPayoutAddressByCancelTokenHashReturns(entry, nil)
+	deps.payoutRepo.CancelPayoutAddressReturns(&cancelled, nil)
+	deps.userRepo.GetUserByIDReturns(&domain.User{ID: entry.UserID, Email: "test@example.com"}, nil)
+
+	// Act
+	result, err := service.CancelPayoutAddressByToken(context.Background(), "raw-token")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PayoutAddressStatusCancelled, result.Status)
+	_, tokenHash := deps.payoutRepo.GetPayoutAddressByCancelTokenHashArgsForCall(0)
+	assert.Equal(t, hashCancelToken("raw-token"), tokenHash)
+	assert.Equal(t, 1, deps.securityRepo.LogSecurityEventCallCount())
+	assert.Equal(t, 1, deps.emailService.SendPayoutAddressCancelledNotificationCallCount())
+}
+
+func TestPayoutAddressService_GetPayoutAddressByCancelToken(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	entry := &domain.PayoutAddress{
+		ID:          uuid.New(),
+		Status:      domain.PayoutAddressStatusPending,
+		AvailableAt: time.Now().Add(time.Hour),
+	}
+	deps.payoutRepo.GetPayoutAddressByCancelTokenHashReturns(entry, nil)
+
+	// Act
+	result, err := service.GetPayoutAddressByCancelToken(context.Background(), "raw-token")
+
+	// Assert: following the link only looks the change up
+	assert.NoError(t, err)
+	assert.Equal(t, entry.ID, result.ID)
+	assert.Equal(t, 0, deps.payoutRepo.CancelPayoutAddressCallCount())
+	assert.Equal(t, 0, deps.securityRepo.LogSecurityEventCallCount())
+
+	_, err = service.GetPayoutAddressByCancelToken(context.Background(), "")
+	var appErr *appErrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, appErrors.ErrorTypeValidation, appErr.ErrorType)
+}
+
+func TestPayoutAddressService_CancelPayoutAddress_AfterCoolingOff(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	userID := uuid.New()
+	deps.payoutRepo.GetPayoutAddressByIDReturns(&domain.PayoutAddress{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Status:      domain.PayoutAddressStatusPending,
+		AvailableAt: time.Now().Add(-time.Minute),
+	}, nil)
+
+	// Act
+	_, err := service.CancelPayoutAddress(context.Background(), userID, uuid.New())
+
+	// Assert
+	var appErr *appErrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, appErrors.ErrorTypeConflict, appErr.ErrorType)
+	assert.Equal(t, 0, deps.payoutRepo.CancelPayoutAddressCallCount())
+}
+
+func TestPayoutAddressService_CancelPayoutAddress_OtherUser(t *testing.T) {
+	// Arrange
+	deps, service := newPayoutAddressTestService()
+	deps.payoutRepo.GetPayoutAddressByIDReturns(&domain.PayoutAddress{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		Status:      domain.PayoutAddressStatusPending,
+		AvailableAt: time.Now().Add(time.Hour),
+	}, nil)
+
+	// Act
+	_, err := service.CancelPayoutAddress(context.Background(), uuid.New(), uuid.New())
+
+	// Assert
+	var appErr *appErrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, appErrors.ErrorTypeNotFound, appErr.ErrorType)
+	assert.Equal(t, 0, deps.payoutRepo.CancelPayoutAddressCallCount())
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+// ListPayslips builds the payslip of every employee in one of the
+// organization's pay runs
+func (s *payrollService) ListPayslips(ctx context.Context, userID, orgID, payRunID uuid.UUID) ([]domain.Payslip, error) {
+	run, err := s.GetPayRun(ctx, userID, orgID, payRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgService.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips := make([]domain.Payslip, 0, len(run.LineItems))
+	for _, item := range run.LineItems {
+		payslip, err := s.payslip(ctx, *run, item, *org)
+		if err != nil {
+			return nil, err
+		}
+		payslips = append(payslips, *payslip)
+	}
+
+	return payslips, nil
+}
+
+// GetPayslip builds one employee's payslip in a pay run. Employees can see
+// their own payslips; anyone else needs a role that manages finances.
+func (s *payrollService) GetPayslip(ctx context.Context, userID, orgID, payRunID, employeeID uuid.UUID) (*domain.Payslip, error) {
+	member, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if member.UserID != employeeID && !member.Role.CanManageFinances() {
+		return nil, appErrors.NewForbiddenError("you can only view your own payslips")
+	}
+
+	run, err := s.payrollRepo.GetPayRun(ctx, payRunID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil || run.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("pay run not found")
+	}
+
+	for _, item := range run.LineItems {
+		if item.UserID != employeeID {
+			continue
+		}
+
+		org, err := s.orgService.GetOrganizationByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		return s.payslip(ctx, *run, item, *org)
+	}
+
+	return nil, appErrors.NewNotFoundError("payslip not found")
+}
+
+// payslip builds a line item's payslip. The payee is the seller of the work
+// and the organization the buyer, so a contractor registered for VAT has the
+// tax on their pay broken out at the rate in force on the pay date. Payees
+// without a tax country, or in a country without rates, get no tax lines.
+func (s *payrollService) payslip(ctx context.Context, run domain.PayRun, item domain.PayRunLineItem, org domain.Organization) (*domain.Payslip, error) {
+	var tax *domain.TaxDetermination
+
+	if item.TaxCountry != "" {
+		seller := domain.TaxParty{Country: item.TaxCountry, TaxID: item.TaxID}
+		buyer := domain.TaxParty{Country: org.Country, TaxID: org.TaxID}
+
+		determination, err := s.taxService.Determine(ctx, seller, buyer, run.PayDate)
+		switch appErrors.GetErrorType(err) {
+		case appErrors.ErrorTypeNotFound, appErrors.ErrorTypeValidation:
+			// Missing rates or an organization without a country leave the
+			// pay as it is rather than holding up the payslip
+			s.logger.Warn("Payslip issued without a tax breakdown", map[string]interface{}{
+				"pay_run_id":  run.ID,
+				"employee_id": item.UserID,
+				"reason":      err.Error(),
+			})
+		default:
+			if err != nil {
+				return nil, err
+			}
+			tax = determination
+		}
+	}
+
+	places, err := currencyAmountPlaces(ctx, s.assetService, item.Amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	payslip := domain.NewPayslip(run, item, org.Name, tax, places)
+	return &payslip, nil
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayslipRun builds an approved pay run paying a VAT-registered contractor
+// in Germany and an employee without tax details
+func testPayslipRun(orgID uuid.UUID, payDate time.Time, contractorID, employeeID uuid.UUID) *domain.PayRun {
+	return &domain.PayRun{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		PayDate:        payDate,
+		PeriodStart:    payDate.AddDate(0, -1, 0),
+		Status:         domain.PayRunStatusApproved,
+		LineItems: []domain.PayRunLineItem{
+			{ID: uuid.New(), UserID: contractorID, FirstName: "Ada", Amount: money.MustParse("1190", "EUR"), PayoutAssetSymbol: "USDC", TaxCountry: "DE", TaxID: "DE123456789"},
+			{ID: uuid.New(), UserID: employeeID, FirstName: "Grace", Amount: money.MustParse("2500", "USD"), PayoutAssetSymbol: "USDC"},
+		},
+	}
+}
+
+func testGermanVAT() *domain.TaxDetermination {
+	return &domain.TaxDetermination{
+		Treatment:    domain.TaxTreatmentStandard,
+		Country:      "DE",
+		TaxName:      "VAT",
+		Rate:         decimal.RequireFromString("19"),
+		RatesVersion: "2025.1",
+	}
+}
+
+func TestPayrollService_ListPayslips(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	contractorID, employeeID := uuid.New(), uuid.New()
+	run := testPayslipRun(orgID, now, contractorID, employeeID)
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "DE", TaxID: "DE987654321"}, nil)
+	mockTaxService.DetermineReturns(testGermanVAT(), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	payslips, err := service.ListPayslips(context.Background(), financeID, orgID, run.ID)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, payslips, 2)
+
+	// The contractor's pay includes VAT, which is broken out of it
+	contractor := payslips[0]
+	assert.Equal(t, "Acme", contractor.OrganizationName)
+	assert.Equal(t, "1190", contractor.Gross.Amount().String())
+	assert.Equal(t, "1000", contractor.Net.Amount().String())
+	require.Len(t, contractor.TaxLines, 1)
+	assert.Equal(t, "VAT 19%", contractor.TaxLines[0].Label)
+	assert.Equal(t, "190", contractor.TaxLines[0].Tax.Amount().String())
+	assert.Equal(t, "2025.1", contractor.TaxRatesVersion)
+
+	_, seller, buyer, at := mockTaxService.DetermineArgsForCall(0)
+	assert.Equal(t, domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, seller)
+	assert.Equal(t, domain.TaxParty{Country: "DE", TaxID: "DE987654321"}, buyer)
+	assert.Equal(t, run.PayDate, at)
+
+	employee := payslips[1]
+	assert.Empty(t, employee.TaxLines)
+	assert.Equal(t, employee.Gross, employee.Net)
+	assert.Equal(t, 1, mockTaxService.DetermineCallCount())
+}
+
+func TestPayrollService_ListPayslips_WithoutRates(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	run := testPayslipRun(orgID, now, uuid.New(), uuid.New())
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "DE", TaxID: "DE987654321"}, nil)
+	mockTaxService.DetermineReturns(nil, appErrors.NewNotFoundError(`no tax rates for country "DE"`))
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	payslips, err := service.ListPayslips(context.Background(), financeID, orgID, run.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, payslips[0].TaxLines)
+	assert.Equal(t, "1190", payslips[0].Net.Amount().String())
+}
+
+func TestPayrollService_GetPayslip(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	contractorID, employeeID, financeID := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		contractorID: domain.OrganizationRoleViewer,
+		employeeID:   domain.OrganizationRoleViewer,
+		financeID:    domain.OrganizationRoleFinance,
+	})
+	run := testPayslipRun(orgID, now, contractorID, employeeID)
+	mockPayrollRepo.GetPayRunReturns(run, nil)
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme", Country: "DE", TaxID: "DE987654321"}, nil)
+	mockTaxService.DetermineReturns(testGermanVAT(), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	own, ownErr := service.GetPayslip(ctx, contractorID, orgID, run.ID, contractorID)
+	other, otherErr := service.GetPayslip(ctx, financeID, orgID, run.ID, employeeID)
+	_, peerErr := service.GetPayslip(ctx, employeeID, orgID, run.ID, contractorID)
+	_, unpaidErr := service.GetPayslip(ctx, financeID, orgID, run.ID, financeID)
+	_, listErr := service.ListPayslips(ctx, employeeID, orgID, run.ID)
+
+	// Assert
+	require.NoError(t, ownErr)
+	assert.Equal(t, "Ada", own.FirstName)
+	assert.Equal(t, "1000", own.Net.Amount().String())
+
+	require.NoError(t, otherErr)
+	assert.Equal(t, "Grace", other.FirstName)
+
+	// Employees cannot see each other's pay
+	assertAppErrorType(t, peerErr, appErrors.ErrorTypeForbidden)
+	assertAppErrorType(t, unpaidErr, appErrors.ErrorTypeNotFound)
+	assertAppErrorType(t, listErr, appErrors.ErrorTypeForbidden)
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+// PayrollScheduler periodically turns arrived pay dates into draft pay runs
+// and expires pay run approvals whose window has passed. Both steps are
+// idempotent, so several instances can run side by side.
+type PayrollScheduler struct {
+	payrollService  ports.PayrollService
+	approvalService ports.ApprovalService
+	config          config.Config
+	logger          logging.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewPayrollScheduler creates a new pay run generator
+func NewPayrollScheduler(payrollService ports.PayrollService, approvalService ports.ApprovalService, config config.Config, logger logging.Logger) *PayrollScheduler {
+	return &PayrollScheduler{
+		payrollService:  payrollService,
+		approvalService: approvalService,
+		config:          config,
+		logger:          logger,
+		stop:            make(chan struct{}),
+	}
+}
+
+// Start generates due pay runs and expires stale approvals in the background every PayrollPollInterval until Stop is called
+func (p *PayrollScheduler) Start() {
+	interval := p.config.PayrollPollInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if _, err := p.payrollService.GenerateDuePayRuns(ctx); err != nil {
+					p.logger.Error("Payroll scheduler run failed", err)
+				}
+				if _, err := p.approvalService.ExpireStaleApprovals(ctx); err != nil {
+					p.logger.Error("Failed to expire stale pay run approvals", err)
+				}
+				cancel()
+			}
+		}
+	}()
+
+	p.logger.Info("Payroll scheduler started", map[string]interface{}{
+		"poll_interval": interval.String(),
+	})
+}
+
+// Stop stops the background generator and waits for an in-flight run to finish
+func (p *PayrollScheduler) Stop() {
+	close(p.stop)
+	p.wg.Wait()
+	p.logger.Info("Payroll scheduler stopped")
+}
@@ -0,0 +1,492 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+)
+
+const (
+	// payrollDueBatchSize is how many due schedules are loaded per generator pass
+	payrollDueBatchSize = 100
+	// payrollCatchUpLimit caps the missed pay dates generated for one schedule in a
+	// pass; the rest follow on the next pass
+	payrollCatchUpLimit = 24
+)
+
+type payrollService struct {
+	payrollRepo    ports.PayrollRepository
+	orgService     ports.OrganizationService
+	assetService   ports.AssetService
+	payoutService  ports.PayoutAddressService
+	fxService      ports.FXService
+	taxService     ports.TaxService
+	contractClient ports.PayrollContractClient
+	config         config.Config
+	logger         logging.Logger
+	now            func() time.Time
+}
+
+// NewPayrollService creates a new payroll service. contractClient may be nil
+// when no payroll contract is configured, in which case simulations carry no
+// gas estimate. Payslips break out tax with taxService.
+func NewPayrollService(
+	payrollRepo ports.PayrollRepository,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	payoutService ports.PayoutAddressService,
+	fxService ports.FXService,
+	taxService ports.TaxService,
+	contractClient ports.PayrollContractClient,
+	config config.Config,
+	logger logging.Logger,
+) ports.PayrollService {
+	return &payrollService{
+		payrollRepo:    payrollRepo,
+		orgService:     orgService,
+		assetService:   assetService,
+		payoutService:  payoutService,
+		fxService:      fxService,
+		taxService:     taxService,
+		contractClient: contractClient,
+		config:         config,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// CreateSchedule creates a payroll schedule. Its first pay run is generated on
+// the first pay date after now, so a first pay date in the past is not back-filled.
+func (s *payrollService) CreateSchedule(ctx context.Context, userID, orgID uuid.UUID, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	schedule.ID = uuid.New()
+	schedule.OrganizationID = orgID
+	schedule.CreatedBy = &userID
+	schedule.Active = true
+
+	if err := s.prepareSchedule(&schedule, s.now()); err != nil {
+		return nil, err
+	}
+
+	created, err := s.payrollRepo.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Payroll schedule created", map[string]interface{}{
+		"organization_id": orgID,
+		"schedule_id":     created.ID,
+		"frequency":       created.Frequency,
+		"next_run_at":     created.NextRunAt,
+	})
+
+	return created, nil
+}
+
+// ListSchedules lists an organization's payroll schedules
+func (s *payrollService) ListSchedules(ctx context.Context, userID, orgID uuid.UUID) ([]domain.PayrollSchedule, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	return s.payrollRepo.ListSchedules(ctx, orgID)
+}
+
+// GetSchedule retrieves one of the organization's payroll schedules
+func (s *payrollService) GetSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID) (*domain.PayrollSchedule, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	return s.getSchedule(ctx, orgID, scheduleID)
+}
+
+// UpdateSchedule replaces a schedule's settings. The next pay date is
+// recomputed from now, so pausing and resuming skips the dates in between.
+func (s *payrollService) UpdateSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getSchedule(ctx, orgID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Never schedule a date that already has a pay run
+	from := s.now()
+	if existing.LastRunAt != nil && existing.LastRunAt.After(from) {
+		from = *existing.LastRunAt
+	}
+
+	existing.Name = schedule.Name
+	existing.Frequency = schedule.Frequency
+	existing.CronExpression = schedule.CronExpression
+	existing.Timezone = schedule.Timezone
+	existing.FirstPayDate = schedule.FirstPayDate
+	existing.Active = schedule.Active
+
+	if err := s.prepareSchedule(existing, from); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.payrollRepo.UpdateSchedule(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Payroll schedule updated", map[string]interface{}{
+		"organization_id": orgID,
+		"schedule_id":     scheduleID,
+		"updated_by":      userID,
+		"active":          updated.Active,
+		"next_run_at":     updated.NextRunAt,
+	})
+
+	return updated, nil
+}
+
+// CreateCompensation records what an employee is paid on each date of a
+// schedule. The employee must be a member of the organization, can only have
+// one active record per schedule and is paid to a wallet on their payout
+// address allowlist that has cleared its cooling-off period.
+func (s *payrollService) CreateCompensation(ctx context.Context, userID, orgID uuid.UUID, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSchedule(ctx, orgID, compensation.ScheduleID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.orgService.AuthorizeMember(ctx, compensation.UserID, orgID, nil); err != nil {
+		if appErrors.GetErrorType(err) == appErrors.ErrorTypeNotFound {
+			return nil, appErrors.NewValidationError("the employee must be a member of the organization")
+		}
+		return nil, err
+	}
+
+	if err := s.validateCompensation(ctx, &compensation); err != nil {
+		return nil, err
+	}
+
+	if err := s.payoutService.ValidatePayoutAddress(ctx, compensation.UserID, compensation.WalletAddress); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureNoActiveCompensation(ctx, orgID, compensation.ScheduleID, compensation.UserID, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	compensation.ID = uuid.New()
+	compensation.OrganizationID = orgID
+	compensation.Active = true
+
+	created, err := s.payrollRepo.CreateCompensation(ctx, compensation)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Employee compensation created", map[string]interface{}{
+		"organization_id": orgID,
+		"compensation_id": created.ID,
+		"schedule_id":     created.ScheduleID,
+		"employee_id":     created.UserID,
+		"created_by":      userID,
+	})
+
+	return created, nil
+}
+
+// ListCompensations lists an organization's compensation records, optionally for one schedule
+func (s *payrollService) ListCompensations(ctx context.Context, userID, orgID uuid.UUID, scheduleID *uuid.UUID) ([]domain.EmployeeCompensation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	return s.payrollRepo.ListCompensations(ctx, orgID, scheduleID)
+}
+
+// UpdateCompensation replaces a compensation record's pay details. Pay runs
+// already generated keep the amounts they were created with.
+func (s *payrollService) UpdateCompensation(ctx context.Context, userID, orgID, compensationID uuid.UUID, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.payrollRepo.GetCompensation(ctx, compensationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("compensation not found")
+	}
+
+	if err := s.validateCompensation(ctx, &compensation); err != nil {
+		return nil, err
+	}
+
+	// The wallet must be on the employee's allowlist for as long as it can be paid
+	if compensation.Active {
+		if err := s.payoutService.ValidatePayoutAddress(ctx, existing.UserID, compensation.WalletAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	if compensation.Active && !existing.Active {
+		if err := s.ensureNoActiveCompensation(ctx, orgID, existing.ScheduleID, existing.UserID, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.Amount = compensation.Amount
+	existing.PayoutAssetID = compensation.PayoutAssetID
+	existing.WalletAddress = compensation.WalletAddress
+	existing.TaxCountry = compensation.TaxCountry
+	existing.TaxID = compensation.TaxID
+	existing.Active = compensation.Active
+
+	updated, err := s.payrollRepo.UpdateCompensation(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Employee compensation updated", map[string]interface{}{
+		"organization_id": orgID,
+		"compensation_id": compensationID,
+		"updated_by":      userID,
+		"active":          updated.Active,
+	})
+
+	return updated, nil
+}
+
+// ListPayRuns lists an organization's pay runs, latest pay date first
+func (s *payrollService) ListPayRuns(ctx context.Context, userID, orgID uuid.UUID, page, pageSize int) ([]domain.PayRun, int64, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, 0, err
+	}
+
+	return s.payrollRepo.ListPayRuns(ctx, orgID, pageSize, (page-1)*pageSize)
+}
+
+// GetPayRun retrieves one of the organization's pay runs with its line items
+func (s *payrollService) GetPayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID) (*domain.PayRun, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	run, err := s.payrollRepo.GetPayRun(ctx, payRunID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil || run.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("pay run not found")
+	}
+
+	return run, nil
+}
+
+// GenerateDuePayRuns creates a draft pay run for every pay date that has
+// arrived. A schedule that fails is logged and skipped so it cannot hold up
+// the others.
+func (s *payrollService) GenerateDuePayRuns(ctx context.Context) (int, error) {
+	now := s.now()
+
+	schedules, err := s.payrollRepo.ListDueSchedules(ctx, now, payrollDueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, schedule := range schedules {
+		count, err := s.generateForSchedule(ctx, schedule, now)
+		generated += count
+		if err != nil {
+			s.logger.Error("Failed to generate pay run", err, map[string]interface{}{
+				"organization_id": schedule.OrganizationID,
+				"schedule_id":     schedule.ID,
+				"pay_date":        schedule.NextRunAt,
+			})
+		}
+	}
+
+	return generated, nil
+}
+
+// generateForSchedule generates the schedule's pay runs for each pay date up to now
+func (s *payrollService) generateForSchedule(ctx context.Context, schedule domain.PayrollSchedule, now time.Time) (int, error) {
+	generated := 0
+
+	for i := 0; i < payrollCatchUpLimit && !schedule.NextRunAt.After(now); i++ {
+		payDate := schedule.NextRunAt
+
+		next, err := schedule.NextPayDate(payDate)
+		if err != nil {
+			return generated, err
+		}
+
+		run, err := s.payrollRepo.GeneratePayRun(ctx, schedule.ID, payDate, next)
+		if err != nil {
+			return generated, err
+		}
+
+		// Another instance generated this date and moved the schedule on
+		if run == nil {
+			return generated, nil
+		}
+
+		generated++
+		s.logger.Info("Draft pay run generated", map[string]interface{}{
+			"organization_id": schedule.OrganizationID,
+			"schedule_id":     schedule.ID,
+			"pay_run_id":      run.ID,
+			"pay_date":        payDate,
+			"line_items":      len(run.LineItems),
+		})
+
+		schedule.NextRunAt = next
+	}
+
+	return generated, nil
+}
+
+// getSchedule loads a schedule that belongs to the organization
+func (s *payrollService) getSchedule(ctx context.Context, orgID, scheduleID uuid.UUID) (*domain.PayrollSchedule, error) {
+	schedule, err := s.payrollRepo.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil || schedule.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("payroll schedule not found")
+	}
+
+	return schedule, nil
+}
+
+// prepareSchedule normalises and validates a schedule's settings and sets its
+// next pay date to the first one after from
+func (s *payrollService) prepareSchedule(schedule *domain.PayrollSchedule, from time.Time) error {
+	schedule.Name = strings.TrimSpace(schedule.Name)
+	if schedule.Name == "" {
+		return appErrors.NewValidationError("name is required")
+	}
+
+	if !schedule.Frequency.IsValid() {
+		return appErrors.NewValidationError(fmt.Sprintf("invalid frequency %q", schedule.Frequency))
+	}
+
+	schedule.CronExpression = strings.TrimSpace(schedule.CronExpression)
+	if schedule.Frequency == domain.PayrollFrequencyCustom {
+		if schedule.CronExpression == "" {
+			return appErrors.NewValidationError("a cron expression is required for custom schedules")
+		}
+		if _, err := domain.ParsePayrollCron(schedule.CronExpression); err != nil {
+			return appErrors.NewValidationError(err.Error())
+		}
+	} else {
+		schedule.CronExpression = ""
+	}
+
+	schedule.Timezone = strings.TrimSpace(schedule.Timezone)
+	if schedule.Timezone == "" {
+		schedule.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+		return appErrors.NewValidationError(fmt.Sprintf("invalid timezone %q", schedule.Timezone))
+	}
+
+	if schedule.FirstPayDate.IsZero() {
+		return appErrors.NewValidationError("first pay date is required")
+	}
+	// Stored timestamps keep microseconds; whole seconds keep computed dates comparable
+	schedule.FirstPayDate = schedule.FirstPayDate.Truncate(time.Second)
+
+	next, err := schedule.NextPayDate(from)
+	if err != nil {
+		return appErrors.NewValidationError(err.Error())
+	}
+	schedule.NextRunAt = next
+
+	return nil
+}
+
+// validateCompensation checks the amount, payout asset, wallet and tax details
+// of a compensation record and normalises the wallet address and tax ID
+func (s *payrollService) validateCompensation(ctx context.Context, compensation *domain.EmployeeCompensation) error {
+	if !compensation.Amount.IsPositive() {
+		return appErrors.NewValidationError("amount must be greater than zero")
+	}
+	if compensation.Amount.Currency() == "" {
+		return appErrors.NewValidationError("currency is required")
+	}
+
+	asset, err := s.assetService.GetAsset(ctx, compensation.PayoutAssetID)
+	if err != nil {
+		if appErrors.GetErrorType(err) == appErrors.ErrorTypeNotFound {
+			return appErrors.NewValidationError("payout asset is not supported")
+		}
+		return err
+	}
+	if !asset.Enabled {
+		return appErrors.NewValidationError(fmt.Sprintf("%s on %s is not enabled for payouts", asset.Symbol, asset.Chain))
+	}
+
+	// Paid in the asset itself, the amount must be payable in its smallest unit
+	if strings.EqualFold(compensation.Amount.Currency(), asset.Symbol) {
+		if _, err := s.assetService.ValidateAmount(ctx, asset.Chain, compensation.Amount); err != nil {
+			return err
+		}
+	}
+
+	wallet, ok := normalizeAddress(asset.Chain, compensation.WalletAddress)
+	if !ok {
+		return appErrors.NewValidationError(fmt.Sprintf("wallet address is not a valid %s address", asset.Chain))
+	}
+	compensation.WalletAddress = wallet
+
+	compensation.TaxCountry = strings.ToUpper(strings.TrimSpace(compensation.TaxCountry))
+	if compensation.TaxCountry != "" && !isCountryCode(compensation.TaxCountry) {
+		return appErrors.NewValidationError("tax country must be a two-letter ISO 3166-1 code")
+	}
+	compensation.TaxID = domain.NormalizeTaxID(compensation.TaxID)
+	if len(compensation.TaxID) > 64 {
+		return appErrors.NewValidationError("tax ID can be at most 64 characters")
+	}
+	if compensation.TaxID != "" && compensation.TaxCountry == "" {
+		return appErrors.NewValidationError("a tax ID needs the country it is registered in")
+	}
+
+	// Amounts are stored with 18 decimal places
+	compensation.Amount = compensation.Amount.Round(18, money.RoundHalfEven)
+
+	return nil
+}
+
+// ensureNoActiveCompensation rejects a second active record for the employee on the schedule
+func (s *payrollService) ensureNoActiveCompensation(ctx context.Context, orgID, scheduleID, employeeID, exceptID uuid.UUID) error {
+	compensations, err := s.payrollRepo.ListCompensations(ctx, orgID, &scheduleID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range compensations {
+		if existing.Active && existing.UserID == employeeID && existing.ID != exceptID {
+			return appErrors.NewConflictError("the employee already has an active compensation on this schedule")
+		}
+	}
+
+	return nil
+}
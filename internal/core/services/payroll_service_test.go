@@ -0,0 +1,757 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayrollSchedule_NextPayDate(t *testing.T) {
+	// Arrange
+	lagos, err := time.LoadLocation("Africa/Lagos")
+	require.NoError(t, err)
+
+	anchor := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		schedule domain.PayrollSchedule
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "before_first_pay_date",
+			schedule: domain.PayrollSchedule{Frequency: domain.PayrollFrequencyWeekly, Timezone: "UTC", FirstPayDate: anchor},
+			after:    anchor.Add(-48 * time.Hour),
+			expected: anchor,
+		},
+		{
+			name:     "weekly_on_pay_date",
+			schedule: domain.PayrollSchedule{Frequency: domain.PayrollFrequencyWeekly, Timezone: "UTC", FirstPayDate: anchor},
+			after:    anchor.AddDate(0, 0, 14),
+			expected: anchor.AddDate(0, 0, 21),
+		},
+		{
+			name:     "biweekly",
+			schedule: domain.PayrollSchedule{Frequency: domain.PayrollFrequencyBiweekly, Timezone: "UTC", FirstPayDate: anchor},
+			after:    anchor.AddDate(0, 0, 15),
+			expected: anchor.AddDate(0, 0, 28),
+		},
+		{
+			name:     "monthly_clamps_to_february",
+			schedule: domain.PayrollSchedule{Frequency: domain.PayrollFrequencyMonthly, Timezone: "UTC", FirstPayDate: anchor},
+			after:    anchor,
+			expected: time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "monthly_returns_to_anchor_day",
+			schedule: domain.PayrollSchedule{Frequency: domain.PayrollFrequencyMonthly, Timezone: "UTC", FirstPayDate: anchor},
+			after:    time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "custom_cron_in_timezone",
+			schedule: domain.PayrollSchedule{
+				Frequency:      domain.PayrollFrequencyCustom,
+				CronExpression: "0 9 1,15 * *",
+				Timezone:       "Africa/Lagos",
+				FirstPayDate:   anchor,
+			},
+			after:    time.Date(2025, 2, 1, 9, 0, 0, 0, lagos),
+			expected: time.Date(2025, 2, 15, 9, 0, 0, 0, lagos),
+		},
+		{
+			name: "custom_cron_before_first_pay_date",
+			schedule: domain.PayrollSchedule{
+				Frequency:      domain.PayrollFrequencyCustom,
+				CronExpression: "0 9 1,15 * *",
+				Timezone:       "UTC",
+				FirstPayDate:   anchor,
+			},
+			after:    anchor.AddDate(0, -1, 0),
+			expected: time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			next, err := tc.schedule.NextPayDate(tc.after)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(next), "expected %s, got %s", tc.expected, next)
+		})
+	}
+}
+
+func TestPayrollService_CreateSchedule(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID, viewerID := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		financeID: domain.OrganizationRoleFinance,
+		viewerID:  domain.OrganizationRoleViewer,
+	})
+	mockPayrollRepo.CreateScheduleStub = func(ctx context.Context, schedule domain.PayrollSchedule) (*domain.PayrollSchedule, error) {
+		return &schedule, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	schedule, err := service.CreateSchedule(ctx, financeID, orgID, domain.PayrollSchedule{
+		Name:           " Monthly staff ",
+		Frequency:      domain.PayrollFrequencyMonthly,
+		CronExpression: "ignored",
+		FirstPayDate:   time.Date(2025, 1, 25, 9, 0, 0, 0, time.UTC),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Monthly staff", schedule.Name)
+	assert.Equal(t, "UTC", schedule.Timezone)
+	assert.Empty(t, schedule.CronExpression)
+	assert.True(t, schedule.Active)
+	// A first pay date in the past is not back-filled
+	assert.Equal(t, time.Date(2025, 5, 25, 9, 0, 0, 0, time.UTC), schedule.NextRunAt.UTC())
+
+	testCases := []struct {
+		name     string
+		userID   uuid.UUID
+		schedule domain.PayrollSchedule
+		errType  appErrors.ErrorType
+	}{
+		{
+			name:     "viewer_cannot_manage_payroll",
+			userID:   viewerID,
+			schedule: domain.PayrollSchedule{Name: "Weekly", Frequency: domain.PayrollFrequencyWeekly, FirstPayDate: now},
+			errType:  appErrors.ErrorTypeForbidden,
+		},
+		{
+			name:     "missing_cron_expression",
+			userID:   financeID,
+			schedule: domain.PayrollSchedule{Name: "Custom", Frequency: domain.PayrollFrequencyCustom, FirstPayDate: now},
+			errType:  appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "invalid_cron_expression",
+			userID:   financeID,
+			schedule: domain.PayrollSchedule{Name: "Custom", Frequency: domain.PayrollFrequencyCustom, CronExpression: "every friday", FirstPayDate: now},
+			errType:  appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "invalid_timezone",
+			userID:   financeID,
+			schedule: domain.PayrollSchedule{Name: "Weekly", Frequency: domain.PayrollFrequencyWeekly, Timezone: "Mars/Olympus", FirstPayDate: now},
+			errType:  appErrors.ErrorTypeValidation,
+		},
+		{
+			name:     "missing_first_pay_date",
+			userID:   financeID,
+			schedule: domain.PayrollSchedule{Name: "Weekly", Frequency: domain.PayrollFrequencyWeekly},
+			errType:  appErrors.ErrorTypeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			_, err := service.CreateSchedule(ctx, tc.userID, orgID, tc.schedule)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+		})
+	}
+}
+
+// stubCompensationAssets enables USDC and registers DAI disabled, both on Ethereum
+func stubCompensationAssets(assetService *mocks.FakeAssetService, usdcID, daiID uuid.UUID) {
+	assetService.GetAssetStub = func(ctx context.Context, id uuid.UUID) (*domain.SupportedAsset, error) {
+		switch id {
+		case usdcID:
+			return &domain.SupportedAsset{ID: id, Chain: "ethereum", Symbol: "USDC", Enabled: true}, nil
+		case daiID:
+			return &domain.SupportedAsset{ID: id, Chain: "ethereum", Symbol: "DAI"}, nil
+		}
+		return nil, appErrors.NewNotFoundError("asset not found")
+	}
+}
+
+func TestPayrollService_CreateCompensation(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID, employeeID := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		adminID:    domain.OrganizationRoleAdmin,
+		employeeID: domain.OrganizationRoleViewer,
+	})
+	scheduleID, usdcID := uuid.New(), uuid.New()
+	mockPayrollRepo.GetScheduleReturns(&domain.PayrollSchedule{ID: scheduleID, OrganizationID: orgID}, nil)
+	mockPayrollRepo.CreateCompensationStub = func(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+		return &compensation, nil
+	}
+	stubCompensationAssets(mockAssetService, usdcID, uuid.New())
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	created, err := service.CreateCompensation(context.Background(), adminID, orgID, domain.EmployeeCompensation{
+		ScheduleID:    scheduleID,
+		UserID:        employeeID,
+		Amount:        money.MustParse("2500", "usd"),
+		PayoutAssetID: usdcID,
+		WalletAddress: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", created.WalletAddress)
+	assert.Equal(t, "USD", created.Amount.Currency())
+	assert.True(t, created.Active)
+}
+
+func TestPayrollService_CreateCompensation_Invalid(t *testing.T) {
+	usdcID, daiID := uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name    string
+		mutate  func(c *domain.EmployeeCompensation)
+		errType appErrors.ErrorType
+	}{
+		{name: "already_on_schedule", mutate: func(c *domain.EmployeeCompensation) {}, errType: appErrors.ErrorTypeConflict},
+		{name: "not_a_member", mutate: func(c *domain.EmployeeCompensation) { c.UserID = uuid.New() }, errType: appErrors.ErrorTypeValidation},
+		{name: "unknown_schedule", mutate: func(c *domain.EmployeeCompensation) { c.ScheduleID = uuid.New() }, errType: appErrors.ErrorTypeNotFound},
+		{name: "zero_amount", mutate: func(c *domain.EmployeeCompensation) { c.Amount = money.Zero("USD") }, errType: appErrors.ErrorTypeValidation},
+		{name: "disabled_asset", mutate: func(c *domain.EmployeeCompensation) { c.PayoutAssetID = daiID }, errType: appErrors.ErrorTypeValidation},
+		{name: "unknown_asset", mutate: func(c *domain.EmployeeCompensation) { c.PayoutAssetID = uuid.New() }, errType: appErrors.ErrorTypeValidation},
+		{name: "invalid_wallet", mutate: func(c *domain.EmployeeCompensation) { c.WalletAddress = "not-a-wallet" }, errType: appErrors.ErrorTypeValidation},
+		{name: "invalid_tax_country", mutate: func(c *domain.EmployeeCompensation) { c.TaxCountry = "DEU" }, errType: appErrors.ErrorTypeValidation},
+		{name: "tax_id_without_country", mutate: func(c *domain.EmployeeCompensation) { c.TaxID = "DE123456789" }, errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockPayrollRepo := new(mocks.FakePayrollRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockPayoutService := new(mocks.FakePayoutAddressService)
+			mockFXService := new(mocks.FakeFXService)
+			mockTaxService := new(mocks.FakeTaxService)
+			mockContract := new(mocks.FakePayrollContractClient)
+
+			now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			adminID, employeeID := uuid.New(), uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+				adminID:    domain.OrganizationRoleAdmin,
+				employeeID: domain.OrganizationRoleViewer,
+			})
+			scheduleID := uuid.New()
+			stubCompensationAssets(mockAssetService, usdcID, daiID)
+
+			// The employee is already paid on the schedule
+			mockPayrollRepo.ListCompensationsReturns([]domain.EmployeeCompensation{{ID: uuid.New(), ScheduleID: scheduleID, UserID: employeeID, Active: true}}, nil)
+
+			compensation := domain.EmployeeCompensation{
+				ScheduleID:    scheduleID,
+				UserID:        employeeID,
+				Amount:        money.MustParse("2500", "USD"),
+				PayoutAssetID: usdcID,
+				WalletAddress: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			}
+			tc.mutate(&compensation)
+			if compensation.ScheduleID == scheduleID {
+				mockPayrollRepo.GetScheduleReturns(&domain.PayrollSchedule{ID: scheduleID, OrganizationID: orgID}, nil)
+			}
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+			service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateCompensation(context.Background(), adminID, orgID, compensation)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Zero(t, mockPayrollRepo.CreateCompensationCallCount())
+		})
+	}
+}
+
+func TestPayrollService_CreateCompensation_WalletCoolingOff(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID, employeeID := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		adminID:    domain.OrganizationRoleAdmin,
+		employeeID: domain.OrganizationRoleViewer,
+	})
+	scheduleID, usdcID := uuid.New(), uuid.New()
+	mockPayrollRepo.GetScheduleReturns(&domain.PayrollSchedule{ID: scheduleID, OrganizationID: orgID}, nil)
+	stubCompensationAssets(mockAssetService, usdcID, uuid.New())
+	// The employee's wallet must have cleared its cooling-off period
+	mockPayoutService.ValidatePayoutAddressReturns(appErrors.NewForbiddenError("payout address is in its cooling-off period"))
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.CreateCompensation(context.Background(), adminID, orgID, domain.EmployeeCompensation{
+		ScheduleID:    scheduleID,
+		UserID:        employeeID,
+		Amount:        money.MustParse("2500", "USD"),
+		PayoutAssetID: usdcID,
+		WalletAddress: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	})
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Zero(t, mockPayrollRepo.CreateCompensationCallCount())
+	_, owner, wallet := mockPayoutService.ValidatePayoutAddressArgsForCall(0)
+	assert.Equal(t, employeeID, owner)
+	assert.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", wallet)
+}
+
+func TestPayrollService_CreateCompensation_PayoutAsset(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	adminID, employeeID := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		adminID:    domain.OrganizationRoleAdmin,
+		employeeID: domain.OrganizationRoleViewer,
+	})
+	scheduleID := uuid.New()
+	usdt := domain.SupportedAsset{ID: uuid.New(), Chain: "tron", Symbol: "USDT", Decimals: 6, Enabled: true}
+	mockPayrollRepo.GetScheduleReturns(&domain.PayrollSchedule{ID: scheduleID, OrganizationID: orgID}, nil)
+	mockPayrollRepo.CreateCompensationStub = func(ctx context.Context, compensation domain.EmployeeCompensation) (*domain.EmployeeCompensation, error) {
+		return &compensation, nil
+	}
+	mockAssetService.GetAssetReturns(&usdt, nil)
+
+	compensation := domain.EmployeeCompensation{
+		ScheduleID:    scheduleID,
+		UserID:        employeeID,
+		Amount:        money.MustParse("2500", "USDT"),
+		PayoutAssetID: usdt.ID,
+		WalletAddress: " TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t ",
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act: a Tron wallet for a Tron asset, with the amount checked in the asset's decimals
+	created, err := service.CreateCompensation(ctx, adminID, orgID, compensation)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", created.WalletAddress)
+	require.Equal(t, 1, mockAssetService.ValidateAmountCallCount())
+	_, chain, amount := mockAssetService.ValidateAmountArgsForCall(0)
+	assert.Equal(t, "tron", chain)
+	assert.Equal(t, "2500 USDT", amount.String())
+
+	// Act: an EVM wallet cannot receive on Tron
+	evmWallet := compensation
+	evmWallet.WalletAddress = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	_, evmErr := service.CreateCompensation(ctx, adminID, orgID, evmWallet)
+
+	// Act: more decimal places than the asset has
+	mockAssetService.ValidateAmountReturns(nil, appErrors.NewValidationError("USDT supports at most 6 decimal places"))
+	precise := compensation
+	precise.Amount = money.MustParse("2500.0000001", "USDT")
+	_, preciseErr := service.CreateCompensation(ctx, adminID, orgID, precise)
+
+	// Assert
+	assertAppErrorType(t, evmErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, preciseErr, appErrors.ErrorTypeValidation)
+	assert.Equal(t, 1, mockPayrollRepo.CreateCompensationCallCount())
+}
+
+func TestPayrollService_GenerateDuePayRuns(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	// The generator was down for two weeks, so three weekly dates are due
+	missed := domain.PayrollSchedule{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Frequency:      domain.PayrollFrequencyWeekly,
+		Timezone:       "UTC",
+		FirstPayDate:   now.AddDate(0, 0, -29),
+		NextRunAt:      now.AddDate(0, 0, -15),
+		Active:         true,
+	}
+	// Another instance already generated this one
+	taken := domain.PayrollSchedule{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Frequency:      domain.PayrollFrequencyMonthly,
+		Timezone:       "UTC",
+		FirstPayDate:   now.Add(-time.Hour),
+		NextRunAt:      now.Add(-time.Hour),
+		Active:         true,
+	}
+	// A broken schedule must not stop the others
+	broken := domain.PayrollSchedule{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Frequency:      domain.PayrollFrequencyWeekly,
+		Timezone:       "Nowhere/Invalid",
+		FirstPayDate:   now.Add(-time.Hour),
+		NextRunAt:      now.Add(-time.Hour),
+		Active:         true,
+	}
+	mockPayrollRepo.ListDueSchedulesReturns([]domain.PayrollSchedule{broken, missed, taken}, nil)
+
+	var payDates, nextDates []time.Time
+	mockPayrollRepo.GeneratePayRunStub = func(ctx context.Context, scheduleID uuid.UUID, payDate, nextRunAt time.Time) (*domain.PayRun, error) {
+		if scheduleID == taken.ID {
+			return nil, nil
+		}
+		payDates = append(payDates, payDate)
+		nextDates = append(nextDates, nextRunAt)
+		return &domain.PayRun{ID: uuid.New(), ScheduleID: scheduleID, PayDate: payDate, Status: domain.PayRunStatusDraft}, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	generated, err := service.GenerateDuePayRuns(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, generated)
+
+	require.Len(t, payDates, 3)
+	assert.Equal(t, now.AddDate(0, 0, -15), payDates[0])
+	assert.Equal(t, now.AddDate(0, 0, -8), payDates[1])
+	assert.Equal(t, now.AddDate(0, 0, -1), payDates[2])
+	assert.Equal(t, now.AddDate(0, 0, 6), nextDates[2].UTC())
+	assert.Equal(t, 4, mockPayrollRepo.GeneratePayRunCallCount())
+
+	_, dueAt, limit := mockPayrollRepo.ListDueSchedulesArgsForCall(0)
+	assert.Equal(t, now, dueAt)
+	assert.Equal(t, payrollDueBatchSize, limit)
+}
+
+func TestPayRun_Totals(t *testing.T) {
+	// Arrange
+	run := domain.PayRun{LineItems: []domain.PayRunLineItem{
+		{Amount: money.MustParse("1000", "USD")},
+		{Amount: money.MustParse("250000", "NGN")},
+		{Amount: money.MustParse("500.50", "USD")},
+	}}
+
+	// Act
+	totals := run.Totals()
+
+	// Assert
+	require.Len(t, totals, 2)
+	assert.True(t, totals[0].Equal(money.MustParse("1500.50", "USD")))
+	assert.True(t, totals[1].Equal(money.MustParse("250000", "NGN")))
+}
+
+func TestDiffPayRunLineItems(t *testing.T) {
+	// Arrange
+	steady, doubled, rewalleted, left, joined := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	assetID := uuid.New()
+
+	line := func(userID uuid.UUID, amount money.Money, wallet string) domain.PayRunLineItem {
+		return domain.PayRunLineItem{UserID: userID, Amount: amount, PayoutAssetID: assetID, WalletAddress: wallet}
+	}
+
+	previous := []domain.PayRunLineItem{
+		line(steady, money.MustParse("1000", "USD"), "0xA"),
+		line(doubled, money.MustParse("2000", "USD"), "0xB"),
+		line(rewalleted, money.MustParse("3000", "USD"), "0xC"),
+		line(left, money.MustParse("500", "USD"), "0xD"),
+	}
+	current := []domain.PayRunLineItem{
+		line(steady, money.MustParse("1100", "USD"), "0xA"),
+		line(doubled, money.MustParse("4000", "USD"), "0xB"),
+		line(rewalleted, money.MustParse("3000", "USD"), "0xE"),
+		line(joined, money.MustParse("800", "USD"), "0xF"),
+	}
+
+	// Act
+	changes := domain.DiffPayRunLineItems(previous, current, decimal.NewFromInt(25))
+
+	// Assert
+	require.Len(t, changes, 5)
+
+	assert.Equal(t, domain.PayRunLineChanged, changes[0].Kind)
+	assert.True(t, changes[0].PercentChange.Equal(decimal.NewFromInt(10)))
+	assert.False(t, changes[0].Anomalous)
+
+	assert.Equal(t, doubled, changes[1].UserID)
+	assert.True(t, changes[1].PercentChange.Equal(decimal.NewFromInt(100)))
+	assert.True(t, changes[1].Anomalous)
+
+	assert.True(t, changes[2].WalletChanged)
+	assert.True(t, changes[2].PercentChange.IsZero())
+	assert.True(t, changes[2].Anomalous)
+
+	assert.Equal(t, domain.PayRunLineAdded, changes[3].Kind)
+	assert.Nil(t, changes[3].PreviousAmount)
+	assert.False(t, changes[3].Anomalous)
+
+	assert.Equal(t, domain.PayRunLineRemoved, changes[4].Kind)
+	assert.Equal(t, left, changes[4].UserID)
+	assert.Nil(t, changes[4].Amount)
+}
+
+func TestPayrollService_SimulateSchedule(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	usdc := domain.SupportedAsset{ID: uuid.New(), Chain: "ethereum", Symbol: "USDC", ContractAddress: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6, Enabled: true}
+	eth := domain.SupportedAsset{ID: uuid.New(), Chain: "ethereum", Symbol: "ETH", Decimals: 18, Enabled: true}
+	mockAssetService.GetAssetReturns(&usdc, nil)
+	mockAssetService.ListAssetsReturns([]domain.SupportedAsset{usdc, eth}, nil)
+
+	schedule := domain.PayrollSchedule{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		FirstPayDate:   now.AddDate(0, -2, 0),
+		NextRunAt:      now.AddDate(0, 0, 7),
+		Active:         true,
+	}
+	mockPayrollRepo.GetScheduleReturns(&schedule, nil)
+
+	steady, raised := uuid.New(), uuid.New()
+	compensation := func(userID uuid.UUID, amount money.Money, wallet string, active bool) domain.EmployeeCompensation {
+		return domain.EmployeeCompensation{
+			ID:                uuid.New(),
+			OrganizationID:    orgID,
+			ScheduleID:        schedule.ID,
+			UserID:            userID,
+			Amount:            amount,
+			PayoutAssetID:     usdc.ID,
+			PayoutAssetSymbol: usdc.Symbol,
+			WalletAddress:     wallet,
+			Active:            active,
+		}
+	}
+	mockPayrollRepo.ListCompensationsReturns([]domain.EmployeeCompensation{
+		compensation(steady, money.MustParse("1000", "USD"), "0x2222222222222222222222222222222222222222", true),
+		compensation(raised, money.MustParse("1500000", "NGN"), "0x3333333333333333333333333333333333333333", true),
+		compensation(uuid.New(), money.MustParse("9999", "USD"), "0x4444444444444444444444444444444444444444", false),
+	}, nil)
+
+	mockFXService.GetRatesStub = func(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error) {
+		rate := decimal.NewFromInt(1)
+		if base == "NGN" {
+			rate = decimal.RequireFromString("0.000625")
+		}
+		return []domain.FXRate{{Base: base, Quote: quotes[0], Rate: rate}}, nil
+	}
+
+	mockPayrollRepo.GetPreviousPayRunReturns(&domain.PayRun{
+		ID:         uuid.New(),
+		ScheduleID: schedule.ID,
+		PayDate:    now.AddDate(0, 0, -7),
+		LineItems: []domain.PayRunLineItem{
+			{UserID: steady, Amount: money.MustParse("1000", "USD"), PayoutAssetID: usdc.ID, WalletAddress: "0x2222222222222222222222222222222222222222"},
+			{UserID: raised, Amount: money.MustParse("750000", "NGN"), PayoutAssetID: usdc.ID, WalletAddress: "0x3333333333333333333333333333333333333333"},
+		},
+	}, nil)
+
+	mockContract.EstimateDisbursementGasReturns(90_000, nil)
+	mockContract.SuggestGasFeeCapReturns(big.NewInt(20_000_000_000), nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	simulation, err := service.SimulateSchedule(context.Background(), financeID, orgID, schedule.ID, nil)
+
+	// Assert
+	require.NoError(t, err)
+
+	assert.Equal(t, schedule.NextRunAt, simulation.PayDate)
+	assert.Nil(t, simulation.PayRunID)
+	assert.Empty(t, simulation.Warnings)
+
+	require.Len(t, simulation.LineItems, 2)
+	assert.True(t, simulation.LineItems[0].PayoutAmount.Equal(money.MustParse("1000", "USDC")))
+	assert.True(t, simulation.LineItems[0].Fee.Equal(money.MustParse("5", "USDC")))
+	assert.True(t, simulation.LineItems[1].PayoutAmount.Equal(money.MustParse("937.5", "USDC")))
+	assert.True(t, simulation.LineItems[1].Fee.Equal(money.MustParse("4.6875", "USDC")))
+
+	require.Len(t, simulation.Funding, 1)
+	funding := simulation.Funding[0]
+	assert.True(t, funding.PayoutAmount.Equal(money.MustParse("1937.5", "USDC")))
+	assert.True(t, funding.TotalAmount.Equal(money.MustParse("1947.1875", "USDC")))
+	assert.Equal(t, 2, funding.Transfers)
+	assert.Equal(t, 1, funding.Batches)
+	require.NotNil(t, funding.EstimatedGas)
+	assert.Equal(t, uint64(90_000), *funding.EstimatedGas)
+	require.NotNil(t, funding.EstimatedGasFee)
+	assert.True(t, funding.EstimatedGasFee.Equal(money.MustParse("0.0018", "ETH")))
+
+	_, batch := mockContract.EstimateDisbursementGasArgsForCall(0)
+	assert.Equal(t, usdc.ContractAddress, batch.Token)
+	assert.Equal(t, big.NewInt(1_000_000_000), batch.Transfers[0].Amount)
+
+	// Only the doubled salary differs from the previous run, and it stands out
+	require.Len(t, simulation.Changes, 1)
+	assert.Equal(t, raised, simulation.Changes[0].UserID)
+	assert.True(t, simulation.Changes[0].Anomalous)
+	assert.True(t, simulation.HasAnomalies())
+
+	_, scheduleID, before := mockPayrollRepo.GetPreviousPayRunArgsForCall(0)
+	assert.Equal(t, schedule.ID, scheduleID)
+	assert.Equal(t, schedule.NextRunAt, before)
+
+	// Nothing is created or submitted
+	assert.Zero(t, mockPayrollRepo.GeneratePayRunCallCount())
+	assert.Zero(t, mockContract.DisburseCallCount())
+}
+
+func TestPayrollService_SimulateSchedule_Warnings(t *testing.T) {
+	// Arrange
+	mockPayrollRepo := new(mocks.FakePayrollRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockPayoutService := new(mocks.FakePayoutAddressService)
+	mockFXService := new(mocks.FakeFXService)
+	mockTaxService := new(mocks.FakeTaxService)
+	mockContract := new(mocks.FakePayrollContractClient)
+
+	now := time.Date(2025, 5, 22, 12, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID, viewerID := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		financeID: domain.OrganizationRoleFinance,
+		viewerID:  domain.OrganizationRoleViewer,
+	})
+	usdc := domain.SupportedAsset{ID: uuid.New(), Chain: "ethereum", Symbol: "USDC", ContractAddress: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6, Enabled: true}
+	mockAssetService.GetAssetReturns(&usdc, nil)
+
+	schedule := domain.PayrollSchedule{ID: uuid.New(), OrganizationID: orgID, FirstPayDate: now, NextRunAt: now.AddDate(0, 0, 7)}
+	mockPayrollRepo.GetScheduleReturns(&schedule, nil)
+	mockPayrollRepo.ListCompensationsReturns([]domain.EmployeeCompensation{
+		{UserID: uuid.New(), Amount: money.MustParse("1000", "USD"), PayoutAssetID: usdc.ID, WalletAddress: "0x2222222222222222222222222222222222222222", Active: true},
+		{UserID: uuid.New(), Amount: money.MustParse("5000", "KES"), PayoutAssetID: usdc.ID, WalletAddress: "0x3333333333333333333333333333333333333333", Active: true},
+	}, nil)
+	mockFXService.GetRatesStub = func(ctx context.Context, base string, quotes []string) ([]domain.FXRate, error) {
+		if base == "KES" {
+			return nil, appErrors.NewValidationError("no exchange rate available for KES/USDC")
+		}
+		return []domain.FXRate{{Base: base, Quote: quotes[0], Rate: decimal.NewFromInt(1)}}, nil
+	}
+	mockContract.EstimateDisbursementGasReturns(0, errors.New("execution reverted: insufficient balance"))
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", PayrollFeeBasisPoints: 50, PayrollAnomalyPercent: 25}
+	service := NewPayrollService(mockPayrollRepo, mockOrgService, mockAssetService, mockPayoutService, mockFXService, mockTaxService, mockContract, cfg, logging.New(&cfg)).(*payrollService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	beforeFirstPayDate := schedule.FirstPayDate.AddDate(0, 0, -1)
+
+	// Act
+	simulation, err := service.SimulateSchedule(ctx, financeID, orgID, schedule.ID, nil)
+	_, payDateErr := service.SimulateSchedule(ctx, financeID, orgID, schedule.ID, &beforeFirstPayDate)
+	_, viewerErr := service.SimulateSchedule(ctx, viewerID, orgID, schedule.ID, nil)
+
+	// Assert: missing rates and failed gas estimates become warnings
+	require.NoError(t, err)
+	require.Len(t, simulation.LineItems, 2)
+	assert.NotNil(t, simulation.LineItems[0].PayoutAmount)
+	assert.Nil(t, simulation.LineItems[1].PayoutAmount)
+	require.Len(t, simulation.Funding, 1)
+	assert.Equal(t, 1, simulation.Funding[0].Transfers)
+	assert.Nil(t, simulation.Funding[0].EstimatedGas)
+	assert.Len(t, simulation.Warnings, 2)
+
+	// With no previous run every employee is new
+	require.Len(t, simulation.Changes, 2)
+	assert.Equal(t, domain.PayRunLineAdded, simulation.Changes[0].Kind)
+	assert.False(t, simulation.HasAnomalies())
+
+	// A pay date before the first one, and members who cannot manage finances, are refused
+	assertAppErrorType(t, payDateErr, appErrors.ErrorTypeValidation)
+	assertAppErrorType(t, viewerErr, appErrors.ErrorTypeForbidden)
+}
@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// basisPointsPerUnit converts the payroll fee from basis points to a fraction
+var basisPointsPerUnit = decimal.NewFromInt(10000)
+
+// SimulateSchedule computes the schedule's pay run for payDate, or its next pay
+// date when payDate is nil, from the active compensation records as they stand now
+func (s *payrollService) SimulateSchedule(ctx context.Context, userID, orgID, scheduleID uuid.UUID, payDate *time.Time) (*domain.PayRunSimulation, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.getSchedule(ctx, orgID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := schedule.NextRunAt
+	if payDate != nil {
+		if payDate.Before(schedule.FirstPayDate) {
+			return nil, appErrors.NewValidationError("pay date is before the schedule's first pay date")
+		}
+		date = *payDate
+	}
+
+	compensations, err := s.payrollRepo.ListCompensations(ctx, orgID, &scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var lineItems []domain.PayRunLineItem
+	for _, compensation := range compensations {
+		if !compensation.Active {
+			continue
+		}
+		compensationID := compensation.ID
+		lineItems = append(lineItems, domain.PayRunLineItem{
+			CompensationID:    &compensationID,
+			UserID:            compensation.UserID,
+			Email:             compensation.Email,
+			FirstName:         compensation.FirstName,
+			LastName:          compensation.LastName,
+			Amount:            compensation.Amount,
+			PayoutAssetID:     compensation.PayoutAssetID,
+			PayoutAssetSymbol: compensation.PayoutAssetSymbol,
+			WalletAddress:     compensation.WalletAddress,
+		})
+	}
+
+	// The run would cover the time since the schedule last paid, as generation does
+	periodStart := schedule.CreatedAt
+	if schedule.LastRunAt != nil {
+		periodStart = *schedule.LastRunAt
+	}
+
+	simulation := &domain.PayRunSimulation{
+		ScheduleID:  schedule.ID,
+		PayDate:     date,
+		PeriodStart: periodStart,
+	}
+
+	return s.simulate(ctx, simulation, lineItems)
+}
+
+// SimulatePayRun computes what one of the organization's pay runs would pay out,
+// at the current rates until it is submitted and at its locked payouts after
+func (s *payrollService) SimulatePayRun(ctx context.Context, userID, orgID, payRunID uuid.UUID) (*domain.PayRunSimulation, error) {
+	run, err := s.GetPayRun(ctx, userID, orgID, payRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &domain.PayRunSimulation{
+		ScheduleID:  run.ScheduleID,
+		PayRunID:    &run.ID,
+		PayDate:     run.PayDate,
+		PeriodStart: run.PeriodStart,
+	}
+
+	return s.simulate(ctx, simulation, run.LineItems)
+}
+
+// simulate fills in the simulation from the line items. Nothing is written and
+// no transaction is sent; a rate, asset or gas estimate that cannot be had is
+// reported as a warning rather than failing the whole simulation.
+func (s *payrollService) simulate(ctx context.Context, simulation *domain.PayRunSimulation, lineItems []domain.PayRunLineItem) (*domain.PayRunSimulation, error) {
+	simulation.SimulatedAt = s.now()
+	simulation.LineItems = make([]domain.SimulatedLineItem, 0, len(lineItems))
+	simulation.Funding = []domain.SimulatedFunding{}
+	simulation.Totals = domain.PayRun{LineItems: lineItems}.Totals()
+	if simulation.Totals == nil {
+		simulation.Totals = []money.Money{}
+	}
+
+	assets := make(map[uuid.UUID]*domain.SupportedAsset)
+	rates := make(map[string]*decimal.Decimal)
+	funding := make(map[uuid.UUID]int)
+	transfers := make(map[uuid.UUID][]domain.DisbursementTransfer)
+	feeRate := decimal.NewFromInt(s.config.PayrollFeeBasisPoints).Div(basisPointsPerUnit)
+
+	for _, item := range lineItems {
+		simulated := domain.SimulatedLineItem{PayRunLineItem: item}
+
+		asset, err := s.simulationAsset(ctx, item.PayoutAssetID, assets)
+		if err != nil {
+			return nil, err
+		}
+		if asset == nil {
+			simulation.Warnings = appendOnce(simulation.Warnings, fmt.Sprintf("payout asset %s is no longer supported", item.PayoutAssetSymbol))
+			simulation.LineItems = append(simulation.LineItems, simulated)
+			continue
+		}
+
+		var payout money.Money
+		var rate *decimal.Decimal
+		if item.LockedPayout != nil {
+			// A submitted pay run pays what was locked, whatever the rate is now
+			payout = *item.LockedPayout
+			rate = s.lockedRate(ctx, item)
+		} else {
+			var warning string
+			if rate, warning = s.simulationRate(ctx, item.Amount.Currency(), asset.Symbol, rates); rate == nil {
+				simulation.Warnings = appendOnce(simulation.Warnings, warning)
+				simulation.LineItems = append(simulation.LineItems, simulated)
+				continue
+			}
+			payout = money.New(item.Amount.Amount().Mul(*rate), asset.Symbol).Round(int32(asset.Decimals), money.RoundHalfEven)
+		}
+
+		fee := payout.Mul(feeRate).Round(int32(asset.Decimals), money.RoundUp)
+		simulated.FXRate = rate
+		simulated.PayoutAmount = &payout
+		simulated.Fee = &fee
+		simulation.LineItems = append(simulation.LineItems, simulated)
+
+		i, ok := funding[asset.ID]
+		if !ok {
+			i = len(simulation.Funding)
+			funding[asset.ID] = i
+			simulation.Funding = append(simulation.Funding, domain.SimulatedFunding{
+				AssetID:      asset.ID,
+				Symbol:       asset.Symbol,
+				Chain:        asset.Chain,
+				PayoutAmount: money.Zero(asset.Symbol),
+				FeeAmount:    money.Zero(asset.Symbol),
+			})
+		}
+		// Same asset by construction, so Add cannot fail
+		simulation.Funding[i].PayoutAmount, _ = simulation.Funding[i].PayoutAmount.Add(payout)
+		simulation.Funding[i].FeeAmount, _ = simulation.Funding[i].FeeAmount.Add(fee)
+		simulation.Funding[i].Transfers++
+
+		units, err := payout.ToBaseUnits(asset.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		transfers[asset.ID] = append(transfers[asset.ID], domain.DisbursementTransfer{Recipient: item.WalletAddress, Amount: units})
+	}
+
+	for i := range simulation.Funding {
+		entry := &simulation.Funding[i]
+		entry.TotalAmount, _ = entry.PayoutAmount.Add(entry.FeeAmount)
+		entry.Batches = (entry.Transfers + domain.MaxDisbursementBatchSize - 1) / domain.MaxDisbursementBatchSize
+
+		if warning := s.estimateDisbursement(ctx, entry, assets[entry.AssetID], transfers[entry.AssetID]); warning != "" {
+			simulation.Warnings = append(simulation.Warnings, warning)
+		}
+	}
+
+	previous, err := s.payrollRepo.GetPreviousPayRun(ctx, simulation.ScheduleID, simulation.PayDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var previousItems []domain.PayRunLineItem
+	if previous != nil {
+		simulation.Previous = previous
+		previousItems = previous.LineItems
+	}
+	simulation.Changes = domain.DiffPayRunLineItems(previousItems, lineItems, decimal.NewFromInt(s.config.PayrollAnomalyPercent))
+
+	if simulation.HasAnomalies() {
+		s.logger.Info("Pay run simulation flagged anomalies", map[string]interface{}{
+			"schedule_id": simulation.ScheduleID,
+			"pay_date":    simulation.PayDate,
+		})
+	}
+
+	return simulation, nil
+}
+
+// simulationAsset loads a payout asset once per simulation. It returns nil if
+// the asset has been removed.
+func (s *payrollService) simulationAsset(ctx context.Context, id uuid.UUID, assets map[uuid.UUID]*domain.SupportedAsset) (*domain.SupportedAsset, error) {
+	if asset, ok := assets[id]; ok {
+		return asset, nil
+	}
+
+	asset, err := s.assetService.GetAsset(ctx, id)
+	if err != nil && appErrors.GetErrorType(err) != appErrors.ErrorTypeNotFound {
+		return nil, err
+	}
+	assets[id] = asset
+
+	return asset, nil
+}
+
+// simulationRate looks up the current rate from currency to the payout asset
+// once per simulation. It returns nil and the reason when no rate is available.
+func (s *payrollService) simulationRate(ctx context.Context, currency, symbol string, rates map[string]*decimal.Decimal) (*decimal.Decimal, string) {
+	pair := currency + "/" + symbol
+	warning := fmt.Sprintf("no exchange rate available for %s", pair)
+
+	if rate, ok := rates[pair]; ok {
+		return rate, warning
+	}
+
+	fxRates, err := s.fxService.GetRates(ctx, currency, []string{symbol})
+	if err != nil || len(fxRates) == 0 {
+		if err != nil {
+			s.logger.Warn("Failed to get exchange rate for pay run simulation", map[string]interface{}{
+				"pair":  pair,
+				"error": err.Error(),
+			})
+		}
+		rates[pair] = nil
+		return nil, warning
+	}
+
+	rate := fxRates[0].Rate
+	rates[pair] = &rate
+
+	return &rate, ""
+}
+
+// lockedRate returns the rate a submitted line item's payout was locked at,
+// or nil if its quote cannot be read
+func (s *payrollService) lockedRate(ctx context.Context, item domain.PayRunLineItem) *decimal.Decimal {
+	one := decimal.NewFromInt(1)
+	if item.FXQuoteID == nil {
+		return &one
+	}
+
+	quote, err := s.fxService.GetQuote(ctx, *item.FXQuoteID)
+	if err != nil {
+		s.logger.Warn("Failed to get locked quote for pay run simulation", map[string]interface{}{
+			"quote_id": *item.FXQuoteID,
+			"error":    err.Error(),
+		})
+		return nil
+	}
+
+	rate := quote.Rate
+	if quote.Base != item.Amount.Currency() {
+		rate = one.DivRound(quote.Rate, 18)
+	}
+
+	return &rate
+}
+
+// estimateDisbursement estimates the gas of disbursing the transfers through
+// the payroll contract and what it would cost at the current fee cap. Only
+// tokens on the chain of the configured node can be estimated; anything else
+// is left blank with the reason returned.
+func (s *payrollService) estimateDisbursement(ctx context.Context, funding *domain.SimulatedFunding, asset *domain.SupportedAsset, transfers []domain.DisbursementTransfer) string {
+	if s.contractClient == nil {
+		return ""
+	}
+	if asset.IsNative() || asset.Chain != s.config.IndexerChain {
+		return fmt.Sprintf("gas is not estimated for %s on %s", asset.Symbol, asset.Chain)
+	}
+
+	var gas uint64
+	for start := 0; start < len(transfers); start += domain.MaxDisbursementBatchSize {
+		end := min(start+domain.MaxDisbursementBatchSize, len(transfers))
+
+		estimate, err := s.contractClient.EstimateDisbursementGas(ctx, domain.DisbursementBatch{
+			Token:     asset.ContractAddress,
+			Transfers: transfers[start:end],
+		})
+		if err != nil {
+			s.logger.Warn("Failed to estimate pay run disbursement gas", map[string]interface{}{
+				"asset_id": asset.ID,
+				"error":    err.Error(),
+			})
+			return fmt.Sprintf("gas could not be estimated for %s on %s: the disbursement would fail as things stand", asset.Symbol, asset.Chain)
+		}
+		gas += estimate
+	}
+	funding.EstimatedGas = &gas
+
+	feeCap, err := s.contractClient.SuggestGasFeeCap(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to get gas price for pay run simulation", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Sprintf("gas fee could not be estimated for %s on %s", asset.Symbol, asset.Chain)
+	}
+
+	native, err := s.nativeAsset(ctx, asset.Chain)
+	if err != nil || native == nil {
+		return fmt.Sprintf("no native asset is configured for %s to price gas in", asset.Chain)
+	}
+
+	wei := new(big.Int).Mul(new(big.Int).SetUint64(gas), feeCap)
+	fee := money.FromBaseUnits(wei, native.Symbol, native.Decimals)
+	funding.EstimatedGasFee = &fee
+
+	return ""
+}
+
+// nativeAsset finds the supported asset for a chain's own coin
+func (s *payrollService) nativeAsset(ctx context.Context, chain string) (*domain.SupportedAsset, error) {
+	assets, err := s.assetService.ListAssets(ctx, domain.SupportedAssetFilter{Chain: chain})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range assets {
+		if assets[i].IsNative() {
+			return &assets[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// appendOnce appends a message unless it is already in the list
+func appendOnce(messages []string, message string) []string {
+	if slices.Contains(messages, message) {
+		return messages
+	}
+	return append(messages, message)
+}
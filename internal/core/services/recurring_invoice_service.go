@@ -0,0 +1,467 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// recurringInvoiceDueBatchSize is how many due recurring invoices are loaded per generator pass
+	recurringInvoiceDueBatchSize = 100
+	// recurringInvoiceCatchUpLimit caps the missed issue dates generated for one
+	// recurring invoice in a pass, so a long outage cannot flood a customer
+	recurringInvoiceCatchUpLimit = 12
+	// defaultRecurringInvoicePreview is how many upcoming invoices a preview shows unless asked otherwise
+	defaultRecurringInvoicePreview = 6
+	// maxRecurringInvoicePreview caps the upcoming invoices one preview shows
+	maxRecurringInvoicePreview = 24
+	// maxDaysUntilDue caps the payment terms of a recurring invoice
+	maxDaysUntilDue = 365
+)
+
+type recurringInvoiceService struct {
+	recurringRepo  ports.RecurringInvoiceRepository
+	invoiceService ports.InvoiceService
+	orgService     ports.OrganizationService
+	assetService   ports.AssetService
+	logger         logging.Logger
+	now            func() time.Time
+}
+
+// NewRecurringInvoiceService creates a new recurring invoice service. The
+// invoices it generates are validated, given deposit addresses and sent by
+// invoiceService.
+func NewRecurringInvoiceService(
+	recurringRepo ports.RecurringInvoiceRepository,
+	invoiceService ports.InvoiceService,
+	orgService ports.OrganizationService,
+	assetService ports.AssetService,
+	logger logging.Logger,
+) ports.RecurringInvoiceService {
+	return &recurringInvoiceService{
+		recurringRepo:  recurringRepo,
+		invoiceService: invoiceService,
+		orgService:     orgService,
+		assetService:   assetService,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// CreateRecurringInvoice creates an active recurring invoice. The first
+// invoice is issued on the start date, or on the first issue date from today
+// onwards if the start date has passed.
+func (s *recurringInvoiceService) CreateRecurringInvoice(ctx context.Context, userID, orgID uuid.UUID, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	recurring.ID = uuid.New()
+	recurring.OrganizationID = orgID
+	recurring.Status = domain.RecurringInvoiceStatusActive
+	recurring.Occurrences = 0
+	recurring.LastIssuedAt = nil
+	recurring.CreatedBy = &userID
+
+	if err := s.prepareRecurringInvoice(ctx, &recurring); err != nil {
+		return nil, err
+	}
+	if recurring.Status == domain.RecurringInvoiceStatusCompleted {
+		return nil, appErrors.NewValidationError("the end date leaves no invoices to issue")
+	}
+
+	created, err := s.recurringRepo.CreateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring invoice created", map[string]interface{}{
+		"organization_id":      orgID,
+		"recurring_invoice_id": created.ID,
+		"frequency":            created.Frequency,
+		"next_issue_at":        created.NextIssueAt,
+	})
+
+	return created, nil
+}
+
+// ListRecurringInvoices lists an organization's recurring invoices. Any member may view them.
+func (s *recurringInvoiceService) ListRecurringInvoices(ctx context.Context, userID, orgID uuid.UUID) ([]domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.recurringRepo.ListRecurringInvoices(ctx, orgID)
+}
+
+// GetRecurringInvoice retrieves one of the organization's recurring invoices with its line items
+func (s *recurringInvoiceService) GetRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.getRecurringInvoice(ctx, orgID, recurringID)
+}
+
+// UpdateRecurringInvoice replaces a recurring invoice's settings and line
+// items. Invoices it already generated are unchanged, and an issue date that
+// already has an invoice is never scheduled again. A completed recurring
+// invoice becomes active again if the new end date or limit leaves room for
+// more invoices.
+func (s *recurringInvoiceService) UpdateRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getRecurringInvoice(ctx, orgID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring.ID = existing.ID
+	recurring.OrganizationID = existing.OrganizationID
+	recurring.Status = existing.Status
+	recurring.Occurrences = existing.Occurrences
+	recurring.LastIssuedAt = existing.LastIssuedAt
+	recurring.CreatedBy = existing.CreatedBy
+
+	if err := s.prepareRecurringInvoice(ctx, &recurring); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.recurringRepo.UpdateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring invoice updated", map[string]interface{}{
+		"organization_id":      orgID,
+		"recurring_invoice_id": recurringID,
+		"updated_by":           userID,
+		"status":               updated.Status,
+		"next_issue_at":        updated.NextIssueAt,
+	})
+
+	return updated, nil
+}
+
+// PauseRecurringInvoice stops an active recurring invoice from generating invoices
+func (s *recurringInvoiceService) PauseRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.getRecurringInvoice(ctx, orgID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != domain.RecurringInvoiceStatusActive {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s recurring invoice cannot be paused", recurring.Status))
+	}
+
+	recurring.Status = domain.RecurringInvoiceStatusPaused
+	paused, err := s.recurringRepo.UpdateRecurringInvoice(ctx, *recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring invoice paused", map[string]interface{}{
+		"organization_id":      orgID,
+		"recurring_invoice_id": recurringID,
+		"paused_by":            userID,
+	})
+
+	return paused, nil
+}
+
+// ResumeRecurringInvoice restarts a paused recurring invoice. Issue dates
+// missed while it was paused are skipped rather than generated late.
+func (s *recurringInvoiceService) ResumeRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.getRecurringInvoice(ctx, orgID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != domain.RecurringInvoiceStatusPaused {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s recurring invoice cannot be resumed", recurring.Status))
+	}
+
+	recurring.Status = domain.RecurringInvoiceStatusActive
+	if err := s.scheduleRecurringInvoice(recurring); err != nil {
+		return nil, err
+	}
+
+	resumed, err := s.recurringRepo.UpdateRecurringInvoice(ctx, *recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring invoice resumed", map[string]interface{}{
+		"organization_id":      orgID,
+		"recurring_invoice_id": recurringID,
+		"resumed_by":           userID,
+		"status":               resumed.Status,
+		"next_issue_at":        resumed.NextIssueAt,
+	})
+
+	return resumed, nil
+}
+
+// PreviewRecurringInvoice works out the next count invoices the recurring
+// invoice will generate, up to its end date or occurrence limit. The invoices
+// are not stored, so they have no ID or number.
+func (s *recurringInvoiceService) PreviewRecurringInvoice(ctx context.Context, userID, orgID, recurringID uuid.UUID, count int) ([]domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	if count <= 0 {
+		count = defaultRecurringInvoicePreview
+	}
+	if count > maxRecurringInvoicePreview {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("a preview can show at most %d invoices", maxRecurringInvoicePreview))
+	}
+
+	recurring, err := s.getRecurringInvoice(ctx, orgID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := recurring.UpcomingIssueDates(count)
+	if err != nil {
+		return nil, err
+	}
+
+	places, err := currencyAmountPlaces(ctx, s.assetService, recurring.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices := make([]domain.Invoice, len(dates))
+	for i, issueDate := range dates {
+		invoices[i] = recurring.InvoiceFor(issueDate)
+		invoices[i].Calculate(places)
+	}
+
+	return invoices, nil
+}
+
+// GenerateRecurringInvoices creates the invoices of every active recurring
+// invoice whose next issue date has arrived, catching up on missed dates, and
+// sends those set to be sent automatically. Failures are logged per recurring
+// invoice so one bad template does not hold up the rest.
+func (s *recurringInvoiceService) GenerateRecurringInvoices(ctx context.Context) (int, error) {
+	now := s.now()
+
+	due, err := s.recurringRepo.ListDueRecurringInvoices(ctx, now, recurringInvoiceDueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, recurring := range due {
+		count, err := s.generateForRecurringInvoice(ctx, recurring, now)
+		generated += count
+		if err != nil {
+			s.logger.Error("Failed to generate recurring invoice", err, map[string]interface{}{
+				"organization_id":      recurring.OrganizationID,
+				"recurring_invoice_id": recurring.ID,
+				"issue_date":           recurring.NextIssueAt,
+			})
+		}
+	}
+
+	return generated, nil
+}
+
+// generateForRecurringInvoice generates the recurring invoice's invoices for
+// each issue date up to now
+func (s *recurringInvoiceService) generateForRecurringInvoice(ctx context.Context, recurring domain.RecurringInvoice, now time.Time) (int, error) {
+	places, err := currencyAmountPlaces(ctx, s.assetService, recurring.Currency)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for i := 0; i < recurringInvoiceCatchUpLimit && recurring.Status == domain.RecurringInvoiceStatusActive && !recurring.NextIssueAt.After(now); i++ {
+		issueDate := recurring.NextIssueAt
+
+		next, err := recurring.NextIssueDate(issueDate)
+		if err != nil {
+			return generated, err
+		}
+		issued := recurring.Occurrences + 1
+		completed := !recurring.Allows(next, issued)
+
+		invoice := recurring.InvoiceFor(issueDate)
+		invoice.ID = uuid.New()
+		invoice.Calculate(places)
+
+		// Without a deposit key any more, the invoice goes out without payment details
+		withAddress, err := s.invoiceService.AssignDepositAddress(ctx, invoice)
+		switch {
+		case err == nil:
+			invoice = *withAddress
+		case appErrors.GetErrorType(err) == appErrors.ErrorTypeValidation:
+			s.logger.Warn("Recurring invoice generated without a deposit address", map[string]interface{}{
+				"organization_id":      recurring.OrganizationID,
+				"recurring_invoice_id": recurring.ID,
+				"reason":               err.Error(),
+			})
+			invoice.PaymentAssetID = nil
+		default:
+			return generated, err
+		}
+
+		created, err := s.recurringRepo.GenerateRecurringInvoice(ctx, recurring.ID, invoice, next, completed)
+		if err != nil {
+			return generated, err
+		}
+
+		// Another instance generated this date and moved the recurring invoice on
+		if created == nil {
+			return generated, nil
+		}
+
+		generated++
+		s.logger.Info("Recurring invoice generated", map[string]interface{}{
+			"organization_id":      recurring.OrganizationID,
+			"recurring_invoice_id": recurring.ID,
+			"invoice_id":           created.ID,
+			"number":               created.Number,
+			"issue_date":           issueDate,
+			"completed":            completed,
+		})
+
+		// A failed send leaves the invoice a draft for someone to send by hand
+		if recurring.AutoSend {
+			if _, err := s.invoiceService.DeliverInvoice(ctx, created.ID); err != nil {
+				s.logger.Error("Failed to send recurring invoice", err, map[string]interface{}{
+					"organization_id":      recurring.OrganizationID,
+					"recurring_invoice_id": recurring.ID,
+					"invoice_id":           created.ID,
+				})
+			}
+		}
+
+		recurring.NextIssueAt = next
+		recurring.Occurrences = issued
+		if completed {
+			recurring.Status = domain.RecurringInvoiceStatusCompleted
+		}
+	}
+
+	return generated, nil
+}
+
+// getRecurringInvoice loads a recurring invoice that belongs to the organization
+func (s *recurringInvoiceService) getRecurringInvoice(ctx context.Context, orgID, recurringID uuid.UUID) (*domain.RecurringInvoice, error) {
+	recurring, err := s.recurringRepo.GetRecurringInvoice(ctx, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil || recurring.OrganizationID != orgID {
+		return nil, appErrors.NewNotFoundError("recurring invoice not found")
+	}
+
+	return recurring, nil
+}
+
+// prepareRecurringInvoice normalises and validates a recurring invoice's
+// schedule, limits and the invoice it issues, then schedules its next issue date
+func (s *recurringInvoiceService) prepareRecurringInvoice(ctx context.Context, recurring *domain.RecurringInvoice) error {
+	recurring.Name = strings.TrimSpace(recurring.Name)
+	if recurring.Name == "" {
+		return appErrors.NewValidationError("name is required")
+	}
+
+	if !recurring.Frequency.IsValid() {
+		return appErrors.NewValidationError(fmt.Sprintf("invalid frequency %q", recurring.Frequency))
+	}
+
+	recurring.Timezone = strings.TrimSpace(recurring.Timezone)
+	if recurring.Timezone == "" {
+		recurring.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(recurring.Timezone); err != nil {
+		return appErrors.NewValidationError(fmt.Sprintf("invalid timezone %q", recurring.Timezone))
+	}
+
+	if recurring.StartDate.IsZero() {
+		return appErrors.NewValidationError("start date is required")
+	}
+	// Stored timestamps keep microseconds; whole seconds keep computed dates comparable
+	recurring.StartDate = recurring.StartDate.Truncate(time.Second)
+
+	if recurring.EndDate != nil && recurring.EndDate.Before(recurring.StartDate) {
+		return appErrors.NewValidationError("end date cannot be before the start date")
+	}
+	if recurring.MaxOccurrences != nil && *recurring.MaxOccurrences <= 0 {
+		return appErrors.NewValidationError("the occurrence limit must be greater than zero")
+	}
+	if recurring.DaysUntilDue < 0 || recurring.DaysUntilDue > maxDaysUntilDue {
+		return appErrors.NewValidationError(fmt.Sprintf("days until due must be between 0 and %d", maxDaysUntilDue))
+	}
+
+	// Validate the template as the invoice it issues first
+	invoice, err := s.invoiceService.PrepareInvoice(ctx, recurring.InvoiceFor(recurring.StartDate))
+	if err != nil {
+		return err
+	}
+	recurring.Currency = invoice.Currency
+	recurring.CustomerName = invoice.CustomerName
+	recurring.CustomerEmail = invoice.CustomerEmail
+	recurring.CustomerAddress = invoice.CustomerAddress
+	recurring.Notes = invoice.Notes
+	recurring.PaymentAddress = invoice.PaymentAddress
+	for i, item := range invoice.LineItems {
+		recurring.LineItems[i].Position = item.Position
+		recurring.LineItems[i].Description = item.Description
+		recurring.LineItems[i].UnitPrice = item.UnitPrice
+	}
+
+	return s.scheduleRecurringInvoice(recurring)
+}
+
+// scheduleRecurringInvoice sets the next issue date to the first one from
+// today onwards, in the recurring invoice's timezone, that has no invoice yet.
+// It completes the recurring invoice if the end date or limit leaves none, and
+// reactivates a completed one if they no longer do.
+func (s *recurringInvoiceService) scheduleRecurringInvoice(recurring *domain.RecurringInvoice) error {
+	loc, err := time.LoadLocation(recurring.Timezone)
+	if err != nil {
+		return appErrors.NewValidationError(fmt.Sprintf("invalid timezone %q", recurring.Timezone))
+	}
+
+	today := s.now().In(loc)
+	from := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, loc).Add(-time.Nanosecond)
+	if recurring.LastIssuedAt != nil && !recurring.LastIssuedAt.Before(from) {
+		from = *recurring.LastIssuedAt
+	}
+
+	next, err := recurring.NextIssueDate(from)
+	if err != nil {
+		return appErrors.NewValidationError(err.Error())
+	}
+	recurring.NextIssueAt = next
+
+	switch {
+	case !recurring.Allows(next, recurring.Occurrences):
+		recurring.Status = domain.RecurringInvoiceStatusCompleted
+	case recurring.Status == domain.RecurringInvoiceStatusCompleted:
+		recurring.Status = domain.RecurringInvoiceStatusActive
+	}
+
+	return nil
+}
@@ -0,0 +1,611 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRecurringRequest builds a monthly 1500 USD retainer starting on 24 May 2025
+func testRecurringRequest() domain.RecurringInvoice {
+	return domain.RecurringInvoice{
+		Name:          " Monthly retainer ",
+		Frequency:     domain.InvoiceFrequencyMonthly,
+		StartDate:     time.Date(2025, 5, 24, 0, 0, 0, 0, time.UTC),
+		DaysUntilDue:  14,
+		Currency:      "usd",
+		CustomerName:  "Globex",
+		CustomerEmail: "Billing@Globex.test",
+		LineItems: []domain.RecurringInvoiceLineItem{
+			{
+				Description: " Retainer ",
+				Quantity:    decimal.RequireFromString("1"),
+				UnitPrice:   money.MustParse("1500", "USD"),
+				TaxRate:     decimal.RequireFromString("10"),
+			},
+		},
+	}
+}
+
+// testStoredRecurringInvoice builds the retainer as the repository returns it
+func testStoredRecurringInvoice(orgID uuid.UUID, status domain.RecurringInvoiceStatus) *domain.RecurringInvoice {
+	recurring := testRecurringRequest()
+	recurring.ID = uuid.New()
+	recurring.OrganizationID = orgID
+	recurring.Name = "Monthly retainer"
+	recurring.Status = status
+	recurring.Timezone = "UTC"
+	recurring.Currency = "USD"
+	recurring.CustomerEmail = "billing@globex.test"
+	recurring.NextIssueAt = recurring.StartDate
+	recurring.LineItems[0].Description = "Retainer"
+	recurring.LineItems[0].Position = 1
+	return &recurring
+}
+
+func TestRecurringInvoice_NextIssueDate(t *testing.T) {
+	// Arrange
+	anchor := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		frequency domain.InvoiceFrequency
+		start     time.Time
+		after     time.Time
+		expected  time.Time
+	}{
+		{
+			name:      "before_start_date",
+			frequency: domain.InvoiceFrequencyMonthly,
+			start:     anchor,
+			after:     anchor.AddDate(0, 0, -3),
+			expected:  anchor,
+		},
+		{
+			name:      "biweekly",
+			frequency: domain.InvoiceFrequencyBiweekly,
+			start:     anchor,
+			after:     anchor.AddDate(0, 0, 14),
+			expected:  anchor.AddDate(0, 0, 28),
+		},
+		{
+			name:      "quarterly_clamps_to_april",
+			frequency: domain.InvoiceFrequencyQuarterly,
+			start:     anchor,
+			after:     anchor,
+			expected:  time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "quarterly_returns_to_anchor_day",
+			frequency: domain.InvoiceFrequencyQuarterly,
+			start:     anchor,
+			after:     time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC),
+			expected:  time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "yearly_from_leap_day",
+			frequency: domain.InvoiceFrequencyYearly,
+			start:     time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			after:     time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			expected:  time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recurring := domain.RecurringInvoice{Frequency: tc.frequency, Timezone: "UTC", StartDate: tc.start}
+
+			// Act
+			next, err := recurring.NextIssueDate(tc.after)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(next), "expected %s, got %s", tc.expected, next)
+		})
+	}
+}
+
+func TestRecurringInvoice_UpcomingIssueDates(t *testing.T) {
+	// Arrange
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	recurring := domain.RecurringInvoice{
+		Status:      domain.RecurringInvoiceStatusActive,
+		Frequency:   domain.InvoiceFrequencyMonthly,
+		Timezone:    "UTC",
+		StartDate:   start,
+		NextIssueAt: start.AddDate(0, 2, 0),
+		Occurrences: 2,
+	}
+
+	// Act
+	dates, err := recurring.UpcomingIssueDates(3)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{start.AddDate(0, 2, 0), start.AddDate(0, 3, 0), start.AddDate(0, 4, 0)}, dates)
+
+	// Act: two of four already issued
+	limit := 4
+	recurring.MaxOccurrences = &limit
+	dates, err = recurring.UpcomingIssueDates(6)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, dates, 2)
+
+	// Act: the end date falls before the limit does
+	endDate := start.AddDate(0, 2, 10)
+	recurring.EndDate = &endDate
+	dates, err = recurring.UpcomingIssueDates(6)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{start.AddDate(0, 2, 0)}, dates)
+
+	// Act
+	recurring.Status = domain.RecurringInvoiceStatusCompleted
+	dates, err = recurring.UpcomingIssueDates(6)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, dates)
+}
+
+func TestRecurringInvoiceService_CreateRecurringInvoice(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockRecurringRepo.CreateRecurringInvoiceStub = func(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+		return &recurring, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	created, err := service.CreateRecurringInvoice(context.Background(), financeID, orgID, testRecurringRequest())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Monthly retainer", created.Name)
+	assert.Equal(t, domain.RecurringInvoiceStatusActive, created.Status)
+	assert.Equal(t, "UTC", created.Timezone)
+	assert.Equal(t, "USD", created.Currency)
+	assert.Equal(t, "billing@globex.test", created.CustomerEmail)
+	assert.Equal(t, "Retainer", created.LineItems[0].Description)
+	assert.Equal(t, 1, created.LineItems[0].Position)
+	assert.Equal(t, &financeID, created.CreatedBy)
+	// Issued today even though midnight has passed
+	assert.True(t, created.NextIssueAt.Equal(created.StartDate), "got %s", created.NextIssueAt)
+}
+
+func TestRecurringInvoiceService_CreateRecurringInvoice_PastStartDate(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockRecurringRepo.CreateRecurringInvoiceStub = func(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+		return &recurring, nil
+	}
+
+	req := testRecurringRequest()
+	req.StartDate = time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	created, err := service.CreateRecurringInvoice(context.Background(), financeID, orgID, req)
+
+	// Assert: the dates already gone by are not backfilled
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC).Equal(created.NextIssueAt), "got %s", created.NextIssueAt)
+}
+
+func TestRecurringInvoiceService_CreateRecurringInvoice_Invalid(t *testing.T) {
+	past := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	zero := 0
+
+	testCases := map[string]func(r *domain.RecurringInvoice){
+		"frequency":       func(r *domain.RecurringInvoice) { r.Frequency = "daily" },
+		"timezone":        func(r *domain.RecurringInvoice) { r.Timezone = "Nowhere/Invalid" },
+		"end_before":      func(r *domain.RecurringInvoice) { r.EndDate = &past },
+		"max_occurrences": func(r *domain.RecurringInvoice) { r.MaxOccurrences = &zero },
+		"days_until_due":  func(r *domain.RecurringInvoice) { r.DaysUntilDue = -1 },
+		"customer_email":  func(r *domain.RecurringInvoice) { r.CustomerEmail = "nope" },
+		"line_items":      func(r *domain.RecurringInvoice) { r.LineItems = nil },
+		"no_dates_left": func(r *domain.RecurringInvoice) {
+			r.StartDate = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC)
+			r.EndDate = &end
+		},
+	}
+
+	for name, mutate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			financeID := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+			req := testRecurringRequest()
+			mutate(&req)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			logger := logging.New(&cfg)
+			invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+			invoiceService.now = func() time.Time { return now }
+			service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateRecurringInvoice(context.Background(), financeID, orgID, req)
+
+			// Assert
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Zero(t, mockRecurringRepo.CreateRecurringInvoiceCallCount())
+		})
+	}
+}
+
+func TestRecurringInvoiceService_CreateRecurringInvoice_RequiresFinanceRole(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	viewerID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{viewerID: domain.OrganizationRoleViewer})
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.CreateRecurringInvoice(context.Background(), viewerID, orgID, testRecurringRequest())
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Zero(t, mockRecurringRepo.CreateRecurringInvoiceCallCount())
+}
+
+func TestRecurringInvoiceService_UpdateRecurringInvoice_ReactivatesCompleted(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	stored := testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusCompleted)
+	stored.StartDate = time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)
+	limit := 4
+	stored.MaxOccurrences = &limit
+	stored.Occurrences = 4
+	lastIssued := time.Date(2025, 4, 24, 0, 0, 0, 0, time.UTC)
+	stored.LastIssuedAt = &lastIssued
+	mockRecurringRepo.GetRecurringInvoiceReturns(stored, nil)
+	mockRecurringRepo.UpdateRecurringInvoiceStub = func(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+		return &recurring, nil
+	}
+
+	req := testRecurringRequest()
+	req.StartDate = stored.StartDate
+	req.MaxOccurrences = &limit
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act: the same limit keeps it completed
+	updated, err := service.UpdateRecurringInvoice(ctx, financeID, orgID, stored.ID, req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.RecurringInvoiceStatusCompleted, updated.Status)
+	assert.Equal(t, 4, updated.Occurrences)
+
+	// Act: raising the limit reactivates it from today
+	more := 6
+	req.MaxOccurrences = &more
+	updated, err = service.UpdateRecurringInvoice(ctx, financeID, orgID, stored.ID, req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.RecurringInvoiceStatusActive, updated.Status)
+	assert.True(t, now.Truncate(24*time.Hour).Equal(updated.NextIssueAt), "got %s", updated.NextIssueAt)
+}
+
+func TestRecurringInvoiceService_PauseAndResumeRecurringInvoice(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	financeID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{financeID: domain.OrganizationRoleFinance})
+	mockRecurringRepo.UpdateRecurringInvoiceStub = func(ctx context.Context, recurring domain.RecurringInvoice) (*domain.RecurringInvoice, error) {
+		return &recurring, nil
+	}
+
+	active := testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusActive)
+	// Paused since February
+	stalled := testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusPaused)
+	stalled.StartDate = time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	stalled.NextIssueAt = time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	mockRecurringRepo.GetRecurringInvoiceStub = func(ctx context.Context, id uuid.UUID) (*domain.RecurringInvoice, error) {
+		stored := *active
+		if id == stalled.ID {
+			stored = *stalled
+		}
+		return &stored, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	paused, pauseErr := service.PauseRecurringInvoice(ctx, financeID, orgID, active.ID)
+	_, resumeActiveErr := service.ResumeRecurringInvoice(ctx, financeID, orgID, active.ID)
+	resumed, resumeErr := service.ResumeRecurringInvoice(ctx, financeID, orgID, stalled.ID)
+	_, otherOrgErr := service.PauseRecurringInvoice(ctx, financeID, uuid.New(), stalled.ID)
+
+	// Assert
+	require.NoError(t, pauseErr)
+	assert.Equal(t, domain.RecurringInvoiceStatusPaused, paused.Status)
+	assertAppErrorType(t, resumeActiveErr, appErrors.ErrorTypeConflict)
+
+	// The dates in between are skipped
+	require.NoError(t, resumeErr)
+	assert.Equal(t, domain.RecurringInvoiceStatusActive, resumed.Status)
+	assert.True(t, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC).Equal(resumed.NextIssueAt), "got %s", resumed.NextIssueAt)
+
+	assertAppErrorType(t, otherOrgErr, appErrors.ErrorTypeNotFound)
+}
+
+func TestRecurringInvoiceService_PreviewRecurringInvoice(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	viewerID := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{viewerID: domain.OrganizationRoleViewer})
+	stored := testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusActive)
+	limit := 3
+	stored.MaxOccurrences = &limit
+	mockRecurringRepo.GetRecurringInvoiceReturns(stored, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act
+	invoices, err := service.PreviewRecurringInvoice(ctx, viewerID, orgID, stored.ID, 0)
+	_, tooManyErr := service.PreviewRecurringInvoice(ctx, viewerID, orgID, stored.ID, maxRecurringInvoicePreview+1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, invoices, 3)
+	assert.Equal(t, time.Date(2025, 6, 24, 0, 0, 0, 0, time.UTC), invoices[1].IssueDate)
+	assert.Equal(t, time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC), invoices[1].DueDate)
+	assert.Equal(t, "1650.00", invoices[1].Total.Amount().StringFixed(2))
+	assert.Equal(t, &stored.ID, invoices[1].RecurringInvoiceID)
+	assert.Zero(t, mockInvoiceRepo.CreateInvoiceCallCount())
+
+	assertAppErrorType(t, tooManyErr, appErrors.ErrorTypeValidation)
+}
+
+func TestRecurringInvoiceService_GenerateRecurringInvoices(t *testing.T) {
+	// Arrange
+	mockRecurringRepo := new(mocks.FakeRecurringInvoiceRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	mockOrgService.GetOrganizationByIDReturns(&domain.Organization{ID: orgID, Name: "Acme"}, nil)
+	mockRenderer.RenderInvoiceReturns([]byte("%PDF-1.3"), nil)
+	stubInvoiceLedgerAccounts(mockLedgerService)
+
+	// The generator was down over two weekly dates; the third and last is still to come
+	limit := 3
+	autoSent := *testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusActive)
+	autoSent.Frequency = domain.InvoiceFrequencyWeekly
+	autoSent.StartDate = now.AddDate(0, 0, -8)
+	autoSent.NextIssueAt = autoSent.StartDate
+	autoSent.MaxOccurrences = &limit
+	autoSent.AutoSend = true
+
+	// The limit is reached with this invoice
+	last := *testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusActive)
+	last.NextIssueAt = now.Add(-time.Hour)
+	last.MaxOccurrences = &limit
+	last.Occurrences = 2
+
+	// Another instance already generated this one
+	taken := *testStoredRecurringInvoice(orgID, domain.RecurringInvoiceStatusActive)
+
+	mockRecurringRepo.ListDueRecurringInvoicesReturns([]domain.RecurringInvoice{autoSent, last, taken}, nil)
+
+	type generation struct {
+		recurringID uuid.UUID
+		invoice     domain.Invoice
+		next        time.Time
+		completed   bool
+	}
+	var generated []generation
+	stored := make(map[uuid.UUID]domain.Invoice)
+	mockRecurringRepo.GenerateRecurringInvoiceStub = func(ctx context.Context, recurringID uuid.UUID, invoice domain.Invoice, next time.Time, completed bool) (*domain.Invoice, error) {
+		if recurringID == taken.ID {
+			return nil, nil
+		}
+		generated = append(generated, generation{recurringID, invoice, next, completed})
+		invoice.ID = uuid.New()
+		invoice.Number = int64(len(generated))
+		stored[invoice.ID] = invoice
+		return &invoice, nil
+	}
+	mockInvoiceRepo.GetInvoiceStub = func(ctx context.Context, id uuid.UUID) (*domain.Invoice, error) {
+		invoice, ok := stored[id]
+		if !ok {
+			return nil, nil
+		}
+		return &invoice, nil
+	}
+	mockInvoiceRepo.MarkSentStub = func(ctx context.Context, id uuid.UUID, at time.Time, issued *domain.LedgerEntry) (*domain.Invoice, error) {
+		return &domain.Invoice{ID: id, OrganizationID: orgID, Status: domain.InvoiceStatusSent}, nil
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewRecurringInvoiceService(mockRecurringRepo, invoiceService, mockOrgService, mockAssetService, logger).(*recurringInvoiceService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	count, err := service.GenerateRecurringInvoices(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	require.Len(t, generated, 3)
+	assert.Equal(t, autoSent.StartDate, generated[0].invoice.IssueDate)
+	assert.Equal(t, autoSent.StartDate.AddDate(0, 0, 14), generated[0].invoice.DueDate)
+	assert.Equal(t, "1650", generated[0].invoice.Total.Amount().String())
+	assert.Equal(t, &autoSent.ID, generated[0].invoice.RecurringInvoiceID)
+	assert.Equal(t, autoSent.StartDate.AddDate(0, 0, 7), generated[1].invoice.IssueDate)
+	assert.True(t, autoSent.StartDate.AddDate(0, 0, 14).Equal(generated[1].next))
+	assert.False(t, generated[1].completed)
+	assert.Equal(t, last.ID, generated[2].recurringID)
+	assert.True(t, generated[2].completed)
+
+	// Only the auto-send template's invoices were emailed
+	assert.Equal(t, 2, mockInvoiceRepo.MarkSentCallCount())
+	assert.Equal(t, 2, mockEmailService.SendInvoiceCallCount())
+	_, _, orgName, _ := mockEmailService.SendInvoiceArgsForCall(0)
+	assert.Equal(t, "Acme", orgName)
+
+	_, dueAt, batch := mockRecurringRepo.ListDueRecurringInvoicesArgsForCall(0)
+	assert.Equal(t, now, dueAt)
+	assert.Equal(t, recurringInvoiceDueBatchSize, batch)
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// recordSecurityEvent writes an entry to the security audit log, picking up the
+// client IP and user agent set by DeviceTrackingMiddleware. Failures are logged
+// and never fail the calling operation.
+func recordSecurityEvent(ctx context.Context, securityRepo ports.SecurityRepository, logger logging.Logger, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	event := domain.SecurityEvent{
+		ID:        uuid.New(),
+		UserID:    userID,
+		EventType: eventType,
+		Metadata:  metadata,
+		Timestamp: time.Now(),
+	}
+
+	if ip, ok := ctx.Value("client_ip").(string); ok {
+		event.IPAddress = ip
+	}
+
+	if ua, ok := ctx.Value("user_agent").(string); ok {
+		event.UserAgent = ua
+	}
+
+	if err := securityRepo.LogSecurityEvent(ctx, event); err != nil {
+		logger.Error("Failed to log security event", err, map[string]interface{}{
+			"event_type": eventType,
+			"user_id":    userID,
+		})
+	}
+}
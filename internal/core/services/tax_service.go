@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/shopspring/decimal"
+)
+
+// euVATDirectiveNote is the legal basis EU reverse-charge invoices cite
+const euVATDirectiveNote = " (Article 196, Council Directive 2006/112/EC)"
+
+type taxService struct {
+	table  *domain.TaxRateTable
+	logger logging.Logger
+}
+
+// NewTaxService creates a tax service answering from the given rate table
+func NewTaxService(table *domain.TaxRateTable, logger logging.Logger) ports.TaxService {
+	return &taxService{
+		table:  table,
+		logger: logger,
+	}
+}
+
+// Determine works out the tax on a sale. A seller without a tax ID charges
+// none. Otherwise a sale within the seller's country, or to a buyer without a
+// tax ID, is charged the seller's standard rate; distance-selling thresholds
+// for consumers abroad are not modelled. A sale to a business in another
+// country with rates in the table is reverse charged, and one to a business
+// anywhere else is outside the scope of the seller's tax.
+func (s *taxService) Determine(ctx context.Context, seller, buyer domain.TaxParty, at time.Time) (*domain.TaxDetermination, error) {
+	sellerRates, ok := s.table.Lookup(seller.Country)
+	if !ok {
+		return nil, appErrors.NewNotFoundError(fmt.Sprintf("no tax rates for country %q", seller.Country))
+	}
+
+	determination := &domain.TaxDetermination{
+		Country:      sellerRates.Code,
+		TaxName:      sellerRates.TaxName,
+		Rate:         decimal.Zero,
+		RatesVersion: s.table.Version,
+	}
+
+	if strings.TrimSpace(seller.TaxID) == "" {
+		determination.Treatment = domain.TaxTreatmentNotRegistered
+		determination.Note = fmt.Sprintf("Not registered for %s", sellerRates.TaxName)
+		return determination, nil
+	}
+
+	if strings.TrimSpace(buyer.Country) == "" {
+		return nil, appErrors.NewValidationError("the customer's country is needed to work out tax")
+	}
+
+	buyerRates, buyerListed := s.table.Lookup(buyer.Country)
+	domestic := buyerListed && buyerRates.Code == sellerRates.Code
+
+	switch {
+	case domestic || strings.TrimSpace(buyer.TaxID) == "":
+		rate, ok := sellerRates.StandardRate(at)
+		if !ok {
+			return nil, appErrors.NewNotFoundError(fmt.Sprintf("no %s rate for %s on %s", sellerRates.TaxName, sellerRates.Name, at.Format(time.DateOnly)))
+		}
+		determination.Treatment = domain.TaxTreatmentStandard
+		determination.Rate = rate
+	case buyerListed:
+		determination.Treatment = domain.TaxTreatmentReverseCharge
+		determination.Note = fmt.Sprintf("Reverse charge: the customer accounts for %s", sellerRates.TaxName)
+		if sellerRates.Union == "EU" && buyerRates.Union == "EU" {
+			determination.Note += euVATDirectiveNote
+		}
+	default:
+		determination.Treatment = domain.TaxTreatmentOutsideScope
+		determination.Note = fmt.Sprintf("Outside the scope of %s %s: supplied to a business outside %s", sellerRates.Name, sellerRates.TaxName, sellerRates.Name)
+	}
+
+	return determination, nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTaxService() *taxService {
+	since := func(date string, rate string) domain.TaxRatePeriod {
+		from, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			panic(err)
+		}
+		return domain.TaxRatePeriod{From: from, Standard: decimal.RequireFromString(rate)}
+	}
+
+	table := &domain.TaxRateTable{
+		Version: "test.1",
+		Countries: map[string]domain.CountryTaxRates{
+			"DE": {Code: "DE", Name: "Germany", TaxName: "VAT", Union: "EU", Rates: []domain.TaxRatePeriod{since("2007-01-01", "19"), since("2020-07-01", "16"), since("2021-01-01", "19")}},
+			"FR": {Code: "FR", Name: "France", TaxName: "VAT", Union: "EU", Rates: []domain.TaxRatePeriod{since("2014-01-01", "20")}},
+			"GB": {Code: "GB", Name: "United Kingdom", TaxName: "VAT", Rates: []domain.TaxRatePeriod{since("2011-01-04", "20")}},
+		},
+	}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	return NewTaxService(table, logging.New(&cfg)).(*taxService)
+}
+
+func TestTaxService_Determine(t *testing.T) {
+	at := time.Date(2025, 5, 24, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		seller    domain.TaxParty
+		buyer     domain.TaxParty
+		at        time.Time
+		treatment domain.TaxTreatment
+		rate      string
+		note      string
+	}{
+		{name: "domestic_b2b", seller: domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, buyer: domain.TaxParty{Country: "de", TaxID: "DE987654321"}, at: at, treatment: domain.TaxTreatmentStandard, rate: "19"},
+		{name: "domestic_by_name", seller: domain.TaxParty{Country: "United Kingdom", TaxID: "GB123456789"}, buyer: domain.TaxParty{Country: "GB"}, at: at, treatment: domain.TaxTreatmentStandard, rate: "20"},
+		{name: "cross_border_b2c", seller: domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, buyer: domain.TaxParty{Country: "FR"}, at: at, treatment: domain.TaxTreatmentStandard, rate: "19"},
+		{name: "rate_on_date", seller: domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, buyer: domain.TaxParty{Country: "DE"}, at: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), treatment: domain.TaxTreatmentStandard, rate: "16"},
+		{name: "eu_reverse_charge", seller: domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, buyer: domain.TaxParty{Country: "FR", TaxID: "FR12345678901"}, at: at, treatment: domain.TaxTreatmentReverseCharge, rate: "0", note: "Reverse charge: the customer accounts for VAT (Article 196, Council Directive 2006/112/EC)"},
+		{name: "uk_reverse_charge", seller: domain.TaxParty{Country: "GB", TaxID: "GB123456789"}, buyer: domain.TaxParty{Country: "FR", TaxID: "FR12345678901"}, at: at, treatment: domain.TaxTreatmentReverseCharge, rate: "0", note: "Reverse charge: the customer accounts for VAT"},
+		{name: "outside_scope", seller: domain.TaxParty{Country: "GB", TaxID: "GB123456789"}, buyer: domain.TaxParty{Country: "US", TaxID: "12-3456789"}, at: at, treatment: domain.TaxTreatmentOutsideScope, rate: "0", note: "Outside the scope of United Kingdom VAT: supplied to a business outside United Kingdom"},
+		{name: "not_registered", seller: domain.TaxParty{Country: "FR"}, buyer: domain.TaxParty{Country: "FR"}, at: at, treatment: domain.TaxTreatmentNotRegistered, rate: "0", note: "Not registered for VAT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestTaxService()
+
+			determination, err := service.Determine(context.Background(), tc.seller, tc.buyer, tc.at)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.treatment, determination.Treatment)
+			assert.Equal(t, tc.rate, determination.Rate.String())
+			assert.Equal(t, tc.note, determination.Note)
+			assert.Equal(t, "VAT", determination.TaxName)
+			assert.Equal(t, "test.1", determination.RatesVersion)
+		})
+	}
+}
+
+func TestTaxService_Determine_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		seller  domain.TaxParty
+		buyer   domain.TaxParty
+		at      time.Time
+		errType appErrors.ErrorType
+	}{
+		{name: "unknown_seller", seller: domain.TaxParty{Country: "US", TaxID: "12-3456789"}, buyer: domain.TaxParty{Country: "US"}, errType: appErrors.ErrorTypeNotFound},
+		{name: "missing_buyer_country", seller: domain.TaxParty{Country: "DE", TaxID: "DE123456789"}, buyer: domain.TaxParty{TaxID: "FR12345678901"}, errType: appErrors.ErrorTypeValidation},
+		{name: "before_first_rate", seller: domain.TaxParty{Country: "FR", TaxID: "FR12345678901"}, buyer: domain.TaxParty{Country: "FR"}, at: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), errType: appErrors.ErrorTypeNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestTaxService()
+
+			_, err := service.Determine(context.Background(), tc.seller, tc.buyer, tc.at)
+			require.Error(t, err)
+			assert.Equal(t, tc.errType, appErrors.GetErrorType(err))
+		})
+	}
+}
@@ -0,0 +1,564 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type timesheetService struct {
+	timesheetRepo  ports.TimesheetRepository
+	clientRepo     ports.ClientRepository
+	orgService     ports.OrganizationService
+	invoiceService ports.InvoiceService
+	logger         logging.Logger
+	now            func() time.Time
+}
+
+// NewTimesheetService creates a new timesheet service. Approved hours are
+// billed on invoices created through invoiceService.
+func NewTimesheetService(
+	timesheetRepo ports.TimesheetRepository,
+	clientRepo ports.ClientRepository,
+	orgService ports.OrganizationService,
+	invoiceService ports.InvoiceService,
+	logger logging.Logger,
+) ports.TimesheetService {
+	return &timesheetService{
+		timesheetRepo:  timesheetRepo,
+		clientRepo:     clientRepo,
+		orgService:     orgService,
+		invoiceService: invoiceService,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// CreateContractorContract sets the hourly rate a contractor's time on a
+// project is billed at. Finance managers may add contracts; the contractor
+// must be a member of the organization and can only have one active contract
+// per project.
+func (s *timesheetService) CreateContractorContract(ctx context.Context, userID, orgID uuid.UUID, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.orgService.AuthorizeMember(ctx, contract.ContractorID, orgID, nil); err != nil {
+		if appErrors.GetErrorType(err) == appErrors.ErrorTypeNotFound {
+			return nil, appErrors.NewValidationError("the contractor must be a member of the organization")
+		}
+		return nil, err
+	}
+
+	contract.OrganizationID = orgID
+	if err := s.prepareContractorContract(ctx, &contract); err != nil {
+		return nil, err
+	}
+	if err := s.ensureNoActiveContract(ctx, contract, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	contract.ID = uuid.New()
+	contract.Active = true
+	contract.CreatedBy = &userID
+
+	created, err := s.timesheetRepo.CreateContractorContract(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("the contractor already has an active contract for project %q", contract.Project))
+	}
+
+	s.logger.Info("Contractor contract created", map[string]interface{}{
+		"organization_id": orgID,
+		"contract_id":     created.ID,
+		"contractor_id":   created.ContractorID,
+		"created_by":      userID,
+	})
+
+	return created, nil
+}
+
+// ListContractorContracts lists the organization's contracts. Members who do
+// not manage finances only see their own.
+func (s *timesheetService) ListContractorContracts(ctx context.Context, userID, orgID uuid.UUID) ([]domain.ContractorContract, error) {
+	member, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractorID *uuid.UUID
+	if !member.Role.CanManageFinances() {
+		contractorID = &userID
+	}
+
+	return s.timesheetRepo.ListContractorContracts(ctx, orgID, contractorID)
+}
+
+// UpdateContractorContract replaces a contract's client, project, rate and
+// active flag. Approved hours not yet invoiced are billed at the new rate;
+// invoices already raised keep theirs.
+func (s *timesheetService) UpdateContractorContract(ctx context.Context, userID, orgID, contractID uuid.UUID, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getContractorContract(ctx, orgID, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	contract.OrganizationID = orgID
+	contract.ContractorID = existing.ContractorID
+	if err := s.prepareContractorContract(ctx, &contract); err != nil {
+		return nil, err
+	}
+	if contract.Active {
+		if err := s.ensureNoActiveContract(ctx, contract, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.ClientID = contract.ClientID
+	existing.Project = contract.Project
+	existing.HourlyRate = contract.HourlyRate
+	existing.Active = contract.Active
+
+	updated, err := s.timesheetRepo.UpdateContractorContract(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, appErrors.NewNotFoundError("contract not found")
+	}
+
+	s.logger.Info("Contractor contract updated", map[string]interface{}{
+		"organization_id": orgID,
+		"contract_id":     contractID,
+		"updated_by":      userID,
+		"active":          updated.Active,
+	})
+
+	return updated, nil
+}
+
+// SaveTimesheet replaces the contractor's entries for the week starting on
+// weekStart, a Monday, on one of their active contracts. Time can be logged
+// for days up to today, and a timesheet can be edited until it is submitted;
+// editing a rejected timesheet returns it to draft.
+func (s *timesheetService) SaveTimesheet(ctx context.Context, userID, orgID, contractID uuid.UUID, weekStart time.Time, entries []domain.TimesheetEntry) (*domain.Timesheet, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	contract, err := s.getContractorContract(ctx, orgID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	if contract.ContractorID != userID {
+		return nil, appErrors.NewForbiddenError("only the contractor can log time on their contract")
+	}
+	if !contract.Active {
+		return nil, appErrors.NewConflictError("the contract is no longer active")
+	}
+
+	timesheet := domain.Timesheet{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		ContractID:     contract.ID,
+		ContractorID:   userID,
+		WeekStart:      weekStart,
+		Entries:        entries,
+	}
+	if err := s.prepareTimesheet(&timesheet); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.timesheetRepo.SaveTimesheet(ctx, timesheet)
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil {
+		return nil, appErrors.NewConflictError("the timesheet has been submitted and can no longer be changed")
+	}
+
+	s.logger.Info("Timesheet saved", map[string]interface{}{
+		"organization_id": orgID,
+		"timesheet_id":    saved.ID,
+		"contract_id":     contract.ID,
+		"week_start":      saved.WeekStart.Format(time.DateOnly),
+		"total_hours":     saved.TotalHours.String(),
+	})
+
+	return saved, nil
+}
+
+// ListTimesheets lists the organization's timesheets, latest week first.
+// Members who do not manage finances only see their own.
+func (s *timesheetService) ListTimesheets(ctx context.Context, userID, orgID uuid.UUID, filter domain.TimesheetFilter, page, pageSize int) ([]domain.Timesheet, int64, error) {
+	member, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, 0, appErrors.NewValidationError(fmt.Sprintf("invalid status %q", *filter.Status))
+	}
+	if !member.Role.CanManageFinances() {
+		filter.ContractorID = &userID
+	}
+
+	return s.timesheetRepo.ListTimesheets(ctx, orgID, filter, pageSize, (page-1)*pageSize)
+}
+
+// GetTimesheet retrieves a timesheet with its entries. Contractors can see
+// their own timesheets; anyone else needs a role that manages finances.
+func (s *timesheetService) GetTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error) {
+	member, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	timesheet, err := s.getTimesheet(ctx, orgID, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.ContractorID != userID && !member.Role.CanManageFinances() {
+		return nil, appErrors.NewForbiddenError("you can only view your own timesheets")
+	}
+
+	return timesheet, nil
+}
+
+// SubmitTimesheet sends the contractor's draft timesheet for approval. Once
+// submitted it can no longer be edited unless it is rejected.
+func (s *timesheetService) SubmitTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, nil); err != nil {
+		return nil, err
+	}
+
+	timesheet, err := s.getTimesheet(ctx, orgID, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.ContractorID != userID {
+		return nil, appErrors.NewForbiddenError("only the contractor can submit their timesheet")
+	}
+	if timesheet.Status != domain.TimesheetStatusDraft {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s timesheet cannot be submitted; edit it first", timesheet.Status))
+	}
+	if !timesheet.TotalHours.IsPositive() {
+		return nil, appErrors.NewValidationError("log some hours before submitting the timesheet")
+	}
+
+	submitted, err := s.timesheetRepo.SubmitTimesheet(ctx, timesheet.ID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if submitted == nil {
+		return nil, appErrors.NewConflictError("the timesheet was changed in the meantime")
+	}
+
+	s.logger.Info("Timesheet submitted", map[string]interface{}{
+		"organization_id": orgID,
+		"timesheet_id":    submitted.ID,
+		"total_hours":     submitted.TotalHours.String(),
+	})
+
+	return submitted, nil
+}
+
+// ApproveTimesheet approves a submitted timesheet so its hours can be
+// invoiced. Finance managers approve timesheets, but not their own.
+func (s *timesheetService) ApproveTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error) {
+	return s.reviewTimesheet(ctx, userID, orgID, timesheetID, domain.TimesheetStatusApproved, "")
+}
+
+// RejectTimesheet sends a submitted timesheet back to the contractor with the
+// reason, so they can correct and resubmit it
+func (s *timesheetService) RejectTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID, reason string) (*domain.Timesheet, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, appErrors.NewValidationError("a reason is required to reject a timesheet")
+	}
+
+	return s.reviewTimesheet(ctx, userID, orgID, timesheetID, domain.TimesheetStatusRejected, reason)
+}
+
+// InvoiceTimesheets raises a draft invoice for the client of approved
+// timesheets, one line per timesheet billing its hours at the contract's
+// hourly rate. The timesheets must share a client and currency; the invoice
+// takes the rest of its details from the client. The timesheets are marked
+// invoiced in the transaction that creates the invoice, so their hours are
+// never billed twice or left on no invoice.
+func (s *timesheetService) InvoiceTimesheets(ctx context.Context, userID, orgID uuid.UUID, timesheetIDs []uuid.UUID) (*domain.Invoice, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(timesheetIDs))
+	for _, id := range timesheetIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, appErrors.NewValidationError("at least one timesheet is required")
+	}
+	if len(ids) > maxInvoiceLineItems {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("at most %d timesheets can be invoiced together", maxInvoiceLineItems))
+	}
+
+	type billedTimesheet struct {
+		timesheet domain.Timesheet
+		rate      money.Money
+	}
+	billed := make([]billedTimesheet, 0, len(ids))
+	var clientID *uuid.UUID
+
+	for _, id := range ids {
+		timesheet, err := s.getTimesheet(ctx, orgID, id)
+		if err != nil {
+			return nil, err
+		}
+		if timesheet.Status != domain.TimesheetStatusApproved {
+			return nil, appErrors.NewConflictError(fmt.Sprintf("the timesheet of %s for the week of %s is %s; only approved timesheets can be invoiced",
+				timesheet.ContractorName(), timesheet.WeekStart.Format(time.DateOnly), timesheet.Status))
+		}
+
+		contract, err := s.getContractorContract(ctx, orgID, timesheet.ContractID)
+		if err != nil {
+			return nil, err
+		}
+		if contract.ClientID == nil {
+			return nil, appErrors.NewValidationError(fmt.Sprintf("the contract for project %q has no client to invoice", contract.Project))
+		}
+		if clientID == nil {
+			clientID = contract.ClientID
+		} else if *clientID != *contract.ClientID {
+			return nil, appErrors.NewValidationError("timesheets for different clients must be invoiced separately")
+		}
+		if len(billed) > 0 && !billed[0].rate.SameCurrency(contract.HourlyRate) {
+			return nil, appErrors.NewValidationError("timesheets billed in different currencies must be invoiced separately")
+		}
+
+		billed = append(billed, billedTimesheet{timesheet: *timesheet, rate: contract.HourlyRate})
+	}
+
+	slices.SortStableFunc(billed, func(a, b billedTimesheet) int {
+		return cmp.Or(
+			a.timesheet.WeekStart.Compare(b.timesheet.WeekStart),
+			strings.Compare(a.timesheet.Project, b.timesheet.Project),
+			strings.Compare(a.timesheet.ContractorName(), b.timesheet.ContractorName()),
+		)
+	})
+
+	invoice := domain.Invoice{
+		ClientID:  clientID,
+		Currency:  billed[0].rate.Currency(),
+		LineItems: make([]domain.InvoiceLineItem, len(billed)),
+	}
+	for i, item := range billed {
+		invoice.LineItems[i] = domain.InvoiceLineItem{
+			Description: fmt.Sprintf("%s: %s, week of %s", item.timesheet.Project, item.timesheet.ContractorName(), item.timesheet.WeekStart.Format("2 Jan 2006")),
+			Quantity:    item.timesheet.TotalHours,
+			UnitPrice:   item.rate,
+		}
+	}
+
+	created, err := s.invoiceService.CreateTimesheetInvoice(ctx, userID, orgID, invoice, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Timesheets invoiced", map[string]interface{}{
+		"organization_id": orgID,
+		"invoice_id":      created.ID,
+		"number":          created.Number,
+		"timesheets":      len(ids),
+		"created_by":      userID,
+	})
+
+	return created, nil
+}
+
+// reviewTimesheet approves or rejects a submitted timesheet. Nobody reviews
+// their own time, even if they manage the organization's finances.
+func (s *timesheetService) reviewTimesheet(ctx context.Context, userID, orgID, timesheetID uuid.UUID, status domain.TimesheetStatus, reason string) (*domain.Timesheet, error) {
+	if _, err := s.orgService.AuthorizeMember(ctx, userID, orgID, domain.OrganizationRole.CanManageFinances); err != nil {
+		return nil, err
+	}
+
+	timesheet, err := s.getTimesheet(ctx, orgID, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.ContractorID == userID {
+		return nil, appErrors.NewForbiddenError("you cannot review your own timesheet")
+	}
+	if timesheet.Status != domain.TimesheetStatusSubmitted {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("a %s timesheet cannot be reviewed", timesheet.Status))
+	}
+
+	reviewed, err := s.timesheetRepo.ReviewTimesheet(ctx, timesheet.ID, status, userID, s.now(), reason)
+	if err != nil {
+		return nil, err
+	}
+	if reviewed == nil {
+		return nil, appErrors.NewConflictError("the timesheet was reviewed in the meantime")
+	}
+
+	s.logger.Info("Timesheet reviewed", map[string]interface{}{
+		"organization_id": orgID,
+		"timesheet_id":    reviewed.ID,
+		"status":          string(reviewed.Status),
+		"reviewed_by":     userID,
+	})
+
+	return reviewed, nil
+}
+
+// prepareContractorContract normalises and validates a contract's project,
+// client and hourly rate
+func (s *timesheetService) prepareContractorContract(ctx context.Context, contract *domain.ContractorContract) error {
+	contract.Project = strings.TrimSpace(contract.Project)
+	if contract.Project == "" {
+		return appErrors.NewValidationError("project is required")
+	}
+	if len(contract.Project) > 255 {
+		return appErrors.NewValidationError("project can be at most 255 characters")
+	}
+
+	if contract.ClientID != nil {
+		client, err := s.clientRepo.GetClient(ctx, contract.OrganizationID, *contract.ClientID)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			return appErrors.NewValidationError("client not found")
+		}
+	}
+
+	currency, err := normalizeCurrencyCode(contract.HourlyRate.Currency())
+	if err != nil {
+		return err
+	}
+	if !contract.HourlyRate.IsPositive() {
+		return appErrors.NewValidationError("hourly rate must be greater than zero")
+	}
+	contract.HourlyRate = money.New(contract.HourlyRate.Amount(), currency)
+
+	return nil
+}
+
+// ensureNoActiveContract rejects a contract for a project the contractor
+// already has another active contract for
+func (s *timesheetService) ensureNoActiveContract(ctx context.Context, contract domain.ContractorContract, excludeID uuid.UUID) error {
+	contracts, err := s.timesheetRepo.ListContractorContracts(ctx, contract.OrganizationID, &contract.ContractorID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range contracts {
+		if existing.Active && existing.ID != excludeID && strings.EqualFold(existing.Project, contract.Project) {
+			return appErrors.NewConflictError(fmt.Sprintf("the contractor already has an active contract for project %q", existing.Project))
+		}
+	}
+
+	return nil
+}
+
+// prepareTimesheet checks a week's entries and adds up its hours. The week
+// and the entries' dates are calendar days, stored as midnight UTC. Entries
+// are kept in date order, fall within the week and not after today, and log
+// at most a day's hours against any one day, in hundredths of an hour.
+func (s *timesheetService) prepareTimesheet(timesheet *domain.Timesheet) error {
+	if timesheet.WeekStart.Weekday() != time.Monday {
+		return appErrors.NewValidationError("the week must start on a Monday")
+	}
+	timesheet.WeekStart = domain.TimesheetWeekStart(timesheet.WeekStart)
+
+	now := s.now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if timesheet.WeekStart.After(today) {
+		return appErrors.NewValidationError("time cannot be logged for a week that has not started")
+	}
+
+	if len(timesheet.Entries) > domain.MaxTimesheetEntries {
+		return appErrors.NewValidationError(fmt.Sprintf("a timesheet can have at most %d entries", domain.MaxTimesheetEntries))
+	}
+
+	maxDayHours := decimal.NewFromInt(domain.MaxTimesheetDayHours)
+	dayHours := make(map[time.Time]decimal.Decimal)
+	total := decimal.Zero
+
+	for i := range timesheet.Entries {
+		entry := &timesheet.Entries[i]
+
+		entry.WorkDate = time.Date(entry.WorkDate.Year(), entry.WorkDate.Month(), entry.WorkDate.Day(), 0, 0, 0, 0, time.UTC)
+		if entry.WorkDate.Before(timesheet.WeekStart) || entry.WorkDate.After(timesheet.WeekEnd()) {
+			return appErrors.NewValidationError(fmt.Sprintf("entry %d: %s is not in the week of %s", i+1, entry.WorkDate.Format(time.DateOnly), timesheet.WeekStart.Format(time.DateOnly)))
+		}
+		if entry.WorkDate.After(today) {
+			return appErrors.NewValidationError(fmt.Sprintf("entry %d: time cannot be logged for a day that has not come yet", i+1))
+		}
+
+		if !entry.Hours.IsPositive() {
+			return appErrors.NewValidationError(fmt.Sprintf("entry %d: hours must be greater than zero", i+1))
+		}
+		if !entry.Hours.Equal(entry.Hours.Round(2)) {
+			return appErrors.NewValidationError(fmt.Sprintf("entry %d: hours can have at most two decimal places", i+1))
+		}
+
+		dayHours[entry.WorkDate] = dayHours[entry.WorkDate].Add(entry.Hours)
+		if dayHours[entry.WorkDate].GreaterThan(maxDayHours) {
+			return appErrors.NewValidationError(fmt.Sprintf("more than %d hours are logged on %s", domain.MaxTimesheetDayHours, entry.WorkDate.Format(time.DateOnly)))
+		}
+		total = total.Add(entry.Hours)
+
+		entry.Description = strings.TrimSpace(entry.Description)
+	}
+
+	slices.SortStableFunc(timesheet.Entries, func(a, b domain.TimesheetEntry) int {
+		return a.WorkDate.Compare(b.WorkDate)
+	})
+	timesheet.TotalHours = total
+
+	return nil
+}
+
+func (s *timesheetService) getContractorContract(ctx context.Context, orgID, contractID uuid.UUID) (*domain.ContractorContract, error) {
+	contract, err := s.timesheetRepo.GetContractorContract(ctx, orgID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	if contract == nil {
+		return nil, appErrors.NewNotFoundError("contract not found")
+	}
+
+	return contract, nil
+}
+
+func (s *timesheetService) getTimesheet(ctx context.Context, orgID, timesheetID uuid.UUID) (*domain.Timesheet, error) {
+	timesheet, err := s.timesheetRepo.GetTimesheet(ctx, orgID, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet == nil {
+		return nil, appErrors.NewNotFoundError("timesheet not found")
+	}
+
+	return timesheet, nil
+}
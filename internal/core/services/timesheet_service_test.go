@@ -0,0 +1,957 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// weekOf is the Monday the test clock's week starts on
+var weekOf = time.Date(2025, 5, 19, 0, 0, 0, 0, time.UTC)
+
+// stubTimesheetStore keeps contracts and timesheets in memory and moves
+// timesheets between statuses the way the repository does
+func stubTimesheetStore(repo *mocks.FakeTimesheetRepository, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) {
+	repo.CreateContractorContractStub = func(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+		contracts[contract.ID] = contract
+		return &contract, nil
+	}
+	repo.GetContractorContractStub = func(ctx context.Context, orgID, id uuid.UUID) (*domain.ContractorContract, error) {
+		contract, ok := contracts[id]
+		if !ok || contract.OrganizationID != orgID {
+			return nil, nil
+		}
+		return &contract, nil
+	}
+	repo.ListContractorContractsStub = func(ctx context.Context, orgID uuid.UUID, contractorID *uuid.UUID) ([]domain.ContractorContract, error) {
+		var listed []domain.ContractorContract
+		for _, contract := range contracts {
+			if contract.OrganizationID == orgID && (contractorID == nil || contract.ContractorID == *contractorID) {
+				listed = append(listed, contract)
+			}
+		}
+		return listed, nil
+	}
+	repo.UpdateContractorContractStub = func(ctx context.Context, contract domain.ContractorContract) (*domain.ContractorContract, error) {
+		contracts[contract.ID] = contract
+		return &contract, nil
+	}
+	repo.SaveTimesheetStub = func(ctx context.Context, timesheet domain.Timesheet) (*domain.Timesheet, error) {
+		for id, existing := range timesheets {
+			if existing.ContractID != timesheet.ContractID || !existing.WeekStart.Equal(timesheet.WeekStart) {
+				continue
+			}
+			if existing.Status != domain.TimesheetStatusDraft && existing.Status != domain.TimesheetStatusRejected {
+				return nil, nil
+			}
+			timesheet.ID = id
+			timesheet.RejectionReason = existing.RejectionReason
+		}
+		timesheet.Status = domain.TimesheetStatusDraft
+		timesheet.Project = contracts[timesheet.ContractID].Project
+		timesheet.FirstName = contracts[timesheet.ContractID].FirstName
+		timesheets[timesheet.ID] = timesheet
+		return &timesheet, nil
+	}
+	repo.GetTimesheetStub = func(ctx context.Context, orgID, id uuid.UUID) (*domain.Timesheet, error) {
+		timesheet, ok := timesheets[id]
+		if !ok || timesheet.OrganizationID != orgID {
+			return nil, nil
+		}
+		return &timesheet, nil
+	}
+	repo.SubmitTimesheetStub = func(ctx context.Context, id uuid.UUID, at time.Time) (*domain.Timesheet, error) {
+		timesheet := timesheets[id]
+		if timesheet.Status != domain.TimesheetStatusDraft {
+			return nil, nil
+		}
+		timesheet.Status = domain.TimesheetStatusSubmitted
+		timesheet.SubmittedAt = &at
+		timesheet.RejectionReason = ""
+		timesheets[id] = timesheet
+		return &timesheet, nil
+	}
+	repo.ReviewTimesheetStub = func(ctx context.Context, id uuid.UUID, status domain.TimesheetStatus, reviewerID uuid.UUID, at time.Time, reason string) (*domain.Timesheet, error) {
+		timesheet := timesheets[id]
+		if timesheet.Status != domain.TimesheetStatusSubmitted {
+			return nil, nil
+		}
+		timesheet.Status = status
+		timesheet.ReviewedBy = &reviewerID
+		timesheet.ReviewedAt = &at
+		timesheet.RejectionReason = reason
+		timesheets[id] = timesheet
+		return &timesheet, nil
+	}
+}
+
+// stubTimesheetInvoicing creates timesheet invoices and marks their
+// timesheets invoiced together, or changes nothing
+func stubTimesheetInvoicing(repo *mocks.FakeInvoiceRepository, timesheets map[uuid.UUID]domain.Timesheet) {
+	repo.CreateTimesheetInvoiceStub = func(ctx context.Context, invoice domain.Invoice, ids []uuid.UUID) (*domain.Invoice, error) {
+		before := make(map[uuid.UUID]domain.Timesheet, len(ids))
+		for _, id := range ids {
+			timesheet := timesheets[id]
+			if timesheet.Status != domain.TimesheetStatusApproved {
+				return nil, nil
+			}
+			before[id] = timesheet
+			timesheet.Status = domain.TimesheetStatusInvoiced
+			timesheet.InvoiceID = &invoice.ID
+			timesheets[id] = timesheet
+		}
+
+		created, err := repo.CreateInvoice(ctx, invoice)
+		if err != nil {
+			// The transaction rolls the timesheets back with the invoice
+			for id, timesheet := range before {
+				timesheets[id] = timesheet
+			}
+			return nil, err
+		}
+		return created, nil
+	}
+}
+
+// testContractorContract builds an active contract billing the contractor's hours to client
+func testContractorContract(orgID, contractorID uuid.UUID, clientID *uuid.UUID, project, rate string) domain.ContractorContract {
+	return domain.ContractorContract{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		ContractorID:   contractorID,
+		FirstName:      "Ada",
+		LastName:       "Lovelace",
+		ClientID:       clientID,
+		Project:        project,
+		HourlyRate:     money.MustParse(rate, "USD"),
+		Active:         true,
+	}
+}
+
+// testTimesheet builds a timesheet for the contract's week in the status
+func testTimesheet(contract domain.ContractorContract, weekStart time.Time, hours string, status domain.TimesheetStatus) domain.Timesheet {
+	return domain.Timesheet{
+		ID:             uuid.New(),
+		OrganizationID: contract.OrganizationID,
+		ContractID:     contract.ID,
+		ContractorID:   contract.ContractorID,
+		FirstName:      contract.FirstName,
+		LastName:       contract.LastName,
+		Project:        contract.Project,
+		WeekStart:      weekStart,
+		Status:         status,
+		TotalHours:     decimal.RequireFromString(hours),
+	}
+}
+
+func entry(date time.Time, hours string) domain.TimesheetEntry {
+	return domain.TimesheetEntry{WorkDate: date, Hours: decimal.RequireFromString(hours)}
+}
+
+func TestTimesheetService_CreateContractorContract(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	finance, contractor := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		finance:    domain.OrganizationRoleFinance,
+		contractor: domain.OrganizationRoleViewer,
+	})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test"}
+	stubClientDirectory(mockClientRepo, client)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	contract, err := service.CreateContractorContract(context.Background(), finance, orgID, domain.ContractorContract{
+		ContractorID: contractor,
+		ClientID:     &client.ID,
+		Project:      " Website ",
+		HourlyRate:   money.MustParse("85.50", "usd"),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Website", contract.Project)
+	assert.Equal(t, "USD", contract.HourlyRate.Currency())
+	assert.Equal(t, "85.5", contract.HourlyRate.Amount().String())
+	assert.True(t, contract.Active)
+	assert.Equal(t, finance, *contract.CreatedBy)
+	assert.Len(t, contracts, 1)
+
+	// Act: the same project, however it is written, cannot have two active contracts
+	_, err = service.CreateContractorContract(context.Background(), finance, orgID, domain.ContractorContract{
+		ContractorID: contractor,
+		Project:      "WEBSITE",
+		HourlyRate:   money.MustParse("90", "USD"),
+	})
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+}
+
+func TestTimesheetService_CreateContractorContract_Errors(t *testing.T) {
+	outsider := uuid.New()
+
+	testCases := []struct {
+		name       string
+		role       domain.OrganizationRole
+		contractor *uuid.UUID
+		clientID   *uuid.UUID
+		project    string
+		rate       money.Money
+		errType    appErrors.ErrorType
+	}{
+		{name: "viewer", role: domain.OrganizationRoleViewer, project: "Website", rate: money.MustParse("85", "USD"), errType: appErrors.ErrorTypeForbidden},
+		{name: "not_a_member", role: domain.OrganizationRoleFinance, contractor: &outsider, project: "Website", rate: money.MustParse("85", "USD"), errType: appErrors.ErrorTypeValidation},
+		{name: "missing_project", role: domain.OrganizationRoleFinance, project: "  ", rate: money.MustParse("85", "USD"), errType: appErrors.ErrorTypeValidation},
+		{name: "zero_rate", role: domain.OrganizationRoleFinance, project: "Website", rate: money.Zero("USD"), errType: appErrors.ErrorTypeValidation},
+		{name: "unknown_client", role: domain.OrganizationRoleFinance, clientID: &outsider, project: "Website", rate: money.MustParse("85", "USD"), errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			contracts := make(map[uuid.UUID]domain.ContractorContract)
+			timesheets := make(map[uuid.UUID]domain.Timesheet)
+			stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+			caller, contractor := uuid.New(), uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+				caller:     tc.role,
+				contractor: domain.OrganizationRoleViewer,
+			})
+			if tc.contractor != nil {
+				contractor = *tc.contractor
+			}
+			stubClientDirectory(mockClientRepo)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			logger := logging.New(&cfg)
+			invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+			invoiceService.now = func() time.Time { return now }
+			service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateContractorContract(context.Background(), caller, orgID, domain.ContractorContract{
+				ContractorID: contractor,
+				ClientID:     tc.clientID,
+				Project:      tc.project,
+				HourlyRate:   tc.rate,
+			})
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Empty(t, contracts)
+		})
+	}
+}
+
+func TestTimesheetService_SaveTimesheet(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	contractor := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{contractor: domain.OrganizationRoleViewer})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	contract := testContractorContract(orgID, contractor, nil, "Website", "85")
+	contracts[contract.ID] = contract
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+
+	// The week and its days are taken as calendar dates in the zone they are sent in
+	lagos := time.FixedZone("WAT", 3600)
+
+	// Act
+	timesheet, err := service.SaveTimesheet(context.Background(), contractor, orgID, contract.ID, time.Date(2025, 5, 19, 0, 0, 0, 0, lagos), []domain.TimesheetEntry{
+		entry(time.Date(2025, 5, 21, 0, 30, 0, 0, lagos), "7.5"),
+		{WorkDate: weekOf, Hours: decimal.RequireFromString("8"), Description: " Kick-off "},
+		entry(weekOf, "0.25"),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, weekOf, timesheet.WeekStart)
+	assert.Equal(t, domain.TimesheetStatusDraft, timesheet.Status)
+	assert.Equal(t, "15.75", timesheet.TotalHours.String())
+	require.Len(t, timesheet.Entries, 3)
+	assert.Equal(t, weekOf, timesheet.Entries[0].WorkDate)
+	assert.Equal(t, "Kick-off", timesheet.Entries[0].Description)
+	assert.Equal(t, time.Date(2025, 5, 21, 0, 0, 0, 0, time.UTC), timesheet.Entries[2].WorkDate)
+}
+
+func TestTimesheetService_SaveTimesheet_Errors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		weekStart time.Time
+		entries   []domain.TimesheetEntry
+		errType   appErrors.ErrorType
+	}{
+		{name: "not_a_monday", weekStart: weekOf.AddDate(0, 0, 1), errType: appErrors.ErrorTypeValidation},
+		{name: "week_not_started", weekStart: weekOf.AddDate(0, 0, 7), errType: appErrors.ErrorTypeValidation},
+		{name: "outside_the_week", weekStart: weekOf, entries: []domain.TimesheetEntry{entry(weekOf.AddDate(0, 0, -1), "2")}, errType: appErrors.ErrorTypeValidation},
+		{name: "day_not_come", weekStart: weekOf, entries: []domain.TimesheetEntry{entry(weekOf.AddDate(0, 0, 6), "2")}, errType: appErrors.ErrorTypeValidation},
+		{name: "zero_hours", weekStart: weekOf, entries: []domain.TimesheetEntry{entry(weekOf, "0")}, errType: appErrors.ErrorTypeValidation},
+		{name: "too_precise", weekStart: weekOf, entries: []domain.TimesheetEntry{entry(weekOf, "1.125")}, errType: appErrors.ErrorTypeValidation},
+		{name: "over_a_day", weekStart: weekOf, entries: []domain.TimesheetEntry{entry(weekOf, "16"), entry(weekOf, "8.5")}, errType: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			contractor := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{contractor: domain.OrganizationRoleViewer})
+			contracts := make(map[uuid.UUID]domain.ContractorContract)
+			timesheets := make(map[uuid.UUID]domain.Timesheet)
+			stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+			contract := testContractorContract(orgID, contractor, nil, "Website", "85")
+			contracts[contract.ID] = contract
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			logger := logging.New(&cfg)
+			invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+			invoiceService.now = func() time.Time { return now }
+			service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.SaveTimesheet(context.Background(), contractor, orgID, contract.ID, tc.weekStart, tc.entries)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Empty(t, timesheets)
+		})
+	}
+}
+
+func TestTimesheetService_SaveTimesheet_Access(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	contractor, admin := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		contractor: domain.OrganizationRoleViewer,
+		admin:      domain.OrganizationRoleAdmin,
+	})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	contract := testContractorContract(orgID, contractor, nil, "Website", "85")
+	contracts[contract.ID] = contract
+	submitted := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusSubmitted)
+	timesheets[submitted.ID] = submitted
+	inactive := testContractorContract(orgID, contractor, nil, "Audit", "85")
+	inactive.Active = false
+	contracts[inactive.ID] = inactive
+	entries := []domain.TimesheetEntry{entry(weekOf, "8")}
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, adminErr := service.SaveTimesheet(context.Background(), admin, orgID, contract.ID, weekOf, entries)
+	_, submittedErr := service.SaveTimesheet(context.Background(), contractor, orgID, contract.ID, weekOf, entries)
+	_, inactiveErr := service.SaveTimesheet(context.Background(), contractor, orgID, inactive.ID, weekOf.AddDate(0, 0, -7), entries)
+
+	// Assert: only the contractor logs time on their contract, whatever the caller's role
+	assertAppErrorType(t, adminErr, appErrors.ErrorTypeForbidden)
+	assertAppErrorType(t, submittedErr, appErrors.ErrorTypeConflict)
+	assert.Equal(t, domain.TimesheetStatusSubmitted, timesheets[submitted.ID].Status)
+	assertAppErrorType(t, inactiveErr, appErrors.ErrorTypeConflict)
+}
+
+func TestTimesheetService_TimesheetApproval(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	contractor, finance := uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		contractor: domain.OrganizationRoleViewer,
+		finance:    domain.OrganizationRoleFinance,
+	})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	contract := testContractorContract(orgID, contractor, nil, "Website", "85")
+	contracts[contract.ID] = contract
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	draft, err := service.SaveTimesheet(ctx, contractor, orgID, contract.ID, weekOf, []domain.TimesheetEntry{entry(weekOf, "8")})
+	require.NoError(t, err)
+
+	// Act: only submitted timesheets are reviewed
+	_, err = service.ApproveTimesheet(ctx, finance, orgID, draft.ID)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+
+	// Act
+	_, financeErr := service.SubmitTimesheet(ctx, finance, orgID, draft.ID)
+	submitted, err := service.SubmitTimesheet(ctx, contractor, orgID, draft.ID)
+
+	// Assert
+	assertAppErrorType(t, financeErr, appErrors.ErrorTypeForbidden)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimesheetStatusSubmitted, submitted.Status)
+	assert.Equal(t, now, *submitted.SubmittedAt)
+
+	// Act
+	_, contractorErr := service.ApproveTimesheet(ctx, contractor, orgID, draft.ID)
+	_, blankErr := service.RejectTimesheet(ctx, finance, orgID, draft.ID, " ")
+	rejected, err := service.RejectTimesheet(ctx, finance, orgID, draft.ID, "Monday was a public holiday")
+
+	// Assert
+	assertAppErrorType(t, contractorErr, appErrors.ErrorTypeForbidden)
+	assertAppErrorType(t, blankErr, appErrors.ErrorTypeValidation)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimesheetStatusRejected, rejected.Status)
+	assert.Equal(t, finance, *rejected.ReviewedBy)
+
+	// Act: a rejected timesheet goes back to draft when it is corrected
+	_, resubmitErr := service.SubmitTimesheet(ctx, contractor, orgID, draft.ID)
+	corrected, err := service.SaveTimesheet(ctx, contractor, orgID, contract.ID, weekOf, []domain.TimesheetEntry{entry(weekOf.AddDate(0, 0, 1), "8")})
+
+	// Assert
+	assertAppErrorType(t, resubmitErr, appErrors.ErrorTypeConflict)
+	require.NoError(t, err)
+	assert.Equal(t, draft.ID, corrected.ID)
+	assert.Equal(t, domain.TimesheetStatusDraft, corrected.Status)
+	assert.Equal(t, "Monday was a public holiday", corrected.RejectionReason)
+
+	// Act
+	_, err = service.SubmitTimesheet(ctx, contractor, orgID, draft.ID)
+	require.NoError(t, err)
+	approved, err := service.ApproveTimesheet(ctx, finance, orgID, draft.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimesheetStatusApproved, approved.Status)
+}
+
+func TestTimesheetService_ApproveTimesheet_OwnTimesheet(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	admin := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{admin: domain.OrganizationRoleAdmin})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	contract := testContractorContract(orgID, admin, nil, "Website", "85")
+	contracts[contract.ID] = contract
+	timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusSubmitted)
+	timesheets[timesheet.ID] = timesheet
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.ApproveTimesheet(context.Background(), admin, orgID, timesheet.ID)
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Equal(t, domain.TimesheetStatusSubmitted, timesheets[timesheet.ID].Status)
+}
+
+func TestTimesheetService_TimesheetVisibility(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	contractor, colleague, finance := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		contractor: domain.OrganizationRoleViewer,
+		colleague:  domain.OrganizationRoleViewer,
+		finance:    domain.OrganizationRoleFinance,
+	})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	contract := testContractorContract(orgID, contractor, nil, "Website", "85")
+	contracts[contract.ID] = contract
+	timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusDraft)
+	timesheets[timesheet.ID] = timesheet
+	invalid := domain.TimesheetStatus("paid")
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Act
+	_, contractorErr := service.GetTimesheet(ctx, contractor, orgID, timesheet.ID)
+	_, financeErr := service.GetTimesheet(ctx, finance, orgID, timesheet.ID)
+	_, colleagueErr := service.GetTimesheet(ctx, colleague, orgID, timesheet.ID)
+	_, _, colleagueListErr := service.ListTimesheets(ctx, colleague, orgID, domain.TimesheetFilter{ContractorID: &contractor}, 1, 10)
+	_, _, financeListErr := service.ListTimesheets(ctx, finance, orgID, domain.TimesheetFilter{ContractorID: &contractor}, 1, 10)
+	_, _, invalidErr := service.ListTimesheets(ctx, finance, orgID, domain.TimesheetFilter{Status: &invalid}, 1, 10)
+
+	// Assert
+	require.NoError(t, contractorErr)
+	require.NoError(t, financeErr)
+	assertAppErrorType(t, colleagueErr, appErrors.ErrorTypeForbidden)
+
+	// Members who do not manage finances only list their own
+	require.NoError(t, colleagueListErr)
+	_, _, filter, _, _ := mockTimesheetRepo.ListTimesheetsArgsForCall(0)
+	assert.Equal(t, colleague, *filter.ContractorID)
+	require.NoError(t, financeListErr)
+	_, _, filter, _, _ = mockTimesheetRepo.ListTimesheetsArgsForCall(1)
+	assert.Equal(t, contractor, *filter.ContractorID)
+	assertAppErrorType(t, invalidErr, appErrors.ErrorTypeValidation)
+}
+
+func TestTimesheetService_InvoiceTimesheets(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	finance, ada, grace := uuid.New(), uuid.New(), uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{
+		finance: domain.OrganizationRoleFinance,
+		ada:     domain.OrganizationRoleViewer,
+		grace:   domain.OrganizationRoleViewer,
+	})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	stubTimesheetInvoicing(mockInvoiceRepo, timesheets)
+	client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test", PaymentTermsDays: 14}
+	stubClientDirectory(mockClientRepo, client)
+	mockInvoiceRepo.CreateInvoiceStub = func(ctx context.Context, invoice domain.Invoice) (*domain.Invoice, error) {
+		invoice.Number = 1
+		return &invoice, nil
+	}
+	website := testContractorContract(orgID, ada, &client.ID, "Website", "85")
+	contracts[website.ID] = website
+	api := testContractorContract(orgID, grace, &client.ID, "API", "100.50")
+	api.FirstName, api.LastName = "Grace", "Hopper"
+	contracts[api.ID] = api
+	thisWeek := testTimesheet(website, weekOf, "12.5", domain.TimesheetStatusApproved)
+	timesheets[thisWeek.ID] = thisWeek
+	lastWeek := testTimesheet(website, weekOf.AddDate(0, 0, -7), "30", domain.TimesheetStatusApproved)
+	timesheets[lastWeek.ID] = lastWeek
+	graceWeek := testTimesheet(api, weekOf, "4", domain.TimesheetStatusApproved)
+	timesheets[graceWeek.ID] = graceWeek
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Act
+	invoice, err := service.InvoiceTimesheets(ctx, finance, orgID, []uuid.UUID{thisWeek.ID, graceWeek.ID, lastWeek.ID, thisWeek.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusDraft, invoice.Status)
+	assert.Equal(t, client.ID, *invoice.ClientID)
+	assert.Equal(t, "Globex", invoice.CustomerName)
+	assert.Equal(t, "USD", invoice.Currency)
+	assert.Equal(t, now.AddDate(0, 0, 14), invoice.DueDate)
+	assert.Equal(t, finance, *invoice.CreatedBy)
+
+	// One line per timesheet, earliest week first, at the contract's rate
+	require.Len(t, invoice.LineItems, 3)
+	assert.Equal(t, "Website: Ada Lovelace, week of 12 May 2025", invoice.LineItems[0].Description)
+	assert.Equal(t, "30", invoice.LineItems[0].Quantity.String())
+	assert.Equal(t, "85", invoice.LineItems[0].UnitPrice.Amount().String())
+	assert.Equal(t, "API: Grace Hopper, week of 19 May 2025", invoice.LineItems[1].Description)
+	assert.Equal(t, "402", invoice.LineItems[1].Total.Amount().String())
+	assert.Equal(t, "Website: Ada Lovelace, week of 19 May 2025", invoice.LineItems[2].Description)
+	assert.Equal(t, "4014.5", invoice.Total.Amount().String())
+
+	_, _, ids := mockInvoiceRepo.CreateTimesheetInvoiceArgsForCall(0)
+	assert.Len(t, ids, 3)
+	for _, id := range []uuid.UUID{thisWeek.ID, lastWeek.ID, graceWeek.ID} {
+		assert.Equal(t, domain.TimesheetStatusInvoiced, timesheets[id].Status)
+		assert.Equal(t, invoice.ID, *timesheets[id].InvoiceID)
+	}
+
+	// Act: hours are only ever billed once
+	_, err = service.InvoiceTimesheets(ctx, finance, orgID, []uuid.UUID{thisWeek.ID})
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+}
+
+func TestTimesheetService_InvoiceTimesheets_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		setup   func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID
+		errType appErrors.ErrorType
+	}{
+		{
+			name: "not_approved",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				contract := testContractorContract(orgID, uuid.New(), &clientID, "Website", "85")
+				contracts[contract.ID] = contract
+				timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusSubmitted)
+				timesheets[timesheet.ID] = timesheet
+				return []uuid.UUID{timesheet.ID}
+			},
+			errType: appErrors.ErrorTypeConflict,
+		},
+		{
+			name: "no_client",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				contract := testContractorContract(orgID, uuid.New(), nil, "Internal tools", "85")
+				contracts[contract.ID] = contract
+				timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusApproved)
+				timesheets[timesheet.ID] = timesheet
+				return []uuid.UUID{timesheet.ID}
+			},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name: "different_clients",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				otherClient := uuid.New()
+				website := testContractorContract(orgID, uuid.New(), &clientID, "Website", "85")
+				contracts[website.ID] = website
+				other := testContractorContract(orgID, uuid.New(), &otherClient, "Audit", "85")
+				contracts[other.ID] = other
+				websiteWeek := testTimesheet(website, weekOf, "8", domain.TimesheetStatusApproved)
+				timesheets[websiteWeek.ID] = websiteWeek
+				otherWeek := testTimesheet(other, weekOf, "8", domain.TimesheetStatusApproved)
+				timesheets[otherWeek.ID] = otherWeek
+				return []uuid.UUID{websiteWeek.ID, otherWeek.ID}
+			},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name: "different_currencies",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				website := testContractorContract(orgID, uuid.New(), &clientID, "Website", "85")
+				contracts[website.ID] = website
+				audit := testContractorContract(orgID, uuid.New(), &clientID, "Audit", "85")
+				audit.HourlyRate = money.MustParse("80", "EUR")
+				contracts[audit.ID] = audit
+				websiteWeek := testTimesheet(website, weekOf, "8", domain.TimesheetStatusApproved)
+				timesheets[websiteWeek.ID] = websiteWeek
+				auditWeek := testTimesheet(audit, weekOf, "8", domain.TimesheetStatusApproved)
+				timesheets[auditWeek.ID] = auditWeek
+				return []uuid.UUID{websiteWeek.ID, auditWeek.ID}
+			},
+			errType: appErrors.ErrorTypeValidation,
+		},
+		{
+			name: "unknown_timesheet",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				return []uuid.UUID{uuid.New()}
+			},
+			errType: appErrors.ErrorTypeNotFound,
+		},
+		{
+			name: "none",
+			setup: func(orgID, clientID uuid.UUID, contracts map[uuid.UUID]domain.ContractorContract, timesheets map[uuid.UUID]domain.Timesheet) []uuid.UUID {
+				return nil
+			},
+			errType: appErrors.ErrorTypeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+			mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+			mockClientRepo := new(mocks.FakeClientRepository)
+			mockOrgService := new(mocks.FakeOrganizationService)
+			mockAssetService := new(mocks.FakeAssetService)
+			mockEmailService := new(mocks.FakeEmailService)
+			mockRenderer := new(mocks.FakeInvoiceRenderer)
+			mockSecurityRepo := new(mocks.FakeSecurityRepository)
+			mockLedgerService := new(mocks.FakeLedgerService)
+			mockTaxService := new(mocks.FakeTaxService)
+
+			now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+			orgID := uuid.New()
+			finance := uuid.New()
+			stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+			contracts := make(map[uuid.UUID]domain.ContractorContract)
+			timesheets := make(map[uuid.UUID]domain.Timesheet)
+			stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+			stubTimesheetInvoicing(mockInvoiceRepo, timesheets)
+			client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test"}
+			stubClientDirectory(mockClientRepo, client)
+			ids := tc.setup(orgID, client.ID, contracts, timesheets)
+
+			cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+			logger := logging.New(&cfg)
+			invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+			invoiceService.now = func() time.Time { return now }
+			service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+			service.now = func() time.Time { return now }
+
+			// Act
+			_, err := service.InvoiceTimesheets(context.Background(), finance, orgID, ids)
+
+			// Assert
+			assertAppErrorType(t, err, tc.errType)
+			assert.Zero(t, mockInvoiceRepo.CreateTimesheetInvoiceCallCount())
+		})
+	}
+}
+
+func TestTimesheetService_InvoiceTimesheets_ChangedInTheMeantime(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	finance := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test"}
+	stubClientDirectory(mockClientRepo, client)
+	contract := testContractorContract(orgID, uuid.New(), &client.ID, "Website", "85")
+	contracts[contract.ID] = contract
+	timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusApproved)
+	timesheets[timesheet.ID] = timesheet
+	// Another request invoices the timesheet between the checks and the write
+	mockInvoiceRepo.CreateTimesheetInvoiceReturns(nil, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+
+	// Act
+	_, err := service.InvoiceTimesheets(context.Background(), finance, orgID, []uuid.UUID{timesheet.ID})
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.True(t, strings.Contains(errorDetails(err), "in the meantime"))
+	assert.Equal(t, domain.TimesheetStatusApproved, timesheets[timesheet.ID].Status)
+}
+
+func TestTimesheetService_InvoiceTimesheets_InvoiceFails(t *testing.T) {
+	// Arrange
+	mockTimesheetRepo := new(mocks.FakeTimesheetRepository)
+	mockInvoiceRepo := new(mocks.FakeInvoiceRepository)
+	mockClientRepo := new(mocks.FakeClientRepository)
+	mockOrgService := new(mocks.FakeOrganizationService)
+	mockAssetService := new(mocks.FakeAssetService)
+	mockEmailService := new(mocks.FakeEmailService)
+	mockRenderer := new(mocks.FakeInvoiceRenderer)
+	mockSecurityRepo := new(mocks.FakeSecurityRepository)
+	mockLedgerService := new(mocks.FakeLedgerService)
+	mockTaxService := new(mocks.FakeTaxService)
+
+	now := time.Date(2025, 5, 24, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.New()
+	finance := uuid.New()
+	stubOrganizationAccess(mockOrgService, orgID, map[uuid.UUID]domain.OrganizationRole{finance: domain.OrganizationRoleFinance})
+	contracts := make(map[uuid.UUID]domain.ContractorContract)
+	timesheets := make(map[uuid.UUID]domain.Timesheet)
+	stubTimesheetStore(mockTimesheetRepo, contracts, timesheets)
+	stubTimesheetInvoicing(mockInvoiceRepo, timesheets)
+	client := domain.Client{ID: uuid.New(), OrganizationID: orgID, Name: "Globex", Email: "billing@globex.test"}
+	stubClientDirectory(mockClientRepo, client)
+	contract := testContractorContract(orgID, uuid.New(), &client.ID, "Website", "85")
+	contracts[contract.ID] = contract
+	timesheet := testTimesheet(contract, weekOf, "8", domain.TimesheetStatusApproved)
+	timesheets[timesheet.ID] = timesheet
+	mockInvoiceRepo.CreateInvoiceReturnsOnCall(0, nil, appErrors.NewInternalError("database unavailable"))
+	mockInvoiceRepo.CreateInvoiceReturnsOnCall(1, &domain.Invoice{ID: uuid.New(), Number: 1}, nil)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	logger := logging.New(&cfg)
+	invoiceService := NewInvoiceService(mockInvoiceRepo, mockClientRepo, mockOrgService, mockAssetService, mockEmailService, mockRenderer, mockSecurityRepo, mockLedgerService, mockTaxService, cfg, logger).(*invoiceService)
+	invoiceService.now = func() time.Time { return now }
+	service := NewTimesheetService(mockTimesheetRepo, mockClientRepo, mockOrgService, invoiceService, logger).(*timesheetService)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Act
+	_, err := service.InvoiceTimesheets(ctx, finance, orgID, []uuid.UUID{timesheet.ID})
+
+	// Assert: the claim on the timesheet is rolled back with the invoice, so
+	// its hours can be invoiced again
+	assertAppErrorType(t, err, appErrors.ErrorTypeInternal)
+	assert.Equal(t, 1, mockInvoiceRepo.CreateTimesheetInvoiceCallCount())
+	assert.Equal(t, domain.TimesheetStatusApproved, timesheets[timesheet.ID].Status)
+	assert.Nil(t, timesheets[timesheet.ID].InvoiceID)
+
+	// Act: once the invoice can be created the hours are billed on it
+	_, err = service.InvoiceTimesheets(ctx, finance, orgID, []uuid.UUID{timesheet.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimesheetStatusInvoiced, timesheets[timesheet.ID].Status)
+}
+
+func TestTimesheetWeekStart(t *testing.T) {
+	// Arrange
+	monday := time.Date(2025, 5, 19, 23, 59, 0, 0, time.UTC)
+	sunday := time.Date(2025, 5, 25, 12, 0, 0, 0, time.UTC)
+	nextMonday := time.Date(2025, 5, 26, 0, 0, 0, 0, time.UTC)
+
+	// Act & Assert
+	assert.Equal(t, weekOf, domain.TimesheetWeekStart(monday))
+	assert.Equal(t, weekOf, domain.TimesheetWeekStart(sunday))
+	assert.Equal(t, weekOf.AddDate(0, 0, 7), domain.TimesheetWeekStart(nextMonday))
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	commons "github.com/demola234/defifundr/infrastructure/hash"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	random "github.com/demola234/defifundr/pkg/random"
+	"github.com/google/uuid"
+)
+
+const (
+	transactionPINLength      = 6
+	transactionPINResetExpiry = 15 * time.Minute
+)
+
+type transactionPINService struct {
+	pinRepo      ports.TransactionPINRepository
+	userRepo     ports.UserRepository
+	otpRepo      ports.OTPRepository
+	securityRepo ports.SecurityRepository
+	emailService ports.EmailService
+	config       config.Config
+	logger       logging.Logger
+}
+
+// NewTransactionPINService creates a new transaction PIN service
+func NewTransactionPINService(
+	pinRepo ports.TransactionPINRepository,
+	userRepo ports.UserRepository,
+	otpRepo ports.OTPRepository,
+	securityRepo ports.SecurityRepository,
+	emailService ports.EmailService,
+	config config.Config,
+	logger logging.Logger,
+) ports.TransactionPINService {
+	return &transactionPINService{
+		pinRepo:      pinRepo,
+		userRepo:     userRepo,
+		otpRepo:      otpRepo,
+		securityRepo: securityRepo,
+		emailService: emailService,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// GetPINStatus reports whether the user has set a PIN and whether it is currently locked
+func (s *transactionPINService) GetPINStatus(ctx context.Context, userID uuid.UUID) (*domain.TransactionPINStatus, error) {
+	pin, err := s.pinRepo.GetTransactionPIN(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &domain.TransactionPINStatus{IsSet: pin != nil}
+	if pin != nil && pin.IsLocked(time.Now()) {
+		status.LockedUntil = pin.LockedUntil
+	}
+
+	return status, nil
+}
+
+// SetPIN sets the user's first transaction PIN
+func (s *transactionPINService) SetPIN(ctx context.Context, userID uuid.UUID, pin string) error {
+	existing, err := s.pinRepo.GetTransactionPIN(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return appErrors.NewConflictError("transaction PIN already set, use change or reset instead")
+	}
+
+	if err := s.storePIN(ctx, userID, pin); err != nil {
+		return err
+	}
+
+	s.logSecurityEvent(ctx, "transaction_pin_set", userID, nil)
+	return nil
+}
+
+// ChangePIN replaces the PIN after verifying the current one
+func (s *transactionPINService) ChangePIN(ctx context.Context, userID uuid.UUID, currentPIN, newPIN string) error {
+	if err := s.VerifyPIN(ctx, userID, currentPIN); err != nil {
+		return err
+	}
+
+	if currentPIN == newPIN {
+		return appErrors.NewValidationError("new transaction PIN must differ from the current PIN")
+	}
+
+	if err := s.storePIN(ctx, userID, newPIN); err != nil {
+		return err
+	}
+
+	s.logSecurityEvent(ctx, "transaction_pin_changed", userID, nil)
+	return nil
+}
+
+// RequestPINReset emails the user an OTP that authorises a PIN reset
+func (s *transactionPINService) RequestPINReset(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return appErrors.NewNotFoundError("user not found")
+	}
+
+	otp := domain.OTPVerification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   domain.OTPPurposeTransactionPINReset,
+		OTPCode:   random.RandomOtp(),
+		ExpiresAt: time.Now().Add(transactionPINResetExpiry),
+	}
+
+	if _, err := s.otpRepo.CreateOTP(ctx, otp); err != nil {
+		return fmt.Errorf("failed to create transaction PIN reset OTP: %w", err)
+	}
+
+	if err := s.emailService.SendTransactionPINResetEmail(ctx, user.Email, user.FirstName, otp.OTPCode); err != nil {
+		return fmt.Errorf("failed to send transaction PIN reset email: %w", err)
+	}
+
+	s.logSecurityEvent(ctx, "transaction_pin_reset_requested", userID, nil)
+	return nil
+}
+
+// ResetPIN replaces the PIN using the emailed OTP. A successful reset also lifts any lockout.
+func (s *transactionPINService) ResetPIN(ctx context.Context, userID uuid.UUID, otpCode, newPIN string) error {
+	if err := validateTransactionPIN(newPIN); err != nil {
+		return err
+	}
+
+	otp, err := s.otpRepo.GetOTPByUserIDAndPurpose(ctx, userID, domain.OTPPurposeTransactionPINReset)
+	if err != nil {
+		return appErrors.NewValidationError("invalid or expired OTP")
+	}
+
+	if err := s.otpRepo.VerifyOTP(ctx, otp.ID, otpCode); err != nil {
+		if incErr := s.otpRepo.IncrementAttempts(ctx, otp.ID); incErr != nil {
+			s.logger.Error("Failed to increment OTP attempts", incErr, map[string]interface{}{
+				"user_id": userID,
+			})
+		}
+		return appErrors.NewValidationError("invalid or expired OTP")
+	}
+
+	if err := s.storePIN(ctx, userID, newPIN); err != nil {
+		return err
+	}
+
+	s.logSecurityEvent(ctx, "transaction_pin_reset", userID, nil)
+	return nil
+}
+
+// VerifyPIN checks the PIN before a payout-initiating action. Wrong entries count
+// towards a lockout of TransactionPINLockoutDuration after TransactionPINMaxAttempts failures.
+func (s *transactionPINService) VerifyPIN(ctx context.Context, userID uuid.UUID, pin string) error {
+	stored, err := s.pinRepo.GetTransactionPIN(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if stored == nil {
+		return appErrors.NewForbiddenError("transaction PIN not set")
+	}
+
+	now := time.Now()
+	if stored.IsLocked(now) {
+		return lockedPINError(*stored.LockedUntil)
+	}
+
+	match, err := commons.CheckPassword(pin, stored.PINHash)
+	if err != nil || !match {
+		return s.recordFailedAttempt(ctx, userID, now)
+	}
+
+	if stored.FailedAttempts > 0 {
+		if err := s.pinRepo.ResetFailedAttempts(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordFailedAttempt counts a wrong PIN and returns the error to show the user
+func (s *transactionPINService) recordFailedAttempt(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	maxAttempts := s.maxAttempts()
+	updated, err := s.pinRepo.RecordFailedAttempt(ctx, userID, maxAttempts, now.Add(s.config.TransactionPINLockoutDuration))
+	if err != nil {
+		return err
+	}
+
+	if updated.IsLocked(now) {
+		s.logSecurityEvent(ctx, "transaction_pin_locked", userID, map[string]interface{}{
+			"locked_until": updated.LockedUntil.Format(time.RFC3339),
+		})
+		return lockedPINError(*updated.LockedUntil)
+	}
+
+	return appErrors.NewForbiddenError(fmt.Sprintf("invalid transaction PIN, %d attempts remaining", maxAttempts-updated.FailedAttempts))
+}
+
+// storePIN validates, hashes and saves a PIN
+func (s *transactionPINService) storePIN(ctx context.Context, userID uuid.UUID, pin string) error {
+	if err := validateTransactionPIN(pin); err != nil {
+		return err
+	}
+
+	pinHash, err := commons.HashPassword(pin)
+	if err != nil {
+		return fmt.Errorf("failed to hash transaction PIN: %w", err)
+	}
+
+	_, err = s.pinRepo.SetTransactionPIN(ctx, userID, pinHash)
+	return err
+}
+
+func (s *transactionPINService) maxAttempts() int {
+	if s.config.TransactionPINMaxAttempts <= 0 {
+		return 5
+	}
+	return s.config.TransactionPINMaxAttempts
+}
+
+// logSecurityEvent records a PIN change or lockout in the security audit log
+func (s *transactionPINService) logSecurityEvent(ctx context.Context, eventType string, userID uuid.UUID, metadata map[string]interface{}) {
+	recordSecurityEvent(ctx, s.securityRepo, s.logger, eventType, userID, metadata)
+}
+
+func lockedPINError(lockedUntil time.Time) error {
+	return appErrors.NewForbiddenError(fmt.Sprintf("transaction PIN locked until %s", lockedUntil.UTC().Format(time.RFC3339)))
+}
+
+// validateTransactionPIN requires a 6 digit PIN that is not a repeated or sequential run
+func validateTransactionPIN(pin string) error {
+	if len(pin) != transactionPINLength {
+		return appErrors.NewValidationError(fmt.Sprintf("transaction PIN must be %d digits", transactionPINLength))
+	}
+
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return appErrors.NewValidationError(fmt.Sprintf("transaction PIN must be %d digits", transactionPINLength))
+		}
+	}
+
+	repeated, ascending, descending := true, true, true
+	for i := 1; i < len(pin); i++ {
+		diff := int(pin[i]) - int(pin[i-1])
+		repeated = repeated && diff == 0
+		ascending = ascending && diff == 1
+		descending = descending && diff == -1
+	}
+
+	if repeated || ascending || descending {
+		return appErrors.NewValidationError("transaction PIN is too easy to guess")
+	}
+
+	return nil
+}
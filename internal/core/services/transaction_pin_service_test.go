@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	commons "github.com/demola234/defifundr/infrastructure/hash"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type transactionPINTestDeps struct {
+	pinRepo      *mocks.FakeTransactionPINRepository
+	userRepo     *mocks.FakeUserRepository
+	otpRepo      *mocks.FakeOTPRepository
+	securityRepo *mocks.FakeSecurityRepository
+	emailService *mocks.FakeEmailService
+}
+
+func newTransactionPINTestService() (*transactionPINTestDeps, *transactionPINService) {
+	deps := &transactionPINTestDeps{
+		pinRepo:      new(mocks.FakeTransactionPINRepository),
+		userRepo:     new(mocks.FakeUserRepository),
+		otpRepo:      new(mocks.FakeOTPRepository),
+		securityRepo: new(mocks.FakeSecurityRepository),
+		emailService: new(mocks.FakeEmailService),
+	}
+
+	cfg := config.Config{
+		TransactionPINMaxAttempts:     3,
+		TransactionPINLockoutDuration: 30 * time.Minute,
+		LogOutput:                     "stdout",
+		LogLevel:                      "panic",
+	}
+
+	service := NewTransactionPINService(deps.pinRepo, deps.userRepo, deps.otpRepo, deps.securityRepo, deps.emailService, cfg, logging.New(&cfg))
+	return deps, service.(*transactionPINService)
+}
+
+func storedPIN(t *testing.T, pin string) *domain.TransactionPIN {
+	t.Helper()
+	pinHash, err := commons.HashPassword(pin)
+	assert.NoError(t, err)
+	return &domain.TransactionPIN{UserID: uuid.New(), PINHash: pinHash}
+}
+
+func TestTransactionPINService_SetPIN(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	deps.pinRepo.GetTransactionPINReturns(nil, nil)
+	userID := uuid.New()
+
+	// Act
+	err := service.SetPIN(context.Background(), userID, "482915")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deps.pinRepo.SetTransactionPINCallCount())
+	_, gotUserID, pinHash := deps.pinRepo.SetTransactionPINArgsForCall(0)
+	assert.Equal(t, userID, gotUserID)
+	assert.NotEqual(t, "482915", pinHash)
+
+	match, err := commons.CheckPassword("482915", pinHash)
+	assert.NoError(t, err)
+	assert.True(t, match)
+}
+
+func TestTransactionPINService_SetPIN_AlreadySet(t *testing.T) {
+	deps, service := newTransactionPINTestService()
+	deps.pinRepo.GetTransactionPINReturns(&domain.TransactionPIN{}, nil)
+
+	err := service.SetPIN(context.Background(), uuid.New(), "482915")
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Equal(t, 0, deps.pinRepo.SetTransactionPINCallCount())
+}
+
+func TestValidateTransactionPIN(t *testing.T) {
+	tests := []struct {
+		pin   string
+		valid bool
+	}{
+		{pin: "482915", valid: true},
+		{pin: "12345", valid: false},
+		{pin: "1234567", valid: false},
+		{pin: "12a456", valid: false},
+		{pin: "111111", valid: false},
+		{pin: "123456", valid: false},
+		{pin: "987654", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pin, func(t *testing.T) {
+			err := validateTransactionPIN(tt.pin)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			}
+		})
+	}
+}
+
+func TestTransactionPINService_VerifyPIN(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	pin := storedPIN(t, "482915")
+	pin.FailedAttempts = 1
+	deps.pinRepo.GetTransactionPINReturns(pin, nil)
+
+	// Act
+	err := service.VerifyPIN(context.Background(), pin.UserID, "482915")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, deps.pinRepo.RecordFailedAttemptCallCount())
+	assert.Equal(t, 1, deps.pinRepo.ResetFailedAttemptsCallCount())
+}
+
+func TestTransactionPINService_VerifyPIN_WrongPIN(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	pin := storedPIN(t, "482915")
+	deps.pinRepo.GetTransactionPINReturns(pin, nil)
+	deps.pinRepo.RecordFailedAttemptReturns(&domain.TransactionPIN{FailedAttempts: 1}, nil)
+
+	// Act
+	err := service.VerifyPIN(context.Background(), pin.UserID, "000001")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Contains(t, err.Error(), "2 attempts remaining")
+	_, _, maxAttempts, lockUntil := deps.pinRepo.RecordFailedAttemptArgsForCall(0)
+	assert.Equal(t, 3, maxAttempts)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), lockUntil, time.Minute)
+	assert.Equal(t, 0, deps.securityRepo.LogSecurityEventCallCount())
+}
+
+func TestTransactionPINService_VerifyPIN_LocksAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	pin := storedPIN(t, "482915")
+	lockedUntil := time.Now().Add(30 * time.Minute)
+	deps.pinRepo.GetTransactionPINReturns(pin, nil)
+	deps.pinRepo.RecordFailedAttemptReturns(&domain.TransactionPIN{LockedUntil: &lockedUntil}, nil)
+
+	// Act
+	err := service.VerifyPIN(context.Background(), pin.UserID, "000001")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Contains(t, err.Error(), "locked until")
+	assert.Equal(t, 1, deps.securityRepo.LogSecurityEventCallCount())
+	_, event := deps.securityRepo.LogSecurityEventArgsForCall(0)
+	assert.Equal(t, "transaction_pin_locked", event.EventType)
+}
+
+func TestTransactionPINService_VerifyPIN_Locked(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	pin := storedPIN(t, "482915")
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	pin.LockedUntil = &lockedUntil
+	deps.pinRepo.GetTransactionPINReturns(pin, nil)
+
+	// Act: even the correct PIN is refused while locked
+	err := service.VerifyPIN(context.Background(), pin.UserID, "482915")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+	assert.Equal(t, 0, deps.pinRepo.RecordFailedAttemptCallCount())
+}
+
+func TestTransactionPINService_VerifyPIN_NotSet(t *testing.T) {
+	deps, service := newTransactionPINTestService()
+	deps.pinRepo.GetTransactionPINReturns(nil, nil)
+
+	err := service.VerifyPIN(context.Background(), uuid.New(), "482915")
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeForbidden)
+}
+
+func TestTransactionPINService_RequestPINReset(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	userID := uuid.New()
+	deps.userRepo.GetUserByIDReturns(&domain.User{ID: userID, Email: "test@example.com", FirstName: "Test"}, nil)
+
+	// Act
+	err := service.RequestPINReset(context.Background(), userID)
+
+	// Assert
+	assert.NoError(t, err)
+	_, otp := deps.otpRepo.CreateOTPArgsForCall(0)
+	assert.Equal(t, domain.OTPPurposeTransactionPINReset, otp.Purpose)
+	assert.Equal(t, userID, otp.UserID)
+
+	_, email, _, otpCode := deps.emailService.SendTransactionPINResetEmailArgsForCall(0)
+	assert.Equal(t, "test@example.com", email)
+	assert.Equal(t, otp.OTPCode, otpCode)
+}
+
+func TestTransactionPINService_ResetPIN(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	otpID := uuid.New()
+	deps.otpRepo.GetOTPByUserIDAndPurposeReturns(&domain.OTPVerification{ID: otpID}, nil)
+
+	// Act
+	err := service.ResetPIN(context.Background(), uuid.New(), "123456", "482915")
+
+	// Assert
+	assert.NoError(t, err)
+	_, _, purpose := deps.otpRepo.GetOTPByUserIDAndPurposeArgsForCall(0)
+	assert.Equal(t, domain.OTPPurposeTransactionPINReset, purpose)
+	_, verifiedID, code := deps.otpRepo.VerifyOTPArgsForCall(0)
+	assert.Equal(t, otpID, verifiedID)
+	assert.Equal(t, "123456", code)
+	assert.Equal(t, 1, deps.pinRepo.SetTransactionPINCallCount())
+}
+
+func TestTransactionPINService_ResetPIN_InvalidOTP(t *testing.T) {
+	// Arrange
+	deps, service := newTransactionPINTestService()
+	deps.otpRepo.GetOTPByUserIDAndPurposeReturns(&domain.OTPVerification{ID: uuid.New()}, nil)
+	deps.otpRepo.VerifyOTPReturns(errors.New("invalid OTP"))
+
+	// Act
+	err := service.ResetPIN(context.Background(), uuid.New(), "000000", "482915")
+
+	// Assert
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	assert.Equal(t, 1, deps.otpRepo.IncrementAttemptsCallCount())
+	assert.Equal(t, 0, deps.pinRepo.SetTransactionPINCallCount())
+}
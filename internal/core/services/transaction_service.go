@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+type transactionService struct {
+	txRepo       ports.TransactionRepository
+	assetService ports.AssetService
+	logger       logging.Logger
+}
+
+// NewTransactionService creates a new transaction service
+func NewTransactionService(txRepo ports.TransactionRepository, assetService ports.AssetService, logger logging.Logger) ports.TransactionService {
+	return &transactionService{
+		txRepo:       txRepo,
+		assetService: assetService,
+		logger:       logger,
+	}
+}
+
+// CreateTransaction records a new transaction for a user in the created state.
+// The transfer it makes is optional; when given, its asset must be supported on
+// the chain and its amount representable in the asset's decimals.
+func (s *transactionService) CreateTransaction(ctx context.Context, userID uuid.UUID, txHash string, transfer *domain.TransactionTransfer) (*domain.Transaction, error) {
+	if !isValidTxHash(txHash) {
+		return nil, appErrors.NewValidationError("invalid transaction hash format")
+	}
+
+	tx := domain.Transaction{
+		ID:     uuid.New(),
+		UserID: userID,
+		TxHash: txHash,
+		Status: domain.TransactionStatusCreated,
+	}
+
+	if transfer != nil {
+		if err := s.prepareTransfer(ctx, &tx, *transfer); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.txRepo.GetTransactionByTxHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, appErrors.NewConflictError("transaction already recorded")
+	}
+
+	created, err := s.txRepo.CreateTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Transaction created", map[string]interface{}{
+		"transaction_id": created.ID,
+		"user_id":        userID,
+		"tx_hash":        txHash,
+	})
+
+	return created, nil
+}
+
+// prepareTransfer validates the declared transfer against the asset registry
+// and records its token, recipient and amount in base units on the transaction
+func (s *transactionService) prepareTransfer(ctx context.Context, tx *domain.Transaction, transfer domain.TransactionTransfer) error {
+	asset, err := s.assetService.ValidateAmount(ctx, transfer.Chain, transfer.Amount)
+	if err != nil {
+		return err
+	}
+
+	to, ok := normalizeAddress(asset.Chain, transfer.To)
+	if !ok {
+		return appErrors.NewValidationError(fmt.Sprintf("recipient is not a valid %s address", asset.Chain))
+	}
+
+	tx.TokenAddress = asset.ContractAddress
+	tx.ToAddress = to
+	// ValidateAmount checked the decimals, so the conversion cannot fail
+	tx.Amount, _ = transfer.Amount.ToBaseUnits(asset.Decimals)
+
+	return nil
+}
+
+// GetTransaction returns a transaction owned by the user
+func (s *transactionService) GetTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*domain.Transaction, error) {
+	tx, err := s.txRepo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("transaction not found")
+	}
+
+	if tx.UserID != userID {
+		return nil, appErrors.NewNotFoundError("transaction not found")
+	}
+
+	return tx, nil
+}
+
+// ListTransactions returns a page of the user's transactions and the total number matching the filter
+func (s *transactionService) ListTransactions(ctx context.Context, userID uuid.UUID, page, pageSize int, filter domain.TransactionFilter) ([]domain.Transaction, int64, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, appErrors.NewValidationError(fmt.Sprintf("invalid transaction status %q", filter.Status))
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, 0, appErrors.NewValidationError("from must be before to")
+	}
+
+	offset := (page - 1) * pageSize
+	return s.txRepo.ListTransactionsByUserID(ctx, userID, pageSize, offset, filter)
+}
+
+// UpdateTransactionStatus moves a transaction to a new status, enforcing the
+// created -> pending -> confirmed/failed/not_found state machine
+func (s *transactionService) UpdateTransactionStatus(ctx context.Context, id uuid.UUID, status domain.TransactionStatus) (*domain.Transaction, error) {
+	if !status.IsValid() {
+		return nil, appErrors.NewValidationError(fmt.Sprintf("invalid transaction status %q", status))
+	}
+
+	tx, err := s.txRepo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("transaction not found")
+	}
+
+	if tx.Status == status {
+		return tx, nil
+	}
+
+	if !tx.Status.CanTransitionTo(status) {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("transaction cannot move from %s to %s", tx.Status, status))
+	}
+
+	updated, err := s.txRepo.TransitionTransactionStatus(ctx, id, tx.Status, status)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Transaction status updated", map[string]interface{}{
+		"transaction_id": id,
+		"from":           tx.Status,
+		"to":             status,
+	})
+
+	return updated, nil
+}
+
+// isValidTxHash validates an EVM transaction hash (0x followed by 32 bytes of hex)
+func isValidTxHash(txHash string) bool {
+	if !strings.HasPrefix(txHash, "0x") || len(txHash) != 66 {
+		return false
+	}
+
+	_, err := hex.DecodeString(txHash[2:])
+	return err == nil
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/demola234/defifundr/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTxHash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+func newTransactionTestService() (*mocks.FakeTransactionRepository, *transactionService) {
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic"}
+	service := NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logging.New(&cfg))
+	return mockTxRepo, service.(*transactionService)
+}
+
+func assertAppErrorType(t *testing.T, err error, expected appErrors.ErrorType) {
+	t.Helper()
+	var appErr *appErrors.AppError
+	if assert.True(t, errors.As(err, &appErr), "expected an AppError, got %v", err) {
+		assert.Equal(t, expected, appErr.ErrorType)
+	}
+}
+
+func TestTransactionService_CreateTransaction(t *testing.T) {
+	// Arrange
+	mockTxRepo, service := newTransactionTestService()
+	mockTxRepo.GetTransactionByTxHashReturns(nil, nil)
+	mockTxRepo.CreateTransactionStub = func(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error) {
+		return &tx, nil
+	}
+	userID := uuid.New()
+
+	// Act
+	result, err := service.CreateTransaction(context.Background(), userID, testTxHash, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusCreated, result.Status)
+	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, 1, mockTxRepo.CreateTransactionCallCount())
+}
+
+func TestTransactionService_CreateTransaction_InvalidHash(t *testing.T) {
+	mockTxRepo, service := newTransactionTestService()
+
+	_, err := service.CreateTransaction(context.Background(), uuid.New(), "0x1234", nil)
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+	assert.Equal(t, 0, mockTxRepo.CreateTransactionCallCount())
+}
+
+func TestTransactionService_CreateTransaction_Duplicate(t *testing.T) {
+	mockTxRepo, service := newTransactionTestService()
+	mockTxRepo.GetTransactionByTxHashReturns(&domain.Transaction{ID: uuid.New(), TxHash: testTxHash}, nil)
+
+	_, err := service.CreateTransaction(context.Background(), uuid.New(), testTxHash, nil)
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeConflict)
+	assert.Equal(t, 0, mockTxRepo.CreateTransactionCallCount())
+}
+
+func TestTransactionService_CreateTransaction_LookupError(t *testing.T) {
+	mockTxRepo, service := newTransactionTestService()
+	mockTxRepo.GetTransactionByTxHashReturns(nil, errors.New("connection reset"))
+
+	_, err := service.CreateTransaction(context.Background(), uuid.New(), testTxHash, nil)
+
+	assert.EqualError(t, err, "connection reset")
+	assert.Equal(t, 0, mockTxRepo.CreateTransactionCallCount())
+}
+
+func TestTransactionService_CreateTransaction_WithTransfer(t *testing.T) {
+	mockTxRepo, service := newTransactionTestService()
+	mockAssetRepo, assets := newAssetTestService()
+	service.assetService = assets
+	mockAssetRepo.GetAssetBySymbolReturns(testUSDCAsset(), nil)
+	mockTxRepo.GetTransactionByTxHashReturns(nil, nil)
+	mockTxRepo.CreateTransactionStub = func(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error) {
+		return &tx, nil
+	}
+
+	result, err := service.CreateTransaction(context.Background(), uuid.New(), testTxHash, &domain.TransactionTransfer{
+		Chain:  "Ethereum",
+		Amount: money.MustParse("12.5", "USDC"),
+		To:     "0x52908400098527886e0f7030069857d2e4169ee7",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, testUSDC, result.TokenAddress)
+	assert.Equal(t, "0x52908400098527886E0F7030069857D2E4169EE7", result.ToAddress)
+	assert.Equal(t, "12500000", result.Amount.String())
+	_, chain, symbol := mockAssetRepo.GetAssetBySymbolArgsForCall(0)
+	assert.Equal(t, "ethereum", chain)
+	assert.Equal(t, "USDC", symbol)
+}
+
+func TestTransactionService_CreateTransaction_InvalidTransfer(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer domain.TransactionTransfer
+	}{
+		{"too many decimals", domain.TransactionTransfer{Chain: "ethereum", Amount: money.MustParse("1.0000001", "USDC"), To: "0x52908400098527886E0F7030069857D2E4169EE7"}},
+		{"zero amount", domain.TransactionTransfer{Chain: "ethereum", Amount: money.MustParse("0", "USDC"), To: "0x52908400098527886E0F7030069857D2E4169EE7"}},
+		{"invalid recipient", domain.TransactionTransfer{Chain: "ethereum", Amount: money.MustParse("1", "USDC"), To: "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxRepo, service := newTransactionTestService()
+			mockAssetRepo, assets := newAssetTestService()
+			service.assetService = assets
+			mockAssetRepo.GetAssetBySymbolReturns(testUSDCAsset(), nil)
+
+			_, err := service.CreateTransaction(context.Background(), uuid.New(), testTxHash, &tt.transfer)
+
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Equal(t, 0, mockTxRepo.CreateTransactionCallCount())
+		})
+	}
+}
+
+func TestTransactionService_GetTransaction_OtherUser(t *testing.T) {
+	mockTxRepo, service := newTransactionTestService()
+	mockTxRepo.GetTransactionByIDReturns(&domain.Transaction{ID: uuid.New(), UserID: uuid.New()}, nil)
+
+	_, err := service.GetTransaction(context.Background(), uuid.New(), uuid.New())
+
+	assertAppErrorType(t, err, appErrors.ErrorTypeNotFound)
+}
+
+func TestTransactionService_ListTransactions(t *testing.T) {
+	// Arrange
+	mockTxRepo, service := newTransactionTestService()
+	mockTxRepo.ListTransactionsByUserIDReturns([]domain.Transaction{{ID: uuid.New()}}, 21, nil)
+	userID := uuid.New()
+	filter := domain.TransactionFilter{Status: domain.TransactionStatusPending}
+
+	// Act
+	txs, total, err := service.ListTransactions(context.Background(), userID, 3, 10, filter)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, int64(21), total)
+	_, gotUserID, limit, offset, gotFilter := mockTxRepo.ListTransactionsByUserIDArgsForCall(0)
+	assert.Equal(t, userID, gotUserID)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 20, offset)
+	assert.Equal(t, filter, gotFilter)
+}
+
+func TestTransactionService_ListTransactions_InvalidFilter(t *testing.T) {
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		filter domain.TransactionFilter
+	}{
+		{name: "unknown_status", filter: domain.TransactionFilter{Status: "settled"}},
+		{name: "inverted_range", filter: domain.TransactionFilter{CreatedFrom: &from, CreatedTo: &to}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxRepo, service := newTransactionTestService()
+
+			_, _, err := service.ListTransactions(context.Background(), uuid.New(), 1, 10, tt.filter)
+
+			assertAppErrorType(t, err, appErrors.ErrorTypeValidation)
+			assert.Equal(t, 0, mockTxRepo.ListTransactionsByUserIDCallCount())
+		})
+	}
+}
+
+func TestTransactionService_UpdateTransactionStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       domain.TransactionStatus
+		to         domain.TransactionStatus
+		expectErr  appErrors.ErrorType
+		expectCall bool
+	}{
+		{name: "created_to_pending", from: domain.TransactionStatusCreated, to: domain.TransactionStatusPending, expectCall: true},
+		{name: "pending_to_confirmed", from: domain.TransactionStatusPending, to: domain.TransactionStatusConfirmed, expectCall: true},
+		{name: "pending_to_failed", from: domain.TransactionStatusPending, to: domain.TransactionStatusFailed, expectCall: true},
+		{name: "pending_to_not_found", from: domain.TransactionStatusPending, to: domain.TransactionStatusNotFound, expectCall: true},
+		{name: "same_status_is_noop", from: domain.TransactionStatusPending, to: domain.TransactionStatusPending},
+		{name: "created_to_confirmed", from: domain.TransactionStatusCreated, to: domain.TransactionStatusConfirmed, expectErr: appErrors.ErrorTypeConflict},
+		{name: "confirmed_is_final", from: domain.TransactionStatusConfirmed, to: domain.TransactionStatusFailed, expectErr: appErrors.ErrorTypeConflict},
+		{name: "unknown_status", from: domain.TransactionStatusPending, to: "settled", expectErr: appErrors.ErrorTypeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxRepo, service := newTransactionTestService()
+			id := uuid.New()
+			mockTxRepo.GetTransactionByIDReturns(&domain.Transaction{ID: id, Status: tt.from}, nil)
+			mockTxRepo.TransitionTransactionStatusReturns(&domain.Transaction{ID: id, Status: tt.to}, nil)
+
+			result, err := service.UpdateTransactionStatus(context.Background(), id, tt.to)
+
+			if tt.expectErr != "" {
+				assertAppErrorType(t, err, tt.expectErr)
+				assert.Equal(t, 0, mockTxRepo.TransitionTransactionStatusCallCount())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.to, result.Status)
+			if tt.expectCall {
+				_, _, from, to := mockTxRepo.TransitionTransactionStatusArgsForCall(0)
+				assert.Equal(t, tt.from, from)
+				assert.Equal(t, tt.to, to)
+			} else {
+				assert.Equal(t, 0, mockTxRepo.TransitionTransactionStatusCallCount())
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+)
+
+const trackerPageSize = 100
+
+// TransactionTracker polls an EVM node for receipts of created and pending
+// transactions and moves them through the status state machine.
+//
+// A mined transaction only becomes confirmed or failed once its block is
+// TxTrackerConfirmations deep. Until then every poll re-reads the receipt, so a
+// reorg that drops or moves the transaction is picked up and the recorded
+// block is updated or cleared. A transaction the node has never seen for
+// TxTrackerNotFoundTimeout becomes not_found.
+type TransactionTracker struct {
+	txRepo    ports.TransactionRepository
+	txService ports.TransactionService
+	client    ports.BlockchainClient
+	publisher ports.TransactionEventPublisher
+	config    config.Config
+	logger    logging.Logger
+	now       func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewTransactionTracker creates a new on-chain transaction tracker
+func NewTransactionTracker(
+	txRepo ports.TransactionRepository,
+	txService ports.TransactionService,
+	client ports.BlockchainClient,
+	publisher ports.TransactionEventPublisher,
+	config config.Config,
+	logger logging.Logger,
+) *TransactionTracker {
+	return &TransactionTracker{
+		txRepo:    txRepo,
+		txService: txService,
+		client:    client,
+		publisher: publisher,
+		config:    config,
+		logger:    logger,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start polls in the background every TxTrackerPollInterval until Stop is called
+func (t *TransactionTracker) Start() {
+	interval := t.config.TxTrackerPollInterval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := t.Poll(ctx); err != nil {
+					t.logger.Error("Transaction tracker poll failed", err)
+				}
+				cancel()
+			}
+		}
+	}()
+
+	t.logger.Info("Transaction tracker started", map[string]interface{}{
+		"poll_interval": interval.String(),
+		"confirmations": t.config.TxTrackerConfirmations,
+	})
+}
+
+// Stop stops the background poller and waits for an in-flight poll to finish
+func (t *TransactionTracker) Stop() {
+	close(t.stop)
+	t.wg.Wait()
+	t.logger.Info("Transaction tracker stopped")
+}
+
+// Poll checks every created and pending transaction once
+func (t *TransactionTracker) Poll(ctx context.Context) error {
+	head, err := t.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range []domain.TransactionStatus{domain.TransactionStatusCreated, domain.TransactionStatusPending} {
+		txs, err := t.listByStatus(ctx, status)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs {
+			if err := t.track(ctx, tx, head); err != nil {
+				// One bad transaction must not block the rest of the batch
+				t.logger.Error("Failed to track transaction", err, map[string]interface{}{
+					"transaction_id": tx.ID,
+					"tx_hash":        tx.TxHash,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// listByStatus loads every transaction in a status before any of them are
+// updated, so status changes cannot shift the pages being read
+func (t *TransactionTracker) listByStatus(ctx context.Context, status domain.TransactionStatus) ([]domain.Transaction, error) {
+	var all []domain.Transaction
+	for offset := 0; ; offset += trackerPageSize {
+		page, err := t.txRepo.GetTransactionsByStatus(ctx, status, trackerPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+		if len(page) < trackerPageSize {
+			return all, nil
+		}
+	}
+}
+
+// track advances a single transaction based on the node's current view
+func (t *TransactionTracker) track(ctx context.Context, tx domain.Transaction, head uint64) error {
+	receipt, err := t.client.TransactionReceipt(ctx, tx.TxHash)
+	if err != nil {
+		return err
+	}
+
+	if receipt == nil {
+		return t.trackUnmined(ctx, tx)
+	}
+
+	if tx.Status == domain.TransactionStatusCreated {
+		updated, err := t.txService.UpdateTransactionStatus(ctx, tx.ID, domain.TransactionStatusPending)
+		if err != nil {
+			return err
+		}
+		tx = *updated
+	}
+
+	if tx.BlockNumber == nil || uint64(*tx.BlockNumber) != receipt.BlockNumber || tx.BlockHash != receipt.BlockHash {
+		if tx.BlockNumber != nil {
+			t.logger.Warn("Transaction moved to a different block after a reorg", map[string]interface{}{
+				"transaction_id": tx.ID,
+				"tx_hash":        tx.TxHash,
+				"old_block":      *tx.BlockNumber,
+				"new_block":      receipt.BlockNumber,
+			})
+		}
+
+		blockNumber := int64(receipt.BlockNumber)
+		updated, err := t.txRepo.UpdateTransactionBlock(ctx, tx.ID, &blockNumber, receipt.BlockHash)
+		if err != nil {
+			return err
+		}
+		tx = *updated
+	}
+
+	confirmations := confirmationsAt(head, receipt.BlockNumber)
+	if confirmations < t.requiredConfirmations() {
+		return nil
+	}
+
+	final := domain.TransactionStatusConfirmed
+	if !receipt.Success {
+		final = domain.TransactionStatusFailed
+	}
+
+	return t.finalize(ctx, tx, final, confirmations)
+}
+
+// trackUnmined handles a transaction with no receipt: it may be in the mempool,
+// reorged out of a block, or unknown to the node
+func (t *TransactionTracker) trackUnmined(ctx context.Context, tx domain.Transaction) error {
+	if tx.BlockNumber != nil {
+		t.logger.Warn("Transaction receipt disappeared after a reorg", map[string]interface{}{
+			"transaction_id": tx.ID,
+			"tx_hash":        tx.TxHash,
+			"old_block":      *tx.BlockNumber,
+		})
+
+		// Clearing the block also restarts the not_found timeout
+		_, err := t.txRepo.UpdateTransactionBlock(ctx, tx.ID, nil, "")
+		return err
+	}
+
+	known, err := t.client.TransactionKnown(ctx, tx.TxHash)
+	if err != nil {
+		return err
+	}
+
+	if known {
+		if tx.Status == domain.TransactionStatusCreated {
+			_, err := t.txService.UpdateTransactionStatus(ctx, tx.ID, domain.TransactionStatusPending)
+			return err
+		}
+		return nil
+	}
+
+	if t.now().Sub(tx.UpdatedAt) < t.config.TxTrackerNotFoundTimeout {
+		return nil
+	}
+
+	return t.finalize(ctx, tx, domain.TransactionStatusNotFound, 0)
+}
+
+// finalize moves a transaction to a final status and emits an event
+func (t *TransactionTracker) finalize(ctx context.Context, tx domain.Transaction, status domain.TransactionStatus, confirmations uint64) error {
+	updated, err := t.txService.UpdateTransactionStatus(ctx, tx.ID, status)
+	if err != nil {
+		return err
+	}
+
+	event := domain.TransactionEvent{
+		Transaction:   *updated,
+		Confirmations: confirmations,
+		OccurredAt:    t.now(),
+	}
+
+	if err := t.publisher.PublishTransactionEvent(ctx, event); err != nil {
+		// The status change is already persisted, subscribers log their own failures
+		t.logger.Error("Failed to publish transaction event", err, map[string]interface{}{
+			"transaction_id": tx.ID,
+			"status":         status,
+		})
+	}
+
+	return nil
+}
+
+func (t *TransactionTracker) requiredConfirmations() uint64 {
+	if t.config.TxTrackerConfirmations == 0 {
+		return 1
+	}
+	return t.config.TxTrackerConfirmations
+}
+
+// confirmationsAt returns how many blocks deep blockNumber is at head, counting the block itself
+func confirmationsAt(head, blockNumber uint64) uint64 {
+	if head < blockNumber {
+		return 0
+	}
+	return head - blockNumber + 1
+}
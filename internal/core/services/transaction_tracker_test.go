@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/blockchain"
+	"github.com/demola234/defifundr/infrastructure/blockchain/rpctest"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockHashA = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testBlockHashB = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+// stubTransactionStore backs the transaction repository fake with the given
+// map, stamping changes with the time now points at
+func stubTransactionStore(repo *mocks.FakeTransactionRepository, txs map[uuid.UUID]*domain.Transaction, now *time.Time) {
+	repo.GetTransactionsByStatusStub = func(ctx context.Context, status domain.TransactionStatus, limit, offset int) ([]domain.Transaction, error) {
+		var result []domain.Transaction
+		for _, tx := range txs {
+			if tx.Status == status {
+				result = append(result, *tx)
+			}
+		}
+		if offset >= len(result) {
+			return nil, nil
+		}
+		return result[offset:min(offset+limit, len(result))], nil
+	}
+	repo.GetTransactionByIDStub = func(ctx context.Context, id uuid.UUID) (*domain.Transaction, error) {
+		tx := *txs[id]
+		return &tx, nil
+	}
+	repo.TransitionTransactionStatusStub = func(ctx context.Context, id uuid.UUID, from, to domain.TransactionStatus) (*domain.Transaction, error) {
+		txs[id].Status = to
+		txs[id].UpdatedAt = *now
+		tx := *txs[id]
+		return &tx, nil
+	}
+	repo.UpdateTransactionBlockStub = func(ctx context.Context, id uuid.UUID, blockNumber *int64, blockHash string) (*domain.Transaction, error) {
+		txs[id].BlockNumber = blockNumber
+		txs[id].BlockHash = blockHash
+		txs[id].UpdatedAt = *now
+		tx := *txs[id]
+		return &tx, nil
+	}
+}
+
+// newTestEVMClient connects the real EVM client to a fake node
+func newTestEVMClient(t *testing.T) (*rpctest.Server, *blockchain.EVMClient) {
+	t.Helper()
+
+	node := rpctest.NewServer()
+	t.Cleanup(node.Close)
+
+	client, err := blockchain.NewEVMClient(context.Background(), node.URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	return node, client
+}
+
+func newTestTransaction(status domain.TransactionStatus, at time.Time) *domain.Transaction {
+	return &domain.Transaction{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		TxHash:    testTxHash,
+		Status:    status,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+}
+
+func TestTransactionTracker_ConfirmsAfterRequiredDepth(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockPublisher := new(mocks.FakeTransactionEventPublisher)
+
+	now := time.Now()
+	tx := newTestTransaction(domain.TransactionStatusCreated, now)
+	stubTransactionStore(mockTxRepo, map[uuid.UUID]*domain.Transaction{tx.ID: tx}, &now)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", TxTrackerConfirmations: 3, TxTrackerNotFoundTimeout: 30 * time.Minute}
+	logger := logging.New(&cfg)
+	tracker := NewTransactionTracker(mockTxRepo, NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logger), client, mockPublisher, cfg, logger)
+	tracker.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Act: the transaction reaches the mempool
+	node.AddPending(testTxHash)
+	node.SetHead(100)
+	err := tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusPending, tx.Status)
+
+	// Act: it is mined but one block short of the required depth
+	node.Mine(testTxHash, rpctest.Receipt{BlockNumber: 101, BlockHash: testBlockHashA, Success: true})
+	node.SetHead(102)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusPending, tx.Status)
+	assert.Equal(t, int64(101), *tx.BlockNumber)
+	assert.Equal(t, 0, mockPublisher.PublishTransactionEventCallCount())
+
+	// Act: the required depth is reached
+	node.SetHead(103)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusConfirmed, tx.Status)
+	require.Equal(t, 1, mockPublisher.PublishTransactionEventCallCount())
+	_, event := mockPublisher.PublishTransactionEventArgsForCall(0)
+	assert.Equal(t, tx.ID, event.Transaction.ID)
+	assert.Equal(t, domain.TransactionStatusConfirmed, event.Transaction.Status)
+	assert.Equal(t, uint64(3), event.Confirmations)
+
+	// Act: final transactions are no longer polled
+	node.SetHead(200)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockPublisher.PublishTransactionEventCallCount())
+}
+
+func TestTransactionTracker_RevertedReceiptFails(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockPublisher := new(mocks.FakeTransactionEventPublisher)
+
+	now := time.Now()
+	tx := newTestTransaction(domain.TransactionStatusPending, now)
+	stubTransactionStore(mockTxRepo, map[uuid.UUID]*domain.Transaction{tx.ID: tx}, &now)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", TxTrackerConfirmations: 3, TxTrackerNotFoundTimeout: 30 * time.Minute}
+	logger := logging.New(&cfg)
+	tracker := NewTransactionTracker(mockTxRepo, NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logger), client, mockPublisher, cfg, logger)
+	tracker.now = func() time.Time { return now }
+
+	node.Mine(testTxHash, rpctest.Receipt{BlockNumber: 10, BlockHash: testBlockHashA, Success: false})
+	node.SetHead(20)
+
+	// Act
+	err := tracker.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusFailed, tx.Status)
+	require.Equal(t, 1, mockPublisher.PublishTransactionEventCallCount())
+	_, event := mockPublisher.PublishTransactionEventArgsForCall(0)
+	assert.Equal(t, domain.TransactionStatusFailed, event.Transaction.Status)
+}
+
+func TestTransactionTracker_ReorgBeforeConfirmation(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockPublisher := new(mocks.FakeTransactionEventPublisher)
+
+	now := time.Now()
+	tx := newTestTransaction(domain.TransactionStatusPending, now)
+	stubTransactionStore(mockTxRepo, map[uuid.UUID]*domain.Transaction{tx.ID: tx}, &now)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", TxTrackerConfirmations: 3, TxTrackerNotFoundTimeout: 30 * time.Minute}
+	logger := logging.New(&cfg)
+	tracker := NewTransactionTracker(mockTxRepo, NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logger), client, mockPublisher, cfg, logger)
+	tracker.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	node.Mine(testTxHash, rpctest.Receipt{BlockNumber: 50, BlockHash: testBlockHashA, Success: true})
+	node.SetHead(50)
+	require.NoError(t, tracker.Poll(ctx))
+	require.NotNil(t, tx.BlockNumber)
+
+	// Act: the block is orphaned and the transaction returns to the mempool
+	node.Reorg(testTxHash)
+	err := tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusPending, tx.Status)
+	assert.Nil(t, tx.BlockNumber)
+	assert.Empty(t, tx.BlockHash)
+
+	// Act: it is re-mined in a different block on the new canonical chain
+	node.Mine(testTxHash, rpctest.Receipt{BlockNumber: 51, BlockHash: testBlockHashB, Success: true})
+	node.SetHead(52)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusPending, tx.Status)
+	assert.Equal(t, int64(51), *tx.BlockNumber)
+	assert.Equal(t, testBlockHashB, tx.BlockHash)
+
+	// Act
+	node.SetHead(53)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusConfirmed, tx.Status)
+	assert.Equal(t, 1, mockPublisher.PublishTransactionEventCallCount())
+}
+
+func TestTransactionTracker_NotFoundAfterTimeout(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockPublisher := new(mocks.FakeTransactionEventPublisher)
+
+	now := time.Now()
+	tx := newTestTransaction(domain.TransactionStatusCreated, now)
+	stubTransactionStore(mockTxRepo, map[uuid.UUID]*domain.Transaction{tx.ID: tx}, &now)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", TxTrackerConfirmations: 3, TxTrackerNotFoundTimeout: 30 * time.Minute}
+	logger := logging.New(&cfg)
+	tracker := NewTransactionTracker(mockTxRepo, NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logger), client, mockPublisher, cfg, logger)
+	tracker.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	node.SetHead(10)
+
+	// Act: the node has not seen it yet
+	err := tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusCreated, tx.Status)
+	assert.Equal(t, 0, mockPublisher.PublishTransactionEventCallCount())
+
+	// Act: and still has not once the timeout passes
+	now = now.Add(31 * time.Minute)
+	err = tracker.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusNotFound, tx.Status)
+	require.Equal(t, 1, mockPublisher.PublishTransactionEventCallCount())
+	_, event := mockPublisher.PublishTransactionEventArgsForCall(0)
+	assert.Equal(t, uint64(0), event.Confirmations)
+}
+
+func TestTransactionTracker_MempoolTransactionIsNotTimedOut(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockPublisher := new(mocks.FakeTransactionEventPublisher)
+
+	now := time.Now()
+	tx := newTestTransaction(domain.TransactionStatusPending, now)
+	stubTransactionStore(mockTxRepo, map[uuid.UUID]*domain.Transaction{tx.ID: tx}, &now)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", TxTrackerConfirmations: 3, TxTrackerNotFoundTimeout: 30 * time.Minute}
+	logger := logging.New(&cfg)
+	tracker := NewTransactionTracker(mockTxRepo, NewTransactionService(mockTxRepo, new(mocks.FakeAssetService), logger), client, mockPublisher, cfg, logger)
+	tracker.now = func() time.Time { return now }
+
+	node.AddPending(testTxHash)
+	now = now.Add(time.Hour)
+
+	// Act
+	err := tracker.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransactionStatusPending, tx.Status)
+	assert.Equal(t, 0, mockPublisher.PublishTransactionEventCallCount())
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports"
+	appErrors "github.com/demola234/defifundr/pkg/app_errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// transferIndexerName identifies the indexer's row in indexer_checkpoints
+	transferIndexerName = "erc20_transfers"
+	// indexerRecipientChunk limits how many wallet addresses go into one eth_getLogs topic filter
+	indexerRecipientChunk = 100
+)
+
+// TransferIndexer scans ERC-20 Transfer events for the configured tokens and
+// records transfers into user wallets as inbound transactions.
+//
+// Progress is checkpointed per batch of blocks together with the hash of the
+// last block. If that block is no longer canonical on the next poll, the
+// indexer rewinds IndexerReorgDepth blocks and scans them again. Inbound
+// transfers are stored as pending with the block they were seen in; the
+// TransactionTracker then confirms them once deep enough, or clears them if
+// their block is orphaned. Rescanning the same logs is idempotent, and a
+// transfer re-mined in another block updates its existing row. Transfers of
+// tokens that are not enabled in the assets registry for IndexerChain are skipped.
+type TransferIndexer struct {
+	client         ports.BlockchainClient
+	walletRepo     ports.WalletRepository
+	txRepo         ports.TransactionRepository
+	checkpointRepo ports.IndexerCheckpointRepository
+	assetService   ports.AssetService
+	config         config.Config
+	logger         logging.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewTransferIndexer creates a new ERC-20 transfer indexer
+func NewTransferIndexer(
+	client ports.BlockchainClient,
+	walletRepo ports.WalletRepository,
+	txRepo ports.TransactionRepository,
+	checkpointRepo ports.IndexerCheckpointRepository,
+	assetService ports.AssetService,
+	config config.Config,
+	logger logging.Logger,
+) *TransferIndexer {
+	return &TransferIndexer{
+		client:         client,
+		walletRepo:     walletRepo,
+		txRepo:         txRepo,
+		checkpointRepo: checkpointRepo,
+		assetService:   assetService,
+		config:         config,
+		logger:         logger,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start indexes in the background every IndexerPollInterval until Stop is called
+func (i *TransferIndexer) Start() {
+	interval := i.config.IndexerPollInterval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-i.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := i.Poll(ctx); err != nil {
+					i.logger.Error("Transfer indexer poll failed", err)
+				}
+				cancel()
+			}
+		}
+	}()
+
+	i.logger.Info("Transfer indexer started", map[string]interface{}{
+		"poll_interval": interval.String(),
+		"tokens":        i.config.IndexerTokens,
+	})
+}
+
+// Stop stops the background indexer and waits for an in-flight poll to finish
+func (i *TransferIndexer) Stop() {
+	close(i.stop)
+	i.wg.Wait()
+	i.logger.Info("Transfer indexer stopped")
+}
+
+// Poll indexes every block from the checkpoint up to the current head
+func (i *TransferIndexer) Poll(ctx context.Context) error {
+	head, err := i.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, err := i.nextBlock(ctx, head)
+	if err != nil {
+		return err
+	}
+
+	if next > head {
+		return nil
+	}
+
+	wallets, err := i.loadWallets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for next <= head {
+		to := min(next+i.batchSize()-1, head)
+
+		if err := i.indexRange(ctx, next, to, wallets); err != nil {
+			return err
+		}
+
+		hash, err := i.client.BlockHash(ctx, to)
+		if err != nil {
+			return err
+		}
+
+		if _, err := i.checkpointRepo.SaveCheckpoint(ctx, domain.IndexerCheckpoint{
+			Name:        transferIndexerName,
+			BlockNumber: to,
+			BlockHash:   hash,
+		}); err != nil {
+			return err
+		}
+
+		next = to + 1
+	}
+
+	return nil
+}
+
+// nextBlock returns the first block to scan, rewinding if the checkpointed block was reorged out
+func (i *TransferIndexer) nextBlock(ctx context.Context, head uint64) (uint64, error) {
+	checkpoint, err := i.checkpointRepo.GetCheckpoint(ctx, transferIndexerName)
+	if err != nil {
+		return 0, err
+	}
+
+	if checkpoint == nil {
+		if i.config.IndexerStartBlock > 0 {
+			return i.config.IndexerStartBlock, nil
+		}
+		return head, nil
+	}
+
+	// A chain that got shorter has necessarily reorged past the checkpoint
+	if checkpoint.BlockNumber <= head {
+		hash, err := i.client.BlockHash(ctx, checkpoint.BlockNumber)
+		if err != nil {
+			return 0, err
+		}
+		if strings.EqualFold(hash, checkpoint.BlockHash) {
+			return checkpoint.BlockNumber + 1, nil
+		}
+	}
+
+	rewindTo := uint64(0)
+	if checkpoint.BlockNumber >= i.config.IndexerReorgDepth {
+		rewindTo = checkpoint.BlockNumber - i.config.IndexerReorgDepth + 1
+	}
+
+	i.logger.Warn("Checkpointed block was reorged out, rescanning", map[string]interface{}{
+		"checkpoint_block": checkpoint.BlockNumber,
+		"checkpoint_hash":  checkpoint.BlockHash,
+		"rescan_from":      rewindTo,
+	})
+
+	return rewindTo, nil
+}
+
+// loadWallets returns the EVM wallets to watch, keyed by lower-cased address
+func (i *TransferIndexer) loadWallets(ctx context.Context) (map[string]domain.UserWallet, error) {
+	wallets, err := i.walletRepo.ListWallets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]domain.UserWallet, len(wallets))
+	for _, wallet := range wallets {
+		if isEVMAddress(wallet.Address) {
+			result[strings.ToLower(wallet.Address)] = wallet
+		}
+	}
+
+	return result, nil
+}
+
+// indexRange records every transfer into a watched wallet between from and to inclusive
+func (i *TransferIndexer) indexRange(ctx context.Context, from, to uint64, wallets map[string]domain.UserWallet) error {
+	// An empty topic list matches every address, so never query without recipients
+	if len(wallets) == 0 || len(i.config.IndexerTokens) == 0 {
+		return nil
+	}
+
+	recipients := make([]string, 0, len(wallets))
+	for address := range wallets {
+		recipients = append(recipients, address)
+	}
+
+	for start := 0; start < len(recipients); start += indexerRecipientChunk {
+		logs, err := i.client.TransferLogs(ctx, domain.TransferLogFilter{
+			FromBlock:  from,
+			ToBlock:    to,
+			Tokens:     i.config.IndexerTokens,
+			Recipients: recipients[start:min(start+indexerRecipientChunk, len(recipients))],
+		})
+		if err != nil {
+			return err
+		}
+
+		// A log's index changes when a reorg re-mines its transaction, so each
+		// transfer is identified by its position among the transaction's
+		// transfers of the same token to the same recipient. Every log to a
+		// recipient comes back in the same response, so the count is complete.
+		slices.SortStableFunc(logs, func(a, b domain.TransferLog) int {
+			return cmp.Or(cmp.Compare(a.BlockNumber, b.BlockNumber), cmp.Compare(a.LogIndex, b.LogIndex))
+		})
+		ordinals := make(map[string]int, len(logs))
+
+		for _, log := range logs {
+			wallet, ok := wallets[strings.ToLower(log.To)]
+			if !ok {
+				continue
+			}
+
+			key := strings.ToLower(log.TxHash + "/" + log.Token + "/" + log.To)
+			ordinal := ordinals[key]
+			ordinals[key]++
+
+			if err := i.recordTransfer(ctx, wallet, log, ordinal); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordTransfer records a transfer into a watched wallet. ordinal is its
+// position among the transaction's transfers of the token to the wallet.
+func (i *TransferIndexer) recordTransfer(ctx context.Context, wallet domain.UserWallet, log domain.TransferLog, ordinal int) error {
+	if _, err := i.assetService.ValidateBaseUnitAmount(ctx, i.config.IndexerChain, log.Token, log.Amount); err != nil {
+		var appErr *appErrors.AppError
+		if errors.As(err, &appErr) && appErr.ErrorType == appErrors.ErrorTypeValidation {
+			i.logger.Warn("Skipping transfer of unsupported asset", map[string]interface{}{
+				"tx_hash":   log.TxHash,
+				"log_index": log.LogIndex,
+				"token":     log.Token,
+				"reason":    appErr.Error(),
+			})
+			return nil
+		}
+		return err
+	}
+
+	logIndex := int(log.LogIndex)
+	blockNumber := int64(log.BlockNumber)
+
+	tx, err := i.txRepo.UpsertInboundTransfer(ctx, domain.Transaction{
+		ID:              uuid.New(),
+		UserID:          wallet.UserID,
+		TxHash:          log.TxHash,
+		Status:          domain.TransactionStatusPending,
+		Direction:       domain.TransactionDirectionInbound,
+		LogIndex:        &logIndex,
+		TransferOrdinal: &ordinal,
+		TokenAddress:    log.Token,
+		FromAddress:     log.From,
+		ToAddress:       log.To,
+		Amount:          log.Amount,
+		BlockNumber:     &blockNumber,
+		BlockHash:       log.BlockHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	i.logger.Info("Inbound transfer indexed", map[string]interface{}{
+		"transaction_id": tx.ID,
+		"tx_hash":        log.TxHash,
+		"log_index":      log.LogIndex,
+		"token":          log.Token,
+		"to":             log.To,
+		"amount":         log.Amount.String(),
+		"block_number":   log.BlockNumber,
+	})
+
+	return nil
+}
+
+func (i *TransferIndexer) batchSize() uint64 {
+	if i.config.IndexerBatchSize == 0 {
+		return 1000
+	}
+	return i.config.IndexerBatchSize
+}
+
+// isEVMAddress reports whether address is 0x followed by 20 bytes of hex
+func isEVMAddress(address string) bool {
+	if !strings.HasPrefix(address, "0x") || len(address) != 42 {
+		return false
+	}
+
+	_, err := hex.DecodeString(address[2:])
+	return err == nil
+}
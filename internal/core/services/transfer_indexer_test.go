@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/demola234/defifundr/config"
+	"github.com/demola234/defifundr/infrastructure/blockchain/rpctest"
+	"github.com/demola234/defifundr/infrastructure/common/logging"
+	"github.com/demola234/defifundr/internal/core/domain"
+	"github.com/demola234/defifundr/internal/core/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUSDC        = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	testOtherToken  = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	testWallet      = "0x4444444444444444444444444444444444444444"
+	testStranger    = "0x5555555555555555555555555555555555555555"
+	testPayer       = "0x6666666666666666666666666666666666666666"
+	testTransferTxA = "0x1000000000000000000000000000000000000000000000000000000000000001"
+	testTransferTxB = "0x1000000000000000000000000000000000000000000000000000000000000002"
+)
+
+// watchedWallets has one EVM wallet the indexer watches for the user and a
+// Solana wallet it must ignore
+func watchedWallets(userID uuid.UUID) []domain.UserWallet {
+	return []domain.UserWallet{
+		{ID: uuid.New(), UserID: userID, Address: testWallet, Chain: "ethereum"},
+		{ID: uuid.New(), UserID: uuid.New(), Address: "So11111111111111111111111111111111111111112", Chain: "solana"},
+	}
+}
+
+// stubInboundTransferStore backs the transaction repository fake's inbound
+// transfers with the given map, keyed like the unique index
+func stubInboundTransferStore(repo *mocks.FakeTransactionRepository, transfers map[string]*domain.Transaction) {
+	repo.UpsertInboundTransferStub = func(ctx context.Context, tx domain.Transaction) (*domain.Transaction, error) {
+		key := transferKey(tx.TxHash, tx.TokenAddress, tx.ToAddress, *tx.TransferOrdinal)
+		if existing, ok := transfers[key]; ok {
+			existing.LogIndex = tx.LogIndex
+			existing.BlockNumber = tx.BlockNumber
+			existing.BlockHash = tx.BlockHash
+			return existing, nil
+		}
+		transfers[key] = &tx
+		return &tx, nil
+	}
+}
+
+// stubCheckpointStore keeps the saved checkpoint in the given pointer
+func stubCheckpointStore(repo *mocks.FakeIndexerCheckpointRepository, checkpoint **domain.IndexerCheckpoint) {
+	repo.GetCheckpointStub = func(ctx context.Context, name string) (*domain.IndexerCheckpoint, error) {
+		return *checkpoint, nil
+	}
+	repo.SaveCheckpointStub = func(ctx context.Context, saved domain.IndexerCheckpoint) (*domain.IndexerCheckpoint, error) {
+		*checkpoint = &saved
+		return &saved, nil
+	}
+}
+
+// stubIndexerAssets enables USDC in the registry and registers the other
+// token disabled
+func stubIndexerAssets(repo *mocks.FakeSupportedAssetRepository) {
+	repo.GetAssetByContractStub = func(ctx context.Context, chain, contractAddress string) (*domain.SupportedAsset, error) {
+		switch {
+		case chain == "ethereum" && strings.EqualFold(contractAddress, testUSDC):
+			return &domain.SupportedAsset{ID: uuid.New(), Chain: chain, Symbol: "USDC", ContractAddress: testUSDC, Decimals: 6, Enabled: true}, nil
+		case chain == "ethereum" && strings.EqualFold(contractAddress, testOtherToken):
+			return &domain.SupportedAsset{ID: uuid.New(), Chain: chain, Symbol: "USDT", ContractAddress: testOtherToken, Decimals: 6}, nil
+		}
+		return nil, nil
+	}
+}
+
+// transferKey mirrors the inbound transfer unique index
+func transferKey(txHash, token, to string, ordinal int) string {
+	return strings.ToLower(fmt.Sprintf("%s:%s:%s:%d", txHash, token, to, ordinal))
+}
+
+// findTransfer finds the stored transfer for a log by its current position in the transaction
+func findTransfer(transfers map[string]*domain.Transaction, txHash string, logIndex uint) *domain.Transaction {
+	for _, tx := range transfers {
+		if strings.EqualFold(tx.TxHash, txHash) && *tx.LogIndex == int(logIndex) {
+			return tx
+		}
+	}
+	return nil
+}
+
+func TestTransferIndexer_IndexesInboundTransfers(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}, IndexerStartBlock: 100, IndexerBatchSize: 5}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	node.SetHead(112)
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 3, BlockNumber: 101, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(2_500_000)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 0, BlockNumber: 110, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(750_000)})
+	// Not ours: another recipient, an unwatched token, and a block before the start
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 4, BlockNumber: 101, Token: testUSDC, From: testPayer, To: testStranger, Amount: big.NewInt(1)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 1, BlockNumber: 110, Token: testOtherToken, From: testPayer, To: testWallet, Amount: big.NewInt(1)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, LogIndex: 2, BlockNumber: 99, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(1)})
+
+	// Act
+	err := indexer.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, transfers, 2)
+
+	first := findTransfer(transfers, testTransferTxA, 3)
+	require.NotNil(t, first)
+	assert.Equal(t, userID, first.UserID)
+	assert.Equal(t, domain.TransactionDirectionInbound, first.Direction)
+	assert.Equal(t, domain.TransactionStatusPending, first.Status)
+	assert.Equal(t, 0, first.Amount.Cmp(big.NewInt(2_500_000)))
+	assert.True(t, strings.EqualFold(testPayer, first.FromAddress))
+	assert.True(t, strings.EqualFold(testUSDC, first.TokenAddress))
+	assert.Equal(t, int64(101), *first.BlockNumber)
+	assert.Equal(t, node.BlockHashAt(101), strings.ToLower(first.BlockHash))
+
+	require.NotNil(t, findTransfer(transfers, testTransferTxB, 0))
+
+	// Blocks 100-112 in batches of five
+	assert.Equal(t, 3, node.Calls("eth_getLogs"))
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(112), checkpoint.BlockNumber)
+	assert.Equal(t, node.BlockHashAt(112), strings.ToLower(checkpoint.BlockHash))
+}
+
+func TestTransferIndexer_ResumesFromCheckpoint(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}, IndexerStartBlock: 1}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	ctx := context.Background()
+	node.SetHead(10)
+	require.NoError(t, indexer.Poll(ctx))
+	calls := node.Calls("eth_getLogs")
+
+	// Act: nothing new on chain, nothing to scan
+	err := indexer.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, calls, node.Calls("eth_getLogs"))
+
+	// Act: a new block with a transfer
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 11, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.SetHead(11)
+	err = indexer.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, findTransfer(transfers, testTransferTxA, 0))
+	assert.Equal(t, uint64(11), checkpoint.BlockNumber)
+}
+
+func TestTransferIndexer_StartsAtHeadWithoutStartBlock(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 40, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.SetHead(50)
+
+	// Act
+	err := indexer.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, transfers)
+	assert.Equal(t, uint64(50), checkpoint.BlockNumber)
+}
+
+func TestTransferIndexer_RescansAfterReorg(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}, IndexerStartBlock: 1, IndexerReorgDepth: 5}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	ctx := context.Background()
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 19, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.SetHead(20)
+	require.NoError(t, indexer.Poll(ctx))
+	require.Len(t, transfers, 1)
+
+	// Blocks 19 and 20 are replaced. The transfer is re-mined in block 20 and a new one lands in 19.
+	node.SetBlockHash(19, "0x"+strings.Repeat("19", 32))
+	node.SetBlockHash(20, "0x"+strings.Repeat("20", 32))
+	node.RemoveLogs(testTransferTxA)
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 20, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, BlockNumber: 19, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(7)})
+
+	// Act
+	err := indexer.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, transfers, 2)
+	moved := findTransfer(transfers, testTransferTxA, 0)
+	assert.Equal(t, int64(20), *moved.BlockNumber)
+	assert.Equal(t, node.BlockHashAt(20), strings.ToLower(moved.BlockHash))
+	assert.NotNil(t, findTransfer(transfers, testTransferTxB, 0))
+	assert.Equal(t, node.BlockHashAt(20), strings.ToLower(checkpoint.BlockHash))
+}
+
+func TestTransferIndexer_ReorgMovingLogIndexKeepsOneTransfer(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}, IndexerStartBlock: 1, IndexerReorgDepth: 5}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	ctx := context.Background()
+	// Two payments to the same wallet in one transaction
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 3, BlockNumber: 19, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 4, BlockNumber: 19, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(6)})
+	node.SetHead(20)
+	require.NoError(t, indexer.Poll(ctx))
+	require.Len(t, transfers, 2)
+	assert.Equal(t, 0, *findTransfer(transfers, testTransferTxA, 3).TransferOrdinal)
+	assert.Equal(t, 1, *findTransfer(transfers, testTransferTxA, 4).TransferOrdinal)
+
+	// The transaction is re-mined in block 20 behind other logs, so its log indexes change
+	node.SetBlockHash(19, "0x"+strings.Repeat("19", 32))
+	node.SetBlockHash(20, "0x"+strings.Repeat("20", 32))
+	node.RemoveLogs(testTransferTxA)
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 8, BlockNumber: 20, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, LogIndex: 9, BlockNumber: 20, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(6)})
+
+	// Act
+	err := indexer.Poll(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, transfers, 2)
+	moved := findTransfer(transfers, testTransferTxA, 8)
+	require.NotNil(t, moved)
+	assert.Equal(t, 0, *moved.TransferOrdinal)
+	assert.Equal(t, int64(20), *moved.BlockNumber)
+	second := findTransfer(transfers, testTransferTxA, 9)
+	require.NotNil(t, second)
+	assert.Equal(t, 1, *second.TransferOrdinal)
+}
+
+func TestTransferIndexer_SkipsDisabledAssets(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	userID := uuid.New()
+	mockWalletRepo.ListWalletsReturns(watchedWallets(userID), nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	// USDT is watched by configuration but disabled in the registry
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC, testOtherToken}, IndexerStartBlock: 1}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxA, BlockNumber: 5, Token: testOtherToken, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.AddLog(rpctest.TransferLog{TxHash: testTransferTxB, BlockNumber: 5, Token: testUSDC, From: testPayer, To: testWallet, Amount: big.NewInt(5)})
+	node.SetHead(10)
+
+	// Act
+	err := indexer.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, transfers, 1)
+	assert.NotNil(t, findTransfer(transfers, testTransferTxB, 0))
+	assert.Equal(t, uint64(10), checkpoint.BlockNumber)
+}
+
+func TestTransferIndexer_NoWatchedWallets(t *testing.T) {
+	// Arrange
+	node, client := newTestEVMClient(t)
+	mockWalletRepo := new(mocks.FakeWalletRepository)
+	mockTxRepo := new(mocks.FakeTransactionRepository)
+	mockCheckpointRepo := new(mocks.FakeIndexerCheckpointRepository)
+	mockAssetRepo := new(mocks.FakeSupportedAssetRepository)
+
+	mockWalletRepo.ListWalletsReturns(nil, nil)
+	transfers := make(map[string]*domain.Transaction)
+	stubInboundTransferStore(mockTxRepo, transfers)
+	var checkpoint *domain.IndexerCheckpoint
+	stubCheckpointStore(mockCheckpointRepo, &checkpoint)
+	stubIndexerAssets(mockAssetRepo)
+
+	cfg := config.Config{LogOutput: "stdout", LogLevel: "panic", IndexerChain: "ethereum", IndexerTokens: []string{testUSDC}, IndexerStartBlock: 1}
+	logger := logging.New(&cfg)
+	indexer := NewTransferIndexer(client, mockWalletRepo, mockTxRepo, mockCheckpointRepo, NewAssetService(mockAssetRepo, logger), cfg, logger)
+
+	node.SetHead(10)
+
+	// Act
+	err := indexer.Poll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	// An empty recipient filter would match every transfer on chain
+	assert.Equal(t, 0, node.Calls("eth_getLogs"))
+	assert.Equal(t, uint64(10), checkpoint.BlockNumber)
+	assert.Equal(t, 0, mockTxRepo.UpsertInboundTransferCallCount())
+}
@@ -0,0 +1,80 @@
+package hdWallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrInvalidKey is returned when a key is not a serialized BIP32 extended key
+	ErrInvalidKey = errors.New("not a valid extended public key")
+	// ErrPrivateKey is returned when an extended private key is given where only the public key may be stored
+	ErrPrivateKey = errors.New("extended private keys are not accepted; export the account's extended public key")
+	// ErrNotAccountKey is returned when the key is not at the BIP44 account level
+	ErrNotAccountKey = errors.New("extended public key must be at the account level (m/44'/60'/0')")
+)
+
+// accountDepth is the depth of a BIP44 account key, m/purpose'/coin_type'/account'
+const accountDepth = 3
+
+// externalChain is the BIP44 change index of receiving addresses
+const externalChain = 0
+
+// AccountKey is a BIP44 account-level extended public key that EVM receiving
+// addresses are derived from without any private key material, so deposit
+// addresses can be handed out by a server that cannot spend from them.
+type AccountKey struct {
+	key      *hdkeychain.ExtendedKey
+	external *hdkeychain.ExtendedKey
+}
+
+// ParseAccountKey parses a serialized extended public key (xpub) exported at
+// the account level, such as m/44'/60'/0'. Private keys are rejected.
+func ParseAccountKey(serialized string) (*AccountKey, error) {
+	key, err := hdkeychain.NewKeyFromString(serialized)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	if key.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+	if key.Depth() != accountDepth {
+		return nil, ErrNotAccountKey
+	}
+
+	external, err := key.Derive(externalChain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive receiving chain: %w", err)
+	}
+
+	return &AccountKey{key: key, external: external}, nil
+}
+
+// String returns the serialized extended public key
+func (k *AccountKey) String() string {
+	return k.key.String()
+}
+
+// Address derives the checksummed EVM address of receiving address index,
+// the path 0/index below the account. A wallet that imports the same account
+// recovers these addresses as long as its gap limit covers the unused ones.
+func (k *AccountKey) Address(index uint32) (string, error) {
+	if index >= hdkeychain.HardenedKeyStart {
+		return "", fmt.Errorf("address index %d is out of range", index)
+	}
+
+	child, err := k.external.Derive(index)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive address %d: %w", index, err)
+	}
+
+	pub, err := child.ECPubKey()
+	if err != nil {
+		return "", fmt.Errorf("failed to derive address %d: %w", index, err)
+	}
+
+	return crypto.PubkeyToAddress(*pub.ToECDSA()).Hex(), nil
+}
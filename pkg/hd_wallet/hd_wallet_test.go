@@ -0,0 +1,80 @@
+package hdWallet
+
+import (
+	"crypto/sha512"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// testMnemonic is the BIP39 test mnemonic; its first Ethereum address is widely published
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// deriveAccount returns the private key at m/44'/60'/0' of the test mnemonic
+func deriveAccount(t *testing.T) *hdkeychain.ExtendedKey {
+	t.Helper()
+
+	seed := pbkdf2.Key([]byte(testMnemonic), []byte("mnemonic"), 2048, 64, sha512.New)
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	for _, index := range []uint32{44, 60, 0} {
+		key, err = key.Derive(hdkeychain.HardenedKeyStart + index)
+		require.NoError(t, err)
+	}
+
+	return key
+}
+
+func TestAccountKey_Address(t *testing.T) {
+	account := deriveAccount(t)
+	xpub, err := account.Neuter()
+	require.NoError(t, err)
+
+	key, err := ParseAccountKey(xpub.String())
+	require.NoError(t, err)
+	assert.Equal(t, xpub.String(), key.String())
+
+	first, err := key.Address(0)
+	require.NoError(t, err)
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", first)
+
+	// Public derivation matches the addresses of the private keys at m/44'/60'/0'/0/i
+	external, err := account.Derive(0)
+	require.NoError(t, err)
+	for _, index := range []uint32{1, 2, 1000} {
+		child, err := external.Derive(index)
+		require.NoError(t, err)
+		priv, err := child.ECPrivKey()
+		require.NoError(t, err)
+
+		address, err := key.Address(index)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(priv.ToECDSA().PublicKey).Hex(), address)
+	}
+
+	_, err = key.Address(hdkeychain.HardenedKeyStart)
+	assert.Error(t, err)
+}
+
+func TestParseAccountKey_Rejects(t *testing.T) {
+	account := deriveAccount(t)
+
+	_, err := ParseAccountKey(account.String())
+	assert.ErrorIs(t, err, ErrPrivateKey)
+
+	external, err := account.Derive(0)
+	require.NoError(t, err)
+	externalPub, err := external.Neuter()
+	require.NoError(t, err)
+	_, err = ParseAccountKey(externalPub.String())
+	assert.ErrorIs(t, err, ErrNotAccountKey)
+
+	_, err = ParseAccountKey("xpub-not-a-key")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,309 @@
+// Package money provides an arbitrary-precision monetary amount tagged with a
+// currency or asset code, with safe arithmetic, explicit rounding, conversion
+// between on-chain base units and display units, and JSON and pgtype.Numeric
+// marshalling for financial tables.
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	// ErrInvalidAmount is returned when an amount cannot be parsed or represented
+	ErrInvalidAmount = errors.New("money: invalid amount")
+	// ErrDivisionByZero is returned when dividing or allocating by zero
+	ErrDivisionByZero = errors.New("money: division by zero")
+	// ErrPrecisionLoss is returned when an amount has more decimal places than the target allows
+	ErrPrecisionLoss = errors.New("money: amount exceeds precision")
+)
+
+// RoundingMode selects how amounts are rounded to a number of decimal places
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to nearest, with halves away from zero
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to nearest, with halves to the even digit (banker's rounding)
+	RoundHalfEven
+	// RoundDown rounds toward zero (truncation)
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeil rounds toward positive infinity
+	RoundCeil
+)
+
+// Money is an immutable decimal amount in a currency or asset code such as
+// USD or USDC. The zero value is a zero amount with no currency.
+type Money struct {
+	amount   decimal.Decimal
+	currency string
+}
+
+// New creates an amount in the given currency. The code is upper-cased.
+func New(amount decimal.Decimal, currency string) Money {
+	return Money{amount: amount, currency: normalizeCurrency(currency)}
+}
+
+// Zero returns a zero amount in the given currency
+func Zero(currency string) Money {
+	return New(decimal.Zero, currency)
+}
+
+// Parse parses a decimal string such as "1250.50" into an amount in the given currency
+func Parse(amount, currency string) (Money, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(amount))
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	return New(d, currency), nil
+}
+
+// MustParse is like Parse but panics on error. Intended for constants and tests.
+func MustParse(amount, currency string) Money {
+	m, err := Parse(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// FromBaseUnits converts an integer amount of an asset's smallest unit (e.g.
+// wei, or 10^-6 USDC) into display units using the asset's decimals
+func FromBaseUnits(units *big.Int, currency string, decimals int) Money {
+	if units == nil {
+		return Zero(currency)
+	}
+	return New(decimal.NewFromBigInt(units, -int32(decimals)), currency)
+}
+
+// ToBaseUnits converts the amount into the asset's smallest unit. It fails with
+// ErrPrecisionLoss if the amount has more decimal places than the asset; round
+// it first to choose how the excess is dropped.
+func (m Money) ToBaseUnits(decimals int) (*big.Int, error) {
+	scaled := m.amount.Shift(int32(decimals))
+	if !scaled.Equal(scaled.Truncate(0)) {
+		return nil, fmt.Errorf("%w: %s has more than %d decimal places", ErrPrecisionLoss, m.amount.String(), decimals)
+	}
+
+	return scaled.BigInt(), nil
+}
+
+// Amount returns the decimal amount
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+// Currency returns the upper-case currency or asset code
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// IsPositive reports whether the amount is greater than zero
+func (m Money) IsPositive() bool {
+	return m.amount.IsPositive()
+}
+
+// IsNegative reports whether the amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of the amount
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// Neg returns the amount with its sign flipped
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs returns the absolute amount
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// SameCurrency reports whether both amounts are in the same currency
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency == other.currency
+}
+
+// Add returns m + other. Both must be in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Add(other.amount), currency: m.currency}, nil
+}
+
+// Sub returns m - other. Both must be in the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Sub(other.amount), currency: m.currency}, nil
+}
+
+// Mul multiplies the amount by a factor such as a quantity, rate or FX rate.
+// The result is exact; round it to the precision required.
+func (m Money) Mul(factor decimal.Decimal) Money {
+	return Money{amount: m.amount.Mul(factor), currency: m.currency}
+}
+
+// Div divides the amount by divisor and rounds the result to places decimal places
+func (m Money) Div(divisor decimal.Decimal, places int32, mode RoundingMode) (Money, error) {
+	if divisor.IsZero() {
+		return Money{}, ErrDivisionByZero
+	}
+
+	// Divide with a few guard digits so the final rounding sees the true remainder
+	quotient := m.amount.DivRound(divisor, places+8)
+	return Money{amount: round(quotient, places, mode), currency: m.currency}, nil
+}
+
+// Round rounds the amount to places decimal places using the given mode
+func (m Money) Round(places int32, mode RoundingMode) Money {
+	return Money{amount: round(m.amount, places, mode), currency: m.currency}
+}
+
+// Allocate splits the amount into parts proportional to ratios, each rounded
+// toward zero to places decimal places. The remainder is handed out one
+// smallest unit at a time to the first parts, so the parts always sum exactly
+// to the amount rounded to places.
+func (m Money) Allocate(ratios []int64, places int32) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("%w: negative ratio %d", ErrInvalidAmount, ratio)
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	target := round(m.amount, places, RoundDown)
+	totalDec := decimal.NewFromInt(total)
+
+	parts := make([]Money, len(ratios))
+	allocated := decimal.Zero
+	for i, ratio := range ratios {
+		share := target.Mul(decimal.NewFromInt(ratio)).DivRound(totalDec, places+8)
+		share = round(share, places, RoundDown)
+		parts[i] = Money{amount: share, currency: m.currency}
+		allocated = allocated.Add(share)
+	}
+
+	unit := decimal.New(1, -places)
+	if target.IsNegative() {
+		unit = unit.Neg()
+	}
+	for i := 0; !allocated.Equal(target); i = (i + 1) % len(parts) {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i].amount = parts[i].amount.Add(unit)
+		allocated = allocated.Add(unit)
+	}
+
+	return parts, nil
+}
+
+// Cmp compares two amounts in the same currency, returning -1, 0 or 1
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
+// Equal reports whether both amounts have the same currency and numeric value.
+// Trailing zeros are ignored, so 1.50 USD equals 1.5 USD.
+func (m Money) Equal(other Money) bool {
+	return m.currency == other.currency && m.amount.Equal(other.amount)
+}
+
+// String formats the amount followed by its currency, e.g. "1250.5 USDC"
+func (m Money) String() string {
+	if m.currency == "" {
+		return m.amount.String()
+	}
+	return m.amount.String() + " " + m.currency
+}
+
+// StringFixed formats the amount with exactly places decimal places, rounding half up
+func (m Money) StringFixed(places int32) string {
+	return m.amount.StringFixed(places)
+}
+
+// jsonMoney is the wire format. Amounts are strings so clients never see a float.
+type jsonMoney struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON encodes the amount as {"amount":"12.50","currency":"USDC"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonMoney{Amount: m.amount, Currency: m.currency})
+}
+
+// UnmarshalJSON decodes {"amount":"12.50","currency":"USDC"}. The amount may
+// also be a bare JSON number.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var aux jsonMoney
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAmount, err)
+	}
+
+	*m = New(aux.Amount, aux.Currency)
+	return nil
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return nil
+}
+
+func round(d decimal.Decimal, places int32, mode RoundingMode) decimal.Decimal {
+	switch mode {
+	case RoundHalfEven:
+		return d.RoundBank(places)
+	case RoundDown:
+		return d.RoundDown(places)
+	case RoundUp:
+		return d.RoundUp(places)
+	case RoundFloor:
+		return d.RoundFloor(places)
+	case RoundCeil:
+		return d.RoundCeil(places)
+	default:
+		return d.Round(places)
+	}
+}
+
+func normalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
@@ -0,0 +1,202 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	m, err := Parse(" 1250.50 ", "usdc")
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", m.Currency())
+	assert.Equal(t, "1250.5 USDC", m.String())
+	assert.Equal(t, "1250.50", m.StringFixed(2))
+
+	_, err = Parse("12,50", "USD")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+}
+
+func TestArithmetic(t *testing.T) {
+	a := MustParse("0.1", "USD")
+	b := MustParse("0.2", "USD")
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	// No float drift
+	assert.True(t, sum.Equal(MustParse("0.3", "USD")))
+
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	assert.True(t, diff.IsNegative())
+	assert.True(t, diff.Abs().Equal(MustParse("0.1", "USD")))
+
+	assert.True(t, a.Mul(decimal.NewFromInt(3)).Equal(MustParse("0.3", "USD")))
+
+	cmp, err := a.Cmp(b)
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	_, err = a.Add(MustParse("1", "EUR"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+	_, err = a.Cmp(MustParse("1", "EUR"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+	assert.False(t, a.Equal(MustParse("0.1", "EUR")))
+}
+
+func TestDiv(t *testing.T) {
+	third, err := MustParse("100", "USD").Div(decimal.NewFromInt(3), 2, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, "33.33", third.StringFixed(2))
+
+	up, err := MustParse("100", "USD").Div(decimal.NewFromInt(3), 2, RoundUp)
+	require.NoError(t, err)
+	assert.Equal(t, "33.34", up.StringFixed(2))
+
+	_, err = MustParse("100", "USD").Div(decimal.Zero, 2, RoundHalfUp)
+	assert.True(t, errors.Is(err, ErrDivisionByZero))
+}
+
+func TestRound(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		mode     RoundingMode
+		expected string
+	}{
+		{amount: "2.345", mode: RoundHalfUp, expected: "2.35"},
+		{amount: "-2.345", mode: RoundHalfUp, expected: "-2.35"},
+		{amount: "2.345", mode: RoundHalfEven, expected: "2.34"},
+		{amount: "2.355", mode: RoundHalfEven, expected: "2.36"},
+		{amount: "2.349", mode: RoundDown, expected: "2.34"},
+		{amount: "-2.349", mode: RoundDown, expected: "-2.34"},
+		{amount: "2.341", mode: RoundUp, expected: "2.35"},
+		{amount: "-2.341", mode: RoundUp, expected: "-2.35"},
+		{amount: "-2.341", mode: RoundFloor, expected: "-2.35"},
+		{amount: "-2.349", mode: RoundCeil, expected: "-2.34"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.amount, func(t *testing.T) {
+			rounded := MustParse(tc.amount, "USD").Round(2, tc.mode)
+			assert.Equal(t, tc.expected, rounded.StringFixed(2))
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	parts, err := MustParse("100", "USDC").Allocate([]int64{1, 1, 1}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"33.34", "33.33", "33.33"}, []string{parts[0].StringFixed(2), parts[1].StringFixed(2), parts[2].StringFixed(2)})
+
+	parts, err = MustParse("-0.05", "USD").Allocate([]int64{1, 0, 1}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "-0.03", parts[0].StringFixed(2))
+	assert.True(t, parts[1].IsZero())
+	assert.Equal(t, "-0.02", parts[2].StringFixed(2))
+
+	// Parts always add back up to the original amount
+	parts, err = MustParse("1000.01", "USD").Allocate([]int64{50, 30, 20}, 2)
+	require.NoError(t, err)
+	total := Zero("USD")
+	for _, part := range parts {
+		total, err = total.Add(part)
+		require.NoError(t, err)
+	}
+	assert.True(t, total.Equal(MustParse("1000.01", "USD")))
+
+	_, err = MustParse("1", "USD").Allocate([]int64{0, 0}, 2)
+	assert.True(t, errors.Is(err, ErrDivisionByZero))
+	_, err = MustParse("1", "USD").Allocate([]int64{1, -1}, 2)
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+}
+
+func TestBaseUnits(t *testing.T) {
+	usdc := FromBaseUnits(big.NewInt(2_500_000), "USDC", 6)
+	assert.Equal(t, "2.5 USDC", usdc.String())
+
+	units, err := usdc.ToBaseUnits(6)
+	require.NoError(t, err)
+	assert.Equal(t, 0, units.Cmp(big.NewInt(2_500_000)))
+
+	wei, ok := new(big.Int).SetString("1234500000000000000", 10)
+	require.True(t, ok)
+	eth := FromBaseUnits(wei, "ETH", 18)
+	assert.True(t, eth.Equal(MustParse("1.2345", "ETH")))
+
+	back, err := eth.ToBaseUnits(18)
+	require.NoError(t, err)
+	assert.Equal(t, 0, back.Cmp(wei))
+
+	_, err = MustParse("0.0000001", "USDC").ToBaseUnits(6)
+	assert.True(t, errors.Is(err, ErrPrecisionLoss))
+
+	units, err = MustParse("0.0000001", "USDC").Round(6, RoundUp).ToBaseUnits(6)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), units.Int64())
+
+	assert.True(t, FromBaseUnits(nil, "USDC", 6).IsZero())
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(MustParse("12.50", "usdc"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.5","currency":"USDC"}`, string(data))
+
+	var m Money
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"0.1","currency":"usd"}`), &m))
+	assert.True(t, m.Equal(MustParse("0.1", "USD")))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":99.99,"currency":"EUR"}`), &m))
+	assert.True(t, m.Equal(MustParse("99.99", "EUR")))
+
+	err = json.Unmarshal([]byte(`{"amount":"abc","currency":"EUR"}`), &m)
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+}
+
+func TestNumeric(t *testing.T) {
+	original := MustParse("-1234.5678", "USD")
+
+	n := original.Numeric()
+	assert.True(t, n.Valid)
+
+	m, err := FromNumeric(n, "USD")
+	require.NoError(t, err)
+	assert.True(t, m.Equal(original))
+
+	// Round trips through the pgtype text encoding used by the driver
+	var scanned pgtype.Numeric
+	require.NoError(t, scanned.Scan("1234.5678"))
+	m, err = FromNumeric(scanned, "USD")
+	require.NoError(t, err)
+	assert.True(t, m.Equal(MustParse("1234.5678", "USD")))
+
+	m, err = FromNumeric(pgtype.Numeric{}, "USD")
+	require.NoError(t, err)
+	assert.True(t, m.IsZero())
+
+	_, err = FromNumeric(pgtype.Numeric{NaN: true, Valid: true}, "USD")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+}
+
+func TestBaseUnitsNumeric(t *testing.T) {
+	units, err := BaseUnitsFromNumeric(pgtype.Numeric{Int: big.NewInt(25), Exp: 3, Valid: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(25_000), units.Int64())
+
+	units, err = BaseUnitsFromNumeric(NumericFromBaseUnits(big.NewInt(750_000)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(750_000), units.Int64())
+
+	units, err = BaseUnitsFromNumeric(pgtype.Numeric{})
+	require.NoError(t, err)
+	assert.Nil(t, units)
+
+	_, err = BaseUnitsFromNumeric(pgtype.Numeric{Int: big.NewInt(15), Exp: -1, Valid: true})
+	assert.True(t, errors.Is(err, ErrPrecisionLoss))
+}
@@ -0,0 +1,77 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// Financial tables store the amount in a NUMERIC column next to a currency or
+// asset code column, so conversion works on the amount and takes the code separately.
+
+// NumericFromDecimal converts a decimal into a pgtype.Numeric without loss of precision
+func NumericFromDecimal(d decimal.Decimal) pgtype.Numeric {
+	return pgtype.Numeric{Int: d.Coefficient(), Exp: d.Exponent(), Valid: true}
+}
+
+// DecimalFromNumeric converts a pgtype.Numeric into a decimal. NULL becomes
+// zero; NaN and infinities are rejected.
+func DecimalFromNumeric(n pgtype.Numeric) (decimal.Decimal, error) {
+	if !n.Valid {
+		return decimal.Zero, nil
+	}
+
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return decimal.Zero, fmt.Errorf("%w: non-finite numeric", ErrInvalidAmount)
+	}
+
+	if n.Int == nil {
+		return decimal.Zero, nil
+	}
+
+	return decimal.NewFromBigInt(n.Int, n.Exp), nil
+}
+
+// Numeric returns the amount as a pgtype.Numeric for writing to an amount column
+func (m Money) Numeric() pgtype.Numeric {
+	return NumericFromDecimal(m.amount)
+}
+
+// FromNumeric builds an amount read from a NUMERIC column in the given currency
+func FromNumeric(n pgtype.Numeric, currency string) (Money, error) {
+	d, err := DecimalFromNumeric(n)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(d, currency), nil
+}
+
+// NumericFromBaseUnits converts an integer base-unit amount, as stored in
+// NUMERIC(78,0) on-chain amount columns, into a pgtype.Numeric
+func NumericFromBaseUnits(units *big.Int) pgtype.Numeric {
+	if units == nil {
+		return pgtype.Numeric{}
+	}
+	return pgtype.Numeric{Int: new(big.Int).Set(units), Valid: true}
+}
+
+// BaseUnitsFromNumeric reads an integer base-unit amount back from a NUMERIC
+// column. It returns nil for NULL and fails if the value is not a whole number.
+func BaseUnitsFromNumeric(n pgtype.Numeric) (*big.Int, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	d, err := DecimalFromNumeric(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if !d.Equal(d.Truncate(0)) {
+		return nil, fmt.Errorf("%w: %s is not a whole number of base units", ErrPrecisionLoss, d.String())
+	}
+
+	return d.BigInt(), nil
+}
@@ -0,0 +1,67 @@
+package signedToken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestSigner_SignAndVerify(t *testing.T) {
+	signer, err := NewSigner(testSecret, "invitation")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC)
+	token := signer.Sign("4f1c9a9e-5d2b-4a8f-9a57-1c2d3e4f5a6b", now.Add(time.Hour))
+
+	subject, err := signer.Verify(token, now)
+	require.NoError(t, err)
+	assert.Equal(t, "4f1c9a9e-5d2b-4a8f-9a57-1c2d3e4f5a6b", subject)
+
+	// Subjects may themselves contain the separator
+	subject, err = signer.Verify(signer.Sign("a|b", now.Add(time.Hour)), now)
+	require.NoError(t, err)
+	assert.Equal(t, "a|b", subject)
+
+	subject, err = signer.Verify(token, now.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrExpiredToken)
+	assert.Equal(t, "4f1c9a9e-5d2b-4a8f-9a57-1c2d3e4f5a6b", subject)
+}
+
+func TestSigner_RejectsTamperedTokens(t *testing.T) {
+	signer, err := NewSigner(testSecret, "invitation")
+	require.NoError(t, err)
+	other, err := NewSigner(testSecret, "share_link")
+	require.NoError(t, err)
+
+	now := time.Now()
+	token := signer.Sign("subject", now.Add(time.Hour))
+	encoded, signature, _ := strings.Cut(token, ".")
+	forged := signer.Sign("other", now.Add(time.Hour))
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no_signature", token: encoded},
+		{name: "swapped_payload", token: forgedPayload + "." + signature},
+		{name: "bad_encoding", token: encoded + ".!!"},
+		{name: "other_purpose", token: other.Sign("subject", now.Add(time.Hour))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := signer.Verify(tc.token, now)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+
+	_, err = NewSigner("short", "invitation")
+	assert.Error(t, err)
+}
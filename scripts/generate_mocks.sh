@@ -6,11 +6,40 @@ counterfeiter -o internal/core/ports/mocks/session_repository.go internal/core/p
 counterfeiter -o internal/core/ports/mocks/otp_repository.go internal/core/ports OTPRepository
 counterfeiter -o internal/core/ports/mocks/kyc_repository.go internal/core/ports KYCRepository
 counterfeiter -o internal/core/ports/mocks/email_repository.go internal/core/ports EmailRepository
+counterfeiter -o internal/core/ports/mocks/security_repository.go internal/core/ports SecurityRepository
+counterfeiter -o internal/core/ports/mocks/payout_address_repository.go internal/core/ports PayoutAddressRepository
+counterfeiter -o internal/core/ports/mocks/transaction_repository.go internal/core/ports TransactionRepository
+counterfeiter -o internal/core/ports/mocks/transaction_pin_repository.go internal/core/ports TransactionPINRepository
+counterfeiter -o internal/core/ports/mocks/indexer_checkpoint_repository.go internal/core/ports IndexerCheckpointRepository
+counterfeiter -o internal/core/ports/mocks/wallet_repository.go internal/core/ports WalletRepository
+counterfeiter -o internal/core/ports/mocks/supported_asset_repository.go internal/core/ports SupportedAssetRepository
+counterfeiter -o internal/core/ports/mocks/fx_rate_repository.go internal/core/ports FXRateRepository
+counterfeiter -o internal/core/ports/mocks/ledger_repository.go internal/core/ports LedgerRepository
+counterfeiter -o internal/core/ports/mocks/organization_repository.go internal/core/ports OrganizationRepository
+counterfeiter -o internal/core/ports/mocks/invitation_repository.go internal/core/ports InvitationRepository
+counterfeiter -o internal/core/ports/mocks/payroll_repository.go internal/core/ports PayrollRepository
+counterfeiter -o internal/core/ports/mocks/approval_repository.go internal/core/ports ApprovalRepository
 
 # Generate mocks for services
 counterfeiter -o internal/core/ports/mocks/auth_service.go internal/core/ports AuthService
 counterfeiter -o internal/core/ports/mocks/user_service.go internal/core/ports UserService
 counterfeiter -o internal/core/ports/mocks/oauth_service.go internal/core/ports OAuthService
+counterfeiter -o internal/core/ports/mocks/email_service.go internal/core/ports EmailService
+counterfeiter -o internal/core/ports/mocks/transaction_pin_service.go internal/core/ports TransactionPINService
+counterfeiter -o internal/core/ports/mocks/asset_service.go internal/core/ports AssetService
+counterfeiter -o internal/core/ports/mocks/fx_service.go internal/core/ports FXService
+counterfeiter -o internal/core/ports/mocks/ledger_service.go internal/core/ports LedgerService
+counterfeiter -o internal/core/ports/mocks/organization_service.go internal/core/ports OrganizationService
+counterfeiter -o internal/core/ports/mocks/invitation_service.go internal/core/ports InvitationService
+counterfeiter -o internal/core/ports/mocks/payroll_service.go internal/core/ports PayrollService
+counterfeiter -o internal/core/ports/mocks/approval_service.go internal/core/ports ApprovalService
+counterfeiter -o internal/core/ports/mocks/invoice_repository.go internal/core/ports InvoiceRepository
+counterfeiter -o internal/core/ports/mocks/invoice_service.go internal/core/ports InvoiceService
+counterfeiter -o internal/core/ports/mocks/invoice_renderer.go internal/core/ports InvoiceRenderer
+counterfeiter -o internal/core/ports/mocks/blockchain_client.go internal/core/ports BlockchainClient
+counterfeiter -o internal/core/ports/mocks/transaction_event_publisher.go internal/core/ports TransactionEventPublisher
+counterfeiter -o internal/core/ports/mocks/payroll_contract_client.go internal/core/ports PayrollContractClient
+counterfeiter -o internal/core/ports/mocks/fx_rate_provider.go internal/core/ports FXRateProvider
 
 # Generate mocks for token maker
 counterfeiter -o internal/core/ports/mocks/token_maker.go pkg/token_maker Maker 
\ No newline at end of file